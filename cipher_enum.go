@@ -25,6 +25,15 @@ const (
 	BellasoCipher
 	VigenereCipher
 	AffineCipher
+	BeaufortCipher
+	VariantBeaufortCipher
+	RunningKeyCipher
+	PlayfairCipher
+	HillCipher
+	PolybiusCipher
+	AdfgvxCipher
+	BifidCipher
+	EnigmaCipher
 )
 
 /* ----------------------------------------------------------------
@@ -39,23 +48,41 @@ var _ yaml.Marshaler = NoCipher
  *-----------------------------------------------------------------*/
 
 var cipherToString = map[CipherVariant]string{
-	NoCipher:        "None",
-	CaesarCipher:    "Caesar",
-	DidimusCipher:   "Didimus",
-	FibonacciCipher: "Fibonacci",
-	BellasoCipher:   "Bellaso",
-	VigenereCipher:  "Vigenere",
-	AffineCipher:    "Affine",
+	NoCipher:              "None",
+	CaesarCipher:          "Caesar",
+	DidimusCipher:         "Didimus",
+	FibonacciCipher:       "Fibonacci",
+	BellasoCipher:         "Bellaso",
+	VigenereCipher:        "Vigenere",
+	AffineCipher:          "Affine",
+	BeaufortCipher:        "Beaufort",
+	VariantBeaufortCipher: "VariantBeaufort",
+	RunningKeyCipher:      "RunningKey",
+	PlayfairCipher:        "Playfair",
+	HillCipher:            "Hill",
+	PolybiusCipher:        "Polybius",
+	AdfgvxCipher:          "ADFGVX",
+	BifidCipher:           "Bifid",
+	EnigmaCipher:          "Enigma",
 }
 
 var stringToCipher = map[string]CipherVariant{
-	"None":      NoCipher,
-	"Caesar":    CaesarCipher,
-	"Didimus":   DidimusCipher,
-	"Fibonacci": FibonacciCipher,
-	"Bellaso":   BellasoCipher,
-	"Vigenere":  VigenereCipher,
-	"Affine":    AffineCipher,
+	"None":            NoCipher,
+	"Caesar":          CaesarCipher,
+	"Didimus":         DidimusCipher,
+	"Fibonacci":       FibonacciCipher,
+	"Bellaso":         BellasoCipher,
+	"Vigenere":        VigenereCipher,
+	"Affine":          AffineCipher,
+	"Beaufort":        BeaufortCipher,
+	"VariantBeaufort": VariantBeaufortCipher,
+	"RunningKey":      RunningKeyCipher,
+	"Playfair":        PlayfairCipher,
+	"Hill":            HillCipher,
+	"Polybius":        PolybiusCipher,
+	"ADFGVX":          AdfgvxCipher,
+	"Bifid":           BifidCipher,
+	"Enigma":          EnigmaCipher,
 }
 
 /* ----------------------------------------------------------------
@@ -133,6 +160,9 @@ func (c CipherVariant) MarshalYAML() (interface{}, error) {
 	return cipherToString[c], nil
 }
 
+// MaxCipher is the highest variant scheduled by the Caesarium. Variants
+// added after AffineCipher are left out so that existing codebooks can
+// still be reproduced from their seed.
 func MaxCipher() int {
 	return int(AffineCipher)
 }
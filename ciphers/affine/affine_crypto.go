@@ -9,12 +9,14 @@
 package affine
 
 import (
-	"bufio"
 	"fmt"
 	"io"
+	z "lordofscripts/caesarx"
 	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
 	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/files"
 	"os"
 	"strings"
 )
@@ -39,6 +41,7 @@ type AffineCrypto struct {
 	slave      *affineContext
 	sequencerE *crypto.AffineSequencer
 	sequencerD *crypto.AffineSequencer
+	header     bool // binary files start with a FileHeader
 }
 
 /* ----------------------------------------------------------------
@@ -100,6 +103,7 @@ func NewAffineCrypto(alpha *cmn.Alphabet, params *AffineParams) *AffineCrypto {
 		slave:      nil,
 		sequencerE: crypto.NewAffineSequencer(params.A, params.B, alpha),
 		sequencerD: crypto.NewAffineSequencer(params.A, params.B, alpha),
+		header:     true,
 	}
 }
 
@@ -134,6 +138,13 @@ func (c *AffineCrypto) GetParams() (masterP *AffineParams, slaveP *AffineParams)
 	return
 }
 
+// enables (default) or disables the FileHeader written at the start of
+// encrypted binary files. Decryption reads it when present.
+func (c *AffineCrypto) WithFileHeader(enabled bool) *AffineCrypto {
+	c.header = enabled
+	return c
+}
+
 // Attach a secondary (slave) alphabet chained to the master alphabet
 // Implements the ciphers.ICipher interface
 func (c *AffineCrypto) WithChain(alphaSlave *cmn.Alphabet) error {
@@ -251,127 +262,46 @@ func (c *AffineCrypto) EncryptTextFile(input, output string) error {
 	fdIn, err := os.Open(input)
 	if err != nil {
 		mlog.ErrorE(err)
+		return err
 	}
 	defer fdIn.Close()
-	reader := bufio.NewReader(fdIn)
-
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	var lineIn, lineOut string
-	err = nil
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		lineIn = scanner.Text()
-		lineOut, err = c.Encode(lineIn)
-		if err == nil {
-			_, err = fmt.Fprintln(fdOut, lineOut)
-		}
-
-		if err != nil {
-			mlog.ErrorE(err)
-			destroyOpenFile(fdOut)
-			return err
-		}
-	}
-
-	if err = scanner.Err(); err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
 
-	return err
+	return ciphers.StreamFile(fdIn, output, c.NewStream(false, false), nil)
 }
 
 // Encrypts a binary file and reports any error. If there was an error of
 // any kind, the unfinished output file is deleted from the filesystem. (v1.1+)
 func (c *AffineCrypto) EncryptBinaryFile(input, output string) error {
-	// -- Preamble
 	fdIn, err := os.Open(input)
 	if err != nil {
 		mlog.ErrorE(err)
-	}
-	defer fdIn.Close()
-
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	// -- Setup Transliteration of 0..255
-	hlpr := NewAffineHelper()
-	if err := hlpr.SetParams(c.master.params); err != nil {
-		mlog.ErrorE(err)
 		return err
 	}
+	defer fdIn.Close()
 
-	XlatE := make([]byte, 256)
-	for i := range len(XlatE) {
-		if enc, err := hlpr.Encode(i); err != nil {
-			mlog.ErrorT("error setting up Affine binary table", mlog.Err(err), mlog.At())
-			return err
-		} else {
-			XlatE[i] = byte(enc)
-		}
-	}
-
-	// -- Process cryptostream
-	const BUFFER_SIZE int = 4096
-	buffer := make([]byte, BUFFER_SIZE)
-
-	for {
-		// (a) read bytes from input stream
-		n, errR := fdIn.Read(buffer)
-		if errR != nil {
-			if errR == io.EOF {
-				err = nil // successful termination of file
-				break
+	// -- File header (v1.2) with the cipher, the original extension, the
+	// alphabet, the fingerprint of the key & the digest of the input
+	var preamble func(io.Writer) error = nil
+	if c.header {
+		var XlatE []byte
+		var fh *files.FileHeader
+		if XlatE, err = c.binaryTable(true); err == nil {
+			if fh, err = files.NewFileHeader(z.AffineCipher, input); err == nil {
+				if err = fh.SetAlphabet(c.langCode); err == nil {
+					if err = fh.SetKey(keyProbe(XlatE)); err == nil {
+						err = fh.SetDigest(input)
+					}
+				}
 			}
-
-			// bad yu-yu
-			err = fmt.Errorf("error reading binary file: %w", errR)
-			break
-		}
-
-		// (b) GH-002 encode byte(s) using Affine table for current Coefficients
-		for offset := range n {
-			toEncodeValue := buffer[offset]
-			buffer[offset] = XlatE[toEncodeValue]
 		}
-
-		// (c) GH-002 write byte(s) to binary output file
-		if writeCount, errW := fdOut.Write(buffer[:n]); errW != nil {
-			// oops! something happened with the filesystem
-			err = errW
-			break
-		} else if writeCount != n {
-			// mismatch between data buffer content size and written count
-			err = fmt.Errorf("write count mismatch for binary file %d != %d", writeCount, n)
-			break
+		if err != nil {
+			mlog.ErrorE(err)
+			return err
 		}
+		preamble = fh.Write
 	}
 
-	// -- Epilogue
-	if err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
-
-	return err
+	return ciphers.StreamFile(fdIn, output, c.NewStream(false, true), preamble)
 }
 
 /* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
@@ -410,132 +340,54 @@ func (c *AffineCrypto) Decode(cipher string) (string, error) {
 }
 
 // Decrypts a TEXT file using the Affine coefficient configuration
-// and current alphabet(s). It does so by repeatedly calling the
-// Decode() method for every line of the text file.
+// and current alphabet(s).
 func (c *AffineCrypto) DecryptTextFile(input, output string) error {
 	fdIn, err := os.Open(input)
 	if err != nil {
 		mlog.ErrorE(err)
+		return err
 	}
 	defer fdIn.Close()
-	reader := bufio.NewReader(fdIn)
-
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
 
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	var lineIn, lineOut string
-	err = nil
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		lineIn = scanner.Text()
-		lineOut, err = c.Decode(lineIn)
-		if err == nil {
-			_, err = fmt.Fprintln(fdOut, lineOut)
-		}
-
-		if err != nil {
-			mlog.ErrorE(err)
-			destroyOpenFile(fdOut)
-			return err
-		}
-	}
-
-	if err = scanner.Err(); err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
-
-	return err
+	return ciphers.StreamFile(fdIn, output, c.NewStream(true, false), nil)
 }
 
-// Encrypts a binary file and reports any error. If there was an error of
+// Decrypts a binary file and reports any error. If there was an error of
 // any kind, the unfinished output file is deleted from the filesystem. (v1.1+)
 func (c *AffineCrypto) DecryptBinaryFile(input, output string) error {
 	// -- Preamble
 	fdIn, err := os.Open(input)
 	if err != nil {
 		mlog.ErrorE(err)
+		return err
 	}
 	defer fdIn.Close()
 
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
+	// -- File header (v1.2) if any, it must name the Affine cipher & match the key
+	header, err := files.ReadOptionalHeader(fdIn)
+	if err == nil && header != nil {
+		if err = header.CheckCipher(z.AffineCipher); err == nil {
+			var XlatE []byte
+			if XlatE, err = c.binaryTable(true); err == nil {
+				err = header.VerifyKey(keyProbe(XlatE))
+			}
+		}
 	}
-
-	// -- Setup Transliteration of 0..255
-	hlpr := NewAffineHelper()
-	if err := hlpr.SetParams(c.master.params); err != nil {
+	if err != nil {
 		mlog.ErrorE(err)
 		return err
 	}
 
-	XlatD := make([]byte, 256)
-	for i := range len(XlatD) {
-		if dec, err := hlpr.Decode(i); err != nil {
-			mlog.ErrorT("error setting up Affine binary table", mlog.Err(err), mlog.At())
-			return err
-		} else {
-			XlatD[i] = byte(dec)
-		}
-	}
-
-	// -- Process cryptostream
-	const BUFFER_SIZE int = 4096
-	buffer := make([]byte, BUFFER_SIZE)
+	err = ciphers.StreamFile(fdIn, output, c.NewStream(true, true), nil)
 
-	for {
-		// (a) read bytes from input stream
-		n, errR := fdIn.Read(buffer)
-		if errR != nil {
-			if errR == io.EOF {
-				err = nil // successful termination of file
-				break
-			}
-
-			// bad yu-yu
-			err = fmt.Errorf("error reading binary file: %w", errR)
-			break
-		}
-
-		// (b) GH-002 encode byte(s) using Affine table for current Coefficients
-		for offset := range n {
-			toDecodeValue := buffer[offset]
-			buffer[offset] = XlatD[toDecodeValue]
-		}
-
-		// (c) GH-002 write byte(s) to binary output file
-		if writeCount, errW := fdOut.Write(buffer[:n]); errW != nil {
-			// oops! something happened with the filesystem
-			err = errW
-			break
-		} else if writeCount != n {
-			// mismatch between data buffer content size and written count
-			err = fmt.Errorf("write count mismatch for binary file %d != %d", writeCount, n)
-			break
+	// -- the plain file must match the digest of the header
+	if err == nil && header != nil {
+		if err = header.VerifyDigest(output); err != nil {
+			mlog.ErrorE(err)
+			os.Remove(output)
 		}
 	}
 
-	// -- Epilogue
-	if err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
-
 	return err
 }
 
@@ -694,3 +546,42 @@ func buildTabula(alpha *cmn.Alphabet, params *AffineParams, check bool, forEncod
 
 	return rt, nil
 }
+
+// the transliteration of the byte values 0..255 with the current master
+// coefficients, for encoding or decoding binary files.
+func (c *AffineCrypto) binaryTable(forEncoding bool) ([]byte, error) {
+	hlpr := NewAffineHelper()
+	if err := hlpr.SetParams(c.master.params); err != nil {
+		mlog.ErrorE(err)
+		return nil, err
+	}
+
+	xlat := make([]byte, 256)
+	for i := range len(xlat) {
+		var value int
+		var err error
+		if forEncoding {
+			value, err = hlpr.Encode(i)
+		} else {
+			value, err = hlpr.Decode(i)
+		}
+		if err != nil {
+			mlog.ErrorT("error setting up Affine binary table", mlog.Err(err), mlog.At())
+			return nil, err
+		}
+		xlat[i] = byte(value)
+	}
+
+	return xlat, nil
+}
+
+// the FileHeader key probe: the probe encoded with the binary table
+func keyProbe(xlatE []byte) files.KeyProbe {
+	return func(probe []byte) []byte {
+		ciphered := make([]byte, len(probe))
+		for i, b := range probe {
+			ciphered[i] = xlatE[b]
+		}
+		return ciphered
+	}
+}
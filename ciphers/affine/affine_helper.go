@@ -69,6 +69,11 @@ func (h *AffineHelper) AreCoprime(a, b int) bool {
 	return h.helper.AreCoprime(a, b)
 }
 
+// The mathematical (never negative) remainder of a modulo n. (inmutable)
+func (h *AffineHelper) Modulo(a, n int) int {
+	return h.helper.Modulo(a, n)
+}
+
 // For a given alphabet length N, get the slice of
 // coprimes of N between (0..N] (inmutable)
 func (h *AffineHelper) ValidCoprimesUpTo(n uint) []int {
@@ -0,0 +1,90 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ *							   CaesarX
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The crypto stream of the Affine cipher. Each rune (byte) is ciphered
+ * on its own with the same coefficients, so the chunks of the stream
+ * are independent of one another.
+ *-----------------------------------------------------------------*/
+package affine
+
+import (
+	"lordofscripts/caesarx/ciphers"
+)
+
+/* ----------------------------------------------------------------
+ *				I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IStreamCipher = (*AffineCrypto)(nil)
+var _ ciphers.ICryptoStream = (*affineStream)(nil)
+
+/* ----------------------------------------------------------------
+ *				P r i v a t e	T y p e s
+ *-----------------------------------------------------------------*/
+
+type affineStream struct {
+	c       *AffineCrypto
+	decrypt bool
+	binary  bool
+	xlat    []byte // the binary table (0..255) of a binary stream
+	err     error  // building the binary table
+}
+
+/* ----------------------------------------------------------------
+ *				P u b l i c		M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// (IStreamCipher) a crypto stream that en/decrypts a text (UTF-8) or
+// binary message chunk by chunk.
+func (c *AffineCrypto) NewStream(decrypt, binary bool) ciphers.ICryptoStream {
+	stream := &affineStream{
+		c:       c,
+		decrypt: decrypt,
+		binary:  binary,
+		xlat:    nil,
+		err:     nil,
+	}
+
+	if binary {
+		stream.xlat, stream.err = c.binaryTable(!decrypt)
+	}
+
+	return stream
+}
+
+// (ICryptoStream) en/decrypts the next chunk of the message
+func (s *affineStream) Next(chunk []byte) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if s.binary {
+		out := make([]byte, len(chunk))
+		for i, b := range chunk {
+			out[i] = s.xlat[b]
+		}
+		return out, nil
+	}
+
+	var text string
+	var err error
+	if s.decrypt {
+		text, err = s.c.Decode(string(chunk))
+	} else {
+		text, err = s.c.Encode(string(chunk))
+	}
+
+	return []byte(text), err
+}
+
+// (ICryptoStream)
+func (s *affineStream) IsBinary() bool {
+	return s.binary
+}
+
+// (ICryptoStream) nothing to reset, the Affine cipher has no key sequence
+func (s *affineStream) Close() error {
+	return nil
+}
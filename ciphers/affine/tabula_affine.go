@@ -0,0 +1,161 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Affine cipher through the Tabula Recta pipeline. The transform
+ * f(x) = (A*x + B) % N is a multiplication by A followed by a Caesar
+ * shift by B, hence an AffineSequencer feeds the Text & Binary
+ * iterators like any other member of the Caesar family. With a
+ * schedule of several (A,B) pairs it becomes polyalphabetic, the
+ * pairs are repeated over the runes present in the alphabets.
+ *-----------------------------------------------------------------*/
+package affine
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"strconv"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// separates the pairs of an Affine schedule, i.e. 7:3,5:12
+	SCHEDULE_SEPARATOR = ","
+	// separates the A & B coefficients of a pair
+	PAIR_SEPARATOR = ":"
+)
+
+var (
+	InfoPoly = ciphers.NewCipherInfo(crypto.ALG_CODE_AFFINE_POLY, "1.0",
+		"Unknown",
+		crypto.ALG_NAME_AFFINE_POLY,
+		"Affine with a schedule of coefficients")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoPoly)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*AffineTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type AffineTabulaRecta struct {
+	caesar.CaesarTabulaRecta
+	keygen *crypto.AffineSequencer
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Affine cipher using a Tabula Recta that supports ASCII,
+ * foreign (UTF8) & binary alphabets. The coefficients come from the
+ * sequencer: NewAffineSequencer (A,B), NewAffineScheduleSequencer
+ * (explicit list or Caesarium) or NewAffineKeywordSequencer.
+ * · follow with WithChain() to chain with supplemental alphabets.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ * It returns nil if the sequencer is nil.
+ */
+func NewAffineTabulaRecta(alphabet *cmn.Alphabet, keygen *crypto.AffineSequencer) *AffineTabulaRecta {
+	if keygen == nil {
+		return nil
+	}
+
+	base := caesar.NewCaesarTabulaRecta(alphabet, alphabet.GetRuneAt(0))
+	base.WithSequencer(keygen)
+
+	return &AffineTabulaRecta{*base, keygen}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *AffineTabulaRecta) String() string {
+	return cx.keygen.Name()
+}
+
+// the schedule of (A,B) coefficients
+func (cx *AffineTabulaRecta) Schedule() []crypto.AffinePair {
+	return cx.keygen.Schedule()
+}
+
+// The coefficients are only valid for the length of the alphabet given
+// in the constructor, a new sequencer is needed for another alphabet.
+func (cx *AffineTabulaRecta) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipher {
+	if alphabet.Size() != uint(len([]rune(cx.GetAlphabet()))) {
+		mlog.ErrorT("Affine coefficients not valid for the new alphabet",
+			mlog.String("Alpha", alphabet.Name),
+			mlog.At())
+		return cx
+	}
+
+	cx.CaesarTabulaRecta.WithAlphabet(alphabet)
+	return cx
+}
+
+// The key of the Affine cipher are its coefficients, keyword-mixed
+// alphabets do not apply.
+func (cx *AffineTabulaRecta) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipher {
+	mlog.WarnT("ignored keyword because Affine uses coefficients", mlog.At())
+	return cx
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+/**
+ * Parse an explicit schedule of Affine coefficients given as A:B pairs
+ * separated by commas, i.e. "7:3,5:12,11:1". The pairs are not checked
+ * against any alphabet, that is done by the sequencer.
+ */
+func ParseAffineSchedule(spec string) ([]crypto.AffinePair, error) {
+	fields := strings.Split(spec, SCHEDULE_SEPARATOR)
+	schedule := make([]crypto.AffinePair, 0, len(fields))
+	for _, field := range fields {
+		coefs := strings.Split(strings.TrimSpace(field), PAIR_SEPARATOR)
+		if len(coefs) != 2 {
+			return nil, fmt.Errorf("invalid Affine pair '%s' in schedule, expected A:B", field)
+		}
+
+		a, errA := strconv.Atoi(coefs[0])
+		b, errB := strconv.Atoi(coefs[1])
+		if errA != nil || errB != nil || a <= 0 || b < 0 {
+			return nil, fmt.Errorf("invalid Affine pair '%s' in schedule, A & B are positive integers", field)
+		}
+
+		schedule = append(schedule, crypto.AffinePair{A: a, B: b})
+	}
+
+	return schedule, nil
+}
+
+// The string representation of a schedule, as parsed by ParseAffineSchedule()
+func ScheduleString(schedule []crypto.AffinePair) string {
+	pairs := make([]string, len(schedule))
+	for i, pair := range schedule {
+		pairs[i] = fmt.Sprintf("%d%s%d", pair.A, PAIR_SEPARATOR, pair.B)
+	}
+
+	return strings.Join(pairs, SCHEDULE_SEPARATOR)
+}
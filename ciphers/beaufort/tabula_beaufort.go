@@ -0,0 +1,80 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Beaufort cipher using a Tabula Recta. Like Bellaso it repeats a
+ * secret over the message but the ciphertext is the key minus the
+ * plaintext (C = K - P) which makes it self-reciprocal: the very
+ * same operation enciphers and deciphers.
+ *-----------------------------------------------------------------*/
+package beaufort
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+var (
+	Info = ciphers.NewCipherInfo(crypto.ALG_CODE_BEAUFORT, "1.0",
+		"Francis Beaufort",
+		crypto.ALG_NAME_BEAUFORT,
+		"Beaufort reciprocal polyalphabetic cipher")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(Info)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*BeaufortTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type BeaufortTabulaRecta struct {
+	caesar.CaesarTabulaRecta
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Beaufort Cipher using a Tabula Recta that supports ASCII and
+ * foreign (UTF8) alphabets. Has an Extended Numeric alphabet.
+ * · Always follow it with a call to VerifyKey() or VerifySecret() prior to
+ *	 beginning encoding/decoding.
+ * · follow with WithChain() to chain with supplemental alphabets.
+ * · follow with WithAlphabet() to specify a different alphabet prior to encoding.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ */
+func NewBeaufortTabulaRecta(alphabet *cmn.Alphabet, secret string) *BeaufortTabulaRecta {
+	base := caesar.NewCaesarTabulaRecta(alphabet, alphabet.GetRuneAt(0))
+	base.WithSequencer(crypto.NewBeaufortSequencer(secret, alphabet))
+	base.WithTabulaMode(caesar.TabulaModeBeaufort)
+
+	francis := &BeaufortTabulaRecta{*base}
+	francis.WithChain(ciphers.NewTabulaRecta(cmn.NUMBERS_DISK_EXT, true))
+
+	return francis
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *BeaufortTabulaRecta) String() string {
+	return crypto.ALG_NAME_BEAUFORT
+}
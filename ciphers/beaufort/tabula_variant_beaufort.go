@@ -0,0 +1,80 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Variant Beaufort cipher using a Tabula Recta. The ciphertext is
+ * the plaintext minus the key (C = P - K), in other words it
+ * enciphers with the Bellaso decipherment and vice versa. Unlike
+ * the Beaufort proper, it is NOT reciprocal.
+ *-----------------------------------------------------------------*/
+package beaufort
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+var (
+	InfoVariant = ciphers.NewCipherInfo(crypto.ALG_CODE_VARIANT_BEAUFORT, "1.0",
+		"Francis Beaufort",
+		crypto.ALG_NAME_VARIANT_BEAUFORT,
+		"Variant Beaufort polyalphabetic cipher")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoVariant)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*VariantBeaufortTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type VariantBeaufortTabulaRecta struct {
+	caesar.CaesarTabulaRecta
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Variant Beaufort Cipher using a Tabula Recta that supports ASCII
+ * and foreign (UTF8) alphabets. Has an Extended Numeric alphabet.
+ * · Always follow it with a call to VerifyKey() or VerifySecret() prior to
+ *	 beginning encoding/decoding.
+ * · follow with WithChain() to chain with supplemental alphabets.
+ * · follow with WithAlphabet() to specify a different alphabet prior to encoding.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ */
+func NewVariantBeaufortTabulaRecta(alphabet *cmn.Alphabet, secret string) *VariantBeaufortTabulaRecta {
+	base := caesar.NewCaesarTabulaRecta(alphabet, alphabet.GetRuneAt(0))
+	base.WithSequencer(crypto.NewVariantBeaufortSequencer(secret, alphabet))
+	base.WithTabulaMode(caesar.TabulaModeVariantBeaufort)
+
+	francis := &VariantBeaufortTabulaRecta{*base}
+	francis.WithChain(ciphers.NewTabulaRecta(cmn.NUMBERS_DISK_EXT, true))
+
+	return francis
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *VariantBeaufortTabulaRecta) String() string {
+	return crypto.ALG_NAME_VARIANT_BEAUFORT
+}
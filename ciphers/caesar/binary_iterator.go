@@ -13,11 +13,13 @@ import (
 type BinaryIterator struct {
 	tabulas     []ciphers.IGTabulaRecta[byte]
 	sequencer   crypto.IKeySequencer
+	affine      crypto.IAffineSequencer // non-nil if the sequencer is Affine
 	sb          strings.Builder
 	accumulated int
 	pos         int
 	dataPtr     *([]byte)
 	max         int
+	mode        TabulaMode
 }
 
 type byteRune struct {
@@ -48,16 +50,26 @@ func NewBinaryIterator(sx crypto.IKeySequencer, tabs ...ciphers.IGTabulaRecta[by
 		}
 	}
 
+	affine, _ := sx.(crypto.IAffineSequencer)
+
 	return &BinaryIterator{
 		tabulas:     tabulas,
 		sequencer:   sx,
+		affine:      affine,
 		accumulated: 0,
 		pos:         -1,
 		dataPtr:     nil,
 		max:         -1,
+		mode:        TabulaModeStandard,
 	}
 }
 
+// WithMode sets how the tabulae are read, by default TabulaModeStandard.
+func (t *BinaryIterator) WithMode(mode TabulaMode) *BinaryIterator {
+	t.mode = mode
+	return t
+}
+
 // implements fmt.Stringer
 func (a *byteRune) String() string {
 	return fmt.Sprintf("%02xh - %d", a.Rune, a.Shift)
@@ -109,11 +121,12 @@ func (t *BinaryIterator) EncodeNext() bool {
 			)
 		}
 
+		plain := t.multiply(currTab, target.Rune, false)
 		if currTab == 0 { // Primary alphabet
-			result = t.tabulas[0].EncodeRune(target.Rune, currKey.Rune)
+			result = substitute(t.tabulas[0], t.mode, plain, currKey.Rune, false)
 		} else {
 			_, keyNew := t.tabulas[currTab].TransposeKey(currKey.Shift)
-			result = t.tabulas[currTab].EncodeRune(target.Rune, keyNew)
+			result = substitute(t.tabulas[currTab], t.mode, plain, keyNew, false)
 		}
 
 		/*
@@ -144,11 +157,12 @@ func (t *BinaryIterator) DecodeNext() bool {
 	// Tabula index, Rune to en/decode, Current key, Shift within alphabet
 	if currTab, target, keyCurr := t.next(); currTab != -1 {
 		if currTab == 0 { // Primary alphabet
-			result = t.tabulas[0].DecodeRune(target.Rune, keyCurr.Rune)
+			result = substitute(t.tabulas[0], t.mode, target.Rune, keyCurr.Rune, true)
 		} else {
 			_, newKey := t.tabulas[currTab].TransposeKey(keyCurr.Shift)
-			result = t.tabulas[currTab].DecodeRune(target.Rune, newKey)
+			result = substitute(t.tabulas[currTab], t.mode, target.Rune, newKey, true)
 		}
+		result = t.multiply(currTab, result, true)
 
 		/*
 			mlog.PrintCatheter("Decode",
@@ -223,6 +237,22 @@ func (t *BinaryIterator) next() (int, *byteRune, *byteRune) {
 	return currTabula, target, currKey
 }
 
+// The multiplicative step of the Affine sequencers (A·x when encoding
+// and A'·y when decoding). It must be called after next() and it is a
+// no-op for the other sequencers.
+func (t *BinaryIterator) multiply(currTab int, b byte, decrypting bool) byte {
+	if t.affine == nil {
+		return b
+	}
+
+	n := t.tabulas[currTab].Size()
+	a, aInverse := t.affine.GetMultiplier(t.accumulated+t.pos-1, n)
+	if decrypting {
+		a = aInverse
+	}
+	return scale(t.tabulas[currTab], b, a, n)
+}
+
 // Result returns the iterator's final output as a slice of bytes.
 func (t *BinaryIterator) Result() []byte {
 	output := []byte(t.sb.String())
@@ -8,7 +8,7 @@ package caesar
 
 import (
 	"fmt"
-	"lordofscripts/caesarx/cmn"
+	"unicode"
 )
 
 /* ----------------------------------------------------------------
@@ -60,13 +60,13 @@ func NewCaesarAugustusOpts(key rune, alternateOffset uint) *CaesarOptions {
 	return &CaesarOptions{CAESAR_AUGUSTUS, key, alternateOffset, false}
 }
 
-// CAESAR_AUGUSTUS
-// Extended Caesar cipher with same character set as CAESAR_EXTENDED but the
-// Inner disk oscillates back and forth between the Initial (outer) key and the
-// Initial key plus an offset (innerKey) depending on the odd/even input character position.
-// Uses the SYMBOL_DISK and a single oscillating ALPHA_DISK.
-func NewCaesarTiberiusOpts(outerKey rune, innerKey uint) *CaesarOptions { // @audit does not work with Foreign alphabets as-is
-	return &CaesarOptions{CAESAR_TIBERIUS, outerKey, innerKey % cmn.ALPHA_DISK.Size(), false}
+// CAESAR_TIBERIUS
+// Extended Caesar cipher with same character set as CAESAR_EXTENDED but
+// with two independent disks. The Initial (outer) key turns the alphabet
+// disk and the innerKey shift turns the SYMBOL_DISK. The inner shift is
+// taken modulo the length of the slave, hence it works with any alphabet.
+func NewCaesarTiberiusOpts(outerKey rune, innerKey uint) *CaesarOptions {
+	return &CaesarOptions{CAESAR_TIBERIUS, outerKey, innerKey, false}
 }
 
 /* ----------------------------------------------------------------
@@ -78,22 +78,36 @@ func (c *CaesarOptions) String() string {
 
 	switch c.Variant {
 	case CAESAR, CAESAR_EXTENDED:
-		readable = fmt.Sprintf("%s (Key = %d)", c.Variant, c.Initial)
+		readable = fmt.Sprintf("%s (Key = %c)", c.Variant, c.Initial)
 
 	case CAESAR_AUGUSTUS, CAESAR_TIBERIUS:
-		readable = fmt.Sprintf("%s (Key = %d, Suplement = %d)", c.Variant, c.Initial, c.Supplemental)
+		readable = fmt.Sprintf("%s (Key = %c, Suplement = %d)", c.Variant, c.Initial, c.Supplemental)
 
 	}
 
 	return readable
 }
 
+/**
+ * A short leader that identifies the mode & keys, i.e. CAESM, CAEXM,
+ * CAEOM03 or CAETM07. Runes that are not printable (Binary alphabet)
+ * are given in hexadecimal, i.e. CAES{0A}.
+ */
 func (c *CaesarOptions) LeaderString() string {
 	var prefix []string = []string{"XXX", "CAES", "CAEX", "CAEO", "CAET"}
+	if c.Variant < CAESAR || c.Variant > CAESAR_TIBERIUS {
+		return prefix[0]
+	}
+
 	var sidx string = ""
 	if c.Variant == CAESAR_AUGUSTUS || c.Variant == CAESAR_TIBERIUS {
 		sidx = fmt.Sprintf("%02d", c.Supplemental)
 	}
 
-	return fmt.Sprintf("%s%02d%s", prefix[int(c.Variant)], c.Initial, sidx)
+	key := string(c.Initial)
+	if !unicode.IsGraphic(c.Initial) || unicode.IsSpace(c.Initial) {
+		key = fmt.Sprintf("{%02X}", c.Initial)
+	}
+
+	return fmt.Sprintf("%s%s%s", prefix[int(c.Variant)], key, sidx)
 }
@@ -6,6 +6,11 @@
  *-----------------------------------------------------------------*/
 package caesar
 
+import (
+	"fmt"
+	"strings"
+)
+
 /* ----------------------------------------------------------------
  *							G l o b a l s
  *-----------------------------------------------------------------*/
@@ -37,7 +42,11 @@ type CaesarCipherMode uint
  *-----------------------------------------------------------------*/
 
 func (m CaesarCipherMode) String() string {
-	return [...]string{"Caesar", "Extended Caesar", "Extended Caesar Augustus", "Extended Caesar Tiberius", "Vignere"}[m-1]
+	names := [...]string{"Caesar", "Extended Caesar", "Extended Caesar Augustus", "Extended Caesar Tiberius", "Bellaso", "Vignere"}
+	if m < CAESAR || m > VIGNERE_AUTOKEY {
+		return "Unknown"
+	}
+	return names[m-1]
 }
 
 func (m CaesarCipherMode) EnumIndex() uint {
@@ -47,3 +56,23 @@ func (m CaesarCipherMode) EnumIndex() uint {
 /* ----------------------------------------------------------------
  *							F u n c t i o n s
  *-----------------------------------------------------------------*/
+
+/**
+ * Parse the (case-insensitive) name of one of the Caesar modes as
+ * given in the CLI or a profile: caesar (or empty), extended, augustus
+ * or tiberius.
+ */
+func ParseCaesarMode(name string) (CaesarCipherMode, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "caesar", "plain":
+		return CAESAR, nil
+	case "extended":
+		return CAESAR_EXTENDED, nil
+	case "augustus":
+		return CAESAR_AUGUSTUS, nil
+	case "tiberius":
+		return CAESAR_TIBERIUS, nil
+	}
+
+	return CAESAR, fmt.Errorf("unknown Caesar mode '%s', use caesar|extended|augustus|tiberius", name)
+}
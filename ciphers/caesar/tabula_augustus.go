@@ -0,0 +1,88 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Extended Caesar Augustus: the same character set as the Extended
+ * Caesar but the disk oscillates back & forth between the key N and
+ * the key N+K: N, N+K, N, N+K...
+ *-----------------------------------------------------------------*/
+package caesar
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+var (
+	InfoAugustus = ciphers.NewCipherInfo(crypto.ALG_CODE_AUGUSTUS, "1.0",
+		"Didimo Grimaldo",
+		crypto.ALG_NAME_AUGUSTUS,
+		"Extended Caesar with an oscillating disk")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoAugustus)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*AugustusTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type AugustusTabulaRecta struct {
+	CaesarTabulaRecta
+	key    rune
+	offset uint
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Extended Caesar Augustus Cipher using a Tabula Recta that
+ * supports ASCII and foreign (UTF8) alphabets. The key is used on even
+ * positions and the key plus the offset on odd positions. Has the
+ * Symbols alphabet as slave.
+ * · Always follow it with a call to VerifyKey() or VerifySecret() prior to
+ *	 beginning encoding/decoding.
+ * · follow with WithChain() to chain with other supplemental alphabets.
+ * · follow with WithAlphabet() to specify a different alphabet prior to encoding.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ */
+func NewAugustusTabulaRecta(alphabet *cmn.Alphabet, key rune, offset uint) *AugustusTabulaRecta {
+	base := NewCaesarTabulaRecta(alphabet, key)
+	base.sequencer = crypto.NewAugustusSequencer(key, offset, alphabet)
+	augustus := &AugustusTabulaRecta{*base, key, offset}
+	augustus.WithChain(ciphers.NewTabulaRecta(cmn.SYMBOL_DISK, true))
+
+	return augustus
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *AugustusTabulaRecta) String() string {
+	return cx.sequencer.GetKeyInfo()
+}
+
+// WithAlphabet() replaces the MAIN (primary) alphabet. The alternate
+// key depends on the alphabet, hence the sequencer is rebuilt.
+func (cx *AugustusTabulaRecta) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipher {
+	cx.CaesarTabulaRecta.WithAlphabet(alphabet)
+	cx.WithSequencer(crypto.NewAugustusSequencer(cx.key, cx.offset, alphabet))
+	return cx
+}
@@ -4,17 +4,23 @@
  * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
  * Plain Caesar cipher using Tabula Recta implementation. Is
  * case-insensitive but preserves case.
+ * Text files are en/decrypted line by line (see tabulaStream): like
+ * in v1 the position in the key sequence starts over with every line
+ * while the sequencer goes on. The line breaks (LF or CRLF, with or
+ * without a final one) are copied as they are, hence the round trip
+ * of a text file gives back the very same bytes.
  *-----------------------------------------------------------------*/
 package caesar
 
 import (
-	"bufio"
 	"fmt"
 	"io"
+	z "lordofscripts/caesarx"
 	"lordofscripts/caesarx/app/mlog"
 	"lordofscripts/caesarx/ciphers"
 	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/files"
 	"os"
 	"sync"
 	"unicode/utf8"
@@ -51,6 +57,9 @@ type CaesarTabulaRecta struct {
 	alpha     *cmn.Alphabet
 	slave     *ciphers.TabulaRecta // implements cmn.IRuneLocalizer
 	sequencer crypto.IKeySequencer
+	mode      TabulaMode
+	keys      ciphers.QuagmireKeys
+	header    bool // binary files start with a FileHeader
 	mu        *sync.Mutex
 }
 
@@ -66,12 +75,16 @@ type CaesarTabulaRecta struct {
  * · follow with WithChain() to chain with supplemental alphabets.
  * · follow with WithAlphabet() to specify a different alphabet prior to encoding.
  * · It does case-folding by default, so it handles & preserves upper/lowercase
+ * · Encrypted binary files start with a FileHeader, see WithFileHeader().
  */
 func NewCaesarTabulaRecta(alphabet *cmn.Alphabet, key rune) *CaesarTabulaRecta {
 	return &CaesarTabulaRecta{
 		alpha:     alphabet,
 		slave:     nil,
 		sequencer: crypto.NewCaesarSequencer(key),
+		mode:      TabulaModeStandard,
+		keys:      ciphers.QuagmireKeys{},
+		header:    true,
 		mu:        new(sync.Mutex),
 	}
 }
@@ -122,12 +135,53 @@ func (cx *CaesarTabulaRecta) WithSequencer(keygen crypto.IKeySequencer) ciphers.
 	return cx
 }
 
+// WithTabulaMode() specifies how the Tabula Recta is read. Used by the
+// Beaufort family which subtracts rather than adds the key.
+func (cx *CaesarTabulaRecta) WithTabulaMode(mode TabulaMode) ciphers.ICipher {
+	cx.mu.Lock()
+	defer cx.mu.Unlock()
+
+	cx.mode = mode
+	return cx
+}
+
+// WithFileHeader() enables (default) or disables the FileHeader written
+// at the start of encrypted binary files. Decryption reads it when present.
+func (cx *CaesarTabulaRecta) WithFileHeader(enabled bool) ciphers.ICipher {
+	cx.mu.Lock()
+	defer cx.mu.Unlock()
+
+	cx.header = enabled
+	return cx
+}
+
+// WithKeyword() uses keyword-mixed alphabets in the master Tabula Recta
+// (Quagmire I-IV). Slaves keep their natural order. Not for Binary.
+func (cx *CaesarTabulaRecta) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipher {
+	cx.mu.Lock()
+	defer cx.mu.Unlock()
+
+	if !cx.alpha.IsBinary() {
+		cx.keys = keys
+	} else {
+		mlog.WarnT("ignored keyword because primary alphabet is Binary", mlog.At())
+	}
+	return cx
+}
+
 /**
  * Verify key(s). If none given it checks the key given in the constructor,
  * else it checks all the given keys. The key (single character) must be
- * present in the encoding alphabet.
+ * present in the encoding alphabet. The Beaufort mode refuses a plaintext
+ * keyword of its own (Quagmire I & IV).
  */
 func (cx *CaesarTabulaRecta) VerifyKey(keys ...rune) error {
+	if cx.mode == TabulaModeBeaufort {
+		if err := cx.keys.CheckRowsOnly(); err != nil {
+			return err
+		}
+	}
+
 	verify := func(k rune) error {
 		if !cx.alpha.Contains(k, cmn.CaseInsensitive) { // @audit what if TR is not case folded!
 			return fmt.Errorf("key '%c' is not part of the alphabet", k)
@@ -219,9 +273,9 @@ func (cx *CaesarTabulaRecta) GetLanguage() string {
 func (cx *CaesarTabulaRecta) Encode(plain string) string {
 	defer cx.sequencer.Reset()
 
-	master := ciphers.NewTabulaRecta(cx.alpha, cmn.CaseInsensitive)
+	master := ciphers.NewKeyedTabulaRecta(cx.alpha, cmn.CaseInsensitive, cx.keys)
 	cx.sequencer.SetDecryptionMode(false) // only matters with Vigenere
-	iter := NewTextIterator(cx.sequencer, master, cx.slave)
+	iter := NewTextIterator(cx.sequencer, master, cx.slave).WithMode(cx.mode)
 	iter.Start(plain)
 	for !iter.EncodeNext() {
 		//fmt.Print("E")
@@ -240,7 +294,7 @@ func (cx *CaesarTabulaRecta) EncodeBytes(plain []byte) []byte {
 	master := ciphers.NewBinaryTabulaRecta()
 	cx.sequencer.SetDecryptionMode(false) // only matters with Vigenere
 
-	iter := NewBinaryIterator(cx.sequencer, master) // @note no slaves with Binary!
+	iter := NewBinaryIterator(cx.sequencer, master).WithMode(cx.mode) // @note no slaves with Binary!
 	iter.Start(plain)
 	for !iter.EncodeNext() {
 	}
@@ -249,55 +303,16 @@ func (cx *CaesarTabulaRecta) EncodeBytes(plain []byte) []byte {
 }
 
 // Encrypts the input TEXT file using the selected Caesar variant and
-// produces the output filename with the encrypted contents.
+// produces the output filename with the encrypted contents. The lines
+// are encrypted one by one, as the files of v1 were.
 func (cx *CaesarTabulaRecta) EncryptTextFile(input, output string) error {
 	cx.mu.Lock()
 	defer cx.mu.Unlock()
 
-	fdIn, err := os.Open(input)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdIn.Close()
-	reader := bufio.NewReader(fdIn)
+	stream := cx.newLineStream(false)
+	defer stream.Close()
 
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	master := ciphers.NewTabulaRecta(cx.alpha, cmn.CaseInsensitive)
-	cx.sequencer.SetDecryptionMode(false) // only matters with Vigenere
-	iter := NewTextIterator(cx.sequencer, master, cx.slave)
-	defer cx.sequencer.Reset()
-
-	var lineIn string
-	err = nil
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		lineIn = scanner.Text()
-		iter.Start(lineIn)
-		for !iter.EncodeNext() {
-		}
-		if _, err = fmt.Fprintln(fdOut, iter.Result()); err != nil {
-			mlog.ErrorE(err)
-			destroyOpenFile(fdOut)
-			return err
-		}
-	}
-
-	if err = scanner.Err(); err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
-
-	return err
+	return ciphers.ProcessTextFile(input, output, stream.NextLine)
 }
 
 // Encrypts a binary file and reports any error. If there was an error of
@@ -306,79 +321,27 @@ func (cx *CaesarTabulaRecta) EncryptBinaryFile(input, output string) error {
 	cx.mu.Lock()
 	defer cx.mu.Unlock()
 
-	// -- Preamble
 	fdIn, err := os.Open(input)
 	if err != nil {
 		mlog.ErrorE(err)
+		return err
 	}
 	defer fdIn.Close()
 
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	// -- Setup Cryptostream
-	master := ciphers.NewBinaryTabulaRecta()
-	cx.sequencer.SetDecryptionMode(false) // only matters with Vigenere
-	iter := NewBinaryIterator(cx.sequencer, master)
-	defer cx.sequencer.Reset()
-
-	// -- Process cryptostream
-	const BUFFER_SIZE int = 4096
-	buffer := make([]byte, BUFFER_SIZE)
-	firstCall := true
-
-	for {
-		// (a) read bytes from input stream
-		n, errR := fdIn.Read(buffer)
-		if errR != nil {
-			if errR == io.EOF {
-				err = nil // successful termination of file
-				break
-			}
-
-			// bad yu-yu
-			err = fmt.Errorf("error reading binary file: %w", errR)
-			break
-		}
-
-		// (b) GH-002 encode byte(s)
-		if firstCall {
-			iter.Start(buffer[:n])
-			firstCall = false
-		} else {
-			iter.Update(buffer[:n])
-		}
-
-		for !iter.EncodeNext() {
-		}
-
-		// (c) GH-002 write byte(s) to binary output file
-		if writeCount, errW := fdOut.Write(iter.Result()); errW != nil {
-			// oops! something happened with the filesystem
-			err = errW
-			break
-		} else if writeCount != n {
-			// mismatch between data buffer content size and written count
-			err = fmt.Errorf("write count mismatch for binary file %d != %d", writeCount, n)
-			break
+	// -- File header (v1.2) with the cipher & the original extension. Even
+	// the reciprocal Beaufort writes it: its files are deciphered by
+	// DecryptBinaryFile, which checks the key & the digest.
+	var preamble func(io.Writer) error = nil
+	if cx.header {
+		fh, err := cx.fileHeader(input)
+		if err != nil {
+			mlog.ErrorE(err)
+			return err
 		}
+		preamble = fh.Write
 	}
 
-	// -- Epilogue
-	if err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
-
-	return err
+	return ciphers.StreamFile(fdIn, output, cx.NewStream(false, true), preamble)
 }
 
 /* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
@@ -390,10 +353,10 @@ func (cx *CaesarTabulaRecta) EncryptBinaryFile(input, output string) error {
 func (cx *CaesarTabulaRecta) Decode(ciphered string) string {
 	defer cx.sequencer.Reset()
 
-	master := ciphers.NewTabulaRecta(cx.alpha, cmn.CaseInsensitive)
+	master := ciphers.NewKeyedTabulaRecta(cx.alpha, cmn.CaseInsensitive, cx.keys)
 	cx.sequencer.SetDecryptionMode(true) // only matters with Vigenere
 
-	iter := NewTextIterator(cx.sequencer, master, cx.slave)
+	iter := NewTextIterator(cx.sequencer, master, cx.slave).WithMode(cx.mode)
 	iter.Start(ciphered)
 	for !iter.DecodeNext() {
 		//fmt.Print("D")
@@ -412,7 +375,7 @@ func (cx *CaesarTabulaRecta) DecodeBytes(ciphered []byte) []byte {
 	master := ciphers.NewBinaryTabulaRecta()
 	cx.sequencer.SetDecryptionMode(true) // only matters with Vigenere
 
-	iter := NewBinaryIterator(cx.sequencer, master) // @note no slaves with Binary!
+	iter := NewBinaryIterator(cx.sequencer, master).WithMode(cx.mode) // @note no slaves with Binary!
 	iter.Start(ciphered)
 	for !iter.DecodeNext() {
 	}
@@ -421,55 +384,16 @@ func (cx *CaesarTabulaRecta) DecodeBytes(ciphered []byte) []byte {
 }
 
 // Decrypts the input TEXT file using the selected Caesar variant and
-// produces the output filename with the decrypted contents.
+// produces the output filename with the decrypted contents. The lines
+// are decrypted one by one, as the files of v1 were.
 func (cx *CaesarTabulaRecta) DecryptTextFile(input, output string) error {
 	cx.mu.Lock()
 	defer cx.mu.Unlock()
 
-	fdIn, err := os.Open(input)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdIn.Close()
-	reader := bufio.NewReader(fdIn)
-
-	fdOut, err := os.Create(output)
-	if err != nil {
-		mlog.ErrorE(err)
-	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	master := ciphers.NewTabulaRecta(cx.alpha, cmn.CaseInsensitive)
-	cx.sequencer.SetDecryptionMode(true) // only matters with Vigenere
-	iter := NewTextIterator(cx.sequencer, master, cx.slave)
-	defer cx.sequencer.Reset()
+	stream := cx.newLineStream(true)
+	defer stream.Close()
 
-	var lineIn string
-	err = nil
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		lineIn = scanner.Text()
-		iter.Start(lineIn)
-		for !iter.DecodeNext() {
-		}
-		if _, err = fmt.Fprintln(fdOut, iter.Result()); err != nil {
-			mlog.ErrorE(err)
-			destroyOpenFile(fdOut)
-			return err
-		}
-	}
-
-	if err = scanner.Err(); err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
-
-	return err
+	return ciphers.ProcessTextFile(input, output, stream.NextLine)
 }
 
 // Decrypts a binary file and reports any error. If there was an error of
@@ -482,75 +406,73 @@ func (cx *CaesarTabulaRecta) DecryptBinaryFile(input, output string) error {
 	fdIn, err := os.Open(input)
 	if err != nil {
 		mlog.ErrorE(err)
+		return err
 	}
 	defer fdIn.Close()
 
-	fdOut, err := os.Create(output)
+	// -- File header (v1.2) if any, it must name this cipher & match the key
+	header, err := files.ReadOptionalHeader(fdIn)
+	if err == nil && header != nil {
+		if err = header.CheckCipher(cx.variant()); err == nil {
+			err = header.VerifyKey(cx.EncodeBytes)
+		}
+	}
 	if err != nil {
 		mlog.ErrorE(err)
+		return err
 	}
-	defer fdOut.Close()
-
-	destroyOpenFile := func(fd *os.File) {
-		fd.Close() // in Windows a file must be closed prior to Remove...
-		os.Remove(fd.Name())
-	}
-
-	// -- Setup Cryptostream
-	master := ciphers.NewBinaryTabulaRecta()
-	cx.sequencer.SetDecryptionMode(true) // only matters with Vigenere
-	iter := NewBinaryIterator(cx.sequencer, master)
-	defer cx.sequencer.Reset()
 
-	// -- Process cryptostream
-	const BUFFER_SIZE int = 4096
-	buffer := make([]byte, BUFFER_SIZE)
-	firstCall := true
-
-	for {
-		// (a) read bytes from input stream
-		n, errR := fdIn.Read(buffer)
-		if errR != nil {
-			if errR == io.EOF {
-				err = nil // successful termination of file
-				break
-			}
+	err = ciphers.StreamFile(fdIn, output, cx.NewStream(true, true), nil)
 
-			// bad yu-yu
-			err = fmt.Errorf("error reading binary file: %w", errR)
-			break
-		}
-
-		// (b) GH-002 encode byte(s)
-		if firstCall {
-			iter.Start(buffer[:n])
-			firstCall = false
-		} else {
-			iter.Update(buffer[:n])
+	// -- the plain file must match the digest of the header
+	if err == nil && header != nil {
+		if err = header.VerifyDigest(output); err != nil {
+			mlog.ErrorE(err)
+			os.Remove(output)
 		}
+	}
 
-		for !iter.DecodeNext() {
-		}
+	return err
+}
 
-		// (c) GH-002 write byte(s) to binary output file
-		if writeCount, errW := fdOut.Write(iter.Result()); errW != nil {
-			// oops! something happened with the filesystem
-			err = errW
-			break
-		} else if writeCount != n {
-			// mismatch between data buffer content size and written count
-			err = fmt.Errorf("write count mismatch for binary file %d != %d", writeCount, n)
-			break
+// the FileHeader of the binary file encrypted from input: the cipher,
+// the alphabet, the fingerprint of the key & the digest of the input.
+func (cx *CaesarTabulaRecta) fileHeader(input string) (*files.FileHeader, error) {
+	fh, err := files.NewFileHeader(cx.variant(), input)
+	if err == nil {
+		if err = fh.SetAlphabet(cx.alpha.LangCodeISO()); err == nil {
+			if err = fh.SetKey(cx.EncodeBytes); err == nil {
+				err = fh.SetDigest(input)
+			}
 		}
 	}
 
-	// -- Epilogue
-	if err != nil {
-		mlog.ErrorE(err)
-		destroyOpenFile(fdOut)
-	}
+	return fh, err
+}
 
-	return err
+// the cipher variant recorded in the FileHeader, told by the sequencer
+// because the other tabula ciphers embed this one.
+func (cx *CaesarTabulaRecta) variant() z.CipherVariant {
+	switch cx.sequencer.(type) {
+	case *crypto.DidimusSequencer:
+		return z.DidimusCipher
+	case *crypto.FibonacciSequencer:
+		return z.FibonacciCipher
+	case *crypto.BellasoSequencer:
+		return z.BellasoCipher
+	case *crypto.VigenereSequencer:
+		return z.VigenereCipher
+	case *crypto.BeaufortSequencer:
+		return z.BeaufortCipher
+	case *crypto.VariantBeaufortSequencer:
+		return z.VariantBeaufortCipher
+	case *crypto.RunningKeySequencer:
+		return z.RunningKeyCipher
+	case *crypto.AffineSequencer:
+		return z.AffineCipher
+	default: // Caesar, Augustus & Tiberius
+		return z.CaesarCipher
+	}
 }
 
 /* ----------------------------------------------------------------
@@ -0,0 +1,76 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Extended Caesar: the plain Caesar single key, but the decimal digits,
+ * the most-used punctuation & symbols are also encoded because the
+ * SYMBOL_DISK is chained by default.
+ *-----------------------------------------------------------------*/
+package caesar
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+var (
+	InfoExtended = ciphers.NewCipherInfo(crypto.ALG_CODE_CAESAR_EXTENDED, "1.0",
+		"Didimo Grimaldo",
+		crypto.ALG_NAME_CAESAR_EXTENDED,
+		"Caesar cipher with digits, punctuation & symbols")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoExtended)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*ExtendedTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type ExtendedTabulaRecta struct {
+	CaesarTabulaRecta
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Extended Caesar Cipher using a Tabula Recta that supports ASCII
+ * and foreign (UTF8) alphabets. Uses a single key and the Symbols
+ * alphabet as slave.
+ * · Always follow it with a call to VerifyKey() or VerifySecret() prior to
+ *	 beginning encoding/decoding.
+ * · follow with WithChain() to chain with other supplemental alphabets.
+ * · follow with WithAlphabet() to specify a different alphabet prior to encoding.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ */
+func NewExtendedTabulaRecta(alphabet *cmn.Alphabet, key rune) *ExtendedTabulaRecta {
+	base := NewCaesarTabulaRecta(alphabet, key)
+	extended := &ExtendedTabulaRecta{*base}
+	extended.WithChain(ciphers.NewTabulaRecta(cmn.SYMBOL_DISK, true))
+
+	return extended
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *ExtendedTabulaRecta) String() string {
+	return cx.sequencer.GetKeyInfo()
+}
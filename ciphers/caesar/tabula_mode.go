@@ -0,0 +1,75 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * How a Tabula Recta is read during encipherment. The classic
+ * Caesar family adds the key (C = P + K), the Beaufort family
+ * subtracts: Beaufort proper is C = K - P (self-reciprocal) and
+ * the Variant Beaufort is C = P - K.
+ *-----------------------------------------------------------------*/
+package caesar
+
+import "lordofscripts/caesarx/ciphers"
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+const (
+	TabulaModeStandard        TabulaMode = iota // C = P + K (Caesar, Bellaso, Vigenère...)
+	TabulaModeBeaufort                          // C = K - P (Francis Beaufort, reciprocal)
+	TabulaModeVariantBeaufort                   // C = P - K (Vigenère decipherment)
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type TabulaMode uint8
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (m TabulaMode) String() string {
+	return [...]string{"Standard", "Beaufort", "Variant Beaufort"}[m]
+}
+
+// IsReciprocal tells whether encoding & decoding are the same operation.
+func (m TabulaMode) IsReciprocal() bool {
+	return m == TabulaModeBeaufort
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// substitute applies the tabula operation that corresponds to the mode
+// and direction. Beaufort has a single code path for both directions.
+func substitute[E rune | byte](tab ciphers.IGTabulaRecta[E], mode TabulaMode, r, key E, decrypting bool) E {
+	switch mode {
+	case TabulaModeBeaufort:
+		return tab.BeaufortRune(r, key)
+
+	case TabulaModeVariantBeaufort:
+		if decrypting {
+			return tab.EncodeRune(r, key)
+		}
+		return tab.DecodeRune(r, key)
+
+	default:
+		if decrypting {
+			return tab.DecodeRune(r, key)
+		}
+		return tab.EncodeRune(r, key)
+	}
+}
+
+// scale multiplies the position of r in the tabula's alphabet by the
+// factor modulo n, the multiplicative half of the Affine transform. The
+// additive half is the regular substitution. Case is preserved.
+func scale[E rune | byte](tab ciphers.IGTabulaRecta[E], r E, factor, n int) E {
+	if exists, at := tab.HasRune(r); exists {
+		return tab.EncodeRuneRaw(r, 0, (at*factor)%n)
+	}
+	return r
+}
@@ -0,0 +1,133 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The crypto stream of the Tabula Recta ciphers. Its Text/Binary
+ * iterator lives as long as the stream, so the key sequence goes on
+ * from one chunk to the next: streaming a message in chunks gives the
+ * same result as Encode/Decode of the whole message.
+ * The text files are the exception, they use a line stream whose
+ * chunks are the lines (without line break) of the file. Like in the
+ * text files of v1 the positions start over with every line, though
+ * the sequencer goes on, else the existing files would not decrypt.
+ *-----------------------------------------------------------------*/
+package caesar
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IStreamCipher = (*CaesarTabulaRecta)(nil)
+var _ ciphers.ICryptoStream = (*tabulaStream)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type tabulaStream struct {
+	cx      *CaesarTabulaRecta
+	text    *TextIterator   // nil in a binary stream
+	binary  *BinaryIterator // nil in a text stream
+	decrypt bool
+	started bool // Start()ed the iterator with the first chunk
+	perLine bool // every chunk is a line, Start()ed on its own
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (IStreamCipher) a crypto stream that en/decrypts a text (UTF-8) or
+ * binary message chunk by chunk. The cipher's sequencer belongs to the
+ * stream until it is closed, don't use the cipher meanwhile.
+ */
+func (cx *CaesarTabulaRecta) NewStream(decrypt, binary bool) ciphers.ICryptoStream {
+	return cx.newStream(decrypt, binary, false)
+}
+
+// the (text) line stream of the text files, see NextLine()
+func (cx *CaesarTabulaRecta) newLineStream(decrypt bool) *tabulaStream {
+	return cx.newStream(decrypt, false, true)
+}
+
+func (cx *CaesarTabulaRecta) newStream(decrypt, binary, perLine bool) *tabulaStream {
+	stream := &tabulaStream{
+		cx:      cx,
+		text:    nil,
+		binary:  nil,
+		decrypt: decrypt,
+		started: false,
+		perLine: perLine,
+	}
+
+	cx.sequencer.SetDecryptionMode(decrypt) // only matters with Vigenere
+	if binary {
+		master := ciphers.NewBinaryTabulaRecta()
+		stream.binary = NewBinaryIterator(cx.sequencer, master).WithMode(cx.mode) // @note no slaves with Binary!
+	} else {
+		master := ciphers.NewKeyedTabulaRecta(cx.alpha, cmn.CaseInsensitive, cx.keys)
+		stream.text = NewTextIterator(cx.sequencer, master, cx.slave).WithMode(cx.mode)
+	}
+
+	return stream
+}
+
+// (ICryptoStream) en/decrypts the next chunk of the message
+func (s *tabulaStream) Next(chunk []byte) ([]byte, error) {
+	if s.binary != nil {
+		if !s.started {
+			s.binary.Start(chunk)
+		} else {
+			s.binary.Update(chunk)
+		}
+		s.started = true
+
+		if s.decrypt {
+			for !s.binary.DecodeNext() {
+			}
+		} else {
+			for !s.binary.EncodeNext() {
+			}
+		}
+		return s.binary.Result(), nil
+	}
+
+	if !s.started || s.perLine {
+		s.text.Start(string(chunk))
+	} else {
+		s.text.Update(string(chunk))
+	}
+	s.started = true
+
+	if s.decrypt {
+		for !s.text.DecodeNext() {
+		}
+	} else {
+		for !s.text.EncodeNext() {
+		}
+	}
+	return []byte(s.text.Result()), nil
+}
+
+// en/decrypts the next line (without its line break) of a text file
+func (s *tabulaStream) NextLine(line string) (string, error) {
+	result, err := s.Next([]byte(line))
+	return string(result), err
+}
+
+// (ICryptoStream)
+func (s *tabulaStream) IsBinary() bool {
+	return s.binary != nil
+}
+
+// (ICryptoStream) resets the sequencer for the next message
+func (s *tabulaStream) Close() error {
+	s.cx.sequencer.Reset()
+	return nil
+}
@@ -0,0 +1,107 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Extended Caesar Tiberius: the same character set as the Extended
+ * Caesar but with two independent disks. The outer (master) disk is
+ * turned by the key N and the inner (slave) disk by the shift N'.
+ *-----------------------------------------------------------------*/
+package caesar
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+var (
+	InfoTiberius = ciphers.NewCipherInfo(crypto.ALG_CODE_TIBERIUS, "1.0",
+		"Didimo Grimaldo",
+		crypto.ALG_NAME_TIBERIUS,
+		"Extended Caesar with two independent disks")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoTiberius)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*TiberiusTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type TiberiusTabulaRecta struct {
+	CaesarTabulaRecta
+	keygen *crypto.TiberiusSequencer
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Extended Caesar Tiberius Cipher using a Tabula Recta that
+ * supports ASCII and foreign (UTF8) alphabets. The outer key is a rune
+ * of the alphabet, the inner key is the shift of the slave (modulo its
+ * length). Has the Symbols alphabet as slave.
+ * · Always follow it with a call to VerifyKey() or VerifySecret() prior to
+ *	 beginning encoding/decoding.
+ * · follow with WithChain() to chain with other supplemental alphabets.
+ * · follow with WithAlphabet() to specify a different alphabet prior to encoding.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ */
+func NewTiberiusTabulaRecta(alphabet *cmn.Alphabet, outerKey rune, innerKey uint) *TiberiusTabulaRecta {
+	base := NewCaesarTabulaRecta(alphabet, outerKey)
+	keygen := crypto.NewTiberiusSequencer(outerKey, innerKey, nil)
+	base.sequencer = keygen
+	tiberius := &TiberiusTabulaRecta{*base, keygen}
+	tiberius.WithChain(ciphers.NewTabulaRecta(cmn.SYMBOL_DISK, true))
+
+	return tiberius
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *TiberiusTabulaRecta) String() string {
+	return cx.sequencer.GetKeyInfo()
+}
+
+// WithChain() attaches the inner disk, the sequencer turns it
+// independently of the outer disk.
+func (cx *TiberiusTabulaRecta) WithChain(extra *ciphers.TabulaRecta) ciphers.ICipher {
+	cx.CaesarTabulaRecta.WithChain(extra)
+	cx.attachInnerDisk()
+	return cx
+}
+
+// WithAlphabet() replaces the MAIN (primary) alphabet. There is no
+// inner disk with a Binary alphabet.
+func (cx *TiberiusTabulaRecta) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipher {
+	cx.CaesarTabulaRecta.WithAlphabet(alphabet)
+	cx.attachInnerDisk()
+	return cx
+}
+
+func (cx *TiberiusTabulaRecta) attachInnerDisk() {
+	cx.mu.Lock()
+	defer cx.mu.Unlock()
+
+	if cx.slave == nil || cx.alpha.IsBinary() {
+		cx.keygen.WithSlave(nil) // not a typed nil!
+	} else {
+		cx.keygen.WithSlave(cx.slave)
+	}
+}
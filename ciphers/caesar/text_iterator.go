@@ -1,3 +1,19 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Iterates over a text rune by rune, en/decoding it with the key
+ * sequencer & the tabulae. The position in the key sequence:
+ *	· is that of the rune in the whole message. A message streamed in
+ *	  chunks (Start, then Update) is a single message, a chunk doesn't
+ *	  start the key sequence over. The text files are Start()ed line
+ *	  by line instead, as they always were.
+ *	· the runes in no tabula (line breaks, punctuation...) are copied
+ *	  as they are and Skip()ped: they use up no key letter (Bellaso,
+ *	  Beaufort, Running Key, Affine schedule...) except in the Fibonacci
+ *	  sequence, whose keys go over every rune, and in Vigenère's
+ *	  auto-key, which pops a letter for them as it always did.
+ *-----------------------------------------------------------------*/
 package caesar
 
 import (
@@ -8,7 +24,6 @@ import (
 	"lordofscripts/caesarx/internal/crypto"
 	"reflect"
 	"strings"
-	"unicode/utf8"
 )
 
 type alphaRune struct {
@@ -17,12 +32,15 @@ type alphaRune struct {
 }
 
 type TextIterator struct {
-	tabulas   []ciphers.ITabulaRecta
-	sequencer crypto.IKeySequencer
-	sb        strings.Builder
-	pos       int
-	dataPtr   *string
-	max       int
+	tabulas     []ciphers.ITabulaRecta
+	sequencer   crypto.IKeySequencer
+	affine      crypto.IAffineSequencer // non-nil if the sequencer is Affine
+	sb          strings.Builder
+	accumulated int
+	pos         int
+	data        []rune
+	max         int
+	mode        TabulaMode
 }
 
 /**
@@ -47,38 +65,55 @@ func NewTextIterator(sx crypto.IKeySequencer, tabs ...ciphers.ITabulaRecta) *Tex
 		}
 	}
 
+	affine, _ := sx.(crypto.IAffineSequencer)
+
 	return &TextIterator{
-		tabulas:   tabulas,
-		sequencer: sx,
-		pos:       -1,
-		dataPtr:   nil,
-		max:       -1,
+		tabulas:     tabulas,
+		sequencer:   sx,
+		affine:      affine,
+		accumulated: 0,
+		pos:         -1,
+		data:        nil,
+		max:         -1,
+		mode:        TabulaModeStandard,
 	}
 }
 
+// WithMode sets how the tabulae are read, by default TabulaModeStandard.
+func (t *TextIterator) WithMode(mode TabulaMode) *TextIterator {
+	t.mode = mode
+	return t
+}
+
 func (a *alphaRune) String() string {
 	return fmt.Sprintf("%c - %d", a.Rune, a.Shift)
 }
 
+// Start initializes the iterator with the text prior to the EncodeNext
+// or DecodeNext operations. When streaming a text in chunks it should
+// only be called for the first one and then use Update() instead.
 func (t *TextIterator) Start(s string) {
-	t.dataPtr = &s
+	t.accumulated = 0
+	t.data = []rune(s)
 	t.pos = 0
-	t.max = utf8.RuneCountInString(s)
+	t.max = len(t.data)
 }
 
-func (t *TextIterator) getRuneAt(s string, nr int) (rune, bool) {
-	if nr < 0 {
+// Update continues with the next chunk of the text, the positions given
+// to the sequencer follow those of the previous chunks.
+func (t *TextIterator) Update(s string) {
+	t.accumulated += t.pos
+	t.data = []rune(s)
+	t.pos = 0
+	t.max = len(t.data)
+}
+
+func (t *TextIterator) getRuneAt(s []rune, nr int) (rune, bool) {
+	if nr < 0 || nr >= len(s) {
 		return 0, false // @audit I guess this should panic
 	}
 
-	count := 0
-	for _, r := range s {
-		if count == nr {
-			return r, true
-		}
-		count++
-	}
-	return 0, false
+	return s[nr], true
 }
 
 func (t *TextIterator) EncodeNext() bool {
@@ -99,11 +134,12 @@ func (t *TextIterator) EncodeNext() bool {
 			)
 		}
 
+		plain := t.multiply(currTab, target.Rune, false)
 		if currTab == 0 { // Primary alphabet
-			result = t.tabulas[0].EncodeRune(target.Rune, currKey.Rune)
+			result = substitute(t.tabulas[0], t.mode, plain, currKey.Rune, false)
 		} else {
 			_, keyNew := t.tabulas[currTab].TransposeKey(currKey.Shift)
-			result = t.tabulas[currTab].EncodeRune(target.Rune, keyNew)
+			result = substitute(t.tabulas[currTab], t.mode, plain, keyNew, false)
 			//colIdx, _ := t.tabulas[trIdx].TransposeKey(shift)
 			//result = t.tabulas[trIdx].EncodeRuneRaw(char, shift, colIdx)
 		}
@@ -127,15 +163,16 @@ func (t *TextIterator) DecodeNext() bool {
 	// Tabula index, Rune to en/decode, Current key, Shift within alphabet
 	if currTab, target, keyCurr := t.next(); currTab != -1 {
 		if currTab == 0 { // Primary alphabet
-			result = t.tabulas[0].DecodeRune(target.Rune, keyCurr.Rune)
+			result = substitute(t.tabulas[0], t.mode, target.Rune, keyCurr.Rune, true)
 		} else {
 			//_, keyNew := t.tabulas[trIdx].TransposeKey(shift) @audit problem here
 			//result = t.tabulas[trIdx].DecodeRune(char, keyNew)
 			//rowIdx, _ := t.tabulas[trIdx].TransposeKey(shift)
 			//result = t.tabulas[trIdx].DecodeRuneRaw(char, rowIdx)
 			_, newKey := t.tabulas[currTab].TransposeKey(keyCurr.Shift)
-			result = t.tabulas[currTab].DecodeRune(target.Rune, newKey)
+			result = substitute(t.tabulas[currTab], t.mode, target.Rune, newKey, true)
 		}
+		result = t.multiply(currTab, result, true)
 
 		if err := t.sequencer.Feedback(result); err != nil {
 			mlog.FatalT(caesarx.ERR_SEQUENCER,
@@ -178,8 +215,8 @@ func (t *TextIterator) next() (int, *alphaRune, *alphaRune) {
 		return id, result
 	}
 
-	if targetChar, ok := t.getRuneAt(*t.dataPtr, t.pos); ok {
-		key := t.sequencer.GetKey(t.pos, targetChar) // always from Primary alphabet
+	if targetChar, ok := t.getRuneAt(t.data, t.pos); ok {
+		key := t.sequencer.GetKey(t.accumulated+t.pos, targetChar) // always from Primary alphabet
 		keyTabulaId, keyInfo := locatorFx(key)
 		if keyTabulaId != -1 {
 			currKey = keyInfo
@@ -221,6 +258,22 @@ func (t *TextIterator) next() (int, *alphaRune, *alphaRune) {
 	return currTabula, target, currKey
 }
 
+// The multiplicative step of the Affine sequencers (A·x when encoding
+// and A'·y when decoding) in the alphabet of the given tabula. It must
+// be called after next() and it is a no-op for the other sequencers.
+func (t *TextIterator) multiply(currTab int, r rune, decrypting bool) rune {
+	if t.affine == nil {
+		return r
+	}
+
+	n := t.tabulas[currTab].Size()
+	a, aInverse := t.affine.GetMultiplier(t.accumulated+t.pos-1, n)
+	if decrypting {
+		a = aInverse
+	}
+	return scale(t.tabulas[currTab], r, a, n)
+}
+
 func (t *TextIterator) Result() string {
 	output := t.sb.String()
 	t.sb.Reset()
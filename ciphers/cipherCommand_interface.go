@@ -48,3 +48,19 @@ type ICipherCommand interface {
 	// String representation of the command
 	fmt.Stringer
 }
+
+// Implemented by the commands of Tabula Recta ciphers which can work
+// with keyword-mixed alphabets (Quagmire I-IV).
+type IKeyedCipherCommand interface {
+	ICipherCommand
+	// Use keyword-mixed plaintext and/or ciphertext alphabets
+	WithKeyword(keys QuagmireKeys) ICipherCommand
+}
+
+// Implemented by the commands of ciphers whose encrypted binary files
+// start with a FileHeader naming the cipher & the original extension.
+type IFileHeaderCommand interface {
+	ICipherCommand
+	// Write (default) or omit the FileHeader of encrypted binary files
+	WithFileHeader(enabled bool) ICipherCommand
+}
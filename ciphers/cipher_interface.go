@@ -21,6 +21,8 @@ type ICipher interface {
 	WithChain(*TabulaRecta) ICipher
 	WithAlphabet(alphabet *cmn.Alphabet) ICipher
 	WithSequencer(crypto.IKeySequencer) ICipher
+	WithKeyword(QuagmireKeys) ICipher
+	WithFileHeader(enabled bool) ICipher
 
 	// Queries
 	cmn.IRuneLocalizer
@@ -39,6 +41,7 @@ type ICipher interface {
 	DecryptTextFile(fileIn, fileOut string) error
 	EncryptBinaryFile(fileIn, fileOut string) error
 	DecryptBinaryFile(fileIn, fileOut string) error
+	IStreamCipher
 
 	fmt.Stringer
 }
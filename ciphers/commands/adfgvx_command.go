@@ -0,0 +1,262 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The ADFGX/ADFGVX ciphers replace each letter by the labels of its
+ * row & column in a keyed Polybius square and then transpose those
+ * labels with a (Double) Columnar transposition. ADFGX is used for a
+ * 5x5 square and ADFGVX for a 6x6 square (i.e. chained with digits).
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with ADFGX/ADFGVX
+	FILE_EXT_ADFGVX string = ".adf"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*AdfgvxCommand)(nil)
+var _ ciphers.ICipherCommand = (*AdfgvxCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type AdfgvxCommand struct {
+	ciphers.Pipe
+	crypto      *polybius.AdfgvxCrypto
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The keyword (may be empty) mixes the square, the transposition is
+// KEY (Columnar) or KEY1,KEY2 (Double Columnar).
+func NewAdfgvxCommand(alpha *cmn.Alphabet, keyword, transposition string) (*AdfgvxCommand, error) {
+	eng, err := polybius.NewAdfgvxCrypto(alpha, keyword, transposition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdfgvxCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		crypto:      eng,
+		outFilename: "",
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *AdfgvxCommand) String() string {
+	return c.crypto.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this cipher.
+ */
+func (c *AdfgvxCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * The slave alphabet is appended to the master to fill a bigger
+ * square, i.e. English + Numbers for the 6x6 ADFGVX square.
+ */
+func (c *AdfgvxCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if err := c.crypto.WithChain(slave); err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+
+	return c
+}
+
+// this result is only meaningful after EncryptTextFile() where the
+// output filename is not explicitely given but generated.
+func (c *AdfgvxCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// the runes of the key square, row by row
+func (c *AdfgvxCommand) Alphabet() string {
+	return c.crypto.GetAlphabet()
+}
+
+// the name of the actual cipher: ADFGX or ADFGVX
+func (c *AdfgvxCommand) Name() string {
+	return c.crypto.Name()
+}
+
+// the transposition applied to the labels
+func (c *AdfgvxCommand) Transposition() ciphers.ITransposition {
+	return c.crypto.Transposition()
+}
+
+// the key square, i.e. to print it for solving by hand
+func (c *AdfgvxCommand) Square() *polybius.PolybiusSquare {
+	return c.crypto.Square()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *AdfgvxCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	var err error
+	if !alphabet.Check() {
+		err = fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+	} else {
+		err = c.crypto.WithAlphabet(alphabet)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the ADFGX/ADFGVX cipher
+func (c *AdfgvxCommand) Encode(plain string) (string, error) {
+	ciphered, err := c.crypto.Encode(plain)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the ADFGX/ADFGVX cipher.
+// The output file has the FILE_EXT_ADFGVX file extension.
+func (c *AdfgvxCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_ADFGVX, true)
+	err := c.crypto.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// ADFGVX is a field cipher for letters, binary files are refused.
+func (c *AdfgvxCommand) EncryptBinFile(filenameIn string) error {
+	mlog.ErrorE(polybius.ErrBinaryFile)
+	return polybius.ErrBinaryFile
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message using the ADFGX/ADFGVX cipher
+func (c *AdfgvxCommand) Decode(ciphered string) (string, error) {
+	plain, err := c.crypto.Decode(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the ADFGX/ADFGVX cipher.
+// The output file target must be explicitely given.
+func (c *AdfgvxCommand) DecryptTextFile(src, target string) error {
+	return c.crypto.DecryptTextFile(src, target) // error already logged by core
+}
+
+// ADFGVX is a field cipher for letters, binary files are refused.
+func (c *AdfgvxCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	mlog.ErrorE(polybius.ErrBinaryFile)
+	return polybius.ErrBinaryFile
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoAdfgvxCommand(alpha, numeric *cmn.Alphabet, phrase string) bool {
+	fmt.Println("ADFGX/ADFGVX Encryption (Command-pattern version)")
+	fmt.Println("( square labels & columnar transposition, the decoded text is uppercase )")
+
+	// the 5x5 ADFGX square and the 6x6 ADFGVX square with the Western digits
+	var ok bool = true
+	for _, slave := range []*cmn.Alphabet{nil, numeric} {
+		var encTxt, encTxt2, decTxt, decTxt2 string
+
+		cnv1, err := NewAdfgvxCommand(alpha, "Nebel", "CARGO")
+		if err == nil {
+			err = cnv1.crypto.WithChain(slave)
+		}
+		if err != nil {
+			// i.e. the alphabet does not fit in a 5x5 or 6x6 square
+			fmt.Println(err)
+			fmt.Println()
+			continue
+		}
+		cnv2, _ := NewAdfgvxCommand(alpha, "Nebel", "CARGO")
+		cnv2.WithChain(slave)
+		cnv2.WithPipe(cmn.NewNgramFormatter(5, ' '))
+
+		fmt.Println(cnv1.Name(), cnv1.Transposition())
+		cnv1.Square().PrintSquare(false)
+		if encTxt, err = cnv1.Encode(phrase); err == nil {
+			if encTxt2, err = cnv2.Encode(phrase); err == nil {
+				decTxt, err = cnv1.Decode(encTxt2)
+			}
+		}
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		// the decoded text is the normalized plain text, hence
+		// compare encodings rather than texts
+		if decTxt2, err = cnv1.Encode(decTxt); err != nil || decTxt2 != encTxt {
+			ok = false
+		}
+	}
+
+	return ok
+}
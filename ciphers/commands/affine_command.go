@@ -38,6 +38,7 @@ const (
 
 var _ ciphers.IPipe = (*AffineCommand)(nil)
 var _ ciphers.ICipherCommand = (*AffineCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*AffineCommand)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
@@ -110,6 +111,12 @@ func (c *AffineCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
 	return c
 }
 
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *AffineCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.crypto.WithFileHeader(enabled)
+	return c
+}
+
 // this result is only meaningful after EncryptBinFile() or EncryptTextFile()
 // where the output filename is not explicitely given but generated.
 func (c *AffineCommand) GetOutputFilename() string {
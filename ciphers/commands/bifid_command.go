@@ -0,0 +1,249 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Bifid cipher fractionates the letters of each period with a
+ * keyed Polybius square, each cipher letter combines the coordinates
+ * of two plain letters. The decoded text is uppercase.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with Bifid
+	FILE_EXT_BIFID string = ".bfd"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*BifidCommand)(nil)
+var _ ciphers.ICipherCommand = (*BifidCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type BifidCommand struct {
+	ciphers.Pipe
+	crypto      *polybius.BifidCrypto
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The keyword (may be empty) mixes the square, the period is the size
+// of the blocks of letters fractionated together (0 whole message).
+func NewBifidCommand(alpha *cmn.Alphabet, keyword string, period int) (*BifidCommand, error) {
+	eng, err := polybius.NewBifidCrypto(alpha, keyword, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BifidCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		crypto:      eng,
+		outFilename: "",
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *BifidCommand) String() string {
+	return c.crypto.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this cipher.
+ */
+func (c *BifidCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * The slave alphabet is appended to the master to fill a bigger
+ * square, i.e. English + Numbers for the 6x6 square.
+ */
+func (c *BifidCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if err := c.crypto.WithChain(slave); err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+
+	return c
+}
+
+// this result is only meaningful after EncryptTextFile() where the
+// output filename is not explicitely given but generated.
+func (c *BifidCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// the runes of the key square, row by row
+func (c *BifidCommand) Alphabet() string {
+	return c.crypto.GetAlphabet()
+}
+
+// the size of the blocks fractionated together, 0 for the whole message
+func (c *BifidCommand) Period() int {
+	return c.crypto.Period()
+}
+
+// the key square, i.e. to print it for solving by hand
+func (c *BifidCommand) Square() *polybius.PolybiusSquare {
+	return c.crypto.Square()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *BifidCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	var err error
+	if !alphabet.Check() {
+		err = fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+	} else {
+		err = c.crypto.WithAlphabet(alphabet)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the Bifid cipher
+func (c *BifidCommand) Encode(plain string) (string, error) {
+	ciphered, err := c.crypto.Encode(plain)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Bifid cipher.
+// The output file has the FILE_EXT_BIFID file extension.
+func (c *BifidCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_BIFID, true)
+	err := c.crypto.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// Bifid is a pen & paper cipher for letters, binary files are refused.
+func (c *BifidCommand) EncryptBinFile(filenameIn string) error {
+	mlog.ErrorE(polybius.ErrBinaryFile)
+	return polybius.ErrBinaryFile
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message using the Bifid cipher
+func (c *BifidCommand) Decode(ciphered string) (string, error) {
+	plain, err := c.crypto.Decode(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Bifid cipher.
+// The output file target must be explicitely given.
+func (c *BifidCommand) DecryptTextFile(src, target string) error {
+	return c.crypto.DecryptTextFile(src, target) // error already logged by core
+}
+
+// Bifid is a pen & paper cipher for letters, binary files are refused.
+func (c *BifidCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	mlog.ErrorE(polybius.ErrBinaryFile)
+	return polybius.ErrBinaryFile
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoBifidCommand(alpha, numeric *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Bifid Encryption (Command-pattern version)")
+	fmt.Println("( fractionating, the decoded text is uppercase )")
+
+	// the whole message as a single block and then periods of 5
+	var ok bool = true
+	for _, period := range []int{0, 5} {
+		var encTxt, encTxt2, decTxt, decTxt2 string
+
+		cnv1, err := NewBifidCommand(alpha, "Delastelle", period)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		cnv2, _ := NewBifidCommand(alpha, "Delastelle", period)
+		cnv2.WithPipe(cmn.NewNgramFormatter(5, '·'))
+
+		fmt.Println(cnv1)
+		cnv1.Square().PrintSquare(false)
+		if encTxt, err = cnv1.Encode(phrase); err == nil {
+			if encTxt2, err = cnv2.Encode(phrase); err == nil {
+				decTxt, err = cnv1.Decode(encTxt2)
+			}
+		}
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		// the decoded text is the normalized plain text, hence
+		// compare encodings rather than texts
+		if decTxt2, err = cnv1.Encode(decTxt); err != nil || decTxt2 != encTxt {
+			ok = false
+		}
+	}
+
+	return ok
+}
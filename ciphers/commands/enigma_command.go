@@ -0,0 +1,254 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Enigma rotor machine with the historical rotors & reflectors.
+ * The settings are given in key sheet notation, i.e.
+ *	B I-II-III 01-12-22 ABC AV BS CG
+ * and the machine is reciprocal: the same settings decode the message.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/enigma"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with Enigma
+	FILE_EXT_ENIGMA string = ".eni"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*EnigmaCommand)(nil)
+var _ ciphers.ICipherCommand = (*EnigmaCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type EnigmaCommand struct {
+	ciphers.Pipe
+	crypto      *enigma.EnigmaCrypto
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The spec are the machine settings in key sheet notation, only the
+// reflector & rotors are mandatory, i.e. "B I-II-III 01-12-22 ABC AV BS".
+func NewEnigmaCommand(alpha *cmn.Alphabet, spec string) (*EnigmaCommand, error) {
+	settings, err := enigma.ParseSettings(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	eng, err := enigma.NewEnigmaCrypto(alpha, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnigmaCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		crypto:      eng,
+		outFilename: "",
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *EnigmaCommand) String() string {
+	return c.crypto.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this cipher.
+ */
+func (c *EnigmaCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * The rotors are wired for the letters only, any slave alphabet is
+ * an error.
+ */
+func (c *EnigmaCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if err := c.crypto.WithChain(slave); err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+
+	return c
+}
+
+// this result is only meaningful after EncryptTextFile() where the
+// output filename is not explicitely given but generated.
+func (c *EnigmaCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// the runes of the machine alphabet
+func (c *EnigmaCommand) Alphabet() string {
+	return c.crypto.GetAlphabet()
+}
+
+// the daily settings of the machine
+func (c *EnigmaCommand) Settings() enigma.Settings {
+	return c.crypto.Settings()
+}
+
+// the machine, i.e. to print the rotor wirings
+func (c *EnigmaCommand) Machine() *enigma.EnigmaMachine {
+	return c.crypto.Machine()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *EnigmaCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	var err error
+	if !alphabet.Check() {
+		err = fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+	} else {
+		err = c.crypto.WithAlphabet(alphabet)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the Enigma machine
+func (c *EnigmaCommand) Encode(plain string) (string, error) {
+	ciphered, err := c.crypto.Encode(plain)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Enigma machine.
+// The output file has the FILE_EXT_ENIGMA file extension.
+func (c *EnigmaCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_ENIGMA, true)
+	err := c.crypto.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// Enigma is a machine for letters, binary files are refused.
+func (c *EnigmaCommand) EncryptBinFile(filenameIn string) error {
+	mlog.ErrorE(enigma.ErrBinaryFile)
+	return enigma.ErrBinaryFile
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message using the Enigma machine
+func (c *EnigmaCommand) Decode(ciphered string) (string, error) {
+	plain, err := c.crypto.Decode(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Enigma machine.
+// The output file target must be explicitely given.
+func (c *EnigmaCommand) DecryptTextFile(src, target string) error {
+	return c.crypto.DecryptTextFile(src, target) // error already logged by core
+}
+
+// Enigma is a machine for letters, binary files are refused.
+func (c *EnigmaCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	mlog.ErrorE(enigma.ErrBinaryFile)
+	return enigma.ErrBinaryFile
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoEnigmaCommand(alpha *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Enigma Encryption (Command-pattern version)")
+	fmt.Println("( historical rotors, only for the letters of English )")
+
+	var ok bool = true
+	for _, spec := range []string{"B I-II-III 01-01-01 AAA", "C IV-II-V 06-22-14 WXC AV BS CG DL FU HZ IN KM OW RX"} {
+		var encTxt, encTxt2, decTxt string
+
+		cnv1, err := NewEnigmaCommand(alpha, spec)
+		if err != nil {
+			// i.e. not the English alphabet
+			fmt.Println(err)
+			fmt.Println()
+			return true
+		}
+		cnv2, _ := NewEnigmaCommand(alpha, spec)
+		cnv2.WithPipe(cmn.NewNgramFormatter(5, ' '))
+
+		fmt.Println(cnv1.Machine())
+		if encTxt, err = cnv1.Encode(phrase); err == nil {
+			if encTxt2, err = cnv2.Encode(phrase); err == nil {
+				decTxt, err = cnv1.Decode(encTxt)
+			}
+		}
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		if decTxt != phrase {
+			ok = false
+		}
+	}
+
+	return ok
+}
@@ -0,0 +1,254 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Hill cipher encrypts blocks of 2 or 3 letters with a key matrix
+ * modulo N. As with Playfair it drops spaces, punctuation & case, so
+ * the decoded text is uppercase and keeps the nulls of the padding.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/hill"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with Hill
+	FILE_EXT_HILL string = ".hil"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*HillCommand)(nil)
+var _ ciphers.ICipherCommand = (*HillCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type HillCommand struct {
+	ciphers.Pipe
+	crypto      *hill.HillCrypto
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The key is a word of 4 or 9 letters or the 4 or 9 matrix values.
+// It fails if the matrix is not invertible modulo the alphabet size.
+func NewHillCommand(alpha *cmn.Alphabet, key string) (*HillCommand, error) {
+	eng, err := hill.NewHillCrypto(alpha, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HillCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		crypto:      eng,
+		outFilename: "",
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *HillCommand) String() string {
+	return c.crypto.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this cipher.
+ */
+func (c *HillCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * The slave alphabet is appended to the master, the key matrix is
+ * then computed modulo the size of both, i.e. English + Numbers is 36.
+ */
+func (c *HillCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if err := c.crypto.WithChain(slave); err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+
+	return c
+}
+
+// Set the null runes (used in turn) that pad the last block.
+func (c *HillCommand) WithNulls(nulls string) error {
+	return c.crypto.WithNulls(nulls)
+}
+
+// this result is only meaningful after EncryptTextFile() where the
+// output filename is not explicitely given but generated.
+func (c *HillCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// the runes of the (chained) alphabet
+func (c *HillCommand) Alphabet() string {
+	return c.crypto.GetAlphabet()
+}
+
+// the key matrix used to encrypt
+func (c *HillCommand) Key() *hill.HillMatrix {
+	return c.crypto.Key()
+}
+
+// the inverse matrix used to decrypt, i.e. to print it for solving by hand
+func (c *HillCommand) Inverse() *hill.HillMatrix {
+	return c.crypto.Inverse()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *HillCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	var err error
+	if !alphabet.Check() {
+		err = fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+	} else {
+		err = c.crypto.WithAlphabet(alphabet)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the Hill cipher
+func (c *HillCommand) Encode(plain string) (string, error) {
+	ciphered, err := c.crypto.Encode(plain)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Hill cipher.
+// The output file has the FILE_EXT_HILL file extension.
+func (c *HillCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_HILL, true)
+	err := c.crypto.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// Hill is a pen & paper cipher for letters, binary files are refused.
+func (c *HillCommand) EncryptBinFile(filenameIn string) error {
+	mlog.ErrorE(hill.ErrBinaryFile)
+	return hill.ErrBinaryFile
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message using the Hill cipher
+func (c *HillCommand) Decode(ciphered string) (string, error) {
+	plain, err := c.crypto.Decode(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Hill cipher.
+// The output file target must be explicitely given.
+func (c *HillCommand) DecryptTextFile(src, target string) error {
+	return c.crypto.DecryptTextFile(src, target) // error already logged by core
+}
+
+// Hill is a pen & paper cipher for letters, binary files are refused.
+func (c *HillCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	mlog.ErrorE(hill.ErrBinaryFile)
+	return hill.ErrBinaryFile
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoHillCommand(alpha, numeric *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Hill Encryption (Command-pattern version)")
+	fmt.Println("( blocks of letters, the decoded text keeps the nulls )")
+
+	// unimodular matrices (det=1) are invertible with any alphabet size
+	var ok bool = true
+	for _, key := range []string{"1 2 3 5", "2 3 1, 1 2 1, 1 1 1"} {
+		var encTxt, encTxt2, decTxt, decTxt2 string
+
+		cnv1, err := NewHillCommand(alpha, key)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		cnv2, _ := NewHillCommand(alpha, key)
+		cnv2.WithPipe(cmn.NewNgramFormatter(uint8(cnv2.Key().Size()), '·'))
+
+		fmt.Println(cnv1)
+		fmt.Println(cnv1.Key().GetMatrixString("\t"))
+		if encTxt, err = cnv1.Encode(phrase); err == nil {
+			if encTxt2, err = cnv2.Encode(phrase); err == nil {
+				decTxt, err = cnv1.Decode(encTxt)
+			}
+		}
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		// the decoded text is the normalized plain text plus nulls,
+		// hence compare encodings rather than texts
+		if decTxt2, err = cnv1.Encode(decTxt); err != nil || decTxt2 != encTxt {
+			ok = false
+		}
+	}
+
+	return ok
+}
@@ -0,0 +1,252 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Playfair cipher encrypts pairs of letters (digraphs) with a
+ * keyed 5x5 (English, I=J) or 6x6 (English+digits) square. Unlike
+ * the Tabula Recta ciphers it drops spaces, punctuation & case, so
+ * the decoded text is uppercase and keeps the fillers.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/playfair"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with Playfair
+	FILE_EXT_PLAYFAIR string = ".pfr"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*PlayfairCommand)(nil)
+var _ ciphers.ICipherCommand = (*PlayfairCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type PlayfairCommand struct {
+	ciphers.Pipe
+	crypto      *playfair.PlayfairCrypto
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The keyword mixes the square. English gives the classic 5x5 square,
+// follow with WithChain(cmn.NUMBERS_DISK) for the 6x6 square.
+func NewPlayfairCommand(alpha *cmn.Alphabet, keyword string) (*PlayfairCommand, error) {
+	eng, err := playfair.NewPlayfairCrypto(alpha, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlayfairCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		crypto:      eng,
+		outFilename: "",
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *PlayfairCommand) String() string {
+	return c.crypto.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this cipher.
+ */
+func (c *PlayfairCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * The slave alphabet is appended to the master to fill a bigger
+ * square, i.e. English + Numbers for the 6x6 square.
+ */
+func (c *PlayfairCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if err := c.crypto.WithChain(slave); err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+
+	return c
+}
+
+// Set the filler (default X) & the alternate filler (default Q) used
+// when the letter to split or pad is the filler itself.
+func (c *PlayfairCommand) WithFiller(filler, alt rune) error {
+	return c.crypto.WithFiller(filler, alt)
+}
+
+// this result is only meaningful after EncryptTextFile() where the
+// output filename is not explicitely given but generated.
+func (c *PlayfairCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// the runes of the key square, row by row
+func (c *PlayfairCommand) Alphabet() string {
+	return c.crypto.GetAlphabet()
+}
+
+// the key square, i.e. to print it for solving by hand
+func (c *PlayfairCommand) Square() *playfair.PlayfairSquare {
+	return c.crypto.Square()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *PlayfairCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	var err error
+	if !alphabet.Check() {
+		err = fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+	} else {
+		err = c.crypto.WithAlphabet(alphabet)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the Playfair cipher
+func (c *PlayfairCommand) Encode(plain string) (string, error) {
+	ciphered, err := c.crypto.Encode(plain)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Playfair cipher.
+// The output file has the FILE_EXT_PLAYFAIR file extension.
+func (c *PlayfairCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_PLAYFAIR, true)
+	err := c.crypto.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// Playfair is a pen & paper cipher for letters, binary files are refused.
+func (c *PlayfairCommand) EncryptBinFile(filenameIn string) error {
+	mlog.ErrorE(playfair.ErrBinaryFile)
+	return playfair.ErrBinaryFile
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message using the Playfair cipher
+func (c *PlayfairCommand) Decode(ciphered string) (string, error) {
+	plain, err := c.crypto.Decode(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Playfair cipher.
+// The output file target must be explicitely given.
+func (c *PlayfairCommand) DecryptTextFile(src, target string) error {
+	return c.crypto.DecryptTextFile(src, target) // error already logged by core
+}
+
+// Playfair is a pen & paper cipher for letters, binary files are refused.
+func (c *PlayfairCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	mlog.ErrorE(playfair.ErrBinaryFile)
+	return playfair.ErrBinaryFile
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoPlayfairCommand(alpha, numeric *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Playfair Encryption (Command-pattern version)")
+	fmt.Println("( digraphs, the decoded text keeps the fillers )")
+
+	// the 5x5 square and the 6x6 square with the Western digits
+	var ok bool = true
+	for _, slave := range []*cmn.Alphabet{nil, cmn.NUMBERS_DISK} {
+		var encTxt, encTxt2, decTxt, decTxt2 string
+
+		cnv1, err := NewPlayfairCommand(alpha, "Playfair Example")
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		cnv1.WithChain(slave)
+		cnv2, _ := NewPlayfairCommand(alpha, "Playfair Example")
+		cnv2.WithChain(slave)
+		cnv2.WithPipe(cmn.NewNgramFormatter(2, '·'))
+
+		cnv1.Square().PrintSquare(false)
+		if encTxt, err = cnv1.Encode(phrase); err == nil {
+			if encTxt2, err = cnv2.Encode(phrase); err == nil {
+				decTxt, err = cnv1.Decode(encTxt)
+			}
+		}
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		// the decoded text is the normalized plain text plus fillers,
+		// hence compare encodings rather than texts
+		if decTxt2, err = cnv1.Encode(decTxt); err != nil || decTxt2 != encTxt {
+			ok = false
+		}
+	}
+
+	return ok
+}
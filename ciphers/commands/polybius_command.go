@@ -0,0 +1,253 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Polybius cipher replaces each letter by the labels of its row
+ * & column in a keyed square (12345, ADFGX, ADFGVX or custom labels).
+ * It drops spaces, punctuation & case, the decoded text is uppercase.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with Polybius
+	FILE_EXT_POLYBIUS string = ".pol"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*PolybiusCommand)(nil)
+var _ ciphers.ICipherCommand = (*PolybiusCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type PolybiusCommand struct {
+	ciphers.Pipe
+	crypto      *polybius.PolybiusCrypto
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The keyword (may be empty) mixes the square, the labels (empty for
+// the numeric 1..N) name its rows & columns.
+func NewPolybiusCommand(alpha *cmn.Alphabet, keyword, labels string) (*PolybiusCommand, error) {
+	eng, err := polybius.NewPolybiusCrypto(alpha, keyword, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PolybiusCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		crypto:      eng,
+		outFilename: "",
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *PolybiusCommand) String() string {
+	return c.crypto.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this cipher.
+ */
+func (c *PolybiusCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * The slave alphabet is appended to the master to fill a bigger
+ * square, i.e. English + Numbers for the 6x6 square.
+ */
+func (c *PolybiusCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if err := c.crypto.WithChain(slave); err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+
+	return c
+}
+
+// this result is only meaningful after EncryptTextFile() where the
+// output filename is not explicitely given but generated.
+func (c *PolybiusCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// the runes of the key square, row by row
+func (c *PolybiusCommand) Alphabet() string {
+	return c.crypto.GetAlphabet()
+}
+
+// the key square, i.e. to print it for solving by hand
+func (c *PolybiusCommand) Square() *polybius.PolybiusSquare {
+	return c.crypto.Square()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *PolybiusCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	var err error
+	if !alphabet.Check() {
+		err = fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+	} else {
+		err = c.crypto.WithAlphabet(alphabet)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the Polybius cipher
+func (c *PolybiusCommand) Encode(plain string) (string, error) {
+	ciphered, err := c.crypto.Encode(plain)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Polybius cipher.
+// The output file has the FILE_EXT_POLYBIUS file extension.
+func (c *PolybiusCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_POLYBIUS, true)
+	err := c.crypto.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// Polybius is a pen & paper cipher for letters, binary files are refused.
+func (c *PolybiusCommand) EncryptBinFile(filenameIn string) error {
+	mlog.ErrorE(polybius.ErrBinaryFile)
+	return polybius.ErrBinaryFile
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message using the Polybius cipher
+func (c *PolybiusCommand) Decode(ciphered string) (string, error) {
+	plain, err := c.crypto.Decode(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Polybius cipher.
+// The output file target must be explicitely given.
+func (c *PolybiusCommand) DecryptTextFile(src, target string) error {
+	return c.crypto.DecryptTextFile(src, target) // error already logged by core
+}
+
+// Polybius is a pen & paper cipher for letters, binary files are refused.
+func (c *PolybiusCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	mlog.ErrorE(polybius.ErrBinaryFile)
+	return polybius.ErrBinaryFile
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoPolybiusCommand(alpha, numeric *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Polybius Encryption (Command-pattern version)")
+	fmt.Println("( square coordinates, the decoded text is uppercase )")
+
+	// the numeric square and the 6x6 ADFGVX square with the Western digits
+	var ok bool = true
+	for _, setup := range []struct {
+		slave  *cmn.Alphabet
+		labels string
+	}{{nil, ""}, {numeric, polybius.LABELS_ADFGVX}} {
+		var encTxt, encTxt2, decTxt, decTxt2 string
+
+		cnv1, err := NewPolybiusCommand(alpha, "Polybius", setup.labels)
+		if err == nil {
+			err = cnv1.crypto.WithChain(setup.slave)
+		}
+		if err != nil {
+			// i.e. the alphabet & digits do not fit in a 6x6 square
+			fmt.Println(err)
+			fmt.Println()
+			continue
+		}
+		cnv2, _ := NewPolybiusCommand(alpha, "Polybius", setup.labels)
+		cnv2.WithChain(setup.slave)
+		cnv2.WithPipe(cmn.NewNgramFormatter(2, '·'))
+
+		cnv1.Square().PrintSquare(false)
+		if encTxt, err = cnv1.Encode(phrase); err == nil {
+			if encTxt2, err = cnv2.Encode(phrase); err == nil {
+				decTxt, err = cnv1.Decode(encTxt2)
+			}
+		}
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		// the decoded text is the normalized plain text, hence
+		// compare encodings rather than texts
+		if decTxt2, err = cnv1.Encode(decTxt); err != nil || decTxt2 != encTxt {
+			ok = false
+		}
+	}
+
+	return ok
+}
@@ -0,0 +1,262 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Affine cipher through the Tabula Recta pipeline. Rather than a
+ * single pair of coefficients it uses a schedule of (A,B) pairs that
+ * is repeated over the message, but ONLY over the characters that are
+ * present in the primary/master (or slave) alphabet. The schedule is
+ * given explicitly, derived from a keyword or taken from a Caesarium.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with the Affine schedule
+	FILE_EXT_AFFINE_POLY string = ".afp"
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*AffineTabulaCommand)(nil)
+var _ ciphers.ICipherCommand = (*AffineTabulaCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*AffineTabulaCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type AffineTabulaCommand struct {
+	ciphers.Pipe
+	core        *affine.AffineTabulaRecta
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// (Ctor) Affine with an explicit schedule of coefficients. It returns
+// nil if any of the pairs is not valid for the alphabet (already logged).
+func NewAffineTabulaCommand(alpha *cmn.Alphabet, schedule []crypto.AffinePair) *AffineTabulaCommand {
+	return newAffineTabulaCommand(alpha, crypto.NewAffineScheduleSequencer(schedule, alpha))
+}
+
+// (Ctor) Affine with a schedule of coefficients derived from a keyword.
+// It returns nil if the keyword is not valid for the alphabet.
+func NewAffineKeywordCommand(alpha *cmn.Alphabet, keyword string) *AffineTabulaCommand {
+	return newAffineTabulaCommand(alpha, crypto.NewAffineKeywordSequencer(keyword, alpha))
+}
+
+func newAffineTabulaCommand(alpha *cmn.Alphabet, keygen *crypto.AffineSequencer) *AffineTabulaCommand {
+	core := affine.NewAffineTabulaRecta(alpha, keygen)
+	if core == nil {
+		return nil
+	}
+
+	return &AffineTabulaCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		core:        core,
+		outFilename: "",
+	}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *AffineTabulaCommand) String() string {
+	return c.core.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this simple cipher.
+ */
+func (c *AffineTabulaCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * Chain a slave disk/tabula that would use the same (or corrected) Affine
+ * coefficients as the main alphabet. A slave disk/tabula is usually a
+ * Numbers and/or Symbols to IMPROVE the ancient cipher against attacks.
+ */
+func (c *AffineTabulaCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if slave != nil {
+		slaveTR := ciphers.NewTabulaRecta(slave, true)
+		c.core.WithChain(slaveTR)
+	} else {
+		c.core.WithChain(nil)
+	}
+
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *AffineTabulaCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
+// this result is only meaningful after EncryptBinFile() or EncryptTextFile()
+// where the output filename is not explicitely given but generated.
+func (c *AffineTabulaCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// get the current alphabet's string
+func (c *AffineTabulaCommand) Alphabet() string {
+	return c.core.GetAlphabet()
+}
+
+// the schedule of (A,B) coefficients
+func (c *AffineTabulaCommand) Schedule() []crypto.AffinePair {
+	return c.core.Schedule()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *AffineTabulaCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	if alphabet.Check() {
+		c.core.WithAlphabet(alphabet)
+	} else {
+		err := fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Encode a text message using the Affine schedule
+func (c *AffineTabulaCommand) Encode(plain string) (string, error) {
+	ciphered := c.core.Encode(plain)
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Affine schedule.
+// The output file has the FILE_EXT_AFFINE_POLY file extension. Please note
+// that this method is only for text files.
+func (c *AffineTabulaCommand) EncryptTextFile(src string) error {
+	fileOut := cmn.NewNameExtOnly(src, FILE_EXT_AFFINE_POLY, true)
+	err := c.core.EncryptTextFile(src, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+// Encodes a binary file and produces a binary encoded file
+func (c *AffineTabulaCommand) EncryptBinFile(filenameIn string) error {
+	fileOut := cmn.NewNameExtOnly(filenameIn, FILE_EXT_AFFINE_POLY, true)
+	err := c.core.EncryptBinaryFile(filenameIn, fileOut) // error already logged by core
+	if err == nil {
+		c.outFilename = fileOut
+	}
+
+	return err
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// Decode a text message encrypted with the Affine schedule
+func (c *AffineTabulaCommand) Decode(ciphered string) (string, error) {
+	plain := c.core.Decode(ciphered)
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Affine schedule.
+// The output file target must be explicitely given. Please note that
+// this method is only for text files.
+func (c *AffineTabulaCommand) DecryptTextFile(src, target string) error {
+	return c.core.DecryptTextFile(src, target) // error already logged by core
+}
+
+// Decodes a binary file and produces a plain binary file
+func (c *AffineTabulaCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	return c.core.DecryptBinaryFile(filenameIn, filenameOut) // error already logged by core
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoAffineTabulaCommand(alpha, numbers *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Polyalphabetic Affine Encryption (Command-pattern version)")
+	fmt.Println("Master : ", alpha.Name)
+	if numbers != nil {
+		fmt.Println("Slave  : ", numbers.Name)
+	}
+
+	// 7 & 11 are coprimes common to all my built-in alphabets
+	schedule := []crypto.AffinePair{{A: 7, B: 12}, {A: 11, B: 3}, {A: 1, B: 5}}
+	keyword := fmt.Sprintf("%c%c%c", alpha.GetRuneAt(10), alpha.GetRuneAt(5), alpha.GetRuneAt(-1))
+
+	var ok bool = true
+	for _, alg := range []*AffineTabulaCommand{
+		NewAffineTabulaCommand(alpha, schedule),
+		NewAffineKeywordCommand(alpha, keyword),
+	} {
+		if alg == nil {
+			mlog.Fatal(caesarx.ERR_DEMO_ERROR, "invalid Affine schedule for "+alpha.Name)
+		}
+		alg.WithChain(numbers)
+
+		encTxt, err := alg.Encode(phrase)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		decTxt, err := alg.Decode(encTxt)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Printf("Schedule: %s\n", affine.ScheduleString(alg.Schedule()))
+		fmt.Println("Plain   : ", phrase)
+		fmt.Println("Encoded : ", encTxt)
+		fmt.Println("Decoded : ", decTxt)
+		fmt.Println()
+
+		if decTxt != phrase {
+			ok = false
+		}
+	}
+
+	return ok
+}
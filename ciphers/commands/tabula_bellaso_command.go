@@ -42,6 +42,8 @@ const (
 
 var _ ciphers.IPipe = (*BellasoCommand)(nil)
 var _ ciphers.ICipherCommand = (*BellasoCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*BellasoCommand)(nil)
+var _ ciphers.IKeyedCipherCommand = (*BellasoCommand)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
@@ -102,6 +104,21 @@ func (c *BellasoCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
 	return c
 }
 
+/**
+ * Use keyword-mixed alphabets (Quagmire I-IV) in the primary/master
+ * tabula. The slave disk/tabula keeps its natural order.
+ */
+func (c *BellasoCommand) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipherCommand {
+	c.core.WithKeyword(keys)
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *BellasoCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
 // this result is only meaningful after EncryptBinFile() or EncryptTextFile()
 // where the output filename is not explicitely given but generated.
 func (c *BellasoCommand) GetOutputFilename() string {
@@ -25,6 +25,10 @@ import (
 const (
 	// Filename extension for files encrypted with plain Caesar
 	FILE_EXT_CAESAR string = ".cae"
+	// Filename extensions for files encrypted with the Extended Caesar modes
+	FILE_EXT_CAESAR_EXTENDED string = ".cax"
+	FILE_EXT_CAESAR_AUGUSTUS string = ".cao"
+	FILE_EXT_CAESAR_TIBERIUS string = ".cat"
 )
 
 /* ----------------------------------------------------------------
@@ -37,6 +41,8 @@ const (
 
 var _ ciphers.IPipe = (*CaesarCommand)(nil)
 var _ ciphers.ICipherCommand = (*CaesarCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*CaesarCommand)(nil)
+var _ ciphers.IKeyedCipherCommand = (*CaesarCommand)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
@@ -63,15 +69,32 @@ func NewCaesarCommand(alpha *cmn.Alphabet, key rune) *CaesarCommand {
 	}
 }
 
+/**
+ * (Ctor) any of the Caesar modes: CAESAR, CAESAR_EXTENDED, CAESAR_AUGUSTUS
+ * or CAESAR_TIBERIUS. The extended modes have the SYMBOL_DISK chained.
+ * Returns nil for any other mode.
+ */
 func NewCaesarCommandWithOptions(alpha *cmn.Alphabet, opts *caesar.CaesarOptions) *CaesarCommand {
-	if opts.Variant != caesar.CAESAR { // @audit expand as I add more TESTED Caesar variant implementations
-		mlog.Error("invalid Caesar variant for CaesarCommand")
+	var core ciphers.ICipher
+	switch opts.Variant {
+	case caesar.CAESAR:
+		core = caesar.NewCaesarTabulaRecta(alpha, opts.Initial)
+	case caesar.CAESAR_EXTENDED:
+		core = caesar.NewExtendedTabulaRecta(alpha, opts.Initial)
+	case caesar.CAESAR_AUGUSTUS:
+		core = caesar.NewAugustusTabulaRecta(alpha, opts.Initial, opts.Supplemental)
+	case caesar.CAESAR_TIBERIUS:
+		core = caesar.NewTiberiusTabulaRecta(alpha, opts.Initial, opts.Supplemental)
+	default:
+		mlog.ErrorT("invalid Caesar variant for CaesarCommand", mlog.String("Mode", opts.Variant.String()))
 		return nil
 	}
 
 	return &CaesarCommand{
-		core: caesar.NewCaesarTabulaRecta(alpha, opts.Initial),
-		opts: opts,
+		Pipe:        ciphers.NewEmptyPipe(),
+		core:        core,
+		opts:        opts,
+		outFilename: "",
 	}
 }
 
@@ -99,9 +122,14 @@ func (c *CaesarCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherComm
 /**
  * Chain a slave disk/tabula that would use the same (or corrected) alphabet shift
  * as the main alphabet. A slave disk/tabula is usually a Numbers and/or Symbols
- * to IMPROVE the ancient cipher against attacks.
+ * to IMPROVE the ancient cipher against attacks. The extended modes always
+ * have a slave, without one they fall back to the SYMBOL_DISK.
  */
 func (c *CaesarCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if slave == nil && c.opts.Variant != caesar.CAESAR {
+		slave = cmn.SYMBOL_DISK
+	}
+
 	if slave != nil {
 		slaveTR := ciphers.NewTabulaRecta(slave, true)
 		c.core.WithChain(slaveTR)
@@ -112,12 +140,32 @@ func (c *CaesarCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
 	return c
 }
 
+/**
+ * Use keyword-mixed alphabets (Quagmire I-IV) in the primary/master
+ * tabula. The slave disk/tabula keeps its natural order.
+ */
+func (c *CaesarCommand) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipherCommand {
+	c.core.WithKeyword(keys)
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *CaesarCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
 // this result is only meaningful after EncryptBinFile() or EncryptTextFile()
 // where the output filename is not explicitely given but generated.
 func (c *CaesarCommand) GetOutputFilename() string {
 	return c.outFilename
 }
 
+// the Caesar mode & keys of this command
+func (c *CaesarCommand) Options() *caesar.CaesarOptions {
+	return c.opts
+}
+
 // get the current alphabet's string
 func (c *CaesarCommand) Alphabet() string {
 	return c.core.GetAlphabet()
@@ -156,13 +204,13 @@ func (c *CaesarCommand) Encode(plain string) (string, error) {
 	}
 }
 
-// EncryptTextFile encrypts the filename src using the standard Caesar cipher.
-// The output file has the FILE_EXT_CAESAR file extension. Please note that
+// EncryptTextFile encrypts the filename src using the chosen Caesar mode.
+// The output file has the FILE_EXT_CAESAR* file extension of the mode. Please note that
 // this method is only for text files.
 func (c *CaesarCommand) EncryptTextFile(src string) error {
 	var err error = nil
 	if err = c.core.VerifyKey(); err == nil {
-		fileOut := cmn.NewNameExtOnly(src, FILE_EXT_CAESAR, true)
+		fileOut := cmn.NewNameExtOnly(src, c.fileExtension(), true)
 		err = c.core.EncryptTextFile(src, fileOut) // error already logged by core
 		if err == nil {
 			c.outFilename = fileOut
@@ -176,7 +224,7 @@ func (c *CaesarCommand) EncryptTextFile(src string) error {
 func (c *CaesarCommand) EncryptBinFile(filenameIn string) error {
 	var err error = nil
 	if err = c.core.VerifyKey(); err == nil {
-		fileOut := cmn.NewNameExtOnly(filenameIn, FILE_EXT_CAESAR, true)
+		fileOut := cmn.NewNameExtOnly(filenameIn, c.fileExtension(), true)
 		err = c.core.EncryptBinaryFile(filenameIn, fileOut) // error already logged by core
 		if err == nil {
 			c.outFilename = fileOut
@@ -186,6 +234,11 @@ func (c *CaesarCommand) EncryptBinFile(filenameIn string) error {
 	return err
 }
 
+// the filename extension of the Caesar mode
+func (c *CaesarCommand) fileExtension() string {
+	return CaesarFileExtension(c.opts.Variant)
+}
+
 /* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
  *					D e c r y p t i o n
  *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
@@ -227,6 +280,24 @@ func (c *CaesarCommand) DecryptBinFile(filenameIn, filenameOut string) error {
 	return err
 }
 
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// The filename extension of files encrypted with the given Caesar mode
+func CaesarFileExtension(mode caesar.CaesarCipherMode) string {
+	switch mode {
+	case caesar.CAESAR_EXTENDED:
+		return FILE_EXT_CAESAR_EXTENDED
+	case caesar.CAESAR_AUGUSTUS:
+		return FILE_EXT_CAESAR_AUGUSTUS
+	case caesar.CAESAR_TIBERIUS:
+		return FILE_EXT_CAESAR_TIBERIUS
+	default:
+		return FILE_EXT_CAESAR
+	}
+}
+
 /* ----------------------------------------------------------------
  *						M A I N | E X A M P L E
  *-----------------------------------------------------------------*/
@@ -275,3 +346,42 @@ func DemoCaesarCommand(alpha, numbers *cmn.Alphabet, phrase string) bool {
 
 	return ok
 }
+
+// Round trip of the Extended, Augustus & Tiberius modes, each one with
+// its own SYMBOL_DISK slave.
+func DemoCaesarModesCommand(alpha *cmn.Alphabet, phrase string) bool {
+	key := alpha.GetRuneAt(10)
+	allOpts := []*caesar.CaesarOptions{
+		caesar.NewCaesarExtendedOpts(key),
+		caesar.NewCaesarAugustusOpts(key, 3),
+		caesar.NewCaesarTiberiusOpts(key, 7),
+	}
+
+	fmt.Println("Extended Caesar Encryption (Command-pattern version)")
+	fmt.Println("Master : ", alpha.Name)
+	fmt.Println("Slave  : ", cmn.SYMBOL_DISK.Name)
+	var ok bool = true
+	for _, opts := range allOpts {
+		cnv := NewCaesarCommandWithOptions(alpha, opts)
+		encTxt, err := cnv.Encode(phrase)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		decTxt, err := cnv.Decode(encTxt)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Println("Mode   : ", opts, opts.LeaderString())
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		if decTxt != phrase {
+			ok = false
+		}
+	}
+
+	return ok
+}
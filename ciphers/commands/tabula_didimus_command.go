@@ -47,6 +47,8 @@ const (
 
 var _ ciphers.IPipe = (*DidimusCommand)(nil)
 var _ ciphers.ICipherCommand = (*DidimusCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*DidimusCommand)(nil)
+var _ ciphers.IKeyedCipherCommand = (*DidimusCommand)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
@@ -107,6 +109,21 @@ func (c *DidimusCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
 	return c
 }
 
+/**
+ * Use keyword-mixed alphabets (Quagmire I-IV) in the primary/master
+ * tabula. The slave disk/tabula keeps its natural order.
+ */
+func (c *DidimusCommand) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipherCommand {
+	c.core.WithKeyword(keys)
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *DidimusCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
 // this result is only meaningful after EncryptBinFile() or EncryptTextFile()
 // where the output filename is not explicitely given but generated.
 func (c *DidimusCommand) GetOutputFilename() string {
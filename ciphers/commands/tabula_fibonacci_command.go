@@ -39,6 +39,8 @@ const (
 
 var _ ciphers.IPipe = (*FibonacciCommand)(nil)
 var _ ciphers.ICipherCommand = (*FibonacciCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*FibonacciCommand)(nil)
+var _ ciphers.IKeyedCipherCommand = (*FibonacciCommand)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
@@ -99,6 +101,21 @@ func (c *FibonacciCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand
 	return c
 }
 
+/**
+ * Use keyword-mixed alphabets (Quagmire I-IV) in the primary/master
+ * tabula. The slave disk/tabula keeps its natural order.
+ */
+func (c *FibonacciCommand) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipherCommand {
+	c.core.WithKeyword(keys)
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *FibonacciCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
 // this result is only meaningful after EncryptBinFile() or EncryptTextFile()
 // where the output filename is not explicitely given but generated.
 func (c *FibonacciCommand) GetOutputFilename() string {
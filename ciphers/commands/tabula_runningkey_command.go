@@ -0,0 +1,336 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * (Command Pattern - See "Design Patterns")
+ * The Running Key cipher is a Vigenère (Bellaso) whose key is as long
+ * as the message. The key is read from a book that both parties own,
+ * starting at an agreed chapter/line/character offset. A message
+ * longer than the available key text is refused BEFORE producing any
+ * output.
+ *-----------------------------------------------------------------*/
+package commands
+
+import (
+	"fmt"
+	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/runningkey"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// Filename extension for files encrypted with Running Key
+	FILE_EXT_RUNNINGKEY string = ".rnk"
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.IPipe = (*RunningKeyCommand)(nil)
+var _ ciphers.ICipherCommand = (*RunningKeyCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*RunningKeyCommand)(nil)
+var _ ciphers.IKeyedCipherCommand = (*RunningKeyCommand)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type RunningKeyCommand struct {
+	ciphers.Pipe
+	core        *runningkey.RunningKeyTabulaRecta
+	outFilename string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// the key sequencer must have been built with the same alphabet
+func NewRunningKeyCommand(alpha *cmn.Alphabet, book *crypto.RunningKeySequencer) *RunningKeyCommand {
+	return &RunningKeyCommand{
+		Pipe:        ciphers.NewEmptyPipe(),
+		core:        runningkey.NewRunningKeyTabulaRecta(alpha, book),
+		outFilename: "",
+	}
+}
+
+// the key is read from the bookFile starting at the offset which is
+// given as N, L:N or C:L:N (see crypto.ParseBookOffset).
+func NewRunningKeyCommandFromFile(alpha *cmn.Alphabet, bookFile, offset string) (*RunningKeyCommand, error) {
+	at, err := crypto.ParseBookOffset(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := crypto.NewRunningKeySequencer(bookFile, at, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRunningKeyCommand(alpha, book), nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *RunningKeyCommand) String() string {
+	return c.core.String()
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					G e n e r a l   P u r p o s e
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+/**
+ * Same as Rebuild() for this simple cipher.
+ */
+func (c *RunningKeyCommand) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipherCommand {
+	c.Rebuild(alphabet)
+	return c
+}
+
+/**
+ * Chain a slave disk/tabula that would use the same (or corrected) alphabet shift
+ * as the main alphabet. A slave disk/tabula is usually a Numbers and/or Symbols
+ * to IMPROVE the ancient cipher against attacks.
+ */
+func (c *RunningKeyCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand {
+	if slave != nil {
+		slaveTR := ciphers.NewTabulaRecta(slave, true)
+		c.core.WithChain(slaveTR)
+	} else {
+		c.core.WithChain(nil)
+	}
+
+	return c
+}
+
+/**
+ * Use keyword-mixed alphabets (Quagmire I-IV) in the primary/master
+ * tabula. The slave disk/tabula keeps its natural order.
+ */
+func (c *RunningKeyCommand) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipherCommand {
+	c.core.WithKeyword(keys)
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *RunningKeyCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
+// this result is only meaningful after EncryptBinFile() or EncryptTextFile()
+// where the output filename is not explicitely given but generated.
+func (c *RunningKeyCommand) GetOutputFilename() string {
+	return c.outFilename
+}
+
+// get the current alphabet's string
+func (c *RunningKeyCommand) Alphabet() string {
+	return c.core.GetAlphabet()
+}
+
+// Checks the alphabet, if OK it is applied to the underlying cipher machine.
+// Else it logs an error and exits with ERR_BAD_ALPHABET.
+func (c *RunningKeyCommand) Rebuild(alphabet *cmn.Alphabet, opts ...any) {
+	if alphabet.Check() {
+		c.core.WithAlphabet(alphabet)
+	} else {
+		err := fmt.Errorf("invalid alphabet '%s' size:%d", alphabet.Name, alphabet.Size())
+		mlog.ErrorE(err)
+		app.DieWithError(err, caesarx.ERR_BAD_ALPHABET)
+	}
+}
+
+// the key must be valid AND long enough for the message
+func (c *RunningKeyCommand) verify(message string) error {
+	err := c.core.VerifyKey()
+	if err == nil {
+		err = c.core.CheckKeyLength(message)
+	}
+
+	return err
+}
+
+// same as verify() but for the contents of a text or binary file
+func (c *RunningKeyCommand) verifyFile(filename string, isBinary bool) error {
+	err := c.core.VerifyKey()
+	if err == nil {
+		err = c.core.CheckKeyLengthFile(filename, isBinary)
+	}
+
+	if err != nil {
+		mlog.ErrorE(err)
+	}
+	return err
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					E n c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+func (c *RunningKeyCommand) Encode(plain string) (string, error) {
+	err := c.verify(plain)
+	if err != nil {
+		return "", err
+	}
+
+	ciphered := c.core.Encode(plain)
+	if err = c.core.Err(); err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeEncode, ciphered)
+	} else {
+		return ciphered, nil
+	}
+}
+
+// EncryptTextFile encrypts the filename src using the Running Key cipher.
+// The output file has the FILE_EXT_RUNNINGKEY file extension. Please note that
+// this method is only for text files.
+func (c *RunningKeyCommand) EncryptTextFile(src string) error {
+	var err error = nil
+	if err = c.verifyFile(src, false); err == nil {
+		fileOut := cmn.NewNameExtOnly(src, FILE_EXT_RUNNINGKEY, true)
+		err = c.core.EncryptTextFile(src, fileOut) // error already logged by core
+		if err == nil {
+			c.outFilename = fileOut
+		}
+	}
+
+	return err
+}
+
+// Encodes a binary file and produces a binary encoded file (v1.1+)
+func (c *RunningKeyCommand) EncryptBinFile(filenameIn string) error {
+	var err error = nil
+	if err = c.verifyFile(filenameIn, true); err == nil {
+		fileOut := cmn.NewNameExtOnly(filenameIn, FILE_EXT_RUNNINGKEY, true)
+		err = c.core.EncryptBinaryFile(filenameIn, fileOut) // error already logged by core
+		if err == nil {
+			c.outFilename = fileOut
+		}
+	}
+
+	return err
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *					D e c r y p t i o n
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+// decode a short message encrypted with the Running Key cipher
+func (c *RunningKeyCommand) Decode(ciphered string) (string, error) {
+	err := c.verify(ciphered)
+	if err != nil {
+		return "", err
+	}
+
+	plain := c.core.Decode(ciphered)
+	if err = c.core.Err(); err != nil {
+		return "", err
+	}
+
+	if c.IsPipeOpen() {
+		return c.PipeOutput(ciphers.PipeDecode, plain)
+	} else {
+		return plain, nil
+	}
+}
+
+// DecryptTextFile decrypts the filename src using the Running Key cipher.
+// The output file target must be explicitely given. Please note that
+// this method is only for text files.
+func (c *RunningKeyCommand) DecryptTextFile(src, target string) error {
+	var err error = nil
+	if err = c.verifyFile(src, false); err == nil {
+		err = c.core.DecryptTextFile(src, target) // error already logged by core
+	}
+
+	return err
+}
+
+// Decodes a binary file and produces a plain binary file (v1.1+)
+func (c *RunningKeyCommand) DecryptBinFile(filenameIn, filenameOut string) error {
+	var err error = nil
+	if err = c.verifyFile(filenameIn, true); err == nil {
+		err = c.core.DecryptBinaryFile(filenameIn, filenameOut) // error already logged by core
+	}
+
+	return err
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+func DemoRunningKeyCommand(alpha, numeric *cmn.Alphabet, phrase string) bool {
+	fmt.Println("Running Key Encryption (Command-pattern version)")
+	fmt.Println("( A Vigenère with a key as long as the message )")
+
+	// any text both parties own will do, here the alphabet over & over
+	book := strings.Repeat(alpha.Chars+"\n", 1+len(phrase)/int(alpha.Size()))
+	var ok bool = true
+	for _, offset := range []string{"0", "7"} {
+		var encTxt, encTxt2, decTxt string
+		var err error
+
+		at, _ := crypto.ParseBookOffset(offset)
+		seq1, err := crypto.NewRunningKeySequencerFromReader(strings.NewReader(book+book), "alphabet.txt", at, alpha)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		seq2, _ := crypto.NewRunningKeySequencerFromReader(strings.NewReader(book+book), "alphabet.txt", at, alpha)
+
+		ngram := cmn.NewNgramFormatter(5, '·')
+		cnv1 := NewRunningKeyCommand(alpha, seq1)
+		cnv2 := NewRunningKeyCommand(alpha, seq2)
+		cnv2.WithPipe(ngram)
+
+		encTxt, err = cnv1.Encode(phrase)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+		encTxt2, err = cnv2.Encode(phrase)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		decTxt, err = cnv1.Decode(encTxt)
+		if err != nil {
+			app.DieWithError(err, caesarx.ERR_DEMO_ERROR)
+		}
+
+		fmt.Printf("Offset : %s\n", at)
+		fmt.Println("Plain  : ", phrase)
+		fmt.Println("Encoded: ", encTxt)
+		fmt.Println("Format : ", encTxt2)
+		fmt.Println("Decoded: ", decTxt)
+		fmt.Println()
+
+		if decTxt != phrase {
+			ok = false
+		}
+	}
+
+	return ok
+}
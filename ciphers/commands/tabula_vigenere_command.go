@@ -43,6 +43,8 @@ const (
 
 var _ ciphers.IPipe = (*VigenereCommand)(nil)
 var _ ciphers.ICipherCommand = (*VigenereCommand)(nil)
+var _ ciphers.IFileHeaderCommand = (*VigenereCommand)(nil)
+var _ ciphers.IKeyedCipherCommand = (*VigenereCommand)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
@@ -103,6 +105,21 @@ func (c *VigenereCommand) WithChain(slave *cmn.Alphabet) ciphers.ICipherCommand
 	return c
 }
 
+/**
+ * Use keyword-mixed alphabets (Quagmire I-IV) in the primary/master
+ * tabula. The slave disk/tabula keeps its natural order.
+ */
+func (c *VigenereCommand) WithKeyword(keys ciphers.QuagmireKeys) ciphers.ICipherCommand {
+	c.core.WithKeyword(keys)
+	return c
+}
+
+// write (default) or omit the FileHeader of encrypted binary files
+func (c *VigenereCommand) WithFileHeader(enabled bool) ciphers.ICipherCommand {
+	c.core.WithFileHeader(enabled)
+	return c
+}
+
 // this result is only meaningful after EncryptBinFile() or EncryptTextFile()
 // where the output filename is not explicitely given but generated.
 func (c *VigenereCommand) GetOutputFilename() string {
@@ -0,0 +1,155 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Enigma cipher (Arthur Scherbius, 1918) with the historical
+ * rotors & reflectors. Every message starts with the rotors at the
+ * Grundstellung (start positions) of the settings, hence Encode and
+ * Decode are the same operation. Letters keep their case and the
+ * other runes pass through unchanged.
+ *-----------------------------------------------------------------*/
+package enigma
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ALG_NAME_ENIGMA = "Enigma"
+	ALG_CODE_ENIGMA = "ENIG"
+)
+
+var (
+	Info = ciphers.NewCipherInfo(ALG_CODE_ENIGMA, "1.0",
+		"Arthur Scherbius",
+		ALG_NAME_ENIGMA,
+		"Rotor machine with ring settings, reflector & plugboard")
+
+	ErrChain      = errors.New("the Enigma rotors are wired for letters only, no slave alphabet")
+	ErrBinaryFile = errors.New("Enigma is a text-only cipher, binary files are not supported")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(Info)
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type EnigmaCrypto struct {
+	alpha    *cmn.Alphabet
+	settings Settings
+	machine  *EnigmaMachine
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the Enigma cipher with the historical parts given by the
+ * settings, i.e. ParseSettings("B I-II-III 01-12-22 ABC AV BS CG").
+ * The alphabet must be the 26 letters of English.
+ */
+func NewEnigmaCrypto(alpha *cmn.Alphabet, settings Settings) (*EnigmaCrypto, error) {
+	c := &EnigmaCrypto{settings: settings}
+	if err := c.rebuild(alpha); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *EnigmaCrypto) String() string {
+	return fmt.Sprintf("%s %s", ALG_NAME_ENIGMA, c.settings)
+}
+
+// the machine alphabet
+func (c *EnigmaCrypto) GetAlphabet() string {
+	return c.machine.GetAlphabet()
+}
+
+func (c *EnigmaCrypto) Settings() Settings {
+	return c.settings
+}
+
+func (c *EnigmaCrypto) Machine() *EnigmaMachine {
+	return c.machine
+}
+
+// Use another master alphabet. The historical parts only fit the 26
+// letters of English.
+func (c *EnigmaCrypto) WithAlphabet(alpha *cmn.Alphabet) error {
+	return c.rebuild(alpha)
+}
+
+// The rotors have no contacts for a slave alphabet, nil is the only
+// acceptable value.
+func (c *EnigmaCrypto) WithChain(slave *cmn.Alphabet) error {
+	if slave != nil {
+		return ErrChain
+	}
+	return nil
+}
+
+// Encode plain text starting at the Grundstellung.
+func (c *EnigmaCrypto) Encode(plain string) (string, error) {
+	c.machine.Reset()
+	return c.machine.Encipher(plain), nil
+}
+
+// Decode ciphertext, the machine is reciprocal.
+func (c *EnigmaCrypto) Decode(cipher string) (string, error) {
+	return c.Encode(cipher)
+}
+
+// Encrypts a text file. The whole file is a single message, the rotors
+// keep turning from one line to the next.
+func (c *EnigmaCrypto) EncryptTextFile(input, output string) error {
+	return c.processTextFile(input, output)
+}
+
+// Decrypts a text file.
+func (c *EnigmaCrypto) DecryptTextFile(input, output string) error {
+	return c.processTextFile(input, output)
+}
+
+func (c *EnigmaCrypto) rebuild(alpha *cmn.Alphabet) error {
+	if alpha == nil || alpha.IsBinary() {
+		return ErrBinaryFile
+	}
+
+	machine, err := NewHistoricalMachine(alpha, c.settings)
+	if err != nil {
+		return err
+	}
+
+	c.alpha = alpha
+	c.machine = machine
+	return nil
+}
+
+// Common factor of text file processing. If there was an error of any
+// kind, the unfinished output file is deleted from the filesystem.
+func (c *EnigmaCrypto) processTextFile(input, output string) error {
+	c.machine.Reset()
+	return ciphers.ProcessTextFile(input, output, func(line string) (string, error) {
+		return c.machine.Encipher(line), nil
+	})
+}
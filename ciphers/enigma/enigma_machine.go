@@ -0,0 +1,341 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * An Enigma-style rotor machine. On every key press the rotors step
+ * like an odometer (with the double-stepping anomaly of the middle
+ * rotor) and then the signal goes:
+ *	plugboard → rotors right to left → reflector → rotors left to
+ *	right → plugboard → lamp
+ * Because of the reflector the machine is reciprocal: the same
+ * settings encrypt & decrypt, and no letter encrypts to itself.
+ *
+ * The daily settings follow the key sheets of the time:
+ *	UKW Walzenlage Ringstellung Grundstellung Steckerverbindungen
+ *	B   I-II-III   01-12-22     ABC           AV BS CG DL FU HZ IN KM OW RX
+ *-----------------------------------------------------------------*/
+package enigma
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// separates the rotor names & ring settings in the settings spec
+	SETTINGS_SEPARATOR = "-"
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The settings of a machine with historical rotors & reflector
+type Settings struct {
+	Reflector string   // Umkehrwalze: A, B or C
+	Rotors    []string // Walzenlage, left to right: I..VIII
+	Rings     []int    // Ringstellung, left to right: 1 (A) .. 26 (Z)
+	Start     string   // Grundstellung, left to right: the letters in the windows
+	Plugs     []string // Steckerverbindungen: pairs of letters
+}
+
+type EnigmaMachine struct {
+	alpha     *cmn.Alphabet
+	letters   []rune // the (uppercase) machine alphabet
+	where     map[rune]int
+	reflector *Reflector
+	rotors    []*Rotor // left to right
+	plugboard *Plugboard
+	start     []int // the positions restored by Reset()
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) a machine with custom parts, all of them built for the same
+ * alphabet. The rotors are given left to right and keep their ring
+ * settings & positions, which become the start positions.
+ */
+func NewEnigmaMachine(alpha *cmn.Alphabet, reflector *Reflector, plugboard *Plugboard, rotors ...*Rotor) (*EnigmaMachine, error) {
+	letters, where := machineLetters(alpha)
+	if len(rotors) == 0 {
+		return nil, fmt.Errorf("%w: at least one rotor is needed", ErrSetting)
+	}
+	for _, r := range rotors {
+		if r.size != len(letters) {
+			return nil, fmt.Errorf("%w: rotor %s is not wired for %s", ErrSetting, r.Name, alpha.Name)
+		}
+	}
+	if len(reflector.pairs) != len(letters) || len(plugboard.swap) != len(letters) {
+		return nil, fmt.Errorf("%w: reflector or plugboard not wired for %s", ErrSetting, alpha.Name)
+	}
+
+	m := &EnigmaMachine{
+		alpha:     alpha,
+		letters:   letters,
+		where:     where,
+		reflector: reflector,
+		rotors:    rotors,
+		plugboard: plugboard,
+		start:     make([]int, len(rotors)),
+	}
+	for i, r := range rotors {
+		m.start[i] = r.Position()
+	}
+
+	return m, nil
+}
+
+// (Ctor) a machine with the historical rotors & reflector given by
+// the settings. The alphabet must be the 26 letters of English.
+func NewHistoricalMachine(alpha *cmn.Alphabet, settings Settings) (*EnigmaMachine, error) {
+	if len(settings.Rings) != 0 && len(settings.Rings) != len(settings.Rotors) {
+		return nil, fmt.Errorf("%w: %d ring settings for %d rotors", ErrSetting, len(settings.Rings), len(settings.Rotors))
+	}
+
+	reflector, err := NewHistoricalReflector(alpha, settings.Reflector)
+	if err != nil {
+		return nil, err
+	}
+	plugboard, err := NewPlugboard(alpha, settings.Plugs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rotors := make([]*Rotor, len(settings.Rotors))
+	for i, name := range settings.Rotors {
+		if rotors[i], err = NewHistoricalRotor(alpha, name); err != nil {
+			return nil, err
+		}
+		if len(settings.Rings) != 0 {
+			ring := settings.Rings[i]
+			if ring < 1 || ring > len(HISTORICAL_LETTERS) {
+				return nil, fmt.Errorf("%w: ring setting %02d", ErrSetting, ring)
+			}
+			rotors[i].SetRing(ring - 1)
+		}
+	}
+
+	m, err := NewEnigmaMachine(alpha, reflector, plugboard, rotors...)
+	if err == nil && len(settings.Start) != 0 {
+		err = m.SetStart(settings.Start)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer. Key sheet notation.
+func (s Settings) String() string {
+	rings := make([]string, len(s.Rings))
+	for i, ring := range s.Rings {
+		rings[i] = fmt.Sprintf("%02d", ring)
+	}
+
+	fields := []string{s.Reflector, strings.Join(s.Rotors, SETTINGS_SEPARATOR)}
+	if len(rings) != 0 || len(s.Start) != 0 || len(s.Plugs) != 0 {
+		fields = append(fields, strings.Join(rings, SETTINGS_SEPARATOR))
+	}
+	if len(s.Start) != 0 || len(s.Plugs) != 0 {
+		fields = append(fields, s.Start)
+	}
+	fields = append(fields, s.Plugs...)
+	return strings.Join(fields, " ")
+}
+
+// implements fmt.Stringer
+func (m *EnigmaMachine) String() string {
+	names := make([]string, len(m.rotors))
+	rings := make([]string, len(m.rotors))
+	for i, r := range m.rotors {
+		names[i] = r.Name
+		rings[i] = fmt.Sprintf("%02d", r.Ring()+1)
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("UKW-%s %s %s %s %s", m.reflector.Name,
+		strings.Join(names, SETTINGS_SEPARATOR),
+		strings.Join(rings, SETTINGS_SEPARATOR),
+		m.Positions(),
+		m.plugboard))
+}
+
+// the machine alphabet
+func (m *EnigmaMachine) GetAlphabet() string {
+	return string(m.letters)
+}
+
+// the rotors, left to right
+func (m *EnigmaMachine) Rotors() []*Rotor {
+	return m.rotors
+}
+
+func (m *EnigmaMachine) Reflector() *Reflector {
+	return m.reflector
+}
+
+func (m *EnigmaMachine) Plugboard() *Plugboard {
+	return m.plugboard
+}
+
+// the letters that show in the windows, left to right
+func (m *EnigmaMachine) Positions() string {
+	var sb strings.Builder
+	for _, r := range m.rotors {
+		sb.WriteRune(r.Letter())
+	}
+	return sb.String()
+}
+
+// Set the Grundstellung: the letters in the windows, left to right.
+// They are restored by Reset().
+func (m *EnigmaMachine) SetStart(letters string) error {
+	runes := []rune(m.alpha.ToUpperString(letters))
+	if len(runes) != len(m.rotors) {
+		return fmt.Errorf("%w: start '%s' needs %d letters", ErrSetting, letters, len(m.rotors))
+	}
+
+	start := make([]int, len(runes))
+	for i, r := range runes {
+		position, found := m.where[r]
+		if !found {
+			return fmt.Errorf("%w: start letter '%c' is not in the alphabet", ErrSetting, r)
+		}
+		start[i] = position
+	}
+
+	m.start = start
+	m.Reset()
+	return nil
+}
+
+// turn the rotors back to the start positions
+func (m *EnigmaMachine) Reset() {
+	for i, r := range m.rotors {
+		r.SetPosition(m.start[i])
+	}
+}
+
+// Press the key of the letter at the (0-based) position, the rotors
+// step and the position of the lit lamp is returned.
+func (m *EnigmaMachine) PressKey(key int) int {
+	m.step()
+
+	signal := m.plugboard.Swap(key)
+	for i := len(m.rotors) - 1; i >= 0; i-- {
+		signal = m.rotors[i].Forward(signal)
+	}
+	signal = m.reflector.Reflect(signal)
+	for _, r := range m.rotors {
+		signal = r.Backward(signal)
+	}
+	return m.plugboard.Swap(signal)
+}
+
+// Type the text on the machine. The runes that are not letters of
+// the machine pass through unchanged (the rotors do not step) and
+// the case is preserved.
+func (m *EnigmaMachine) Encipher(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		upper := []rune(m.alpha.ToUpperString(string(r)))
+		key, found := -1, false
+		if len(upper) == 1 {
+			key, found = m.where[upper[0]]
+		}
+		if !found {
+			sb.WriteRune(r)
+			continue
+		}
+
+		lamp := m.letters[m.PressKey(key)]
+		if upper[0] != r {
+			lamp = []rune(m.alpha.ToLowerString(string(lamp)))[0]
+		}
+		sb.WriteRune(lamp)
+	}
+	return sb.String()
+}
+
+// Odometer stepping: the rightmost rotor always steps and a rotor at
+// its notch makes its left neighbour step. The pawl engaging that
+// notch also pushes the rotor itself, hence a middle rotor at its
+// notch steps twice in a row (double stepping).
+func (m *EnigmaMachine) step() {
+	last := len(m.rotors) - 1
+	steps := make([]bool, len(m.rotors))
+	steps[last] = true
+	for i := last - 1; i >= 0; i-- {
+		if m.rotors[i+1].AtNotch() {
+			steps[i] = true
+			steps[i+1] = true
+		}
+	}
+
+	for i, r := range m.rotors {
+		if steps[i] {
+			r.step()
+		}
+	}
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+/**
+ * Parse the settings in key sheet notation, the fields are separated
+ * by spaces and only the first two are mandatory:
+ *	REFLECTOR ROTORS [RINGS [START [PAIR...]]]
+ *	B I-II-III 01-12-22 ABC AV BS CG DL
+ */
+func ParseSettings(spec string) (Settings, error) {
+	var settings Settings
+	fields := strings.Fields(spec)
+	if len(fields) < 2 {
+		return settings, fmt.Errorf("%w: '%s' needs at least the reflector & rotors, i.e. 'B I-II-III'", ErrSetting, spec)
+	}
+
+	settings.Reflector = strings.ToUpper(fields[0])
+	settings.Rotors = strings.Split(strings.ToUpper(fields[1]), SETTINGS_SEPARATOR)
+	if len(fields) > 2 {
+		for _, ring := range strings.Split(fields[2], SETTINGS_SEPARATOR) {
+			value, err := strconv.Atoi(ring)
+			if err != nil || !isDigits(ring) {
+				return settings, fmt.Errorf("%w: ring settings '%s' are numbers, i.e. 01-12-22", ErrSetting, fields[2])
+			}
+			settings.Rings = append(settings.Rings, value)
+		}
+	}
+	if len(fields) > 3 {
+		settings.Start = strings.ToUpper(fields[3])
+	}
+	if len(fields) > 4 {
+		settings.Plugs = fields[4:]
+	}
+
+	return settings, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return len(s) != 0
+}
@@ -0,0 +1,352 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The parts of an Enigma-style rotor machine. Each of them is a
+ * permutation of the machine alphabet, which is kept as a permuted
+ * cmn.Alphabet (the wiring) so that it can be printed & inspected:
+ *	· Rotor		wheel with a wiring, ring setting, position & notches
+ *	· Reflector	(Umkehrwalze) pairs of contacts, sends the signal back
+ *	· Plugboard	(Steckerbrett) pairs of swapped letters
+ * The historical wirings of the Wehrmacht & Kriegsmarine machines are
+ * included, they are only valid for the 26 letters of English.
+ *-----------------------------------------------------------------*/
+package enigma
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+// the letters the historical rotors are wired for
+const HISTORICAL_LETTERS = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var (
+	// Historical rotors (Walzen) I-V of the Enigma I and the
+	// additional VI-VIII of the Kriegsmarine M3/M4.
+	HistoricalRotors = map[string]RotorSpec{
+		"I":    {"EKMFLGDQVZNTOWYHXUSPAIBRCJ", "Q"},
+		"II":   {"AJDKSIRUXBLHWTMCQGZNPYFVOE", "E"},
+		"III":  {"BDFHJLCPRTXVZNYEIWGAKMUSQO", "V"},
+		"IV":   {"ESOVPZJAYQUIRHXLNFTGKDCMWB", "J"},
+		"V":    {"VZBRGITYUPSDNHLXAWMJQOFECK", "Z"},
+		"VI":   {"JPGVOUMFYQBENHZRDKASXLICTW", "ZM"},
+		"VII":  {"NZJHGRCXMYSWBOUFAIVLPEKQDT", "ZM"},
+		"VIII": {"FKQHTLXOCBJSPDZRAMEWNIUYGV", "ZM"},
+	}
+
+	// Historical reflectors (Umkehrwalzen)
+	HistoricalReflectors = map[string]string{
+		"A": "EJMZALYXVBWFCRQUONTSPIKHGD",
+		"B": "YRUHQSLDPXNGOKMIEBFZCWVJAT",
+		"C": "FVPJIAOYEDRZXWGCTKUQSBNMHL",
+	}
+
+	ErrWiring    = errors.New("invalid Enigma wiring")
+	ErrPlugboard = errors.New("invalid Enigma plugboard")
+	ErrSetting   = errors.New("invalid Enigma setting")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The wiring & turnover notches of a rotor
+type RotorSpec struct {
+	Wiring  string
+	Notches string
+}
+
+type Rotor struct {
+	Name     string
+	Wiring   *cmn.Alphabet // the permuted machine alphabet
+	letters  []rune        // the (uppercase) machine alphabet
+	size     int
+	forward  []int // contact to contact, right to left
+	backward []int // contact to contact, left to right
+	notches  []int
+	ring     int // Ringstellung, 0 is A (01)
+	position int // the letter in the window, 0 is A
+}
+
+type Reflector struct {
+	Name   string
+	Wiring *cmn.Alphabet // the permuted machine alphabet
+	pairs  []int
+}
+
+type Plugboard struct {
+	pairs []string // the swapped pairs as given, i.e. AB
+	swap  []int
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) a rotor for the machine alphabet. The wiring is a permutation
+ * of the (uppercase) alphabet: the letter at position i is where the
+ * contact i is wired to. The notches are the letters that show in the
+ * window when the rotor makes its left neighbour step.
+ */
+func NewRotor(alpha *cmn.Alphabet, name, wiring, notches string) (*Rotor, error) {
+	base, where := machineLetters(alpha)
+	forward, err := permutation(alpha, base, where, wiring)
+	if err != nil {
+		return nil, fmt.Errorf("rotor %s: %w", name, err)
+	}
+
+	r := &Rotor{
+		Name:     name,
+		Wiring:   alpha.From(alpha.ToUpperString(wiring)).Rename(name),
+		letters:  base,
+		size:     len(base),
+		forward:  forward,
+		backward: make([]int, len(base)),
+		notches:  make([]int, 0, len(notches)),
+	}
+	for i, j := range forward {
+		r.backward[j] = i
+	}
+	for _, n := range alpha.ToUpperString(notches) {
+		index, found := where[n]
+		if !found {
+			return nil, fmt.Errorf("%w: rotor %s notch '%c' is not in the alphabet", ErrWiring, name, n)
+		}
+		r.notches = append(r.notches, index)
+	}
+	if len(r.notches) == 0 {
+		return nil, fmt.Errorf("%w: rotor %s has no notches", ErrWiring, name)
+	}
+
+	return r, nil
+}
+
+// (Ctor) one of the historical rotors I..VIII
+func NewHistoricalRotor(alpha *cmn.Alphabet, name string) (*Rotor, error) {
+	spec, found := HistoricalRotors[strings.ToUpper(name)]
+	if !found {
+		return nil, fmt.Errorf("%w: unknown rotor '%s'", ErrSetting, name)
+	}
+	if err := checkHistorical(alpha); err != nil {
+		return nil, err
+	}
+
+	return NewRotor(alpha, strings.ToUpper(name), spec.Wiring, spec.Notches)
+}
+
+/**
+ * (Ctor) a reflector for the machine alphabet. The wiring is a
+ * permutation of the (uppercase) alphabet made of pairs: if A goes
+ * to Y then Y goes to A, and no letter goes to itself. Hence the
+ * alphabet must have an even number of letters.
+ */
+func NewReflector(alpha *cmn.Alphabet, name, wiring string) (*Reflector, error) {
+	base, where := machineLetters(alpha)
+	pairs, err := permutation(alpha, base, where, wiring)
+	if err != nil {
+		return nil, fmt.Errorf("reflector %s: %w", name, err)
+	}
+
+	for i, j := range pairs {
+		if i == j || pairs[j] != i {
+			return nil, fmt.Errorf("%w: reflector %s must pair the letters, %c is not", ErrWiring, name, base[i])
+		}
+	}
+
+	return &Reflector{
+		Name:   name,
+		Wiring: alpha.From(alpha.ToUpperString(wiring)).Rename(name),
+		pairs:  pairs,
+	}, nil
+}
+
+// (Ctor) one of the historical reflectors A, B or C
+func NewHistoricalReflector(alpha *cmn.Alphabet, name string) (*Reflector, error) {
+	wiring, found := HistoricalReflectors[strings.ToUpper(name)]
+	if !found {
+		return nil, fmt.Errorf("%w: unknown reflector '%s'", ErrSetting, name)
+	}
+	if err := checkHistorical(alpha); err != nil {
+		return nil, err
+	}
+
+	return NewReflector(alpha, strings.ToUpper(name), wiring)
+}
+
+/**
+ * (Ctor) a plugboard swapping the given pairs of letters, i.e.
+ * "AB", "CD", "EF" swaps A & B, C & D and E & F. No letter may appear
+ * in more than one pair. Without pairs there are no cables plugged.
+ */
+func NewPlugboard(alpha *cmn.Alphabet, pairs ...string) (*Plugboard, error) {
+	base, where := machineLetters(alpha)
+	p := &Plugboard{
+		pairs: make([]string, 0, len(pairs)),
+		swap:  make([]int, len(base)),
+	}
+	for i := range p.swap {
+		p.swap[i] = i
+	}
+
+	for _, pair := range pairs {
+		letters := []rune(alpha.ToUpperString(pair))
+		if len(letters) != 2 {
+			return nil, fmt.Errorf("%w: '%s' is not a pair of letters", ErrPlugboard, pair)
+		}
+		a, foundA := where[letters[0]]
+		b, foundB := where[letters[1]]
+		if !foundA || !foundB || a == b {
+			return nil, fmt.Errorf("%w: '%s' needs two different letters of the alphabet", ErrPlugboard, pair)
+		}
+		if p.swap[a] != a || p.swap[b] != b {
+			return nil, fmt.Errorf("%w: '%s' reuses a plugged letter", ErrPlugboard, pair)
+		}
+
+		p.swap[a], p.swap[b] = b, a
+		p.pairs = append(p.pairs, string(letters))
+	}
+
+	return p, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (r *Rotor) String() string {
+	return fmt.Sprintf("%s %s ring:%02d pos:%c", r.Name, r.Wiring.Chars, r.ring+1, r.Letter())
+}
+
+// the Ringstellung as 0-based offset, 0 is A (01)
+func (r *Rotor) Ring() int {
+	return r.ring
+}
+
+// the 0-based position of the letter that shows in the window
+func (r *Rotor) Position() int {
+	return r.position
+}
+
+// the letter that shows in the window
+func (r *Rotor) Letter() rune {
+	return r.letters[r.position]
+}
+
+// set the Ringstellung as 0-based offset, 0 is A (01)
+func (r *Rotor) SetRing(ring int) {
+	r.ring = modulo(ring, r.size)
+}
+
+// set the 0-based position of the letter that shows in the window
+func (r *Rotor) SetPosition(position int) {
+	r.position = modulo(position, r.size)
+}
+
+// whether the letter in the window is a turnover notch, then the
+// rotor to its left steps on the next key press.
+func (r *Rotor) AtNotch() bool {
+	for _, n := range r.notches {
+		if n == r.position {
+			return true
+		}
+	}
+	return false
+}
+
+// advance the rotor one position
+func (r *Rotor) step() {
+	r.position = (r.position + 1) % r.size
+}
+
+// the signal enters from the right (plugboard side) at contact i
+func (r *Rotor) Forward(i int) int {
+	shift := r.position - r.ring
+	return modulo(r.forward[modulo(i+shift, r.size)]-shift, r.size)
+}
+
+// the signal returns from the reflector at contact i
+func (r *Rotor) Backward(i int) int {
+	shift := r.position - r.ring
+	return modulo(r.backward[modulo(i+shift, r.size)]-shift, r.size)
+}
+
+// implements fmt.Stringer
+func (r *Reflector) String() string {
+	return fmt.Sprintf("UKW-%s %s", r.Name, r.Wiring.Chars)
+}
+
+// the contact i is sent back through the paired contact
+func (r *Reflector) Reflect(i int) int {
+	return r.pairs[i]
+}
+
+// implements fmt.Stringer
+func (p *Plugboard) String() string {
+	return strings.Join(p.pairs, " ")
+}
+
+// the swapped pairs of letters
+func (p *Plugboard) Pairs() []string {
+	return p.pairs
+}
+
+// the contact i goes through its cable (if plugged)
+func (p *Plugboard) Swap(i int) int {
+	return p.swap[i]
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// the uppercase letters of the machine & their (0-based) positions
+func machineLetters(alpha *cmn.Alphabet) ([]rune, map[rune]int) {
+	base := []rune(alpha.ToUpperString(alpha.Chars))
+	where := make(map[rune]int, len(base))
+	for i, r := range base {
+		where[r] = i
+	}
+	return base, where
+}
+
+// the wiring as a permutation of the contacts of the machine alphabet
+func permutation(alpha *cmn.Alphabet, base []rune, where map[rune]int, wiring string) ([]int, error) {
+	letters := []rune(alpha.ToUpperString(wiring))
+	if len(letters) != len(base) {
+		return nil, fmt.Errorf("%w: %d letters for an alphabet of %d", ErrWiring, len(letters), len(base))
+	}
+
+	perm := make([]int, len(letters))
+	used := make([]bool, len(letters))
+	for i, r := range letters {
+		j, found := where[r]
+		if !found || used[j] {
+			return nil, fmt.Errorf("%w: '%c' is not in the alphabet or repeated", ErrWiring, r)
+		}
+		used[j] = true
+		perm[i] = j
+	}
+	return perm, nil
+}
+
+// the historical wirings are only valid for the 26 letters of English
+func checkHistorical(alpha *cmn.Alphabet) error {
+	if alpha.ToUpperString(alpha.Chars) != HISTORICAL_LETTERS {
+		return fmt.Errorf("%w: the historical rotors are wired for %s, not %s", ErrWiring, HISTORICAL_LETTERS, alpha.Name)
+	}
+	return nil
+}
+
+// the mathematical modulo, always positive
+func modulo(a, n int) int {
+	return ((a % n) + n) % n
+}
@@ -0,0 +1,293 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Hill cipher (Lester S. Hill, 1929) encrypts BLOCKS of 2 or 3
+ * letters at once. Each block is a column vector of letter positions
+ * that is multiplied by the key matrix modulo N:
+ *			C = K · P  (mod N)
+ *			P = K⁻¹ · C  (mod N)
+ * Like Playfair it drops everything that is not in the alphabet and
+ * the result is uppercase. The last block is padded with the nulls
+ * (X by default) which remain in the decrypted text.
+ *-----------------------------------------------------------------*/
+package hill
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ALG_NAME_HILL = "Hill"
+	ALG_CODE_HILL = "HILL"
+
+	// pads the last block, unless it is not part of the alphabet,
+	// then the last letter of the (master) alphabet is used.
+	DEFAULT_NULL rune = 'X'
+)
+
+var (
+	Info = ciphers.NewCipherInfo(ALG_CODE_HILL, "1.0",
+		"Lester S. Hill",
+		ALG_NAME_HILL,
+		"Polygraphic cipher with a 2x2 or 3x3 key matrix modulo N")
+
+	ErrKeySpec    = errors.New("invalid Hill key")
+	ErrNulls      = errors.New("invalid Hill nulls")
+	ErrBlock      = errors.New("invalid Hill ciphertext")
+	ErrBinaryFile = errors.New("Hill is a text-only cipher, binary files are not supported")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(Info)
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type HillCrypto struct {
+	alpha   *cmn.Alphabet
+	slave   *cmn.Alphabet
+	keySpec string
+	chars   []rune // uppercase master (+ slave) runes
+	where   map[rune]int
+	key     *HillMatrix
+	inverse *HillMatrix
+	nulls   []rune // nil for the default null
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the Hill cipher for the alphabet (N = its size). The key is
+ * either a word of 4 or 9 letters of the alphabet ("HILL") or the
+ * 4 or 9 matrix values row by row ("3 3 2 5" or "3,3,2,5"). It fails
+ * if the key matrix is not invertible modulo N.
+ */
+func NewHillCrypto(alpha *cmn.Alphabet, key string) (*HillCrypto, error) {
+	c := &HillCrypto{keySpec: key}
+	if err := c.rebuild(alpha, nil); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *HillCrypto) String() string {
+	size := c.key.Size()
+	return fmt.Sprintf("%s %dx%d mod %d key:'%s' nulls:%s", ALG_NAME_HILL, size, size, c.key.Modulus(), c.keySpec, string(c.padding()))
+}
+
+// the runes of the (chained) alphabet in position order
+func (c *HillCrypto) GetAlphabet() string {
+	return string(c.chars)
+}
+
+// the key matrix used for encryption
+func (c *HillCrypto) Key() *HillMatrix {
+	return c.key
+}
+
+// the inverse key matrix used for decryption
+func (c *HillCrypto) Inverse() *HillMatrix {
+	return c.inverse
+}
+
+// Use another master alphabet. The matrix is rebuilt with the same
+// key which may no longer be invertible for the new size N.
+func (c *HillCrypto) WithAlphabet(alpha *cmn.Alphabet) error {
+	return c.rebuild(alpha, c.slave)
+}
+
+// Append the slave alphabet to the master, i.e. English + Numbers
+// gives N=36. Nil to remove it.
+func (c *HillCrypto) WithChain(slave *cmn.Alphabet) error {
+	return c.rebuild(c.alpha, slave)
+}
+
+// Set the null runes that pad the last block, they are used in turn
+// to avoid a recognizable XX or XXX at the end of the message.
+func (c *HillCrypto) WithNulls(nulls string) error {
+	runes := []rune(c.alpha.ToUpperString(nulls))
+	if len(runes) == 0 {
+		return fmt.Errorf("%w: at least one null is needed", ErrNulls)
+	}
+	for _, r := range runes {
+		if _, ok := c.where[r]; !ok {
+			return fmt.Errorf("%w: '%c' is not part of the alphabet", ErrNulls, r)
+		}
+	}
+
+	c.nulls = runes
+	return nil
+}
+
+// Encode plain text. Runes that are not part of the alphabet are
+// removed and the last block is padded with the nulls.
+func (c *HillCrypto) Encode(plain string) (string, error) {
+	positions := c.positions(plain)
+	nulls := c.padding()
+	for i := 0; len(positions)%c.key.Size() != 0; i++ {
+		positions = append(positions, c.where[nulls[i%len(nulls)]])
+	}
+
+	return c.transform(c.key, positions), nil
+}
+
+// Decode Hill ciphertext. Runes that are not part of the alphabet
+// (i.e. the separators of NGram formatting) are ignored. The nulls
+// are NOT removed from the plain text.
+func (c *HillCrypto) Decode(cipher string) (string, error) {
+	positions := c.positions(cipher)
+	if len(positions)%c.key.Size() != 0 {
+		return "", fmt.Errorf("%w: %d letters is not a multiple of the block size %d", ErrBlock, len(positions), c.key.Size())
+	}
+
+	return c.transform(c.inverse, positions), nil
+}
+
+// Encrypts a text file line by line. Each line is padded on its own.
+func (c *HillCrypto) EncryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Encode)
+}
+
+// Decrypts a text file line by line.
+func (c *HillCrypto) DecryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Decode)
+}
+
+func (c *HillCrypto) rebuild(alpha, slave *cmn.Alphabet) error {
+	if alpha == nil || alpha.IsBinary() {
+		return ErrBinaryFile
+	}
+
+	chars := []rune(alpha.Clone().ToUpper().Chars) // ToUpper() modifies the receiver
+	if slave != nil {
+		chars = append(chars, []rune(slave.Chars)...)
+	}
+	where := make(map[rune]int, len(chars))
+	for i, r := range chars {
+		where[r] = i
+	}
+
+	values, err := parseKey(c.keySpec, alpha, where)
+	if err != nil {
+		return err
+	}
+	key, err := NewHillMatrix(values, len(chars))
+	if err != nil {
+		return err
+	}
+	inverse, err := key.Inverse()
+	if err != nil {
+		return err
+	}
+
+	// the custom nulls may no longer be part of the new alphabet
+	for _, r := range c.nulls {
+		if _, ok := where[r]; !ok {
+			return fmt.Errorf("%w: '%c' not usable in %s", ErrNulls, r, alpha.Name)
+		}
+	}
+
+	c.alpha = alpha
+	c.slave = slave
+	c.chars = chars
+	c.where = where
+	c.key = key
+	c.inverse = inverse
+	return nil
+}
+
+// the custom nulls or the default one for the current alphabet
+func (c *HillCrypto) padding() []rune {
+	if len(c.nulls) != 0 {
+		return c.nulls
+	}
+	if _, ok := c.where[DEFAULT_NULL]; ok {
+		return []rune{DEFAULT_NULL}
+	}
+	last := int(c.alpha.Size()) - 1
+	return c.chars[last : last+1]
+}
+
+// the positions of the runes of the text that are part of the alphabet
+func (c *HillCrypto) positions(text string) []int {
+	positions := make([]int, 0, len(text))
+	for _, r := range c.alpha.ToUpperString(text) {
+		if pos, ok := c.where[r]; ok {
+			positions = append(positions, pos)
+		}
+	}
+	return positions
+}
+
+// multiply each block by the matrix and return the resulting runes
+func (c *HillCrypto) transform(m *HillMatrix, positions []int) string {
+	var sb strings.Builder
+	for i := 0; i < len(positions); i += m.Size() {
+		for _, pos := range m.Multiply(positions[i : i+m.Size()]) {
+			sb.WriteRune(c.chars[pos])
+		}
+	}
+	return sb.String()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// The key is either numeric, the matrix values separated by spaces
+// and/or commas, or a word whose letters give the values by their
+// position in the (chained) alphabet. Spaces are ignored in words.
+func parseKey(spec string, alpha *cmn.Alphabet, where map[rune]int) ([]int, error) {
+	fields := strings.FieldsFunc(spec, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: it is empty", ErrKeySpec)
+	}
+
+	// numeric matrix values
+	if _, err := strconv.Atoi(fields[0]); err == nil {
+		values := make([]int, len(fields))
+		for i, field := range fields {
+			if values[i], err = strconv.Atoi(field); err != nil {
+				return nil, fmt.Errorf("%w: '%s' is not a number", ErrKeySpec, field)
+			}
+		}
+		return values, nil
+	}
+
+	// key word
+	values := make([]int, 0, 9)
+	for _, r := range alpha.ToUpperString(strings.Join(fields, "")) {
+		pos, ok := where[r]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%c' is not part of the alphabet", ErrKeySpec, r)
+		}
+		values = append(values, pos)
+	}
+	return values, nil
+}
@@ -0,0 +1,213 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The key matrix of the Hill cipher: a 2x2 or 3x3 matrix over the
+ * integers modulo N, the size of the alphabet. Just like the A
+ * coefficient of Affine must be coprime with N, the DETERMINANT of
+ * the Hill matrix must be coprime with N, else it has no inverse and
+ * the message cannot be decrypted. The modular arithmetic is that of
+ * the AffineHelper, hence it works with any alphabet size, not only
+ * with the 26 letters of English.
+ *			K⁻¹ = det(K)⁻¹ · adj(K)  (mod N)
+ *-----------------------------------------------------------------*/
+package hill
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers/affine"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrMatrixSize    = errors.New("Hill key matrix must be 2x2 (4 values) or 3x3 (9 values)")
+	ErrNotInvertible = errors.New("Hill key matrix is not invertible")
+
+	// only the (stateless) modular arithmetic of the helper is used
+	modular = affine.NewAffineHelper()
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type HillMatrix struct {
+	size  int   // 2 or 3 rows (and columns)
+	mod   int   // N, the size of the alphabet
+	cells []int // row by row, reduced modulo N
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) a Hill key matrix from its values given row by row, 4 for
+ * a 2x2 matrix or 9 for a 3x3 matrix. The values are reduced modulo
+ * N (negative values allowed). The matrix is refused if it is not
+ * invertible modulo N, the error gives the reason.
+ */
+func NewHillMatrix(values []int, n int) (*HillMatrix, error) {
+	var size int
+	switch len(values) {
+	case 4:
+		size = 2
+	case 9:
+		size = 3
+	default:
+		return nil, fmt.Errorf("%w, got %d values", ErrMatrixSize, len(values))
+	}
+
+	if n < 2 {
+		return nil, fmt.Errorf("invalid Hill modulus N=%d", n)
+	}
+
+	m := &HillMatrix{size, n, make([]int, len(values))}
+	for i, v := range values {
+		m.cells[i] = modular.Modulo(v, n)
+	}
+
+	if det := m.Determinant(); det == 0 {
+		return nil, fmt.Errorf("%w modulo %d: its determinant is 0", ErrNotInvertible, n)
+	} else if !modular.AreCoprime(det, n) {
+		return nil, fmt.Errorf("%w modulo %d: its determinant %d is not coprime with %d", ErrNotInvertible, n, det, n)
+	}
+
+	return m, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// number of rows (and columns), also the size of the blocks
+func (m *HillMatrix) Size() int {
+	return m.size
+}
+
+// the modulus N (alphabet size) of the matrix arithmetic
+func (m *HillMatrix) Modulus() int {
+	return m.mod
+}
+
+// the value at the given (zero-based) row & column
+func (m *HillMatrix) At(row, col int) int {
+	return m.cells[row*m.size+col]
+}
+
+// a copy of the values row by row
+func (m *HillMatrix) Values() []int {
+	return append([]int(nil), m.cells...)
+}
+
+// The determinant modulo N in the range [0..N)
+func (m *HillMatrix) Determinant() int {
+	var det int
+	if m.size == 2 {
+		det = m.At(0, 0)*m.At(1, 1) - m.At(0, 1)*m.At(1, 0)
+	} else {
+		for col := range 3 {
+			det += m.At(0, col) * m.cofactor(0, col)
+		}
+	}
+
+	return modular.Modulo(det, m.mod)
+}
+
+// The inverse matrix modulo N, the one used for decryption. The
+// modular inverse of the determinant is that of the AffineHelper.
+func (m *HillMatrix) Inverse() (*HillMatrix, error) {
+	detInv, err := modular.ModularInverse(m.Determinant(), m.mod)
+	if err != nil {
+		return nil, fmt.Errorf("%w modulo %d: %v", ErrNotInvertible, m.mod, err)
+	}
+
+	// the adjugate is the transposed matrix of cofactors
+	inverse := make([]int, len(m.cells))
+	for row := range m.size {
+		for col := range m.size {
+			inverse[col*m.size+row] = modular.Modulo(detInv*m.cofactor(row, col), m.mod)
+		}
+	}
+
+	return NewHillMatrix(inverse, m.mod)
+}
+
+// Multiply the matrix by the column vector of a block of letter
+// positions. The result is reduced modulo N.
+func (m *HillMatrix) Multiply(block []int) []int {
+	result := make([]int, m.size)
+	for row := range m.size {
+		sum := 0
+		for col := range m.size {
+			sum += m.At(row, col) * block[col]
+		}
+		result[row] = modular.Modulo(sum, m.mod)
+	}
+
+	return result
+}
+
+// implements fmt.Stringer
+func (m *HillMatrix) String() string {
+	rows := make([]string, m.size)
+	for row := range m.size {
+		rows[row] = fmt.Sprint(m.cells[row*m.size : (row+1)*m.size])
+	}
+	return fmt.Sprintf("[%s] mod %d", strings.Join(rows, " "), m.mod)
+}
+
+// A multi-line string block with the matrix, with headings & row
+// numbers as the Affine tabula is printed. Each line starts with
+// the optional leader.
+func (m *HillMatrix) GetMatrixString(leader ...string) string {
+	var sb strings.Builder
+	var pre string = ""
+	if leader != nil {
+		pre = leader[0]
+	}
+
+	sb.WriteString(pre + "   ")
+	for col := range m.size {
+		sb.WriteString(fmt.Sprintf("%4d", col))
+	}
+	sb.WriteString("\n" + pre + "   " + strings.Repeat("-", 4*m.size) + "\n")
+	for row := range m.size {
+		sb.WriteString(fmt.Sprintf("%s%2d|", pre, row))
+		for col := range m.size {
+			sb.WriteString(fmt.Sprintf("%4d", m.At(row, col)))
+		}
+		if row < m.size-1 {
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// the signed cofactor of the given cell (no modulo applied)
+func (m *HillMatrix) cofactor(row, col int) int {
+	if m.size == 2 {
+		// the minor is the diagonally opposed cell
+		value := m.At(1-row, 1-col)
+		if (row+col)%2 != 0 {
+			value = -value
+		}
+		return value
+	}
+
+	// 3x3: the cyclic order of the remaining rows & columns takes
+	// care of the sign of the cofactor.
+	r1, r2 := (row+1)%3, (row+2)%3
+	c1, c2 := (col+1)%3, (col+2)%3
+	return m.At(r1, c1)*m.At(r2, c2) - m.At(r1, c2)*m.At(r2, c1)
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
@@ -0,0 +1,260 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Playfair cipher (Charles Wheatstone, 1854) encrypts PAIRS of
+ * letters (digraphs) using a keyed square. The plain text is split
+ * in digraphs after dropping everything that is not in the square:
+ *	· a doubled letter within a digraph is split with a filler (X)
+ *	· an odd letter at the end is padded with the filler
+ *	· the alternate filler (Q) is used when the letter is the filler
+ * Each digraph is then substituted:
+ *	· same row		each letter is replaced by the one to its right
+ *	· same column	each letter is replaced by the one below it
+ *	· otherwise		each letter is replaced by the one in its own row
+ *					but in the column of the other letter (rectangle)
+ * Decryption does the reverse but fillers remain in the plain text,
+ * exactly as it happens when done by hand.
+ *-----------------------------------------------------------------*/
+package playfair
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ALG_NAME_PLAYFAIR = "Playfair"
+	ALG_CODE_PLAYFAIR = "PLAY"
+
+	DEFAULT_FILLER     rune = 'X'
+	DEFAULT_ALT_FILLER rune = 'Q'
+)
+
+var (
+	Info = ciphers.NewCipherInfo(ALG_CODE_PLAYFAIR, "1.0",
+		"Charles Wheatstone",
+		ALG_NAME_PLAYFAIR,
+		"Digraph substitution cipher with a 5x5 or 6x6 key square")
+
+	ErrFiller     = errors.New("invalid Playfair filler")
+	ErrDigraph    = errors.New("invalid Playfair ciphertext")
+	ErrBinaryFile = errors.New("Playfair is a text-only cipher, binary files are not supported")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(Info)
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type PlayfairCrypto struct {
+	alpha     *cmn.Alphabet
+	slave     *cmn.Alphabet
+	keyword   string
+	square    *PlayfairSquare
+	filler    rune
+	altFiller rune
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the Playfair cipher with the key square built from the
+ * alphabet & keyword. With English you get the classic 5x5 square
+ * (I=J), chain it with the Numbers to get the 6x6 square.
+ */
+func NewPlayfairCrypto(alpha *cmn.Alphabet, keyword string) (*PlayfairCrypto, error) {
+	square, err := NewPlayfairSquare(alpha, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlayfairCrypto{
+		alpha:     alpha,
+		slave:     nil,
+		keyword:   keyword,
+		square:    square,
+		filler:    DEFAULT_FILLER,
+		altFiller: DEFAULT_ALT_FILLER,
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *PlayfairCrypto) String() string {
+	return fmt.Sprintf("%s %dx%d key:'%s' filler:%c/%c", ALG_NAME_PLAYFAIR, c.square.Side(), c.square.Side(), c.keyword, c.filler, c.altFiller)
+}
+
+// the runes of the key square, row by row
+func (c *PlayfairCrypto) GetAlphabet() string {
+	var sb strings.Builder
+	for row := range c.square.Side() {
+		for col := range c.square.Side() {
+			sb.WriteRune(c.square.RuneAt(row, col))
+		}
+	}
+	return sb.String()
+}
+
+func (c *PlayfairCrypto) Square() *PlayfairSquare {
+	return c.square
+}
+
+// Use another master alphabet. The square is rebuilt with the same
+// keyword and the current slave alphabet (if any).
+func (c *PlayfairCrypto) WithAlphabet(alpha *cmn.Alphabet) error {
+	return c.rebuild(alpha, c.slave)
+}
+
+// Append the slave alphabet to the master to fill a bigger square,
+// i.e. English + Numbers gives the 6x6 square. Nil to remove it.
+func (c *PlayfairCrypto) WithChain(slave *cmn.Alphabet) error {
+	return c.rebuild(c.alpha, slave)
+}
+
+// Set the filler used to split doubled letters & pad an odd message
+// and the alternate filler used when the letter is the filler itself.
+func (c *PlayfairCrypto) WithFiller(filler, alt rune) error {
+	var ok1, ok2 bool
+	filler, ok1 = c.square.Normalize(filler)
+	alt, ok2 = c.square.Normalize(alt)
+	if !ok1 || !ok2 || filler == alt {
+		return fmt.Errorf("%w: '%c' & '%c' must be different runes of the square", ErrFiller, filler, alt)
+	}
+
+	c.filler = filler
+	c.altFiller = alt
+	return nil
+}
+
+// Encode plain text. Runes that are not part of the square are removed
+// and the result is uppercase with no separation between digraphs.
+func (c *PlayfairCrypto) Encode(plain string) (string, error) {
+	letters := c.letters(plain)
+	var sb strings.Builder
+	for i := 0; i < len(letters); {
+		first := letters[i]
+		second := c.fillerFor(first)
+		if i+1 < len(letters) && letters[i+1] != first {
+			second = letters[i+1]
+			i += 2
+		} else {
+			i += 1 // doubled letter (or last one), the 2nd starts the next digraph
+		}
+
+		a, b := c.substitute(first, second, +1)
+		sb.WriteRune(a)
+		sb.WriteRune(b)
+	}
+
+	return sb.String(), nil
+}
+
+// Decode Playfair ciphertext. Runes that are not part of the square
+// (i.e. the separators of NGram formatting) are ignored. The fillers
+// are NOT removed from the plain text.
+func (c *PlayfairCrypto) Decode(cipher string) (string, error) {
+	letters := c.letters(cipher)
+	if len(letters)%2 != 0 {
+		return "", fmt.Errorf("%w: odd number of letters (%d)", ErrDigraph, len(letters))
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(letters); i += 2 {
+		if letters[i] == letters[i+1] {
+			return "", fmt.Errorf("%w: digraph with a doubled letter '%c%c'", ErrDigraph, letters[i], letters[i+1])
+		}
+
+		a, b := c.substitute(letters[i], letters[i+1], -1)
+		sb.WriteRune(a)
+		sb.WriteRune(b)
+	}
+
+	return sb.String(), nil
+}
+
+// Encrypts a text file line by line. Each line is padded on its own.
+func (c *PlayfairCrypto) EncryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Encode)
+}
+
+// Decrypts a text file line by line.
+func (c *PlayfairCrypto) DecryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Decode)
+}
+
+func (c *PlayfairCrypto) rebuild(alpha, slave *cmn.Alphabet) error {
+	full := alpha
+	if slave != nil {
+		full = alpha.From(alpha.Chars + slave.Chars)
+	}
+
+	square, err := NewPlayfairSquare(full, c.keyword)
+	if err != nil {
+		return err
+	}
+
+	// the fillers may no longer be part of the new square
+	filler, ok1 := square.Normalize(c.filler)
+	alt, ok2 := square.Normalize(c.altFiller)
+	if !ok1 || !ok2 || filler == alt {
+		return fmt.Errorf("%w: '%c' & '%c' not usable in %s", ErrFiller, c.filler, c.altFiller, square.Name)
+	}
+
+	c.alpha = alpha
+	c.slave = slave
+	c.square = square
+	return nil
+}
+
+// the normalized runes of the text that are part of the square
+func (c *PlayfairCrypto) letters(text string) []rune {
+	letters := make([]rune, 0, len(text))
+	for _, r := range text {
+		if n, ok := c.square.Normalize(r); ok {
+			letters = append(letters, n)
+		}
+	}
+	return letters
+}
+
+func (c *PlayfairCrypto) fillerFor(r rune) rune {
+	if r == c.filler {
+		return c.altFiller
+	}
+	return c.filler
+}
+
+// apply the Playfair rules to a digraph, shift is +1 to encode and
+// -1 to decode.
+func (c *PlayfairCrypto) substitute(a, b rune, shift int) (rune, rune) {
+	rowA, colA, _ := c.square.Position(a)
+	rowB, colB, _ := c.square.Position(b)
+
+	switch {
+	case rowA == rowB:
+		return c.square.RuneAt(rowA, colA+shift), c.square.RuneAt(rowB, colB+shift)
+	case colA == colB:
+		return c.square.RuneAt(rowA+shift, colA), c.square.RuneAt(rowB+shift, colB)
+	default:
+		return c.square.RuneAt(rowA, colB), c.square.RuneAt(rowB, colA)
+	}
+}
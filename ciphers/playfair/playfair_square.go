@@ -0,0 +1,225 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The key square of the Playfair cipher. The alphabet (optionally
+ * keyword-mixed) is laid row by row on an NxN grid. The classic
+ * English square is 5x5 and merges J into I, the English+digits
+ * square is 6x6 and needs no merging.
+ *-----------------------------------------------------------------*/
+package playfair
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// the letter that disappears from the 5x5 English square...
+	MERGED_FROM rune = 'J'
+	// ...and the one that takes its place
+	MERGED_INTO rune = 'I'
+)
+
+var (
+	ErrSquareAlphabet = errors.New("alphabet does not fit in a Playfair square")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// row & column of a rune in the square
+type cell struct {
+	row int
+	col int
+}
+
+type PlayfairSquare struct {
+	Name   string
+	side   int
+	grid   [][]rune
+	where  map[rune]cell
+	merged bool // J is merged into I
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) a Playfair key square for the given alphabet mixed with the
+ * keyword (may be empty). The alphabet must have a square number of
+ * runes (25, 36...) or one more and contain both I & J, in which case
+ * J is merged into I (classic 5x5 English square).
+ */
+func NewPlayfairSquare(alpha *cmn.Alphabet, keyword string) (*PlayfairSquare, error) {
+	if alpha == nil || alpha.IsBinary() {
+		return nil, ErrSquareAlphabet
+	}
+
+	upper := alpha.Clone().ToUpper() // ToUpper() modifies the receiver
+	size := int(upper.Size())
+	side := squareSide(size)
+	merged := false
+	if side*side != size {
+		side = squareSide(size - 1)
+		if side*side != size-1 || !upper.Contains(MERGED_FROM, false) || !upper.Contains(MERGED_INTO, false) {
+			return nil, fmt.Errorf("%w: %s has %d runes", ErrSquareAlphabet, alpha.Name, size)
+		}
+
+		upper = upper.From(strings.ReplaceAll(upper.Chars, string(MERGED_FROM), ""))
+		merged = true
+	}
+
+	sq := &PlayfairSquare{
+		Name:   fmt.Sprintf("Playfair %dx%d %s", side, side, alpha.Name),
+		side:   side,
+		grid:   make([][]rune, side),
+		where:  make(map[rune]cell, side*side),
+		merged: merged,
+	}
+
+	if merged {
+		keyword = strings.ReplaceAll(strings.ToUpper(keyword), string(MERGED_FROM), string(MERGED_INTO))
+	}
+	chars := []rune(upper.Keyed(keyword).Chars)
+	for row := range side {
+		sq.grid[row] = chars[row*side : (row+1)*side]
+		for col, r := range sq.grid[row] {
+			sq.where[r] = cell{row, col}
+		}
+	}
+
+	return sq, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// number of rows (and columns) of the square
+func (s *PlayfairSquare) Side() int {
+	return s.side
+}
+
+// whether J was merged into I to fit the alphabet in the square
+func (s *PlayfairSquare) IsMerged() bool {
+	return s.merged
+}
+
+// Normalize returns the uppercase version of the rune as it appears
+// in the square (J becomes I in a merged square) and whether it is
+// part of the square at all.
+func (s *PlayfairSquare) Normalize(r rune) (rune, bool) {
+	r = unicode.ToUpper(r)
+	if s.merged && r == MERGED_FROM {
+		r = MERGED_INTO
+	}
+
+	_, found := s.where[r]
+	return r, found
+}
+
+// the rune at the given row & column. Both wrap around the square.
+func (s *PlayfairSquare) RuneAt(row, col int) rune {
+	return s.grid[wrap(row, s.side)][wrap(col, s.side)]
+}
+
+// the row & column of a (normalized) rune in the square
+func (s *PlayfairSquare) Position(r rune) (int, int, bool) {
+	c, found := s.where[r]
+	return c.row, c.col, found
+}
+
+func (s *PlayfairSquare) PrintSquare(center bool) {
+	fmt.Println(s.renderSquare(center, true))
+}
+
+// implements fmt.Stringer
+func (s *PlayfairSquare) String() string {
+	return s.renderSquare(false, false)
+}
+
+// renders the square in the style of the Tabula Recta with the row &
+// column numbers as headings.
+func (s *PlayfairSquare) renderSquare(center, boxDrawing bool) string {
+	var sb strings.Builder
+	// Prints a Row of Runes
+	rowPrinterFunc := func(row []rune) {
+		for _, char := range row {
+			sb.WriteString(fmt.Sprintf("%c ", char))
+		}
+
+		sb.WriteRune('\n')
+	}
+
+	// Generates a Space Leader to Center a string
+	const MAX_WIDTH = 80
+	centerLeaderFunc := func(length int) string {
+		leaderLength := int((MAX_WIDTH - length) / 2)
+		return strings.Repeat(" ", leaderLength)
+	}
+
+	var leader string = ""
+	if center {
+		leader = centerLeaderFunc(s.side*2 + 2)
+	}
+
+	// Print Heading
+	var bC, bH, bV rune
+	if boxDrawing {
+		bC = '\u250c' // ┌
+		bH = '\u2500' // ─
+		bV = '\u2502' // │
+	} else {
+		bC = '+'
+		bH = '-'
+		bV = '|'
+	}
+
+	title := fmt.Sprintf("%c %s %c\n", 0x00ab, s.Name, 0x00bb)
+	sb.WriteString(centerLeaderFunc(len(title)))
+	sb.WriteString(title)
+
+	header := make([]rune, s.side)
+	for i := range header {
+		header[i] = rune('1' + i)
+	}
+	sb.WriteString(leader)
+	sb.WriteString("  ")
+	rowPrinterFunc(header)
+	sb.WriteString(fmt.Sprintf("%s %c%s\n", leader, bC, strings.Repeat(string(bH), 2*s.side-1)))
+
+	for i, row := range s.grid {
+		sb.WriteString(fmt.Sprintf("%s%c%c", leader, header[i], bV))
+		rowPrinterFunc(row)
+	}
+
+	return sb.String()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// integer square root (floor)
+func squareSide(size int) int {
+	side := 0
+	for (side+1)*(side+1) <= size {
+		side++
+	}
+	return side
+}
+
+// a modulo that is never negative
+func wrap(v, n int) int {
+	return ((v % n) + n) % n
+}
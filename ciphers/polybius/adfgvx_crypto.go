@@ -0,0 +1,150 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The ADFGX (1918) & ADFGVX ciphers of Fritz Nebel, used by the
+ * German army in World War I. Each letter is replaced by the labels
+ * of its row & column in a keyed Polybius square and the resulting
+ * labels are then transposed with a (Double) Columnar transposition:
+ *	· ADFGX		5x5 square, i.e. English with J merged into I
+ *	· ADFGVX	6x6 square, i.e. English plus the digits 0-9
+ * The labels were chosen because they are very different in Morse.
+ *-----------------------------------------------------------------*/
+package polybius
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ALG_NAME_ADFGVX = "ADFGVX"
+	ALG_CODE_ADFGVX = "ADFG"
+)
+
+var (
+	InfoADFGVX = ciphers.NewCipherInfo(ALG_CODE_ADFGVX, "1.0",
+		"Fritz Nebel",
+		ALG_NAME_ADFGVX,
+		"Polybius square with ADFGX/ADFGVX labels followed by a columnar transposition")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoADFGVX)
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type AdfgvxCrypto struct {
+	squareBase
+	transposer ciphers.ITransposition
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the ADFGX or ADFGVX cipher. The square keyword mixes the
+ * square, the transposition is KEY (Columnar) or KEY1,KEY2 (Double
+ * Columnar) as in ciphers.ParseTransposition(). The labels depend on
+ * the size of the square: ADFGX for 5x5 & ADFGVX for 6x6.
+ */
+func NewAdfgvxCrypto(alpha *cmn.Alphabet, keyword, transposition string) (*AdfgvxCrypto, error) {
+	transposer, err := ciphers.ParseTransposition(transposition)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &AdfgvxCrypto{squareBase{keyword: keyword}, transposer}
+	if err = c.rebuildAdfgvx(alpha, nil); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *AdfgvxCrypto) String() string {
+	return fmt.Sprintf("%s key:'%s' %s", c.square.Labels(), c.keyword, c.transposer)
+}
+
+// the name of the actual cipher: ADFGX or ADFGVX
+func (c *AdfgvxCrypto) Name() string {
+	return c.square.Labels()
+}
+
+// the transposition applied to the labels
+func (c *AdfgvxCrypto) Transposition() ciphers.ITransposition {
+	return c.transposer
+}
+
+// Use another master alphabet. The square is rebuilt with the same
+// keyword and the current slave alphabet (if any).
+func (c *AdfgvxCrypto) WithAlphabet(alpha *cmn.Alphabet) error {
+	return c.rebuildAdfgvx(alpha, c.slave)
+}
+
+// Append the slave alphabet to the master to fill a bigger square,
+// i.e. English + Numbers gives the 6x6 ADFGVX square. Nil to remove it.
+func (c *AdfgvxCrypto) WithChain(slave *cmn.Alphabet) error {
+	return c.rebuildAdfgvx(c.alpha, slave)
+}
+
+// Encode plain text. Runes that are not part of the square are
+// removed, the labels of the remaining letters are transposed.
+func (c *AdfgvxCrypto) Encode(plain string) (string, error) {
+	return c.transposer.Execute(c.fractionate(c.letters(plain)))
+}
+
+// Decode ADFGX/ADFGVX ciphertext. Runes that are not labels (spaces,
+// NGram separators) are ignored.
+func (c *AdfgvxCrypto) Decode(cipher string) (string, error) {
+	untransposed, err := c.transposer.Inverse().Execute(c.onlyLabels(cipher))
+	if err != nil {
+		return "", err
+	}
+	return c.assemble(untransposed)
+}
+
+// Encrypts a text file line by line.
+func (c *AdfgvxCrypto) EncryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Encode)
+}
+
+// Decrypts a text file line by line.
+func (c *AdfgvxCrypto) DecryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Decode)
+}
+
+// the labels follow the size of the (chained) alphabet square
+func (c *AdfgvxCrypto) rebuildAdfgvx(alpha, slave *cmn.Alphabet) error {
+	probe, err := NewPolybiusSquare(fullAlphabet(alpha, slave), c.keyword, "")
+	if err != nil {
+		return err
+	}
+
+	switch probe.Side() {
+	case len(LABELS_ADFGX):
+		return c.rebuild(alpha, slave, LABELS_ADFGX)
+	case len(LABELS_ADFGVX):
+		return c.rebuild(alpha, slave, LABELS_ADFGVX)
+	default:
+		side := probe.Side()
+		return fmt.Errorf("%w: ADFGX needs a 5x5 and ADFGVX a 6x6 square, %s needs %dx%d", ErrSquareAlphabet, alpha.Name, side, side)
+	}
+}
@@ -0,0 +1,166 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Bifid cipher (Félix Delastelle, 1895) FRACTIONATES the letters
+ * with a keyed Polybius square. For each period (block) of letters:
+ *	· the row coordinates are written in one line and the column
+ *	  coordinates below them
+ *	· both lines are read one after the other, two by two, and each
+ *	  pair of coordinates is replaced by the letter of that cell.
+ * Each cipher letter depends on two plain letters, thus the single
+ * letter frequencies are hidden. The period 0 is the whole message.
+ *-----------------------------------------------------------------*/
+package polybius
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ALG_NAME_BIFID = "Bifid"
+	ALG_CODE_BIFID = "BFID"
+)
+
+var (
+	InfoBifid = ciphers.NewCipherInfo(ALG_CODE_BIFID, "1.0",
+		"Félix Delastelle",
+		ALG_NAME_BIFID,
+		"Fractionating cipher combining the Polybius coordinates of a period of letters")
+
+	ErrPeriod = errors.New("invalid Bifid period, it must be zero (whole message) or positive")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(InfoBifid)
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type BifidCrypto struct {
+	squareBase
+	period int // 0 is the whole message
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the Bifid cipher with the key square built from the alphabet
+ * & keyword (may be empty). The period is the size of the blocks of
+ * letters that are fractionated together, 0 for the whole message.
+ */
+func NewBifidCrypto(alpha *cmn.Alphabet, keyword string, period int) (*BifidCrypto, error) {
+	if period < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrPeriod, period)
+	}
+
+	c := &BifidCrypto{squareBase{keyword: keyword}, period}
+	if err := c.rebuild(alpha, nil, ""); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (c *BifidCrypto) String() string {
+	side := c.square.Side()
+	return fmt.Sprintf("%s %dx%d key:'%s' period:%d", ALG_NAME_BIFID, side, side, c.keyword, c.period)
+}
+
+// the size of the blocks fractionated together, 0 for the whole message
+func (c *BifidCrypto) Period() int {
+	return c.period
+}
+
+// Use another master alphabet. The square is rebuilt with the same
+// keyword and the current slave alphabet (if any).
+func (c *BifidCrypto) WithAlphabet(alpha *cmn.Alphabet) error {
+	return c.rebuild(alpha, c.slave, "")
+}
+
+// Append the slave alphabet to the master to fill a bigger square,
+// i.e. English + Numbers gives the 6x6 square. Nil to remove it.
+func (c *BifidCrypto) WithChain(slave *cmn.Alphabet) error {
+	return c.rebuild(c.alpha, slave, "")
+}
+
+// Encode plain text. Runes that are not part of the square are
+// removed and the result is uppercase.
+func (c *BifidCrypto) Encode(plain string) (string, error) {
+	return c.process(c.letters(plain), c.encodeBlock), nil
+}
+
+// Decode Bifid ciphertext. Runes that are not part of the square
+// (i.e. the separators of NGram formatting) are ignored.
+func (c *BifidCrypto) Decode(cipher string) (string, error) {
+	return c.process(c.letters(cipher), c.decodeBlock), nil
+}
+
+// Encrypts a text file line by line. Each line has its own periods.
+func (c *BifidCrypto) EncryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Encode)
+}
+
+// Decrypts a text file line by line.
+func (c *BifidCrypto) DecryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Decode)
+}
+
+// apply the block conversion period by period
+func (c *BifidCrypto) process(letters []rune, convert func([]rune, *strings.Builder)) string {
+	period := c.period
+	if period == 0 {
+		period = len(letters)
+	}
+
+	var sb strings.Builder
+	for start := 0; start < len(letters); start += period {
+		end := min(start+period, len(letters))
+		convert(letters[start:end], &sb)
+	}
+	return sb.String()
+}
+
+// rows line followed by the columns line, read two by two
+func (c *BifidCrypto) encodeBlock(block []rune, sb *strings.Builder) {
+	coords := make([]int, 2*len(block))
+	for i, r := range block {
+		coords[i], coords[len(block)+i], _ = c.square.Position(r)
+	}
+
+	for i := 0; i < len(coords); i += 2 {
+		sb.WriteRune(c.square.RuneAt(coords[i], coords[i+1]))
+	}
+}
+
+// the coordinates two by two are the rows line followed by the columns line
+func (c *BifidCrypto) decodeBlock(block []rune, sb *strings.Builder) {
+	coords := make([]int, 2*len(block))
+	for i, r := range block {
+		coords[2*i], coords[2*i+1], _ = c.square.Position(r)
+	}
+
+	for i := range block {
+		sb.WriteRune(c.square.RuneAt(coords[i], coords[len(block)+i]))
+	}
+}
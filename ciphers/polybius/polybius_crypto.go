@@ -0,0 +1,220 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Polybius cipher replaces each letter by the labels of its row
+ * & column in the key square, so HELLO becomes 23 15 31 31 34 with
+ * the classic square. It is the basis of the fractionating ciphers
+ * of this package (ADFGX/ADFGVX & Bifid) which share the handling of
+ * the key square implemented here.
+ * Like Playfair it drops everything that is not in the square and
+ * the decoded text is uppercase.
+ *-----------------------------------------------------------------*/
+package polybius
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ALG_NAME_POLYBIUS = "Polybius"
+	ALG_CODE_POLYBIUS = "POLY"
+)
+
+var (
+	Info = ciphers.NewCipherInfo(ALG_CODE_POLYBIUS, "1.0",
+		"Polybius",
+		ALG_NAME_POLYBIUS,
+		"Each letter becomes the row & column labels of a keyed square")
+
+	ErrCoordinates = errors.New("invalid Polybius coordinates")
+	ErrBinaryFile  = errors.New("the Polybius square ciphers are text-only, binary files are not supported")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(Info)
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// the key square management common to the whole Polybius family
+type squareBase struct {
+	alpha   *cmn.Alphabet
+	slave   *cmn.Alphabet
+	keyword string
+	labels  string // as requested, empty to let the square decide
+	square  *PolybiusSquare
+}
+
+type PolybiusCrypto struct {
+	squareBase
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the Polybius cipher with the key square built from the
+ * alphabet & keyword (may be empty). The labels name the rows &
+ * columns, if empty the numeric labels 1..N are used.
+ */
+func NewPolybiusCrypto(alpha *cmn.Alphabet, keyword, labels string) (*PolybiusCrypto, error) {
+	c := &PolybiusCrypto{squareBase{keyword: keyword, labels: strings.ToUpper(labels)}}
+	if err := c.rebuild(alpha, nil, c.labels); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// the runes of the key square, row by row
+func (b *squareBase) GetAlphabet() string {
+	return b.square.Runes()
+}
+
+func (b *squareBase) Square() *PolybiusSquare {
+	return b.square
+}
+
+// (re)build the square with the given alphabets & labels. Nothing
+// changes if it fails.
+func (b *squareBase) rebuild(alpha, slave *cmn.Alphabet, labels string) error {
+	square, err := NewPolybiusSquare(fullAlphabet(alpha, slave), b.keyword, labels)
+	if err != nil {
+		return err
+	}
+
+	b.alpha = alpha
+	b.slave = slave
+	b.square = square
+	return nil
+}
+
+// the normalized runes of the text that are part of the square
+func (b *squareBase) letters(text string) []rune {
+	letters := make([]rune, 0, len(text))
+	for _, r := range b.alpha.ToUpperString(text) {
+		if n, ok := b.square.Normalize(r); ok {
+			letters = append(letters, n)
+		}
+	}
+	return letters
+}
+
+// the row & column indices of the labels in the text, other runes
+// (i.e. the separators of NGram formatting) are ignored.
+func (b *squareBase) coordinates(text string) []int {
+	coords := make([]int, 0, len(text))
+	for _, r := range strings.ToUpper(text) {
+		if index := b.square.LabelIndex(r); index >= 0 {
+			coords = append(coords, index)
+		}
+	}
+	return coords
+}
+
+// only the runes of the text that are labels
+func (b *squareBase) onlyLabels(text string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(text) {
+		if b.square.LabelIndex(r) >= 0 {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// the label coordinates of the letters, two labels per letter
+func (b *squareBase) fractionate(letters []rune) string {
+	var sb strings.Builder
+	for _, r := range letters {
+		row, col, _ := b.square.Coordinates(r)
+		sb.WriteRune(row)
+		sb.WriteRune(col)
+	}
+	return sb.String()
+}
+
+// the letters of the pairs of label coordinates
+func (b *squareBase) assemble(text string) (string, error) {
+	coords := b.coordinates(text)
+	if len(coords)%2 != 0 {
+		return "", fmt.Errorf("%w: odd number of labels (%d)", ErrCoordinates, len(coords))
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(coords); i += 2 {
+		sb.WriteRune(b.square.RuneAt(coords[i], coords[i+1]))
+	}
+	return sb.String(), nil
+}
+
+// implements fmt.Stringer
+func (c *PolybiusCrypto) String() string {
+	side := c.square.Side()
+	return fmt.Sprintf("%s %dx%d key:'%s' labels:%s", ALG_NAME_POLYBIUS, side, side, c.keyword, c.square.Labels())
+}
+
+// Use another master alphabet. The square is rebuilt with the same
+// keyword, labels and the current slave alphabet (if any).
+func (c *PolybiusCrypto) WithAlphabet(alpha *cmn.Alphabet) error {
+	return c.rebuild(alpha, c.slave, c.labels)
+}
+
+// Append the slave alphabet to the master to fill a bigger square,
+// i.e. English + Numbers gives the 6x6 square. Nil to remove it.
+func (c *PolybiusCrypto) WithChain(slave *cmn.Alphabet) error {
+	return c.rebuild(c.alpha, slave, c.labels)
+}
+
+// Encode plain text. Runes that are not part of the square are
+// removed, each letter becomes its row & column labels.
+func (c *PolybiusCrypto) Encode(plain string) (string, error) {
+	return c.fractionate(c.letters(plain)), nil
+}
+
+// Decode Polybius coordinates. Runes that are not labels (spaces,
+// NGram separators) are ignored.
+func (c *PolybiusCrypto) Decode(cipher string) (string, error) {
+	return c.assemble(cipher)
+}
+
+// Encrypts a text file line by line.
+func (c *PolybiusCrypto) EncryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Encode)
+}
+
+// Decrypts a text file line by line.
+func (c *PolybiusCrypto) DecryptTextFile(input, output string) error {
+	return ciphers.ProcessTextFile(input, output, c.Decode)
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// the master alphabet with the slave (if any) appended
+func fullAlphabet(alpha, slave *cmn.Alphabet) *cmn.Alphabet {
+	if slave == nil {
+		return alpha
+	}
+	return alpha.From(alpha.Chars + slave.Chars)
+}
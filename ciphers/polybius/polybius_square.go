@@ -0,0 +1,299 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Polybius square (Polybius, ~150 BC) lays the (optionally
+ * keyword-mixed) alphabet row by row on an NxN grid, each letter is
+ * then identified by the labels of its row & column. The labels are
+ * configurable: 12345 (classic), ADFGX (5x5) or ADFGVX (6x6).
+ *	· English (26) fits in a 5x5 square by merging J into I
+ *	· the other alphabets use the smallest square they fit in and
+ *	  the empty cells are filled with digits & punctuation, so that
+ *	  every row/column combination is a valid cell (Bifid needs it).
+ *-----------------------------------------------------------------*/
+package polybius
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	LABELS_NUMERIC = "123456789"
+	LABELS_ADFGX   = "ADFGX"
+	LABELS_ADFGVX  = "ADFGVX"
+
+	// the letter that disappears from the 5x5 English square...
+	MERGED_FROM rune = 'J'
+	// ...and the one that takes its place
+	MERGED_INTO rune = 'I'
+
+	// fill the empty cells of the square (those not in the alphabet)
+	SQUARE_FILLERS = "0123456789.,;:!?'-()"
+)
+
+var (
+	ErrSquareAlphabet = errors.New("alphabet does not fit in the Polybius square")
+	ErrSquareLabels   = errors.New("invalid Polybius square labels")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// row & column of a rune in the square
+type cell struct {
+	row int
+	col int
+}
+
+type PolybiusSquare struct {
+	Name   string
+	labels []rune
+	grid   [][]rune
+	where  map[rune]cell
+	label  map[rune]int // label rune to row/column index
+	merged bool         // J is merged into I
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) a Polybius square for the given alphabet mixed with the
+ * keyword (may be empty). The labels (unique runes) name the rows &
+ * columns, hence the side of the square is the number of labels. If
+ * no labels are given the smallest square is used with the numeric
+ * labels 1..N. The empty cells are filled with SQUARE_FILLERS runes.
+ */
+func NewPolybiusSquare(alpha *cmn.Alphabet, keyword, labels string) (*PolybiusSquare, error) {
+	if alpha == nil || alpha.IsBinary() {
+		return nil, ErrSquareAlphabet
+	}
+
+	upper := alpha.Clone().ToUpper() // ToUpper() modifies the receiver
+	size := int(upper.Size())
+	side := len([]rune(labels))
+	if side == 0 {
+		side = squareSide(size)
+		if side*side < size {
+			side++
+		}
+		// the classic square merges rather than growing a row & column
+		if (side-1)*(side-1)+1 == size && canMerge(upper) {
+			side--
+		}
+		if side > len(LABELS_NUMERIC) {
+			return nil, fmt.Errorf("%w: %s has %d runes", ErrSquareAlphabet, alpha.Name, size)
+		}
+		labels = LABELS_NUMERIC[:side]
+	} else if side < 2 || !cmn.HasUniqueRunes(labels) {
+		return nil, fmt.Errorf("%w: '%s' needs 2 or more unique runes", ErrSquareLabels, labels)
+	}
+
+	merged := false
+	if size > side*side {
+		if size != side*side+1 || !canMerge(upper) {
+			return nil, fmt.Errorf("%w: %s has %d runes, a %dx%d square only %d", ErrSquareAlphabet, alpha.Name, size, side, side, side*side)
+		}
+
+		upper = upper.From(strings.ReplaceAll(upper.Chars, string(MERGED_FROM), ""))
+		keyword = strings.ReplaceAll(strings.ToUpper(keyword), string(MERGED_FROM), string(MERGED_INTO))
+		merged = true
+	}
+
+	// fill the empty cells
+	chars := []rune(upper.Keyed(keyword).Chars)
+	for _, r := range SQUARE_FILLERS {
+		if len(chars) == side*side {
+			break
+		}
+		if !strings.ContainsRune(upper.Chars, r) {
+			chars = append(chars, r)
+		}
+	}
+	if len(chars) != side*side {
+		return nil, fmt.Errorf("%w: %s has %d runes, too few for a %dx%d square", ErrSquareAlphabet, alpha.Name, size, side, side)
+	}
+
+	sq := &PolybiusSquare{
+		Name:   fmt.Sprintf("Polybius %dx%d %s", side, side, alpha.Name),
+		labels: []rune(labels),
+		grid:   make([][]rune, side),
+		where:  make(map[rune]cell, side*side),
+		label:  make(map[rune]int, side),
+		merged: merged,
+	}
+
+	for i, l := range sq.labels {
+		sq.label[l] = i
+	}
+	for row := range side {
+		sq.grid[row] = chars[row*side : (row+1)*side]
+		for col, r := range sq.grid[row] {
+			sq.where[r] = cell{row, col}
+		}
+	}
+
+	return sq, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// number of rows (and columns) of the square
+func (s *PolybiusSquare) Side() int {
+	return s.side()
+}
+
+// the row & column labels
+func (s *PolybiusSquare) Labels() string {
+	return string(s.labels)
+}
+
+// whether J was merged into I to fit the alphabet in the square
+func (s *PolybiusSquare) IsMerged() bool {
+	return s.merged
+}
+
+// the runes of the square, row by row
+func (s *PolybiusSquare) Runes() string {
+	var sb strings.Builder
+	for _, row := range s.grid {
+		sb.WriteString(string(row))
+	}
+	return sb.String()
+}
+
+// Normalize returns the rune as it appears in the square (J becomes
+// I in a merged square) and whether it is part of the square at all.
+// The rune is expected to be uppercase already.
+func (s *PolybiusSquare) Normalize(r rune) (rune, bool) {
+	if s.merged && r == MERGED_FROM {
+		r = MERGED_INTO
+	}
+
+	_, found := s.where[r]
+	return r, found
+}
+
+// the (zero-based) row & column of a (normalized) rune in the square
+func (s *PolybiusSquare) Position(r rune) (int, int, bool) {
+	c, found := s.where[r]
+	return c.row, c.col, found
+}
+
+// the rune at the given (zero-based) row & column
+func (s *PolybiusSquare) RuneAt(row, col int) rune {
+	return s.grid[row][col]
+}
+
+// Coordinates of a (normalized) rune as its row & column labels
+func (s *PolybiusSquare) Coordinates(r rune) (rune, rune, bool) {
+	c, found := s.where[r]
+	if !found {
+		return 0, 0, false
+	}
+	return s.labels[c.row], s.labels[c.col], true
+}
+
+// the (zero-based) index of a row/column label, -1 if not a label
+func (s *PolybiusSquare) LabelIndex(l rune) int {
+	if index, found := s.label[l]; found {
+		return index
+	}
+	return -1
+}
+
+func (s *PolybiusSquare) PrintSquare(center bool) {
+	fmt.Println(s.renderSquare(center, true))
+}
+
+// implements fmt.Stringer
+func (s *PolybiusSquare) String() string {
+	return s.renderSquare(false, false)
+}
+
+func (s *PolybiusSquare) side() int {
+	return len(s.labels)
+}
+
+// renders the square in the style of the Tabula Recta with the row &
+// column labels as headings.
+func (s *PolybiusSquare) renderSquare(center, boxDrawing bool) string {
+	var sb strings.Builder
+	// Prints a Row of Runes
+	rowPrinterFunc := func(row []rune) {
+		for _, char := range row {
+			sb.WriteString(fmt.Sprintf("%c ", char))
+		}
+
+		sb.WriteRune('\n')
+	}
+
+	// Generates a Space Leader to Center a string
+	const MAX_WIDTH = 80
+	centerLeaderFunc := func(length int) string {
+		leaderLength := int((MAX_WIDTH - length) / 2)
+		return strings.Repeat(" ", leaderLength)
+	}
+
+	var leader string = ""
+	if center {
+		leader = centerLeaderFunc(s.side()*2 + 2)
+	}
+
+	// Print Heading
+	var bC, bH, bV rune
+	if boxDrawing {
+		bC = '\u250c' // ┌
+		bH = '\u2500' // ─
+		bV = '\u2502' // │
+	} else {
+		bC = '+'
+		bH = '-'
+		bV = '|'
+	}
+
+	title := fmt.Sprintf("%c %s %c\n", 0x00ab, s.Name, 0x00bb)
+	sb.WriteString(centerLeaderFunc(len(title)))
+	sb.WriteString(title)
+
+	sb.WriteString(leader)
+	sb.WriteString("  ")
+	rowPrinterFunc(s.labels)
+	sb.WriteString(fmt.Sprintf("%s %c%s\n", leader, bC, strings.Repeat(string(bH), 2*s.side()-1)))
+
+	for i, row := range s.grid {
+		sb.WriteString(fmt.Sprintf("%s%c%c", leader, s.labels[i], bV))
+		rowPrinterFunc(row)
+	}
+
+	return sb.String()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// integer square root (floor)
+func squareSide(size int) int {
+	side := 0
+	for (side+1)*(side+1) <= size {
+		side++
+	}
+	return side
+}
+
+// J can be merged into I if the (uppercase) alphabet has both
+func canMerge(upper *cmn.Alphabet) bool {
+	return upper.Contains(MERGED_FROM, false) && upper.Contains(MERGED_INTO, false)
+}
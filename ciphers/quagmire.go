@@ -0,0 +1,164 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Keyword-mixed alphabets for the Tabula Recta, better known as the
+ * Quagmire family of the American Cryptogram Association:
+ *	Quagmire I	 keyed plaintext alphabet, straight ciphertext rows
+ *	Quagmire II	 straight plaintext alphabet, keyed ciphertext rows
+ *	Quagmire III both alphabets keyed with the same keyword
+ *	Quagmire IV	 both alphabets keyed with different keywords
+ * Any Tabula Recta cipher (Caesar, Bellaso, Vigenère...) can use them.
+ *-----------------------------------------------------------------*/
+package ciphers
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	QuagmireNone Quagmire = iota // straight alphabets
+	QuagmireI                    // keyed plaintext
+	QuagmireII                   // keyed ciphertext
+	QuagmireIII                  // both keyed, same keyword
+	QuagmireIV                   // both keyed, different keywords
+)
+
+// separates the plaintext & ciphertext keywords, i.e. "PLAIN:CIPHER"
+const QUAGMIRE_SEPARATOR = ":"
+
+var (
+	ErrPlainKeyword = errors.New("the Beaufort Tabula Recta has no keyed plaintext alphabet, use a single keyword or :CIPHER")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type Quagmire uint8
+
+// The keywords used to mix the plaintext (columns heading) and the
+// ciphertext (rows) alphabets of a Tabula Recta. An empty keyword
+// leaves that alphabet in its natural order.
+type QuagmireKeys struct {
+	PlainKeyword  string
+	CipherKeyword string
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// The same keyword for both alphabets (Quagmire III)
+func NewQuagmireKeys(keyword string) QuagmireKeys {
+	return QuagmireKeys{keyword, keyword}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (q Quagmire) String() string {
+	return [...]string{"Straight", "Quagmire I", "Quagmire II", "Quagmire III", "Quagmire IV"}[q]
+}
+
+// Type tells which member of the Quagmire family the keywords produce.
+func (k QuagmireKeys) Type() Quagmire {
+	switch {
+	case k.PlainKeyword == "" && k.CipherKeyword == "":
+		return QuagmireNone
+	case k.CipherKeyword == "":
+		return QuagmireI
+	case k.PlainKeyword == "":
+		return QuagmireII
+	case k.PlainKeyword == k.CipherKeyword:
+		return QuagmireIII
+	default:
+		return QuagmireIV
+	}
+}
+
+func (k QuagmireKeys) IsZero() bool {
+	return k.Type() == QuagmireNone
+}
+
+// implements fmt.Stringer
+func (k QuagmireKeys) String() string {
+	switch k.Type() {
+	case QuagmireNone:
+		return QuagmireNone.String()
+	case QuagmireI:
+		return fmt.Sprintf("%s (%s)", QuagmireI, k.PlainKeyword)
+	case QuagmireII, QuagmireIII:
+		return fmt.Sprintf("%s (%s)", k.Type(), k.CipherKeyword)
+	default:
+		return fmt.Sprintf("%s (%s%s%s)", QuagmireIV, k.PlainKeyword, QUAGMIRE_SEPARATOR, k.CipherKeyword)
+	}
+}
+
+// Check that the keywords only have runes of the alphabet. Keyed()
+// ignores the others, but that is most likely a typing mistake.
+func (k QuagmireKeys) Check(alpha *cmn.Alphabet) error {
+	for _, keyword := range []string{k.PlainKeyword, k.CipherKeyword} {
+		for _, r := range keyword {
+			if !alpha.Contains(r, cmn.CaseInsensitive) {
+				return fmt.Errorf("keyword '%s' has '%c' which is not part of the %s alphabet", keyword, r, alpha.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckRowsOnly is for the Beaufort reading of the Tabula Recta, which
+// only uses the rows: a plaintext keyword of its own would be ignored.
+func (k QuagmireKeys) CheckRowsOnly() error {
+	if k.PlainKeyword != "" && k.PlainKeyword != k.CipherKeyword {
+		return fmt.Errorf("%w: %s", ErrPlainKeyword, k)
+	}
+
+	return nil
+}
+
+// Plain is the keyed plaintext alphabet heading the Tabula Recta columns
+func (k QuagmireKeys) Plain(alpha *cmn.Alphabet) *cmn.Alphabet {
+	if k.PlainKeyword == "" {
+		return alpha
+	}
+	return alpha.Keyed(k.PlainKeyword)
+}
+
+// Cipher is the keyed ciphertext alphabet the Tabula Recta rows are made of
+func (k QuagmireKeys) Cipher(alpha *cmn.Alphabet) *cmn.Alphabet {
+	if k.CipherKeyword == "" {
+		return alpha
+	}
+	return alpha.Keyed(k.CipherKeyword)
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ParseQuagmireKeys parses a keyword specification as given on the CLI:
+//
+//	"KEYWORD"			Quagmire III (both alphabets with the same keyword)
+//	"KEYWORD:"			Quagmire I (keyed plaintext)
+//	":KEYWORD"			Quagmire II (keyed ciphertext)
+//	"PLAIN:CIPHER"		Quagmire IV
+func ParseQuagmireKeys(spec string) QuagmireKeys {
+	spec = strings.TrimFunc(spec, unicode.IsSpace)
+	if plain, cipher, found := strings.Cut(spec, QUAGMIRE_SEPARATOR); found {
+		return QuagmireKeys{strings.TrimSpace(plain), strings.TrimSpace(cipher)}
+	}
+
+	return NewQuagmireKeys(spec)
+}
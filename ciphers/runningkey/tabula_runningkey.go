@@ -0,0 +1,172 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Running Key cipher using a Tabula Recta. It works like Vigenère's
+ * (Bellaso) but the key is as long as the message because it is
+ * taken from a book held by both parties, starting at an agreed
+ * chapter/line/character offset.
+ *-----------------------------------------------------------------*/
+package runningkey
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/files"
+	"os"
+)
+
+/* ----------------------------------------------------------------
+ *						G l o b a l s
+ *-----------------------------------------------------------------*/
+var (
+	Info = ciphers.NewCipherInfo(crypto.ALG_CODE_RUNNINGKEY, "1.0",
+		"Running Key",
+		crypto.ALG_NAME_RUNNINGKEY,
+		"Polyalphabetic cipher with a key taken from a book")
+)
+
+/* ----------------------------------------------------------------
+ *				M o d u l e   I n i t i a l i z a t i o n
+ *-----------------------------------------------------------------*/
+func init() {
+	ciphers.RegisterCipher(Info)
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ciphers.ICipher = (*RunningKeyTabulaRecta)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type RunningKeyTabulaRecta struct {
+	caesar.CaesarTabulaRecta
+	book *crypto.RunningKeySequencer
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) Running Key Cipher using a Tabula Recta that supports ASCII
+ * and foreign (UTF8) alphabets. Has an Extended Numeric alphabet.
+ * · The key sequencer must have been created with the same alphabet.
+ * · Always follow it with a call to VerifyKey() prior to encoding/decoding.
+ * · follow with WithChain() to chain with supplemental alphabets.
+ * · It does case-folding by default, so it handles & preserves upper/lowercase
+ */
+func NewRunningKeyTabulaRecta(alphabet *cmn.Alphabet, book *crypto.RunningKeySequencer) *RunningKeyTabulaRecta {
+	base := caesar.NewCaesarTabulaRecta(alphabet, alphabet.GetRuneAt(0))
+	base.WithSequencer(book)
+
+	reader := &RunningKeyTabulaRecta{*base, book}
+	reader.WithChain(ciphers.NewTabulaRecta(cmn.NUMBERS_DISK_EXT, true))
+
+	return reader
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (cx *RunningKeyTabulaRecta) String() string {
+	return crypto.ALG_NAME_RUNNINGKEY
+}
+
+// WithAlphabet() replaces the MAIN (primary) alphabet. The book is
+// filtered again because the key stream depends on the alphabet.
+func (cx *RunningKeyTabulaRecta) WithAlphabet(alphabet *cmn.Alphabet) ciphers.ICipher {
+	if err := cx.book.Rebuild(alphabet); err != nil {
+		mlog.ErrorE(err)
+	}
+
+	cx.CaesarTabulaRecta.WithAlphabet(alphabet)
+	return cx
+}
+
+// KeyDemand counts how many key runes are needed to process the text,
+// that is, the number of runes present in the master or slave alphabets.
+func (cx *RunningKeyTabulaRecta) KeyDemand(text string) int {
+	count := 0
+	for _, r := range text {
+		if _, _, err := cx.FindRune(r); err == nil {
+			count++
+		}
+	}
+
+	return count
+}
+
+// CheckKeyLength verifies that the book provides enough key runes to
+// process the text. Call it BEFORE encoding/decoding to avoid a
+// partially processed output.
+func (cx *RunningKeyTabulaRecta) CheckKeyLength(text string) error {
+	return cx.checkDemand(cx.KeyDemand(text))
+}
+
+// CheckKeyLengthFile is the same as CheckKeyLength for a text file or,
+// if isBinary is set, for a binary file where every byte needs a key.
+func (cx *RunningKeyTabulaRecta) CheckKeyLengthFile(filename string, isBinary bool) error {
+	if isBinary {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		// the file header (if any) of a ciphered file isn't enciphered
+		header, err := files.PeekFileHeader(filename)
+		if err != nil {
+			return err
+		}
+		demand := int(info.Size())
+		if header != nil {
+			demand -= header.Size()
+		}
+		return cx.checkDemand(demand)
+	}
+
+	fd, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	// a bufio.Reader, unlike a Scanner, has no limit on the line length
+	demand := 0
+	reader := bufio.NewReader(fd)
+	for done := false; !done; {
+		var line string
+		if line, err = reader.ReadString('\n'); err == io.EOF {
+			done = true
+		} else if err != nil {
+			return err
+		}
+		demand += cx.KeyDemand(line)
+	}
+
+	return cx.checkDemand(demand)
+}
+
+// Err reports whether the last operation ran out of key stream.
+func (cx *RunningKeyTabulaRecta) Err() error {
+	return cx.book.Err()
+}
+
+func (cx *RunningKeyTabulaRecta) checkDemand(demand int) error {
+	if demand > cx.book.Capacity() {
+		return fmt.Errorf("%w: the message needs %d key runes but %s provides only %d",
+			crypto.ErrRunningKeyExhausted, demand, cx.book.GetKeyInfo(), cx.book.Capacity())
+	}
+
+	return nil
+}
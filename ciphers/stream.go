@@ -0,0 +1,316 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Streaming encryption. A cipher's crypto stream en/decrypts a message
+ * chunk by chunk, its key sequence goes on from one chunk to the next
+ * as if the whole stream were a single message. The CipherWriter and
+ * CipherReader wrap any io.Writer/io.Reader (files, network streams,
+ * archives, stdin) with a crypto stream:
+ *	w := ciphers.NewEncryptingWriter(os.Stdout, cipher)
+ *	io.Copy(w, os.Stdin)
+ *	w.Close()
+ * Text streams are UTF-8, a rune split between two chunks is carried
+ * over to the next one. Binary streams are processed byte by byte.
+ *-----------------------------------------------------------------*/
+package ciphers
+
+import (
+	"errors"
+	"io"
+	"lordofscripts/caesarx/app/mlog"
+	"os"
+	"unicode/utf8"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	STREAM_BUFFER_SIZE int = 4096
+)
+
+var (
+	ErrStreamClosed = errors.New("write to a closed crypto stream")
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ io.WriteCloser = (*CipherWriter)(nil)
+var _ io.ReadCloser = (*CipherReader)(nil)
+
+// The running en/decryption of a message, chunk after chunk.
+type ICryptoStream interface {
+	// en/decrypts the next chunk: whole runes in a text stream, any
+	// bytes in a binary stream.
+	Next(chunk []byte) ([]byte, error)
+	// whether the stream is binary rather than UTF-8 text
+	IsBinary() bool
+	// ends the stream, the cipher is then ready for another message
+	Close() error
+}
+
+// A cipher that en/decrypts streams. Only one stream at a time, the
+// cipher's key sequencer is that of the stream until it is closed.
+type IStreamCipher interface {
+	NewStream(decrypt, binary bool) ICryptoStream
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// An io.WriteCloser that en/decrypts what is written to it
+type CipherWriter struct {
+	w      io.Writer
+	stream ICryptoStream
+	carry  []byte // the incomplete rune at the end of the last text chunk
+	closed bool
+}
+
+// An io.ReadCloser that en/decrypts what is read from it
+type CipherReader struct {
+	r      io.Reader
+	stream ICryptoStream
+	buffer []byte
+	carry  []byte // the incomplete rune at the end of the last text chunk
+	out    []byte // processed but not yet read
+	err    error  // that of the underlying reader, io.EOF when done
+}
+
+/* ----------------------------------------------------------------
+ *						C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// (Ctor) a writer that passes what is written through the crypto stream
+// on to w. It must be closed to flush the stream.
+func NewCipherWriter(w io.Writer, stream ICryptoStream) *CipherWriter {
+	return &CipherWriter{
+		w:      w,
+		stream: stream,
+		carry:  nil,
+		closed: false,
+	}
+}
+
+// (Ctor) a reader that passes what it reads from r through the crypto stream
+func NewCipherReader(r io.Reader, stream ICryptoStream) *CipherReader {
+	return &CipherReader{
+		r:      r,
+		stream: stream,
+		buffer: make([]byte, STREAM_BUFFER_SIZE),
+		carry:  nil,
+		out:    nil,
+		err:    nil,
+	}
+}
+
+// (Ctor) a writer that encrypts the UTF-8 text written to it into w
+func NewEncryptingWriter(w io.Writer, cipher IStreamCipher) *CipherWriter {
+	return NewCipherWriter(w, cipher.NewStream(false, false))
+}
+
+// (Ctor) a writer that decrypts the UTF-8 text written to it into w
+func NewDecryptingWriter(w io.Writer, cipher IStreamCipher) *CipherWriter {
+	return NewCipherWriter(w, cipher.NewStream(true, false))
+}
+
+// (Ctor) a writer that encrypts the bytes written to it into w
+func NewBinaryEncryptingWriter(w io.Writer, cipher IStreamCipher) *CipherWriter {
+	return NewCipherWriter(w, cipher.NewStream(false, true))
+}
+
+// (Ctor) a writer that decrypts the bytes written to it into w
+func NewBinaryDecryptingWriter(w io.Writer, cipher IStreamCipher) *CipherWriter {
+	return NewCipherWriter(w, cipher.NewStream(true, true))
+}
+
+// (Ctor) a reader of the encrypted UTF-8 text read from r
+func NewEncryptingReader(r io.Reader, cipher IStreamCipher) *CipherReader {
+	return NewCipherReader(r, cipher.NewStream(false, false))
+}
+
+// (Ctor) a reader of the decrypted UTF-8 text read from r
+func NewDecryptingReader(r io.Reader, cipher IStreamCipher) *CipherReader {
+	return NewCipherReader(r, cipher.NewStream(true, false))
+}
+
+// (Ctor) a reader of the encrypted bytes read from r
+func NewBinaryEncryptingReader(r io.Reader, cipher IStreamCipher) *CipherReader {
+	return NewCipherReader(r, cipher.NewStream(false, true))
+}
+
+// (Ctor) a reader of the decrypted bytes read from r
+func NewBinaryDecryptingReader(r io.Reader, cipher IStreamCipher) *CipherReader {
+	return NewCipherReader(r, cipher.NewStream(true, true))
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// (io.Writer) en/decrypts p into the underlying writer. In a text stream
+// an incomplete rune at the end of p waits for the next Write.
+func (cw *CipherWriter) Write(p []byte) (int, error) {
+	if cw.closed {
+		return 0, ErrStreamClosed
+	}
+
+	chunk := p
+	if !cw.stream.IsBinary() {
+		if len(cw.carry) != 0 {
+			chunk = append(cw.carry, p...)
+		}
+		cut := fullRunes(chunk)
+		cw.carry = append([]byte(nil), chunk[cut:]...)
+		chunk = chunk[:cut]
+	}
+
+	if err := cw.write(chunk); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+/**
+ * (io.Closer) flushes what is left of the text (an invalid UTF-8 tail)
+ * and ends the stream. The underlying writer is not closed.
+ */
+func (cw *CipherWriter) Close() error {
+	if cw.closed {
+		return nil
+	}
+	cw.closed = true
+
+	err := cw.write(cw.carry)
+	cw.carry = nil
+	if errC := cw.stream.Close(); err == nil {
+		err = errC
+	}
+
+	return err
+}
+
+func (cw *CipherWriter) write(chunk []byte) error {
+	if len(chunk) == 0 {
+		return nil
+	}
+
+	out, err := cw.stream.Next(chunk)
+	if err == nil {
+		_, err = cw.w.Write(out)
+	}
+
+	return err
+}
+
+// (io.Reader) reads the en/decrypted stream. The crypto stream ends
+// when the underlying reader does.
+func (cr *CipherReader) Read(p []byte) (int, error) {
+	for len(cr.out) == 0 {
+		if cr.err != nil {
+			return 0, cr.err
+		}
+
+		n, errR := cr.r.Read(cr.buffer)
+		chunk := append(cr.carry, cr.buffer[:n]...)
+		cr.carry = nil
+		if errR == nil && !cr.stream.IsBinary() {
+			cut := fullRunes(chunk)
+			cr.carry = append([]byte(nil), chunk[cut:]...)
+			chunk = chunk[:cut]
+		}
+
+		if len(chunk) != 0 {
+			out, err := cr.stream.Next(chunk)
+			if err != nil {
+				errR, out = err, nil
+			}
+			cr.out = out
+		}
+
+		if errR != nil {
+			cr.err = errR
+			if err := cr.stream.Close(); err != nil && errR == io.EOF {
+				cr.err = err
+			}
+		}
+	}
+
+	n := copy(p, cr.out)
+	cr.out = cr.out[n:]
+	return n, nil
+}
+
+// (io.Closer) ends the stream before the underlying reader does. The
+// underlying reader is not closed.
+func (cr *CipherReader) Close() error {
+	if cr.err != nil {
+		return nil
+	}
+
+	cr.err = ErrStreamClosed
+	cr.out = nil
+	return cr.stream.Close()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+/**
+ * Passes the input through the crypto stream into the (new) output file.
+ * The preamble (if any) writes what goes before the stream, like the
+ * FileHeader of binary files. On any error the output file is removed.
+ */
+func StreamFile(input io.Reader, output string, stream ICryptoStream, preamble func(io.Writer) error) error {
+	fdOut, err := os.Create(output)
+	if err != nil {
+		mlog.ErrorE(err)
+		stream.Close()
+		return err
+	}
+
+	if preamble != nil {
+		err = preamble(fdOut)
+	}
+
+	writer := NewCipherWriter(fdOut, stream)
+	if err == nil {
+		_, err = io.Copy(writer, input)
+	}
+	if errC := writer.Close(); err == nil {
+		err = errC
+	}
+
+	// in Windows a file must be closed prior to Remove...
+	if errC := fdOut.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		mlog.ErrorE(err)
+		os.Remove(output)
+	}
+
+	return err
+}
+
+// the length of the UTF-8 text up to the incomplete rune at its end (if any)
+func fullRunes(text []byte) int {
+	// a rune has at most utf8.UTFMax bytes, look for its start
+	for back := 1; back <= utf8.UTFMax && back <= len(text); back++ {
+		at := len(text) - back
+		if utf8.RuneStart(text[at]) {
+			if utf8.FullRune(text[at:]) {
+				return len(text)
+			}
+			return at
+		}
+	}
+
+	return len(text)
+}
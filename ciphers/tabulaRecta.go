@@ -32,9 +32,11 @@ type ITabulaRecta interface {
 	fmt.Stringer
 	cmn.IRuneLocalizer
 	GetName() string
+	Size() int
 	HasRune(r rune) (bool, int)
 	EncodeRune(r, key rune) rune
 	DecodeRune(r, key rune) rune
+	BeaufortRune(r, key rune) rune
 	EncodeRuneRaw(rune, int, int) rune
 	DecodeRuneRaw(rune, int) rune
 	IsCaseInsensitive() bool
@@ -68,6 +70,7 @@ type TabulaRecta struct {
 	caseFolding bool
 	alphabet    string
 	tabula      [][]rune
+	header      []rune // plaintext alphabet heading the columns
 	specialCase *cmn.SpecialCaseHandler
 }
 
@@ -108,6 +111,7 @@ func NewTabulaRecta(alphabetO *cmn.Alphabet, foldCase bool) *TabulaRecta {
 		caseFolding: foldCase,
 		alphabet:    letters,
 		tabula:      nil,
+		header:      nil,
 		specialCase: specialCase,
 	}
 
@@ -115,6 +119,27 @@ func NewTabulaRecta(alphabetO *cmn.Alphabet, foldCase bool) *TabulaRecta {
 	return tr
 }
 
+/**
+ * Create a new TabulaRecta with keyword-mixed alphabets (Quagmire I-IV).
+ * The rows are made of the keyed ciphertext alphabet and the columns
+ * are headed by the keyed plaintext alphabet. With empty keywords it is
+ * the same as NewTabulaRecta().
+ * NOTE: the Beaufort reading of the table only uses the rows (it stays
+ * reciprocal), a plaintext keyword of its own is rejected for it, see
+ * QuagmireKeys.CheckRowsOnly().
+ * @param alphabet (*cmn.Alphabet) The encoding alphabet in natural order
+ * @param foldCase (bool) true to preserve (upper/lower)
+ * @param keys (QuagmireKeys) The plaintext & ciphertext keywords
+ */
+func NewKeyedTabulaRecta(alphabet *cmn.Alphabet, foldCase bool, keys QuagmireKeys) *TabulaRecta {
+	tr := NewTabulaRecta(keys.Cipher(alphabet), foldCase)
+	if keys.PlainKeyword != "" {
+		tr.header = NewTabulaRecta(keys.Plain(alphabet), foldCase).header
+	}
+
+	return tr
+}
+
 /* ----------------------------------------------------------------
  *							M e t h o d s
  *-----------------------------------------------------------------*/
@@ -139,6 +164,8 @@ func (t *TabulaRecta) generateTabulaRecta() {
 		}
 		t.tabula[i] = row
 	}
+
+	t.header = t.tabula[0]
 }
 
 func (t *TabulaRecta) renderTabulaRecta(center, boxDrawing bool) string {
@@ -182,7 +209,7 @@ func (t *TabulaRecta) renderTabulaRecta(center, boxDrawing bool) string {
 
 	sb.WriteString(leader)
 	sb.WriteString("  ")
-	rowPrinterFunc(t.tabula[0])
+	rowPrinterFunc(t.header)
 	sb.WriteString(fmt.Sprintf("%s %c%s\n", leader, bC, strings.Repeat(string(bH), 2*len(t.tabula[0])-1)))
 
 	for _, row := range t.tabula {
@@ -234,7 +261,7 @@ func (t *TabulaRecta) renderTape(keyShift int, center, boxDrawing bool) string {
 
 	sb.WriteString(leader)
 	sb.WriteString("  ")
-	rowPrinterFunc(t.tabula[0])
+	rowPrinterFunc(t.header)
 	sb.WriteString(fmt.Sprintf("%s %c%s\n", leader, bC, strings.Repeat(string(bH), 2*len(t.tabula[0])-1)))
 
 	sb.WriteString(fmt.Sprintf("%s%c%c", leader, t.tabula[keyShift][0], bV))
@@ -256,6 +283,17 @@ func (t *TabulaRecta) rowContains(rowNum int, target rune) (bool, int) {
 	return false, -1
 }
 
+// locate the column of a plaintext rune. It is the same as row #0
+// unless the plaintext alphabet is keyed (Quagmire I, III & IV)
+func (t *TabulaRecta) headerContains(target rune) (bool, int) {
+	for pos, r := range t.header {
+		if r == target {
+			return true, pos
+		}
+	}
+	return false, -1
+}
+
 /**
  * Checks if the target & key runes must be case-folded either through the
  * default library functions, or the special case rules of the alphabet.
@@ -306,6 +344,11 @@ func (t *TabulaRecta) GetName() string {
 	return t.Name
 }
 
+// the number of runes in the alphabet of the tabula
+func (t *TabulaRecta) Size() int {
+	return utf8.RuneCountInString(t.alphabet)
+}
+
 func (t *TabulaRecta) HasRune(r rune) (bool, int) {
 	if t.caseFolding {
 		// The tabula directory is uppercase; therefore, convert param
@@ -357,7 +400,7 @@ func (t *TabulaRecta) EncodeRune(r, key rune) rune {
 
 	//if exists, keyIndex := t.rowContains(0, key); exists {
 	if exists, keyIndex := t.HasRune(key); exists {
-		if exists, column := t.rowContains(0, r); exists {
+		if exists, column := t.headerContains(r); exists {
 			result = t.tabula[keyIndex][column]
 		}
 	} else {
@@ -482,7 +525,7 @@ func (t *TabulaRecta) DecodeRune(r, key rune) rune {
 	if exists, keyIndex := t.HasRune(key); exists {
 		exists, column := t.rowContains(keyIndex, r)
 		if exists {
-			result = t.tabula[0][column]
+			result = t.header[column]
 		}
 	} else {
 		mlog.WarnT("Key absent in alphabet", mlog.String("Alpha", t.Name), mlog.Rune("Rune", key))
@@ -499,6 +542,36 @@ func (t *TabulaRecta) DecodeRune(r, key rune) rune {
 	return result
 }
 
+/**
+ * Beaufort substitution of a rune with the key using the current Tabula
+ * Recta. The result is the key minus the rune (C = K - P) which makes
+ * the operation self-reciprocal: the same call encodes and decodes.
+ * @param r (rune) character to encode/decode
+ * @param key (rune) encoding key
+ * @returns (rune) substituted rune, or r if not found.
+ */
+func (t *TabulaRecta) BeaufortRune(r, key rune) rune {
+	var result rune = r // pass-through if not found
+
+	rN, keyN, isConvertedCase := t.normalizeRuneCase(r, key)
+
+	// locate the row of the rune and within it the column of the key,
+	// the header of that column is the difference K - P.
+	if exists, rowIdx := t.HasRune(rN); exists {
+		if exists, column := t.rowContains(rowIdx, keyN); exists {
+			result = t.tabula[0][column]
+		} else {
+			mlog.WarnT("Key absent in alphabet", mlog.String("Alpha", t.Name), mlog.Rune("Rune", key))
+		}
+	}
+
+	if isConvertedCase { // respect input text's upper/lowercase
+		result = t.denormalizeRuneCase(result)
+	}
+
+	return result
+}
+
 func (t *TabulaRecta) DecodeRuneRaw(r rune, rowIdx int) rune {
 	var result rune = r
 
@@ -513,7 +586,7 @@ func (t *TabulaRecta) DecodeRuneRaw(r rune, rowIdx int) rune {
 
 	// Middle
 	if exists, colIdx := t.rowContains(rowIdx, r); exists {
-		result = t.tabula[0][colIdx]
+		result = t.header[colIdx]
 	} else {
 		// This would never happen UNLESS someone edits TabulaCaesar(Command) and didn't
 		// check for rune's presence in the slave alphabet. But this check is here
@@ -561,7 +634,7 @@ func (t *TabulaRecta) DecodeRuneByShiftOld(r rune, shift int) rune { // @audit d
 
 	// Middle
 	if exists, column := t.rowContains(shift, r); exists {
-		result = t.tabula[0][column]
+		result = t.header[column]
 	} else {
 		mlog.ErrorT("reference Slave alphabet does not contain rune", mlog.String("Alpha", t.Name), mlog.Rune("Rune", r))
 	}
@@ -27,9 +27,11 @@ type IGTabulaRecta[E rune | byte] interface {
 	fmt.Stringer
 	cmn.IRuneLocalizer
 	GetName() string
+	Size() int
 	HasRune(r E) (bool, int)
 	EncodeRune(r, key E) E
 	DecodeRune(r, key E) E
+	BeaufortRune(r, key E) E
 	EncodeRuneRaw(E, int, int) E
 	DecodeRuneRaw(E, int) E
 	IsCaseInsensitive() bool
@@ -84,6 +86,11 @@ func (t *BinaryTabulaRecta) GetName() string {
 	return t.Name
 }
 
+// the number of values in the binary alphabet (256)
+func (t *BinaryTabulaRecta) Size() int {
+	return len(t.tabula[0])
+}
+
 func (t *BinaryTabulaRecta) HasRune(r byte) (bool, int) {
 	var exists bool = false
 	var where int = -1
@@ -243,6 +250,20 @@ func (t *BinaryTabulaRecta) DecodeRune(r, key byte) byte {
 	return result
 }
 
+// Beaufort substitution of a byte using the key (C = K - P). It is
+// self-reciprocal therefore it is used for both encoding and decoding.
+func (t *BinaryTabulaRecta) BeaufortRune(r, key byte) byte {
+	var result byte = r
+
+	if exists, rowIdx := t.HasRune(r); exists {
+		if exists, column := t.rowContains(rowIdx, key); exists {
+			result = t.tabula[0][column]
+		}
+	}
+
+	return result
+}
+
 func (t *BinaryTabulaRecta) DecodeRuneRaw(r byte, rowIdx int) byte {
 	var result byte = r
 
@@ -0,0 +1,85 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Line by line text files, for the ciphers that convert whole lines
+ * (Playfair, Hill, Polybius, Bifid, ADFGVX & Enigma) and the Tabula
+ * Recta ciphers, whose key positions start over with every line as in
+ * v1. The file layout survives the round trip: the line breaks (LF or
+ * CRLF) are copied as they are, a missing final line break stays
+ * missing and the lines have no length limit.
+ *-----------------------------------------------------------------*/
+package ciphers
+
+import (
+	"bufio"
+	"io"
+	"lordofscripts/caesarx/app/mlog"
+	"os"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// Converts the input text file line by line into the (new) output file.
+// If there was an error of any kind, the unfinished output file is
+// deleted from the filesystem.
+func ProcessTextFile(input, output string, convert func(string) (string, error)) error {
+	fdIn, err := os.Open(input)
+	if err != nil {
+		mlog.ErrorE(err)
+		return err
+	}
+	defer fdIn.Close()
+
+	fdOut, err := os.Create(output)
+	if err != nil {
+		mlog.ErrorE(err)
+		return err
+	}
+
+	reader := bufio.NewReader(fdIn)
+	writer := bufio.NewWriter(fdOut)
+	for done := false; !done && err == nil; {
+		var line, lineOut string
+		if line, err = reader.ReadString('\n'); err == io.EOF {
+			done, err = true, nil
+		}
+		if err != nil || len(line) == 0 {
+			continue
+		}
+
+		content, eol := SplitLineBreak(line)
+		if lineOut, err = convert(content); err == nil {
+			_, err = writer.WriteString(lineOut + eol)
+		}
+	}
+
+	if err == nil {
+		err = writer.Flush()
+	}
+	// in Windows a file must be closed prior to Remove...
+	if errC := fdOut.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		mlog.ErrorE(err)
+		os.Remove(output)
+	}
+
+	return err
+}
+
+// the line without its line break (\n or \r\n) and the line break, empty
+// for the last line of a file without a final line break.
+func SplitLineBreak(line string) (string, string) {
+	for _, eol := range []string{"\r\n", "\n"} {
+		if content, found := strings.CutSuffix(line, eol); found {
+			return content, eol
+		}
+	}
+
+	return line, ""
+}
@@ -0,0 +1,229 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Keyword-driven Columnar & Double Columnar transposition. They are
+ * post-processing commands (cmn.ICommand) to be piped after any of
+ * the substitution ciphers (superencipherment). The text is written
+ * row by row under the keyword and read column by column in the
+ * alphabetical order of the keyword letters (ties left to right).
+ * The last row may be incomplete, no padding is used, therefore ALL
+ * runes (spaces & punctuation too) survive the round trip.
+ *-----------------------------------------------------------------*/
+package ciphers
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// separates the keywords of a Double Columnar spec: "KEY1,KEY2"
+	TRANSPOSITION_SEPARATOR = ","
+)
+
+var (
+	ErrTranspositionKey = errors.New("transposition keyword needs at least 2 letters")
+)
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ ITransposition = (*ColumnarCmd)(nil)
+var _ ITransposition = (*DoubleColumnarCmd)(nil)
+
+// A transposition is a post-processing command that knows how to
+// produce the command that undoes it.
+type ITransposition interface {
+	cmn.ICommand
+	Inverse() ITransposition
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type ColumnarCmd struct {
+	keyword string
+	order   []int // column indices in reading order
+	inverse bool
+}
+
+type DoubleColumnarCmd struct {
+	first   *ColumnarCmd
+	second  *ColumnarCmd
+	inverse bool
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// Columnar transposition with the given keyword (spaces are ignored)
+func NewColumnarCommand(keyword string) (*ColumnarCmd, error) {
+	key := []rune(strings.Join(strings.Fields(keyword), ""))
+	if len(key) < 2 {
+		return nil, fmt.Errorf("%w: '%s'", ErrTranspositionKey, keyword)
+	}
+
+	order := make([]int, len(key))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return unicode.ToUpper(key[order[i]]) < unicode.ToUpper(key[order[j]])
+	})
+
+	return &ColumnarCmd{string(key), order, false}, nil
+}
+
+// The command that undoes NewColumnarCommand(keyword)
+func NewColumnarInverseCommand(keyword string) (*ColumnarCmd, error) {
+	cmd, err := NewColumnarCommand(keyword)
+	if err == nil {
+		cmd.inverse = true
+	}
+	return cmd, err
+}
+
+// Double Columnar transposition, first with key1 then with key2
+func NewDoubleColumnarCommand(key1, key2 string) (*DoubleColumnarCmd, error) {
+	first, err := NewColumnarCommand(key1)
+	if err != nil {
+		return nil, err
+	}
+	second, err := NewColumnarCommand(key2)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DoubleColumnarCmd{first, second, false}, nil
+}
+
+// The command that undoes NewDoubleColumnarCommand(key1, key2)
+func NewDoubleColumnarInverseCommand(key1, key2 string) (*DoubleColumnarCmd, error) {
+	cmd, err := NewDoubleColumnarCommand(key1, key2)
+	if err == nil {
+		cmd.inverse = true
+	}
+	return cmd, err
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements cmn.ICommand
+func (c *ColumnarCmd) Execute(input string) (string, error) {
+	if c.inverse {
+		return c.decode([]rune(input)), nil
+	}
+	return c.encode([]rune(input)), nil
+}
+
+// implements ITransposition
+func (c *ColumnarCmd) Inverse() ITransposition {
+	return &ColumnarCmd{c.keyword, c.order, !c.inverse}
+}
+
+/**
+ * A friendly representation of the command in the pipe
+ */
+func (c *ColumnarCmd) String() string {
+	if c.inverse {
+		return fmt.Sprintf("Columnar⁻¹(%s)", c.keyword)
+	}
+	return fmt.Sprintf("Columnar(%s)", c.keyword)
+}
+
+// read the columns in keyword order
+func (c *ColumnarCmd) encode(text []rune) string {
+	cols := len(c.order)
+	var sb strings.Builder
+	for _, col := range c.order {
+		for pos := col; pos < len(text); pos += cols {
+			sb.WriteRune(text[pos])
+		}
+	}
+
+	return sb.String()
+}
+
+// refill the columns in keyword order, the first len%cols columns
+// are one rune longer than the others.
+func (c *ColumnarCmd) decode(text []rune) string {
+	cols := len(c.order)
+	plain := make([]rune, len(text))
+	next := 0
+	for _, col := range c.order {
+		for pos := col; pos < len(text); pos += cols {
+			plain[pos] = text[next]
+			next++
+		}
+	}
+
+	return string(plain)
+}
+
+// implements cmn.ICommand
+func (c *DoubleColumnarCmd) Execute(input string) (string, error) {
+	first, second := c.first, c.second
+	if c.inverse {
+		first, second = second.Inverse().(*ColumnarCmd), first.Inverse().(*ColumnarCmd)
+	}
+
+	output, err := first.Execute(input)
+	if err == nil {
+		output, err = second.Execute(output)
+	}
+
+	return output, err
+}
+
+// implements ITransposition
+func (c *DoubleColumnarCmd) Inverse() ITransposition {
+	return &DoubleColumnarCmd{c.first, c.second, !c.inverse}
+}
+
+/**
+ * A friendly representation of the command in the pipe
+ */
+func (c *DoubleColumnarCmd) String() string {
+	if c.inverse {
+		return fmt.Sprintf("DoubleColumnar⁻¹(%s%s%s)", c.first.keyword, TRANSPOSITION_SEPARATOR, c.second.keyword)
+	}
+	return fmt.Sprintf("DoubleColumnar(%s%s%s)", c.first.keyword, TRANSPOSITION_SEPARATOR, c.second.keyword)
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ParseTransposition parses the CLI specification "KEY" (Columnar)
+// or "KEY1,KEY2" (Double Columnar) and returns the transposition used
+// when encoding. Use its Inverse() when decoding.
+func ParseTransposition(spec string) (ITransposition, error) {
+	// careful not to return a typed nil pointer inside the interface
+	if key1, key2, found := strings.Cut(spec, TRANSPOSITION_SEPARATOR); found {
+		if cmd, err := NewDoubleColumnarCommand(key1, key2); err != nil {
+			return nil, err
+		} else {
+			return cmd, nil
+		}
+	}
+
+	if cmd, err := NewColumnarCommand(spec); err != nil {
+		return nil, err
+	} else {
+		return cmd, nil
+	}
+}
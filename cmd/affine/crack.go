@@ -0,0 +1,162 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The -crack action. Recovers the lost A & B coefficients of a classic
+ * Affine message by trying every valid pair and ranking the decoded
+ * texts by language fitness, or solves them from two known letters.
+ *	affine -crack [-alpha ALPHABET|auto] [-top N] [-known p:c,p:c] 'text' | -F filename
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"os"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	CRACK_TOP_DEFAULT = 5  // candidates shown unless -top is given
+	CRACK_PREVIEW     = 60 // runes of the decoded text shown per candidate
+)
+
+var (
+	ErrCrackOnly   = errors.New("-crack finds the coefficients, it can't be used with -A, -B, -schedule, -secret, -tabula, -transpose or -d")
+	ErrCrackTop    = errors.New("-top must be at least 1")
+	ErrKnownTwo    = errors.New("-known needs exactly two pairs, i.e. e:x,t:q")
+	ErrKnownAlone  = errors.New("-known & -top only with -crack")
+	ErrCrackBinary = errors.New("-crack only works with text")
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *AffineCliOptions) validateCrack() error {
+	if c.CoefficientA != -1 || c.CoefficientB != -1 || c.IsPolyalphabetic() ||
+		c.ActPrintTabula || c.ActIsDecode || len(c.OptTranspose) != 0 {
+		return ErrCrackOnly
+	}
+	if c.Top < 1 {
+		return ErrCrackTop
+	}
+	if c.Common.IsBinary() {
+		return ErrCrackBinary
+	}
+
+	if len(c.OptKnown) != 0 {
+		pairs, err := cryptanalysis.ParseKnownPairs(c.OptKnown)
+		if err != nil {
+			return err
+		}
+		if len(pairs) != 2 {
+			return ErrKnownTwo
+		}
+		c.Known = pairs
+	}
+
+	if app.IsPipedInput() {
+		if c.OptUseFiles {
+			return ErrPipeOutOnly
+		}
+	} else if flag.NArg() != 1 {
+		if c.OptUseFiles {
+			return ErrFilesRequired
+		}
+		return ErrFreeTextRequired
+	} else if c.OptUseFiles {
+		c.Files = cmd.NewFileOptions(flag.Arg(0), "")
+	}
+
+	return nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteCrack recovers the Affine coefficients of the ciphered text and
+// prints the most likely candidates with a preview of the decoded text.
+func ExecuteCrack(co *cmd.CommonOptions, opts *AffineCliOptions) (int, error) {
+	text, err := crackInput(opts)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	alphas := []*cmn.Alphabet{}
+	if co.IsAutoAlphabet() {
+		for _, ref := range cryptanalysis.AllLanguageStats() {
+			alphas = append(alphas, ref.Alphabet())
+		}
+	} else {
+		alphas = append(alphas, co.Alphabet())
+	}
+
+	cracker := cryptanalysis.NewAffineCracker().WithChain(co.Numbers())
+
+	var candidates []*cryptanalysis.AffineCandidate
+	if len(opts.Known) != 0 {
+		if candidates, err = cracker.SolveKnown(text, opts.Known[0], opts.Known[1], alphas...); err != nil {
+			return z.ERR_PARAMETER, err
+		}
+		fmt.Println("Variant  : ", z.AffineCipher, "(known plain text)")
+	} else {
+		if candidates, err = cracker.Crack(text, alphas...); err != nil {
+			return z.ERR_PARAMETER, err
+		}
+		fmt.Println("Variant  : ", z.AffineCipher)
+		fmt.Printf("Tried    :  %d keys\n", len(candidates))
+	}
+
+	for i, candidate := range cryptanalysis.TopAffineCandidates(candidates, opts.Top) {
+		_, alphaName := cmn.AlphabetNameByPISO(candidate.Alphabet.LangCodeISO())
+		slave := ""
+		if candidate.Slave != nil {
+			slave = fmt.Sprintf("  (slave A=%d A'=%d)", candidate.Slave.A, candidate.Slave.Ap)
+		}
+
+		fmt.Printf("#%-2d %7.3f  -alpha %s -A %d -B %d%s\n", i+1, candidate.Score, alphaName, candidate.Params.A, candidate.Params.B, slave)
+		fmt.Printf("\t%s\n", preview(candidate.Plain))
+	}
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the ciphered text from the pipe, the -F file or the free argument
+func crackInput(opts *AffineCliOptions) (string, error) {
+	switch {
+	case app.IsPipedInput():
+		data, err := io.ReadAll(os.Stdin)
+		return string(data), err
+
+	case opts.Files != nil:
+		data, err := os.ReadFile(opts.Files.Input)
+		return string(data), err
+
+	default:
+		return flag.Arg(0), nil
+	}
+}
+
+// the first runes of the first line of the text
+func preview(text string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	if runes := []rune(line); len(runes) > CRACK_PREVIEW {
+		return string(runes[:CRACK_PREVIEW]) + "…"
+	}
+
+	return line
+}
@@ -14,14 +14,18 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	z "lordofscripts/caesarx"
 	"lordofscripts/caesarx/app"
 	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
 	"lordofscripts/caesarx/ciphers/affine"
 	"lordofscripts/caesarx/ciphers/commands"
 	"lordofscripts/caesarx/cmd"
 	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/files"
 	"os"
+	"strings"
 )
 
 /* ----------------------------------------------------------------
@@ -49,6 +53,16 @@ func init() {
 	}
 }
 
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+// the classic (A,B) and the polyalphabetic (schedule) Affine commands
+type IAffineCommand interface {
+	ciphers.ICipherCommand
+	fmt.Stringer
+}
+
 /* ----------------------------------------------------------------
  *							T y p e s
  *-----------------------------------------------------------------*/
@@ -165,31 +179,49 @@ func ExecuteMessage(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions, input
 
 	cmdCipher := setupAffineCrypto(alpha, numbers, opts)
 
-	if opts.ActIsDecode {
-		output, err = cmdCipher.Decode(input)
+	var armor *files.Armor = nil
+	if opts.ActIsDecode && opts.Armor != nil {
+		input = opts.Armor.Body
+		decoded := make([]string, 0)
+		for _, lineIn := range opts.Armor.Lines() {
+			if output, err = cmdCipher.Decode(untranspose(opts, lineIn)); err != nil {
+				break
+			}
+			decoded = append(decoded, output)
+		}
+		output = strings.Join(decoded, "\n")
+	} else if opts.ActIsDecode {
+		output, err = cmdCipher.Decode(untranspose(opts, input))
 	} else {
 		output, err = cmdCipher.Encode(input)
+		if opts.Common.WantsArmor() {
+			armor = opts.NewArmor()
+			armor.Body = output
+		}
 	}
 
 	if err != nil {
 		exitCode = z.ERR_CIPHER
 	} else {
-		//var params *affine.AffineParams
-		//params, err = affine.NewAffineParams(a, b, int(alpha.Size()))
-		paramsM, paramsS := cmdCipher.GetParams()
-
 		fmt.Println("Operation: ", namePrimaryOperation)
 		fmt.Printf("Alphabet : %s (Master/Primary)\n", nameMasterAlphabet)
 		fmt.Printf("Alphabet : %s (Slave/Secondary)\n", nameSlaveAlphabet)
-		fmt.Println("Params  M: ", paramsM)
-		fmt.Println("Params  S: ", paramsS)
+		printAffineParams(cmdCipher)
 		fmt.Println("Algorithm: ", cmdCipher.String())
+		if opts.Transposer != nil {
+			fmt.Println("Transpose: ", opts.Transposer)
+		}
 		if opts.ActIsDecode {
 			fmt.Println("Encoded  : ", input)
 			fmt.Println("Decoded  : ", output)
 		} else {
 			fmt.Println("Plain    : ", input)
-			fmt.Println("Encoded  : ", output)
+			if armor != nil {
+				fmt.Println("Encoded  :")
+				fmt.Print(armor)
+			} else {
+				fmt.Println("Encoded  : ", output)
+			}
 		}
 		fmt.Println()
 	}
@@ -207,14 +239,26 @@ func ExecutePipedMessage(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (
 	var command func(string) (string, error)
 
 	if opts.ActIsDecode {
-		command = cmdCipher.Decode
+		command = func(lineIn string) (string, error) {
+			return cmdCipher.Decode(untranspose(opts, lineIn))
+		}
 	} else {
 		command = cmdCipher.Encode
 	}
 
-	reader := bufio.NewReader(os.Stdin)
+	// an armored message was read already, the ciphered text is enclosed
+	// in an armor with -armor
+	var armor *files.Armor = nil
+	var reader io.Reader = cmd.StdinReader()
+	if opts.Armor != nil {
+		reader = strings.NewReader(opts.Armor.Text())
+	} else if !opts.ActIsDecode && opts.Common.WantsArmor() {
+		armor = opts.NewArmor()
+	}
+
 	scanner := bufio.NewScanner(reader)
 	var lineIn, lineOut string
+	encoded := make([]string, 0)
 	for scanner.Scan() {
 		lineIn = scanner.Text()
 		lineOut, err = command(lineIn)
@@ -222,11 +266,18 @@ func ExecutePipedMessage(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (
 			mlog.ErrorE(err)
 			break
 		}
-		fmt.Println(lineOut)
+		if armor != nil {
+			encoded = append(encoded, lineOut)
+		} else {
+			fmt.Println(lineOut)
+		}
 	}
 
 	if err = scanner.Err(); err != nil {
 		mlog.ErrorE(err)
+	} else if armor != nil {
+		armor.Body = strings.Join(encoded, "\n")
+		fmt.Print(armor)
 	}
 
 	if err != nil {
@@ -248,9 +299,32 @@ func ExecuteFile(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (int, err
 
 	cmdCipher := setupAffineCrypto(alpha, numbers, opts)
 
+	// the armor & the transposition of text files are undone in separate passes
+	decryptTextFile := func(src, target string) error {
+		unarmored, err := cmd.UnarmorTextFile(src)
+		if err != nil {
+			return err
+		}
+		if unarmored != src {
+			defer os.Remove(unarmored)
+			src = unarmored
+		}
+
+		if opts.Transposer == nil {
+			return cmdCipher.DecryptTextFile(src, target)
+		}
+
+		untransposed := cmn.GenerateTemporaryFileName("tempfile-affine-*")
+		defer os.Remove(untransposed)
+		if err := cmd.TransposeTextFile(src, untransposed, opts.Transposer.Inverse()); err != nil {
+			return err
+		}
+		return cmdCipher.DecryptTextFile(untransposed, target)
+	}
+
 	if opts.ActIsDecode {
 		if !opts.Common.IsBinary() {
-			err = cmdCipher.DecryptTextFile(opts.Files.Input, opts.Files.Output)
+			err = decryptTextFile(opts.Files.Input, opts.Files.Output)
 		} else {
 			err = cmdCipher.DecryptBinFile(opts.Files.Input, opts.Files.Output)
 		}
@@ -261,6 +335,13 @@ func ExecuteFile(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (int, err
 	} else {
 		if !opts.Common.IsBinary() {
 			err = cmdCipher.EncryptTextFile(opts.Files.Input)
+			if err == nil && opts.Transposer != nil {
+				outFile := cmdCipher.GetOutputFilename()
+				err = cmd.TransposeTextFile(outFile, outFile, opts.Transposer)
+			}
+			if err == nil && opts.Common.WantsArmor() {
+				err = cmd.ArmorTextFile(cmdCipher.GetOutputFilename(), opts.NewArmor())
+			}
 		} else {
 			err = cmdCipher.EncryptBinFile(opts.Files.Input)
 		}
@@ -272,14 +353,14 @@ func ExecuteFile(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (int, err
 			cipherFilename := cmdCipher.GetOutputFilename()
 
 			if !opts.Common.IsBinary() {
-				err = cmdCipher.DecryptTextFile(cipherFilename, tempOut)
+				err = decryptTextFile(cipherFilename, tempOut)
 			} else {
 				err = cmdCipher.DecryptBinFile(cipherFilename, tempOut)
 			}
 
 			// issue Verify command ONLY if the temporary decrypted file exists
 			if err == nil {
-				postCmd = cmd.NewVerifyFilesCommand(opts.Files.Input, tempOut, cmd.HashMD5)
+				postCmd = cmd.NewVerifyFilesCommand(opts.Files.Input, tempOut, cmd.HashSHA256)
 			} else {
 				tempOut = ""
 			}
@@ -289,16 +370,14 @@ func ExecuteFile(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (int, err
 	if err != nil {
 		exitCode = z.ERR_CIPHER
 	} else {
-		//var params *affine.AffineParams
-		//params, err = affine.NewAffineParams(a, b, int(alpha.Size()))
-		paramsM, paramsS := cmdCipher.GetParams()
-
 		fmt.Println("Operation: ", namePrimaryOperation)
 		fmt.Printf("Alphabet : %s (Master/Primary)\n", nameMasterAlphabet)
 		fmt.Printf("Alphabet : %s (Slave/Secondary)\n", nameSlaveAlphabet)
-		fmt.Println("Params  M: ", paramsM)
-		fmt.Println("Params  S: ", paramsS)
+		printAffineParams(cmdCipher)
 		fmt.Println("Algorithm: ", cmdCipher.String())
+		if opts.Transposer != nil {
+			fmt.Println("Transpose: ", opts.Transposer)
+		}
 		if opts.ActIsDecode {
 			fmt.Println("Encoded  : ", opts.Files.Input)
 			fmt.Println("Decoded  : ", opts.Files.Output)
@@ -309,16 +388,20 @@ func ExecuteFile(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (int, err
 
 		// any post-execution command?
 		if postCmd != nil {
-			// perform the file verification
-			if err = postCmd.Execute(); err == nil {
-				fmt.Println("\t", postCmd)
-				postCmd.GetOutput(true)
-			}
+			// perform the file verification, the round trip must give
+			// back the very same bytes
+			err = postCmd.Execute()
+			fmt.Println("\t", postCmd)
+			postCmd.GetOutput(true)
 
 			// remove temporary file
 			if tempOut != "" {
 				os.Remove(tempOut)
 			}
+			if err != nil {
+				mlog.ErrorE(err)
+				exitCode = z.ERR_POST_CMD
+			}
 		}
 
 		fmt.Println()
@@ -327,13 +410,61 @@ func ExecuteFile(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) (int, err
 	return exitCode, err
 }
 
+// print the coefficients of the classic (master & slave) or polyalphabetic Affine
+func printAffineParams(cmdCipher IAffineCommand) {
+	switch c := cmdCipher.(type) {
+	case *commands.AffineCommand:
+		paramsM, paramsS := c.GetParams()
+		fmt.Println("Params  M: ", paramsM)
+		fmt.Println("Params  S: ", paramsS)
+
+	case *commands.AffineTabulaCommand:
+		fmt.Println("Schedule : ", affine.ScheduleString(c.Schedule()))
+	}
+}
+
+// undo the transposition (if any) of the ciphered text before decoding,
+// the grouping of an NGram formatted text (-ngram) is removed beforehand.
+func untranspose(opts *AffineCliOptions, ciphered string) string {
+	if opts.Transposer == nil {
+		return ciphered
+	}
+
+	if opts.OptNgramSize > 0 {
+		ciphered, _ = cmn.NewNgramFormatter(uint8(opts.OptNgramSize), '·').Inverse().Execute(ciphered)
+	}
+	untransposed, _ := opts.Transposer.Inverse().Execute(ciphered)
+	return untransposed
+}
+
 // setupAffineCrypto does the preliminary setup for the cryptographic operation.
 // and returns an Affine cryptographic command object capable of performing the
 // actual encryption/decryption.
-func setupAffineCrypto(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) *commands.AffineCommand {
+func setupAffineCrypto(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) IAffineCommand {
 	// the main Affine cipher engine only has a language/letters alphabet and no slave/chain
 	// cmdCipher implements ciphers.ICipherCommand
-	cmdCipher := commands.NewAffineCommand(alpha, opts.CoefficientA, opts.CoefficientB)
+	var cmdCipher IAffineCommand
+	switch {
+	case len(opts.Schedule) != 0:
+		if poly := commands.NewAffineTabulaCommand(alpha, opts.Schedule); poly != nil {
+			cmdCipher = poly
+		}
+	case len(opts.OptSecret) != 0:
+		if poly := commands.NewAffineKeywordCommand(alpha, opts.OptSecret); poly != nil {
+			cmdCipher = poly
+		}
+	default:
+		cmdCipher = commands.NewAffineCommand(alpha, opts.CoefficientA, opts.CoefficientB)
+	}
+	if cmdCipher == nil { // the schedule was already logged
+		app.DieWithError(fmt.Errorf("invalid Affine schedule for the %s alphabet", alpha.Name), z.ERR_PARAMETER)
+	}
+
+	// encrypted binary files start with a FileHeader unless -noheader is given
+	if headed, ok := cmdCipher.(ciphers.IFileHeaderCommand); ok && opts.Common.IsBinary() {
+		headed.WithFileHeader(opts.Common.WantsFileHeader())
+	}
+
 	// attach any optional alphabet if any
 	nameSlaveAlphabet = "(None)"
 	if numbers != nil {
@@ -341,9 +472,21 @@ func setupAffineCrypto(alpha, numbers *cmn.Alphabet, opts *AffineCliOptions) *co
 		nameSlaveAlphabet = numbers.Name
 	}
 
-	if opts.OptNgramSize > 0 && !opts.ActIsDecode {
-		cmdNGram := cmn.NewNgramFormatter(uint8(opts.OptNgramSize), '·') // is cmn.ICommand
-		cmdCipher.WithPipe(cmdNGram)
+	// the transposition (superencipherment) goes before the NGram formatting,
+	// without the spaces the formatting removes, else it couldn't be undone.
+	if !opts.ActIsDecode {
+		var cmdNGram *cmn.NgramCmd = nil
+		if opts.OptNgramSize > 0 {
+			cmdNGram = cmn.NewNgramFormatter(uint8(opts.OptNgramSize), '·') // is cmn.ICommand
+		}
+
+		if opts.Transposer != nil && cmdNGram != nil {
+			cmdCipher.WithPipe(cmn.NewCommandChain(cmdNGram.Inverse(), opts.Transposer, cmdNGram))
+		} else if opts.Transposer != nil {
+			cmdCipher.WithPipe(opts.Transposer)
+		} else if cmdNGram != nil {
+			cmdCipher.WithPipe(cmdNGram)
+		}
 	}
 
 	if opts.ActIsDecode {
@@ -388,6 +531,7 @@ func main() {
 
 	case copts.NeedsDemo():
 		passed := affine.DemoAffine()
+		passed = commands.DemoAffineTabulaCommand(copts.Alphabet(), cmn.NUMBERS_DISK, "Attack at 10:45!") && passed
 		if !passed {
 			exitCode = z.ERR_DEMO_ERROR
 		}
@@ -395,6 +539,7 @@ func main() {
 	case copts.NeedsVersion():
 		fmt.Printf("\tAffine cipher app. v%s\n", APP_VERSION)
 		fmt.Println("\t", affine.Info)
+		fmt.Println("\t", affine.InfoPoly)
 
 	/*
 	 * Affine-specific terminal arguments
@@ -402,6 +547,12 @@ func main() {
 	case aopts.ActListCoprimes:
 		exitCode = PrintCoprimes(copts.Alphabet(), aopts.OptModulo)
 
+	case aopts.ActCrack:
+		exitCode, err = ExecuteCrack(copts, aopts)
+
+	case aopts.ActStrength:
+		exitCode, err = ExecuteStrength(copts, aopts)
+
 	case aopts.ActPrintTabula:
 		exitCode = PrintAffineTabula(copts.Alphabet(), aopts.CoefficientA, aopts.CoefficientB, aopts.ActIsDecode)
 
@@ -13,10 +13,16 @@ import (
 	z "lordofscripts/caesarx"
 	"lordofscripts/caesarx/app"
 	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/affine"
 	"lordofscripts/caesarx/ciphers/commands"
 	"lordofscripts/caesarx/cmd"
 	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/cmn/prefs"
+	"lordofscripts/caesarx/cryptanalysis"
+	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/files"
+	"path/filepath"
 )
 
 /* ----------------------------------------------------------------
@@ -24,15 +30,22 @@ import (
  *-----------------------------------------------------------------*/
 
 const (
-	FLAG_COEFF_A  = "A"
-	FLAG_NGRAM    = "ngram" // (optional) only if encrypting
-	FLAG_COEFF_B  = "B"
-	FLAG_DECODE   = "d"
-	FLAG_COPRIMES = "coprime"
-	FLAG_MODULO   = "N" // (optional) only if -coprime is given
-	FLAG_TABULA   = "tabula"
-	FLAG_FILE     = "F"      // (optional) free args are filenames and not strings
-	FLAG_VERIFY   = "verify" // (optional) ignored unless -F is used
+	FLAG_COEFF_A   = "A"
+	FLAG_NGRAM     = "ngram" // (optional) only if encrypting
+	FLAG_COEFF_B   = "B"
+	FLAG_DECODE    = "d"
+	FLAG_COPRIMES  = "coprime"
+	FLAG_MODULO    = "N" // (optional) only if -coprime is given
+	FLAG_TABULA    = "tabula"
+	FLAG_FILE      = "F"         // (optional) free args are filenames and not strings
+	FLAG_VERIFY    = "verify"    // (optional) ignored unless -F is used
+	FLAG_TRANSPOSE = "transpose" // (optional) superencipherment with KEY (Columnar) or KEY1,KEY2 (Double Columnar)
+	FLAG_SCHEDULE  = "schedule"  // (optional) polyalphabetic with explicit A:B pairs, i.e. 7:3,5:12
+	FLAG_SECRET    = "secret"    // (optional) polyalphabetic with A:B pairs derived from a keyword
+	FLAG_CRACK     = "crack"     // recover the A & B coefficients of a ciphered text
+	FLAG_KNOWN     = "known"     // (optional) only with -crack, known PLAIN:CIPHERED letters, i.e. e:x,t:q
+	FLAG_TOP       = "top"       // (optional) only with -crack, number of candidates shown
+	FLAG_STRENGTH  = "strength"  // keyspace & weaknesses of the A & B coefficients
 )
 
 /* ----------------------------------------------------------------
@@ -50,6 +63,9 @@ var (
 	ErrFilesRequired          = errors.New("for encode/decode a file the 2 free parameters must be input and output filenames")
 	ErrPipeTextOnly           = errors.New("for pipe input only text operations allowed")
 	ErrPipeOutOnly            = errors.New("for pipe input only piped output allowed")
+	ErrTransposeTextOnly      = errors.New("transposition only for text operations")
+	ErrScheduleOrCoefficients = errors.New("use either -A and -B, -schedule or -secret")
+	ErrScheduleNoTabula       = errors.New("-tabula only for the -A and -B Affine coefficients")
 )
 
 /* ----------------------------------------------------------------
@@ -63,13 +79,25 @@ type AffineCliOptions struct {
 	OptNgramSize    int
 	OptUseFiles     bool
 	OptVerify       bool // ignored unless -F is used
+	OptTranspose    string
+	OptSchedule     string // A:B pairs separated by commas
+	OptSecret       string // keyword for the schedule
+	OptKnown        string // known letter pairs (only with -crack)
+	Top             int    // candidates shown (only with -crack)
 	ActListCoprimes bool
 	ActPrintTabula  bool
 	ActIsDecode     bool
+	ActCrack        bool
+	ActStrength     bool
 
-	isReady bool
-	Files   *cmd.FileOptions
-	Common  *cmd.CommonOptions
+	isReady    bool
+	Files      *cmd.FileOptions
+	Transposer ciphers.ITransposition    // derived from OptTranspose
+	Schedule   []crypto.AffinePair       // derived from OptSchedule
+	Known      []cryptanalysis.KnownPair // derived from OptKnown
+	Armor      *files.Armor              // (decode) the armored message, nil if none
+	armorErr   error
+	Common     *cmd.CommonOptions
 }
 
 /* ----------------------------------------------------------------
@@ -92,6 +120,8 @@ func NewAffineOptions(common *cmd.CommonOptions) *AffineCliOptions {
 		ActListCoprimes: false,
 		ActPrintTabula:  false,
 		ActIsDecode:     false,
+		ActCrack:        false,
+		ActStrength:     false,
 		isReady:         false,
 		Files:           nil,
 		Common:          common,
@@ -117,11 +147,28 @@ func (c *AffineCliOptions) initialize() {
 	flag.IntVar(&c.OptNgramSize, FLAG_NGRAM, defaultNGram, "Format encoded output as NGram")
 	flag.BoolVar(&c.OptUseFiles, FLAG_FILE, false, "Free argument(s) are filenames")
 	flag.BoolVar(&c.OptVerify, FLAG_VERIFY, false, "Verify operation (only if -F is used)")
+	flag.StringVar(&c.OptTranspose, FLAG_TRANSPOSE, "", "Transposition after the substitution: KEYWORD (Columnar) or KEYWORD1,KEYWORD2 (Double Columnar)")
+	flag.StringVar(&c.OptSchedule, FLAG_SCHEDULE, "", "Polyalphabetic schedule of A:B coefficients, i.e. 7:3,5:12")
+	flag.StringVar(&c.OptSecret, FLAG_SECRET, "", "Polyalphabetic schedule derived from a secret keyword")
 	flag.BoolVar(&c.ActIsDecode, FLAG_DECODE, false, "Decode text")
 	flag.BoolVar(&c.ActListCoprimes, FLAG_COPRIMES, false, "List coprimes for 'A' for the chosen alphabet")
 	flag.BoolVar(&c.ActPrintTabula, FLAG_TABULA, false, "Print Tabula for chosen parameters")
+	flag.BoolVar(&c.ActCrack, FLAG_CRACK, false, "Recover the A & B coefficients of a ciphered text")
+	flag.StringVar(&c.OptKnown, FLAG_KNOWN, "", "Known PLAIN:CIPHERED letters for -crack, i.e. e:x,t:q")
+	flag.IntVar(&c.Top, FLAG_TOP, CRACK_TOP_DEFAULT, "Number of candidates shown by -crack")
+	flag.BoolVar(&c.ActStrength, FLAG_STRENGTH, false, "Keyspace, cracking effort & weaknesses of the A & B coefficients")
 	flag.Parse()
 
+	// an armored message presets the alphabets the CLI didn't give,
+	// errors are reported by Validate()
+	if c.ActIsDecode && !c.ActCrack && !c.ActStrength && !c.ActPrintTabula {
+		if c.Armor, c.armorErr = cmd.ReadArmoredInput(c.OptUseFiles); c.Armor != nil {
+			if c.armorErr = c.Armor.CheckCipher(z.AffineCipher); c.armorErr == nil {
+				c.armorErr = c.Common.PresetArmor(c.Armor)
+			}
+		}
+	}
+
 	// check that user is requesting presets from a profile and that the profile exists. @note perhaps move elsewhere
 	if cmd.AppConfig.IsGood() && c.Common.RequestsProfile() {
 		profileID := c.Common.GetRequestedProfile()
@@ -171,9 +218,18 @@ func (c *AffineCliOptions) IsReady() bool {
 }
 
 func (c *AffineCliOptions) FileExt() string {
+	if c.IsPolyalphabetic() {
+		return commands.FILE_EXT_AFFINE_POLY
+	}
 	return commands.FILE_EXT_AFFINE
 }
 
+// IsPolyalphabetic indicates whether a schedule of coefficients (explicit
+// or derived from a secret) was given rather than the -A & -B pair.
+func (c *AffineCliOptions) IsPolyalphabetic() bool {
+	return len(c.OptSchedule) != 0 || len(c.OptSecret) != 0
+}
+
 // Validate validates application CLI parameters. If it is successful
 // it returns EXIT_CODE_SUCCESS with nil error.
 func (c *AffineCliOptions) Validate() (int, error) {
@@ -185,6 +241,12 @@ func (c *AffineCliOptions) Validate() (int, error) {
 	if c.Common.NeedsDemo() || c.Common.NeedsHelp() ||
 		c.Common.NeedsVersion() || c.ActListCoprimes {
 		c.isReady = true
+	} else if c.ActCrack {
+		err = c.validateCrack()
+	} else if c.ActStrength {
+		err = c.validateStrength()
+	} else if len(c.OptKnown) != 0 || c.Top != CRACK_TOP_DEFAULT {
+		err = ErrKnownAlone
 	} else { // non-terminal arguments
 		if !(c.OptNgramSize == 0 || (c.OptNgramSize >= 2 && c.OptNgramSize <= 5)) {
 			err = ErrNGramSize
@@ -192,9 +254,31 @@ func (c *AffineCliOptions) Validate() (int, error) {
 			err = ErrModuloNotNeeded
 		} else if c.OptModulo < 0 {
 			err = ErrInvalidModulo
-		} else if c.CoefficientA == -1 || c.CoefficientB == -1 { // for -tabula and -d we require -A and -B
+		} else if c.IsPolyalphabetic() && (c.CoefficientA != -1 || c.CoefficientB != -1 ||
+			(len(c.OptSchedule) != 0 && len(c.OptSecret) != 0)) {
+			err = ErrScheduleOrCoefficients
+		} else if c.IsPolyalphabetic() && c.ActPrintTabula {
+			err = ErrScheduleNoTabula
+		} else if !c.IsPolyalphabetic() && (c.CoefficientA == -1 || c.CoefficientB == -1) { // for -tabula and -d we require -A and -B
 			err = ErrNeedAffineCoefficients
-		} else if !c.ActPrintTabula {
+		} else if len(c.OptTranspose) != 0 && c.Common.IsBinary() {
+			err = ErrTransposeTextOnly
+			exitCode = z.ERR_CLI_OPTIONS // same as caesarx
+		} else if c.Common.WantsArmor() && c.Common.IsBinary() {
+			err = cmd.ErrArmorBinary
+		} else if c.armorErr != nil {
+			err = c.armorErr
+		} else if len(c.OptTranspose) != 0 && !c.ActPrintTabula {
+			if c.Transposer, err = ciphers.ParseTransposition(c.OptTranspose); err != nil {
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+		}
+
+		if err == nil && len(c.OptSchedule) != 0 {
+			c.Schedule, err = affine.ParseAffineSchedule(c.OptSchedule)
+		}
+
+		if err == nil && !c.ActPrintTabula {
 			// encode OR decode (-d) operation requested
 			if !app.IsPipedInput() {
 				if c.OptUseFiles { // -F given
@@ -204,13 +288,18 @@ func (c *AffineCliOptions) Validate() (int, error) {
 							err = ErrFilesRequired
 						} else {
 							c.Files = cmd.NewFileOptions(flag.Arg(0), flag.Arg(1))
+							if c.Common.IsBinary() {
+								if exitCode, err = c.checkFileHeader(); err != nil {
+									return exitCode, err
+								}
+							}
 						}
 					} else { // -F plain_filename
 						if flag.NArg() != 1 {
 							err = ErrFilesRequired
 						} else {
 							// @note in Ring 1 the encrypted filename is auto-generated, we use the same spec here
-							outputFilename := cmn.NewNameExtOnly(flag.Arg(0), commands.FILE_EXT_AFFINE, true)
+							outputFilename := cmn.NewNameExtOnly(flag.Arg(0), c.FileExt(), true)
 							c.Files = cmd.NewFileOptions(flag.Arg(0), outputFilename)
 						}
 					}
@@ -234,7 +323,9 @@ func (c *AffineCliOptions) Validate() (int, error) {
 	}
 
 	if err != nil {
-		exitCode = z.ERR_PARAMETER
+		if exitCode == z.EXIT_CODE_SUCCESS {
+			exitCode = z.ERR_PARAMETER
+		}
 		c.isReady = false
 	} else {
 		c.isReady = true
@@ -243,6 +334,44 @@ func (c *AffineCliOptions) Validate() (int, error) {
 	return exitCode, err
 }
 
+// the FileHeader (if any) of the binary file to decrypt must name the
+// Affine cipher. The original extension is restored when the output
+// filename has none.
+func (c *AffineCliOptions) checkFileHeader() (int, error) {
+	header, err := files.PeekFileHeader(c.Files.Input)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+	if header == nil {
+		return z.EXIT_CODE_SUCCESS, nil // v1.0 or -noheader
+	}
+
+	if err = header.CheckCipher(z.AffineCipher); err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	if len(filepath.Ext(c.Files.Output)) == 0 {
+		c.Files.Output += header.FileExtension()
+	}
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// NewArmor returns the armor of the ciphered text, its headers taken
+// from the options.
+func (c *AffineCliOptions) NewArmor() *files.Armor {
+	armor := files.NewArmor(z.AffineCipher, c.Common.Alphabet().LangCodeISO())
+	if numbers := c.Common.Numbers(); numbers != nil {
+		armor.Chained = numbers.LangCodeISO()
+	}
+	if c.OptNgramSize > 0 {
+		armor.NGram = c.OptNgramSize
+	}
+	armor.Profile = c.Common.GetRequestedProfile()
+
+	return armor
+}
+
 // UseFiles indicates whether the encrypt/decrypt operation will work
 // with input/output file instead of a (short) text string.
 func (c *AffineCliOptions) UseFiles() bool {
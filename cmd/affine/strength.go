@@ -0,0 +1,108 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The -strength action. Reports the keyspace of the Affine cipher
+ * for the alphabet, the effort of the brute-force cracker and warns
+ * about weak coefficients (A=1 is a mere Caesar).
+ *	affine -strength -A N -B N [-alpha ALPHABET] ['text' | -F filename]
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cryptanalysis"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrStrengthOnly = errors.New("-strength needs -A & -B, it can't be used with -schedule, -secret, -tabula, -transpose, -crack or -d")
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *AffineCliOptions) validateStrength() error {
+	if c.CoefficientA == -1 || c.CoefficientB == -1 || c.IsPolyalphabetic() ||
+		c.ActPrintTabula || c.ActIsDecode || c.ActCrack || len(c.OptTranspose) != 0 {
+		return ErrStrengthOnly
+	}
+
+	// the message is optional
+	if app.IsPipedInput() {
+		if c.OptUseFiles {
+			return ErrPipeOutOnly
+		}
+	} else if flag.NArg() > 1 {
+		if c.OptUseFiles {
+			return ErrFilesRequired
+		}
+		return ErrFreeTextRequired
+	} else if c.OptUseFiles {
+		if flag.NArg() != 1 {
+			return ErrFilesRequired
+		}
+		c.Files = cmd.NewFileOptions(flag.Arg(0), "")
+	}
+
+	return nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteStrength prints the keyspace & cracking effort of the Affine
+// coefficients followed by their warnings, if any.
+func ExecuteStrength(co *cmd.CommonOptions, opts *AffineCliOptions) (int, error) {
+	alpha := co.Alphabet()
+	letters := 0
+	if app.IsPipedInput() || flag.NArg() != 0 {
+		text, err := crackInput(opts)
+		if err != nil {
+			return z.ERR_FILE_IO, err
+		}
+		for _, r := range alpha.ToUpperString(text) {
+			if alpha.PositionOf(r) != -1 {
+				letters++
+			}
+		}
+	}
+
+	config := &cryptanalysis.KeyConfig{
+		Variant:  z.AffineCipher,
+		Alphabet: alpha,
+		A:        opts.CoefficientA,
+		B:        opts.CoefficientB,
+	}
+	report, err := cryptanalysis.NewStrengthAnalyzer().Analyze(config, letters)
+	if err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	fmt.Println("Variant  : ", report.Config.Variant)
+	fmt.Printf("Alphabet :  %s (%d letters)\n", alpha.Name, alpha.Size())
+	fmt.Printf("Keyspace :  %s (2^%.1f)\n", report.Keyspace, report.Bits)
+	if report.Unicity != 0 {
+		fmt.Printf("Unicity  :  %.0f letters\n", report.Unicity)
+	}
+	if letters != 0 {
+		fmt.Printf("Message  :  %d letters\n", letters)
+	}
+	fmt.Printf("Effort   :  %.0f trial decryptions (%s)\n", report.Effort, report.Cracker)
+	for _, warning := range report.Warnings {
+		fmt.Printf("\t%s\n", warning)
+	}
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
@@ -0,0 +1,159 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * ASCII-armored messages on the command line. With -armor the ciphered
+ * text (CLI, piped or text file) is enclosed in an armor. When decoding
+ * an armored message is detected in any of them, its headers preset
+ * the alphabets the CLI didn't give and its ciphered text is decoded.
+ *-----------------------------------------------------------------*/
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/files"
+	"os"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrArmorBinary = errors.New("-" + FLAG_ARMOR + " is for text, encrypted binary files have their file header")
+)
+
+// the piped input, once read to look for an armored message
+var stdin *bufio.Reader = nil
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * Presets the (master) alphabet & the slave alphabet of the armored
+ * message unless given with -alpha or -num, which must then be those
+ * of the message.
+ */
+func (c *CommonOptions) PresetArmor(armor *files.Armor) error {
+	alphaGiven, numGiven := false, false
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case FLAG_ALPHA:
+			alphaGiven = true
+		case FLAG_NUM:
+			numGiven = true
+		}
+	})
+
+	alpha, handle := cmn.AlphabetNameByPISO(armor.Alphabet)
+	if alpha == nil {
+		return fmt.Errorf("unknown alphabet '%s' in the armored message", armor.Alphabet)
+	}
+	if !alphaGiven {
+		c.PresetPrimaryAlphabet(handle)
+	} else if c.IsBinary() || c.Alphabet().LangCodeISO() != alpha.LangCodeISO() {
+		return fmt.Errorf("the message was encrypted with the %s alphabet, not %s", alpha.Name, c.alpha)
+	}
+
+	if len(armor.Chained) != 0 {
+		slave, handle := cmn.AlphabetNameByPISO(armor.Chained)
+		if slave == nil {
+			return fmt.Errorf("unknown chained alphabet '%s' in the armored message", armor.Chained)
+		}
+		if !numGiven {
+			c.PresetSecondaryAlphabet(handle)
+		} else if numbers := c.Numbers(); numbers == nil || numbers.LangCodeISO() != slave.LangCodeISO() {
+			return fmt.Errorf("the message was encrypted with the %s chained alphabet", slave.Name)
+		}
+	}
+
+	return nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// the reader of the piped input, it has what ReadArmoredInput read
+// when there wasn't an armored message.
+func StdinReader() *bufio.Reader {
+	if stdin == nil {
+		stdin = bufio.NewReader(os.Stdin)
+	}
+
+	return stdin
+}
+
+/**
+ * The armored message given as piped input, as the (single) CLI text
+ * or in the text file (-F), nil if not armored.
+ */
+func ReadArmoredInput(useFiles bool) (*files.Armor, error) {
+	switch {
+	case app.IsPipedInput():
+		contents, err := io.ReadAll(StdinReader())
+		if err != nil {
+			return nil, err
+		}
+		stdin = bufio.NewReader(bytes.NewReader(contents))
+		if files.IsArmored(string(contents)) {
+			return files.ParseArmor(string(contents))
+		}
+
+	case useFiles:
+		if flag.NArg() != 0 && app.FileExists(flag.Arg(0)) {
+			return files.ReadArmorFile(flag.Arg(0))
+		}
+
+	case flag.NArg() == 1:
+		if files.IsArmored(flag.Arg(0)) {
+			return files.ParseArmor(flag.Arg(0))
+		}
+	}
+
+	return nil, nil
+}
+
+// ArmorTextFile encloses the ciphered text file in the armor, in place.
+func ArmorTextFile(filename string, armor *files.Armor) error {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	armor.Body = strings.TrimSuffix(string(contents), "\n")
+	if err = os.WriteFile(filename, []byte(armor.String()), 0644); err != nil {
+		mlog.ErrorE(err)
+	}
+	return err
+}
+
+/**
+ * The ciphered text of the armored text file is written to a temporary
+ * file, to be decrypted & removed by the caller. If the file isn't
+ * armored the same filename is returned.
+ */
+func UnarmorTextFile(filename string) (string, error) {
+	armor, err := files.ReadArmorFile(filename)
+	if err != nil || armor == nil {
+		return filename, err
+	}
+
+	unarmored := cmn.GenerateTemporaryFileName("tempfile-armor-*")
+	if err = os.WriteFile(unarmored, []byte(armor.Text()+"\n"), 0600); err != nil {
+		mlog.ErrorE(err)
+		return filename, err
+	}
+
+	return unarmored, nil
+}
@@ -0,0 +1,329 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The "crack" sub-command. Recovers a lost Caesar, Didimus or Fibonacci
+ * key by trying them all and ranking the decoded texts by language
+ * fitness. The Bellaso secret is recovered with the Kasiski & Friedman
+ * tests and the Vigenère primer by trying every length. With a known
+ * piece of the plain text (-crib) the key is inferred from it instead.
+ * With -alpha auto every built-in alphabet is tried. The key of a binary
+ * file (-alpha binary) is recovered from its file signature instead.
+ *	caesarx crack -variant caesar|didimus|fibonacci|bellaso|vigenere [-alpha ALPHABET|auto] [-top N] 'text' | -F filename
+ *	caesarx crack -variant caesar|didimus|fibonacci|bellaso -crib 'plain' [-at N] [-alpha ALPHABET|auto] 'text' | -F filename
+ *	caesarx crack -variant caesar|didimus|fibonacci|bellaso -alpha binary -F filename
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"lordofscripts/caesarx/internal/files"
+	"os"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	CRACK_TOP_DEFAULT = 5  // candidates shown unless -top is given
+	CRACK_PREVIEW     = 60 // runes of the decoded text shown per candidate
+)
+
+var (
+	ErrCrackTop     = errors.New("-top must be at least 1")
+	ErrCrackMode    = errors.New("crack only supports the standard Caesar mode")
+	ErrCrackVariant = errors.New("crack supports Caesar, Didimus, Fibonacci, Bellaso & Vigenère")
+	ErrCrackCrib    = errors.New("-crib supports Caesar, Didimus, Fibonacci & Bellaso")
+	ErrCrackCribAt  = errors.New("-at needs -crib and a rune position from 0 on")
+	ErrCrackBinary  = errors.New("the key of a binary file (-F) is recovered from its signature, -crib doesn't apply")
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *CaesarxOptions) validateCrack() (int, error) {
+	if c.Common.IsBinary() {
+		return c.validateBinaryCrack()
+	}
+	if _, err := cryptanalysis.NewKeyCracker(c.VariantID); err != nil && !isPolyCrack(c.VariantID) {
+		return z.ERR_PARAMETER, ErrCrackVariant
+	}
+	if c.VariantID == z.CaesarCipher && c.caesarMode != caesar.CAESAR {
+		return z.ERR_PARAMETER, ErrCrackMode
+	}
+	if c.Top < 1 {
+		return z.ERR_PARAMETER, ErrCrackTop
+	}
+	if c.CribAt != cryptanalysis.CRIB_ANYWHERE && (len(c.Crib) == 0 || c.CribAt < 0) {
+		return z.ERR_PARAMETER, ErrCrackCribAt
+	}
+	if len(c.Crib) != 0 && c.VariantID == z.VigenereCipher {
+		return z.ERR_PARAMETER, ErrCrackCrib
+	}
+
+	return c.validateAnalysisInput()
+}
+
+// binary files are recovered from their file signature, a whole file is needed
+func (c *CaesarxOptions) validateBinaryCrack() (int, error) {
+	switch c.VariantID {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher, z.BellasoCipher:
+	default:
+		return z.ERR_PARAMETER, cryptanalysis.ErrSignatureVariant
+	}
+	if c.VariantID == z.CaesarCipher && c.caesarMode != caesar.CAESAR {
+		return z.ERR_PARAMETER, ErrCrackMode
+	}
+	if c.Top < 1 {
+		return z.ERR_PARAMETER, ErrCrackTop
+	}
+	if len(c.Crib) != 0 || c.CribAt != cryptanalysis.CRIB_ANYWHERE {
+		return z.ERR_PARAMETER, ErrCrackBinary
+	}
+	if !c.UseFiles || flag.NArg() != 1 {
+		return z.ERR_PARAMETER, ErrFilesRequired
+	}
+
+	c.Files = cmd.NewFileOptions(flag.Arg(0), "")
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteCrack brute-forces the key of the ciphered text and prints the
+// most likely candidates with their keys and a preview of the text.
+func ExecuteCrack(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	if co.IsBinary() {
+		return executeSignatureCrack(ao)
+	}
+
+	text, err := analysisInput(ao)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	alphas := crackAlphabets(co)
+	if len(ao.Crib) != 0 {
+		return executeCribCrack(co, ao, text, alphas)
+	}
+	if isPolyCrack(ao.VariantID) {
+		return executePolyCrack(co, ao, text, alphas)
+	}
+
+	cracker, _ := cryptanalysis.NewKeyCracker(ao.VariantID) // validated
+	if numbers := co.Numbers(); numbers != nil {
+		cracker.WithChain(numbers)
+	}
+
+	candidates, err := cracker.Crack(text, alphas...)
+	if err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	fmt.Println("Variant  : ", ao.VariantID)
+	fmt.Printf("Tried    :  %d keys\n", len(candidates))
+	for i, candidate := range cryptanalysis.TopCandidates(candidates, ao.Top) {
+		key := fmt.Sprintf("-key %c", candidate.Key)
+		if candidate.Variant == z.DidimusCipher {
+			key += fmt.Sprintf(" -offset %d", candidate.Offset)
+		}
+		_, alphaName := cmn.AlphabetNameByPISO(candidate.Alphabet.LangCodeISO())
+
+		fmt.Printf("#%-2d %7.3f  -alpha %s %s\n", i+1, candidate.Score, alphaName, key)
+		fmt.Printf("\t%s\n", preview(candidate.Plain))
+	}
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the polyalphabetic secret is recovered rather than brute-forced
+func executePolyCrack(co *cmd.CommonOptions, ao *CaesarxOptions, text string, alphas []*cmn.Alphabet) (int, error) {
+	var best *cryptanalysis.PolySolution = nil
+	var estimates []cryptanalysis.KeyLengthEstimate
+	var lastErr error = cryptanalysis.ErrCrackAlphabet
+	for _, alpha := range alphas {
+		cracker, err := cryptanalysis.NewPolyCracker(alpha)
+		if err != nil {
+			continue // no reference for the alphabet
+		}
+		cracker.WithChain(co.Numbers())
+
+		var solution *cryptanalysis.PolySolution
+		var lengths []cryptanalysis.KeyLengthEstimate
+		if ao.VariantID == z.BellasoCipher {
+			if lengths, err = cracker.KeyLengths(text); err == nil {
+				solution, err = cracker.SolveBellaso(text)
+			}
+		} else {
+			solution, err = cracker.SolveAutokey(text)
+		}
+		if err != nil {
+			lastErr = err // too few letters of this alphabet
+			continue
+		}
+
+		if best == nil || solution.Score > best.Score {
+			best, estimates = solution, lengths
+		}
+	}
+
+	if best == nil {
+		return z.ERR_PARAMETER, lastErr
+	}
+
+	_, alphaName := cmn.AlphabetNameByPISO(best.Alphabet.LangCodeISO())
+	fmt.Println("Variant  : ", ao.VariantID)
+	fmt.Println("Alphabet : ", alphaName)
+	if len(estimates) != 0 {
+		lengths := make([]string, 0, ao.Top)
+		for _, estimate := range estimates[:min(ao.Top, len(estimates))] {
+			lengths = append(lengths, fmt.Sprintf("%d (IC %.4f, Kasiski %d)", estimate.Length, estimate.IC, estimate.Kasiski))
+		}
+		fmt.Println("Key size : ", strings.Join(lengths, ", "))
+		fmt.Println("Secret   : ", best.Secret)
+	} else {
+		fmt.Println("Primer   : ", best.Secret)
+	}
+	fmt.Printf("Score    :  %.3f\n", best.Score)
+	fmt.Println("Plain    : ", preview(best.Plain))
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the key is inferred from the crib (at every position if -at isn't given)
+func executeCribCrack(co *cmd.CommonOptions, ao *CaesarxOptions, text string, alphas []*cmn.Alphabet) (int, error) {
+	solutions := make([]*cryptanalysis.CribSolution, 0)
+	var lastErr error = cryptanalysis.ErrCribNotFound
+	for _, alpha := range alphas {
+		found, err := cryptanalysis.NewCribAttack(alpha).WithChain(co.Numbers()).Solve(ao.VariantID, text, ao.Crib, ao.CribAt)
+		if err != nil {
+			lastErr = err // not in this alphabet
+			continue
+		}
+		solutions = append(solutions, found...)
+	}
+
+	if len(solutions) == 0 {
+		return z.ERR_PARAMETER, lastErr
+	}
+
+	fmt.Println("Variant  : ", ao.VariantID)
+	fmt.Printf("Crib     :  %q found %d time(s)\n", ao.Crib, len(solutions))
+	for i, solution := range solutions[:min(ao.Top, len(solutions))] {
+		var key string
+		switch solution.Variant {
+		case z.DidimusCipher:
+			key = fmt.Sprintf("-key %c -offset %d", solution.Key, solution.AltOffset)
+		case z.BellasoCipher:
+			key = fmt.Sprintf("-secret %s", solution.Secret)
+		default:
+			key = fmt.Sprintf("-key %c", solution.Key)
+		}
+		_, alphaName := cmn.AlphabetNameByPISO(solution.Alphabet.LangCodeISO())
+
+		fmt.Printf("#%-2d at %-5d -alpha %s %s\n", i+1, solution.Stream.Offset, alphaName, key)
+		if len(solution.Stream.Skipped) != 0 {
+			fmt.Printf("\tskipped %v\n", solution.Stream.Skipped)
+		}
+		if len(solution.Plain) != 0 {
+			fmt.Printf("\t%s\n", preview(solution.Plain))
+		}
+	}
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the key of a binary file is inferred from the signature of its format
+func executeSignatureCrack(ao *CaesarxOptions) (int, error) {
+	fd, err := os.Open(ao.Files.Input)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+	defer fd.Close()
+
+	// the file header (if any) names the cipher, the signature follows it
+	header, err := files.ReadOptionalHeader(fd)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+	if header != nil {
+		if err = header.CheckCipher(ao.VariantID); err != nil {
+			return z.ERR_PARAMETER, err
+		}
+	}
+
+	head := make([]byte, cryptanalysis.SIGNATURE_PEEK)
+	n, err := io.ReadFull(fd, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return z.ERR_FILE_IO, err
+	}
+
+	solutions, err := cryptanalysis.NewSignatureRecovery().Recover(ao.VariantID, head[:n])
+	if err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	fmt.Println("Variant  : ", ao.VariantID)
+	fmt.Printf("File     :  %s (%d bytes read)\n", ao.Files.Input, n)
+	for i, solution := range solutions[:min(ao.Top, len(solutions))] {
+		var key string
+		switch solution.Variant {
+		case z.DidimusCipher:
+			key = fmt.Sprintf("-key %q -offset %d", solution.Key, solution.AltOffset)
+		case z.BellasoCipher:
+			key = fmt.Sprintf("-secret %q", solution.Secret)
+		default:
+			key = fmt.Sprintf("-key %q", solution.Key)
+		}
+
+		fmt.Printf("#%-2d -alpha binary %s\n", i+1, key)
+		fmt.Printf("\t%s\n", solution.Signature)
+	}
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the alphabet given with -alpha or all the built-in ones with -alpha auto
+func crackAlphabets(co *cmd.CommonOptions) []*cmn.Alphabet {
+	if !co.IsAutoAlphabet() {
+		return []*cmn.Alphabet{co.Alphabet()}
+	}
+
+	alphas := make([]*cmn.Alphabet, 0)
+	for _, ref := range cryptanalysis.AllLanguageStats() {
+		alphas = append(alphas, ref.Alphabet())
+	}
+
+	return alphas
+}
+
+func isPolyCrack(variant z.CipherVariant) bool {
+	return variant == z.BellasoCipher || variant == z.VigenereCipher
+}
+
+// the first runes of the first line of the text
+func preview(text string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	if runes := []rune(line); len(runes) > CRACK_PREVIEW {
+		return string(runes[:CRACK_PREVIEW]) + "…"
+	}
+
+	return line
+}
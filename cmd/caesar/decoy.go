@@ -0,0 +1,176 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The "decoy" sub-command. Plausible deniability: derives the key that
+ * turns an existing ciphered message into a harmless decoy. For a
+ * Bellaso ciphered text it is a secret, emitted as a profile snippet
+ * for the caesarx.yaml configuration. For a binary file it is a
+ * one-time pad written to the -keyfile, the book of a binary Running Key.
+ *	caesarx decoy -variant bellaso -decoy 'decoy text' [-alpha ALPHABET] [-num N|A|H|E] 'ciphered text' | -F filename
+ *	caesarx decoy -variant runningkey -alpha binary -decoy DECOYFILE -keyfile PADFILE -F filename
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cmn/prefs"
+	"lordofscripts/caesarx/cryptanalysis"
+	"lordofscripts/caesarx/internal/files"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	DECOY_PROFILE_ID = "you+decoy@bitbucket.com" // to be replaced by that of the recipient
+)
+
+var (
+	ErrDecoyVariant = errors.New("decoy supports Bellaso (text) & RunningKey (-alpha binary)")
+	ErrDecoyText    = errors.New("the decoy of a text is a Bellaso secret, use -variant bellaso")
+	ErrDecoyBinary  = errors.New("the decoy of a binary file is a one-time pad, use -variant runningkey")
+	ErrDecoyPad     = errors.New("the binary decoy needs the ciphered file (-F), the decoy file & the pad file (-keyfile) to write")
+	ErrDecoyHeader  = errors.New("the file header gives the real cipher & key away, encrypt with -" + cmd.FLAG_NO_HEADER)
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *CaesarxOptions) validateDecoy() (int, error) {
+	switch c.VariantID {
+	case z.BellasoCipher:
+		if c.Common.IsBinary() {
+			return z.ERR_PARAMETER, ErrDecoyBinary
+		}
+	case z.RunningKeyCipher:
+		if !c.Common.IsBinary() {
+			return z.ERR_PARAMETER, ErrDecoyText
+		}
+	default:
+		return z.ERR_PARAMETER, ErrDecoyVariant
+	}
+	if len(c.Decoy) == 0 {
+		return z.ERR_CLI_OPTIONS, fmt.Errorf("needs the decoy '%s 'DECOY'", FLAG_DECOY)
+	}
+
+	if !c.Common.IsBinary() {
+		return c.validateAnalysisInput()
+	}
+
+	// the decoy is a file, the pad is written in the key file
+	if app.IsPipedInput() || !c.UseFiles || flag.NArg() != 1 || len(c.KeyFile) == 0 {
+		return z.ERR_PARAMETER, ErrDecoyPad
+	}
+	if !app.FileExists(c.Decoy) {
+		return z.ERR_FILE_IO, fmt.Errorf("decoy file '%s' does not exist", c.Decoy)
+	}
+	if c.KeyFile == flag.Arg(0) || c.KeyFile == c.Decoy {
+		return z.ERR_PARAMETER, fmt.Errorf("the pad file '%s' would overwrite an input", c.KeyFile)
+	}
+	c.Files = cmd.NewFileOptions(flag.Arg(0), c.KeyFile)
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteDecoy derives the decoy secret (text) or one-time pad (binary)
+func ExecuteDecoy(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	if co.IsBinary() {
+		return executeDecoyPad(co, ao)
+	}
+
+	text, err := analysisInput(ao)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	alpha := co.Alphabet()
+	generator := cryptanalysis.NewDecoyGenerator(alpha)
+	if _, wants := co.WantsSlave(); wants {
+		generator.WithChain(co.Numbers())
+	}
+	decoy, err := generator.Secret(text, ao.Decoy)
+	if err != nil {
+		if errors.Is(err, cryptanalysis.ErrDecoyLayout) && co.Numbers() == nil {
+			err = fmt.Errorf("%w (the digits & space are only ciphered with -num)", err)
+		}
+		return z.ERR_PARAMETER, err
+	}
+
+	_, alphaName := cmn.AlphabetNameByPISO(alpha.LangCodeISO())
+	chained := ""
+	if decoy.Slave != nil {
+		_, chained = cmn.AlphabetNameByPISO(decoy.Slave.LangCodeISO())
+	}
+	profile := prefs.NewProfileWithCipher(DECOY_PROFILE_ID, "Decoy", z.BellasoCipher, alpha.LangCodeISO(), chained, &prefs.SecretsModel{Secret: decoy.Secret})
+	snippet, err := yaml.Marshal(&struct {
+		Profiles []*prefs.Recipient `yaml:"profiles"`
+	}{[]*prefs.Recipient{profile}})
+	if err != nil {
+		return z.ERR_INTERNAL, err
+	}
+
+	fmt.Println("Variant  : ", ao.VariantID)
+	fmt.Println("Alphabet : ", alphaName)
+	fmt.Printf("Secret   :  %s (%d letters)\n", decoy.Secret, len([]rune(decoy.Secret)))
+	fmt.Println("Plain    : ", preview(decoy.Plain))
+	fmt.Println()
+	fmt.Println("# decoy profile, add its entry to the profiles of caesarx.yaml")
+	fmt.Print(string(snippet))
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the one-time pad of the ciphered file is written to the -keyfile
+func executeDecoyPad(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	ciphered, err := os.ReadFile(ao.Files.Input)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+	decoy, err := os.ReadFile(ao.Decoy)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	// a v1.0 Running Key file header stays, the pad covers what follows
+	// it. Later headers have the fingerprint of the real key.
+	reader := bytes.NewReader(ciphered)
+	header, err := files.ReadOptionalHeader(reader)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+	if header != nil && (header.Check != nil || header.CheckCipher(z.RunningKeyCipher) != nil) {
+		return z.ERR_PARAMETER, fmt.Errorf("%w (%s)", ErrDecoyHeader, header.Start.AlgorithmA)
+	}
+
+	pad, err := cryptanalysis.DecoyPad(ciphered[len(ciphered)-reader.Len():], decoy)
+	if err != nil {
+		return z.ERR_PARAMETER, err
+	}
+	if err = os.WriteFile(ao.Files.Output, pad, 0600); err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	fmt.Println("Variant  : ", ao.VariantID)
+	fmt.Printf("Pad      :  %s (%d bytes)\n", ao.Files.Output, len(pad))
+	fmt.Printf("Decode   :  %s -variant runningkey -alpha binary -keyfile %s -d -F %s DECOYFILE\n", APP_NAME, ao.Files.Output, ao.Files.Input)
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
@@ -0,0 +1,90 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The "identify" sub-command. Guesses which cipher variant & alphabet
+ * produced a ciphered text and suggests the command that cracks it.
+ *	caesarx identify [-num N|A|H|E] [-top N] 'text' | -F filename
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *CaesarxOptions) validateIdentify() (int, error) {
+	if c.Top < 1 {
+		return z.ERR_PARAMETER, ErrCrackTop
+	}
+
+	return c.validateAnalysisInput()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteIdentify prints the statistics of the ciphered text and the
+// most likely variants, each with the command that cracks it (if any).
+func ExecuteIdentify(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	text, err := analysisInput(ao)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	guesses, profile, err := cryptanalysis.NewVariantClassifier().WithChain(co.Numbers()).Classify(text)
+	if err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	if profile.Alphabet != nil {
+		_, alphaName := cmn.AlphabetNameByPISO(profile.Alphabet.LangCodeISO())
+		fmt.Printf("Alphabet :  %s (%.0f%% of the letters)\n", alphaName, 100*profile.Coverage)
+		fmt.Printf("Letters  :  %d\n", profile.Letters)
+		fmt.Printf("IC       :  %.4f (%s %.4f, random %.4f)\n", profile.IC, profile.Alphabet.LangCodeISO(), profile.ExpectedIC, profile.RandomIC)
+		if profile.Period != 0 {
+			fmt.Printf("Key size :  %d (IC %.4f)\n", profile.Period, profile.PeriodIC)
+		}
+	} else {
+		fmt.Println("Alphabet :  (none, digits only)")
+	}
+	if profile.Grouping != 0 {
+		fmt.Printf("Grouping :  %d runes\n", profile.Grouping)
+	}
+
+	for i, guess := range guesses[:min(ao.Top, len(guesses))] {
+		fmt.Printf("#%-2d %s\n", i+1, guess)
+		if command := crackCommand(guess); len(command) != 0 {
+			fmt.Printf("\t%s\n", command)
+		}
+	}
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the CLI command that cracks the guessed variant, empty if none does
+func crackCommand(guess *cryptanalysis.VariantGuess) string {
+	alphaName := "auto"
+	if guess.Alphabet != nil {
+		_, alphaName = cmn.AlphabetNameByPISO(guess.Alphabet.LangCodeISO())
+	}
+
+	switch guess.Variant {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher, z.BellasoCipher, z.VigenereCipher:
+		return fmt.Sprintf("caesarx %s -variant %s -alpha %s", SUBCMD_CRACK, strings.ToLower(guess.Variant.String()), alphaName)
+	case z.AffineCipher:
+		return fmt.Sprintf("affine -crack -alpha %s", alphaName)
+	}
+
+	return ""
+}
@@ -3,12 +3,16 @@
  *				  Copyright (C)2025 Dídimo Grimaldo T.
  * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
  * Extended Caesar Cipher command-line application. It supports the
- * following ciphers: Caesar, Didimus, Fibonacci, Bellaso & Vigenère.
+ * following ciphers: Caesar (plain, Extended, Augustus & Tiberius
+ * modes), Didimus, Fibonacci, Bellaso, Vigenère,
+ * Beaufort, Variant Beaufort, Running Key, Playfair, Hill, Polybius,
+ * ADFGX/ADFGVX, Bifid & Enigma.
  *-----------------------------------------------------------------*/
 package main
 
 import (
 	"bufio"
+	"errors"
 	"flag"
 	"fmt"
 	z "lordofscripts/caesarx"
@@ -16,13 +20,22 @@ import (
 	"lordofscripts/caesarx/app/mlog"
 	"lordofscripts/caesarx/ciphers"
 	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/beaufort"
 	"lordofscripts/caesarx/ciphers/bellaso"
 	"lordofscripts/caesarx/ciphers/caesar"
 	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/ciphers/enigma"
+	"lordofscripts/caesarx/ciphers/hill"
+	"lordofscripts/caesarx/ciphers/playfair"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/ciphers/runningkey"
 	"lordofscripts/caesarx/ciphers/vigenere"
 	"lordofscripts/caesarx/cmd"
 	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/files"
 	"os"
+	"strings"
 )
 
 /* ----------------------------------------------------------------
@@ -50,8 +63,12 @@ func init() {
 func Demo(copts *cmd.CommonOptions, aopts *CaesarxOptions) (int, error) {
 	var passed bool // the demos return whether the Round-trip Encode/Decode was good
 	switch aopts.VariantID {
-	case z.CaesarCipher: // -variant caesar -alpha <ALPHABET_NAME> -key <LETTER>
-		passed = commands.DemoCaesarCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+	case z.CaesarCipher: // -variant caesar -alpha <ALPHABET_NAME> -key <LETTER> [-mode <MODE>]
+		if len(aopts.Mode) == 0 {
+			passed = commands.DemoCaesarCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+		} else {
+			passed = commands.DemoCaesarModesCommand(copts.Alphabet(), copts.DefaultPhrase)
+		}
 
 	case z.DidimusCipher: // -variant didimus -alpha <ALPHABET_NAME> -key <LETTER> -offset <NUMBER>
 		passed = commands.DemoDidimusCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
@@ -65,6 +82,33 @@ func Demo(copts *cmd.CommonOptions, aopts *CaesarxOptions) (int, error) {
 	case z.VigenereCipher: // -variant vigenere -alpha <ALPHABET_NAME> -secret <SECRET_WORD>
 		passed = commands.DemoVigenereCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
 
+	case z.BeaufortCipher: // -variant beaufort -alpha <ALPHABET_NAME> -secret <SECRET_WORD>
+		passed = commands.DemoBeaufortCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.VariantBeaufortCipher: // -variant variantbeaufort -alpha <ALPHABET_NAME> -secret <SECRET_WORD>
+		passed = commands.DemoVariantBeaufortCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.RunningKeyCipher: // -variant runningkey -alpha <ALPHABET_NAME> -keyfile <BOOK> -keyoffset <C:L:N>
+		passed = commands.DemoRunningKeyCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.PlayfairCipher: // -variant playfair -alpha english -secret <KEYWORD>
+		passed = commands.DemoPlayfairCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.HillCipher: // -variant hill -alpha <ALPHABET_NAME> -secret <KEY>
+		passed = commands.DemoHillCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.PolybiusCipher: // -variant polybius -alpha <ALPHABET_NAME> [-secret <KEYWORD>] [-labels <LABELS>]
+		passed = commands.DemoPolybiusCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.AdfgvxCipher: // -variant adfgvx -alpha <ALPHABET_NAME> -secret <KEYWORD> -transpose <KEY>
+		passed = commands.DemoAdfgvxCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.BifidCipher: // -variant bifid -alpha <ALPHABET_NAME> [-secret <KEYWORD>] [-period <N>]
+		passed = commands.DemoBifidCommand(copts.Alphabet(), copts.Numbers(), copts.DefaultPhrase)
+
+	case z.EnigmaCipher: // -variant enigma -alpha english -secret <SETTINGS>
+		passed = commands.DemoEnigmaCommand(copts.Alphabet(), copts.DefaultPhrase)
+
 	case z.AffineCipher:
 		passed = affine.DemoAffine()
 	}
@@ -87,8 +131,8 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 	var cmdCipher ciphers.ICipherCommand
 	switch ao.VariantID {
 	case z.CaesarCipher:
-		// single key, space not included
-		cmdCipher = commands.NewCaesarCommand(co.Alphabet(), ao.MainKey.Value)
+		// single key, space not included. The extended modes include the symbols
+		cmdCipher = commands.NewCaesarCommandWithOptions(co.Alphabet(), ao.CaesarOptions())
 
 	case z.DidimusCipher:
 		// double alternating key, space, numbers and number-related symbols included
@@ -106,6 +150,88 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 		// multi-letter secret & autokey, space, numbers and number-related symbols included
 		cmdCipher = commands.NewVigenereCommand(co.Alphabet(), ao.Secret)
 
+	case z.BeaufortCipher:
+		// multi-letter secret, reciprocal K-P, space, numbers and number-related symbols included
+		cmdCipher = commands.NewBeaufortCommand(co.Alphabet(), ao.Secret)
+
+	case z.VariantBeaufortCipher:
+		// multi-letter secret, P-K, space, numbers and number-related symbols included
+		cmdCipher = commands.NewVariantBeaufortCommand(co.Alphabet(), ao.Secret)
+
+	case z.RunningKeyCipher:
+		// key as long as the message read from a book, space, numbers and number-related symbols included
+		var errRK error
+		if cmdCipher, errRK = commands.NewRunningKeyCommandFromFile(co.Alphabet(), ao.KeyFile, ao.KeyOffset); errRK != nil {
+			return z.ERR_SEQUENCER, errRK
+		}
+
+	case z.PlayfairCipher:
+		// digraphs on a keyed 5x5 square, 6x6 with the numbers slave
+		pfr, errPF := commands.NewPlayfairCommand(co.Alphabet(), ao.Secret)
+		if errPF != nil {
+			return z.ERR_BAD_ALPHABET, errPF
+		}
+		// the fillers are checked against the final (chained) square
+		if _, wants := co.WantsSlave(); wants {
+			pfr.WithChain(co.Numbers())
+		}
+		if filler := []rune(ao.Filler); len(filler) != 0 {
+			if errPF = pfr.WithFiller(filler[0], filler[1]); errPF != nil {
+				return z.ERR_PARAMETER, errPF
+			}
+		}
+		cmdCipher = pfr
+
+	case z.HillCipher:
+		// blocks of 2/3 letters times the key matrix modulo N
+		hil, errH := commands.NewHillCommand(co.Alphabet(), ao.Secret)
+		if errH != nil {
+			return z.ERR_PARAMETER, errH
+		}
+		// the nulls are checked against the final (chained) alphabet
+		if _, wants := co.WantsSlave(); wants {
+			hil.WithChain(co.Numbers())
+		}
+		if len(ao.Filler) != 0 {
+			if errH = hil.WithNulls(ao.Filler); errH != nil {
+				return z.ERR_PARAMETER, errH
+			}
+		}
+		cmdCipher = hil
+
+	case z.PolybiusCipher:
+		// row & column labels of each letter in a keyed square
+		plb, errP := commands.NewPolybiusCommand(co.Alphabet(), ao.Secret, ao.Labels)
+		if errP != nil {
+			return z.ERR_BAD_ALPHABET, errP
+		}
+		cmdCipher = plb
+
+	case z.AdfgvxCipher:
+		// square labels followed by the columnar transposition, which is
+		// part of the cipher rather than a superencipherment
+		adf, errA := commands.NewAdfgvxCommand(co.Alphabet(), ao.Secret, ao.Transpose)
+		if errA != nil {
+			return z.ERR_BAD_ALPHABET, errA
+		}
+		cmdCipher = adf
+
+	case z.BifidCipher:
+		// fractionating the square coordinates of each period
+		bfd, errB := commands.NewBifidCommand(co.Alphabet(), ao.Secret, ao.Period)
+		if errB != nil {
+			return z.ERR_BAD_ALPHABET, errB
+		}
+		cmdCipher = bfd
+
+	case z.EnigmaCipher:
+		// rotor machine with the daily settings in key sheet notation
+		eni, errE := commands.NewEnigmaCommand(co.Alphabet(), ao.Secret)
+		if errE != nil {
+			return z.ERR_PARAMETER, errE
+		}
+		cmdCipher = eni
+
 	case z.AffineCipher:
 		fmt.Println("Please use the affine (affine.exe) application.")
 		fallthrough
@@ -119,14 +245,93 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 		cmdCipher.WithChain(co.Numbers())
 	}
 
-	// If NGram formatting wanted, create it as Pipe command, only for Encoding
-	if ao.NGramSize > 0 && !ao.IsDecode {
-		ngramCmd := cmn.NewNgramFormatter(uint8(ao.NGramSize), '·')
-		cmdCipher.WithPipe(ngramCmd) // @audit add Tee Command to output regular and NGram
+	// Check if user wants keyword-mixed alphabets (Quagmire)
+	var keys ciphers.QuagmireKeys
+	if len(ao.Keyword) != 0 {
+		keys = ciphers.ParseQuagmireKeys(ao.Keyword)
+		if err := keys.Check(co.Alphabet()); err != nil {
+			return z.ERR_PARAMETER, err
+		}
+		if ao.VariantID == z.BeaufortCipher {
+			if err := keys.CheckRowsOnly(); err != nil {
+				return z.ERR_PARAMETER, err
+			}
+		}
+
+		if keyed, ok := cmdCipher.(ciphers.IKeyedCipherCommand); ok {
+			keyed.WithKeyword(keys)
+		} else {
+			return z.ERR_PARAMETER, fmt.Errorf("%s does not support keyword-mixed alphabets", cmdCipher)
+		}
+	}
+
+	// encrypted binary files start with a FileHeader unless -noheader is given
+	if headed, ok := cmdCipher.(ciphers.IFileHeaderCommand); ok && co.IsBinary() {
+		headed.WithFileHeader(co.WantsFileHeader())
+	}
+
+	// Check if user wants superencipherment (substitution + transposition)
+	var transposer ciphers.ITransposition = nil
+	if len(ao.Transpose) != 0 && ao.VariantID != z.AdfgvxCipher {
+		var errT error
+		if transposer, errT = ciphers.ParseTransposition(ao.Transpose); errT != nil {
+			return z.ERR_PARAMETER, errT
+		}
+	}
+
+	// If NGram formatting wanted, create it as Pipe command, only for Encoding.
+	// The transposition (if any) goes first, but without the spaces that
+	// the NGram formatting removes, else it couldn't be undone.
+	var ngramCmd *cmn.NgramCmd = nil
+	if ao.NGramSize > 0 {
+		ngramCmd = cmn.NewNgramFormatter(uint8(ao.NGramSize), '·')
+	}
+	if !ao.IsDecode {
+		if transposer != nil && ngramCmd != nil {
+			cmdCipher.WithPipe(cmn.NewCommandChain(ngramCmd.Inverse(), transposer, ngramCmd))
+		} else if transposer != nil {
+			cmdCipher.WithPipe(transposer)
+		} else if ngramCmd != nil {
+			cmdCipher.WithPipe(ngramCmd) // @audit add Tee Command to output regular and NGram
+		}
+	}
+
+	// undoes the transposition (if any) of a ciphered text, when decoding
+	// an NGram formatted one (-ngram) its grouping is removed beforehand.
+	var untranspose cmn.ICommand = nil
+	if transposer != nil {
+		untranspose = transposer.Inverse()
+		if ngramCmd != nil {
+			untranspose = cmn.NewCommandChain(ngramCmd.Inverse(), transposer.Inverse())
+		}
+	}
+
+	// the armor & the transposition of text files are undone in separate passes
+	decryptTextFile := func(src, target string) error {
+		unarmored, err := cmd.UnarmorTextFile(src)
+		if err != nil {
+			return err
+		}
+		if unarmored != src {
+			defer os.Remove(unarmored)
+			src = unarmored
+		}
+
+		if transposer == nil {
+			return cmdCipher.DecryptTextFile(src, target)
+		}
+
+		untransposed := cmn.GenerateTemporaryFileName("tempfile-caesarx-*")
+		defer os.Remove(untransposed)
+		if err := cmd.TransposeTextFile(src, untransposed, transposer.Inverse()); err != nil {
+			return err
+		}
+		return cmdCipher.DecryptTextFile(untransposed, target)
 	}
 
 	// Do the (de)cipher operation
 	var plain, cipher, operation string
+	var armor *files.Armor = nil
 	var err error
 	if ao.IsDecode {
 		operation = "Decrypt"
@@ -135,19 +340,39 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 			if co.IsBinary() {
 				err = cmdCipher.DecryptBinFile(ao.Files.Input, ao.Files.Output)
 			} else {
-				err = cmdCipher.DecryptTextFile(ao.Files.Input, ao.Files.Output)
+				err = decryptTextFile(ao.Files.Input, ao.Files.Output)
 			}
 
 			// is file verification requested
 			if err == nil && ao.OptVerify {
 				postCmd = cmd.NewVerifyFileCommand(ao.Files.Output, cmd.HashCRC64)
 			}
+		} else if ao.Armor != nil { // armored CLI text or piped input
+			cipher = ao.Armor.Body
+			decoded := make([]string, 0)
+			for _, lineIn := range ao.Armor.Lines() {
+				if untranspose != nil {
+					lineIn, _ = untranspose.Execute(lineIn)
+				}
+				if plain, err = cmdCipher.Decode(lineIn); err != nil {
+					mlog.ErrorE(err)
+					break
+				}
+				decoded = append(decoded, plain)
+			}
+			plain = strings.Join(decoded, "\n")
+			if err == nil && app.IsPipedInput() {
+				fmt.Println(plain)
+			}
 		} else if app.IsPipedInput() {
-			reader := bufio.NewReader(os.Stdin)
+			reader := cmd.StdinReader()
 			scanner := bufio.NewScanner(reader)
 			var lineIn string
 			for scanner.Scan() {
 				lineIn = scanner.Text()
+				if untranspose != nil {
+					lineIn, _ = untranspose.Execute(lineIn)
+				}
 				plain, err = cmdCipher.Decode(lineIn)
 				if err != nil {
 					mlog.ErrorE(err)
@@ -160,16 +385,30 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 				mlog.ErrorE(err)
 			}
 		} else { // short messages that can be given on the CLI
-			plain, err = cmdCipher.Decode(cipher)
+			untransposed := cipher
+			if untranspose != nil {
+				untransposed, _ = untranspose.Execute(cipher)
+			}
+			plain, err = cmdCipher.Decode(untransposed)
 		}
 	} else {
 		operation = "Encrypt"
 		plain = flag.Arg(0)
+		if co.WantsArmor() {
+			armor = ao.NewArmor()
+		}
 		if ao.UseFiles {
 			if co.IsBinary() {
 				err = cmdCipher.EncryptBinFile(ao.Files.Input)
 			} else {
 				err = cmdCipher.EncryptTextFile(ao.Files.Input)
+				if err == nil && transposer != nil {
+					outFile := cmdCipher.GetOutputFilename()
+					err = cmd.TransposeTextFile(outFile, outFile, transposer)
+				}
+				if err == nil && armor != nil {
+					err = cmd.ArmorTextFile(cmdCipher.GetOutputFilename(), armor)
+				}
 			}
 
 			// For round-trip verification if -verify is given
@@ -179,22 +418,23 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 				cipherFilename := cmdCipher.GetOutputFilename()
 
 				if !ao.Common.IsBinary() {
-					err = cmdCipher.DecryptTextFile(cipherFilename, tempOut)
+					err = decryptTextFile(cipherFilename, tempOut)
 				} else {
 					err = cmdCipher.DecryptBinFile(cipherFilename, tempOut)
 				}
 
 				// issue Verify command ONLY if the temporary decrypted file exists
 				if err == nil {
-					postCmd = cmd.NewVerifyFilesCommand(ao.Files.Input, tempOut, cmd.HashMD5)
+					postCmd = cmd.NewVerifyFilesCommand(ao.Files.Input, tempOut, cmd.HashSHA256)
 				} else {
 					tempOut = ""
 				}
 			}
 		} else if app.IsPipedInput() {
-			reader := bufio.NewReader(os.Stdin)
+			reader := cmd.StdinReader()
 			scanner := bufio.NewScanner(reader)
 			var lineIn string
+			encoded := make([]string, 0)
 			for scanner.Scan() {
 				lineIn = scanner.Text()
 				cipher, err = cmdCipher.Encode(lineIn)
@@ -202,18 +442,32 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 					mlog.ErrorE(err)
 					break
 				}
-				fmt.Println(cipher)
+				if armor != nil {
+					encoded = append(encoded, cipher)
+				} else {
+					fmt.Println(cipher)
+				}
 			}
 
 			if err = scanner.Err(); err != nil {
 				mlog.ErrorE(err)
+			} else if armor != nil {
+				armor.Body = strings.Join(encoded, "\n")
+				fmt.Print(armor)
 			}
 		} else {
 			cipher, err = cmdCipher.Encode(plain)
+			if armor != nil {
+				armor.Body = cipher
+			}
 		}
 	}
 
-	if err != nil {
+	if errors.Is(err, crypto.ErrRunningKeyExhausted) {
+		return z.ERR_SEQUENCER, err
+	} else if errors.Is(err, files.ErrHeaderKey) || errors.Is(err, files.ErrHeaderDigest) {
+		return z.ERR_CIPHER, err
+	} else if err != nil {
 		return z.ERR_INTERNAL, err
 	} else if !app.IsPipedInput() {
 		// common output
@@ -223,6 +477,12 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 		fmt.Println("Operation: ", operation)
 		fmt.Printf("Alphabet : %s (Master/Primary)\n", co.Alphabet().Name)
 		fmt.Printf("Alphabet : %s (Slave/Secondary)\n", numbersName)
+		if !keys.IsZero() {
+			fmt.Printf("Keyword  :  %s\n", keys)
+		}
+		if transposer != nil {
+			fmt.Printf("Transpose:  %s\n", transposer)
+		}
 		// assorted parameters
 		switch ao.ItNeeds {
 		case NeedCompositeKey:
@@ -234,8 +494,37 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 
 		case NeedsSecret:
 			fmt.Printf("Secret   :  %s\n", ao.Secret)
+			if hil, ok := cmdCipher.(*commands.HillCommand); ok {
+				fmt.Printf("Matrix   :  det=%d\n%s\n", hil.Key().Determinant(), hil.Key().GetMatrixString("\t"))
+				fmt.Printf("Inverse  :  det=%d\n%s\n", hil.Inverse().Determinant(), hil.Inverse().GetMatrixString("\t"))
+			}
+
+		case NeedsKeyFile:
+			fmt.Printf("Key file :  %s @%s\n", ao.KeyFile, ao.KeyOffset)
 
 		}
+		// the Polybius square family
+		var square *polybius.PolybiusSquare = nil
+		switch sq := cmdCipher.(type) {
+		case *commands.PolybiusCommand:
+			square = sq.Square()
+		case *commands.AdfgvxCommand:
+			fmt.Printf("Transpose:  %s\n", sq.Transposition())
+			square = sq.Square()
+		case *commands.BifidCommand:
+			fmt.Printf("Period   :  %d\n", sq.Period())
+			square = sq.Square()
+		}
+		if square != nil {
+			fmt.Printf("Square   :\n%s\n", square)
+		}
+		if cae, ok := cmdCipher.(*commands.CaesarCommand); ok {
+			fmt.Printf("Mode     :  %s (%s)\n", cae.Options().Variant, cae.Options().LeaderString())
+		}
+		if eni, ok := cmdCipher.(*commands.EnigmaCommand); ok {
+			eni.Machine().Reset() // show the Grundstellung
+			fmt.Printf("Machine  :  %s\n", eni.Machine())
+		}
 		// input/output relations
 		if ao.IsDecode {
 			if ao.UseFiles {
@@ -250,21 +539,30 @@ func DoCrypto(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
 				cipher = ao.Files.Output
 			}
 			fmt.Println("Plain    : ", plain)
-			fmt.Println("Encoded  : ", cipher)
+			if armor != nil && !ao.UseFiles {
+				fmt.Println("Encoded  :")
+				fmt.Print(armor)
+			} else {
+				fmt.Println("Encoded  : ", cipher)
+			}
 		}
 
 		// any post-execution command?
 		if postCmd != nil {
-			// perform the file verification
-			if err = postCmd.Execute(); err == nil {
-				fmt.Println("\t", postCmd)
-				postCmd.GetOutput(true)
-			}
+			// perform the file verification, the round trip must give
+			// back the very same bytes
+			err = postCmd.Execute()
+			fmt.Println("\t", postCmd)
+			postCmd.GetOutput(true)
 
 			// remove temporary file
 			if tempOut != "" {
 				os.Remove(tempOut)
 			}
+			if err != nil {
+				mlog.ErrorE(err)
+				return z.ERR_POST_CMD, err
+			}
 		}
 
 		fmt.Println()
@@ -327,12 +625,28 @@ func main() {
 	case copts.NeedsVersion():
 		fmt.Printf("\tCaesarX cipher app. v%s\n", APP_VERSION)
 		fmt.Println("\t", caesar.Info)
+		fmt.Println("\t", caesar.InfoExtended)
+		fmt.Println("\t", caesar.InfoAugustus)
+		fmt.Println("\t", caesar.InfoTiberius)
 		fmt.Println("\t", caesar.InfoDidimus)
 		fmt.Println("\t", caesar.InfoFibonacci)
 		fmt.Println("\t", bellaso.Info)
 		fmt.Println("\t", vigenere.Info)
+		fmt.Println("\t", beaufort.Info)
+		fmt.Println("\t", beaufort.InfoVariant)
+		fmt.Println("\t", runningkey.Info)
+		fmt.Println("\t", playfair.Info)
+		fmt.Println("\t", hill.Info)
+		fmt.Println("\t", polybius.Info)
+		fmt.Println("\t", polybius.InfoADFGVX)
+		fmt.Println("\t", polybius.InfoBifid)
+		fmt.Println("\t", enigma.Info)
 		exitCode = z.EXIT_CODE_SUCCESS
 
+	// stats...
+	case len(aopts.SubCommand) != 0:
+		exitCode, err = ExecuteSubCommand(copts, aopts)
+
 	// -d or encrypt
 	default:
 		exitCode, err = DoCrypto(copts, aopts)
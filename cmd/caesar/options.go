@@ -13,18 +13,28 @@ import (
 	z "lordofscripts/caesarx"
 	"lordofscripts/caesarx/app"
 	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
 	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/beaufort"
 	"lordofscripts/caesarx/ciphers/bellaso"
 	"lordofscripts/caesarx/ciphers/caesar"
 	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/ciphers/enigma"
+	"lordofscripts/caesarx/ciphers/hill"
+	"lordofscripts/caesarx/ciphers/playfair"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/ciphers/runningkey"
 	"lordofscripts/caesarx/ciphers/vigenere"
 	"lordofscripts/caesarx/cmd"
 	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/cmn/prefs"
+	"lordofscripts/caesarx/cryptanalysis"
 	"lordofscripts/caesarx/internal/bip39"
 	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/files"
 	"lordofscripts/caesarx/internal/sched"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -34,15 +44,27 @@ import (
  *-----------------------------------------------------------------*/
 
 const (
-	FLAG_VARIANT      = "variant" // select encoding algorithm
-	FLAG_NGRAM        = "ngram"   // (only for ENCODE) format output as NGram
-	FLAG_OFFSET       = "offset"  // (only for Didimus) numeric offset to main key
-	FLAG_DECODE       = "d"       // operation: DECODE, if not given operation is ENCODE
-	FLAG_KEY          = "key"     // (only for Caesar, Didimus & Fibonacci) main encoding key
-	FLAG_SECRET       = "secret"  // (only for Vigenère & Bellaso) secret password/phrase
-	FLAG_FILE         = "F"       // ENCODE or DECODE files, free argument(s) are filenames
-	FLAG_VERIFY       = "verify"  // (optional) ignored unless -F is used
-	FLAG_MESSAGE_DATE = "date"    // (optional) Message date, only with both -d -profile
+	FLAG_VARIANT      = "variant"   // select encoding algorithm
+	FLAG_NGRAM        = "ngram"     // format output as NGram (when decoding: it was formatted)
+	FLAG_OFFSET       = "offset"    // (only for Didimus, Augustus & Tiberius) numeric offset to main key
+	FLAG_MODE         = "mode"      // (only for Caesar) extended|augustus|tiberius
+	FLAG_DECODE       = "d"         // operation: DECODE, if not given operation is ENCODE
+	FLAG_KEY          = "key"       // (only for Caesar, Didimus & Fibonacci) main encoding key
+	FLAG_SECRET       = "secret"    // (only for Vigenère, Bellaso & Beaufort) secret password/phrase
+	FLAG_KEYFILE      = "keyfile"   // (only for Running Key) book the key is taken from
+	FLAG_KEYOFFSET    = "keyoffset" // (only for Running Key) N, L:N or C:L:N offset within the book
+	FLAG_KEYWORD      = "keyword"   // (optional) keyword-mixed alphabets KEY, PLAIN: , :CIPHER or PLAIN:CIPHER
+	FLAG_FILLER       = "filler"    // (only for Playfair & Hill) filler & alternate filler letters, Hill nulls
+	FLAG_TRANSPOSE    = "transpose" // (optional) superencipherment with KEY (Columnar) or KEY1,KEY2 (Double Columnar)
+	FLAG_LABELS       = "labels"    // (only for Polybius) row & column labels of the square
+	FLAG_PERIOD       = "period"    // (only for Bifid) letters fractionated together, 0 whole message
+	FLAG_FILE         = "F"         // ENCODE or DECODE files, free argument(s) are filenames
+	FLAG_VERIFY       = "verify"    // (optional) ignored unless -F is used
+	FLAG_MESSAGE_DATE = "date"      // (optional) Message date, only with both -d -profile
+	FLAG_TOP          = "top"       // (only for crack) number of candidates shown
+	FLAG_CRIB         = "crib"      // (only for crack) known plain text of the message
+	FLAG_CRIB_AT      = "at"        // (only for crack) rune position of the crib, -1 unknown
+	FLAG_DECOY        = "decoy"     // (only for decoy) plain text (file if binary) the message should decode into
 )
 
 const (
@@ -59,6 +81,7 @@ const (
 	NeedKey                       // -key
 	NeedCompositeKey              // -key -offset
 	NeedsSecret                   // -secret
+	NeedsKeyFile                  // -keyfile [-keyoffset]
 	NeedOther
 )
 
@@ -85,18 +108,34 @@ type CaesarxOptions struct {
 	VariantVersion string
 	MainKey        cmd.RuneFlag
 	Secret         string
+	KeyFile        string
+	KeyOffset      string
+	Keyword        string
+	Filler         string
+	Transpose      string
+	Labels         string
+	Period         int
+	Top            int
+	Crib           string
+	CribAt         int
+	Decoy          string
 	MessageDate    *cmd.DateFlag
 	NGramSize      int
 	Offset         int
+	Mode           string
 	IsDecode       bool
 	UseFiles       bool
-	OptVerify      bool // ignored unless -F is used
+	OptVerify      bool         // ignored unless -F is used
+	SubCommand     string       // (optional) given before the flags, see SUBCMD_*
+	Armor          *files.Armor // (decode) the armored message, nil if none
 	// derived values
-	ItNeeds   Needs
-	VariantID z.CipherVariant
-	Files     *cmd.FileOptions
-	fileExt   string
-	isReady   bool
+	ItNeeds    Needs
+	VariantID  z.CipherVariant
+	caesarMode caesar.CaesarCipherMode
+	Files      *cmd.FileOptions
+	fileExt    string
+	armorErr   error
+	isReady    bool
 
 	Common *cmd.CommonOptions
 }
@@ -140,17 +179,38 @@ func (c *CaesarxOptions) initialize() {
 		defaultNGram = cmd.AppConfig.Configuration.Defaults.NGramSize
 	}
 
-	flag.StringVar(&c.VariantTag, FLAG_VARIANT, crypto.ALG_NAME_CAESAR, "Algorithm (caesar|didimus|fibonacci|bellaso|vigenere)")
+	flag.StringVar(&c.VariantTag, FLAG_VARIANT, crypto.ALG_NAME_CAESAR, "Algorithm (caesar|didimus|fibonacci|bellaso|vigenere|beaufort|variantbeaufort|runningkey|playfair|hill|polybius|adfgvx|bifid|enigma)")
 	flag.IntVar(&c.NGramSize, FLAG_NGRAM, defaultNGram, "Format encoded output as NGram")
-	flag.IntVar(&c.Offset, FLAG_OFFSET, 0, "Alternate key offset (Didimus)")
+	flag.IntVar(&c.Offset, FLAG_OFFSET, 0, "Alternate key offset (Didimus & Augustus), inner disk shift (Tiberius)")
+	flag.StringVar(&c.Mode, FLAG_MODE, "", "Caesar mode (caesar|extended|augustus|tiberius)")
 	flag.BoolVar(&c.IsDecode, FLAG_DECODE, false, "Decode text")
 	flag.BoolVar(&c.UseFiles, FLAG_FILE, false, "Free argument(s) are/is filename(s)")
 	flag.BoolVar(&c.OptVerify, FLAG_VERIFY, false, "Verify operation (only if -F is used)")
 	flag.Var(&c.MainKey, FLAG_KEY, "Main key")
-	flag.StringVar(&c.Secret, FLAG_SECRET, "", "Secret word/phrase used in Bellaso & Vigenere variants")
+	flag.StringVar(&c.Secret, FLAG_SECRET, "", "Secret word/phrase used in Bellaso, Vigenere, Beaufort & Playfair variants. Hill key word or matrix values. Polybius square keyword. Enigma settings 'B I-II-III 01-12-22 ABC AV BS'")
+	flag.StringVar(&c.KeyFile, FLAG_KEYFILE, "", "Book (text file) the Running Key is read from")
+	flag.StringVar(&c.KeyOffset, FLAG_KEYOFFSET, "0", "Running Key offset within the book: N, L:N or C:L:N")
+	flag.StringVar(&c.Keyword, FLAG_KEYWORD, "", "Keyword-mixed alphabets (Quagmire): KEY, PLAINKEY:, :CIPHERKEY or PLAINKEY:CIPHERKEY")
+	flag.StringVar(&c.Filler, FLAG_FILLER, "", fmt.Sprintf("Playfair filler and alternate filler letters (%c%c), Hill padding nulls (%c)", playfair.DEFAULT_FILLER, playfair.DEFAULT_ALT_FILLER, hill.DEFAULT_NULL))
+	flag.StringVar(&c.Transpose, FLAG_TRANSPOSE, "", "Transposition after the substitution: KEYWORD (Columnar) or KEYWORD1,KEYWORD2 (Double Columnar)")
+	flag.StringVar(&c.Labels, FLAG_LABELS, "", fmt.Sprintf("Polybius row & column labels (%s, %s, %s...)", polybius.LABELS_NUMERIC[:5], polybius.LABELS_ADFGX, polybius.LABELS_ADFGVX))
+	flag.IntVar(&c.Period, FLAG_PERIOD, 0, "Bifid period, 0 for the whole message")
+	flag.IntVar(&c.Top, FLAG_TOP, CRACK_TOP_DEFAULT, "Number of candidate keys shown by crack")
+	flag.StringVar(&c.Crib, FLAG_CRIB, "", "Known plain text (crib) of the message to crack")
+	flag.IntVar(&c.CribAt, FLAG_CRIB_AT, cryptanalysis.CRIB_ANYWHERE, "Rune position of the crib in the ciphered text, -1 if unknown")
+	flag.StringVar(&c.Decoy, FLAG_DECOY, "", "Decoy plain text (file if binary) the message should decode into")
 	flag.Var(c.MessageDate, "date", "Encrypted message full date. Use with both -profile and -d only.")
+	c.SubCommand = popSubCommand()
 	flag.Parse()
 
+	// an armored message presets what the CLI didn't give, the message
+	// date included (Caesarium codebook)
+	if c.IsDecode && len(c.SubCommand) == 0 {
+		if c.Armor, c.armorErr = cmd.ReadArmoredInput(c.UseFiles); c.Armor != nil {
+			c.presetArmor()
+		}
+	}
+
 	// check that user is requesting presets from a profile and that the profile exists. @note perhaps move elsewhere
 	if cmd.AppConfig.IsGood() && c.Common.RequestsProfile() {
 		// Decode with Codebook test, we need the exact date the message was
@@ -174,8 +234,11 @@ func (c *CaesarxOptions) initialize() {
 			} else {
 				mlog.Fatal(z.ERR_PROFILE_CONFIG, "could not get Alpha from profile information")
 			}
-			// Preset slave (optional) alphabet
-			c.Common.PresetSecondaryAlphabet(target.Chained)
+			// Preset slave (optional) alphabet, without one there is none
+			// (like without -num) rather than the default of the cipher
+			if len(target.Chained) != 0 {
+				c.Common.PresetSecondaryAlphabet(target.Chained)
+			}
 			//println("user-profile", "slave", target.Chained)
 			// Preset cipher-specific parameters
 			switch v := target.Params.Item.(type) {
@@ -184,7 +247,7 @@ func (c *CaesarxOptions) initialize() {
 				if c.VariantID == z.DidimusCipher || c.VariantID == z.FibonacciCipher {
 					presetDidimusFibonacci(c, rune(v.Key), int(v.Offset))
 				} else {
-					presetCaesar(c, rune(v.Key))
+					presetCaesar(c, rune(v.Key), v.Mode, int(v.Offset))
 				}
 
 			case *prefs.SecretsModel:
@@ -227,7 +290,7 @@ func (c *CaesarxOptions) initialize() {
 					_, bipSeed = bip.ToSeedAlt(mnemonicSlice, NO_PASSPHRASE)
 				}
 				// generate the recovered Caesarium and preset accordingly
-				warn := c.processCaesarium(target, bipSeed, mnemonicSlice, NO_PASSPHRASE, alpha, time.Now())
+				warn := c.processCaesarium(target, bipSeed, mnemonicSlice, NO_PASSPHRASE, alpha, c.MessageDate.Value)
 				if warn != nil {
 					c.isReady = false
 				}
@@ -254,6 +317,7 @@ func (c *CaesarxOptions) setVersion(variant z.CipherVariant) {
 		c.VariantVersion = caesar.Info.String()
 		c.fileExt = commands.FILE_EXT_CAESAR
 		c.ItNeeds = NeedKey
+		c.setCaesarMode()
 
 	case z.DidimusCipher:
 		c.VariantID = z.DidimusCipher
@@ -289,6 +353,69 @@ func (c *CaesarxOptions) setVersion(variant z.CipherVariant) {
 		c.VariantVersion = affine.Info.String()
 		c.fileExt = commands.FILE_EXT_AFFINE
 		c.ItNeeds = NeedNone
+
+	case z.BeaufortCipher:
+		c.VariantID = z.BeaufortCipher
+		c.VariantTag = crypto.ALG_NAME_BEAUFORT
+		c.VariantVersion = beaufort.Info.String()
+		c.fileExt = commands.FILE_EXT_BEAUFORT
+		c.ItNeeds = NeedsSecret
+
+	case z.VariantBeaufortCipher:
+		c.VariantID = z.VariantBeaufortCipher
+		c.VariantTag = crypto.ALG_NAME_VARIANT_BEAUFORT
+		c.VariantVersion = beaufort.InfoVariant.String()
+		c.fileExt = commands.FILE_EXT_VARIANT_BEAUFORT
+		c.ItNeeds = NeedsSecret
+
+	case z.RunningKeyCipher:
+		c.VariantID = z.RunningKeyCipher
+		c.VariantTag = crypto.ALG_NAME_RUNNINGKEY
+		c.VariantVersion = runningkey.Info.String()
+		c.fileExt = commands.FILE_EXT_RUNNINGKEY
+		c.ItNeeds = NeedsKeyFile
+
+	case z.PlayfairCipher:
+		c.VariantID = z.PlayfairCipher
+		c.VariantTag = playfair.ALG_NAME_PLAYFAIR
+		c.VariantVersion = playfair.Info.String()
+		c.fileExt = commands.FILE_EXT_PLAYFAIR
+		c.ItNeeds = NeedsSecret
+
+	case z.HillCipher:
+		c.VariantID = z.HillCipher
+		c.VariantTag = hill.ALG_NAME_HILL
+		c.VariantVersion = hill.Info.String()
+		c.fileExt = commands.FILE_EXT_HILL
+		c.ItNeeds = NeedsSecret
+
+	case z.PolybiusCipher:
+		c.VariantID = z.PolybiusCipher
+		c.VariantTag = polybius.ALG_NAME_POLYBIUS
+		c.VariantVersion = polybius.Info.String()
+		c.fileExt = commands.FILE_EXT_POLYBIUS
+		c.ItNeeds = NeedNone
+
+	case z.AdfgvxCipher:
+		c.VariantID = z.AdfgvxCipher
+		c.VariantTag = polybius.ALG_NAME_ADFGVX
+		c.VariantVersion = polybius.InfoADFGVX.String()
+		c.fileExt = commands.FILE_EXT_ADFGVX
+		c.ItNeeds = NeedsSecret
+
+	case z.BifidCipher:
+		c.VariantID = z.BifidCipher
+		c.VariantTag = polybius.ALG_NAME_BIFID
+		c.VariantVersion = polybius.InfoBifid.String()
+		c.fileExt = commands.FILE_EXT_BIFID
+		c.ItNeeds = NeedNone
+
+	case z.EnigmaCipher:
+		c.VariantID = z.EnigmaCipher
+		c.VariantTag = enigma.ALG_NAME_ENIGMA
+		c.VariantVersion = enigma.Info.String()
+		c.fileExt = commands.FILE_EXT_ENIGMA
+		c.ItNeeds = NeedsSecret
 	}
 }
 
@@ -328,6 +455,7 @@ func (c *CaesarxOptions) checkAlterEgo() {
 			c.VariantID = z.CaesarCipher
 			c.fileExt = commands.FILE_EXT_CAESAR
 			c.ItNeeds = NeedKey
+			c.setCaesarMode()
 
 		case strings.ToLower(crypto.ALG_NAME_DIDIMUS):
 			c.VariantID = z.DidimusCipher
@@ -353,19 +481,223 @@ func (c *CaesarxOptions) checkAlterEgo() {
 			c.VariantID = z.AffineCipher
 			c.fileExt = commands.FILE_EXT_AFFINE
 			c.ItNeeds = NeedNone
+
+		case strings.ToLower(crypto.ALG_NAME_BEAUFORT):
+			c.VariantID = z.BeaufortCipher
+			c.VariantVersion = beaufort.Info.String()
+			c.fileExt = commands.FILE_EXT_BEAUFORT
+			c.ItNeeds = NeedsSecret
+
+		case strings.ToLower(crypto.ALG_NAME_VARIANT_BEAUFORT):
+			c.VariantID = z.VariantBeaufortCipher
+			c.VariantVersion = beaufort.InfoVariant.String()
+			c.fileExt = commands.FILE_EXT_VARIANT_BEAUFORT
+			c.ItNeeds = NeedsSecret
+
+		case strings.ToLower(crypto.ALG_NAME_RUNNINGKEY):
+			c.VariantID = z.RunningKeyCipher
+			c.VariantVersion = runningkey.Info.String()
+			c.fileExt = commands.FILE_EXT_RUNNINGKEY
+			c.ItNeeds = NeedsKeyFile
+
+		case strings.ToLower(playfair.ALG_NAME_PLAYFAIR):
+			c.VariantID = z.PlayfairCipher
+			c.VariantVersion = playfair.Info.String()
+			c.fileExt = commands.FILE_EXT_PLAYFAIR
+			c.ItNeeds = NeedsSecret
+
+		case strings.ToLower(hill.ALG_NAME_HILL):
+			c.VariantID = z.HillCipher
+			c.VariantVersion = hill.Info.String()
+			c.fileExt = commands.FILE_EXT_HILL
+			c.ItNeeds = NeedsSecret
+
+		case strings.ToLower(polybius.ALG_NAME_POLYBIUS):
+			c.VariantID = z.PolybiusCipher
+			c.VariantVersion = polybius.Info.String()
+			c.fileExt = commands.FILE_EXT_POLYBIUS
+			c.ItNeeds = NeedNone
+
+		case strings.ToLower(polybius.ALG_NAME_ADFGVX), strings.ToLower(polybius.LABELS_ADFGX):
+			c.VariantID = z.AdfgvxCipher
+			c.VariantVersion = polybius.InfoADFGVX.String()
+			c.fileExt = commands.FILE_EXT_ADFGVX
+			c.ItNeeds = NeedsSecret
+
+		case strings.ToLower(polybius.ALG_NAME_BIFID):
+			c.VariantID = z.BifidCipher
+			c.VariantVersion = polybius.InfoBifid.String()
+			c.fileExt = commands.FILE_EXT_BIFID
+			c.ItNeeds = NeedNone
+
+		case strings.ToLower(enigma.ALG_NAME_ENIGMA):
+			c.VariantID = z.EnigmaCipher
+			c.VariantVersion = enigma.Info.String()
+			c.fileExt = commands.FILE_EXT_ENIGMA
+			c.ItNeeds = NeedsSecret
+		}
+	}
+}
+
+// the variant is given by -variant, a profile or the executable name,
+// else it may be taken from the FileHeader of a binary file.
+func (c *CaesarxOptions) variantGiven() bool {
+	given := c.Common.RequestsProfile()
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == FLAG_VARIANT {
+			given = true
+		}
+	})
+	switch os.Args[0] {
+	case APP_NAME_ALT1, APP_NAME_ALT2, APP_NAME_ALT3, APP_NAME_ALT4:
+		given = true
+	}
+
+	return given
+}
+
+// the headers of the armored message preset the alphabets, the date
+// and (if not given) the variant. Errors are reported by Validate().
+func (c *CaesarxOptions) presetArmor() {
+	if c.armorErr = c.Common.PresetArmor(c.Armor); c.armorErr != nil {
+		return
+	}
+
+	if !c.MessageDate.IsSet && !c.Armor.Date.IsZero() {
+		c.MessageDate.Value = c.Armor.Date
+		c.MessageDate.IsSet = true
+	}
+	if !c.variantGiven() {
+		if !app.IsPipedInput() {
+			mlog.Console.Info("Cipher %s from the armored message\n", c.Armor.Variant)
+		}
+		c.setVersion(c.Armor.Variant)
+	}
+}
+
+// the armor of the ciphered text, its headers taken from the options
+func (c *CaesarxOptions) NewArmor() *files.Armor {
+	armor := files.NewArmor(c.VariantID, c.Common.Alphabet().LangCodeISO())
+	if numbers := c.Common.Numbers(); numbers != nil {
+		armor.Chained = numbers.LangCodeISO()
+	}
+	if c.NGramSize > 0 {
+		armor.NGram = c.NGramSize
+	}
+	armor.Date = c.MessageDate.Value
+	armor.Profile = c.Common.GetRequestedProfile()
+
+	return armor
+}
+
+// the FileHeader (if any) of the binary file to decrypt selects the
+// variant when none was given, else it must name the given one. The
+// original extension is restored when the output filename has none.
+func (c *CaesarxOptions) checkFileHeader() (int, error) {
+	header, err := files.PeekFileHeader(c.Files.Input)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+	if header == nil {
+		return z.EXIT_CODE_SUCCESS, nil // v1.0 or -noheader
+	}
+
+	if !c.variantGiven() {
+		if header.Start.AlgorithmA == z.AffineCipher {
+			return z.ERR_CLI_OPTIONS, fmt.Errorf("the file was encrypted with %s, please use the 'affine' program instead", header.Start.AlgorithmA)
 		}
+		mlog.Console.Info("Cipher %s from the file header\n", header.Start.AlgorithmA)
+		c.setVersion(header.Start.AlgorithmA)
+	} else if err = header.CheckCipher(c.VariantID); err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	if len(filepath.Ext(c.Files.Output)) == 0 {
+		c.Files.Output += header.FileExtension()
+	}
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the Caesar modes other than plain Caesar have their own sequencer,
+// file extension and (Augustus & Tiberius) an offset. An invalid mode
+// is reported by Validate().
+func (c *CaesarxOptions) setCaesarMode() {
+	mode, err := caesar.ParseCaesarMode(c.Mode)
+	if err != nil {
+		return
+	}
+
+	c.caesarMode = mode
+	c.fileExt = commands.CaesarFileExtension(mode)
+	switch mode {
+	case caesar.CAESAR_EXTENDED:
+		c.VariantVersion = caesar.InfoExtended.String()
+	case caesar.CAESAR_AUGUSTUS:
+		c.VariantVersion = caesar.InfoAugustus.String()
+		c.ItNeeds = NeedCompositeKey
+	case caesar.CAESAR_TIBERIUS:
+		c.VariantVersion = caesar.InfoTiberius.String()
+		c.ItNeeds = NeedCompositeKey
+	}
+}
+
+// the options of the chosen Caesar mode
+func (c *CaesarxOptions) CaesarOptions() *caesar.CaesarOptions {
+	switch c.caesarMode {
+	case caesar.CAESAR_EXTENDED:
+		return caesar.NewCaesarExtendedOpts(c.MainKey.Value)
+	case caesar.CAESAR_AUGUSTUS:
+		return caesar.NewCaesarAugustusOpts(c.MainKey.Value, uint(c.Offset))
+	case caesar.CAESAR_TIBERIUS:
+		return caesar.NewCaesarTiberiusOpts(c.MainKey.Value, uint(c.Offset))
+	default:
+		return caesar.NewCaesarOpts(c.MainKey.Value)
 	}
 }
 
 func (c *CaesarxOptions) ShowUsage(name string) {
 	fmt.Println("Options for ALL variants:")
-	fmt.Println("\t[-alpha ALPHABET] [-ngram SIZE] [-F [-verify]] [-d]")
+	fmt.Println("\t[-alpha ALPHABET] [-ngram SIZE] [-F [-verify]] [-d] [-armor] [-keyword KEYWORD] [-transpose KEY[,KEY2]]")
 	fmt.Println("Caesar & Fibonacci variants")
-	fmt.Printf("\t%s -variant NAME -key LETTER [other options] 'user text'", name)
+	fmt.Printf("\t%s -variant NAME -key LETTER [other options] 'user text'\n", name)
 	fmt.Println("Didimus variant")
-	fmt.Printf("\t%s -variant didimus -key LETTER -offset NUMBER [other options] 'user text'", name)
-	fmt.Println("Bellaso & Vigenère variants")
-	fmt.Printf("\t%s -variant NAME -secret 'password' [other options] 'user text'", name)
+	fmt.Printf("\t%s -variant didimus -key LETTER -offset NUMBER [other options] 'user text'\n", name)
+	fmt.Println("Caesar Extended mode (digits, punctuation & symbols)")
+	fmt.Printf("\t%s -variant caesar -mode extended -key LETTER [other options] 'user text'\n", name)
+	fmt.Println("Caesar Augustus & Tiberius modes (oscillating or two independent disks)")
+	fmt.Printf("\t%s -variant caesar -mode augustus|tiberius -key LETTER -offset NUMBER [other options] 'user text'\n", name)
+	fmt.Println("Bellaso, Vigenère, Beaufort & VariantBeaufort variants")
+	fmt.Printf("\t%s -variant NAME -secret 'password' [other options] 'user text'\n", name)
+	fmt.Println("RunningKey variant")
+	fmt.Printf("\t%s -variant runningkey -keyfile BOOK [-keyoffset C:L:N] [other options] 'user text'\n", name)
+	fmt.Println("Playfair variant (text only, -num A for the 6x6 square)")
+	fmt.Printf("\t%s -variant playfair -secret 'keyword' [-filler XQ] [other options] 'user text'\n", name)
+	fmt.Println("Hill variant (text only, key of 4/9 letters or matrix values)")
+	fmt.Printf("\t%s -variant hill -secret 'HILL'|'3 3 2 5' [-filler NULLS] [other options] 'user text'\n", name)
+	fmt.Println("Polybius variant (text only, optional square keyword)")
+	fmt.Printf("\t%s -variant polybius [-secret 'keyword'] [-labels ADFGX] [other options] 'user text'\n", name)
+	fmt.Println("ADFGX/ADFGVX variant (text only, -num A for the 6x6 ADFGVX square)")
+	fmt.Printf("\t%s -variant adfgvx -secret 'keyword' -transpose KEY[,KEY2] [other options] 'user text'\n", name)
+	fmt.Println("Bifid variant (text only, optional square keyword)")
+	fmt.Printf("\t%s -variant bifid [-secret 'keyword'] [-period N] [other options] 'user text'\n", name)
+	fmt.Println("Enigma variant (text only, English, settings in key sheet notation)")
+	fmt.Printf("\t%s -variant enigma -secret 'B I-II-III 01-12-22 ABC AV BS' [other options] 'user text'\n", name)
+	fmt.Println("Frequency analysis (histogram, IC, Chi² & bigrams)")
+	fmt.Printf("\t%s %s [-alpha ALPHABET] 'user text' | -F filename\n", name, SUBCMD_STATS)
+	fmt.Println("Key cracker (Caesar, Didimus & Fibonacci by brute force, Bellaso & Vigenère by key length)")
+	fmt.Printf("\t%s %s -variant NAME [-alpha ALPHABET|auto] [-top N] 'ciphered text' | -F filename\n", name, SUBCMD_CRACK)
+	fmt.Println("Known plain text (crib) attack (Caesar, Didimus, Fibonacci & Bellaso)")
+	fmt.Printf("\t%s %s -variant NAME -crib 'plain text' [-at POSITION] [-alpha ALPHABET|auto] 'ciphered text' | -F filename\n", name, SUBCMD_CRACK)
+	fmt.Println("Binary file key recovery from its file signature (PNG, JPEG, PDF, ZIP, ELF...)")
+	fmt.Printf("\t%s %s -variant NAME -alpha binary -F filename\n", name, SUBCMD_CRACK)
+	fmt.Println("Variant identification (ranked guesses & the command that cracks them)")
+	fmt.Printf("\t%s %s [-num N|A|H|E] [-top N] 'ciphered text' | -F filename\n", name, SUBCMD_IDENTIFY)
+	fmt.Println("Keyspace, cracking effort & weak key warnings of a cipher configuration")
+	fmt.Printf("\t%s %s -variant NAME -key LETTER | -secret 'password' [-alpha ALPHABET] ['user text' | -F filename]\n", name, SUBCMD_STRENGTH)
+	fmt.Println("Deniable decoy key (Bellaso secret as a profile, binary one-time pad as a Running Key book)")
+	fmt.Printf("\t%s %s -variant bellaso -decoy 'decoy text' [-alpha ALPHABET] 'ciphered text' | -F filename\n", name, SUBCMD_DECOY)
+	fmt.Printf("\t%s %s -variant runningkey -alpha binary -decoy DECOYFILE -keyfile PADFILE -F filename\n", name, SUBCMD_DECOY)
 }
 
 func (c *CaesarxOptions) IsReady() bool {
@@ -381,6 +713,13 @@ func (c *CaesarxOptions) Validate() (int, error) {
 	var err error = nil
 	var exitCode int = z.EXIT_CODE_SUCCESS
 
+	if len(c.SubCommand) != 0 {
+		if exitCode, err = c.validateSubCommand(); err == nil {
+			c.isReady = true
+		}
+		return exitCode, err
+	}
+
 	// firewall
 	if c.VariantID == z.AffineCipher {
 		// Affine not supported by caesarx executable but by its own affine program
@@ -389,6 +728,19 @@ func (c *CaesarxOptions) Validate() (int, error) {
 
 	}
 
+	// the armor is for text, the armored message must be for this variant
+	if c.Common.WantsArmor() && c.Common.IsBinary() {
+		return z.ERR_CLI_OPTIONS, cmd.ErrArmorBinary
+	}
+	if c.armorErr != nil {
+		return z.ERR_PARAMETER, c.armorErr
+	}
+	if c.Armor != nil {
+		if err = c.Armor.CheckCipher(c.VariantID); err != nil {
+			return z.ERR_PARAMETER, err
+		}
+	}
+
 	// check basic needs. Except in DEMO mode
 	if !c.Common.NeedsDemo() {
 		// check nr. of free arguments
@@ -410,6 +762,11 @@ func (c *CaesarxOptions) Validate() (int, error) {
 						// now we know we have sufficient free args
 						if c.IsDecode {
 							c.Files = cmd.NewFileOptions(flag.Arg(0), flag.Arg(1))
+							if c.Common.IsBinary() {
+								if exitCode, err = c.checkFileHeader(); err != nil {
+									return exitCode, err
+								}
+							}
 						} else {
 							// @note in Ring 1 the encrypted filename is auto-generated, we use the same spec here
 							outputFilename := cmn.NewNameExtOnly(flag.Arg(0), c.fileExt, true)
@@ -450,6 +807,18 @@ func (c *CaesarxOptions) Validate() (int, error) {
 				exitCode = z.ERR_CLI_OPTIONS
 			}
 
+		case NeedsKeyFile:
+			if len(c.KeyFile) == 0 {
+				err = fmt.Errorf("needs a book to take the key from '%s FILENAME'", FLAG_KEYFILE)
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if !app.FileExists(c.KeyFile) {
+				err = fmt.Errorf("key file '%s' does not exist", c.KeyFile)
+				exitCode = z.ERR_FILE_IO
+			} else if _, errO := crypto.ParseBookOffset(c.KeyOffset); errO != nil {
+				err = errO
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+
 		case NeedOther:
 
 		case NeedNone:
@@ -459,6 +828,80 @@ func (c *CaesarxOptions) Validate() (int, error) {
 			exitCode = z.ERR_INTERNAL
 		}
 
+		// keyword-mixed alphabets make no sense for binary data
+		if len(c.Keyword) != 0 && c.Common.IsBinary() {
+			err = fmt.Errorf("'%s' cannot be used with a binary alphabet", FLAG_KEYWORD)
+			exitCode = z.ERR_CLI_OPTIONS
+		}
+
+		// transposition works on text (runes) only
+		if len(c.Transpose) != 0 {
+			if c.Common.IsBinary() {
+				err = fmt.Errorf("'%s' cannot be used with a binary alphabet", FLAG_TRANSPOSE)
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if _, errT := ciphers.ParseTransposition(c.Transpose); errT != nil {
+				err = errT
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+		}
+
+		// Playfair is a pen & paper cipher with two distinct fillers
+		if c.VariantID == z.PlayfairCipher {
+			if c.Common.IsBinary() {
+				err = playfair.ErrBinaryFile
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if len(c.Filler) != 0 && len([]rune(c.Filler)) != 2 {
+				err = fmt.Errorf("'%s' needs two letters: filler & alternate filler", FLAG_FILLER)
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+		}
+
+		// Hill is a pen & paper cipher too, the key matrix is checked later
+		if c.VariantID == z.HillCipher && c.Common.IsBinary() {
+			err = hill.ErrBinaryFile
+			exitCode = z.ERR_CLI_OPTIONS
+		}
+
+		// the Polybius square family is text-only, ADFGVX transposes the labels
+		switch c.VariantID {
+		case z.PolybiusCipher, z.AdfgvxCipher, z.BifidCipher:
+			if c.Common.IsBinary() {
+				err = polybius.ErrBinaryFile
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if c.VariantID == z.AdfgvxCipher && len(c.Transpose) == 0 {
+				err = fmt.Errorf("needs the columnar transposition '%s KEY[,KEY2]'", FLAG_TRANSPOSE)
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if c.VariantID == z.BifidCipher && c.Period < 0 {
+				err = polybius.ErrPeriod
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+		}
+
+		// the Enigma rotors are wired for the letters only
+		if c.VariantID == z.EnigmaCipher {
+			if c.Common.IsBinary() {
+				err = enigma.ErrBinaryFile
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if c.Common.Numbers() != nil {
+				err = enigma.ErrChain
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if _, errE := enigma.ParseSettings(c.Secret); len(c.Secret) != 0 && errE != nil {
+				err = errE
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+		}
+
+		// the modes only apply to the Caesar variant
+		if len(c.Mode) != 0 {
+			if c.VariantID != z.CaesarCipher {
+				err = fmt.Errorf("'%s' only applies to the %s variant", FLAG_MODE, crypto.ALG_NAME_CAESAR)
+				exitCode = z.ERR_CLI_OPTIONS
+			} else if _, errM := caesar.ParseCaesarMode(c.Mode); errM != nil {
+				err = errM
+				exitCode = z.ERR_CLI_OPTIONS
+			}
+		}
+
 		// validate NGramSize
 		if !c.isValidNGram() {
 			err = ErrNGramSize
@@ -475,12 +918,12 @@ func (c *CaesarxOptions) Validate() (int, error) {
 }
 
 // isValidNGram verifies the NGram size validity. If it is
-// out of context it is ignored (returns true). It is only
-// checked for Encoding operations provided it has been set
-// via the CLI
+// out of context it is ignored (returns true). It is checked
+// provided it has been set via the CLI, when decoding it tells
+// that the transposed text was NGram formatted.
 func (c *CaesarxOptions) isValidNGram() bool {
 	valid := true
-	if !c.IsDecode && c.NGramSize != cmd.DEFAULT_UNSET_NGRAM {
+	if c.NGramSize != cmd.DEFAULT_UNSET_NGRAM {
 		if c.NGramSize < 2 || c.NGramSize > 5 {
 			valid = false
 		}
@@ -504,7 +947,7 @@ func (c *CaesarxOptions) processCaesarium(recipient *prefs.Recipient, seed uint6
 	case z.CaesarCipher:
 		mlog.Console.Info("With %s from Caesarium\n", cipherMode)
 		var key rune = rune(csm.CompileCaesarBook()[dayOffset])
-		presetCaesar(c, key)
+		presetCaesar(c, key, "", 0)
 
 	case z.DidimusCipher:
 		fallthrough
@@ -517,9 +960,7 @@ func (c *CaesarxOptions) processCaesarium(recipient *prefs.Recipient, seed uint6
 		key := alpha.GetRuneAt(composite.A)
 		presetDidimusFibonacci(c, key, composite.B)
 
-	case z.BellasoCipher:
-		fallthrough
-	case z.VigenereCipher:
+	case z.BellasoCipher, z.VigenereCipher, z.BeaufortCipher, z.VariantBeaufortCipher:
 		mlog.Console.Info("With %s from Caesarium\n", cipherMode)
 		// get that day's secret word
 		geheim := csm.CompileWordBook(sched.DEFAULT_SECRET_LENGTH)[dayOffset]
@@ -549,11 +990,18 @@ func (c *CaesarxOptions) processCaesarium(recipient *prefs.Recipient, seed uint6
  *							F u n c t i o n s
  *-----------------------------------------------------------------*/
 
-// preset configuration for Caesar handling
-func presetCaesar(c *CaesarxOptions, key rune) {
+// preset configuration for Caesar handling, the mode & offset are only
+// used by the Extended, Augustus & Tiberius modes.
+func presetCaesar(c *CaesarxOptions, key rune, mode string, offset int) {
 	c.MainKey.Value = key
 	c.MainKey.IsSet = true
 	c.ItNeeds = NeedKey
+	if len(c.Mode) == 0 { // the CLI has the last word
+		c.Mode = mode
+	}
+	if c.Offset == 0 {
+		c.Offset = offset
+	}
 }
 
 // preset configuration for Didimus or Fibonacci handling
@@ -565,7 +1013,7 @@ func presetDidimusFibonacci(c *CaesarxOptions, key rune, offset int) {
 	c.ItNeeds = NeedCompositeKey
 }
 
-// preset the configuration for Bellaso or Vigenère handling
+// preset the configuration for Bellaso, Vigenère or Beaufort handling
 func presetBellasoVigenere(c *CaesarxOptions, secret string) {
 	c.Secret = secret
 	c.ItNeeds = NeedsSecret
@@ -0,0 +1,73 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The "stats" sub-command. Frequency analysis of a (plain or ciphered)
+ * text against the reference distribution of the chosen alphabet.
+ *	caesarx stats [-alpha ALPHABET] 'text' | -F filename
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cryptanalysis"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	STATS_HISTOGRAM_WIDTH = 40 // longest histogram bar
+	STATS_TOP_BIGRAMS     = 10 // number of bigrams listed
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *CaesarxOptions) validateStats() (int, error) {
+	return c.validateAnalysisInput()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteStats prints the letter histogram of the text next to the
+// expected distribution of the chosen alphabet's language, the Index
+// of Coincidence, the Chi-Squared and the most common bigrams.
+func ExecuteStats(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	text, err := analysisInput(ao)
+	if err != nil {
+		return z.ERR_FILE_IO, err
+	}
+
+	alpha := co.Alphabet()
+	ref := cryptanalysis.StatsFor(alpha)
+	table := cryptanalysis.CountFrequencies(text, alpha)
+
+	fmt.Println("Alphabet : ", alpha.Name)
+	fmt.Printf("Letters  :  %d of %d characters\n", table.Total(), len([]rune(text)))
+	if ref != nil {
+		fmt.Printf("IC       :  %.4f (%s %.4f, random %.4f)\n", table.IndexOfCoincidence(), ref.LangCode, ref.ExpectedIC(), ref.RandomIC())
+		fmt.Printf("Chi²     :  %.2f\n", table.ChiSquared(ref))
+		fmt.Println("Histogram:  observed | expected")
+	} else {
+		fmt.Printf("IC       :  %.4f\n", table.IndexOfCoincidence())
+		fmt.Println("Histogram:  observed (no reference for this alphabet)")
+	}
+	fmt.Print(table.Histogram(ref, STATS_HISTOGRAM_WIDTH))
+
+	bigrams := make([]string, 0, STATS_TOP_BIGRAMS)
+	for _, bigram := range table.TopBigrams(STATS_TOP_BIGRAMS) {
+		bigrams = append(bigrams, fmt.Sprintf("%s %.2f%%", bigram.NGram, bigram.Value*100))
+	}
+	fmt.Println("Bigrams  : ", strings.Join(bigrams, ", "))
+	fmt.Println()
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
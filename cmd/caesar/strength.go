@@ -0,0 +1,134 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The "strength" sub-command. Reports the keyspace of a cipher
+ * configuration, given with the same flags as an encode, the effort
+ * the crackers need to break it and warns about the weak choices.
+ * The (optional) message is compared with the unicity distance.
+ *	caesarx strength -variant caesar|didimus|fibonacci -key LETTER [-offset N] [-alpha ALPHABET] ['text' | -F filename]
+ *	caesarx strength -variant bellaso|vigenere -secret 'password' [-alpha ALPHABET] ['text' | -F filename]
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmd"
+	"lordofscripts/caesarx/cryptanalysis"
+	"math"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrStrengthAffine = errors.New("the Affine strength is reported by 'affine -strength -A N -B N'")
+)
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *CaesarxOptions) validateStrength() (int, error) {
+	switch c.VariantID {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher, z.BellasoCipher, z.VigenereCipher:
+	case z.AffineCipher:
+		return z.ERR_PARAMETER, ErrStrengthAffine
+	default:
+		return z.ERR_PARAMETER, cryptanalysis.ErrStrengthVariant
+	}
+	if c.VariantID == z.CaesarCipher && c.caesarMode != caesar.CAESAR {
+		return z.ERR_PARAMETER, ErrCrackMode
+	}
+
+	switch c.ItNeeds {
+	case NeedCompositeKey:
+		if c.Offset <= 0 {
+			return z.ERR_CLI_OPTIONS, fmt.Errorf("needs offset '%s INTEGER' for composite key", FLAG_OFFSET)
+		}
+		fallthrough
+	case NeedKey:
+		if !c.MainKey.IsSet {
+			return z.ERR_CLI_OPTIONS, fmt.Errorf("needs main key '%s LETTER'", FLAG_KEY)
+		}
+	case NeedsSecret:
+		if len(c.Secret) == 0 {
+			return z.ERR_CLI_OPTIONS, fmt.Errorf("needs a secret password or phrase '%s 'SECRET'", FLAG_SECRET)
+		}
+	}
+
+	// the message is optional
+	if !app.IsPipedInput() && flag.NArg() == 0 {
+		return z.EXIT_CODE_SUCCESS, nil
+	}
+
+	return c.validateAnalysisInput()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ExecuteStrength prints the keyspace, unicity distance & cracking
+// effort of the configuration followed by its warnings, if any.
+func ExecuteStrength(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	alpha := co.Alphabet()
+	letters := 0
+	if app.IsPipedInput() || flag.NArg() != 0 {
+		text, err := analysisInput(ao)
+		if err != nil {
+			return z.ERR_FILE_IO, err
+		}
+		for _, r := range alpha.ToUpperString(text) {
+			if alpha.PositionOf(r) != -1 {
+				letters++
+			}
+		}
+	}
+
+	config := &cryptanalysis.KeyConfig{
+		Variant:  ao.VariantID,
+		Alphabet: alpha,
+		Key:      ao.MainKey.Value,
+		Offset:   ao.Offset,
+		Secret:   ao.Secret,
+	}
+	report, err := cryptanalysis.NewStrengthAnalyzer().Analyze(config, letters)
+	if err != nil {
+		return z.ERR_PARAMETER, err
+	}
+
+	PrintStrength(report, letters)
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+// the report, the message length is shown if there was one
+func PrintStrength(report *cryptanalysis.StrengthReport, letters int) {
+	fmt.Println("Variant  : ", report.Config.Variant)
+	fmt.Printf("Alphabet :  %s (%d letters)\n", report.Config.Alphabet.Name, report.Config.Alphabet.Size())
+	if report.Period > 1 {
+		fmt.Printf("Period   :  %d key letters\n", report.Period)
+	}
+	fmt.Printf("Keyspace :  %s (2^%.1f)\n", report.Keyspace, report.Bits)
+	if report.Unicity != 0 {
+		fmt.Printf("Unicity  :  %.0f letters\n", report.Unicity)
+	}
+	if letters != 0 {
+		fmt.Printf("Message  :  %d letters\n", letters)
+	}
+	if math.IsInf(report.Effort, 1) {
+		fmt.Printf("Effort   :  out of reach of the crackers\n")
+	} else {
+		fmt.Printf("Effort   :  %.0f trial decryptions (%s)\n", report.Effort, report.Cracker)
+	}
+	for _, warning := range report.Warnings {
+		fmt.Printf("\t%s\n", warning)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,129 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * CaesarX sub-commands. They are given as the first CLI argument,
+ * before the flags, i.e. "caesarx stats -alpha german -F text.txt".
+ * They reuse the same flags of the cipher operations.
+ *-----------------------------------------------------------------*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app"
+	"lordofscripts/caesarx/cmd"
+	"os"
+	"slices"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	SUBCMD_STATS    = "stats"    // frequency analysis of a text
+	SUBCMD_CRACK    = "crack"    // brute-force the key of a ciphered text
+	SUBCMD_IDENTIFY = "identify" // guess the variant of a ciphered text
+	SUBCMD_STRENGTH = "strength" // keyspace & weaknesses of a cipher configuration
+	SUBCMD_DECOY    = "decoy"    // deniable key of a ciphered message
+)
+
+var subCommands = []string{SUBCMD_STATS, SUBCMD_CRACK, SUBCMD_IDENTIFY, SUBCMD_STRENGTH, SUBCMD_DECOY}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// validates the free arguments & flags of the requested sub-command
+func (c *CaesarxOptions) validateSubCommand() (int, error) {
+	switch c.SubCommand {
+	case SUBCMD_STATS:
+		return c.validateStats()
+	case SUBCMD_CRACK:
+		return c.validateCrack()
+	case SUBCMD_IDENTIFY:
+		return c.validateIdentify()
+	case SUBCMD_STRENGTH:
+		return c.validateStrength()
+	case SUBCMD_DECOY:
+		return c.validateDecoy()
+	}
+
+	return z.ERR_CLI_OPTIONS, fmt.Errorf("unknown sub-command '%s'", c.SubCommand)
+}
+
+// the sub-commands that analyze a text take it from a pipe, a text
+// file (-F) or the single free argument.
+func (c *CaesarxOptions) validateAnalysisInput() (int, error) {
+	if c.Common.IsBinary() {
+		return z.ERR_PARAMETER, fmt.Errorf("'%s' only works with text", c.SubCommand)
+	}
+
+	if app.IsPipedInput() {
+		if c.UseFiles {
+			return z.ERR_PARAMETER, ErrPipeOutOnly
+		}
+	} else if flag.NArg() != 1 {
+		if c.UseFiles {
+			return z.ERR_PARAMETER, ErrFilesRequired
+		}
+		return z.ERR_PARAMETER, ErrFreeTextRequired
+	} else if c.UseFiles {
+		c.Files = cmd.NewFileOptions(flag.Arg(0), "")
+	}
+
+	return z.EXIT_CODE_SUCCESS, nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// Takes the sub-command (if any) out of the CLI arguments so that the
+// flag package can parse the rest. It must be done before flag.Parse()
+func popSubCommand() string {
+	if len(os.Args) > 1 && slices.Contains(subCommands, os.Args[1]) {
+		subCommand := os.Args[1]
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+		return subCommand
+	}
+
+	return ""
+}
+
+// ExecuteSubCommand executes the sub-command given in the CLI
+func ExecuteSubCommand(co *cmd.CommonOptions, ao *CaesarxOptions) (int, error) {
+	switch ao.SubCommand {
+	case SUBCMD_STATS:
+		return ExecuteStats(co, ao)
+	case SUBCMD_CRACK:
+		return ExecuteCrack(co, ao)
+	case SUBCMD_IDENTIFY:
+		return ExecuteIdentify(co, ao)
+	case SUBCMD_STRENGTH:
+		return ExecuteStrength(co, ao)
+	case SUBCMD_DECOY:
+		return ExecuteDecoy(co, ao)
+	}
+
+	return z.ERR_CLI_OPTIONS, fmt.Errorf("unknown sub-command '%s'", ao.SubCommand)
+}
+
+// the text to analyze, from a pipe, a text file or the free argument
+func analysisInput(ao *CaesarxOptions) (string, error) {
+	switch {
+	case app.IsPipedInput():
+		data, err := io.ReadAll(os.Stdin)
+		return string(data), err
+
+	case ao.Files != nil:
+		data, err := os.ReadFile(ao.Files.Input)
+		return string(data), err
+
+	default:
+		return flag.Arg(0), nil
+	}
+}
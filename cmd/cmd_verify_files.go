@@ -7,6 +7,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"lordofscripts/caesarx/cmn"
 )
@@ -21,6 +22,10 @@ const (
 	HashSHA256
 )
 
+var (
+	ErrVerifyFiles = errors.New("the files are different")
+)
+
 /* ----------------------------------------------------------------
  *						I n t e r f a c e s
  *-----------------------------------------------------------------*/
@@ -96,7 +101,8 @@ func (vc *VerifyFilesCommand) String() string {
 }
 
 // execute the command with the input provided in the constructor.
-// returns nil on success. Check command output with GetOutput()
+// returns nil on success, ErrVerifyFiles if the two files differ.
+// Check command output with GetOutput()
 func (vc *VerifyFilesCommand) Execute() error {
 	var err error = nil
 
@@ -131,7 +137,9 @@ func (vc *VerifyFilesCommand) Execute() error {
 			if vc.hasSecondFile() {
 				if hash2, errB := cmn.CalculateFileCRC64(vc.filenameB); errB == nil {
 					vc.Output("·%16X %s\n", hash2, vc.filenameB)
-					funcVerify(hash1, hash2)
+					if !funcVerify(hash1, hash2) {
+						err = ErrVerifyFiles
+					}
 				} else {
 					err = errB
 				}
@@ -146,7 +154,9 @@ func (vc *VerifyFilesCommand) Execute() error {
 			if vc.hasSecondFile() {
 				if hash2, errB := cmn.CalculateFileMD5(vc.filenameB); errB == nil {
 					vc.Output("·%32s %s\n", hash2, vc.filenameB)
-					funcVerify(hash1, hash2)
+					if !funcVerify(hash1, hash2) {
+						err = ErrVerifyFiles
+					}
 				} else {
 					err = errB
 				}
@@ -161,7 +171,9 @@ func (vc *VerifyFilesCommand) Execute() error {
 			if vc.hasSecondFile() {
 				if hash2, errB := cmn.CalculateFileSHA256(vc.filenameB); errB == nil {
 					vc.Output("·%64s %s\n", hash2, vc.filenameB)
-					funcVerify(hash1, hash2)
+					if !funcVerify(hash1, hash2) {
+						err = ErrVerifyFiles
+					}
 				} else {
 					err = errB
 				}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/enigma"
 	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/internal/sched"
 	"slices"
@@ -313,6 +314,38 @@ func (r *ConsoleCodebookRenderer) RenderMonthPage(cipher caesarx.CipherVariant,
 		}
 		footnotes = append(footnotes, "For Bellaso the Secret is repeated over the input")
 		footnotes = append(footnotes, "For Vigenere Auto-key the Secret is only used once")
+
+		// (Cipher) Enigma: [Day Part] [UKW] [Walzenlage] [Ringstellung] [Steckerverbindungen] [Grundstellung]
+	case caesarx.EnigmaCipher:
+		// │ %Day% %Weekday% │UKW│ %Rotors%  │ %Rings%  │ %Plugboard%               │%Start%│
+		// ├────────┼───┼───────────┼──────────┼──────────────────────────────┼─────┤
+		cellDividers = []int{9, 14, 26, 37, 68}
+		fmt.Fprintf(r.sb, "%-3s%c%s%c%s%c%s%c%s%c\n", "UKW", VERT, centerString("Rotors", 11), VERT, centerString("Rings", 10), VERT, centerString("Plugboard", 30), VERT, "Start", VERT)
+		fmt.Fprintf(r.sb, "%s", boxLineCell(MIDLEFT, MIDRIGHT, HORIZ, HORIZ_CROSS, cellDividers, LEADER_LEN, LINE_WIDTH))
+		keysE := r.hlp.CompileEnigmaBook()
+		// like the historical key sheets the last day goes first, so
+		// that the row of the day can be cut off & destroyed after use
+		for i := lastDay - 1; i >= 0; i-- {
+			day := date.AddDate(0, 0, i)
+			weekday := day.Weekday().String()[0:3] // 1st three letters of the Weekday
+			rings := make([]string, len(keysE[i].Rings))
+			for j, ring := range keysE[i].Rings {
+				rings[j] = fmt.Sprintf("%02d", ring)
+			}
+			fmt.Fprintf(r.sb, DAY_PART_FORMAT_D, LEADER_LEN, "", VERT, day.Day(), weekday, VERT)
+			fmt.Fprintf(r.sb, "%s%c %-9s %c %-8s %c %-29s%c %-3s %c\n", centerString(keysE[i].Reflector, 3), VERT,
+				strings.Join(keysE[i].Rotors, enigma.SETTINGS_SEPARATOR), VERT,
+				strings.Join(rings, enigma.SETTINGS_SEPARATOR), VERT,
+				strings.Join(keysE[i].Plugs, " "), VERT,
+				keysE[i].Start, VERT)
+		}
+		footnotes = append(footnotes, "Enigma I (English letters only) with rotors I-V and reflectors B & C")
+		footnotes = append(footnotes, "UKW: Umkehrwalze (reflector)")
+		footnotes = append(footnotes, "Rotors: Walzenlage (rotor order) left to right")
+		footnotes = append(footnotes, "Rings: Ringstellung (ring settings) 01=A ... 26=Z")
+		footnotes = append(footnotes, "Plugboard: Steckerverbindungen (plugboard cables)")
+		footnotes = append(footnotes, "Start: Grundstellung (rotor start positions)")
+		footnotes = append(footnotes, "caesarx -variant enigma -secret 'UKW ROTORS RINGS START PLUGBOARD'")
 	}
 
 	// .4 Box footer
@@ -331,7 +364,7 @@ func (r *ConsoleCodebookRenderer) RenderMonthPage(cipher caesarx.CipherVariant,
 	addFootnote(r.sb, "Alphabet Runes: %s", r.alpha.Chars)
 	if len(footnotes) > 0 {
 		for _, footnote := range footnotes {
-			addFootnote(r.sb, footnote)
+			addFootnote(r.sb, "%s", footnote)
 		}
 	}
 
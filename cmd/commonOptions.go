@@ -40,13 +40,20 @@ const (
 
 const (
 	// Common CLI flags. Each may be excluded on an app basis
-	FLAG_HELP    string = "help"
-	FLAG_DEMO    string = "demo"
-	FLAG_LIST    string = "list"
-	FLAG_VERSION string = "version"
-	FLAG_ALPHA   string = "alpha"
-	FLAG_NUM     string = "num"
-	FLAG_PROFILE string = "profile" // (optional) Select profile
+	FLAG_HELP      string = "help"
+	FLAG_DEMO      string = "demo"
+	FLAG_LIST      string = "list"
+	FLAG_VERSION   string = "version"
+	FLAG_ALPHA     string = "alpha"
+	FLAG_NUM       string = "num"
+	FLAG_PROFILE   string = "profile"  // (optional) Select profile
+	FLAG_NO_HEADER string = "noheader" // (optional) binary files without FileHeader
+	FLAG_ARMOR     string = "armor"    // (optional) ASCII-armored ciphered text
+)
+
+const (
+	// (only for cryptanalysis) -alpha value to try every built-in alphabet
+	ALPHA_NAME_AUTO string = "auto"
 )
 
 var AppConfig = NewConfiguration()
@@ -82,6 +89,8 @@ type CommonOptions struct {
 	encodeSpace   bool
 	alpha         string
 	numeric       RuneFlag
+	noHeader      bool
+	armor         bool
 	isReady       bool
 }
 
@@ -148,6 +157,16 @@ func (c *CommonOptions) GetRequestedProfile() string {
 	return c.optProfile
 }
 
+// encrypted binary files start with a FileHeader unless -noheader is given
+func (c *CommonOptions) WantsFileHeader() bool {
+	return !c.noHeader
+}
+
+// the ciphered text is enclosed in an ASCII armor if -armor is given
+func (c *CommonOptions) WantsArmor() bool {
+	return c.armor
+}
+
 func (c *CommonOptions) EncodeSpaces() bool { // @audit deprecate
 	return c.encodeSpace
 }
@@ -159,6 +178,12 @@ func (c *CommonOptions) Alphabet() *cmn.Alphabet {
 	return alpha
 }
 
+// the -alpha auto CLI option is given. Only the cryptanalysis tools
+// accept it, Alphabet() would refuse it.
+func (c *CommonOptions) IsAutoAlphabet() bool {
+	return strings.ToLower(c.alpha) == ALPHA_NAME_AUTO
+}
+
 // the selected alphabet is Binary
 func (c *CommonOptions) IsBinary() bool {
 	return strings.ToLower(c.alpha) == cmn.ALPHA_NAME_BINARY
@@ -231,6 +256,8 @@ func (c *CommonOptions) ShowUsage(name string) {
 	fmt.Printf("\t%s [-help|-demo|-list|-version]\n", name)
 	fmt.Printf("\t%s -alpha {%s}\n", name, supportedAlphabets)
 	fmt.Printf("\t%s -num {%s}\n", name, supportedNumbers)
+	fmt.Printf("\t%s -alpha binary [-%s]\n", name, FLAG_NO_HEADER)
+	fmt.Printf("\t%s [-%s] 'user text' | -F filename\n", name, FLAG_ARMOR)
 }
 
 // FileExt of CommonOptions returns an empty string
@@ -341,6 +368,12 @@ func (c *CommonOptions) initialize(skipFlags ...string) {
 	if !slices.Contains(skipFlags, FLAG_PROFILE) {
 		flag.StringVar(&c.optProfile, FLAG_PROFILE, "", "Profile selector for cipher presets")
 	}
+	if !slices.Contains(skipFlags, FLAG_NO_HEADER) {
+		flag.BoolVar(&c.noHeader, FLAG_NO_HEADER, false, "Encrypt binary files without the file header")
+	}
+	if !slices.Contains(skipFlags, FLAG_ARMOR) {
+		flag.BoolVar(&c.armor, FLAG_ARMOR, false, "Enclose the ciphered text in an ASCII armor")
+	}
 
 	c.isReady = false
 }
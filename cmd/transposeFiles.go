@@ -0,0 +1,42 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Superencipherment of text files. The ciphers do not pipe the lines
+ * of a file through their post-processing command, therefore the
+ * transposition is applied to each line of the encrypted file in a
+ * second pass (and undone in a first pass when decrypting).
+ *-----------------------------------------------------------------*/
+package cmd
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/cmn"
+	"os"
+)
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// TransposeTextFile applies the (transposition) command to each line
+// of the input text file. When input & output are the same file it
+// is replaced only after it was completely processed. If there was
+// an error the unfinished output file is deleted.
+func TransposeTextFile(input, output string, command cmn.ICommand) error {
+	target := output
+	if input == output {
+		target = output + ".transposing"
+	}
+
+	// the line breaks are kept as they are
+	if err := ciphers.ProcessTextFile(input, target, command.Execute); err != nil {
+		return err
+	}
+
+	if target != output {
+		return os.Rename(target, output)
+	}
+
+	return nil
+}
@@ -53,6 +53,7 @@ type IAlphabet interface {
 	Contains(r rune, ignoreCase bool) bool
 	From(string) *Alphabet
 	Rotate(rotateQty int) *Alphabet
+	Keyed(keyword string) *Alphabet
 	Check() bool
 	IsBinary() bool
 	Clone() *Alphabet
@@ -318,6 +319,46 @@ func (a *Alphabet) Rotate(rotateQty int) *Alphabet {
 	return result
 }
 
+/**
+ * Returns a NEW keyword-mixed alphabet where the unique letters of the
+ * keyword come first (in keyword order) followed by the rest of the
+ * alphabet in its natural order. Keyword runes are matched ignoring
+ * the case (honoring the special case rules, i.e. German ß) and those
+ * absent in the alphabet are ignored. Basis of the Quagmire tabulae.
+ */
+func (a *Alphabet) Keyed(keyword string) *Alphabet {
+	upper := unicode.ToUpper
+	if a.specialCase != nil {
+		upper = a.specialCase.ToUpperRune
+	}
+
+	chars := []rune(a.Chars)
+	used := make([]bool, len(chars))
+	var sb strings.Builder
+	for _, k := range keyword {
+		k = upper(k)
+		for i, ch := range chars {
+			if !used[i] && upper(ch) == k {
+				used[i] = true
+				sb.WriteRune(ch)
+				break
+			}
+		}
+	}
+
+	for i, ch := range chars {
+		if !used[i] {
+			sb.WriteRune(ch)
+		}
+	}
+
+	keyed := a.Clone()
+	keyed.Chars = sb.String()
+	keyed.isBinary = a.isBinary
+	keyed.langCode = a.langCode
+	return keyed
+}
+
 /**
  * The name must not be empty and the alphabet is not empty and
  * must has only unique runes (no duplicates).
@@ -333,7 +374,9 @@ func (a *Alphabet) Check() bool {
  * Clone an alphabet. Please use this to clone the built-in alphabets
  */
 func (a *Alphabet) Clone() *Alphabet {
-	return NewAlphabet(a.Name, a.Chars, !a.Foreign, a.OnlySymbols).WithSpecialCase(a.specialCase)
+	clone := NewAlphabet(a.Name, a.Chars, !a.Foreign, a.OnlySymbols).WithSpecialCase(a.specialCase)
+	clone.langCode = a.langCode
+	return clone
 }
 
 /* ----------------------------------------------------------------
@@ -8,10 +8,15 @@ package cmn
 
 import (
 	"lordofscripts/caesarx/app/mlog"
+	"sort"
 	"strings"
+	"unicode"
 )
 
 const (
+	// the language alphabets lead the built-in ones
+	BUILTIN_LANGUAGES = 8
+
 	// CLI alphabet composer concatenation operator
 	ALPHA_COMPOSER_SEP string = "+"
 
@@ -40,6 +45,32 @@ const (
 	PSO_PUNCT_DEC   string = "PUNCX" // ALPHA_NAME_SYMBOLS
 )
 
+var builtinAlphabets = []*Alphabet{
+	ALPHA_DISK,
+	ALPHA_DISK_LATIN,
+	ALPHA_DISK_GERMAN,
+	ALPHA_DISK_GREEK,
+	ALPHA_DISK_ITALIAN,
+	ALPHA_DISK_PORTUGUESE,
+	ALPHA_DISK_CYRILLIC,
+	ALPHA_DISK_CZECH,
+	NUMBERS_DISK,
+	NUMBERS_DISK_EXT,
+	NUMBERS_EASTERN_DISK,
+	SYMBOL_DISK,
+	PUNCTUATION_DISK,
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// A built-in alphabet and how much of a message it covers
+type AlphabetMatch struct {
+	Alphabet *Alphabet
+	Coverage float64 // ratio of the letters of the message in the alphabet
+}
+
 /* ----------------------------------------------------------------
  *							F u n c t i o n s
  *-----------------------------------------------------------------*/
@@ -201,23 +232,7 @@ func AlphabetFactoryByISO(langCode string) IAlphabet {
 // is case-insensitive but the order of the characters
 // must be the same.
 func IdentifyAlphabet(alphaStr string) *Alphabet {
-	var all = []*Alphabet{
-		ALPHA_DISK,
-		ALPHA_DISK_LATIN,
-		ALPHA_DISK_GERMAN,
-		ALPHA_DISK_GREEK,
-		ALPHA_DISK_ITALIAN,
-		ALPHA_DISK_PORTUGUESE,
-		ALPHA_DISK_CYRILLIC,
-		ALPHA_DISK_CZECH,
-		NUMBERS_DISK,
-		NUMBERS_DISK_EXT,
-		NUMBERS_EASTERN_DISK,
-		SYMBOL_DISK,
-		PUNCTUATION_DISK,
-	}
-
-	for _, candidate := range all {
+	for _, candidate := range builtinAlphabets {
 		if strings.EqualFold(candidate.Chars, alphaStr) {
 			return candidate
 		}
@@ -226,6 +241,46 @@ func IdentifyAlphabet(alphaStr string) *Alphabet {
 	return nil
 }
 
+// When given a message rather than an alphabet, it ranks the built-in
+// language alphabets by the ratio of the letters of the message they
+// contain. Among those that cover the same the smaller comes first,
+// i.e. English before Spanish for a text without accented letters.
+// A message without letters gives no match.
+func IdentifyAlphabets(text string) []AlphabetMatch {
+	letters := make([]rune, 0, len(text))
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters = append(letters, r)
+		}
+	}
+
+	matches := make([]AlphabetMatch, 0)
+	if len(letters) == 0 {
+		return matches
+	}
+
+	for _, candidate := range builtinAlphabets[:BUILTIN_LANGUAGES] {
+		contained := 0
+		for _, r := range letters {
+			if candidate.Contains(r, CaseInsensitive) {
+				contained++
+			}
+		}
+		if contained != 0 {
+			matches = append(matches, AlphabetMatch{candidate, float64(contained) / float64(len(letters))})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Coverage != matches[j].Coverage {
+			return matches[i].Coverage > matches[j].Coverage
+		}
+		return matches[i].Alphabet.Size() < matches[j].Alphabet.Size()
+	})
+
+	return matches
+}
+
 // the spec contains a list of built-in alphabet names separated by "+"
 // which are then used to compose a single alphabet. If there is just one
 // then that is used. It verifies the composition has no duplicates.
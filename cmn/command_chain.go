@@ -0,0 +1,83 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * A chain of post-processing commands executed in sequence, the
+ * output of one is the input of the next. Use it when a Pipe needs
+ * more than one command, i.e. a transposition followed by NGram
+ * formatting.
+ *-----------------------------------------------------------------*/
+package cmn
+
+import (
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+var _ ICommand = (*CommandChain)(nil)
+
+type CommandChain struct {
+	commands []ICommand
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// nil commands are skipped
+func NewCommandChain(commands ...ICommand) *CommandChain {
+	chain := &CommandChain{make([]ICommand, 0, len(commands))}
+	for _, cmd := range commands {
+		if cmd != nil {
+			chain.commands = append(chain.commands, cmd)
+		}
+	}
+
+	return chain
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *CommandChain) Execute(s string) (string, error) {
+	var err error = nil
+	for _, cmd := range c.commands {
+		if s, err = cmd.Execute(s); err != nil {
+			break
+		}
+	}
+
+	return s, err
+}
+
+/**
+ * A friendly representation of the commands in the chain
+ */
+func (c *CommandChain) String() string {
+	names := make([]string, len(c.commands))
+	for i, cmd := range c.commands {
+		names[i] = cmd.String()
+	}
+
+	return strings.Join(names, " | ")
+}
+
+// number of commands in the chain
+func (c *CommandChain) Len() int {
+	return len(c.commands)
+}
+
+/* ----------------------------------------------------------------
+ *						M A I N | E X A M P L E
+ *-----------------------------------------------------------------*/
+
+/*
+func DemoCommandChain() {
+	transpose, _ := ciphers.NewColumnarCommand("ZEBRA")
+	chain := NewCommandChain(transpose, NewNgramFormatter(2, '·'))
+	fmt.Println(chain.Execute("ABCDEFGH")) // EC·HB·GD·AF
+}
+*/
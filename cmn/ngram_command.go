@@ -25,8 +25,9 @@ import (
 var _ ICommand = (*NgramCmd)(nil)
 
 type NgramCmd struct {
-	length uint8
-	sep    rune
+	length  uint8
+	sep     rune
+	inverse bool // removes the grouping instead
 }
 
 /* ----------------------------------------------------------------
@@ -38,7 +39,7 @@ func NewNgramFormatter(length uint8, separator rune) *NgramCmd {
 		panic("nGramFormatter only supports 0,2,3,4 & 5")
 	}
 
-	return &NgramCmd{length, separator}
+	return &NgramCmd{length, separator, false}
 }
 
 /* ----------------------------------------------------------------
@@ -50,6 +51,10 @@ func (f *NgramCmd) Execute(s string) (string, error) {
 	var result string
 
 	s = strings.Replace(s, " ", "", -1)
+	if f.inverse {
+		return strings.Replace(s, string(f.sep), "", -1), nil
+	}
+
 	switch f.length {
 	case 0:
 		result = s
@@ -74,10 +79,22 @@ func (f *NgramCmd) Execute(s string) (string, error) {
 	return result, err
 }
 
+/**
+ * The command that removes the grouping: the separators go away and
+ * the spaces too, like the formatting did. Hence a transposition piped
+ * between Inverse() & the formatter is undone after this command.
+ */
+func (f *NgramCmd) Inverse() *NgramCmd {
+	return &NgramCmd{f.length, f.sep, !f.inverse}
+}
+
 /**
  * A friendly representation of the command in the pipe
  */
 func (f *NgramCmd) String() string {
+	if f.inverse {
+		return fmt.Sprintf("NGram⁻¹(%d%c)", f.length, f.sep)
+	}
 	return fmt.Sprintf("NGram(%d%c)", f.length, f.sep)
 }
 
@@ -71,8 +71,11 @@ type Recipient struct {
 type CaesarModel struct {
 	// the main encryption key letter (determines shift)
 	Key Rune `yaml:"key"`
-	// (optional) offset used to derive secondary key in Didimus & Fibonacci
+	// (optional) offset used to derive secondary key in Didimus & Fibonacci,
+	// the alternate key offset of Augustus or the inner disk of Tiberius
 	Offset uint `yaml:"offset,omitempty"`
+	// (optional) Caesar mode: caesar (default), extended, augustus or tiberius
+	Mode string `yaml:"mode,omitempty"`
 }
 
 // Affine parameter model
@@ -131,7 +134,7 @@ func (cm *CaesarModel) ItemType() string {
 }
 
 func (cm *CaesarModel) String() string {
-	return fmt.Sprintf("CaesarModel Key:%c (optional)Offset:%d", cm.Key, cm.Offset)
+	return fmt.Sprintf("CaesarModel Key:%c (optional)Offset:%d (optional)Mode:%s", cm.Key, cm.Offset, cm.Mode)
 }
 
 func (am *AffineModel) ItemType() string {
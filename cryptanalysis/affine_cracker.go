@@ -0,0 +1,258 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Key recovery for the (classic) Affine cipher. The A coefficient
+ * must be a coprime of the alphabet size N and B is taken modulo N,
+ * 12 x 26 keys for English, few enough to try them all and rank the
+ * decoded texts by language fitness. With two known plain/ciphered
+ * letters the coefficients are solved directly instead.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/cmn"
+	"math"
+	"sort"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrKnownPairs     = errors.New("known pairs are given as PLAIN:CIPHERED letters, i.e. e:x,t:q")
+	ErrKnownLetter    = errors.New("known pair letter is not in the alphabet")
+	ErrKnownNoKey     = errors.New("no Affine key maps both known pairs")
+	ErrKnownAmbiguous = errors.New("several Affine keys map both known pairs, try other letters")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// A possible solution of an Affine brute-force attack
+type AffineCandidate struct {
+	Alphabet *cmn.Alphabet
+	Params   *affine.AffineParams // of the master alphabet
+	Slave    *affine.AffineParams // derived for the slave alphabet (if any)
+	Score    float64              // language fitness, the higher the better
+	Plain    string               // the text decoded with the coefficients
+}
+
+// A letter of the plain text and what it was ciphered into
+type KnownPair struct {
+	Plain  rune
+	Cipher rune
+}
+
+type AffineCracker struct {
+	helper *affine.AffineHelper
+	slave  *cmn.Alphabet
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A brute-force cracker for the classic Affine cipher.
+ * · follow with WithChain() if the message was ciphered with a slave.
+ */
+func NewAffineCracker() *AffineCracker {
+	return &AffineCracker{affine.NewAffineHelper(), nil}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *AffineCandidate) String() string {
+	return fmt.Sprintf("Affine %s A=%d B=%d (%.3f)", c.Alphabet.Name, c.Params.A, c.Params.B, c.Score)
+}
+
+// the slave alphabet (digits, punctuation) the message was ciphered with,
+// there is none by default (like in the CLI). Its coefficients are derived
+// from those of the master like AffineCrypto.WithChain() does.
+func (k *AffineCracker) WithChain(slave *cmn.Alphabet) *AffineCracker {
+	k.slave = slave
+	return k
+}
+
+/**
+ * Try every valid (A,B) of every given alphabet on the ciphered text.
+ * The candidates are sorted from the most to the least likely. Alphabets
+ * without reference frequencies are skipped, if none is left it fails
+ * with ErrCrackAlphabet.
+ */
+func (k *AffineCracker) Crack(ciphered string, alphas ...*cmn.Alphabet) ([]*AffineCandidate, error) {
+	candidates := make([]*AffineCandidate, 0)
+	for _, alpha := range alphas {
+		ref := StatsFor(alpha)
+		if ref == nil {
+			mlog.WarnT("no reference to crack with", mlog.String("Alpha", alpha.Name))
+			continue
+		}
+
+		n := int(alpha.Size())
+		for _, a := range k.helper.ValidCoprimesUpTo(uint(n)) {
+			for b := range n {
+				params, _ := affine.NewAffineParams(a, b, n) // a is a coprime of n
+				if candidate, err := k.decode(ciphered, alpha, params); err == nil {
+					candidate.Score = Fitness(candidate.Plain, ref)
+					candidates = append(candidates, candidate)
+				}
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrCrackAlphabet
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	return candidates, nil
+}
+
+/**
+ * Known-plaintext attack. Two plain letters and their ciphered letters
+ * give two equations:
+ *		y1 = (A * x1 + B) % N
+ *		y2 = (A * x2 + B) % N
+ * so A = (y1 - y2) * (x1 - x2)' % N when x1 - x2 has a modular inverse,
+ * else every valid A is tested. B follows from the first equation.
+ * The text is decoded with the coefficients solved for every alphabet
+ * the letters belong to, the candidates are sorted like with Crack().
+ */
+func (k *AffineCracker) SolveKnown(ciphered string, first, second KnownPair, alphas ...*cmn.Alphabet) ([]*AffineCandidate, error) {
+	candidates := make([]*AffineCandidate, 0)
+	var err error = ErrKnownLetter
+	for _, alpha := range alphas {
+		var params *affine.AffineParams
+		if params, err = k.solveKnown(alpha, first, second); err != nil {
+			continue
+		}
+
+		var candidate *AffineCandidate
+		if candidate, err = k.decode(ciphered, alpha, params); err != nil {
+			continue
+		}
+		candidate.Score = math.Inf(-1)
+		if ref := StatsFor(alpha); ref != nil {
+			candidate.Score = Fitness(candidate.Plain, ref)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if len(candidates) == 0 {
+		return nil, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	return candidates, nil
+}
+
+func (k *AffineCracker) solveKnown(alpha *cmn.Alphabet, first, second KnownPair) (*affine.AffineParams, error) {
+	positions := make([]int, 0, 4)
+	for _, r := range []rune{first.Plain, first.Cipher, second.Plain, second.Cipher} {
+		pos := alpha.PositionOf(toUpperRune(alpha, r))
+		if pos == -1 {
+			return nil, ErrKnownLetter
+		}
+		positions = append(positions, pos)
+	}
+
+	n := int(alpha.Size())
+	x1, y1, x2, y2 := positions[0], positions[1], positions[2], positions[3]
+	dx, dy := modulo(x1-x2, n), modulo(y1-y2, n)
+
+	solutions := make([]int, 0)
+	if inverse, err := k.helper.ModularInverse(dx, n); err == nil {
+		solutions = append(solutions, modulo(dy*inverse, n))
+	} else {
+		for _, a := range k.helper.ValidCoprimesUpTo(uint(n)) {
+			if modulo(a*dx, n) == dy {
+				solutions = append(solutions, a)
+			}
+		}
+	}
+
+	switch {
+	case len(solutions) == 0:
+		return nil, ErrKnownNoKey
+	case len(solutions) > 1:
+		return nil, ErrKnownAmbiguous
+	case !k.helper.AreCoprime(solutions[0], n):
+		return nil, ErrKnownNoKey
+	}
+
+	return affine.NewAffineParams(solutions[0], modulo(y1-solutions[0]*x1, n), n)
+}
+
+func (k *AffineCracker) decode(ciphered string, alpha *cmn.Alphabet, params *affine.AffineParams) (*AffineCandidate, error) {
+	cipher := affine.NewAffineCrypto(alpha, params)
+	if err := cipher.WithChain(k.slave); err != nil {
+		return nil, err
+	}
+
+	plain, err := cipher.Decode(ciphered)
+	if err != nil {
+		return nil, err
+	}
+
+	master, slave := cipher.GetParams()
+	return &AffineCandidate{
+		Alphabet: alpha,
+		Params:   master,
+		Slave:    slave,
+		Score:    0,
+		Plain:    plain,
+	}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// (Convenience) the n most likely Affine candidates
+func TopAffineCandidates(candidates []*AffineCandidate, n int) []*AffineCandidate {
+	if n < len(candidates) {
+		return candidates[:n]
+	}
+
+	return candidates
+}
+
+/**
+ * Parse the known pairs of the CLI, PLAIN:CIPHERED letters separated by
+ * commas like the Affine schedule, i.e. "e:x,t:q".
+ */
+func ParseKnownPairs(spec string) ([]KnownPair, error) {
+	pairs := make([]KnownPair, 0)
+	for _, item := range strings.Split(spec, ",") {
+		plain, ciphered, found := strings.Cut(strings.TrimSpace(item), ":")
+		plainRunes, cipherRunes := []rune(plain), []rune(ciphered)
+		if !found || len(plainRunes) != 1 || len(cipherRunes) != 1 {
+			return nil, ErrKnownPairs
+		}
+		pairs = append(pairs, KnownPair{plainRunes[0], cipherRunes[0]})
+	}
+
+	return pairs, nil
+}
+
+// the (positive) remainder of x modulo n
+func modulo(x, n int) int {
+	return ((x % n) + n) % n
+}
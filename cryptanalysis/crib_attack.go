@@ -0,0 +1,442 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Known plain text (crib) attack on the Tabula Recta ciphers. A crib
+ * is a piece of the plain text, like "Dear John". Laid over the
+ * ciphered text it gives the key row of every one of its letters,
+ * the one whose TabulaRecta.DecodeRune() turns the ciphered letter
+ * into that of the crib. From that key stream the Caesar key, the
+ * Didimus key & offset, the Fibonacci prime key or the Bellaso secret
+ * and its period are inferred. If the position of the crib is unknown
+ * it is slid over the whole ciphered text.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	CRIB_ANYWHERE = -1 // the position of the crib is unknown
+	// the key stream of a crib found by sliding it must have this many
+	// more letters than the key has unknowns, fewer match by chance
+	CRIB_EVIDENCE = 2
+	CRIB_UNKNOWN  = '?' // Bellaso secret letter the crib didn't reveal
+)
+
+var (
+	ErrCribVariant  = errors.New("the crib attack supports Caesar, Didimus, Fibonacci & Bellaso")
+	ErrCribEmpty    = errors.New("the crib has no letters of the alphabet")
+	ErrCribOffset   = errors.New("the crib doesn't fit in the ciphered text at that position")
+	ErrCribMismatch = errors.New("the crib doesn't match the ciphered text at that position")
+	ErrCribKey      = errors.New("no key of the variant produces the crib")
+	ErrCribShort    = errors.New("the crib is too short to determine the key")
+	ErrCribNotFound = errors.New("the crib was not found in the ciphered text")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The key of a letter of the crib
+type CribKey struct {
+	Pos   int  // rune position in the ciphered text
+	At    int  // position among the runes of the alphabets (Didimus & Bellaso)
+	Shift int  // the key row, modulo the size of the slave for slave runes
+	Slave bool // the rune belongs to the slave alphabet
+}
+
+// The keys of the crib laid over the ciphered text
+type KeyStream struct {
+	Offset  int       // rune position of the crib in the ciphered text
+	Keys    []CribKey // of the runes in the alphabets
+	Skipped []int     // positions of the runes in no alphabet
+}
+
+// The key inferred from a key stream
+type CribSolution struct {
+	Variant   z.CipherVariant
+	Alphabet  *cmn.Alphabet
+	Key       rune   // Caesar, Didimus & Fibonacci (prime) key
+	AltOffset int    // the alternate key offset (Didimus only)
+	Secret    string // Bellaso secret, CRIB_UNKNOWN letters not revealed
+	Stream    *KeyStream
+	Plain     string // the text decoded with the key, empty if incomplete
+}
+
+type CribAttack struct {
+	alpha  *cmn.Alphabet
+	slave  *cmn.Alphabet
+	tabula [2]*ciphers.TabulaRecta // master & slave (if any)
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A crib attack on messages ciphered with the given (master)
+ * alphabet. No language reference is needed.
+ * · follow with WithChain() if the message was ciphered with a slave.
+ */
+func NewCribAttack(alpha *cmn.Alphabet) *CribAttack {
+	return &CribAttack{alpha, nil, [2]*ciphers.TabulaRecta{ciphers.NewTabulaRecta(alpha, true), nil}}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (s *CribSolution) String() string {
+	switch s.Variant {
+	case z.DidimusCipher:
+		return fmt.Sprintf("%s %s key %c offset %d at %d", s.Variant, s.Alphabet.Name, s.Key, s.AltOffset, s.Stream.Offset)
+	case z.BellasoCipher:
+		return fmt.Sprintf("%s %s secret %s at %d", s.Variant, s.Alphabet.Name, s.Secret, s.Stream.Offset)
+	}
+
+	return fmt.Sprintf("%s %s key %c at %d", s.Variant, s.Alphabet.Name, s.Key, s.Stream.Offset)
+}
+
+// the slave alphabet (digits, punctuation) the message was ciphered with,
+// there is none by default (like in the CLI). Its runes reveal the key
+// only modulo the size of the slave.
+func (c *CribAttack) WithChain(slave *cmn.Alphabet) *CribAttack {
+	c.slave = slave
+	c.tabula[1] = nil
+	if slave != nil {
+		c.tabula[1] = ciphers.NewTabulaRecta(slave, true)
+	}
+
+	return c
+}
+
+/**
+ * The key stream of the crib laid over the ciphered text at the given
+ * rune position. Every ciphered rune must belong to the same alphabet
+ * as that of the crib, those in no alphabet pass unciphered and must be
+ * the same. They are reported as skipped.
+ */
+func (c *CribAttack) KeyStream(ciphered, crib string, offset int) (*KeyStream, error) {
+	cipherRunes, cribRunes := []rune(ciphered), []rune(crib)
+	if offset < 0 || offset+len(cribRunes) > len(cipherRunes) {
+		return nil, ErrCribOffset
+	}
+
+	at := 0
+	for _, r := range cipherRunes[:offset] {
+		if c.tabulaOf(r) != -1 {
+			at++
+		}
+	}
+
+	stream := &KeyStream{offset, make([]CribKey, 0, len(cribRunes)), make([]int, 0)}
+	for i, plain := range cribRunes {
+		pos, r := offset+i, cipherRunes[offset+i]
+		tab := c.tabulaOf(r)
+		switch {
+		case tab != c.tabulaOf(plain):
+			return nil, ErrCribMismatch
+
+		case tab == -1:
+			if r != plain {
+				return nil, ErrCribMismatch
+			}
+			stream.Skipped = append(stream.Skipped, pos)
+
+		default:
+			shift := c.keyRow(tab, r, plain)
+			if shift == -1 {
+				return nil, ErrCribMismatch // never with case folding
+			}
+			stream.Keys = append(stream.Keys, CribKey{pos, at, shift, tab == 1})
+			at++
+		}
+	}
+
+	if len(stream.Keys) == 0 {
+		return nil, ErrCribEmpty
+	}
+
+	return stream, nil
+}
+
+/**
+ * Infer the key of the variant from the crib at the given rune position
+ * of the ciphered text. With CRIB_ANYWHERE every position is tried and
+ * all those where the crib produces a key (with CRIB_EVIDENCE) are
+ * returned in order of appearance.
+ */
+func (c *CribAttack) Solve(variant z.CipherVariant, ciphered, crib string, offset int) ([]*CribSolution, error) {
+	switch variant {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher, z.BellasoCipher:
+	default:
+		return nil, ErrCribVariant
+	}
+	if strings.IndexFunc(crib, func(r rune) bool { return c.tabulaOf(r) != -1 }) == -1 {
+		return nil, ErrCribEmpty
+	}
+
+	if offset != CRIB_ANYWHERE {
+		stream, err := c.KeyStream(ciphered, crib, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		solution, err := c.infer(variant, stream, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		solution.Plain = c.decode(solution, ciphered)
+		return []*CribSolution{solution}, nil
+	}
+
+	solutions := make([]*CribSolution, 0)
+	last := len([]rune(ciphered)) - len([]rune(crib))
+	for offset = 0; offset <= last; offset++ {
+		stream, err := c.KeyStream(ciphered, crib, offset)
+		if err != nil {
+			continue
+		}
+
+		if solution, err := c.infer(variant, stream, CRIB_EVIDENCE); err == nil {
+			mlog.DebugT("crib found", mlog.Int("At", offset), mlog.String("Key", solution.String()))
+			solution.Plain = c.decode(solution, ciphered)
+			solutions = append(solutions, solution)
+		}
+	}
+
+	if len(solutions) == 0 {
+		return nil, ErrCribNotFound
+	}
+
+	return solutions, nil
+}
+
+// the key of the variant revealed by the key stream with, at least, the
+// given number of keys more than the unknowns of the key.
+func (c *CribAttack) infer(variant z.CipherVariant, stream *KeyStream, evidence int) (*CribSolution, error) {
+	solution := &CribSolution{
+		Variant:   variant,
+		Alphabet:  c.alpha,
+		Key:       0,
+		AltOffset: 0,
+		Secret:    "",
+		Stream:    stream,
+		Plain:     "",
+	}
+
+	keys := stream.Keys
+	size := int(c.alpha.Size())
+	switch variant {
+	case z.CaesarCipher:
+		if len(keys)-1 < evidence {
+			return nil, ErrCribShort
+		}
+		shift, err := c.agreeOn(keys)
+		if err != nil {
+			return nil, err
+		}
+		solution.Key = c.alpha.GetRuneAt(shift)
+
+	case z.DidimusCipher:
+		if len(keys)-2 < evidence {
+			return nil, ErrCribShort
+		}
+		// the prime key at the even positions, the alternate at the odd
+		even, odd := make([]CribKey, 0), make([]CribKey, 0)
+		for _, key := range keys {
+			if key.At%2 == 0 {
+				even = append(even, key)
+			} else {
+				odd = append(odd, key)
+			}
+		}
+		prime, err := c.agreeOn(even)
+		if err != nil {
+			return nil, err
+		}
+		alt, err := c.agreeOn(odd)
+		if err != nil {
+			return nil, err
+		}
+		// an alternate key at position 0 is moved to 1
+		if alt == 0 || alt == prime {
+			return nil, ErrCribKey
+		}
+		solution.Key, solution.AltOffset = c.alpha.GetRuneAt(prime), modulo(alt-prime, size)
+
+	case z.FibonacciCipher:
+		if len(keys)-1 < evidence {
+			return nil, ErrCribShort
+		}
+		primes := c.fibonacciPrimes(keys)
+		switch {
+		case len(primes) == 0:
+			return nil, ErrCribKey
+		case len(primes) > 1:
+			return nil, ErrCribShort
+		}
+		solution.Key = primes[0]
+
+	case z.BellasoCipher:
+		secret, err := c.bellasoSecret(keys, evidence)
+		if err != nil {
+			return nil, err
+		}
+		solution.Secret = secret
+	}
+
+	return solution, nil
+}
+
+// the shortest period (secret) the key stream is consistent with. The
+// secret must repeat within the crib, else any period would do.
+func (c *CribAttack) bellasoSecret(keys []CribKey, evidence int) (string, error) {
+next:
+	for period := 1; period <= len(keys)-max(evidence, 1); period++ {
+		cosets := make([][]CribKey, period)
+		for _, key := range keys {
+			cosets[key.At%period] = append(cosets[key.At%period], key)
+		}
+
+		secret := make([]rune, period)
+		for i, coset := range cosets {
+			shift, err := c.agreeOn(coset)
+			switch err {
+			case nil:
+				secret[i] = c.alpha.GetRuneAt(shift)
+			case ErrCribShort:
+				secret[i] = CRIB_UNKNOWN // no letter or only slave runes
+			default:
+				continue next
+			}
+		}
+
+		return string(secret), nil
+	}
+
+	return "", ErrCribShort
+}
+
+// the Fibonacci prime keys whose derived keys match the key stream
+func (c *CribAttack) fibonacciPrimes(keys []CribKey) []rune {
+	primes := make([]rune, 0)
+	for _, prime := range c.alpha.Chars {
+		derived := crypto.NewFibonacciSequencer(c.alpha, prime).Keys()
+		matches := true
+		for _, key := range keys {
+			shift := c.alpha.PositionOf(derived[key.Pos%len(derived)])
+			if key.Slave {
+				shift %= int(c.slave.Size())
+			}
+			if shift != key.Shift {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			primes = append(primes, prime)
+		}
+	}
+
+	return primes
+}
+
+/**
+ * The key row (master shift) all the keys agree on. Slave keys only tell
+ * it modulo the size of the slave, if there is no master key they only
+ * have to agree among themselves and it fails with ErrCribShort.
+ */
+func (c *CribAttack) agreeOn(keys []CribKey) (int, error) {
+	master, slave := -1, -1
+	for _, key := range keys {
+		current := &master
+		if key.Slave {
+			current = &slave
+		}
+		if *current != -1 && *current != key.Shift {
+			return -1, ErrCribKey
+		}
+		*current = key.Shift
+	}
+
+	switch {
+	case master == -1:
+		return -1, ErrCribShort
+	case slave != -1 && master%int(c.slave.Size()) != slave:
+		return -1, ErrCribKey
+	}
+
+	return master, nil
+}
+
+/**
+ * The key row of the ciphered rune, that which TabulaRecta.DecodeRune()
+ * turns into the plain rune, or -1 if there is none.
+ */
+func (c *CribAttack) keyRow(tab int, ciphered, plain rune) int {
+	alpha := c.alpha
+	if tab == 1 {
+		alpha = c.slave
+	}
+
+	plain = toUpperRune(alpha, plain)
+	for shift, key := range []rune(alpha.Chars) {
+		if toUpperRune(alpha, c.tabula[tab].DecodeRune(ciphered, key)) == plain {
+			return shift
+		}
+	}
+
+	return -1
+}
+
+// the index of the tabula (0 master, 1 slave) of the rune, -1 if none
+func (c *CribAttack) tabulaOf(r rune) int {
+	for tab, tabula := range c.tabula {
+		if tabula != nil {
+			if found, _ := tabula.HasRune(r); found {
+				return tab
+			}
+		}
+	}
+
+	return -1
+}
+
+// the ciphered text decoded with the solution, empty if the key is incomplete
+func (c *CribAttack) decode(solution *CribSolution, ciphered string) string {
+	var cipher ciphers.ICipher
+	switch solution.Variant {
+	case z.CaesarCipher:
+		cipher = caesar.NewCaesarTabulaRecta(c.alpha, solution.Key)
+	case z.DidimusCipher:
+		cipher = caesar.NewDidimusTabulaRecta(c.alpha, solution.Key, uint8(solution.AltOffset))
+	case z.FibonacciCipher:
+		cipher = caesar.NewFibonacciTabulaRecta(c.alpha, solution.Key)
+	case z.BellasoCipher:
+		if strings.ContainsRune(solution.Secret, CRIB_UNKNOWN) {
+			return ""
+		}
+		cipher = bellaso.NewBellasoTabulaRecta(c.alpha, solution.Secret)
+	}
+
+	cipher.WithChain(c.tabula[1]) // Didimus chains one by default
+	return cipher.Decode(ciphered)
+}
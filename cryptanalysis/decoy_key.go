@@ -0,0 +1,222 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Deniable keys. Given a Bellaso ciphered text and a decoy plain text
+ * with as many runes, the decoy secret is that which decodes the
+ * ciphered text into the decoy. It is the crib attack with the whole
+ * decoy as crib: every rune in the alphabets reveals its key row. The
+ * runes the BellasoSequencer skips (those in no alphabet, punctuation,
+ * line breaks and without a slave the digits & space) are not ciphered,
+ * the decoy must have the very same ones at the same place. Its other
+ * runes only need to be in the same alphabet (master or slave, where
+ * the space is) as those of the ciphered text at the same position.
+ * A binary file is explained away by a one-time pad: the key bytes are
+ * the difference between the ciphered file & the decoy, to be used as
+ * the book of a binary Running Key.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrDecoyLength = errors.New("the decoy must have as many runes as the ciphered text")
+	ErrDecoyLayout = errors.New("the decoy must keep the runes the cipher skips")
+	ErrDecoySize   = errors.New("the decoy must have as many bytes as the ciphered file")
+	ErrDecoyKey    = errors.New("no secret of the alphabet decodes the ciphered text into the decoy")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The decoy secret of a Bellaso ciphered text
+type DecoySecret struct {
+	Alphabet *cmn.Alphabet
+	Slave    *cmn.Alphabet // nil if none
+	Secret   string        // shortest period of the key stream
+	Stream   *KeyStream
+	Plain    string // the ciphered text decoded with the secret
+}
+
+type DecoyGenerator struct {
+	alpha *cmn.Alphabet
+	crib  *CribAttack
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A decoy generator for messages ciphered with the given (master)
+ * alphabet and, like the Bellaso cipher, the extended numbers slave.
+ * · follow with WithChain() if the message was ciphered with another slave.
+ */
+func NewDecoyGenerator(alpha *cmn.Alphabet) *DecoyGenerator {
+	return &DecoyGenerator{alpha, NewCribAttack(alpha).WithChain(cmn.NUMBERS_DISK_EXT)}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (d *DecoySecret) String() string {
+	return fmt.Sprintf("Bellaso %s decoy secret %s", d.Alphabet.Name, d.Secret)
+}
+
+// the slave alphabet the message was ciphered with, nil for none
+func (g *DecoyGenerator) WithChain(slave *cmn.Alphabet) *DecoyGenerator {
+	g.crib.WithChain(slave)
+	return g
+}
+
+/**
+ * The Bellaso secret that decodes the ciphered text into the decoy. The
+ * decoded text keeps the case of the ciphered text, its letters (and
+ * slave runes) are those of the decoy.
+ * The secret is as long as the message unless the key stream repeats.
+ */
+func (g *DecoyGenerator) Secret(ciphered, decoy string) (*DecoySecret, error) {
+	aligned, err := g.align(ciphered, decoy)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := g.crib.KeyStream(ciphered, aligned, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := g.periodic(stream.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	solution := &DecoySecret{
+		Alphabet: g.alpha,
+		Slave:    g.crib.slave,
+		Secret:   secret,
+		Stream:   stream,
+		Plain:    "",
+	}
+
+	cipher := bellaso.NewBellasoTabulaRecta(g.alpha, solution.Secret)
+	cipher.WithChain(g.crib.tabula[1])
+	solution.Plain = cipher.Decode(ciphered)
+	if g.alpha.ToUpperString(solution.Plain) != g.alpha.ToUpperString(aligned) {
+		mlog.ErrorT("decoy secret mismatch", mlog.String("Secret", solution.Secret), mlog.String("Plain", solution.Plain))
+		return nil, ErrDecoyKey
+	}
+
+	return solution, nil
+}
+
+/**
+ * The shortest secret the key stream is consistent with, the whole key
+ * stream if it doesn't repeat. Slave keys only tell the key row modulo
+ * the size of the slave, where there is no master key the smallest key
+ * row will do.
+ */
+func (g *DecoyGenerator) periodic(keys []CribKey) (string, error) {
+	size := int(g.alpha.Size())
+	slaveSize := 0
+	if g.crib.slave != nil {
+		slaveSize = int(g.crib.slave.Size())
+	}
+
+next:
+	for period := 1; period <= len(keys); period++ {
+		master, slave := make([]int, period), make([]int, period)
+		for i := range period {
+			master[i], slave[i] = -1, -1
+		}
+		for _, key := range keys {
+			at := key.At % period
+			current := &master[at]
+			if key.Slave {
+				current = &slave[at]
+			}
+			if *current != -1 && *current != key.Shift {
+				continue next
+			}
+			*current = key.Shift
+			if master[at] != -1 && slave[at] != -1 && master[at]%slaveSize != slave[at] {
+				continue next
+			}
+		}
+
+		secret := make([]rune, period)
+		for at := range secret {
+			shift := master[at]
+			if shift == -1 {
+				shift = slave[at]
+			}
+			if shift >= size {
+				continue next
+			}
+			secret[at] = g.alpha.GetRuneAt(shift)
+		}
+
+		return string(secret), nil
+	}
+
+	return "", ErrDecoyKey
+}
+
+// the decoy laid over the ciphered text rune by rune: its letters replace
+// those of the ciphered text, the runes in no alphabet must be the same.
+func (g *DecoyGenerator) align(ciphered, decoy string) (string, error) {
+	aligned, decoyRunes := []rune(ciphered), []rune(decoy)
+	if len(decoyRunes) != len(aligned) {
+		return "", ErrDecoyLength
+	}
+
+	for i, r := range aligned {
+		tab := g.crib.tabulaOf(r)
+		if tab == -1 {
+			if decoyRunes[i] != r {
+				return "", fmt.Errorf("%w: decoy rune %q at %d where the ciphered text has %q", ErrDecoyLayout, decoyRunes[i], i, r)
+			}
+			continue
+		}
+		if g.crib.tabulaOf(decoyRunes[i]) != tab {
+			return "", fmt.Errorf("decoy rune %q at %d must be in the same alphabet as the ciphered %q", decoyRunes[i], i, r)
+		}
+		aligned[i] = decoyRunes[i]
+	}
+
+	return string(aligned), nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+/**
+ * The one-time pad that deciphers the binary ciphered file into the
+ * decoy (of the same size), a key byte per byte. Used as the book of a
+ * binary Running Key, at offset 0, it decodes the ciphered file.
+ */
+func DecoyPad(ciphered, decoy []byte) ([]byte, error) {
+	if len(ciphered) != len(decoy) || len(ciphered) == 0 {
+		return nil, ErrDecoySize
+	}
+
+	pad := make([]byte, len(ciphered))
+	for i := range ciphered {
+		pad[i] = ciphered[i] - decoy[i] // modulo 256
+	}
+
+	return pad, nil
+}
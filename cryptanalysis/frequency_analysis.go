@@ -0,0 +1,260 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Frequency analysis, the oldest tool of the cryptanalyst. It counts
+ * the letters & bigrams of a text that belong to an alphabet, the
+ * rest (digits, spaces, punctuation) are ignored like the ciphers
+ * skip them. The observed distribution gives the Index of Coincidence
+ * and the Chi-Squared distance to the reference of a language.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"math"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	HISTOGRAM_BAR      rune = '█'
+	HISTOGRAM_EXPECTED rune = '|'
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type FrequencyTable struct {
+	alpha   *cmn.Alphabet
+	counts  []int          // letter counts in alphabet order
+	total   int            // number of letters counted
+	bigrams map[string]int // counts of adjacent letter pairs
+	pairs   int            // number of bigrams counted
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// (Ctor) an empty frequency table for the letters of the alphabet
+func NewFrequencyTable(alpha *cmn.Alphabet) *FrequencyTable {
+	return &FrequencyTable{
+		alpha:   alpha,
+		counts:  make([]int, alpha.Size()),
+		total:   0,
+		bigrams: make(map[string]int),
+		pairs:   0,
+	}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * Count the letters & bigrams of the text, it can be called repeatedly
+ * (i.e. per line of a file) to accumulate. The comparison is case
+ * insensitive. A rune that is not in the alphabet is skipped and it
+ * breaks the bigram, so "A B" has no "AB" bigram.
+ */
+func (f *FrequencyTable) Add(text string) *FrequencyTable {
+	var prev rune = 0
+	for _, r := range f.alpha.ToUpperString(text) {
+		pos := f.alpha.PositionOf(r)
+		if pos == -1 {
+			prev = 0
+			continue
+		}
+
+		f.counts[pos]++
+		f.total++
+		if prev != 0 {
+			f.bigrams[string([]rune{prev, r})]++
+			f.pairs++
+		}
+		prev = r
+	}
+
+	return f
+}
+
+// the alphabet whose letters are counted
+func (f *FrequencyTable) Alphabet() *cmn.Alphabet {
+	return f.alpha
+}
+
+// the number of letters counted
+func (f *FrequencyTable) Total() int {
+	return f.total
+}
+
+// the number of occurrences of the letter (case insensitive)
+func (f *FrequencyTable) Count(r rune) int {
+	pos := f.alpha.PositionOf(toUpperRune(f.alpha, r))
+	if pos == -1 {
+		return 0
+	}
+
+	return f.counts[pos]
+}
+
+// a copy of the letter counts in alphabet order
+func (f *FrequencyTable) Counts() []int {
+	counts := make([]int, len(f.counts))
+	copy(counts, f.counts)
+	return counts
+}
+
+// the observed frequency (0..1) of the letter
+func (f *FrequencyTable) Frequency(r rune) float64 {
+	if f.total == 0 {
+		return 0
+	}
+
+	return float64(f.Count(r)) / float64(f.total)
+}
+
+// the observed frequency (0..1) of the letter at the alphabet position
+func (f *FrequencyTable) FrequencyAt(pos int) float64 {
+	if f.total == 0 {
+		return 0
+	}
+
+	return float64(f.counts[pos]) / float64(f.total)
+}
+
+// the number of occurrences of the bigram (case insensitive)
+func (f *FrequencyTable) BigramCount(bigram string) int {
+	return f.bigrams[f.alpha.ToUpperString(bigram)]
+}
+
+// the observed frequency (0..1) of the bigram
+func (f *FrequencyTable) BigramFrequency(bigram string) float64 {
+	if f.pairs == 0 {
+		return 0
+	}
+
+	return float64(f.BigramCount(bigram)) / float64(f.pairs)
+}
+
+// the n most common bigrams with their observed frequency
+func (f *FrequencyTable) TopBigrams(n int) []NGramFrequency {
+	freqs := make(map[string]float64, len(f.bigrams))
+	for k, v := range f.bigrams {
+		freqs[k] = float64(v) / float64(f.pairs)
+	}
+
+	top := sortedNGrams(freqs)
+	if n < len(top) {
+		top = top[:n]
+	}
+
+	return top
+}
+
+/**
+ * The Index of Coincidence (Friedman) is the probability that two
+ * letters taken at random from the text are the same. It is close to
+ * the language's ExpectedIC() for plain text and monoalphabetic
+ * ciphers, and close to RandomIC() for polyalphabetic ciphers.
+ */
+func (f *FrequencyTable) IndexOfCoincidence() float64 {
+	if f.total < 2 {
+		return 0
+	}
+
+	var sum int = 0
+	for _, n := range f.counts {
+		sum += n * (n - 1)
+	}
+
+	return float64(sum) / float64(f.total*(f.total-1))
+}
+
+/**
+ * The Chi-Squared statistic of the observed letter counts against the
+ * expected counts for the reference language. The lower, the closer
+ * the text is to the language. A plain text is usually below 100 while
+ * a ciphered one is in the hundreds or thousands (depending on length).
+ */
+func (f *FrequencyTable) ChiSquared(ref *LanguageStats) float64 {
+	var chi float64 = 0
+	for pos, observed := range f.counts {
+		expected := ref.ExpectedAt(pos) * float64(f.total)
+		if expected > 0 {
+			delta := float64(observed) - expected
+			chi += delta * delta / expected
+		}
+	}
+
+	return chi
+}
+
+/**
+ * A text histogram with one row per letter: the observed percentage
+ * with its bar and the expected percentage of the reference language
+ * marked with '|' on the same scale. The width is that of the longest
+ * bar. If ref is nil only the observed distribution is shown.
+ */
+func (f *FrequencyTable) Histogram(ref *LanguageStats, width int) string {
+	var peak float64 = 0
+	for pos := range f.counts {
+		peak = math.Max(peak, f.FrequencyAt(pos))
+		if ref != nil {
+			peak = math.Max(peak, ref.ExpectedAt(pos))
+		}
+	}
+	if peak == 0 {
+		peak = 1
+	}
+
+	var sb strings.Builder
+	for pos, letter := range []rune(f.alpha.Chars) {
+		observed := f.FrequencyAt(pos)
+		bar := []rune(strings.Repeat(" ", width+1))
+		for i := range int(math.Round(observed / peak * float64(width))) {
+			bar[i] = HISTOGRAM_BAR
+		}
+
+		if ref != nil {
+			expected := ref.ExpectedAt(pos)
+			bar[int(math.Round(expected/peak*float64(width)))] = HISTOGRAM_EXPECTED
+			sb.WriteString(fmt.Sprintf("\t%c %6.2f%% %s %6.2f%%\n", letter, observed*100, string(bar), expected*100))
+		} else {
+			sb.WriteString(fmt.Sprintf("\t%c %6.2f%% %s\n", letter, observed*100, strings.TrimRight(string(bar), " ")))
+		}
+	}
+
+	return sb.String()
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// (Convenience) the frequency table of a text
+func CountFrequencies(text string, alpha *cmn.Alphabet) *FrequencyTable {
+	return NewFrequencyTable(alpha).Add(text)
+}
+
+// (Convenience) the Index of Coincidence of the text's letters
+func IndexOfCoincidence(text string, alpha *cmn.Alphabet) float64 {
+	return CountFrequencies(text, alpha).IndexOfCoincidence()
+}
+
+// (Convenience) the Chi-Squared of the text against the language of the
+// alphabet. It fails if there is no reference for the alphabet.
+func ChiSquared(text string, alpha *cmn.Alphabet) (float64, error) {
+	ref := StatsFor(alpha)
+	if ref == nil {
+		return 0, ErrNoReference
+	}
+
+	return CountFrequencies(text, alpha).ChiSquared(ref), nil
+}
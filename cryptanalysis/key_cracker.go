@@ -0,0 +1,223 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Brute-force key recovery for the Caesar family. The key space of
+ * Caesar & Fibonacci is the size of the alphabet, that of Didimus is
+ * the size times the offsets, small enough to try every key with the
+ * very Tabula Recta of the variant and rank the decoded candidates
+ * by how much they look like the language of the alphabet.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"math"
+	"sort"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+var (
+	ErrCrackVariant  = errors.New("only Caesar, Didimus & Fibonacci keys can be brute-forced")
+	ErrCrackAlphabet = errors.New("no alphabet with reference frequencies to crack with")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// A possible solution of a brute-force attack
+type Candidate struct {
+	Variant  z.CipherVariant
+	Alphabet *cmn.Alphabet
+	Key      rune    // the (prime) key
+	Offset   int     // the alternate key offset (Didimus only)
+	Score    float64 // language fitness, the higher the better
+	Plain    string  // the text decoded with the key
+}
+
+type KeyCracker struct {
+	variant z.CipherVariant
+	slave   *cmn.Alphabet
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A brute-force key cracker for the Caesar, Didimus or Fibonacci
+ * variants, any other variant is refused with ErrCrackVariant.
+ * · follow with WithChain() if the message was ciphered with a slave.
+ */
+func NewKeyCracker(variant z.CipherVariant) (*KeyCracker, error) {
+	switch variant {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher:
+		return &KeyCracker{variant, nil}, nil
+	}
+
+	return nil, ErrCrackVariant
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (c *Candidate) String() string {
+	if c.Variant == z.DidimusCipher {
+		return fmt.Sprintf("%s %s key %c offset %d (%.3f)", c.Variant, c.Alphabet.Name, c.Key, c.Offset, c.Score)
+	}
+
+	return fmt.Sprintf("%s %s key %c (%.3f)", c.Variant, c.Alphabet.Name, c.Key, c.Score)
+}
+
+// the slave alphabet (digits, punctuation) the message was ciphered with,
+// there is none by default (like in the CLI).
+func (k *KeyCracker) WithChain(slave *cmn.Alphabet) *KeyCracker {
+	k.slave = slave
+	return k
+}
+
+/**
+ * Try every key (and Didimus offset) of every given alphabet on the
+ * ciphered text. The candidates are sorted from the most to the least
+ * likely. Alphabets without reference frequencies (binary, numbers,
+ * custom) are skipped, if none is left it fails with ErrCrackAlphabet.
+ */
+func (k *KeyCracker) Crack(ciphered string, alphas ...*cmn.Alphabet) ([]*Candidate, error) {
+	candidates := make([]*Candidate, 0)
+	for _, alpha := range alphas {
+		ref := StatsFor(alpha)
+		if ref == nil {
+			mlog.WarnT("no reference to crack with", mlog.String("Alpha", alpha.Name))
+			continue
+		}
+
+		for _, candidate := range k.crackWith(ciphered, alpha) {
+			candidate.Score = Fitness(candidate.Plain, ref)
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrCrackAlphabet
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	return candidates, nil
+}
+
+// decode the text with every key of the alphabet
+func (k *KeyCracker) crackWith(ciphered string, alpha *cmn.Alphabet) []*Candidate {
+	size := int(alpha.Size())
+	candidates := make([]*Candidate, 0, size)
+	for keyOrd, key := range []rune(alpha.Chars) {
+		switch k.variant {
+		case z.CaesarCipher:
+			cipher := caesar.NewCaesarTabulaRecta(alpha, key)
+			candidates = append(candidates, k.decode(cipher, ciphered, alpha, key, 0))
+
+		case z.DidimusCipher:
+			for offset := 1; offset < size; offset++ {
+				if (keyOrd+offset)%size == 0 {
+					continue // same alternate key as offset+1
+				}
+				cipher := caesar.NewDidimusTabulaRecta(alpha, key, uint8(offset))
+				candidates = append(candidates, k.decode(cipher, ciphered, alpha, key, offset))
+			}
+
+		case z.FibonacciCipher:
+			cipher := caesar.NewFibonacciTabulaRecta(alpha, key)
+			candidates = append(candidates, k.decode(cipher, ciphered, alpha, key, 0))
+		}
+	}
+
+	return candidates
+}
+
+func (k *KeyCracker) decode(cipher ciphers.ICipher, ciphered string, alpha *cmn.Alphabet, key rune, offset int) *Candidate {
+	var slave *ciphers.TabulaRecta = nil // Didimus chains one by default
+	if k.slave != nil {
+		slave = ciphers.NewTabulaRecta(k.slave, true)
+	}
+	cipher.WithChain(slave)
+
+	return &Candidate{
+		Variant:  k.variant,
+		Alphabet: alpha,
+		Key:      key,
+		Offset:   offset,
+		Score:    0,
+		Plain:    cipher.Decode(ciphered),
+	}
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+/**
+ * The language fitness of a text is the average log10 probability of
+ * its letters plus that of its bigrams in the reference language, less
+ * what a text of the language is expected to score. Around zero the
+ * text looks like the language, the lower the less it does. Letters
+ * foreign to the alphabet are penalized so that candidates of different
+ * alphabets can be compared.
+ */
+func Fitness(text string, ref *LanguageStats) float64 {
+	var unigrams, bigrams float64 = 0, 0
+	var letters, pairs int = 0, 0
+	var prev rune = 0
+	for _, r := range ref.alpha.ToUpperString(text) {
+		pos := ref.alpha.PositionOf(r)
+		if pos == -1 {
+			if unicode.IsLetter(r) {
+				unigrams += 2 * math.Log10(BIGRAM_FLOOR)
+				letters++
+			}
+			prev = 0
+			continue
+		}
+
+		letters++
+		unigrams += math.Log10(math.Max(ref.unigrams[pos], BIGRAM_FLOOR))
+		if prev != 0 {
+			bigrams += math.Log10(ref.bigram(string([]rune{prev, r})))
+			pairs++
+		}
+		prev = r
+	}
+
+	if letters == 0 {
+		return math.Inf(-1)
+	}
+
+	fitness := unigrams/float64(letters) - ref.expectedUnigramLog
+	if pairs != 0 {
+		fitness += bigrams/float64(pairs) - ref.expectedBigramLog
+	}
+
+	return fitness
+}
+
+// (Convenience) the n most likely candidates
+func TopCandidates(candidates []*Candidate, n int) []*Candidate {
+	if n < len(candidates) {
+		return candidates[:n]
+	}
+
+	return candidates
+}
@@ -0,0 +1,277 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Keyspace & strength report of a cipher configuration. The keyspace
+ * alone says little: a Bellaso secret of 8 letters has 26^8 keys but
+ * the Kasiski & Friedman tests solve it letter by letter. So besides
+ * the keyspace the report estimates how many trial decryptions the
+ * crackers of this package need, the unicity distance (letters of
+ * ciphered text that determine the key) and flags the weak choices
+ * with warnings.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/bip39"
+	"lordofscripts/caesarx/internal/crypto"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	LANGUAGE_RATE = 1.5 // bits of information per letter of a natural language (Shannon)
+)
+
+// warning codes (CryptanalysisPCode) of the weak key choices
+const (
+	WARN_IDENTITY_KEY    uint16 = iota + 1 // (some) letters are left as they are
+	WARN_AFFINE_CAESAR                     // A=1 is a Caesar cipher
+	WARN_DICTIONARY_WORD                   // the secret is a dictionary word
+	WARN_UNICITY                           // the message is longer than the unicity distance
+	WARN_FILE_SIGNATURE                    // the key of a binary file is in its signature
+)
+
+var (
+	ErrStrengthVariant = errors.New("the strength report supports Caesar, Didimus, Fibonacci, Bellaso, Vigenère & Affine")
+	ErrStrengthKey     = errors.New("the key must be a letter of the alphabet")
+	ErrStrengthSecret  = errors.New("the secret is empty")
+	ErrStrengthAffine  = errors.New("the A coefficient must be a coprime of the alphabet size")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The cipher configuration to assess, as given to encode
+type KeyConfig struct {
+	Variant  z.CipherVariant
+	Alphabet *cmn.Alphabet
+	Key      rune   // Caesar, Didimus & Fibonacci (prime) key
+	Offset   int    // the alternate key offset (Didimus only)
+	Secret   string // Bellaso secret or Vigenère primer
+	A        int    // Affine coefficients
+	B        int
+}
+
+// The strength of a cipher configuration
+type StrengthReport struct {
+	Config   *KeyConfig
+	Period   int      // key letters before the key repeats itself
+	Keyspace *big.Int // keys of that period
+	Bits     float64  // log2 of the keyspace
+	Unicity  float64  // letters of ciphered text that determine the key, 0 if unknown
+	Effort   float64  // trial decryptions the crackers need, +Inf if out of their reach
+	Cracker  string   // how the crackers break it
+	Warnings []*z.Warning
+}
+
+type StrengthAnalyzer struct {
+	dictionary map[string]bool
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A strength analyzer whose dictionary are the (English) BIP39
+ * words, the kind of word a dictionary attack tries first.
+ * · follow with WithDictionary() to add the words of other languages.
+ */
+func NewStrengthAnalyzer() *StrengthAnalyzer {
+	s := &StrengthAnalyzer{make(map[string]bool)}
+	return s.WithDictionary(strings.Fields(bip39.BIP39_WORDS)...)
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (r *StrengthReport) String() string {
+	return fmt.Sprintf("%s %s keyspace 2^%.1f effort %.3g (%d warnings)", r.Config.Variant, r.Config.Alphabet.Name, r.Bits, r.Effort, len(r.Warnings))
+}
+
+// the report carries a warning with that code
+func (r *StrengthReport) HasWarning(code uint16) bool {
+	for _, warning := range r.Warnings {
+		if warning.Code.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// a weak choice of the configuration
+func (r *StrengthReport) warn(code uint16, format string, args ...any) {
+	r.Warnings = append(r.Warnings, z.NewWarning(fmt.Sprintf(format, args...), z.CryptanalysisPCode, code))
+}
+
+// more words a dictionary attack would try, case insensitive
+func (s *StrengthAnalyzer) WithDictionary(words ...string) *StrengthAnalyzer {
+	for _, word := range words {
+		s.dictionary[strings.ToUpper(word)] = true
+	}
+
+	return s
+}
+
+/**
+ * Assess the cipher configuration. The message length (letters) is
+ * only needed to compare it with the unicity distance, zero if unknown.
+ * Only the variants the package can crack are supported, any other
+ * fails with ErrStrengthVariant.
+ */
+func (s *StrengthAnalyzer) Analyze(config *KeyConfig, letters int) (*StrengthReport, error) {
+	alpha := config.Alphabet
+	size := int(alpha.Size())
+	report := &StrengthReport{
+		Config:   config,
+		Period:   1,
+		Keyspace: big.NewInt(int64(size)),
+		Bits:     0,
+		Unicity:  0,
+		Effort:   0,
+		Cracker:  "",
+		Warnings: make([]*z.Warning, 0),
+	}
+
+	switch config.Variant {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher:
+		key := alpha.PositionOf(config.Key)
+		if key == -1 {
+			return nil, ErrStrengthKey
+		}
+		if config.Variant == z.DidimusCipher {
+			// the alternate key is neither the key nor the first letter
+			report.Keyspace.SetInt64(int64((size - 1) * (size - 1)))
+		}
+		report.Cracker = "brute force"
+
+		if key == 0 && config.Variant == z.CaesarCipher {
+			report.warn(WARN_IDENTITY_KEY, "key %c leaves the text as it is", config.Key)
+		} else if key == 0 && config.Variant == z.DidimusCipher {
+			report.warn(WARN_IDENTITY_KEY, "key %c leaves every other letter as it is", config.Key)
+		}
+
+	case z.BellasoCipher, z.VigenereCipher:
+		secret, err := new(crypto.BellasoSequencer).VerifySecret(alpha.ToUpperString(config.Secret), alpha)
+		if err != nil {
+			return nil, err
+		}
+		if len(secret) == 0 {
+			return nil, ErrStrengthSecret
+		}
+
+		// a Bellaso secret that repeats itself is as good as its period,
+		// the Vigenère primer is followed by the plain text instead
+		period := []rune(secret)
+		if config.Variant == z.BellasoCipher {
+			period = shortestPeriod(period)
+		}
+		report.Period = len(period)
+		report.Keyspace.Exp(report.Keyspace, big.NewInt(int64(report.Period)), nil)
+		report.Cracker = "Kasiski & Friedman, coset by coset"
+		if config.Variant == z.VigenereCipher {
+			report.Cracker = "every primer length, letter by letter"
+		}
+
+		unshifted := 0
+		for _, r := range period {
+			if alpha.PositionOf(r) == 0 {
+				unshifted++
+			}
+		}
+		if unshifted == len(period) && config.Variant == z.BellasoCipher {
+			report.warn(WARN_IDENTITY_KEY, "secret %q leaves the text as it is", secret)
+		} else if unshifted != 0 {
+			report.warn(WARN_IDENTITY_KEY, "%d of the %d secret letters leave the letter as it is", unshifted, len(period))
+		}
+
+	case z.AffineCipher:
+		coprimes := affine.NewAffineHelper().ValidCoprimesUpTo(uint(size))
+		if !slices.Contains(coprimes, config.A) {
+			return nil, ErrStrengthAffine
+		}
+		report.Keyspace.SetInt64(int64(len(coprimes) * size))
+		report.Cracker = "brute force"
+
+		if config.A == 1 && modulo(config.B, size) == 0 {
+			report.warn(WARN_IDENTITY_KEY, "A=1 B=%d leaves the text as it is", config.B)
+		} else if config.A == 1 {
+			report.warn(WARN_AFFINE_CAESAR, "A=1 is a Caesar cipher with key %c", alpha.GetRuneAt(modulo(config.B, size)))
+		}
+
+	default:
+		return nil, ErrStrengthVariant
+	}
+
+	report.Bits = log2(report.Keyspace)
+	report.Effort = s.effort(report)
+	if ref := StatsFor(alpha); ref != nil {
+		report.Unicity = report.Bits / (math.Log2(float64(size)) - LANGUAGE_RATE)
+	}
+
+	if config.Variant == z.BellasoCipher && letters != 0 && report.Unicity != 0 && float64(letters) > report.Unicity {
+		report.warn(WARN_UNICITY, "the message (%d letters) is longer than the unicity distance of the secret (%.0f letters), only one secret deciphers it", letters, report.Unicity)
+	}
+	if config.Variant == z.VigenereCipher && s.dictionary[alpha.ToUpperString(strings.Trim(config.Secret, " \t"))] {
+		report.warn(WARN_DICTIONARY_WORD, "secret %q is a dictionary word, one of %d a dictionary attack tries", config.Secret, len(s.dictionary))
+		report.Effort = min(report.Effort, float64(len(s.dictionary)))
+		report.Cracker = "dictionary attack"
+	}
+	if alpha.IsBinary() && config.Variant != z.VigenereCipher && config.Variant != z.AffineCipher {
+		report.warn(WARN_FILE_SIGNATURE, "the key of a binary file is revealed by its file signature")
+		report.Effort = 1
+		report.Cracker = "file signature"
+	}
+
+	return report, nil
+}
+
+// the trial decryptions the crackers of the package need
+func (s *StrengthAnalyzer) effort(report *StrengthReport) float64 {
+	size := float64(report.Config.Alphabet.Size())
+	switch report.Config.Variant {
+	case z.BellasoCipher, z.VigenereCipher:
+		// every length is tried, each key letter on its own
+		if report.Period > MAX_KEY_LENGTH {
+			return math.Inf(1)
+		}
+		lengths := float64(MAX_KEY_LENGTH * (MAX_KEY_LENGTH + 1) / 2)
+		if report.Config.Variant == z.BellasoCipher {
+			return size * (lengths + float64(report.Period))
+		}
+		return size * lengths
+	}
+
+	// every key is tried
+	effort, _ := new(big.Float).SetInt(report.Keyspace).Float64()
+	return effort
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// log2 of a (big) number
+func log2(n *big.Int) float64 {
+	mantissa := new(big.Float)
+	exp := new(big.Float).SetInt(n).MantExp(mantissa)
+	m, _ := mantissa.Float64()
+
+	return float64(exp) + math.Log2(m)
+}
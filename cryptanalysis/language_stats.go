@@ -0,0 +1,276 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The statistical fingerprint of a language written with one of the
+ * built-in alphabets. It is the reference against which an observed
+ * (plain or ciphered) text is measured during cryptanalysis.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/cmn"
+	"math"
+	"sort"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	// the probability of a bigram missing from the reference table
+	BIGRAM_FLOOR float64 = 0.0001
+	// the probability of a quadgram missing from the reference table
+	QUADGRAM_FLOOR float64 = 0.000001
+)
+
+var (
+	ErrNoReference = errors.New("no reference frequencies for the alphabet")
+)
+
+// the languages of the built-in alphabets, in the order they are tried
+// when the alphabet is not known (see AllLanguageStats)
+var references = []*LanguageStats{
+	newLanguageStats(cmn.ISO_EN, cmn.ALPHA_DISK, unigramsEN, bigramsEN, quadgramsEN, chainBigramsEN),
+	newLanguageStats(cmn.ISO_ES, cmn.ALPHA_DISK_LATIN, unigramsES, bigramsES, quadgramsES, chainBigramsES),
+	newLanguageStats(cmn.ISO_IT, cmn.ALPHA_DISK_ITALIAN, unigramsIT, bigramsIT, quadgramsIT, chainBigramsIT),
+	newLanguageStats(cmn.ISO_PT, cmn.ALPHA_DISK_PORTUGUESE, unigramsPT, bigramsPT, quadgramsPT, chainBigramsPT),
+	newLanguageStats(cmn.ISO_DE, cmn.ALPHA_DISK_GERMAN, unigramsDE, bigramsDE, quadgramsDE, chainBigramsDE),
+	newLanguageStats(cmn.ISO_GR, cmn.ALPHA_DISK_GREEK, unigramsGR, bigramsGR, quadgramsGR, chainBigramsGR),
+	newLanguageStats(cmn.ISO_RU, cmn.ALPHA_DISK_CYRILLIC, unigramsRU, bigramsRU, quadgramsRU, chainBigramsRU),
+	newLanguageStats(cmn.ISO_CZ, cmn.ALPHA_DISK_CZECH, unigramsCZ, bigramsCZ, quadgramsCZ, chainBigramsCZ),
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type LanguageStats struct {
+	LangCode string // ISO code of the language, see cmn.ISO_*
+	alpha    *cmn.Alphabet
+	unigrams []float64          // probability of every letter in alphabet order
+	bigrams  map[string]float64 // probability of the most common bigrams
+	quads    map[string]float64 // probability of the most common quadgrams
+	chain    map[string]float64 // bigrams of the text the quadgrams were counted over
+	// the average log10 probability of a letter & a bigram (see Fitness)
+	expectedUnigramLog float64
+	expectedBigramLog  float64
+}
+
+// A bigram (or any N-gram) and its probability or count
+type NGramFrequency struct {
+	NGram string
+	Value float64
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// the percentages are normalized to probabilities
+func newLanguageStats(iso string, alpha *cmn.Alphabet, unigrams []float64, bigrams, quadgrams, chain map[string]float64) *LanguageStats {
+	if len(unigrams) != int(alpha.Size()) {
+		mlog.ErrorT("reference unigrams do not match the alphabet",
+			mlog.String("Alpha", alpha.Name),
+			mlog.Int("Size", len(unigrams)))
+		panic("invalid reference frequencies for " + alpha.Name)
+	}
+
+	stats := &LanguageStats{
+		LangCode: iso,
+		alpha:    alpha,
+		unigrams: normalize(unigrams),
+		bigrams:  normalizeMap(bigrams),
+		quads:    normalizeMap(quadgrams),
+		chain:    normalizeMap(chain),
+	}
+
+	// the bigrams that are not listed share the rest of the probability
+	var listed float64 = 0
+	for _, p := range stats.unigrams {
+		stats.expectedUnigramLog += p * math.Log10(math.Max(p, BIGRAM_FLOOR))
+	}
+	for _, p := range stats.bigrams {
+		stats.expectedBigramLog += p * math.Log10(p)
+		listed += p
+	}
+	stats.expectedBigramLog += (1 - listed) * math.Log10(BIGRAM_FLOOR)
+
+	return stats
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (l *LanguageStats) String() string {
+	return l.alpha.Name + " (" + l.LangCode + ")"
+}
+
+// the built-in alphabet the reference frequencies belong to
+func (l *LanguageStats) Alphabet() *cmn.Alphabet {
+	return l.alpha
+}
+
+// the expected probability (0..1) of the letter, zero if not in the alphabet
+func (l *LanguageStats) Expected(r rune) float64 {
+	pos := l.alpha.PositionOf(toUpperRune(l.alpha, r))
+	if pos == -1 {
+		return 0
+	}
+
+	return l.unigrams[pos]
+}
+
+// the expected probability (0..1) of the letter at the alphabet position
+func (l *LanguageStats) ExpectedAt(pos int) float64 {
+	return l.unigrams[pos]
+}
+
+// the expected probability of a bigram, the rare ones get BIGRAM_FLOOR
+func (l *LanguageStats) ExpectedBigram(bigram string) float64 {
+	return l.bigram(l.alpha.ToUpperString(bigram))
+}
+
+// the reference bigrams sorted from the most to the least common
+func (l *LanguageStats) Bigrams() []NGramFrequency {
+	return sortedNGrams(l.bigrams)
+}
+
+// the expected probability of a quadgram (word separators removed), the
+// rare ones get QUADGRAM_FLOOR
+func (l *LanguageStats) ExpectedQuadgram(quadgram string) float64 {
+	if p, found := l.quads[l.alpha.ToUpperString(quadgram)]; found {
+		return p
+	}
+
+	return QUADGRAM_FLOOR
+}
+
+// the reference quadgrams sorted from the most to the least common
+func (l *LanguageStats) Quadgrams() []NGramFrequency {
+	return sortedNGrams(l.quads)
+}
+
+// the Index of Coincidence of a text written in this language
+func (l *LanguageStats) ExpectedIC() float64 {
+	var ic float64 = 0
+	for _, p := range l.unigrams {
+		ic += p * p
+	}
+
+	return ic
+}
+
+// the Index of Coincidence of random text with this alphabet, 1/N
+func (l *LanguageStats) RandomIC() float64 {
+	return 1.0 / float64(len(l.unigrams))
+}
+
+// same as ExpectedBigram() for an upper case bigram
+func (l *LanguageStats) bigram(upper string) float64 {
+	if p, found := l.bigrams[upper]; found {
+		return p
+	}
+
+	return BIGRAM_FLOOR
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+/**
+ * The reference statistics for the language of the alphabet. Besides the
+ * built-in alphabets it recognizes their (upper case) clones. It returns
+ * nil for custom, symbol and binary alphabets.
+ */
+func StatsFor(alpha *cmn.Alphabet) *LanguageStats {
+	if stats := StatsByISO(alpha.LangCodeISO()); stats != nil {
+		return stats
+	}
+
+	// clones lose the language code but keep the characters
+	if builtin := cmn.IdentifyAlphabet(alpha.Chars); builtin != nil {
+		return StatsByISO(builtin.LangCodeISO())
+	}
+
+	return nil
+}
+
+// The reference statistics by ISO language code (cmn.ISO_*)
+func StatsByISO(iso string) *LanguageStats {
+	if iso == cmn.ISO_UA { // both use the built-in Cyrillic alphabet
+		iso = cmn.ISO_RU
+	}
+
+	for _, stats := range references {
+		if stats.LangCode == iso {
+			return stats
+		}
+	}
+
+	return nil
+}
+
+// The reference statistics of all the built-in languages
+func AllLanguageStats() []*LanguageStats {
+	all := make([]*LanguageStats, len(references))
+	copy(all, references)
+	return all
+}
+
+// upper case of a rune honoring the special cases of the alphabet
+func toUpperRune(alpha *cmn.Alphabet, r rune) rune {
+	if upper := []rune(alpha.ToUpperString(string(r))); len(upper) == 1 {
+		return upper[0]
+	}
+
+	return r
+}
+
+// percentages (or counts) to probabilities
+func normalize(values []float64) []float64 {
+	var sum float64 = 0
+	for _, v := range values {
+		sum += v
+	}
+
+	probs := make([]float64, len(values))
+	for i, v := range values {
+		probs[i] = v / sum
+	}
+
+	return probs
+}
+
+// bigram percentages to probabilities, they are only the most common
+// bigrams so they are scaled by 100 rather than by their sum.
+func normalizeMap(values map[string]float64) map[string]float64 {
+	probs := make(map[string]float64, len(values))
+	for k, v := range values {
+		probs[k] = v / 100.0
+	}
+
+	return probs
+}
+
+// N-grams sorted by decreasing value, ties in alphabetical order
+func sortedNGrams(values map[string]float64) []NGramFrequency {
+	list := make([]NGramFrequency, 0, len(values))
+	for k, v := range values {
+		list = append(list, NGramFrequency{k, v})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Value == list[j].Value {
+			return list[i].NGram < list[j].NGram
+		}
+		return list[i].Value > list[j].Value
+	})
+
+	return list
+}
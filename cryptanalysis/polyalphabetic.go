@@ -0,0 +1,429 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Cryptanalysis of the polyalphabetic Bellaso & Vigenère (autokey)
+ * ciphers. The key length of Bellaso is estimated with the Friedman
+ * (Index of Coincidence) & Kasiski tests, then every coset of letters
+ * ciphered with the same key letter is solved as a Caesar. For the
+ * Vigenère autokey every primer length is tried.
+ * The runes that are not in the alphabets are skipped and do not use
+ * a key position, exactly like IKeySequencer.Skip() does during the
+ * encryption, so the positions line up with the ciphered text.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/vigenere"
+	"lordofscripts/caesarx/cmn"
+	"math"
+	"sort"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	MAX_KEY_LENGTH       = 20  // longest secret (or primer) that is tried
+	KEY_LENGTH_TOLERANCE = 0.9 // of the best coset IC, see KeyLengths()
+	KASISKI_NGRAM        = 3   // size of the repeated sequences
+	BELLASO_MARGIN       = 0.1 // of the best fitness, see SolveBellaso()
+)
+
+var (
+	ErrNoKeyLength = errors.New("the text is too short to estimate the key length")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// A possible length of the secret of a polyalphabetic cipher
+type KeyLengthEstimate struct {
+	Length  int
+	IC      float64 // average Index of Coincidence of the cosets
+	Kasiski int     // distances between repeated sequences that are multiples of Length
+}
+
+// The secret & plain text recovered from a polyalphabetic cipher
+type PolySolution struct {
+	Variant  z.CipherVariant
+	Alphabet *cmn.Alphabet
+	Secret   string  // the Bellaso secret or the Vigenère primer
+	Score    float64 // language fitness of the plain text
+	Plain    string
+}
+
+type PolyCracker struct {
+	alpha     *cmn.Alphabet
+	ref       *LanguageStats
+	slave     *cmn.Alphabet
+	maxLength int
+}
+
+// a letter of the ciphered text and the key position it was ciphered at
+type keyedLetter struct {
+	pos int // position within the alphabet
+	at  int // key position, the skipped runes are not counted
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A cracker for the Bellaso & Vigenère ciphers of a language.
+ * It fails with ErrNoReference if the alphabet has no reference
+ * frequencies.
+ * · follow with WithChain() if the message was ciphered with a slave.
+ */
+func NewPolyCracker(alpha *cmn.Alphabet) (*PolyCracker, error) {
+	ref := StatsFor(alpha)
+	if ref == nil {
+		return nil, ErrNoReference
+	}
+
+	return &PolyCracker{alpha, ref, nil, MAX_KEY_LENGTH}, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// the slave alphabet (digits, punctuation) the message was ciphered with,
+// there is none by default (like in the CLI). Its runes use key positions.
+func (p *PolyCracker) WithChain(slave *cmn.Alphabet) *PolyCracker {
+	p.slave = slave
+	return p
+}
+
+// the longest secret or primer to try, MAX_KEY_LENGTH by default
+func (p *PolyCracker) WithMaxLength(length int) *PolyCracker {
+	p.maxLength = max(length, 1)
+	return p
+}
+
+/**
+ * The Kasiski examination. The distance between repeated sequences of
+ * letters is likely a multiple of the key length. It returns how many
+ * distances are a multiple of each length (2..max).
+ */
+func (p *PolyCracker) Kasiski(ciphered string) map[int]int {
+	letters := p.keyedLetters(ciphered)
+	seen := make(map[string]int)
+	votes := make(map[int]int)
+	for i := 0; i+KASISKI_NGRAM <= len(letters); i++ {
+		// only sequences ciphered with consecutive key positions
+		if letters[i+KASISKI_NGRAM-1].at-letters[i].at != KASISKI_NGRAM-1 {
+			continue
+		}
+
+		ngram := make([]rune, KASISKI_NGRAM)
+		for j := range KASISKI_NGRAM {
+			ngram[j] = rune(letters[i+j].pos)
+		}
+		if last, found := seen[string(ngram)]; found {
+			distance := letters[i].at - last
+			for length := 2; length <= p.maxLength; length++ {
+				if distance%length == 0 {
+					votes[length]++
+				}
+			}
+		}
+		seen[string(ngram)] = letters[i].at
+	}
+
+	return votes
+}
+
+/**
+ * The possible key lengths, most likely first. For every length the
+ * letters are split into cosets (one per key letter) and the Index of
+ * Coincidence of each coset is averaged (Friedman). The right length
+ * and its multiples have the IC of the language, so the most likely is
+ * the SHORTEST length within KEY_LENGTH_TOLERANCE of the best IC. The
+ * rest follow by decreasing IC. The Kasiski votes are included.
+ */
+func (p *PolyCracker) KeyLengths(ciphered string) ([]KeyLengthEstimate, error) {
+	letters := p.keyedLetters(ciphered)
+	votes := p.Kasiski(ciphered)
+
+	estimates := make([]KeyLengthEstimate, 0, p.maxLength)
+	for length := 1; length <= p.maxLength && 2*length <= len(letters); length++ {
+		var ic float64 = 0
+		for _, coset := range p.cosets(letters, length) {
+			ic += indexOfCoincidence(coset, p.alpha.Size())
+		}
+		estimates = append(estimates, KeyLengthEstimate{length, ic / float64(length), votes[length]})
+	}
+
+	if len(estimates) == 0 {
+		return nil, ErrNoKeyLength
+	}
+
+	sort.SliceStable(estimates, func(i, j int) bool {
+		return estimates[i].IC > estimates[j].IC
+	})
+	best := 0
+	for i, estimate := range estimates {
+		if estimate.IC >= KEY_LENGTH_TOLERANCE*estimates[0].IC && estimate.Length < estimates[best].Length {
+			best = i
+		}
+	}
+	shortest := estimates[best]
+	copy(estimates[1:best+1], estimates[:best])
+	estimates[0] = shortest
+
+	return estimates, nil
+}
+
+/**
+ * Recover the Bellaso secret & plain text. The key length estimates are
+ * verified by solving the cosets of each and decoding the text. On short
+ * texts the Friedman & Kasiski tests alone often prefer a multiple of
+ * the length, and long secrets fit any text a little better, so the
+ * SHORTEST secret within BELLASO_MARGIN of the best fitness wins.
+ */
+func (p *PolyCracker) SolveBellaso(ciphered string) (*PolySolution, error) {
+	estimates, err := p.KeyLengths(ciphered)
+	if err != nil {
+		return nil, err
+	}
+
+	letters := p.keyedLetters(ciphered)
+	solutions := make([]*PolySolution, 0, len(estimates))
+	var best float64 = math.Inf(-1)
+	for _, estimate := range estimates {
+		secret := p.solveCosets(letters, estimate.Length)
+		cipher := bellaso.NewBellasoTabulaRecta(p.alpha, string(secret))
+		solution := p.solution(z.BellasoCipher, cipher, string(secret), ciphered)
+		solutions = append(solutions, solution)
+		best = max(best, solution.Score)
+	}
+
+	shortest := p.maxLength
+	for _, solution := range solutions {
+		if solution.Score >= best-BELLASO_MARGIN {
+			shortest = min(shortest, len([]rune(solution.Secret)))
+		}
+	}
+
+	return p.SolveBellasoLength(ciphered, shortest), nil
+}
+
+/**
+ * Same as SolveBellaso() with a known key length. Every coset is solved
+ * as a Caesar with the lowest Chi-Squared, then every secret letter is
+ * refined with the fitness (bigrams) of the whole plain text, this fixes
+ * the cosets that are too short for the Chi-Squared.
+ */
+func (p *PolyCracker) SolveBellasoLength(ciphered string, length int) *PolySolution {
+	secret := p.solveCosets(p.keyedLetters(ciphered), length)
+	for i := range secret {
+		var fitness float64 = math.Inf(-1)
+		var choice rune = secret[i]
+		for _, letter := range p.alpha.Chars {
+			secret[i] = letter
+			cipher := bellaso.NewBellasoTabulaRecta(p.alpha, string(secret))
+			if f := Fitness(p.withChain(cipher).Decode(ciphered), p.ref); f > fitness {
+				fitness, choice = f, letter
+			}
+		}
+		secret[i] = choice
+	}
+
+	cipher := bellaso.NewBellasoTabulaRecta(p.alpha, string(secret))
+	return p.solution(z.BellasoCipher, cipher, string(secret), ciphered)
+}
+
+/**
+ * Recover the Vigenère (autokey) primer & plain text. The key of a letter
+ * is either a primer letter or a plain letter, so every primer letter
+ * starts a chain that can be solved on its own. For every primer length
+ * each primer letter is chosen to give its chain the most likely letters,
+ * decoding with the VigenereSequencer. The length whose plain text has
+ * the best fitness wins. The skipped runes use up auto-key letters too
+ * and the VigenereSequencer falls back on the primer when there is none
+ * left, decoding with it follows the same chains.
+ * NOTE: a slave rune used as key does not shift the letters, so with a
+ *		 slave the chains are short and only the first letters depend on
+ *		 the primer. The plain text is recovered even if the primer is not.
+ */
+func (p *PolyCracker) SolveAutokey(ciphered string) (*PolySolution, error) {
+	letters := len(p.keyedLetters(ciphered))
+	var best *PolySolution = nil
+	for length := 1; length <= p.maxLength && 2*length <= letters; length++ {
+		primer := []rune(p.alpha.Chars)[:length]
+		for i := range primer {
+			var likelihood float64 = math.Inf(-1)
+			var choice rune = primer[i]
+			for _, letter := range p.alpha.Chars {
+				primer[i] = letter
+				if l := p.logLikelihood(p.decodeAutokey(string(primer), ciphered)); l > likelihood {
+					likelihood, choice = l, letter
+				}
+			}
+			primer[i] = choice
+		}
+
+		cipher := vigenere.NewVigenereTabulaRecta(p.alpha, string(primer))
+		solution := p.solution(z.VigenereCipher, cipher, string(primer), ciphered)
+		if best == nil || solution.Score > best.Score {
+			best = solution
+		}
+	}
+
+	if best == nil {
+		return nil, ErrNoKeyLength
+	}
+
+	return best, nil
+}
+
+// the letters of the master alphabet with their key position
+func (p *PolyCracker) keyedLetters(ciphered string) []keyedLetter {
+	letters := make([]keyedLetter, 0, len(ciphered))
+	at := 0
+	for _, r := range ciphered {
+		if pos := p.alpha.PositionOf(toUpperRune(p.alpha, r)); pos != -1 {
+			letters = append(letters, keyedLetter{pos, at})
+			at++
+		} else if p.slave != nil && p.slave.Contains(r, cmn.CaseInsensitive) {
+			at++
+		}
+	}
+
+	return letters
+}
+
+// the alphabet positions of the letters ciphered with each key letter
+func (p *PolyCracker) cosets(letters []keyedLetter, length int) [][]int {
+	cosets := make([][]int, length)
+	for _, letter := range letters {
+		cosets[letter.at%length] = append(cosets[letter.at%length], letter.pos)
+	}
+
+	return cosets
+}
+
+// the secret with the Caesar shift of every coset. A secret that repeats
+// itself (the length was a multiple) is shortened as it deciphers the same.
+func (p *PolyCracker) solveCosets(letters []keyedLetter, length int) []rune {
+	secret := make([]rune, length)
+	for i, coset := range p.cosets(letters, length) {
+		secret[i] = p.alpha.GetRuneAt(p.solveCaesar(coset))
+	}
+
+	return shortestPeriod(secret)
+}
+
+// the Caesar shift (key position) with the lowest Chi-Squared
+func (p *PolyCracker) solveCaesar(coset []int) int {
+	size := int(p.alpha.Size())
+	bestShift, bestChi := 0, math.Inf(1)
+	for shift := range size {
+		counts := make([]int, size)
+		for _, pos := range coset {
+			counts[(pos-shift+size)%size]++
+		}
+
+		var chi float64 = 0
+		for pos, observed := range counts {
+			expected := p.ref.ExpectedAt(pos) * float64(len(coset))
+			if expected > 0 {
+				delta := float64(observed) - expected
+				chi += delta * delta / expected
+			}
+		}
+		if chi < bestChi {
+			bestShift, bestChi = shift, chi
+		}
+	}
+
+	return bestShift
+}
+
+func (p *PolyCracker) decodeAutokey(primer, ciphered string) string {
+	return p.withChain(vigenere.NewVigenereTabulaRecta(p.alpha, primer)).Decode(ciphered)
+}
+
+func (p *PolyCracker) solution(variant z.CipherVariant, cipher ciphers.ICipher, secret, ciphered string) *PolySolution {
+	plain := p.withChain(cipher).Decode(ciphered)
+	return &PolySolution{
+		Variant:  variant,
+		Alphabet: p.alpha,
+		Secret:   secret,
+		Score:    Fitness(plain, p.ref),
+		Plain:    plain,
+	}
+}
+
+// the tabulas chain the extended numbers by default
+func (p *PolyCracker) withChain(cipher ciphers.ICipher) ciphers.ICipher {
+	var slave *ciphers.TabulaRecta = nil
+	if p.slave != nil {
+		slave = ciphers.NewTabulaRecta(p.slave, true)
+	}
+
+	return cipher.WithChain(slave)
+}
+
+// the log10 likelihood of the letters of the text in the language. Unlike
+// Fitness() it is the sum over the letters, so every one counts alone.
+func (p *PolyCracker) logLikelihood(text string) float64 {
+	var likelihood float64 = 0
+	for _, r := range p.alpha.ToUpperString(text) {
+		if pos := p.alpha.PositionOf(r); pos != -1 {
+			likelihood += math.Log10(math.Max(p.ref.unigrams[pos], BIGRAM_FLOOR))
+		}
+	}
+
+	return likelihood
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// the IC of a coset of alphabet positions
+func indexOfCoincidence(coset []int, size uint) float64 {
+	if len(coset) < 2 {
+		return 0
+	}
+
+	counts := make([]int, size)
+	for _, pos := range coset {
+		counts[pos]++
+	}
+
+	var sum int = 0
+	for _, n := range counts {
+		sum += n * (n - 1)
+	}
+
+	return float64(sum) / float64(len(coset)*(len(coset)-1))
+}
+
+// the shortest secret that repeated gives the same secret
+func shortestPeriod(secret []rune) []rune {
+	for period := 1; period < len(secret); period++ {
+		if len(secret)%period != 0 {
+			continue
+		}
+
+		repeats := true
+		for i := period; i < len(secret) && repeats; i++ {
+			repeats = secret[i] == secret[i-period]
+		}
+		if repeats {
+			return secret[:period]
+		}
+	}
+
+	return secret
+}
@@ -0,0 +1,143 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Reference letter (unigram) and bigram frequencies of the languages
+ * of the built-in alphabets. The values are percentages taken from
+ * published corpus counts, they are approximate and are normalized
+ * when loaded. The unigram tables are in the SAME order as the
+ * characters of the built-in alphabet, accented letters included.
+ * Only the most common bigrams are listed, the rest are rare enough
+ * to be treated as a small constant during scoring.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+/* ----------------------------------------------------------------
+ *							L o c a l s
+ *-----------------------------------------------------------------*/
+
+// ABCDEFGHIJKLMNOPQRSTUVWXYZ
+var unigramsEN = []float64{
+	8.167, 1.492, 2.782, 4.253, 12.702, 2.228, 2.015, 6.094, 6.966, 0.153,
+	0.772, 4.025, 2.406, 6.749, 7.507, 1.929, 0.095, 5.987, 6.327, 9.056,
+	2.758, 0.978, 2.360, 0.150, 1.974, 0.074,
+}
+
+var bigramsEN = map[string]float64{
+	"TH": 3.56, "HE": 3.07, "IN": 2.43, "ER": 2.05, "AN": 1.99,
+	"RE": 1.85, "ON": 1.76, "AT": 1.49, "EN": 1.45, "ND": 1.35,
+	"TI": 1.34, "ES": 1.34, "OR": 1.28, "TE": 1.20, "OF": 1.17,
+	"ED": 1.17, "IS": 1.13, "IT": 1.12, "AL": 1.09, "AR": 1.07,
+	"ST": 1.05, "TO": 1.04, "NT": 1.04, "NG": 0.95, "SE": 0.93,
+	"HA": 0.93, "AS": 0.87, "OU": 0.87, "IO": 0.83, "LE": 0.83,
+}
+
+// ABCDEFGHIJKLMNÑOPQRSTUVWXYZÁÉÍÓÚÜ
+var unigramsES = []float64{
+	11.525, 2.215, 4.019, 5.010, 12.181, 0.692, 1.768, 0.703, 6.247, 0.493,
+	0.011, 4.967, 3.157, 6.712, 0.311, 8.683, 2.510, 0.877, 6.871, 7.977,
+	4.632, 2.927, 1.138, 0.017, 0.215, 1.008, 0.467, 0.502, 0.433, 0.725,
+	0.827, 0.168, 0.012,
+}
+
+var bigramsES = map[string]float64{
+	"DE": 2.57, "ES": 2.31, "EN": 2.27, "EL": 2.01, "LA": 1.95,
+	"OS": 1.82, "UE": 1.55, "AR": 1.53, "RA": 1.49, "RE": 1.44,
+	"ER": 1.38, "AS": 1.38, "ON": 1.35, "ST": 1.22, "AD": 1.17,
+	"AL": 1.09, "OR": 1.07, "TA": 1.06, "CO": 1.06, "TE": 1.04,
+	"SE": 1.00, "NT": 0.98, "QU": 0.88, "CI": 0.86, "AN": 0.85,
+}
+
+// ABCDEFGHILMNOPQRSTUVZÉÓÀÈÌÒÙ
+var unigramsIT = []float64{
+	11.745, 0.927, 4.501, 3.736, 11.792, 1.153, 1.644, 0.636, 10.143, 6.510,
+	2.512, 6.883, 9.832, 3.056, 0.505, 6.367, 4.981, 5.623, 3.011, 2.097,
+	1.181, 0.020, 0.010, 0.635, 0.263, 0.030, 0.002, 0.166,
+}
+
+var bigramsIT = map[string]float64{
+	"ER": 1.95, "ES": 1.60, "ON": 1.58, "RE": 1.55, "EL": 1.50,
+	"EN": 1.45, "DE": 1.42, "DI": 1.40, "TI": 1.35, "SI": 1.25,
+	"AL": 1.23, "AN": 1.20, "RA": 1.18, "NT": 1.17, "TA": 1.15,
+	"CO": 1.12, "IN": 1.10, "LE": 1.08, "TO": 1.06, "NE": 1.02,
+	"LA": 1.00, "IO": 0.95, "CH": 0.92, "IA": 0.90, "NO": 0.88,
+}
+
+// ABCÇDEFGHIJKLMNOPQRSTUVWXYZÁÉÍÓÚÀÂÊÔÃÕ
+var unigramsPT = []float64{
+	14.634, 1.043, 3.882, 0.530, 4.992, 12.570, 1.023, 1.303, 0.781, 6.186,
+	0.397, 0.015, 2.779, 4.738, 4.446, 9.735, 2.523, 1.204, 6.530, 6.805,
+	4.336, 3.639, 1.575, 0.037, 0.253, 0.006, 0.470, 0.118, 0.337, 0.132,
+	0.296, 0.207, 0.072, 0.562, 0.450, 0.635, 0.733, 0.040,
+}
+
+var bigramsPT = map[string]float64{
+	"DE": 2.30, "OS": 1.90, "ES": 1.80, "AS": 1.75, "RA": 1.60,
+	"DO": 1.55, "EN": 1.45, "AR": 1.40, "TE": 1.38, "CO": 1.35,
+	"NT": 1.30, "RE": 1.28, "ER": 1.25, "SE": 1.20, "AD": 1.15,
+	"DA": 1.12, "QU": 1.10, "UE": 1.05, "ST": 1.02, "TA": 1.00,
+	"OR": 0.98, "AO": 0.95, "AM": 0.92, "EM": 0.90, "NA": 0.88,
+}
+
+// ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÜẞ
+var unigramsDE = []float64{
+	6.516, 1.886, 2.732, 5.076, 16.396, 1.656, 3.009, 4.577, 6.550, 0.268,
+	1.417, 3.437, 2.534, 9.776, 2.594, 0.670, 0.018, 7.003, 7.270, 6.154,
+	4.166, 0.846, 1.921, 0.034, 0.039, 1.134, 0.578, 0.443, 0.995, 0.307,
+}
+
+var bigramsDE = map[string]float64{
+	"ER": 4.09, "EN": 4.00, "CH": 2.76, "DE": 2.27, "EI": 1.93,
+	"TE": 1.86, "IN": 1.75, "ND": 1.72, "IE": 1.70, "GE": 1.47,
+	"ES": 1.30, "NE": 1.22, "UN": 1.21, "ST": 1.19, "RE": 1.17,
+	"HE": 1.10, "AN": 1.07, "BE": 1.06, "SE": 1.03, "NG": 1.00,
+	"IC": 0.99, "SC": 0.96, "DI": 0.95, "AU": 0.93, "IT": 0.90,
+}
+
+// ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩ
+var unigramsGR = []float64{
+	11.0, 0.8, 1.8, 1.9, 8.0, 0.5, 4.6, 1.3, 8.9, 4.3,
+	2.6, 3.4, 6.8, 0.6, 9.7, 4.3, 4.6, 7.5, 8.7, 4.3,
+	0.8, 1.2, 0.2, 1.9,
+}
+
+var bigramsGR = map[string]float64{
+	"ΤΟ": 2.40, "ΟΥ": 2.20, "ΑΙ": 2.00, "ΝΑ": 1.70, "ΤΑ": 1.60,
+	"ΕΙ": 1.55, "ΚΑ": 1.50, "ΤΗ": 1.45, "ΑΝ": 1.30, "ΣΤ": 1.25,
+	"ΕΝ": 1.20, "ΟΝ": 1.15, "ΙΑ": 1.10, "ΠΟ": 1.05, "ΤΙ": 1.00,
+	"ΗΣ": 0.95, "ΟΣ": 0.95, "ΑΡ": 0.90, "ΤΕ": 0.90, "ΝΟ": 0.85,
+	"ΡΑ": 0.85, "ΕΡ": 0.80, "ΑΣ": 0.80, "ΙΚ": 0.75, "ΜΕ": 0.75,
+}
+
+// АБВГДЕËЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ
+var unigramsRU = []float64{
+	8.01, 1.59, 4.54, 1.70, 2.98, 8.45, 0.04, 0.94, 1.65, 7.35,
+	1.21, 3.49, 4.40, 3.21, 6.70, 10.97, 2.81, 4.73, 5.47, 6.26,
+	2.62, 0.26, 0.97, 0.48, 1.44, 0.73, 0.36, 0.04, 1.90, 1.74,
+	0.32, 0.64, 2.01,
+}
+
+var bigramsRU = map[string]float64{
+	"СТ": 1.60, "НО": 1.50, "ТО": 1.40, "НА": 1.30, "ЕН": 1.30,
+	"ОВ": 1.20, "НИ": 1.20, "РА": 1.10, "ВО": 1.10, "КО": 1.10,
+	"ОС": 1.00, "ПР": 1.00, "ЕР": 0.95, "ЕС": 0.95, "ПО": 0.90,
+	"РО": 0.90, "ЛИ": 0.85, "ОЛ": 0.85, "ЕТ": 0.85, "АЛ": 0.80,
+	"ОР": 0.80, "ТА": 0.80, "ОН": 0.75, "ЕЛ": 0.75, "ЛО": 0.70,
+}
+
+// ABCČDĎEFGHIJKLMNŇOPQRŘSŠTŤUVWXYÝZŽÁÉÍÓÚĚŮ
+var unigramsCZ = []float64{
+	8.421, 0.822, 0.740, 0.462, 3.475, 0.015, 7.562, 0.084, 0.092, 1.356,
+	6.073, 1.433, 2.894, 3.802, 2.446, 6.468, 0.007, 6.695, 1.906, 0.001,
+	4.799, 0.380, 5.212, 0.688, 5.727, 0.006, 2.160, 5.344, 0.016, 0.027,
+	1.043, 0.995, 1.503, 0.721, 0.867, 0.633, 1.643, 0.024, 0.045, 1.222,
+	0.204,
+}
+
+var bigramsCZ = map[string]float64{
+	"ST": 1.60, "PR": 1.50, "NE": 1.45, "PO": 1.40, "OV": 1.20,
+	"RO": 1.15, "EN": 1.10, "NI": 1.10, "TE": 1.05, "KO": 1.05,
+	"NA": 1.00, "RA": 1.00, "LE": 0.95, "OS": 0.95, "OD": 0.90,
+	"JE": 0.90, "NO": 0.85, "TO": 0.85, "LI": 0.80, "AN": 0.80,
+	"VA": 0.75, "NÍ": 0.75, "OU": 0.70, "KA": 0.70, "TA": 0.70,
+}
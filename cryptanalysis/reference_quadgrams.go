@@ -0,0 +1,10308 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Reference quadgram frequencies of the languages of the built-in
+ * alphabets. They are percentages of the most common quadgrams of
+ * corpus counts with the word separators removed, so many of them span
+ * two words (NTHE, DELA). They were counted over the messages & manual
+ * pages of a GNU/Linux system, the English originals and the translations
+ * (1 to 3 MB of text per language, 37 MB in English, words with foreign
+ * letters dropped, Greek without accents), each with the bigrams of the
+ * same text: the chain the quadgrams correct in the SubstitutionSolver.
+ * The quadgrams that are not listed share what is left of the probability
+ * during scoring.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+/* ----------------------------------------------------------------
+ *							L o c a l s
+ *-----------------------------------------------------------------*/
+
+var quadgramsEN = map[string]float64{
+	"TION": 0.5479, "STHE": 0.2374, "THES": 0.2349, "FILE": 0.2304, "FTHE": 0.2253,
+	"THIS": 0.2192, "THER": 0.2091, "NTHE": 0.2091, "ATIO": 0.2049, "WITH": 0.1924,
+	"IONS": 0.1819, "OFTH": 0.1772, "ETHE": 0.1771, "THAT": 0.1651, "OTHE": 0.1593,
+	"CTIO": 0.1585, "SPEC": 0.1512, "NAME": 0.1490, "THEC": 0.1490, "THEF": 0.1435,
+	"MENT": 0.1396, "ABLE": 0.1372, "INTH": 0.1311, "TTHE": 0.1262, "PTIO": 0.1220,
+	"PECI": 0.1216, "STEM": 0.1192, "SYST": 0.1179, "YSTE": 0.1178, "INGT": 0.1146,
+	"EDIN": 0.1144, "CALL": 0.1131, "FORM": 0.1109, "ECIF": 0.1101, "HERE": 0.1097,
+	"INTE": 0.1073, "ESTH": 0.1069, "OPTI": 0.1066, "TURN": 0.1063, "THEP": 0.1049,
+	"SION": 0.1044, "VALU": 0.1039, "THEN": 0.1036, "USED": 0.1019, "ETUR": 0.1013,
+	"ALUE": 0.1013, "TING": 0.1011, "RETU": 0.1010, "TOTH": 0.1004, "RTHE": 0.0997,
+	"CHAR": 0.0995, "IFIE": 0.0978, "READ": 0.0953, "NGTH": 0.0951, "SIGN": 0.0947,
+	"ORMA": 0.0941, "EDBY": 0.0939, "CESS": 0.0936, "ATED": 0.0932, "CIFI": 0.0921,
+	"COMM": 0.0920, "EDTO": 0.0913, "PORT": 0.0898, "DTHE": 0.0876, "EFOR": 0.0861,
+	"NTER": 0.0860, "SARE": 0.0849, "WILL": 0.0836, "STRU": 0.0833, "ENTS": 0.0832,
+	"PROC": 0.0831, "THEA": 0.0814, "THED": 0.0810, "SING": 0.0809, "UNCT": 0.0804,
+	"TIME": 0.0803, "NCTI": 0.0802, "ILES": 0.0801, "FUNC": 0.0797, "CONT": 0.0783,
+	"SNOT": 0.0781, "TRUC": 0.0781, "RUCT": 0.0781, "WHEN": 0.0778, "ORTH": 0.0776,
+	"ECTI": 0.0770, "HESE": 0.0762, "FROM": 0.0748, "AULT": 0.0748, "FAUL": 0.0748,
+	"EUSE": 0.0741, "COMP": 0.0739, "RING": 0.0739, "EFAU": 0.0737, "STRI": 0.0731,
+	"DEFA": 0.0721, "THEM": 0.0720, "RESS": 0.0710, "GTHE": 0.0705, "THET": 0.0703,
+	"HICH": 0.0703, "WHIC": 0.0702, "THEL": 0.0701, "YTHE": 0.0698, "IONI": 0.0696,
+	"SAND": 0.0696, "DING": 0.0695, "ROCE": 0.0690, "RMAT": 0.0689, "VERS": 0.0685,
+	"TYPE": 0.0675, "THEO": 0.0675, "IFTH": 0.0674, "ALLO": 0.0674, "LLOW": 0.0673,
+	"RECT": 0.0665, "FIED": 0.0665, "ONTH": 0.0662, "ICAT": 0.0662, "EFIL": 0.0660,
+	"MPLE": 0.0660, "HEFI": 0.0659, "ECON": 0.0657, "IONO": 0.0656, "HECO": 0.0652,
+	"TURE": 0.0649, "LOCA": 0.0649, "INFO": 0.0648, "IONA": 0.0647, "DINT": 0.0634,
+	"SERV": 0.0632, "TAND": 0.0631, "ONST": 0.0631, "FONT": 0.0631, "RINT": 0.0631,
+	"ENTI": 0.0629, "DRAW": 0.0628, "MAND": 0.0628, "HTHE": 0.0621, "ONLY": 0.0620,
+	"MBER": 0.0615, "ERRO": 0.0615, "FORT": 0.0614, "RROR": 0.0614, "ANDA": 0.0613,
+	"THEE": 0.0606, "ETER": 0.0603, "EPRO": 0.0598, "REAT": 0.0597, "UMEN": 0.0597,
+	"USER": 0.0596, "ALLY": 0.0591, "ERSI": 0.0590, "ANGE": 0.0587, "NFOR": 0.0587,
+	"LIST": 0.0586, "ERTH": 0.0581, "EAND": 0.0580, "ANDT": 0.0578, "DATA": 0.0575,
+	"CATI": 0.0575, "PRES": 0.0574, "LINE": 0.0574, "RENT": 0.0571, "CONF": 0.0571,
+	"SFOR": 0.0570, "OMMA": 0.0569, "THEI": 0.0568, "VICE": 0.0567, "INGA": 0.0567,
+	"ECOM": 0.0566, "SCRI": 0.0566, "INST": 0.0563, "CATE": 0.0562, "ERAT": 0.0561,
+	"OPEN": 0.0549, "NUMB": 0.0545, "UMBE": 0.0544, "DIRE": 0.0544, "OCES": 0.0543,
+	"MMAN": 0.0543, "OPER": 0.0543, "IREC": 0.0543, "ESTR": 0.0540, "ITHT": 0.0540,
+	"ESCR": 0.0539, "NDTH": 0.0539, "SETT": 0.0539, "THTH": 0.0538, "FORE": 0.0536,
+	"DWIT": 0.0536, "CONS": 0.0533, "CLUD": 0.0530, "STHA": 0.0529, "CREA": 0.0529,
+	"CHAN": 0.0529, "OULD": 0.0528, "DISP": 0.0525, "ETHA": 0.0523, "EDWI": 0.0521,
+	"INCL": 0.0519, "TAIN": 0.0519, "ONFI": 0.0514, "TERS": 0.0511, "NCLU": 0.0511,
+	"RACT": 0.0510, "INGS": 0.0508, "EMEN": 0.0507, "USIN": 0.0504, "ESYS": 0.0504,
+	"TERN": 0.0504, "EOFT": 0.0503, "POSI": 0.0501, "EATE": 0.0501, "SIZE": 0.0501,
+	"NING": 0.0499, "ENAM": 0.0498, "ARGU": 0.0498, "GUME": 0.0497, "RGUM": 0.0497,
+	"ADDR": 0.0494, "RATI": 0.0494, "THAN": 0.0494, "PRIN": 0.0490, "JECT": 0.0490,
+	"SUPP": 0.0487, "STAT": 0.0486, "DESC": 0.0485, "TTER": 0.0485, "MATI": 0.0483,
+	"TERM": 0.0482, "ENDE": 0.0482, "TRIN": 0.0481, "ECTO": 0.0481, "SUSE": 0.0480,
+	"ANDS": 0.0479, "TIVE": 0.0477, "ISNO": 0.0477, "REQU": 0.0476, "AMES": 0.0472,
+	"URNS": 0.0472, "ESSA": 0.0472, "ESPE": 0.0471, "SINT": 0.0471, "OINT": 0.0470,
+	"ERMI": 0.0469, "STAN": 0.0469, "ESSI": 0.0464, "PARA": 0.0463, "EXTE": 0.0462,
+	"SPLA": 0.0462, "RSIO": 0.0462, "HARA": 0.0460, "OUTP": 0.0459, "IONT": 0.0458,
+	"HANG": 0.0457, "ATCH": 0.0457, "EVAL": 0.0457, "ISPL": 0.0454, "DFOR": 0.0451,
+	"CTOR": 0.0451, "ONOF": 0.0451, "ATIN": 0.0451, "EDEF": 0.0450, "LUDE": 0.0449,
+	"PLAY": 0.0449, "HEPR": 0.0447, "PROG": 0.0447, "EDES": 0.0444, "ISTH": 0.0443,
+	"RESE": 0.0439, "TENT": 0.0438, "LOCK": 0.0438, "TORY": 0.0437, "ATTH": 0.0436,
+	"EQUE": 0.0436, "ACTE": 0.0433, "NDER": 0.0432, "POIN": 0.0432, "OLLO": 0.0430,
+	"BJEC": 0.0430, "INGI": 0.0430, "ENTR": 0.0429, "ARAC": 0.0428, "SINC": 0.0428,
+	"HEAD": 0.0428, "ENTH": 0.0427, "EDTH": 0.0427, "CTER": 0.0427, "ATES": 0.0425,
+	"FOLL": 0.0425, "XFTD": 0.0425, "CANB": 0.0424, "HEDE": 0.0424, "VOID": 0.0424,
+	"ANBE": 0.0424, "OUNT": 0.0423, "TPUT": 0.0421, "CTUR": 0.0420, "HEFO": 0.0418,
+	"AMET": 0.0417, "EFIN": 0.0417, "IGNE": 0.0417, "ANDL": 0.0416, "REMO": 0.0416,
+	"TEMD": 0.0416, "EINT": 0.0413, "ENTA": 0.0411, "TORE": 0.0409, "LIBC": 0.0409,
+	"ONIS": 0.0409, "UTPU": 0.0408, "DRES": 0.0407, "GNED": 0.0406, "DEFI": 0.0406,
+	"RETH": 0.0406, "SWIT": 0.0406, "OBJE": 0.0406, "TDRA": 0.0403, "ALLE": 0.0402,
+	"SAGE": 0.0401, "NFIG": 0.0400, "INIT": 0.0399, "TATI": 0.0396, "EVER": 0.0393,
+	"RIPT": 0.0393, "PPOR": 0.0392, "ITIS": 0.0391, "FORA": 0.0390, "SECT": 0.0390,
+	"SOFT": 0.0390, "UPPO": 0.0389, "ROUT": 0.0389, "UTIN": 0.0389, "ITIO": 0.0388,
+	"LONG": 0.0388, "ESSE": 0.0388, "FFER": 0.0388, "NDAR": 0.0388, "HENT": 0.0386,
+	"ELIN": 0.0386, "CRIP": 0.0385, "REST": 0.0383, "ERVI": 0.0382, "TREA": 0.0381,
+	"FTDR": 0.0379, "STOR": 0.0379, "FREE": 0.0378, "OUTI": 0.0378, "LYPH": 0.0378,
+	"GLYP": 0.0377, "THEU": 0.0377, "DAND": 0.0377, "COLO": 0.0377, "INED": 0.0376,
+	"LINK": 0.0375, "TFOR": 0.0375, "HEST": 0.0375, "OCAT": 0.0374, "ORRE": 0.0374,
+	"THRE": 0.0374, "WRIT": 0.0374, "ESTA": 0.0373, "RESU": 0.0372, "ROGR": 0.0372,
+	"NDIN": 0.0372, "ONTA": 0.0372, "ULTI": 0.0372, "EDFO": 0.0371, "LEME": 0.0370,
+	"LINU": 0.0369, "TABL": 0.0368, "SEDT": 0.0366, "OGRA": 0.0366, "EPRE": 0.0365,
+	"MTHE": 0.0365, "RVIC": 0.0365, "ERES": 0.0365, "INUX": 0.0364, "TSTH": 0.0364,
+	"EREN": 0.0364, "TETH": 0.0364, "GRAM": 0.0363, "NSIG": 0.0363, "RATE": 0.0363,
+	"LATI": 0.0363, "RMIN": 0.0362, "EROF": 0.0362, "ESTO": 0.0362, "ORDE": 0.0360,
+	"NOTE": 0.0358, "STRE": 0.0358, "TEST": 0.0358, "XTEN": 0.0358, "DDRE": 0.0358,
+	"BYTH": 0.0358, "ILED": 0.0357, "PACK": 0.0357, "IGNA": 0.0357, "NTAI": 0.0357,
+	"DOES": 0.0356, "SETH": 0.0355, "EACH": 0.0354, "ITHA": 0.0353, "PATH": 0.0352,
+	"URRE": 0.0352, "ESIN": 0.0351, "OVER": 0.0350, "ALSO": 0.0349, "CODE": 0.0349,
+	"TOBE": 0.0349, "CURR": 0.0349, "ARCH": 0.0348, "HATT": 0.0347, "GROU": 0.0347,
+	"ATTR": 0.0347, "SHOU": 0.0346, "INGO": 0.0345, "ENCE": 0.0344, "HOUL": 0.0344,
+	"LLOC": 0.0343, "ERTO": 0.0341, "OLOR": 0.0341, "EQUI": 0.0341, "ERNA": 0.0339,
+	"ARAM": 0.0339, "BYTE": 0.0339, "EPAR": 0.0339, "ISTO": 0.0338, "HAVE": 0.0338,
+	"ESET": 0.0338, "LLBE": 0.0338, "NSTH": 0.0336, "LLIN": 0.0336, "BEUS": 0.0335,
+	"TERT": 0.0335, "THEV": 0.0335, "VARI": 0.0334, "SPAC": 0.0333, "OWIN": 0.0333,
+	"ANDI": 0.0333, "ECUR": 0.0332, "GLIB": 0.0331, "INCE": 0.0331, "UNSI": 0.0331,
+	"PASS": 0.0331, "TCHA": 0.0330, "ERET": 0.0330, "ILLB": 0.0330, "ECAL": 0.0329,
+	"IMPL": 0.0328, "THEB": 0.0328, "RAME": 0.0327, "RREN": 0.0327, "RARY": 0.0327,
+	"FLAG": 0.0327, "RIES": 0.0326, "SENT": 0.0325, "ESNO": 0.0325, "HISI": 0.0325,
+	"ZERO": 0.0325, "FERE": 0.0324, "SULT": 0.0324, "HAND": 0.0324, "ISRE": 0.0323,
+	"WING": 0.0323, "CKET": 0.0322, "THEG": 0.0321, "PROV": 0.0320, "ROMT": 0.0320,
+	"IATE": 0.0320, "MESS": 0.0320, "LOWI": 0.0320, "IFIC": 0.0319, "ATTE": 0.0319,
+	"TRIB": 0.0319, "NSTA": 0.0318, "IBUT": 0.0318, "SAME": 0.0318, "RIBU": 0.0318,
+	"TALL": 0.0317, "ONAL": 0.0317, "ESEN": 0.0316, "PERA": 0.0316, "LING": 0.0316,
+	"NITI": 0.0316, "INGF": 0.0315, "EFUN": 0.0315, "PACE": 0.0314, "HESA": 0.0314,
+	"CCES": 0.0313, "OCAL": 0.0313, "IELD": 0.0313, "NTIN": 0.0313, "RTED": 0.0312,
+	"TINE": 0.0311, "ERNE": 0.0311, "ESAN": 0.0310, "FINE": 0.0310, "ROUP": 0.0310,
+	"OESN": 0.0309, "ISSE": 0.0309, "EVEN": 0.0309, "HEPA": 0.0309, "RECO": 0.0308,
+	"GENE": 0.0308, "TEDB": 0.0307, "ENER": 0.0307, "ESUL": 0.0307, "HELI": 0.0307,
+	"VENT": 0.0306, "FIEL": 0.0306, "SSAG": 0.0305, "OUND": 0.0305, "ICAL": 0.0305,
+	"OMTH": 0.0305, "NTHI": 0.0305, "RESP": 0.0304, "MODE": 0.0304, "HEIN": 0.0304,
+	"AGES": 0.0304, "METE": 0.0304, "TCON": 0.0303, "TINT": 0.0302, "ISUS": 0.0302,
+	"LIBR": 0.0301, "STAR": 0.0301, "MATC": 0.0301, "EMOR": 0.0299, "IBRA": 0.0299,
+	"ETHI": 0.0299, "ISTE": 0.0299, "SSIO": 0.0298, "ESAM": 0.0298, "TRAN": 0.0298,
+	"EDAS": 0.0298, "MBOL": 0.0298, "HREA": 0.0298, "BRAR": 0.0298, "HECA": 0.0297,
+	"WORK": 0.0296, "UCTU": 0.0296, "MORE": 0.0295, "SYMB": 0.0295, "NERA": 0.0295,
+	"YMBO": 0.0295, "DBYT": 0.0295, "HEMA": 0.0294, "ERWI": 0.0294, "NSTR": 0.0293,
+	"ARED": 0.0291, "ESAR": 0.0291, "TEXT": 0.0291, "INES": 0.0290, "OURC": 0.0290,
+	"IMIT": 0.0290, "RAND": 0.0289, "RFOR": 0.0289, "BUTE": 0.0287, "HATI": 0.0286,
+	"ROVI": 0.0285, "EXEC": 0.0285, "TTRI": 0.0285, "HENA": 0.0284, "BERO": 0.0284,
+	"SHOW": 0.0283, "GIVE": 0.0283, "ECHA": 0.0282, "EDON": 0.0282, "OVID": 0.0282,
+	"EREA": 0.0281, "VIDE": 0.0281, "TEDT": 0.0280, "CEPT": 0.0280, "IONF": 0.0280,
+	"ARIA": 0.0280, "ERVE": 0.0279, "REFE": 0.0279, "SOPT": 0.0279, "EFER": 0.0279,
+	"PENS": 0.0279, "IGUR": 0.0279, "RANS": 0.0279, "NVAL": 0.0278, "USET": 0.0278,
+	"ERIN": 0.0278, "FIGU": 0.0278, "DIFF": 0.0278, "THEX": 0.0277, "GNAL": 0.0277,
+	"VALI": 0.0276, "AREN": 0.0276, "SOUR": 0.0276, "AMPL": 0.0275, "INTF": 0.0275,
+	"URCE": 0.0275, "TIAL": 0.0275, "PLIC": 0.0275, "EXAM": 0.0274, "HISO": 0.0273,
+	"INTO": 0.0272, "EDIS": 0.0272, "NTST": 0.0271, "IRST": 0.0271, "INDI": 0.0271,
+	"MEMO": 0.0271, "EOUT": 0.0270, "EFOL": 0.0270, "TFON": 0.0270, "PROP": 0.0270,
+	"ERAN": 0.0270, "SSET": 0.0269, "STHI": 0.0268, "TERF": 0.0268, "DARD": 0.0268,
+	"ENTT": 0.0268, "STIN": 0.0267, "LUES": 0.0267, "MAIN": 0.0267, "TEDI": 0.0267,
+	"ALID": 0.0266, "ISOP": 0.0266, "RTHA": 0.0265, "FAIL": 0.0265, "LIMI": 0.0265,
+	"MUST": 0.0265, "EDAN": 0.0263, "SOCK": 0.0263, "QUIR": 0.0262, "PEND": 0.0262,
+	"ORTE": 0.0262, "FIRS": 0.0261, "MORY": 0.0261, "ORED": 0.0261, "ALLS": 0.0260,
+	"IVEN": 0.0260, "APPL": 0.0260, "USES": 0.0260, "RNAL": 0.0260, "XAMP": 0.0259,
+	"INAL": 0.0259, "EOPT": 0.0258, "OSIX": 0.0258, "PPLI": 0.0257, "NTED": 0.0257,
+	"EADO": 0.0256, "ONTO": 0.0256, "TEDA": 0.0256, "SETS": 0.0256, "CASE": 0.0256,
+	"IEST": 0.0256, "UIRE": 0.0255, "NAND": 0.0255, "HENE": 0.0254, "CHEC": 0.0254,
+	"NPUT": 0.0253, "ONSI": 0.0253, "IABL": 0.0253, "NABL": 0.0252, "INPU": 0.0252,
+	"SEDI": 0.0252, "SSIN": 0.0252, "INGL": 0.0252, "AYBE": 0.0252, "TOCO": 0.0251,
+	"TFIL": 0.0251, "FACE": 0.0250, "TSTO": 0.0250, "MAYB": 0.0250, "HECK": 0.0250,
+	"RNEL": 0.0249, "ISIS": 0.0249, "NATI": 0.0249, "ULTS": 0.0248, "AUSE": 0.0248,
+	"REDI": 0.0248, "CTIV": 0.0248, "EADS": 0.0248, "BEFO": 0.0248, "KERN": 0.0247,
+	"IGHT": 0.0247, "SOME": 0.0247, "INTS": 0.0246, "TART": 0.0246, "HOST": 0.0245,
+	"LESY": 0.0245, "RIAB": 0.0245, "TARG": 0.0245, "AINS": 0.0245, "ASTH": 0.0244,
+	"ECTS": 0.0244, "ITHO": 0.0244, "DENT": 0.0244, "LATE": 0.0244, "ORAN": 0.0244,
+	"NULL": 0.0244, "LEDE": 0.0243, "OSIT": 0.0243, "HEVA": 0.0242, "ACTI": 0.0242,
+	"TSPE": 0.0242, "INGP": 0.0242, "DITI": 0.0241, "EDAT": 0.0241, "FICA": 0.0241,
+	"CHIN": 0.0241, "REND": 0.0241, "LECT": 0.0241, "ONTE": 0.0241, "MALL": 0.0240,
+	"NTAT": 0.0240, "ARGE": 0.0240, "DEST": 0.0240, "ONVE": 0.0239, "FTER": 0.0239,
+	"XFTF": 0.0239, "NGIN": 0.0239, "ONSA": 0.0238, "ESER": 0.0238, "ENUM": 0.0238,
+	"REGI": 0.0238, "HEOP": 0.0238, "TUSE": 0.0238, "OCKE": 0.0237, "NEDI": 0.0237,
+	"MOUN": 0.0237, "ENOT": 0.0236, "ERFO": 0.0236, "TOFT": 0.0236, "ROPE": 0.0236,
+	"FTFO": 0.0236, "INDO": 0.0236, "ACCE": 0.0236, "UEST": 0.0236, "SCAN": 0.0235,
+	"EXCE": 0.0235, "YTES": 0.0235, "ETTE": 0.0235, "SUCC": 0.0235, "AFTE": 0.0234,
+	"UCCE": 0.0234, "NVER": 0.0234, "ANDO": 0.0234, "ENTO": 0.0234, "INGC": 0.0233,
+	"ALLI": 0.0233, "MOVE": 0.0233, "URNE": 0.0233, "ESST": 0.0233, "SEFU": 0.0233,
+	"CONV": 0.0232, "LESS": 0.0232, "TEDW": 0.0232, "REPR": 0.0232, "ENSS": 0.0232,
+	"SPRO": 0.0231, "ENTL": 0.0231, "EWIT": 0.0230, "RENC": 0.0229, "TPRO": 0.0229,
+	"HISF": 0.0229, "REAM": 0.0229, "ISIN": 0.0229, "RVER": 0.0229, "HERW": 0.0229,
+	"ERFA": 0.0229, "NOTA": 0.0228, "STER": 0.0228, "SSPE": 0.0228, "ONSE": 0.0228,
+	"RRES": 0.0228, "SCON": 0.0228, "UNDE": 0.0228, "SSED": 0.0228, "NSSL": 0.0227,
+	"HISR": 0.0227, "NTRO": 0.0227, "NOFT": 0.0227, "EARE": 0.0226, "BASE": 0.0226,
+	"ELOC": 0.0226, "VERT": 0.0226, "ESOF": 0.0226, "CTED": 0.0226, "NDEX": 0.0225,
+	"URES": 0.0225, "EADD": 0.0225, "NORE": 0.0224, "IONC": 0.0224, "NDLE": 0.0224,
+	"BLES": 0.0224, "ORET": 0.0224, "ENSI": 0.0224, "MINA": 0.0223, "ANDR": 0.0223,
+	"AREA": 0.0222, "THOU": 0.0222, "SRET": 0.0222, "TICA": 0.0222, "LETT": 0.0222,
+	"ONTS": 0.0222, "EMOV": 0.0222, "EEDE": 0.0221, "INDE": 0.0221, "ARES": 0.0221,
+	"KING": 0.0221, "NGTO": 0.0221, "ANDE": 0.0221, "THEH": 0.0221, "ASSO": 0.0220,
+	"PLEM": 0.0220, "ONDI": 0.0220, "AILA": 0.0219, "NOTS": 0.0219, "TORI": 0.0219,
+	"RESO": 0.0219, "DATE": 0.0219, "EXIS": 0.0219, "ITHE": 0.0219, "NNOT": 0.0218,
+	"INGE": 0.0218, "WIND": 0.0218, "RNED": 0.0218, "SCRE": 0.0218, "ISTI": 0.0218,
+	"SALL": 0.0218, "HISS": 0.0218, "ECTE": 0.0217, "ETTO": 0.0217, "BLET": 0.0217,
+	"LETH": 0.0217, "HESY": 0.0217, "NALL": 0.0216, "IENT": 0.0216, "ENCO": 0.0215,
+	"RFAC": 0.0215, "TTIN": 0.0215, "RDER": 0.0215, "ASSE": 0.0215, "FIES": 0.0215,
+	"RITE": 0.0215, "ERST": 0.0214, "ONOT": 0.0214, "TIFI": 0.0214, "THIN": 0.0213,
+	"XIST": 0.0213, "NSIO": 0.0213, "NINT": 0.0213, "PART": 0.0212, "BEEN": 0.0212,
+	"BUFF": 0.0212, "SEST": 0.0212, "OMMI": 0.0212, "UTTH": 0.0211, "INAT": 0.0211,
+	"MMIT": 0.0211, "NTEN": 0.0211, "ILIT": 0.0211, "NDOW": 0.0210, "UFFE": 0.0210,
+	"BLOC": 0.0210, "ESPA": 0.0210, "ETTH": 0.0209, "TEAD": 0.0209, "LTER": 0.0209,
+	"HEUS": 0.0209, "ILEI": 0.0209, "BLED": 0.0209, "BACK": 0.0209, "LLED": 0.0209,
+	"ECRE": 0.0208, "IONW": 0.0208, "ESSO": 0.0208, "ENTE": 0.0208, "REPO": 0.0208,
+	"TSTR": 0.0208, "LEAS": 0.0208, "INVA": 0.0207, "PATT": 0.0207, "SSOC": 0.0207,
+	"ERSA": 0.0207, "ONIN": 0.0207, "TEMS": 0.0207, "YPHS": 0.0207, "NDRE": 0.0207,
+	"ITTE": 0.0207, "ATIS": 0.0207, "VAIL": 0.0206, "WISE": 0.0206, "LICA": 0.0206,
+	"IGNO": 0.0206, "HING": 0.0206, "ERIS": 0.0206, "ANIN": 0.0206, "AVAI": 0.0205,
+	"REED": 0.0205, "CORR": 0.0205, "NSTE": 0.0205, "ETIM": 0.0205, "TERA": 0.0205,
+	"ONTR": 0.0205, "APPE": 0.0205, "DEVI": 0.0205, "TERE": 0.0205, "THEW": 0.0205,
+	"HEDI": 0.0204, "QUES": 0.0204, "ILAB": 0.0204, "IPTO": 0.0204, "MULT": 0.0204,
+	"STOF": 0.0204, "SELE": 0.0203, "TAIL": 0.0203, "HARE": 0.0203, "TESA": 0.0203,
+	"PECT": 0.0203, "IMES": 0.0203, "LTHE": 0.0203, "ENAB": 0.0202, "GNOR": 0.0202,
+	"XCEP": 0.0202, "UTES": 0.0202, "UNIT": 0.0202, "ACRO": 0.0201, "ECOR": 0.0201,
+	"NDIS": 0.0201, "ACKA": 0.0200, "STEA": 0.0200, "MEAN": 0.0200, "SROU": 0.0200,
+	"PROT": 0.0200, "EDIF": 0.0200, "ATEA": 0.0200, "RCHI": 0.0199, "ECUT": 0.0199,
+	"LABL": 0.0199, "TCHE": 0.0199, "DLIN": 0.0199, "ORIN": 0.0198, "MITS": 0.0198,
+	"HEAR": 0.0198, "ERTI": 0.0197, "LETO": 0.0197, "EVIC": 0.0197, "ULDB": 0.0196,
+	"TENS": 0.0196, "ATUR": 0.0196, "ETTI": 0.0196, "PTOR": 0.0196, "HECU": 0.0196,
+	"NOTB": 0.0196, "THEK": 0.0196, "TRIE": 0.0195, "TRIC": 0.0195, "REEN": 0.0195,
+	"SITI": 0.0195, "XECU": 0.0194, "AFIL": 0.0194, "ETAI": 0.0194, "ERED": 0.0194,
+	"LDBE": 0.0193, "HESP": 0.0193, "NFIL": 0.0193, "ANDC": 0.0193, "EATT": 0.0193,
+	"TERI": 0.0193, "HENU": 0.0193, "SUAL": 0.0193, "ININ": 0.0192, "MPRE": 0.0192,
+	"RWIS": 0.0192, "ATER": 0.0192, "LOWE": 0.0192, "ILEN": 0.0191, "ESPO": 0.0191,
+	"ESFO": 0.0191, "PAGE": 0.0191, "AILS": 0.0191, "LOAD": 0.0190, "EARG": 0.0190,
+	"SSES": 0.0190, "SECO": 0.0190, "COND": 0.0190, "ISRO": 0.0190, "DISA": 0.0189,
+	"EINC": 0.0189, "ATIV": 0.0189, "HEEN": 0.0189, "RGET": 0.0189, "ISCA": 0.0189,
+	"INGW": 0.0189, "OMPR": 0.0189, "NGAN": 0.0188, "HOUT": 0.0188, "ELAT": 0.0188,
+	"TSET": 0.0187, "ITIA": 0.0187, "OREX": 0.0187, "ISCO": 0.0187, "HISC": 0.0187,
+	"HEKE": 0.0187, "CAUS": 0.0187, "EADE": 0.0186, "ONSO": 0.0186, "SWHE": 0.0186,
+	"HEFU": 0.0186, "CIAT": 0.0186, "OCIA": 0.0186, "LYTH": 0.0186, "SOCI": 0.0186,
+	"ONEO": 0.0186, "WHER": 0.0185, "PERT": 0.0185, "ESWI": 0.0185, "PERF": 0.0185,
+	"FECT": 0.0185, "COUN": 0.0185, "THEY": 0.0185, "ATET": 0.0185, "ANNO": 0.0184,
+	"NTRY": 0.0184, "CHED": 0.0184, "NEED": 0.0184, "ADDI": 0.0184, "IBLE": 0.0184,
+	"WORD": 0.0183, "ROOT": 0.0183, "ELEC": 0.0183, "XFTC": 0.0183, "NTTO": 0.0183,
+	"LEAN": 0.0183, "FFEC": 0.0183, "SIDE": 0.0183, "HELL": 0.0183, "ERPR": 0.0182,
+	"ESOU": 0.0182, "HERT": 0.0182, "ANDP": 0.0182, "NDIC": 0.0182, "LIKE": 0.0182,
+	"URAT": 0.0181, "EREM": 0.0181, "STOT": 0.0181, "TEMC": 0.0180, "CIFY": 0.0180,
+	"NTTH": 0.0180, "EMPT": 0.0180, "BILI": 0.0180, "ORIT": 0.0180, "LASS": 0.0180,
+	"NOTH": 0.0179, "SETO": 0.0179, "ENDI": 0.0179, "MACR": 0.0179, "ITIN": 0.0179,
+	"ALLT": 0.0179, "QUEU": 0.0179, "TAKE": 0.0179, "LITY": 0.0178, "EDIR": 0.0178,
+	"HATA": 0.0178, "LENA": 0.0178, "RICT": 0.0178, "INGD": 0.0178, "TWOR": 0.0178,
+	"CANN": 0.0178, "DETE": 0.0177, "ONSU": 0.0177, "ALIZ": 0.0177, "MOTE": 0.0177,
+	"AMEA": 0.0177, "ASTR": 0.0177, "NEXT": 0.0177, "EMOT": 0.0177, "TCAN": 0.0176,
+	"OTHA": 0.0176, "TRAC": 0.0176, "IONR": 0.0176, "DFRO": 0.0176, "TOMA": 0.0176,
+	"DICA": 0.0176, "ELIS": 0.0176, "DONL": 0.0176, "UEUE": 0.0176, "OTBE": 0.0176,
+	"ISSI": 0.0175, "TROL": 0.0175, "HOSE": 0.0175, "ATIC": 0.0175, "EOPE": 0.0175,
+	"NGLE": 0.0175, "PLET": 0.0175, "MAKE": 0.0175, "ETWO": 0.0175, "BELO": 0.0174,
+	"OREA": 0.0174, "SUCH": 0.0174, "DGET": 0.0174, "SPON": 0.0174, "DWHE": 0.0174,
+	"DONO": 0.0174, "EITH": 0.0174, "BEIN": 0.0174, "NOTI": 0.0173, "ROTO": 0.0173,
+	"ANDF": 0.0173, "ALRE": 0.0173, "TSIN": 0.0173, "ASIN": 0.0173, "URET": 0.0173,
+	"ACKE": 0.0173, "DFIL": 0.0173, "ACHE": 0.0172, "EALL": 0.0172, "OUSE": 0.0172,
+	"HESI": 0.0172, "CKAG": 0.0172, "SHEL": 0.0172, "GPRO": 0.0172, "SFIL": 0.0172,
+	"FORC": 0.0171, "PREC": 0.0171, "DTOT": 0.0171, "ERSE": 0.0171, "EENT": 0.0171,
+	"SEQU": 0.0171, "ANCE": 0.0171, "ERAL": 0.0171, "IFIT": 0.0171, "KAGE": 0.0171,
+	"ANEX": 0.0170, "FTHI": 0.0170, "CRIB": 0.0170, "GURA": 0.0170, "DBYA": 0.0170,
+	"NTIS": 0.0170, "REAL": 0.0170, "SFRO": 0.0170, "NTLY": 0.0169, "ETYP": 0.0169,
+	"CALE": 0.0169, "LETE": 0.0169, "ARTI": 0.0169, "NGPR": 0.0169, "METH": 0.0169,
+	"ONRE": 0.0169, "AUTH": 0.0169, "GETH": 0.0168, "DNOT": 0.0168, "IFFE": 0.0168,
+	"NALS": 0.0168, "URNA": 0.0168, "OMPL": 0.0167, "EDWH": 0.0167, "NCON": 0.0167,
+	"DETA": 0.0167, "NERR": 0.0167, "RALL": 0.0166, "SIST": 0.0166, "HEEX": 0.0166,
+	"CLAS": 0.0166, "EARC": 0.0166, "LEIS": 0.0166, "SIBL": 0.0165, "STBE": 0.0165,
+	"NEOF": 0.0165, "RECE": 0.0165, "LLET": 0.0165, "SERS": 0.0165, "ODIF": 0.0165,
+	"EFIR": 0.0165, "DEIN": 0.0165, "NDED": 0.0164, "VETH": 0.0164, "ANDD": 0.0164,
+	"ESEC": 0.0164, "PARE": 0.0164, "OTET": 0.0164, "IDGE": 0.0164, "RENO": 0.0164,
+	"PDAT": 0.0163, "CETH": 0.0163, "EWHE": 0.0163, "TWIL": 0.0163, "SEND": 0.0163,
+	"TTHI": 0.0162, "PLAC": 0.0162, "REIN": 0.0162, "LAYD": 0.0162, "DONT": 0.0162,
+	"AMEI": 0.0162, "ERRE": 0.0162, "CELI": 0.0162, "NTOF": 0.0161, "TECT": 0.0161,
+	"ISAL": 0.0161, "TSOF": 0.0161, "HETE": 0.0161, "FSET": 0.0161, "AINT": 0.0160,
+	"NCEL": 0.0160, "AMEO": 0.0160, "ONAN": 0.0160, "NMEN": 0.0159, "FORD": 0.0159,
+	"EPOS": 0.0159, "ILET": 0.0159, "LAGS": 0.0158, "YPES": 0.0158, "SSTH": 0.0158,
+	"DOFT": 0.0158, "SWIL": 0.0158, "LEST": 0.0158, "TIST": 0.0158, "STED": 0.0158,
+	"SWHI": 0.0158, "ATUS": 0.0157, "LOWS": 0.0157, "EXIT": 0.0157, "ETRA": 0.0157,
+	"EXFT": 0.0157, "LUEI": 0.0157, "ARRA": 0.0157, "ORDI": 0.0157, "SEOF": 0.0157,
+	"FORS": 0.0156, "EADI": 0.0156, "SKTO": 0.0156, "LEIN": 0.0156, "TOAN": 0.0156,
+	"RNIN": 0.0156, "NGED": 0.0156, "TEDF": 0.0156, "BERE": 0.0156, "TERP": 0.0156,
+	"USTB": 0.0155, "MEOF": 0.0155, "ANON": 0.0155, "NUSE": 0.0155, "ANCH": 0.0155,
+	"DTHI": 0.0155, "NTSA": 0.0154, "ICES": 0.0154, "RDIN": 0.0154, "TISA": 0.0154,
+	"SHAR": 0.0154, "INTA": 0.0154, "NTSO": 0.0154, "MODI": 0.0154, "DESK": 0.0154,
+	"GIST": 0.0154, "TEMP": 0.0154, "EPEN": 0.0154, "THOS": 0.0154, "SCOM": 0.0153,
+	"KTOP": 0.0153, "LACE": 0.0153, "TOPE": 0.0153, "REDE": 0.0153, "MESP": 0.0153,
+	"NCOM": 0.0153, "UPDA": 0.0153, "REIS": 0.0153, "AKES": 0.0152, "DINA": 0.0152,
+	"YAND": 0.0152, "EPOR": 0.0152, "NTOT": 0.0152, "ETOT": 0.0152, "ESKT": 0.0152,
+	"YFOR": 0.0152, "EPAT": 0.0152, "ANDW": 0.0151, "HELO": 0.0151, "PING": 0.0151,
+	"ISAB": 0.0151, "TCOM": 0.0151, "ONME": 0.0151, "REXA": 0.0151, "RERE": 0.0150,
+	"EFUL": 0.0150, "WARN": 0.0150, "ELOW": 0.0150, "TNAM": 0.0150, "HETH": 0.0150,
+	"TARE": 0.0150, "IONP": 0.0150, "ERGE": 0.0150, "TTHA": 0.0150, "HEME": 0.0150,
+	"IALI": 0.0150, "TATE": 0.0149, "ABOU": 0.0149, "EERR": 0.0149, "ASSI": 0.0149,
+	"NDLI": 0.0149, "RANG": 0.0149, "BOUT": 0.0149, "LUEO": 0.0149, "ADER": 0.0149,
+	"EDLO": 0.0149, "ITOR": 0.0149, "DPRO": 0.0149, "RITY": 0.0149, "AUTO": 0.0149,
+	"ISPR": 0.0148, "EDUR": 0.0148, "ISAN": 0.0148, "ONFO": 0.0148, "TRUE": 0.0148,
+	"LIZE": 0.0148, "UNTI": 0.0148, "DTOB": 0.0148, "DULE": 0.0148, "DCON": 0.0148,
+	"EINF": 0.0148, "NTRI": 0.0147, "ORMO": 0.0147, "NSAR": 0.0147, "ISAS": 0.0147,
+	"UCTI": 0.0147, "HATC": 0.0147, "SABL": 0.0147, "IOUS": 0.0147, "GFRE": 0.0147,
+	"LIEN": 0.0147, "RNST": 0.0147, "ALLB": 0.0147, "ICHI": 0.0147, "NTHA": 0.0146,
+	"DIFI": 0.0146, "SONE": 0.0146, "REAC": 0.0146, "CLIE": 0.0146, "RTHI": 0.0146,
+	"ESIG": 0.0145, "GEST": 0.0145, "FINI": 0.0145, "ELEM": 0.0145, "MINE": 0.0145,
+	"TINS": 0.0145, "EGIS": 0.0145, "CHES": 0.0145, "INGM": 0.0145, "ADOF": 0.0144,
+	"PERM": 0.0144, "INAR": 0.0144, "YING": 0.0144, "TSAR": 0.0144, "MERG": 0.0144,
+	"OUTT": 0.0144, "INGR": 0.0144, "THAS": 0.0144, "ACES": 0.0143, "ORGF": 0.0143,
+	"ANDM": 0.0143, "CEDU": 0.0143, "EHAV": 0.0143, "DOUT": 0.0143, "RRAY": 0.0143,
+	"IDEN": 0.0143, "IPLE": 0.0142, "RGFR": 0.0142, "ALSE": 0.0142, "NTCO": 0.0142,
+	"ARTO": 0.0142, "ECAN": 0.0142, "TINC": 0.0142, "PREF": 0.0142, "TSTA": 0.0141,
+	"OMPA": 0.0141, "ESTI": 0.0141, "HIST": 0.0141, "SMAL": 0.0141, "NCOD": 0.0141,
+	"LAND": 0.0141, "HECH": 0.0141, "MISS": 0.0141, "BEHA": 0.0141, "LUET": 0.0141,
+	"TORS": 0.0141, "EASE": 0.0141, "MITT": 0.0140, "USEF": 0.0140, "ISTR": 0.0140,
+	"HELP": 0.0140, "NGES": 0.0140, "ORTS": 0.0140, "YINT": 0.0140, "CORD": 0.0140,
+	"RANC": 0.0139, "LLTH": 0.0139, "MEDI": 0.0139, "OCED": 0.0139, "SEAR": 0.0139,
+	"ENIN": 0.0139, "CCUR": 0.0139, "ESEF": 0.0139, "DURE": 0.0138, "IOND": 0.0138,
+	"IRED": 0.0138, "LENG": 0.0138, "ESIZ": 0.0138, "CREE": 0.0138, "ETWE": 0.0138,
+	"AINI": 0.0138, "SSIB": 0.0138, "SOTH": 0.0138, "WIDG": 0.0137, "EDSI": 0.0137,
+	"YXFT": 0.0137, "SHOR": 0.0137, "YDEF": 0.0137, "MCAL": 0.0137, "TOSE": 0.0137,
+	"SEDA": 0.0137, "EMCA": 0.0137, "LTIP": 0.0137, "IEDI": 0.0137, "EXPL": 0.0137,
+	"ORCO": 0.0137, "SCHA": 0.0136, "NATE": 0.0136, "TWEE": 0.0136, "ATOR": 0.0136,
+	"OUBL": 0.0136, "SEDF": 0.0136, "HORT": 0.0136, "NONE": 0.0136, "TIES": 0.0136,
+	"IZED": 0.0136, "NPRO": 0.0136, "TATU": 0.0136, "ONTI": 0.0136, "REVI": 0.0136,
+	"EAST": 0.0136, "NWIT": 0.0136, "QUEN": 0.0136, "AYDP": 0.0136, "TREE": 0.0135,
+	"RIBE": 0.0135, "YDPY": 0.0135, "PLIE": 0.0135, "BETW": 0.0135, "EROO": 0.0135,
+	"NTEX": 0.0135, "REAS": 0.0135, "DDIT": 0.0135, "DERT": 0.0135, "INER": 0.0135,
+	"TOIN": 0.0135, "WEEN": 0.0135, "DLON": 0.0135, "YTHI": 0.0135, "UALL": 0.0134,
+	"DEDT": 0.0134, "LAST": 0.0134, "DEPE": 0.0134, "DOUB": 0.0134, "ESHO": 0.0134,
+	"NTEG": 0.0134, "ONNE": 0.0134, "EPER": 0.0134, "OCOL": 0.0134, "NGCO": 0.0134,
+	"OCCU": 0.0134, "KNOW": 0.0134, "AREP": 0.0134, "TWIT": 0.0133, "EISA": 0.0133,
+	"ARSE": 0.0133, "UDEI": 0.0133, "VERI": 0.0133, "LFOR": 0.0133, "EMOD": 0.0133,
+	"SAFE": 0.0133, "BRAN": 0.0133, "DISC": 0.0133, "LYIN": 0.0133, "LEFO": 0.0133,
+	"ERTY": 0.0132, "INCO": 0.0132, "POND": 0.0132, "RECA": 0.0132, "EKER": 0.0132,
+	"YNAM": 0.0132, "OTOC": 0.0132, "ADIN": 0.0132, "ECTA": 0.0132, "ENVI": 0.0132,
+	"TEND": 0.0132, "REFI": 0.0132, "TIPL": 0.0132, "IMUM": 0.0132, "ELDS": 0.0131,
+	"COPY": 0.0131, "NTSP": 0.0131, "RUSE": 0.0131, "SDEF": 0.0131, "LENT": 0.0131,
+	"NTOP": 0.0131, "DUSE": 0.0131, "EFFE": 0.0131, "IDER": 0.0131, "EREF": 0.0131,
+	"HEIR": 0.0131, "ORES": 0.0131, "TTOT": 0.0131, "MEST": 0.0131, "EMAN": 0.0131,
+	"INTI": 0.0130, "DETH": 0.0130, "UEOF": 0.0130, "SCAL": 0.0130, "HNAM": 0.0130,
+	"ODET": 0.0130, "NDST": 0.0130, "BLEA": 0.0130, "ACHI": 0.0130, "RPRO": 0.0130,
+	"SMAY": 0.0130, "RNAM": 0.0130, "NTSI": 0.0130, "INSE": 0.0129, "CERT": 0.0129,
+	"NGFO": 0.0129, "ESAS": 0.0129, "UBLE": 0.0129, "MODU": 0.0129, "INVO": 0.0128,
+	"NVIR": 0.0128, "ODUL": 0.0128, "NLIN": 0.0128, "IRON": 0.0128, "INAN": 0.0128,
+	"AMED": 0.0128, "FOUN": 0.0128, "EATU": 0.0128, "HERO": 0.0128, "NEDL": 0.0128,
+	"ITRE": 0.0128, "AILE": 0.0128, "ARNI": 0.0128, "NXFT": 0.0128, "EDFR": 0.0127,
+	"IONM": 0.0127, "EWHI": 0.0127, "MASK": 0.0127, "FEAT": 0.0127, "AINE": 0.0127,
+	"VIRO": 0.0127, "NEDB": 0.0127, "ENSE": 0.0127, "TRES": 0.0126, "SEPA": 0.0126,
+	"SERI": 0.0126, "FILT": 0.0126, "TOUS": 0.0126, "SEEA": 0.0126, "STAL": 0.0126,
+	"NETW": 0.0126, "DEDI": 0.0126, "HENI": 0.0126, "SEDB": 0.0125, "TSUP": 0.0125,
+	"RELA": 0.0125, "EGLI": 0.0125, "ISDE": 0.0125, "ISPA": 0.0125, "CHIS": 0.0125,
+	"INKE": 0.0125, "BLEI": 0.0125, "OSSI": 0.0125, "ESWH": 0.0125, "ISTS": 0.0125,
+	"ONCO": 0.0125, "ILTE": 0.0125, "BOTH": 0.0125, "ISSP": 0.0125, "CURS": 0.0125,
+	"SUBS": 0.0124, "EREI": 0.0124, "LECO": 0.0124, "TADD": 0.0124, "RONM": 0.0124,
+	"NCES": 0.0124, "BYDE": 0.0124, "OLIC": 0.0124, "DSET": 0.0124, "EMAI": 0.0124,
+	"ESAL": 0.0124, "POSS": 0.0124, "IMEO": 0.0124, "TSAN": 0.0123, "INET": 0.0123,
+	"FULL": 0.0123, "EETH": 0.0123, "NTIC": 0.0123, "ABIL": 0.0123, "ONWI": 0.0123,
+	"SIMP": 0.0123, "LESA": 0.0123, "ETST": 0.0123, "EGIV": 0.0123, "ISFI": 0.0122,
+	"LESI": 0.0122, "ODES": 0.0122, "LINT": 0.0122, "STCH": 0.0122, "EINS": 0.0122,
+	"ATHE": 0.0122, "NSET": 0.0122, "UCHA": 0.0122, "TTEN": 0.0121, "CONN": 0.0121,
+	"PARS": 0.0121, "TODE": 0.0121, "DRET": 0.0121, "HESO": 0.0121, "CEED": 0.0121,
+	"ENDO": 0.0121, "BECA": 0.0121, "ERCO": 0.0121, "SPAR": 0.0121, "LLER": 0.0121,
+	"ETOF": 0.0121, "DERS": 0.0121, "IVEL": 0.0121, "RITT": 0.0121, "HITE": 0.0120,
+	"CUTE": 0.0120, "ENTC": 0.0120, "USEO": 0.0120, "NGOF": 0.0120, "STOB": 0.0120,
+	"RMOR": 0.0120, "IDES": 0.0120, "STOA": 0.0120, "ACEA": 0.0120, "IZEO": 0.0120,
+	"GETS": 0.0120, "HISM": 0.0120, "HAVI": 0.0120, "PREV": 0.0120, "ENGT": 0.0119,
+	"TCHI": 0.0119, "ADDE": 0.0119, "NTRE": 0.0119, "SOFA": 0.0119, "HERA": 0.0119,
+	"BOVE": 0.0119, "TCOL": 0.0119, "HESU": 0.0119, "ITHM": 0.0119, "NITS": 0.0119,
+	"NNEC": 0.0119, "ABOV": 0.0119, "RTIN": 0.0119, "OUGH": 0.0119, "ENDS": 0.0119,
+	"DDED": 0.0119, "ORAL": 0.0119, "ASES": 0.0119, "EENC": 0.0119, "INEI": 0.0119,
+	"EASS": 0.0119, "THNA": 0.0119, "NECT": 0.0118, "OMAT": 0.0118, "AVER": 0.0118,
+	"ARET": 0.0118, "OFAN": 0.0118, "MARK": 0.0118, "BUIL": 0.0118, "TISN": 0.0118,
+	"OWED": 0.0118, "EEDS": 0.0118, "KETS": 0.0118, "ITWI": 0.0118, "CHIL": 0.0118,
+	"ETHO": 0.0118, "CHAS": 0.0118, "ASSW": 0.0118, "VERA": 0.0118, "ENEW": 0.0118,
+	"OCKS": 0.0118, "RSTO": 0.0117, "ITHI": 0.0117, "HILD": 0.0117, "IPTI": 0.0117,
+	"GFOR": 0.0117, "NALI": 0.0117, "ALIN": 0.0117, "ULAR": 0.0117, "EVEL": 0.0117,
+	"TEDO": 0.0117, "SPOR": 0.0117, "LARG": 0.0117, "ALIS": 0.0116, "EKEY": 0.0116,
+	"NSAN": 0.0116, "ITSC": 0.0116, "YOUR": 0.0116, "NBEU": 0.0116, "CAND": 0.0116,
+	"EING": 0.0116, "RCON": 0.0116, "EDIT": 0.0116, "HEPO": 0.0116, "NTIF": 0.0116,
+	"PPIN": 0.0116, "REMA": 0.0116, "EDUS": 0.0116, "REDT": 0.0115, "NGLI": 0.0115,
+	"DIST": 0.0115, "AREC": 0.0115, "SETA": 0.0115, "LTIN": 0.0115, "STRA": 0.0115,
+	"TUAL": 0.0115, "STOP": 0.0115, "TEGE": 0.0115, "TTHR": 0.0115, "TRET": 0.0115,
+	"ENTV": 0.0115, "XREN": 0.0115, "ERIF": 0.0115, "NODE": 0.0115, "NDSE": 0.0115,
+	"EAPP": 0.0115, "ALCO": 0.0115, "HERP": 0.0114, "STEN": 0.0114, "TERR": 0.0114,
+	"MAPP": 0.0114, "RFIL": 0.0114, "REME": 0.0114, "NEXP": 0.0114, "INCA": 0.0114,
+	"APRO": 0.0114, "LCON": 0.0114, "WHIL": 0.0114, "IFNO": 0.0114, "EBUG": 0.0114,
+	"DERR": 0.0114, "HEMO": 0.0114, "ARAT": 0.0114, "VERR": 0.0114, "ITAL": 0.0114,
+	"ALEN": 0.0114, "ORTA": 0.0114, "PATC": 0.0113, "RSTH": 0.0113, "EFIX": 0.0113,
+	"DONE": 0.0113, "KEYS": 0.0113, "RNSA": 0.0113, "EOFA": 0.0113, "FORI": 0.0113,
+	"EOTH": 0.0113, "DSIG": 0.0113, "OIDX": 0.0113, "GAND": 0.0113, "EXPE": 0.0113,
+	"ORME": 0.0113, "HELA": 0.0112, "NOTR": 0.0112, "ROUN": 0.0112, "HILE": 0.0112,
+	"SSHO": 0.0112, "ISMA": 0.0112, "EISN": 0.0112, "ESEE": 0.0112, "ITSE": 0.0112,
+	"BINA": 0.0112, "CHTH": 0.0112, "OFFS": 0.0112, "NOTC": 0.0112, "TISS": 0.0112,
+	"EGER": 0.0112, "RSET": 0.0112, "HEOU": 0.0112, "EOBJ": 0.0112, "RORS": 0.0112,
+	"DECO": 0.0112, "SESS": 0.0112, "ESSS": 0.0112, "EDFI": 0.0112, "IONE": 0.0112,
+	"TVAL": 0.0111, "APOI": 0.0111, "OVED": 0.0111, "SSOF": 0.0111, "RYIN": 0.0111,
+	"AREI": 0.0111, "URED": 0.0111, "ASNO": 0.0111, "HETR": 0.0111, "IONN": 0.0111,
+	"ANAL": 0.0111, "NNIN": 0.0111, "TTYP": 0.0111, "ALWA": 0.0111, "BOOL": 0.0111,
+	"MPTY": 0.0111, "RMAL": 0.0111, "ERSO": 0.0110, "RYLI": 0.0110, "REVE": 0.0110,
+	"SREA": 0.0110, "EMDS": 0.0110, "ISSU": 0.0110, "ATAR": 0.0110, "SONL": 0.0110,
+	"WAIT": 0.0110, "NOWN": 0.0110, "LEVE": 0.0110, "EADY": 0.0110, "EREP": 0.0110,
+	"OSTA": 0.0110, "MANA": 0.0110, "RTOT": 0.0110, "ORIS": 0.0110, "HARS": 0.0110,
+	"SSEC": 0.0110, "ENTF": 0.0110, "DINS": 0.0110, "REPL": 0.0110, "INTR": 0.0109,
+	"UEIS": 0.0109, "ERCA": 0.0109, "BERS": 0.0109, "ATHN": 0.0109, "ORIE": 0.0109,
+	"EWIL": 0.0109, "RELO": 0.0109, "VELY": 0.0109, "ULAT": 0.0109, "TTIM": 0.0109,
+	"VIOU": 0.0109, "OTAL": 0.0109, "MOST": 0.0109, "HEDA": 0.0109, "EPRI": 0.0108,
+	"NORM": 0.0108, "ERRI": 0.0108, "PRIV": 0.0108, "ADSA": 0.0108, "MEAS": 0.0108,
+	"NGIT": 0.0108, "SLAT": 0.0108, "DERE": 0.0108, "OSET": 0.0108, "NETH": 0.0108,
+	"ASET": 0.0108, "ANEW": 0.0108, "TGLY": 0.0108, "TOST": 0.0108, "EFIE": 0.0108,
+	"RAWA": 0.0108, "SWOR": 0.0108, "THAV": 0.0108, "INGU": 0.0108, "OTRE": 0.0107,
+	"PUTF": 0.0107, "NPRI": 0.0107, "YOFT": 0.0107, "IESA": 0.0107, "UTIO": 0.0107,
+	"ANSP": 0.0107, "TINF": 0.0107, "EDRE": 0.0107, "TNOT": 0.0107, "YCON": 0.0107,
+	"ESCA": 0.0107, "ECOD": 0.0107, "WAYS": 0.0107, "MAXI": 0.0107, "ZEOF": 0.0107,
+	"BLEF": 0.0107, "REUS": 0.0107, "SZER": 0.0107, "NDEF": 0.0106, "HEGI": 0.0106,
+	"TLIN": 0.0106, "DTOA": 0.0106, "NWHI": 0.0106, "ROMA": 0.0106, "EDCO": 0.0106,
+	"CIAL": 0.0106, "ITST": 0.0106, "RANE": 0.0106, "SANE": 0.0106, "NSUC": 0.0106,
+	"ALLL": 0.0106, "ASBE": 0.0106, "HENC": 0.0106, "TSHO": 0.0106, "EIFT": 0.0106,
+	"IDED": 0.0106, "ANDN": 0.0106, "CAPA": 0.0106, "ATAT": 0.0105, "ECOL": 0.0105,
+	"HETI": 0.0105, "DSTH": 0.0105, "FFSE": 0.0105, "XDRS": 0.0105, "ANAG": 0.0105,
+	"LOGI": 0.0105, "FTCO": 0.0105, "NDEN": 0.0105, "DCHA": 0.0105, "INGB": 0.0105,
+	"NDIT": 0.0105, "SAVE": 0.0105, "LTST": 0.0105, "DXFT": 0.0105, "NARY": 0.0105,
+	"RSTA": 0.0105, "NSEE": 0.0105, "ATAB": 0.0105, "ONTC": 0.0105, "NGWI": 0.0104,
+	"SSTR": 0.0104, "HASB": 0.0104, "HISA": 0.0104, "LREA": 0.0104, "IEDT": 0.0104,
+	"GURE": 0.0104, "AXIM": 0.0104, "UNTE": 0.0104, "WARE": 0.0104, "ORTO": 0.0104,
+	"SSEE": 0.0104, "ESUP": 0.0104, "TEDS": 0.0103, "TAPP": 0.0103, "DEBU": 0.0103,
+	"CKIN": 0.0103, "CHIV": 0.0103, "NGIS": 0.0103, "RAMS": 0.0103, "TSFO": 0.0103,
+	"ESTE": 0.0103, "IDTH": 0.0103, "RTOF": 0.0103, "TOGE": 0.0103, "WABL": 0.0103,
+	"NSPO": 0.0103, "FIER": 0.0102, "RSIN": 0.0102, "HETA": 0.0102, "AVIO": 0.0102,
+	"EEND": 0.0102, "OMPO": 0.0102, "ONEN": 0.0102, "HIVE": 0.0102, "STTH": 0.0102,
+	"ASED": 0.0102, "DERI": 0.0102, "ECEI": 0.0102, "GTHI": 0.0102, "IFYT": 0.0102,
+	"TDEF": 0.0102, "PERL": 0.0102, "EISS": 0.0102, "SPRE": 0.0102, "SEET": 0.0102,
+	"EMAC": 0.0102, "RSTR": 0.0102, "NSTC": 0.0102, "PAND": 0.0102, "ANYO": 0.0102,
+	"AFET": 0.0102, "EREQ": 0.0101, "NSIN": 0.0101, "EPLA": 0.0101, "XIMU": 0.0101,
+	"IVES": 0.0101, "TOPT": 0.0101, "FYTH": 0.0101, "CTLY": 0.0101, "YUSE": 0.0101,
+	"OWTH": 0.0101, "IBED": 0.0101, "NEDA": 0.0101, "NAGE": 0.0101, "EFLA": 0.0101,
+	"NCET": 0.0101, "IVET": 0.0101, "YLIB": 0.0101, "EDSE": 0.0101, "OURN": 0.0100,
+	"OTHI": 0.0100, "ELET": 0.0100, "ILEA": 0.0100, "ONCA": 0.0100, "EIST": 0.0100,
+	"ERSW": 0.0100, "EENS": 0.0100, "EHEA": 0.0100, "NSON": 0.0100, "HISP": 0.0100,
+	"ARYL": 0.0100, "CTIN": 0.0100, "ELIM": 0.0100, "RACE": 0.0100, "EGIT": 0.0100,
+	"ECTL": 0.0099, "SSIG": 0.0099, "HECL": 0.0099, "DINP": 0.0099, "EREC": 0.0099,
+	"CROS": 0.0099, "UTOM": 0.0099, "HATS": 0.0099, "ECIA": 0.0099, "NEVE": 0.0099,
+	"OCON": 0.0099, "SBEE": 0.0099, "CLOS": 0.0099, "AGEI": 0.0099, "ROTH": 0.0099,
+	"CEDI": 0.0099, "NAMI": 0.0099, "SDIS": 0.0099, "CHIT": 0.0099, "BOLS": 0.0099,
+	"IEDB": 0.0098, "CLIB": 0.0098, "NSPE": 0.0098, "WTHE": 0.0098, "DSAF": 0.0098,
+	"SINS": 0.0098, "NDCO": 0.0098, "NERE": 0.0098, "HERS": 0.0098, "WERE": 0.0098,
+	"IVAT": 0.0098, "ICEN": 0.0098, "OWER": 0.0098, "SERT": 0.0098, "SEDW": 0.0098,
+	"LWAY": 0.0098, "LLRE": 0.0098, "ILAR": 0.0098, "APAB": 0.0098, "ECAU": 0.0098,
+	"ACOM": 0.0098, "USUA": 0.0098, "AREU": 0.0098, "DSTR": 0.0098, "EXPR": 0.0097,
+	"MACH": 0.0097, "MEIS": 0.0097, "NALC": 0.0097, "SANA": 0.0097, "BUTI": 0.0097,
+	"SEVE": 0.0097, "IFYO": 0.0097, "NSMA": 0.0097, "ICHA": 0.0097, "INEA": 0.0097,
+	"WOUL": 0.0097, "EUNI": 0.0097, "HINT": 0.0097, "NENT": 0.0097, "YARE": 0.0097,
+	"NGRE": 0.0097, "RIGH": 0.0097, "SCHE": 0.0097, "TTED": 0.0097, "SETI": 0.0097,
+	"ANDU": 0.0097, "AWAB": 0.0096, "FYOU": 0.0096, "ONSS": 0.0096, "TOAL": 0.0096,
+	"ECTT": 0.0096, "NDOF": 0.0096, "EPAC": 0.0096, "JOUR": 0.0096, "DCOM": 0.0096,
+	"GCON": 0.0096, "ONSW": 0.0096, "CEIV": 0.0096, "LLST": 0.0096, "BITS": 0.0096,
+	"ITMA": 0.0096, "EDBE": 0.0096, "IGIN": 0.0096, "LOSE": 0.0096, "EADA": 0.0096,
+	"ENON": 0.0096, "ANAR": 0.0096, "HATW": 0.0096, "BECO": 0.0096, "FSTR": 0.0095,
+	"HEUN": 0.0095, "ADON": 0.0095, "DBUS": 0.0095, "DELE": 0.0095, "VISU": 0.0095,
+	"DOCU": 0.0095, "DASA": 0.0095, "ESSF": 0.0095, "FERS": 0.0095, "ORTI": 0.0095,
+	"OCUM": 0.0095, "CUME": 0.0095, "RAWC": 0.0095, "XFTG": 0.0095, "UTHE": 0.0095,
+	"ISUA": 0.0095, "NTFO": 0.0095, "TEAN": 0.0095, "HELE": 0.0095, "TOPR": 0.0095,
+	"NSER": 0.0094, "EIND": 0.0094, "YOUC": 0.0094, "CAPI": 0.0094, "HARD": 0.0094,
+	"GWIT": 0.0094, "SEIT": 0.0094, "ULDN": 0.0094, "MAGE": 0.0094, "ASAN": 0.0094,
+	"NLYT": 0.0094, "CORE": 0.0094, "ACCO": 0.0094, "EFRO": 0.0094, "EINV": 0.0094,
+	"VERY": 0.0094, "ONAB": 0.0094, "ICHC": 0.0094, "PPRO": 0.0094, "RETE": 0.0094,
+	"TORA": 0.0094, "IMAG": 0.0094, "VING": 0.0094, "OFIN": 0.0094, "ORSE": 0.0094,
+	"UTED": 0.0094, "GAIN": 0.0094, "ORIG": 0.0094, "ERRN": 0.0094, "HEWI": 0.0093,
+	"FLOA": 0.0093, "ESOL": 0.0093, "DVAL": 0.0093, "NTVA": 0.0093, "EXTR": 0.0093,
+	"OFIL": 0.0093, "TWAS": 0.0093, "NNAM": 0.0093, "OTIN": 0.0093, "PUTI": 0.0093,
+	"HERI": 0.0093, "FETY": 0.0093, "DSTO": 0.0093, "CRYP": 0.0093, "RYPT": 0.0093,
+	"ARER": 0.0093, "RTTH": 0.0093, "SDES": 0.0093, "HEAP": 0.0093, "NABO": 0.0092,
+	"DUCE": 0.0092, "FALS": 0.0092, "SPRI": 0.0092, "EEDT": 0.0092, "NTIA": 0.0092,
+	"XPEC": 0.0092, "SEDO": 0.0092, "RITH": 0.0092, "GING": 0.0092, "ANTH": 0.0092,
+	"NDAN": 0.0092, "EDOR": 0.0092, "ANER": 0.0092, "ARDC": 0.0092, "SIMI": 0.0092,
+	"LNOT": 0.0092, "ATEO": 0.0092, "ROFT": 0.0092, "SADD": 0.0092, "JUST": 0.0092,
+	"BOLI": 0.0092, "GINT": 0.0092, "NTSE": 0.0092, "HINE": 0.0092, "TOAS": 0.0092,
+	"IONB": 0.0092, "EDST": 0.0092, "PABI": 0.0092, "FTGL": 0.0092, "ESOR": 0.0092,
+	"MILA": 0.0091, "HAST": 0.0091, "LLLE": 0.0091, "SSWO": 0.0091, "FIND": 0.0091,
+	"ITTH": 0.0091, "ITSU": 0.0091, "IMIL": 0.0091, "OOTH": 0.0091, "EINP": 0.0091,
+	"ONDS": 0.0091, "ORTT": 0.0091, "OFRE": 0.0091, "TERC": 0.0091, "DARE": 0.0091,
+	"MEOU": 0.0091, "LARE": 0.0091, "RESI": 0.0091, "TINA": 0.0091, "CEST": 0.0091,
+	"USAG": 0.0091, "ECLA": 0.0091, "SASS": 0.0090, "LICE": 0.0090, "FORP": 0.0090,
+	"NADD": 0.0090, "EMBE": 0.0090, "ESRE": 0.0090, "LPRO": 0.0090, "NGON": 0.0090,
+	"EMEM": 0.0090, "RYTH": 0.0090, "RTIF": 0.0090, "EDEN": 0.0090, "UFFI": 0.0090,
+	"NCHA": 0.0090, "AGET": 0.0090, "SONT": 0.0090, "SUFF": 0.0090, "RORI": 0.0090,
+	"UTIS": 0.0090, "ONEI": 0.0090, "STRO": 0.0090, "LFIL": 0.0090, "HEER": 0.0090,
+	"EHAS": 0.0090, "HEGL": 0.0090, "INOR": 0.0090, "SOUT": 0.0090, "DSYS": 0.0090,
+	"NGET": 0.0090, "ITHS": 0.0089, "DUSI": 0.0089, "INGN": 0.0089, "INTT": 0.0089,
+	"NSOF": 0.0089, "ITEC": 0.0089, "ESON": 0.0089, "LEAR": 0.0089, "AVAL": 0.0089,
+	"TWHE": 0.0089, "LIED": 0.0089, "NOTP": 0.0089, "SSTO": 0.0089, "GETO": 0.0089,
+	"TANT": 0.0089, "EEXP": 0.0089, "SNUM": 0.0089, "REAR": 0.0089, "RIOR": 0.0089,
+	"NDTO": 0.0089, "EORD": 0.0089, "EMIT": 0.0089, "RODU": 0.0089, "XPRE": 0.0089,
+	"LERE": 0.0089, "HFON": 0.0089, "VALE": 0.0089, "LOAT": 0.0089, "LETI": 0.0089,
+	"ILLA": 0.0089, "XTER": 0.0089, "EDLI": 0.0089, "RELE": 0.0089, "BLEO": 0.0088,
+	"SINA": 0.0088, "AGAI": 0.0088, "RRID": 0.0088, "RKIN": 0.0088, "EWID": 0.0088,
+	"ANAT": 0.0088, "ESTS": 0.0088, "ORKI": 0.0088, "TWHI": 0.0088, "GNUM": 0.0088,
+	"RRNO": 0.0088, "SSTA": 0.0088, "LICI": 0.0088, "NGEX": 0.0088, "ISUN": 0.0088,
+	"APIT": 0.0088, "SRES": 0.0088, "UCTT": 0.0088, "OLON": 0.0088, "ONON": 0.0088,
+	"ETES": 0.0088, "OUCA": 0.0088, "ODUC": 0.0088, "FORU": 0.0088, "RSAR": 0.0088,
+	"ASTE": 0.0087, "EPTI": 0.0087, "USEA": 0.0087, "RSAN": 0.0087, "IVAL": 0.0087,
+	"EONL": 0.0087, "MPOR": 0.0087, "FORW": 0.0087, "OUTS": 0.0087, "COUL": 0.0087,
+	"EYOU": 0.0087, "ANSL": 0.0087, "LSTH": 0.0087, "UCAN": 0.0087, "HESH": 0.0087,
+	"DNAM": 0.0087, "EALS": 0.0087, "PRIO": 0.0087, "CEIS": 0.0087, "BESE": 0.0087,
+	"DSIN": 0.0087, "AGER": 0.0087, "ITCO": 0.0087, "RSPE": 0.0087, "ENAN": 0.0087,
+	"GINA": 0.0087, "ERIT": 0.0087, "TTOA": 0.0087, "MPLI": 0.0086, "HASH": 0.0086,
+	"RRED": 0.0086, "ISON": 0.0086, "RTOA": 0.0086, "RCOM": 0.0086, "VATE": 0.0086,
+	"SINF": 0.0086, "CTTH": 0.0086, "OREI": 0.0086, "SURE": 0.0086, "RETR": 0.0086,
+	"DOWN": 0.0086, "LOPE": 0.0086, "PRET": 0.0086, "SESA": 0.0086, "NOPT": 0.0086,
+	"EVIO": 0.0086, "ATAS": 0.0086, "REDO": 0.0086, "ILEF": 0.0086, "ENTW": 0.0086,
+	"ONOR": 0.0086, "INTC": 0.0086, "TOFA": 0.0086, "ERPC": 0.0086, "PPED": 0.0086,
+	"LEWI": 0.0086, "OLVE": 0.0086, "ATEL": 0.0086, "ETRI": 0.0086, "CKED": 0.0086,
+	"STAC": 0.0086, "INPR": 0.0086, "ILEO": 0.0086, "OMAI": 0.0086, "EINI": 0.0086,
+	"MING": 0.0085, "TOOL": 0.0085, "NGST": 0.0085, "MPAT": 0.0085, "TOFI": 0.0085,
+	"UREI": 0.0085, "NGER": 0.0085, "EEXT": 0.0085, "IFAN": 0.0085, "OBTA": 0.0085,
+	"BTAI": 0.0085, "OFCO": 0.0085, "ATHA": 0.0085, "OTES": 0.0085, "CLEA": 0.0085,
+	"REES": 0.0085, "OTSU": 0.0085, "TUSI": 0.0085, "DTOS": 0.0085, "EANS": 0.0085,
+	"EDED": 0.0085, "ITCH": 0.0085, "OTEP": 0.0085, "SSUC": 0.0085, "CTAN": 0.0085,
+	"ETED": 0.0085, "QUIV": 0.0085, "ENEX": 0.0084, "RITI": 0.0084, "HEOR": 0.0084,
+	"PICT": 0.0084, "CHCA": 0.0084, "PITA": 0.0084, "YPED": 0.0084, "LDNO": 0.0084,
+	"ILLI": 0.0084, "TGET": 0.0084, "TVAR": 0.0084, "TDOE": 0.0084, "UIVA": 0.0084,
+	"LLLL": 0.0084, "GORI": 0.0084, "EENA": 0.0084, "RIGI": 0.0084, "FERR": 0.0084,
+	"NSID": 0.0084, "PPER": 0.0084, "GITS": 0.0084, "TMAY": 0.0084, "TACK": 0.0084,
+	"SITO": 0.0084, "ONMA": 0.0084, "ISST": 0.0084, "DOMA": 0.0084, "ASFO": 0.0084,
+	"CLIP": 0.0084, "TEVA": 0.0083, "OUTA": 0.0083, "TSCH": 0.0083, "ALTE": 0.0083,
+	"RDET": 0.0083, "RNAT": 0.0083, "ETRE": 0.0083, "ERTE": 0.0083, "SENA": 0.0083,
+	"NCAP": 0.0083, "ESMA": 0.0083, "WHET": 0.0083, "VIOR": 0.0083, "RVAL": 0.0083,
+	"THEQ": 0.0083, "CCEE": 0.0083, "TCAL": 0.0083, "ATCA": 0.0083, "LEDT": 0.0083,
+	"AVET": 0.0083, "GFIL": 0.0083, "DTHA": 0.0083, "TESO": 0.0083, "DIFT": 0.0083,
+	"ECTU": 0.0082, "ESHA": 0.0082, "UDIN": 0.0082, "ANOT": 0.0082, "NCED": 0.0082,
+	"TDIR": 0.0082, "UENC": 0.0082, "WHAT": 0.0082, "ITES": 0.0082, "NDSI": 0.0082,
+	"ULES": 0.0082, "FORG": 0.0082, "IVER": 0.0082, "ACET": 0.0082, "WEVE": 0.0082,
+	"EIVE": 0.0082, "HESC": 0.0082, "LUDI": 0.0082, "UTFI": 0.0082, "IMER": 0.0082,
+	"ORDS": 0.0082, "EROR": 0.0082, "TITI": 0.0082, "NPAR": 0.0082, "ERSN": 0.0082,
+	"INIS": 0.0082, "APPI": 0.0081, "ONSR": 0.0081, "ORYT": 0.0081, "BOOT": 0.0081,
+	"STOO": 0.0081, "ONDE": 0.0081, "DECI": 0.0081, "RAWS": 0.0081, "BLEC": 0.0081,
+	"LLNO": 0.0081, "ICEI": 0.0081, "NEST": 0.0081, "SISA": 0.0081, "YFIL": 0.0081,
+	"ESUB": 0.0081, "WARD": 0.0081, "OREC": 0.0081, "RAWD": 0.0081, "PATI": 0.0081,
+	"TEPR": 0.0081, "POSE": 0.0081, "TSEL": 0.0081, "LYRE": 0.0081, "DOTH": 0.0081,
+	"EISU": 0.0081, "ISDI": 0.0081, "ORCE": 0.0081, "SORT": 0.0081, "IZES": 0.0081,
+	"MANU": 0.0081, "FORR": 0.0081, "ETOP": 0.0081, "ITIV": 0.0081, "ECKS": 0.0080,
+	"SITE": 0.0080, "VENI": 0.0080, "GLOB": 0.0080, "LLLI": 0.0080, "TOBY": 0.0080,
+	"CTST": 0.0080, "INEC": 0.0080, "TABA": 0.0080, "GTOT": 0.0080, "PTHE": 0.0080,
+	"GION": 0.0080, "ONSP": 0.0080, "NOTT": 0.0080, "EIMP": 0.0080, "ELAS": 0.0080,
+	"SSUP": 0.0080, "EGIO": 0.0080, "EATI": 0.0080, "GETT": 0.0080, "XPRT": 0.0080,
+	"ANDB": 0.0080, "CACH": 0.0080, "SIFT": 0.0080, "ERLI": 0.0080, "ANUA": 0.0080,
+	"NTIM": 0.0080, "EMIN": 0.0080, "HOLD": 0.0080, "ENRE": 0.0080, "DEXI": 0.0080,
+	"HENO": 0.0080, "ILLN": 0.0080, "FFIL": 0.0080, "ERUN": 0.0079, "RATO": 0.0079,
+	"ILER": 0.0079, "HATD": 0.0079, "ANDG": 0.0079, "NRET": 0.0079, "ETAR": 0.0079,
+	"MITI": 0.0079, "LESE": 0.0079, "AYTH": 0.0079, "HOWE": 0.0079, "EACC": 0.0079,
+	"ONWH": 0.0079, "OPRI": 0.0079, "MINI": 0.0079, "RCES": 0.0079, "IRES": 0.0079,
+	"ULTT": 0.0079, "EACT": 0.0079, "CULA": 0.0079, "OSPE": 0.0079, "HEQU": 0.0079,
+	"XPLA": 0.0079, "EDIA": 0.0079, "HROU": 0.0078, "HANT": 0.0078, "ARDI": 0.0078,
+	"GEDS": 0.0078, "ONSF": 0.0078, "FITS": 0.0078, "ORST": 0.0078, "ORYA": 0.0078,
+	"NSIS": 0.0078, "NUAL": 0.0078, "YALL": 0.0078, "CPUS": 0.0078, "HEFL": 0.0078,
+	"QUER": 0.0078, "AREM": 0.0078, "ICTU": 0.0078, "NTIL": 0.0078, "ARIN": 0.0078,
+	"FFIC": 0.0078, "LDRE": 0.0078, "PUTS": 0.0078, "SECU": 0.0078, "ALGO": 0.0078,
+	"ICIT": 0.0078, "OMPI": 0.0078, "CCHA": 0.0078, "LLYA": 0.0078, "RPRE": 0.0078,
+	"RMAN": 0.0078, "ROCC": 0.0078, "AINA": 0.0077, "STST": 0.0077, "SREQ": 0.0077,
+	"ESBE": 0.0077, "INEO": 0.0077, "NZER": 0.0077, "IEDA": 0.0077, "EEXC": 0.0077,
+	"ABAS": 0.0077, "TERO": 0.0077, "EBAS": 0.0077, "SELF": 0.0077, "HATM": 0.0077,
+	"ONIT": 0.0077, "TOPD": 0.0077, "NSTO": 0.0077, "MANY": 0.0077, "ISTA": 0.0077,
+	"MEIN": 0.0077, "DSER": 0.0077, "ATIB": 0.0077, "AMIN": 0.0077, "ASER": 0.0077,
+	"FIXE": 0.0077, "LLYT": 0.0077, "BEGI": 0.0077, "OFOR": 0.0077, "NTDE": 0.0077,
+	"OALL": 0.0077, "UNTO": 0.0077, "DCLI": 0.0077, "SAVA": 0.0077, "RNSO": 0.0077,
+	"ELEA": 0.0077, "EOFF": 0.0077, "HASA": 0.0077, "BUTT": 0.0077, "EDPR": 0.0077,
+	"RORE": 0.0077, "NTOA": 0.0077, "CEOF": 0.0076, "EANI": 0.0076, "SPER": 0.0076,
+	"NDPR": 0.0076, "REFO": 0.0076, "TELY": 0.0076, "LBLB": 0.0076, "ALPA": 0.0076,
+	"REET": 0.0076, "OWEV": 0.0076, "EMON": 0.0076, "ESYM": 0.0076, "YWIT": 0.0076,
+	"SDON": 0.0076, "ISLI": 0.0076, "EREL": 0.0076, "RMIS": 0.0076, "ENTP": 0.0076,
+	"MPIL": 0.0076, "IMAL": 0.0076, "FORF": 0.0076, "ETOS": 0.0076, "HEAC": 0.0076,
+	"ITHC": 0.0076, "LYON": 0.0076, "INFI": 0.0076, "PEAR": 0.0076, "ENSA": 0.0076,
+	"TONE": 0.0076, "ECAT": 0.0076, "EMAX": 0.0075, "ILEW": 0.0075, "APPR": 0.0075,
+	"ORAR": 0.0075, "ENIT": 0.0075, "PLAN": 0.0075, "ORYI": 0.0075, "LGOR": 0.0075,
+	"CENT": 0.0075, "SPAT": 0.0075, "ASSU": 0.0075, "ATEI": 0.0075, "ILEC": 0.0075,
+	"NSLA": 0.0075, "TSWI": 0.0075, "KETH": 0.0075, "YNOT": 0.0075, "EDUN": 0.0075,
+	"TSIG": 0.0075, "NTRA": 0.0075, "RUNN": 0.0075, "SERE": 0.0075, "EUSI": 0.0075,
+	"AMEF": 0.0075, "DSHO": 0.0075, "NDAT": 0.0074, "MEMB": 0.0074, "URNT": 0.0074,
+	"HOWN": 0.0074, "THOR": 0.0074, "ITER": 0.0074, "ICET": 0.0074, "ERMS": 0.0074,
+	"ITED": 0.0074, "AMIC": 0.0074, "PTTH": 0.0074, "ERMA": 0.0074, "NCRE": 0.0074,
+	"NGWH": 0.0074, "RDCL": 0.0074, "UEIF": 0.0074, "ISEN": 0.0074, "ENCR": 0.0074,
+	"NIST": 0.0074, "NTAN": 0.0074, "BEDI": 0.0074, "ALLF": 0.0074, "EHOS": 0.0074,
+	"REDA": 0.0074, "EDEV": 0.0074, "NVOK": 0.0074, "EONE": 0.0074, "IORI": 0.0074,
+	"USPR": 0.0074, "NALF": 0.0074, "ITSA": 0.0074, "NOPE": 0.0074, "FLOW": 0.0074,
+	"UTEV": 0.0074, "TONL": 0.0074, "AREF": 0.0074, "ONCE": 0.0073, "NSRE": 0.0073,
+	"ONEA": 0.0073, "ATAI": 0.0073, "AVEA": 0.0073, "AREG": 0.0073, "NCEG": 0.0073,
+	"ACEI": 0.0073, "RIDE": 0.0073, "TVER": 0.0073, "DYNA": 0.0073, "URIN": 0.0073,
+	"NOTO": 0.0073, "CEAN": 0.0073, "TEDU": 0.0073, "LEDB": 0.0073, "LANA": 0.0073,
+	"RREC": 0.0073, "RWIT": 0.0073, "OPRO": 0.0073, "ANGL": 0.0073, "HOWS": 0.0073,
+	"PDBU": 0.0073, "EXPO": 0.0073, "DEDB": 0.0073, "SANO": 0.0073, "MPRO": 0.0073,
+	"SSOR": 0.0073, "IDIN": 0.0073, "ORUS": 0.0073, "CANT": 0.0073, "BUSP": 0.0073,
+	"EORI": 0.0073, "HEXF": 0.0072, "DESI": 0.0072, "DTOC": 0.0072, "TMAP": 0.0072,
+	"CLOC": 0.0072, "INSM": 0.0072, "ORUN": 0.0072, "NISS": 0.0072, "ISEX": 0.0072,
+	"EGET": 0.0072, "NDWI": 0.0072, "TRYI": 0.0072, "EPAS": 0.0072, "TROY": 0.0072,
+	"TSIZ": 0.0072, "ATIT": 0.0072, "FALL": 0.0072, "RCHA": 0.0072, "UREC": 0.0072,
+	"ETHR": 0.0072, "ENFI": 0.0072, "DLER": 0.0072, "RAPH": 0.0072, "UNNI": 0.0072,
+	"STON": 0.0072, "RXFT": 0.0072, "SXFT": 0.0072, "NLYA": 0.0072, "STOS": 0.0072,
+	"OPDB": 0.0072, "ODEI": 0.0072, "CANO": 0.0072, "RFUN": 0.0072, "NBYT": 0.0072,
+	"DENC": 0.0072, "PERS": 0.0072, "PRIM": 0.0072, "PPEA": 0.0072, "VOKE": 0.0072,
+	"RYTO": 0.0071, "ICLI": 0.0071, "GRAP": 0.0071, "ONLI": 0.0071, "SAPO": 0.0071,
+	"RTST": 0.0071, "TOUT": 0.0071, "TDES": 0.0071, "ASRE": 0.0071, "LEOR": 0.0071,
+	"EADT": 0.0071, "EDCH": 0.0071, "TOEN": 0.0071, "NKER": 0.0071, "EROU": 0.0071,
+	"DWIL": 0.0071, "TEXI": 0.0071, "UREA": 0.0071, "HOWT": 0.0071, "EVAR": 0.0071,
+	"IONU": 0.0071, "OUPS": 0.0071, "ALLA": 0.0071, "LOOK": 0.0071, "LCOM": 0.0071,
+	"ICHT": 0.0071, "ACED": 0.0071, "LLIS": 0.0071, "IFYI": 0.0071, "ESCO": 0.0071,
+	"QUOT": 0.0071, "ACON": 0.0071, "CEAT": 0.0071, "RSNU": 0.0071, "LEDI": 0.0071,
+	"OLLE": 0.0071, "STMA": 0.0071, "ONPR": 0.0071, "OGNU": 0.0071, "NEDT": 0.0071,
+	"ESEA": 0.0071, "OUTO": 0.0071, "IZEI": 0.0071, "CTUA": 0.0071, "NCAN": 0.0071,
+	"LTIS": 0.0071, "ARDE": 0.0070, "EAVA": 0.0070, "ACTU": 0.0070, "LUSE": 0.0070,
+	"ETIN": 0.0070, "BESP": 0.0070, "EWAS": 0.0070, "ATAL": 0.0070, "EIFI": 0.0070,
+	"EWRI": 0.0070, "CEGL": 0.0070, "ATCO": 0.0070, "HEYA": 0.0070, "ALTO": 0.0070,
+	"MESA": 0.0070, "NORD": 0.0070, "RACK": 0.0070, "NGSE": 0.0070, "SSAR": 0.0070,
+	"EREX": 0.0070, "HANO": 0.0070, "ETIS": 0.0070, "ERSC": 0.0070, "ECLI": 0.0070,
+	"CESA": 0.0070, "SPOS": 0.0070, "TMAT": 0.0070, "OFAL": 0.0070, "SFUL": 0.0070,
+	"NDSO": 0.0070, "TESP": 0.0070, "MSUS": 0.0070, "ERSU": 0.0070, "ACKS": 0.0070,
+	"ERAR": 0.0070, "ESIT": 0.0070, "NTOR": 0.0069, "TTEM": 0.0069, "REAN": 0.0069,
+	"HEMI": 0.0069, "OMME": 0.0069, "EMES": 0.0069, "EAUT": 0.0069, "BLBL": 0.0069,
+	"ESIS": 0.0069, "CHRO": 0.0069, "SOLV": 0.0069, "UNLE": 0.0069, "SUIT": 0.0069,
+	"RWHI": 0.0069, "OFFI": 0.0069, "ESHE": 0.0069, "WANT": 0.0069, "ANTT": 0.0069,
+	"PTED": 0.0069, "DDIS": 0.0069, "NSFO": 0.0069, "ADED": 0.0069, "NTMA": 0.0069,
+	"RELI": 0.0069, "AMEC": 0.0069, "TSUC": 0.0069, "ODED": 0.0069, "ROGN": 0.0069,
+	"NUXT": 0.0069, "IVED": 0.0069, "ORIF": 0.0069, "HENS": 0.0069, "OGET": 0.0069,
+	"LANG": 0.0069, "MERE": 0.0069, "LITI": 0.0069, "ERSH": 0.0069, "TATT": 0.0069,
+	"ULTO": 0.0069, "SEAN": 0.0069, "DEPR": 0.0069, "CLIN": 0.0069, "ORMS": 0.0068,
+	"INKS": 0.0068, "ERIC": 0.0068, "SEMA": 0.0068, "CARD": 0.0068, "TISR": 0.0068,
+	"RMAP": 0.0068, "ANDH": 0.0068, "IESI": 0.0068, "INGX": 0.0068, "WIDT": 0.0068,
+	"ODEF": 0.0068, "RTYE": 0.0068, "DOPT": 0.0068, "NGFI": 0.0068, "ONUS": 0.0068,
+	"SLIS": 0.0068, "CCEP": 0.0068, "RTIE": 0.0068, "SYNC": 0.0068, "ETAN": 0.0068,
+	"NEIS": 0.0068, "CENS": 0.0068, "NALT": 0.0068, "NOTD": 0.0068, "ITAB": 0.0068,
+	"SETU": 0.0068, "TEMA": 0.0068, "ALIA": 0.0068, "NGEN": 0.0068, "TISU": 0.0068,
+	"DINF": 0.0068, "ITIE": 0.0068, "LLTO": 0.0068, "CIMA": 0.0068, "ERAC": 0.0068,
+	"NDIF": 0.0068, "NARG": 0.0068, "NTSF": 0.0068, "SBUT": 0.0068, "WHOS": 0.0068,
+	"ITEM": 0.0068, "NLES": 0.0067, "PROB": 0.0067, "INLI": 0.0067, "BYAN": 0.0067,
+	"OSES": 0.0067, "TYEM": 0.0067, "HASN": 0.0067, "YEMI": 0.0067, "ERTA": 0.0067,
+	"ECES": 0.0067, "IONV": 0.0067, "LBOX": 0.0067, "EGAT": 0.0067, "PFIL": 0.0067,
+	"RSWH": 0.0067, "NTAR": 0.0067, "TSYS": 0.0067, "RAGE": 0.0067, "DDAT": 0.0067,
+	"ERSP": 0.0067, "HEAN": 0.0067, "TOAC": 0.0067, "TRUN": 0.0067, "LORM": 0.0067,
+	"NDMA": 0.0067, "SINV": 0.0067, "SAST": 0.0067, "TREC": 0.0067, "OAND": 0.0067,
+	"ILLS": 0.0067, "UILD": 0.0067, "UETH": 0.0067, "ODIN": 0.0067, "ETYM": 0.0067,
+	"IVEI": 0.0067, "WELL": 0.0067, "TTRA": 0.0067, "XPOS": 0.0067, "TMOD": 0.0067,
+	"TISP": 0.0066, "OREQ": 0.0066, "MPUT": 0.0066, "TANC": 0.0066, "NOTU": 0.0066,
+	"NGAS": 0.0066, "EOVE": 0.0066, "RIVE": 0.0066, "ANGU": 0.0066, "ONSC": 0.0066,
+	"XAND": 0.0066, "DTOD": 0.0066, "OCKI": 0.0066, "TYMT": 0.0066, "TISC": 0.0066,
+	"EXRE": 0.0066, "GESI": 0.0066, "OUPI": 0.0066, "XTRA": 0.0066, "HSPE": 0.0066,
+	"EEVE": 0.0066, "OCRE": 0.0066, "EYAR": 0.0066, "MMEN": 0.0066, "TLEA": 0.0066,
+	"NATU": 0.0066, "DIAT": 0.0066, "ASIG": 0.0066, "ERDE": 0.0066, "EITI": 0.0066,
+	"ONER": 0.0066, "EANE": 0.0066, "MAPS": 0.0066, "RNTH": 0.0066, "ONGP": 0.0066,
+	"URTH": 0.0066, "OFST": 0.0066, "ECIS": 0.0065, "CETO": 0.0065, "DECL": 0.0065,
+	"EADF": 0.0065, "SUSI": 0.0065, "TSEE": 0.0065, "SALS": 0.0065, "SSIS": 0.0065,
+	"IFIS": 0.0065, "PEDE": 0.0065, "LLBO": 0.0065, "NEDS": 0.0065, "YPRO": 0.0065,
+	"TICS": 0.0065, "NGDO": 0.0065, "EGEN": 0.0065, "SVAL": 0.0065, "TESI": 0.0065,
+	"LIAS": 0.0065, "LTIM": 0.0065, "ISIO": 0.0065, "AREL": 0.0065, "EEXI": 0.0065,
+	"ETOA": 0.0065, "ANSI": 0.0065, "SUME": 0.0065, "NLYI": 0.0065, "TONT": 0.0065,
+	"EENV": 0.0065, "ERVA": 0.0065, "EREG": 0.0065, "KTHE": 0.0065, "PUTT": 0.0065,
+	"REXT": 0.0065, "ANDX": 0.0065, "GNAT": 0.0065, "SOPE": 0.0065, "TEDC": 0.0064,
+	"NECE": 0.0064, "HISD": 0.0064, "LEOF": 0.0064, "TUNS": 0.0064, "EPTT": 0.0064,
+	"MPOS": 0.0064, "TOTA": 0.0064, "GATI": 0.0064, "REDW": 0.0064, "EOFS": 0.0064,
+	"SPEN": 0.0064, "TEMI": 0.0064, "ALVA": 0.0064, "EEXE": 0.0064, "IONH": 0.0064,
+	"LYUS": 0.0064, "CODI": 0.0064, "LEFT": 0.0064, "HOFT": 0.0064, "OMMO": 0.0064,
+	"EMAP": 0.0064, "EGIN": 0.0064, "HEHE": 0.0064, "ECIM": 0.0064, "AMER": 0.0064,
+	"VIAT": 0.0064, "ERUS": 0.0064, "ESES": 0.0064, "ALTH": 0.0064, "GMEN": 0.0064,
+	"STCO": 0.0064, "EXPA": 0.0064, "DDIR": 0.0064, "INWH": 0.0064, "TLOC": 0.0064,
+	"OBAL": 0.0064, "NALD": 0.0064, "INSI": 0.0064, "NSAV": 0.0064, "ONGE": 0.0064,
+	"ONZE": 0.0064, "SFOL": 0.0064, "DEDA": 0.0064, "RNOT": 0.0064, "SIVE": 0.0064,
+	"DARG": 0.0064, "ESFR": 0.0064, "TOSP": 0.0064, "UTOF": 0.0064, "ENTM": 0.0064,
+	"HEBE": 0.0064, "OIND": 0.0064, "ESDE": 0.0064, "HETO": 0.0064, "RENA": 0.0064,
+	"NWHE": 0.0063, "UDED": 0.0063, "PUTE": 0.0063, "TFRO": 0.0063, "DSTA": 0.0063,
+	"ISFO": 0.0063, "RINF": 0.0063, "MATT": 0.0063, "LCHA": 0.0063, "NONZ": 0.0063,
+	"ACHA": 0.0063, "IMME": 0.0063, "TOCH": 0.0063, "OLEA": 0.0063, "ETOB": 0.0063,
+	"ILEG": 0.0063, "NEIF": 0.0063, "EDBU": 0.0063, "SABO": 0.0063, "EDDE": 0.0063,
+	"MIGH": 0.0063, "ELIB": 0.0063, "ATEC": 0.0063, "QUAL": 0.0063, "ERFI": 0.0063,
+	"OSTN": 0.0063, "IGIT": 0.0063, "RPRI": 0.0063, "SSFU": 0.0063, "TACH": 0.0063,
+	"FTCH": 0.0063, "LOBA": 0.0063, "ASTO": 0.0063, "MMED": 0.0063, "ENTB": 0.0063,
+	"PHFO": 0.0063, "DIGI": 0.0063, "RMSU": 0.0063, "EBUF": 0.0063, "DERF": 0.0063,
+	"UNDI": 0.0062, "PILE": 0.0062, "NDON": 0.0062, "ETAD": 0.0062, "THRO": 0.0062,
+	"SFUN": 0.0062, "EWOR": 0.0062, "PLEA": 0.0062, "SETC": 0.0062, "ORYO": 0.0062,
+	"SEES": 0.0062, "REDB": 0.0062, "YPHF": 0.0062, "NCEO": 0.0062, "STNA": 0.0062,
+	"NIFT": 0.0062, "TVOI": 0.0062, "TDIS": 0.0062, "NINF": 0.0062, "TPOI": 0.0062,
+	"TINI": 0.0062, "HEBU": 0.0062, "VISI": 0.0062, "ATHS": 0.0062, "OGIN": 0.0062,
+	"PPEN": 0.0062, "HEND": 0.0062, "BSOL": 0.0062, "EFON": 0.0062, "ARGV": 0.0062,
+	"BOXL": 0.0062, "OTEX": 0.0062, "ALLR": 0.0062, "EPOI": 0.0062, "NGSY": 0.0062,
+	"OADE": 0.0062, "EEAT": 0.0062, "PONE": 0.0062, "INTL": 0.0062, "ETSI": 0.0062,
+	"XPAN": 0.0062, "ATAN": 0.0062, "NDDE": 0.0062, "TPAT": 0.0062, "OXLB": 0.0062,
+	"XLBL": 0.0062, "EMAT": 0.0062, "FYIN": 0.0062, "ETOR": 0.0062, "REGU": 0.0062,
+	"TODI": 0.0061, "TCRE": 0.0061, "OCHA": 0.0061, "ENDA": 0.0061, "UPPL": 0.0061,
+	"EBUT": 0.0061, "BLEM": 0.0061, "OLUM": 0.0061, "GULA": 0.0061, "OMPU": 0.0061,
+	"EARR": 0.0061, "EDET": 0.0061, "ARDS": 0.0061, "PECR": 0.0061, "HATH": 0.0061,
+	"ITCA": 0.0061, "RORO": 0.0061, "ANTS": 0.0061, "DPKG": 0.0061, "RMED": 0.0061,
+	"TFUN": 0.0061, "VEBE": 0.0061, "NSWI": 0.0061, "YWHE": 0.0061, "BLEW": 0.0061,
+	"OTCO": 0.0061, "ZATI": 0.0061, "RPOS": 0.0061, "ANOP": 0.0061, "RWRI": 0.0061,
+	"ULLI": 0.0061, "ASON": 0.0061, "UTAN": 0.0061, "EABO": 0.0061, "OREP": 0.0061,
+	"REPA": 0.0061, "ONAR": 0.0061, "TISI": 0.0061, "SGIV": 0.0061, "MMON": 0.0061,
+	"RESA": 0.0061, "EISR": 0.0061, "SHAV": 0.0061, "NTFN": 0.0061, "DATT": 0.0060,
+	"ERWH": 0.0060, "UTAB": 0.0060, "LYFO": 0.0060, "NGSI": 0.0060, "IZAT": 0.0060,
+	"SWER": 0.0060, "DTOR": 0.0060, "ENSU": 0.0060, "HEHO": 0.0060, "ERID": 0.0060,
+	"OMBI": 0.0060, "DINC": 0.0060, "ICHM": 0.0060, "AVEB": 0.0060, "ISAP": 0.0060,
+	"NGUA": 0.0060, "VETO": 0.0060, "YTHA": 0.0060, "OWNE": 0.0060, "AREE": 0.0060,
+	"NGDE": 0.0060, "ERPA": 0.0060, "RIVA": 0.0060, "EBIT": 0.0060, "ANST": 0.0060,
+	"ROUG": 0.0060, "EVIS": 0.0060, "FCON": 0.0060, "AILU": 0.0060, "NPAT": 0.0060,
+	"LBXL": 0.0060, "ADTH": 0.0060, "RIFT": 0.0060, "TMAC": 0.0060, "GINS": 0.0060,
+	"OMAN": 0.0060, "ILLE": 0.0060, "INAD": 0.0060, "TDAT": 0.0060, "NEWL": 0.0060,
+	"MBIN": 0.0060, "INGG": 0.0060, "VEIN": 0.0060, "MERI": 0.0060, "EANY": 0.0060,
+	"TRAT": 0.0060, "LYBE": 0.0060, "CHRE": 0.0060, "SITS": 0.0060, "TLIS": 0.0060,
+	"EQUA": 0.0060, "EMAY": 0.0060, "CNAM": 0.0060, "AMOU": 0.0060, "NGPA": 0.0060,
+	"ALLC": 0.0060, "NOTF": 0.0060, "ORWH": 0.0060, "RUNS": 0.0060, "EASI": 0.0060,
+	"ODEA": 0.0060, "THOD": 0.0060, "POLI": 0.0060, "FLEN": 0.0060, "UETO": 0.0060,
+	"UPTO": 0.0060, "THUS": 0.0060, "ITHD": 0.0060, "ETEC": 0.0060, "LAYE": 0.0060,
+	"ENBY": 0.0060, "ENTD": 0.0059, "MATS": 0.0059, "LYTO": 0.0059, "VERW": 0.0059,
+	"PROD": 0.0059, "ENIF": 0.0059, "LTTH": 0.0059, "UXTH": 0.0059, "NSEC": 0.0059,
+	"INSA": 0.0059, "NREA": 0.0059, "AMIL": 0.0059, "VEST": 0.0059, "IXED": 0.0059,
+	"INGV": 0.0059, "NTAL": 0.0059, "ORAS": 0.0059, "ODEC": 0.0059, "EDPA": 0.0059,
+	"FICI": 0.0059, "USEI": 0.0059, "ATLE": 0.0059, "ODIS": 0.0059, "GETA": 0.0059,
+	"STAB": 0.0059, "WEDB": 0.0059, "ALAN": 0.0059, "IMPO": 0.0059, "OSED": 0.0059,
+	"ANTI": 0.0059, "ANAN": 0.0059, "LEGE": 0.0059, "ITFO": 0.0059, "HISW": 0.0059,
+	"SEIN": 0.0059, "RDIS": 0.0059, "HEDU": 0.0059, "YBEU": 0.0059, "ERAS": 0.0059,
+	"ILLR": 0.0059, "RLIN": 0.0059, "NELS": 0.0059, "AGEF": 0.0059, "GUAG": 0.0059,
+	"UAGE": 0.0059, "LIES": 0.0059, "NTIO": 0.0059, "UALP": 0.0059, "UERY": 0.0059,
+	"ROLL": 0.0059, "PEOF": 0.0059, "ETCH": 0.0059, "ATTA": 0.0059, "UNRE": 0.0058,
+	"CANA": 0.0058, "LURE": 0.0058, "OLUT": 0.0058, "FURT": 0.0058, "UBSE": 0.0058,
+	"YPEO": 0.0058, "ILUR": 0.0058, "YSET": 0.0058, "LEDA": 0.0058, "IZET": 0.0058,
+	"LEDW": 0.0058, "ASDE": 0.0058, "TENA": 0.0058, "EGUL": 0.0058, "WIDE": 0.0058,
+	"IONL": 0.0058, "NDNO": 0.0058, "PLES": 0.0058, "ARIE": 0.0058, "DWAR": 0.0058,
+	"SOLU": 0.0058, "IBCS": 0.0058, "PUTA": 0.0058, "NGDI": 0.0058, "ASEO": 0.0058,
+	"ELLS": 0.0058, "ONIF": 0.0058, "ELEN": 0.0058, "ONAS": 0.0058, "AGED": 0.0058,
+	"NTSC": 0.0058, "AGEA": 0.0058, "EBEE": 0.0058, "IDET": 0.0058, "REXP": 0.0058,
+	"EFRE": 0.0058, "CLAR": 0.0058, "DBUT": 0.0058, "ITHN": 0.0058, "IMET": 0.0058,
+	"LESO": 0.0058, "PUSE": 0.0058, "IREM": 0.0058, "NWIL": 0.0058, "GOFT": 0.0058,
+	"IESO": 0.0058, "NPOS": 0.0058, "WITC": 0.0058, "CHCO": 0.0058, "HTHI": 0.0058,
+	"APSE": 0.0058, "SBET": 0.0058, "ONES": 0.0057, "EXTA": 0.0057, "NKNO": 0.0057,
+	"FAND": 0.0057, "OMES": 0.0057, "EDOU": 0.0057, "TISE": 0.0057, "OTSE": 0.0057,
+	"HIGH": 0.0057, "RYFO": 0.0057, "RWIL": 0.0057, "LVER": 0.0057, "SREC": 0.0057,
+	"HEPI": 0.0057, "KESA": 0.0057, "UENT": 0.0057, "GITC": 0.0057, "ASTA": 0.0057,
+	"RAPP": 0.0057, "FAMI": 0.0057, "NGAL": 0.0057, "HEVE": 0.0057, "ETSE": 0.0057,
+	"DEXE": 0.0057, "TAST": 0.0057, "COVE": 0.0057, "GTHA": 0.0057, "IXEL": 0.0057,
+	"PIXE": 0.0057, "EIGH": 0.0057, "ASSP": 0.0057, "UCTS": 0.0057, "DUMP": 0.0057,
+	"ASEC": 0.0057, "EDVA": 0.0057, "PYIN": 0.0057, "OREN": 0.0057, "HEOT": 0.0057,
+	"UBLI": 0.0057, "CCOR": 0.0057, "DURI": 0.0057, "FANY": 0.0057, "IBIL": 0.0057,
+	"CEIN": 0.0057, "EALI": 0.0057, "TEDP": 0.0057, "ISMO": 0.0057, "LAYT": 0.0057,
+	"ULTA": 0.0057, "LESW": 0.0057, "LDIN": 0.0057, "ORPR": 0.0057, "TNUM": 0.0057,
+	"LVAL": 0.0057, "YSTR": 0.0056, "ILLC": 0.0056, "NEGA": 0.0056, "ERNS": 0.0056,
+	"NISA": 0.0056, "ICIE": 0.0056, "DADD": 0.0056, "CREN": 0.0056, "TRAI": 0.0056,
+	"EASO": 0.0056, "ONSD": 0.0056, "LYIF": 0.0056, "ESEL": 0.0056, "NTPR": 0.0056,
+	"COMB": 0.0056, "AMEW": 0.0056, "PUBL": 0.0056, "EBYT": 0.0056, "SNUL": 0.0056,
+	"ESTM": 0.0056, "MPAR": 0.0056, "SACO": 0.0056, "REPE": 0.0056, "ARIO": 0.0056,
+	"UNIX": 0.0056, "RIAT": 0.0056, "ERFL": 0.0056, "DOPE": 0.0056, "ISHE": 0.0056,
+	"TPRE": 0.0056, "EDME": 0.0056, "GINF": 0.0056, "TYOU": 0.0056, "ISPO": 0.0056,
+	"LOFT": 0.0056, "SESI": 0.0056, "HEOB": 0.0056, "YPEI": 0.0056, "GREA": 0.0056,
+	"RIVI": 0.0056, "NOUT": 0.0056, "ONET": 0.0056, "ESSU": 0.0056, "FORO": 0.0056,
+	"FORB": 0.0056, "RANT": 0.0056, "SARY": 0.0056, "EEFF": 0.0056, "NONS": 0.0056,
+	"OTUS": 0.0056, "NDSA": 0.0056, "NYOF": 0.0056, "KILL": 0.0056, "XTHE": 0.0056,
+	"RTIC": 0.0055, "SASI": 0.0055, "ORWA": 0.0055, "UNKN": 0.0055, "INAS": 0.0055,
+	"HERC": 0.0055, "ESUS": 0.0055, "GEIN": 0.0055, "OOLE": 0.0055, "MADE": 0.0055,
+	"SYOU": 0.0055, "UNDA": 0.0055, "VEAN": 0.0055, "SSUM": 0.0055, "IGNI": 0.0055,
+	"TEOR": 0.0055, "CEDB": 0.0055, "ASEI": 0.0055, "OFPR": 0.0055, "ENST": 0.0055,
+	"LLCO": 0.0055, "PADD": 0.0055, "RUEI": 0.0055, "YCOM": 0.0055, "ALST": 0.0055,
+	"SCUR": 0.0055, "TTTT": 0.0055, "OWST": 0.0055, "UTST": 0.0055, "ITSO": 0.0055,
+	"RUNT": 0.0055, "EIGN": 0.0055, "EGRO": 0.0055, "SNON": 0.0055, "MSTH": 0.0055,
+	"RYIS": 0.0055, "ATRE": 0.0055, "SARG": 0.0055, "ALFO": 0.0055, "THOF": 0.0055,
+	"ANIS": 0.0055, "MITE": 0.0055, "NARE": 0.0055, "TOEX": 0.0055, "TINP": 0.0055,
+	"NCEI": 0.0055, "ITTO": 0.0055, "ELLA": 0.0055, "DESA": 0.0055, "IALL": 0.0055,
+	"RCAN": 0.0055, "FFIX": 0.0055, "PIPE": 0.0055, "ASPE": 0.0055, "ASWE": 0.0055,
+	"EMUS": 0.0055, "ORSO": 0.0055, "ORSI": 0.0055, "ROPT": 0.0055, "AMEP": 0.0055,
+	"BOUN": 0.0055, "EDSH": 0.0055, "LBLL": 0.0055, "EDNO": 0.0054, "REDU": 0.0054,
+	"ORTM": 0.0054, "INPO": 0.0054, "TERW": 0.0054, "LEIF": 0.0054, "STIM": 0.0054,
+	"MESE": 0.0054, "OUSL": 0.0054, "ECTF": 0.0054, "ORNO": 0.0054, "TANG": 0.0054,
+	"DBYX": 0.0054, "TBUF": 0.0054, "LEDO": 0.0054, "SEXP": 0.0054, "SIXP": 0.0054,
+	"ALTI": 0.0054, "DTOE": 0.0054, "CTSA": 0.0054, "PENF": 0.0054, "LERS": 0.0054,
+	"FERT": 0.0054, "LBAC": 0.0054, "HATP": 0.0054, "GERT": 0.0054, "NGLY": 0.0054,
+	"BLLL": 0.0054, "STOM": 0.0054, "CUTA": 0.0054, "SINP": 0.0054, "NOFA": 0.0054,
+	"DEVE": 0.0054, "RETO": 0.0054, "ITSI": 0.0054, "NDCA": 0.0054, "TLEN": 0.0054,
+	"TSON": 0.0054, "RIOU": 0.0054, "INEW": 0.0054, "BIND": 0.0054, "OBEU": 0.0054,
+	"TANY": 0.0054, "NEAR": 0.0054, "NSYS": 0.0054, "ENFO": 0.0054, "ANUN": 0.0054,
+	"ARAN": 0.0054, "RBIT": 0.0054, "SWEL": 0.0054, "RPAR": 0.0054, "ROPR": 0.0054,
+	"SMOD": 0.0054, "NRES": 0.0054, "LLSE": 0.0054, "HISV": 0.0054, "CURI": 0.0054,
+	"SETE": 0.0054, "PPLY": 0.0054, "NSWH": 0.0054, "RDES": 0.0054, "XPLI": 0.0053,
+	"OFAS": 0.0053, "ARTS": 0.0053, "WASA": 0.0053, "LLYI": 0.0053, "OMET": 0.0053,
+	"HEXD": 0.0053, "TRIP": 0.0053, "EARL": 0.0053, "LNAM": 0.0053, "COLU": 0.0053,
+	"ISEI": 0.0053, "LEWH": 0.0053, "LLPR": 0.0053, "TIFT": 0.0053, "EDDI": 0.0053,
+	"LEPR": 0.0053, "EDEC": 0.0053, "OFTW": 0.0053, "TESE": 0.0053, "ITYI": 0.0053,
+	"NOTM": 0.0053, "UGHT": 0.0053, "ICTI": 0.0053, "TECO": 0.0053, "RAMI": 0.0053,
+	"UILT": 0.0053, "LWIT": 0.0053, "GETP": 0.0053, "SWRI": 0.0053, "CRED": 0.0053,
+	"NEDC": 0.0053, "PONS": 0.0053, "TYLE": 0.0053, "TPRI": 0.0053, "NISO": 0.0053,
+	"XLBX": 0.0053, "BXLB": 0.0053, "DERL": 0.0053, "LAYS": 0.0053, "SFLA": 0.0053,
+	"TEDE": 0.0053, "STAM": 0.0053, "UPER": 0.0053, "EUND": 0.0053, "ECAS": 0.0053,
+	"LORA": 0.0053, "LNUM": 0.0053, "SNAM": 0.0053, "DCAN": 0.0053, "ITHF": 0.0053,
+	"SLIN": 0.0053, "OCOM": 0.0053, "OREM": 0.0053, "ESSP": 0.0053, "TEMT": 0.0053,
+	"RAWG": 0.0053, "NGOP": 0.0053, "SISN": 0.0053, "NEIN": 0.0053, "HEXR": 0.0053,
+	"VERF": 0.0053, "REWI": 0.0053, "OMIT": 0.0053, "TOFO": 0.0053, "TWAR": 0.0053,
+	"ALLP": 0.0053, "TYPI": 0.0053, "LONE": 0.0052, "WDRA": 0.0052, "FITI": 0.0052,
+	"NUSI": 0.0052, "NGOR": 0.0052, "LSTA": 0.0052, "AWDR": 0.0052, "NONL": 0.0052,
+	"ETOC": 0.0052, "AMIS": 0.0052, "HETY": 0.0052, "META": 0.0052, "FPRO": 0.0052,
+	"YOUM": 0.0052, "UTIL": 0.0052, "TSOC": 0.0052, "NIZE": 0.0052, "NDAL": 0.0052,
+	"NFRO": 0.0052, "MILY": 0.0052, "FILL": 0.0052, "HXFT": 0.0052, "AWGL": 0.0052,
+	"WGLY": 0.0052, "LEFI": 0.0052, "ORFO": 0.0052, "TPAR": 0.0052, "ECHE": 0.0052,
+	"ETEX": 0.0052, "EROI": 0.0052, "ADAT": 0.0052, "SUPE": 0.0052, "MECO": 0.0052,
+	"SSAN": 0.0052, "TALS": 0.0052, "TORT": 0.0052, "NALO": 0.0052, "SSPA": 0.0052,
+	"HISL": 0.0052, "TCHT": 0.0052, "ECHI": 0.0052, "RATH": 0.0052, "DITS": 0.0052,
+	"IVEA": 0.0052, "INTM": 0.0052, "TBEU": 0.0052, "ERFU": 0.0052, "OLDE": 0.0052,
+	"GRES": 0.0052, "EXCL": 0.0052, "ORAT": 0.0052, "TILL": 0.0052, "EROT": 0.0052,
+	"DALL": 0.0052, "DAFT": 0.0052, "ICUL": 0.0052, "YINS": 0.0052, "YONE": 0.0052,
+	"INPA": 0.0052, "TAMP": 0.0052, "PTIN": 0.0052, "LICL": 0.0052, "ELOG": 0.0052,
+	"VERB": 0.0052, "NCRY": 0.0052, "ESIF": 0.0052, "LYCO": 0.0052, "GREP": 0.0052,
+	"DERA": 0.0052, "FSTA": 0.0052, "AFFE": 0.0052, "UMER": 0.0052, "NKED": 0.0052,
+	"ONSH": 0.0052, "SERN": 0.0052, "EMOU": 0.0052, "HCON": 0.0052, "IALS": 0.0052,
+	"NEOR": 0.0052, "NBES": 0.0052, "ARYF": 0.0052, "LLBA": 0.0052, "RAWI": 0.0052,
+	"YPIC": 0.0052, "WASS": 0.0052, "PERC": 0.0052, "NFIN": 0.0052, "ESOC": 0.0052,
+	"ULLY": 0.0051, "SEGM": 0.0051, "TSRE": 0.0051, "DSAN": 0.0051, "SAPP": 0.0051,
+	"LLVM": 0.0051, "ECHO": 0.0051, "SANI": 0.0051, "ISVA": 0.0051, "LAGI": 0.0051,
+	"EDSO": 0.0051, "SCAP": 0.0051, "ONOP": 0.0051, "FULF": 0.0051, "NSAP": 0.0051,
+	"ECAP": 0.0051, "UMUN": 0.0051, "ATMA": 0.0051, "RMIT": 0.0051, "CITL": 0.0051,
+	"ADST": 0.0051, "TOPO": 0.0051, "ORTF": 0.0051, "NDUS": 0.0051, "NOTN": 0.0051,
+	"OTIF": 0.0051, "LSIN": 0.0051, "SETB": 0.0051, "STIT": 0.0051, "TINU": 0.0051,
+	"LSTO": 0.0051, "MAYN": 0.0051, "NCAT": 0.0051, "ISDO": 0.0051, "CTRE": 0.0051,
+	"NUME": 0.0051, "CUTI": 0.0051, "UESA": 0.0051, "LLOF": 0.0051, "FERI": 0.0051,
+	"NDDI": 0.0051, "RFLO": 0.0051, "ATEM": 0.0051, "PRIA": 0.0051, "UTHO": 0.0051,
+	"COME": 0.0051, "TREQ": 0.0051, "RADD": 0.0051, "RSYS": 0.0051, "ORPA": 0.0051,
+	"ENET": 0.0051, "TOCR": 0.0051, "NINS": 0.0051, "ETOO": 0.0051, "GEIS": 0.0050,
+	"SWHO": 0.0050, "COGN": 0.0050, "OGNI": 0.0050, "ITYO": 0.0050, "OFSE": 0.0050,
+	"RECI": 0.0050, "EWIN": 0.0050, "ECOG": 0.0050, "RWHE": 0.0050, "STIC": 0.0050,
+	"NCAL": 0.0050, "NSUP": 0.0050, "SEDE": 0.0050, "GVER": 0.0050, "HFOR": 0.0050,
+	"OSEN": 0.0050, "SCAR": 0.0050, "NDOU": 0.0050, "ARTE": 0.0050, "REOF": 0.0050,
+	"EGME": 0.0050, "NGAR": 0.0050, "ECLO": 0.0050, "OUTE": 0.0050, "HATO": 0.0050,
+	"TTTH": 0.0050, "IEDW": 0.0050, "ITLY": 0.0050, "IVIL": 0.0050, "VILE": 0.0050,
+	"RUPT": 0.0050, "NLYO": 0.0050, "VERE": 0.0050, "UREO": 0.0050, "PSER": 0.0050,
+	"ALFI": 0.0050, "SLIK": 0.0050, "NDOP": 0.0050, "TITU": 0.0050, "RINS": 0.0050,
+	"ENCY": 0.0050, "GSYS": 0.0050, "IDOF": 0.0050, "LART": 0.0050, "XITS": 0.0050,
+	"ITSS": 0.0050, "SANY": 0.0050, "TSAS": 0.0050, "BLIC": 0.0050, "ARDW": 0.0050,
+	"HEAB": 0.0050, "LESC": 0.0050, "HARI": 0.0050, "TPOS": 0.0050, "MEVA": 0.0050,
+	"TERV": 0.0050, "ISAC": 0.0050, "NESA": 0.0050, "NGAT": 0.0050, "RSEC": 0.0050,
+	"NALA": 0.0050, "DEDF": 0.0050, "SSYS": 0.0050, "NDIR": 0.0050, "ULTF": 0.0050,
+	"RPCS": 0.0050, "UTMP": 0.0050, "ANDV": 0.0050, "SERA": 0.0050, "SOLE": 0.0050,
+	"UEIN": 0.0050, "IESW": 0.0050, "ESEP": 0.0050, "LLAS": 0.0050, "RRET": 0.0050,
+	"CECO": 0.0050, "UNDT": 0.0050, "ERTS": 0.0049, "RARG": 0.0049, "DBYS": 0.0049,
+	"EGLY": 0.0049, "OSTR": 0.0049, "LEAD": 0.0049, "EISO": 0.0049, "SUND": 0.0049,
+	"NISN": 0.0049, "LDIS": 0.0049, "NNUM": 0.0049, "DSEE": 0.0049, "ULFO": 0.0049,
+	"ISME": 0.0049, "ETIO": 0.0049, "BEDE": 0.0049, "APAR": 0.0049, "RAMM": 0.0049,
+	"NGMA": 0.0049, "ORAG": 0.0049, "LLIF": 0.0049, "TIFY": 0.0049, "CANC": 0.0049,
+	"PENT": 0.0049, "UBST": 0.0049, "FUSE": 0.0049, "EISC": 0.0049, "TSEN": 0.0049,
+	"OTPR": 0.0049, "TEVE": 0.0049, "MAIL": 0.0049, "PUTO": 0.0049, "UDES": 0.0049,
+	"PICA": 0.0049, "XFTT": 0.0049, "FAST": 0.0049, "TTOS": 0.0049, "SPAS": 0.0049,
+	"CIEN": 0.0049, "AREO": 0.0049, "DTIM": 0.0049, "RIFI": 0.0049, "PSTR": 0.0049,
+	"HEBA": 0.0049, "DBEF": 0.0049, "EDEL": 0.0049, "ENUS": 0.0049, "ENOR": 0.0049,
+	"ASCI": 0.0049, "ERBE": 0.0049, "EMBL": 0.0049, "SATT": 0.0049, "TEIN": 0.0049,
+	"DOWS": 0.0049, "MECH": 0.0049, "TICU": 0.0049, "EDUL": 0.0049, "FORK": 0.0049,
+	"ACHO": 0.0049, "ATEF": 0.0049, "OKED": 0.0049, "CHMA": 0.0049, "MUNS": 0.0049,
+	"ASAS": 0.0049, "SASE": 0.0049, "NALR": 0.0049, "NGVE": 0.0049, "ELDI": 0.0049,
+	"OREG": 0.0049, "LUMN": 0.0049, "ORNU": 0.0049, "TBUT": 0.0049, "AYNO": 0.0049,
+	"RTAB": 0.0049, "OTSP": 0.0049, "EMIS": 0.0049, "ALNU": 0.0048, "ACKI": 0.0048,
+	"YLIN": 0.0048, "LIGN": 0.0048, "CEDE": 0.0048, "DBYD": 0.0048, "SUBM": 0.0048,
+	"UTNO": 0.0048, "DISK": 0.0048, "SCII": 0.0048, "RULE": 0.0048, "METO": 0.0048,
+	"MFOR": 0.0048, "OWNA": 0.0048, "RCOL": 0.0048, "TBEA": 0.0048, "MINT": 0.0048,
+	"SLOC": 0.0048, "OMEO": 0.0048, "YCHA": 0.0048, "DVER": 0.0048, "ATHI": 0.0048,
+	"TEXP": 0.0048, "SALI": 0.0048, "DPAR": 0.0048, "UNIC": 0.0048, "ITHX": 0.0048,
+	"TREM": 0.0048, "ORSA": 0.0048, "ACKT": 0.0048, "ARYT": 0.0048, "DWHI": 0.0048,
+	"ESSC": 0.0048, "AYOF": 0.0048, "NDFO": 0.0048, "SPEE": 0.0048, "OSTO": 0.0048,
+	"EISI": 0.0048, "RNVA": 0.0048, "NIFI": 0.0048, "NTON": 0.0048, "ONEX": 0.0048,
+	"KEEP": 0.0048, "STYL": 0.0048, "STTO": 0.0048, "DELI": 0.0048, "MPON": 0.0048,
+	"NISR": 0.0048, "MEFO": 0.0048, "RISA": 0.0048, "EANA": 0.0048, "NUMU": 0.0048,
+	"AGEO": 0.0048, "EFOU": 0.0048, "UITA": 0.0048, "ASEA": 0.0048, "ORYS": 0.0048,
+	"CTHE": 0.0048, "RWAR": 0.0048, "OROF": 0.0048, "SFIE": 0.0048, "SMAN": 0.0048,
+	"ESSH": 0.0048, "NDOM": 0.0048, "URNV": 0.0048, "RTAN": 0.0048, "HEPE": 0.0048,
+	"OWSA": 0.0048, "HANI": 0.0048, "LSOB": 0.0048, "FNOT": 0.0048, "RTMA": 0.0048,
+	"SETW": 0.0048, "DOBJ": 0.0048, "WASN": 0.0048, "CKTH": 0.0048, "NLOC": 0.0048,
+	"INEN": 0.0047, "HEAL": 0.0047, "VIEW": 0.0047, "BLEX": 0.0047, "LOOP": 0.0047,
+	"ZETH": 0.0047, "ATAF": 0.0047, "IDEA": 0.0047, "USLY": 0.0047, "LYAL": 0.0047,
+	"ISAV": 0.0047, "URIT": 0.0047, "HARF": 0.0047, "AYST": 0.0047, "ADIR": 0.0047,
+	"APHI": 0.0047, "NEIT": 0.0047, "SEXT": 0.0047, "EXDR": 0.0047, "YOUW": 0.0047,
+	"UTPR": 0.0047, "LSOF": 0.0047, "NLYS": 0.0047, "TSUB": 0.0047, "DSAR": 0.0047,
+	"SEMB": 0.0047, "TEDL": 0.0047, "NEDO": 0.0047, "TBYT": 0.0047, "ENIS": 0.0047,
+	"ALPH": 0.0047, "TISD": 0.0047, "DLES": 0.0047, "EPAG": 0.0047, "ONSY": 0.0047,
+	"UTUS": 0.0047, "NTWI": 0.0047, "SSIM": 0.0047, "EREW": 0.0047, "SYNT": 0.0047,
+	"TOFF": 0.0047, "NTLO": 0.0047, "GETW": 0.0047, "IDXF": 0.0047, "EDAR": 0.0047,
+	"RYAN": 0.0047, "OLIS": 0.0047, "ONFA": 0.0047, "BLER": 0.0047, "LLFI": 0.0047,
+	"PENA": 0.0047, "LOWT": 0.0047, "EMER": 0.0047, "SSEM": 0.0047, "ELON": 0.0047,
+	"TEOF": 0.0047, "RECU": 0.0047, "HEHA": 0.0047, "HATR": 0.0047, "ISCU": 0.0047,
+	"HISE": 0.0047, "BEPR": 0.0047, "ATFO": 0.0047, "INTN": 0.0047, "GCOM": 0.0047,
+	"DSIZ": 0.0047, "NAPP": 0.0047, "AVES": 0.0047, "DSPE": 0.0047, "TTOI": 0.0047,
+	"SEFO": 0.0047, "RARE": 0.0047, "OFPA": 0.0047, "ATYP": 0.0047, "USTO": 0.0047,
+	"SEAC": 0.0047, "CKSI": 0.0047, "HEAS": 0.0047, "FETC": 0.0047, "NYOU": 0.0047,
+	"AMEN": 0.0046, "EAMS": 0.0046, "FINA": 0.0046, "HREE": 0.0046, "MITA": 0.0046,
+	"HARR": 0.0046, "MDSE": 0.0046, "FPRI": 0.0046, "EPTH": 0.0046, "DINO": 0.0046,
+	"OATI": 0.0046, "ONFL": 0.0046, "LPHA": 0.0046, "ICHW": 0.0046, "ARDO": 0.0046,
+	"DKEY": 0.0046, "INAC": 0.0046, "SESE": 0.0046, "DPRI": 0.0046, "RASE": 0.0046,
+	"TBEC": 0.0046, "NTCH": 0.0046, "GOPT": 0.0046, "ERSS": 0.0046, "GERS": 0.0046,
+	"ICTS": 0.0046, "POLL": 0.0046, "ENCA": 0.0046, "ANXF": 0.0046, "TKEY": 0.0046,
+	"NFAI": 0.0046, "ORFI": 0.0046, "BMOD": 0.0046, "YSTH": 0.0046, "ALON": 0.0046,
+	"SETR": 0.0046, "RDWA": 0.0046, "BUTN": 0.0046, "DATI": 0.0046, "VESA": 0.0046,
+	"ITAN": 0.0046, "RNET": 0.0046, "GERE": 0.0046, "BYXF": 0.0046, "LXFT": 0.0046,
+	"ENAS": 0.0046, "NTCA": 0.0046, "OTAT": 0.0046, "EEAL": 0.0046, "COLL": 0.0046,
+	"NEXI": 0.0046, "EITS": 0.0046, "SOBE": 0.0046, "GETI": 0.0046, "RSAL": 0.0046,
+	"ORGL": 0.0046, "DAST": 0.0046, "OGEN": 0.0046, "RTIT": 0.0046, "INGH": 0.0046,
+	"ATEN": 0.0046, "ERON": 0.0046, "EINA": 0.0046, "DIFY": 0.0046, "MESO": 0.0046,
+	"ISCR": 0.0045, "GLES": 0.0045, "OTTH": 0.0045, "EXAC": 0.0045, "XCLU": 0.0045,
+	"SETP": 0.0045, "LSET": 0.0045, "PERI": 0.0045, "ETSO": 0.0045, "TSCO": 0.0045,
+	"DMEM": 0.0045, "NEDF": 0.0045, "INSO": 0.0045, "LUEF": 0.0045, "RGLI": 0.0045,
+	"TOLO": 0.0045, "BUGS": 0.0045, "HEGE": 0.0045, "NDFI": 0.0045, "IATH": 0.0045,
+	"RAVE": 0.0045, "AFOR": 0.0045, "NDPO": 0.0045, "NSOM": 0.0045, "ITSH": 0.0045,
+	"BREA": 0.0045, "LESF": 0.0045, "XACT": 0.0045, "ARSP": 0.0045, "NTUN": 0.0045,
+	"ICAN": 0.0045, "WNER": 0.0045, "ISGI": 0.0045, "YOTH": 0.0045, "BYAS": 0.0045,
+	"TEMO": 0.0045, "AROU": 0.0045, "WSTH": 0.0045, "KEYI": 0.0045, "SETF": 0.0045,
+	"ACEO": 0.0045, "ONPA": 0.0045, "IXPO": 0.0045, "PROJ": 0.0045, "ASHA": 0.0045,
+	"OFME": 0.0045, "DREC": 0.0045, "AILQ": 0.0045, "RARC": 0.0045, "ARRE": 0.0045,
+	"NINC": 0.0045, "STOC": 0.0045, "EDOE": 0.0045, "RCAS": 0.0045, "THFO": 0.0045,
+	"GETC": 0.0045, "LLYC": 0.0045, "ROMS": 0.0045, "RREA": 0.0045, "ISLO": 0.0045,
+	"IEVE": 0.0045, "TAVA": 0.0045, "PUSH": 0.0045, "TERD": 0.0045, "DREN": 0.0045,
+	"TEAC": 0.0045, "TOAP": 0.0045, "ILIN": 0.0045, "TMUS": 0.0045, "ASHE": 0.0045,
+	"ESLI": 0.0045, "EDAF": 0.0045, "RTYP": 0.0045, "INRE": 0.0045, "LREP": 0.0045,
+	"AVOI": 0.0045, "LEIT": 0.0045, "INUS": 0.0045, "LEON": 0.0045, "TPER": 0.0045,
+	"BERI": 0.0045, "OVES": 0.0045, "DNET": 0.0045, "CESI": 0.0045, "ISFU": 0.0045,
+	"OBER": 0.0045, "UPPE": 0.0045, "HEXT": 0.0045, "HALL": 0.0045, "TSCR": 0.0045,
+	"GNIZ": 0.0045, "SEFI": 0.0045, "WHIT": 0.0045, "UPON": 0.0045, "CAST": 0.0045,
+	"RINC": 0.0045, "PAIR": 0.0045, "LEEX": 0.0045, "ISNE": 0.0045, "RRUP": 0.0045,
+	"GEOF": 0.0045, "STIL": 0.0045, "WCRE": 0.0045, "EBEH": 0.0045, "TIMP": 0.0045,
+	"ARFO": 0.0045, "NCHE": 0.0044, "ALIG": 0.0044, "ESPR": 0.0044, "RUNC": 0.0044,
+	"OKEN": 0.0044, "INSU": 0.0044, "SONS": 0.0044, "NEAN": 0.0044, "GDOU": 0.0044,
+	"PEED": 0.0044, "TOFR": 0.0044, "ETSA": 0.0044, "RANY": 0.0044, "UTTO": 0.0044,
+	"NREC": 0.0044, "NITO": 0.0044, "RVED": 0.0044, "ARLI": 0.0044, "RDOE": 0.0044,
+	"IXMA": 0.0044, "YSUP": 0.0044, "ENPA": 0.0044, "NTLE": 0.0044, "EEDI": 0.0044,
+	"NELI": 0.0044, "LBEU": 0.0044, "OTEC": 0.0044, "NSIT": 0.0044, "PTIM": 0.0044,
+	"SONA": 0.0044, "CESO": 0.0044, "ETCL": 0.0044, "DINI": 0.0044, "CESE": 0.0044,
+	"METI": 0.0044, "NDOR": 0.0044, "NTLI": 0.0044, "NGVA": 0.0044, "ROMP": 0.0044,
+	"SMUS": 0.0044, "YADD": 0.0044, "EBLO": 0.0044, "ORMI": 0.0044, "ERBO": 0.0044,
+	"NTUS": 0.0044, "EDRA": 0.0044, "TFNP": 0.0044, "LDSA": 0.0044, "HERR": 0.0044,
+	"ALAR": 0.0044, "ONGD": 0.0044, "ASPA": 0.0044, "USPE": 0.0044, "KAND": 0.0044,
+	"ERHA": 0.0044, "IERA": 0.0044, "AGEN": 0.0044, "FNPR": 0.0044, "ERSF": 0.0044,
+	"REEX": 0.0044, "BEST": 0.0044, "STYP": 0.0044, "NVEN": 0.0044, "IMEI": 0.0044,
+	"ORYF": 0.0044, "NTFI": 0.0044, "SEEN": 0.0044, "ISBE": 0.0044, "ORSU": 0.0044,
+	"ELSE": 0.0044, "EUNS": 0.0044, "SERR": 0.0044, "ANYS": 0.0044, "NISM": 0.0044,
+	"LICY": 0.0044, "OJEC": 0.0044, "EHAN": 0.0044, "NONT": 0.0044, "ROJE": 0.0044,
+	"FINT": 0.0044, "YNTA": 0.0044, "ILDR": 0.0044, "STBY": 0.0044, "LOWA": 0.0044,
+	"INCR": 0.0044, "SREL": 0.0044, "DFUN": 0.0044, "NDAS": 0.0044, "UTFO": 0.0044,
+	"IFSE": 0.0044, "ROFI": 0.0043, "NMOD": 0.0043, "GTHO": 0.0043, "SHAS": 0.0043,
+	"LPAG": 0.0043, "AMEM": 0.0043, "DPAT": 0.0043, "HERN": 0.0043, "TEXC": 0.0043,
+	"TTAC": 0.0043, "SSUE": 0.0043, "NGSA": 0.0043, "YSPE": 0.0043, "OWIS": 0.0043,
+	"NITY": 0.0043, "ORXF": 0.0043, "SVER": 0.0043, "RNON": 0.0043, "TOPA": 0.0043,
+	"TALI": 0.0043, "NCHR": 0.0043, "YWHI": 0.0043, "ETUP": 0.0043, "RYOF": 0.0043,
+	"EBOO": 0.0043, "ARYS": 0.0043, "SREP": 0.0043, "NEWH": 0.0043, "STOU": 0.0043,
+	"CTTY": 0.0043, "NCEA": 0.0043, "PROF": 0.0043, "REAK": 0.0043, "ITSP": 0.0043,
+	"ENTU": 0.0043, "ITHP": 0.0043, "BITM": 0.0043, "HOWI": 0.0043, "ILEE": 0.0043,
+	"NMAY": 0.0043, "EMUL": 0.0043, "SDET": 0.0043, "NLEN": 0.0043, "ILEP": 0.0043,
+	"ESSW": 0.0043, "ITRA": 0.0043, "DEAL": 0.0043, "EROP": 0.0043, "HERU": 0.0043,
+	"TESB": 0.0043, "NGPO": 0.0043, "TREP": 0.0043, "OROC": 0.0043, "GESA": 0.0043,
+	"PLEI": 0.0043, "ONGV": 0.0043, "STDE": 0.0043, "ONHA": 0.0043, "NSUR": 0.0043,
+	"NOIS": 0.0043, "SIND": 0.0043, "ECED": 0.0043, "ALLM": 0.0043, "UBMO": 0.0043,
+	"ESEM": 0.0043, "THXF": 0.0043, "ATEX": 0.0043, "YCAL": 0.0043, "AWCR": 0.0043,
+	"TSEC": 0.0043, "HEON": 0.0043, "STIS": 0.0043, "GHTH": 0.0043, "ANEN": 0.0043,
+	"GEFO": 0.0043, "PHSP": 0.0043, "TIBI": 0.0043, "RSWI": 0.0043, "ERNO": 0.0043,
+	"TELL": 0.0043, "BLEP": 0.0043, "LRET": 0.0043, "XFTU": 0.0043, "TXFT": 0.0043,
+	"RSES": 0.0042, "GITR": 0.0042, "HENR": 0.0042, "ONWA": 0.0042, "STSE": 0.0042,
+	"TREF": 0.0042, "CSEE": 0.0042, "SUSU": 0.0042, "OBES": 0.0042, "ARKE": 0.0042,
+	"NICA": 0.0042, "WLIN": 0.0042, "YDAT": 0.0042, "DDIN": 0.0042, "LECA": 0.0042,
+	"ATDE": 0.0042, "OMOR": 0.0042, "REDF": 0.0042, "OVET": 0.0042, "AGEM": 0.0042,
+	"LSYS": 0.0042, "BCSE": 0.0042, "LLAL": 0.0042, "TEDV": 0.0042, "SISS": 0.0042,
+	"NGEA": 0.0042, "NTAX": 0.0042, "LLYD": 0.0042, "ROVE": 0.0042, "INHE": 0.0042,
+	"ITYT": 0.0042, "ERLY": 0.0042, "SENC": 0.0042, "GGIN": 0.0042, "ERWR": 0.0042,
+	"ERDO": 0.0042, "NEDW": 0.0042, "TIVA": 0.0042, "EELE": 0.0042, "RNUM": 0.0042,
+	"LEUS": 0.0042, "ENAT": 0.0042, "GEAN": 0.0042, "EDVI": 0.0042, "XPOR": 0.0042,
+	"GINN": 0.0042, "CALC": 0.0042, "YPER": 0.0042, "SEEB": 0.0042, "TSER": 0.0042,
+	"NDSH": 0.0042, "EEXA": 0.0042, "TCLI": 0.0042, "MARY": 0.0042, "FTWA": 0.0042,
+	"ENDT": 0.0042, "YRES": 0.0042, "LYAS": 0.0042, "CSER": 0.0042, "DTOP": 0.0042,
+	"AGIS": 0.0042, "RNOI": 0.0042, "RIFY": 0.0042, "RSUS": 0.0042, "ISWI": 0.0042,
+	"TORD": 0.0042, "TBES": 0.0042, "RDOU": 0.0042, "OVAL": 0.0042, "SHED": 0.0042,
+	"EDEX": 0.0042, "EONT": 0.0041, "NDPA": 0.0041, "ATST": 0.0041, "CROR": 0.0041,
+	"RIEV": 0.0041, "GVAL": 0.0041, "SEIS": 0.0041, "OUTU": 0.0041, "ECTW": 0.0041,
+	"ERLA": 0.0041, "LERR": 0.0041, "INOD": 0.0041, "ATIM": 0.0041, "LPER": 0.0041,
+	"TLYT": 0.0041, "FANE": 0.0041, "OISS": 0.0041, "OOPE": 0.0041, "ISIG": 0.0041,
+	"HOME": 0.0041, "ANYT": 0.0041, "TOAD": 0.0041, "WRAP": 0.0041, "TBEF": 0.0041,
+	"RSHO": 0.0041, "VEDI": 0.0041, "FORN": 0.0041, "LLAN": 0.0041, "RESH": 0.0041,
+	"KETI": 0.0041, "ITSF": 0.0041, "MLIN": 0.0041, "ANSF": 0.0041, "HATF": 0.0041,
+	"EETY": 0.0041, "PROM": 0.0041, "DERC": 0.0041, "XTIS": 0.0041, "EDUP": 0.0041,
+	"NGTR": 0.0041, "OREF": 0.0041, "SWAP": 0.0041, "BITR": 0.0041, "TENC": 0.0041,
+	"TISO": 0.0041, "ORTP": 0.0041, "CING": 0.0041, "ARDL": 0.0041, "THAC": 0.0041,
+	"DSZE": 0.0041, "SCAS": 0.0041, "RSOF": 0.0041, "URSI": 0.0041, "LEPA": 0.0041,
+	"NCAS": 0.0041, "BING": 0.0041, "INIM": 0.0041, "ONOU": 0.0041, "TRUS": 0.0041,
+	"UPID": 0.0041, "TASK": 0.0041, "ANTE": 0.0041, "ONTD": 0.0041, "OIDS": 0.0041,
+	"EWLI": 0.0041, "CCOU": 0.0041, "OCKA": 0.0041, "MESI": 0.0041, "ALDE": 0.0041,
+	"ITFI": 0.0041, "NPRE": 0.0041, "APRE": 0.0041, "RISN": 0.0041, "MDSY": 0.0041,
+	"ISFL": 0.0041, "ORON": 0.0041, "FORL": 0.0041, "YIFT": 0.0041, "LISH": 0.0041,
+	"DHEA": 0.0041, "YOUT": 0.0041, "INTD": 0.0041, "RUNI": 0.0041, "TAUT": 0.0041,
+	"TOOP": 0.0041, "NPER": 0.0041, "MAST": 0.0041, "MONI": 0.0041, "ONEW": 0.0041,
+	"FTYP": 0.0041, "HARC": 0.0041, "HEEL": 0.0041, "DIVI": 0.0041, "MEND": 0.0041,
+	"HUNK": 0.0041, "OPIE": 0.0041, "INEX": 0.0041, "ASUB": 0.0041, "NDXF": 0.0041,
+	"LASH": 0.0041, "NEWI": 0.0041, "TOWH": 0.0041, "REDS": 0.0040, "RORM": 0.0040,
+	"KFOR": 0.0040, "HEBI": 0.0040, "AMAC": 0.0040, "OREO": 0.0040, "LEHA": 0.0040,
+	"GDIR": 0.0040, "RORT": 0.0040, "LTOT": 0.0040, "VELO": 0.0040, "HPRO": 0.0040,
+	"ENTG": 0.0040, "RISS": 0.0040, "KEYT": 0.0040, "EFST": 0.0040, "OFAP": 0.0040,
+	"COPI": 0.0040, "BETH": 0.0040, "RHAS": 0.0040, "CLON": 0.0040, "ASEN": 0.0040,
+	"ARRI": 0.0040, "TOTR": 0.0040, "OTFO": 0.0040, "ALIT": 0.0040, "GITA": 0.0040,
+	"EORA": 0.0040, "AYED": 0.0040, "IMEV": 0.0040, "TRAR": 0.0040, "TEME": 0.0040,
+	"DELA": 0.0040, "OSTE": 0.0040, "ISTT": 0.0040, "ITET": 0.0040, "MNUM": 0.0040,
+	"RARI": 0.0040, "SUSP": 0.0040, "YOUA": 0.0040, "EIDE": 0.0040, "VENA": 0.0040,
+	"ANRE": 0.0040, "TEDR": 0.0040, "LEVA": 0.0040, "ADEC": 0.0040, "CALT": 0.0040,
+	"DVIA": 0.0040, "NLOA": 0.0040, "EASA": 0.0040, "HFIL": 0.0040, "ICEA": 0.0040,
+	"EBAC": 0.0040, "MITO": 0.0040, "EAMO": 0.0040, "RIMI": 0.0040, "GETU": 0.0040,
+	"NWAS": 0.0040, "UNTS": 0.0040, "EPTF": 0.0040, "RGER": 0.0040, "UNAB": 0.0040,
+	"GITI": 0.0040, "OFAR": 0.0040, "ECOU": 0.0040, "PHIC": 0.0040, "ABEL": 0.0040,
+	"NALP": 0.0040, "EENO": 0.0040, "RFON": 0.0040, "EDSY": 0.0040, "CEFO": 0.0040,
+	"PLEO": 0.0040, "CINT": 0.0040, "LSOU": 0.0040, "LQUE": 0.0040, "CHOF": 0.0040,
+	"DISN": 0.0040, "YBES": 0.0040, "DUNI": 0.0040, "ESTT": 0.0040, "LLUS": 0.0040,
+	"ECTD": 0.0040, "ULIN": 0.0040, "EISP": 0.0040, "TEDD": 0.0040, "FCOM": 0.0040,
+	"EMAS": 0.0040, "NSTS": 0.0040, "PLEX": 0.0040, "EDAL": 0.0040, "STDI": 0.0040,
+	"LLSI": 0.0040, "HEIM": 0.0040, "ULTV": 0.0040, "UALI": 0.0040, "HEDO": 0.0040,
+	"RISR": 0.0040, "CPAT": 0.0040, "LBES": 0.0040, "DEFO": 0.0040, "GONT": 0.0039,
+	"OSEC": 0.0039, "SDAT": 0.0039, "ADIF": 0.0039, "DSOF": 0.0039, "NANY": 0.0039,
+	"ADIS": 0.0039, "NUXA": 0.0039, "EDSZ": 0.0039, "ELLI": 0.0039, "TIND": 0.0039,
+	"HWIL": 0.0039, "TFLA": 0.0039, "USTE": 0.0039, "IMEA": 0.0039, "NGUN": 0.0039,
+	"EDDA": 0.0039, "APAC": 0.0039, "OSTI": 0.0039, "VENO": 0.0039, "BSEQ": 0.0039,
+	"RYCO": 0.0039, "LDER": 0.0039, "ESAT": 0.0039, "ALSI": 0.0039, "THNO": 0.0039,
+	"LELI": 0.0039, "ETAB": 0.0039, "ORFU": 0.0039, "NANO": 0.0039, "ORER": 0.0039,
+	"EMPL": 0.0039, "ONFR": 0.0039, "RTIS": 0.0039, "TTAK": 0.0039, "FFFF": 0.0039,
+	"TOFS": 0.0039, "ISEQ": 0.0039, "LLON": 0.0039, "SSEN": 0.0039, "TPAS": 0.0039,
+	"FGET": 0.0039, "REIT": 0.0039, "EISD": 0.0039, "FTHA": 0.0039, "INEF": 0.0039,
+	"FFOR": 0.0039, "ANYP": 0.0039, "DRIV": 0.0039, "NANA": 0.0039, "ONSM": 0.0039,
+	"PREP": 0.0039, "SESO": 0.0039, "TSPA": 0.0039, "SISU": 0.0039, "IDIS": 0.0039,
+	"OFOP": 0.0039, "LUEA": 0.0039, "TYOF": 0.0039, "DRST": 0.0039, "ESSM": 0.0039,
+	"OLIN": 0.0039, "IDDE": 0.0039, "RIND": 0.0039, "SHTO": 0.0039, "NTAS": 0.0039,
+	"OAST": 0.0039, "NDWH": 0.0039, "NRPC": 0.0039, "ORAD": 0.0039, "EMST": 0.0039,
+	"MEPA": 0.0039, "NEWE": 0.0039, "IEDO": 0.0039, "DLIS": 0.0039, "RLIE": 0.0039,
+	"XMAP": 0.0039, "IBCG": 0.0039, "NTYP": 0.0039, "OREL": 0.0039, "YDIS": 0.0039,
+	"DEFS": 0.0039, "NDDO": 0.0039, "LYWH": 0.0039, "ROMI": 0.0039, "ANYC": 0.0039,
+	"NSSE": 0.0039, "EOFI": 0.0039, "CHWI": 0.0039, "PCSE": 0.0039, "TEIS": 0.0039,
+	"TERB": 0.0039, "ARYI": 0.0039, "IDEC": 0.0039, "NIMP": 0.0039, "ATEP": 0.0039,
+	"ASST": 0.0039, "MCON": 0.0039, "SGRO": 0.0039, "TACT": 0.0039, "NSHO": 0.0039,
+	"IEDF": 0.0039, "DPYI": 0.0039, "SLAS": 0.0038, "LFUN": 0.0038, "SUBC": 0.0038,
+	"ETEN": 0.0038, "TUNI": 0.0038, "CGRO": 0.0038, "ANRP": 0.0038, "ROBL": 0.0038,
+	"RCAL": 0.0038, "ILEH": 0.0038, "COPE": 0.0038, "WISH": 0.0038, "LTHI": 0.0038,
+	"HANA": 0.0038, "UALT": 0.0038, "ASIT": 0.0038, "XTRE": 0.0038, "UCED": 0.0038,
+	"RSOR": 0.0038, "SEAS": 0.0038, "SACT": 0.0038, "HERF": 0.0038, "EMDE": 0.0038,
+	"RKED": 0.0038, "IFYA": 0.0038, "ORWI": 0.0038, "ANUM": 0.0038, "NCOU": 0.0038,
+	"IESF": 0.0038, "INNA": 0.0038, "NLYB": 0.0038, "EDOB": 0.0038, "HEHI": 0.0038,
+	"INTU": 0.0038, "CEWH": 0.0038, "DLIB": 0.0038, "ARLY": 0.0038, "NLYW": 0.0038,
+	"DCOL": 0.0038, "ADFR": 0.0038, "INKI": 0.0038, "NEAC": 0.0038, "LIER": 0.0038,
+	"OMAK": 0.0038, "WCHA": 0.0038, "USTA": 0.0038, "EORM": 0.0038, "THST": 0.0038,
+	"MAYC": 0.0038, "NKEY": 0.0038, "EOFO": 0.0038, "RPCC": 0.0038, "DBEL": 0.0038,
+	"ERRU": 0.0038, "ERCH": 0.0038, "ETWI": 0.0038, "HAPP": 0.0038, "TNEE": 0.0038,
+	"ISHT": 0.0038, "OMED": 0.0038, "BUGG": 0.0038, "LYLI": 0.0038, "HRON": 0.0038,
+	"SSOM": 0.0038, "ONNO": 0.0038, "DWID": 0.0038, "HISH": 0.0038, "TOVE": 0.0038,
+	"TOIT": 0.0038, "RNSI": 0.0038, "CTSI": 0.0038, "VEDF": 0.0038, "OBEA": 0.0038,
+	"SFOU": 0.0038, "AILI": 0.0038, "GETR": 0.0038, "HISU": 0.0038, "RUST": 0.0038,
+	"DECH": 0.0038, "NANE": 0.0038, "LABE": 0.0038, "GSTO": 0.0038, "EAMI": 0.0038,
+	"ONNU": 0.0038, "DIND": 0.0038, "HCAN": 0.0038, "NOLO": 0.0038, "CAPE": 0.0038,
+	"OBLE": 0.0038, "ISET": 0.0038, "NUXS": 0.0038, "SSSP": 0.0038, "TODO": 0.0038,
+	"ASAD": 0.0038, "ROFB": 0.0038, "NBEC": 0.0038, "YNCH": 0.0038, "ONED": 0.0038,
+	"RTOS": 0.0038, "ONVA": 0.0038, "PHER": 0.0038, "NUNS": 0.0038, "GESW": 0.0038,
+	"IGNM": 0.0038, "LLYS": 0.0038, "BAND": 0.0038, "GENT": 0.0038, "EXTI": 0.0038,
+	"YRET": 0.0037, "ETDE": 0.0037, "RNAN": 0.0037, "RCED": 0.0037, "LEGA": 0.0037,
+	"INOP": 0.0037, "EVOI": 0.0037, "AQAQ": 0.0037, "OADI": 0.0037, "NITF": 0.0037,
+	"RYFI": 0.0037, "SREM": 0.0037, "ILEM": 0.0037, "SUNS": 0.0037, "EMDN": 0.0037,
+	"RORC": 0.0037, "SOFS": 0.0037, "TOFC": 0.0037, "ERSY": 0.0037, "UALC": 0.0037,
+	"RTFO": 0.0037, "DERO": 0.0037, "NDSU": 0.0037, "DTOI": 0.0037, "TRYT": 0.0037,
+	"GWHE": 0.0037, "ELOO": 0.0037, "NONC": 0.0037, "SGEN": 0.0037, "OPRE": 0.0037,
+	"RETA": 0.0037, "PINT": 0.0037, "ONDO": 0.0037, "TIBL": 0.0037, "HENP": 0.0037,
+	"HISN": 0.0037, "CHFI": 0.0037, "ESUC": 0.0037, "AKET": 0.0037, "OSEE": 0.0037,
+	"LLYB": 0.0037, "ADAN": 0.0037, "UEFO": 0.0037, "EPIX": 0.0037, "ICKE": 0.0037,
+	"DBYC": 0.0037, "OCNA": 0.0037, "NGSO": 0.0037, "IBIN": 0.0037, "OWIT": 0.0037,
+	"SMEA": 0.0037, "GSAR": 0.0037, "IERS": 0.0037, "GALL": 0.0037, "ASHO": 0.0037,
+	"USTH": 0.0037, "ARBI": 0.0037, "NTAB": 0.0037, "UTLI": 0.0037, "UTDO": 0.0037,
+	"KSTH": 0.0037, "ORKS": 0.0037, "IQUE": 0.0037, "ERAW": 0.0037, "SMAT": 0.0037,
+	"NTSH": 0.0037, "SSER": 0.0037, "PCON": 0.0037, "AAND": 0.0037, "IKET": 0.0037,
+	"STFO": 0.0037, "ATOF": 0.0037, "GWHI": 0.0037, "RTER": 0.0037, "ONNA": 0.0037,
+	"FBYT": 0.0037, "OFWH": 0.0037, "EASU": 0.0037, "PULA": 0.0037, "DROP": 0.0037,
+	"SEEF": 0.0037, "UTUR": 0.0037, "ULTC": 0.0037, "EBEL": 0.0037, "MONT": 0.0037,
+	"TDON": 0.0037, "NETO": 0.0037, "ELEV": 0.0037, "LYDE": 0.0037, "SKIP": 0.0037,
+	"ANOR": 0.0037, "DMOD": 0.0037, "LESP": 0.0037, "CIES": 0.0037, "RONE": 0.0037,
+	"REFS": 0.0037, "WOBJ": 0.0037, "ARYO": 0.0037, "FUTU": 0.0037, "NGFR": 0.0037,
+	"ERDI": 0.0037, "SAUT": 0.0037, "OLDS": 0.0037, "LYAN": 0.0037, "MULA": 0.0037,
+	"SOVE": 0.0037, "HCAS": 0.0037, "DASI": 0.0037, "YTOT": 0.0037, "NALE": 0.0037,
+	"AFON": 0.0037, "CGLI": 0.0037, "CISI": 0.0036, "LICT": 0.0036, "IPHE": 0.0036,
+	"MVER": 0.0036, "UNSE": 0.0036, "PLAT": 0.0036, "SDEP": 0.0036, "NTDI": 0.0036,
+	"OPYR": 0.0036, "LRES": 0.0036, "FOPE": 0.0036, "ELYT": 0.0036, "BCGL": 0.0036,
+	"EXPI": 0.0036, "UNCA": 0.0036, "TOKE": 0.0036, "ODEO": 0.0036, "FMEM": 0.0036,
+	"PIXM": 0.0036, "OFBY": 0.0036, "FTUS": 0.0036, "NREQ": 0.0036, "FCPA": 0.0036,
+	"ASTT": 0.0036, "OFLI": 0.0036, "TTOO": 0.0036, "RCHE": 0.0036, "POWE": 0.0036,
+	"RMAY": 0.0036, "HENF": 0.0036, "LSER": 0.0036, "DABO": 0.0036, "AYIN": 0.0036,
+	"TITS": 0.0036, "TSUS": 0.0036, "LLEC": 0.0036, "RSED": 0.0036, "TTOE": 0.0036,
+	"OFNO": 0.0036, "HISB": 0.0036, "NOMO": 0.0036, "NESS": 0.0036, "INUE": 0.0036,
+	"LUSH": 0.0036, "DGRO": 0.0036, "TLYI": 0.0036, "MANI": 0.0036, "ITUT": 0.0036,
+	"SINI": 0.0036, "NFLI": 0.0036, "RERR": 0.0036, "CSTR": 0.0036, "ILQU": 0.0036,
+	"FXFT": 0.0036, "AKEA": 0.0036, "IZEA": 0.0036, "YSTA": 0.0036, "LSON": 0.0036,
+	"TONO": 0.0036, "OADD": 0.0036, "CTTI": 0.0036, "DOFA": 0.0036, "NEOP": 0.0036,
+	"ETPR": 0.0036, "IDST": 0.0036, "SPLI": 0.0036, "VERL": 0.0036, "LEMA": 0.0036,
+	"EIFA": 0.0036, "NINP": 0.0036, "LVAR": 0.0036, "FPAT": 0.0036, "OEXT": 0.0036,
+	"OWHI": 0.0036, "RNSN": 0.0036, "TFAI": 0.0036, "NATT": 0.0036, "UPTH": 0.0036,
+	"GEXT": 0.0036, "HEWO": 0.0036, "SEDS": 0.0036, "RYOU": 0.0036, "ITHR": 0.0036,
+	"NICO": 0.0036, "ABLI": 0.0036, "SSHA": 0.0036, "LYDO": 0.0036, "TAGE": 0.0036,
+	"DUND": 0.0036, "ACOL": 0.0036, "RIBI": 0.0036, "AINN": 0.0036, "EXTS": 0.0036,
+	"LYSE": 0.0036, "REDL": 0.0036, "DEAN": 0.0036, "OUMA": 0.0036, "ITHG": 0.0036,
+	"ORKT": 0.0036, "RLIM": 0.0036, "OACC": 0.0036, "TSOR": 0.0036, "SCOP": 0.0036,
+	"FLUS": 0.0036, "PORA": 0.0036, "ORTC": 0.0036, "ABSO": 0.0036, "URNO": 0.0035,
+	"ORHA": 0.0035, "NMAN": 0.0035, "IBCA": 0.0035, "NASS": 0.0035, "PTYS": 0.0035,
+	"EWAY": 0.0035, "EXTT": 0.0035, "NGEI": 0.0035, "GFRO": 0.0035, "OWNT": 0.0035,
+	"EINO": 0.0035, "NINI": 0.0035, "XTHI": 0.0035, "PEIS": 0.0035, "ISAR": 0.0035,
+	"DINL": 0.0035, "UTSI": 0.0035, "TAIS": 0.0035, "OMEM": 0.0035, "OSTB": 0.0035,
+	"CURL": 0.0035, "RAWO": 0.0035, "GNME": 0.0035, "ECTP": 0.0035, "HEGR": 0.0035,
+	"AREX": 0.0035, "OMEA": 0.0035, "GSIN": 0.0035, "EDOP": 0.0035, "DEDE": 0.0035,
+	"FLIC": 0.0035, "ANNE": 0.0035, "ATEW": 0.0035, "NEMP": 0.0035, "NGMO": 0.0035,
+	"EBRA": 0.0035, "ASYM": 0.0035, "LLIC": 0.0035, "EDOC": 0.0035, "BLEE": 0.0035,
+	"MANC": 0.0035, "URNI": 0.0035, "NTEL": 0.0035, "NORA": 0.0035, "SECH": 0.0035,
+	"ATEG": 0.0035, "BSTI": 0.0035, "ILTH": 0.0035, "RBUF": 0.0035, "CALS": 0.0035,
+	"RAYO": 0.0035, "EDMA": 0.0035, "LSOS": 0.0035, "TYST": 0.0035, "UNUS": 0.0035,
+	"GEME": 0.0035, "OING": 0.0035, "LSOA": 0.0035, "ATWA": 0.0035, "GGER": 0.0035,
+	"NOTW": 0.0035, "NGAP": 0.0035, "ORYW": 0.0035, "NIND": 0.0035, "NDEP": 0.0035,
+	"THDI": 0.0035, "RADI": 0.0035, "TOAV": 0.0035, "SDIR": 0.0035, "LTVA": 0.0035,
+	"BERT": 0.0035, "ARIT": 0.0035, "AGEC": 0.0035, "OOSE": 0.0035, "DTOO": 0.0035,
+	"OMIN": 0.0035, "DEPT": 0.0035, "ITWA": 0.0035, "OVEA": 0.0035, "LUTE": 0.0035,
+	"ETSP": 0.0035, "STAS": 0.0035, "LBER": 0.0035, "SUNI": 0.0035, "YOPE": 0.0035,
+	"ROFF": 0.0035, "DTYP": 0.0035, "TMAS": 0.0035, "SSLO": 0.0035, "IVID": 0.0035,
+	"ITON": 0.0035, "TOUN": 0.0035, "ANAM": 0.0035, "SBEI": 0.0035, "CEFI": 0.0035,
+	"FDIS": 0.0035, "UNLI": 0.0035, "NOFF": 0.0035, "ESEX": 0.0035, "YRIG": 0.0035,
+	"NTPO": 0.0035, "TSWH": 0.0035, "SEEG": 0.0035, "SHAN": 0.0035, "CIPH": 0.0035,
+	"TOFM": 0.0035, "UTEN": 0.0035, "SCOR": 0.0035, "ENOU": 0.0035, "OWTO": 0.0035,
+	"ESAF": 0.0035, "LORC": 0.0035, "NEWS": 0.0035, "DHAS": 0.0035, "NDEA": 0.0035,
+	"GPAT": 0.0035, "TECH": 0.0035, "IDSE": 0.0035, "DDEF": 0.0035, "RSON": 0.0035,
+	"UARE": 0.0034, "NDMO": 0.0034, "STSI": 0.0034, "MWIT": 0.0034, "DWRI": 0.0034,
+	"TATY": 0.0034, "DASS": 0.0034, "SANU": 0.0034, "GITW": 0.0034, "ERMO": 0.0034,
+	"OTER": 0.0034, "UOTE": 0.0034, "NGEO": 0.0034, "DBYO": 0.0034, "NLYF": 0.0034,
+	"RORA": 0.0034, "OTDE": 0.0034, "PTST": 0.0034, "NTWH": 0.0034, "ROTE": 0.0034,
+	"NTWO": 0.0034, "GLIN": 0.0034, "OFSI": 0.0034, "ADDS": 0.0034, "HOUG": 0.0034,
+	"NSCO": 0.0034, "TTEX": 0.0034, "ORTU": 0.0034, "DDOE": 0.0034, "SVAR": 0.0034,
+	"LIDA": 0.0034, "ERPO": 0.0034, "ORCA": 0.0034, "ORFR": 0.0034, "EPOL": 0.0034,
+	"GETE": 0.0034, "PUTC": 0.0034, "YPET": 0.0034, "IDAN": 0.0034, "NARR": 0.0034,
+	"FCHA": 0.0034, "INNI": 0.0034, "SSLP": 0.0034, "REUN": 0.0034, "ERIE": 0.0034,
+	"EGNU": 0.0034, "PENI": 0.0034, "DDEN": 0.0034, "RTOR": 0.0034, "PIRE": 0.0034,
+	"BUTA": 0.0034, "UMAN": 0.0034, "PUTB": 0.0034, "SALW": 0.0034, "OOLX": 0.0034,
+	"WASC": 0.0034, "YUSI": 0.0034, "LESH": 0.0034, "LVES": 0.0034, "RFRO": 0.0034,
+	"ORTR": 0.0034, "HEXS": 0.0034, "MNAM": 0.0034, "GDAT": 0.0034, "SEXC": 0.0034,
+	"MESW": 0.0034, "PYRI": 0.0034, "ARGC": 0.0034, "ESCH": 0.0034, "ASEP": 0.0034,
+	"LEXT": 0.0034, "ALOG": 0.0034, "TCLO": 0.0034, "THCO": 0.0034, "YDON": 0.0034,
+	"REGE": 0.0034, "ICHD": 0.0034, "LWHE": 0.0034, "SACC": 0.0034, "ESYN": 0.0034,
+	"AGEW": 0.0034, "ASYS": 0.0034, "EENR": 0.0034, "CROT": 0.0034, "PLIT": 0.0034,
+	"ERIO": 0.0034, "ONEF": 0.0034, "UESO": 0.0034, "SEXE": 0.0034, "HEEF": 0.0034,
+	"CEWI": 0.0034, "TMAI": 0.0034, "ANEM": 0.0034, "OBEC": 0.0034, "AEMO": 0.0034,
+	"REOR": 0.0034, "ARGS": 0.0034, "OSEA": 0.0034, "TOMO": 0.0034, "STOD": 0.0034,
+	"ULTP": 0.0034, "ONTF": 0.0034, "ORTN": 0.0034, "SORA": 0.0034, "TENO": 0.0034,
+	"BINE": 0.0034, "NGFU": 0.0034, "DAEM": 0.0034, "TOLI": 0.0034, "KIND": 0.0034,
+	"TOFP": 0.0034, "TERU": 0.0034, "ORDA": 0.0034, "SBEL": 0.0034, "ESNT": 0.0034,
+	"NDOE": 0.0034, "ENCI": 0.0034, "ALLD": 0.0034, "ASAL": 0.0034, "WSET": 0.0034,
+	"HINF": 0.0034, "ASKS": 0.0034, "NDOT": 0.0034, "HIER": 0.0034, "TEAL": 0.0034,
+	"ERAD": 0.0034, "HSTR": 0.0034, "EISE": 0.0034, "NESE": 0.0034, "NLYU": 0.0034,
+	"YPEA": 0.0034, "XLIB": 0.0034, "SEPR": 0.0034, "RSIG": 0.0034, "EAFT": 0.0034,
+	"DMAY": 0.0034, "NDIA": 0.0034, "NIQU": 0.0034, "UMAY": 0.0034, "RLYI": 0.0034,
+	"ATEE": 0.0034, "TPOR": 0.0034, "STSO": 0.0034, "CKSU": 0.0033, "LLMA": 0.0033,
+	"STLI": 0.0033, "MTRA": 0.0033, "LSYM": 0.0033, "NEDE": 0.0033, "NISU": 0.0033,
+	"EORG": 0.0033, "RITS": 0.0033, "XPIR": 0.0033, "TMPF": 0.0033, "ACHC": 0.0033,
+	"ISAT": 0.0033, "EBIN": 0.0033, "NHAS": 0.0033, "DEAR": 0.0033, "NITE": 0.0033,
+	"ELAY": 0.0033, "ISED": 0.0033, "EDEP": 0.0033, "GSET": 0.0033, "ETOD": 0.0033,
+	"RAMT": 0.0033, "RYRE": 0.0033, "ESIM": 0.0033, "LIPP": 0.0033, "EMTH": 0.0033,
+	"CTFO": 0.0033, "PKEY": 0.0033, "ECKI": 0.0033, "NGLO": 0.0033, "DEIS": 0.0033,
+	"ATAA": 0.0033, "DEDS": 0.0033, "LENI": 0.0033, "ARIL": 0.0033, "XTAP": 0.0033,
+	"EMSE": 0.0033, "NBEA": 0.0033, "KEYR": 0.0033, "GITD": 0.0033, "RIAN": 0.0033,
+	"CTFI": 0.0033, "TREG": 0.0033, "LERT": 0.0033, "DSYM": 0.0033, "OTED": 0.0033,
+	"VIRT": 0.0033, "ICHS": 0.0033, "YWIL": 0.0033, "OFUN": 0.0033, "LTSI": 0.0033,
+	"EMPO": 0.0033, "IESS": 0.0033, "NESI": 0.0033, "TORO": 0.0033, "PTTO": 0.0033,
+	"YEXI": 0.0033, "LIFT": 0.0033, "LATT": 0.0033, "NTOS": 0.0033, "ARYD": 0.0033,
+	"FTIM": 0.0033, "NOTG": 0.0033, "ISIM": 0.0033, "RILY": 0.0033, "IPPI": 0.0033,
+	"PLEC": 0.0033, "DSEC": 0.0033, "YTEO": 0.0033, "INBY": 0.0033, "TENV": 0.0033,
+	"WAYT": 0.0033, "OFXF": 0.0033, "ATDO": 0.0033, "OURS": 0.0033, "SOFF": 0.0033,
+	"ECOP": 0.0033, "ROIF": 0.0033, "USEC": 0.0033, "UNCO": 0.0033, "HMAY": 0.0033,
+	"OFTY": 0.0033, "NDFR": 0.0033, "EDAB": 0.0033, "DDES": 0.0033, "ALMA": 0.0033,
+	"LELO": 0.0033, "SSWI": 0.0033, "ASCO": 0.0033, "UNLO": 0.0033, "CCOM": 0.0033,
+	"RTOB": 0.0033, "HARN": 0.0033, "ESAC": 0.0033, "MPFI": 0.0033, "ECPU": 0.0033,
+	"OGIC": 0.0033, "OSTS": 0.0033, "LSWI": 0.0033, "ENED": 0.0033, "TBEE": 0.0033,
+	"ANOB": 0.0033, "AWCH": 0.0033, "AWOB": 0.0033, "BUFL": 0.0033, "AITI": 0.0033,
+	"ALSY": 0.0033, "OFTE": 0.0033, "NGUS": 0.0033, "HATE": 0.0033, "UPLI": 0.0033,
+	"PENE": 0.0033, "SEMP": 0.0033, "LIGH": 0.0033, "CFOR": 0.0033, "TSID": 0.0033,
+	"OLLI": 0.0033, "YFRO": 0.0033, "ITAT": 0.0033, "SEOP": 0.0033, "STEL": 0.0033,
+	"OXFT": 0.0033, "LADD": 0.0033, "RSER": 0.0033, "NUMV": 0.0033, "MDNE": 0.0033,
+	"EHEL": 0.0033, "LOPT": 0.0033, "CONC": 0.0033, "TOBJ": 0.0033, "DIRF": 0.0033,
+	"NTCL": 0.0033, "LORS": 0.0033, "KINT": 0.0033, "DBEU": 0.0033, "TDEV": 0.0033,
+	"RKEY": 0.0033, "UCTE": 0.0033, "NTIT": 0.0033, "HENN": 0.0033, "THAR": 0.0033,
+	"RSFO": 0.0033, "UTEX": 0.0033, "NFUN": 0.0033, "ROFS": 0.0033, "NHER": 0.0032,
+	"TRAV": 0.0032, "ETOU": 0.0032, "ODEL": 0.0032, "ONEC": 0.0032, "TDIF": 0.0032,
+	"NGME": 0.0032, "ASAR": 0.0032, "OENT": 0.0032, "ALET": 0.0032, "LIFI": 0.0032,
+	"SSLC": 0.0032, "MALI": 0.0032, "RCEF": 0.0032, "EXTC": 0.0032, "LDPR": 0.0032,
+	"DUPL": 0.0032, "ULDR": 0.0032, "OFLE": 0.0032, "SIFI": 0.0032, "URSE": 0.0032,
+	"RMTH": 0.0032, "ICOD": 0.0032, "BLEB": 0.0032, "SERO": 0.0032, "ISHA": 0.0032,
+	"DPER": 0.0032, "CEOR": 0.0032, "INVE": 0.0032, "NECO": 0.0032, "MEFI": 0.0032,
+	"BYRE": 0.0032, "ERCL": 0.0032, "STEX": 0.0032, "SESF": 0.0032, "ETAS": 0.0032,
+	"NGCH": 0.0032, "SLOG": 0.0032, "TIRE": 0.0032, "ILLU": 0.0032, "ISPE": 0.0032,
+	"TEMW": 0.0032, "EOFC": 0.0032, "CPRO": 0.0032, "SREF": 0.0032, "LESU": 0.0032,
+	"OTAV": 0.0032, "EREV": 0.0032, "ANUL": 0.0032, "RDAT": 0.0032, "OCKP": 0.0032,
+	"CNUM": 0.0032, "DEOF": 0.0032, "TILT": 0.0032, "UTSE": 0.0032, "HAIN": 0.0032,
+	"EORT": 0.0032, "EEIN": 0.0032, "ECTR": 0.0032, "OREU": 0.0032, "EGED": 0.0032,
+	"DMIN": 0.0032, "ZONE": 0.0032, "SHES": 0.0032, "ITUS": 0.0032, "ITDO": 0.0032,
+	"SASA": 0.0032, "RYUS": 0.0032, "RTAI": 0.0032, "INTW": 0.0032, "SEED": 0.0032,
+	"AMEU": 0.0032, "ORDO": 0.0032, "APAT": 0.0032, "ONDA": 0.0032, "DIAN": 0.0032,
+	"GITF": 0.0032, "REGA": 0.0032, "REDM": 0.0032, "GHTS": 0.0032, "ROCN": 0.0032,
+	"DFRE": 0.0032, "DOFF": 0.0032, "NTFS": 0.0032, "ORPO": 0.0032, "TORF": 0.0032,
+	"ERBU": 0.0032, "TESW": 0.0032, "EEST": 0.0032, "RIMA": 0.0032, "OWNL": 0.0032,
+	"URPO": 0.0032, "ALOC": 0.0032, "WASR": 0.0032, "PYOF": 0.0032, "RAWN": 0.0032,
+	"ROCI": 0.0032, "HEXA": 0.0032, "CANS": 0.0032, "NSAS": 0.0032, "GCHA": 0.0032,
+	"TEIT": 0.0032, "ALLW": 0.0032, "HANE": 0.0032, "TWOU": 0.0032, "DLOC": 0.0032,
+	"AVED": 0.0032, "TMAK": 0.0032, "AKIN": 0.0032, "TTOC": 0.0032, "EACO": 0.0032,
+	"LINF": 0.0032, "TANE": 0.0032, "GEDI": 0.0032, "ATRA": 0.0032, "NGCA": 0.0032,
+	"ERYO": 0.0032, "TDER": 0.0032, "OTMA": 0.0032, "XDRA": 0.0032, "TFIN": 0.0032,
+	"EOLD": 0.0032, "TUTE": 0.0032, "RIST": 0.0032, "IRTU": 0.0032, "SRUN": 0.0032,
+	"MEOR": 0.0032, "ISIT": 0.0032, "DREF": 0.0032, "KEYP": 0.0032, "PCRE": 0.0032,
+	"OWAN": 0.0032, "TOHA": 0.0032, "ITYA": 0.0032, "PHIN": 0.0032, "DEBI": 0.0032,
+	"HSIN": 0.0032, "DREL": 0.0032, "RTUA": 0.0032, "OROR": 0.0032, "METR": 0.0032,
+	"TETO": 0.0032, "RMES": 0.0032, "CHOO": 0.0032, "DBYI": 0.0032, "TSSE": 0.0032,
+	"OCIS": 0.0032, "BLUE": 0.0032, "NTHO": 0.0032, "AISE": 0.0032, "BCLA": 0.0032,
+	"PROX": 0.0032, "ETCO": 0.0032, "GECO": 0.0032, "ORYC": 0.0032, "ZEIS": 0.0032,
+	"NISE": 0.0032, "AWIN": 0.0032, "RFRE": 0.0032, "UMIN": 0.0032, "TLOG": 0.0032,
+	"TESF": 0.0032, "MSTA": 0.0032, "FNAM": 0.0032, "CKEY": 0.0032, "ARNA": 0.0032,
+	"OGRE": 0.0032, "SEWH": 0.0032, "CIRC": 0.0032, "SEIF": 0.0031, "EVIA": 0.0031,
+	"ITDE": 0.0031, "EGLO": 0.0031, "TGRO": 0.0031, "LYWI": 0.0031, "ASOC": 0.0031,
+	"NINV": 0.0031, "NSNO": 0.0031, "INDA": 0.0031, "YPEE": 0.0031, "ANAD": 0.0031,
+	"SLIM": 0.0031, "UERE": 0.0031, "ILLP": 0.0031, "ITEA": 0.0031, "OLAT": 0.0031,
+	"OROP": 0.0031, "CESY": 0.0031, "TADA": 0.0031, "SESW": 0.0031, "RBOS": 0.0031,
+	"GANY": 0.0031, "MFIL": 0.0031, "OTDI": 0.0031, "LUSI": 0.0031, "SPOI": 0.0031,
+	"OCCH": 0.0031, "YPRE": 0.0031, "TESS": 0.0031, "MAJO": 0.0031, "AJOR": 0.0031,
+	"ELES": 0.0031, "ICEW": 0.0031, "DEXP": 0.0031, "ESSR": 0.0031, "RAIL": 0.0031,
+	"TLYA": 0.0031, "OMPT": 0.0031, "ADDA": 0.0031, "MAPC": 0.0031, "ASYN": 0.0031,
+	"TFOU": 0.0031, "NTGE": 0.0031, "ATEB": 0.0031, "ORNE": 0.0031, "OLET": 0.0031,
+	"AUNI": 0.0031, "CISU": 0.0031, "ERSM": 0.0031, "OLST": 0.0031, "DREA": 0.0031,
+	"ITDI": 0.0031, "EOFR": 0.0031, "AGSA": 0.0031, "PURP": 0.0031, "SEDU": 0.0031,
+	"AKEN": 0.0031, "UNIQ": 0.0031, "LTHA": 0.0031, "NECA": 0.0031, "EGRE": 0.0031,
+	"SOBJ": 0.0031, "SENO": 0.0031, "AMEE": 0.0031, "KFIL": 0.0031, "EOFL": 0.0031,
+	"FORH": 0.0031, "ITHL": 0.0031, "OOKI": 0.0031, "TERL": 0.0031, "ONCH": 0.0031,
+	"THPR": 0.0031, "TSCA": 0.0031, "RORN": 0.0031, "YCAN": 0.0031, "ISAD": 0.0031,
+	"FLIN": 0.0031, "LDCO": 0.0031, "HTTP": 0.0031, "ARYC": 0.0031, "USSI": 0.0031,
+	"ICED": 0.0031, "IDAT": 0.0031, "MBLE": 0.0031, "UTWI": 0.0031, "KEYW": 0.0031,
+	"OPYI": 0.0031, "TEDM": 0.0031, "ESIR": 0.0031, "RSST": 0.0031, "TEON": 0.0031,
+	"EEFO": 0.0031, "OTEN": 0.0031, "ELLO": 0.0031, "EMAR": 0.0031, "KDEV": 0.0031,
+	"INDS": 0.0031, "XINT": 0.0031, "NDAC": 0.0031, "UGGI": 0.0031, "BEEX": 0.0031,
+	"TGIT": 0.0031, "ETAC": 0.0031, "TWID": 0.0031, "NGXF": 0.0031, "KEDA": 0.0031,
+	"UREW": 0.0031, "ATAP": 0.0031, "ROSS": 0.0031, "HCOM": 0.0031, "LIDF": 0.0031,
+	"ENGI": 0.0031, "GATE": 0.0031, "THLI": 0.0031, "PANS": 0.0031, "NACO": 0.0031,
+	"ENPR": 0.0031, "MATO": 0.0031, "FEAC": 0.0031, "ENNO": 0.0031, "NSIF": 0.0031,
+	"MENA": 0.0031, "OSEF": 0.0031, "NGIF": 0.0031, "BLEN": 0.0031, "RTOU": 0.0031,
+	"ENUL": 0.0031, "DONA": 0.0031, "MTHI": 0.0031, "TYIN": 0.0031, "DDRI": 0.0031,
+	"LDAT": 0.0031, "ROMF": 0.0031, "ISEM": 0.0031, "NVAR": 0.0031, "TACC": 0.0031,
+	"TENI": 0.0031, "REBO": 0.0031, "CTEN": 0.0031, "ISZE": 0.0031, "UPST": 0.0031,
+	"ABLY": 0.0031, "UFLE": 0.0031, "LYSU": 0.0031, "NOVE": 0.0031, "EOFE": 0.0031,
+	"GERI": 0.0031, "LBYT": 0.0030, "NBER": 0.0030, "ONGS": 0.0030, "ESUN": 0.0030,
+	"NCOR": 0.0030, "ETRY": 0.0030, "NFLA": 0.0030, "ORMT": 0.0030, "DERP": 0.0030,
+	"EGAR": 0.0030, "IONX": 0.0030, "TATH": 0.0030, "YHAV": 0.0030, "ISOR": 0.0030,
+	"TOCA": 0.0030, "MEDA": 0.0030, "ACTL": 0.0030, "TFST": 0.0030, "TICE": 0.0030,
+}
+
+var chainBigramsEN = map[string]float64{
+	"TH": 2.5265, "HE": 1.9965, "IN": 1.9860, "RE": 1.7114, "ER": 1.6627,
+	"ES": 1.5977, "ON": 1.4105, "ST": 1.3268, "TE": 1.2710, "TI": 1.2575,
+	"ED": 1.2541, "NT": 1.2436, "OR": 1.2020, "EN": 1.1669, "AT": 1.1169,
+	"SE": 1.1142, "AN": 1.0865, "ET": 1.0491, "IS": 1.0243, "TO": 0.9139,
+	"EC": 0.8712, "AL": 0.8612, "LE": 0.8584, "AR": 0.8272, "IT": 0.8142,
+	"ND": 0.7853, "DE": 0.7691, "SI": 0.7481, "EA": 0.7429, "NG": 0.7119,
+	"IO": 0.7031, "RO": 0.6782, "CO": 0.6414, "TA": 0.6342, "RA": 0.6336,
+	"ME": 0.6254, "NS": 0.6131, "FI": 0.6092, "LI": 0.6052, "RI": 0.6011,
+	"LL": 0.5912, "NE": 0.5832, "HA": 0.5813, "FO": 0.5746, "DI": 0.5709,
+	"SS": 0.5610, "NA": 0.5522, "AS": 0.5506, "CT": 0.5501, "SA": 0.5484,
+	"MA": 0.5347, "NO": 0.5309, "IL": 0.5261, "EF": 0.5066, "CA": 0.5051,
+	"TR": 0.5035, "OT": 0.5004, "TS": 0.4991, "OF": 0.4976, "PE": 0.4785,
+	"CE": 0.4747, "CH": 0.4736, "OU": 0.4718, "US": 0.4712, "EM": 0.4619,
+	"TT": 0.4533, "PR": 0.4481, "HI": 0.4394, "LO": 0.4370, "VE": 0.4350,
+	"RT": 0.4343, "FT": 0.4263, "EL": 0.4237, "AC": 0.4168, "SO": 0.4121,
+	"BE": 0.4030, "UT": 0.4028, "WI": 0.3943, "EI": 0.3907, "IF": 0.3893,
+	"IC": 0.3856, "SP": 0.3801, "NC": 0.3783, "UR": 0.3730, "AM": 0.3670,
+	"GE": 0.3650, "UN": 0.3630, "EE": 0.3607, "RS": 0.3555, "PA": 0.3538,
+	"OM": 0.3495, "LA": 0.3471, "EX": 0.3410, "OC": 0.3389, "EP": 0.3376,
+	"AD": 0.3277, "OP": 0.3255, "SU": 0.3216, "NI": 0.3167, "EO": 0.3044,
+	"DA": 0.2986, "DO": 0.2878, "UL": 0.2848, "TU": 0.2778, "PO": 0.2765,
+	"LY": 0.2719, "IE": 0.2589, "RN": 0.2553, "PT": 0.2540, "DT": 0.2529,
+	"OL": 0.2518, "SC": 0.2486, "ID": 0.2421, "DS": 0.2421, "AB": 0.2412,
+	"TC": 0.2378, "BL": 0.2361, "OS": 0.2356, "MO": 0.2339, "PL": 0.2316,
+	"FA": 0.2314, "VA": 0.2292, "HO": 0.2289, "IG": 0.2282, "LT": 0.2277,
+	"OW": 0.2259, "RM": 0.2258, "MI": 0.2249, "RR": 0.2189, "IM": 0.2181,
+	"YS": 0.2159, "KE": 0.2151, "UE": 0.2145, "MP": 0.2121, "WH": 0.2119,
+	"AP": 0.2099, "CI": 0.2084, "NU": 0.2077, "AI": 0.2053, "GI": 0.2032,
+	"LU": 0.2031, "NF": 0.2016, "EV": 0.1990, "UM": 0.1950, "SH": 0.1948,
+	"DR": 0.1941, "CR": 0.1880, "LS": 0.1874, "BY": 0.1848, "AG": 0.1846,
+	"VI": 0.1839, "RY": 0.1828, "SY": 0.1791, "CK": 0.1790, "IR": 0.1738,
+	"RU": 0.1703, "OD": 0.1691, "TF": 0.1678, "IB": 0.1663, "EG": 0.1660,
+	"TY": 0.1658, "DB": 0.1645, "RD": 0.1643, "YT": 0.1642, "EW": 0.1603,
+	"CL": 0.1603, "RC": 0.1571, "LD": 0.1563, "EU": 0.1557, "BU": 0.1539,
+	"DD": 0.1526, "YP": 0.1526, "SF": 0.1501, "AY": 0.1490, "UP": 0.1487,
+	"AU": 0.1478, "FE": 0.1474, "GN": 0.1472, "IA": 0.1469, "UC": 0.1459,
+	"RG": 0.1458, "SN": 0.1444, "WA": 0.1438, "IV": 0.1437, "SW": 0.1433,
+	"PP": 0.1400, "TP": 0.1397, "OI": 0.1388, "FR": 0.1383, "GT": 0.1380,
+	"OB": 0.1353, "FF": 0.1337, "TW": 0.1331, "XT": 0.1318, "FU": 0.1305,
+	"TD": 0.1302, "EB": 0.1273, "XF": 0.1270, "GR": 0.1255, "PU": 0.1243,
+	"HT": 0.1200, "NL": 0.1198, "MM": 0.1196, "CU": 0.1194, "QU": 0.1193,
+	"SR": 0.1186, "BO": 0.1181, "MB": 0.1179, "OO": 0.1172, "PI": 0.1171,
+	"TL": 0.1158, "OA": 0.1158, "YO": 0.1153, "GU": 0.1130, "OV": 0.1130,
+	"DF": 0.1123, "RF": 0.1122, "GL": 0.1121, "DU": 0.1105, "IP": 0.1085,
+	"ZE": 0.1072, "CC": 0.1068, "DW": 0.1061, "AV": 0.1057, "SL": 0.1030,
+	"GA": 0.1017, "OG": 0.1016, "NP": 0.1005, "YA": 0.0992, "SM": 0.0950,
+	"TM": 0.0948, "RP": 0.0942, "NV": 0.0941, "SD": 0.0917, "DL": 0.0915,
+	"GS": 0.0915, "TB": 0.0914, "EQ": 0.0904, "AF": 0.0894, "BI": 0.0886,
+	"DP": 0.0876, "YI": 0.0874, "AW": 0.0866, "DC": 0.0858, "MS": 0.0858,
+	"NN": 0.0849, "WO": 0.0845, "WE": 0.0834, "BA": 0.0831, "EY": 0.0829,
+	"NB": 0.0827, "RV": 0.0821, "MU": 0.0821, "FL": 0.0817, "IZ": 0.0817,
+	"LB": 0.0807, "FS": 0.0806, "UI": 0.0770, "OE": 0.0723, "UA": 0.0705,
+	"EH": 0.0704, "XP": 0.0697, "UD": 0.0695, "MT": 0.0694, "SB": 0.0687,
+	"XI": 0.0680, "RW": 0.0678, "YM": 0.0673, "RL": 0.0659, "PH": 0.0658,
+	"IX": 0.0658, "GO": 0.0647, "BR": 0.0640, "HR": 0.0635, "UB": 0.0607,
+	"NY": 0.0607, "LF": 0.0602, "VO": 0.0599, "NM": 0.0587, "TN": 0.0585,
+	"NR": 0.0577, "KI": 0.0577, "NK": 0.0567, "LP": 0.0565, "YD": 0.0559,
+	"PS": 0.0559, "LC": 0.0546, "HS": 0.0542, "DN": 0.0540, "GF": 0.0539,
+	"YB": 0.0537, "AK": 0.0537, "KS": 0.0532, "MD": 0.0531, "RK": 0.0530,
+	"XA": 0.0530, "GH": 0.0523, "NW": 0.0519, "SK": 0.0519, "BC": 0.0513,
+	"XE": 0.0501, "CS": 0.0493, "JE": 0.0488, "WR": 0.0488, "PC": 0.0486,
+	"YC": 0.0481, "FC": 0.0471, "DM": 0.0468, "UF": 0.0462, "YN": 0.0461,
+	"YE": 0.0458, "GP": 0.0452, "RB": 0.0448, "BJ": 0.0448, "WS": 0.0432,
+	"KA": 0.0424, "WN": 0.0419, "LR": 0.0414, "TG": 0.0410, "CP": 0.0410,
+	"FY": 0.0405, "YF": 0.0402, "YR": 0.0395, "XC": 0.0395, "GC": 0.0392,
+	"TV": 0.0391, "FD": 0.0385, "DG": 0.0384, "KT": 0.0380, "BS": 0.0378,
+	"UX": 0.0375, "SG": 0.0375, "YL": 0.0366, "UG": 0.0366, "YW": 0.0358,
+	"EK": 0.0351, "OK": 0.0336, "PD": 0.0336, "FN": 0.0335, "MC": 0.0329,
+	"HC": 0.0325, "LV": 0.0323, "HM": 0.0321, "PY": 0.0318, "FP": 0.0316,
+	"LW": 0.0316, "LN": 0.0315, "LM": 0.0299, "DV": 0.0298, "GD": 0.0280,
+	"GM": 0.0277, "NX": 0.0266, "HF": 0.0266, "XD": 0.0262, "HU": 0.0261,
+	"NH": 0.0261, "XL": 0.0256, "GW": 0.0255, "TX": 0.0253, "DH": 0.0249,
+	"YU": 0.0248, "XS": 0.0247, "SV": 0.0243, "DY": 0.0239, "AX": 0.0234,
+	"HN": 0.0227, "DX": 0.0224, "WC": 0.0215, "AQ": 0.0213, "HD": 0.0208,
+	"CM": 0.0206, "GG": 0.0204, "IK": 0.0199, "RH": 0.0197, "GV": 0.0194,
+	"WT": 0.0191, "MN": 0.0190, "LG": 0.0189, "HP": 0.0187, "PF": 0.0186,
+	"CF": 0.0185, "JO": 0.0184, "KN": 0.0184, "FM": 0.0181, "OX": 0.0177,
+	"CN": 0.0176, "SX": 0.0172, "XR": 0.0170, "WD": 0.0170, "MF": 0.0170,
+	"CY": 0.0170, "YX": 0.0166, "GB": 0.0165, "KO": 0.0156, "FB": 0.0152,
+	"AA": 0.0151, "BT": 0.0148, "RX": 0.0146, "HL": 0.0140, "PK": 0.0139,
+}
+
+var quadgramsES = map[string]float64{
+	"CIÓN": 0.5695, "PARA": 0.3381, "NOSE": 0.3050, "ACIÓ": 0.2582, "CION": 0.2337,
+	"OSEP": 0.2236, "UEDE": 0.2143, "ENTE": 0.2131, "SEPU": 0.2130, "PUED": 0.2116,
+	"CHER": 0.2094, "FICH": 0.2059, "HERO": 0.2055, "ICHE": 0.2054, "MENT": 0.2024,
+	"IÓND": 0.1973, "ENTO": 0.1937, "ÓNDE": 0.1904, "CONT": 0.1793, "ANDO": 0.1778,
+	"ECCI": 0.1751, "ONES": 0.1729, "ESDE": 0.1705, "CCIÓ": 0.1673, "IONE": 0.1654,
+	"EPUE": 0.1598, "ESPE": 0.1527, "ARCH": 0.1524, "FICA": 0.1522, "NTRA": 0.1520,
+	"VÁLI": 0.1514, "ÁLID": 0.1514, "IFIC": 0.1510, "ESTA": 0.1491, "PERA": 0.1477,
+	"ORDE": 0.1448, "OMBR": 0.1439, "NOMB": 0.1437, "DELA": 0.1403, "ECON": 0.1386,
+	"RCHI": 0.1365, "CHIV": 0.1351, "DIRE": 0.1350, "LIDA": 0.1339, "SIÓN": 0.1323,
+	"MBRE": 0.1319, "IREC": 0.1315, "HIVO": 0.1284, "COMP": 0.1253, "ENTR": 0.1228,
+	"OPCI": 0.1228, "IENT": 0.1222, "NOES": 0.1216, "DODE": 0.1214, "ERRO": 0.1212,
+	"RROR": 0.1207, "ESTÁ": 0.1201, "OSDE": 0.1185, "ENLA": 0.1182, "ICAD": 0.1168,
+	"ORMA": 0.1162, "DESC": 0.1159, "AQUE": 0.1152, "LIZA": 0.1142, "RODE": 0.1129,
+	"FORM": 0.1125, "ECTO": 0.1123, "RECT": 0.1101, "CACI": 0.1100, "DECO": 0.1092,
+	"ODEL": 0.1085, "QUET": 0.1081, "ICAC": 0.1080, "SECC": 0.1066, "ISTR": 0.1043,
+	"TRAD": 0.1023, "CADO": 0.1022, "ENCI": 0.1006, "IONA": 0.1005, "STRA": 0.1002,
+	"LAVE": 0.0992, "LIDO": 0.0990, "SCON": 0.0990, "RADA": 0.0988, "ABLE": 0.0987,
+	"TORI": 0.0986, "FALL": 0.0984, "RADO": 0.0970, "TODE": 0.0968, "AREL": 0.0966,
+	"ONTR": 0.0965, "OPER": 0.0956, "ENEL": 0.0942, "NVÁL": 0.0942, "ADOS": 0.0942,
+	"TADO": 0.0934, "DEFI": 0.0926, "INVÁ": 0.0924, "ODES": 0.0920, "OPAR": 0.0919,
+	"NCON": 0.0915, "REDE": 0.0913, "REGI": 0.0911, "DERE": 0.0905, "MIEN": 0.0899,
+	"CONO": 0.0895, "NCIA": 0.0891, "INST": 0.0870, "ORIO": 0.0869, "ESCO": 0.0868,
+	"ADOR": 0.0862, "ANTE": 0.0858, "SPEC": 0.0849, "SPER": 0.0848, "CONF": 0.0847,
+	"ONOC": 0.0839, "SCRI": 0.0839, "MITE": 0.0829, "GIST": 0.0826, "EDES": 0.0825,
+	"EGIS": 0.0825, "ERAD": 0.0822, "ERMI": 0.0815, "INTE": 0.0815, "PAQU": 0.0806,
+	"DESE": 0.0795, "ARIO": 0.0793, "VERS": 0.0792, "IDAD": 0.0791, "ERSI": 0.0790,
+	"NTER": 0.0787, "TIEN": 0.0781, "LICA": 0.0781, "ACON": 0.0778, "RAND": 0.0778,
+	"STRO": 0.0776, "ELFI": 0.0772, "ESCR": 0.0771, "SDEL": 0.0769, "OEST": 0.0764,
+	"OCON": 0.0762, "VALO": 0.0759, "NTES": 0.0759, "ADEL": 0.0758, "EROS": 0.0752,
+	"LFIC": 0.0748, "OPOR": 0.0744, "UBIC": 0.0744, "BICA": 0.0744, "ALOR": 0.0744,
+	"CCIO": 0.0744, "ENER": 0.0742, "COMO": 0.0740, "IENE": 0.0739, "UETE": 0.0739,
+	"CTOR": 0.0739, "ENCO": 0.0727, "ALIZ": 0.0727, "TABL": 0.0722, "EROD": 0.0714,
+	"EPAR": 0.0710, "ALID": 0.0705, "MBOL": 0.0703, "ISTE": 0.0702, "CLAV": 0.0696,
+	"BOLO": 0.0695, "SÍMB": 0.0694, "PECI": 0.0691, "ÍMBO": 0.0691, "UMEN": 0.0690,
+	"ACIO": 0.0682, "PCIÓ": 0.0682, "OBJE": 0.0681, "RADE": 0.0674, "AMBI": 0.0674,
+	"TIPO": 0.0673, "RDEN": 0.0673, "BJET": 0.0673, "NTEN": 0.0672, "STRU": 0.0672,
+	"MERO": 0.0670, "LÍNE": 0.0669, "ÍNEA": 0.0668, "MINA": 0.0666, "STAD": 0.0664,
+	"ELAR": 0.0664, "CADE": 0.0659, "DEBE": 0.0658, "PORT": 0.0656, "ORES": 0.0656,
+	"NOCI": 0.0656, "ECIF": 0.0650, "RACI": 0.0647, "ESTR": 0.0646, "OCID": 0.0643,
+	"CREA": 0.0642, "TUAL": 0.0641, "AMIE": 0.0640, "POSI": 0.0640, "SALI": 0.0638,
+	"ALES": 0.0636, "ENTA": 0.0636, "ECOM": 0.0635, "EUBI": 0.0634, "TIVA": 0.0627,
+	"IBLE": 0.0624, "NSTR": 0.0624, "REUB": 0.0624, "CIFI": 0.0620, "EREN": 0.0618,
+	"SQUE": 0.0616, "DADE": 0.0616, "BRED": 0.0615, "RALA": 0.0613, "ERAN": 0.0612,
+	"ADAS": 0.0610, "ONFI": 0.0610, "CTUA": 0.0608, "ARLA": 0.0602, "ADES": 0.0600,
+	"ARAL": 0.0600, "ARAE": 0.0600, "PCIO": 0.0599, "TRUC": 0.0596, "ASDE": 0.0593,
+	"RABA": 0.0593, "PUDO": 0.0592, "SPAR": 0.0589, "EPUD": 0.0588, "CONS": 0.0583,
+	"NDEL": 0.0582, "NTOD": 0.0582, "ATOS": 0.0582, "UEST": 0.0581, "UCCI": 0.0581,
+	"ODEC": 0.0580, "ORTA": 0.0580, "NÚME": 0.0579, "ÚMER": 0.0579, "TURA": 0.0572,
+	"MPLE": 0.0568, "AMEN": 0.0568, "ACTU": 0.0567, "ESTE": 0.0565, "APAR": 0.0565,
+	"EQUI": 0.0563, "UTIL": 0.0563, "AEST": 0.0561, "CTIV": 0.0560, "CAMB": 0.0559,
+	"TILI": 0.0559, "RAMA": 0.0557, "RSIÓ": 0.0557, "EESP": 0.0557, "ESEN": 0.0553,
+	"DATO": 0.0553, "TRAR": 0.0551, "NDIC": 0.0548, "LIST": 0.0548, "ISTA": 0.0547,
+	"IÓNE": 0.0546, "RUCC": 0.0546, "PRES": 0.0545, "LASE": 0.0544, "ADOD": 0.0544,
+	"ESCA": 0.0541, "ALLÓ": 0.0536, "DEMA": 0.0536, "SCAR": 0.0536, "OQUE": 0.0535,
+	"ESER": 0.0533, "NESD": 0.0533, "ONAL": 0.0532, "TRAS": 0.0530, "NFOR": 0.0529,
+	"DESA": 0.0529, "JETO": 0.0528, "DEEN": 0.0526, "EDEL": 0.0525, "EJEC": 0.0525,
+	"JECU": 0.0523, "ENES": 0.0523, "INFO": 0.0521, "BLEC": 0.0518, "ICAR": 0.0518,
+	"LARC": 0.0516, "SEDE": 0.0516, "CIDO": 0.0513, "ASEC": 0.0511, "RAEL": 0.0509,
+	"EEST": 0.0507, "STAB": 0.0506, "EFIN": 0.0506, "TADE": 0.0501, "PODE": 0.0500,
+	"DOEN": 0.0495, "ARAC": 0.0494, "NSTA": 0.0493, "NTOS": 0.0489, "TIVO": 0.0485,
+	"FERE": 0.0482, "DEDE": 0.0480, "DEES": 0.0475, "QUIE": 0.0474, "ADMI": 0.0474,
+	"ELOS": 0.0473, "NFIG": 0.0473, "MODO": 0.0472, "UIER": 0.0471, "ESUN": 0.0470,
+	"MUES": 0.0467, "NTRO": 0.0465, "CADA": 0.0465, "IZAR": 0.0465, "COMM": 0.0465,
+	"SEES": 0.0464, "DECA": 0.0464, "TAMA": 0.0463, "ODEE": 0.0459, "ILIZ": 0.0458,
+	"ORAL": 0.0457, "TENE": 0.0457, "DESP": 0.0457, "EPRO": 0.0455, "TROD": 0.0455,
+	"TALA": 0.0455, "LAZA": 0.0453, "ARGU": 0.0451, "CORR": 0.0451, "SDES": 0.0451,
+	"RMAT": 0.0451, "TEDE": 0.0450, "AMAÑ": 0.0450, "MAÑO": 0.0450, "RECO": 0.0450,
+	"DOCO": 0.0449, "ONTE": 0.0448, "RECC": 0.0448, "GENE": 0.0448, "RGUM": 0.0447,
+	"GUME": 0.0447, "IVOS": 0.0447, "ECER": 0.0443, "RENC": 0.0442, "EFIC": 0.0442,
+	"DIGO": 0.0441, "CÓDI": 0.0439, "ÓDIG": 0.0439, "ELAS": 0.0438, "TERN": 0.0437,
+	"NERA": 0.0437, "ESPA": 0.0436, "TERM": 0.0436, "DEUN": 0.0436, "ECUT": 0.0436,
+	"DELO": 0.0435, "EDEC": 0.0435, "RMIN": 0.0435, "EMAS": 0.0435, "DENA": 0.0435,
+	"IÓNN": 0.0433, "OSEN": 0.0432, "REST": 0.0431, "ENSA": 0.0431, "NDES": 0.0430,
+	"IADO": 0.0430, "DENT": 0.0429, "NESP": 0.0429, "ELCO": 0.0428, "RESI": 0.0428,
+	"GURA": 0.0428, "SENT": 0.0426, "PLIC": 0.0426, "OCOM": 0.0425, "TENT": 0.0421,
+	"EMEN": 0.0421, "ORRE": 0.0419, "CONE": 0.0418, "EUNA": 0.0418, "PERM": 0.0417,
+	"EDEE": 0.0417, "USUA": 0.0417, "MATO": 0.0417, "LADO": 0.0416, "MACI": 0.0415,
+	"DMIT": 0.0415, "USAR": 0.0413, "SUAR": 0.0412, "RELA": 0.0411, "FINI": 0.0411,
+	"RMAC": 0.0411, "UARI": 0.0410, "IGUR": 0.0410, "OMMI": 0.0410, "MMIT": 0.0410,
+	"INAR": 0.0409, "FIGU": 0.0409, "CTER": 0.0409, "DETE": 0.0409, "ESAL": 0.0409,
+	"PRIM": 0.0408, "ALDE": 0.0407, "OLOS": 0.0407, "ARUN": 0.0406, "ECLA": 0.0405,
+	"ZADO": 0.0405, "ENDE": 0.0405, "ÓNNO": 0.0403, "ALOS": 0.0403, "DORE": 0.0401,
+	"AOPC": 0.0401, "EDEN": 0.0400, "AVIS": 0.0400, "NIDO": 0.0399, "SEGU": 0.0398,
+	"CUTA": 0.0398, "EQUE": 0.0397, "DOSE": 0.0397, "PROC": 0.0396, "ROCE": 0.0396,
+	"ADEN": 0.0393, "INIC": 0.0393, "DOPA": 0.0393, "ADOE": 0.0393, "NICI": 0.0392,
+	"OPUE": 0.0391, "SCOM": 0.0391, "DISP": 0.0391, "INAL": 0.0390, "EENC": 0.0390,
+	"AUNA": 0.0388, "ADOP": 0.0387, "IRMA": 0.0386, "ACTI": 0.0385, "REAR": 0.0385,
+	"ENTI": 0.0384, "TOSD": 0.0384, "UENT": 0.0384, "NOHA": 0.0383, "CRIB": 0.0383,
+	"NING": 0.0382, "FIRM": 0.0382, "IODE": 0.0381, "ESIÓ": 0.0381, "TIFI": 0.0380,
+	"FUER": 0.0380, "ERAC": 0.0379, "NUEV": 0.0378, "ACOM": 0.0378, "OGRA": 0.0376,
+	"ADEC": 0.0376, "REFE": 0.0375, "STEM": 0.0375, "OMPA": 0.0374, "OSCO": 0.0374,
+	"RIOS": 0.0373, "ALAS": 0.0373, "EMPL": 0.0373, "IDOS": 0.0372, "UNAR": 0.0372,
+	"NEST": 0.0372, "ODEB": 0.0371, "EFER": 0.0371, "RESD": 0.0370, "EXIS": 0.0370,
+	"XIST": 0.0370, "MASI": 0.0369, "MITI": 0.0365, "NADO": 0.0364, "ELPR": 0.0363,
+	"NDOS": 0.0363, "IERE": 0.0362, "RASE": 0.0362, "OCES": 0.0362, "OSPA": 0.0362,
+	"VISO": 0.0362, "OVÁL": 0.0362, "NCOM": 0.0361, "DICE": 0.0361, "ERES": 0.0360,
+	"BRES": 0.0360, "RORE": 0.0359, "BAJO": 0.0358, "STAL": 0.0358, "RARE": 0.0357,
+	"SIST": 0.0357, "ECES": 0.0356, "IÓNP": 0.0354, "ÍNDI": 0.0354, "OSTR": 0.0354,
+	"ALTA": 0.0353, "ECTU": 0.0353, "LADE": 0.0352, "ELNO": 0.0351, "REQU": 0.0351,
+	"RESE": 0.0349, "NODE": 0.0349, "SION": 0.0349, "OINV": 0.0349, "LARE": 0.0348,
+	"TICA": 0.0347, "OHAY": 0.0347, "URAC": 0.0347, "CTUR": 0.0346, "TANT": 0.0346,
+	"PROP": 0.0346, "UALI": 0.0346, "AVER": 0.0346, "VOCA": 0.0346, "DELS": 0.0345,
+	"ASIA": 0.0345, "EREL": 0.0345, "LOCA": 0.0344, "LECE": 0.0344, "IVOD": 0.0343,
+	"BLIC": 0.0343, "SIAD": 0.0342, "LEER": 0.0342, "NECE": 0.0342, "AINS": 0.0342,
+	"INDE": 0.0341, "IÓNS": 0.0340, "QUEE": 0.0340, "SEHA": 0.0339, "DEPA": 0.0339,
+	"DEDI": 0.0338, "EENT": 0.0338, "ARES": 0.0338, "IÓNI": 0.0338, "PERO": 0.0338,
+	"ACLA": 0.0338, "OESP": 0.0336, "OESU": 0.0336, "SERV": 0.0335, "BASE": 0.0335,
+	"CESI": 0.0334, "RMIT": 0.0333, "ERTI": 0.0333, "ELRE": 0.0333, "LIMI": 0.0333,
+	"ETRA": 0.0333, "LMEN": 0.0332, "NLAC": 0.0331, "ADIR": 0.0330, "HACE": 0.0330,
+	"ARGA": 0.0330, "ESIN": 0.0330, "LNOM": 0.0330, "QUES": 0.0330, "ARDE": 0.0330,
+	"BLES": 0.0329, "ONOE": 0.0328, "ELPA": 0.0328, "LTIP": 0.0328, "ARIA": 0.0326,
+	"DELE": 0.0325, "NPAR": 0.0325, "VODE": 0.0324, "NOVÁ": 0.0324, "OINC": 0.0324,
+	"ETES": 0.0324, "ARCA": 0.0323, "TROS": 0.0323, "MEDI": 0.0322, "TEMA": 0.0322,
+	"ELDI": 0.0321, "RIOR": 0.0321, "ASAL": 0.0320, "ODER": 0.0320, "EXPR": 0.0319,
+	"LAIN": 0.0319, "MOST": 0.0319, "UERA": 0.0317, "PÚBL": 0.0317, "ÚBLI": 0.0317,
+	"LCON": 0.0316, "LACO": 0.0316, "CIAS": 0.0316, "DOEL": 0.0316, "ELIM": 0.0316,
+	"NOPU": 0.0315, "ACER": 0.0315, "ARCO": 0.0315, "ALLO": 0.0315, "SERE": 0.0314,
+	"OSEE": 0.0314, "ENDO": 0.0314, "SDEC": 0.0314, "RORA": 0.0314, "DENE": 0.0314,
+	"EDEA": 0.0314, "IPOD": 0.0313, "GRUP": 0.0312, "ARAD": 0.0312, "SOBR": 0.0312,
+	"CABE": 0.0312, "PART": 0.0311, "OSIN": 0.0310, "ARTA": 0.0308, "INAD": 0.0306,
+	"RUNA": 0.0306, "VARI": 0.0306, "XPRE": 0.0306, "ELEC": 0.0305, "CIDA": 0.0305,
+	"MPOR": 0.0304, "ODIF": 0.0304, "ENCA": 0.0304, "OBRE": 0.0304, "UETA": 0.0304,
+	"MBIA": 0.0303, "EMPO": 0.0303, "NALI": 0.0303, "TRIB": 0.0302, "ATIV": 0.0302,
+	"TENI": 0.0301, "INCO": 0.0301, "DEUS": 0.0301, "CESO": 0.0300, "EPÚB": 0.0300,
+	"ONTI": 0.0299, "LEME": 0.0299, "IÓNA": 0.0299, "TODO": 0.0298, "DENC": 0.0298,
+	"IEND": 0.0298, "NLAS": 0.0298, "ICIO": 0.0298, "RIBU": 0.0297, "ERAB": 0.0297,
+	"LAOP": 0.0297, "ASIN": 0.0297, "PLAZ": 0.0296, "BYTE": 0.0295, "EROE": 0.0295,
+	"ATOD": 0.0295, "DIFI": 0.0294, "DEPR": 0.0294, "TADA": 0.0294, "ABAJ": 0.0294,
+	"CIAL": 0.0293, "ARAM": 0.0293, "FECT": 0.0293, "NOCO": 0.0292, "EFEC": 0.0292,
+	"OMPR": 0.0292, "ESTO": 0.0291, "LPRO": 0.0290, "INCI": 0.0290, "SEÑA": 0.0290,
+	"DORD": 0.0290, "SELE": 0.0290, "OSEA": 0.0290, "TRAB": 0.0290, "SDEE": 0.0289,
+	"ODEP": 0.0289, "TARE": 0.0289, "ALÍN": 0.0289, "ARSE": 0.0289, "ODED": 0.0288,
+	"DERA": 0.0288, "GMEN": 0.0288, "RUPO": 0.0287, "AENT": 0.0287, "NTEE": 0.0287,
+	"CUEN": 0.0287, "CART": 0.0287, "LOSP": 0.0286, "RCON": 0.0286, "DELÍ": 0.0286,
+	"ADER": 0.0286, "SIGN": 0.0285, "STÁN": 0.0284, "FUNC": 0.0284, "LDIR": 0.0284,
+	"SEEN": 0.0284, "OSIT": 0.0284, "TESD": 0.0284, "DEIN": 0.0283, "SPOS": 0.0283,
+	"COMA": 0.0283, "OCAL": 0.0282, "ÓNIN": 0.0282, "ODOS": 0.0282, "ENOS": 0.0282,
+	"CARG": 0.0282, "ROGR": 0.0282, "SÓLO": 0.0282, "APLI": 0.0282, "REAL": 0.0281,
+	"EUSA": 0.0281, "SENE": 0.0280, "LOSD": 0.0280, "MARC": 0.0279, "ITOR": 0.0279,
+	"ALLA": 0.0279, "NDOE": 0.0279, "EVER": 0.0278, "ESPO": 0.0278, "ZAMI": 0.0278,
+	"AREN": 0.0275, "ALIN": 0.0275, "IÓNC": 0.0274, "ESPL": 0.0274, "PROG": 0.0274,
+	"IVOC": 0.0274, "RUTA": 0.0274, "SEPA": 0.0274, "RREC": 0.0273, "RIBI": 0.0273,
+	"DAEN": 0.0273, "EDER": 0.0272, "ADOC": 0.0272, "ICIÓ": 0.0272, "OTIE": 0.0272,
+	"IDEN": 0.0271, "ELÍN": 0.0271, "ORIA": 0.0270, "ECTA": 0.0270, "ERON": 0.0270,
+	"ERNO": 0.0269, "ESTI": 0.0268, "CARA": 0.0267, "IMIN": 0.0267, "SOLO": 0.0266,
+	"ARAR": 0.0266, "ADAE": 0.0266, "ISPO": 0.0266, "EDEB": 0.0266, "OCAD": 0.0266,
+	"ALME": 0.0265, "SOPO": 0.0265, "OSLO": 0.0265, "DEST": 0.0265, "EREC": 0.0264,
+	"RDEL": 0.0264, "LOQU": 0.0264, "AGEN": 0.0264, "DEBI": 0.0264, "ORIN": 0.0263,
+	"EDEF": 0.0263, "EESC": 0.0263, "ORRA": 0.0262, "EDED": 0.0262, "UNCI": 0.0262,
+	"RACT": 0.0262, "DETR": 0.0262, "ESEC": 0.0262, "ERDE": 0.0261, "ENOM": 0.0261,
+	"LODE": 0.0261, "ICIA": 0.0260, "LCOM": 0.0260, "MPAR": 0.0259, "PORC": 0.0259,
+	"ECRE": 0.0258, "NORE": 0.0258, "OSNO": 0.0258, "NENT": 0.0258, "NTIE": 0.0257,
+	"EDET": 0.0257, "UNAC": 0.0257, "ENUN": 0.0256, "LACE": 0.0256, "COIN": 0.0255,
+	"RLOS": 0.0254, "RDEF": 0.0254, "IREL": 0.0253, "RESP": 0.0253, "ESIT": 0.0253,
+	"TECT": 0.0252, "EROO": 0.0252, "NFIC": 0.0252, "LÍMI": 0.0252, "ÍMIT": 0.0252,
+	"ÚLTI": 0.0252, "MENS": 0.0251, "IZAD": 0.0251, "IGNO": 0.0251, "DEAR": 0.0250,
+	"ONOS": 0.0250, "LOSE": 0.0250, "RIME": 0.0250, "EDEP": 0.0250, "EREG": 0.0250,
+	"UAND": 0.0250, "ODEM": 0.0250, "NADA": 0.0249, "TERE": 0.0248, "CRIT": 0.0248,
+	"ELAC": 0.0247, "ODOD": 0.0247, "DENO": 0.0247, "ADAD": 0.0247, "IADE": 0.0247,
+	"LLÓA": 0.0247, "REPÚ": 0.0247, "SINT": 0.0246, "LASA": 0.0246, "BORR": 0.0246,
+	"UCIÓ": 0.0245, "TIDO": 0.0245, "CEDE": 0.0245, "ENID": 0.0245, "RLAS": 0.0245,
+	"SPLA": 0.0245, "EADM": 0.0245, "RIOD": 0.0244, "EPER": 0.0244, "ORNO": 0.0244,
+	"BLOQ": 0.0244, "GRAM": 0.0244, "LÓAL": 0.0244, "ABRI": 0.0243, "EARC": 0.0243,
+	"ALIS": 0.0243, "STIN": 0.0243, "IQUE": 0.0243, "SPOR": 0.0243, "STRI": 0.0242,
+	"DESD": 0.0242, "INES": 0.0242, "OBTE": 0.0242, "ANDE": 0.0242, "SAJE": 0.0242,
+	"SITA": 0.0242, "ÑODE": 0.0242, "EDIA": 0.0242, "ETER": 0.0242, "BTEN": 0.0242,
+	"NDEC": 0.0242, "MBIO": 0.0242, "NSAJ": 0.0242, "AÑOD": 0.0242, "IBIR": 0.0241,
+	"PROB": 0.0241, "QUEN": 0.0241, "TEXT": 0.0240, "LADI": 0.0240, "CERT": 0.0240,
+	"SPON": 0.0240, "IDOE": 0.0240, "REPO": 0.0240, "SEAD": 0.0240, "APOR": 0.0240,
+	"ARLO": 0.0239, "FINA": 0.0239, "SLOS": 0.0239, "ROSD": 0.0239, "STAR": 0.0239,
+	"EADE": 0.0239, "CUAN": 0.0238, "SINS": 0.0238, "DEPE": 0.0238, "FALT": 0.0237,
+	"RTIF": 0.0237, "EBES": 0.0237, "IDAE": 0.0237, "STAN": 0.0237, "CAMP": 0.0237,
+	"LORD": 0.0237, "TEEL": 0.0236, "AMPO": 0.0236, "TECL": 0.0236, "DIST": 0.0235,
+	"NADE": 0.0235, "INDI": 0.0235, "LVAL": 0.0235, "EXTE": 0.0235, "EDAT": 0.0234,
+	"NCID": 0.0234, "LOSC": 0.0234, "OENL": 0.0234, "ETAD": 0.0234, "LDES": 0.0234,
+	"ELES": 0.0234, "ELDE": 0.0234, "DELF": 0.0234, "UESE": 0.0234, "ODEF": 0.0233,
+	"UNTO": 0.0233, "QUIV": 0.0233, "ACAD": 0.0232, "MADE": 0.0232, "LEST": 0.0232,
+	"BRIR": 0.0232, "BESE": 0.0232, "ERVI": 0.0231, "CARÁ": 0.0231, "TEEN": 0.0231,
+	"URAD": 0.0231, "LECC": 0.0231, "ONAD": 0.0231, "PORD": 0.0231, "IÓNR": 0.0230,
+	"REVI": 0.0230, "REMO": 0.0230, "SIBL": 0.0229, "EDIR": 0.0229, "ENDI": 0.0229,
+	"ALAR": 0.0229, "ADAP": 0.0229, "PEND": 0.0229, "LAMA": 0.0228, "ARÁC": 0.0228,
+	"UENO": 0.0228, "NCIÓ": 0.0227, "RONO": 0.0227, "OENE": 0.0227, "ELVA": 0.0227,
+	"ENOE": 0.0227, "PACI": 0.0227, "OMPO": 0.0227, "PRED": 0.0227, "DEDA": 0.0226,
+	"OSIB": 0.0226, "ASEÑ": 0.0226, "BINA": 0.0226, "ELSI": 0.0226, "IDOR": 0.0225,
+	"IDOD": 0.0225, "NARE": 0.0225, "EPOS": 0.0224, "ELEN": 0.0224, "LORE": 0.0223,
+	"PONE": 0.0222, "AINF": 0.0222, "TRAE": 0.0221, "RAUN": 0.0221, "DOSL": 0.0221,
+	"LINE": 0.0220, "RTAD": 0.0220, "DONO": 0.0220, "CONL": 0.0220, "ADEF": 0.0220,
+	"DETA": 0.0219, "PUNT": 0.0219, "SIGU": 0.0219, "SOPC": 0.0219, "DEFE": 0.0219,
+	"FUSI": 0.0219, "RENT": 0.0219, "ELSE": 0.0219, "CESA": 0.0219, "RAES": 0.0219,
+	"RBOL": 0.0219, "DULO": 0.0219, "ÓNRE": 0.0218, "ONST": 0.0218, "SITO": 0.0218,
+	"MÓDU": 0.0218, "ÓDUL": 0.0218, "ESPU": 0.0217, "IEMP": 0.0217, "ANTI": 0.0217,
+	"ERUN": 0.0217, "RORD": 0.0216, "AVES": 0.0216, "EREU": 0.0216, "QUIT": 0.0216,
+	"NESE": 0.0215, "ODEA": 0.0215, "LOSS": 0.0215, "OLDE": 0.0215, "ECHA": 0.0215,
+	"AORD": 0.0214, "EDEU": 0.0214, "SPAC": 0.0214, "NDOD": 0.0214, "ÓNEN": 0.0213,
+	"TOCO": 0.0213, "SDEM": 0.0213, "DOLA": 0.0213, "ROOT": 0.0213, "CIAD": 0.0213,
+	"DELP": 0.0212, "ONEL": 0.0212, "NDEF": 0.0212, "INID": 0.0212, "ELCA": 0.0211,
+	"LFOR": 0.0211, "CIDE": 0.0211, "NDIR": 0.0211, "ERAL": 0.0211, "UIVO": 0.0211,
+	"NDAR": 0.0211, "DOIN": 0.0210, "INEA": 0.0210, "OSQU": 0.0210, "ELTA": 0.0210,
+	"STRE": 0.0210, "TRAL": 0.0209, "RÁCT": 0.0209, "ÁCTE": 0.0209, "LARG": 0.0209,
+	"ADOA": 0.0209, "PORE": 0.0209, "SFIC": 0.0209, "NEAD": 0.0209, "TENC": 0.0208,
+	"EPEN": 0.0208, "AUTO": 0.0208, "NDEN": 0.0207, "ITID": 0.0207, "YTES": 0.0207,
+	"OEXI": 0.0207, "ASCO": 0.0207, "LOAL": 0.0207, "ESAC": 0.0207, "ÁRBO": 0.0207,
+	"IDOP": 0.0206, "ELAL": 0.0206, "ASED": 0.0206, "TIDA": 0.0206, "SENL": 0.0206,
+	"LSIS": 0.0206, "SACT": 0.0206, "LACA": 0.0205, "DOES": 0.0205, "LALI": 0.0205,
+	"LPAQ": 0.0205, "ERIO": 0.0205, "IZAC": 0.0205, "IABL": 0.0205, "OMAN": 0.0204,
+	"RECU": 0.0204, "MENO": 0.0203, "INGU": 0.0203, "IVAD": 0.0203, "EMOR": 0.0203,
+	"CRIP": 0.0203, "LOSA": 0.0203, "NSER": 0.0203, "MEMO": 0.0203, "RODU": 0.0203,
+	"ECAD": 0.0203, "EMOT": 0.0203, "ERNA": 0.0202, "AZAM": 0.0202, "ETIQ": 0.0202,
+	"TIQU": 0.0202, "UEVO": 0.0201, "TAND": 0.0201, "NTED": 0.0201, "RIDA": 0.0201,
+	"NUNA": 0.0201, "SDEF": 0.0201, "ESQU": 0.0200, "TESE": 0.0200, "RPAR": 0.0200,
+	"DACO": 0.0200, "ACTE": 0.0200, "IMPL": 0.0200, "ITEC": 0.0200, "ASOP": 0.0200,
+	"ENSE": 0.0199, "NARC": 0.0199, "ASEN": 0.0199, "ESAR": 0.0199, "CONC": 0.0198,
+	"PONI": 0.0198, "NNOM": 0.0198, "RELF": 0.0198, "ONAR": 0.0198, "ARQU": 0.0197,
+	"RANG": 0.0197, "ETOS": 0.0197, "SOLA": 0.0197, "ECTI": 0.0197, "EXTO": 0.0196,
+	"ETAR": 0.0196, "OREC": 0.0196, "MORI": 0.0196, "ICAS": 0.0196, "MISM": 0.0196,
+	"ODET": 0.0195, "NGIT": 0.0195, "LICO": 0.0195, "EEMP": 0.0195, "RCOM": 0.0195,
+	"DICI": 0.0195, "OMPL": 0.0195, "DOPO": 0.0195, "DEOB": 0.0195, "ÓLIC": 0.0195,
+	"COND": 0.0195, "MODE": 0.0194, "SINC": 0.0194, "SIMB": 0.0194, "EINS": 0.0194,
+	"VIDO": 0.0193, "NACO": 0.0193, "ARAS": 0.0193, "NORA": 0.0193, "DELC": 0.0192,
+	"ÓNES": 0.0192, "NIDA": 0.0192, "RAIN": 0.0192, "MBÓL": 0.0192, "BÓLI": 0.0192,
+	"LLOA": 0.0192, "LAEN": 0.0191, "UGAR": 0.0191, "DESU": 0.0191, "ALAD": 0.0191,
+	"ANGO": 0.0190, "NVAL": 0.0190, "ELAT": 0.0190, "OSFI": 0.0190, "NGUN": 0.0189,
+	"SUNA": 0.0189, "OSER": 0.0189, "IMBÓ": 0.0189, "LLAD": 0.0189, "ELTI": 0.0189,
+	"OREN": 0.0188, "NLAZ": 0.0188, "RINT": 0.0188, "EDIS": 0.0188, "LACL": 0.0188,
+	"SADO": 0.0188, "OTEC": 0.0188, "RALE": 0.0187, "SLAS": 0.0187, "LLAM": 0.0187,
+	"SODE": 0.0187, "LPAR": 0.0187, "READ": 0.0187, "ONEN": 0.0187, "RVID": 0.0187,
+	"TACI": 0.0187, "AMAD": 0.0187, "NEUN": 0.0187, "NOEX": 0.0187, "CONU": 0.0187,
+	"EADO": 0.0187, "TOSE": 0.0187, "NTRE": 0.0186, "ENEN": 0.0186, "EENL": 0.0186,
+	"CARE": 0.0186, "DEME": 0.0186, "ÓNCO": 0.0186, "NEAS": 0.0186, "DOSP": 0.0186,
+	"RARL": 0.0186, "RRAR": 0.0186, "VACÍ": 0.0185, "UNRE": 0.0185, "LECT": 0.0185,
+	"ULAR": 0.0185, "ONIB": 0.0185, "DAES": 0.0185, "NLOS": 0.0185, "LUGA": 0.0185,
+	"ATRI": 0.0184, "IMER": 0.0184, "CERO": 0.0184, "AUSE": 0.0184, "GNOR": 0.0184,
+	"MAND": 0.0184, "UEVA": 0.0183, "SDEP": 0.0183, "LONG": 0.0183, "ROPO": 0.0183,
+	"EUTI": 0.0183, "ZACI": 0.0183, "ENEC": 0.0183, "BLAD": 0.0183, "NMED": 0.0183,
+	"RMAD": 0.0182, "NIBL": 0.0182, "XTEN": 0.0182, "AEXP": 0.0182, "NCOR": 0.0181,
+	"NOTI": 0.0181, "TELA": 0.0181, "CLAS": 0.0181, "RESO": 0.0181, "RIAB": 0.0181,
+	"QUEC": 0.0180, "TENO": 0.0179, "QUEL": 0.0179, "LSÍM": 0.0179, "SARC": 0.0179,
+	"OENC": 0.0179, "LASI": 0.0179, "PILA": 0.0179, "SDEU": 0.0179, "EPRE": 0.0179,
+	"ALQU": 0.0179, "USEN": 0.0179, "ROES": 0.0178, "RECI": 0.0178, "SECO": 0.0178,
+	"GRAN": 0.0178, "TROL": 0.0178, "MODI": 0.0178, "UPER": 0.0178, "ODEN": 0.0177,
+	"DOCU": 0.0177, "LOSF": 0.0177, "ICAN": 0.0177, "RALO": 0.0177, "FUEN": 0.0177,
+	"INME": 0.0177, "RQUE": 0.0176, "LASO": 0.0176, "ELNÚ": 0.0176, "ARAU": 0.0176,
+	"RELE": 0.0176, "NDER": 0.0176, "TÁND": 0.0176, "SDEA": 0.0176, "ERLA": 0.0175,
+	"IDAS": 0.0175, "NTAR": 0.0175, "TREA": 0.0175, "DECL": 0.0174, "DOSD": 0.0174,
+	"ELUS": 0.0174, "ONGI": 0.0174, "LDEL": 0.0174, "TIEM": 0.0174, "ÓNPA": 0.0174,
+	"ONTA": 0.0174, "NTAD": 0.0174, "ALLE": 0.0173, "ESES": 0.0173, "RDES": 0.0173,
+	"ETEN": 0.0173, "ROBA": 0.0173, "ELLA": 0.0173, "ADDE": 0.0173, "ADEE": 0.0173,
+	"SDED": 0.0173, "UIEN": 0.0173, "OFUE": 0.0172, "OSOP": 0.0172, "ÓRDE": 0.0172,
+	"ESOL": 0.0172, "NDEP": 0.0172, "GUIE": 0.0172, "NDOL": 0.0172, "FAKE": 0.0172,
+	"ERRA": 0.0171, "AENL": 0.0171, "ADON": 0.0171, "NVER": 0.0171, "STAT": 0.0171,
+	"RACO": 0.0171, "ALAB": 0.0171, "TODA": 0.0171, "RQUI": 0.0171, "FILE": 0.0171,
+	"STÁE": 0.0171, "DEVE": 0.0171, "VERT": 0.0171, "OTRA": 0.0171, "ARTE": 0.0171,
+	"EGUR": 0.0171, "BIOS": 0.0171, "ONUN": 0.0171, "ICOS": 0.0171, "ISMO": 0.0171,
+	"TINO": 0.0170, "ALAC": 0.0170, "ETRO": 0.0170, "INGÚ": 0.0170, "NGÚN": 0.0170,
+	"UTAR": 0.0170, "EALI": 0.0170, "LTAM": 0.0170, "TECA": 0.0170, "RESU": 0.0169,
+	"QUED": 0.0169, "OSEH": 0.0169, "OSES": 0.0169, "ESEL": 0.0169, "PORA": 0.0169,
+	"IGUI": 0.0169, "DIAT": 0.0169, "NCAB": 0.0169, "RTAR": 0.0168, "DEMO": 0.0168,
+	"ITUD": 0.0168, "PREF": 0.0168, "NALE": 0.0168, "RIMI": 0.0168, "TECO": 0.0167,
+	"TAEL": 0.0167, "LNÚM": 0.0167, "ADEP": 0.0167, "VUEL": 0.0167, "LENL": 0.0167,
+	"UITE": 0.0167, "ABEZ": 0.0167, "BEZA": 0.0167, "RARC": 0.0166, "OLOC": 0.0166,
+	"UECO": 0.0166, "ADED": 0.0166, "TIPL": 0.0166, "DAPA": 0.0166, "SERU": 0.0166,
+	"OREL": 0.0165, "TEPA": 0.0165, "PORF": 0.0165, "NDED": 0.0165, "ARRE": 0.0165,
+	"NREG": 0.0164, "NELA": 0.0164, "ROPI": 0.0164, "TERI": 0.0164, "ECOR": 0.0164,
+	"ARTI": 0.0164, "IMAG": 0.0164, "MAGE": 0.0164, "LACI": 0.0163, "NACI": 0.0163,
+	"ITEE": 0.0163, "ABLA": 0.0163, "ONLA": 0.0163, "ORFA": 0.0163, "SUBM": 0.0163,
+	"LICE": 0.0163, "PATI": 0.0163, "EINT": 0.0163, "TONO": 0.0163, "ÉSDE": 0.0163,
+	"AESP": 0.0163, "NINV": 0.0163, "ORIG": 0.0163, "TICO": 0.0163, "OPIA": 0.0163,
+	"NCLU": 0.0163, "MADA": 0.0162, "ELEM": 0.0162, "GUNA": 0.0162, "MPAT": 0.0162,
+	"AREC": 0.0162, "JETI": 0.0162, "EGUN": 0.0162, "GODE": 0.0161, "NALD": 0.0161,
+	"ORCI": 0.0161, "PACK": 0.0161, "NTEC": 0.0160, "LDET": 0.0160, "REGA": 0.0160,
+	"ATIB": 0.0160, "QUEA": 0.0160, "APRO": 0.0160, "NCUE": 0.0160, "NCIO": 0.0160,
+	"MPRI": 0.0160, "OSAR": 0.0160, "ACEN": 0.0160, "BOLD": 0.0160, "IOTE": 0.0160,
+	"GITU": 0.0159, "DESI": 0.0159, "RELC": 0.0159, "FAVO": 0.0159, "AVOR": 0.0159,
+	"INCL": 0.0159, "LÍND": 0.0159, "SAND": 0.0158, "OSSI": 0.0158, "ALEN": 0.0158,
+	"SNOM": 0.0158, "OSPO": 0.0158, "RCIO": 0.0158, "ADEM": 0.0158, "BREE": 0.0157,
+	"NOSO": 0.0157, "LASC": 0.0157, "ALCO": 0.0157, "ETIV": 0.0157, "MPON": 0.0157,
+	"TOES": 0.0156, "ROSE": 0.0156, "UNCA": 0.0156, "UEEL": 0.0156, "OESC": 0.0156,
+	"ERAR": 0.0156, "ECAM": 0.0156, "EROP": 0.0156, "VADO": 0.0156, "EFOR": 0.0156,
+	"EPAQ": 0.0156, "UTEN": 0.0155, "ASIG": 0.0155, "EPOR": 0.0155, "SOFT": 0.0155,
+	"ENEU": 0.0155, "ANAL": 0.0155, "UNAS": 0.0155, "LESD": 0.0155, "ETOD": 0.0155,
+	"MPRE": 0.0155, "ADET": 0.0155, "UALQ": 0.0155, "BIBL": 0.0155, "NTAL": 0.0154,
+	"DADD": 0.0154, "IGUA": 0.0154, "CUAL": 0.0154, "STEN": 0.0154, "DECR": 0.0153,
+	"RPRE": 0.0153, "PARE": 0.0153, "ODEU": 0.0153, "MANE": 0.0153, "ONDE": 0.0153,
+	"RIPT": 0.0153, "BSOL": 0.0153, "SDET": 0.0153, "RENO": 0.0153, "PARC": 0.0153,
+	"LATI": 0.0152, "SHEL": 0.0152, "AKER": 0.0152, "AZAD": 0.0151, "DEPU": 0.0151,
+	"DELI": 0.0151, "WARE": 0.0151, "ODEO": 0.0151, "RCHE": 0.0151, "IBLI": 0.0151,
+	"ELSU": 0.0150, "BLEM": 0.0150, "ESNO": 0.0150, "OMEN": 0.0150, "SSIN": 0.0150,
+	"OUNA": 0.0150, "ACES": 0.0150, "NOTA": 0.0150, "ISIÓ": 0.0150, "IBUT": 0.0149,
+	"EBAS": 0.0149, "TERO": 0.0149, "CONV": 0.0149, "UNCO": 0.0149, "OSAL": 0.0149,
+	"ERDA": 0.0149, "LARA": 0.0149, "SIND": 0.0149, "OCUM": 0.0149, "CUME": 0.0149,
+	"HELL": 0.0149, "BLIO": 0.0149, "LIOT": 0.0149, "FRAG": 0.0149, "USAN": 0.0148,
+	"AUTE": 0.0148, "ENAD": 0.0148, "JUNT": 0.0148, "SALT": 0.0148, "RCAD": 0.0148,
+	"RORI": 0.0148, "REIN": 0.0148, "ARAA": 0.0148, "RAGM": 0.0148, "KERO": 0.0148,
+	"STAS": 0.0147, "NARI": 0.0147, "EMAN": 0.0147, "TEMP": 0.0147, "SEIN": 0.0147,
+	"DADO": 0.0147, "AGME": 0.0147, "OSRE": 0.0147, "OSEC": 0.0147, "CACH": 0.0147,
+	"ECUR": 0.0147, "RFAV": 0.0147, "ILEG": 0.0147, "ELSÍ": 0.0147, "LOBJ": 0.0147,
+	"LEDE": 0.0146, "PLET": 0.0146, "TALL": 0.0146, "ERÍA": 0.0146, "AGRE": 0.0146,
+	"GREG": 0.0146, "FIJO": 0.0146, "DEEM": 0.0146, "ONFL": 0.0146, "LICT": 0.0146,
+	"TOIN": 0.0146, "AENE": 0.0146, "ELFO": 0.0145, "NTIC": 0.0145, "RECE": 0.0145,
+	"LALÍ": 0.0145, "NTEA": 0.0145, "NFLI": 0.0145, "FLIC": 0.0145, "EOBT": 0.0145,
+	"OOPE": 0.0145, "UNDI": 0.0145, "PERS": 0.0145, "NSEG": 0.0145, "OTRO": 0.0144,
+	"NTOE": 0.0144, "ECAR": 0.0144, "RSIN": 0.0144, "TARI": 0.0144, "DOUN": 0.0143,
+	"ENSI": 0.0143, "UNAD": 0.0143, "ONDI": 0.0143, "METR": 0.0143, "ADEB": 0.0143,
+	"TAME": 0.0143, "EOBJ": 0.0143, "AREU": 0.0143, "DEEL": 0.0142, "EHAC": 0.0142,
+	"EEJE": 0.0142, "LOSN": 0.0142, "OACT": 0.0142, "DEOP": 0.0142, "RSIO": 0.0142,
+	"IMPO": 0.0141, "NTEL": 0.0141, "ADAC": 0.0141, "ÁNDA": 0.0141, "SAMB": 0.0141,
+	"ESSE": 0.0141, "NELF": 0.0141, "ERTE": 0.0140, "ASSE": 0.0140, "IGNA": 0.0140,
+	"DEFU": 0.0140, "EUSU": 0.0140, "UNTA": 0.0140, "SEGM": 0.0140, "EGME": 0.0140,
+	"SUPE": 0.0140, "TION": 0.0139, "MOTO": 0.0139, "LUSU": 0.0139, "ESSI": 0.0139,
+	"AÑAD": 0.0139, "RDEC": 0.0139, "EOPE": 0.0139, "COPI": 0.0139, "UBMÓ": 0.0139,
+	"BMÓD": 0.0139, "SULT": 0.0139, "NENL": 0.0139, "UDOE": 0.0139, "PARÁ": 0.0139,
+	"LETA": 0.0139, "TRAT": 0.0139, "SOLU": 0.0139, "ELOB": 0.0139, "NIVE": 0.0139,
+	"LSER": 0.0138, "BILI": 0.0138, "ACÍO": 0.0138, "SDER": 0.0138, "ITAD": 0.0138,
+	"USIÓ": 0.0138, "NOAD": 0.0137, "EENE": 0.0137, "SVÁL": 0.0137, "DASE": 0.0137,
+	"CARS": 0.0137, "MÚLT": 0.0137, "TAUN": 0.0137, "ATRA": 0.0137, "NOCE": 0.0137,
+	"INSE": 0.0137, "IVEL": 0.0137, "ÁMIC": 0.0137, "SDEB": 0.0136, "ORTE": 0.0136,
+	"COLO": 0.0136, "BUTO": 0.0136, "ELMO": 0.0136, "ARÁM": 0.0136, "ADAA": 0.0136,
+	"SPUÉ": 0.0136, "PUÉS": 0.0136, "OSPR": 0.0136, "RAMI": 0.0136, "AGIT": 0.0136,
+	"VERI": 0.0135, "ARUT": 0.0135, "TIBL": 0.0135, "ACCE": 0.0135, "DECI": 0.0135,
+	"CURS": 0.0135, "ENIN": 0.0135, "SARE": 0.0135, "LEMA": 0.0135, "LTIM": 0.0135,
+	"UNID": 0.0134, "TÁEN": 0.0134, "IARE": 0.0134, "RÁME": 0.0134, "ÁMET": 0.0134,
+	"ORDA": 0.0134, "TOEN": 0.0134, "UNAL": 0.0134, "GINA": 0.0134, "CENA": 0.0134,
+	"STÁD": 0.0134, "SEST": 0.0134, "CERA": 0.0134, "IATO": 0.0134, "TORE": 0.0134,
+	"NDOC": 0.0133, "CIEN": 0.0133, "RELP": 0.0133, "DETI": 0.0133, "OADM": 0.0133,
+	"SEPR": 0.0133, "ASLA": 0.0133, "UNFI": 0.0133, "EABR": 0.0133, "PLEA": 0.0133,
+	"ELÁR": 0.0133, "LABR": 0.0132, "NTIF": 0.0132, "DIDO": 0.0132, "LAOR": 0.0132,
+	"AUSA": 0.0132, "URAR": 0.0132, "UEES": 0.0132, "DAPO": 0.0132, "VALI": 0.0132,
+	"IANO": 0.0132, "EVIS": 0.0132, "ASES": 0.0131, "RITU": 0.0131, "ELIN": 0.0131,
+	"NTEP": 0.0131, "RAEN": 0.0131, "OPRO": 0.0131, "UNAE": 0.0131, "ANOE": 0.0131,
+	"DESB": 0.0131, "ASPA": 0.0131, "ELEE": 0.0131, "NTOR": 0.0131, "EACT": 0.0131,
+	"GUND": 0.0131, "SPAQ": 0.0131, "EARE": 0.0131, "BIAR": 0.0131, "TRAN": 0.0131,
+	"ADAT": 0.0131, "UELV": 0.0131, "ITUR": 0.0131, "EVAL": 0.0131, "GARD": 0.0131,
+	"ZAND": 0.0131, "ACIN": 0.0131, "LREG": 0.0130, "NTEX": 0.0130, "LCER": 0.0130,
+	"ISOS": 0.0130, "DELR": 0.0130, "TARL": 0.0130, "ETIE": 0.0130, "ONSI": 0.0130,
+	"ELAE": 0.0130, "RTID": 0.0130, "SINO": 0.0130, "NPRO": 0.0130, "ABEC": 0.0130,
+	"CELA": 0.0130, "ICTO": 0.0130, "ESÍM": 0.0130, "DICA": 0.0129, "BERÍ": 0.0129,
+	"CODI": 0.0129, "ZARE": 0.0129, "ESEG": 0.0129, "ITEN": 0.0129, "OFTW": 0.0129,
+	"MBRA": 0.0129, "RLAC": 0.0129, "ANZA": 0.0129, "UNNO": 0.0129, "NDOA": 0.0129,
+	"OBLE": 0.0129, "ARPA": 0.0129, "DEFO": 0.0129, "QUER": 0.0128, "LMOD": 0.0128,
+	"ITAR": 0.0128, "REGU": 0.0128, "EVUE": 0.0128, "ELVE": 0.0128, "TWAR": 0.0128,
+	"LCAM": 0.0128, "NDEB": 0.0128, "ENCU": 0.0128, "FECH": 0.0128, "RDEE": 0.0127,
+	"DESO": 0.0127, "RESC": 0.0127, "CAPA": 0.0127, "FTWA": 0.0127, "LAEX": 0.0127,
+	"UNAO": 0.0127, "LESP": 0.0127, "MACE": 0.0127, "DEAC": 0.0127, "EBIT": 0.0127,
+	"OTAN": 0.0127, "INÁM": 0.0127, "ELÍM": 0.0127, "RENE": 0.0126, "MPOS": 0.0126,
+	"RELD": 0.0126, "NTRÓ": 0.0126, "UÉSD": 0.0126, "ILLA": 0.0126, "DOAL": 0.0126,
+	"ETEC": 0.0125, "SINV": 0.0125, "IDDE": 0.0125, "IRLA": 0.0125, "BAUN": 0.0125,
+	"TEND": 0.0125, "UNDO": 0.0125, "ALMA": 0.0125, "SPRE": 0.0125, "CODE": 0.0125,
+	"LTER": 0.0125, "ANCH": 0.0125, "DINÁ": 0.0125, "NÁMI": 0.0125, "RANC": 0.0125,
+	"STEF": 0.0125, "HEAD": 0.0125, "ESRE": 0.0124, "AAUT": 0.0124, "DEEX": 0.0124,
+	"ABAU": 0.0124, "OPIE": 0.0124, "SBOR": 0.0124, "EREA": 0.0124, "NSAM": 0.0124,
+	"AMBL": 0.0124, "DELD": 0.0124, "RANT": 0.0123, "ADOL": 0.0123, "MISO": 0.0123,
+	"EMPA": 0.0123, "NDEE": 0.0123, "REFI": 0.0123, "ANCO": 0.0123, "PATR": 0.0123,
+	"IÓNL": 0.0123, "UEDA": 0.0123, "BIEN": 0.0123, "OVER": 0.0123, "NAMI": 0.0123,
+	"AELC": 0.0123, "LVER": 0.0123, "AINT": 0.0123, "OPRE": 0.0123, "OSCA": 0.0123,
+	"NTON": 0.0123, "DOSI": 0.0123, "NLUG": 0.0123, "AELS": 0.0123, "MBLA": 0.0123,
+	"SESP": 0.0123, "USAD": 0.0123, "PLEM": 0.0123, "AELF": 0.0123, "META": 0.0123,
+	"RITO": 0.0122, "SEPE": 0.0122, "ADOG": 0.0122, "DOSA": 0.0122, "ARAN": 0.0122,
+	"TAIN": 0.0122, "ENLU": 0.0122, "OMPI": 0.0121, "COMI": 0.0121, "OMIS": 0.0121,
+	"EGAL": 0.0121, "ARAP": 0.0121, "RMAS": 0.0121, "BECE": 0.0121, "IÓNT": 0.0121,
+	"ANOS": 0.0121, "EREF": 0.0121, "NELC": 0.0120, "ETAL": 0.0120, "RENL": 0.0120,
+	"LECO": 0.0120, "EERE": 0.0120, "NOPE": 0.0120, "DIFE": 0.0120, "IFER": 0.0120,
+	"SECU": 0.0120, "ECUE": 0.0120, "LEGA": 0.0120, "EPUR": 0.0120, "PURA": 0.0120,
+	"ATEN": 0.0120, "OUSA": 0.0120, "SCAM": 0.0120, "LÁRB": 0.0120, "NCAR": 0.0119,
+	"ERTA": 0.0119, "MPOD": 0.0119, "OSDI": 0.0119, "IÓNO": 0.0119, "ADEA": 0.0119,
+	"DEAP": 0.0119, "NICO": 0.0119, "EDEO": 0.0119, "OMOD": 0.0119, "ROIN": 0.0119,
+	"ABAS": 0.0119, "OREG": 0.0118, "RELO": 0.0118, "USCA": 0.0118, "IMPR": 0.0118,
+	"LMAC": 0.0118, "DEAB": 0.0118, "NTIG": 0.0118, "TIGU": 0.0118, "OMIT": 0.0118,
+	"RUNC": 0.0117, "NESS": 0.0117, "ESIM": 0.0117, "INTR": 0.0117, "SSIG": 0.0117,
+	"EXPO": 0.0117, "NLAL": 0.0116, "NERE": 0.0116, "RETA": 0.0116, "PREC": 0.0116,
+	"LAME": 0.0116, "BORD": 0.0116, "EREP": 0.0116, "SITI": 0.0116, "AENC": 0.0116,
+	"NARG": 0.0116, "OOPC": 0.0116, "ODAS": 0.0116, "TENS": 0.0116, "ÁTIC": 0.0116,
+	"IPLE": 0.0116, "CTOS": 0.0116, "EERR": 0.0115, "UDOC": 0.0115, "SUFI": 0.0115,
+	"NDEM": 0.0115, "ESBO": 0.0115, "POST": 0.0115, "AINV": 0.0115, "IOSE": 0.0115,
+	"PROD": 0.0115, "OSDA": 0.0115, "REAM": 0.0115, "ZADA": 0.0115, "NMOD": 0.0115,
+	"CERE": 0.0115, "ESVÁ": 0.0115, "NOEN": 0.0115, "EDAD": 0.0115, "IBUC": 0.0115,
+	"BUCI": 0.0115, "RSEP": 0.0115, "OENT": 0.0115, "LASD": 0.0114, "CIFR": 0.0114,
+	"IFRA": 0.0114, "ULTA": 0.0114, "MÁXI": 0.0114, "ÁXIM": 0.0114, "INAC": 0.0114,
+	"NQUE": 0.0114, "ORCO": 0.0114, "INTA": 0.0114, "NDOP": 0.0114, "SISE": 0.0114,
+	"SPRO": 0.0114, "REES": 0.0114, "BITS": 0.0114, "DEHA": 0.0114, "DOGR": 0.0113,
+	"DIEN": 0.0113, "MADO": 0.0113, "TEES": 0.0113, "ANEJ": 0.0113, "ASTA": 0.0113,
+	"IÓNF": 0.0113, "SIMP": 0.0113, "UNAF": 0.0113, "LAPA": 0.0113, "GADO": 0.0113,
+	"MPRO": 0.0113, "IÓNM": 0.0112, "ADUC": 0.0112, "AJED": 0.0112, "TERA": 0.0112,
+	"SMUE": 0.0112, "ROEN": 0.0112, "ONIN": 0.0112, "AELP": 0.0112, "SDAT": 0.0112,
+	"NTIN": 0.0111, "NNOE": 0.0111, "CERR": 0.0111, "UNPA": 0.0111, "AETI": 0.0111,
+	"ESSO": 0.0111, "OFIC": 0.0111, "NESC": 0.0111, "IORI": 0.0111, "ASRE": 0.0111,
+	"ESAD": 0.0111, "RDAD": 0.0111, "REPR": 0.0111, "AFIR": 0.0111, "NGUA": 0.0111,
+	"AACT": 0.0110, "RIRE": 0.0110, "EINI": 0.0110, "EXTR": 0.0110, "SENC": 0.0110,
+	"ADIS": 0.0110, "ODEI": 0.0110, "JEDE": 0.0110, "DELL": 0.0110, "SICI": 0.0110,
+	"ÓNSE": 0.0110, "DASL": 0.0110, "ELAD": 0.0110, "IASD": 0.0110, "LQUE": 0.0110,
+	"LAND": 0.0110, "ERIF": 0.0109, "ORQU": 0.0109, "NESA": 0.0109, "DEVU": 0.0109,
+	"ASER": 0.0109, "IGEN": 0.0109, "EÑAL": 0.0109, "LOTA": 0.0109, "AVAR": 0.0109,
+	"XPOR": 0.0109, "ALTE": 0.0108, "NERR": 0.0108, "ORIT": 0.0108, "LATA": 0.0108,
+	"TORN": 0.0108, "OANT": 0.0108, "EROT": 0.0108, "EELE": 0.0108, "ERSO": 0.0108,
+	"TOPA": 0.0108, "LABA": 0.0108, "OREU": 0.0108, "ONVE": 0.0107, "RELS": 0.0107,
+	"IDOC": 0.0107, "NDID": 0.0107, "EROR": 0.0107, "JODE": 0.0107, "ERRÓ": 0.0107,
+	"ENRE": 0.0107, "UERT": 0.0107, "ARDA": 0.0107, "NSUL": 0.0107, "RTEN": 0.0107,
+	"ALAL": 0.0107, "ALAO": 0.0107, "CUER": 0.0107, "TRÓN": 0.0107, "NTOI": 0.0107,
+	"IDOA": 0.0107, "PLES": 0.0107, "ERAS": 0.0107, "ALCA": 0.0107, "ENOP": 0.0107,
+	"UELA": 0.0107, "RÓNE": 0.0107, "DIFF": 0.0107, "ERTO": 0.0106, "EBER": 0.0106,
+	"TEUN": 0.0106, "REUN": 0.0106, "ONOM": 0.0106, "ITIV": 0.0106, "OLVE": 0.0106,
+	"IZAN": 0.0106, "SDIS": 0.0106, "NSÍM": 0.0106, "VISI": 0.0106, "EVOC": 0.0106,
+	"TAAU": 0.0106, "MINI": 0.0105, "NELS": 0.0105, "OCRE": 0.0105, "DANO": 0.0105,
+	"NPRE": 0.0105, "LASS": 0.0105, "CALA": 0.0105, "STAC": 0.0105, "PRIO": 0.0105,
+	"RATA": 0.0105, "TOSP": 0.0105, "ICED": 0.0105, "AMAS": 0.0104, "CEPT": 0.0104,
+	"ERID": 0.0104, "ERVA": 0.0104, "UTAD": 0.0104, "AFUN": 0.0104, "LAPR": 0.0104,
+	"ALGO": 0.0104, "ERPR": 0.0104, "BUSC": 0.0104, "DUPL": 0.0104, "XTRA": 0.0104,
+	"UALE": 0.0104, "HAYU": 0.0104, "ELAO": 0.0104, "RECH": 0.0104, "LESC": 0.0104,
+	"NATI": 0.0104, "ALRE": 0.0103, "ETIP": 0.0103, "ACHE": 0.0103, "RIFI": 0.0103,
+	"LARU": 0.0103, "OCAR": 0.0103, "RGAR": 0.0103, "MPIL": 0.0103, "ASQU": 0.0103,
+	"SSON": 0.0103, "OSEL": 0.0103, "ANOM": 0.0103, "ROBL": 0.0103, "CEEL": 0.0103,
+	"ITAA": 0.0103, "USIO": 0.0103, "BIAN": 0.0103, "TOSI": 0.0102, "OCOI": 0.0102,
+	"INIR": 0.0102, "UPLI": 0.0102, "MULT": 0.0102, "GUNO": 0.0102, "TANO": 0.0102,
+	"CANT": 0.0102, "NTID": 0.0102, "EELT": 0.0102, "LLEE": 0.0102, "ATAR": 0.0102,
+	"OMIE": 0.0102, "MBIN": 0.0102, "TESA": 0.0102, "GITR": 0.0102, "AVEP": 0.0101,
+	"CIAR": 0.0101, "ARAI": 0.0101, "RMAL": 0.0101, "OSIC": 0.0101, "OIMP": 0.0101,
+	"EINV": 0.0101, "EGUI": 0.0101, "LOSI": 0.0101, "FLOT": 0.0101, "ELMI": 0.0101,
+	"DESÍ": 0.0101, "ITIR": 0.0101, "ENGU": 0.0101, "ANUE": 0.0101, "NEXI": 0.0100,
+	"LFIN": 0.0100, "OLAR": 0.0100, "EXCE": 0.0100, "NDON": 0.0100, "NADI": 0.0100,
+	"AELN": 0.0100, "ERCA": 0.0100, "NAME": 0.0100, "ROCO": 0.0100, "ESOF": 0.0100,
+	"MPAQ": 0.0100, "IDAC": 0.0100, "AYUN": 0.0100, "BLEE": 0.0100, "RIBE": 0.0100,
+	"CIOS": 0.0100, "BLED": 0.0100, "ADIC": 0.0100, "LENG": 0.0100, "AMAR": 0.0099,
+	"NDEA": 0.0099, "TESI": 0.0099, "CULA": 0.0099, "DESH": 0.0099, "PRET": 0.0099,
+	"GULA": 0.0099, "ODEV": 0.0099, "EROC": 0.0099, "EROA": 0.0099, "EDEM": 0.0099,
+	"PREV": 0.0099, "LQUI": 0.0099, "LMIS": 0.0099, "ACAR": 0.0099, "OALA": 0.0099,
+	"EFUE": 0.0099, "SREG": 0.0099, "RRUP": 0.0099, "EMEM": 0.0099, "ONEC": 0.0099,
+	"QUEP": 0.0099, "SUME": 0.0099, "HADE": 0.0099, "RAST": 0.0099, "MANO": 0.0099,
+	"ODUC": 0.0099, "EFRA": 0.0099, "TOPO": 0.0098, "UDOA": 0.0098, "DUCI": 0.0098,
+	"EAUN": 0.0098, "IDAP": 0.0098, "OLAS": 0.0098, "NTIP": 0.0098, "CALE": 0.0098,
+	"IORE": 0.0098, "PLEO": 0.0098, "ASTR": 0.0098, "EBEN": 0.0098, "ESOB": 0.0098,
+	"CAEL": 0.0098, "COMB": 0.0098, "OMBI": 0.0098, "RRÓN": 0.0098, "SEUS": 0.0098,
+	"ONSE": 0.0098, "ECUC": 0.0097, "CUCI": 0.0097, "ENMO": 0.0097, "IMIE": 0.0097,
+	"ELCE": 0.0097, "DDEL": 0.0097, "STÁF": 0.0097, "NAIN": 0.0097, "LUSO": 0.0097,
+	"SSEC": 0.0097, "INCA": 0.0097, "ARIN": 0.0096, "LGRU": 0.0096, "EREQ": 0.0096,
+	"ULTI": 0.0096, "ENAR": 0.0096, "EGUL": 0.0096, "RTAS": 0.0096, "ENAS": 0.0096,
+	"ROSI": 0.0096, "MERA": 0.0096, "OMOS": 0.0096, "EEXP": 0.0096, "TARC": 0.0096,
+	"EELF": 0.0096, "TASE": 0.0096, "ALIA": 0.0096, "LESE": 0.0096, "CHEC": 0.0096,
+	"EZAD": 0.0096, "ITRE": 0.0096, "ADOU": 0.0095, "RITM": 0.0095, "TROE": 0.0095,
+	"IADA": 0.0095, "AYUD": 0.0095, "YUDA": 0.0095, "AFIC": 0.0095, "COME": 0.0095,
+	"DISC": 0.0095, "DEQU": 0.0095, "TESP": 0.0095, "ÓNSI": 0.0095, "STEC": 0.0095,
+	"GUAR": 0.0095, "IVOE": 0.0095, "ADEV": 0.0095, "REVO": 0.0095, "ENOR": 0.0094,
+	"XIMO": 0.0094, "PLAN": 0.0094, "OESV": 0.0094, "OTAL": 0.0094, "IGUO": 0.0094,
+	"ENLO": 0.0094, "OREA": 0.0094, "ELAN": 0.0094, "OELF": 0.0094, "DEFA": 0.0094,
+	"IGOO": 0.0094, "DELN": 0.0093, "OLAM": 0.0093, "ASOL": 0.0093, "PUES": 0.0093,
+	"ALNO": 0.0093, "UERD": 0.0093, "ASNO": 0.0093, "APER": 0.0093, "ELAP": 0.0093,
+	"STER": 0.0093, "ALAE": 0.0093, "IVAS": 0.0093, "MITA": 0.0093, "RIOE": 0.0093,
+	"ÍFIC": 0.0093, "RSEC": 0.0093, "TOSC": 0.0093, "NTOP": 0.0093, "CITA": 0.0092,
+	"RTED": 0.0092, "ONSU": 0.0092, "STÁA": 0.0092, "ERCO": 0.0092, "GUAL": 0.0092,
+	"TOMA": 0.0092, "RIOP": 0.0092, "SECT": 0.0092, "AARQ": 0.0092, "EBYT": 0.0092,
+	"SONO": 0.0092, "LEEN": 0.0092, "TCON": 0.0092, "CERL": 0.0092, "REBA": 0.0092,
+	"ISLA": 0.0091, "ANDA": 0.0091, "IGOD": 0.0091, "ARGO": 0.0091, "TÁFU": 0.0091,
+	"SPUE": 0.0091, "ÁSDE": 0.0091, "DAMI": 0.0091, "CASO": 0.0091, "ILIC": 0.0091,
+	"ECIE": 0.0091, "LENC": 0.0091, "ODIR": 0.0091, "NOBJ": 0.0091, "GOOP": 0.0091,
+	"OGIT": 0.0091, "RTAC": 0.0091, "ELGR": 0.0091, "TEIN": 0.0091, "ZARL": 0.0091,
+	"REPA": 0.0091, "ALAI": 0.0091, "RIGE": 0.0091, "OMOU": 0.0091, "SONA": 0.0091,
+	"SEUT": 0.0091, "ACÓD": 0.0091, "TEFR": 0.0091, "IOSD": 0.0090, "TINU": 0.0090,
+	"LAFU": 0.0090, "EMOD": 0.0090, "DECE": 0.0090, "NDIE": 0.0090, "ISTI": 0.0090,
+	"EMAD": 0.0090, "ORRU": 0.0090, "ALFI": 0.0090, "NTAX": 0.0090, "LPRI": 0.0090,
+	"ASSI": 0.0090, "OSED": 0.0090, "DIDA": 0.0090, "SARG": 0.0090, "PTOR": 0.0090,
+	"ESEA": 0.0090, "UTOM": 0.0090, "NFAL": 0.0090, "ASCA": 0.0090, "NESM": 0.0090,
+	"TEST": 0.0090, "VISA": 0.0089, "UARD": 0.0089, "ONEX": 0.0089, "NENE": 0.0089,
+	"STÁS": 0.0089, "NNÚM": 0.0089, "UNAV": 0.0089, "ECUA": 0.0089, "ÁFUE": 0.0089,
+	"TPAR": 0.0089, "IONO": 0.0089, "ELME": 0.0089, "EBEE": 0.0089, "TESO": 0.0089,
+	"LASL": 0.0089, "GLOB": 0.0089, "ISCO": 0.0089, "STEE": 0.0089, "ORID": 0.0089,
+	"LADA": 0.0089, "UNTI": 0.0089, "AMIF": 0.0089, "GEND": 0.0089, "NSIÓ": 0.0089,
+	"RPOR": 0.0089, "DEAL": 0.0089, "ACKA": 0.0089, "URAN": 0.0088, "URID": 0.0088,
+	"ITER": 0.0088, "ALGU": 0.0088, "OESD": 0.0088, "ITEL": 0.0088, "PORL": 0.0088,
+	"LIBR": 0.0088, "TORD": 0.0088, "ROMI": 0.0088, "RMAR": 0.0088, "EAPT": 0.0088,
+	"CASD": 0.0088, "IRUN": 0.0088, "SENS": 0.0088, "DARE": 0.0088, "NASE": 0.0088,
+	"LAVA": 0.0088, "RNOS": 0.0087, "UNES": 0.0087, "RNOE": 0.0087, "ALAN": 0.0087,
+	"EHAE": 0.0087, "IOCO": 0.0087, "UENC": 0.0087, "ESIS": 0.0087, "OCOR": 0.0087,
+	"ORAD": 0.0087, "ABSO": 0.0087, "CÍFI": 0.0087, "ESUB": 0.0087, "AOPE": 0.0087,
+	"ARON": 0.0087, "MANI": 0.0087, "AREG": 0.0086, "DEER": 0.0086, "INUA": 0.0086,
+	"LLAV": 0.0086, "GURI": 0.0086, "OINT": 0.0086, "TOSA": 0.0086, "ERED": 0.0086,
+	"RLAR": 0.0086, "LCÓD": 0.0086, "DELM": 0.0086, "ADAN": 0.0086, "ONOR": 0.0086,
+	"ITED": 0.0086, "INOS": 0.0086, "OMOE": 0.0086, "RTOD": 0.0086, "LSUB": 0.0086,
+	"RUPT": 0.0086, "DELT": 0.0086, "OLOD": 0.0086, "OLOE": 0.0086, "AFAL": 0.0086,
+	"MIFI": 0.0086, "OSOB": 0.0086, "RNAT": 0.0086, "ALAV": 0.0085, "DEBA": 0.0085,
+	"LASM": 0.0085, "FRAD": 0.0085, "ESOP": 0.0085, "OCUA": 0.0085, "ELCÓ": 0.0085,
+	"EFAL": 0.0085, "CCES": 0.0085, "NORM": 0.0085, "RIOC": 0.0085, "NPOR": 0.0085,
+	"EROI": 0.0085, "TERD": 0.0085, "ELLÍ": 0.0085, "ILID": 0.0085, "USOD": 0.0085,
+	"RFIC": 0.0085, "RICO": 0.0085, "PECÍ": 0.0085, "ECÍF": 0.0085, "ASUM": 0.0085,
+	"OMAF": 0.0085, "NLAE": 0.0085, "DPKG": 0.0085, "AZDE": 0.0085, "OLON": 0.0084,
+	"ABRA": 0.0084, "NDOU": 0.0084, "UTOS": 0.0084, "SALA": 0.0084, "IERT": 0.0084,
+	"LETO": 0.0084, "DEGR": 0.0084, "TAXI": 0.0084, "AXIS": 0.0084, "NALA": 0.0084,
+	"PALA": 0.0084, "LASP": 0.0084, "LLÍM": 0.0084, "CORT": 0.0084, "RESA": 0.0084,
+	"OLUT": 0.0084, "APRI": 0.0084, "ACUE": 0.0084, "MAFL": 0.0084, "AFLO": 0.0084,
+	"INTO": 0.0084, "MICA": 0.0084, "KAGE": 0.0084, "VERD": 0.0084, "MITS": 0.0084,
+	"LCAR": 0.0083, "ODEG": 0.0083, "ROTO": 0.0083, "ENPA": 0.0083, "ACCI": 0.0083,
+	"REDI": 0.0083, "OALC": 0.0083, "ESOS": 0.0083, "NESI": 0.0083, "URSO": 0.0083,
+	"RSON": 0.0083, "EASE": 0.0083, "CKAG": 0.0083, "ICAE": 0.0083, "DEEJ": 0.0083,
+	"ACEP": 0.0083, "NDET": 0.0083, "ONOP": 0.0083, "ARDI": 0.0083, "RELN": 0.0083,
+	"LTAN": 0.0083, "ROPA": 0.0083, "SNOS": 0.0083, "LONO": 0.0083, "LANT": 0.0083,
+	"RDAM": 0.0083, "ENSU": 0.0083, "DATA": 0.0083, "ICEN": 0.0083, "ICOD": 0.0083,
+	"NARA": 0.0083, "NNOS": 0.0083, "ÓLOS": 0.0083, "ELAI": 0.0083, "LASR": 0.0083,
+	"UALM": 0.0083, "ORAR": 0.0083, "NICA": 0.0082, "PROT": 0.0082, "NPAQ": 0.0082,
+	"ITMO": 0.0082, "NCAM": 0.0082, "ILAD": 0.0082, "MÁSD": 0.0082, "OLAC": 0.0082,
+	"NEJA": 0.0082, "NTOA": 0.0082, "CIOD": 0.0082, "TÁDE": 0.0082, "HORA": 0.0082,
+	"PSTR": 0.0082, "ROPE": 0.0082, "OEQU": 0.0082, "ELLE": 0.0082, "ABIB": 0.0082,
+	"ALAP": 0.0082, "OCIA": 0.0081, "MONT": 0.0081, "RIDO": 0.0081, "CTAD": 0.0081,
+	"LTAE": 0.0081, "RRAD": 0.0081, "RTAN": 0.0081, "ERRE": 0.0081, "OSTA": 0.0081,
+	"ENAM": 0.0081, "ITOS": 0.0081, "AFOR": 0.0081, "AELD": 0.0081, "EELN": 0.0081,
+	"TACO": 0.0081, "MAYÚ": 0.0081, "AYÚS": 0.0081, "OSSE": 0.0081, "NAOP": 0.0081,
+	"MÁTI": 0.0081, "TARA": 0.0081, "OTEM": 0.0081, "ÉRIC": 0.0081, "MOUN": 0.0081,
+	"EMBR": 0.0081, "UNAI": 0.0081, "ALCR": 0.0081, "FICO": 0.0081, "EALM": 0.0081,
+	"EADA": 0.0081, "DENS": 0.0080, "ITAL": 0.0080, "ASOC": 0.0080, "ECIB": 0.0080,
+	"ETAM": 0.0080, "LÓLA": 0.0080, "RARU": 0.0080, "RELM": 0.0080, "TERP": 0.0080,
+	"LRES": 0.0080, "RSTA": 0.0080, "SOPE": 0.0080, "OFAL": 0.0080, "NACA": 0.0080,
+	"NTAN": 0.0080, "IMPI": 0.0080, "UNEN": 0.0080, "DEBY": 0.0080, "AREF": 0.0080,
+	"OOBJ": 0.0080, "ROSO": 0.0080, "EGIT": 0.0080, "ESUL": 0.0079, "LLÓL": 0.0079,
+	"PRUE": 0.0079, "RUEB": 0.0079, "EARU": 0.0079, "ABIL": 0.0079, "UNAP": 0.0079,
+	"LGUN": 0.0079, "LLAS": 0.0079, "NTOC": 0.0079, "STES": 0.0079, "STEP": 0.0079,
+	"AVED": 0.0079, "SOLV": 0.0079, "RIOA": 0.0079, "IEMB": 0.0079, "MPLA": 0.0079,
+	"LCRE": 0.0079, "MONI": 0.0079, "UTAB": 0.0079, "DIAN": 0.0079, "UNVA": 0.0079,
+	"ULOS": 0.0079, "DEPO": 0.0079, "EAUT": 0.0078, "ADOI": 0.0078, "NCAD": 0.0078,
+	"BÚSQ": 0.0078, "ÚSQU": 0.0078, "RTIR": 0.0078, "RCAM": 0.0078, "TAMB": 0.0078,
+	"IPTO": 0.0078, "ARTO": 0.0078, "TOMÁ": 0.0078, "ROTE": 0.0078, "UPOD": 0.0078,
+	"LOBA": 0.0078, "ECID": 0.0078, "ABOR": 0.0078, "SECR": 0.0078, "IVAE": 0.0078,
+	"ENOT": 0.0078, "LOES": 0.0078, "ASEG": 0.0078, "ANCE": 0.0078, "ÓNPR": 0.0078,
+	"ESEP": 0.0078, "GUAS": 0.0078, "LORI": 0.0077, "SNOE": 0.0077, "UNNÚ": 0.0077,
+	"QUEM": 0.0077, "ELSO": 0.0077, "NELM": 0.0077, "NNOV": 0.0077, "NDOI": 0.0077,
+	"BEES": 0.0077, "SDEN": 0.0077, "TRON": 0.0077, "TOTA": 0.0077, "EHAN": 0.0077,
+	"EÓRD": 0.0077, "LANC": 0.0077, "ESDI": 0.0077, "OIND": 0.0077, "FINE": 0.0077,
+	"TIMO": 0.0077, "YAES": 0.0077, "IMIR": 0.0077, "RIGI": 0.0077, "OPEN": 0.0077,
+	"ENZA": 0.0077, "ECEE": 0.0077, "PERI": 0.0077, "ISTO": 0.0077, "TAGE": 0.0077,
+	"SANT": 0.0076, "EZAM": 0.0076, "ANIN": 0.0076, "ELRA": 0.0076, "INVA": 0.0076,
+	"IALI": 0.0076, "CTAM": 0.0076, "SDEO": 0.0076, "SLÍN": 0.0076, "CIAA": 0.0076,
+	"SREC": 0.0076, "EDIT": 0.0076, "EACI": 0.0076, "VEDE": 0.0076, "ONOT": 0.0076,
+	"ERAM": 0.0076, "RION": 0.0076, "ENEE": 0.0076, "AEXT": 0.0076, "NIST": 0.0076,
+	"SSÍM": 0.0076, "LECI": 0.0076, "EIMP": 0.0076, "IALE": 0.0076, "LTAD": 0.0076,
+	"ADAM": 0.0076, "OSPE": 0.0076, "EGAR": 0.0076, "LGOR": 0.0076, "EERL": 0.0076,
+	"ORPA": 0.0076, "TALO": 0.0076, "SIDE": 0.0076, "ECIA": 0.0076, "STÁC": 0.0076,
+	"LIMP": 0.0076, "RARA": 0.0076, "ORAN": 0.0076, "SINU": 0.0076, "ASAD": 0.0076,
+	"URAS": 0.0076, "NCRE": 0.0076, "NTAC": 0.0076, "HERR": 0.0076, "CAME": 0.0076,
+	"CLUY": 0.0076, "AELM": 0.0076, "AFEC": 0.0076, "CADU": 0.0076, "CARP": 0.0076,
+	"STOR": 0.0076, "PULA": 0.0076, "GITC": 0.0076, "LOCO": 0.0075, "EPTA": 0.0075,
+	"UERI": 0.0075, "OCOL": 0.0075, "LRAN": 0.0075, "GORI": 0.0075, "BIDO": 0.0075,
+	"RDEB": 0.0075, "FICI": 0.0075, "LAES": 0.0075, "SESI": 0.0075, "DOSC": 0.0075,
+	"OSTE": 0.0075, "CEDI": 0.0075, "BIRE": 0.0075, "DENI": 0.0075, "DEND": 0.0075,
+	"AVEZ": 0.0075, "TAES": 0.0075, "NREL": 0.0075, "OMAS": 0.0075, "NARL": 0.0075,
+	"NOTE": 0.0075, "EBOR": 0.0075, "ALLI": 0.0075, "CHAD": 0.0075, "NELE": 0.0075,
+	"NCAP": 0.0075, "ITNO": 0.0075, "STAG": 0.0075, "MISI": 0.0074, "RANO": 0.0074,
+	"EROM": 0.0074, "RALD": 0.0074, "ESHA": 0.0074, "ETED": 0.0074, "ARAT": 0.0074,
+	"ERLO": 0.0074, "LOEN": 0.0074, "ATRÓ": 0.0074, "IVAL": 0.0074, "ESLA": 0.0074,
+	"BIÉN": 0.0074, "UFIJ": 0.0074, "RLAE": 0.0074, "ÑADI": 0.0074, "SARI": 0.0074,
+	"NENC": 0.0074, "RANS": 0.0074, "NSIN": 0.0074, "OBAL": 0.0074, "EREV": 0.0074,
+	"ARAO": 0.0074, "MIEM": 0.0074, "MBRO": 0.0074, "SERÁ": 0.0074, "SVER": 0.0074,
+	"NSEC": 0.0074, "INGL": 0.0074, "AUDI": 0.0074, "OSSÍ": 0.0074, "OLUM": 0.0073,
+	"AMAN": 0.0073, "CKET": 0.0073, "IÓNG": 0.0073, "UACI": 0.0073, "GRES": 0.0073,
+	"AELE": 0.0073, "TUDD": 0.0073, "UDDE": 0.0073, "LESS": 0.0073, "REAU": 0.0073,
+	"NTEU": 0.0073, "OELC": 0.0073, "DOLO": 0.0073, "OUTI": 0.0073, "ASEP": 0.0073,
+	"ETAS": 0.0073, "NELP": 0.0073, "NSOL": 0.0073, "LOSR": 0.0073, "ICAL": 0.0073,
+	"ASEL": 0.0073, "TACL": 0.0073, "ESPR": 0.0073, "NSIS": 0.0073, "NOPC": 0.0073,
+	"EVOL": 0.0073, "TUVO": 0.0073, "BAND": 0.0073, "NOSQ": 0.0073, "TIMA": 0.0073,
+	"CLAR": 0.0073, "CALI": 0.0073, "ERUS": 0.0073, "AAPL": 0.0073, "SLOC": 0.0073,
+	"SIEL": 0.0073, "DEAU": 0.0072, "RDEU": 0.0072, "DURA": 0.0072, "NAVE": 0.0072,
+	"EELC": 0.0072, "ILTR": 0.0072, "UEGO": 0.0072, "SIES": 0.0072, "CONJ": 0.0072,
+	"OMOP": 0.0072, "EJEM": 0.0072, "OMÁT": 0.0072, "ALPA": 0.0072, "NAND": 0.0072,
+	"ALAA": 0.0072, "ITES": 0.0072, "DASP": 0.0072, "RREG": 0.0072, "AEXI": 0.0072,
+	"EALA": 0.0072, "USAE": 0.0072, "PIAR": 0.0072, "ROBJ": 0.0072, "RMAN": 0.0072,
+	"RDIR": 0.0072, "DALA": 0.0072, "ENGA": 0.0072, "APAZ": 0.0072, "BLEP": 0.0071,
+	"RRES": 0.0071, "LOSO": 0.0071, "LICI": 0.0071, "SINE": 0.0071, "CARL": 0.0071,
+	"FILT": 0.0071, "NAEN": 0.0071, "EGRU": 0.0071, "OMUE": 0.0071, "ASMU": 0.0071,
+	"NEAM": 0.0071, "EFIJ": 0.0071, "RONE": 0.0071, "ONCO": 0.0071, "ODIS": 0.0071,
+	"MBIÉ": 0.0071, "JEMP": 0.0071, "AUNS": 0.0071, "IMIT": 0.0071, "STIC": 0.0071,
+	"APUN": 0.0071, "STOS": 0.0071, "PRIN": 0.0071, "LIAS": 0.0071, "RARS": 0.0071,
+	"GOPA": 0.0071, "ROSP": 0.0071, "UNAA": 0.0071, "DECU": 0.0071, "PASA": 0.0071,
+	"REGL": 0.0071, "SIDO": 0.0071, "RGAD": 0.0071, "TAEN": 0.0071, "LSEG": 0.0071,
+	"CARU": 0.0071, "NDIA": 0.0071, "EINC": 0.0070, "AJES": 0.0070, "NINT": 0.0070,
+	"ÓNIC": 0.0070, "CONG": 0.0070, "NUNC": 0.0070, "TEQU": 0.0070, "ARRA": 0.0070,
+	"OBSO": 0.0070, "OLET": 0.0070, "DEÓR": 0.0070, "MASD": 0.0070, "NECT": 0.0070,
+	"NFIA": 0.0070, "ECÓD": 0.0070, "ICON": 0.0070, "AELI": 0.0070, "EDEH": 0.0070,
+	"ELMA": 0.0070, "LENO": 0.0070, "NOIN": 0.0070, "QUIN": 0.0070, "IANT": 0.0070,
+	"MATR": 0.0070, "AYOR": 0.0069, "STÁP": 0.0069, "LELE": 0.0069, "AELA": 0.0069,
+	"RMIS": 0.0069, "UELO": 0.0069, "EFIR": 0.0069, "HABI": 0.0069, "TINT": 0.0069,
+	"NEAC": 0.0069, "RTES": 0.0069, "NJUN": 0.0069, "RACA": 0.0069, "ELOC": 0.0069,
+	"JADO": 0.0069, "SOLE": 0.0069, "ELAV": 0.0069, "SINI": 0.0069, "ARFI": 0.0069,
+	"DOAN": 0.0069, "CAND": 0.0069, "LUYE": 0.0069, "CLUS": 0.0069, "DEVA": 0.0069,
+	"ATAB": 0.0069, "UNOB": 0.0069, "IGIN": 0.0069, "LLEN": 0.0069, "ECAC": 0.0069,
+	"PIET": 0.0069, "IETA": 0.0069, "UNOD": 0.0069, "RELL": 0.0069, "ESUS": 0.0069,
+	"PAZD": 0.0069, "NDOR": 0.0068, "OTAD": 0.0068, "LANO": 0.0068, "INRE": 0.0068,
+	"OHAC": 0.0068, "RDEP": 0.0068, "NELN": 0.0068, "RCAR": 0.0068, "ONJU": 0.0068,
+	"ASLÍ": 0.0068, "NSID": 0.0068, "RADI": 0.0068, "AINC": 0.0068, "REEL": 0.0068,
+	"DAAL": 0.0068, "VOSD": 0.0068, "ECHO": 0.0068, "ACAB": 0.0068, "UPTO": 0.0068,
+	"NDIS": 0.0068, "RDEA": 0.0068, "EOPC": 0.0068, "LEGI": 0.0068, "ONAM": 0.0068,
+	"INOD": 0.0068, "UTAS": 0.0068, "EGLA": 0.0068, "QUEO": 0.0068, "REEM": 0.0068,
+	"ADEU": 0.0068, "IVAR": 0.0068, "UINA": 0.0068, "RALC": 0.0068, "LATE": 0.0068,
+	"ETCH": 0.0068, "GITA": 0.0068, "IGOP": 0.0068, "NEJE": 0.0068, "MAYO": 0.0068,
+	"UEMA": 0.0068, "OINE": 0.0068, "EDEI": 0.0068, "NACT": 0.0068, "OLIC": 0.0068,
+	"PETI": 0.0068, "EFIL": 0.0068, "ALFO": 0.0068, "PROV": 0.0068, "ROVE": 0.0068,
+	"ARPE": 0.0068, "DENU": 0.0068, "SINF": 0.0068, "ARSO": 0.0068, "MÉRI": 0.0068,
+	"QUEU": 0.0068, "SVAL": 0.0068, "ORLA": 0.0068, "ICAM": 0.0068, "ANEN": 0.0068,
+	"TASH": 0.0068, "ESLO": 0.0068, "FRAN": 0.0068, "ININ": 0.0068, "REFS": 0.0068,
+	"DEIM": 0.0067, "EXIÓ": 0.0067, "XIÓN": 0.0067, "ICIT": 0.0067, "OGEN": 0.0067,
+	"ANOT": 0.0067, "CONN": 0.0067, "NMEN": 0.0067, "ADEI": 0.0067, "EINF": 0.0067,
+	"ACRE": 0.0067, "MALA": 0.0067, "NELR": 0.0067, "MPLO": 0.0067, "HAST": 0.0067,
+	"ALPR": 0.0067, "NDOF": 0.0067, "INAN": 0.0067, "MUER": 0.0067, "NOTR": 0.0067,
+	"IMOD": 0.0067, "RDER": 0.0067, "NEGA": 0.0067, "DEAN": 0.0067, "PATH": 0.0067,
+	"EVAR": 0.0067, "ÓNDI": 0.0067, "LASV": 0.0067, "OLUC": 0.0067, "RERE": 0.0067,
+	"NTAS": 0.0067, "EBIA": 0.0067, "OREM": 0.0067, "OCAC": 0.0067, "IDIO": 0.0067,
+	"DELÁ": 0.0067, "ITCO": 0.0067, "TALE": 0.0066, "AESC": 0.0066, "FIND": 0.0066,
+	"RAPA": 0.0066, "ELAF": 0.0066, "NESN": 0.0066, "DOLE": 0.0066, "DADA": 0.0066,
+	"ENED": 0.0066, "RINV": 0.0066, "RARI": 0.0066, "RAQU": 0.0066, "BREC": 0.0066,
+	"ROSA": 0.0066, "URAE": 0.0066, "UNSÍ": 0.0066, "ÉTIC": 0.0066, "ONOA": 0.0066,
+	"AREA": 0.0066, "CORE": 0.0066, "SEOB": 0.0066, "NREP": 0.0066, "TONI": 0.0066,
+	"STAM": 0.0066, "ECAS": 0.0066, "RACÓ": 0.0066, "OCKE": 0.0065, "ONOV": 0.0065,
+	"RNOM": 0.0065, "IÓNY": 0.0065, "SEMP": 0.0065, "SELI": 0.0065, "SOSE": 0.0065,
+	"DEBL": 0.0065, "EBLO": 0.0065, "INSN": 0.0065, "OBTU": 0.0065, "BTUV": 0.0065,
+	"OEXT": 0.0065, "TROI": 0.0065, "NESY": 0.0065, "ALEL": 0.0065, "PORQ": 0.0065,
+	"BACI": 0.0065, "INIS": 0.0065, "NGLÉ": 0.0065, "GLÉS": 0.0065, "DEGI": 0.0065,
+	"BRAD": 0.0064, "ROLA": 0.0064, "NRES": 0.0064, "DOSS": 0.0064, "TRUN": 0.0064,
+	"SOLI": 0.0064, "RINC": 0.0064, "ONCU": 0.0064, "NTEM": 0.0064, "TEME": 0.0064,
+	"OSME": 0.0064, "SDIR": 0.0064, "CENC": 0.0064, "RESS": 0.0064, "REAC": 0.0064,
+	"LAET": 0.0064, "LTAR": 0.0064, "RALL": 0.0064, "OCAM": 0.0064, "UNPR": 0.0064,
+	"LOST": 0.0064, "ACUA": 0.0064, "ELID": 0.0064, "OEJE": 0.0064, "EXCL": 0.0064,
+	"NTIL": 0.0064, "LUCI": 0.0064, "EVIO": 0.0064, "TRAC": 0.0064, "ÁQUI": 0.0064,
+	"CTOD": 0.0064, "REVE": 0.0064, "MICO": 0.0064, "ISOR": 0.0064, "NOAP": 0.0064,
+	"HECK": 0.0064, "FETC": 0.0064, "ERSE": 0.0063, "OSUN": 0.0063, "SOCK": 0.0063,
+	"XTOD": 0.0063, "OTOC": 0.0063, "WORD": 0.0063, "OLEC": 0.0063, "VADA": 0.0063,
+	"TICI": 0.0063, "TERC": 0.0063, "EELA": 0.0063, "DELU": 0.0063, "IERO": 0.0063,
+	"ECED": 0.0063, "IPCI": 0.0063, "TESS": 0.0063, "ÑADE": 0.0063, "SERR": 0.0063,
+	"ERAI": 0.0063, "MAIN": 0.0063, "PORO": 0.0063, "MASE": 0.0063, "BRAR": 0.0063,
+	"SINA": 0.0063, "ESIG": 0.0063, "NSOB": 0.0063, "EMON": 0.0063, "EFUS": 0.0063,
+	"UTAN": 0.0063, "ERDO": 0.0063, "CANO": 0.0063, "TEAL": 0.0063, "ASÍM": 0.0063,
+	"SAEL": 0.0063, "SCOR": 0.0063, "ASID": 0.0063, "ESEE": 0.0063, "ANDI": 0.0063,
+	"LREP": 0.0063, "LCAN": 0.0063, "MÁQU": 0.0063, "ENNO": 0.0063, "MANT": 0.0063,
+	"OAPL": 0.0063, "TENG": 0.0063, "HIST": 0.0063, "RUSA": 0.0063, "ANIP": 0.0063,
+	"NIPU": 0.0063, "IPUL": 0.0063, "PENS": 0.0062, "DOPE": 0.0062, "LENT": 0.0062,
+	"DVER": 0.0062, "IVOP": 0.0062, "NLAR": 0.0062, "NALC": 0.0062, "DOCR": 0.0062,
+	"RELR": 0.0062, "EELI": 0.0062, "ENOV": 0.0062, "LAPI": 0.0062, "IBRE": 0.0062,
+	"EJAD": 0.0062, "OPTI": 0.0062, "DASI": 0.0062, "STAA": 0.0062, "DITA": 0.0062,
+	"NUMÉ": 0.0062, "UMÉR": 0.0062, "OLOR": 0.0062, "SILE": 0.0062, "ASPR": 0.0062,
+	"LAAR": 0.0062, "VIRT": 0.0062, "DEVO": 0.0062, "DEMÁ": 0.0062, "ÓNEO": 0.0062,
+	"EARL": 0.0062, "HELP": 0.0062, "INDO": 0.0062, "SACO": 0.0062, "ESUM": 0.0062,
+	"EUNC": 0.0061, "DAME": 0.0061, "PRIV": 0.0061, "DOCA": 0.0061, "ARNO": 0.0061,
+	"OBIE": 0.0061, "UNER": 0.0061, "REAN": 0.0061, "TEPR": 0.0061, "LOSM": 0.0061,
+	"RASO": 0.0061, "PÁGI": 0.0061, "ÁGIN": 0.0061, "VOLV": 0.0061, "MACO": 0.0061,
+	"FLUJ": 0.0061, "LUJO": 0.0061, "IOEN": 0.0061, "SDEI": 0.0061, "IVON": 0.0061,
+	"XCLU": 0.0061, "EDIO": 0.0061, "TITU": 0.0061, "ARAG": 0.0061, "OERR": 0.0061,
+	"EOTR": 0.0061, "ALEM": 0.0061, "RTAM": 0.0061, "RORS": 0.0060, "LARL": 0.0060,
+	"ATOR": 0.0060, "LITA": 0.0060, "NTEO": 0.0060, "ATRO": 0.0060, "SSIM": 0.0060,
+	"RNOD": 0.0060, "CONP": 0.0060, "DOND": 0.0060, "COMU": 0.0060, "NAUN": 0.0060,
+	"DOAU": 0.0060, "SMÁS": 0.0060, "BREL": 0.0060, "SEUD": 0.0060, "EUDO": 0.0060,
+	"NTAM": 0.0060, "RASI": 0.0060, "ERAU": 0.0060, "VONO": 0.0060, "ISON": 0.0060,
+	"ORME": 0.0060, "EGEN": 0.0060, "SOCI": 0.0060, "DEBU": 0.0060, "DIOM": 0.0060,
+	"STIL": 0.0060, "ELSH": 0.0060, "ADAL": 0.0060, "CIBI": 0.0060, "UNTE": 0.0060,
+	"OLEE": 0.0060, "EACC": 0.0060, "ÓUNA": 0.0060, "OPOS": 0.0060, "EMUE": 0.0060,
+	"ESEM": 0.0060, "OSIM": 0.0060, "HILO": 0.0060, "OVAL": 0.0060, "CLUI": 0.0060,
+	"AJOS": 0.0060, "ICAB": 0.0060, "IOPA": 0.0060, "CTOA": 0.0060, "OTES": 0.0060,
+	"ENDA": 0.0060, "DETO": 0.0060, "REJE": 0.0060, "NANO": 0.0060, "RTAE": 0.0060,
+	"ENAN": 0.0060, "OFUN": 0.0060, "CTOE": 0.0060, "ÁRAB": 0.0060, "IERD": 0.0060,
+	"IÓNV": 0.0059, "RIAN": 0.0059, "ELEL": 0.0059, "RDEM": 0.0059, "ENVI": 0.0059,
+	"IDEC": 0.0059, "DEOR": 0.0059, "EJAR": 0.0059, "SCAP": 0.0059, "MALF": 0.0059,
+	"ERIN": 0.0059, "ENFI": 0.0059, "SCUL": 0.0059, "ULAS": 0.0059, "ELIB": 0.0059,
+	"ÓNSO": 0.0059, "ERAE": 0.0059, "OENS": 0.0059, "BLAN": 0.0059, "SUSA": 0.0059,
+	"ARAF": 0.0059, "RSOL": 0.0059, "ORSI": 0.0059, "PIAD": 0.0059, "ELAB": 0.0059,
+	"ETOC": 0.0059, "OVAC": 0.0059, "HASI": 0.0059, "ASVE": 0.0059, "APRE": 0.0059,
+	"ONIT": 0.0059, "NCER": 0.0059, "RABE": 0.0059, "TAMI": 0.0059, "TILO": 0.0059,
+	"ISPE": 0.0059, "FRAS": 0.0059, "GITS": 0.0059, "SCAL": 0.0058, "TERR": 0.0058,
+	"ADVE": 0.0058, "DOAC": 0.0058, "ILIT": 0.0058, "DDEC": 0.0058, "OSAC": 0.0058,
+	"CONM": 0.0058, "OELI": 0.0058, "AMÁS": 0.0058, "LEOO": 0.0058, "ADEÓ": 0.0058,
+	"ALON": 0.0058, "SCLA": 0.0058, "IOMA": 0.0058, "ASUB": 0.0058, "SPRI": 0.0058,
+	"OSIE": 0.0058, "RTAL": 0.0058, "OSVA": 0.0058, "ESÓL": 0.0058, "YAEX": 0.0058,
+	"VOCO": 0.0058, "NLAD": 0.0058, "NESO": 0.0058, "AALA": 0.0058, "TARS": 0.0058,
+	"XTER": 0.0058, "AUNI": 0.0058, "DMIN": 0.0058, "ERFA": 0.0058, "SADA": 0.0058,
+	"UERE": 0.0058, "EENS": 0.0058, "ECEL": 0.0058, "ZQUI": 0.0058, "LSHE": 0.0058,
+	"CIND": 0.0058, "ITAN": 0.0057, "LASU": 0.0057, "EROL": 0.0057, "REEN": 0.0057,
+	"ROEL": 0.0057, "ALSE": 0.0057, "DOAB": 0.0057, "LATR": 0.0057, "EAND": 0.0057,
+	"ERSA": 0.0057, "DERO": 0.0057, "ONLO": 0.0057, "IDER": 0.0057, "APIL": 0.0057,
+	"IBIL": 0.0057, "OMUN": 0.0057, "ESUP": 0.0057, "OROM": 0.0057, "ODUZ": 0.0057,
+	"DUZC": 0.0057, "UZCA": 0.0057, "CAUN": 0.0057, "SDEV": 0.0057, "NUME": 0.0057,
+	"ZARC": 0.0057, "PSEU": 0.0057, "BLEA": 0.0057, "NARU": 0.0057, "ÁENE": 0.0057,
+	"NDOO": 0.0057, "MOVE": 0.0057, "AHOR": 0.0057, "TASD": 0.0057, "IPOS": 0.0057,
+	"ZAEL": 0.0057, "RASU": 0.0057, "ASDI": 0.0057, "RELT": 0.0057, "EMAR": 0.0057,
+	"AUNC": 0.0057, "UDIO": 0.0057, "RASA": 0.0056, "LTRA": 0.0056, "CIAE": 0.0056,
+	"EREE": 0.0056, "EORD": 0.0056, "TAPA": 0.0056, "NEQU": 0.0056, "IOSP": 0.0056,
+	"NAEX": 0.0056, "EOOP": 0.0056, "FICÓ": 0.0056, "AUNN": 0.0056, "POSD": 0.0056,
+	"UMER": 0.0056, "RALM": 0.0056, "RPRO": 0.0056, "OBAS": 0.0056, "IRTU": 0.0056,
+	"RTUA": 0.0056, "IMAL": 0.0056, "EGAT": 0.0056, "NIEN": 0.0056, "AFUE": 0.0056,
+	"NITO": 0.0056, "ESHE": 0.0056, "ARST": 0.0056, "FORZ": 0.0056, "ORZA": 0.0056,
+	"NAFU": 0.0056, "ISPA": 0.0056, "ELAA": 0.0056, "OBAC": 0.0056, "STAE": 0.0056,
+	"TRAV": 0.0056, "AZAR": 0.0056, "TALI": 0.0056, "NDÉS": 0.0056, "CLON": 0.0056,
+	"GIÓN": 0.0055, "IONS": 0.0055, "IÓNQ": 0.0055, "ÓNQU": 0.0055, "HASH": 0.0055,
+	"LINT": 0.0055, "LORN": 0.0055, "LTRO": 0.0055, "RUTI": 0.0055, "ONNO": 0.0055,
+	"INCR": 0.0055, "LORP": 0.0055, "ESED": 0.0055, "ÚSCU": 0.0055, "AHAC": 0.0055,
+	"NELD": 0.0055, "RAUS": 0.0055, "EVIA": 0.0055, "TÁCO": 0.0055, "ITAU": 0.0055,
+	"ETAN": 0.0055, "ENAL": 0.0055, "HIJO": 0.0055, "ELTE": 0.0055, "ARME": 0.0055,
+	"USAG": 0.0055, "TENL": 0.0055, "NECO": 0.0055, "UNDE": 0.0055, "ACAM": 0.0055,
+	"ONAN": 0.0055, "SOBJ": 0.0055, "OSON": 0.0055, "EXPA": 0.0055, "IVOA": 0.0055,
+	"CABL": 0.0055, "ERFI": 0.0055, "SLIS": 0.0055, "ELLO": 0.0055, "RIAS": 0.0055,
+	"ZABL": 0.0055, "UNAB": 0.0055, "PETA": 0.0055, "WIND": 0.0055, "ANDÉ": 0.0055,
+	"RÁFI": 0.0055, "ÁFIC": 0.0055, "RRAM": 0.0055, "SEAC": 0.0055, "LAJA": 0.0055,
+	"SAGI": 0.0055, "EGIÓ": 0.0054, "NCIP": 0.0054, "ORUN": 0.0054, "CANA": 0.0054,
+	"DOOB": 0.0054, "TARD": 0.0054, "CERS": 0.0054, "AGOT": 0.0054, "ATAM": 0.0054,
+	"SOCO": 0.0054, "ITOP": 0.0054, "SRES": 0.0054, "FIAN": 0.0054, "IANZ": 0.0054,
+	"DASD": 0.0054, "CONA": 0.0054, "CIMA": 0.0054, "AUNE": 0.0054, "MULA": 0.0054,
+	"ELAJ": 0.0054, "GATI": 0.0054, "ALSI": 0.0054, "EGAD": 0.0054, "ILES": 0.0054,
+	"CLAD": 0.0054, "NEEL": 0.0054, "AVEN": 0.0054, "INUN": 0.0054, "EFAU": 0.0054,
+	"SFUE": 0.0054, "DATE": 0.0054, "UTOR": 0.0054, "HIVA": 0.0054, "GRÁF": 0.0054,
+	"OESE": 0.0054, "RAVE": 0.0054, "ROOB": 0.0054, "SEAS": 0.0054, "SUBC": 0.0054,
+	"PORU": 0.0053, "EROF": 0.0053, "LALL": 0.0053, "DORA": 0.0053, "UEBA": 0.0053,
+	"TOSS": 0.0053, "UDOL": 0.0053, "CIAC": 0.0053, "ETIC": 0.0053, "ONSO": 0.0053,
+	"RÓNI": 0.0053, "SUPR": 0.0053, "CESS": 0.0053, "ESMA": 0.0053, "BRAS": 0.0053,
+	"IRLO": 0.0053, "EUNP": 0.0053, "LALO": 0.0053, "ENBL": 0.0053, "SOSI": 0.0053,
+	"LAST": 0.0053, "ALAF": 0.0053, "ATAL": 0.0053, "OMÁX": 0.0053, "LEPA": 0.0053,
+	"IASE": 0.0053, "NDEI": 0.0053, "DIVI": 0.0053, "OSTI": 0.0053, "MORE": 0.0053,
+	"MAES": 0.0053, "ÓLOL": 0.0053, "VOSE": 0.0053, "ENPR": 0.0053, "ARAB": 0.0053,
+	"FAUL": 0.0053, "AULT": 0.0053, "SENU": 0.0053, "RIAL": 0.0053, "CIER": 0.0053,
+	"ARAV": 0.0053, "NSIG": 0.0053, "LLOS": 0.0053, "EAMI": 0.0053, "OREF": 0.0053,
+	"SARL": 0.0053, "CENT": 0.0052, "HACI": 0.0052, "DEDO": 0.0052, "ÓNAL": 0.0052,
+	"CERC": 0.0052, "POND": 0.0052, "ESAM": 0.0052, "EVAC": 0.0052, "EODE": 0.0052,
+	"OMÁS": 0.0052, "ATIE": 0.0052, "PIED": 0.0052, "RIPC": 0.0052, "OLOL": 0.0052,
+	"ROLD": 0.0052, "EASI": 0.0052, "ADAF": 0.0052, "UELT": 0.0052, "OEXP": 0.0052,
+	"OALE": 0.0052, "DONI": 0.0052, "OTAS": 0.0052, "GALE": 0.0052, "UIDO": 0.0052,
+	"RICA": 0.0052, "SERC": 0.0052, "NOIM": 0.0052, "OMPE": 0.0052, "RLAI": 0.0052,
+	"TARO": 0.0052, "VORA": 0.0052, "UNTU": 0.0052, "EZDE": 0.0052, "EARG": 0.0052,
+	"NONO": 0.0052, "OINS": 0.0052, "ICOE": 0.0052, "LEAS": 0.0052, "ISOE": 0.0052,
+	"ÚNIC": 0.0052, "ALIC": 0.0052, "LNUE": 0.0052, "IAND": 0.0052, "PLÍC": 0.0052,
+	"LÍCI": 0.0052, "ÍCIT": 0.0052, "ALVA": 0.0052, "XPAN": 0.0052, "OHAS": 0.0052,
+	"RETE": 0.0052, "OELP": 0.0052, "RPET": 0.0052, "TERF": 0.0052, "EMAC": 0.0052,
+	"OREJ": 0.0052, "RREO": 0.0052, "IZQU": 0.0052, "ROFU": 0.0052, "XCED": 0.0052,
+	"OINI": 0.0052, "IEDA": 0.0052, "STAI": 0.0052, "DOFU": 0.0052, "BREP": 0.0052,
+	"OENU": 0.0052, "IARL": 0.0052, "NCES": 0.0052, "SARS": 0.0052, "TRUI": 0.0052,
+	"NBLA": 0.0052, "VEPÚ": 0.0052, "EDOR": 0.0052, "RINF": 0.0052, "ASIL": 0.0052,
+	"PODI": 0.0052, "NIFI": 0.0052, "ÓNPO": 0.0052, "ASEE": 0.0052, "OCER": 0.0052,
+	"BORT": 0.0052, "OTEN": 0.0052, "ASPO": 0.0052, "LESA": 0.0052, "STIT": 0.0052,
+	"IVOT": 0.0052, "LTIE": 0.0052, "IREN": 0.0052, "MANU": 0.0052, "APTC": 0.0052,
+	"ETEE": 0.0052, "QUEF": 0.0052, "SMON": 0.0052, "IMID": 0.0052, "ANCI": 0.0052,
+	"AROB": 0.0052, "AUNO": 0.0052, "RARR": 0.0052, "AFUS": 0.0052, "OFAK": 0.0052,
+	"ILIA": 0.0051, "SOSD": 0.0051, "NTEI": 0.0051, "TMOD": 0.0051, "OABR": 0.0051,
+	"IACI": 0.0051, "RELI": 0.0051, "IDAN": 0.0051, "ANOP": 0.0051, "EDEV": 0.0051,
+	"LPRE": 0.0051, "AEJE": 0.0051, "DAUN": 0.0051, "ROSC": 0.0051, "BIAD": 0.0051,
+	"RALI": 0.0051, "ZALA": 0.0051, "LUTA": 0.0051, "DECÓ": 0.0051, "SINM": 0.0051,
+	"SADE": 0.0051, "LITE": 0.0051, "RÍAS": 0.0051, "RATI": 0.0051, "DOSO": 0.0051,
+	"ASTE": 0.0051, "VIER": 0.0051, "SUST": 0.0051, "MPIA": 0.0051, "EANO": 0.0051,
+	"ELEA": 0.0051, "ORAS": 0.0051, "EAPL": 0.0051, "OELE": 0.0051, "VESE": 0.0051,
+	"TILL": 0.0051, "NDOW": 0.0051, "VECO": 0.0051, "STAO": 0.0051, "PCIN": 0.0051,
+	"BRAN": 0.0051, "TRIC": 0.0050, "ECIM": 0.0050, "ETAB": 0.0050, "SINR": 0.0050,
+	"EPRI": 0.0050, "RUCT": 0.0050, "NEAE": 0.0050, "ORSE": 0.0050, "RIAA": 0.0050,
+	"EELP": 0.0050, "RIAD": 0.0050, "IZAE": 0.0050, "NPER": 0.0050, "EMAT": 0.0050,
+	"AMAA": 0.0050, "DEPI": 0.0050, "TART": 0.0050, "SDEG": 0.0050, "AUNR": 0.0050,
+	"RDET": 0.0050, "EMUL": 0.0050, "SERT": 0.0050, "NOAL": 0.0050, "ERPO": 0.0050,
+	"ELNU": 0.0050, "DEBO": 0.0050, "ENAC": 0.0050, "FALS": 0.0050, "YEND": 0.0050,
+	"RARO": 0.0050, "ANUA": 0.0050, "OSEI": 0.0050, "RATE": 0.0050, "SERI": 0.0050,
+	"SFAL": 0.0050, "MIDO": 0.0050, "VENO": 0.0050, "LAFI": 0.0050, "ETAI": 0.0050,
+	"RMEN": 0.0050, "TARJ": 0.0050, "ULAC": 0.0050, "OREV": 0.0050, "GITP": 0.0050,
+	"ECOL": 0.0049, "NERL": 0.0049, "UDOD": 0.0049, "GEST": 0.0049, "OEMP": 0.0049,
+	"NOFU": 0.0049, "ICIE": 0.0049, "UNAT": 0.0049, "RAAL": 0.0049, "OREQ": 0.0049,
+	"EUNI": 0.0049, "NESQ": 0.0049, "AINE": 0.0049, "LLÓE": 0.0049, "NALO": 0.0049,
+	"NESR": 0.0049, "ENUE": 0.0049, "OSSO": 0.0049, "NREQ": 0.0049, "STOE": 0.0049,
+	"IDAA": 0.0049, "ARSI": 0.0049, "VALA": 0.0049, "MOSE": 0.0049, "RCER": 0.0049,
+	"ABAR": 0.0049, "AUNF": 0.0049, "STAU": 0.0049, "SILA": 0.0049, "OSUS": 0.0049,
+	"TESC": 0.0049, "LOPE": 0.0049, "OALI": 0.0049, "NINS": 0.0049, "RUNR": 0.0049,
+	"IDAR": 0.0049, "TROP": 0.0049, "ROTA": 0.0049, "LOSV": 0.0049, "AMER": 0.0049,
+	"LAFE": 0.0049, "SULU": 0.0049, "ULUG": 0.0049, "PERF": 0.0049, "AELT": 0.0049,
+	"UNSE": 0.0049, "RAME": 0.0049, "IDON": 0.0049, "SFOR": 0.0049, "HTTP": 0.0049,
+	"DOWS": 0.0049, "ARPO": 0.0049, "NACL": 0.0049, "ARJE": 0.0049, "RJET": 0.0049,
+	"JETA": 0.0049, "NAPA": 0.0049, "AMBO": 0.0048, "DORP": 0.0048, "IAEL": 0.0048,
+	"ASHA": 0.0048, "NVIA": 0.0048, "AREJ": 0.0048, "ONER": 0.0048, "UNME": 0.0048,
+	"NDAT": 0.0048, "STED": 0.0048, "LPER": 0.0048, "EASD": 0.0048, "OSIG": 0.0048,
+	"ASOB": 0.0048, "IALD": 0.0048, "NNOA": 0.0048, "LEES": 0.0048, "HAES": 0.0048,
+	"ESAN": 0.0048, "YUNA": 0.0048, "NINC": 0.0048, "LDIS": 0.0048, "TAUR": 0.0048,
+	"EHAS": 0.0048, "TRAU": 0.0048, "TESQ": 0.0048, "TIME": 0.0048, "SUNT": 0.0048,
+	"CACO": 0.0048, "NOSU": 0.0048, "NLAO": 0.0048, "LDEC": 0.0048, "ÓNEX": 0.0048,
+	"LACU": 0.0048, "UNDA": 0.0048, "USTI": 0.0048, "IERA": 0.0048, "TUBE": 0.0048,
+	"NELT": 0.0048, "ENFO": 0.0048, "RAPR": 0.0048, "MEND": 0.0048, "LOLO": 0.0048,
+	"AREV": 0.0048, "DASA": 0.0048, "CTIO": 0.0048, "TRIN": 0.0048, "RING": 0.0048,
+	"POIN": 0.0048, "MACR": 0.0048, "NAPR": 0.0048, "OSIL": 0.0048, "VOSS": 0.0048,
+	"SDIN": 0.0048, "UELL": 0.0048, "ÓNLO": 0.0047, "NNUE": 0.0047, "ACÍA": 0.0047,
+	"ECOP": 0.0047, "DOMI": 0.0047, "LIEN": 0.0047, "DOHA": 0.0047, "UCIR": 0.0047,
+	"ENOC": 0.0047, "ADOM": 0.0047, "CAPE": 0.0047, "LOLE": 0.0047, "ASIM": 0.0047,
+	"TELO": 0.0047, "MOQU": 0.0047, "ÍSTI": 0.0047, "OUNF": 0.0047, "ENVE": 0.0047,
+	"AUTI": 0.0047, "BASA": 0.0047, "EBID": 0.0047, "DDES": 0.0047, "TÁCT": 0.0047,
+	"ÁCTI": 0.0047, "NDEX": 0.0047, "EMÁS": 0.0047, "NINI": 0.0047, "VOES": 0.0047,
+	"OARC": 0.0047, "ACOP": 0.0047, "LLES": 0.0047, "RASD": 0.0047, "OSUP": 0.0047,
+	"EELD": 0.0047, "UBER": 0.0047, "GAND": 0.0047, "CHAZ": 0.0047, "OLAD": 0.0047,
+	"UEUN": 0.0047, "GURO": 0.0047, "DUCA": 0.0047, "PDAT": 0.0047, "RIZA": 0.0047,
+	"ESET": 0.0047, "AALI": 0.0047, "LEMÁ": 0.0047, "EMÁN": 0.0047, "SENR": 0.0047,
+	"SCUA": 0.0047, "ATEC": 0.0047, "CHES": 0.0047, "EMPU": 0.0047, "MPUJ": 0.0047,
+	"COLU": 0.0046, "RCAS": 0.0046, "ULAD": 0.0046, "SESO": 0.0046, "ONCE": 0.0046,
+	"REJA": 0.0046, "UDOR": 0.0046, "DORS": 0.0046, "IÓUN": 0.0046, "GOTA": 0.0046,
+	"EFUN": 0.0046, "PEQU": 0.0046, "TESM": 0.0046, "RVAR": 0.0046, "ARED": 0.0046,
+	"ACIA": 0.0046, "REME": 0.0046, "MÁSA": 0.0046, "HAEN": 0.0046, "PERT": 0.0046,
+	"LESI": 0.0046, "UPOS": 0.0046, "DEHI": 0.0046, "ODEH": 0.0046, "ORPR": 0.0046,
+	"ANTA": 0.0046, "SCAN": 0.0046, "NELI": 0.0046, "LTAU": 0.0046, "OTER": 0.0046,
+	"ECOD": 0.0046, "DODI": 0.0046, "SUBI": 0.0046, "QUEI": 0.0046, "OMOC": 0.0046,
+	"NUAL": 0.0046, "DEOT": 0.0046, "SUMI": 0.0046, "SOUR": 0.0046, "AVEE": 0.0046,
+	"ELCU": 0.0046, "LETR": 0.0046, "VOLU": 0.0046, "VÍDE": 0.0046, "EMET": 0.0046,
+	"EPAC": 0.0046, "CHAS": 0.0046, "TAQU": 0.0046, "NCHO": 0.0046, "LAAL": 0.0046,
+	"ECKO": 0.0046, "CKOU": 0.0046, "KOUT": 0.0046, "ASMA": 0.0045, "ETEX": 0.0045,
+	"EIND": 0.0045, "IVID": 0.0045, "ACOL": 0.0045, "EROB": 0.0045, "RLAV": 0.0045,
+	"DEBÚ": 0.0045, "AENS": 0.0045, "NELL": 0.0045, "VORE": 0.0045, "ARPR": 0.0045,
+	"HAYN": 0.0045, "INVE": 0.0045, "ARAQ": 0.0045, "AANT": 0.0045, "UNFA": 0.0045,
+	"DDEU": 0.0045, "OLOP": 0.0045, "SEJE": 0.0045, "TRAI": 0.0045, "ERUT": 0.0045,
+	"ANSI": 0.0045, "EASU": 0.0045, "ÍTIC": 0.0045, "SIEM": 0.0045, "CCED": 0.0045,
+	"BREO": 0.0045, "ECAB": 0.0045, "AIMP": 0.0045, "EEXT": 0.0045, "DOQU": 0.0045,
+	"NÍND": 0.0045, "OSEU": 0.0045, "PLOD": 0.0045, "ASVA": 0.0045, "SVAR": 0.0045,
+	"EEFE": 0.0045, "ALTO": 0.0045, "ESVI": 0.0045, "STOD": 0.0045, "ADOY": 0.0045,
+	"AÚLT": 0.0045, "ESOR": 0.0045, "ELIS": 0.0045, "CANZ": 0.0045, "ALOC": 0.0045,
+	"NASO": 0.0045, "SUNN": 0.0045, "DERU": 0.0045, "UCID": 0.0045, "ZARS": 0.0045,
+	"IVAM": 0.0045, "OURC": 0.0045, "LCUA": 0.0045, "ÍDEO": 0.0045, "ENDS": 0.0045,
+	"CRET": 0.0045, "NRED": 0.0045, "ANTO": 0.0044, "ANIA": 0.0044, "INAS": 0.0044,
+	"RUNN": 0.0044, "NUAC": 0.0044, "DORN": 0.0044, "RASS": 0.0044, "CTAR": 0.0044,
+	"TEPE": 0.0044, "ADEO": 0.0044, "RLAD": 0.0044, "ACTA": 0.0044, "TEPO": 0.0044,
+	"IOSA": 0.0044, "TOSO": 0.0044, "RATO": 0.0044, "TOSN": 0.0044, "URSI": 0.0044,
+	"OESS": 0.0044, "PORY": 0.0044, "ALOB": 0.0044, "GOFU": 0.0044, "ESMU": 0.0044,
+	"OMAT": 0.0044, "ASIE": 0.0044, "EMPR": 0.0044, "DEAD": 0.0044, "NSIM": 0.0044,
+	"EXAD": 0.0044, "ITPA": 0.0044, "LREC": 0.0044, "ATOE": 0.0044, "SONI": 0.0044,
+	"ENIE": 0.0044, "OYAE": 0.0044, "EDIC": 0.0044, "IEST": 0.0044, "OAUN": 0.0044,
+	"EATR": 0.0044, "ZARU": 0.0044, "MENZ": 0.0044, "LABI": 0.0044, "ACHÉ": 0.0044,
+	"NMAN": 0.0044, "EUNR": 0.0044, "FIER": 0.0044, "OGRE": 0.0044, "NABI": 0.0044,
+	"URCE": 0.0044, "IOES": 0.0044, "AREP": 0.0044, "OSSU": 0.0044, "RETR": 0.0044,
+	"TEGI": 0.0044, "OSEX": 0.0044, "ODIN": 0.0044, "TUAR": 0.0044, "UPST": 0.0044,
+	"NSAC": 0.0044, "GARE": 0.0044, "EELS": 0.0044, "NNOP": 0.0044, "OFIN": 0.0044,
+	"STÁI": 0.0044, "LAMI": 0.0044, "CECO": 0.0044, "NUNE": 0.0044, "LCUL": 0.0044,
+	"RARD": 0.0044, "XACT": 0.0044, "DAEL": 0.0044, "DOPR": 0.0044, "LASF": 0.0044,
+	"MOPA": 0.0044, "MILL": 0.0044, "ILAR": 0.0044, "GUIÓ": 0.0044, "UIÓN": 0.0044,
+	"EROG": 0.0044, "EUNS": 0.0044, "ÍADE": 0.0044, "ALIM": 0.0044, "AURA": 0.0044,
+	"UBUN": 0.0044, "NANT": 0.0044, "ILOS": 0.0044, "VITA": 0.0044, "APAC": 0.0044,
+	"ERGE": 0.0044, "NTAA": 0.0044, "ILEN": 0.0044, "ASAR": 0.0044, "ESAB": 0.0044,
+	"ITDE": 0.0044, "RARP": 0.0044, "OLVI": 0.0044, "ICEE": 0.0044, "JUST": 0.0044,
+	"OSEM": 0.0044, "DOOP": 0.0044, "NMÚL": 0.0044, "AMAE": 0.0044, "AIND": 0.0044,
+	"NÚCL": 0.0044, "ÚCLE": 0.0044, "CLEO": 0.0044, "SNOR": 0.0044, "MARE": 0.0044,
+	"MAPA": 0.0044, "EPIN": 0.0044, "ODUJ": 0.0044, "JOUN": 0.0044, "LAÚL": 0.0044,
+	"VOPA": 0.0044, "UPDA": 0.0044, "ORIZ": 0.0044, "NESL": 0.0044, "LIZÓ": 0.0044,
+	"ADEG": 0.0044, "IERR": 0.0044, "NREF": 0.0044, "IASP": 0.0044, "ACRO": 0.0044,
+	"RREF": 0.0044, "ITMA": 0.0044, "SESC": 0.0043, "SMEN": 0.0043, "LUMN": 0.0043,
+	"DASS": 0.0043, "ADOF": 0.0043, "OMIN": 0.0043, "IRDE": 0.0043, "RLAP": 0.0043,
+	"UCTU": 0.0043, "STEA": 0.0043, "RDAT": 0.0043, "EBÚS": 0.0043, "ASUR": 0.0043,
+	"ORDI": 0.0043, "REPE": 0.0043, "UPRI": 0.0043, "ECOI": 0.0043, "OAUT": 0.0043,
+	"NALÍ": 0.0043, "AENU": 0.0043, "OSBI": 0.0043, "EDID": 0.0043, "BARR": 0.0043,
+	"NASI": 0.0043, "NMÁS": 0.0043, "ENST": 0.0043, "ESNE": 0.0043, "ERNE": 0.0043,
+	"RVAL": 0.0043, "NALM": 0.0043, "ARMA": 0.0043, "DOBL": 0.0043, "CAEN": 0.0043,
+	"OTAM": 0.0043, "NUSA": 0.0043, "OINM": 0.0043, "INTÁ": 0.0043, "NTÁC": 0.0043,
+	"CTIC": 0.0043, "ÍASE": 0.0043, "TOFU": 0.0043, "LSIG": 0.0043, "DELG": 0.0043,
+	"PONO": 0.0043, "IÓNB": 0.0043, "UEAR": 0.0043, "OSET": 0.0043, "IÓNU": 0.0043,
+	"ISMA": 0.0043, "QUEÑ": 0.0043, "LEAN": 0.0043, "IGNI": 0.0043, "RDAR": 0.0043,
+	"IENZ": 0.0043, "POSE": 0.0043, "TREM": 0.0043, "NOPA": 0.0043, "MAPE": 0.0043,
+	"CIPA": 0.0043, "ESON": 0.0043, "RUSO": 0.0043, "CINT": 0.0043, "TOSH": 0.0043,
+	"EMIS": 0.0043, "TAAL": 0.0043, "ATIN": 0.0043, "RIOG": 0.0043, "TEFI": 0.0043,
+	"OAME": 0.0043, "MÉTO": 0.0042, "ÉTOD": 0.0042, "EEXC": 0.0042, "BIER": 0.0042,
+	"OOBT": 0.0042, "TEAR": 0.0042, "OBLO": 0.0042, "IOSC": 0.0042, "CASE": 0.0042,
+	"MONO": 0.0042, "SEXT": 0.0042, "EPAT": 0.0042, "ONCA": 0.0042, "TESN": 0.0042,
+	"UCLE": 0.0042, "ALDI": 0.0042, "TOQU": 0.0042, "OSAN": 0.0042, "ASEX": 0.0042,
+	"IAIN": 0.0042, "SMÚL": 0.0042, "EUNE": 0.0042, "IGOF": 0.0042, "APOD": 0.0042,
+	"BUNT": 0.0042, "PREG": 0.0042, "SMAL": 0.0042, "ONAC": 0.0042, "CREM": 0.0042,
+	"TROC": 0.0042, "LORA": 0.0042, "AUND": 0.0042, "AERR": 0.0042, "IPLO": 0.0042,
+	"ARAH": 0.0042, "SUEL": 0.0042, "VAEL": 0.0042, "NTET": 0.0042, "ANLA": 0.0042,
+	"ORLO": 0.0042, "ABAN": 0.0042, "AELO": 0.0042, "USTA": 0.0042, "LDEF": 0.0042,
+	"NZAR": 0.0042, "DUJO": 0.0042, "LUIR": 0.0042, "ALVE": 0.0042, "ÚNNO": 0.0042,
+	"VAME": 0.0042, "LANA": 0.0042, "GARO": 0.0042, "SMOD": 0.0042, "RELÍ": 0.0042,
+	"COMÚ": 0.0042, "OMÚN": 0.0042, "TOAL": 0.0042, "AFRA": 0.0042, "ISEC": 0.0042,
+	"BISE": 0.0042, "RGIT": 0.0042, "RRAN": 0.0041, "SMAR": 0.0041, "ELOR": 0.0041,
+	"UMNA": 0.0041, "OPUD": 0.0041, "SCRE": 0.0041, "SHAB": 0.0041, "RLAL": 0.0041,
+	"EELM": 0.0041, "RADU": 0.0041, "RVIC": 0.0041, "INPR": 0.0041, "ENME": 0.0041,
+	"LTAL": 0.0041, "ELTO": 0.0041, "LOMI": 0.0041, "SERA": 0.0041, "ESLI": 0.0041,
+	"ARER": 0.0041, "YDES": 0.0041, "OSIS": 0.0041, "ONRE": 0.0041, "NNIN": 0.0041,
+	"OPRI": 0.0041, "MPEN": 0.0041, "RECA": 0.0041, "ASAC": 0.0041, "OESI": 0.0041,
+	"ICAU": 0.0041, "STÁV": 0.0041, "IJOS": 0.0041, "GNAR": 0.0041, "ODID": 0.0041,
+	"NUTI": 0.0041, "IVAA": 0.0041, "BENS": 0.0041, "MAEN": 0.0041, "IGOS": 0.0041,
+	"LIDE": 0.0041, "IDAO": 0.0041, "SORE": 0.0041, "SOEL": 0.0041, "RAER": 0.0041,
+	"OORI": 0.0041, "RINM": 0.0041, "IDAL": 0.0041, "LINK": 0.0041, "NGRU": 0.0041,
+	"STON": 0.0041, "ONOD": 0.0041, "XCEP": 0.0041, "UNOS": 0.0041, "SAME": 0.0041,
+	"RUIR": 0.0041, "EACO": 0.0041, "MALE": 0.0041, "RLDE": 0.0041, "ESMÁ": 0.0041,
+	"HAZA": 0.0041, "ALAT": 0.0041, "UEÑO": 0.0041, "EAPP": 0.0041, "MALO": 0.0041,
+	"ODAV": 0.0041, "DAVÍ": 0.0041, "AVÍA": 0.0041, "BIOD": 0.0041, "TAOP": 0.0041,
+	"SREU": 0.0041, "CANC": 0.0041, "ÓALA": 0.0041, "DADC": 0.0041, "ZAUN": 0.0041,
+	"DWAR": 0.0041, "IASI": 0.0041, "OMAR": 0.0041, "ANCÉ": 0.0041, "NCÉS": 0.0041,
+	"NDEV": 0.0041, "MOTE": 0.0041, "AMOS": 0.0041, "INAP": 0.0041, "RABI": 0.0041,
+	"ZACO": 0.0041, "SALV": 0.0041, "EPAS": 0.0041, "UBCL": 0.0041, "BCLA": 0.0041,
+	"PUER": 0.0040, "STOP": 0.0040, "ENEG": 0.0040, "DADI": 0.0040, "EXAC": 0.0040,
+	"ERDI": 0.0040, "YCON": 0.0040, "IAAG": 0.0040, "TREC": 0.0040, "OMIL": 0.0040,
+	"SMOQ": 0.0040, "TOST": 0.0040, "OTIP": 0.0040, "YNIN": 0.0040, "NESF": 0.0040,
+	"SPAL": 0.0040, "DENL": 0.0040, "ÁDES": 0.0040, "SDIF": 0.0040, "MPLI": 0.0040,
+	"EHAP": 0.0040, "RAFI": 0.0040, "AAUN": 0.0040, "LNOE": 0.0040, "ALEC": 0.0040,
+	"NDEO": 0.0040, "ERAT": 0.0040, "AJUS": 0.0040, "RASL": 0.0040, "RIOY": 0.0040,
+	"LLID": 0.0040, "NDEU": 0.0040, "BUIL": 0.0040, "EVIT": 0.0040, "SARA": 0.0040,
+	"RAEX": 0.0040, "UJOU": 0.0040, "GNIF": 0.0040, "PREP": 0.0040, "ÓNTI": 0.0040,
+	"PROX": 0.0040, "ALAU": 0.0040, "SSEG": 0.0040, "AVEC": 0.0040, "ATEG": 0.0040,
+	"DUCE": 0.0040, "TAGI": 0.0040, "NREU": 0.0040, "RENA": 0.0040, "OSTU": 0.0040,
+	"TRIZ": 0.0040, "ANOV": 0.0039, "MARI": 0.0039, "ENEJ": 0.0039, "ATIO": 0.0039,
+	"NDEG": 0.0039, "ISOD": 0.0039, "RELU": 0.0039, "TDEL": 0.0039, "ATUS": 0.0039,
+	"OSAS": 0.0039, "AAGO": 0.0039, "IAPA": 0.0039, "AELR": 0.0039, "OMON": 0.0039,
+	"SESA": 0.0039, "DAFI": 0.0039, "UPON": 0.0039, "ALSO": 0.0039, "ESFI": 0.0039,
+	"DAIN": 0.0039, "OSMA": 0.0039, "ATER": 0.0039, "QUEH": 0.0039, "ESTÉ": 0.0039,
+	"SATR": 0.0039, "ÁVAC": 0.0039, "ACOR": 0.0039, "IATA": 0.0039, "THUM": 0.0039,
+	"EREM": 0.0039, "LEVA": 0.0039, "AMOD": 0.0039, "ADOO": 0.0039, "PAND": 0.0039,
+	"OTAC": 0.0039, "EUNN": 0.0039, "AACC": 0.0039, "AMES": 0.0039, "ADOT": 0.0039,
+	"ELIG": 0.0039, "TRÓU": 0.0039, "OSPU": 0.0039, "VELD": 0.0039, "ICES": 0.0039,
+	"ETAE": 0.0039, "OMOA": 0.0039, "ROQU": 0.0039, "APTU": 0.0039, "SARP": 0.0039,
+	"BECO": 0.0039, "EMOV": 0.0039, "CIAN": 0.0039, "LVEC": 0.0039, "ACKE": 0.0039,
+	"FUND": 0.0039, "LREM": 0.0039, "OMMO": 0.0039, "GRAB": 0.0039, "IRPA": 0.0039,
+	"AIMA": 0.0039, "NAOR": 0.0039, "UNOP": 0.0039, "SACI": 0.0039, "AARG": 0.0039,
+	"ATCH": 0.0039, "IAME": 0.0039, "TNOT": 0.0039, "EDIF": 0.0039, "TAGS": 0.0039,
+	"DVOR": 0.0039, "ORAK": 0.0039, "NCAS": 0.0038, "DOFI": 0.0038, "NREC": 0.0038,
+	"ÓALC": 0.0038, "ELPU": 0.0038, "EAEN": 0.0038, "RIOL": 0.0038, "IMAD": 0.0038,
+	"VICI": 0.0038, "TYPE": 0.0038, "LESQ": 0.0038, "PERD": 0.0038, "SURA": 0.0038,
+	"AALF": 0.0038, "OREP": 0.0038, "ESOD": 0.0038, "SNOC": 0.0038, "TOSM": 0.0038,
+	"SENM": 0.0038, "LINI": 0.0038, "RASC": 0.0038, "IAEN": 0.0038, "GARA": 0.0038,
+	"MOIN": 0.0038, "AMAC": 0.0038, "ÓALE": 0.0038, "CIAI": 0.0038, "ERCE": 0.0038,
+	"CONR": 0.0038, "OSAT": 0.0038, "TÁVA": 0.0038, "ENUM": 0.0038, "ALUS": 0.0038,
+	"ÓNIM": 0.0038, "DEUT": 0.0038, "RORN": 0.0038, "IDAT": 0.0038, "ESCE": 0.0038,
+	"SCER": 0.0038, "SERD": 0.0038, "RMED": 0.0038, "LMAN": 0.0038, "RÓUN": 0.0038,
+	"NAEL": 0.0038, "IVOM": 0.0038, "ERÁN": 0.0038, "OMAL": 0.0038, "AMIS": 0.0038,
+	"LDED": 0.0038, "DESV": 0.0038, "EÍND": 0.0038, "ROMP": 0.0038, "ULTE": 0.0038,
+	"CAQU": 0.0038, "ITUA": 0.0038, "TABA": 0.0038, "NSUB": 0.0038, "NEDO": 0.0038,
+	"ETOE": 0.0038, "ROXY": 0.0038, "YCOM": 0.0038, "OBAR": 0.0038, "RAOB": 0.0038,
+	"NLAI": 0.0038, "NIRE": 0.0038, "ENAP": 0.0038, "NPRI": 0.0038, "ICOA": 0.0038,
+	"IASA": 0.0038, "LOPU": 0.0038, "PORS": 0.0038, "IALA": 0.0038, "ONIO": 0.0038,
+	"EUSO": 0.0038, "NEAN": 0.0038, "VARE": 0.0038, "AURL": 0.0038, "HUMB": 0.0038,
+	"NUNS": 0.0038, "PUSH": 0.0038, "ÁREA": 0.0038, "GITN": 0.0038, "ERRY": 0.0038,
+	"NASD": 0.0037, "SUNI": 0.0037, "INOE": 0.0037, "HAYS": 0.0037, "LSOC": 0.0037,
+	"EALT": 0.0037, "VIAR": 0.0037, "NDIN": 0.0037, "UFIC": 0.0037, "TINA": 0.0037,
+	"XITO": 0.0037, "SSEL": 0.0037, "IDAM": 0.0037, "ADRE": 0.0037, "RSIV": 0.0037,
+	"EGNU": 0.0037, "REMA": 0.0037, "RERR": 0.0037, "ELON": 0.0037, "GUIR": 0.0037,
+	"ÓNAC": 0.0037, "SAPA": 0.0037, "SNEC": 0.0037, "NFIR": 0.0037, "NIMP": 0.0037,
+	"IRAL": 0.0037, "ERRU": 0.0037, "MIPS": 0.0037, "OILE": 0.0037, "SETI": 0.0037,
+	"UYEN": 0.0037, "SUNO": 0.0037, "CAUS": 0.0037, "UNAM": 0.0037, "MEZC": 0.0037,
+	"EZCL": 0.0037, "ZCLA": 0.0037, "UILD": 0.0037, "RAAC": 0.0037, "VECE": 0.0037,
+	"TÁNE": 0.0037, "RZAR": 0.0037, "CATE": 0.0037, "DESÓ": 0.0037, "IRES": 0.0037,
+	"RFAZ": 0.0037, "RUNO": 0.0037, "IRCO": 0.0037, "IPAL": 0.0037, "ETON": 0.0037,
+	"IZAU": 0.0037, "OSOL": 0.0037, "DPAR": 0.0037, "DAQU": 0.0037, "STUB": 0.0037,
+	"FERI": 0.0037, "TRES": 0.0037, "TDES": 0.0037, "ANÉS": 0.0037, "RLAN": 0.0037,
+	"ESME": 0.0037, "TCOM": 0.0037, "AALT": 0.0037, "AÑOS": 0.0037, "ÓLOE": 0.0037,
+	"OLLA": 0.0037, "CORD": 0.0037, "LESY": 0.0037, "MBOS": 0.0037, "EAIN": 0.0037,
+	"MODU": 0.0037, "VAEN": 0.0037, "STUR": 0.0037, "RREU": 0.0037, "ITCH": 0.0037,
+	"LONA": 0.0037, "ISIS": 0.0036, "LNOR": 0.0036, "ARDO": 0.0036, "DOSU": 0.0036,
+	"RPER": 0.0036, "OPLA": 0.0036, "RIVA": 0.0036, "RUNP": 0.0036, "NESU": 0.0036,
+	"RNON": 0.0036, "ELNI": 0.0036, "ABAE": 0.0036, "NENU": 0.0036, "NGUI": 0.0036,
+	"BASU": 0.0036, "LUEG": 0.0036, "IBIE": 0.0036, "MOPR": 0.0036, "IGUE": 0.0036,
+	"POES": 0.0036, "BUCL": 0.0036, "AYNI": 0.0036, "SIVA": 0.0036, "ISDE": 0.0036,
+	"NNOT": 0.0036, "AAYU": 0.0036, "PALD": 0.0036, "SAUN": 0.0036, "UITA": 0.0036,
+	"SEMA": 0.0036, "ASCL": 0.0036, "VEZD": 0.0036, "ALMI": 0.0036, "SLAR": 0.0036,
+	"TEDI": 0.0036, "DOAR": 0.0036, "PULS": 0.0036, "NTAJ": 0.0036, "TAJE": 0.0036,
+	"ENOD": 0.0036, "EPET": 0.0036, "EABI": 0.0036, "NOSA": 0.0036, "OMOR": 0.0036,
+	"RART": 0.0036, "RDED": 0.0036, "RAOP": 0.0036, "RDAN": 0.0036, "OELD": 0.0036,
+	"DOSN": 0.0036, "EADD": 0.0036, "INTÉ": 0.0036, "IZAL": 0.0036, "ÓNEA": 0.0036,
+	"ÓNLA": 0.0036, "EUNF": 0.0036, "LAUN": 0.0036, "RSOS": 0.0036, "INVO": 0.0036,
+	"MASC": 0.0036, "NTOT": 0.0036, "ACED": 0.0036, "MOEN": 0.0036, "HECO": 0.0036,
+	"ADIN": 0.0036, "EAME": 0.0036, "NORD": 0.0036, "UNAN": 0.0036, "ÓNAB": 0.0036,
+	"ODUL": 0.0036, "EREI": 0.0036, "CASI": 0.0036, "NELÁ": 0.0036, "ASÓL": 0.0036,
+	"TCHE": 0.0036, "OUNI": 0.0036, "TNOE": 0.0036, "LESN": 0.0036, "TARU": 0.0036,
+	"EELR": 0.0036, "IDES": 0.0036, "EEND": 0.0036, "EHAR": 0.0036, "ELAU": 0.0036,
+	"TING": 0.0036, "SAMI": 0.0036, "OBIN": 0.0036, "ANOC": 0.0036, "NLAB": 0.0036,
+	"DOUT": 0.0036, "NDOM": 0.0036, "UIRL": 0.0036, "AHAS": 0.0036, "NOSD": 0.0036,
+	"TOAM": 0.0036, "ANES": 0.0036, "EPIL": 0.0036, "TÁAC": 0.0036, "SUTI": 0.0036,
+	"USER": 0.0036, "ESOH": 0.0036, "LAQU": 0.0036, "PANT": 0.0036, "ITIE": 0.0036,
+	"NODI": 0.0036, "SSED": 0.0036, "NDIZ": 0.0036, "DIZA": 0.0036, "ELOP": 0.0036,
+	"IRRE": 0.0036, "OMOM": 0.0036, "LACT": 0.0036, "TASI": 0.0036, "NOCA": 0.0036,
+	"EMOS": 0.0036, "REAS": 0.0036, "EGRA": 0.0036, "SEEL": 0.0036, "IOLA": 0.0036,
+	"EXPI": 0.0036, "XPIR": 0.0036, "NDIF": 0.0036, "DEÍN": 0.0036, "PTIM": 0.0036,
+	"TOSU": 0.0036, "REDO": 0.0036, "NBLO": 0.0036, "CESE": 0.0036, "BREV": 0.0036,
+	"CUAD": 0.0036, "EXPL": 0.0036, "ACTO": 0.0036, "NCEL": 0.0036, "AVÉS": 0.0036,
+	"EANA": 0.0036, "SORD": 0.0036, "SENB": 0.0036, "LEJE": 0.0036, "RUND": 0.0036,
+	"LEQU": 0.0036, "ADCO": 0.0036, "ALFA": 0.0036, "SBLO": 0.0036, "HARD": 0.0036,
+	"MOTA": 0.0036, "RNAC": 0.0036, "MUCH": 0.0036, "LENA": 0.0036, "CEPA": 0.0036,
+	"RAFO": 0.0036, "APPS": 0.0036, "PPST": 0.0036, "LANU": 0.0036, "MERG": 0.0036,
+	"NTEF": 0.0036, "EEUU": 0.0036, "BITM": 0.0036, "DULE": 0.0036, "SREF": 0.0036,
+	"OCSP": 0.0036, "OOST": 0.0036, "NÉTI": 0.0036, "NLOC": 0.0035, "TREY": 0.0035,
+	"STIE": 0.0035, "HAYA": 0.0035, "CEUN": 0.0035, "LPUN": 0.0035, "ESNU": 0.0035,
+	"RARN": 0.0035, "XIMA": 0.0035, "BREA": 0.0035, "PORP": 0.0035, "TATU": 0.0035,
+	"AOBT": 0.0035, "UIRE": 0.0035, "SINP": 0.0035, "ROPR": 0.0035, "ESIO": 0.0035,
+	"NLÍN": 0.0035, "LESM": 0.0035, "TRÁS": 0.0035, "SNUE": 0.0035, "RAMÁ": 0.0035,
+	"ESIE": 0.0035, "ICEP": 0.0035, "LOBT": 0.0035, "SCAD": 0.0035, "RAPO": 0.0035,
+	"RINS": 0.0035, "TETI": 0.0035, "NOMO": 0.0035, "STÁM": 0.0035, "DOMA": 0.0035,
+	"SACA": 0.0035, "EACU": 0.0035, "DEMI": 0.0035, "DASC": 0.0035, "ECIS": 0.0035,
+	"IJOD": 0.0035, "ENET": 0.0035, "ÓNAU": 0.0035, "TROO": 0.0035, "ROEX": 0.0035,
+	"AQUÍ": 0.0035, "AMAL": 0.0035, "EAEL": 0.0035, "LOUN": 0.0035, "USOS": 0.0035,
+	"MAQU": 0.0035, "LÓEL": 0.0035, "SETE": 0.0035, "SECA": 0.0035, "NTEG": 0.0035,
+	"ARÁN": 0.0035, "RANU": 0.0035, "TOEL": 0.0035, "RGEN": 0.0035, "ERMA": 0.0035,
+	"ERAP": 0.0035, "OARG": 0.0035, "NLAV": 0.0035, "TIRA": 0.0035, "EAPA": 0.0035,
+	"ICAQ": 0.0035, "EGIO": 0.0035, "NERU": 0.0035, "OLOI": 0.0035, "DEJA": 0.0035,
+	"NIMO": 0.0035, "RAVÉ": 0.0035, "VÉSD": 0.0035, "AMAY": 0.0035, "SETR": 0.0035,
+	"GUIN": 0.0035, "CHIN": 0.0035, "VACO": 0.0035, "ISIO": 0.0035, "OWER": 0.0035,
+	"OTOD": 0.0035, "RMÁS": 0.0035, "EUND": 0.0035, "GNAD": 0.0035, "CABO": 0.0035,
+	"SASI": 0.0035, "GIDO": 0.0035, "ALTI": 0.0035, "ONIV": 0.0035, "IENO": 0.0035,
+	"GITB": 0.0035, "FONÉ": 0.0035, "ONÉT": 0.0035, "UCIN": 0.0035, "JOOS": 0.0035,
+	"NIDE": 0.0034, "RUNS": 0.0034, "ÁSOP": 0.0034, "HAYC": 0.0034, "RDEV": 0.0034,
+	"TÁPE": 0.0034, "ÁPER": 0.0034, "INEN": 0.0034, "SERO": 0.0034, "NGOD": 0.0034,
+	"CHAN": 0.0034, "OELS": 0.0034, "NAES": 0.0034, "NALS": 0.0034, "RAÍZ": 0.0034,
+	"OENV": 0.0034, "ATUR": 0.0034, "IBID": 0.0034, "ÓCON": 0.0034, "ERPA": 0.0034,
+	"RVER": 0.0034, "NINE": 0.0034, "NLAP": 0.0034, "APOS": 0.0034, "ISEE": 0.0034,
+	"ETAC": 0.0034, "NPAT": 0.0034, "SIMI": 0.0034, "INAE": 0.0034, "TURO": 0.0034,
+	"DUCC": 0.0034, "ÁACT": 0.0034, "STDI": 0.0034, "LESO": 0.0034, "GPAR": 0.0034,
+	"SNOP": 0.0034, "VORI": 0.0034, "AGRU": 0.0034, "TATO": 0.0034, "AOTR": 0.0034,
+	"SNOT": 0.0034, "ADOB": 0.0034, "ACID": 0.0034, "MALL": 0.0034, "EHAD": 0.0034,
+	"NABS": 0.0034, "NSEP": 0.0034, "RACE": 0.0034, "LUID": 0.0034, "DEAT": 0.0034,
+	"MÁSC": 0.0034, "TROA": 0.0034, "TÁDI": 0.0034, "ÁDIS": 0.0034, "RDIF": 0.0034,
+	"NCHU": 0.0034, "RLAF": 0.0034, "SELA": 0.0034, "DOVA": 0.0034, "LPAT": 0.0034,
+	"NCUA": 0.0034, "LÚLT": 0.0034, "EIGN": 0.0034, "EUNO": 0.0034, "VIOL": 0.0034,
+	"ECUP": 0.0034, "CUPE": 0.0034, "UERZ": 0.0034, "ERZA": 0.0034, "DROM": 0.0034,
+	"OSEG": 0.0034, "LSIN": 0.0034, "OVAR": 0.0034, "RUSU": 0.0034, "ESMO": 0.0034,
+	"ONUE": 0.0034, "DUCT": 0.0034, "LUTO": 0.0034, "NAFI": 0.0034, "SEQU": 0.0034,
+	"NORU": 0.0034, "EBAN": 0.0034, "CAPT": 0.0034, "IPOR": 0.0034, "DEEF": 0.0034,
+	"MUNE": 0.0034, "CEQU": 0.0034, "AZAN": 0.0034, "BLOB": 0.0034, "LESR": 0.0033,
+	"RIEN": 0.0033, "NGAR": 0.0033, "ERRI": 0.0033, "CERD": 0.0033, "TÁSO": 0.0033,
+	"RÍAN": 0.0033, "COLE": 0.0033, "NELB": 0.0033, "ASEA": 0.0033, "EBET": 0.0033,
+	"AUNP": 0.0033, "ASFU": 0.0033, "APLA": 0.0033, "OELN": 0.0033, "SHOW": 0.0033,
+	"NMUE": 0.0033, "TALD": 0.0033, "ERET": 0.0033, "YÚSC": 0.0033, "LOAD": 0.0033,
+	"CURR": 0.0033, "ITEU": 0.0033, "PTIO": 0.0033, "LARD": 0.0033, "DIER": 0.0033,
+	"LENE": 0.0033, "UEHA": 0.0033, "LOPR": 0.0033, "IACO": 0.0033, "HAPO": 0.0033,
+	"GUNT": 0.0033, "TREE": 0.0033, "COEN": 0.0033, "CIAP": 0.0033, "AEMP": 0.0033,
+	"NOUN": 0.0033, "SERP": 0.0033, "OALO": 0.0033, "ORCE": 0.0033, "CEEN": 0.0033,
+	"AVAL": 0.0033, "COSE": 0.0033, "DCON": 0.0033, "ETEP": 0.0033, "LDEB": 0.0033,
+	"LOIN": 0.0033, "NZAN": 0.0033, "RALG": 0.0033, "OESM": 0.0033, "REDU": 0.0033,
+	"NAMA": 0.0033, "ANAD": 0.0033, "RBYT": 0.0033, "NELÍ": 0.0033, "IÓNH": 0.0033,
+	"LARO": 0.0033, "TESR": 0.0033, "UCIO": 0.0033, "CDRO": 0.0033, "SUMA": 0.0033,
+	"TAAR": 0.0033, "RIVI": 0.0033, "SELL": 0.0033, "ASUS": 0.0033, "TALV": 0.0033,
+	"AELU": 0.0033, "SALL": 0.0033, "TACA": 0.0033, "TIRE": 0.0033, "ASSO": 0.0033,
+	"VECT": 0.0033, "UEPR": 0.0033, "IARA": 0.0033, "LIAR": 0.0033, "UALD": 0.0033,
+	"GITO": 0.0033, "TARR": 0.0033, "WORK": 0.0033, "ANGE": 0.0033, "UMAN": 0.0033,
+	"ELET": 0.0033, "MICR": 0.0033, "NTOO": 0.0033, "ASET": 0.0033, "PTUR": 0.0033,
+	"EOMI": 0.0033, "OMET": 0.0033, "TOPR": 0.0033, "HAFA": 0.0033, "ESUE": 0.0033,
+	"OELT": 0.0033, "REDA": 0.0033, "IMIZ": 0.0033, "MIZA": 0.0033, "ÁDEF": 0.0033,
+	"ABIT": 0.0033, "ALSÍ": 0.0033, "FREN": 0.0033, "PORR": 0.0033, "HUEL": 0.0033,
+	"RITY": 0.0033, "TMAP": 0.0033, "LNEA": 0.0033, "IBIÓ": 0.0032, "SMAY": 0.0032,
+	"TOPU": 0.0032, "ALIR": 0.0032, "NEAL": 0.0032, "CALC": 0.0032, "SDEH": 0.0032,
+	"ICAP": 0.0032, "ANOR": 0.0032, "AMIN": 0.0032, "ESEX": 0.0032, "TODI": 0.0032,
+	"ODOE": 0.0032, "MBIG": 0.0032, "BIGU": 0.0032, "OLOA": 0.0032, "ÁSIN": 0.0032,
+	"LDER": 0.0032, "EALG": 0.0032, "AJOA": 0.0032, "DARD": 0.0032, "NSIO": 0.0032,
+	"ELAM": 0.0032, "NOAC": 0.0032, "RALP": 0.0032, "SUNF": 0.0032, "PUDI": 0.0032,
+	"SUBP": 0.0032, "ÁSRE": 0.0032, "EERD": 0.0032, "ESAP": 0.0032, "ARVA": 0.0032,
+	"OINF": 0.0032, "SCAS": 0.0032, "ATAD": 0.0032, "DASO": 0.0032, "OHIJ": 0.0032,
+	"CTRL": 0.0032, "TATE": 0.0032, "LLOD": 0.0032, "QUIZ": 0.0032, "UIZÁ": 0.0032,
+	"TSDE": 0.0032, "NOCR": 0.0032, "SBYT": 0.0032, "ORPO": 0.0032, "RAHA": 0.0032,
+	"EAÑA": 0.0032, "MPLÍ": 0.0032, "MAKE": 0.0032, "ITAM": 0.0032, "TIBI": 0.0032,
+	"TOCA": 0.0032, "VOEN": 0.0032, "OELA": 0.0032, "RGAN": 0.0032, "NTEQ": 0.0032,
+	"ADNO": 0.0032, "GENT": 0.0032, "RDOS": 0.0032, "CERU": 0.0032, "NESV": 0.0032,
+	"GNAC": 0.0032, "ALCE": 0.0032, "ADAI": 0.0032, "ELER": 0.0032, "LBLO": 0.0032,
+	"PERU": 0.0032, "UTOD": 0.0032, "ORUT": 0.0032, "DÍGI": 0.0032, "ÍGIT": 0.0032,
+	"ASAP": 0.0032, "LIDD": 0.0032, "ETOP": 0.0032, "ORUS": 0.0032, "OMOF": 0.0032,
+	"LDEM": 0.0032, "MAIL": 0.0032, "DOUS": 0.0032, "EROY": 0.0032, "SMET": 0.0032,
+	"ITUC": 0.0032, "TUCI": 0.0032, "EFIE": 0.0032, "EINO": 0.0032, "ENCE": 0.0032,
+	"VEEL": 0.0032, "IORD": 0.0032, "RPAQ": 0.0032, "LOQM": 0.0032, "OQMA": 0.0032,
+	"QMAY": 0.0032, "NORT": 0.0031, "TÁIN": 0.0031, "ABIE": 0.0031, "UDOI": 0.0031,
+	"IARD": 0.0031, "RNOP": 0.0031, "TROB": 0.0031, "CONI": 0.0031, "CRON": 0.0031,
+	"HAYR": 0.0031, "AINI": 0.0031, "UNIN": 0.0031, "OCÓD": 0.0031, "AMUE": 0.0031,
+	"ROSQ": 0.0031, "NEXP": 0.0031, "DENP": 0.0031, "ORCA": 0.0031, "ÁNDO": 0.0031,
+	"ASUN": 0.0031, "NNOR": 0.0031, "ILAC": 0.0031, "NALP": 0.0031, "LEEL": 0.0031,
+	"DOEX": 0.0031, "SÓRD": 0.0031, "ALDO": 0.0031, "ÓNCA": 0.0031, "ETAP": 0.0031,
+	"UDIE": 0.0031, "ANLO": 0.0031, "NUAR": 0.0031, "ÓNAN": 0.0031, "RATR": 0.0031,
+	"VERE": 0.0031, "AABI": 0.0031, "CEIN": 0.0031, "NÓNI": 0.0031, "EARS": 0.0031,
+	"EROH": 0.0031, "UNÍN": 0.0031, "EMAE": 0.0031, "NAET": 0.0031, "LEAD": 0.0031,
+	"SERB": 0.0031, "EANC": 0.0031, "UNGR": 0.0031, "UEIN": 0.0031, "YPAR": 0.0031,
+	"ESYS": 0.0031, "ELOD": 0.0031, "TASA": 0.0031, "IOSO": 0.0031, "PODR": 0.0031,
+	"SALE": 0.0031, "RRID": 0.0031, "LAIM": 0.0031, "RSÍM": 0.0031, "ELPE": 0.0031,
+	"TANC": 0.0031, "ATUB": 0.0031, "NNOC": 0.0031, "NDLE": 0.0031, "LAAC": 0.0031,
+	"NELO": 0.0031, "NAUT": 0.0031, "ELUR": 0.0031, "ITAE": 0.0031, "ACOD": 0.0031,
+	"DADN": 0.0031, "LFLU": 0.0031, "UCTO": 0.0031, "APEA": 0.0031, "ELFL": 0.0031,
+	"QUIP": 0.0031, "UIPO": 0.0031, "NZAB": 0.0031, "OESA": 0.0031, "AROD": 0.0031,
+	"NEXT": 0.0031, "ANSF": 0.0031, "INSI": 0.0031, "NANU": 0.0031, "ECOS": 0.0031,
+	"HOJA": 0.0031, "ICRO": 0.0031, "VADE": 0.0031, "ICOP": 0.0031, "ROLO": 0.0031,
+	"ADAR": 0.0031, "NONE": 0.0031, "OBOR": 0.0031, "ROST": 0.0031, "ORTO": 0.0031,
+	"SELN": 0.0031, "NTÉR": 0.0031, "SNÚM": 0.0031, "APAL": 0.0031, "RASÍ": 0.0031,
+	"CARD": 0.0031, "ODOI": 0.0031, "LÁRE": 0.0031, "RNOT": 0.0031, "EEXI": 0.0031,
+	"MAAC": 0.0031, "ARGI": 0.0031, "MITP": 0.0031, "SORI": 0.0030, "ORIE": 0.0030,
+	"UNNU": 0.0030, "ROSN": 0.0030, "ACEC": 0.0030, "VEPR": 0.0030, "ERST": 0.0030,
+	"RAAR": 0.0030, "OBAB": 0.0030, "EAES": 0.0030, "PLAT": 0.0030, "ENÚM": 0.0030,
+	"SICA": 0.0030, "VALE": 0.0030, "IPOE": 0.0030, "SSEP": 0.0030, "DEGN": 0.0030,
+	"ÓNOP": 0.0030, "UNIC": 0.0030, "EDOS": 0.0030, "VERA": 0.0030, "ONAU": 0.0030,
+	"MOEL": 0.0030, "UNSU": 0.0030, "LINU": 0.0030, "INUX": 0.0030, "INPA": 0.0030,
+	"ÓNFI": 0.0030, "TDIN": 0.0030, "IDOO": 0.0030, "ECEN": 0.0030, "DEAS": 0.0030,
+	"DOAP": 0.0030, "OSID": 0.0030, "SOHI": 0.0030, "EJOR": 0.0030, "ESMÚ": 0.0030,
+	"DADP": 0.0030, "UNMÚ": 0.0030, "CHUR": 0.0030, "HURA": 0.0030, "OANA": 0.0030,
+	"ABER": 0.0030, "ONMU": 0.0030, "BROD": 0.0030, "ELÚL": 0.0030, "DANC": 0.0030,
+	"EBAJ": 0.0030, "SEEJ": 0.0030, "IVOI": 0.0030, "MOCO": 0.0030, "TÁMA": 0.0030,
+	"JOSE": 0.0030, "LAGE": 0.0030, "TANA": 0.0030, "ACAC": 0.0030, "EROV": 0.0030,
+	"TPOR": 0.0030, "LUSI": 0.0030, "OTOS": 0.0030, "LVEZ": 0.0030, "EBEC": 0.0030,
+	"RENU": 0.0030, "CALO": 0.0030, "NTOY": 0.0030, "OTOM": 0.0030, "EMIT": 0.0030,
+	"UEAP": 0.0030, "ASLO": 0.0030, "ALEE": 0.0030, "MPOE": 0.0030, "LNIV": 0.0030,
+	"SARD": 0.0030, "ELTR": 0.0030, "SMOS": 0.0030, "ALAM": 0.0030, "AUXI": 0.0030,
+	"UXIL": 0.0030, "XILI": 0.0030, "MIRA": 0.0030, "TOSY": 0.0030, "PROF": 0.0030,
+	"CARC": 0.0030, "OJAD": 0.0030, "EMAP": 0.0030, "OCEE": 0.0030, "SARR": 0.0030,
+	"OMOO": 0.0030, "EBIB": 0.0030, "MPRU": 0.0030, "SEXP": 0.0030, "ESSU": 0.0030,
+	"TESU": 0.0030, "TSIN": 0.0030, "OALT": 0.0030, "UTAE": 0.0030, "ISTÁ": 0.0030,
+	"TIMI": 0.0030, "OLOG": 0.0030, "ALIT": 0.0030, "OOMI": 0.0030, "ROHI": 0.0030,
+	"OCED": 0.0030, "ESFU": 0.0030, "EVOS": 0.0030, "PINP": 0.0030, "IOGI": 0.0030,
+	"EFAK": 0.0030, "RAGI": 0.0030, "SCEN": 0.0029, "NOVA": 0.0029, "DIGI": 0.0029,
+	"ESOC": 0.0029, "CRED": 0.0029, "INIO": 0.0029, "TREG": 0.0029, "COLA": 0.0029,
+	"OLAL": 0.0029, "ELBI": 0.0029, "ASCR": 0.0029, "NIMA": 0.0029, "UEEN": 0.0029,
+	"TRÓE": 0.0029, "NOUS": 0.0029, "CENO": 0.0029, "BABL": 0.0029, "RIAP": 0.0029,
+	"NOPR": 0.0029, "OVEE": 0.0029, "IVOR": 0.0029, "ERAA": 0.0029, "EÑAD": 0.0029,
+	"MINÚ": 0.0029, "INÚS": 0.0029, "NÚSC": 0.0029, "ONPA": 0.0029, "RLÍN": 0.0029,
+	"RTEP": 0.0029, "MÁSR": 0.0029, "ITAS": 0.0029, "NVEZ": 0.0029, "IOSI": 0.0029,
+	"ELPI": 0.0029, "ATOP": 0.0029, "RIOO": 0.0029, "MOTI": 0.0029, "CISI": 0.0029,
+	"XADE": 0.0029, "NATA": 0.0029, "ARIT": 0.0029, "OCAB": 0.0029, "IOPE": 0.0029,
+	"HECH": 0.0029, "NTAB": 0.0029, "IOQU": 0.0029, "RSAL": 0.0029, "REND": 0.0029,
+	"EDAN": 0.0029, "LMIE": 0.0029, "AALG": 0.0029, "VOIN": 0.0029, "NEJO": 0.0029,
+	"NTAE": 0.0029, "OMOL": 0.0029, "SVEC": 0.0029, "POCO": 0.0029, "VOSI": 0.0029,
+	"ZARA": 0.0029, "HAND": 0.0029, "OSEO": 0.0029, "RLAT": 0.0029, "EMAL": 0.0029,
+	"COCO": 0.0029, "ENUS": 0.0029, "NVOC": 0.0029, "EMÁQ": 0.0029, "OFOR": 0.0029,
+	"BIRL": 0.0029, "FSTA": 0.0029, "PARS": 0.0029, "RRAS": 0.0029, "LOSB": 0.0029,
+	"LIAN": 0.0029, "ORUE": 0.0029, "RUEG": 0.0029, "JADE": 0.0029, "CULO": 0.0029,
+	"RREN": 0.0029, "NGEN": 0.0029, "AREQ": 0.0029, "NLAT": 0.0029, "INMA": 0.0029,
+	"ARSU": 0.0029, "ELPS": 0.0029, "LPSE": 0.0029, "SUCE": 0.0029, "THEL": 0.0029,
+	"EHIS": 0.0029, "UTAG": 0.0029, "VIEJ": 0.0028, "VENT": 0.0028, "ABUL": 0.0028,
+	"CARO": 0.0028, "ICAT": 0.0028, "NENO": 0.0028, "SLEN": 0.0028, "NPUN": 0.0028,
+	"ÓLAC": 0.0028, "UDOO": 0.0028, "TRAP": 0.0028, "IVOO": 0.0028, "BETE": 0.0028,
+	"URAA": 0.0028, "ANID": 0.0028, "NSCR": 0.0028, "IAES": 0.0028, "LLOE": 0.0028,
+	"SSUP": 0.0028, "RSEL": 0.0028, "EAYU": 0.0028, "SJUN": 0.0028, "NCAJ": 0.0028,
+	"CAJA": 0.0028, "SREA": 0.0028, "IMIL": 0.0028, "ENLÍ": 0.0028, "VALÍ": 0.0028,
+	"EVAS": 0.0028, "LASH": 0.0028, "OSIX": 0.0028, "RESN": 0.0028, "DELB": 0.0028,
+	"SOND": 0.0028, "LARS": 0.0028, "OLES": 0.0028, "DSDE": 0.0028, "ECPU": 0.0028,
+	"OFUS": 0.0028, "EENU": 0.0028, "EROU": 0.0028, "URAM": 0.0028, "OSLI": 0.0028,
+	"BERE": 0.0028, "ESAS": 0.0028, "SERS": 0.0028, "HEXA": 0.0028, "DADS": 0.0028,
+	"TREO": 0.0028, "IOAL": 0.0028, "DEGE": 0.0028, "CTAL": 0.0028, "DIMI": 0.0028,
+	"NAVA": 0.0028, "TREL": 0.0028, "AJOD": 0.0028, "NSÓL": 0.0028, "GRAD": 0.0028,
+	"SEND": 0.0028, "SAPL": 0.0028, "RIDE": 0.0028, "ÓNEL": 0.0028, "SOBS": 0.0028,
+	"LELO": 0.0028, "ÁNEN": 0.0028, "SPUN": 0.0028, "TÁTI": 0.0028, "ROFI": 0.0028,
+	"OFIR": 0.0028, "OPAQ": 0.0028, "SADI": 0.0028, "RIBA": 0.0028, "RCUA": 0.0028,
+	"AÚNN": 0.0028, "MALI": 0.0028, "RUNE": 0.0028, "PILE": 0.0028, "OSÓL": 0.0028,
+	"APPL": 0.0028, "IDIR": 0.0028, "AREX": 0.0028, "NDUC": 0.0028, "SEMU": 0.0028,
+	"SSIS": 0.0028, "LLIS": 0.0028, "NIND": 0.0028, "ANEL": 0.0028, "NVOL": 0.0028,
+	"AOBJ": 0.0028, "SREL": 0.0028, "DADM": 0.0028, "OUNT": 0.0028, "SSUB": 0.0028,
+	"OLCA": 0.0028, "EGIB": 0.0028, "AADE": 0.0028, "MOME": 0.0028, "OSEV": 0.0028,
+	"SSER": 0.0028, "OSNÚ": 0.0028, "AÑOE": 0.0028, "EREB": 0.0028, "CINA": 0.0028,
+	"ROTR": 0.0028, "NETI": 0.0028, "TABU": 0.0028, "ASCU": 0.0028, "ROAC": 0.0028,
+	"RASQ": 0.0028, "LITT": 0.0028, "RCAE": 0.0028, "UEQU": 0.0028, "LISI": 0.0028,
+	"ISOL": 0.0028, "DIRS": 0.0028, "RRAL": 0.0028, "IANA": 0.0028, "LSUR": 0.0028,
+	"ODOP": 0.0028, "PASS": 0.0028, "INEL": 0.0028, "TÁPR": 0.0028, "SUNP": 0.0028,
+	"NULO": 0.0028, "ALCU": 0.0028, "TIRL": 0.0028, "MÍNI": 0.0028, "ÍNIM": 0.0028,
+	"RÓEL": 0.0028, "VIAD": 0.0028, "IDOU": 0.0028, "TSTA": 0.0028, "DEAY": 0.0028,
+	"LARP": 0.0028, "NMAR": 0.0028, "AJAC": 0.0028, "LERE": 0.0028, "IDOI": 0.0028,
+	"RTAB": 0.0028, "OUNC": 0.0028, "UNÁR": 0.0028, "MATA": 0.0028, "REGE": 0.0028,
+	"IOIN": 0.0028, "SSOL": 0.0028, "ASCI": 0.0028, "ESOA": 0.0028, "EMAI": 0.0028,
+	"EPÁG": 0.0028, "SOES": 0.0028, "MEJO": 0.0028, "OCEL": 0.0028, "EARQ": 0.0028,
+	"CTOC": 0.0028, "REMP": 0.0028, "OÍND": 0.0028, "RSEE": 0.0028, "ALIE": 0.0028,
+	"SEDI": 0.0028, "ÚTIL": 0.0028, "CEPC": 0.0028, "NVAR": 0.0028, "STIP": 0.0028,
+	"NINF": 0.0028, "OLAO": 0.0028, "ODRÍ": 0.0028, "DRÍA": 0.0028, "HACA": 0.0028,
+	"LEYE": 0.0028, "VERR": 0.0028, "EJOD": 0.0028, "VASE": 0.0028, "ECIR": 0.0028,
+	"SEAN": 0.0028, "EANU": 0.0028, "MAVE": 0.0028, "OAPT": 0.0028, "YAQU": 0.0028,
+	"EDPK": 0.0028, "VILE": 0.0028, "LIGA": 0.0028, "ELBL": 0.0028, "OSTO": 0.0028,
+	"RAMO": 0.0028, "LURI": 0.0028, "TOEX": 0.0028, "LAAP": 0.0028, "BACK": 0.0028,
+	"ÓLAR": 0.0028, "ONDU": 0.0028, "ÓALL": 0.0028, "UNSI": 0.0028, "EESD": 0.0028,
+	"EÑAS": 0.0028, "ÓNAD": 0.0028, "AMIL": 0.0028, "ARGE": 0.0028, "EDON": 0.0028,
+	"MAOR": 0.0028, "ICOC": 0.0028, "IGIT": 0.0028, "GIBL": 0.0028, "TREB": 0.0028,
+	"EALE": 0.0028, "VOLC": 0.0028, "ELFR": 0.0028, "ANOA": 0.0028, "DINA": 0.0028,
+	"EARD": 0.0028, "IONI": 0.0028, "MPID": 0.0028, "ITTL": 0.0028, "TTLE": 0.0028,
+	"PROH": 0.0028, "OHIB": 0.0028, "TOEQ": 0.0028, "RNOF": 0.0028, "GITM": 0.0028,
+	"ERTY": 0.0028, "ACAP": 0.0027, "HOST": 0.0027, "ENEP": 0.0027, "NPUE": 0.0027,
+	"OOBI": 0.0027, "IENC": 0.0027, "ODON": 0.0027, "DOTR": 0.0027, "BAEL": 0.0027,
+	"ANÚM": 0.0027, "STEU": 0.0027, "ANER": 0.0027, "STET": 0.0027, "NBYT": 0.0027,
+	"SONE": 0.0027, "SACC": 0.0027, "MILA": 0.0027, "ARRO": 0.0027, "NOPO": 0.0027,
+	"UEBE": 0.0027, "HODE": 0.0027, "YSON": 0.0027, "SOEN": 0.0027, "CIOP": 0.0027,
+	"SENO": 0.0027, "DEPÁ": 0.0027, "NOUT": 0.0027, "NODO": 0.0027, "ECTR": 0.0027,
+	"IBEL": 0.0027, "OQUI": 0.0027, "EUNT": 0.0027, "ASIS": 0.0027, "ISIN": 0.0027,
+	"EEQU": 0.0027, "NEAR": 0.0027, "ÁSCA": 0.0027, "ICAI": 0.0027, "SIQU": 0.0027,
+	"INLA": 0.0027, "NALN": 0.0027, "NTOF": 0.0027, "EPCI": 0.0027, "ENDR": 0.0027,
+	"TAPO": 0.0027, "LOLA": 0.0027, "LBUS": 0.0027, "EELO": 0.0027, "VOSA": 0.0027,
+	"QUIS": 0.0027, "NECA": 0.0027, "ADAO": 0.0027, "NAPT": 0.0027, "IVIL": 0.0027,
+	"UEAD": 0.0027, "NNEC": 0.0027, "NCÓD": 0.0027, "IZAB": 0.0027, "NOQU": 0.0027,
+	"UTAL": 0.0027, "RELV": 0.0027, "ERER": 0.0027, "TARF": 0.0027, "ESMI": 0.0027,
+	"ABYT": 0.0027, "LUME": 0.0027, "EORI": 0.0027, "VOSC": 0.0027, "MOVI": 0.0027,
+	"LTOS": 0.0027, "BEEN": 0.0027, "SRUT": 0.0027, "ONÉS": 0.0027, "AICO": 0.0027,
+	"NGAL": 0.0027, "HÚNG": 0.0027, "ÚNGA": 0.0027, "ARRU": 0.0027, "OGLO": 0.0027,
+	"POWE": 0.0027, "AVEF": 0.0027, "ILLO": 0.0027, "ADAU": 0.0027, "ITSE": 0.0027,
+	"BIAE": 0.0027, "RDIN": 0.0027, "TORY": 0.0027, "DOSY": 0.0027, "ZDEL": 0.0027,
+	"TARP": 0.0027, "AMPA": 0.0027, "BULA": 0.0027, "RAPE": 0.0027, "EBUG": 0.0027,
+	"RASN": 0.0027, "NALG": 0.0027, "RABL": 0.0027, "ABID": 0.0027, "BIDE": 0.0027,
+	"SAVE": 0.0027, "NOFA": 0.0027, "ETHE": 0.0027, "ISOA": 0.0027, "MITD": 0.0027,
+	"UCED": 0.0027, "GUIM": 0.0027, "UIMI": 0.0027, "YNQA": 0.0027, "NQAD": 0.0027,
+	"ÁNDE": 0.0026, "ESEH": 0.0026, "PELI": 0.0026, "OLAA": 0.0026, "EDEG": 0.0026,
+	"ACIE": 0.0026, "ABÚS": 0.0026, "EENV": 0.0026, "RAUT": 0.0026, "AUBI": 0.0026,
+	"AARC": 0.0026, "ANON": 0.0026, "LLAR": 0.0026, "POLA": 0.0026, "AATR": 0.0026,
+	"OLÍN": 0.0026, "AYCO": 0.0026, "ASLE": 0.0026, "ONOH": 0.0026, "STAP": 0.0026,
+	"IOSS": 0.0026, "RREA": 0.0026, "OENF": 0.0026, "EASC": 0.0026, "GÚNA": 0.0026,
+	"EELU": 0.0026, "SSOB": 0.0026, "MÁSI": 0.0026, "ESLE": 0.0026, "SESU": 0.0026,
+	"ÁCON": 0.0026, "SOIN": 0.0026, "RESM": 0.0026, "RCLA": 0.0026, "EDEQ": 0.0026,
+	"EUSE": 0.0026, "DECP": 0.0026, "MINO": 0.0026, "POLÍ": 0.0026, "SOPA": 0.0026,
+	"SABI": 0.0026, "YLOS": 0.0026, "LEAR": 0.0026, "NDOB": 0.0026, "ITSD": 0.0026,
+	"AEQU": 0.0026, "RORL": 0.0026, "RNOC": 0.0026, "OEND": 0.0026, "PÓSI": 0.0026,
+	"ÓSIT": 0.0026, "SARU": 0.0026, "LVIÓ": 0.0026, "ATON": 0.0026, "ITEP": 0.0026,
+	"OENM": 0.0026, "RFUE": 0.0026, "SUND": 0.0026, "SUNM": 0.0026, "OESN": 0.0026,
+	"RIMA": 0.0026, "IMAR": 0.0026, "NOMA": 0.0026, "RÁEN": 0.0026, "ERME": 0.0026,
+	"IMEN": 0.0026, "MPIE": 0.0026, "ANUR": 0.0026, "RFIL": 0.0026, "NENI": 0.0026,
+	"ENEM": 0.0026, "RIND": 0.0026, "SCOL": 0.0026, "UEPU": 0.0026, "ETAG": 0.0026,
+	"SANI": 0.0026, "ESCU": 0.0026, "ELQU": 0.0026, "INOP": 0.0026, "FLAG": 0.0026,
+	"OSAD": 0.0026, "VORU": 0.0026, "NNOD": 0.0026, "DESK": 0.0026, "RLAA": 0.0026,
+	"SENF": 0.0026, "UROS": 0.0026, "TAMO": 0.0026, "ANOD": 0.0026, "ILDE": 0.0026,
+	"ASON": 0.0026, "TÁSE": 0.0026, "ONÚM": 0.0026, "SRAM": 0.0026, "SALF": 0.0026,
+	"ORÍA": 0.0026, "IDEL": 0.0026, "AMAI": 0.0026, "RCAI": 0.0026, "ERBI": 0.0026,
+	"NTOM": 0.0026, "ULOD": 0.0026, "MMON": 0.0026, "ECUB": 0.0026, "HANG": 0.0026,
+	"HERE": 0.0026, "OPEQ": 0.0026, "RARÁ": 0.0026, "LSTA": 0.0026, "EJOS": 0.0026,
+	"URLD": 0.0026, "EALO": 0.0026, "NVIE": 0.0026, "ARÁE": 0.0026, "USAL": 0.0026,
+	"RTAG": 0.0026, "LFRA": 0.0026, "USAP": 0.0026, "ILEC": 0.0026, "LVAD": 0.0026,
+	"COSD": 0.0026, "LALA": 0.0026, "UCON": 0.0026, "OLAT": 0.0026, "RFIR": 0.0026,
+	"TARG": 0.0026, "MNAS": 0.0026, "RHEA": 0.0026, "PUJA": 0.0026, "FSMO": 0.0026,
+	"STHE": 0.0026, "UISI": 0.0025, "NGAN": 0.0025, "ADIG": 0.0025, "COPY": 0.0025,
+	"LAUR": 0.0025, "SUNR": 0.0025, "URAP": 0.0025, "VIDA": 0.0025, "ECAN": 0.0025,
+	"ENTÓ": 0.0025, "LALG": 0.0025, "SHDE": 0.0025, "EGOC": 0.0025, "ATDE": 0.0025,
+	"NSUF": 0.0025, "AESD": 0.0025, "EXIT": 0.0025, "DATI": 0.0025, "AYRE": 0.0025,
+	"SFUN": 0.0025, "ROND": 0.0025, "SREQ": 0.0025, "CUTE": 0.0025, "GAEL": 0.0025,
+	"ATRÁ": 0.0025, "LARI": 0.0025, "OESL": 0.0025, "HANE": 0.0025, "TONC": 0.0025,
+	"LASÓ": 0.0025, "ASÓR": 0.0025, "ACAN": 0.0025, "OACE": 0.0025, "LINV": 0.0025,
+	"BPRO": 0.0025, "CHOD": 0.0025, "BLEN": 0.0025, "TADÍ": 0.0025, "ADÍS": 0.0025,
+	"DÍST": 0.0025, "SEAU": 0.0025, "RESQ": 0.0025, "SCII": 0.0025, "IDSD": 0.0025,
+	"PADR": 0.0025, "ESOE": 0.0025, "SUSU": 0.0025, "TDER": 0.0025, "BREN": 0.0025,
+	"EESE": 0.0025, "ELAG": 0.0025, "ALPU": 0.0025, "PUBL": 0.0025, "UBLI": 0.0025,
+	"SERM": 0.0025, "SAEN": 0.0025, "SMOT": 0.0025, "UIDA": 0.0025, "SIEN": 0.0025,
+	"COES": 0.0025, "IBER": 0.0025, "IBEE": 0.0025, "ROPC": 0.0025, "EGID": 0.0025,
+	"LENS": 0.0025, "RNOA": 0.0025, "LOSL": 0.0025, "POTE": 0.0025, "OALG": 0.0025,
+}
+
+var chainBigramsES = map[string]float64{
+	"DE": 2.7598, "ES": 2.1233, "EN": 1.9580, "ER": 1.7515, "AR": 1.7144,
+	"RE": 1.6830, "RA": 1.4679, "AD": 1.4187, "OS": 1.4013, "EL": 1.3791,
+	"AL": 1.2575, "SE": 1.2454, "LA": 1.2416, "DO": 1.2292, "NO": 1.1906,
+	"CI": 1.1890, "ON": 1.1838, "OR": 1.1609, "CO": 1.1447, "EC": 1.1095,
+	"NT": 1.1019, "TE": 1.0844, "IN": 1.0573, "TA": 0.9774, "ST": 0.9509,
+	"RO": 0.8961, "AC": 0.8806, "CA": 0.8714, "AS": 0.8683, "NE": 0.8329,
+	"IC": 0.8228, "TO": 0.7947, "OD": 0.7908, "UE": 0.7794, "ÓN": 0.7207,
+	"ND": 0.7180, "LI": 0.7053, "IÓ": 0.6985, "ED": 0.6928, "DA": 0.6924,
+	"TR": 0.6902, "AN": 0.6872, "SI": 0.6736, "LO": 0.6647, "TI": 0.6597,
+	"NA": 0.6563, "PA": 0.6288, "RI": 0.6208, "LE": 0.6198, "ID": 0.6104,
+	"UN": 0.6001, "MA": 0.5988, "FI": 0.5833, "IO": 0.5791, "OM": 0.5712,
+	"EP": 0.5458, "OP": 0.5374, "DI": 0.5256, "EE": 0.4994, "PO": 0.4899,
+	"EA": 0.4890, "ET": 0.4735, "NC": 0.4704, "ME": 0.4646, "IT": 0.4603,
+	"IS": 0.4509, "SA": 0.4460, "QU": 0.4452, "AM": 0.4437, "MI": 0.4429,
+	"CH": 0.4423, "OE": 0.4407, "SP": 0.4373, "OC": 0.4319, "SD": 0.4258,
+	"PE": 0.4232, "SO": 0.3958, "CE": 0.3881, "EM": 0.3825, "AE": 0.3799,
+	"IE": 0.3754, "IA": 0.3729, "CT": 0.3725, "PR": 0.3710, "SC": 0.3680,
+	"AT": 0.3636, "PU": 0.3581, "NS": 0.3499, "AB": 0.3364, "NI": 0.3351,
+	"OL": 0.3336, "MB": 0.3305, "IR": 0.3288, "HE": 0.3032, "RD": 0.3031,
+	"MO": 0.3016, "MP": 0.3013, "BI": 0.2981, "RC": 0.2965, "IV": 0.2945,
+	"BL": 0.2815, "VE": 0.2793, "AP": 0.2793, "RR": 0.2783, "EG": 0.2725,
+	"RM": 0.2707, "SU": 0.2694, "EF": 0.2668, "VO": 0.2647, "RU": 0.2610,
+	"US": 0.2595, "VA": 0.2569, "CC": 0.2545, "RT": 0.2425, "GU": 0.2381,
+	"LL": 0.2367, "BR": 0.2345, "EU": 0.2337, "IM": 0.2299, "OT": 0.2260,
+	"ZA": 0.2234, "GI": 0.2169, "IG": 0.2127, "AV": 0.2121, "OB": 0.2103,
+	"OA": 0.2101, "UA": 0.2100, "UR": 0.2057, "UT": 0.2056, "HA": 0.2049,
+	"AU": 0.2036, "RS": 0.2035, "IL": 0.2022, "EX": 0.1987, "CU": 0.1984,
+	"NL": 0.1977, "TU": 0.1966, "IF": 0.1925, "NF": 0.1878, "CR": 0.1869,
+	"PL": 0.1856, "LT": 0.1813, "IB": 0.1796, "FA": 0.1787, "UI": 0.1718,
+	"BA": 0.1696, "EB": 0.1688, "HI": 0.1686, "JE": 0.1641, "RL": 0.1632,
+	"NU": 0.1622, "AA": 0.1602, "NG": 0.1557, "EV": 0.1541, "NV": 0.1536,
+	"ÁL": 0.1515, "LD": 0.1492, "CL": 0.1490, "BO": 0.1472, "PC": 0.1467,
+	"SS": 0.1458, "VI": 0.1436, "VÁ": 0.1430, "BE": 0.1428, "IZ": 0.1424,
+	"LC": 0.1409, "FO": 0.1391, "LS": 0.1372, "EO": 0.1361, "AI": 0.1346,
+	"IP": 0.1338, "UC": 0.1332, "OI": 0.1314, "UB": 0.1304, "TÁ": 0.1279,
+	"LF": 0.1278, "AG": 0.1255, "GA": 0.1254, "GE": 0.1215, "UL": 0.1208,
+	"GR": 0.1202, "AQ": 0.1176, "FU": 0.1166, "UM": 0.1157, "LP": 0.1141,
+	"LÍ": 0.1137, "GO": 0.1118, "RG": 0.1108, "OO": 0.1101, "LU": 0.1092,
+	"UD": 0.1091, "FE": 0.1087, "EI": 0.1085, "NP": 0.1075, "SL": 0.1060,
+	"PI": 0.1046, "AO": 0.1039, "ÍN": 0.1038, "NN": 0.1018, "OF": 0.1009,
+	"RN": 0.0990, "SM": 0.0965, "UP": 0.0949, "EQ": 0.0942, "AY": 0.0939,
+	"AF": 0.0939, "OU": 0.0929, "EJ": 0.0916, "LM": 0.0916, "ÍM": 0.0905,
+	"DU": 0.0880, "OG": 0.0861, "MU": 0.0855, "SN": 0.0845, "AJ": 0.0833,
+	"OV": 0.0829, "RP": 0.0806, "JO": 0.0797, "NM": 0.0792, "LN": 0.0791,
+	"RÁ": 0.0761, "XI": 0.0744, "SH": 0.0729, "PT": 0.0726, "AZ": 0.0718,
+	"BU": 0.0709, "SÍ": 0.0707, "NR": 0.0701, "EH": 0.0687, "MÁ": 0.0676,
+	"ÓD": 0.0668, "NÚ": 0.0668, "BJ": 0.0658, "OH": 0.0650, "ÓL": 0.0625,
+	"LV": 0.0624, "SR": 0.0620, "AÑ": 0.0617, "SQ": 0.0606, "OQ": 0.0604,
+	"GN": 0.0596, "XT": 0.0588, "XP": 0.0584, "ÚM": 0.0567, "LR": 0.0556,
+	"SF": 0.0549, "RF": 0.0548, "LÓ": 0.0546, "CÓ": 0.0535, "MM": 0.0526,
+	"DM": 0.0519, "ÑO": 0.0510, "FR": 0.0508, "DD": 0.0508, "ÁN": 0.0505,
+	"YA": 0.0499, "TS": 0.0498, "ÁS": 0.0497, "SV": 0.0474, "ÉS": 0.0466,
+	"TH": 0.0462, "JA": 0.0453, "SY": 0.0449, "HO": 0.0447, "ÍA": 0.0441,
+	"RV": 0.0441, "ÑA": 0.0438, "EZ": 0.0438, "KE": 0.0419, "FL": 0.0416,
+	"LG": 0.0409, "SB": 0.0405, "TC": 0.0403, "RB": 0.0400, "OY": 0.0397,
+	"YT": 0.0389, "CK": 0.0385, "RÓ": 0.0374, "YE": 0.0372, "ÚN": 0.0370,
+	"RQ": 0.0349, "ÁC": 0.0345, "ÁR": 0.0338, "AK": 0.0336, "BT": 0.0334,
+	"EÑ": 0.0331, "TP": 0.0331, "NB": 0.0331, "ÁM": 0.0321, "SÓ": 0.0318,
+	"EY": 0.0316, "BY": 0.0312, "PÚ": 0.0311, "UG": 0.0308, "GM": 0.0305,
+	"PS": 0.0304, "AH": 0.0302, "ÚB": 0.0300, "TD": 0.0298, "RÍ": 0.0293,
+	"WA": 0.0281, "BS": 0.0274, "CÍ": 0.0273, "DS": 0.0272, "ÓA": 0.0268,
+	"YS": 0.0264, "RY": 0.0258, "IQ": 0.0256, "FF": 0.0253, "GL": 0.0252,
+	"JU": 0.0248, "YU": 0.0247, "ÚL": 0.0246, "MÓ": 0.0246, "NZ": 0.0241,
+	"DP": 0.0233, "IJ": 0.0233, "LB": 0.0230, "DR": 0.0229, "TL": 0.0223,
+	"YN": 0.0223, "FT": 0.0223, "TT": 0.0214, "OW": 0.0213, "ÁE": 0.0212,
+	"TM": 0.0209, "HU": 0.0207, "LÁ": 0.0201, "LQ": 0.0200, "UÉ": 0.0199,
+	"TN": 0.0198, "ÓR": 0.0195, "BÓ": 0.0191, "UY": 0.0191, "YO": 0.0188,
+	"GÚ": 0.0186, "YP": 0.0186, "ÓE": 0.0186, "GS": 0.0184, "SG": 0.0183,
+	"ÚS": 0.0182, "GP": 0.0182, "TY": 0.0181, "BM": 0.0180, "FS": 0.0179,
+	"XC": 0.0178, "NY": 0.0176, "UJ": 0.0174, "MÚ": 0.0174, "KA": 0.0169,
+	"NÁ": 0.0168, "TW": 0.0165, "ÁD": 0.0162, "VU": 0.0161, "PP": 0.0159,
+	"ÁF": 0.0158, "ÉN": 0.0156, "YD": 0.0154, "ÓU": 0.0153, "UF": 0.0153,
+	"ZD": 0.0152, "YC": 0.0150, "CD": 0.0149, "NQ": 0.0148, "ÍO": 0.0148,
+	"PD": 0.0146, "ÁT": 0.0145, "MÉ": 0.0144, "DN": 0.0143, "PK": 0.0140,
+	"XA": 0.0139, "YL": 0.0138, "DV": 0.0137, "UO": 0.0137, "CS": 0.0137,
+	"LY": 0.0137, "VÍ": 0.0135, "ÉR": 0.0133, "CP": 0.0133, "NÉ": 0.0131,
+	"HT": 0.0125, "WI": 0.0124, "AX": 0.0124, "TF": 0.0123, "TÓ": 0.0118,
+	"YM": 0.0118, "ZC": 0.0117, "ÉT": 0.0117, "ÁX": 0.0115, "RH": 0.0115,
+	"MS": 0.0115, "DL": 0.0115, "NH": 0.0114, "PG": 0.0113, "FD": 0.0113,
+	"NJ": 0.0112, "FP": 0.0112, "WO": 0.0110, "AÚ": 0.0109, "KG": 0.0107,
+}
+
+var quadgramsIT = map[string]float64{
+	"ZION": 0.6687, "IONE": 0.6209, "FILE": 0.3754, "AZIO": 0.3283, "MENT": 0.3141,
+	"DELL": 0.2612, "IMPO": 0.2502, "MPOS": 0.2442, "IBIL": 0.2436, "BILE": 0.2385,
+	"ENTE": 0.2282, "POSS": 0.2141, "IFIC": 0.2049, "ELLA": 0.1970, "OSSI": 0.1965,
+	"IONI": 0.1945, "SIBI": 0.1931, "SSIB": 0.1912, "FICA": 0.1801, "ONED": 0.1765,
+	"SION": 0.1692, "EDEL": 0.1684, "ENON": 0.1639, "ENTI": 0.1623, "AGGI": 0.1562,
+	"ENTO": 0.1545, "LFIL": 0.1396, "OSTA": 0.1379, "AMEN": 0.1353, "ICAT": 0.1338,
+	"CONT": 0.1326, "OPZI": 0.1325, "PZIO": 0.1325, "SEGU": 0.1207, "ANDO": 0.1190,
+	"TENT": 0.1187, "STAT": 0.1178, "OPER": 0.1171, "ODEL": 0.1161, "ETTO": 0.1149,
+	"ALID": 0.1144, "ERRO": 0.1138, "IZZA": 0.1124, "ECON": 0.1122, "TATO": 0.1118,
+	"ORMA": 0.1103, "VALI": 0.1094, "ILED": 0.1092, "SONO": 0.1089, "RROR": 0.1082,
+	"ESEG": 0.1077, "ICON": 0.1065, "ADEL": 0.1062, "ACCH": 0.1062, "ATTE": 0.1061,
+	"CHET": 0.1053, "ONON": 0.1052, "GGIO": 0.1049, "NOME": 0.1038, "REIL": 0.1037,
+	"LIZZ": 0.1031, "CHIA": 0.1020, "HETT": 0.1019, "POST": 0.1017, "UEST": 0.1013,
+	"FORM": 0.1010, "QUES": 0.1009, "PACC": 0.1007, "ALLA": 0.1005, "NEDE": 0.1004,
+	"PORT": 0.0992, "INTE": 0.0989, "ESTA": 0.0988, "VERS": 0.0981, "MAND": 0.0980,
+	"SPEC": 0.0978, "CCHE": 0.0976, "OMAN": 0.0970, "PECI": 0.0961, "IONA": 0.0954,
+	"EPER": 0.0951, "RORE": 0.0938, "CATO": 0.0933, "COMP": 0.0923, "EGUI": 0.0915,
+	"ECIF": 0.0913, "INDI": 0.0909, "ESSE": 0.0906, "NONR": 0.0901, "SCRI": 0.0900,
+	"CONF": 0.0899, "CIFI": 0.0895, "NONÈ": 0.0894, "ERSI": 0.0893, "NONV": 0.0889,
+	"ESTO": 0.0883, "AREI": 0.0882, "NEDI": 0.0879, "ENTA": 0.0876, "LEDI": 0.0875,
+	"ONRI": 0.0874, "DIFI": 0.0867, "IFIL": 0.0863, "USCI": 0.0862, "NVAL": 0.0862,
+	"IUSC": 0.0860, "NELL": 0.0851, "SCIT": 0.0851, "COME": 0.0845, "ELLE": 0.0841,
+	"SSER": 0.0836, "HIAV": 0.0828, "SERE": 0.0824, "ETTI": 0.0822, "DICO": 0.0819,
+	"COMA": 0.0816, "ONVA": 0.0812, "NTER": 0.0809, "NALE": 0.0809, "RSIO": 0.0790,
+	"ESSI": 0.0766, "RAZI": 0.0764, "UPPO": 0.0763, "LICA": 0.0762, "ICOM": 0.0761,
+	"ODIF": 0.0761, "MINA": 0.0749, "AREL": 0.0740, "RELA": 0.0736, "ONAL": 0.0733,
+	"ORTA": 0.0733, "ALIZ": 0.0733, "RIUS": 0.0731, "ELLO": 0.0729, "CORR": 0.0727,
+	"ILFI": 0.0713, "ONFI": 0.0711, "TALE": 0.0705, "SIST": 0.0705, "COMM": 0.0703,
+	"ISTE": 0.0700, "NONS": 0.0700, "UTEN": 0.0699, "TARE": 0.0694, "CITA": 0.0688,
+	"IMEN": 0.0686, "IENE": 0.0686, "NRIU": 0.0684, "EZIO": 0.0676, "CESS": 0.0675,
+	"LING": 0.0673, "TOCO": 0.0672, "ISPO": 0.0668, "INGU": 0.0667, "IAVE": 0.0666,
+	"TURA": 0.0655, "REUN": 0.0655, "TTEN": 0.0651, "INFO": 0.0651, "OMEN": 0.0648,
+	"ORED": 0.0648, "PRES": 0.0648, "ONTR": 0.0647, "ANTE": 0.0646, "ICAR": 0.0643,
+	"EFIN": 0.0643, "MODI": 0.0643, "NFIG": 0.0643, "APER": 0.0642, "TTER": 0.0641,
+	"ENTR": 0.0634, "ESSA": 0.0633, "SEGN": 0.0630, "PERI": 0.0629, "OCON": 0.0629,
+	"ONEN": 0.0628, "ILES": 0.0628, "LIDO": 0.0627, "ONOS": 0.0627, "ILEC": 0.0625,
+	"CREA": 0.0624, "STRA": 0.0623, "CARE": 0.0620, "IGUR": 0.0619, "NESS": 0.0618,
+	"FIGU": 0.0616, "PERC": 0.0616, "NFOR": 0.0613, "NTAL": 0.0612, "DENT": 0.0610,
+	"ICHI": 0.0607, "ENZA": 0.0606, "GURA": 0.0605, "PERL": 0.0603, "ELFI": 0.0601,
+	"DEFI": 0.0597, "NTEN": 0.0596, "REDE": 0.0596, "BILI": 0.0594, "ENEL": 0.0591,
+	"UMER": 0.0589, "INON": 0.0589, "TODI": 0.0587, "STAL": 0.0586, "ECOM": 0.0583,
+	"ICHE": 0.0577, "NUME": 0.0576, "FINI": 0.0576, "ABIL": 0.0575, "ANON": 0.0575,
+	"ALOR": 0.0574, "ARCH": 0.0570, "ONES": 0.0569, "RCHI": 0.0567, "SPON": 0.0567,
+	"ANCH": 0.0564, "LLAR": 0.0559, "CONO": 0.0559, "VALO": 0.0558, "CHIE": 0.0558,
+	"ILEA": 0.0557, "TAZI": 0.0556, "EDIC": 0.0554, "ESSO": 0.0550, "RMAT": 0.0549,
+	"ETTE": 0.0547, "TORE": 0.0544, "INIT": 0.0544, "PPOR": 0.0544, "ACHI": 0.0542,
+	"REDI": 0.0542, "OCOM": 0.0541, "AVER": 0.0539, "IZIO": 0.0535, "SUPP": 0.0533,
+	"TION": 0.0531, "ATOD": 0.0531, "ONTE": 0.0529, "PERA": 0.0525, "TICO": 0.0522,
+	"RICH": 0.0520, "IPER": 0.0520, "MESS": 0.0519, "TICA": 0.0519, "UIRE": 0.0516,
+	"NSTA": 0.0515, "NTRO": 0.0514, "TOPE": 0.0514, "TONO": 0.0510, "ROVA": 0.0507,
+	"INST": 0.0507, "NDIC": 0.0506, "ILCO": 0.0504, "ODEI": 0.0504, "RESE": 0.0500,
+	"RATO": 0.0499, "SOLO": 0.0499, "LCOM": 0.0498, "TTUR": 0.0498, "LORE": 0.0494,
+	"ERIM": 0.0494, "PLIC": 0.0493, "EROD": 0.0493, "OMMI": 0.0493, "MMIT": 0.0493,
+	"RATT": 0.0492, "DALL": 0.0492, "GIOR": 0.0492, "NOSC": 0.0490, "RECO": 0.0490,
+	"ESSU": 0.0489, "MAZI": 0.0489, "ONID": 0.0487, "DICE": 0.0486, "CORS": 0.0486,
+	"ARAT": 0.0486, "LLIN": 0.0485, "LECO": 0.0485, "ESTI": 0.0485, "ARGO": 0.0482,
+	"RISP": 0.0482, "UALI": 0.0480, "SSUN": 0.0479, "OMED": 0.0479, "ISTR": 0.0478,
+	"NZIO": 0.0477, "TALL": 0.0476, "LITÀ": 0.0475, "MERI": 0.0474, "ELEN": 0.0474,
+	"GOME": 0.0474, "EDEF": 0.0474, "TODE": 0.0473, "TEST": 0.0472, "RGOM": 0.0472,
+	"REIN": 0.0472, "SSIO": 0.0471, "INIZ": 0.0471, "MODO": 0.0471, "NIZI": 0.0470,
+	"PRIM": 0.0470, "NTIC": 0.0467, "ACON": 0.0467, "LENC": 0.0465, "IPAC": 0.0464,
+	"VIEN": 0.0464, "TECO": 0.0464, "CRIT": 0.0462, "RESS": 0.0462, "SARE": 0.0462,
+	"LESE": 0.0459, "LOCA": 0.0459, "NTES": 0.0458, "NFIL": 0.0458, "RMAZ": 0.0458,
+	"OTTO": 0.0457, "GETT": 0.0456, "TERM": 0.0454, "NITO": 0.0454, "IDEN": 0.0453,
+	"ERCO": 0.0452, "DERE": 0.0451, "ERMI": 0.0449, "INAT": 0.0449, "RIME": 0.0446,
+	"LOST": 0.0445, "DIUN": 0.0445, "LEIN": 0.0443, "OGGE": 0.0443, "ARIC": 0.0442,
+	"ODIC": 0.0442, "GUIR": 0.0442, "ALIT": 0.0441, "ATOR": 0.0441, "NTRA": 0.0439,
+	"LARE": 0.0439, "TROV": 0.0439, "NUOV": 0.0438, "USER": 0.0438, "RANC": 0.0437,
+	"DIRI": 0.0437, "DELF": 0.0437, "CAZI": 0.0437, "GIUN": 0.0437, "UZIO": 0.0436,
+	"SUAL": 0.0436, "RODI": 0.0435, "MERO": 0.0433, "IVER": 0.0433, "GGET": 0.0433,
+	"CONS": 0.0432, "TUTT": 0.0432, "ONEL": 0.0431, "MBOL": 0.0431, "RITT": 0.0430,
+	"IUTO": 0.0430, "SIMB": 0.0429, "GGIU": 0.0429, "CHIV": 0.0429, "ICAD": 0.0428,
+	"USAT": 0.0427, "LETT": 0.0427, "RMIN": 0.0427, "IMBO": 0.0427, "NONC": 0.0426,
+	"NAME": 0.0425, "TATA": 0.0424, "EUSA": 0.0424, "IORN": 0.0424, "RIGA": 0.0422,
+	"ENER": 0.0421, "OSCI": 0.0421, "OGRA": 0.0420, "IEST": 0.0420, "GIOD": 0.0420,
+	"SCIU": 0.0419, "TODA": 0.0418, "SEZI": 0.0417, "IZIA": 0.0416, "URAZ": 0.0415,
+	"ÈSTA": 0.0414, "IODE": 0.0413, "FERI": 0.0413, "CIUT": 0.0413, "ARIO": 0.0413,
+	"ISEG": 0.0412, "ALLI": 0.0412, "URAN": 0.0412, "CARA": 0.0412, "REMO": 0.0412,
+	"NOMI": 0.0412, "VISU": 0.0412, "RIDI": 0.0411, "ERID": 0.0410, "NENO": 0.0410,
+	"UTIL": 0.0410, "BBLI": 0.0409, "IENT": 0.0409, "ERIC": 0.0408, "BLIC": 0.0408,
+	"ANDA": 0.0405, "ONDE": 0.0404, "INAR": 0.0403, "NARE": 0.0403, "FIRM": 0.0403,
+	"LENO": 0.0402, "ILEN": 0.0402, "EREI": 0.0401, "ISUA": 0.0401, "LARI": 0.0400,
+	"ORRE": 0.0400, "LIMI": 0.0400, "ACHE": 0.0399, "CODI": 0.0398, "VERE": 0.0398,
+	"HIVI": 0.0398, "MATO": 0.0397, "EDIS": 0.0396, "ATOC": 0.0396, "RICA": 0.0395,
+	"UBBL": 0.0394, "SCON": 0.0394, "NMOD": 0.0393, "PUBB": 0.0392, "ERES": 0.0392,
+	"ETTA": 0.0392, "IDEL": 0.0391, "LEDE": 0.0391, "TEMA": 0.0391, "ALLO": 0.0388,
+	"PRED": 0.0388, "EREL": 0.0385, "ORNA": 0.0383, "ORSO": 0.0382, "ONEP": 0.0382,
+	"TIPO": 0.0381, "NCOR": 0.0381, "LLAC": 0.0379, "EPRE": 0.0379, "TOIN": 0.0379,
+	"AREU": 0.0379, "EUNA": 0.0379, "SOTT": 0.0379, "NALI": 0.0378, "ATOP": 0.0378,
+	"EPUB": 0.0377, "ELLI": 0.0376, "LIDA": 0.0376, "STRI": 0.0375, "ONIS": 0.0373,
+	"SENT": 0.0373, "OSTR": 0.0372, "LLAS": 0.0372, "STAN": 0.0371, "CADE": 0.0370,
+	"NDEN": 0.0370, "IANO": 0.0370, "TIVO": 0.0370, "TIFI": 0.0369, "DEIS": 0.0368,
+	"ONEA": 0.0367, "AUNA": 0.0366, "DURA": 0.0365, "SERV": 0.0365, "STAM": 0.0365,
+	"SSAG": 0.0364, "TERE": 0.0363, "EQUE": 0.0363, "ESEN": 0.0362, "LNOM": 0.0361,
+	"EILF": 0.0361, "EREU": 0.0361, "MEDI": 0.0359, "UNFI": 0.0359, "ESIS": 0.0358,
+	"RTAT": 0.0356, "ISOL": 0.0355, "OREN": 0.0355, "DIIN": 0.0355, "TATI": 0.0354,
+	"ODAL": 0.0354, "DATI": 0.0354, "ALTR": 0.0354, "ENZI": 0.0353, "INCO": 0.0352,
+	"STAZ": 0.0352, "SAGG": 0.0352, "IMIN": 0.0352, "TILI": 0.0351, "RIMA": 0.0351,
+	"CENT": 0.0350, "ETTU": 0.0350, "ILEI": 0.0350, "TERN": 0.0349, "ESTR": 0.0349,
+	"NTEL": 0.0349, "APRI": 0.0349, "ILEE": 0.0349, "NTOD": 0.0346, "RANT": 0.0346,
+	"AMBI": 0.0346, "TAMP": 0.0346, "INMO": 0.0345, "EFIL": 0.0345, "ENDE": 0.0343,
+	"RICO": 0.0343, "ERTI": 0.0343, "IRMA": 0.0343, "TORI": 0.0341, "NESE": 0.0340,
+	"EREN": 0.0340, "TTES": 0.0340, "ATIC": 0.0339, "BASE": 0.0339, "ANDI": 0.0338,
+	"POND": 0.0338, "TERI": 0.0337, "DELS": 0.0336, "RELE": 0.0336, "NIDI": 0.0336,
+	"ULTI": 0.0336, "ATIV": 0.0335, "TROL": 0.0335, "BERO": 0.0334, "OUTP": 0.0334,
+	"AMPA": 0.0333, "HIES": 0.0333, "IGNO": 0.0333, "UTPU": 0.0333, "LLAT": 0.0333,
+	"TPUT": 0.0332, "NGUE": 0.0332, "STEM": 0.0332, "REGI": 0.0331, "ERIL": 0.0331,
+	"NATO": 0.0330, "CIDE": 0.0329, "GNOR": 0.0329, "ERLA": 0.0329, "AGIN": 0.0329,
+	"REPU": 0.0328, "USAR": 0.0328, "NCON": 0.0327, "NTRI": 0.0326, "ECHE": 0.0326,
+	"POSI": 0.0326, "ONEC": 0.0325, "TTIV": 0.0325, "ARIA": 0.0324, "DIME": 0.0323,
+	"EGLI": 0.0323, "ILIT": 0.0323, "NGUA": 0.0323, "ISTA": 0.0322, "ROLL": 0.0322,
+	"ELIM": 0.0322, "IVIO": 0.0322, "BOLI": 0.0321, "EDER": 0.0321, "ONCO": 0.0321,
+	"LOPZ": 0.0321, "EIFI": 0.0321, "DISP": 0.0320, "ITÀD": 0.0319, "RRIS": 0.0319,
+	"ZZAR": 0.0319, "RALE": 0.0318, "LLOS": 0.0318, "SENZ": 0.0318, "DICA": 0.0317,
+	"RIMO": 0.0317, "EOPZ": 0.0317, "RATI": 0.0315, "UPER": 0.0315, "MPOR": 0.0315,
+	"ONER": 0.0314, "LITA": 0.0314, "INOM": 0.0314, "ONEI": 0.0313, "CATI": 0.0313,
+	"RCOR": 0.0313, "ORRI": 0.0313, "LAVE": 0.0313, "UMEN": 0.0313, "ILIZ": 0.0313,
+	"LERI": 0.0313, "ATOI": 0.0313, "EGNA": 0.0312, "LASE": 0.0312, "PART": 0.0311,
+	"OVER": 0.0311, "RENE": 0.0310, "LACH": 0.0310, "GLIA": 0.0309, "ZARE": 0.0309,
+	"ISON": 0.0308, "NSIO": 0.0308, "RIZZ": 0.0308, "EGUE": 0.0308, "IFER": 0.0308,
+	"EDIT": 0.0307, "NONP": 0.0307, "LEGG": 0.0306, "ONSI": 0.0306, "BRAN": 0.0305,
+	"ODIS": 0.0305, "MENO": 0.0305, "ILER": 0.0305, "DELC": 0.0304, "EDEI": 0.0304,
+	"RADE": 0.0303, "TENE": 0.0303, "MEDE": 0.0302, "URAD": 0.0302, "ILNO": 0.0302,
+	"DENZ": 0.0301, "ICAZ": 0.0301, "INPU": 0.0301, "ERVE": 0.0300, "RIFE": 0.0300,
+	"ORIE": 0.0299, "TAME": 0.0299, "ONSU": 0.0299, "DELP": 0.0298, "EISE": 0.0298,
+	"LELE": 0.0297, "INEL": 0.0296, "NPUT": 0.0296, "TÀDI": 0.0295, "EALL": 0.0295,
+	"GUAG": 0.0295, "AUTO": 0.0294, "ECOR": 0.0294, "ENSI": 0.0293, "TAND": 0.0293,
+	"ILET": 0.0292, "CCID": 0.0291, "CARI": 0.0291, "GERE": 0.0291, "ASSI": 0.0290,
+	"SETT": 0.0290, "UAGG": 0.0290, "ACCE": 0.0287, "ULLA": 0.0287, "NORA": 0.0287,
+	"EESE": 0.0287, "ANTI": 0.0286, "EVER": 0.0286, "COLL": 0.0286, "IDIO": 0.0286,
+	"DIVE": 0.0286, "ESPE": 0.0286, "ERGE": 0.0286, "GROU": 0.0286, "ANDE": 0.0285,
+	"FINE": 0.0285, "OCCI": 0.0284, "NONE": 0.0284, "RIMU": 0.0284, "ERNO": 0.0283,
+	"CATA": 0.0283, "DESC": 0.0283, "EGGE": 0.0283, "SULL": 0.0283, "INES": 0.0282,
+	"LMEN": 0.0282, "LINT": 0.0282, "EGNI": 0.0282, "ATOS": 0.0281, "NONA": 0.0281,
+	"PROG": 0.0281, "LLAL": 0.0280, "LEES": 0.0280, "EMEN": 0.0280, "IREI": 0.0280,
+	"GGER": 0.0280, "POTE": 0.0280, "NERE": 0.0280, "RVER": 0.0280, "MUOV": 0.0280,
+	"AREA": 0.0279, "HELP": 0.0279, "DISA": 0.0278, "ONNE": 0.0278, "GRUP": 0.0278,
+	"RUPP": 0.0278, "APPL": 0.0278, "CHIN": 0.0277, "ELIN": 0.0277, "CERT": 0.0277,
+	"CCES": 0.0277, "RIFI": 0.0277, "IMUO": 0.0277, "EMOT": 0.0277, "RIEN": 0.0276,
+	"ITTO": 0.0275, "LINE": 0.0275, "ORNI": 0.0274, "ETRO": 0.0274, "LIST": 0.0274,
+	"EDES": 0.0274, "RIOR": 0.0273, "ONTI": 0.0273, "ENCO": 0.0273, "ROUP": 0.0273,
+	"CCHI": 0.0272, "TINO": 0.0272, "UTTI": 0.0272, "PPLI": 0.0272, "TRAL": 0.0271,
+	"RENT": 0.0271, "ESTE": 0.0270, "EIMP": 0.0270, "LAVO": 0.0269, "IODI": 0.0269,
+	"LPRO": 0.0269, "EILC": 0.0269, "BLOC": 0.0269, "ONDI": 0.0269, "REQU": 0.0269,
+	"GRAM": 0.0269, "DION": 0.0268, "SSON": 0.0268, "RAMM": 0.0268, "TROP": 0.0267,
+	"INAL": 0.0267, "NECO": 0.0266, "LIND": 0.0266, "ERAT": 0.0266, "DIST": 0.0265,
+	"NCHE": 0.0265, "TIEN": 0.0265, "ARIG": 0.0263, "NTEI": 0.0263, "EINF": 0.0263,
+	"UNIC": 0.0262, "ESCR": 0.0262, "ERIF": 0.0262, "DATA": 0.0262, "REST": 0.0261,
+	"MANC": 0.0261, "QUAN": 0.0261, "SIZI": 0.0261, "ADIC": 0.0260, "ACCO": 0.0260,
+	"EDIA": 0.0260, "UOVE": 0.0260, "OSSO": 0.0259, "ENZE": 0.0259, "COND": 0.0259,
+	"NDIR": 0.0259, "TESO": 0.0259, "ROGR": 0.0259, "PEND": 0.0258, "LACO": 0.0258,
+	"TAPE": 0.0258, "IUNG": 0.0258, "RTIF": 0.0257, "ENUT": 0.0257, "OSPE": 0.0257,
+	"EESS": 0.0256, "AREC": 0.0256, "TENZ": 0.0256, "RARE": 0.0256, "LEOP": 0.0256,
+	"TAST": 0.0256, "TTII": 0.0255, "UNCO": 0.0254, "UITO": 0.0254, "SIDE": 0.0253,
+	"ANCA": 0.0253, "OVAT": 0.0253, "TOMA": 0.0253, "TENU": 0.0253, "SATO": 0.0253,
+	"OUNT": 0.0253, "OMPO": 0.0253, "ALME": 0.0252, "OCAL": 0.0252, "DOPO": 0.0252,
+	"IDAT": 0.0252, "HECO": 0.0252, "ZIAL": 0.0251, "CONL": 0.0251, "MATI": 0.0251,
+	"APOT": 0.0251, "VARI": 0.0250, "ONOE": 0.0250, "ONTA": 0.0250, "CIPA": 0.0249,
+	"IPAL": 0.0249, "RION": 0.0249, "RING": 0.0249, "ATOA": 0.0249, "NARI": 0.0248,
+	"CACH": 0.0248, "LLAP": 0.0247, "ALLE": 0.0247, "NOES": 0.0247, "OCHE": 0.0247,
+	"OTEC": 0.0247, "OSIZ": 0.0247, "PERE": 0.0247, "ASSE": 0.0246, "NELF": 0.0246,
+	"PERS": 0.0246, "SELE": 0.0246, "ASOL": 0.0246, "GUIT": 0.0246, "MERG": 0.0246,
+	"MUNI": 0.0245, "NORD": 0.0245, "ICAM": 0.0245, "LPAC": 0.0245, "QUAL": 0.0245,
+	"IEDE": 0.0244, "CHES": 0.0244, "NERA": 0.0243, "TRIN": 0.0243, "OMES": 0.0243,
+	"NTIE": 0.0243, "ALCU": 0.0243, "NEIN": 0.0243, "ERRA": 0.0242, "RICE": 0.0242,
+	"IALI": 0.0242, "LCUN": 0.0242, "TELA": 0.0242, "NIBI": 0.0242, "NERI": 0.0242,
+	"TIIN": 0.0241, "ILPR": 0.0241, "OALL": 0.0241, "ASEZ": 0.0241, "STRE": 0.0240,
+	"PARA": 0.0240, "LUNG": 0.0240, "DIRE": 0.0240, "LLEG": 0.0240, "LAZI": 0.0240,
+	"ESON": 0.0240, "ZZAT": 0.0240, "CRIV": 0.0240, "DIPE": 0.0239, "NDAR": 0.0239,
+	"PERM": 0.0239, "NTED": 0.0239, "STEN": 0.0239, "OFIL": 0.0239, "OLLO": 0.0238,
+	"LCON": 0.0238, "NSUP": 0.0238, "CEDE": 0.0238, "GENE": 0.0238, "RIPT": 0.0238,
+	"VEDE": 0.0238, "LADI": 0.0237, "ITAR": 0.0237, "CRIP": 0.0237, "EMOD": 0.0237,
+	"ROCE": 0.0236, "LATI": 0.0236, "DIFF": 0.0236, "FORN": 0.0235, "ONSE": 0.0235,
+	"CONN": 0.0235, "ORAT": 0.0235, "EBBE": 0.0235, "TOUN": 0.0235, "LBER": 0.0234,
+	"DIVI": 0.0234, "CITO": 0.0234, "ROPP": 0.0234, "UNAR": 0.0234, "IDIS": 0.0234,
+	"AUSA": 0.0234, "GLIO": 0.0234, "ETHE": 0.0234, "EDIP": 0.0233, "ADIS": 0.0233,
+	"OLAR": 0.0233, "DISI": 0.0233, "STAR": 0.0233, "REBB": 0.0233, "NCOM": 0.0233,
+	"RDIN": 0.0233, "ALBE": 0.0233, "IQUE": 0.0232, "STIN": 0.0232, "GEST": 0.0232,
+	"AVOR": 0.0232, "SEMP": 0.0232, "ISPE": 0.0232, "RISO": 0.0232, "LEGA": 0.0231,
+	"OESS": 0.0231, "AVVI": 0.0231, "EINT": 0.0231, "REAL": 0.0231, "EZZA": 0.0230,
+	"AGLI": 0.0230, "TRIO": 0.0230, "IZZO": 0.0230, "LEME": 0.0230, "TELE": 0.0229,
+	"ATIO": 0.0229, "DEVE": 0.0229, "RREN": 0.0229, "VOCA": 0.0229, "ONÈS": 0.0228,
+	"CHEI": 0.0228, "MPRE": 0.0228, "LVAL": 0.0228, "TAIN": 0.0228, "CAME": 0.0227,
+	"EARE": 0.0227, "ZAPO": 0.0227, "ICIP": 0.0226, "ARES": 0.0226, "TRAT": 0.0226,
+	"ATON": 0.0226, "TOAL": 0.0226, "RIVE": 0.0226, "UOVA": 0.0225, "ORID": 0.0225,
+	"MPLE": 0.0225, "MENS": 0.0225, "IATO": 0.0225, "SHEL": 0.0225, "NOTA": 0.0224,
+	"NICI": 0.0223, "EPAR": 0.0223, "ERIN": 0.0223, "ITOR": 0.0223, "UAND": 0.0223,
+	"LOCC": 0.0223, "MOST": 0.0223, "REAR": 0.0223, "PALI": 0.0222, "ALES": 0.0222,
+	"OMOD": 0.0222, "LLAD": 0.0222, "ESEC": 0.0222, "ELCO": 0.0222, "IRIZ": 0.0221,
+	"STIT": 0.0221, "TALA": 0.0221, "NENT": 0.0221, "EVIM": 0.0221, "TONE": 0.0220,
+	"INGA": 0.0220, "NAZI": 0.0220, "STES": 0.0220, "EUSE": 0.0220, "DELN": 0.0219,
+	"IGLI": 0.0219, "ACOM": 0.0219, "LLOD": 0.0219, "ERCA": 0.0219, "ATTI": 0.0219,
+	"REPE": 0.0219, "OLLE": 0.0219, "GINE": 0.0218, "ECRE": 0.0218, "SCAR": 0.0218,
+	"TIDA": 0.0217, "NESI": 0.0217, "VARE": 0.0217, "IDAL": 0.0217, "ESPR": 0.0217,
+	"HIED": 0.0217, "TIVA": 0.0216, "VERI": 0.0216, "TRIB": 0.0216, "LATO": 0.0216,
+	"GUEN": 0.0216, "NISU": 0.0215, "LAND": 0.0215, "QUEL": 0.0215, "ANEL": 0.0215,
+	"OPRI": 0.0215, "DILA": 0.0215, "AUTE": 0.0214, "PONI": 0.0214, "IARE": 0.0214,
+	"NCOD": 0.0214, "NGHE": 0.0214, "ATAS": 0.0214, "REAT": 0.0214, "PROC": 0.0214,
+	"NTHE": 0.0214, "ERIO": 0.0214, "ESEM": 0.0214, "HELL": 0.0214, "LOGI": 0.0214,
+	"LLES": 0.0213, "RETT": 0.0213, "ONÈU": 0.0213, "FUNZ": 0.0213, "UNZI": 0.0213,
+	"RACC": 0.0213, "TOMO": 0.0213, "EINS": 0.0213, "ARAB": 0.0213, "PTIO": 0.0213,
+	"TEIN": 0.0212, "ISIM": 0.0212, "IAVI": 0.0211, "RIDE": 0.0211, "MALE": 0.0211,
+	"LTER": 0.0211, "ISCE": 0.0211, "CODE": 0.0211, "IREL": 0.0210, "NATI": 0.0210,
+	"CEDI": 0.0209, "OMPR": 0.0209, "GOLA": 0.0209, "INEA": 0.0209, "ANNO": 0.0209,
+	"SPRE": 0.0209, "RIRE": 0.0209, "ERIS": 0.0209, "ELNO": 0.0208, "ILEP": 0.0208,
+	"ISUL": 0.0208, "VATO": 0.0208, "OPTI": 0.0208, "RAND": 0.0207, "AQUE": 0.0207,
+	"ASTI": 0.0207, "ILME": 0.0207, "EDAL": 0.0207, "ONSO": 0.0207, "ONIB": 0.0207,
+	"OCES": 0.0207, "SAND": 0.0206, "TTOC": 0.0206, "SSAR": 0.0206, "FERE": 0.0206,
+	"NECE": 0.0205, "MANO": 0.0205, "NÈUN": 0.0205, "NATT": 0.0205, "RENO": 0.0204,
+	"ERRI": 0.0204, "IPRO": 0.0204, "LLAQ": 0.0204, "ERAZ": 0.0203, "TACO": 0.0203,
+	"EFOR": 0.0203, "AMMA": 0.0203, "IORI": 0.0202, "ERVA": 0.0202, "PUÒE": 0.0202,
+	"UÒES": 0.0202, "TEDI": 0.0202, "TIDI": 0.0202, "CERC": 0.0201, "RNAM": 0.0201,
+	"RECE": 0.0200, "LTIP": 0.0200, "ATIN": 0.0200, "CAMB": 0.0200, "ATID": 0.0200,
+	"EILP": 0.0200, "ITAT": 0.0200, "ATOU": 0.0200, "LEST": 0.0199, "TADI": 0.0199,
+	"ORTO": 0.0199, "TNON": 0.0199, "ECES": 0.0199, "ÒESS": 0.0199, "CHEN": 0.0198,
+	"TITU": 0.0198, "TEPE": 0.0198, "ILAV": 0.0198, "EDUR": 0.0198, "TTOD": 0.0197,
+	"ORIT": 0.0197, "INED": 0.0197, "PODI": 0.0197, "VUOT": 0.0197, "LEAP": 0.0197,
+	"TEMP": 0.0197, "MAGI": 0.0197, "DESE": 0.0197, "EREA": 0.0196, "ODIU": 0.0196,
+	"ONEE": 0.0196, "GENT": 0.0196, "TIVI": 0.0196, "COUN": 0.0196, "ANGE": 0.0196,
+	"LAST": 0.0195, "ALED": 0.0195, "UALE": 0.0195, "FICH": 0.0195, "VORO": 0.0195,
+	"MODU": 0.0195, "MADI": 0.0194, "OLIN": 0.0194, "NTEC": 0.0194, "UELL": 0.0194,
+	"METT": 0.0194, "ODIL": 0.0194, "BINA": 0.0193, "TOIL": 0.0193, "ONAT": 0.0193,
+	"RGEN": 0.0193, "ALET": 0.0193, "ERED": 0.0193, "ONIN": 0.0192, "EDIR": 0.0192,
+	"DOCU": 0.0192, "OCUM": 0.0192, "CUME": 0.0192, "IPEN": 0.0191, "ANAL": 0.0191,
+	"STRU": 0.0191, "NTIN": 0.0191, "NTAT": 0.0191, "INUN": 0.0191, "TANO": 0.0191,
+	"NODI": 0.0191, "EMPO": 0.0191, "AREP": 0.0190, "RIBU": 0.0190, "CONV": 0.0190,
+	"REVI": 0.0190, "EICO": 0.0190, "CATE": 0.0190, "ODUL": 0.0190, "ABLE": 0.0190,
+	"ONOM": 0.0189, "LFOR": 0.0189, "FFER": 0.0189, "TICH": 0.0189, "DARD": 0.0189,
+	"UNAS": 0.0189, "THEC": 0.0189, "SECU": 0.0188, "UNAL": 0.0188, "IUNA": 0.0188,
+	"CHED": 0.0187, "SERI": 0.0187, "ILEL": 0.0187, "IMMA": 0.0187, "LTIM": 0.0187,
+	"EAPT": 0.0187, "UNAC": 0.0186, "SPOS": 0.0186, "SINT": 0.0186, "LIVE": 0.0186,
+	"ECHI": 0.0185, "MORI": 0.0185, "RECU": 0.0185, "SARI": 0.0185, "GNAL": 0.0185,
+	"LARC": 0.0185, "RIAB": 0.0185, "ALTA": 0.0184, "IMAD": 0.0184, "DINO": 0.0184,
+	"GRAF": 0.0184, "PERU": 0.0184, "MULT": 0.0184, "NVER": 0.0183, "HENO": 0.0183,
+	"ARTI": 0.0183, "COLO": 0.0183, "NONI": 0.0183, "LTRI": 0.0183, "ENCA": 0.0183,
+	"ILVA": 0.0183, "CCOU": 0.0183, "IUNT": 0.0182, "PONE": 0.0182, "TEDE": 0.0182,
+	"LESP": 0.0182, "INAZ": 0.0182, "TINA": 0.0181, "EREC": 0.0181, "SUNA": 0.0181,
+	"NULL": 0.0181, "ECED": 0.0181, "EGAM": 0.0181, "DEIP": 0.0181, "RNAT": 0.0181,
+	"ECUP": 0.0181, "CUPE": 0.0181, "RATA": 0.0181, "NSEN": 0.0181, "ILNU": 0.0181,
+	"IDEI": 0.0181, "MMAG": 0.0181, "TREA": 0.0181, "NTEA": 0.0180, "ICUR": 0.0180,
+	"GAME": 0.0180, "DIES": 0.0180, "UNGE": 0.0180, "EINI": 0.0180, "NNOM": 0.0180,
+	"HEUS": 0.0180, "OMPA": 0.0180, "DINA": 0.0179, "TUAL": 0.0179, "RERI": 0.0179,
+	"EIPA": 0.0179, "READ": 0.0179, "NTON": 0.0179, "RENZ": 0.0179, "UNGH": 0.0178,
+	"SICU": 0.0178, "VERR": 0.0178, "DEIF": 0.0178, "IVEL": 0.0178, "HANG": 0.0178,
+	"VALL": 0.0177, "ACCI": 0.0177, "PASS": 0.0177, "OPPO": 0.0177, "OVAR": 0.0177,
+	"LEZI": 0.0177, "OMID": 0.0177, "ICAN": 0.0176, "IORE": 0.0176, "ELAC": 0.0176,
+	"RADI": 0.0176, "MODE": 0.0176, "LAFI": 0.0176, "DIDE": 0.0176, "AFIN": 0.0176,
+	"UNTI": 0.0176, "EVOC": 0.0176, "MBIA": 0.0175, "CALE": 0.0175, "ONPU": 0.0175,
+	"LAPR": 0.0175, "PRIR": 0.0175, "CCIA": 0.0174, "DEST": 0.0174, "ALIS": 0.0174,
+	"ECUZ": 0.0174, "CUZI": 0.0174, "OMIN": 0.0174, "ZZAZ": 0.0174, "ENOM": 0.0174,
+	"TINE": 0.0174, "DISC": 0.0174, "OPRE": 0.0174, "REUS": 0.0174, "REDU": 0.0174,
+	"VELL": 0.0174, "SENO": 0.0173, "RIGH": 0.0173, "VOCE": 0.0173, "EIND": 0.0173,
+	"RELO": 0.0173, "OSTI": 0.0173, "ROPR": 0.0173, "ERSO": 0.0173, "TTOM": 0.0173,
+	"ORIA": 0.0172, "BIAN": 0.0172, "ATAB": 0.0172, "NOND": 0.0172, "ZAZI": 0.0172,
+	"LEPE": 0.0172, "NEPE": 0.0172, "ADES": 0.0172, "LACA": 0.0171, "ITAL": 0.0171,
+	"MINI": 0.0171, "URAT": 0.0171, "LPER": 0.0171, "ERME": 0.0171, "CONI": 0.0170,
+	"LEDA": 0.0170, "DAUN": 0.0170, "SCHE": 0.0170, "NCAN": 0.0170, "ETER": 0.0170,
+	"MEMO": 0.0170, "LNUM": 0.0170, "OLLA": 0.0170, "ENDO": 0.0170, "NTOS": 0.0170,
+	"CHAN": 0.0170, "THEU": 0.0170, "OPRO": 0.0169, "PERT": 0.0169, "OMPL": 0.0169,
+	"PROB": 0.0169, "ILEV": 0.0169, "ITTU": 0.0169, "TADE": 0.0169, "IALE": 0.0169,
+	"ELEZ": 0.0169, "OLOI": 0.0169, "IDER": 0.0169, "THIS": 0.0169, "ISAB": 0.0168,
+	"IESE": 0.0168, "ALIN": 0.0168, "TOSU": 0.0168, "EMOR": 0.0168, "NITA": 0.0168,
+	"DATE": 0.0168, "ESCO": 0.0168, "TEDA": 0.0168, "STHE": 0.0168, "LADE": 0.0167,
+	"EDIU": 0.0167, "TESS": 0.0167, "IPOS": 0.0167, "CAMP": 0.0166, "LECH": 0.0166,
+	"CADI": 0.0166, "UOVO": 0.0166, "HEAD": 0.0166, "TENO": 0.0166, "TIDE": 0.0166,
+	"LASC": 0.0166, "EDIF": 0.0166, "SOLA": 0.0165, "ORDI": 0.0165, "TESA": 0.0165,
+	"TALI": 0.0165, "OREI": 0.0165, "NOCO": 0.0165, "CIAT": 0.0165, "UTOM": 0.0165,
+	"LICO": 0.0165, "ERIA": 0.0164, "AMER": 0.0164, "ITOD": 0.0164, "DONO": 0.0164,
+	"RRAT": 0.0164, "TIRE": 0.0164, "NONT": 0.0164, "IUTA": 0.0164, "STRO": 0.0163,
+	"ADEI": 0.0163, "ALEN": 0.0163, "ADIM": 0.0163, "DALI": 0.0163, "NEST": 0.0163,
+	"TTOS": 0.0163, "GIST": 0.0163, "EDIN": 0.0162, "DITA": 0.0162, "ICER": 0.0162,
+	"NOST": 0.0162, "OREP": 0.0162, "PROP": 0.0162, "IVAL": 0.0162, "TOCH": 0.0162,
+	"ELAS": 0.0162, "MPAT": 0.0162, "IVIS": 0.0162, "GION": 0.0161, "NTID": 0.0161,
+	"MODA": 0.0161, "IERR": 0.0161, "NUTI": 0.0161, "LODI": 0.0161, "NSON": 0.0161,
+	"EMAN": 0.0161, "OGNI": 0.0161, "ODOP": 0.0161, "LLAV": 0.0161, "MPON": 0.0161,
+	"LERE": 0.0160, "DERA": 0.0160, "PLET": 0.0160, "SABI": 0.0160, "TATE": 0.0160,
+	"DEIC": 0.0160, "LIDI": 0.0160, "PREF": 0.0160, "TTON": 0.0160, "ATCH": 0.0160,
+	"OMMA": 0.0160, "OGIN": 0.0160, "IONS": 0.0159, "IPOD": 0.0159, "AICO": 0.0159,
+	"ONIL": 0.0159, "ADAT": 0.0159, "ODIV": 0.0159, "NEPR": 0.0159, "EGIS": 0.0159,
+	"ONUN": 0.0159, "ICOL": 0.0158, "TERV": 0.0158, "ANUO": 0.0158, "EPRO": 0.0158,
+	"CANT": 0.0158, "ELEM": 0.0158, "INVE": 0.0158, "GINA": 0.0158, "SPAZ": 0.0158,
+	"TILE": 0.0158, "DEBU": 0.0157, "ORES": 0.0157, "PUNT": 0.0157, "ERUN": 0.0157,
+	"ERNA": 0.0156, "UNTA": 0.0156, "SODI": 0.0156, "PAZI": 0.0156, "RVAL": 0.0156,
+	"EALT": 0.0156, "REAM": 0.0156, "OTHE": 0.0156, "OPIA": 0.0155, "IUSA": 0.0155,
+	"ATTR": 0.0155, "UENT": 0.0155, "IMOD": 0.0155, "PATI": 0.0155, "INGL": 0.0155,
+	"FTHE": 0.0155, "ELPA": 0.0154, "NGLE": 0.0154, "TRAS": 0.0154, "LETA": 0.0154,
+	"AMOD": 0.0154, "EREG": 0.0153, "ONIC": 0.0153, "IMAL": 0.0153, "EDIO": 0.0153,
+	"PREC": 0.0153, "GHEZ": 0.0153, "HEZZ": 0.0153, "EPOS": 0.0153, "LETR": 0.0153,
+	"NDOI": 0.0153, "IMIT": 0.0153, "REPR": 0.0153, "LESO": 0.0153, "IGIT": 0.0153,
+	"RABO": 0.0153, "RODE": 0.0152, "TADA": 0.0152, "TOPR": 0.0152, "PORA": 0.0152,
+	"TTOP": 0.0152, "ORGE": 0.0152, "UNNO": 0.0152, "LALB": 0.0152, "CONU": 0.0152,
+	"USAN": 0.0151, "EQUI": 0.0151, "ORAN": 0.0151, "AFIR": 0.0151, "OSCR": 0.0151,
+	"VEES": 0.0151, "ARTE": 0.0151, "SECO": 0.0151, "INTH": 0.0151, "LEAN": 0.0150,
+	"ILOC": 0.0150, "NODE": 0.0150, "LECR": 0.0150, "EDEN": 0.0150, "UNME": 0.0150,
+	"GADI": 0.0150, "IGHE": 0.0150, "ESCA": 0.0150, "LSIS": 0.0150, "ATIB": 0.0150,
+	"TIME": 0.0149, "TERR": 0.0149, "THEA": 0.0149, "EQUA": 0.0149, "ANCE": 0.0149,
+	"EGOL": 0.0149, "EPRI": 0.0149, "ALCO": 0.0149, "HEIN": 0.0149, "TTUA": 0.0149,
+	"SORG": 0.0149, "NTEP": 0.0149, "PERR": 0.0149, "GLES": 0.0149, "EGIO": 0.0148,
+	"NÈST": 0.0148, "ELAP": 0.0148, "STAP": 0.0148, "UNPA": 0.0148, "ERIG": 0.0148,
+	"NDOS": 0.0148, "MMAN": 0.0148, "VISI": 0.0148, "APOS": 0.0147, "ECCH": 0.0147,
+	"LEMA": 0.0147, "IPRE": 0.0147, "RANE": 0.0147, "RMAL": 0.0147, "EDII": 0.0147,
+	"REGO": 0.0147, "ILEO": 0.0147, "TAIL": 0.0147, "NEDA": 0.0147, "ISTI": 0.0147,
+	"ATED": 0.0147, "TOSI": 0.0147, "OCAT": 0.0147, "EBUG": 0.0146, "FRAN": 0.0146,
+	"LAPA": 0.0146, "CHEL": 0.0146, "LSER": 0.0146, "NPUÒ": 0.0146, "ATAD": 0.0146,
+	"NTIS": 0.0146, "EMPI": 0.0146, "EAPR": 0.0146, "EAGG": 0.0146, "OESE": 0.0146,
+	"ASEN": 0.0146, "EVAL": 0.0145, "CORD": 0.0145, "ASTR": 0.0145, "DISE": 0.0145,
+	"LTRO": 0.0145, "ATII": 0.0145, "APAR": 0.0145, "NUTO": 0.0145, "ORIG": 0.0145,
+	"TIPE": 0.0145, "CHEA": 0.0145, "NGON": 0.0145, "GONO": 0.0145, "DIQU": 0.0145,
+	"NIST": 0.0144, "ISCO": 0.0144, "REDA": 0.0144, "ONVE": 0.0144, "ISIS": 0.0144,
+	"IPRI": 0.0144, "TRAC": 0.0144, "BLEM": 0.0144, "NQUE": 0.0144, "NNES": 0.0143,
+	"DIPA": 0.0143, "EAZI": 0.0143, "INVI": 0.0143, "EVEE": 0.0143, "OMAT": 0.0143,
+	"LEMO": 0.0143, "LATA": 0.0143, "CALI": 0.0142, "ERPR": 0.0142, "RNIT": 0.0142,
+	"AIUT": 0.0142, "VISO": 0.0142, "EFER": 0.0142, "EBAS": 0.0142, "NORM": 0.0141,
+	"TREB": 0.0141, "EINV": 0.0141, "MOTO": 0.0141, "SUCC": 0.0141, "UCCE": 0.0141,
+	"ATUR": 0.0141, "NTIP": 0.0141, "ERLE": 0.0141, "REFE": 0.0141, "PERO": 0.0141,
+	"EHEL": 0.0141, "REOL": 0.0141, "DTHE": 0.0141, "RELI": 0.0140, "EMAI": 0.0140,
+	"ODIA": 0.0140, "ELOS": 0.0140, "EVIS": 0.0140, "ATAN": 0.0140, "ESIN": 0.0140,
+	"ELEA": 0.0140, "LESC": 0.0140, "RIPR": 0.0140, "ICAL": 0.0139, "EINC": 0.0139,
+	"OUSA": 0.0139, "ELEG": 0.0139, "NSER": 0.0139, "TTOA": 0.0139, "ABAS": 0.0139,
+	"ELSU": 0.0138, "PROV": 0.0138, "ASSO": 0.0138, "VENG": 0.0138, "OCED": 0.0138,
+	"ASCR": 0.0138, "BOLO": 0.0138, "LOPE": 0.0138, "MPLI": 0.0138, "TIVE": 0.0138,
+	"NDOL": 0.0138, "INVO": 0.0138, "SOLE": 0.0137, "NICO": 0.0137, "ARIS": 0.0137,
+	"ITUI": 0.0137, "ESEL": 0.0137, "UNTO": 0.0137, "TIBI": 0.0137, "IABI": 0.0137,
+	"LOGG": 0.0137, "OFTH": 0.0137, "ESET": 0.0136, "TARI": 0.0136, "ATAC": 0.0136,
+	"STOD": 0.0136, "UTOD": 0.0136, "SALT": 0.0136, "LEAS": 0.0136, "IINP": 0.0136,
+	"ZATO": 0.0136, "TTEL": 0.0136, "REHE": 0.0136, "PATC": 0.0136, "HEDE": 0.0135,
+	"ATIS": 0.0135, "INIS": 0.0135, "ESIA": 0.0135, "NTIT": 0.0135, "UNAV": 0.0135,
+	"ARED": 0.0135, "CREO": 0.0135, "SSEG": 0.0135, "NACO": 0.0134, "RINO": 0.0134,
+	"ALTE": 0.0134, "OTRE": 0.0134, "LARG": 0.0134, "OPOS": 0.0134, "EDID": 0.0134,
+	"NATA": 0.0134, "IGAD": 0.0134, "CHEC": 0.0134, "AOPZ": 0.0134, "VVIS": 0.0134,
+	"ONIP": 0.0134, "ROBL": 0.0134, "LAQU": 0.0134, "UNAP": 0.0134, "ERAN": 0.0133,
+	"NDON": 0.0133, "NIDE": 0.0133, "UTTE": 0.0133, "NALT": 0.0133, "EDAT": 0.0133,
+	"TIAL": 0.0133, "HESE": 0.0133, "OLIC": 0.0133, "EGGI": 0.0133, "ILRE": 0.0133,
+	"EASE": 0.0133, "APRO": 0.0132, "ONEO": 0.0132, "TOPO": 0.0132, "ONHA": 0.0132,
+	"LLAF": 0.0132, "ODIR": 0.0132, "NTOA": 0.0132, "RATE": 0.0132, "AREQ": 0.0132,
+	"ESPO": 0.0132, "NVOC": 0.0132, "NTIA": 0.0131, "NONH": 0.0131, "STAI": 0.0131,
+	"REFI": 0.0131, "EAPP": 0.0131, "CANO": 0.0131, "NUNA": 0.0131, "CIFR": 0.0131,
+	"ONIA": 0.0130, "DECI": 0.0130, "ERMA": 0.0130, "DEGL": 0.0130, "ERAR": 0.0130,
+	"TCON": 0.0130, "OCAZ": 0.0130, "ERVI": 0.0130, "RAME": 0.0130, "VONO": 0.0130,
+	"ATTA": 0.0130, "ERLO": 0.0130, "INTA": 0.0130, "LEAL": 0.0130, "INSE": 0.0130,
+	"ENGO": 0.0130, "IMPL": 0.0130, "AINF": 0.0130, "OBLE": 0.0130, "VIDE": 0.0129,
+	"SUPE": 0.0129, "ILEM": 0.0129, "OUNA": 0.0129, "ATUT": 0.0129, "LLEL": 0.0129,
+	"UORI": 0.0129, "EOLO": 0.0129, "ELPR": 0.0128, "RESU": 0.0128, "ICOR": 0.0128,
+	"APRE": 0.0128, "OREA": 0.0128, "ANCO": 0.0128, "EREM": 0.0128, "TEND": 0.0128,
+	"TEIL": 0.0128, "IRIF": 0.0128, "IAMA": 0.0127, "ICAC": 0.0127, "ILSE": 0.0127,
+	"REAZ": 0.0127, "ONLA": 0.0127, "SSOC": 0.0127, "AREN": 0.0127, "RESP": 0.0127,
+	"NTAS": 0.0127, "OCOR": 0.0127, "AMPO": 0.0127, "ONIM": 0.0127, "STAO": 0.0127,
+	"REVE": 0.0127, "LNOR": 0.0126, "VECC": 0.0126, "OCCO": 0.0126, "CONG": 0.0126,
+	"IFRA": 0.0126, "COPI": 0.0126, "VERT": 0.0126, "LMOD": 0.0126, "SATA": 0.0126,
+	"NCLU": 0.0126, "ATAL": 0.0125, "TANE": 0.0125, "ALEI": 0.0125, "RNON": 0.0125,
+	"VATA": 0.0125, "TDEL": 0.0125, "OMEI": 0.0125, "UTOR": 0.0125, "MARI": 0.0124,
+	"THEN": 0.0124, "RETA": 0.0124, "THER": 0.0124, "ONDO": 0.0124, "POTR": 0.0124,
+	"ONET": 0.0124, "DIDI": 0.0124, "OMPI": 0.0124, "ESOL": 0.0124, "IMAN": 0.0124,
+	"ZZAI": 0.0124, "IMME": 0.0124, "TABA": 0.0123, "DELT": 0.0123, "LICI": 0.0123,
+	"NPAC": 0.0123, "UNOS": 0.0123, "ONOI": 0.0123, "OOPZ": 0.0123, "IATA": 0.0123,
+	"FUOR": 0.0123, "LLOP": 0.0123, "FETT": 0.0123, "EVIE": 0.0123, "EREH": 0.0123,
+	"ORSI": 0.0122, "NALA": 0.0122, "ICEV": 0.0122, "LEPR": 0.0122, "ASPE": 0.0122,
+	"ATAP": 0.0122, "NELE": 0.0122, "TOSE": 0.0122, "NZAD": 0.0122, "APPA": 0.0122,
+	"ELAR": 0.0122, "DULO": 0.0122, "REBA": 0.0122, "AMAN": 0.0121, "LOIN": 0.0121,
+	"DICI": 0.0121, "NDES": 0.0121, "ENES": 0.0121, "CHIU": 0.0121, "LLOC": 0.0121,
+	"ETRA": 0.0121, "SADE": 0.0121, "ELAT": 0.0121, "UNSE": 0.0121, "OINT": 0.0120,
+	"OMER": 0.0120, "IREC": 0.0120, "ERAL": 0.0120, "GRAN": 0.0120, "NCES": 0.0120,
+	"ACOR": 0.0120, "RIAN": 0.0120, "EDIM": 0.0120, "LCOL": 0.0120, "EILN": 0.0120,
+	"IONO": 0.0120, "LUTE": 0.0120, "ONOC": 0.0120, "TISO": 0.0120, "IMOS": 0.0120,
+	"OLOC": 0.0120, "SOUR": 0.0120, "OQUE": 0.0120, "IELE": 0.0120, "LSUD": 0.0119,
+	"EDIE": 0.0119, "ENEI": 0.0119, "OMEC": 0.0119, "TODO": 0.0119, "IACC": 0.0119,
+	"ALAR": 0.0119, "ONFL": 0.0119, "ONOA": 0.0119, "EFFE": 0.0119, "MADE": 0.0118,
+	"RECH": 0.0118, "ATIA": 0.0118, "LEIM": 0.0118, "RAGG": 0.0118, "CHEP": 0.0118,
+	"RIDA": 0.0118, "OURC": 0.0118, "DIGI": 0.0118, "NDEF": 0.0117, "ASSA": 0.0117,
+	"ONOR": 0.0117, "LORI": 0.0117, "OTTE": 0.0117, "RRET": 0.0117, "ATAA": 0.0117,
+	"OCAR": 0.0117, "OIMP": 0.0117, "LITT": 0.0117, "CONC": 0.0117, "IAGG": 0.0117,
+	"FFET": 0.0117, "IINC": 0.0117, "CORA": 0.0117, "URCE": 0.0117, "OMEA": 0.0117,
+	"AREG": 0.0116, "CESE": 0.0116, "THEF": 0.0116, "LOCO": 0.0116, "SULT": 0.0116,
+	"ISOR": 0.0116, "GORI": 0.0116, "AILN": 0.0116, "TASS": 0.0116, "TERA": 0.0116,
+	"ETIC": 0.0116, "NFLI": 0.0116, "MITA": 0.0116, "ONOD": 0.0116, "ORAR": 0.0116,
+	"INCL": 0.0116, "LSOT": 0.0116, "RIGI": 0.0115, "LEVE": 0.0115, "ONEM": 0.0115,
+	"DELD": 0.0115, "RONO": 0.0115, "TPER": 0.0115, "LOSC": 0.0115, "LALE": 0.0115,
+	"VECE": 0.0115, "MAIN": 0.0115, "ETUT": 0.0115, "IALL": 0.0115, "TTOR": 0.0115,
+	"NADI": 0.0115, "DISO": 0.0115, "DIPR": 0.0115, "ORIC": 0.0115, "ILPA": 0.0115,
+	"MPIL": 0.0115, "EINO": 0.0115, "MITE": 0.0115, "VALU": 0.0115, "TOSO": 0.0115,
+	"TTID": 0.0115, "GITR": 0.0115, "IFFE": 0.0115, "DELM": 0.0114, "LESI": 0.0114,
+	"LTAT": 0.0114, "EDIV": 0.0114, "ELOC": 0.0114, "SSIM": 0.0114, "ALEA": 0.0114,
+	"NVEC": 0.0114, "NTOP": 0.0114, "ONVI": 0.0114, "IDIC": 0.0114, "ONEF": 0.0114,
+	"REVO": 0.0114, "ERDE": 0.0113, "RIOD": 0.0113, "SICO": 0.0113, "ULTA": 0.0113,
+	"TESE": 0.0113, "NTEE": 0.0113, "LEVA": 0.0113, "AALL": 0.0113, "CASO": 0.0113,
+	"NNON": 0.0113, "NOTE": 0.0113, "CLUS": 0.0113, "UALM": 0.0113, "FROM": 0.0113,
+	"IFOR": 0.0112, "ODIE": 0.0112, "CAIL": 0.0112, "ELAL": 0.0112, "OQUA": 0.0112,
+	"RAIN": 0.0112, "MIDI": 0.0112, "IOUT": 0.0112, "NIFI": 0.0112, "IGIN": 0.0112,
+	"DEBI": 0.0112, "TIMO": 0.0112, "SING": 0.0111, "ACAC": 0.0111, "ESUL": 0.0111,
+	"ATOE": 0.0111, "IRET": 0.0111, "NRIC": 0.0111, "DOCO": 0.0111, "OVRA": 0.0111,
+	"CHIT": 0.0111, "SOLU": 0.0111, "LTRE": 0.0111, "EBIA": 0.0111, "NDOC": 0.0111,
+	"ONEV": 0.0110, "CETT": 0.0110, "NDER": 0.0110, "GLIS": 0.0110, "NITI": 0.0110,
+	"MAIL": 0.0110, "EREP": 0.0110, "NOIN": 0.0110, "SCAD": 0.0110, "STOA": 0.0110,
+	"INAM": 0.0110, "NDIS": 0.0110, "CAUS": 0.0110, "CIAL": 0.0110, "PRIO": 0.0110,
+	"TAOP": 0.0110, "RASS": 0.0110, "MOTE": 0.0110, "INAN": 0.0109, "ASTA": 0.0109,
+	"ESPA": 0.0109, "TTAG": 0.0109, "NZIA": 0.0109, "LODE": 0.0109, "AVEP": 0.0109,
+	"ESCE": 0.0109, "IDIF": 0.0109, "ZZAL": 0.0109, "RILO": 0.0109, "RANN": 0.0109,
+	"NGOL": 0.0109, "LIBR": 0.0109, "STOC": 0.0109, "DIAP": 0.0108, "GLIE": 0.0108,
+	"RORI": 0.0108, "STOP": 0.0108, "ALIA": 0.0108, "OSTE": 0.0108, "TACH": 0.0108,
+	"EINM": 0.0108, "ONEÈ": 0.0108, "ISIC": 0.0108, "NMES": 0.0108, "ISIN": 0.0108,
+	"ATES": 0.0108, "DIOU": 0.0108, "ELES": 0.0108, "OSUL": 0.0108, "OCHI": 0.0108,
+	"SOCO": 0.0108, "ISIO": 0.0108, "SERA": 0.0108, "TOTH": 0.0108, "LAPO": 0.0107,
+	"RAFI": 0.0107, "AIMP": 0.0107, "CHEM": 0.0107, "ILEG": 0.0107, "ENEU": 0.0107,
+	"PERD": 0.0107, "SOVR": 0.0107, "IATT": 0.0107, "MPIO": 0.0107, "FLIT": 0.0107,
+	"ANES": 0.0107, "EILM": 0.0107, "EUNF": 0.0107, "HITE": 0.0107, "ITES": 0.0107,
+	"SIAS": 0.0107, "OVAL": 0.0107, "MOVE": 0.0107, "TTHE": 0.0107, "ISTO": 0.0106,
+	"IBUT": 0.0106, "ABEL": 0.0106, "RANO": 0.0106, "ITOI": 0.0106, "TAAL": 0.0106,
+	"STAS": 0.0106, "TOFI": 0.0106, "OMEU": 0.0106, "OSEN": 0.0106, "EILR": 0.0106,
+	"NSID": 0.0106, "HOME": 0.0106, "RONT": 0.0106, "ASHE": 0.0106, "RISC": 0.0106,
+	"RTHE": 0.0105, "DINE": 0.0105, "ASCI": 0.0105, "ARAN": 0.0105, "EGRO": 0.0105,
+	"HOST": 0.0105, "CURE": 0.0105, "PILA": 0.0105, "RARI": 0.0105, "OVIE": 0.0105,
+	"ZADI": 0.0105, "ILMO": 0.0105, "IATI": 0.0105, "ITRE": 0.0105, "LAQA": 0.0105,
+	"NDOV": 0.0104, "SPET": 0.0104, "PETT": 0.0104, "SEPA": 0.0104, "UOTO": 0.0104,
+	"OINC": 0.0104, "LETE": 0.0104, "TIPL": 0.0104, "ISSO": 0.0104, "USOD": 0.0104,
+	"ALSI": 0.0104, "SPOR": 0.0104, "ICAI": 0.0104, "LMER": 0.0103, "MATA": 0.0103,
+	"OREC": 0.0103, "ADAL": 0.0103, "TOGR": 0.0103, "LLAZ": 0.0103, "LCER": 0.0103,
+	"STAD": 0.0103, "RITM": 0.0103, "ARAM": 0.0103, "LOUT": 0.0103, "TRAN": 0.0103,
+	"ANZA": 0.0103, "EADE": 0.0103, "EMOV": 0.0103, "SIPU": 0.0103, "RIST": 0.0102,
+	"RITA": 0.0102, "RCON": 0.0102, "HINA": 0.0102, "OLON": 0.0102, "OSTO": 0.0102,
+	"REAU": 0.0102, "HEMA": 0.0102, "TTRI": 0.0102, "AILC": 0.0102, "OINS": 0.0102,
+	"ALGO": 0.0102, "NTEU": 0.0102, "ESAD": 0.0102, "TAUN": 0.0102, "ASED": 0.0102,
+	"ILSI": 0.0102, "TETT": 0.0102, "FISS": 0.0102, "RSON": 0.0102, "NGER": 0.0102,
+	"EILT": 0.0102, "ITUT": 0.0102, "AGIT": 0.0102, "OLIV": 0.0101, "NICA": 0.0101,
+	"TIMA": 0.0101, "LIIN": 0.0101, "ICEN": 0.0101, "SEIN": 0.0101, "OLOS": 0.0101,
+	"ATIP": 0.0101, "NELC": 0.0101, "IDEB": 0.0101, "NDIV": 0.0101, "AVIM": 0.0101,
+	"ATAR": 0.0100, "IDIP": 0.0100, "ODIP": 0.0100, "ILRI": 0.0100, "PERP": 0.0100,
+	"MOSS": 0.0100, "NTIF": 0.0100, "SOST": 0.0100, "LALI": 0.0100, "HIAM": 0.0100,
+	"ONOP": 0.0100, "IAPT": 0.0100, "THES": 0.0100, "ARDI": 0.0099, "BELL": 0.0099,
+	"ZZOD": 0.0099, "IINS": 0.0099, "POLA": 0.0099, "IETT": 0.0099, "ITAD": 0.0099,
+	"NEAP": 0.0099, "LLOG": 0.0099, "TTIN": 0.0099, "NEES": 0.0099, "EAME": 0.0099,
+	"LBRA": 0.0099, "ITTI": 0.0098, "STER": 0.0098, "ITAN": 0.0098, "CANA": 0.0098,
+	"ONGO": 0.0098, "OLET": 0.0098, "CCET": 0.0098, "FALL": 0.0098, "SSIV": 0.0098,
+	"DOIN": 0.0098, "ATTU": 0.0098, "DOVI": 0.0098, "TRAD": 0.0098, "RNOD": 0.0098,
+	"OSOL": 0.0098, "BIET": 0.0098, "UPDA": 0.0098, "PDAT": 0.0098, "ECEN": 0.0097,
+	"NTIV": 0.0097, "TABE": 0.0097, "METR": 0.0097, "EUTI": 0.0097, "ICOD": 0.0097,
+	"AULT": 0.0097, "NGES": 0.0097, "RASC": 0.0097, "MEIN": 0.0097, "EAUN": 0.0097,
+	"IOGG": 0.0097, "VIMI": 0.0097, "INDE": 0.0096, "DIDA": 0.0096, "RIES": 0.0096,
+	"DITE": 0.0096, "ICED": 0.0096, "ONEG": 0.0096, "ILEU": 0.0096, "NISC": 0.0096,
+	"ENUM": 0.0096, "TING": 0.0096, "OREM": 0.0096, "ENEC": 0.0096, "SIAN": 0.0096,
+	"ELAD": 0.0096, "ISCR": 0.0096, "ESOR": 0.0096, "EANA": 0.0096, "AINM": 0.0096,
+	"OBIE": 0.0096, "EESI": 0.0096, "COMU": 0.0096, "SIGN": 0.0096, "CIMA": 0.0096,
+	"HERE": 0.0096, "APTG": 0.0096, "PTGE": 0.0096, "THEG": 0.0096, "GUIN": 0.0095,
+	"MIDE": 0.0095, "LPRI": 0.0095, "TAGL": 0.0095, "EILS": 0.0095, "ONCA": 0.0095,
+	"ONÈP": 0.0095, "UNIN": 0.0095, "ILEF": 0.0095, "OCCA": 0.0095, "TTAM": 0.0095,
+	"ZADE": 0.0095, "EDEV": 0.0095, "ESEI": 0.0095, "OSCO": 0.0095, "SEIL": 0.0095,
+	"EVEN": 0.0095, "OMEF": 0.0095, "IIND": 0.0095, "OFOR": 0.0095, "IBRE": 0.0095,
+	"BRER": 0.0095, "ENOT": 0.0095, "TGET": 0.0095, "IFIE": 0.0095, "AFIL": 0.0094,
+	"AVEN": 0.0094, "LEMI": 0.0094, "IVEN": 0.0094, "GLII": 0.0094, "DETE": 0.0094,
+	"ITMO": 0.0094, "OPPI": 0.0094, "TEUN": 0.0094, "ASES": 0.0094, "ONAC": 0.0094,
+	"EISI": 0.0094, "OIND": 0.0094, "PERG": 0.0094, "AVED": 0.0094, "VEDI": 0.0094,
+	"AQUA": 0.0094, "RERE": 0.0094, "LUSO": 0.0094, "ATTO": 0.0094, "OMUN": 0.0094,
+	"STIM": 0.0094, "HEFI": 0.0094, "OVES": 0.0093, "ADEC": 0.0093, "MANI": 0.0093,
+	"NADE": 0.0093, "OPOL": 0.0093, "ESID": 0.0093, "MECO": 0.0093, "RMES": 0.0093,
+	"AFUN": 0.0093, "NVIA": 0.0093, "LIDE": 0.0093, "AMET": 0.0093, "DOPE": 0.0093,
+	"UNAF": 0.0093, "DINP": 0.0093, "OILC": 0.0093, "LEVI": 0.0093, "UNAD": 0.0093,
+	"SARÀ": 0.0093, "MESE": 0.0093, "NPER": 0.0093, "DEVO": 0.0093, "UNNU": 0.0093,
+	"IUTE": 0.0093, "INQU": 0.0093, "ELSI": 0.0093, "INSI": 0.0093, "ANDT": 0.0093,
+	"EAND": 0.0092, "TEAL": 0.0092, "UNAN": 0.0092, "INAI": 0.0092, "SODE": 0.0092,
+	"ARDO": 0.0092, "RASE": 0.0092, "TAGI": 0.0092, "LLED": 0.0092, "AVAL": 0.0092,
+	"ECOL": 0.0092, "DODI": 0.0092, "META": 0.0092, "PROT": 0.0092, "RESO": 0.0092,
+	"ALEC": 0.0092, "FSET": 0.0092, "AINC": 0.0092, "IPUÒ": 0.0092, "AREE": 0.0092,
+	"ADIU": 0.0092, "FAUL": 0.0092, "EREV": 0.0092, "ATAI": 0.0092, "TOES": 0.0092,
+	"GING": 0.0092, "MAIU": 0.0092, "EAUT": 0.0091, "ESAR": 0.0091, "NNUL": 0.0091,
+	"ULLO": 0.0091, "LGOR": 0.0091, "ELLU": 0.0091, "SESS": 0.0091, "IARG": 0.0091,
+	"ZERO": 0.0091, "UNGI": 0.0091, "OZIO": 0.0091, "TTIC": 0.0091, "TIMU": 0.0091,
+	"LLAN": 0.0090, "MALI": 0.0090, "SEMB": 0.0090, "RAUN": 0.0090, "IADI": 0.0090,
+	"TRON": 0.0090, "IDID": 0.0090, "ONIE": 0.0090, "MPAI": 0.0090, "ÈUNA": 0.0090,
+	"CISO": 0.0090, "IVIA": 0.0090, "DEFA": 0.0090, "GITA": 0.0090, "MBER": 0.0090,
+	"MUTI": 0.0090, "ALUE": 0.0090, "AINT": 0.0089, "ERSE": 0.0089, "LAVA": 0.0089,
+	"ASCO": 0.0089, "LICE": 0.0089, "EDIL": 0.0089, "IMER": 0.0089, "LAME": 0.0089,
+	"DIER": 0.0089, "DOVR": 0.0089, "ÈPOS": 0.0089, "NEUN": 0.0089, "FARE": 0.0089,
+	"PERF": 0.0089, "OLOP": 0.0089, "STAC": 0.0089, "DELB": 0.0089, "RIET": 0.0089,
+	"DICH": 0.0089, "DATO": 0.0089, "EFAU": 0.0089, "PARE": 0.0089, "OSIM": 0.0089,
+	"NONF": 0.0089, "NCHI": 0.0089, "ALVA": 0.0089, "AIUS": 0.0089, "RITO": 0.0088,
+	"LMES": 0.0088, "VENT": 0.0088, "MASS": 0.0088, "ALEP": 0.0088, "LLAM": 0.0088,
+	"INGO": 0.0088, "DIUS": 0.0088, "OCOL": 0.0088, "TOTR": 0.0088, "AILS": 0.0088,
+	"HASH": 0.0088, "RPRE": 0.0088, "ZZAD": 0.0088, "ASIN": 0.0088, "PRIE": 0.0088,
+	"ROOT": 0.0088, "RTED": 0.0088, "PERN": 0.0088, "ETAR": 0.0088, "EMBR": 0.0088,
+	"LLIM": 0.0088, "EUNN": 0.0088, "LGRU": 0.0088, "OSIT": 0.0088, "LULT": 0.0088,
+	"LEPA": 0.0088, "TESI": 0.0088, "VRAS": 0.0088, "IMOZ": 0.0088, "MOZI": 0.0088,
+	"AVAR": 0.0088, "AUNO": 0.0088, "LEFI": 0.0088, "AMIC": 0.0088, "IANC": 0.0088,
+	"IEME": 0.0088, "EACC": 0.0087, "ORIZ": 0.0087, "NORI": 0.0087, "NESP": 0.0087,
+	"DENO": 0.0087, "ILSU": 0.0087, "IDOP": 0.0087, "ILTI": 0.0087, "HEDI": 0.0087,
+	"IGRU": 0.0087, "SEDI": 0.0087, "OFFS": 0.0087, "FFSE": 0.0087, "LASS": 0.0087,
+	"LORA": 0.0087, "AESE": 0.0087, "BBEE": 0.0087, "ITET": 0.0087, "ELET": 0.0087,
+	"VOCI": 0.0087, "LREC": 0.0087, "EUNC": 0.0087, "EDIG": 0.0087, "THEO": 0.0087,
+	"IMUT": 0.0087, "DEFS": 0.0087, "NTEM": 0.0086, "ERLI": 0.0086, "REMA": 0.0086,
+	"TAMA": 0.0086, "ATOL": 0.0086, "ANNE": 0.0086, "OLTA": 0.0086, "ITER": 0.0086,
+	"ESUP": 0.0086, "TITO": 0.0086, "LNON": 0.0086, "DETT": 0.0086, "IRIC": 0.0086,
+	"ANNU": 0.0086, "IINT": 0.0086, "ERFA": 0.0086, "ELAV": 0.0086, "ODIM": 0.0086,
+	"IRIG": 0.0086, "NOUN": 0.0086, "LLOR": 0.0086, "NCAR": 0.0086, "ETEM": 0.0086,
+	"STIC": 0.0086, "EVON": 0.0086, "EIDA": 0.0086, "FATT": 0.0086, "AILF": 0.0086,
+	"NIMP": 0.0086, "LASH": 0.0086, "VAME": 0.0086, "NAIN": 0.0086, "SIEM": 0.0086,
+	"NOLI": 0.0086, "DELR": 0.0085, "ACEN": 0.0085, "AMMI": 0.0085, "LELI": 0.0085,
+	"PRIN": 0.0085, "USAI": 0.0085, "OVRE": 0.0085, "VREB": 0.0085, "DUTE": 0.0085,
+	"ITIP": 0.0085, "STON": 0.0085, "ZIAN": 0.0085, "IOPE": 0.0085, "ERCH": 0.0085,
+	"EARG": 0.0085, "NTAI": 0.0085, "HTTP": 0.0085, "NICH": 0.0085, "EGNO": 0.0085,
+	"ECIM": 0.0085, "ELAQ": 0.0085, "GVIM": 0.0085, "ERSA": 0.0084, "ERAC": 0.0084,
+	"SSIC": 0.0084, "OTES": 0.0084, "IEDI": 0.0084, "PIPE": 0.0084, "SOIN": 0.0084,
+	"NCAT": 0.0084, "ODIT": 0.0084, "TOSP": 0.0084, "TRAM": 0.0084, "VOLT": 0.0084,
+	"OTAL": 0.0084, "FORZ": 0.0084, "ZATA": 0.0084, "CLUD": 0.0084, "DIAL": 0.0083,
+	"GRAD": 0.0083, "IRES": 0.0083, "ISOT": 0.0083, "ELAB": 0.0083, "TIRI": 0.0083,
+	"NEIL": 0.0083, "OCIA": 0.0083, "THAT": 0.0083, "OERR": 0.0083, "PURE": 0.0083,
+	"EERR": 0.0083, "HAUN": 0.0083, "OLOL": 0.0083, "ILEÈ": 0.0083, "BEES": 0.0083,
+	"ITNO": 0.0083, "EATT": 0.0083, "OAGG": 0.0083, "MANU": 0.0083, "RUME": 0.0083,
+	"EADI": 0.0083, "UINE": 0.0083, "PUSH": 0.0083, "APAT": 0.0083, "IVIM": 0.0083,
+	"SORE": 0.0082, "RSOD": 0.0082, "RMAN": 0.0082, "ELSE": 0.0082, "TANT": 0.0082,
+	"AVOC": 0.0082, "OTRO": 0.0082, "ATOM": 0.0082, "IAZI": 0.0082, "IDUT": 0.0082,
+	"SONE": 0.0082, "NONU": 0.0082, "FICI": 0.0082, "ILTR": 0.0082, "IDEV": 0.0082,
+	"NTEG": 0.0082, "DIMO": 0.0082, "IINF": 0.0082, "ATEC": 0.0082, "EALC": 0.0082,
+	"NDOD": 0.0082, "TIER": 0.0082, "ICIT": 0.0082, "APTC": 0.0082, "NAMI": 0.0082,
+	"IASI": 0.0082, "OGLI": 0.0082, "EGIT": 0.0082, "ELSO": 0.0082, "ATEN": 0.0082,
+	"NACH": 0.0082, "LLIT": 0.0082, "HIUS": 0.0082, "ODII": 0.0082, "ISER": 0.0082,
+	"ODID": 0.0082, "ARSI": 0.0082, "DITO": 0.0082, "NPRE": 0.0082, "ERER": 0.0082,
+	"LTAR": 0.0082, "MMEN": 0.0082, "GLIU": 0.0082, "SORS": 0.0082, "COST": 0.0082,
+	"DUSE": 0.0082, "QUIS": 0.0082, "RNAR": 0.0082, "IDES": 0.0082, "MPER": 0.0082,
+	"EDAU": 0.0082, "AINS": 0.0082, "ANOL": 0.0082, "ESTH": 0.0082, "DONE": 0.0081,
+	"ELME": 0.0081, "REND": 0.0081, "ODOD": 0.0081, "ELEC": 0.0081, "STOS": 0.0081,
+	"IOIN": 0.0081, "LABO": 0.0081, "AIFI": 0.0081, "SEDE": 0.0081, "SITI": 0.0081,
+	"ATIE": 0.0081, "ACRE": 0.0081, "ADIR": 0.0081, "EATE": 0.0081, "ELOG": 0.0081,
+	"ORTH": 0.0080, "VEST": 0.0080, "OLAC": 0.0080, "UANG": 0.0080, "NDIA": 0.0080,
+	"ABOR": 0.0080, "ENOC": 0.0080, "OMET": 0.0080, "TINU": 0.0080, "CUNA": 0.0080,
+	"PPUR": 0.0080, "ODIN": 0.0080, "UFFI": 0.0080, "RUZI": 0.0080, "ITRO": 0.0080,
+	"SUUN": 0.0080, "DEVI": 0.0080, "AUNS": 0.0080, "TCOM": 0.0080, "NPRO": 0.0080,
+	"ITCO": 0.0080, "ESES": 0.0080, "LANO": 0.0080, "NDOA": 0.0080, "PATH": 0.0080,
+	"ALIB": 0.0080, "ERIT": 0.0080, "REIM": 0.0080, "ORZA": 0.0080, "HUAN": 0.0080,
+	"CINE": 0.0080, "STIL": 0.0080, "DESI": 0.0080, "OTAR": 0.0080, "AMAT": 0.0079,
+	"IADE": 0.0079, "ROMA": 0.0079, "EMAD": 0.0079, "ITAM": 0.0079, "ETOD": 0.0079,
+	"NEAL": 0.0079, "OPPU": 0.0079, "LETO": 0.0079, "SATT": 0.0079, "DNON": 0.0079,
+	"EINA": 0.0079, "ONST": 0.0079, "TOLO": 0.0079, "NARG": 0.0079, "OMEP": 0.0079,
+	"MEMB": 0.0079, "ADIE": 0.0079, "ROTT": 0.0079, "TRAI": 0.0079, "ESCL": 0.0079,
+	"ERCI": 0.0079, "ALOP": 0.0079, "NTOI": 0.0079, "NGIT": 0.0079, "TTIS": 0.0079,
+	"SETH": 0.0079, "LUSI": 0.0078, "SILE": 0.0078, "ISCA": 0.0078, "REES": 0.0078,
+	"RIZI": 0.0078, "DIMA": 0.0078, "ILIN": 0.0078, "SAMI": 0.0078, "MONG": 0.0078,
+	"ICAS": 0.0078, "ATRA": 0.0078, "AINO": 0.0078, "RISU": 0.0078, "IDIA": 0.0078,
+	"ZZAN": 0.0078, "ALUN": 0.0078, "IMES": 0.0078, "ILDE": 0.0078, "OLAL": 0.0078,
+	"ALAV": 0.0078, "LONO": 0.0078, "CECO": 0.0078, "TASE": 0.0078, "ONFR": 0.0078,
+	"IABL": 0.0078, "NEUS": 0.0078, "OMBI": 0.0078, "UISI": 0.0078, "TTOI": 0.0078,
+	"ROMP": 0.0078, "DOSI": 0.0078, "UISC": 0.0078, "FIED": 0.0078, "UALC": 0.0078,
+	"LAQI": 0.0078, "INCI": 0.0077, "INOR": 0.0077, "DORI": 0.0077, "EILD": 0.0077,
+	"DITI": 0.0077, "EINU": 0.0077, "UNPR": 0.0077, "ENAM": 0.0077, "ORSE": 0.0077,
+	"NTOR": 0.0077, "STAG": 0.0077, "THEL": 0.0077, "LAQO": 0.0077, "AQOP": 0.0077,
+	"HEGR": 0.0077, "IERA": 0.0076, "STDI": 0.0076, "LOVE": 0.0076, "ITRA": 0.0076,
+	"ASTO": 0.0076, "ERTU": 0.0076, "DECO": 0.0076, "ONÈC": 0.0076, "COMB": 0.0076,
+	"DOIL": 0.0076, "BUFF": 0.0076, "UFFE": 0.0076, "IACO": 0.0076, "BORA": 0.0076,
+	"MPAL": 0.0076, "IREG": 0.0076, "IVOD": 0.0076, "PSTR": 0.0076, "ISOP": 0.0076,
+	"LLEP": 0.0076, "INGT": 0.0076, "ADII": 0.0076, "STOF": 0.0076, "GNAT": 0.0076,
+	"OVEN": 0.0075, "MANE": 0.0075, "LLEC": 0.0075, "SEQU": 0.0075, "FERM": 0.0075,
+	"CEVU": 0.0075, "EVUT": 0.0075, "ALSE": 0.0075, "ATOT": 0.0075, "ADIA": 0.0075,
+	"TERP": 0.0075, "ILAT": 0.0075, "NINT": 0.0075, "VIAT": 0.0075, "NENE": 0.0075,
+	"LESA": 0.0075, "NIRE": 0.0075, "GNIF": 0.0075, "NEVE": 0.0075, "EATO": 0.0075,
+	"USOO": 0.0075, "AVES": 0.0075, "DEID": 0.0075, "DIAT": 0.0075, "ECIA": 0.0075,
+	"ILFO": 0.0075, "TEGI": 0.0075, "OILF": 0.0075, "IRIS": 0.0075, "TURE": 0.0075,
+	"LIAN": 0.0075, "MEFI": 0.0075, "AUDI": 0.0075, "HELA": 0.0075, "LIOG": 0.0075,
+	"ASSU": 0.0074, "NCIA": 0.0074, "IBER": 0.0074, "IANA": 0.0074, "EMAC": 0.0074,
+	"LAMO": 0.0074, "OLOG": 0.0074, "VIRG": 0.0074, "ELDI": 0.0074, "NCAI": 0.0074,
+	"HEIL": 0.0074, "OLUN": 0.0074, "RCOM": 0.0074, "OATT": 0.0074, "NESC": 0.0074,
+	"PAGI": 0.0074, "IASC": 0.0074, "REME": 0.0074, "SCEL": 0.0074, "HESI": 0.0074,
+	"SATI": 0.0074, "NOCH": 0.0074, "CHIO": 0.0074, "ACQU": 0.0074, "CQUI": 0.0074,
+	"RCES": 0.0074, "OAPT": 0.0074, "SCOL": 0.0074, "GUIB": 0.0074, "UNBR": 0.0074,
+	"SERS": 0.0074, "ILAS": 0.0073, "ACAR": 0.0073, "LCAR": 0.0073, "APPO": 0.0073,
+	"ANEO": 0.0073, "UNIT": 0.0073, "OAUT": 0.0073, "TTOG": 0.0073, "EIGN": 0.0073,
+	"ILPE": 0.0073, "AFOR": 0.0073, "NATE": 0.0073, "LLER": 0.0073, "NTOC": 0.0073,
+	"SSOS": 0.0073, "ITDI": 0.0073, "NTAD": 0.0073, "MBIN": 0.0073, "NZED": 0.0073,
+	"TOTA": 0.0073, "SINO": 0.0073, "ONPO": 0.0073, "ONAR": 0.0073, "EILV": 0.0073,
+	"IRME": 0.0073, "IUNF": 0.0073, "IAIN": 0.0073, "SELA": 0.0073, "UGLI": 0.0073,
+	"NSIE": 0.0073, "THED": 0.0073, "LEAG": 0.0072, "TRIM": 0.0072, "LIAR": 0.0072,
+	"IAME": 0.0072, "IPAR": 0.0072, "ELCA": 0.0072, "NANU": 0.0072, "NDIN": 0.0072,
+	"STAB": 0.0072, "RNIS": 0.0072, "RETE": 0.0072, "CAUN": 0.0072, "NZAP": 0.0072,
+	"IRGO": 0.0072, "RGOL": 0.0072, "ESAL": 0.0072, "SESI": 0.0072, "USED": 0.0072,
+	"DOUT": 0.0072, "IDIR": 0.0072, "IMPE": 0.0072, "LLEI": 0.0072, "CART": 0.0072,
+	"EVUO": 0.0072, "NTAZ": 0.0072, "ACUI": 0.0072, "IBAS": 0.0072, "AGUI": 0.0072,
+	"IALT": 0.0072, "LIAC": 0.0072, "NDEA": 0.0071, "DIGR": 0.0071, "USAL": 0.0071,
+	"EORI": 0.0071, "CALA": 0.0071, "ALAC": 0.0071, "COLA": 0.0071, "DIPO": 0.0071,
+	"ENDI": 0.0071, "ILTE": 0.0071, "ELTA": 0.0071, "TOAU": 0.0071, "DALS": 0.0071,
+	"RTIR": 0.0071, "NDOU": 0.0071, "NERR": 0.0071, "TEQU": 0.0071, "AMES": 0.0071,
+	"LLIS": 0.0071, "RILP": 0.0071, "TOUS": 0.0071, "ULTE": 0.0071, "PROD": 0.0071,
+	"IAUN": 0.0071, "LIMP": 0.0071, "MERE": 0.0071, "FLAG": 0.0071, "OLUT": 0.0071,
+	"EGAT": 0.0071, "UOTA": 0.0071, "SCLU": 0.0071, "REAN": 0.0071, "LDAT": 0.0071,
+	"PERV": 0.0071, "EFIR": 0.0071, "RAVE": 0.0071, "ERIE": 0.0071, "OGON": 0.0071,
+	"DOSE": 0.0071, "UOVI": 0.0071, "MITD": 0.0071, "SUGL": 0.0071, "IFTH": 0.0071,
+	"GERI": 0.0070, "CAPO": 0.0070, "PPON": 0.0070, "NGAN": 0.0070, "FORT": 0.0070,
+	"ITIC": 0.0070, "FINA": 0.0070, "ODAU": 0.0070, "ÈTRO": 0.0070, "SIMO": 0.0070,
+	"TRAR": 0.0070, "RESI": 0.0070, "SOCI": 0.0070, "ONUM": 0.0070, "UNEL": 0.0070,
+	"NORE": 0.0070, "SOOP": 0.0070, "GUEI": 0.0070, "DEUN": 0.0070, "CLAS": 0.0070,
+	"ARET": 0.0070, "EÈST": 0.0070, "LSIM": 0.0070, "MOLT": 0.0070, "LSEG": 0.0070,
+	"ITIV": 0.0070, "RSIA": 0.0070, "REGL": 0.0070, "EOPE": 0.0070, "LENE": 0.0070,
+	"ADIF": 0.0070, "INFI": 0.0070, "ITOP": 0.0070, "ONEU": 0.0070, "LUDE": 0.0070,
+	"DAND": 0.0070, "FRAT": 0.0070, "ONTH": 0.0070, "TASH": 0.0070, "AQIN": 0.0070,
+	"IELD": 0.0070, "ASTD": 0.0069, "ONDA": 0.0069, "ADIN": 0.0069, "LITO": 0.0069,
+	"ICHÉ": 0.0069, "VUTO": 0.0069, "DIAN": 0.0069, "TTAT": 0.0069, "LEUS": 0.0069,
+	"EUNI": 0.0069, "OTRA": 0.0069, "UPLI": 0.0069, "ICAU": 0.0069, "NPOS": 0.0069,
+	"ATEI": 0.0069, "OLOR": 0.0069, "TISE": 0.0069, "PALE": 0.0069, "OLAS": 0.0069,
+	"PRIS": 0.0069, "OLTO": 0.0069, "NSEG": 0.0069, "EVAR": 0.0069, "COSA": 0.0069,
+	"NUAL": 0.0069, "LTRA": 0.0069, "NEFI": 0.0069, "AMIT": 0.0069, "RSOR": 0.0069,
+	"TERS": 0.0069, "SEVI": 0.0069, "ELED": 0.0069, "FICO": 0.0069, "ISEZ": 0.0069,
+	"FIEL": 0.0069, "QOPZ": 0.0069, "ELGR": 0.0068, "GGIA": 0.0068, "TIGL": 0.0068,
+	"ICES": 0.0068, "ALTO": 0.0068, "ORIS": 0.0068, "INOA": 0.0068, "NDID": 0.0068,
+	"OINV": 0.0068, "ASEP": 0.0068, "NDOP": 0.0068, "TISU": 0.0068, "DELG": 0.0068,
+	"NCRE": 0.0068, "ADIP": 0.0068, "EANC": 0.0068, "NONN": 0.0068, "TUIS": 0.0068,
+	"UALS": 0.0068, "LSIA": 0.0068, "EUNO": 0.0068, "OING": 0.0068, "IREV": 0.0068,
+	"ALAQ": 0.0068, "EVED": 0.0068, "VVER": 0.0068, "NDTH": 0.0068, "VIMR": 0.0068,
+	"LVER": 0.0067, "ELEO": 0.0067, "ERUS": 0.0067, "ELAN": 0.0067, "AROL": 0.0067,
+	"INAD": 0.0067, "EMET": 0.0067, "IAND": 0.0067, "NDEV": 0.0067, "IAUT": 0.0067,
+	"AALC": 0.0067, "IRED": 0.0067, "NÈCO": 0.0067, "IREU": 0.0067, "IASS": 0.0067,
+	"ERDI": 0.0067, "EMAS": 0.0067, "DUPL": 0.0067, "SUFF": 0.0067, "QUIR": 0.0067,
+	"VALE": 0.0067, "IRIM": 0.0067, "RAPP": 0.0067, "ÈNEC": 0.0067, "ELEI": 0.0067,
+	"PPAR": 0.0067, "OTON": 0.0067, "STOR": 0.0067, "IUDE": 0.0067, "CHEU": 0.0067,
+	"TOLI": 0.0067, "IMET": 0.0067, "IARC": 0.0067, "NARC": 0.0067, "ANUA": 0.0067,
+	"AREO": 0.0067, "LIAT": 0.0067, "OMEO": 0.0067, "IAPP": 0.0067, "DARE": 0.0067,
+	"USET": 0.0067, "TABL": 0.0067, "ILCA": 0.0066, "ENOR": 0.0066, "ERTO": 0.0066,
+	"NIDA": 0.0066, "SSOD": 0.0066, "SATE": 0.0066, "OALT": 0.0066, "ONIG": 0.0066,
+	"RILE": 0.0066, "TRAE": 0.0066, "ONAP": 0.0066, "REZZ": 0.0066, "PRET": 0.0066,
+	"DELI": 0.0066, "UTID": 0.0066, "ODIG": 0.0066, "HELO": 0.0066, "TASO": 0.0066,
+	"ASEL": 0.0066, "PONO": 0.0066, "LDIS": 0.0066, "VEPU": 0.0066, "ANOT": 0.0066,
+	"CONA": 0.0066, "ORIM": 0.0066, "CHER": 0.0066, "ERAD": 0.0066, "LORO": 0.0066,
+	"EPAT": 0.0066, "MMET": 0.0066, "RITÀ": 0.0066, "SALV": 0.0066, "ODOS": 0.0066,
+	"TOVI": 0.0066, "IDEO": 0.0066, "ONOL": 0.0066, "NAFI": 0.0066, "DOGO": 0.0066,
+	"UIBI": 0.0066, "MANT": 0.0066, "NAGG": 0.0066, "AREF": 0.0066, "THEI": 0.0066,
+	"AQUT": 0.0066, "QUTE": 0.0066, "NDEL": 0.0065, "BASS": 0.0065, "ENGA": 0.0065,
+	"OCCH": 0.0065, "LONE": 0.0065, "TROD": 0.0065, "LIEL": 0.0065, "ARLO": 0.0065,
+	"INER": 0.0065, "ÈSUP": 0.0065, "OTOC": 0.0065, "DDEL": 0.0065, "HANN": 0.0065,
+	"LACR": 0.0065, "NASO": 0.0065, "ISEN": 0.0065, "NINO": 0.0065, "RLAC": 0.0065,
+	"ILEB": 0.0065, "OREE": 0.0065, "ELRE": 0.0065, "ESEP": 0.0065, "ICAP": 0.0065,
+	"DOLA": 0.0065, "DAVI": 0.0065, "REPA": 0.0065, "NELA": 0.0065, "UNRE": 0.0065,
+	"OILN": 0.0065, "TRAZ": 0.0065, "SCUN": 0.0065, "NETT": 0.0065, "LSUO": 0.0065,
+	"ALEO": 0.0065, "TRIG": 0.0065, "NBRA": 0.0065, "ALER": 0.0064, "AESA": 0.0064,
+	"EMIN": 0.0064, "OINM": 0.0064, "AURI": 0.0064, "PIÙD": 0.0064, "METO": 0.0064,
+	"OINF": 0.0064, "LECA": 0.0064, "FFIC": 0.0064, "ERIP": 0.0064, "LEIL": 0.0064,
+	"TOST": 0.0064, "ONLE": 0.0064, "EMPL": 0.0064, "UTNO": 0.0064, "NTIR": 0.0064,
+	"UNCA": 0.0064, "OMME": 0.0064, "NANT": 0.0064, "NTII": 0.0064, "ZIOD": 0.0064,
+	"MAPP": 0.0064, "ITOL": 0.0064, "ARIM": 0.0064, "LAPI": 0.0064, "IINI": 0.0064,
+	"NASE": 0.0064, "RAFO": 0.0064, "LEAR": 0.0064, "LEEL": 0.0064, "ONIT": 0.0064,
+	"URES": 0.0064, "ATEA": 0.0064, "ANDU": 0.0064, "APAS": 0.0064, "UDIO": 0.0064,
+	"ILCU": 0.0064, "SNOT": 0.0064, "IMAR": 0.0063, "DIBA": 0.0063, "NTEV": 0.0063,
+	"TOCA": 0.0063, "ITOS": 0.0063, "TALO": 0.0063, "LNUO": 0.0063, "RTIC": 0.0063,
+	"QUIN": 0.0063, "DICU": 0.0063, "EGRE": 0.0063, "NOAL": 0.0063, "ARTA": 0.0063,
+	"IVIE": 0.0063, "ODAT": 0.0063, "RTAZ": 0.0063, "BBER": 0.0063, "ONLO": 0.0063,
+	"ISCI": 0.0063, "DEPE": 0.0063, "OINA": 0.0063, "LDEL": 0.0063, "LLUT": 0.0063,
+	"TRUZ": 0.0063, "ORIP": 0.0063, "EDEU": 0.0063, "IVID": 0.0063, "MAGG": 0.0063,
+	"ÈVAL": 0.0063, "RDEL": 0.0063, "ECOS": 0.0063, "CORE": 0.0063, "ICOS": 0.0063,
+	"OPEN": 0.0063, "IIFI": 0.0063, "ALSO": 0.0063, "OTET": 0.0063, "RUSS": 0.0063,
+	"STIE": 0.0063, "IAPE": 0.0062, "OVAG": 0.0062, "ASCA": 0.0062, "ATOV": 0.0062,
+	"RTAR": 0.0062, "OSUP": 0.0062, "IVOL": 0.0062, "RANG": 0.0062, "EPIÙ": 0.0062,
+	"ROTO": 0.0062, "NTRE": 0.0062, "VIAR": 0.0062, "NECH": 0.0062, "ECOD": 0.0062,
+	"ILDI": 0.0062, "ELPE": 0.0062, "FILT": 0.0062, "RCAD": 0.0062, "ODOA": 0.0062,
+	"ACOD": 0.0062, "RIPE": 0.0062, "NETI": 0.0062, "ZAIL": 0.0062, "IDON": 0.0062,
+	"ALLU": 0.0062, "CIAS": 0.0062, "NAPA": 0.0062, "EFIS": 0.0062, "LOSP": 0.0062,
+	"ASCH": 0.0062, "IDIV": 0.0062, "MBIE": 0.0062, "IVOC": 0.0062, "DELE": 0.0062,
+	"ODIO": 0.0062, "TERF": 0.0062, "MACH": 0.0062, "NNET": 0.0062, "LENT": 0.0062,
+	"OLID": 0.0062, "TRUM": 0.0062, "EBRA": 0.0062, "ZHUA": 0.0062, "LPIN": 0.0062,
+	"HEDA": 0.0062, "LAQE": 0.0062, "INAC": 0.0061, "ANOA": 0.0061, "ILOG": 0.0061,
+	"ANCI": 0.0061, "EEST": 0.0061, "ALAP": 0.0061, "SSEM": 0.0061, "OLIT": 0.0061,
+	"HMON": 0.0061, "ERAS": 0.0061, "ILLI": 0.0061, "VERO": 0.0061, "SSID": 0.0061,
+	"VIOD": 0.0061, "TOLA": 0.0061, "OPOI": 0.0061, "UNER": 0.0061, "IQUA": 0.0061,
+	"ITED": 0.0061, "OARG": 0.0061, "NDOE": 0.0061, "NELS": 0.0061, "UNTU": 0.0061,
+	"LETI": 0.0061, "RABI": 0.0061, "TESU": 0.0061, "TEES": 0.0061, "NAPP": 0.0061,
+	"USAD": 0.0061, "TOVE": 0.0061, "OLOD": 0.0061, "NIPE": 0.0061, "NDIP": 0.0061,
+	"EARC": 0.0061, "GANO": 0.0061, "NNEG": 0.0061, "CCAT": 0.0061, "MPAR": 0.0061,
+	"DAUS": 0.0061, "RTAM": 0.0061, "OVIM": 0.0061, "EDTO": 0.0061, "AIND": 0.0060,
+	"ERAG": 0.0060, "SANT": 0.0060, "DOCC": 0.0060, "ALAS": 0.0060, "ERNE": 0.0060,
+	"ETAT": 0.0060, "ODUR": 0.0060, "RIIN": 0.0060, "TRAV": 0.0060, "LPRE": 0.0060,
+	"REIF": 0.0060, "OMEM": 0.0060, "HEAP": 0.0060, "MICO": 0.0060, "INNO": 0.0060,
+	"ORSA": 0.0060, "UINO": 0.0060, "EPUÒ": 0.0060, "BIEN": 0.0060, "EREQ": 0.0060,
+	"TOQU": 0.0060, "THEM": 0.0060, "GIBI": 0.0060, "DOES": 0.0060, "ANOC": 0.0060,
+	"INCA": 0.0060, "UITE": 0.0060, "UESS": 0.0060, "ROMT": 0.0060, "OMTH": 0.0060,
+	"VIMP": 0.0060, "AMAR": 0.0059, "BLAS": 0.0059, "ORDO": 0.0059, "IDIN": 0.0059,
+	"ALCA": 0.0059, "RECA": 0.0059, "NZAA": 0.0059, "ALAT": 0.0059, "ICRE": 0.0059,
+	"IIMP": 0.0059, "RIVI": 0.0059, "ROCO": 0.0059, "EOTT": 0.0059, "ALFI": 0.0059,
+	"EREF": 0.0059, "ALFO": 0.0059, "ADUT": 0.0059, "RESC": 0.0059, "ESAM": 0.0059,
+	"ALAD": 0.0059, "PUTN": 0.0059, "EROS": 0.0059, "OCRE": 0.0059, "PPOG": 0.0059,
+	"RIAS": 0.0059, "DILE": 0.0059, "RROT": 0.0059, "ANOM": 0.0059, "LATE": 0.0059,
+	"ZALE": 0.0059, "ZATI": 0.0059, "ICOA": 0.0059, "ITIN": 0.0059, "UNSI": 0.0059,
+	"SOLI": 0.0059, "REPO": 0.0059, "UNVA": 0.0059, "NIIN": 0.0059, "AGES": 0.0059,
+	"RACO": 0.0059, "APIP": 0.0059, "DODA": 0.0059, "NPAR": 0.0059, "APUA": 0.0059,
+	"PUAN": 0.0059, "FORE": 0.0059, "OANC": 0.0059, "RODU": 0.0059, "AVVE": 0.0059,
+	"HISO": 0.0059, "USAG": 0.0059, "GITC": 0.0059, "EOPT": 0.0059, "APTA": 0.0059,
+	"MTHE": 0.0059, "ORAL": 0.0058, "MALA": 0.0058, "ANOR": 0.0058, "EDON": 0.0058,
+	"SUDO": 0.0058, "RAMI": 0.0058, "NAVE": 0.0058, "IVAT": 0.0058, "ÈRIC": 0.0058,
+	"EUNP": 0.0058, "LAGE": 0.0058, "SIMA": 0.0058, "DOPR": 0.0058, "GLOB": 0.0058,
+	"TTIE": 0.0058, "PERQ": 0.0058, "ERQU": 0.0058, "DOTT": 0.0058, "NTAR": 0.0058,
+	"RINT": 0.0058, "NNUM": 0.0058, "RMET": 0.0058, "ITAS": 0.0058, "ASEC": 0.0058,
+	"NCAL": 0.0058, "LASO": 0.0058, "IONF": 0.0058, "ENED": 0.0058, "LEFO": 0.0058,
+	"AVEC": 0.0058, "PAPU": 0.0058, "TOTO": 0.0058, "LLEM": 0.0058, "AREV": 0.0058,
+	"IVED": 0.0058, "IMOP": 0.0058, "GUII": 0.0058, "NGTH": 0.0058, "THEV": 0.0058,
+	"PUES": 0.0058, "ORNO": 0.0057, "RALI": 0.0057, "GOLE": 0.0057, "ANOI": 0.0057,
+	"AVAN": 0.0057, "LIBE": 0.0057, "OANT": 0.0057, "NISI": 0.0057, "NÈSU": 0.0057,
+	"ZODI": 0.0057, "UNGO": 0.0057, "NFER": 0.0057, "UREZ": 0.0057, "TERO": 0.0057,
+	"INCR": 0.0057, "NACC": 0.0057, "IBUZ": 0.0057, "BUZI": 0.0057, "DEIN": 0.0057,
+	"FRON": 0.0057, "SOPR": 0.0057, "ICUI": 0.0057, "LCAM": 0.0057, "RQUE": 0.0057,
+	"ONÈV": 0.0057, "ISPA": 0.0057, "ONII": 0.0057, "OINU": 0.0057, "ROPE": 0.0057,
+	"ASCU": 0.0057, "NEAM": 0.0057, "NOLO": 0.0057, "ROTE": 0.0057, "NOAN": 0.0057,
+	"ASSP": 0.0057, "NGET": 0.0057, "RIGG": 0.0057, "IGGE": 0.0057, "VIMÈ": 0.0057,
+	"TTIM": 0.0056, "ROVI": 0.0056, "ASTE": 0.0056, "TTIG": 0.0056, "ALEM": 0.0056,
+	"MONT": 0.0056, "ECAR": 0.0056, "TCHA": 0.0056, "ANIA": 0.0056, "RINC": 0.0056,
+	"GUES": 0.0056, "ONAG": 0.0056, "NGAD": 0.0056, "ELCE": 0.0056, "RESA": 0.0056,
+	"INEC": 0.0056, "INUS": 0.0056, "PREV": 0.0056, "ETRI": 0.0056, "EESA": 0.0056,
+	"MNON": 0.0056, "CENO": 0.0056, "ESAU": 0.0056, "TUIT": 0.0056, "CADU": 0.0056,
+	"ONIR": 0.0056, "EGES": 0.0056, "RETR": 0.0056, "POGR": 0.0056, "NONO": 0.0056,
+	"TENS": 0.0056, "ITOA": 0.0056, "LINS": 0.0056, "RTEN": 0.0056, "RELU": 0.0056,
+	"SOLV": 0.0056, "SSOA": 0.0056, "SADI": 0.0056, "DIBI": 0.0056, "ONNO": 0.0056,
+	"VENO": 0.0056, "ASOT": 0.0056, "ATEP": 0.0056, "IFIU": 0.0056, "FIUT": 0.0056,
+	"PLEM": 0.0056, "DANN": 0.0056, "IBLO": 0.0056, "UNAI": 0.0056, "CHEE": 0.0056,
+	"NTOE": 0.0056, "ONTO": 0.0056, "LISP": 0.0056, "TFIL": 0.0056, "UIIL": 0.0056,
+	"OREV": 0.0056, "SSPH": 0.0056, "SPHR": 0.0056, "PHRA": 0.0056, "HRAS": 0.0056,
+	"ÉQUE": 0.0056, "EDTH": 0.0056, "UPGR": 0.0056, "VIMS": 0.0056, "RADO": 0.0055,
+	"APOR": 0.0055, "NCIS": 0.0055, "TORA": 0.0055, "DILO": 0.0055, "RIVA": 0.0055,
+	"SUME": 0.0055, "INGI": 0.0055, "ADIG": 0.0055, "DITU": 0.0055, "ASUP": 0.0055,
+	"OMEV": 0.0055, "EDET": 0.0055, "LAFU": 0.0055, "ANUM": 0.0055, "ECAM": 0.0055,
+	"VVIA": 0.0055, "TONU": 0.0055, "UNTE": 0.0055, "NTEO": 0.0055, "IMOC": 0.0055,
+	"OEDE": 0.0055, "INGH": 0.0055, "ASEG": 0.0055, "LESU": 0.0055, "IOCO": 0.0055,
+	"SIVA": 0.0055, "LIFI": 0.0055, "ATAU": 0.0055, "ANEN": 0.0055, "UENZ": 0.0055,
+	"EELE": 0.0055, "EDOP": 0.0055, "PUTD": 0.0055, "ONAN": 0.0055, "AUNF": 0.0055,
+	"ILGR": 0.0055, "TENA": 0.0055, "NÈVA": 0.0055, "RAMA": 0.0055, "BIAR": 0.0055,
+	"REAP": 0.0055, "IDET": 0.0055, "SAIL": 0.0055, "ORON": 0.0055, "RRID": 0.0055,
+	"RILA": 0.0055, "HNON": 0.0055, "TOSC": 0.0055, "EROF": 0.0055, "NEGG": 0.0055,
+	"ILSO": 0.0055, "CRIZ": 0.0055, "EOGG": 0.0055, "ONOT": 0.0055, "OVED": 0.0055,
+	"OFRA": 0.0055, "ZALA": 0.0055, "MUNE": 0.0055, "DEIT": 0.0055, "TOUT": 0.0055,
+	"SOPT": 0.0055, "VIMV": 0.0055, "LEAC": 0.0054, "RIOS": 0.0054, "INLA": 0.0054,
+	"SALA": 0.0054, "CHEV": 0.0054, "LADA": 0.0054, "ASET": 0.0054, "LAIN": 0.0054,
+	"TISP": 0.0054, "STIR": 0.0054, "TOAD": 0.0054, "LASI": 0.0054, "DIZI": 0.0054,
+	"ONSA": 0.0054, "IÙDI": 0.0054, "NÈPO": 0.0054, "ILPU": 0.0054, "TMOD": 0.0054,
+	"RMAD": 0.0054, "INIM": 0.0054, "PPIA": 0.0054, "DOUN": 0.0054, "OUNC": 0.0054,
+	"IMEM": 0.0054, "CRON": 0.0054, "OFIN": 0.0054, "REIP": 0.0054, "ONPR": 0.0054,
+	"UTOE": 0.0054, "CURS": 0.0054, "LLEO": 0.0054, "PARO": 0.0054, "AALT": 0.0054,
+	"AUNE": 0.0054, "ODAE": 0.0054, "QUEN": 0.0054, "ERAP": 0.0054, "MITI": 0.0054,
+	"IPLI": 0.0054, "STOM": 0.0054, "EMPR": 0.0054, "NFRO": 0.0054, "IDII": 0.0054,
+	"NTIL": 0.0054, "ENEA": 0.0054, "ACAU": 0.0054, "STIO": 0.0054, "PROF": 0.0054,
+	"GIAT": 0.0054, "ZAME": 0.0054, "NCUI": 0.0054, "ILIP": 0.0054, "NCAS": 0.0054,
+	"LQUA": 0.0054, "UANU": 0.0054, "VAGU": 0.0054, "DORE": 0.0054, "RIMP": 0.0054,
+	"UGIN": 0.0054, "ITTE": 0.0054, "PROM": 0.0054, "EDEB": 0.0054, "HEVA": 0.0054,
+	"NÉQU": 0.0054, "GETH": 0.0054, "LINO": 0.0053, "LALO": 0.0053, "GHES": 0.0053,
+	"FONT": 0.0053, "SCOM": 0.0053, "ILAN": 0.0053, "AINA": 0.0053, "CALC": 0.0053,
+	"TOME": 0.0053, "LELA": 0.0053, "CASE": 0.0053, "OLAM": 0.0053, "LAUT": 0.0053,
+	"EASS": 0.0053, "INAS": 0.0053, "BUTO": 0.0053, "NEQU": 0.0053, "ONÈI": 0.0053,
+	"ESOT": 0.0053, "ELRI": 0.0053, "RTUR": 0.0053, "ININ": 0.0053, "MALF": 0.0053,
+	"GHED": 0.0053, "VODI": 0.0053, "ADIL": 0.0053, "CAPE": 0.0053, "BSOL": 0.0053,
+	"CESO": 0.0053, "OSPA": 0.0053, "IDIU": 0.0053, "FIGL": 0.0053, "ERMO": 0.0053,
+	"SAME": 0.0053, "NLAR": 0.0053, "ITON": 0.0053, "SPAR": 0.0053, "IDAN": 0.0053,
+	"DANE": 0.0053, "OPIÙ": 0.0053, "OUNO": 0.0053, "AATT": 0.0053, "NVIE": 0.0053,
+	"DUMP": 0.0053, "DIAR": 0.0053, "LISC": 0.0053, "OTTA": 0.0053, "ITAG": 0.0053,
+	"AGNO": 0.0053, "ESEZ": 0.0053, "RFAC": 0.0053, "GNOD": 0.0053, "DPER": 0.0053,
+	"TIES": 0.0053, "SELI": 0.0053, "TRUS": 0.0053, "SINI": 0.0053, "INTO": 0.0053,
+	"AUSE": 0.0053, "IUNP": 0.0053, "ESIM": 0.0053, "SAGI": 0.0053, "GITS": 0.0053,
+	"ALEG": 0.0052, "BICO": 0.0052, "MMIN": 0.0052, "BUTI": 0.0052, "TREM": 0.0052,
+	"IUNI": 0.0052, "RINI": 0.0052, "SURA": 0.0052, "OLAN": 0.0052, "ELOP": 0.0052,
+	"PALA": 0.0052, "ERRE": 0.0052, "AMAI": 0.0052, "IAVV": 0.0052, "UNRI": 0.0052,
+	"ENEN": 0.0052, "ATAM": 0.0052, "POLU": 0.0052, "ODEV": 0.0052, "LALU": 0.0052,
+	"SITO": 0.0052, "ENOU": 0.0052, "DAIN": 0.0052, "MEUN": 0.0052, "EMUL": 0.0052,
+	"ETAN": 0.0052, "AFIC": 0.0052, "AREM": 0.0052, "ÈIMP": 0.0052, "AANC": 0.0052,
+	"RATU": 0.0052, "RASI": 0.0052, "ZIOS": 0.0052, "TIIS": 0.0052, "EMBL": 0.0052,
+	"AUNI": 0.0052, "SACO": 0.0052, "ALEV": 0.0052, "ÈCON": 0.0052, "EMED": 0.0052,
+	"INEI": 0.0052, "TIIF": 0.0052, "ELEV": 0.0052, "HESO": 0.0052, "HEQU": 0.0052,
+	"ISUC": 0.0052, "AVIS": 0.0052, "STOT": 0.0052, "DEMO": 0.0052, "OCAM": 0.0052,
+	"SEGR": 0.0052, "FODE": 0.0052, "DCOM": 0.0052, "ONIF": 0.0052, "IESP": 0.0052,
+	"ITRI": 0.0052, "LREM": 0.0052, "PGRA": 0.0052, "LLON": 0.0051, "PPER": 0.0051,
+	"ILIA": 0.0051, "ANGA": 0.0051, "SAIN": 0.0051, "NAND": 0.0051, "OVIS": 0.0051,
+	"NCOL": 0.0051, "PPOL": 0.0051, "RUNA": 0.0051, "ALOS": 0.0051, "SUNC": 0.0051,
+	"EIME": 0.0051, "ATER": 0.0051, "SETD": 0.0051, "TISI": 0.0051, "IGUA": 0.0051,
+	"TRIC": 0.0051, "EESP": 0.0051, "ATRO": 0.0051, "CONP": 0.0051, "ESIP": 0.0051,
+	"IMUL": 0.0051, "MMED": 0.0051, "ONÈA": 0.0051, "LAGG": 0.0051, "INPA": 0.0051,
+	"RPER": 0.0051, "ADER": 0.0051, "ELDA": 0.0051, "IDIT": 0.0051, "ERRÀ": 0.0051,
+	"MIGL": 0.0051, "OSON": 0.0051, "RMOD": 0.0051, "IETA": 0.0051, "GTHE": 0.0051,
+	"TTIA": 0.0051, "UPST": 0.0051, "NTUT": 0.0051, "CLON": 0.0051, "PARI": 0.0050,
+	"DFOR": 0.0050, "OBLA": 0.0050, "NAGA": 0.0050, "AMME": 0.0050, "AOCC": 0.0050,
+	"CAND": 0.0050, "ESAN": 0.0050, "MACO": 0.0050, "ISAR": 0.0050, "APAC": 0.0050,
+	"ELFO": 0.0050, "OUTI": 0.0050, "ESAT": 0.0050, "CODA": 0.0050, "TABI": 0.0050,
+	"RTOG": 0.0050, "IUTI": 0.0050, "URIT": 0.0050, "IDIE": 0.0050, "HECH": 0.0050,
+	"PUTS": 0.0050, "NLIN": 0.0050, "RALA": 0.0050, "TIQU": 0.0050, "ANZI": 0.0050,
+	"AILP": 0.0050, "NZAT": 0.0050, "PTIN": 0.0050, "TIST": 0.0050, "LIMM": 0.0050,
+	"OTER": 0.0050, "SOSP": 0.0050, "TOIM": 0.0050, "ATOO": 0.0050, "TRIE": 0.0050,
+	"AVOL": 0.0050, "SOFI": 0.0050, "TEPR": 0.0050, "ZAUN": 0.0050, "CURA": 0.0050,
+	"CCOM": 0.0050, "BREV": 0.0050, "CHEQ": 0.0050, "IGNI": 0.0050, "NAPR": 0.0050,
+	"IMAC": 0.0050, "RZIO": 0.0050, "LISU": 0.0050, "EDAN": 0.0050, "URSO": 0.0050,
+	"FOND": 0.0050, "NOUT": 0.0050, "RLAS": 0.0050, "AFOD": 0.0050, "ONOU": 0.0050,
+	"AREB": 0.0050, "NUMB": 0.0050, "SFRO": 0.0050, "ARIT": 0.0049, "EPOR": 0.0049,
+	"DILI": 0.0049, "SAMA": 0.0049, "DIAC": 0.0049, "RTIN": 0.0049, "TENG": 0.0049,
+	"SIDI": 0.0049, "DIGE": 0.0049, "LLOV": 0.0049, "EROE": 0.0049, "ROES": 0.0049,
+	"NETR": 0.0049, "ILCE": 0.0049, "STOI": 0.0049, "RENU": 0.0049, "ORAD": 0.0049,
+	"IINU": 0.0049, "IREP": 0.0049, "DOAN": 0.0049, "AMPI": 0.0049, "UIVA": 0.0049,
+	"SIMI": 0.0049, "NSOL": 0.0049, "SULF": 0.0049, "RADU": 0.0049, "CCED": 0.0049,
+	"INGR": 0.0049, "RGLI": 0.0049, "VESE": 0.0049, "IGRA": 0.0049, "ODES": 0.0049,
+	"OELE": 0.0049, "EALM": 0.0049, "OREL": 0.0049, "EINP": 0.0049, "PUÒA": 0.0049,
+	"NUTE": 0.0049, "TEUS": 0.0049, "SSAT": 0.0049, "NOLA": 0.0049, "ACCA": 0.0049,
+	"VISA": 0.0049, "SPAN": 0.0049, "LIOR": 0.0049, "PREP": 0.0049, "SLIS": 0.0049,
+	"SOPE": 0.0049, "IICO": 0.0049, "UNED": 0.0049, "ATEG": 0.0049, "EMOC": 0.0049,
+	"GITN": 0.0049, "NNUO": 0.0049, "LCHE": 0.0049, "RVIM": 0.0049, "VIMT": 0.0049,
+	"SSIN": 0.0049, "TAPR": 0.0049, "UTON": 0.0049, "AVIE": 0.0049, "EDIB": 0.0049,
+	"IDAU": 0.0049, "OREG": 0.0049, "ORAZ": 0.0049, "SSIA": 0.0049, "ALAN": 0.0049,
+	"NDOR": 0.0049, "UIND": 0.0049, "NDED": 0.0049, "DAME": 0.0049, "LAMA": 0.0049,
+	"ORIN": 0.0049, "OUNM": 0.0049, "UTIN": 0.0049, "NAVO": 0.0049, "TOAI": 0.0049,
+	"TOED": 0.0049, "TELI": 0.0049, "CHCO": 0.0049, "UTTO": 0.0049, "PREM": 0.0049,
+	"ROIN": 0.0049, "NOSP": 0.0049, "TATU": 0.0049, "ALEL": 0.0049, "SNON": 0.0049,
+	"PIÙR": 0.0049, "ELTE": 0.0049, "MOUN": 0.0049, "INUT": 0.0049, "OLIS": 0.0049,
+	"SIPO": 0.0049, "SSUM": 0.0049, "IUSU": 0.0049, "USUR": 0.0049, "OALC": 0.0049,
+	"ONCI": 0.0049, "PULI": 0.0049, "TORN": 0.0049, "SONA": 0.0049, "ETAD": 0.0049,
+	"URAR": 0.0049, "EIMM": 0.0049, "TOAP": 0.0049, "INCU": 0.0049, "NIMO": 0.0049,
+	"UNOG": 0.0049, "NOGG": 0.0049, "AUNP": 0.0049, "LRIF": 0.0049, "CEVE": 0.0049,
+	"TTOV": 0.0049, "GATI": 0.0049, "USCO": 0.0049, "ALIV": 0.0049, "FAIL": 0.0049,
+	"EPEC": 0.0049, "OARA": 0.0049, "MOCR": 0.0049, "OCRA": 0.0049, "CRAT": 0.0049,
+	"INUO": 0.0049, "CONM": 0.0049, "RUST": 0.0049, "LIUT": 0.0049, "RCHÉ": 0.0049,
+	"INIB": 0.0049, "ETAS": 0.0049, "TEVI": 0.0049, "UMBE": 0.0049, "RLIN": 0.0048,
+	"SLAV": 0.0048, "TOPI": 0.0048, "OLAD": 0.0048, "ELEL": 0.0048, "ZAND": 0.0048,
+	"SIVI": 0.0048, "LISI": 0.0048, "ITID": 0.0048, "DICR": 0.0048, "NIND": 0.0048,
+	"IPOR": 0.0048, "VIZI": 0.0048, "ISHE": 0.0048, "OILP": 0.0048, "NIRI": 0.0048,
+	"ECUR": 0.0048, "NEIM": 0.0048, "OLOU": 0.0048, "NDIE": 0.0048, "ELIB": 0.0048,
+	"OMEL": 0.0048, "OSEI": 0.0048, "HEPE": 0.0048, "SCAN": 0.0048, "RANS": 0.0048,
+	"LEIS": 0.0048, "TITA": 0.0048, "ETDI": 0.0048, "TROA": 0.0048, "LACC": 0.0048,
+	"RAIC": 0.0048, "FINO": 0.0048, "OLUZ": 0.0048, "LUZI": 0.0048, "SSOF": 0.0048,
+	"GOLO": 0.0048, "ERSC": 0.0048, "AUNM": 0.0048, "LEVO": 0.0048, "MEAR": 0.0048,
+	"APPR": 0.0048, "IRIL": 0.0048, "FACC": 0.0048, "NDIT": 0.0048, "COLE": 0.0048,
+	"LELO": 0.0048, "ACIN": 0.0048, "NEIF": 0.0048, "OSES": 0.0048, "HEAL": 0.0048,
+	"EEVI": 0.0048, "ITDE": 0.0048, "HEAN": 0.0048, "CUIN": 0.0048, "CMAI": 0.0048,
+	"APTS": 0.0048, "IMRC": 0.0048, "MINM": 0.0048, "DIAI": 0.0047, "AGEN": 0.0047,
+	"NOPR": 0.0047, "ECHA": 0.0047, "MAES": 0.0047, "DING": 0.0047, "ODON": 0.0047,
+	"LEPO": 0.0047, "ERET": 0.0047, "ERTH": 0.0047, "IAES": 0.0047, "GINI": 0.0047,
+	"AMAC": 0.0047, "IDIM": 0.0047, "ECCE": 0.0047, "RAFA": 0.0047, "SCII": 0.0047,
+	"RVIZ": 0.0047, "IVON": 0.0047, "RIAE": 0.0047, "ZZER": 0.0047, "OANO": 0.0047,
+	"TSTA": 0.0047, "LIPE": 0.0047, "IGEN": 0.0047, "RAIL": 0.0047, "ALEF": 0.0047,
+	"PATT": 0.0047, "ZEDI": 0.0047, "OLVE": 0.0047, "UTOS": 0.0047, "NEGA": 0.0047,
+	"CABI": 0.0047, "NEAN": 0.0047, "TRAU": 0.0047, "EMAL": 0.0047, "SIAU": 0.0047,
+	"CINO": 0.0047, "DEAL": 0.0047, "VATI": 0.0047, "RPRO": 0.0047, "UNAQ": 0.0047,
+	"ANIN": 0.0047, "MESO": 0.0047, "SIRI": 0.0047, "NMER": 0.0047, "IVIN": 0.0047,
+	"GRET": 0.0047, "TIPA": 0.0047, "ELLD": 0.0047, "ETCH": 0.0047, "MITN": 0.0047,
+	"BISE": 0.0047, "ERAQ": 0.0047, "ARIN": 0.0046, "VERN": 0.0046, "IVAR": 0.0046,
+	"IGHT": 0.0046, "OORI": 0.0046, "ALIC": 0.0046, "ANTO": 0.0046, "AINI": 0.0046,
+	"SCIA": 0.0046, "LTAS": 0.0046, "CCOL": 0.0046, "EBIN": 0.0046, "ISAL": 0.0046,
+	"SARA": 0.0046, "DITR": 0.0046, "NTEF": 0.0046, "RIPO": 0.0046, "ITOC": 0.0046,
+	"LLEN": 0.0046, "IDDE": 0.0046, "ERAI": 0.0046, "PEDI": 0.0046, "EICA": 0.0046,
+	"IMIL": 0.0046, "OTTI": 0.0046, "OLOA": 0.0046, "BERI": 0.0046, "ALAL": 0.0046,
+	"EUNE": 0.0046, "TIPR": 0.0046, "CHID": 0.0046, "EDEC": 0.0046, "IAIL": 0.0046,
+	"EISO": 0.0046, "LENZ": 0.0046, "LLUL": 0.0046, "ODAA": 0.0046, "EADA": 0.0046,
+	"ÈGIÀ": 0.0046, "AINU": 0.0046, "ENUO": 0.0046, "OLTR": 0.0046, "NOPE": 0.0046,
+	"ONME": 0.0046, "ATEM": 0.0046, "IVAM": 0.0046, "ONOF": 0.0046, "EUNS": 0.0046,
+	"SCIO": 0.0046, "IATE": 0.0046, "MARE": 0.0046, "VANZ": 0.0046, "NZAM": 0.0046,
+	"RIAL": 0.0046, "SITR": 0.0046, "NAUN": 0.0046, "NANO": 0.0046, "USTD": 0.0046,
+	"ILID": 0.0046, "RMAR": 0.0046, "AINV": 0.0046, "NNEC": 0.0046, "INPR": 0.0046,
+	"ADEM": 0.0046, "LALA": 0.0046, "ITIA": 0.0046, "INOT": 0.0046, "MOTI": 0.0046,
+	"LIAL": 0.0046, "TOPU": 0.0046, "VIME": 0.0046, "LHEA": 0.0046, "GUIG": 0.0046,
+	"FETC": 0.0046, "ILBR": 0.0046, "AQAR": 0.0046, "RAQS": 0.0046, "DDIS": 0.0045,
+	"DIAM": 0.0045, "APPE": 0.0045, "BABI": 0.0045, "ISLA": 0.0045, "IARA": 0.0045,
+	"RIAC": 0.0045, "DROM": 0.0045, "PIAN": 0.0045, "AMIG": 0.0045, "LANA": 0.0045,
+	"INDO": 0.0045, "NISO": 0.0045, "ALBA": 0.0045, "ERGL": 0.0045, "GRES": 0.0045,
+	"RAPE": 0.0045, "TIIP": 0.0045, "TROC": 0.0045, "ACOP": 0.0045, "RUTT": 0.0045,
+	"ASHD": 0.0045, "ECER": 0.0045, "RISE": 0.0045, "NSPE": 0.0045, "NEER": 0.0045,
+	"VERL": 0.0045, "TOAT": 0.0045, "RMEN": 0.0045, "SAUR": 0.0045, "NOSE": 0.0045,
+	"DIFU": 0.0045, "ÈDIS": 0.0045, "ITAC": 0.0045, "LLOU": 0.0045, "FLUS": 0.0045,
+	"LLID": 0.0045, "UNSO": 0.0045, "COLI": 0.0045, "DUZI": 0.0045, "OTEM": 0.0045,
+	"INAP": 0.0045, "OUNP": 0.0045, "NOSU": 0.0045, "ATOÈ": 0.0045, "ERAU": 0.0045,
+	"IREA": 0.0045, "SSOL": 0.0045, "TAIM": 0.0045, "UNQU": 0.0045, "UNBU": 0.0045,
+	"TOAG": 0.0045, "SAPE": 0.0045, "DERR": 0.0045, "NTOF": 0.0045, "ECOP": 0.0045,
+	"ESOU": 0.0045, "ELOR": 0.0045, "CHEF": 0.0045, "NTDI": 0.0045, "OAME": 0.0045,
+	"ORIF": 0.0045, "MINE": 0.0045, "ELIS": 0.0045, "DISH": 0.0045, "ZATE": 0.0045,
+	"LAMI": 0.0045, "COLC": 0.0045, "AHUA": 0.0045, "GHER": 0.0045, "IMED": 0.0045,
+	"MPRO": 0.0045, "TNOT": 0.0045, "EOFT": 0.0045, "FTHI": 0.0045, "UNGA": 0.0044,
+	"ADEN": 0.0044, "RCAR": 0.0044, "LIER": 0.0044, "OLED": 0.0044, "CIAD": 0.0044,
+	"ANTA": 0.0044, "NEMA": 0.0044, "DOVE": 0.0044, "ONSC": 0.0044, "ROLI": 0.0044,
+	"OVAC": 0.0044, "INFE": 0.0044, "LOSE": 0.0044, "TÀNO": 0.0044, "ORER": 0.0044,
+	"LSUP": 0.0044, "EREE": 0.0044, "IDAI": 0.0044, "POIL": 0.0044, "TTRO": 0.0044,
+	"URAS": 0.0044, "QUIV": 0.0044, "MASE": 0.0044, "IAIU": 0.0044, "BUGD": 0.0044,
+	"ORII": 0.0044, "SIPE": 0.0044, "RETU": 0.0044, "INEE": 0.0044, "IZZI": 0.0044,
+	"USAS": 0.0044, "ANSI": 0.0044, "IORA": 0.0044, "ADIT": 0.0044, "POIN": 0.0044,
+	"NCER": 0.0044, "MBLA": 0.0044, "SETA": 0.0044, "TANZ": 0.0044, "INSO": 0.0044,
+	"EILB": 0.0044, "NONG": 0.0044, "POIC": 0.0044, "OICH": 0.0044, "TOPA": 0.0044,
+	"TOLE": 0.0044, "NSET": 0.0044, "IVOA": 0.0044, "SEMA": 0.0044, "VOCO": 0.0044,
+	"ODIQ": 0.0044, "NSCR": 0.0044, "INCH": 0.0044, "SODA": 0.0044, "TTOE": 0.0044,
+	"MMAE": 0.0044, "EANO": 0.0044, "ENEE": 0.0044, "MERU": 0.0044, "ELBR": 0.0044,
+	"OSAR": 0.0044, "MACC": 0.0044, "EDAV": 0.0044, "URRE": 0.0044, "SFOR": 0.0044,
+	"EDOV": 0.0044, "HASE": 0.0044, "NOAC": 0.0044, "AFON": 0.0044, "VERV": 0.0044,
+	"OBRA": 0.0044, "NCHC": 0.0044, "LCUR": 0.0044, "NAQT": 0.0044, "NEAU": 0.0043,
+	"LASU": 0.0043, "OCEN": 0.0043, "ESNO": 0.0043, "GARE": 0.0043, "NEVI": 0.0043,
+	"ANDS": 0.0043, "OMAL": 0.0043, "TTAD": 0.0043, "NING": 0.0043, "NTAN": 0.0043,
+	"OSCA": 0.0043, "CIAR": 0.0043, "ZANO": 0.0043, "MARC": 0.0043, "TACA": 0.0043,
+	"LINI": 0.0043, "FUTU": 0.0043, "INUA": 0.0043, "OBAB": 0.0043, "SSEN": 0.0043,
+	"PRIV": 0.0043, "OREF": 0.0043, "PARS": 0.0043, "ELEF": 0.0043, "IOAL": 0.0043,
+	"IMPR": 0.0043, "EELI": 0.0043, "OAIU": 0.0043, "LEBI": 0.0043, "ANOD": 0.0043,
+	"UTOP": 0.0043, "LINP": 0.0043, "ORAI": 0.0043, "ALEE": 0.0043, "ROSE": 0.0043,
+	"IANE": 0.0043, "ERST": 0.0043, "ESER": 0.0043, "AICA": 0.0043, "NTIO": 0.0043,
+	"SCEG": 0.0043, "CEGL": 0.0043, "NITE": 0.0043, "ICIA": 0.0043, "UDER": 0.0043,
+	"ELAG": 0.0043, "OADE": 0.0043, "ONUS": 0.0043, "NINS": 0.0043, "TROS": 0.0043,
+	"TTAR": 0.0043, "AHOM": 0.0043, "EGIÀ": 0.0043, "NSIA": 0.0043, "ISIT": 0.0043,
+	"BAND": 0.0043, "EHAN": 0.0043, "REOP": 0.0043, "ESLI": 0.0043, "RITI": 0.0043,
+	"EDAI": 0.0043, "TTRA": 0.0043, "OLAT": 0.0043, "TOVA": 0.0043, "TREI": 0.0043,
+	"ORAS": 0.0043, "ITEN": 0.0043, "ETAI": 0.0043, "ILLO": 0.0043, "AGTA": 0.0043,
+	"EANT": 0.0043, "ROFO": 0.0043, "GEDI": 0.0043, "REGN": 0.0043, "ITEC": 0.0043,
+	"ODOC": 0.0043, "HEOP": 0.0043, "STIS": 0.0043, "ISUG": 0.0043, "PRON": 0.0043,
+	"ATIR": 0.0043, "OCSP": 0.0043, "IIPA": 0.0043, "PORZ": 0.0043, "ORZI": 0.0043,
+	"ESFR": 0.0043, "IMÈS": 0.0043, "ROVE": 0.0042, "DIAU": 0.0042, "FREE": 0.0042,
+	"BANE": 0.0042, "ERPE": 0.0042, "ORET": 0.0042, "RCIA": 0.0042, "DIOG": 0.0042,
+	"NESO": 0.0042, "OBAL": 0.0042, "ACOS": 0.0042, "ANGO": 0.0042, "LONN": 0.0042,
+	"NOTI": 0.0042, "LATT": 0.0042, "TRES": 0.0042, "LEOT": 0.0042, "IINV": 0.0042,
+	"ÈCOM": 0.0042, "NPUN": 0.0042, "RTAN": 0.0042, "LALG": 0.0042, "ALOC": 0.0042,
+	"LRIS": 0.0042, "ÀNON": 0.0042, "EHOS": 0.0042, "ONAM": 0.0042, "ATIM": 0.0042,
+	"VICE": 0.0042, "MEST": 0.0042, "SIVO": 0.0042, "SSIS": 0.0042, "ULFI": 0.0042,
+	"OSIS": 0.0042, "RODO": 0.0042, "LOUN": 0.0042, "EIPR": 0.0042, "ROLA": 0.0042,
+	"LOBA": 0.0042, "OPAR": 0.0042, "LUSE": 0.0042, "LDIF": 0.0042, "NGEN": 0.0042,
+	"ADIV": 0.0042, "OMEE": 0.0042, "LOBI": 0.0042, "ELER": 0.0042, "NAST": 0.0042,
+	"SEPE": 0.0042, "LBLO": 0.0042, "NAPT": 0.0042, "CESL": 0.0042, "ITUA": 0.0042,
+	"UNOP": 0.0042, "LCOD": 0.0042, "EMIT": 0.0042, "ONFO": 0.0042, "MINU": 0.0042,
+	"RITE": 0.0042, "GREC": 0.0042, "ESEA": 0.0042, "HAVI": 0.0042, "ARGH": 0.0042,
+	"SUSE": 0.0042, "ETHA": 0.0042, "EPEN": 0.0042, "TEAM": 0.0042, "PLUG": 0.0042,
+	"LUGI": 0.0042, "AVIO": 0.0042, "SENS": 0.0042, "HEUN": 0.0042, "IMOF": 0.0042,
+	"NVAR": 0.0042, "TREV": 0.0042, "LPUS": 0.0042, "GITP": 0.0042, "OLCO": 0.0042,
+	"VIMO": 0.0042, "CDRO": 0.0042, "IMVI": 0.0042, "MÈST": 0.0042, "DIAG": 0.0041,
+	"NOMA": 0.0041, "PAGN": 0.0041, "LGRA": 0.0041, "ONEH": 0.0041, "OVIN": 0.0041,
+	"VINC": 0.0041, "RINE": 0.0041, "ARIE": 0.0041, "AELE": 0.0041, "CHAT": 0.0041,
+	"TIPI": 0.0041, "PIRE": 0.0041, "RIAT": 0.0041, "IDAD": 0.0041, "GARA": 0.0041,
+	"NELI": 0.0041, "ARCA": 0.0041, "TARA": 0.0041, "MINO": 0.0041, "EDEG": 0.0041,
+	"RILS": 0.0041, "REPI": 0.0041, "IGES": 0.0041, "CEPE": 0.0041, "VVIO": 0.0041,
+	"NIMA": 0.0041, "PNON": 0.0041, "ITAP": 0.0041, "OILM": 0.0041, "SUNO": 0.0041,
+	"EUTE": 0.0041, "LDES": 0.0041, "AVIR": 0.0041, "ASUL": 0.0041, "EEDE": 0.0041,
+	"RSIV": 0.0041, "UNAG": 0.0041, "ARER": 0.0041, "ESCI": 0.0041, "SANO": 0.0041,
+	"PPOD": 0.0041, "TETE": 0.0041, "ONMO": 0.0041, "TATS": 0.0041, "BASA": 0.0041,
+	"ISAS": 0.0041, "FFIS": 0.0041, "NELP": 0.0041, "ARIL": 0.0041, "ERAM": 0.0041,
+	"RRIM": 0.0041, "ELUS": 0.0041, "OLER": 0.0041, "DIOP": 0.0041, "OLTE": 0.0041,
+	"CTRL": 0.0041, "ROFI": 0.0041, "TASU": 0.0041, "VIRT": 0.0041, "TOFO": 0.0041,
+	"OUNE": 0.0041, "ORDD": 0.0041, "ETAM": 0.0041, "NIPO": 0.0041, "SEPR": 0.0041,
+	"EDAS": 0.0041, "AVEA": 0.0041, "HEES": 0.0041, "LIAS": 0.0041, "EUNB": 0.0041,
+	"BRAI": 0.0041, "HENE": 0.0041, "TURC": 0.0041, "SIND": 0.0041, "NDUS": 0.0041,
+	"ELAM": 0.0041, "ELEP": 0.0041, "RGHE": 0.0041, "OSSE": 0.0041, "MACI": 0.0041,
+	"ONIV": 0.0041, "TATT": 0.0041, "ONGI": 0.0041, "GUEC": 0.0041, "MITT": 0.0041,
+	"AILM": 0.0041, "EHAV": 0.0041, "EFSC": 0.0041, "IONT": 0.0041, "ERON": 0.0040,
+	"ROSS": 0.0040, "STAF": 0.0040, "DIED": 0.0040, "EVEC": 0.0040, "NLAN": 0.0040,
+	"AMIN": 0.0040, "ICCO": 0.0040, "SORI": 0.0040, "OSEL": 0.0040, "LENA": 0.0040,
+	"NUSA": 0.0040, "IPLE": 0.0040, "RASU": 0.0040, "EMER": 0.0040, "MBIO": 0.0040,
+	"EVAT": 0.0040, "ACOL": 0.0040, "LEFA": 0.0040, "PIAR": 0.0040, "ONSP": 0.0040,
+	"OSCH": 0.0040, "SINC": 0.0040, "EIPE": 0.0040, "RTAL": 0.0040, "LLEV": 0.0040,
+	"EMAT": 0.0040, "NZAC": 0.0040, "GESE": 0.0040, "ITAI": 0.0040, "IESC": 0.0040,
+	"RILC": 0.0040, "EROO": 0.0040, "IANT": 0.0040, "THRE": 0.0040, "ODEF": 0.0040,
+	"IOPZ": 0.0040, "SOSO": 0.0040, "PIAD": 0.0040, "TECH": 0.0040, "ALAM": 0.0040,
+	"IDGI": 0.0040, "CCOD": 0.0040, "EDIQ": 0.0040, "EROP": 0.0040, "NDLE": 0.0040,
+	"NCAM": 0.0040, "NTAG": 0.0040, "NZAS": 0.0040, "OIGN": 0.0040, "NEAD": 0.0040,
+	"VIAZ": 0.0040, "UNTD": 0.0040, "VECO": 0.0040, "NIER": 0.0040, "VERA": 0.0040,
+	"INTR": 0.0040, "VENE": 0.0040, "EELA": 0.0040, "OOGG": 0.0040, "PPRE": 0.0040,
+	"AZER": 0.0040, "ONAS": 0.0040, "CUNE": 0.0040, "TARC": 0.0040, "DITÀ": 0.0040,
+	"EDUS": 0.0040, "UONO": 0.0040, "INGE": 0.0040, "LCAS": 0.0040, "ESTN": 0.0040,
+	"INRE": 0.0040, "OGIT": 0.0040, "GITB": 0.0040, "FONE": 0.0040, "OUPM": 0.0040,
+	"THEB": 0.0040, "VIMD": 0.0040, "HERA": 0.0039, "AINE": 0.0039, "TÀDE": 0.0039,
+	"DIDO": 0.0039, "ROME": 0.0039, "COMO": 0.0039, "ANDC": 0.0039, "PICC": 0.0039,
+	"MUST": 0.0039, "SASS": 0.0039, "ORDE": 0.0039, "ANAD": 0.0039, "SERB": 0.0039,
+	"AIDA": 0.0039, "ESHE": 0.0039, "ETIN": 0.0039, "LLAU": 0.0039, "ZODE": 0.0039,
+	"SSOI": 0.0039, "PCON": 0.0039, "TRUT": 0.0039, "EFAR": 0.0039, "ELAF": 0.0039,
+	"OILS": 0.0039, "PUTI": 0.0039, "DEIM": 0.0039, "IETÀ": 0.0039, "ONCR": 0.0039,
+	"PIAT": 0.0039, "UTCO": 0.0039, "OINO": 0.0039, "EESC": 0.0039, "NELR": 0.0039,
+	"OREÈ": 0.0039, "NEPI": 0.0039, "INUM": 0.0039, "OPOT": 0.0039, "NVIS": 0.0039,
+	"HREA": 0.0039, "HEAC": 0.0039, "CUIS": 0.0039, "ISEC": 0.0039, "MONI": 0.0039,
+	"MULA": 0.0039, "SEGM": 0.0039, "PPAT": 0.0039, "GATO": 0.0039, "NDAT": 0.0039,
+	"ICAA": 0.0039, "ARRE": 0.0039, "NUNC": 0.0039, "RILF": 0.0039, "LECT": 0.0039,
+	"OPAC": 0.0039, "PTCO": 0.0039, "TTOT": 0.0039, "RFIL": 0.0039, "EVIA": 0.0039,
+	"EROG": 0.0039, "ESUC": 0.0039, "UGUA": 0.0039, "NSIN": 0.0039, "ÀCON": 0.0039,
+	"NTAA": 0.0039, "EMBE": 0.0039, "AMAL": 0.0039, "TOOL": 0.0039, "USAP": 0.0039,
+	"ATEO": 0.0039, "TOAN": 0.0039, "AILA": 0.0039, "DADE": 0.0039, "QUAS": 0.0039,
+	"ORTE": 0.0039, "OVOC": 0.0039, "FORS": 0.0039, "ISSI": 0.0039, "ODOV": 0.0039,
+	"VISE": 0.0039, "LLDI": 0.0039, "RADD": 0.0039, "UIGI": 0.0039, "CISI": 0.0039,
+	"OCMA": 0.0039, "QARC": 0.0039, "DGRO": 0.0039, "LESS": 0.0038, "TAFI": 0.0038,
+	"ORIO": 0.0038, "LLAB": 0.0038, "LEGI": 0.0038, "LARO": 0.0038, "UANT": 0.0038,
+	"LTAG": 0.0038, "HEBE": 0.0038, "HENA": 0.0038, "ARLA": 0.0038, "IAVA": 0.0038,
+	"NGAL": 0.0038, "GADE": 0.0038, "ACRO": 0.0038, "PELI": 0.0038, "ROBA": 0.0038,
+	"BILM": 0.0038, "CRED": 0.0038, "LDAP": 0.0038, "SIVE": 0.0038, "TOER": 0.0038,
+	"ATIL": 0.0038, "ASUA": 0.0038, "LAPE": 0.0038, "PUTC": 0.0038, "ERTE": 0.0038,
+	"EROM": 0.0038, "UTAT": 0.0038, "MBRO": 0.0038, "NEAS": 0.0038, "ICID": 0.0038,
+	"CIDI": 0.0038, "NEEL": 0.0038, "UNDI": 0.0038, "OLOF": 0.0038, "ÈFUO": 0.0038,
+	"ITPE": 0.0038, "NSIC": 0.0038, "ITOU": 0.0038, "OAVE": 0.0038, "ATAE": 0.0038,
+	"LUTO": 0.0038, "ONFA": 0.0038, "HEPO": 0.0038, "RMED": 0.0038, "ILEH": 0.0038,
+	"PUTA": 0.0038, "VITA": 0.0038, "GMEN": 0.0038, "EPUN": 0.0038, "IRTU": 0.0038,
+	"RTUA": 0.0038, "EDUP": 0.0038, "HEHA": 0.0038, "ILIS": 0.0038, "URAI": 0.0038,
+	"DAQU": 0.0038, "USIO": 0.0038, "ANEA": 0.0038, "ODEB": 0.0038, "EUNT": 0.0038,
+	"ENEG": 0.0038, "ODOR": 0.0038, "ILTA": 0.0038, "AUTI": 0.0038, "OMUL": 0.0038,
+	"INBA": 0.0038, "NBAS": 0.0038, "MECH": 0.0038, "EMEA": 0.0038, "UTOC": 0.0038,
+	"ROUN": 0.0038, "ONQU": 0.0038, "VOLE": 0.0038, "ARME": 0.0038, "NIGE": 0.0038,
+	"TONA": 0.0038, "TAQU": 0.0038, "TEPA": 0.0038, "OSIA": 0.0038, "IINM": 0.0038,
+	"MOCO": 0.0038, "SUIT": 0.0038, "PUOI": 0.0038, "UPPI": 0.0038, "LOCM": 0.0038,
+	"LUES": 0.0038, "RLAQ": 0.0038, "AFRA": 0.0037, "ALSU": 0.0037, "NDAN": 0.0037,
+	"CAQU": 0.0037, "IDUR": 0.0037, "ADUR": 0.0037, "APAG": 0.0037, "ITHE": 0.0037,
+	"LLAG": 0.0037, "NECA": 0.0037, "ROSO": 0.0037, "RRAN": 0.0037, "OSET": 0.0037,
+	"NEME": 0.0037, "UGGE": 0.0037, "USTR": 0.0037, "IDAM": 0.0037, "SUQU": 0.0037,
+	"NESU": 0.0037, "RTOP": 0.0037, "DOMI": 0.0037, "ÈUNF": 0.0037, "OLTI": 0.0037,
+	"NUNO": 0.0037, "EGEN": 0.0037, "ICEP": 0.0037, "STDE": 0.0037, "ELMO": 0.0037,
+	"ITÀN": 0.0037, "ICIE": 0.0037, "IPOT": 0.0037, "INVA": 0.0037, "NTEQ": 0.0037,
+	"URIA": 0.0037, "NEED": 0.0037, "PAIL": 0.0037, "LISO": 0.0037, "SENE": 0.0037,
+	"ADID": 0.0037, "EIBU": 0.0037, "IBUG": 0.0037, "IDUE": 0.0037, "ELDE": 0.0037,
+	"EENO": 0.0037, "OSSA": 0.0037, "ORRO": 0.0037, "EVID": 0.0037, "ENIT": 0.0037,
+	"EGAR": 0.0037, "TOEL": 0.0037, "AUNN": 0.0037, "NDIZ": 0.0037, "POCO": 0.0037,
+	"DARI": 0.0037, "UNGR": 0.0037, "IONV": 0.0037, "CUNI": 0.0037, "GUEA": 0.0037,
+	"ASIA": 0.0037, "ICOP": 0.0037, "ATSU": 0.0037, "ONLI": 0.0037, "NITÀ": 0.0037,
+	"ISID": 0.0037, "FALS": 0.0037, "EFAT": 0.0037, "SOUN": 0.0037, "ZIAR": 0.0037,
+	"ISUI": 0.0037, "AUNC": 0.0037, "TEGR": 0.0037, "ASEA": 0.0037, "MEAL": 0.0037,
+	"VOLU": 0.0037, "AILT": 0.0037, "GUID": 0.0037, "OSEM": 0.0037, "OAUN": 0.0037,
+	"ASTH": 0.0037, "GIUD": 0.0037, "UDEO": 0.0037, "NOIL": 0.0037, "DINU": 0.0037,
+	"SOFT": 0.0037, "VIOR": 0.0037, "RMAS": 0.0037, "BLES": 0.0037, "ADUN": 0.0037,
+	"AFFI": 0.0037, "OICO": 0.0037, "OVOF": 0.0037, "ANIE": 0.0037, "VINE": 0.0037,
+	"OVAF": 0.0037, "VAFI": 0.0037, "EITR": 0.0037, "RUSE": 0.0037, "EADD": 0.0037,
+	"RGED": 0.0037, "BLOB": 0.0037, "OMPT": 0.0037, "TETH": 0.0037, "OUPA": 0.0037,
+	"CANB": 0.0037, "ANBE": 0.0037, "PPEN": 0.0036, "RABA": 0.0036, "LEUT": 0.0036,
+	"QUAT": 0.0036, "ÀDEL": 0.0036, "MASC": 0.0036, "AORI": 0.0036, "IANI": 0.0036,
+	"RLAN": 0.0036, "NCIP": 0.0036, "DOAR": 0.0036, "ILOS": 0.0036, "DISU": 0.0036,
+	"ATIU": 0.0036, "IESI": 0.0036, "LANU": 0.0036, "UQUE": 0.0036, "ISUP": 0.0036,
+	"GDEL": 0.0036, "LIDU": 0.0036, "RTAC": 0.0036, "INEG": 0.0036, "IARI": 0.0036,
+	"UNAT": 0.0036, "ÙDIU": 0.0036, "LBUF": 0.0036, "MPAS": 0.0036, "ULAZ": 0.0036,
+	"TTOL": 0.0036, "TOAM": 0.0036, "NNOT": 0.0036, "UNES": 0.0036, "DEDI": 0.0036,
+	"ASEI": 0.0036, "GENI": 0.0036, "NSIT": 0.0036, "HIUD": 0.0036, "ASEM": 0.0036,
+	"SOAL": 0.0036, "SPER": 0.0036, "ZZAS": 0.0036, "AOPE": 0.0036, "TOOP": 0.0036,
+	"EROI": 0.0036, "SPLI": 0.0036, "GNON": 0.0036, "PPOS": 0.0036, "PPOP": 0.0036,
+	"IFIN": 0.0036, "UNAM": 0.0036, "AAPP": 0.0036, "CHEH": 0.0036, "LCOR": 0.0036,
+	"MAIC": 0.0036, "IESS": 0.0036, "RDOU": 0.0036, "EGME": 0.0036, "NELD": 0.0036,
+	"ANDL": 0.0036, "NSIS": 0.0036, "PREN": 0.0036, "STAA": 0.0036, "NSIG": 0.0036,
+	"CLEA": 0.0036, "VAUN": 0.0036, "TEME": 0.0036, "NTEÈ": 0.0036, "NOTR": 0.0036,
+	"ANOS": 0.0036, "PATU": 0.0036, "IMAP": 0.0036, "REEN": 0.0036, "ANOB": 0.0036,
+	"USSO": 0.0036, "COCO": 0.0036, "ARID": 0.0036, "ICOC": 0.0036, "OFON": 0.0036,
+	"AIPA": 0.0036, "OTAG": 0.0036, "THET": 0.0036, "HEFO": 0.0036, "VUOI": 0.0036,
+	"ONRE": 0.0036, "NHAS": 0.0036, "DAVV": 0.0036, "MOPZ": 0.0036, "PAND": 0.0036,
+	"HATT": 0.0036, "SOLT": 0.0036, "IMSI": 0.0036, "NEAG": 0.0036, "NORO": 0.0036,
+	"DULE": 0.0036, "NEGU": 0.0036, "TTEI": 0.0036, "OUPD": 0.0036, "ISNO": 0.0036,
+	"ARAG": 0.0035, "RINA": 0.0035, "ASIL": 0.0035, "DANO": 0.0035, "STEG": 0.0035,
+	"SCEN": 0.0035, "CACE": 0.0035, "ODIB": 0.0035, "LAPP": 0.0035, "NEGR": 0.0035,
+	"LEDO": 0.0035, "ELQU": 0.0035, "ACAT": 0.0035, "VIST": 0.0035, "ENTS": 0.0035,
+	"TEFI": 0.0035, "ABUL": 0.0035, "NELO": 0.0035, "IPEL": 0.0035, "OCOD": 0.0035,
+	"NESA": 0.0035, "TODU": 0.0035, "ODEG": 0.0035, "NÈIN": 0.0035, "HESU": 0.0035,
+	"HDEL": 0.0035, "DALC": 0.0035, "EAVV": 0.0035, "OMEH": 0.0035, "AMEM": 0.0035,
+	"AERR": 0.0035, "CCOR": 0.0035, "MEUT": 0.0035, "GHEC": 0.0035, "BMOD": 0.0035,
+	"EPIN": 0.0035, "EROA": 0.0035, "CREE": 0.0035, "TTAA": 0.0035, "DIET": 0.0035,
+	"UGDI": 0.0035, "IINO": 0.0035, "NEMO": 0.0035, "SEFI": 0.0035, "TAUS": 0.0035,
+	"NZAN": 0.0035, "ORTI": 0.0035, "SAUN": 0.0035, "RIOP": 0.0035, "IÙRE": 0.0035,
+	"RIOI": 0.0035, "TIOP": 0.0035, "NTOL": 0.0035, "SOIL": 0.0035, "RIAD": 0.0035,
+	"NEOP": 0.0035, "ORAG": 0.0035, "CEIN": 0.0035, "ALEB": 0.0035, "EVIT": 0.0035,
+	"LEBA": 0.0035, "LTEP": 0.0035, "OVAM": 0.0035, "RSOI": 0.0035, "ASIP": 0.0035,
+	"LURI": 0.0035, "RSOS": 0.0035, "NEGL": 0.0035, "ESOP": 0.0035, "TEAD": 0.0035,
+	"PODE": 0.0035, "GUAL": 0.0035, "OACC": 0.0035, "NRIF": 0.0035, "LEQU": 0.0035,
+	"NOLE": 0.0035, "EINQ": 0.0035, "TISC": 0.0035, "ALAF": 0.0035, "OESI": 0.0035,
+	"ZERA": 0.0035, "NDIB": 0.0035, "SUDA": 0.0035, "ITNE": 0.0035, "PIDG": 0.0035,
+	"DGIN": 0.0035, "OLEC": 0.0035, "ICEC": 0.0035, "HUAT": 0.0035, "UATL": 0.0035,
+	"REZI": 0.0035, "IOGN": 0.0035, "ERFO": 0.0035, "ODEC": 0.0035, "DIFO": 0.0035,
+	"TIIC": 0.0035, "ÈPRE": 0.0035, "IILC": 0.0035, "IVEA": 0.0035, "ERTR": 0.0035,
+	"DEIB": 0.0035, "TUTE": 0.0035, "ICIF": 0.0035, "OELI": 0.0035, "EROR": 0.0035,
+	"SERD": 0.0035, "IMTE": 0.0035, "EFIE": 0.0035, "BEHA": 0.0035, "BLEI": 0.0035,
+	"HHEL": 0.0035, "CANE": 0.0034, "ONCE": 0.0034, "HEST": 0.0034, "OLEG": 0.0034,
+	"RONI": 0.0034, "MADA": 0.0034, "ELNU": 0.0034, "RUSA": 0.0034, "URIS": 0.0034,
+	"IETR": 0.0034, "MERA": 0.0034, "ISAN": 0.0034, "TIAG": 0.0034, "RSTA": 0.0034,
+	"IVIL": 0.0034, "EREO": 0.0034, "NDOÈ": 0.0034, "NLOS": 0.0034, "TAUT": 0.0034,
+	"LTES": 0.0034, "UTTU": 0.0034, "SHDE": 0.0034, "CIEN": 0.0034, "TINT": 0.0034,
+	"NTOT": 0.0034, "NUSC": 0.0034, "TABU": 0.0034, "CICL": 0.0034, "ICLO": 0.0034,
+	"IDAS": 0.0034, "GGEL": 0.0034, "GELO": 0.0034, "SCEC": 0.0034, "IMOA": 0.0034,
+	"AAUT": 0.0034, "ADUZ": 0.0034, "MEPR": 0.0034, "FIDA": 0.0034, "MPRI": 0.0034,
+	"SOSE": 0.0034, "ALIP": 0.0034, "NZAU": 0.0034, "OBAS": 0.0034, "NVIR": 0.0034,
+	"HIAR": 0.0034, "CNON": 0.0034, "OREO": 0.0034, "LLEF": 0.0034, "APTI": 0.0034,
+	"TINS": 0.0034, "CELT": 0.0034, "UNPE": 0.0034, "OUND": 0.0034, "IIST": 0.0034,
+	"ONEQ": 0.0034, "EILL": 0.0034, "EDED": 0.0034, "ATAT": 0.0034, "RCAT": 0.0034,
+	"RRES": 0.0034, "RDER": 0.0034, "SITA": 0.0034, "CHNO": 0.0034, "LTAN": 0.0034,
+	"ALAZ": 0.0034, "ERFI": 0.0034, "TTOO": 0.0034, "LOQU": 0.0034, "FORC": 0.0034,
+	"PRIA": 0.0034, "EBLO": 0.0034, "EMAP": 0.0034, "UNID": 0.0034, "REID": 0.0034,
+	"PMOS": 0.0034, "VICO": 0.0034, "STAU": 0.0034, "ALDI": 0.0034, "ESED": 0.0034,
+	"UDOC": 0.0034, "IGER": 0.0034, "TALT": 0.0034, "NOSO": 0.0034, "TAPA": 0.0034,
+	"NSAR": 0.0034, "LATR": 0.0034, "IBLE": 0.0034, "IPTD": 0.0034, "TOSH": 0.0034,
+	"RAMB": 0.0034, "CACO": 0.0034, "RDET": 0.0034, "LREB": 0.0034, "DULI": 0.0034,
+	"GESI": 0.0034, "USSA": 0.0034, "IMNO": 0.0034, "OLAQ": 0.0034, "MTER": 0.0034,
+	"MINF": 0.0034, "LONA": 0.0033, "OEST": 0.0033, "ICAE": 0.0033, "SCHA": 0.0033,
+	"TANN": 0.0033, "INGD": 0.0033, "EMON": 0.0033, "OMOR": 0.0033, "DONG": 0.0033,
+	"RAVI": 0.0033, "UNIO": 0.0033, "OVEC": 0.0033, "TDIN": 0.0033, "TICI": 0.0033,
+	"LLEA": 0.0033, "TIBL": 0.0033, "AILL": 0.0033, "TRIP": 0.0033, "RRIC": 0.0033,
+	"OMEG": 0.0033, "NASP": 0.0033, "LEHA": 0.0033, "VEPR": 0.0033, "RTAI": 0.0033,
+	"RADA": 0.0033, "IFIR": 0.0033, "LEGE": 0.0033, "ATOF": 0.0033, "NELM": 0.0033,
+	"TTAL": 0.0033, "RIPA": 0.0033, "DAAL": 0.0033, "IMAI": 0.0033, "LTAI": 0.0033,
+	"BULA": 0.0033, "NRIM": 0.0033, "IBUI": 0.0033, "SSOP": 0.0033, "SICH": 0.0033,
+	"LIID": 0.0033, "ILPI": 0.0033, "NOUS": 0.0033, "PAIN": 0.0033, "OPRA": 0.0033,
+	"MESP": 0.0033, "IOSO": 0.0033, "TMAN": 0.0033, "ITUZ": 0.0033, "TUZI": 0.0033,
+	"NGRU": 0.0033, "NIES": 0.0033, "DDIV": 0.0033, "TOAC": 0.0033, "DIIS": 0.0033,
+	"OFIG": 0.0033, "UTUR": 0.0033, "NEPO": 0.0033, "ECAN": 0.0033, "NTOÈ": 0.0033,
+	"APTP": 0.0033, "PIEG": 0.0033, "RROM": 0.0033, "IPAT": 0.0033, "ODOI": 0.0033,
+	"ZAIN": 0.0033, "ELPM": 0.0033, "LPMO": 0.0033, "RINF": 0.0033, "CONE": 0.0033,
+	"ULDE": 0.0033, "SUST": 0.0033, "IDOC": 0.0033, "PUÒI": 0.0033, "UÒIN": 0.0033,
+	"EROT": 0.0033, "OROF": 0.0033, "GIND": 0.0033, "SHAN": 0.0033, "OCHA": 0.0033,
+	"OGHE": 0.0033, "ORME": 0.0033, "NAHU": 0.0033, "PPIN": 0.0033, "UNDE": 0.0033,
+	"ELPI": 0.0033, "HAIL": 0.0033, "NORV": 0.0033, "ORVE": 0.0033, "SEST": 0.0033,
+	"REEV": 0.0033, "ENSO": 0.0033, "RREC": 0.0033, "LTUO": 0.0033, "ESUG": 0.0033,
+	"MOFI": 0.0033, "CALO": 0.0033, "NEPU": 0.0033, "HCOR": 0.0033, "TDIG": 0.0033,
+	"SSAA": 0.0033, "RORS": 0.0033, "HAVE": 0.0033, "ECTU": 0.0033, "ETHI": 0.0033,
+	"QINS": 0.0033, "OTCH": 0.0033, "HARI": 0.0032, "ABIT": 0.0032, "ODAN": 0.0032,
+	"ETAG": 0.0032, "OLUM": 0.0032, "UATO": 0.0032, "ERAA": 0.0032, "AMAS": 0.0032,
+	"MANA": 0.0032, "FARS": 0.0032, "IOVE": 0.0032, "LABA": 0.0032, "FINL": 0.0032,
+	"NION": 0.0032, "LUSS": 0.0032, "ANIC": 0.0032, "ROCC": 0.0032, "OOCC": 0.0032,
+	"POLI": 0.0032, "TART": 0.0032, "DIVO": 0.0032, "DIAV": 0.0032, "RONC": 0.0032,
+	"LISS": 0.0032, "OINI": 0.0032, "MEHO": 0.0032, "TNEL": 0.0032, "IGAN": 0.0032,
+	"EFUN": 0.0032, "AILV": 0.0032, "ICRI": 0.0032, "TEGG": 0.0032, "RASO": 0.0032,
+	"ISIA": 0.0032, "AESP": 0.0032, "CUNF": 0.0032, "NSUL": 0.0032, "NUSE": 0.0032,
+	"NSOT": 0.0032, "DODE": 0.0032, "UARE": 0.0032, "IERE": 0.0032, "ERIR": 0.0032,
+	"ISET": 0.0032, "ÙREC": 0.0032, "CAIN": 0.0032, "RAGL": 0.0032, "ZIAI": 0.0032,
+	"ENAT": 0.0032, "OUSE": 0.0032, "RIAV": 0.0032, "UNCE": 0.0032, "IDAV": 0.0032,
+	"OSOT": 0.0032, "RCHE": 0.0032, "URAA": 0.0032, "COPP": 0.0032, "CANC": 0.0032,
+	"OASS": 0.0032, "REUT": 0.0032, "OLEN": 0.0032, "ISAT": 0.0032, "ARLI": 0.0032,
+	"EPOT": 0.0032, "INOL": 0.0032, "CORP": 0.0032, "RECI": 0.0032, "BIAT": 0.0032,
+	"ALIM": 0.0032, "ENEP": 0.0032, "FILI": 0.0032, "INEN": 0.0032, "NEFO": 0.0032,
+	"IALA": 0.0032, "PTDE": 0.0032, "ORCE": 0.0032, "VIMA": 0.0032, "AVEÈ": 0.0032,
+	"REIC": 0.0032, "IOSP": 0.0032, "FSTA": 0.0032, "SEAL": 0.0032, "NINE": 0.0032,
+	"MAST": 0.0032, "LOLE": 0.0032, "ASHA": 0.0032, "IBIA": 0.0032, "SECT": 0.0032,
+	"DADI": 0.0032, "OCAN": 0.0032, "NADA": 0.0032, "APAN": 0.0032, "CHIM": 0.0032,
+	"THAI": 0.0032, "ESST": 0.0032, "ETED": 0.0032, "ELMA": 0.0032, "SPAG": 0.0032,
+	"ISUS": 0.0032, "RVEG": 0.0032, "CINT": 0.0032, "OTAZ": 0.0032, "HIVE": 0.0032,
+	"OSEP": 0.0032, "FAST": 0.0032, "NODA": 0.0032, "GEDE": 0.0032, "COSÌ": 0.0032,
+	"SIDA": 0.0032, "TSPE": 0.0032, "UÒAN": 0.0032, "ÒANC": 0.0032, "OSIP": 0.0032,
+	"ECIS": 0.0032, "MIFI": 0.0032, "ASEV": 0.0032, "LESF": 0.0032, "RVIS": 0.0032,
+	"EFON": 0.0032, "IISI": 0.0032, "MITP": 0.0032, "MITS": 0.0032, "OROC": 0.0032,
+	"FORA": 0.0032, "VIMU": 0.0032, "IBRA": 0.0031, "AGAR": 0.0031, "TCHE": 0.0031,
+	"NGRA": 0.0031, "DEIR": 0.0031, "ICEE": 0.0031, "TINI": 0.0031, "MAZA": 0.0031,
+	"RDOC": 0.0031, "UITA": 0.0031, "RIGU": 0.0031, "LOSU": 0.0031, "ETIP": 0.0031,
+	"LIEN": 0.0031, "AASS": 0.0031, "PIDI": 0.0031, "ERSU": 0.0031, "OSTD": 0.0031,
+	"ITÀC": 0.0031, "MMES": 0.0031, "NCRO": 0.0031, "OTIP": 0.0031, "APIÙ": 0.0031,
+	"IDAA": 0.0031, "OINP": 0.0031, "OSEG": 0.0031, "GGID": 0.0031, "USHD": 0.0031,
+	"TUSA": 0.0031, "EVIC": 0.0031, "NEAT": 0.0031, "ROLE": 0.0031, "MBIG": 0.0031,
+	"ERUL": 0.0031, "RULT": 0.0031, "SPAC": 0.0031, "TTIP": 0.0031, "OUNI": 0.0031,
+	"STDO": 0.0031, "VENI": 0.0031, "ALEÈ": 0.0031, "OALB": 0.0031, "NDII": 0.0031,
+	"TATD": 0.0031, "EADS": 0.0031, "TEOP": 0.0031, "NLOP": 0.0031, "ASAT": 0.0031,
+	"OILR": 0.0031, "OUNS": 0.0031, "TEPU": 0.0031, "CELL": 0.0031, "AEFF": 0.0031,
+	"ITIS": 0.0031, "RMAG": 0.0031, "ESOV": 0.0031, "EAPE": 0.0031, "DINT": 0.0031,
+	"GUAR": 0.0031, "UARD": 0.0031, "IVUO": 0.0031, "CURO": 0.0031, "TOHA": 0.0031,
+	"STIP": 0.0031, "AIRI": 0.0031, "NTOM": 0.0031, "TÀCO": 0.0031, "SISO": 0.0031,
+	"AVIC": 0.0031, "HEPR": 0.0031, "AAPR": 0.0031, "MENA": 0.0031, "HEVE": 0.0031,
+	"OGIA": 0.0031, "ETOT": 0.0031, "LBAN": 0.0031, "AROC": 0.0031, "MIAO": 0.0031,
+	"ERGI": 0.0031, "EFRA": 0.0031, "COSE": 0.0031, "HELI": 0.0031, "GINN": 0.0031,
+	"OMIF": 0.0031, "OLOQ": 0.0031, "OBLO": 0.0031, "OLIE": 0.0031, "ENDS": 0.0031,
+	"RFOR": 0.0031, "HERI": 0.0031, "STHA": 0.0031, "OTEN": 0.0031, "ISEL": 0.0031,
+	"VOFI": 0.0031, "STIA": 0.0031, "NQAD": 0.0031, "OUPS": 0.0031, "UIVI": 0.0031,
+	"HIST": 0.0031, "CURR": 0.0031, "EPAC": 0.0031, "STNÉ": 0.0031, "NREL": 0.0031,
+	"AQES": 0.0031, "BEFO": 0.0031, "OESN": 0.0031, "PTVI": 0.0031, "SAMO": 0.0030,
+	"ALUS": 0.0030, "ACAP": 0.0030, "ALEH": 0.0030, "NIAR": 0.0030, "EDFO": 0.0030,
+	"NGAP": 0.0030, "LTOP": 0.0030, "TEVE": 0.0030, "ENAD": 0.0030, "RIUN": 0.0030,
+	"GALL": 0.0030, "ALUT": 0.0030, "OGRE": 0.0030, "LODA": 0.0030, "ÀDIS": 0.0030,
+	"AUST": 0.0030, "URIN": 0.0030, "IORD": 0.0030, "ELLH": 0.0030, "ITÀP": 0.0030,
+	"AFIA": 0.0030, "EALS": 0.0030, "ARCO": 0.0030, "IODA": 0.0030, "OVUO": 0.0030,
+	"REER": 0.0030, "RLAR": 0.0030, "SUNT": 0.0030, "IZZE": 0.0030, "IFUN": 0.0030,
+	"ILAR": 0.0030, "GACO": 0.0030, "UEIL": 0.0030, "ALPR": 0.0030, "OPUÒ": 0.0030,
+	"ÈANC": 0.0030, "LISE": 0.0030, "CENZ": 0.0030, "SESO": 0.0030, "LANC": 0.0030,
+	"OBSO": 0.0030, "DOLE": 0.0030, "TITI": 0.0030, "ODOT": 0.0030, "ONPE": 0.0030,
+	"LIAG": 0.0030, "MITC": 0.0030, "UNMO": 0.0030, "NEVA": 0.0030, "LPOS": 0.0030,
+	"AMAZ": 0.0030, "DATT": 0.0030, "DCON": 0.0030, "OAPP": 0.0030, "GIOE": 0.0030,
+	"IVOP": 0.0030, "DEPR": 0.0030, "ECAT": 0.0030, "ORAC": 0.0030, "GIÀS": 0.0030,
+	"LOPR": 0.0030, "AVUO": 0.0030, "LUTA": 0.0030, "GPER": 0.0030, "DOAL": 0.0030,
+	"DAPA": 0.0030, "GZIP": 0.0030, "MBRA": 0.0030, "ÈUNN": 0.0030, "METH": 0.0030,
+	"SITU": 0.0030, "TUPD": 0.0030, "ISCH": 0.0030, "ZZAM": 0.0030, "NTIM": 0.0030,
+	"NTOO": 0.0030, "CEST": 0.0030, "LLAI": 0.0030, "EÈTR": 0.0030, "NGIB": 0.0030,
+	"ATOQ": 0.0030, "EPAS": 0.0030, "GUEM": 0.0030, "SIPR": 0.0030, "OLEV": 0.0030,
+	"ACED": 0.0030, "REPL": 0.0030, "SEAN": 0.0030, "ONMA": 0.0030, "LICH": 0.0030,
+	"DOCH": 0.0030, "TOGH": 0.0030, "GNOL": 0.0030, "MATE": 0.0030, "EGIA": 0.0030,
+	"UTEP": 0.0030, "ENET": 0.0030, "TESC": 0.0030, "IPTF": 0.0030, "PTFI": 0.0030,
+	"SHDI": 0.0030, "RIEM": 0.0030, "GINS": 0.0030, "NGED": 0.0030, "FINC": 0.0030,
+	"TERZ": 0.0030, "CEUN": 0.0030, "OLAV": 0.0030, "LURL": 0.0030, "SEUS": 0.0030,
+	"OSOS": 0.0030, "UIDA": 0.0030, "RLOC": 0.0030, "NZIC": 0.0030, "ZICH": 0.0030,
+	"INOU": 0.0030, "HENU": 0.0030, "ANDG": 0.0030, "OUPI": 0.0030, "ANDR": 0.0029,
+	"NDAM": 0.0029, "LLOI": 0.0029, "ARDE": 0.0029, "RRAD": 0.0029, "ZZAU": 0.0029,
+	"ALIF": 0.0029, "LREG": 0.0029, "NDEB": 0.0029, "TRET": 0.0029, "SLAN": 0.0029,
+	"NOMO": 0.0029, "RSIC": 0.0029, "DIVA": 0.0029, "ITTA": 0.0029, "OLES": 0.0029,
+	"OBIN": 0.0029, "RLAV": 0.0029, "OCOS": 0.0029, "MARA": 0.0029, "INIC": 0.0029,
+	"OLAP": 0.0029, "ICAB": 0.0029, "OLEM": 0.0029, "NAMA": 0.0029, "TDIS": 0.0029,
+	"SETI": 0.0029, "ETES": 0.0029, "INOD": 0.0029, "NOCC": 0.0029, "ICOE": 0.0029,
+	"SUNS": 0.0029, "EOUT": 0.0029, "ETAB": 0.0029, "NONL": 0.0029, "UNPU": 0.0029,
+	"CASU": 0.0029, "RDIS": 0.0029, "DALF": 0.0029, "NVIO": 0.0029, "RLOP": 0.0029,
+	"DUTO": 0.0029, "LOFF": 0.0029, "RLOS": 0.0029, "SEPI": 0.0029, "EATR": 0.0029,
+	"RIOC": 0.0029, "MATU": 0.0029, "SSIP": 0.0029, "NGEL": 0.0029, "ESOD": 0.0029,
+	"TDOU": 0.0029, "OMOS": 0.0029, "ÈVUO": 0.0029, "NONM": 0.0029, "ATDE": 0.0029,
+	"TIIL": 0.0029, "ONPI": 0.0029, "ODDI": 0.0029, "PUTP": 0.0029, "CPER": 0.0029,
+	"ITÀE": 0.0029, "DALP": 0.0029, "ORPO": 0.0029, "VIOL": 0.0029, "TATR": 0.0029,
+	"ITAA": 0.0029, "TEPO": 0.0029, "RZAT": 0.0029, "TSUL": 0.0029, "ORDA": 0.0029,
+	"AESS": 0.0029, "ASIC": 0.0029, "VELO": 0.0029, "NOOP": 0.0029, "OAQU": 0.0029,
+	"ASON": 0.0029, "ESTD": 0.0029, "RNEL": 0.0029, "EUNM": 0.0029, "CONQ": 0.0029,
+	"TEDU": 0.0029, "UDAN": 0.0029, "EAPA": 0.0029, "IOTA": 0.0029, "RENÉ": 0.0029,
+	"TROM": 0.0029, "RONE": 0.0029, "HATI": 0.0029, "INTL": 0.0029, "MAME": 0.0029,
+	"ESIL": 0.0029, "SVED": 0.0029, "TAVI": 0.0029, "MIGA": 0.0029, "FOGL": 0.0029,
+	"ITMA": 0.0029, "ICEA": 0.0029, "ALNO": 0.0029, "MORE": 0.0029, "ULAT": 0.0029,
+	"EALI": 0.0029, "RIUT": 0.0029, "POVE": 0.0029, "LVAT": 0.0029, "LCUI": 0.0029,
+	"ATEL": 0.0029, "IGAI": 0.0029, "RLDE": 0.0029, "TUTO": 0.0029, "STED": 0.0029,
+	"EEIL": 0.0029, "UEND": 0.0029, "NCHR": 0.0029, "RGEA": 0.0029, "ATTH": 0.0029,
+	"TUPG": 0.0029, "BEUS": 0.0029, "IORO": 0.0029, "NVIM": 0.0029, "ZIAV": 0.0029,
+	"PUTE": 0.0028, "LALT": 0.0028, "DAMA": 0.0028, "LMAN": 0.0028, "AFAT": 0.0028,
+	"EACA": 0.0028, "SOME": 0.0028, "IFRE": 0.0028, "LIPP": 0.0028, "SICA": 0.0028,
+	"FEDE": 0.0028, "AEST": 0.0028, "TRID": 0.0028, "MARO": 0.0028, "INEV": 0.0028,
+	"RENA": 0.0028, "CAVA": 0.0028, "TRIA": 0.0028, "DOLI": 0.0028, "IGAT": 0.0028,
+	"CARL": 0.0028, "MICA": 0.0028, "ARSE": 0.0028, "OPOR": 0.0028, "PREI": 0.0028,
+	"NNEL": 0.0028, "STOV": 0.0028, "IRIA": 0.0028, "TIUN": 0.0028, "IVIR": 0.0028,
+	"MEVI": 0.0028, "SSAP": 0.0028, "REAS": 0.0028, "NRIS": 0.0028, "LMET": 0.0028,
+	"ENUL": 0.0028, "URAP": 0.0028, "ODAI": 0.0028, "DALM": 0.0028, "TADD": 0.0028,
+	"MOME": 0.0028, "ELTI": 0.0028, "ELBU": 0.0028, "TITE": 0.0028, "IGAC": 0.0028,
+	"ENEV": 0.0028, "BIGU": 0.0028, "NDOM": 0.0028, "RANZ": 0.0028, "DAES": 0.0028,
+	"NGEI": 0.0028, "ZZON": 0.0028, "NIEN": 0.0028, "MEPE": 0.0028, "IPIÙ": 0.0028,
+	"HIDI": 0.0028, "NTET": 0.0028, "SSIE": 0.0028, "PIDA": 0.0028, "SCES": 0.0028,
+	"SIFI": 0.0028, "RIAP": 0.0028, "OSEC": 0.0028, "SODD": 0.0028, "DISF": 0.0028,
+	"ISFA": 0.0028, "UISO": 0.0028, "ASOD": 0.0028, "STOO": 0.0028, "BITO": 0.0028,
+	"RSID": 0.0028, "REVA": 0.0028, "IESO": 0.0028, "ZZAB": 0.0028, "ZABI": 0.0028,
+	"OACA": 0.0028, "RLAM": 0.0028, "EDAE": 0.0028, "DUEP": 0.0028, "IENO": 0.0028,
+	"NIPR": 0.0028, "REAI": 0.0028, "NAPO": 0.0028, "TTOÈ": 0.0028, "VIAN": 0.0028,
+	"OTAT": 0.0028, "SIGL": 0.0028, "RREG": 0.0028, "ONUL": 0.0028, "NOPZ": 0.0028,
+	"EDUE": 0.0028, "EIDE": 0.0028, "NTCO": 0.0028, "GUEU": 0.0028, "ÈUNC": 0.0028,
+	"SAAL": 0.0028, "NELN": 0.0028, "BECO": 0.0028, "SEUN": 0.0028, "AFRI": 0.0028,
+	"FRIC": 0.0028, "OTEP": 0.0028, "DANI": 0.0028, "AELI": 0.0028, "EODE": 0.0028,
+	"ITOT": 0.0028, "EMES": 0.0028, "GAIN": 0.0028, "ERBO": 0.0028, "GETS": 0.0028,
+	"BUND": 0.0028, "UNDL": 0.0028, "NMEN": 0.0028, "IDIL": 0.0028, "IEMP": 0.0028,
+	"ÀPOS": 0.0028, "SABL": 0.0028, "LITI": 0.0028, "ANUL": 0.0028, "ATET": 0.0028,
+	"NCHÉ": 0.0028, "DOAP": 0.0028, "PROS": 0.0028, "ICAF": 0.0028, "EDNO": 0.0028,
+	"NFRE": 0.0028, "IDAR": 0.0028, "MISS": 0.0028, "CEDU": 0.0028, "DIRM": 0.0028,
+	"ESEU": 0.0028, "HPER": 0.0028, "EIRI": 0.0028, "STLO": 0.0028, "RECT": 0.0028,
+	"RROO": 0.0028, "ODUC": 0.0028, "NINP": 0.0028, "TEED": 0.0028, "FRAM": 0.0028,
+	"SEES": 0.0028, "LIRI": 0.0028, "DOLL": 0.0028, "OOTC": 0.0028, "TECT": 0.0028,
+}
+
+var chainBigramsIT = map[string]float64{
+	"ON": 2.4351, "ER": 1.7925, "RE": 1.6898, "IN": 1.4849, "EN": 1.4634,
+	"LE": 1.4216, "ES": 1.4003, "DI": 1.3242, "RI": 1.3231, "TO": 1.2970,
+	"CO": 1.2875, "AL": 1.2799, "IL": 1.2785, "IO": 1.2601, "TA": 1.2557,
+	"AT": 1.2543, "NE": 1.2205, "NO": 1.2119, "EL": 1.1803, "NT": 1.1591,
+	"TE": 1.1560, "DE": 1.1375, "OR": 1.0804, "TI": 1.0234, "AR": 0.9965,
+	"IC": 0.9864, "ST": 0.9702, "LI": 0.9633, "AN": 0.9342, "SE": 0.8994,
+	"ED": 0.8777, "RA": 0.8762, "LA": 0.8708, "ME": 0.8610, "SI": 0.8379,
+	"FI": 0.8247, "IS": 0.7914, "CA": 0.7569, "OS": 0.7537, "IM": 0.7368,
+	"LL": 0.7349, "IT": 0.7326, "ZI": 0.7287, "PO": 0.7062, "OD": 0.6990,
+	"UN": 0.6950, "NA": 0.6866, "SS": 0.6779, "TT": 0.6761, "OM": 0.6726,
+	"CH": 0.6699, "RO": 0.6563, "IA": 0.6505, "MA": 0.6398, "PE": 0.6134,
+	"EC": 0.6116, "ET": 0.5987, "NI": 0.5844, "EI": 0.5759, "HE": 0.5637,
+	"ID": 0.5617, "SO": 0.5518, "LO": 0.5487, "ND": 0.5240, "AS": 0.5196,
+	"TR": 0.5069, "AC": 0.4785, "OL": 0.4691, "VE": 0.4682, "EA": 0.4668,
+	"OP": 0.4606, "MP": 0.4593, "AD": 0.4582, "CI": 0.4521, "DA": 0.4439,
+	"AM": 0.4344, "UT": 0.4326, "OC": 0.4325, "SC": 0.4231, "SA": 0.4144,
+	"BI": 0.4110, "IF": 0.4093, "EG": 0.4081, "GI": 0.4037, "PR": 0.4023,
+	"DO": 0.3887, "US": 0.3881, "VI": 0.3801, "AP": 0.3663, "PA": 0.3656,
+	"CE": 0.3630, "SU": 0.3607, "IE": 0.3570, "MO": 0.3561, "VA": 0.3512,
+	"IP": 0.3388, "AZ": 0.3367, "EM": 0.3331, "NC": 0.3322, "IB": 0.3249,
+	"AG": 0.3165, "NG": 0.3163, "EP": 0.3144, "IR": 0.3055, "OT": 0.3003,
+	"HI": 0.2970, "AI": 0.2949, "NS": 0.2915, "MI": 0.2899, "IU": 0.2892,
+	"IV": 0.2856, "GU": 0.2853, "IG": 0.2792, "UR": 0.2776, "CC": 0.2768,
+	"SP": 0.2682, "AV": 0.2679, "RR": 0.2614, "GE": 0.2505, "GG": 0.2461,
+	"RM": 0.2402, "NU": 0.2385, "ZA": 0.2365, "IZ": 0.2358, "QU": 0.2322,
+	"OV": 0.2298, "UE": 0.2284, "RS": 0.2274, "RT": 0.2227, "NF": 0.2170,
+	"EF": 0.2168, "UA": 0.2158, "LT": 0.2158, "TH": 0.2136, "AU": 0.2125,
+	"EV": 0.2114, "OI": 0.2098, "TU": 0.2077, "CR": 0.2046, "PU": 0.2035,
+	"EU": 0.2024, "OA": 0.2021, "UI": 0.2017, "OU": 0.1995, "FO": 0.1972,
+	"OG": 0.1865, "EE": 0.1827, "RC": 0.1814, "PP": 0.1787, "UL": 0.1738,
+	"EO": 0.1728, "LF": 0.1726, "AB": 0.1711, "UP": 0.1695, "PI": 0.1668,
+	"LC": 0.1662, "ZZ": 0.1646, "NV": 0.1637, "GA": 0.1595, "II": 0.1594,
+	"VO": 0.1567, "NZ": 0.1550, "GR": 0.1548, "GO": 0.1447, "CU": 0.1444,
+	"HA": 0.1443, "GN": 0.1431, "MM": 0.1382, "LU": 0.1367, "RN": 0.1337,
+	"BE": 0.1320, "DU": 0.1315, "UO": 0.1315, "LP": 0.1300, "RG": 0.1277,
+	"PZ": 0.1260, "GL": 0.1227, "UM": 0.1219, "LS": 0.1201, "BL": 0.1194,
+	"NP": 0.1166, "NN": 0.1158, "MB": 0.1158, "BA": 0.1152, "OF": 0.1148,
+	"OE": 0.1136, "EB": 0.1124, "AA": 0.1123, "RL": 0.1094, "NR": 0.1087,
+	"RD": 0.1077, "MU": 0.1063, "AF": 0.1063, "PT": 0.1063, "RU": 0.1046,
+	"BO": 0.0969, "EZ": 0.0964, "AQ": 0.0956, "PL": 0.0949, "FE": 0.0930,
+	"FA": 0.0877, "OO": 0.0873, "NÈ": 0.0869, "BU": 0.0843, "SH": 0.0833,
+	"LN": 0.0831, "LM": 0.0819, "OB": 0.0809, "AE": 0.0786, "LD": 0.0742,
+	"NM": 0.0741, "FF": 0.0727, "TÀ": 0.0710, "BR": 0.0693, "BB": 0.0685,
+	"TP": 0.0684, "RV": 0.0683, "FR": 0.0644, "EQ": 0.0641, "TC": 0.0637,
+	"TD": 0.0632, "AO": 0.0628, "GH": 0.0604, "UD": 0.0584, "UB": 0.0576,
+	"TS": 0.0569, "ÈS": 0.0554, "LB": 0.0540, "FU": 0.0524, "EH": 0.0521,
+	"HO": 0.0513, "UG": 0.0510, "EÈ": 0.0460, "CL": 0.0457, "NL": 0.0457,
+	"ZE": 0.0452, "LV": 0.0449, "UZ": 0.0442, "LR": 0.0422, "DD": 0.0409,
+	"TN": 0.0401, "UC": 0.0373, "HU": 0.0361, "RP": 0.0361, "UÒ": 0.0359,
+	"LG": 0.0353, "ZO": 0.0343, "ÀD": 0.0336, "ÈU": 0.0329, "OÈ": 0.0328,
+	"TL": 0.0327, "VU": 0.0320, "DS": 0.0315, "FT": 0.0315, "IQ": 0.0307,
+	"NB": 0.0301, "DT": 0.0300, "VV": 0.0294, "FL": 0.0279, "SL": 0.0269,
+	"CT": 0.0265, "OQ": 0.0260, "TM": 0.0256, "FS": 0.0256, "AH": 0.0254,
+	"IÙ": 0.0248, "RF": 0.0236, "DR": 0.0213, "NH": 0.0210, "PS": 0.0210,
+	"SN": 0.0206, "SF": 0.0205, "OH": 0.0205, "NQ": 0.0202, "VR": 0.0201,
+	"GS": 0.0199, "PD": 0.0197, "OZ": 0.0192, "RÀ": 0.0191, "ÒE": 0.0190,
+	"RZ": 0.0189, "ÈI": 0.0189, "UF": 0.0187, "ÈP": 0.0185, "TF": 0.0184,
+	"QA": 0.0182, "PG": 0.0179, "HT": 0.0173, "DN": 0.0173, "SM": 0.0170,
+	"MT": 0.0169, "GP": 0.0168, "DC": 0.0163, "GD": 0.0162, "TV": 0.0156,
+	"HR": 0.0148, "ÈA": 0.0148, "IÀ": 0.0146, "ÈC": 0.0145, "GT": 0.0145,
+	"TB": 0.0144, "MS": 0.0143, "TG": 0.0142, "PH": 0.0140, "AÈ": 0.0140,
+	"DL": 0.0139, "PC": 0.0138, "HÉ": 0.0137, "HD": 0.0137, "IH": 0.0136,
+	"LH": 0.0135, "IÈ": 0.0135, "DG": 0.0132, "SV": 0.0130, "RB": 0.0124,
+	"HS": 0.0124, "QI": 0.0123, "ÈV": 0.0123, "CS": 0.0122, "DF": 0.0122,
+	"DP": 0.0121, "MD": 0.0121, "CD": 0.0120, "MN": 0.0119, "GV": 0.0114,
+	"MR": 0.0114, "ÈN": 0.0111, "ÈR": 0.0110, "BS": 0.0109, "SD": 0.0109,
+	"SB": 0.0107, "ÈD": 0.0107, "GM": 0.0106, "SR": 0.0100, "UU": 0.0097,
+	"QO": 0.0095, "HM": 0.0093, "ÈT": 0.0093, "QE": 0.0092, "HC": 0.0092,
+	"PM": 0.0090, "FD": 0.0088, "NÉ": 0.0087, "CP": 0.0085, "HN": 0.0085,
+	"DB": 0.0084, "DM": 0.0081, "GC": 0.0081, "GB": 0.0080, "CM": 0.0080,
+	"LQ": 0.0076, "PN": 0.0075, "ÈL": 0.0075, "CN": 0.0075, "HH": 0.0075,
+	"CQ": 0.0072, "ZH": 0.0071, "ÀP": 0.0070, "ÀE": 0.0070, "MC": 0.0070,
+	"DV": 0.0070, "FN": 0.0070, "HP": 0.0070, "HL": 0.0068, "ÀS": 0.0068,
+	"ÀI": 0.0066, "MV": 0.0065, "ÀC": 0.0064, "ÈF": 0.0064, "QS": 0.0064,
+	"MÈ": 0.0063, "RH": 0.0062, "ÙD": 0.0062, "RQ": 0.0060, "SG": 0.0059,
+	"ÀN": 0.0058, "TÈ": 0.0056, "QT": 0.0055, "DH": 0.0053, "ÉQ": 0.0053,
+	"SÌ": 0.0052, "ÒA": 0.0049, "ÈG": 0.0049, "BD": 0.0049, "ML": 0.0048,
+	"BM": 0.0048, "ÈM": 0.0046, "ÙR": 0.0046, "FC": 0.0045, "UQ": 0.0042,
+	"ÀU": 0.0041, "FP": 0.0041, "GZ": 0.0040, "ÀA": 0.0039, "ZU": 0.0039,
+	"BC": 0.0039, "GF": 0.0039, "PF": 0.0038, "CÈ": 0.0038, "DÈ": 0.0038,
+}
+
+var quadgramsPT = map[string]float64{
+	"PARA": 0.4337, "MENT": 0.3021, "ENTE": 0.2418, "COMP": 0.2407, "AÇÃO": 0.2404,
+	"EIRO": 0.2365, "ARQU": 0.2318, "ADOS": 0.2242, "RQUI": 0.2235, "QUIV": 0.2105,
+	"UIVO": 0.2018, "DEFI": 0.2006, "FICH": 0.1982, "ICHE": 0.1952, "CHEI": 0.1949,
+	"HEIR": 0.1946, "FICA": 0.1886, "IFIC": 0.1824, "ODES": 0.1734, "PODE": 0.1729,
+	"ANDO": 0.1707, "CONT": 0.1705, "ESPE": 0.1670, "OCOM": 0.1619, "EFIN": 0.1547,
+	"ORMA": 0.1520, "ENTO": 0.1509, "NOME": 0.1499, "ESDE": 0.1479, "TADO": 0.1456,
+	"COMO": 0.1428, "FORM": 0.1396, "AMEN": 0.1392, "DECO": 0.1387, "OPAR": 0.1384,
+	"ÇÕES": 0.1363, "ICAD": 0.1360, "ACOT": 0.1338, "PACO": 0.1336, "ÍVEL": 0.1332,
+	"OMPA": 0.1311, "SCOM": 0.1289, "FINI": 0.1272, "SPEC": 0.1269, "LIZA": 0.1258,
+	"COTE": 0.1257, "MPAC": 0.1252, "POSS": 0.1248, "RADA": 0.1226, "TRAD": 0.1225,
+	"ECOM": 0.1174, "NTRA": 0.1163, "DADE": 0.1145, "DESE": 0.1099, "RADO": 0.1097,
+	"PORT": 0.1094, "OSDE": 0.1089, "PECI": 0.1087, "DESC": 0.1083, "CESS": 0.1082,
+	"ESTA": 0.1064, "ÇÃOD": 0.1063, "ESCO": 0.1053, "SÍVE": 0.1050, "OSSÍ": 0.1043,
+	"ÃODE": 0.1039, "SSÍV": 0.1037, "ACTA": 0.1033, "INHA": 0.1029, "ADOR": 0.1026,
+	"PACT": 0.1020, "ERRO": 0.1017, "CADO": 0.1007, "LINH": 0.1006, "VERS": 0.0987,
+	"COMA": 0.0978, "ENTR": 0.0976, "ADAS": 0.0974, "RODE": 0.0969, "ECIF": 0.0957,
+	"ESER": 0.0947, "CIFI": 0.0946, "CION": 0.0925, "ORES": 0.0901, "ÁLID": 0.0888,
+	"VÁLI": 0.0886, "VALO": 0.0886, "INTE": 0.0884, "ISTE": 0.0879, "ONTE": 0.0878,
+	"NTES": 0.0873, "ECON": 0.0845, "IONA": 0.0837, "NTER": 0.0833, "APAR": 0.0832,
+	"EPAR": 0.0801, "SPAR": 0.0800, "DIRE": 0.0791, "ALIZ": 0.0789, "ORTA": 0.0786,
+	"ALOR": 0.0785, "ACOM": 0.0785, "ONÃO": 0.0784, "CONF": 0.0777, "ESTE": 0.0772,
+	"ONTR": 0.0767, "ARAC": 0.0765, "CADA": 0.0765, "OMAN": 0.0764, "IVOS": 0.0763,
+	"INVÁ": 0.0757, "NVÁL": 0.0757, "EDEF": 0.0756, "LOCA": 0.0755, "ESSO": 0.0754,
+	"LIST": 0.0753, "LICA": 0.0751, "IROS": 0.0744, "ODEC": 0.0744, "PADR": 0.0738,
+	"IDAD": 0.0737, "DODE": 0.0733, "OARQ": 0.0732, "ÁRIO": 0.0720, "REDE": 0.0719,
+	"ERMI": 0.0717, "SQUE": 0.0716, "MAND": 0.0716, "AMAN": 0.0713, "UMEN": 0.0713,
+	"FALH": 0.0709, "ISTA": 0.0709, "ANTE": 0.0708, "DEVE": 0.0703, "NCIA": 0.0702,
+	"DADO": 0.0699, "TAMA": 0.0697, "STEM": 0.0692, "RESP": 0.0691, "DOPA": 0.0689,
+	"CORR": 0.0688, "ANHO": 0.0682, "TODE": 0.0679, "LIDO": 0.0673, "GURA": 0.0670,
+	"DOCO": 0.0669, "PROC": 0.0661, "ESCR": 0.0659, "OFIC": 0.0658, "ECID": 0.0657,
+	"MANH": 0.0656, "MERO": 0.0656, "OFOR": 0.0653, "PRES": 0.0652, "TEMA": 0.0652,
+	"OQUE": 0.0652, "USAD": 0.0652, "ARAO": 0.0651, "ADES": 0.0649, "ÃOFO": 0.0648,
+	"SIST": 0.0647, "ADRÃ": 0.0644, "DRÃO": 0.0644, "INFO": 0.0643, "PÇÃO": 0.0642,
+	"OMED": 0.0641, "STAD": 0.0639, "RMAT": 0.0639, "ORDE": 0.0637, "DERE": 0.0631,
+	"OMES": 0.0630, "MPOS": 0.0629, "OCON": 0.0626, "OPÇÃ": 0.0625, "SEGU": 0.0621,
+	"ODEM": 0.0621, "AÇÕE": 0.0619, "SCRI": 0.0617, "SCON": 0.0616, "AÍDA": 0.0614,
+	"DPKG": 0.0613, "SAÍD": 0.0612, "OEST": 0.0607, "QUAN": 0.0605, "IZAD": 0.0604,
+	"ALHO": 0.0602, "OPOD": 0.0601, "DEDE": 0.0601, "STRA": 0.0599, "MAIS": 0.0598,
+	"REMO": 0.0594, "UTIL": 0.0594, "ARAD": 0.0587, "NÃOF": 0.0586, "PERA": 0.0584,
+	"ASDE": 0.0580, "NÚME": 0.0578, "ÚMER": 0.0577, "INST": 0.0576, "UPOR": 0.0575,
+	"ADOP": 0.0575, "ENTA": 0.0574, "ORRE": 0.0573, "RESS": 0.0572, "MATO": 0.0572,
+	"TUAL": 0.0569, "ALIN": 0.0566, "SPON": 0.0564, "TODO": 0.0564, "ODOS": 0.0564,
+	"OTES": 0.0562, "IDOS": 0.0561, "OFOI": 0.0561, "NFOR": 0.0560, "PRIM": 0.0559,
+	"SUPO": 0.0559, "SADO": 0.0557, "IMPO": 0.0555, "ROCE": 0.0554, "OSCO": 0.0548,
+	"ONFI": 0.0546, "SDES": 0.0543, "ATIV": 0.0543, "OCES": 0.0541, "TIVA": 0.0538,
+	"LIDA": 0.0536, "SSÃO": 0.0533, "LMEN": 0.0530, "DOSE": 0.0529, "ZADO": 0.0529,
+	"ESPA": 0.0526, "PRED": 0.0526, "TÓRI": 0.0525, "OPOR": 0.0523, "DESA": 0.0522,
+	"OSPA": 0.0522, "ARAA": 0.0522, "ONOM": 0.0521, "EVER": 0.0521, "ALHA": 0.0519,
+	"ILIZ": 0.0519, "EQUE": 0.0518, "ERAD": 0.0516, "ICAÇ": 0.0513, "CAÇÃ": 0.0513,
+	"AVER": 0.0510, "LIMI": 0.0509, "TURA": 0.0509, "INID": 0.0506, "ENAS": 0.0505,
+	"RADE": 0.0503, "TIPO": 0.0502, "ALTE": 0.0502, "TAÇÃ": 0.0501, "OCAL": 0.0501,
+	"EDES": 0.0500, "TILI": 0.0499, "ESPO": 0.0497, "ARGU": 0.0495, "IÇÃO": 0.0494,
+	"ÕESD": 0.0493, "QUER": 0.0491, "TENT": 0.0490, "EARQ": 0.0487, "CRIA": 0.0484,
+	"EMOV": 0.0484, "IMIT": 0.0482, "STAL": 0.0482, "LTER": 0.0481, "ÓRIO": 0.0481,
+	"MITE": 0.0481, "PÇÕE": 0.0479, "NSTA": 0.0478, "CONH": 0.0478, "ICIO": 0.0478,
+	"OPÇÕ": 0.0478, "TERA": 0.0478, "ONHE": 0.0477, "NHEC": 0.0476, "CTAD": 0.0476,
+	"OSTR": 0.0474, "TICA": 0.0472, "INAL": 0.0471, "APEN": 0.0470, "NFIG": 0.0470,
+	"ADEC": 0.0468, "DEAR": 0.0468, "EFIC": 0.0467, "DOSP": 0.0467, "ESTÁ": 0.0466,
+	"RIOS": 0.0463, "NTOD": 0.0462, "PENA": 0.0461, "ALME": 0.0460, "IPOS": 0.0460,
+	"NÃOÉ": 0.0456, "ÃOCO": 0.0455, "GUME": 0.0455, "HECI": 0.0455, "RSÃO": 0.0453,
+	"DEPA": 0.0453, "IGUR": 0.0453, "TADA": 0.0452, "AMPO": 0.0452, "ADAP": 0.0450,
+	"FIGU": 0.0450, "ALIS": 0.0450, "ENÃO": 0.0449, "CARA": 0.0449, "DICI": 0.0446,
+	"IROD": 0.0446, "DAPA": 0.0446, "CIDO": 0.0444, "ERSÃ": 0.0443, "SDEC": 0.0442,
+	"TERE": 0.0441, "CAMP": 0.0440, "SPER": 0.0439, "EXEC": 0.0438, "NCON": 0.0438,
+	"TIVO": 0.0437, "ENDE": 0.0436, "EEST": 0.0434, "OMAS": 0.0434, "MODO": 0.0432,
+	"NECE": 0.0431, "MBOL": 0.0430, "ADOD": 0.0429, "TADE": 0.0429, "NENH": 0.0428,
+	"MOST": 0.0427, "ENHU": 0.0427, "AOPÇ": 0.0427, "MESM": 0.0427, "BYTE": 0.0425,
+	"RGUM": 0.0424, "ODEA": 0.0424, "DOSD": 0.0424, "XECU": 0.0424, "IREC": 0.0422,
+	"RIME": 0.0420, "NHUM": 0.0418, "USAR": 0.0417, "NTOS": 0.0417, "EUMA": 0.0415,
+	"ACON": 0.0415, "TEDE": 0.0414, "IADO": 0.0414, "ISTO": 0.0413, "ODEF": 0.0413,
+	"ERES": 0.0413, "NDOS": 0.0413, "NÃOP": 0.0412, "ENCO": 0.0412, "PLIC": 0.0411,
+	"OPAC": 0.0411, "OPER": 0.0410, "NALI": 0.0409, "CTER": 0.0409, "PART": 0.0408,
+	"MEDE": 0.0407, "GRUP": 0.0406, "AGEM": 0.0405, "CADE": 0.0405, "TERM": 0.0403,
+	"TERN": 0.0402, "TALA": 0.0402, "TESD": 0.0401, "QUEO": 0.0398, "ODED": 0.0397,
+	"EMDE": 0.0397, "POSI": 0.0396, "FOIP": 0.0396, "IVOD": 0.0395, "ÊNCI": 0.0394,
+	"OIPO": 0.0394, "BOLO": 0.0394, "RACT": 0.0393, "MPRE": 0.0393, "ASCO": 0.0391,
+	"OMPI": 0.0391, "ESMO": 0.0390, "ESTI": 0.0390, "DORE": 0.0389, "ODEP": 0.0389,
+	"RUPO": 0.0389, "RECT": 0.0389, "OSEM": 0.0388, "DEME": 0.0388, "DOSO": 0.0387,
+	"OINV": 0.0386, "MOVE": 0.0386, "SINA": 0.0385, "ODEE": 0.0384, "AVIS": 0.0383,
+	"OPRO": 0.0383, "ISPO": 0.0382, "DEST": 0.0382, "DEUM": 0.0382, "ÃOPO": 0.0381,
+	"SPOR": 0.0381, "ODEV": 0.0380, "ADOC": 0.0380, "AQUE": 0.0380, "NHOD": 0.0379,
+	"IZAÇ": 0.0379, "DECA": 0.0378, "EROD": 0.0378, "VODE": 0.0378, "ODEL": 0.0377,
+	"OVER": 0.0377, "RCOM": 0.0376, "ODET": 0.0375, "OBRE": 0.0373, "UAND": 0.0372,
+	"ESSA": 0.0371, "SOBR": 0.0371, "DEPE": 0.0371, "ÓRIA": 0.0371, "PERM": 0.0370,
+	"SNÃO": 0.0369, "ARAS": 0.0368, "RAÇÃ": 0.0368, "EXIS": 0.0368, "XIST": 0.0368,
+	"ICAR": 0.0368, "DONO": 0.0367, "NÃOE": 0.0367, "SDED": 0.0367, "MEMÓ": 0.0366,
+	"EMÓR": 0.0365, "MÓRI": 0.0365, "ESQU": 0.0364, "OESP": 0.0363, "RAMA": 0.0362,
+	"NIDO": 0.0362, "CALI": 0.0362, "RMIN": 0.0362, "VEIS": 0.0362, "MINA": 0.0361,
+	"VISO": 0.0361, "CONS": 0.0361, "VERI": 0.0361, "ÇÃOP": 0.0361, "RMAÇ": 0.0360,
+	"EPRO": 0.0360, "ECES": 0.0359, "NÃOS": 0.0359, "OTAM": 0.0359, "EFOR": 0.0358,
+	"BLIC": 0.0357, "PORE": 0.0356, "ARUM": 0.0354, "NHAS": 0.0353, "ADED": 0.0352,
+	"DESD": 0.0351, "ORTE": 0.0350, "MENO": 0.0350, "ADEI": 0.0349, "ESSÃ": 0.0348,
+	"NTRO": 0.0347, "DISP": 0.0347, "EMEN": 0.0347, "ONDE": 0.0347, "PEND": 0.0346,
+	"ESSE": 0.0345, "ECUT": 0.0345, "OGRA": 0.0342, "ODIF": 0.0342, "OMOD": 0.0342,
+	"LADO": 0.0341, "FONT": 0.0341, "NTEA": 0.0339, "ÃOPA": 0.0339, "IGNO": 0.0339,
+	"OSNO": 0.0338, "RQUE": 0.0338, "EMSE": 0.0338, "OSIS": 0.0338, "ACTE": 0.0336,
+	"OMEN": 0.0336, "DEEN": 0.0335, "GNOR": 0.0335, "DEPR": 0.0335, "CÇÃO": 0.0334,
+	"SERU": 0.0333, "CTAÇ": 0.0333, "RRES": 0.0332, "ADEF": 0.0330, "EREN": 0.0330,
+	"ANÃO": 0.0330, "VARI": 0.0330, "ÃOES": 0.0329, "OVAL": 0.0329, "RIOR": 0.0328,
+	"EMEM": 0.0328, "COMU": 0.0328, "NTED": 0.0327, "HAVE": 0.0327, "RUMA": 0.0326,
+	"YTES": 0.0324, "DENT": 0.0324, "ATOD": 0.0322, "DETE": 0.0322, "ODIR": 0.0322,
+	"UMAR": 0.0321, "MPRI": 0.0320, "REST": 0.0320, "ONAL": 0.0319, "FERE": 0.0319,
+	"DELI": 0.0319, "DEIN": 0.0319, "MPIL": 0.0318, "COME": 0.0318, "PÚBL": 0.0318,
+	"ÚBLI": 0.0317, "RESE": 0.0316, "ÁVEL": 0.0315, "ERSO": 0.0315, "ELIN": 0.0315,
+	"SODE": 0.0315, "ADAD": 0.0314, "APOR": 0.0314, "EPEN": 0.0314, "POND": 0.0314,
+	"IRET": 0.0314, "RTAD": 0.0313, "ADOE": 0.0313, "ERIF": 0.0313, "ANTI": 0.0313,
+	"OMPR": 0.0313, "ASTA": 0.0312, "OUTR": 0.0312, "CHAV": 0.0312, "REVE": 0.0312,
+	"OINT": 0.0311, "QUAL": 0.0311, "DASA": 0.0311, "NDER": 0.0311, "EPÚB": 0.0311,
+	"RIFI": 0.0311, "DOAR": 0.0311, "NORA": 0.0310, "ORNE": 0.0310, "SECÇ": 0.0310,
+	"DASE": 0.0308, "MPOR": 0.0308, "ODAS": 0.0307, "ECÇÃ": 0.0307, "DEES": 0.0307,
+	"PELO": 0.0307, "OSOS": 0.0306, "ASPA": 0.0305, "RACO": 0.0305, "INIÇ": 0.0305,
+	"CRIT": 0.0303, "EMAS": 0.0303, "SEJA": 0.0302, "ADIC": 0.0302, "FORN": 0.0302,
+	"READ": 0.0301, "TAMB": 0.0301, "GERA": 0.0300, "VELD": 0.0300, "EMPO": 0.0300,
+	"PILA": 0.0299, "SEPA": 0.0298, "STAT": 0.0298, "CIAL": 0.0298, "RECE": 0.0298,
+	"ENTI": 0.0298, "MPAR": 0.0297, "TROS": 0.0297, "ASAÍ": 0.0297, "ISSO": 0.0297,
+	"ADOA": 0.0296, "SÍMB": 0.0296, "AUMA": 0.0295, "ÍMBO": 0.0295, "NTEI": 0.0295,
+	"NDIC": 0.0294, "ESEN": 0.0294, "ARAE": 0.0294, "ÃOSE": 0.0293, "RNEC": 0.0293,
+	"CTUA": 0.0293, "OSER": 0.0293, "NÍVE": 0.0293, "RUSA": 0.0293, "EPAC": 0.0293,
+	"RPAR": 0.0292, "ZAÇÃ": 0.0292, "ACTU": 0.0292, "TEMP": 0.0292, "IADE": 0.0291,
+	"AODE": 0.0291, "INIR": 0.0291, "OSQU": 0.0291, "DOPO": 0.0291, "SDEP": 0.0291,
+	"IBMI": 0.0291, "BMIB": 0.0291, "REPÚ": 0.0291, "ENOS": 0.0290, "ORAD": 0.0290,
+	"DEBI": 0.0290, "AENT": 0.0290, "SERV": 0.0289, "EDAD": 0.0288, "IOMA": 0.0288,
+	"TEXT": 0.0288, "CTIV": 0.0288, "ILTR": 0.0287, "UANT": 0.0287, "OTEM": 0.0286,
+	"TIDO": 0.0286, "FILT": 0.0286, "MPLE": 0.0286, "RMIT": 0.0286, "ODOD": 0.0286,
+	"MSER": 0.0286, "SENT": 0.0285, "DIFI": 0.0285, "DACO": 0.0284, "ÁRIA": 0.0284,
+	"EENT": 0.0283, "ELDE": 0.0283, "VIDO": 0.0283, "LIGA": 0.0282, "OSSE": 0.0281,
+	"AUTO": 0.0281, "MITI": 0.0281, "ERUM": 0.0280, "SADA": 0.0280, "ONTA": 0.0279,
+	"ÚLTI": 0.0278, "TRIB": 0.0278, "RIAD": 0.0278, "DEMA": 0.0278, "ASOP": 0.0277,
+	"MIBM": 0.0277, "UALI": 0.0277, "DOSC": 0.0277, "LOCO": 0.0276, "ARTI": 0.0276,
+	"NTEN": 0.0275, "EMPL": 0.0275, "TANT": 0.0275, "ASSE": 0.0274, "DOSN": 0.0274,
+	"TODA": 0.0273, "ARAP": 0.0273, "ÇÃOE": 0.0273, "DORD": 0.0273, "LHAA": 0.0272,
+	"IODE": 0.0272, "ARAR": 0.0272, "EGUI": 0.0272, "INIC": 0.0271, "TAME": 0.0270,
+	"ASSI": 0.0270, "TESE": 0.0270, "ALTA": 0.0270, "ROSD": 0.0269, "LTRO": 0.0269,
+	"BLOC": 0.0269, "ARES": 0.0269, "RAND": 0.0269, "OPRE": 0.0268, "DOSA": 0.0268,
+	"CUTA": 0.0268, "NADO": 0.0268, "IDAS": 0.0267, "REPO": 0.0267, "ADON": 0.0267,
+	"ICIA": 0.0267, "ESAÍ": 0.0267, "STRI": 0.0266, "FORA": 0.0266, "NICI": 0.0265,
+	"EXEM": 0.0265, "MPLO": 0.0265, "ALDE": 0.0265, "RETÓ": 0.0265, "ETÓR": 0.0265,
+	"ENOM": 0.0265, "ÇÃON": 0.0265, "HAAO": 0.0265, "XEMP": 0.0264, "OSPO": 0.0264,
+	"TECT": 0.0264, "SCRE": 0.0263, "RIBU": 0.0263, "RIAR": 0.0263, "MARQ": 0.0262,
+	"ANTO": 0.0262, "LHOU": 0.0261, "DOSI": 0.0261, "EDPK": 0.0260, "BILI": 0.0259,
+	"OUSA": 0.0259, "CIDA": 0.0259, "QUEA": 0.0258, "OMPO": 0.0258, "RAES": 0.0257,
+	"URAN": 0.0257, "CABE": 0.0257, "EXTO": 0.0257, "DIOM": 0.0257, "PREC": 0.0256,
+	"EDEP": 0.0256, "NIDA": 0.0256, "ELOC": 0.0256, "ROGR": 0.0256, "NAME": 0.0256,
+	"HADE": 0.0255, "CREV": 0.0255, "SDEA": 0.0255, "DETA": 0.0254, "ATUA": 0.0254,
+	"TEPA": 0.0254, "ÃODO": 0.0254, "AEST": 0.0252, "SDEF": 0.0252, "IDIO": 0.0252,
+	"NÃOC": 0.0252, "ASAS": 0.0251, "TROL": 0.0251, "ÃORE": 0.0251, "SERE": 0.0250,
+	"IDOP": 0.0250, "LORE": 0.0250, "STAR": 0.0249, "OCAM": 0.0249, "NDOA": 0.0248,
+	"THRE": 0.0248, "CIAS": 0.0247, "URAÇ": 0.0247, "IENT": 0.0247, "HREA": 0.0247,
+	"NHAD": 0.0247, "TIDA": 0.0247, "QUEN": 0.0246, "IMPR": 0.0246, "ILHA": 0.0246,
+	"ÃOIN": 0.0246, "REQU": 0.0246, "APRO": 0.0245, "URAD": 0.0245, "TECO": 0.0245,
+	"MALI": 0.0245, "RIPT": 0.0245, "OPAD": 0.0244, "OSDO": 0.0244, "OAPT": 0.0244,
+	"OUMA": 0.0244, "FINE": 0.0244, "REGI": 0.0244, "SELE": 0.0244, "PROG": 0.0244,
+	"ERUS": 0.0244, "CRIP": 0.0243, "ERAR": 0.0243, "ECLA": 0.0242, "OSUP": 0.0242,
+	"DESI": 0.0242, "MADE": 0.0242, "ABEÇ": 0.0242, "BEÇA": 0.0242, "ASER": 0.0242,
+	"EPOS": 0.0241, "SPAC": 0.0241, "EMVE": 0.0241, "ADEP": 0.0240, "IPOD": 0.0240,
+	"EÇAL": 0.0240, "ÇALH": 0.0240, "LHAD": 0.0239, "REXE": 0.0239, "TRIO": 0.0239,
+	"AOCO": 0.0238, "OREX": 0.0238, "DEDA": 0.0237, "NCLU": 0.0237, "ADEA": 0.0237,
+	"SARQ": 0.0237, "RAIN": 0.0237, "OPRI": 0.0237, "EIRA": 0.0236, "MCOM": 0.0236,
+	"ESDO": 0.0236, "LZMA": 0.0236, "APAD": 0.0235, "UMAL": 0.0234, "REFE": 0.0234,
+	"DOAP": 0.0234, "TECA": 0.0234, "ÇÃOS": 0.0234, "APRE": 0.0234, "ENDO": 0.0234,
+	"LQUE": 0.0233, "ANDE": 0.0233, "ACAD": 0.0233, "MAÇÃ": 0.0232, "DEDP": 0.0232,
+	"RECI": 0.0231, "OEXI": 0.0231, "ESSÁ": 0.0231, "RPRE": 0.0231, "ERAL": 0.0231,
+	"ALGU": 0.0231, "NÁRI": 0.0230, "ARAU": 0.0230, "OBTE": 0.0230, "RESD": 0.0229,
+	"MUIT": 0.0229, "ACTI": 0.0229, "ROSE": 0.0228, "MFIC": 0.0228, "ICAS": 0.0228,
+	"SSÁR": 0.0228, "SÁRI": 0.0228, "HODE": 0.0228, "OSDA": 0.0228, "GIST": 0.0228,
+	"ITAD": 0.0228, "CTAR": 0.0228, "LINK": 0.0227, "EMCO": 0.0227, "GRAM": 0.0227,
+	"SEDE": 0.0227, "ERDE": 0.0227, "TRAR": 0.0227, "IMPL": 0.0227, "OMOS": 0.0226,
+	"AUSA": 0.0226, "INDI": 0.0226, "STAS": 0.0226, "OCOR": 0.0226, "DOEM": 0.0226,
+	"MODE": 0.0226, "OMUM": 0.0226, "ADAE": 0.0225, "ITEC": 0.0225, "ETAM": 0.0225,
+	"EXIB": 0.0225, "SEST": 0.0224, "ARAM": 0.0224, "RIOD": 0.0224, "OTEC": 0.0224,
+	"EFER": 0.0223, "SFIC": 0.0223, "NDOO": 0.0223, "APLI": 0.0223, "ATRI": 0.0221,
+	"DENO": 0.0221, "EREM": 0.0221, "ISTR": 0.0220, "QUES": 0.0220, "CIAD": 0.0220,
+	"NTIC": 0.0220, "ARCA": 0.0219, "NIÇÃ": 0.0219, "TOPO": 0.0219, "EGIS": 0.0219,
+	"SOPÇ": 0.0219, "ÃONÃ": 0.0218, "ARCO": 0.0218, "STIN": 0.0218, "ALQU": 0.0218,
+	"ETER": 0.0218, "ODEB": 0.0217, "EREÇ": 0.0217, "ÃOEX": 0.0217, "RENT": 0.0217,
+	"ODER": 0.0217, "LTIM": 0.0217, "SDEE": 0.0216, "SEMP": 0.0216, "RELA": 0.0216,
+	"ERAÇ": 0.0216, "ITOS": 0.0216, "OCUM": 0.0216, "OSES": 0.0216, "DOSS": 0.0216,
+	"DOCU": 0.0216, "USUÁ": 0.0216, "SUÁR": 0.0216, "UÁRI": 0.0216, "EXPR": 0.0215,
+	"TARO": 0.0215, "OSIN": 0.0215, "ONÚM": 0.0215, "ISDE": 0.0215, "STEN": 0.0215,
+	"ERNO": 0.0215, "EESP": 0.0215, "OSTA": 0.0215, "UITE": 0.0215, "IMEI": 0.0214,
+	"IVER": 0.0214, "ASEM": 0.0214, "AFOR": 0.0214, "ADAA": 0.0214, "MVEZ": 0.0214,
+	"OMAI": 0.0214, "OSFI": 0.0213, "CUME": 0.0213, "MESD": 0.0213, "MCON": 0.0213,
+	"VEZD": 0.0213, "FILE": 0.0213, "ECTU": 0.0213, "OREM": 0.0212, "VELA": 0.0212,
+	"ESIN": 0.0212, "EPOR": 0.0212, "NTEP": 0.0212, "ELEC": 0.0211, "GULA": 0.0211,
+	"SÃOD": 0.0211, "HUMA": 0.0211, "RROA": 0.0210, "BIAN": 0.0210, "DEIA": 0.0210,
+	"RECO": 0.0210, "ADAN": 0.0210, "BTER": 0.0210, "REÇO": 0.0210, "MPON": 0.0210,
+	"NOVA": 0.0210, "ESTR": 0.0210, "RESU": 0.0210, "UITO": 0.0209, "ORQU": 0.0209,
+	"RICO": 0.0209, "AESP": 0.0209, "ORIG": 0.0209, "MPOD": 0.0209, "SPOD": 0.0208,
+	"ESEM": 0.0208, "PAST": 0.0208, "EPRE": 0.0208, "REAL": 0.0207, "OSAR": 0.0207,
+	"EMUM": 0.0207, "RAME": 0.0207, "CTUR": 0.0207, "ONEN": 0.0207, "ERNA": 0.0207,
+	"VESE": 0.0207, "ANÇA": 0.0206, "AQUA": 0.0205, "AREM": 0.0205, "RAUM": 0.0205,
+	"ERCO": 0.0205, "RECU": 0.0204, "SPOS": 0.0204, "DIFE": 0.0204, "USOD": 0.0204,
+	"EITO": 0.0204, "IFER": 0.0204, "ICOS": 0.0204, "ALOC": 0.0204, "OSIT": 0.0203,
+	"FORE": 0.0203, "IZAR": 0.0203, "EBIA": 0.0203, "AZER": 0.0203, "QUEE": 0.0203,
+	"XPRE": 0.0202, "EUSA": 0.0202, "NTEE": 0.0202, "ÇÃOI": 0.0202, "ETRA": 0.0201,
+	"DAPO": 0.0201, "TEIR": 0.0201, "HAMA": 0.0201, "RASE": 0.0201, "ALAD": 0.0200,
+	"ECHA": 0.0200, "AINF": 0.0199, "AMAI": 0.0199, "DEDI": 0.0199, "SESP": 0.0199,
+	"UALQ": 0.0199, "AMIN": 0.0199, "TECL": 0.0199, "NADA": 0.0198, "TORN": 0.0198,
+	"EMPA": 0.0198, "ENHA": 0.0198, "UTRO": 0.0198, "ARAL": 0.0198, "ROIN": 0.0197,
+	"INAD": 0.0197, "ACHA": 0.0197, "INCL": 0.0197, "ONÍV": 0.0197, "ROAO": 0.0197,
+	"VELC": 0.0197, "ARRE": 0.0196, "MEIR": 0.0196, "NTID": 0.0196, "ECIS": 0.0196,
+	"QUIT": 0.0196, "IÁVE": 0.0195, "OQUA": 0.0195, "NDEN": 0.0195, "ROCO": 0.0195,
+	"ESES": 0.0195, "QUEP": 0.0195, "INAR": 0.0194, "UMER": 0.0194, "AMBÉ": 0.0194,
+	"MBÉM": 0.0194, "ERVA": 0.0194, "EGUR": 0.0194, "FAZE": 0.0193, "TIVE": 0.0193,
+	"DOFI": 0.0193, "RITO": 0.0193, "DEUS": 0.0193, "EVES": 0.0193, "ICAO": 0.0193,
+	"EQUI": 0.0192, "UMFI": 0.0192, "TOSD": 0.0192, "UTEN": 0.0191, "DEPO": 0.0191,
+	"APÓS": 0.0191, "TERI": 0.0191, "MEDO": 0.0191, "DASP": 0.0190, "CULA": 0.0190,
+	"SDOS": 0.0190, "RIDA": 0.0190, "SLIN": 0.0189, "FIXO": 0.0189, "OCAR": 0.0189,
+	"OÉUM": 0.0189, "ÇÃOA": 0.0189, "RROD": 0.0189, "DOPR": 0.0189, "ONVE": 0.0189,
+	"TRAN": 0.0188, "LEME": 0.0188, "ITID": 0.0188, "AIND": 0.0188, "FEIT": 0.0188,
+	"AOPO": 0.0188, "OMOU": 0.0187, "TARE": 0.0187, "IQUE": 0.0187, "SSIN": 0.0187,
+	"CHAM": 0.0187, "ODOA": 0.0187, "AINT": 0.0186, "ROPR": 0.0186, "NOVO": 0.0186,
+	"AARQ": 0.0186, "MAGE": 0.0186, "NASA": 0.0186, "ERIO": 0.0185, "AVAR": 0.0185,
+	"SUFI": 0.0185, "ARIÁ": 0.0185, "RIÁV": 0.0185, "OLOS": 0.0185, "ASLI": 0.0185,
+	"OSPR": 0.0185, "ONAD": 0.0184, "EXCE": 0.0184, "CLAS": 0.0184, "STAO": 0.0184,
+	"TRAS": 0.0184, "RAPA": 0.0184, "TOCO": 0.0184, "RARE": 0.0183, "DALI": 0.0183,
+	"SSOS": 0.0183, "QUET": 0.0182, "ADAC": 0.0182, "AMAD": 0.0181, "UMAS": 0.0181,
+	"TICO": 0.0181, "IANO": 0.0181, "DOQU": 0.0181, "ETOD": 0.0181, "AROS": 0.0181,
+	"SIGN": 0.0181, "ADEE": 0.0181, "ULAR": 0.0180, "MAIO": 0.0180, "IMAG": 0.0180,
+	"UMPA": 0.0179, "DEMS": 0.0179, "IDOD": 0.0179, "CENT": 0.0179, "SNOM": 0.0179,
+	"SDEV": 0.0179, "TEND": 0.0179, "INTA": 0.0179, "SPAÇ": 0.0179, "ADET": 0.0179,
+	"DEFO": 0.0179, "TARA": 0.0179, "INDO": 0.0179, "SCAR": 0.0179, "URAO": 0.0179,
+	"DICA": 0.0178, "FALT": 0.0178, "NCIO": 0.0178, "SULT": 0.0178, "ASEC": 0.0178,
+	"OSRE": 0.0177, "RDES": 0.0177, "SDAD": 0.0177, "IDOA": 0.0177, "GEMD": 0.0177,
+	"ENSA": 0.0177, "SINT": 0.0177, "DERA": 0.0177, "OLOC": 0.0177, "TEMD": 0.0176,
+	"VAZI": 0.0176, "NATI": 0.0176, "SAGE": 0.0176, "NTEC": 0.0176, "ESCA": 0.0176,
+	"AIOR": 0.0176, "PAÇO": 0.0175, "AUTE": 0.0175, "OUTI": 0.0175, "FLUX": 0.0175,
+	"LUXO": 0.0175, "NTÉM": 0.0175, "NENT": 0.0175, "SPRO": 0.0174, "MONT": 0.0174,
+	"UMAC": 0.0174, "UMAV": 0.0174, "ECOR": 0.0173, "NECI": 0.0173, "TEDO": 0.0173,
+	"NTAD": 0.0173, "QUIN": 0.0173, "ERSE": 0.0173, "EITA": 0.0172, "ODEU": 0.0172,
+	"ODEN": 0.0172, "ODOP": 0.0172, "ESVI": 0.0171, "RDAD": 0.0171, "EXTR": 0.0171,
+	"ATRA": 0.0171, "OLIM": 0.0171, "MENS": 0.0171, "ÇÃOC": 0.0171, "DATA": 0.0171,
+	"MDES": 0.0171, "SUBS": 0.0171, "IOTE": 0.0171, "ECTR": 0.0171, "BASE": 0.0170,
+	"UINA": 0.0170, "SÕES": 0.0170, "VELE": 0.0170, "ARAI": 0.0170, "OSCA": 0.0170,
+	"GINA": 0.0169, "DIGO": 0.0169, "ECUR": 0.0168, "ARPA": 0.0168, "ASEN": 0.0168,
+	"ARTE": 0.0168, "OTIP": 0.0168, "EQUA": 0.0168, "MNOM": 0.0168, "ENCI": 0.0168,
+	"LEMA": 0.0168, "EROS": 0.0168, "PALA": 0.0167, "ALAV": 0.0167, "IVAD": 0.0167,
+	"SDET": 0.0167, "NTAR": 0.0167, "ROPA": 0.0167, "VOCÊ": 0.0167, "SALT": 0.0166,
+	"HODO": 0.0166, "EPOD": 0.0166, "ABEL": 0.0166, "RAÇÕ": 0.0166, "EMAI": 0.0166,
+	"LAÇÃ": 0.0166, "RCON": 0.0166, "AISD": 0.0166, "TABE": 0.0166, "NORM": 0.0165,
+	"SIMB": 0.0165, "HELL": 0.0165, "ASIN": 0.0165, "SHEL": 0.0165, "TINO": 0.0165,
+	"ORPA": 0.0164, "EMAD": 0.0164, "UÇÃO": 0.0164, "LCOM": 0.0164, "ONTÉ": 0.0164,
+	"TIFI": 0.0164, "SFOR": 0.0164, "CAME": 0.0164, "RCAD": 0.0163, "EMBR": 0.0163,
+	"SCOL": 0.0163, "CLUI": 0.0163, "ÉNEC": 0.0163, "TITU": 0.0163, "ODPK": 0.0163,
+	"NSAG": 0.0163, "QUEC": 0.0163, "HADO": 0.0163, "AMES": 0.0163, "ERVI": 0.0163,
+	"TAND": 0.0163, "RELO": 0.0163, "FINA": 0.0162, "ULTA": 0.0162, "EINS": 0.0162,
+	"RIAA": 0.0162, "RIAS": 0.0162, "LAVR": 0.0162, "SLOC": 0.0162, "PONE": 0.0162,
+	"CTRI": 0.0162, "DESV": 0.0161, "NTRE": 0.0161, "SPRE": 0.0161, "AVRA": 0.0161,
+	"ODEI": 0.0161, "ANAL": 0.0161, "CODE": 0.0161, "MAOP": 0.0161, "IMEN": 0.0161,
+	"SIMP": 0.0161, "PONT": 0.0161, "MUMA": 0.0161, "ERDA": 0.0161, "LITA": 0.0161,
+	"POST": 0.0160, "TAIN": 0.0160, "ROOT": 0.0160, "LORD": 0.0160, "SSOA": 0.0160,
+	"DOUM": 0.0160, "ORNA": 0.0160, "IDOE": 0.0160, "OMON": 0.0159, "DOMA": 0.0159,
+	"META": 0.0159, "MAIN": 0.0159, "EDEC": 0.0159, "DOIN": 0.0159, "ADOO": 0.0159,
+	"ELAT": 0.0159, "ISCO": 0.0159, "NVER": 0.0158, "ERTI": 0.0158, "ÚNIC": 0.0158,
+	"ELES": 0.0158, "SAND": 0.0158, "TESP": 0.0158, "CASO": 0.0158, "RESC": 0.0157,
+	"OUSE": 0.0157, "SSIM": 0.0157, "MADO": 0.0157, "TION": 0.0157, "AMBI": 0.0157,
+	"PORF": 0.0157, "DANO": 0.0157, "ÃOSU": 0.0157, "RAOP": 0.0157, "ETAL": 0.0157,
+	"TOTA": 0.0157, "RMAL": 0.0156, "NTEO": 0.0156, "NICO": 0.0156, "PASS": 0.0156,
+	"USER": 0.0156, "DEIR": 0.0156, "CODI": 0.0156, "MQUE": 0.0156, "USAN": 0.0155,
+	"PROP": 0.0155, "IBLI": 0.0155, "EREC": 0.0155, "DODO": 0.0155, "NTIG": 0.0155,
+	"NTOP": 0.0155, "LHOS": 0.0155, "TOSE": 0.0155, "LODE": 0.0154, "PORP": 0.0154,
+	"ESIS": 0.0154, "DOES": 0.0154, "ESEG": 0.0154, "ORUM": 0.0154, "ECAD": 0.0154,
+	"OSEN": 0.0154, "CURS": 0.0153, "CISA": 0.0153, "ARDE": 0.0153, "BELA": 0.0153,
+	"ÃOÉU": 0.0153, "BIBL": 0.0153, "BLIO": 0.0153, "LIOT": 0.0153, "ETAD": 0.0153,
+	"ASPO": 0.0152, "RARA": 0.0152, "NAIS": 0.0152, "ROSP": 0.0152, "GUIN": 0.0152,
+	"MEST": 0.0152, "LADE": 0.0152, "MULT": 0.0152, "UALM": 0.0152, "TEQU": 0.0152,
+	"ECAR": 0.0152, "IGAÇ": 0.0152, "MADA": 0.0152, "ARAN": 0.0151, "RARO": 0.0151,
+	"CAMI": 0.0151, "MINH": 0.0151, "INHO": 0.0151, "EFON": 0.0151, "NDOP": 0.0151,
+	"QUEU": 0.0151, "LTIP": 0.0151, "RAVA": 0.0151, "IROP": 0.0151, "ABRI": 0.0151,
+	"MODI": 0.0151, "UBST": 0.0151, "STIT": 0.0151, "BAIX": 0.0151, "ORAR": 0.0150,
+	"EMPR": 0.0150, "TAOP": 0.0150, "DIDO": 0.0150, "DETR": 0.0150, "FAVO": 0.0150,
+	"AVOR": 0.0150, "STRU": 0.0150, "ÃOÉP": 0.0149, "POIS": 0.0149, "EDIR": 0.0149,
+	"ILIT": 0.0149, "ASSO": 0.0149, "MELH": 0.0149, "ARMA": 0.0149, "ETOR": 0.0149,
+	"RREG": 0.0149, "NTEM": 0.0149, "ITAR": 0.0149, "QUIS": 0.0149, "OGRU": 0.0149,
+	"OPEL": 0.0149, "ODEO": 0.0149, "NICA": 0.0148, "ACHE": 0.0148, "IDON": 0.0148,
+	"OREG": 0.0147, "EDEU": 0.0147, "SERA": 0.0147, "MAVE": 0.0147, "BREP": 0.0147,
+	"NODE": 0.0147, "ORFA": 0.0147, "SDEU": 0.0147, "ONAR": 0.0147, "DOPE": 0.0147,
+	"ADEM": 0.0147, "ERRA": 0.0147, "ASES": 0.0146, "MBIE": 0.0146, "BIEN": 0.0146,
+	"ACOR": 0.0146, "OINC": 0.0146, "MERR": 0.0146, "CÓDI": 0.0146, "PRIO": 0.0146,
+	"EENC": 0.0146, "CEDE": 0.0145, "EZDE": 0.0145, "ÓDIG": 0.0145, "ECTA": 0.0145,
+	"ASVE": 0.0145, "ABIL": 0.0145, "TIPL": 0.0145, "RONO": 0.0145, "ONTO": 0.0145,
+	"STAM": 0.0144, "XIMO": 0.0144, "ESAC": 0.0144, "ÓLIC": 0.0144, "UMNO": 0.0144,
+	"DEMO": 0.0144, "ACES": 0.0144, "TEFO": 0.0144, "SSEG": 0.0144, "DÊNC": 0.0144,
+	"INES": 0.0144, "ENVI": 0.0144, "RARQ": 0.0144, "DEEX": 0.0143, "OSEU": 0.0143,
+	"BRIR": 0.0143, "EFIL": 0.0143, "DELO": 0.0143, "RFIC": 0.0143, "AAUT": 0.0143,
+	"CARR": 0.0143, "NOFI": 0.0143, "BSTI": 0.0143, "OTAL": 0.0143, "RÊNC": 0.0142,
+	"MOVI": 0.0142, "RANS": 0.0141, "ONSI": 0.0141, "SACT": 0.0141, "SUSA": 0.0141,
+	"DEQU": 0.0141, "ICAM": 0.0141, "MAÇÕ": 0.0141, "MÁXI": 0.0141, "ÁXIM": 0.0141,
+	"IMBÓ": 0.0141, "MBÓL": 0.0141, "BÓLI": 0.0141, "DAME": 0.0141, "MLIM": 0.0141,
+	"ÍDAP": 0.0140, "OVID": 0.0140, "SANT": 0.0140, "ÍVEI": 0.0140, "BLEM": 0.0140,
+	"ÕESS": 0.0140, "VERT": 0.0140, "CEIT": 0.0140, "RFAV": 0.0140, "ÃODA": 0.0140,
+	"EDED": 0.0140, "ESMA": 0.0140, "MARC": 0.0139, "ERPR": 0.0139, "STES": 0.0139,
+	"TASE": 0.0139, "UINT": 0.0139, "SERÁ": 0.0139, "ULTI": 0.0139, "DAEM": 0.0139,
+	"OIMP": 0.0139, "MACO": 0.0139, "SENH": 0.0139, "IRAS": 0.0139, "MASE": 0.0138,
+	"MITA": 0.0138, "SEMA": 0.0138, "IORI": 0.0138, "OPOS": 0.0138, "FUNÇ": 0.0138,
+	"RING": 0.0138, "OMPL": 0.0138, "UAIS": 0.0138, "REIN": 0.0138, "ALVO": 0.0138,
+	"OENT": 0.0138, "RMAO": 0.0138, "ATUR": 0.0137, "STAN": 0.0137, "ESEJ": 0.0137,
+	"RAOS": 0.0137, "MOQU": 0.0137, "TEME": 0.0136, "IBUT": 0.0136, "SOCO": 0.0136,
+	"ALID": 0.0136, "JUNT": 0.0136, "NHAM": 0.0136, "RIMI": 0.0136, "ULAS": 0.0136,
+	"ECIA": 0.0136, "NÃOA": 0.0136, "IÇÕE": 0.0136, "SERC": 0.0136, "VERD": 0.0136,
+	"TOMA": 0.0136, "NDÊN": 0.0135, "RVAL": 0.0135, "UEES": 0.0135, "OBJE": 0.0135,
+	"TILH": 0.0135, "INIO": 0.0135, "OMEA": 0.0135, "OANT": 0.0135, "ÉPOS": 0.0135,
+	"OREC": 0.0135, "IROC": 0.0134, "UECO": 0.0134, "TIMO": 0.0134, "APAS": 0.0134,
+	"IONÁ": 0.0134, "ROBL": 0.0134, "GADO": 0.0134, "ECIO": 0.0134, "OFIL": 0.0134,
+	"UFIX": 0.0133, "RÁRI": 0.0133, "NDOD": 0.0133, "TIRD": 0.0133, "TOPA": 0.0133,
+	"ROLE": 0.0133, "EMLI": 0.0133, "LPAR": 0.0133, "ZERO": 0.0133, "ÇÃOF": 0.0133,
+	"RDEF": 0.0132, "ARAT": 0.0132, "NÇÃO": 0.0132, "PREF": 0.0132, "EMAN": 0.0132,
+	"ECER": 0.0132, "CACH": 0.0132, "UMAP": 0.0132, "MASI": 0.0132, "XIBE": 0.0132,
+	"SVIO": 0.0131, "RANC": 0.0131, "MVAL": 0.0131, "ILAO": 0.0131, "ACEI": 0.0131,
+	"ORAM": 0.0131, "PROB": 0.0131, "ICAT": 0.0131, "TEST": 0.0131, "TREA": 0.0131,
+	"UMCO": 0.0131, "TRIN": 0.0131, "ÇÃOÉ": 0.0130, "NALD": 0.0130, "ESSI": 0.0130,
+	"DUZI": 0.0130, "RACA": 0.0130, "NDOC": 0.0130, "NÃOT": 0.0130, "RAQU": 0.0130,
+	"DOSU": 0.0130, "OSSU": 0.0130, "ORDO": 0.0130, "INDA": 0.0130, "ECAM": 0.0130,
+	"ESUL": 0.0130, "AEXP": 0.0129, "UTOM": 0.0129, "MPAT": 0.0129, "ISTI": 0.0129,
+	"NIFI": 0.0129, "SION": 0.0129, "JUDA": 0.0129, "ERÊN": 0.0129, "ÕESE": 0.0129,
+	"RTIR": 0.0129, "TROD": 0.0129, "VELO": 0.0129, "IAPA": 0.0129, "FUNC": 0.0129,
+	"MOUM": 0.0129, "OBLE": 0.0129, "UNDO": 0.0129, "ESTO": 0.0129, "AGEN": 0.0128,
+	"ADOU": 0.0128, "OMOO": 0.0128, "AOPR": 0.0128, "NDOE": 0.0128, "MASN": 0.0128,
+	"ESNO": 0.0128, "ERSI": 0.0128, "EBYT": 0.0128, "AREC": 0.0128, "FERÊ": 0.0128,
+	"RETO": 0.0128, "VEJA": 0.0128, "LONG": 0.0128, "MASA": 0.0128, "RAAS": 0.0128,
+	"ONÁR": 0.0128, "RNAT": 0.0128, "PLET": 0.0127, "SEUS": 0.0127, "ÃOTE": 0.0127,
+	"IADA": 0.0127, "IVOP": 0.0127, "ODOF": 0.0127, "OUDE": 0.0127, "DEAL": 0.0126,
+	"ERTE": 0.0126, "MESP": 0.0126, "REGA": 0.0126, "ICOM": 0.0126, "EGUN": 0.0126,
+	"GUND": 0.0126, "OPES": 0.0126, "INKS": 0.0126, "SDEM": 0.0126, "AINV": 0.0126,
+	"RTEA": 0.0126, "XTRA": 0.0126, "ALAR": 0.0126, "MFOR": 0.0126, "BUTO": 0.0126,
+	"STRE": 0.0126, "OTEN": 0.0126, "ELAD": 0.0126, "RETA": 0.0125, "ROFI": 0.0125,
+	"ADOI": 0.0125, "ORPR": 0.0125, "IVOE": 0.0125, "VALI": 0.0125, "INAT": 0.0125,
+	"IORE": 0.0125, "GRAN": 0.0125, "ÇÃOO": 0.0125, "NOSE": 0.0125, "TALH": 0.0125,
+	"UNCI": 0.0125, "SMOQ": 0.0125, "TATU": 0.0125, "NTEX": 0.0124, "DIST": 0.0124,
+	"DODI": 0.0124, "RITA": 0.0124, "TESA": 0.0124, "OSNÃ": 0.0124, "INCO": 0.0124,
+	"NOTE": 0.0124, "UMAO": 0.0124, "ASQU": 0.0124, "EINT": 0.0124, "ITOR": 0.0124,
+	"UNTO": 0.0124, "OSPE": 0.0124, "SVAL": 0.0124, "ESDA": 0.0124, "SIÇÃ": 0.0124,
+	"COLU": 0.0124, "NÃOD": 0.0123, "EXTE": 0.0123, "UTAR": 0.0123, "CONV": 0.0123,
+	"ECTÓ": 0.0123, "CTÓR": 0.0123, "RADU": 0.0123, "ATUS": 0.0123, "DOIS": 0.0123,
+	"NESP": 0.0123, "RALI": 0.0123, "AINS": 0.0123, "LICO": 0.0123, "PORU": 0.0123,
+	"TENO": 0.0123, "MDEC": 0.0123, "DOCA": 0.0123, "INEO": 0.0123, "UEUM": 0.0123,
+	"RROS": 0.0122, "VORE": 0.0122, "SSER": 0.0122, "ECTO": 0.0122, "RANT": 0.0122,
+	"CAPA": 0.0122, "OSIÇ": 0.0122, "RANÇ": 0.0121, "COMS": 0.0121, "OÉPO": 0.0121,
+	"SECO": 0.0121, "STEC": 0.0121, "ADEV": 0.0121, "OLUN": 0.0121, "LUNA": 0.0121,
+	"ITIV": 0.0121, "EDIS": 0.0121, "SEME": 0.0121, "UMCA": 0.0121, "ERID": 0.0121,
+	"EMIT": 0.0121, "TESS": 0.0121, "AJUD": 0.0120, "TEES": 0.0120, "ANOM": 0.0120,
+	"AISA": 0.0120, "ÁQUI": 0.0120, "CERT": 0.0120, "LGUM": 0.0120, "DNCI": 0.0120,
+	"DEDO": 0.0120, "ÁVEI": 0.0120, "HORA": 0.0120, "VAME": 0.0120, "RTAM": 0.0120,
+	"SSEM": 0.0119, "ELIM": 0.0119, "AMAS": 0.0119, "RABA": 0.0119, "UTOS": 0.0119,
+	"UNCA": 0.0119, "ORCO": 0.0119, "STOD": 0.0119, "EVAL": 0.0119, "EADO": 0.0119,
+	"ITOP": 0.0119, "RODU": 0.0119, "FOIE": 0.0119, "RAOA": 0.0119, "OAUT": 0.0119,
+	"OCOS": 0.0119, "SQUA": 0.0118, "FICO": 0.0118, "OTER": 0.0118, "SARO": 0.0118,
+	"STÃO": 0.0118, "LATI": 0.0118, "SITI": 0.0118, "TERV": 0.0118, "ASNO": 0.0118,
+	"ÉUMA": 0.0118, "SERI": 0.0118, "DONÃ": 0.0118, "IGNI": 0.0118, "EDEM": 0.0118,
+	"ADAM": 0.0117, "NDON": 0.0117, "SEFO": 0.0117, "OCUR": 0.0117, "MARG": 0.0117,
+	"IBIL": 0.0117, "VADO": 0.0117, "ADEB": 0.0117, "IDOC": 0.0117, "GNIF": 0.0117,
+	"EÇÃO": 0.0117, "EUSO": 0.0117, "OOPÇ": 0.0116, "DELE": 0.0116, "EINF": 0.0116,
+	"HADA": 0.0116, "NSTR": 0.0116, "ELHO": 0.0116, "NOSQ": 0.0116, "TESN": 0.0115,
+	"VOSE": 0.0115, "IROE": 0.0115, "VELM": 0.0115, "SERD": 0.0115, "ÍDAD": 0.0115,
+	"EIAD": 0.0115, "ANOS": 0.0115, "EADS": 0.0115, "LENT": 0.0115, "ASSA": 0.0115,
+	"ARSE": 0.0115, "IDOR": 0.0115, "CIMA": 0.0115, "RDEM": 0.0115, "ÕESP": 0.0114,
+	"MPRO": 0.0114, "SSAD": 0.0114, "UMAE": 0.0114, "WARE": 0.0114, "PONÍ": 0.0114,
+	"VELP": 0.0114, "RIAP": 0.0114, "UTRA": 0.0114, "DEAM": 0.0114, "EMES": 0.0114,
+	"DOUS": 0.0114, "DESO": 0.0114, "NEST": 0.0114, "TRAT": 0.0114, "MÁQU": 0.0114,
+	"RFOR": 0.0114, "TOIN": 0.0113, "SÃOE": 0.0113, "ERNE": 0.0113, "MATA": 0.0113,
+	"OLVE": 0.0113, "PARE": 0.0113, "OSEA": 0.0113, "GREP": 0.0113, "NDNC": 0.0113,
+	"ERED": 0.0113, "BUIL": 0.0113, "ISAR": 0.0113, "TIGO": 0.0113, "ÃOEM": 0.0113,
+	"AFIC": 0.0112, "RTAR": 0.0112, "ISAD": 0.0112, "ICOD": 0.0112, "MAPA": 0.0112,
+	"INDE": 0.0112, "DAES": 0.0112, "ERIN": 0.0112, "EESC": 0.0112, "NOTA": 0.0112,
+	"UESE": 0.0112, "POSD": 0.0112, "LOCK": 0.0112, "SDOA": 0.0112, "QUEM": 0.0112,
+	"ESLO": 0.0112, "CATI": 0.0112, "SÃOP": 0.0111, "EXCL": 0.0111, "OCRI": 0.0111,
+	"STIV": 0.0111, "ITUI": 0.0111, "RIGI": 0.0111, "MANT": 0.0111, "OSEG": 0.0111,
+	"OSÃO": 0.0111, "RNÃO": 0.0111, "SSOC": 0.0111, "LHOR": 0.0111, "NDID": 0.0110,
+	"ITED": 0.0110, "ARRA": 0.0110, "OFAL": 0.0110, "GAÇÃ": 0.0110, "UTAD": 0.0110,
+	"AZEN": 0.0110, "UNÇÃ": 0.0110, "EDEV": 0.0110, "EDEA": 0.0110, "INCI": 0.0110,
+	"URAR": 0.0110, "RAOD": 0.0110, "RADI": 0.0110, "ENOR": 0.0110, "ARAF": 0.0110,
+	"PELA": 0.0110, "RTAN": 0.0110, "ERRE": 0.0109, "ELCO": 0.0109, "ADEN": 0.0109,
+	"EDID": 0.0109, "EDER": 0.0109, "ORIN": 0.0109, "DASD": 0.0109, "IVAS": 0.0109,
+	"CITA": 0.0109, "ONOR": 0.0109, "VELL": 0.0108, "SOSE": 0.0108, "LTAD": 0.0108,
+	"LHAS": 0.0108, "VOSD": 0.0108, "TENS": 0.0108, "DOUT": 0.0108, "OSOU": 0.0108,
+	"VEZE": 0.0108, "OREL": 0.0108, "OMOA": 0.0108, "EALI": 0.0108, "TILS": 0.0108,
+	"INRI": 0.0108, "UMLI": 0.0107, "GENS": 0.0107, "ETRO": 0.0107, "UILD": 0.0107,
+	"SUCE": 0.0107, "SEMS": 0.0107, "ODOC": 0.0107, "XCLU": 0.0107, "MOCO": 0.0107,
+	"RIOA": 0.0107, "IAAU": 0.0107, "VOSC": 0.0107, "ASPR": 0.0107, "ASSU": 0.0107,
+	"SSAR": 0.0107, "TRAA": 0.0107, "NVIA": 0.0107, "DOAO": 0.0107, "EMTE": 0.0107,
+	"VÁRI": 0.0107, "ECTI": 0.0107, "CONE": 0.0107, "UNID": 0.0107, "BINR": 0.0107,
+	"SCUL": 0.0106, "OSIM": 0.0106, "RDEN": 0.0106, "SDEB": 0.0106, "DEOP": 0.0106,
+	"LECI": 0.0106, "OSEP": 0.0105, "REGU": 0.0105, "EACO": 0.0105, "NTAX": 0.0105,
+	"OUCO": 0.0105, "OMÁX": 0.0105, "ASED": 0.0105, "ALEM": 0.0105, "RPRO": 0.0105,
+	"ARDA": 0.0105, "DAEN": 0.0105, "AALT": 0.0105, "UENO": 0.0105, "NATU": 0.0105,
+	"IASD": 0.0105, "NRIO": 0.0105, "ORÁR": 0.0105, "ONTI": 0.0105, "SOPE": 0.0105,
+	"MASO": 0.0105, "ITAS": 0.0105, "AUTI": 0.0105, "DASC": 0.0105, "ASAL": 0.0104,
+	"OEXE": 0.0104, "OUSO": 0.0104, "EVOC": 0.0104, "EMQU": 0.0104, "RENC": 0.0104,
+	"ZENA": 0.0104, "TEPO": 0.0104, "SAME": 0.0104, "UMAI": 0.0104, "RIGE": 0.0104,
+	"SÃOA": 0.0104, "SERR": 0.0104, "QUEI": 0.0104, "RATA": 0.0104, "PLEM": 0.0104,
+	"UMDI": 0.0104, "SEES": 0.0104, "OREA": 0.0104, "ESÍM": 0.0104, "RIOP": 0.0104,
+	"IRON": 0.0103, "NCOM": 0.0103, "OSOB": 0.0103, "LISA": 0.0103, "URSO": 0.0103,
+	"EUTI": 0.0103, "ICAN": 0.0103, "AIXO": 0.0103, "TERP": 0.0103, "EALT": 0.0103,
+	"ARNO": 0.0103, "SREC": 0.0103, "ERCA": 0.0103, "ARIA": 0.0103, "TAGE": 0.0103,
+	"GUNS": 0.0103, "RMAZ": 0.0103, "ETHR": 0.0103, "ROCU": 0.0102, "RROI": 0.0102,
+	"LEIT": 0.0102, "RESO": 0.0102, "ALCO": 0.0102, "EROO": 0.0102, "MAZE": 0.0102,
+	"ENDN": 0.0102, "SVER": 0.0102, "IDEN": 0.0102, "TAXE": 0.0102, "NHOS": 0.0102,
+	"ÕESA": 0.0102, "UEOS": 0.0102, "DEBU": 0.0102, "DARE": 0.0102, "ÍNDI": 0.0102,
+	"AMAR": 0.0102, "ERAO": 0.0102, "BITS": 0.0102, "ASDO": 0.0102, "AISS": 0.0102,
+	"NÃOI": 0.0101, "XTEN": 0.0101, "IVOA": 0.0101, "OMOE": 0.0101, "APRI": 0.0101,
+	"EVEM": 0.0101, "ETEM": 0.0101, "BAND": 0.0101, "PACK": 0.0101, "RAPE": 0.0101,
+	"LGUN": 0.0101, "OSEO": 0.0101, "TEAO": 0.0101, "OTEF": 0.0101, "EGUL": 0.0100,
+	"ASNÃ": 0.0100, "SEQU": 0.0100, "DADA": 0.0100, "OARG": 0.0100, "AROU": 0.0100,
+	"DONA": 0.0100, "ELEM": 0.0100, "ERÃO": 0.0100, "RTIL": 0.0100, "IGEM": 0.0100,
+	"NASE": 0.0100, "REME": 0.0100, "ITES": 0.0100, "SIDE": 0.0100, "LDES": 0.0100,
+	"CIAI": 0.0100, "EITU": 0.0100, "RGUL": 0.0100, "DASS": 0.0100, "DOOU": 0.0100,
+	"ASEG": 0.0100, "DECI": 0.0100, "ERPA": 0.0099, "OCAD": 0.0099, "VERO": 0.0099,
+	"TENÃ": 0.0099, "TRAB": 0.0099, "IOSD": 0.0099, "ITUR": 0.0099, "STIC": 0.0099,
+	"SESS": 0.0099, "RAVE": 0.0099, "CHEC": 0.0099, "RIDO": 0.0099, "MEDI": 0.0099,
+	"EZES": 0.0099, "TACO": 0.0099, "NTEG": 0.0099, "RVID": 0.0099, "QUED": 0.0099,
+	"ROMP": 0.0099, "SMAI": 0.0099, "SENO": 0.0099, "SDEL": 0.0099, "ÇÃOM": 0.0099,
+	"SPEL": 0.0099, "IOSE": 0.0099, "ADAT": 0.0098, "MOSE": 0.0098, "MEMB": 0.0098,
+	"ROCA": 0.0098, "OALT": 0.0098, "EAME": 0.0098, "SENE": 0.0098, "RRON": 0.0098,
+	"MISS": 0.0098, "DESÍ": 0.0098, "RIVE": 0.0098, "SSES": 0.0098, "ODON": 0.0097,
+	"CURA": 0.0097, "LETA": 0.0097, "NTEÚ": 0.0097, "IDAP": 0.0097, "ROUT": 0.0097,
+	"ANCO": 0.0097, "VOLV": 0.0097, "TÁVE": 0.0097, "NTEU": 0.0097, "TUSD": 0.0097,
+	"AMBM": 0.0097, "OSTE": 0.0097, "NÃOH": 0.0097, "RTAS": 0.0097, "TESC": 0.0097,
+	"NTOE": 0.0097, "NOFO": 0.0097, "TRAI": 0.0096, "ENDI": 0.0096, "ATAM": 0.0096,
+	"SEMC": 0.0096, "UCES": 0.0096, "SNOS": 0.0096, "ROTE": 0.0096, "OLON": 0.0096,
+	"HAME": 0.0096, "COLH": 0.0096, "NÃOR": 0.0096, "ILHO": 0.0096, "OMOC": 0.0096,
+	"ACKA": 0.0096, "KAGE": 0.0096, "SOUR": 0.0096, "UEMA": 0.0096, "IVAM": 0.0096,
+	"DOFO": 0.0096, "SERS": 0.0096, "UPOS": 0.0096, "UMPR": 0.0096, "RMAI": 0.0096,
+	"ITEM": 0.0096, "DAVE": 0.0096, "IBUI": 0.0096, "PORQ": 0.0096, "CKAG": 0.0096,
+	"AASS": 0.0096, "DETH": 0.0096, "EERR": 0.0095, "INÁR": 0.0095, "BINA": 0.0095,
+	"ABAL": 0.0095, "ROAR": 0.0095, "ÁTIC": 0.0095, "PROD": 0.0095, "NDOU": 0.0095,
+	"OMAT": 0.0095, "ADOM": 0.0095, "ENEN": 0.0095, "LOME": 0.0095, "LTAN": 0.0095,
+	"EAMB": 0.0095, "DICE": 0.0095, "IAIS": 0.0095, "OSUF": 0.0095, "ERAE": 0.0094,
+	"SASE": 0.0094, "ILAD": 0.0094, "ÃOMA": 0.0094, "AMOS": 0.0094, "ACRI": 0.0094,
+	"DEVA": 0.0094, "MUDA": 0.0094, "CONJ": 0.0094, "ONJU": 0.0094, "NJUN": 0.0094,
+	"AFUN": 0.0094, "SMAS": 0.0094, "BINÁ": 0.0094, "TEUM": 0.0094, "ILID": 0.0094,
+	"MÚLT": 0.0094, "PLOS": 0.0094, "BALH": 0.0094, "OACT": 0.0094, "EASE": 0.0094,
+	"SARA": 0.0094, "DASO": 0.0094, "XADE": 0.0094, "ESLI": 0.0094, "IGIN": 0.0094,
+	"ASEP": 0.0094, "UMVA": 0.0094, "URAS": 0.0093, "ESNÃ": 0.0093, "AAPE": 0.0093,
+	"AISR": 0.0093, "AOFI": 0.0093, "EMOD": 0.0093, "RODO": 0.0093, "APEL": 0.0093,
+	"ERAN": 0.0093, "DEAC": 0.0093, "ARAV": 0.0093, "USDE": 0.0093, "SDER": 0.0093,
+	"MAPE": 0.0093, "AISE": 0.0093, "OUSU": 0.0093, "SMBO": 0.0093, "MLIN": 0.0093,
+	"ADER": 0.0093, "ARÁC": 0.0093, "TOGR": 0.0093, "RAPR": 0.0093, "RALM": 0.0093,
+	"OINS": 0.0093, "EMAR": 0.0093, "ATCH": 0.0093, "EXPO": 0.0093, "DANÃ": 0.0093,
+	"DOEX": 0.0093, "INGL": 0.0093, "DEBY": 0.0092, "GRAV": 0.0092, "ODIS": 0.0092,
+	"ADIS": 0.0092, "SERÃ": 0.0092, "LAND": 0.0092, "RPOR": 0.0092, "EOCO": 0.0092,
+	"ODUZ": 0.0092, "SOFT": 0.0092, "AZIO": 0.0092, "DEVI": 0.0092, "UMAD": 0.0092,
+	"OTRA": 0.0092, "NADE": 0.0092, "UNAS": 0.0092, "ALEN": 0.0091, "NSID": 0.0091,
+	"RORE": 0.0091, "FECH": 0.0091, "COMB": 0.0091, "TIMA": 0.0091, "ELAS": 0.0091,
+	"TOES": 0.0091, "MATI": 0.0091, "REAM": 0.0091, "TYPE": 0.0091, "NOAR": 0.0091,
+	"DIFF": 0.0091, "OSSI": 0.0091, "ORNO": 0.0091, "NTON": 0.0091, "ÍCIO": 0.0091,
+	"OSSO": 0.0091, "METR": 0.0091, "DESL": 0.0091, "ROVA": 0.0091, "PROX": 0.0091,
+	"OFLU": 0.0091, "AROA": 0.0091, "IALI": 0.0090, "ATAB": 0.0090, "STER": 0.0090,
+	"CLUS": 0.0090, "ENAD": 0.0090, "VERE": 0.0090, "TERO": 0.0090, "UDES": 0.0090,
+	"PERS": 0.0090, "OÚLT": 0.0090, "SEUM": 0.0090, "ASMO": 0.0090, "ANDA": 0.0090,
+	"SÃOC": 0.0090, "TEÚD": 0.0090, "EÚDO": 0.0090, "COTA": 0.0090, "RASA": 0.0090,
+	"IROF": 0.0090, "HOSD": 0.0090, "TORE": 0.0090, "DETO": 0.0090, "IVON": 0.0090,
+	"REEN": 0.0090, "RTIF": 0.0090, "OMUN": 0.0090, "STRO": 0.0090, "ADAL": 0.0089,
+	"UIVA": 0.0089, "OGER": 0.0089, "ECEN": 0.0089, "NTIN": 0.0089, "COLO": 0.0089,
+	"AROP": 0.0089, "IRUM": 0.0089, "COPI": 0.0089, "RASD": 0.0089, "AACO": 0.0089,
+	"AINE": 0.0089, "TESO": 0.0089, "RSIO": 0.0089, "INER": 0.0089, "OSSÃ": 0.0089,
+	"INAI": 0.0089, "ONFL": 0.0089, "ALIG": 0.0089, "AORE": 0.0089, "DECH": 0.0089,
+	"SSOP": 0.0089, "SMOS": 0.0089, "DOOS": 0.0089, "IMOD": 0.0089, "STIR": 0.0089,
+	"OTEQ": 0.0089, "ROPO": 0.0089, "IVOC": 0.0089, "SLIS": 0.0089, "ESAB": 0.0089,
+	"ONAI": 0.0089, "ANOV": 0.0089, "IZAO": 0.0089, "HASD": 0.0088, "IROA": 0.0088,
+	"ULAÇ": 0.0088, "LASS": 0.0088, "ÃOPE": 0.0088, "ÃOHÁ": 0.0088, "IDER": 0.0088,
+	"DENA": 0.0088, "ELOM": 0.0088, "ROES": 0.0088, "ASTR": 0.0088, "VOPA": 0.0088,
+	"ETEC": 0.0088, "OSEL": 0.0088, "ENÇA": 0.0088, "EMSU": 0.0088, "OTAD": 0.0088,
+	"REDI": 0.0088, "NFLI": 0.0088, "SADE": 0.0088, "OLIN": 0.0088, "MFAL": 0.0088,
+	"ÉRIC": 0.0088, "EVOL": 0.0088, "ATIC": 0.0088, "MASC": 0.0088, "OCIA": 0.0088,
+	"XIBI": 0.0088, "IVAL": 0.0087, "RTED": 0.0087, "LETO": 0.0087, "RODI": 0.0087,
+	"MOPA": 0.0087, "AREF": 0.0087, "RAAC": 0.0087, "ATEN": 0.0087, "MIDO": 0.0087,
+	"NTOO": 0.0087, "APAL": 0.0087, "CHAN": 0.0087, "OINI": 0.0087, "DIDA": 0.0087,
+	"ÇÃOR": 0.0087, "CARÁ": 0.0087, "EOUT": 0.0087, "ÃONO": 0.0087, "ELLE": 0.0087,
+	"STEP": 0.0087, "RIOE": 0.0087, "ZAÇÕ": 0.0087, "ARFI": 0.0087, "SOSP": 0.0087,
+	"OSVA": 0.0087, "EAPE": 0.0087, "SVEZ": 0.0087, "AZIA": 0.0087, "AESC": 0.0087,
+	"OTAR": 0.0087, "HTTP": 0.0087, "TAPA": 0.0086, "IGUA": 0.0086, "ARCH": 0.0086,
+	"ÃOUS": 0.0086, "PRIN": 0.0086, "UMAA": 0.0086, "ROSI": 0.0086, "SÃOI": 0.0086,
+	"RIAN": 0.0086, "ULAD": 0.0086, "OAPE": 0.0086, "MANO": 0.0086, "NQUA": 0.0086,
+	"OASS": 0.0086, "REFI": 0.0086, "VALE": 0.0086, "TEAP": 0.0086, "ADAF": 0.0086,
+	"NASU": 0.0086, "VERA": 0.0086, "TARC": 0.0086, "MORT": 0.0086, "OPIA": 0.0086,
+	"OCHA": 0.0086, "STED": 0.0086, "LETR": 0.0086, "OMOM": 0.0086, "XPOR": 0.0086,
+	"AOPA": 0.0086, "EREL": 0.0086, "EDEB": 0.0086, "DURA": 0.0086, "TALI": 0.0086,
+	"EDOP": 0.0086, "OSAP": 0.0086, "XTOD": 0.0085, "XCED": 0.0085, "NTOI": 0.0085,
+	"TORD": 0.0085, "TARP": 0.0085, "EMFA": 0.0085, "IRDE": 0.0085, "SSOE": 0.0085,
+	"AOPE": 0.0085, "ITIR": 0.0085, "OSNA": 0.0085, "NIÇÕ": 0.0085, "ESOB": 0.0085,
+	"ERSÕ": 0.0085, "RSÕE": 0.0085, "PCIO": 0.0085, "SERO": 0.0085, "ZADA": 0.0085,
+	"EMRE": 0.0085, "MBRO": 0.0085, "TONO": 0.0085, "EPUR": 0.0085, "ONEC": 0.0085,
+	"RMAD": 0.0085, "DATE": 0.0085, "LOSE": 0.0085, "ARAQ": 0.0085, "NACO": 0.0084,
+	"TEIN": 0.0084, "COMM": 0.0084, "DUPL": 0.0084, "ASEA": 0.0084, "OIND": 0.0084,
+	"SDIS": 0.0084, "ENQU": 0.0084, "ANGE": 0.0084, "UENÃ": 0.0083, "TEAC": 0.0083,
+	"MASS": 0.0083, "DESP": 0.0083, "ONOS": 0.0083, "ÃOEN": 0.0083, "RINF": 0.0083,
+	"RRAM": 0.0083, "EPER": 0.0083, "SOME": 0.0083, "CASE": 0.0083, "CAQU": 0.0083,
+	"RIED": 0.0083, "EBLO": 0.0083, "TONÃ": 0.0083, "SSAM": 0.0083, "DEVO": 0.0083,
+	"PURA": 0.0083, "NDEI": 0.0083, "MNÚM": 0.0083, "RSON": 0.0083, "RASO": 0.0083,
+	"SDEO": 0.0083, "NTAI": 0.0083, "EMEL": 0.0083, "RONA": 0.0082, "AGER": 0.0082,
+	"NTOA": 0.0082, "ÃOSÃ": 0.0082, "ADEU": 0.0082, "ELRE": 0.0082, "AODI": 0.0082,
+	"AVES": 0.0082, "RROM": 0.0082, "OURC": 0.0082, "URCE": 0.0082, "PULA": 0.0082,
+	"OCOD": 0.0082, "EDOC": 0.0082, "OCKE": 0.0082, "RTES": 0.0082, "IDOO": 0.0082,
+	"UETA": 0.0082, "EGRU": 0.0082, "EMAP": 0.0082, "NUNC": 0.0082, "RESA": 0.0082,
+	"ALIA": 0.0082, "AOBT": 0.0082, "AONO": 0.0082, "LHAN": 0.0082, "EDEN": 0.0081,
+	"RTOD": 0.0081, "MÍNI": 0.0081, "LARA": 0.0081, "OSDI": 0.0081, "MPID": 0.0081,
+	"TESI": 0.0081, "FIMD": 0.0081, "EUMP": 0.0081, "AOES": 0.0081, "AOIN": 0.0081,
+	"ODOM": 0.0081, "OERR": 0.0081, "CATE": 0.0081, "OBLO": 0.0081, "JETO": 0.0081,
+	"NSOL": 0.0081, "SOLA": 0.0081, "REPR": 0.0081, "REPE": 0.0081, "SSOB": 0.0081,
+	"ROSA": 0.0081, "OALI": 0.0081, "MSUP": 0.0081, "SÃOR": 0.0081, "EEXP": 0.0081,
+	"MASD": 0.0081, "OENC": 0.0081, "VELR": 0.0081, "OACE": 0.0081, "EDOS": 0.0081,
+	"UPOD": 0.0081, "IODO": 0.0081, "OSME": 0.0081, "ORID": 0.0081, "NASD": 0.0081,
+	"SDEI": 0.0081, "ONDI": 0.0081, "JECT": 0.0081, "UTUR": 0.0081, "SSUI": 0.0081,
+	"OEXC": 0.0081, "ESUP": 0.0081, "OMQU": 0.0081, "NGLÊ": 0.0081, "GLÊS": 0.0081,
+	"TEGR": 0.0081, "MRIC": 0.0081, "IMIR": 0.0080, "EROU": 0.0080, "SOCI": 0.0080,
+	"ARPR": 0.0080, "DISC": 0.0080, "ÚTIL": 0.0080, "NOCA": 0.0080, "NOES": 0.0080,
+	"TERF": 0.0080, "OESC": 0.0080, "ONSO": 0.0080, "HANG": 0.0080, "NOMO": 0.0080,
+	"SAPE": 0.0080, "LOGI": 0.0080, "ARIV": 0.0080, "AIMP": 0.0080, "RPAD": 0.0080,
+	"EINV": 0.0080, "IPTS": 0.0080, "ONGO": 0.0080, "OROU": 0.0080, "AACT": 0.0080,
+	"LCRI": 0.0080, "ELOG": 0.0080, "MAUT": 0.0080, "COMQ": 0.0080, "EOAR": 0.0080,
+	"SOOP": 0.0079, "ETAR": 0.0079, "ROUP": 0.0079, "MAEX": 0.0079, "TEMU": 0.0079,
+	"ÃOFA": 0.0079, "LFOR": 0.0079, "STOP": 0.0079, "STAC": 0.0079, "NIRO": 0.0079,
+	"ESDI": 0.0079, "LORP": 0.0079, "ABLE": 0.0079, "LARG": 0.0079, "EADE": 0.0079,
+	"ESRE": 0.0079, "COIN": 0.0079, "ÃOOU": 0.0079, "ECUÇ": 0.0079, "TRUÇ": 0.0079,
+	"RDEP": 0.0079, "CIAR": 0.0079, "AUME": 0.0079, "UMAM": 0.0079, "MANU": 0.0079,
+	"VADA": 0.0079, "PRIE": 0.0079, "OMAR": 0.0079, "RSES": 0.0079, "ÉUSA": 0.0079,
+	"EFIX": 0.0078, "TESQ": 0.0078, "APOS": 0.0078, "ELAB": 0.0078, "SÃOM": 0.0078,
+	"EEND": 0.0078, "SAPA": 0.0078, "LOQU": 0.0078, "EPOI": 0.0078, "DEAP": 0.0078,
+	"RINC": 0.0078, "ESTÃ": 0.0078, "ICAE": 0.0078, "ICAQ": 0.0078, "UTOR": 0.0078,
+	"GARO": 0.0078, "ASMA": 0.0078, "ECAS": 0.0078, "AISC": 0.0078, "ÂMIC": 0.0078,
+	"UMDE": 0.0078, "ONDÊ": 0.0078, "SUMA": 0.0078, "UCOM": 0.0078, "ONGA": 0.0078,
+	"RENO": 0.0078, "OMEÇ": 0.0078, "ORED": 0.0078, "RNOM": 0.0078, "OSMA": 0.0078,
+	"SMOR": 0.0078, "NTAG": 0.0078, "DOSM": 0.0078, "AROD": 0.0078, "ASRE": 0.0078,
+	"EDIA": 0.0078, "AREN": 0.0078, "NTOC": 0.0078, "ESAI": 0.0077, "ONAS": 0.0077,
+	"QUEF": 0.0077, "RÁCT": 0.0077, "ÁCTE": 0.0077, "ALDO": 0.0077, "OFTW": 0.0077,
+	"BRES": 0.0077, "TINU": 0.0077, "DEPU": 0.0077, "LICI": 0.0077, "EISD": 0.0077,
+	"ELCR": 0.0077, "IOPA": 0.0077, "RAAL": 0.0077, "RELE": 0.0077, "PREE": 0.0077,
+	"ONUM": 0.0077, "OATU": 0.0077, "DAAR": 0.0077, "FRAN": 0.0077, "COMV": 0.0077,
+	"TENH": 0.0077, "DODA": 0.0077, "NOCO": 0.0077, "DOEN": 0.0077, "LABR": 0.0077,
+	"SDEN": 0.0077, "PIDO": 0.0077, "DEGR": 0.0077, "RODA": 0.0077, "ASOU": 0.0077,
+	"VÍRG": 0.0077, "ÍRGU": 0.0077, "EGRA": 0.0077, "OFAZ": 0.0077, "UMAF": 0.0077,
+	"VOCO": 0.0077, "CARO": 0.0077, "EASS": 0.0077, "OTED": 0.0077, "REPA": 0.0077,
+	"DOAU": 0.0077, "STOS": 0.0077, "OPCI": 0.0077, "IEDA": 0.0077, "UEPO": 0.0077,
+	"IVEL": 0.0077, "AIXA": 0.0077, "ISOS": 0.0077, "OFIN": 0.0076, "ÚSCU": 0.0076,
+	"ECED": 0.0076, "FLIT": 0.0076, "NDEP": 0.0076, "BSOL": 0.0076, "DOSF": 0.0076,
+	"OEND": 0.0076, "ALFO": 0.0076, "ICAA": 0.0076, "MDIR": 0.0076, "EJAM": 0.0076,
+	"NSÃO": 0.0076, "PROV": 0.0076, "UERD": 0.0076, "UZID": 0.0076, "TALD": 0.0076,
+	"RQUA": 0.0076, "EREG": 0.0076, "TWAR": 0.0076, "NARO": 0.0076, "SDAS": 0.0076,
+	"RATI": 0.0076, "SABI": 0.0076, "MVER": 0.0076, "TPAR": 0.0076, "SCAM": 0.0076,
+	"HABI": 0.0076, "DOST": 0.0075, "SSUM": 0.0075, "RIAE": 0.0075, "SSEP": 0.0075,
+	"PRET": 0.0075, "MCAR": 0.0075, "FTWA": 0.0075, "FERI": 0.0075, "RTEN": 0.0075,
+	"EGAD": 0.0075, "EVAR": 0.0075, "SINC": 0.0075, "NTAÇ": 0.0075, "AEXT": 0.0075,
+	"OSFO": 0.0075, "SONA": 0.0075, "IAND": 0.0075, "AORD": 0.0075, "ODIC": 0.0075,
+	"GUMA": 0.0075, "CEDI": 0.0075, "OSCR": 0.0075, "CUÇÃ": 0.0075, "ÇODE": 0.0075,
+	"QUAI": 0.0075, "SINS": 0.0075, "DEUT": 0.0075, "VERB": 0.0075, "COND": 0.0075,
+	"IVAR": 0.0075, "AROF": 0.0075, "RÃOE": 0.0075, "APOD": 0.0075, "RAOB": 0.0075,
+	"ELAC": 0.0075, "ÉTIC": 0.0075, "AEXE": 0.0074, "RVOR": 0.0074, "OSLI": 0.0074,
+	"ITAN": 0.0074, "SÃOS": 0.0074, "LADA": 0.0074, "ERDO": 0.0074, "AMEM": 0.0074,
+	"EDOA": 0.0074, "OGIN": 0.0074, "EBIN": 0.0074, "SPAO": 0.0074, "USOO": 0.0073,
+	"ERQU": 0.0073, "ARME": 0.0073, "TASD": 0.0073, "ASDA": 0.0073, "ASOB": 0.0073,
+	"ECIM": 0.0073, "OSEF": 0.0073, "EGRI": 0.0073, "RSOD": 0.0073, "SEÇÃ": 0.0073,
+	"COMC": 0.0073, "OLER": 0.0073, "SRES": 0.0073, "TIQU": 0.0073, "TOPR": 0.0073,
+	"OSUS": 0.0073, "OMEM": 0.0073, "RUMP": 0.0073, "CONC": 0.0073, "MÉRI": 0.0073,
+	"STAB": 0.0073, "ADEL": 0.0073, "ICIT": 0.0073, "ESOU": 0.0073, "GEST": 0.0073,
+	"FICI": 0.0073, "ATEM": 0.0073, "ABIB": 0.0073, "EMFO": 0.0073, "GATI": 0.0073,
+	"UEDE": 0.0073, "ORIT": 0.0073, "EOPE": 0.0073, "LASM": 0.0073, "ROSC": 0.0072,
+	"SOMA": 0.0072, "ASEO": 0.0072, "ETIP": 0.0072, "TERU": 0.0072, "IARO": 0.0072,
+	"STAA": 0.0072, "EFEI": 0.0072, "ROTA": 0.0072, "ERFA": 0.0072, "IAUM": 0.0072,
+	"ASUM": 0.0072, "INUA": 0.0072, "TANO": 0.0072, "ONOV": 0.0072, "ERAS": 0.0072,
+	"OPEN": 0.0072, "AVEZ": 0.0072, "RPAC": 0.0072, "DEFA": 0.0072, "MANI": 0.0072,
+	"AVEL": 0.0072, "DEAT": 0.0072, "ÃOLI": 0.0072, "ASPE": 0.0072, "EORI": 0.0072,
+	"FAKE": 0.0072, "NÇAS": 0.0071, "OAPÓ": 0.0071, "OFON": 0.0071, "PATH": 0.0071,
+	"LEGA": 0.0071, "MBIN": 0.0071, "IVID": 0.0071, "TENA": 0.0071, "OSTO": 0.0071,
+	"ASAP": 0.0071, "ZMAU": 0.0071, "OBYT": 0.0071, "ALPA": 0.0071, "BARR": 0.0071,
+	"SINF": 0.0071, "LTAR": 0.0071, "DASN": 0.0071, "ÃOPR": 0.0071, "RMIS": 0.0071,
+	"LENC": 0.0071, "ESOL": 0.0071, "ORME": 0.0071, "ÍFIC": 0.0071, "ASVA": 0.0071,
+	"MONO": 0.0071, "UMNÚ": 0.0071, "LDEA": 0.0071, "LHOD": 0.0071, "RGUR": 0.0071,
+	"ENCH": 0.0071, "ÃOIM": 0.0070, "SSOD": 0.0070, "ISQU": 0.0070, "ÃOSI": 0.0070,
+	"MAEN": 0.0070, "ESÃO": 0.0070, "REFA": 0.0070, "ERTO": 0.0070, "AAVE": 0.0070,
+	"RAEX": 0.0070, "ORAN": 0.0070, "QUEL": 0.0070, "SSOR": 0.0070, "SFIL": 0.0070,
+	"EMIN": 0.0070, "SENÃ": 0.0070, "IAÇÃ": 0.0070, "LINU": 0.0070, "SOPO": 0.0070,
+	"OSUM": 0.0070, "ENOV": 0.0070, "CÍFI": 0.0070, "BJEC": 0.0070, "ADAU": 0.0070,
+	"ESEC": 0.0070, "COMT": 0.0070, "ANCE": 0.0070, "MBYT": 0.0070, "AISP": 0.0070,
+	"AKER": 0.0070, "RNEL": 0.0070, "PKGO": 0.0070, "DORP": 0.0069, "SBIN": 0.0069,
+	"IPLO": 0.0069, "EMEX": 0.0069, "ETIQ": 0.0069, "INUX": 0.0069, "NOSU": 0.0069,
+	"ATAR": 0.0069, "VIAD": 0.0069, "GROU": 0.0069, "UMPO": 0.0069, "GUID": 0.0069,
+	"MACH": 0.0069, "TOSA": 0.0069, "TARU": 0.0069, "UISI": 0.0069, "DIVI": 0.0069,
+	"SATI": 0.0069, "NORE": 0.0069, "DEBL": 0.0069, "UPER": 0.0069, "JUST": 0.0069,
+	"MEML": 0.0069, "ERAC": 0.0069, "MEÇA": 0.0069, "KILL": 0.0069, "ARAG": 0.0069,
+	"NTAS": 0.0069, "MALM": 0.0069, "DAUM": 0.0069, "EROI": 0.0069, "OTEA": 0.0069,
+	"OATR": 0.0069, "ASOC": 0.0069, "RALG": 0.0069, "UDIO": 0.0069, "ESUM": 0.0069,
+	"SSÕE": 0.0068, "ERIR": 0.0068, "CKET": 0.0068, "NUMA": 0.0068, "SOES": 0.0068,
+	"STÁD": 0.0068, "ERMA": 0.0068, "OSEX": 0.0068, "BACK": 0.0068, "ERIA": 0.0068,
+	"ARTA": 0.0068, "REVI": 0.0068, "SADI": 0.0068, "ORFO": 0.0068, "RFAC": 0.0068,
+	"OAPR": 0.0068, "STIL": 0.0068, "OLZM": 0.0068, "AOUS": 0.0068, "UMAN": 0.0068,
+	"SOQU": 0.0068, "AMRI": 0.0068, "DEER": 0.0068, "OEXP": 0.0068, "GUAL": 0.0068,
+	"ROSS": 0.0068, "RINV": 0.0068, "SARG": 0.0068, "RAFI": 0.0068, "MTAM": 0.0068,
+	"ESAP": 0.0068, "LORI": 0.0068, "EMAC": 0.0068, "IDDE": 0.0068, "SEMU": 0.0068,
+	"ERBO": 0.0068, "ELOS": 0.0068, "PECÍ": 0.0068, "ECÍF": 0.0068, "APAC": 0.0068,
+	"TOSP": 0.0068, "ADAO": 0.0068, "ELME": 0.0068, "EOFI": 0.0068, "PORV": 0.0068,
+	"TILO": 0.0068, "INSE": 0.0068, "DESS": 0.0068, "MTOD": 0.0068, "OMOP": 0.0067,
+	"ORSE": 0.0067, "IALD": 0.0067, "RBOS": 0.0067, "OEMB": 0.0067, "AAOA": 0.0067,
+	"DESU": 0.0067, "MAPR": 0.0067, "EACE": 0.0067, "LESP": 0.0067, "AOLE": 0.0067,
+	"DANA": 0.0067, "EEXE": 0.0067, "RIOO": 0.0067, "UMES": 0.0067, "ALAO": 0.0067,
+	"MASP": 0.0067, "EEXI": 0.0067, "AQUI": 0.0067, "LIMP": 0.0067, "RÃOD": 0.0067,
+	"ARIN": 0.0067, "MEND": 0.0067, "RINT": 0.0067, "RANO": 0.0067, "MAQU": 0.0067,
+	"PARÂ": 0.0067, "ARÂM": 0.0067, "RÂME": 0.0067, "ÂMET": 0.0067, "ERVE": 0.0067,
+	"AÇOS": 0.0067, "OVOC": 0.0067, "ABAS": 0.0067, "ROFO": 0.0067, "TEEM": 0.0067,
+	"DUAS": 0.0067, "AREP": 0.0067, "ADIR": 0.0067, "EXPL": 0.0067, "SSEU": 0.0067,
+	"FACE": 0.0067, "RAEN": 0.0067, "TOQU": 0.0067, "IMAL": 0.0067, "ORIA": 0.0067,
+	"AICO": 0.0067, "MPOU": 0.0067, "SOCK": 0.0066, "SSEN": 0.0066, "UALA": 0.0066,
+	"EJAD": 0.0066, "IROT": 0.0066, "ISRE": 0.0066, "APER": 0.0066, "OMEC": 0.0066,
+	"RONÃ": 0.0066, "ESPR": 0.0066, "OSTI": 0.0066, "IASE": 0.0066, "PORC": 0.0066,
+	"NDEA": 0.0066, "AFON": 0.0066, "CTAM": 0.0066, "ÇÃOT": 0.0066, "RDEC": 0.0066,
+	"AOSE": 0.0066, "ROSU": 0.0066, "INAM": 0.0066, "ANDÊ": 0.0066, "NDÊS": 0.0066,
+	"HIST": 0.0066, "ESFO": 0.0066, "SUMI": 0.0066, "KERN": 0.0066, "ROLO": 0.0066,
+	"OLOD": 0.0066, "SEMV": 0.0066, "RESN": 0.0066, "SOSC": 0.0066, "ELEN": 0.0066,
+	"CHAR": 0.0066, "ÃODI": 0.0066, "TARD": 0.0066, "DOAL": 0.0066, "NTET": 0.0066,
+	"NUME": 0.0066, "BJET": 0.0066, "EFOI": 0.0066, "EINI": 0.0066, "ICOA": 0.0066,
+	"QUIR": 0.0066, "ÁUDI": 0.0066, "EBUG": 0.0066, "LESS": 0.0066, "ASCA": 0.0065,
+	"RIOC": 0.0065, "IOCO": 0.0065, "ICEN": 0.0065, "LNÃO": 0.0065, "ORRO": 0.0065,
+	"PORA": 0.0065, "NÍCI": 0.0065, "IROU": 0.0065, "EAJU": 0.0065, "SEMT": 0.0065,
+	"EAUT": 0.0065, "GIDO": 0.0065, "ICON": 0.0065, "DAFO": 0.0065, "QUÊN": 0.0065,
+	"OAOC": 0.0065, "ITUA": 0.0065, "HASE": 0.0065, "SODO": 0.0065, "NMER": 0.0065,
+	"ÇÃOL": 0.0065, "RARP": 0.0065, "SELI": 0.0065, "TEPR": 0.0065, "SITA": 0.0065,
+	"ESAS": 0.0065, "NARE": 0.0065, "RASI": 0.0065, "RALE": 0.0065, "EITE": 0.0065,
+	"IVOF": 0.0065, "EISS": 0.0065, "EAVE": 0.0065, "KERO": 0.0065, "TROC": 0.0064,
+	"PILH": 0.0064, "RLIN": 0.0064, "DESM": 0.0064, "OLIS": 0.0064, "OCOL": 0.0064,
+	"APAZ": 0.0064, "AOUT": 0.0064, "IESP": 0.0064, "ALHE": 0.0064, "ONOF": 0.0064,
+	"HASH": 0.0064, "EMAL": 0.0064, "ERAP": 0.0064, "NTEÉ": 0.0064, "UMAB": 0.0064,
+	"EMAE": 0.0064, "OASE": 0.0064, "ALGO": 0.0064, "MAOD": 0.0064, "RAAP": 0.0064,
+	"AASA": 0.0064, "ODOB": 0.0064, "OOBT": 0.0064, "MTER": 0.0064, "ELEI": 0.0064,
+	"SOLU": 0.0064, "OMBI": 0.0064, "RNAR": 0.0064, "OALV": 0.0064, "SNOF": 0.0064,
+	"OTEE": 0.0064, "UMAT": 0.0064, "ORPO": 0.0064, "RASP": 0.0064, "AOAP": 0.0064,
+	"TAIS": 0.0064, "SARE": 0.0064, "UDAR": 0.0064, "DINÂ": 0.0064, "INÂM": 0.0064,
+	"NÂMI": 0.0064, "MTEC": 0.0064, "MQUA": 0.0063, "COMN": 0.0063, "RAUS": 0.0063,
+	"LVER": 0.0063, "LLER": 0.0063, "PORÁ": 0.0063, "EMSI": 0.0063, "DOLI": 0.0063,
+	"ITER": 0.0063, "ENDA": 0.0063, "SOSA": 0.0063, "REIT": 0.0063, "UPLI": 0.0063,
+	"BRUT": 0.0063, "AIST": 0.0063, "ÁEXI": 0.0063, "OSHE": 0.0063, "UFIC": 0.0063,
+	"MINI": 0.0063, "EMOR": 0.0063, "DAMA": 0.0063, "DEOR": 0.0063, "SPAS": 0.0063,
+	"ONAM": 0.0063, "IXOS": 0.0063, "SIND": 0.0063, "GRID": 0.0063, "OSAL": 0.0062,
+	"OIGN": 0.0062, "ARPO": 0.0062, "NASC": 0.0062, "NÇAD": 0.0062, "UERE": 0.0062,
+	"ATÓR": 0.0062, "EMON": 0.0062, "OLHA": 0.0062, "EALG": 0.0062, "UERI": 0.0062,
+	"AOAR": 0.0062, "EMCA": 0.0062, "RAOC": 0.0062, "SDIF": 0.0062, "DERO": 0.0062,
+	"CIOD": 0.0062, "ÃOAL": 0.0062, "GZIP": 0.0062, "EAOP": 0.0062, "RDEV": 0.0062,
+	"BOSE": 0.0062, "OUPA": 0.0062, "FLAG": 0.0062, "NIMO": 0.0062, "TORI": 0.0062,
+	"ROXY": 0.0062, "AOFO": 0.0062, "SBLO": 0.0062, "OEMV": 0.0062, "PATC": 0.0062,
+	"LEDE": 0.0062, "LHAR": 0.0062, "RAAM": 0.0062, "RAAR": 0.0062, "AFET": 0.0062,
+	"FETA": 0.0062, "ASIM": 0.0061, "SASS": 0.0061, "ERLI": 0.0061, "LCON": 0.0061,
+	"RSOS": 0.0061, "ILEN": 0.0061, "AIGN": 0.0061, "OSEC": 0.0061, "RVER": 0.0061,
+	"RRAD": 0.0061, "EVEN": 0.0061, "GMEN": 0.0061, "ELEA": 0.0061, "ROPE": 0.0061,
+	"OCAS": 0.0061, "MUNI": 0.0061, "OSEE": 0.0061, "ITRI": 0.0061, "OMIT": 0.0061,
+	"ERSA": 0.0061, "ASOS": 0.0061, "ADOF": 0.0061, "ETEN": 0.0061, "IREI": 0.0061,
+	"ROSN": 0.0061, "EREP": 0.0061, "AROC": 0.0061, "RUMS": 0.0061, "EFUN": 0.0061,
+	"ERAT": 0.0061, "TAQU": 0.0061, "TRAV": 0.0061, "IARA": 0.0061, "RASU": 0.0061,
+	"OORI": 0.0061, "CANO": 0.0061, "TOPE": 0.0061, "GRAD": 0.0061, "EPAS": 0.0060,
+	"INAC": 0.0060, "OREQ": 0.0060, "OAMB": 0.0060, "DAIN": 0.0060, "FFER": 0.0060,
+	"ÃOAC": 0.0060, "VELS": 0.0060, "DORN": 0.0060, "IORQ": 0.0060, "ARED": 0.0060,
+	"MECO": 0.0060, "MOUT": 0.0060, "PROT": 0.0060, "MNÃO": 0.0060, "TALL": 0.0060,
+	"NAÇÃ": 0.0060, "EJAA": 0.0060, "IDAE": 0.0060, "HOUA": 0.0060, "OINE": 0.0060,
+	"TAXA": 0.0060, "ILES": 0.0060, "EVID": 0.0060, "EXPI": 0.0060, "XPIR": 0.0060,
+	"DEOU": 0.0060, "ROST": 0.0060, "RAOF": 0.0060, "OSEJ": 0.0060, "DAAP": 0.0060,
+	"OELE": 0.0060, "CANA": 0.0060, "OMUL": 0.0060, "SEND": 0.0060, "RAFO": 0.0060,
+	"ICAP": 0.0060, "VEND": 0.0060, "ASDI": 0.0060, "SARU": 0.0060, "DÚNI": 0.0060,
+	"TREM": 0.0060, "EDET": 0.0060, "AAOP": 0.0060, "ASEX": 0.0060, "ORNÃ": 0.0060,
+	"ASEL": 0.0060, "INÍC": 0.0060, "FORU": 0.0060, "ONSU": 0.0060, "VELN": 0.0060,
+	"DOGR": 0.0060, "EATR": 0.0060, "VELI": 0.0060, "MARE": 0.0060, "SEMO": 0.0060,
+	"ASIA": 0.0060, "LDEF": 0.0060, "ICIE": 0.0060, "NTIF": 0.0060, "FORC": 0.0060,
+	"EPRI": 0.0060, "PRIV": 0.0060, "SEAD": 0.0060, "SIDO": 0.0060, "SASO": 0.0060,
+	"OISS": 0.0060, "MESE": 0.0060, "ZCOM": 0.0060, "LARE": 0.0059, "SPAD": 0.0059,
+	"RDEA": 0.0059, "EROM": 0.0059, "MAES": 0.0059, "ÍNIM": 0.0059, "ITAM": 0.0059,
+	"IAME": 0.0059, "ASUA": 0.0059, "ARON": 0.0059, "BLOQ": 0.0059, "EBIT": 0.0059,
+	"HOME": 0.0059, "TÍVE": 0.0059, "OURE": 0.0059, "NUMÉ": 0.0059, "UMÉR": 0.0059,
+	"BRAN": 0.0059, "CORE": 0.0059, "CIPA": 0.0059, "DOAS": 0.0059, "COMD": 0.0059,
+	"OISA": 0.0059, "ANUA": 0.0059, "DAOU": 0.0059, "UMID": 0.0059, "PLES": 0.0059,
+	"OCAB": 0.0059, "STEF": 0.0059, "SORE": 0.0059, "TRAO": 0.0059, "AODO": 0.0059,
+	"LINE": 0.0059, "BUFF": 0.0059, "DENÃ": 0.0059, "INOD": 0.0059, "UMSI": 0.0059,
+	"ERFI": 0.0059, "RAOU": 0.0059, "DAPE": 0.0059, "DUSE": 0.0059, "EIMP": 0.0059,
+	"AXAD": 0.0059, "EFAZ": 0.0059, "AOCA": 0.0059, "CIEN": 0.0059, "RAPO": 0.0059,
+	"PEQU": 0.0059, "QUEÉ": 0.0059, "LEAS": 0.0059, "OUIN": 0.0059, "ANEN": 0.0059,
+	"AOCR": 0.0059, "RSER": 0.0059, "PORO": 0.0059, "DADI": 0.0059, "ACIO": 0.0059,
+	"NDED": 0.0059, "CAIS": 0.0059, "RÃOÉ": 0.0059, "ENAM": 0.0059, "RION": 0.0059,
+	"FERR": 0.0059, "RITM": 0.0059, "AMCO": 0.0059, "HECK": 0.0059, "PONV": 0.0059,
+	"ROLI": 0.0058, "CIDE": 0.0058, "REMA": 0.0058, "UFFE": 0.0058, "IRCO": 0.0058,
+	"GUIR": 0.0058, "DEEM": 0.0058, "DAPR": 0.0058, "LDEC": 0.0058, "LHES": 0.0058,
+	"ORUS": 0.0058, "NCIP": 0.0058, "ATAD": 0.0058, "ENDÊ": 0.0058, "ODAC": 0.0058,
+	"SSOF": 0.0058, "PKGP": 0.0058, "ORRI": 0.0058, "ENSÃ": 0.0058, "RTER": 0.0058,
+	"CARU": 0.0058, "ANIP": 0.0058, "NIPU": 0.0058, "IPUL": 0.0058, "UEPA": 0.0058,
+	"RROR": 0.0058, "EQUÊ": 0.0058, "UÊNC": 0.0058, "ENOE": 0.0058, "IDOU": 0.0058,
+	"DELA": 0.0058, "UMSU": 0.0058, "ROLA": 0.0058, "EONO": 0.0058, "TABU": 0.0058,
+	"ABUL": 0.0058, "BULA": 0.0058, "PÁGI": 0.0058, "ÁGIN": 0.0058, "OLET": 0.0058,
+	"SÃON": 0.0058, "SACO": 0.0058, "EROP": 0.0058, "NALM": 0.0058, "NARA": 0.0058,
+	"TOSO": 0.0058, "MCAM": 0.0058, "DOAT": 0.0058, "IGAD": 0.0058, "IROM": 0.0058,
+	"ÃOAP": 0.0058, "NIST": 0.0058, "DOOP": 0.0058, "LPOR": 0.0058, "IORA": 0.0058,
+	"NDIA": 0.0058, "AMDE": 0.0058, "OSAT": 0.0058, "NTAN": 0.0058, "ESAN": 0.0058,
+	"RICA": 0.0058, "IMAD": 0.0058, "XIMA": 0.0058, "EADÚ": 0.0058, "ADÚN": 0.0058,
+	"GLOB": 0.0057, "ETAS": 0.0057, "ECRI": 0.0057, "SCAP": 0.0057, "RREC": 0.0057,
+	"AANT": 0.0057, "OMOF": 0.0057, "UMGR": 0.0057, "OUUM": 0.0057, "PATÍ": 0.0057,
+	"ATÍV": 0.0057, "SAIN": 0.0057, "OCÊP": 0.0057, "AVAL": 0.0057, "IGAR": 0.0057,
+	"EGER": 0.0057, "ADAI": 0.0057, "OREP": 0.0057, "SEAS": 0.0057, "SOTA": 0.0057,
+	"IANA": 0.0057, "RRUP": 0.0057, "PECF": 0.0057, "LITO": 0.0057, "ALSE": 0.0057,
+	"CERC": 0.0057, "EGAR": 0.0057, "IGOS": 0.0057, "IOSA": 0.0057, "ALLO": 0.0057,
+	"SPRI": 0.0057, "DEBA": 0.0057, "KSIM": 0.0057, "AZDE": 0.0057, "REGR": 0.0057,
+	"CASD": 0.0057, "OMUI": 0.0057, "AAOC": 0.0057, "VONÃ": 0.0057, "OMÍN": 0.0057,
+	"OEXT": 0.0057, "EOTA": 0.0057, "NUAL": 0.0057, "NCOR": 0.0057, "SMON": 0.0057,
+	"EAPL": 0.0057, "TOEM": 0.0057, "RAMO": 0.0057, "LASD": 0.0057, "RPRI": 0.0057,
+	"AREA": 0.0056, "MASU": 0.0056, "NSPO": 0.0056, "MMOD": 0.0056, "ÃOSO": 0.0056,
+	"SFER": 0.0056, "DEAJ": 0.0056, "NKSI": 0.0056, "PAZD": 0.0056, "STAV": 0.0056,
+	"OADI": 0.0056, "FORÇ": 0.0056, "TARS": 0.0056, "ALTO": 0.0056, "IANT": 0.0056,
+	"ERAM": 0.0056, "ESAT": 0.0056, "CAÇÕ": 0.0056, "RÃOP": 0.0056, "ANAM": 0.0056,
+	"OSAO": 0.0056, "EXAD": 0.0056, "GORI": 0.0056, "OCID": 0.0056, "MICA": 0.0056,
+	"PORD": 0.0056, "ADUO": 0.0056, "EUSU": 0.0056, "NOIN": 0.0056, "EMAT": 0.0056,
+	"RRER": 0.0056, "OOUO": 0.0056, "MEAR": 0.0056, "ACER": 0.0056, "LEST": 0.0056,
+	"ARLI": 0.0056, "MPLI": 0.0056, "TIME": 0.0056, "TERR": 0.0056, "EDEE": 0.0056,
+	"ACED": 0.0056, "RATO": 0.0056, "VELF": 0.0056, "ANOF": 0.0056, "OAPA": 0.0056,
+	"ELOD": 0.0056, "ATOS": 0.0056, "SCEN": 0.0056, "PIAD": 0.0056, "DEAS": 0.0056,
+	"MOME": 0.0056, "CUTÁ": 0.0056, "DOXZ": 0.0056, "NCUL": 0.0056, "ECFI": 0.0056,
+	"CFIC": 0.0056, "LASE": 0.0055, "AISQ": 0.0055, "VIDA": 0.0055, "SOUS": 0.0055,
+	"UIDO": 0.0055, "MOFI": 0.0055, "UÍDO": 0.0055, "MAST": 0.0055, "MBRA": 0.0055,
+	"ÉSUP": 0.0055, "DOTE": 0.0055, "STAP": 0.0055, "EMOT": 0.0055, "MALF": 0.0055,
+	"DAST": 0.0055, "SIAD": 0.0055, "LOAD": 0.0055, "ROME": 0.0055, "ÉINV": 0.0055,
+	"ISIT": 0.0055, "STÁF": 0.0055, "SNAS": 0.0055, "ASUS": 0.0055, "UTÁV": 0.0055,
+	"SISS": 0.0055, "ESUS": 0.0055, "TGET": 0.0055, "LICE": 0.0055, "NDOI": 0.0055,
+	"SCHA": 0.0055, "TENC": 0.0055, "IRAP": 0.0055, "NDAM": 0.0055, "MGRU": 0.0055,
+	"IROO": 0.0055, "OIES": 0.0055, "SCOR": 0.0055, "DDUS": 0.0055, "PTIO": 0.0055,
+	"AOMO": 0.0055, "AÚLT": 0.0055, "NCAD": 0.0055, "OOPE": 0.0055, "MDEE": 0.0055,
+	"OREN": 0.0055, "SOPA": 0.0055, "POIN": 0.0055, "NASP": 0.0055, "IDES": 0.0055,
+	"SÃOF": 0.0055, "DAQU": 0.0055, "INEA": 0.0055, "NIBI": 0.0055, "DEXZ": 0.0055,
+	"RCAR": 0.0054, "ORDA": 0.0054, "CAPE": 0.0054, "SQUI": 0.0054, "PIDA": 0.0054,
+	"RUTO": 0.0054, "PSTR": 0.0054, "EREA": 0.0054, "RUMN": 0.0054, "GITO": 0.0054,
+	"ADDU": 0.0054, "ONST": 0.0054, "SESE": 0.0054, "AOAL": 0.0054, "ROEM": 0.0054,
+	"ELIS": 0.0054, "OOCO": 0.0054, "SERP": 0.0054, "IARE": 0.0054, "RUMD": 0.0054,
+	"ULOS": 0.0054, "OPTI": 0.0054, "MAOU": 0.0054, "PKGE": 0.0054, "APTG": 0.0054,
+	"PTGE": 0.0054, "ÃOMO": 0.0054, "SUME": 0.0054, "ILAÇ": 0.0054, "STDO": 0.0054,
+	"SOUT": 0.0054, "PEDI": 0.0054, "TEMO": 0.0054, "OMET": 0.0054, "EVIT": 0.0054,
+	"NTOR": 0.0054, "EOPÇ": 0.0054, "ÃOAR": 0.0054, "ASUB": 0.0054, "FILH": 0.0054,
+	"EINC": 0.0054, "TEFI": 0.0054, "NODI": 0.0054, "GGER": 0.0054, "DMIN": 0.0054,
+	"ÕESN": 0.0054, "BIDO": 0.0054, "RDOC": 0.0054, "ISSE": 0.0054, "ABAI": 0.0054,
+	"MORE": 0.0054, "ONEX": 0.0054, "PKGA": 0.0054, "EMFI": 0.0053, "NHAE": 0.0053,
+	"AAOD": 0.0053, "OEMF": 0.0053, "SOSD": 0.0053, "NALE": 0.0053, "VERP": 0.0053,
+	"NÃOU": 0.0053, "APAG": 0.0053, "AREL": 0.0053, "UMDO": 0.0053, "OUAO": 0.0053,
+	"GODE": 0.0053, "MADI": 0.0053, "TUAN": 0.0053, "OMAL": 0.0053, "OBTI": 0.0053,
+	"LOPA": 0.0053, "ESAL": 0.0053, "FUTU": 0.0053, "BERT": 0.0053, "ERTA": 0.0053,
+	"DEAU": 0.0053, "SFON": 0.0053, "ADMI": 0.0053, "DOTI": 0.0053, "TSDE": 0.0053,
+	"VOCA": 0.0053, "SOSF": 0.0053, "NIRA": 0.0053, "LOSD": 0.0053, "HOCO": 0.0053,
+	"EDEL": 0.0053, "OCAI": 0.0053, "NCID": 0.0052, "EMOS": 0.0052, "IROR": 0.0052,
+	"RIRO": 0.0052, "OEMS": 0.0052, "TDOU": 0.0052, "IDAN": 0.0052, "ELOB": 0.0052,
+	"DEND": 0.0052, "MDOS": 0.0052, "SEMB": 0.0052, "DEMÁ": 0.0052, "TEIS": 0.0052,
+	"NTEL": 0.0052, "IACO": 0.0052, "ACAR": 0.0052, "EBRA": 0.0052, "PERI": 0.0052,
+	"ORÇA": 0.0052, "APTC": 0.0052, "UETE": 0.0052, "TING": 0.0052, "ISAL": 0.0052,
+	"OOUT": 0.0052, "XPLI": 0.0052, "MACA": 0.0052, "OTEB": 0.0052, "AJUS": 0.0052,
+	"RAAV": 0.0052, "ELHA": 0.0052, "NHAP": 0.0052, "ELIG": 0.0052, "SEMM": 0.0052,
+	"RREM": 0.0052, "ESEU": 0.0052, "SOLV": 0.0052, "UARD": 0.0052, "UTUA": 0.0052,
+	"ITEA": 0.0052, "ISPA": 0.0052, "RIAU": 0.0052, "AFAL": 0.0052, "RRET": 0.0052,
+	"OOUS": 0.0052, "NDES": 0.0052, "OAME": 0.0052, "SECT": 0.0052, "CORD": 0.0052,
+	"NTAL": 0.0052, "VÍDE": 0.0052, "ÍDEO": 0.0052, "SAPR": 0.0052, "OFER": 0.0052,
+	"UEFO": 0.0052, "VOSL": 0.0052, "DASI": 0.0051, "ITOG": 0.0051, "TEOS": 0.0051,
+	"OSAN": 0.0051, "RIAM": 0.0051, "RUMF": 0.0051, "ODAD": 0.0051, "SENC": 0.0051,
+	"VEMS": 0.0051, "EINO": 0.0051, "UXOS": 0.0051, "NSIS": 0.0051, "UIND": 0.0051,
+	"OIST": 0.0051, "EIST": 0.0051, "PAGA": 0.0051, "RDEU": 0.0051, "STIP": 0.0051,
+	"NDEF": 0.0051, "IVOI": 0.0051, "QUEB": 0.0051, "SDIR": 0.0051, "LCUL": 0.0051,
+	"SCAB": 0.0051, "ICED": 0.0051, "OESQ": 0.0051, "NDAN": 0.0051, "UPAR": 0.0051,
+	"DESK": 0.0051, "SMES": 0.0051, "LEMÃ": 0.0051, "EMÃO": 0.0051, "NGUA": 0.0051,
+	"TEBI": 0.0051, "UIRE": 0.0051, "ELPO": 0.0051, "OLED": 0.0051, "VOES": 0.0051,
+	"CAOD": 0.0051, "SSED": 0.0051, "RTID": 0.0051, "EÇOS": 0.0051, "EADI": 0.0051,
+	"ANSF": 0.0051, "UIÇÃ": 0.0051, "PESQ": 0.0051, "SERM": 0.0051, "LOBT": 0.0051,
+	"PORS": 0.0051, "GUAR": 0.0051, "ILEG": 0.0051, "NASO": 0.0051, "ORIZ": 0.0051,
+	"ASAD": 0.0051, "VORA": 0.0051, "IGGE": 0.0051, "SSON": 0.0051, "PTDE": 0.0051,
+	"ICOE": 0.0051, "SNOT": 0.0051, "ÓPIA": 0.0051, "ROSO": 0.0051, "ESUB": 0.0051,
+	"ENOT": 0.0051, "OFUN": 0.0051, "RUSS": 0.0051, "USSO": 0.0051, "TOSH": 0.0051,
+	"SGER": 0.0051, "ASEÇ": 0.0051, "RÕES": 0.0050, "IROI": 0.0050, "ARDI": 0.0050,
+	"DECL": 0.0050, "HODA": 0.0050, "ASAO": 0.0050, "TERC": 0.0050, "ENCE": 0.0050,
+	"SALV": 0.0050, "OSAS": 0.0050, "OISD": 0.0050, "ÇÃOU": 0.0050, "NÃOO": 0.0050,
+	"LDEP": 0.0050, "BTID": 0.0050, "DOME": 0.0050, "MAFO": 0.0050, "DAEX": 0.0050,
+	"TRIG": 0.0050, "SONÃ": 0.0050, "RAOM": 0.0050, "MERA": 0.0050, "NTOT": 0.0050,
+	"EGAT": 0.0050, "SEEN": 0.0050, "ASBA": 0.0050, "IONS": 0.0050, "MQUI": 0.0050,
+	"RINS": 0.0050, "IDAO": 0.0050, "ANAD": 0.0050, "UPRI": 0.0050, "RASC": 0.0050,
+	"ISIN": 0.0050, "TART": 0.0050, "TATO": 0.0050, "ESIG": 0.0050, "MOES": 0.0050,
+	"EMUS": 0.0050, "ADOL": 0.0050, "MEXE": 0.0050, "NAPA": 0.0050, "SIVA": 0.0050,
+	"ISSÃ": 0.0050, "IRQU": 0.0050, "FIQU": 0.0050, "EVÁR": 0.0050, "SVAR": 0.0050,
+	"OREF": 0.0050, "ISOA": 0.0050, "QUEV": 0.0050, "ONOD": 0.0050, "ABER": 0.0050,
+	"SEXI": 0.0050, "ÇÃOG": 0.0050, "BREO": 0.0050, "MEDA": 0.0050, "ANCÊ": 0.0050,
+	"NCÊS": 0.0050, "ETEX": 0.0050, "REDU": 0.0050, "AFOI": 0.0050, "AISL": 0.0050,
+	"ORLI": 0.0050, "ESEA": 0.0050, "VINC": 0.0050, "PKGD": 0.0050, "VOFO": 0.0050,
+	"AINC": 0.0049, "GAÇÕ": 0.0049, "GPAR": 0.0049, "MUSA": 0.0049, "STAI": 0.0049,
+	"SSUP": 0.0049, "OTEP": 0.0049, "ASFO": 0.0049, "OVAS": 0.0049, "AAPA": 0.0049,
+	"TEUS": 0.0049, "XCEP": 0.0049, "CEPT": 0.0049, "DEFU": 0.0049, "OBSE": 0.0049,
+	"PRIA": 0.0049, "OCAN": 0.0049, "TESV": 0.0049, "MAME": 0.0049, "EOPA": 0.0049,
+	"AROT": 0.0049, "AAIN": 0.0049, "RAON": 0.0049, "IARU": 0.0049, "ILAR": 0.0049,
+	"OIRE": 0.0049, "EUMC": 0.0049, "APEA": 0.0049, "RABE": 0.0049, "GEMP": 0.0049,
+	"IVOM": 0.0049, "ACOL": 0.0049, "USTA": 0.0049, "EADA": 0.0049, "AALI": 0.0049,
+	"RASS": 0.0049, "VOSP": 0.0049, "INCU": 0.0049, "ERON": 0.0049, "LTIT": 0.0049,
+	"ANSP": 0.0049, "TAUM": 0.0049, "SOLE": 0.0049, "ONOC": 0.0049, "ÚDOD": 0.0049,
+	"TÁDE": 0.0049, "OSUB": 0.0049, "OSIG": 0.0049, "SERF": 0.0049, "ATER": 0.0049,
+	"DORA": 0.0049, "MMEM": 0.0049, "TIBI": 0.0049, "RAST": 0.0049, "AGES": 0.0049,
+	"OMOV": 0.0049, "DODP": 0.0049, "DOWN": 0.0049, "ORCE": 0.0049, "RMEN": 0.0049,
+	"RDET": 0.0049, "LUIR": 0.0049, "OOTA": 0.0049, "SOSS": 0.0049, "RNOV": 0.0049,
+	"NCHI": 0.0049, "AASE": 0.0049, "AOQU": 0.0049, "ORDI": 0.0049, "NONE": 0.0049,
+	"EEXT": 0.0049, "TESB": 0.0049, "SUPR": 0.0048, "IRSE": 0.0048, "ANUM": 0.0048,
+	"NOSD": 0.0048, "ÉTOD": 0.0048, "EDIT": 0.0048, "RANÃ": 0.0048, "ESOF": 0.0048,
+	"CIOS": 0.0048, "TEEX": 0.0048, "EEXC": 0.0048, "STEA": 0.0048, "AISO": 0.0048,
+	"PATI": 0.0048, "ONOT": 0.0048, "STON": 0.0048, "IGOD": 0.0048, "SINV": 0.0048,
+	"BSER": 0.0048, "ÉMAI": 0.0048, "OMDE": 0.0048, "TAES": 0.0048, "VADE": 0.0048,
+	"ECEB": 0.0048, "NDEM": 0.0048, "VAVE": 0.0048, "CTIO": 0.0048, "SICO": 0.0048,
+	"TESF": 0.0048, "ONAT": 0.0048, "ENUM": 0.0048, "NHAC": 0.0048, "VRAS": 0.0048,
+	"ÃOÉA": 0.0048, "AISI": 0.0048, "OUOP": 0.0048, "ONAC": 0.0048, "TEAS": 0.0048,
+	"MSIN": 0.0048, "TEAD": 0.0048, "DSDE": 0.0048, "ENAR": 0.0048, "RDIR": 0.0048,
+	"ONSE": 0.0048, "DONE": 0.0048, "ATIB": 0.0048, "AMBO": 0.0048, "MOTO": 0.0048,
+	"RSEM": 0.0048, "EMMA": 0.0048, "OAOP": 0.0048, "RMAN": 0.0048, "RIGG": 0.0048,
+	"NHAN": 0.0048, "TETA": 0.0048, "IOSP": 0.0048, "ÉPER": 0.0048, "DIAN": 0.0048,
+	"OMIN": 0.0048, "TOOU": 0.0048, "UALO": 0.0048, "USAO": 0.0048, "SREQ": 0.0048,
+	"SREL": 0.0048, "MANE": 0.0048, "SMOD": 0.0048, "AAPR": 0.0048, "ODEQ": 0.0048,
+	"ENTÃ": 0.0048, "NTÃO": 0.0048, "ORRU": 0.0048, "ONFF": 0.0048, "POUC": 0.0048,
+	"ITHR": 0.0048, "SREG": 0.0047, "SARP": 0.0047, "EPAD": 0.0047, "OOUP": 0.0047,
+	"TURO": 0.0047, "MÉTO": 0.0047, "AMQU": 0.0047, "EÇOD": 0.0047, "EACT": 0.0047,
+	"RARU": 0.0047, "MSUC": 0.0047, "EMAO": 0.0047, "SDOP": 0.0047, "ROUS": 0.0047,
+	"VITA": 0.0047, "ADOT": 0.0047, "ISES": 0.0047, "DIAT": 0.0047, "UEBR": 0.0047,
+	"SOFI": 0.0047, "UEOA": 0.0047, "RIZA": 0.0047, "DEHA": 0.0047, "OÉDE": 0.0047,
+	"ERAI": 0.0047, "RANA": 0.0047, "NOPA": 0.0047, "MATR": 0.0047, "EDIC": 0.0047,
+	"OVAV": 0.0047, "RIOT": 0.0047, "ALDA": 0.0047, "SSIV": 0.0047, "RCES": 0.0047,
+	"DINA": 0.0047, "MALG": 0.0047, "IVOT": 0.0047, "IMDE": 0.0047, "NDOR": 0.0047,
+	"OBOT": 0.0047, "OVOS": 0.0047, "QUEX": 0.0047, "TITH": 0.0047, "XODE": 0.0047,
+	"ÃOCA": 0.0047, "ANOR": 0.0047, "INVE": 0.0047, "SVÁL": 0.0047, "VIRT": 0.0047,
+	"RROP": 0.0047, "PLAS": 0.0047, "OIEN": 0.0047, "ODAV": 0.0047, "ELAL": 0.0047,
+	"NCAP": 0.0047, "MDEA": 0.0047, "TOSN": 0.0047, "TIRA": 0.0047, "TEJA": 0.0047,
+	"INCR": 0.0047, "IMED": 0.0047, "RRID": 0.0047, "IRDO": 0.0047, "LDET": 0.0047,
+	"CALC": 0.0047, "UMRE": 0.0047, "ATIN": 0.0047, "RÃOA": 0.0047, "SEVO": 0.0047,
+	"CÓPI": 0.0047, "ONOE": 0.0047, "SSOT": 0.0047, "FOIR": 0.0047, "PLOD": 0.0047,
+	"ALFA": 0.0047, "TABL": 0.0047, "NHOC": 0.0047, "OSLO": 0.0047, "SARD": 0.0047,
+	"LAOP": 0.0047, "IRAR": 0.0047, "RAIS": 0.0047, "CCIO": 0.0046, "OSBI": 0.0046,
+	"DAFI": 0.0046, "NDOF": 0.0046, "TÁRI": 0.0046, "UMTE": 0.0046, "ARSO": 0.0046,
+	"EMDI": 0.0046, "SUTI": 0.0046, "OFIM": 0.0046, "RATE": 0.0046, "NSUL": 0.0046,
+	"EGAL": 0.0046, "PODO": 0.0046, "JÁEX": 0.0046, "LIVR": 0.0046, "SITO": 0.0046,
+	"VECO": 0.0046, "STEJ": 0.0046, "SLIG": 0.0046, "RNOS": 0.0046, "ONON": 0.0046,
+	"RAMI": 0.0046, "TOSC": 0.0046, "NDAR": 0.0046, "SBYT": 0.0046, "AUNI": 0.0046,
+	"ESKT": 0.0046, "SKTO": 0.0046, "KTOP": 0.0046, "DOSR": 0.0046, "RALT": 0.0046,
+	"ETAI": 0.0046, "NECT": 0.0046, "TESU": 0.0046, "LANO": 0.0046, "SSAS": 0.0046,
+	"NFFI": 0.0046, "VRIA": 0.0046, "ECCI": 0.0046, "SNUM": 0.0046, "GERI": 0.0046,
+	"IRPA": 0.0046, "SEMF": 0.0046, "ÃOÉS": 0.0046, "IDAA": 0.0046, "TAOS": 0.0046,
+	"FOIC": 0.0046, "ESEX": 0.0046, "OSNE": 0.0046, "ECAB": 0.0046, "MDEF": 0.0046,
+	"DEOB": 0.0046, "TEEN": 0.0046, "ISOU": 0.0046, "IPAL": 0.0046, "AMOD": 0.0046,
+	"OVAZ": 0.0046, "EREF": 0.0046, "ÕESC": 0.0046, "RAUT": 0.0046, "HELP": 0.0046,
+	"EELE": 0.0046, "SESD": 0.0046, "OUNO": 0.0046, "RTEP": 0.0046, "TOUM": 0.0046,
+	"EMME": 0.0046, "ASAR": 0.0046, "CODO": 0.0046, "FUND": 0.0046, "AONÚ": 0.0046,
+	"EMET": 0.0046, "NOSI": 0.0046, "SEOP": 0.0046, "ROBO": 0.0046, "RIOM": 0.0046,
+	"PONI": 0.0046, "DVOR": 0.0046, "XTRE": 0.0046, "ENÚM": 0.0045, "DIAS": 0.0045,
+	"PERT": 0.0045, "SACE": 0.0045, "TATA": 0.0045, "DORO": 0.0045, "ASTE": 0.0045,
+	"RUMC": 0.0045, "MBOS": 0.0045, "EOLI": 0.0045, "MAKE": 0.0045, "NTOF": 0.0045,
+	"RNAS": 0.0045, "ECOD": 0.0045, "MUMC": 0.0045, "PIPE": 0.0045, "RUÇÃ": 0.0045,
+	"DASL": 0.0045, "ZARA": 0.0045, "ALCA": 0.0045, "TROP": 0.0045, "PDAT": 0.0045,
+	"RTAÇ": 0.0045, "ARET": 0.0045, "OTEX": 0.0045, "EAPR": 0.0045, "ASME": 0.0045,
+	"TAAP": 0.0045, "UEAS": 0.0045, "REMI": 0.0045, "ZIDO": 0.0045, "INGD": 0.0045,
+	"ASÍM": 0.0045, "ORAK": 0.0045, "ATIL": 0.0045, "ALIM": 0.0045, "ASAC": 0.0045,
+	"ARAÇ": 0.0045, "RNOD": 0.0045, "APIL": 0.0045, "SEXT": 0.0045, "EJAR": 0.0045,
+	"ÁDES": 0.0045, "OMSU": 0.0045, "USAA": 0.0045, "ISSÕ": 0.0045, "RCHA": 0.0045,
+	"STAU": 0.0045, "ODAP": 0.0045, "SUNT": 0.0045, "MUTI": 0.0045, "ROOU": 0.0045,
+	"ELUS": 0.0045, "UMOU": 0.0045, "EVIA": 0.0045, "OSOP": 0.0045, "OEMC": 0.0045,
+	"PERD": 0.0045, "NTEF": 0.0045, "IENC": 0.0045, "OAPL": 0.0045, "ROSF": 0.0045,
+	"CRIÇ": 0.0045, "NDOT": 0.0045, "LUSA": 0.0045, "AAPL": 0.0045, "OANA": 0.0045,
+	"OEMU": 0.0045, "IMID": 0.0045, "IVOO": 0.0045, "ORTU": 0.0045, "SUPE": 0.0045,
+	"INTO": 0.0045, "ENDS": 0.0045, "LEVA": 0.0045, "MAPO": 0.0045, "NOPO": 0.0045,
+	"CAPS": 0.0045, "SEMI": 0.0044, "IRTU": 0.0044, "RTUA": 0.0044, "ADAV": 0.0044,
+	"CEDO": 0.0044, "AOME": 0.0044, "CAUS": 0.0044, "ALES": 0.0044, "NITO": 0.0044,
+	"DOMO": 0.0044, "UEST": 0.0044, "OMEI": 0.0044, "APON": 0.0044, "VORC": 0.0044,
+	"SEPR": 0.0044, "APTI": 0.0044, "VERU": 0.0044, "NEGA": 0.0044, "TEDI": 0.0044,
+	"LÍCI": 0.0044, "ADEO": 0.0044, "INOU": 0.0044, "AISN": 0.0044, "NACA": 0.0044,
+	"ELOU": 0.0044, "OAIN": 0.0044, "SEPO": 0.0044, "VÉSD": 0.0044, "EROF": 0.0044,
+	"OAPP": 0.0044, "EMNO": 0.0044, "ODOT": 0.0044, "MDIS": 0.0044, "INIS": 0.0044,
+	"IRES": 0.0044, "RVIÇ": 0.0044, "VIÇO": 0.0044, "OMAP": 0.0044, "FORF": 0.0044,
+	"EMBY": 0.0044, "PETI": 0.0044, "AESS": 0.0044, "COSD": 0.0044, "PORL": 0.0044,
+	"ONTM": 0.0044, "HASC": 0.0044, "OBIN": 0.0044, "RÃOS": 0.0044, "ELAN": 0.0044,
+	"UMFO": 0.0044, "UISA": 0.0044, "RATR": 0.0044, "OSOC": 0.0044, "LANA": 0.0044,
+	"INCA": 0.0044, "EAPA": 0.0044, "AOAC": 0.0044, "NOEN": 0.0044, "SEGM": 0.0044,
+	"EGME": 0.0044, "EDOF": 0.0044, "SOLI": 0.0044, "SDOD": 0.0044, "TAOU": 0.0044,
+	"ISAS": 0.0044, "ONES": 0.0044, "DOAN": 0.0044, "NALO": 0.0044, "BREA": 0.0044,
+	"OSET": 0.0044, "NULO": 0.0044, "UMIN": 0.0044, "ÍDAC": 0.0044, "ODEG": 0.0044,
+	"DAOP": 0.0044, "NFIA": 0.0044, "DEBD": 0.0044, "RUPT": 0.0044, "FFIL": 0.0044,
+	"ROSB": 0.0043, "ODAL": 0.0043, "IPLA": 0.0043, "ASCH": 0.0043, "ATAG": 0.0043,
+	"SMEN": 0.0043, "CLUÍ": 0.0043, "INAS": 0.0043, "LACO": 0.0043, "ÃOGE": 0.0043,
+	"IRAD": 0.0043, "OLHE": 0.0043, "VOSA": 0.0043, "EROE": 0.0043, "EANO": 0.0043,
+	"RIOU": 0.0043, "TCOM": 0.0043, "CTOR": 0.0043, "ONOA": 0.0043, "RTUG": 0.0043,
+	"NTOU": 0.0043, "NTYP": 0.0043, "IALM": 0.0043, "SMET": 0.0043, "IONE": 0.0043,
+	"MINF": 0.0043, "IBIR": 0.0043, "EMBA": 0.0043, "NVEL": 0.0043, "HANT": 0.0043,
+	"OKER": 0.0043, "IRRO": 0.0043, "DICT": 0.0043, "TRAE": 0.0043, "IMPA": 0.0043,
+	"ÍDAS": 0.0043, "SONO": 0.0043, "RÁTI": 0.0043, "ARTO": 0.0043, "RAEM": 0.0043,
+	"TARN": 0.0043, "SOIN": 0.0043, "SSIT": 0.0043, "ÕESI": 0.0043, "EDOR": 0.0043,
+	"OÉIN": 0.0043, "OSAC": 0.0043, "OMAO": 0.0043, "ORAO": 0.0043, "LUÍD": 0.0043,
+	"ISDO": 0.0043, "ONFO": 0.0043, "DULO": 0.0043, "LECT": 0.0043, "STAE": 0.0043,
+	"EESS": 0.0043, "DOFA": 0.0043, "UPDA": 0.0043, "ÃOOP": 0.0043, "MMAN": 0.0043,
+	"WIND": 0.0043, "ALET": 0.0043, "REAS": 0.0043, "ZDEC": 0.0043, "AOSP": 0.0043,
+	"ELAR": 0.0043, "TUGU": 0.0043, "ISTÓ": 0.0043, "IVOX": 0.0043, "LITE": 0.0043,
+	"EDUZ": 0.0043, "TINA": 0.0043, "SSOÉ": 0.0043, "PESS": 0.0043, "EDIF": 0.0043,
+	"XADO": 0.0043, "STOA": 0.0043, "PAOS": 0.0043, "IASP": 0.0042, "PÓSO": 0.0042,
+	"ELLO": 0.0042, "SÃOO": 0.0042, "GARA": 0.0042, "TEDA": 0.0042, "UALD": 0.0042,
+	"VIAR": 0.0042, "URAE": 0.0042, "ÁRVO": 0.0042, "EOSP": 0.0042, "ASCI": 0.0042,
+	"STÁV": 0.0042, "ORTO": 0.0042, "ONCL": 0.0042, "ORAS": 0.0042, "ÍCIT": 0.0042,
+	"OSUN": 0.0042, "ODOO": 0.0042, "TELI": 0.0042, "TRAC": 0.0042, "CLAR": 0.0042,
+	"OALG": 0.0042, "ARGA": 0.0042, "ITAA": 0.0042, "PORI": 0.0042, "PIRA": 0.0042,
+	"ROQU": 0.0042, "LLOC": 0.0042, "URAA": 0.0042, "ASOM": 0.0042, "ESCE": 0.0042,
+	"GRES": 0.0042, "MULA": 0.0042, "TOFO": 0.0042, "DOSB": 0.0042, "OHÁS": 0.0042,
+	"ÁSUP": 0.0042, "MSEG": 0.0042, "LIAN": 0.0042, "INAA": 0.0042, "ENOF": 0.0042,
+	"CURT": 0.0042, "OSAD": 0.0042, "ETRI": 0.0042, "DEMD": 0.0042, "OTOT": 0.0042,
+	"HORÁ": 0.0042, "MESC": 0.0042, "NÉTI": 0.0042, "ONIB": 0.0042, "CHIM": 0.0042,
+	"ESSR": 0.0042, "PESD": 0.0042, "MIRR": 0.0042, "XZCO": 0.0042, "HACO": 0.0041,
+	"ADRÕ": 0.0041, "DRÕE": 0.0041, "IMIN": 0.0041, "RARS": 0.0041, "TERD": 0.0041,
+	"PORM": 0.0041, "ITEN": 0.0041, "RACH": 0.0041, "STEE": 0.0041, "TRUN": 0.0041,
+	"ROTO": 0.0041, "MOSD": 0.0041, "SOPR": 0.0041, "TATI": 0.0041, "ERDI": 0.0041,
+	"LARD": 0.0041, "IFIQ": 0.0041, "ELET": 0.0041, "RAGE": 0.0041, "SEXC": 0.0041,
+	"ESHE": 0.0041, "EARG": 0.0041, "LOBA": 0.0041, "CIAA": 0.0041, "MSIS": 0.0041,
+	"DOSH": 0.0041, "ISAM": 0.0041, "MOAR": 0.0041, "SASA": 0.0041, "OAOD": 0.0041,
+	"UTOD": 0.0041, "OIDE": 0.0041, "CANC": 0.0041, "INVO": 0.0041, "CARE": 0.0041,
+	"ROMA": 0.0041, "EROC": 0.0041, "MOCA": 0.0041, "NHON": 0.0041, "HÁSU": 0.0041,
+	"URAP": 0.0041, "NAMA": 0.0041, "LAOD": 0.0041, "PLAN": 0.0041, "INGU": 0.0041,
+	"ASNA": 0.0041, "MICO": 0.0041, "EDON": 0.0041, "AARG": 0.0041, "IREM": 0.0041,
+	"LDEB": 0.0041, "NICE": 0.0041, "WERT": 0.0041, "LOCI": 0.0041, "NTRI": 0.0041,
+	"OSLZ": 0.0041, "SCAD": 0.0041, "NARC": 0.0041, "IAES": 0.0041, "UERU": 0.0041,
+	"URSI": 0.0041, "RMOD": 0.0041, "MEMO": 0.0041, "STÁA": 0.0041, "OSED": 0.0041,
+	"RDEE": 0.0041, "EUMF": 0.0041, "RLIS": 0.0041, "SEOS": 0.0041, "OSMO": 0.0041,
+	"TOTE": 0.0041, "RUNC": 0.0041, "AGAR": 0.0041, "ERMO": 0.0041, "USAM": 0.0041,
+	"AFAZ": 0.0041, "OBAS": 0.0041, "DEIM": 0.0041, "ECHO": 0.0041, "LATA": 0.0041,
+	"ITOD": 0.0041, "SEAR": 0.0041, "ECAP": 0.0041, "ALCU": 0.0041, "CAND": 0.0041,
+	"LORA": 0.0041, "ROMO": 0.0041, "TAVA": 0.0041, "ATAL": 0.0041, "USÃO": 0.0041,
+	"IOES": 0.0041, "MEAD": 0.0041, "SESC": 0.0041, "CIAN": 0.0041, "POCO": 0.0041,
+	"EPET": 0.0041, "ÁRAB": 0.0041, "ÍSIC": 0.0041, "GUÊS": 0.0041, "VOCP": 0.0041,
+	"DIGI": 0.0041, "IGIT": 0.0041, "GRÁF": 0.0041, "RÁFI": 0.0041, "ÁFIC": 0.0041,
+	"CART": 0.0041, "TROF": 0.0041, "GRAF": 0.0041, "EVEE": 0.0041, "TAÇÕ": 0.0041,
+	"DWAR": 0.0041, "SINI": 0.0041, "EPTO": 0.0041, "MFIL": 0.0041, "SASL": 0.0041,
+	"RÁPI": 0.0041, "HIME": 0.0041, "RRIO": 0.0041, "SSRI": 0.0041, "EOPR": 0.0040,
+	"CENÇ": 0.0040, "ALEI": 0.0040, "OBSO": 0.0040, "MÁTI": 0.0040, "ÃOQU": 0.0040,
+	"ARPE": 0.0040, "TAPR": 0.0040, "MEIN": 0.0040, "UEPR": 0.0040, "EIND": 0.0040,
+	"AGUA": 0.0040, "LUSI": 0.0040, "USIV": 0.0040, "USEA": 0.0040, "IVRE": 0.0040,
+	"RIÇÃ": 0.0040, "IOSO": 0.0040, "VOIN": 0.0040, "ABOR": 0.0040, "TIGA": 0.0040,
+	"NONO": 0.0040, "UMSE": 0.0040, "AEMB": 0.0040, "ONIT": 0.0040, "IDIR": 0.0040,
+	"IXOP": 0.0040, "OSUL": 0.0040, "STÓR": 0.0040, "ORVÍ": 0.0040, "RVÍR": 0.0040,
+	"UMTA": 0.0040, "NTEQ": 0.0040, "OCER": 0.0040, "ITEO": 0.0040, "BÉMP": 0.0040,
+	"MASM": 0.0040, "OUVE": 0.0040, "CLAD": 0.0040, "AVAD": 0.0040, "ETIV": 0.0040,
+	"ESBI": 0.0040, "RÃON": 0.0040, "PSLO": 0.0040, "FONÉ": 0.0040, "ONÉT": 0.0040,
+	"GEME": 0.0040, "RENÇ": 0.0040, "RSIV": 0.0040, "SIVO": 0.0040, "AUMP": 0.0040,
+	"OÉCO": 0.0040, "NOSC": 0.0040, "ÃOAN": 0.0040, "SARC": 0.0040, "SSOU": 0.0040,
+	"CTRL": 0.0040, "PARC": 0.0040, "NSEG": 0.0040, "RAFA": 0.0040, "EREX": 0.0040,
+	"DÍGI": 0.0040, "ÍGIT": 0.0040, "MAVA": 0.0040, "VOSN": 0.0040, "FOIA": 0.0040,
+	"ORAA": 0.0040, "ITAP": 0.0040, "ÁFOR": 0.0040, "SNES": 0.0040, "ZARO": 0.0040,
+	"AHOR": 0.0040, "NDIR": 0.0040, "LFAZ": 0.0040, "ASIS": 0.0040, "MTIP": 0.0040,
+	"OMMA": 0.0040, "ACOD": 0.0040, "LREM": 0.0040, "OMEL": 0.0040, "RESI": 0.0040,
+	"ENOA": 0.0040, "IASA": 0.0040, "NORT": 0.0040, "ATOR": 0.0040, "OSOF": 0.0040,
+	"UOPÇ": 0.0040, "AEXI": 0.0040, "ISTÃ": 0.0040, "SNOA": 0.0040, "CHES": 0.0040,
+	"ETAB": 0.0040, "NOEX": 0.0040, "STHR": 0.0040, "ONME": 0.0040, "OFAK": 0.0040,
+	"TROB": 0.0040, "UEXZ": 0.0040, "SICA": 0.0039, "MINÚ": 0.0039, "INÚS": 0.0039,
+	"NÚSC": 0.0039, "AINI": 0.0039, "OUPO": 0.0039, "ESFI": 0.0039, "SAOP": 0.0039,
+	"RPER": 0.0039, "ETOS": 0.0039, "IDAC": 0.0039, "TENE": 0.0039, "ANHA": 0.0039,
+	"ESEP": 0.0039, "ADEQ": 0.0039, "UIDA": 0.0039, "AOAB": 0.0039, "OABR": 0.0039,
+	"OLAN": 0.0039, "DEOS": 0.0039, "STÁC": 0.0039, "TCHE": 0.0039, "STDE": 0.0039,
+	"ORAC": 0.0039, "TESM": 0.0039, "NONÍ": 0.0039, "NDEO": 0.0039, "EAIN": 0.0039,
+	"ISÃO": 0.0039, "ODAT": 0.0039, "MALE": 0.0039, "AOSU": 0.0039, "NDAD": 0.0039,
+	"ARGS": 0.0039, "DARD": 0.0039, "OLIC": 0.0039, "OCTA": 0.0039, "CAPO": 0.0039,
+	"ROVE": 0.0039, "NGEL": 0.0039, "LING": 0.0039, "AESA": 0.0039, "ÓRIC": 0.0039,
+	"ICOP": 0.0039, "EOAP": 0.0039, "IBID": 0.0039, "SSEC": 0.0039, "OMVE": 0.0039,
+	"ESSU": 0.0039, "ERTY": 0.0039, "QWER": 0.0039, "HASS": 0.0039, "DASU": 0.0039,
+	"LOIN": 0.0039, "AOSC": 0.0039, "ICAL": 0.0039, "RIAÇ": 0.0039, "NSFE": 0.0039,
+	"IRMA": 0.0039, "SOND": 0.0039, "RAOE": 0.0039, "AADI": 0.0039, "PLÍC": 0.0039,
+	"VEUM": 0.0039, "STÁE": 0.0039, "FLUT": 0.0039, "LUTU": 0.0039, "DACA": 0.0039,
+	"OMEE": 0.0039, "ELFA": 0.0039, "MENC": 0.0039, "AROM": 0.0039, "EALO": 0.0039,
+	"OMOT": 0.0039, "ODAM": 0.0039, "OOPR": 0.0039, "ROMI": 0.0039, "RSOE": 0.0039,
+	"UZIR": 0.0039, "FIXA": 0.0039, "OSBL": 0.0039, "SYST": 0.0039, "YSTE": 0.0039,
+	"VOXZ": 0.0039, "EVIS": 0.0039, "UÇÕE": 0.0039, "NDOM": 0.0039, "TEOU": 0.0039,
+	"POSE": 0.0039, "SALI": 0.0039, "ATEC": 0.0039, "SEEX": 0.0039, "HAPA": 0.0039,
+	"AMÁQ": 0.0039, "PKGS": 0.0039, "SVIA": 0.0039, "APSL": 0.0039, "MINS": 0.0039,
+	"RÁUM": 0.0039, "TRRI": 0.0039, "TCON": 0.0038, "ÇASE": 0.0038, "ODAA": 0.0038,
+	"ÕESF": 0.0038, "ODOE": 0.0038, "AVEP": 0.0038, "ADIF": 0.0038, "OAOA": 0.0038,
+	"OINÍ": 0.0038, "ABSO": 0.0038, "ROSM": 0.0038, "ONAP": 0.0038, "ISNO": 0.0038,
+	"ESEE": 0.0038, "DOAC": 0.0038, "UERO": 0.0038, "FALS": 0.0038, "UADO": 0.0038,
+	"AREG": 0.0038, "NTAT": 0.0038, "LHOE": 0.0038, "EROT": 0.0038, "RMAS": 0.0038,
+	"MATE": 0.0038, "ADOQ": 0.0038, "RIVA": 0.0038, "MONI": 0.0038, "OEMI": 0.0038,
+	"ÉDES": 0.0038, "ALNO": 0.0038, "ABYT": 0.0038, "ORAL": 0.0038, "ADAR": 0.0038,
+	"OOBJ": 0.0038, "QUIP": 0.0038, "DOSL": 0.0038, "SESA": 0.0038, "COSE": 0.0038,
+	"CHIN": 0.0038, "UNDI": 0.0038, "OTOD": 0.0038, "ATRO": 0.0038, "SSET": 0.0038,
+	"COPA": 0.0038, "APPS": 0.0038, "EUML": 0.0038, "SHOW": 0.0038, "EMTO": 0.0038,
+	"ASHE": 0.0038, "NGDE": 0.0038, "MAVI": 0.0038, "ASUP": 0.0038, "EOUS": 0.0038,
+	"LAÇÕ": 0.0038, "RUME": 0.0038, "EMMO": 0.0038, "RCOR": 0.0038, "RAIZ": 0.0038,
+	"SSOM": 0.0038, "SOSN": 0.0038, "GERE": 0.0038, "USAS": 0.0038, "ROUD": 0.0038,
+	"EPON": 0.0038, "ÃONE": 0.0038, "ÃOIS": 0.0038, "TAPO": 0.0038, "CARG": 0.0038,
+	"ITOA": 0.0038, "EXPA": 0.0038, "EROA": 0.0038, "BORT": 0.0038, "TEAL": 0.0038,
+	"MÓDU": 0.0038, "ÓDUL": 0.0038, "SOFO": 0.0038, "UIUM": 0.0038, "CHED": 0.0038,
+	"ESVA": 0.0038, "RSTA": 0.0038, "HOPA": 0.0038, "OPOI": 0.0038, "SERL": 0.0038,
+	"APTU": 0.0038, "OUAP": 0.0038, "OÉNE": 0.0038, "ODAE": 0.0038, "RLIG": 0.0038,
+	"EMDA": 0.0038, "ACIM": 0.0038, "MUMS": 0.0038, "STOU": 0.0038, "EASP": 0.0038,
+	"EOSE": 0.0038, "RSEG": 0.0038, "SDOM": 0.0038, "OCOP": 0.0038, "EMOC": 0.0038,
+	"IXAD": 0.0038, "ELTA": 0.0038, "OSXZ": 0.0038, "SBAN": 0.0038, "DISS": 0.0038,
+	"PPST": 0.0038, "REOS": 0.0038, "SYMB": 0.0038, "YMBO": 0.0038, "ÉEQU": 0.0038,
+	"MSCR": 0.0038, "ÁPID": 0.0038, "OOUD": 0.0038, "SLZM": 0.0038, "MPAD": 0.0037,
+	"IMOS": 0.0037, "NARY": 0.0037, "OUTE": 0.0037, "LOSP": 0.0037, "DAAO": 0.0037,
+	"ANOT": 0.0037, "SPEJ": 0.0037, "RDEL": 0.0037, "AOEX": 0.0037, "MANA": 0.0037,
+	"APES": 0.0037, "LESE": 0.0037, "SOSU": 0.0037, "SILE": 0.0037, "TAMO": 0.0037,
+	"MOTE": 0.0037, "FREE": 0.0037, "ASAN": 0.0037, "DESH": 0.0037, "OARM": 0.0037,
+	"MMAI": 0.0037, "SEMN": 0.0037, "SNOV": 0.0037, "ÇADO": 0.0037, "ELEX": 0.0037,
+	"CRES": 0.0037, "REXP": 0.0037, "ÉMUM": 0.0037, "NVOC": 0.0037, "ERFO": 0.0037,
+	"IAST": 0.0037, "OAOL": 0.0037, "ESEO": 0.0037, "SPEN": 0.0037, "OUME": 0.0037,
+	"POLI": 0.0037, "OLIT": 0.0037, "RCHI": 0.0037, "EASO": 0.0037, "ATOE": 0.0037,
+	"MNOV": 0.0037, "EJAE": 0.0037, "ÃOÉN": 0.0037, "CKSU": 0.0037, "LUSÃ": 0.0037,
+	"SEOA": 0.0037, "UNTY": 0.0037, "IMEO": 0.0037, "EGUE": 0.0037, "TOUS": 0.0037,
+	"MAIÚ": 0.0037, "AIÚS": 0.0037, "IÚSC": 0.0037, "RROE": 0.0037, "ESAR": 0.0037,
+	"ANAS": 0.0037, "SLIM": 0.0037, "AOSI": 0.0037, "OAUM": 0.0037, "DPAR": 0.0037,
+	"ERAU": 0.0037, "DIRS": 0.0037, "NIZA": 0.0037, "IMEA": 0.0037, "CARI": 0.0037,
+	"BRAD": 0.0037, "MREL": 0.0037, "CLUD": 0.0037, "LCAN": 0.0037, "OGRE": 0.0037,
+	"TACA": 0.0037, "EUNI": 0.0037, "LORN": 0.0037, "DOSV": 0.0037, "RACR": 0.0037,
+	"PROF": 0.0037, "SAOS": 0.0037, "NVOL": 0.0037, "NPAR": 0.0037, "NSER": 0.0037,
+	"FÍSI": 0.0037, "EODI": 0.0037, "TETE": 0.0037, "ARBA": 0.0037, "ECKS": 0.0037,
+	"APTP": 0.0037, "UPGR": 0.0037, "EÉUM": 0.0036, "ERÁE": 0.0036, "RERR": 0.0036,
+	"AOOB": 0.0036, "DECR": 0.0036, "ANEX": 0.0036, "RAAT": 0.0036, "OSUT": 0.0036,
+	"ODEH": 0.0036, "DNÃO": 0.0036, "PANH": 0.0036, "LARO": 0.0036, "HUMP": 0.0036,
+	"ÃOMU": 0.0036, "RDOS": 0.0036, "SAIR": 0.0036, "SVEL": 0.0036, "HORE": 0.0036,
+	"ISOD": 0.0036, "PREP": 0.0036, "IOOU": 0.0036, "NTOM": 0.0036, "OENV": 0.0036,
+	"HARD": 0.0036, "EDEI": 0.0036, "SEVE": 0.0036, "ERAA": 0.0036, "UIPA": 0.0036,
+	"ANÊS": 0.0036, "ONÊS": 0.0036, "NIAN": 0.0036, "MACI": 0.0036, "OEDE": 0.0036,
+	"OBRI": 0.0036, "SITU": 0.0036, "AATU": 0.0036, "SERÚ": 0.0036, "OCAC": 0.0036,
+	"EANT": 0.0036, "TURE": 0.0036, "LETE": 0.0036, "LGOR": 0.0036, "DOMU": 0.0036,
+	"RIVI": 0.0036, "MEFE": 0.0036, "KSUM": 0.0036, "IRRE": 0.0036, "MUNS": 0.0036,
+	"SETO": 0.0036, "OSSC": 0.0036, "SSCR": 0.0036, "VIOD": 0.0036, "LASP": 0.0036,
+	"DAAS": 0.0036, "OCOI": 0.0036, "SUNI": 0.0036, "TOMÁ": 0.0036, "OGNU": 0.0036,
+	"IRFI": 0.0036, "IOEM": 0.0036, "TAUR": 0.0036, "TOLO": 0.0036, "ORAP": 0.0036,
+	"SCII": 0.0036, "OLUT": 0.0036, "RPEL": 0.0036, "UNDA": 0.0036, "EMÁQ": 0.0036,
+	"CAES": 0.0036, "DRES": 0.0036, "SAQU": 0.0036, "MOMA": 0.0036, "ARNA": 0.0036,
+	"ASEE": 0.0036, "EDOD": 0.0036, "SAUT": 0.0036, "ALPO": 0.0036, "AUMC": 0.0036,
+	"MPOE": 0.0036, "NOSO": 0.0036, "ETOP": 0.0036, "ITDE": 0.0036, "HEXA": 0.0036,
+	"IPTD": 0.0036, "ACIN": 0.0036, "CINT": 0.0036, "ADUZ": 0.0036, "ENVO": 0.0036,
+	"OEMP": 0.0036, "EBIB": 0.0036, "SEXP": 0.0036, "SETI": 0.0036, "ERÚT": 0.0036,
+	"RÚTI": 0.0036, "COMI": 0.0036, "AREX": 0.0036, "UELE": 0.0036, "TUDO": 0.0036,
+	"EMOP": 0.0036, "PKGC": 0.0036, "RITY": 0.0036, "PTSD": 0.0036, "NVEI": 0.0036,
+	"ÍDAE": 0.0035, "IXOD": 0.0035, "IMES": 0.0035, "ESEL": 0.0035, "AOLI": 0.0035,
+	"RMUI": 0.0035, "TOSS": 0.0035, "CACO": 0.0035, "NIMA": 0.0035, "IRAL": 0.0035,
+	"REGE": 0.0035, "DCOM": 0.0035, "RIRA": 0.0035, "TERS": 0.0035, "UNTA": 0.0035,
+	"TREE": 0.0035, "ACKU": 0.0035, "CKUP": 0.0035, "LUSE": 0.0035, "UEAP": 0.0035,
+	"EMAA": 0.0035, "UERA": 0.0035, "LAGS": 0.0035, "VOPO": 0.0035, "OOFI": 0.0035,
+	"EFAL": 0.0035, "MINO": 0.0035, "ECOL": 0.0035, "ITAE": 0.0035, "VOSO": 0.0035,
+	"ERER": 0.0035, "RAIR": 0.0035, "TÉMU": 0.0035, "ACRE": 0.0035, "ODOU": 0.0035,
+	"INGS": 0.0035, "CARC": 0.0035, "STOR": 0.0035, "SNÚM": 0.0035, "SASC": 0.0035,
+	"OCAÇ": 0.0035, "OSIX": 0.0035, "SATR": 0.0035, "ASIL": 0.0035, "AIMA": 0.0035,
+	"ÂNIA": 0.0035, "MALT": 0.0035, "OVEN": 0.0035, "RANI": 0.0035, "IVOL": 0.0035,
+	"LTRA": 0.0035, "TILP": 0.0035, "DEMI": 0.0035, "ESME": 0.0035, "CARP": 0.0035,
+	"SASV": 0.0035, "SEMD": 0.0035, "EVEA": 0.0035, "MOUS": 0.0035, "EAQU": 0.0035,
+	"ITMO": 0.0035, "EMTA": 0.0035, "LECO": 0.0035, "EASA": 0.0035, "TARB": 0.0035,
+	"ODIN": 0.0035, "EONÚ": 0.0035, "NDOL": 0.0035, "MCOR": 0.0035, "IDÊN": 0.0035,
+	"COCO": 0.0035, "DOBL": 0.0035, "REES": 0.0035, "AFEC": 0.0035, "SSOI": 0.0035,
+	"TNÃO": 0.0035, "ALVA": 0.0035, "POPA": 0.0035, "SEAO": 0.0035, "JÁES": 0.0035,
+	"EFAS": 0.0035, "XPAN": 0.0035, "IRDA": 0.0035, "TÁCO": 0.0035, "ARAB": 0.0035,
+	"TOSI": 0.0035, "STÁI": 0.0035, "LERO": 0.0035, "ECAC": 0.0035, "DOOC": 0.0035,
+	"OUMN": 0.0035, "OUNI": 0.0035, "DOAM": 0.0035, "ACAM": 0.0035, "OÉPE": 0.0035,
+	"NOST": 0.0035, "MOVA": 0.0035, "SUAS": 0.0035, "RAAN": 0.0035, "OMOL": 0.0035,
+	"VACO": 0.0035, "FECT": 0.0035, "TORA": 0.0035, "ELZM": 0.0035, "XOSB": 0.0035,
+	"AACE": 0.0035, "MUMN": 0.0035, "ECEM": 0.0035, "AAME": 0.0035, "VONO": 0.0035,
+	"ELAO": 0.0035, "EISA": 0.0035, "PLOA": 0.0035, "CQUI": 0.0035, "BUIO": 0.0035,
+	"SITR": 0.0035, "IVEI": 0.0035, "ERNC": 0.0035, "PGRA": 0.0035, "OSBR": 0.0035,
+	"NHAI": 0.0034, "SEML": 0.0034, "OMCO": 0.0034, "LLOW": 0.0034, "TERÁ": 0.0034,
+	"FORD": 0.0034, "ROMÁ": 0.0034, "AUMN": 0.0034, "OMEP": 0.0034, "ÍSTI": 0.0034,
+	"TARI": 0.0034, "TEAN": 0.0034, "EVEC": 0.0034, "OACO": 0.0034, "IASV": 0.0034,
+	"SERQ": 0.0034, "AFIL": 0.0034, "OFUT": 0.0034, "TDES": 0.0034, "IROV": 0.0034,
+	"OADD": 0.0034, "ÕESQ": 0.0034, "LIZE": 0.0034, "NALP": 0.0034, "DAVA": 0.0034,
+	"UALP": 0.0034, "TAAS": 0.0034, "SIVE": 0.0034, "REDO": 0.0034, "DOFL": 0.0034,
+	"RAAD": 0.0034, "CULO": 0.0034, "DEAN": 0.0034, "DELT": 0.0034, "OAFE": 0.0034,
+	"IASO": 0.0034, "IOQU": 0.0034, "SMEM": 0.0034, "GÍVE": 0.0034, "ESNA": 0.0034,
+	"UEOT": 0.0034, "ACQU": 0.0034, "UNIQ": 0.0034, "AESD": 0.0034, "ESMB": 0.0034,
+	"LECC": 0.0034, "SDOF": 0.0034, "ERET": 0.0034, "ICAC": 0.0034, "AÇOD": 0.0034,
+	"NEXA": 0.0034, "ITÓR": 0.0034, "RIOF": 0.0034, "ISAN": 0.0034, "DÊNT": 0.0034,
+	"ÊNTI": 0.0034, "ICAU": 0.0034, "EREQ": 0.0034, "OPID": 0.0034, "OMAC": 0.0034,
+	"RISS": 0.0034, "EAPT": 0.0034, "NASS": 0.0034, "ASSÃ": 0.0034, "LUIN": 0.0034,
+	"DERS": 0.0034, "OPAS": 0.0034, "DOTA": 0.0034, "ÇÃOV": 0.0034, "OÉSU": 0.0034,
+	"OISP": 0.0034, "WORD": 0.0034, "MAFU": 0.0034, "IVOÉ": 0.0034, "SUIU": 0.0034,
+	"PTCO": 0.0034, "SVAZ": 0.0034, "HASI": 0.0034, "DECÓ": 0.0034, "ANON": 0.0034,
+	"IORD": 0.0034, "ÃOAT": 0.0034, "RERE": 0.0034, "NÇÕE": 0.0034, "RROC": 0.0034,
+	"OWIN": 0.0034, "SFAL": 0.0034, "SECU": 0.0034, "SEDI": 0.0034, "ÕESU": 0.0034,
+	"LORM": 0.0034, "ANEI": 0.0034, "NEIR": 0.0034, "ITAL": 0.0034, "ETES": 0.0034,
+	"RUÇÕ": 0.0034, "AAQU": 0.0034, "OLVI": 0.0034, "ATOL": 0.0034, "TIRQ": 0.0034,
+	"ONAO": 0.0034, "ADIN": 0.0034, "OOST": 0.0034, "AUMS": 0.0034, "SDOQ": 0.0034,
+	"BDEB": 0.0034, "OAQU": 0.0034, "OETE": 0.0034, "UODE": 0.0034, "EODE": 0.0033,
+	"UERP": 0.0033, "IOSS": 0.0033, "IROB": 0.0033, "USEM": 0.0033, "NÃOL": 0.0033,
+	"OMÁT": 0.0033, "IAIN": 0.0033, "INTR": 0.0033, "MEPA": 0.0033, "UALE": 0.0033,
+	"ERPO": 0.0033, "NAUM": 0.0033, "BREV": 0.0033, "DELU": 0.0033, "DEAD": 0.0033,
+	"MAAC": 0.0033, "AOTA": 0.0033, "RNAM": 0.0033, "HAIN": 0.0033, "ERRI": 0.0033,
+	"TEMC": 0.0033, "TAEM": 0.0033, "RREU": 0.0033, "DOOA": 0.0033, "LERA": 0.0033,
+	"IDOF": 0.0033, "IANE": 0.0033, "ÕESM": 0.0033, "ZERA": 0.0033, "UMÚN": 0.0033,
+	"MÚNI": 0.0033, "ROFA": 0.0033, "AAJU": 0.0033, "IAEM": 0.0033, "TRON": 0.0033,
+	"ALOU": 0.0033, "SLAV": 0.0033, "TURC": 0.0033, "GELO": 0.0033, "ÃOVE": 0.0033,
+	"SCAS": 0.0033, "NAPR": 0.0033, "ADRO": 0.0033, "VEES": 0.0033, "SALG": 0.0033,
+	"ARIT": 0.0033, "CRIO": 0.0033, "IPAD": 0.0033, "LPOD": 0.0033, "AMSE": 0.0033,
+	"OOAR": 0.0033, "NTRR": 0.0033, "ÕESR": 0.0033, "TASA": 0.0033, "ENEC": 0.0033,
+	"AGRU": 0.0033, "DITA": 0.0033, "ELPA": 0.0033, "OAOE": 0.0033, "IONO": 0.0033,
+	"XACT": 0.0033, "FIRM": 0.0033, "AATR": 0.0033, "UNIC": 0.0033, "CALA": 0.0033,
+	"OIDD": 0.0033, "ELER": 0.0033, "EOBT": 0.0033, "INAÇ": 0.0033, "GADA": 0.0033,
+	"ATIO": 0.0033, "EMBU": 0.0033, "LVOS": 0.0033, "RAIM": 0.0033, "VERR": 0.0033,
+	"TTER": 0.0033, "OMNO": 0.0033, "SDAE": 0.0033, "SMAN": 0.0033, "EUMN": 0.0033,
+	"UGAR": 0.0033, "OMAD": 0.0033, "VEDE": 0.0033, "MUMD": 0.0033, "RSOB": 0.0033,
+	"MTEM": 0.0033, "UANO": 0.0033, "OMOR": 0.0033, "MAAP": 0.0033, "ONOP": 0.0033,
+	"IOIN": 0.0033, "ENOD": 0.0033, "RVAD": 0.0033, "AVAI": 0.0033, "TOAP": 0.0033,
+	"COLE": 0.0033, "DOSQ": 0.0033, "ÇOSD": 0.0033, "SNAL": 0.0033, "UEOC": 0.0033,
+	"ORCA": 0.0033, "EBDE": 0.0033, "AROL": 0.0033, "SETA": 0.0033, "ÉMEN": 0.0033,
+	"LOUS": 0.0033, "IMOP": 0.0033, "ELAP": 0.0033, "DEHI": 0.0033, "OSSA": 0.0033,
+	"ASOD": 0.0033, "GERS": 0.0033, "NEXÃ": 0.0033, "EXÃO": 0.0033, "NIQU": 0.0033,
+	"ENSD": 0.0032, "NAIN": 0.0032, "OICO": 0.0032, "AURA": 0.0032, "MDEP": 0.0032,
+	"OTOC": 0.0032, "IODA": 0.0032, "EJAO": 0.0032, "AOGR": 0.0032, "ULTE": 0.0032,
+	"ALER": 0.0032, "SEAL": 0.0032, "IATO": 0.0032, "ALSO": 0.0032, "DODU": 0.0032,
+	"IANÃ": 0.0032, "ITOE": 0.0032, "AAOL": 0.0032, "IOPO": 0.0032, "ENCA": 0.0032,
+	"EMDO": 0.0032, "OALO": 0.0032, "TALC": 0.0032, "NGES": 0.0032, "LACI": 0.0032,
+	"SNOR": 0.0032, "DACH": 0.0032, "ASID": 0.0032, "GEMN": 0.0032, "EGRE": 0.0032,
+	"SBIT": 0.0032, "OMSE": 0.0032, "IASI": 0.0032, "OVAC": 0.0032, "UELA": 0.0032,
+	"URCO": 0.0032, "OEMT": 0.0032, "REMS": 0.0032, "NODO": 0.0032, "ÉMPO": 0.0032,
+	"UMSC": 0.0032, "OOQU": 0.0032, "HOUV": 0.0032, "UVER": 0.0032, "EPEL": 0.0032,
+	"ONDA": 0.0032, "SAFO": 0.0032, "PKGI": 0.0032, "EHIS": 0.0032, "SASD": 0.0032,
+	"PELI": 0.0032, "RDAS": 0.0032, "ÍDAR": 0.0032, "TOOS": 0.0032, "NATR": 0.0032,
+	"CPOD": 0.0032, "SRIO": 0.0032, "OXZE": 0.0032, "SUSU": 0.0032, "IBEA": 0.0032,
+	"NSDE": 0.0031, "OESA": 0.0031, "SGOT": 0.0031, "TEÉU": 0.0031, "ÉCON": 0.0031,
+	"IROL": 0.0031, "ÇADE": 0.0031, "LATÓ": 0.0031, "PPAR": 0.0031, "EXAC": 0.0031,
+	"ORAI": 0.0031, "ANES": 0.0031, "IDDO": 0.0031, "LERD": 0.0031, "PIAR": 0.0031,
+	"RONT": 0.0031, "MPLÍ": 0.0031, "MDET": 0.0031, "HUMD": 0.0031, "ABAR": 0.0031,
+	"ARRO": 0.0031, "OINF": 0.0031, "UITA": 0.0031, "RIDE": 0.0031, "LAPA": 0.0031,
+	"OBAL": 0.0031, "MPER": 0.0031, "PERF": 0.0031, "ODOR": 0.0031, "ORVE": 0.0031,
+	"MCAB": 0.0031, "CAUM": 0.0031, "ÉUMN": 0.0031, "SERN": 0.0031, "MINT": 0.0031,
+	"MOSI": 0.0031, "UNÇÕ": 0.0031, "AOUM": 0.0031, "AOSA": 0.0031, "TUIÇ": 0.0031,
+	"RAOT": 0.0031, "NDOW": 0.0031, "SSUB": 0.0031, "UDAN": 0.0031, "AENC": 0.0031,
+	"TEOR": 0.0031, "OPOP": 0.0031, "SBAS": 0.0031, "ÃOOS": 0.0031, "HECO": 0.0031,
+	"SERT": 0.0031, "EMIB": 0.0031, "USPE": 0.0031, "POPO": 0.0031, "TUAÇ": 0.0031,
+	"ELOP": 0.0031, "DAAC": 0.0031, "EOSU": 0.0031, "STAF": 0.0031, "IVIL": 0.0031,
+	"USAC": 0.0031, "DOON": 0.0031, "SUSP": 0.0031, "VARO": 0.0031, "APTA": 0.0031,
+	"SBRU": 0.0031, "TULO": 0.0031, "OSVÁ": 0.0031, "HOIN": 0.0031, "MOIN": 0.0031,
+	"JADO": 0.0031, "AISF": 0.0031, "ELSE": 0.0031, "EGEX": 0.0031, "SDAL": 0.0031,
+	"NHAA": 0.0031, "IDSD": 0.0031, "AOAN": 0.0031, "SODA": 0.0031, "RATU": 0.0031,
+	"SSIS": 0.0031, "ELID": 0.0031, "CRON": 0.0031, "OOUA": 0.0031, "ASON": 0.0031,
+	"ESTD": 0.0031, "LATE": 0.0031, "UNSA": 0.0031, "HAEM": 0.0031, "COMF": 0.0031,
+	"TÃOD": 0.0031, "SOAS": 0.0031, "AVÉS": 0.0031, "DOLO": 0.0031, "DOOF": 0.0031,
+	"UMTI": 0.0031, "ERIM": 0.0031, "TROO": 0.0031, "ADOÉ": 0.0031, "ITSD": 0.0031,
+	"EAVA": 0.0031, "OONO": 0.0031, "DONU": 0.0031, "WAIT": 0.0031, "TRUT": 0.0031,
+	"AVEN": 0.0031, "AOTI": 0.0031, "UXOD": 0.0031, "ANOC": 0.0031, "ZIDA": 0.0031,
+	"RÁVE": 0.0031, "SATU": 0.0031, "EOND": 0.0031, "RALO": 0.0031, "IRAC": 0.0031,
+	"HÚNG": 0.0031, "ÚNGA": 0.0031, "NGAR": 0.0031, "OEMA": 0.0031, "EIGN": 0.0031,
+	"EOSD": 0.0031, "SEAP": 0.0031, "NAVE": 0.0031, "COMX": 0.0031, "OESS": 0.0031,
+	"DOTO": 0.0031, "NOSS": 0.0031, "DEVÁ": 0.0031, "COST": 0.0031, "TOAL": 0.0031,
+	"OUEM": 0.0031, "BUGS": 0.0031, "SAPÓ": 0.0031, "IADI": 0.0031, "OCPO": 0.0031,
+	"ARVO": 0.0031, "DUOD": 0.0031, "ASTH": 0.0031, "ROSQ": 0.0030, "CASP": 0.0030,
+	"RDIS": 0.0030, "RARD": 0.0030, "EOES": 0.0030, "ÉESP": 0.0030, "AETI": 0.0030,
+	"SAAP": 0.0030, "UIRA": 0.0030, "ÍTIC": 0.0030, "ONAU": 0.0030, "PNÃO": 0.0030,
+	"SFUN": 0.0030, "AMBA": 0.0030, "LOSI": 0.0030, "RFIL": 0.0030, "UIST": 0.0030,
+	"FORO": 0.0030, "VOEM": 0.0030, "CARS": 0.0030, "VIST": 0.0030, "UEVO": 0.0030,
+	"EUME": 0.0030, "OPON": 0.0030, "DETI": 0.0030, "OOPC": 0.0030, "EUMD": 0.0030,
+	"ITEP": 0.0030, "ODUR": 0.0030, "RPOD": 0.0030, "ROEX": 0.0030, "INGE": 0.0030,
+	"AOTE": 0.0030, "ELAE": 0.0030, "DASV": 0.0030, "NTAO": 0.0030, "EOVA": 0.0030,
+	"ANGU": 0.0030, "ANTA": 0.0030, "SLOV": 0.0030, "LOSC": 0.0030, "TRAP": 0.0030,
+	"GUAG": 0.0030, "UAGE": 0.0030, "ERSU": 0.0030, "SUSE": 0.0030, "CALE": 0.0030,
+	"IPLI": 0.0030, "UATR": 0.0030, "TEMS": 0.0030, "NEUM": 0.0030, "JARE": 0.0030,
+	"STAG": 0.0030, "VASE": 0.0030, "ESNE": 0.0030, "SSIO": 0.0030, "ÃOAS": 0.0030,
+	"RETI": 0.0030, "RRED": 0.0030, "PRÓX": 0.0030, "RÓXI": 0.0030, "ÓXIM": 0.0030,
+	"RSEC": 0.0030, "FAZC": 0.0030, "NHAÉ": 0.0030, "OQWE": 0.0030, "OLEG": 0.0030,
+	"TEAM": 0.0030, "CESL": 0.0030, "VVER": 0.0030, "PCPU": 0.0030, "OMOI": 0.0030,
+	"ESGO": 0.0030, "PREM": 0.0030, "SEOC": 0.0030, "ISOE": 0.0030, "AÚNI": 0.0030,
+	"LESC": 0.0030, "SDOC": 0.0030, "RDER": 0.0030, "ORRA": 0.0030, "NTAA": 0.0030,
+	"OSAM": 0.0030, "ATOP": 0.0030, "APAT": 0.0030, "NARU": 0.0030, "REFO": 0.0030,
+	"AEVI": 0.0030, "MOSU": 0.0030, "NORQ": 0.0030, "ESAD": 0.0030, "ASSW": 0.0030,
+	"EISE": 0.0030, "ÇAME": 0.0030, "COIS": 0.0030, "IVAA": 0.0030, "TOLI": 0.0030,
+	"ATTE": 0.0030, "UASV": 0.0030, "RÃOC": 0.0030, "ANDI": 0.0030, "MBAS": 0.0030,
+	"TDEF": 0.0030, "EJAU": 0.0030, "OMEO": 0.0030, "UMMA": 0.0030, "ÃOFI": 0.0030,
+	"CHAD": 0.0030, "TODI": 0.0030, "SAUM": 0.0030, "HUME": 0.0030, "RUTU": 0.0030,
+	"SFAZ": 0.0030, "ALNÃ": 0.0030, "HANO": 0.0030, "ENSO": 0.0030, "RASM": 0.0030,
+	"ONAA": 0.0030, "OLUM": 0.0030, "ROSL": 0.0030, "UNOM": 0.0030, "MIDA": 0.0030,
+	"IOSC": 0.0030, "MPOP": 0.0030, "EMUL": 0.0030, "ADEG": 0.0030, "OBAI": 0.0030,
+	"DAAL": 0.0030, "ANIC": 0.0030, "RIST": 0.0030, "UEIN": 0.0030, "SOSO": 0.0030,
+	"RSUP": 0.0030, "MIND": 0.0030, "OADM": 0.0030, "CAEM": 0.0030, "USCA": 0.0030,
+	"NFER": 0.0030, "INIB": 0.0030, "OHOR": 0.0030, "SNOC": 0.0030, "SHIF": 0.0030,
+	"NKSS": 0.0030, "AAMR": 0.0030, "SDEX": 0.0030, "MCAD": 0.0029, "ROAL": 0.0029,
+	"ÇÃOB": 0.0029, "AMUI": 0.0029, "ADAÉ": 0.0029, "ÕESG": 0.0029, "STDI": 0.0029,
+	"RREP": 0.0029, "ÃOÉI": 0.0029, "SAOC": 0.0029, "ETAN": 0.0029, "ZDES": 0.0029,
+	"GEND": 0.0029, "UMUT": 0.0029, "TINS": 0.0029, "OJÁE": 0.0029, "MUMT": 0.0029,
+	"OODI": 0.0029, "VISA": 0.0029, "SOUM": 0.0029, "TUIR": 0.0029, "ARIM": 0.0029,
+	"ÍDAA": 0.0029, "PODA": 0.0029, "TEFA": 0.0029, "EMAM": 0.0029, "TÁIN": 0.0029,
+	"ECÓD": 0.0029, "OTET": 0.0029, "LDEM": 0.0029, "SOTE": 0.0029, "HONÃ": 0.0029,
+	"EOBJ": 0.0029, "IDAM": 0.0029, "IMOC": 0.0029, "ANOA": 0.0029, "TÁFO": 0.0029,
+	"EMNÃ": 0.0029, "CESU": 0.0029, "ILDE": 0.0029, "DEFL": 0.0029, "OOUN": 0.0029,
+	"CTAL": 0.0029, "MBAR": 0.0029, "ALAN": 0.0029, "OMPC": 0.0029, "ADOW": 0.0029,
+	"CAPT": 0.0029, "ALOS": 0.0029, "IDOM": 0.0029, "UIRO": 0.0029, "DARO": 0.0029,
+	"QUIA": 0.0029, "EPAL": 0.0029, "ADAG": 0.0029, "FIAN": 0.0029, "TUAI": 0.0029,
+	"LEGÍ": 0.0029, "EGÍV": 0.0029, "IXON": 0.0029, "NAND": 0.0029, "RNCI": 0.0029,
+	"ADRE": 0.0029, "STEÉ": 0.0029, "ROEN": 0.0029, "AXED": 0.0029, "DORI": 0.0029,
+	"ABRE": 0.0029, "IPTA": 0.0029, "ENTÁ": 0.0029, "EPID": 0.0029, "ATAS": 0.0029,
+	"EUSE": 0.0029, "ADOB": 0.0029, "RMEM": 0.0029, "EFLU": 0.0029, "TAVE": 0.0029,
+	"PONÃ": 0.0029, "RAEV": 0.0029, "SDOI": 0.0029, "RACE": 0.0029, "PADO": 0.0029,
+	"NCOD": 0.0029, "ALEL": 0.0029, "LTAM": 0.0029, "TEAR": 0.0029, "PATT": 0.0029,
+	"ÃOVA": 0.0029, "OSÍM": 0.0029, "PRÓP": 0.0029, "RÓPR": 0.0029, "ÓPRI": 0.0029,
+	"ADEH": 0.0029, "RTAB": 0.0029, "SUBP": 0.0029, "TATE": 0.0029, "LEXE": 0.0029,
+	"ATAO": 0.0029, "DROM": 0.0029, "ADOV": 0.0029, "LODO": 0.0029, "ALAÇ": 0.0029,
+	"SOAN": 0.0029, "SDAA": 0.0029, "MORY": 0.0029, "ASFI": 0.0029, "OSBY": 0.0029,
+	"VENO": 0.0029, "SACA": 0.0029, "DASM": 0.0029, "ENOC": 0.0029, "TAAR": 0.0029,
+	"ASEU": 0.0029, "PLOP": 0.0029, "CONO": 0.0029, "VOAR": 0.0029, "SSEJ": 0.0029,
+	"ESON": 0.0029, "USEN": 0.0029, "QUAT": 0.0029, "OTEO": 0.0029, "IRAM": 0.0029,
+	"RÃOO": 0.0029, "ÃOAO": 0.0029, "OSOM": 0.0029, "SAES": 0.0029, "AOST": 0.0029,
+	"HOES": 0.0029, "UESA": 0.0029, "HARA": 0.0029, "OCÊD": 0.0029, "CÊDE": 0.0029,
+	"ETID": 0.0029, "WARC": 0.0029, "OWAR": 0.0029, "OUOC": 0.0029, "MAOA": 0.0029,
+	"OEMD": 0.0029, "MSEC": 0.0029, "EOCA": 0.0029, "HIFT": 0.0029, "VOSM": 0.0029,
+	"TÉME": 0.0029, "FERN": 0.0029, "PRPR": 0.0029, "EXZE": 0.0029, "ROBC": 0.0029,
+	"OBCJ": 0.0029, "SEOU": 0.0028, "CREM": 0.0028, "ÃOIG": 0.0028, "NTIA": 0.0028,
+	"SVEJ": 0.0028, "OLTA": 0.0028, "MOOS": 0.0028, "NIRP": 0.0028, "SOUA": 0.0028,
+	"VEQU": 0.0028, "EUMS": 0.0028, "EOSI": 0.0028, "ENHO": 0.0028, "RARI": 0.0028,
+	"SOSI": 0.0028, "ICOC": 0.0028, "CARM": 0.0028, "MACE": 0.0028, "DORC": 0.0028,
+	"ENES": 0.0028, "MASF": 0.0028, "TRAÇ": 0.0028, "STÁP": 0.0028, "OFEC": 0.0028,
+	"OSAI": 0.0028, "ASNE": 0.0028, "TAFO": 0.0028, "RÃOI": 0.0028, "NTEV": 0.0028,
+	"QUAD": 0.0028, "RRIG": 0.0028, "INGI": 0.0028, "USEJ": 0.0028, "EVEU": 0.0028,
+	"RIAL": 0.0028, "ECAN": 0.0028, "LSEM": 0.0028, "IGNA": 0.0028, "NCAT": 0.0028,
+	"DENC": 0.0028, "SNOD": 0.0028, "DOWS": 0.0028, "TASP": 0.0028, "VOOU": 0.0028,
+	"VOLU": 0.0028, "EBID": 0.0028, "ITOU": 0.0028, "SAMI": 0.0028, "LTOS": 0.0028,
+	"IVOR": 0.0028, "ROFU": 0.0028, "EAVI": 0.0028, "BPAR": 0.0028, "EMOU": 0.0028,
+	"EOSC": 0.0028, "ASAU": 0.0028, "AOSD": 0.0028, "TRÁR": 0.0028, "OLAD": 0.0028,
+	"ONLY": 0.0028, "ESLA": 0.0028, "SSAO": 0.0028, "ROAP": 0.0028, "MZER": 0.0028,
+	"EVEO": 0.0028, "TIRE": 0.0028, "ATIS": 0.0028, "TRÊS": 0.0028, "SRÁP": 0.0028,
+	"ÉMOS": 0.0028, "SOAL": 0.0028, "NCRE": 0.0028, "CAON": 0.0028, "ATOV": 0.0028,
+}
+
+var chainBigramsPT = map[string]float64{
+	"DE": 2.3323, "ES": 1.9815, "AD": 1.6527, "DO": 1.6309, "AR": 1.5804,
+	"OS": 1.5702, "RA": 1.4476, "CO": 1.4304, "ER": 1.4226, "TE": 1.3615,
+	"RE": 1.2657, "EN": 1.1865, "OR": 1.1816, "OD": 1.1524, "IN": 1.1098,
+	"NT": 1.0822, "TA": 1.0758, "MA": 1.0436, "AS": 1.0407, "OM": 1.0398,
+	"SE": 1.0168, "ON": 0.9928, "ÃO": 0.9911, "AL": 0.9866, "PA": 0.9641,
+	"RO": 0.9298, "DA": 0.9094, "EM": 0.9080, "ME": 0.8780, "EC": 0.8770,
+	"ST": 0.8616, "PO": 0.8510, "CA": 0.7935, "RI": 0.7919, "IC": 0.7751,
+	"LI": 0.7622, "AN": 0.7484, "FI": 0.7235, "QU": 0.7043, "OP": 0.6882,
+	"TO": 0.6691, "AC": 0.6591, "VE": 0.6271, "OC": 0.6242, "IS": 0.6111,
+	"SA": 0.6076, "UM": 0.5985, "NO": 0.5963, "TI": 0.5962, "SS": 0.5918,
+	"ID": 0.5642, "AM": 0.5543, "MP": 0.5422, "IR": 0.5396, "EL": 0.5210,
+	"SO": 0.5210, "SP": 0.5172, "TR": 0.5135, "ED": 0.5091, "SD": 0.5082,
+	"NA": 0.4908, "EI": 0.4790, "IO": 0.4744, "ND": 0.4735, "CI": 0.4700,
+	"PE": 0.4679, "MO": 0.4641, "PR": 0.4617, "IA": 0.4593, "AP": 0.4557,
+	"AO": 0.4468, "DI": 0.4413, "LO": 0.4344, "OA": 0.4298, "AT": 0.4291,
+	"EA": 0.4199, "ÇÃ": 0.4156, "IT": 0.4076, "UI": 0.4067, "SC": 0.3983,
+	"OU": 0.3955, "FO": 0.3902, "EP": 0.3837, "OT": 0.3827, "SI": 0.3780,
+	"LA": 0.3655, "IM": 0.3654, "IV": 0.3636, "ET": 0.3560, "UE": 0.3543,
+	"OE": 0.3527, "EF": 0.3509, "VO": 0.3468, "AI": 0.3373, "NÃ": 0.3292,
+	"CH": 0.3268, "US": 0.3221, "HE": 0.3205, "HA": 0.3115, "EX": 0.3104,
+	"AÇ": 0.3096, "LE": 0.3054, "CT": 0.3045, "OF": 0.3022, "NH": 0.2946,
+	"RM": 0.2911, "MI": 0.2895, "AA": 0.2876, "NI": 0.2872, "OI": 0.2860,
+	"VA": 0.2843, "SU": 0.2818, "IL": 0.2812, "CE": 0.2799, "NE": 0.2697,
+	"OL": 0.2674, "UA": 0.2625, "RQ": 0.2567, "RT": 0.2553, "RR": 0.2533,
+	"NC": 0.2520, "EE": 0.2493, "GU": 0.2359, "UT": 0.2348, "IF": 0.2294,
+	"UR": 0.2289, "AV": 0.2273, "AE": 0.2268, "EO": 0.2236, "OO": 0.2225,
+	"IG": 0.2170, "RU": 0.2110, "HO": 0.2067, "RD": 0.2035, "LT": 0.2016,
+	"RS": 0.2006, "NS": 0.2005, "EV": 0.1945, "EG": 0.1931, "TU": 0.1908,
+	"FA": 0.1839, "LH": 0.1786, "EU": 0.1770, "AU": 0.1757, "GE": 0.1745,
+	"OB": 0.1726, "OV": 0.1704, "MB": 0.1697, "IP": 0.1696, "AB": 0.1688,
+	"UN": 0.1687, "VI": 0.1676, "CR": 0.1661, "UP": 0.1626, "ÕE": 0.1570,
+	"BI": 0.1544, "RN": 0.1539, "SN": 0.1503, "IZ": 0.1500, "NF": 0.1490,
+	"ZA": 0.1478, "IB": 0.1445, "RC": 0.1440, "ÍV": 0.1423, "GR": 0.1396,
+	"CU": 0.1393, "SÃ": 0.1365, "PL": 0.1355, "SM": 0.1346, "UL": 0.1325,
+	"SÍ": 0.1323, "ÇÕ": 0.1308, "MU": 0.1299, "AG": 0.1235, "NV": 0.1232,
+	"RP": 0.1208, "GA": 0.1190, "FE": 0.1174, "ÁR": 0.1127, "EB": 0.1106,
+	"BL": 0.1104, "MD": 0.1097, "OG": 0.1083, "PÇ": 0.1079, "PI": 0.1064,
+	"MS": 0.1058, "AF": 0.1048, "BA": 0.1046, "OÉ": 0.1034, "GI": 0.1031,
+	"LD": 0.1006, "LU": 0.0993, "VÁ": 0.0970, "RG": 0.0962, "BR": 0.0953,
+	"ÁL": 0.0951, "BE": 0.0941, "EQ": 0.0931, "PT": 0.0897, "ÓR": 0.0889,
+	"OQ": 0.0879, "SL": 0.0873, "NG": 0.0871, "SQ": 0.0860, "XI": 0.0853,
+	"BO": 0.0851, "DR": 0.0842, "XE": 0.0842, "NU": 0.0838, "MC": 0.0837,
+	"SF": 0.0818, "SV": 0.0794, "RÁ": 0.0784, "CL": 0.0774, "AZ": 0.0767,
+	"ÍD": 0.0763, "BU": 0.0755, "ZE": 0.0753, "RÃ": 0.0749, "IE": 0.0738,
+	"GO": 0.0725, "XZ": 0.0722, "DU": 0.0720, "DP": 0.0715, "RV": 0.0706,
+	"RF": 0.0694, "ÇA": 0.0689, "GN": 0.0687, "UD": 0.0681, "AQ": 0.0677,
+	"LL": 0.0673, "EÇ": 0.0665, "PK": 0.0663, "IÇ": 0.0654, "NÚ": 0.0641,
+	"LM": 0.0640, "KG": 0.0632, "JA": 0.0627, "AÍ": 0.0616, "XT": 0.0610,
+	"TÁ": 0.0609, "SR": 0.0604, "EJ": 0.0604, "ÚM": 0.0581, "SH": 0.0577,
+	"MT": 0.0570, "ÉM": 0.0562, "MF": 0.0558, "IX": 0.0550, "MN": 0.0544,
+	"TH": 0.0532, "TÓ": 0.0519, "LC": 0.0516, "LS": 0.0513, "XO": 0.0511,
+	"XP": 0.0509, "HU": 0.0502, "MV": 0.0488, "SB": 0.0486, "EZ": 0.0464,
+	"TS": 0.0463, "ÊN": 0.0463, "CK": 0.0462, "ÁV": 0.0458, "ZI": 0.0453,
+	"ÇO": 0.0452, "NÇ": 0.0448, "YT": 0.0441, "MM": 0.0440, "ÊS": 0.0437,
+	"FL": 0.0436, "FU": 0.0435, "RL": 0.0435, "UB": 0.0434, "BY": 0.0433,
+	"LP": 0.0428, "ML": 0.0424, "BM": 0.0422, "BS": 0.0420, "KE": 0.0411,
+	"XA": 0.0404, "MÓ": 0.0393, "ÉU": 0.0393, "LV": 0.0390, "DS": 0.0382,
+	"PU": 0.0380, "MÁ": 0.0375, "HI": 0.0374, "TP": 0.0372, "UC": 0.0370,
+	"LG": 0.0366, "TD": 0.0363, "UF": 0.0357, "DD": 0.0354, "NÍ": 0.0353,
+	"JU": 0.0352, "ZD": 0.0350, "CÇ": 0.0347, "LZ": 0.0346, "BT": 0.0336,
+	"TC": 0.0333, "OX": 0.0330, "TÉ": 0.0320, "OW": 0.0313, "ÚB": 0.0310,
+	"PS": 0.0308, "TT": 0.0308, "WA": 0.0305, "PÚ": 0.0305, "ÍM": 0.0302,
+	"XC": 0.0301, "FF": 0.0298, "MR": 0.0295, "TM": 0.0291, "OH": 0.0289,
+	"VR": 0.0286, "UG": 0.0285, "UO": 0.0285, "ÚL": 0.0281, "AX": 0.0277,
+	"UX": 0.0272, "HR": 0.0266, "FR": 0.0266, "ZM": 0.0262, "MQ": 0.0261,
+	"RB": 0.0261, "GS": 0.0260, "NK": 0.0254, "SÁ": 0.0254, "NÁ": 0.0254,
+	"EÉ": 0.0253, "LF": 0.0252, "LQ": 0.0246, "AK": 0.0244, "ÓS": 0.0244,
+	"ÉP": 0.0242, "LN": 0.0239, "DN": 0.0238, "ÍN": 0.0237, "DÊ": 0.0235,
+	"SG": 0.0229, "PC": 0.0228, "AÉ": 0.0228, "AJ": 0.0227, "MÉ": 0.0225,
+	"IÁ": 0.0223, "TY": 0.0222, "JE": 0.0221, "CÊ": 0.0215, "UÁ": 0.0213,
+	"KA": 0.0209, "BÉ": 0.0206, "EH": 0.0205, "UÇ": 0.0200, "KS": 0.0200,
+	"PÓ": 0.0199, "ÓD": 0.0199, "TL": 0.0198, "ÉR": 0.0197, "IQ": 0.0197,
+	"TÃ": 0.0197, "UZ": 0.0196, "ÉS": 0.0195, "CÓ": 0.0194, "ÉN": 0.0194,
+	"ÚN": 0.0193, "CC": 0.0192, "CP": 0.0187, "GP": 0.0187, "OK": 0.0187,
+	"GL": 0.0186, "ÁE": 0.0179, "NR": 0.0179, "RÊ": 0.0179, "KI": 0.0178,
+	"ÓL": 0.0177, "CD": 0.0177, "PP": 0.0169, "LÊ": 0.0167, "ÁC": 0.0167,
+	"FT": 0.0167, "SÓ": 0.0166, "ÍC": 0.0166, "SÕ": 0.0163, "ÁU": 0.0161,
+	"ÂM": 0.0161, "AH": 0.0160, "ÁS": 0.0159, "NN": 0.0157, "SÉ": 0.0156,
+	"ÉE": 0.0154, "TN": 0.0153, "SY": 0.0150, "TF": 0.0150, "BÓ": 0.0150,
+	"NP": 0.0150, "ÁX": 0.0149, "MÍ": 0.0149, "ÉD": 0.0148, "MG": 0.0148,
+}
+
+var quadgramsDE = map[string]float64{
+	"ICHT": 0.3666, "EINE": 0.3641, "DATE": 0.3601, "ENDE": 0.3375, "NICH": 0.3149,
+	"ATEI": 0.2968, "TION": 0.2922, "RDEN": 0.2874, "ERDE": 0.2855, "ISCH": 0.2662,
+	"NDER": 0.2645, "EICH": 0.2616, "ICHE": 0.2512, "WERD": 0.2298, "DIES": 0.2274,
+	"WIRD": 0.2043, "IERT": 0.2024, "NDEN": 0.1984, "FEHL": 0.1972, "CHEN": 0.1922,
+	"EBEN": 0.1868, "NDIE": 0.1657, "SCHE": 0.1569, "ANGE": 0.1562, "LICH": 0.1539,
+	"EREN": 0.1539, "ERWE": 0.1537, "KANN": 0.1514, "GEBE": 0.1456, "IESE": 0.1443,
+	"TEIN": 0.1406, "ODER": 0.1401, "TWER": 0.1399, "INDE": 0.1391, "OPTI": 0.1343,
+	"SCHL": 0.1341, "PTIO": 0.1341, "ERST": 0.1325, "NTER": 0.1312, "CHER": 0.1279,
+	"SION": 0.1276, "ZEIC": 0.1274, "VERS": 0.1269, "NGEN": 0.1265, "ENSI": 0.1260,
+	"KOMP": 0.1259, "NAME": 0.1241, "ERTE": 0.1208, "KEIN": 0.1198, "IONE": 0.1184,
+	"ERUN": 0.1177, "ONEN": 0.1163, "VERW": 0.1162, "WEND": 0.1159, "TELL": 0.1158,
+	"ERZE": 0.1156, "STEL": 0.1148, "EHLE": 0.1125, "NSIE": 0.1113, "TDIE": 0.1102,
+	"UNGE": 0.1097, "OMPR": 0.1086, "ENDI": 0.1070, "AKET": 0.1069, "IERE": 0.1066,
+	"PAKE": 0.1064, "ATIO": 0.1063, "GABE": 0.1035, "ENWE": 0.1035, "ESCH": 0.1029,
+	"AUSG": 0.1028, "NEIN": 0.1028, "RUNG": 0.1016, "SEIN": 0.1012, "EDAT": 0.1010,
+	"EITE": 0.1005, "RWEN": 0.1004, "GEGE": 0.1004, "NUTZ": 0.1000, "ORMA": 0.0997,
+	"EREI": 0.0997, "REIN": 0.0993, "NNTE": 0.0985, "ERBE": 0.0983, "EGEB": 0.0983,
+	"FORM": 0.0973, "UNTE": 0.0968, "ALTE": 0.0968, "SETZ": 0.0956, "RZEI": 0.0940,
+	"ELLE": 0.0939, "BENU": 0.0939, "TEIE": 0.0932, "ENDA": 0.0927, "HLER": 0.0923,
+	"ERSI": 0.0919, "ENUT": 0.0916, "ISTE": 0.0908, "EVER": 0.0892, "EDER": 0.0880,
+	"CHNI": 0.0875, "INST": 0.0871, "RMAT": 0.0865, "EIEN": 0.0854, "EINS": 0.0851,
+	"CHTE": 0.0851, "DERE": 0.0837, "EILE": 0.0833, "RSCH": 0.0829, "TERS": 0.0828,
+	"TIGE": 0.0825, "NNIC": 0.0821, "SCHR": 0.0813, "WERT": 0.0811, "ENBE": 0.0811,
+	"IGEN": 0.0804, "NWER": 0.0801, "RESS": 0.0790, "UNGS": 0.0789, "ICHN": 0.0787,
+	"NVER": 0.0783, "NDET": 0.0782, "LTIG": 0.0773, "FALL": 0.0766, "ENAU": 0.0765,
+	"NUND": 0.0765, "URDE": 0.0764, "RBEI": 0.0763, "WENN": 0.0756, "TAND": 0.0752,
+	"LLEN": 0.0750, "ZEIL": 0.0748, "GÜLT": 0.0741, "ÜLTI": 0.0741, "ENUN": 0.0739,
+	"NGEG": 0.0739, "IONS": 0.0738, "ERSC": 0.0737, "SSEL": 0.0737, "EEIN": 0.0734,
+	"RSIO": 0.0731, "EDIE": 0.0730, "ENVO": 0.0729, "STAN": 0.0727, "ANDE": 0.0727,
+	"LTEN": 0.0723, "WURD": 0.0714, "LIST": 0.0709, "DERD": 0.0706, "ANDA": 0.0705,
+	"HALT": 0.0705, "EFEH": 0.0701, "ESEN": 0.0699, "SIND": 0.0699, "SSEN": 0.0699,
+	"ÜBER": 0.0699, "ÜSSE": 0.0695, "BERE": 0.0694, "UTZE": 0.0689, "DERS": 0.0684,
+	"ATEN": 0.0680, "MENT": 0.0680, "ALLS": 0.0670, "DASS": 0.0669, "NDAR": 0.0669,
+	"ETZT": 0.0665, "INEN": 0.0661, "CHES": 0.0658, "BEIM": 0.0658, "ZEIG": 0.0656,
+	"RVER": 0.0656, "AMEN": 0.0655, "ALLE": 0.0645, "DENS": 0.0640, "TENS": 0.0639,
+	"ERDA": 0.0639, "RDIE": 0.0637, "GEND": 0.0637, "VERZ": 0.0633, "NSTA": 0.0633,
+	"NVON": 0.0632, "DIEA": 0.0630, "NDAT": 0.0630, "LUNG": 0.0627, "ENIN": 0.0625,
+	"TIER": 0.0618, "HNIS": 0.0618, "ENZU": 0.0617, "URCH": 0.0617, "TEND": 0.0616,
+	"DURC": 0.0616, "NTEN": 0.0615, "GESC": 0.0615, "SPEI": 0.0614, "TWIR": 0.0613,
+	"EING": 0.0613, "PEIC": 0.0612, "DEND": 0.0609, "RSTE": 0.0609, "LÜSS": 0.0608,
+	"NDES": 0.0607, "NGÜL": 0.0605, "CHLÜ": 0.0605, "PASS": 0.0604, "HLÜS": 0.0604,
+	"ESSI": 0.0604, "PRES": 0.0604, "RDAT": 0.0598, "MATI": 0.0591, "MPRE": 0.0588,
+	"NACH": 0.0586, "ENEN": 0.0586, "UELL": 0.0585, "UNGÜ": 0.0583, "INER": 0.0582,
+	"LTER": 0.0580, "DARD": 0.0580, "NZEI": 0.0579, "UNDE": 0.0575, "IEDE": 0.0575,
+	"ENIC": 0.0574, "EZEI": 0.0574, "DERN": 0.0574, "SDIE": 0.0573, "EKOM": 0.0570,
+	"DERA": 0.0569, "EOPT": 0.0568, "BEFE": 0.0566, "ESSE": 0.0561, "EIGE": 0.0561,
+	"FOLG": 0.0560, "EAUS": 0.0558, "ERVE": 0.0556, "ERGE": 0.0556, "TZEN": 0.0555,
+	"DIED": 0.0553, "DPKG": 0.0550, "NSTE": 0.0545, "MIER": 0.0544, "SICH": 0.0542,
+	"NFOR": 0.0537, "SVER": 0.0535, "USGE": 0.0532, "NNEN": 0.0532, "IMIE": 0.0528,
+	"STEM": 0.0527, "TVER": 0.0524, "ONNT": 0.0518, "KONN": 0.0517, "SSIO": 0.0517,
+	"RTEN": 0.0514, "ENAN": 0.0511, "STEN": 0.0507, "NAUS": 0.0507, "STAT": 0.0507,
+	"ERNE": 0.0506, "INFO": 0.0506, "PRIM": 0.0504, "ACHE": 0.0503, "FÜHR": 0.0499,
+	"EITS": 0.0496, "BENE": 0.0493, "MITD": 0.0492, "CHTA": 0.0488, "TZER": 0.0488,
+	"WEIS": 0.0488, "LLUN": 0.0486, "ZAHL": 0.0485, "MPRI": 0.0485, "RIMI": 0.0484,
+	"ARTE": 0.0482, "SGAB": 0.0482, "INEM": 0.0482, "REND": 0.0481, "USGA": 0.0479,
+	"NWIR": 0.0473, "ERTW": 0.0473, "SYST": 0.0473, "YSTE": 0.0473, "UNGD": 0.0471,
+	"AUFD": 0.0471, "TNIC": 0.0471, "HREN": 0.0469, "FERN": 0.0467, "ITTE": 0.0466,
+	"ENST": 0.0466, "EINA": 0.0463, "DERT": 0.0463, "MMEN": 0.0462, "NUNG": 0.0461,
+	"CHRE": 0.0461, "ENVE": 0.0461, "ANNN": 0.0460, "TDER": 0.0459, "DUNG": 0.0455,
+	"ITDE": 0.0455, "RDER": 0.0454, "LESE": 0.0453, "TALL": 0.0450, "ELLU": 0.0450,
+	"SIER": 0.0450, "EIDE": 0.0449, "BERS": 0.0448, "ENGE": 0.0447, "MODU": 0.0446,
+	"HREI": 0.0446, "ITER": 0.0445, "ILEN": 0.0445, "SCHA": 0.0444, "INGE": 0.0444,
+	"RENZ": 0.0443, "ERAU": 0.0441, "IEBE": 0.0441, "TDAS": 0.0441, "ERHA": 0.0438,
+	"ERNA": 0.0437, "ENEI": 0.0437, "ERSE": 0.0435, "ASSE": 0.0435, "NISC": 0.0435,
+	"AKTI": 0.0435, "TENI": 0.0435, "IRDD": 0.0433, "SIED": 0.0433, "AUSD": 0.0432,
+	"UNDD": 0.0430, "ONFI": 0.0430, "TIST": 0.0428, "INES": 0.0428, "IERU": 0.0427,
+	"IEDA": 0.0427, "ZUNG": 0.0427, "GRÖẞ": 0.0426, "NDAS": 0.0425, "ENWI": 0.0424,
+	"NNNI": 0.0423, "ONDE": 0.0421, "ANNT": 0.0421, "NODE": 0.0419, "EVON": 0.0419,
+	"REIB": 0.0419, "GESE": 0.0418, "TSCH": 0.0416, "PROZ": 0.0416, "RÖẞE": 0.0415,
+	"NIST": 0.0414, "ROZE": 0.0413, "BENW": 0.0413, "ENTF": 0.0413, "AUCH": 0.0411,
+	"FÜRD": 0.0409, "DDIE": 0.0407, "UMEN": 0.0406, "INDI": 0.0406, "ÄNDE": 0.0405,
+	"ALIS": 0.0405, "NFIG": 0.0405, "TUND": 0.0404, "DENE": 0.0400, "ERWA": 0.0398,
+	"ARBE": 0.0398, "TUNG": 0.0396, "RECH": 0.0396, "USER": 0.0394, "TISC": 0.0393,
+	"EKAN": 0.0393, "NMIT": 0.0393, "WEIT": 0.0392, "ESET": 0.0391, "KONF": 0.0391,
+	"AGEN": 0.0390, "MEHR": 0.0390, "KETE": 0.0390, "ESTE": 0.0390, "GLIC": 0.0390,
+	"ASSW": 0.0389, "IGER": 0.0389, "INTE": 0.0389, "STAL": 0.0388, "SCHI": 0.0386,
+	"NSCH": 0.0386, "ERTI": 0.0385, "AKTU": 0.0385, "CHTG": 0.0384, "TFER": 0.0384,
+	"EIBE": 0.0383, "OLGE": 0.0383, "ESIS": 0.0382, "EIND": 0.0382, "NTFE": 0.0380,
+	"ERAN": 0.0380, "DERU": 0.0380, "SEIT": 0.0380, "ARCH": 0.0379, "OZES": 0.0379,
+	"ZESS": 0.0379, "BEIT": 0.0377, "IGUR": 0.0377, "STER": 0.0376, "NGDE": 0.0376,
+	"ABER": 0.0375, "REIT": 0.0375, "VOND": 0.0375, "ESTA": 0.0374, "FIGU": 0.0374,
+	"RICH": 0.0373, "RWEI": 0.0373, "COMM": 0.0373, "ERFO": 0.0372, "EIST": 0.0371,
+	"TERN": 0.0371, "ENTH": 0.0371, "NGAB": 0.0370, "RATI": 0.0369, "EISE": 0.0368,
+	"ZEIT": 0.0368, "NWEN": 0.0367, "SIST": 0.0367, "GIBT": 0.0367, "ESER": 0.0367,
+	"EINF": 0.0367, "DEKO": 0.0366, "REIC": 0.0366, "RIER": 0.0365, "ISTD": 0.0365,
+	"ISIE": 0.0364, "KÖNN": 0.0364, "RCHI": 0.0364, "CHLA": 0.0361, "ERLA": 0.0357,
+	"DENA": 0.0357, "STÜT": 0.0356, "TÜTZ": 0.0356, "SEND": 0.0356, "OGRA": 0.0356,
+	"ENTE": 0.0355, "NDUN": 0.0355, "RSTÜ": 0.0355, "DIEV": 0.0355, "HTGE": 0.0353,
+	"NEND": 0.0353, "CHEI": 0.0352, "ERAL": 0.0352, "ORDE": 0.0352, "RTET": 0.0352,
+	"SIGN": 0.0351, "FELD": 0.0351, "KTIO": 0.0350, "DERB": 0.0350, "ENSP": 0.0349,
+	"LAGE": 0.0347, "LERB": 0.0347, "CHRI": 0.0346, "EINT": 0.0346, "IONA": 0.0345,
+	"DEIN": 0.0344, "SUCH": 0.0343, "LEIC": 0.0343, "PROG": 0.0343, "DERZ": 0.0343,
+	"GRAM": 0.0342, "HEND": 0.0342, "ROGR": 0.0341, "NEUE": 0.0340, "ISTI": 0.0340,
+	"SDAT": 0.0340, "RAUS": 0.0340, "SOLL": 0.0339, "ODUS": 0.0339, "ERIN": 0.0338,
+	"IEDI": 0.0338, "RTWE": 0.0337, "LISI": 0.0334, "MMER": 0.0334, "ENZE": 0.0334,
+	"EGEN": 0.0333, "ELLT": 0.0332, "BEID": 0.0331, "ANZE": 0.0331, "MEIN": 0.0329,
+	"ENER": 0.0328, "LIER": 0.0328, "RAMM": 0.0327, "DERV": 0.0325, "HTEN": 0.0324,
+	"MMIT": 0.0323, "CHTI": 0.0323, "ERRE": 0.0321, "TZUN": 0.0321, "ITEN": 0.0321,
+	"ENNI": 0.0320, "EDES": 0.0320, "ETZE": 0.0320, "RACH": 0.0320, "LDER": 0.0319,
+	"IESI": 0.0319, "DIEG": 0.0319, "LLTE": 0.0318, "NIND": 0.0317, "AMME": 0.0317,
+	"AUFG": 0.0316, "QUEL": 0.0316, "KTIV": 0.0315, "VERB": 0.0314, "NGES": 0.0314,
+	"SWOR": 0.0313, "EFUN": 0.0313, "EIGT": 0.0313, "SDER": 0.0313, "LEER": 0.0312,
+	"ABLE": 0.0312, "RÜCK": 0.0311, "GLEI": 0.0311, "EWER": 0.0310, "INEI": 0.0310,
+	"INGA": 0.0309, "TZTE": 0.0309, "BEKA": 0.0309, "ENPA": 0.0309, "ERDI": 0.0309,
+	"DIEE": 0.0309, "IONI": 0.0308, "SSWO": 0.0308, "ERER": 0.0308, "EVOR": 0.0307,
+	"READ": 0.0306, "TLIC": 0.0306, "ETWE": 0.0305, "ZEUG": 0.0305, "HAND": 0.0305,
+	"GENE": 0.0304, "LGEN": 0.0303, "ENDU": 0.0302, "ENSE": 0.0301, "ERMI": 0.0301,
+	"DENW": 0.0301, "SISC": 0.0301, "ÜTZT": 0.0301, "LEND": 0.0301, "TEIL": 0.0300,
+	"EMIT": 0.0299, "NKOM": 0.0299, "UFEN": 0.0299, "RGUM": 0.0299, "GUME": 0.0299,
+	"EAUF": 0.0299, "NAUF": 0.0298, "ERPR": 0.0298, "VORG": 0.0298, "ARGU": 0.0298,
+	"IDER": 0.0297, "FUND": 0.0296, "GRUP": 0.0295, "RUPP": 0.0295, "SZEI": 0.0294,
+	"ERTD": 0.0294, "NIER": 0.0294, "NENT": 0.0293, "RHAL": 0.0292, "ESPA": 0.0291,
+	"ENTI": 0.0291, "ENMI": 0.0290, "ENUM": 0.0290, "EITD": 0.0290, "TERE": 0.0290,
+	"ENND": 0.0289, "IBEN": 0.0289, "ABEN": 0.0289, "TENB": 0.0289, "VERF": 0.0289,
+	"HLAG": 0.0288, "BYTE": 0.0288, "UPPE": 0.0288, "TENA": 0.0288, "ERKO": 0.0287,
+	"RTER": 0.0287, "ERZU": 0.0287, "GIST": 0.0286, "EINI": 0.0286, "WART": 0.0285,
+	"HEIN": 0.0285, "LISC": 0.0285, "CHAN": 0.0284, "TKEI": 0.0284, "IEAU": 0.0284,
+	"URAT": 0.0284, "ENNE": 0.0284, "ENNU": 0.0283, "GURA": 0.0283, "NDDE": 0.0282,
+	"UFGE": 0.0282, "BEIS": 0.0282, "TZTW": 0.0281, "KEIT": 0.0280, "DERL": 0.0280,
+	"SPIE": 0.0280, "VIER": 0.0280, "STIM": 0.0279, "WORT": 0.0278, "UNKT": 0.0278,
+	"NKAN": 0.0278, "ILTE": 0.0277, "NNDI": 0.0277, "ENSO": 0.0276, "KOMM": 0.0276,
+	"TIVE": 0.0275, "DESS": 0.0274, "ENIS": 0.0274, "DEUT": 0.0274, "CHTU": 0.0272,
+	"AUSF": 0.0272, "DIEO": 0.0271, "LANG": 0.0271, "UMME": 0.0271, "DIEB": 0.0270,
+	"OMMI": 0.0270, "DERG": 0.0269, "HENS": 0.0269, "ETTE": 0.0269, "EEND": 0.0268,
+	"NPAK": 0.0268, "TAUF": 0.0268, "DIEN": 0.0268, "ELDE": 0.0268, "TDEN": 0.0267,
+	"TAUS": 0.0267, "LGES": 0.0266, "IBMI": 0.0266, "DETW": 0.0266, "NZAH": 0.0266,
+	"GENA": 0.0265, "IEIN": 0.0265, "RLAU": 0.0265, "NENA": 0.0265, "EUER": 0.0264,
+	"ISTN": 0.0264, "EINZ": 0.0264, "ACHT": 0.0263, "HREA": 0.0263, "BMIB": 0.0263,
+	"ÖNNE": 0.0263, "DERF": 0.0262, "IEEI": 0.0262, "TIMM": 0.0262, "TETE": 0.0262,
+	"BEST": 0.0262, "STEI": 0.0261, "INZU": 0.0260, "DERK": 0.0260, "LLIE": 0.0260,
+	"CHAL": 0.0259, "FILT": 0.0259, "ANZA": 0.0259, "HLGE": 0.0259, "GEFU": 0.0259,
+	"TMIT": 0.0259, "UNBE": 0.0259, "ZIEL": 0.0259, "EMEN": 0.0259, "MITE": 0.0259,
+	"NVOR": 0.0258, "SPRA": 0.0258, "ORIE": 0.0258, "VARI": 0.0258, "PRAC": 0.0258,
+	"SERV": 0.0257, "THRE": 0.0257, "GREN": 0.0257, "ÜRDI": 0.0256, "RZEU": 0.0255,
+	"EHEN": 0.0255, "CHDE": 0.0255, "XIST": 0.0254, "EHLG": 0.0254, "UNGA": 0.0253,
+	"ALLI": 0.0253, "PRÜF": 0.0253, "DENI": 0.0253, "EWIR": 0.0253, "HTER": 0.0252,
+	"LAUB": 0.0252, "EANG": 0.0252, "NBEN": 0.0252, "IOND": 0.0251, "MIBM": 0.0251,
+	"RNUN": 0.0250, "SPRO": 0.0250, "TGES": 0.0250, "STAR": 0.0250, "ETEN": 0.0250,
+	"TABE": 0.0249, "IGNA": 0.0249, "GELE": 0.0249, "ENNA": 0.0249, "ARIA": 0.0248,
+	"MELD": 0.0247, "UMBE": 0.0247, "ERKE": 0.0247, "DERP": 0.0247, "CHNE": 0.0247,
+	"BEEN": 0.0246, "NSEI": 0.0246, "TWUR": 0.0246, "IEOP": 0.0246, "ENOD": 0.0245,
+	"WARN": 0.0245, "MÖGL": 0.0245, "EAKT": 0.0245, "ÖGLI": 0.0244, "EXIS": 0.0244,
+	"EFER": 0.0244, "CHTM": 0.0243, "KETT": 0.0242, "ENTS": 0.0242, "ITUN": 0.0241,
+	"AUTO": 0.0241, "ERSP": 0.0241, "NGIG": 0.0241, "ANIS": 0.0240, "DIEK": 0.0240,
+	"OMMA": 0.0240, "ERVO": 0.0240, "DENN": 0.0240, "JEDE": 0.0240, "EGRE": 0.0240,
+	"HLEN": 0.0239, "DENU": 0.0239, "RNAM": 0.0239, "PIEL": 0.0239, "OREI": 0.0239,
+	"HTUN": 0.0238, "USFÜ": 0.0238, "NDIG": 0.0238, "ETRE": 0.0238, "WIED": 0.0238,
+	"MITT": 0.0237, "IEST": 0.0237, "VERA": 0.0237, "NBEK": 0.0236, "ZIER": 0.0236,
+	"DENK": 0.0236, "ATIS": 0.0235, "NFAL": 0.0235, "EISP": 0.0235, "ESES": 0.0235,
+	"BARE": 0.0234, "ERFÜ": 0.0234, "RWAR": 0.0234, "RMIT": 0.0234, "TFÜR": 0.0234,
+	"ESIN": 0.0234, "IEAN": 0.0234, "VORE": 0.0234, "FORD": 0.0233, "HTAU": 0.0233,
+	"RSET": 0.0232, "GKEI": 0.0232, "MUSS": 0.0232, "NSPE": 0.0232, "GNOR": 0.0232,
+	"ITEI": 0.0232, "TDPK": 0.0232, "IGNO": 0.0231, "GEST": 0.0231, "MBOL": 0.0231,
+	"AUFE": 0.0231, "ENKO": 0.0231, "ENAL": 0.0231, "CHIE": 0.0230, "SIEH": 0.0230,
+	"SELB": 0.0230, "ESPE": 0.0230, "BESC": 0.0230, "TIVI": 0.0230, "SYMB": 0.0230,
+	"IVIE": 0.0230, "FILE": 0.0230, "IABL": 0.0230, "NENS": 0.0229, "ATCH": 0.0229,
+	"RSTA": 0.0229, "DDER": 0.0229, "ENKE": 0.0228, "LSCH": 0.0228, "UNGI": 0.0228,
+	"TUEL": 0.0228, "YMBO": 0.0228, "UTZT": 0.0228, "TUNT": 0.0226, "GDER": 0.0226,
+	"ISPI": 0.0226, "STNI": 0.0225, "UCHE": 0.0225, "ATIV": 0.0225, "EDAS": 0.0225,
+	"SCHN": 0.0224, "EDEN": 0.0224, "SFÜH": 0.0224, "USDE": 0.0224, "TDEM": 0.0224,
+	"IEHE": 0.0224, "ERIS": 0.0223, "INAM": 0.0223, "IGKE": 0.0223, "RIAB": 0.0223,
+	"WISC": 0.0222, "BEND": 0.0222, "TENT": 0.0222, "NORI": 0.0222, "IGES": 0.0222,
+	"ENFA": 0.0222, "CHTS": 0.0221, "KETS": 0.0221, "DASP": 0.0221, "ERLI": 0.0221,
+	"LEIN": 0.0221, "SWIR": 0.0221, "UNGV": 0.0220, "TWEN": 0.0219, "ERSU": 0.0218,
+	"GENW": 0.0218, "NTRA": 0.0218, "NFÜR": 0.0218, "UALI": 0.0218, "LLSD": 0.0218,
+	"GUNG": 0.0217, "ZUGE": 0.0217, "NSIN": 0.0216, "LSDI": 0.0216, "UFDE": 0.0216,
+	"TART": 0.0215, "ITDP": 0.0215, "BUNG": 0.0215, "ELIS": 0.0215, "NSOL": 0.0214,
+	"NPRO": 0.0214, "OLLT": 0.0213, "TIGT": 0.0213, "DERO": 0.0213, "CHLI": 0.0213,
+	"ENTA": 0.0213, "EFÜR": 0.0213, "INAL": 0.0212, "GENS": 0.0212, "LEME": 0.0212,
+	"RUND": 0.0212, "SNIC": 0.0212, "DENB": 0.0212, "CHTV": 0.0211, "PFAD": 0.0211,
+	"LZMA": 0.0211, "RIST": 0.0211, "ENAB": 0.0211, "RNIC": 0.0211, "FUNG": 0.0210,
+	"DIEZ": 0.0210, "ARNU": 0.0209, "UẞER": 0.0209, "EHLS": 0.0209, "MEND": 0.0209,
+	"SGEB": 0.0209, "DIEF": 0.0209, "ISTA": 0.0208, "RALS": 0.0208, "NDDI": 0.0208,
+	"TERB": 0.0208, "MALE": 0.0208, "ESPR": 0.0208, "RIPT": 0.0208, "DIER": 0.0208,
+	"TODE": 0.0208, "CHDI": 0.0208, "PACK": 0.0208, "HINZ": 0.0208, "STIE": 0.0207,
+	"MAND": 0.0207, "OHNE": 0.0206, "AUẞE": 0.0206, "IRDA": 0.0206, "GVON": 0.0206,
+	"UBLI": 0.0206, "ERNT": 0.0206, "RKOM": 0.0206, "RFOR": 0.0205, "HÄLT": 0.0205,
+	"ZWIS": 0.0205, "SAMM": 0.0205, "LAUF": 0.0204, "SPAK": 0.0204, "ENFÜ": 0.0204,
+	"IEGE": 0.0204, "TUAL": 0.0204, "NUMM": 0.0204, "RWER": 0.0204, "CHIN": 0.0203,
+	"KENN": 0.0203, "TATT": 0.0203, "RERE": 0.0203, "RANG": 0.0203, "DLIC": 0.0202,
+	"ABGE": 0.0202, "ENSC": 0.0202, "PUBL": 0.0202, "TENE": 0.0202, "HRER": 0.0202,
+	"EUND": 0.0201, "ERBI": 0.0201, "VORH": 0.0201, "NGEB": 0.0201, "EFOR": 0.0201,
+	"ÜHRE": 0.0201, "EANZ": 0.0201, "RDAS": 0.0201, "ERAR": 0.0201, "BUCH": 0.0201,
+	"NOCH": 0.0200, "INTR": 0.0200, "BEDE": 0.0200, "KTUA": 0.0200, "DENV": 0.0200,
+	"LÖSC": 0.0200, "ÖSCH": 0.0200, "DERR": 0.0199, "NANG": 0.0199, "NBEI": 0.0199,
+	"STDI": 0.0199, "RENN": 0.0199, "HANG": 0.0198, "FDIE": 0.0198, "EENT": 0.0198,
+	"DEBI": 0.0198, "MDIE": 0.0198, "RDAU": 0.0198, "HDIE": 0.0198, "CHTD": 0.0198,
+	"JEKT": 0.0198, "INZE": 0.0197, "SAUF": 0.0197, "EINB": 0.0197, "DIEM": 0.0197,
+	"GROU": 0.0197, "RSUC": 0.0196, "ERME": 0.0196, "IEKO": 0.0196, "TERM": 0.0196,
+	"AUFR": 0.0196, "TERA": 0.0195, "CHIV": 0.0195, "PATC": 0.0195, "RDEI": 0.0195,
+	"IMAL": 0.0195, "CHSE": 0.0194, "RDDI": 0.0194, "ASSD": 0.0194, "SCHU": 0.0193,
+	"SUND": 0.0193, "SAUS": 0.0193, "RUNT": 0.0193, "NKTI": 0.0193, "ROUP": 0.0193,
+	"LCHE": 0.0193, "UVER": 0.0193, "TVON": 0.0192, "BIAN": 0.0192, "ENKA": 0.0192,
+	"TIND": 0.0191, "NENN": 0.0191, "DNIC": 0.0191, "ERES": 0.0191, "EBER": 0.0191,
+	"ERFE": 0.0190, "TSPR": 0.0190, "ENFO": 0.0190, "PORT": 0.0190, "ICHS": 0.0190,
+	"RIEB": 0.0190, "ERNI": 0.0189, "TEIA": 0.0189, "BENS": 0.0189, "EPRO": 0.0189,
+	"EBIA": 0.0189, "EROP": 0.0189, "CONF": 0.0189, "ETER": 0.0189, "EHRE": 0.0188,
+	"NGEF": 0.0188, "OBJE": 0.0188, "NNAM": 0.0188, "ECHT": 0.0188, "ZTWE": 0.0188,
+	"RISC": 0.0188, "INDA": 0.0187, "INED": 0.0187, "IKAT": 0.0187, "UNDA": 0.0187,
+	"NBER": 0.0187, "USTE": 0.0186, "RAUF": 0.0186, "ETZU": 0.0186, "BEDA": 0.0186,
+	"RNEN": 0.0186, "BJEK": 0.0186, "ENTR": 0.0186, "UFDI": 0.0186, "ERTA": 0.0186,
+	"IEVE": 0.0185, "IEVO": 0.0185, "STRI": 0.0185, "WAND": 0.0185, "ROPT": 0.0185,
+	"UNDB": 0.0185, "NORD": 0.0185, "FUNK": 0.0185, "LSEI": 0.0185, "NGVO": 0.0185,
+	"UMGE": 0.0185, "TEDE": 0.0184, "KTUE": 0.0184, "TENV": 0.0184, "RFOL": 0.0184,
+	"WEST": 0.0183, "ITET": 0.0183, "TTEL": 0.0182, "NISS": 0.0182, "TEIS": 0.0182,
+	"FIND": 0.0182, "EFIN": 0.0182, "DESP": 0.0182, "NALS": 0.0182, "TIFI": 0.0182,
+	"NIGE": 0.0182, "TATU": 0.0182, "EITU": 0.0182, "SPEZ": 0.0182, "KISC": 0.0182,
+	"REMO": 0.0182, "EODE": 0.0181, "PEZI": 0.0181, "ISSE": 0.0180, "NDEM": 0.0180,
+	"UFRU": 0.0180, "ZUSA": 0.0180, "TTEN": 0.0180, "NGER": 0.0180, "IZIE": 0.0180,
+	"SSEI": 0.0180, "TEDA": 0.0180, "RTES": 0.0180, "FRUF": 0.0180, "DERI": 0.0180,
+	"DAUS": 0.0179, "LASS": 0.0179, "ZUVE": 0.0179, "LOCK": 0.0179, "BIND": 0.0179,
+	"EDEU": 0.0179, "DIRE": 0.0179, "STUN": 0.0178, "HABE": 0.0178, "ETDA": 0.0178,
+	"TANG": 0.0178, "IENI": 0.0178, "TEAU": 0.0178, "ANND": 0.0178, "GEFÜ": 0.0178,
+	"TSTE": 0.0178, "TSTA": 0.0177, "HERE": 0.0177, "IDEN": 0.0177, "ESTI": 0.0177,
+	"AUSW": 0.0177, "ESEI": 0.0177, "SCHT": 0.0176, "USAM": 0.0176, "DENT": 0.0176,
+	"INWE": 0.0176, "NKEI": 0.0176, "TZTD": 0.0176, "GTWE": 0.0175, "DIEI": 0.0175,
+	"CHBE": 0.0175, "HLDE": 0.0175, "TERD": 0.0175, "HÄNG": 0.0175, "IRDE": 0.0175,
+	"UNDS": 0.0174, "CHST": 0.0174, "ENPR": 0.0174, "INEA": 0.0174, "SSCH": 0.0174,
+	"INIE": 0.0174, "TEXT": 0.0174, "NAND": 0.0174, "TSIN": 0.0174, "ESBE": 0.0174,
+	"HIED": 0.0174, "UNGF": 0.0174, "ZUDE": 0.0174, "ANCH": 0.0173, "NATI": 0.0173,
+	"AHLD": 0.0173, "RSPR": 0.0173, "NFEH": 0.0173, "IRDN": 0.0173, "ORGA": 0.0172,
+	"RUFE": 0.0172, "OTHE": 0.0172, "ONAL": 0.0172, "CHTZ": 0.0172, "NWEI": 0.0171,
+	"ERPA": 0.0171, "POSI": 0.0171, "SKRI": 0.0171, "IELE": 0.0171, "DARF": 0.0171,
+	"VERH": 0.0171, "ENES": 0.0171, "DERH": 0.0171, "TEVE": 0.0171, "OMME": 0.0170,
+	"ERGR": 0.0170, "IFIK": 0.0170, "FIKA": 0.0170, "NWUR": 0.0170, "FFNE": 0.0169,
+	"NENZ": 0.0169, "HRIE": 0.0169, "SENS": 0.0169, "HAFT": 0.0168, "ONIS": 0.0168,
+	"DEST": 0.0168, "NEBE": 0.0168, "KRIP": 0.0168, "HELL": 0.0168, "ZWEI": 0.0168,
+	"BITT": 0.0168, "NENB": 0.0168, "DERM": 0.0168, "HITE": 0.0168, "HTIN": 0.0167,
+	"REPU": 0.0167, "MENS": 0.0167, "GBAR": 0.0167, "STLI": 0.0167, "NDBE": 0.0166,
+	"EKON": 0.0166, "LDAT": 0.0166, "SHEL": 0.0166, "ÖFFN": 0.0166, "DING": 0.0166,
+	"ENRE": 0.0165, "NOPT": 0.0165, "ZUGR": 0.0165, "CHTB": 0.0165, "ATUR": 0.0165,
+	"ZENT": 0.0164, "ROOT": 0.0164, "SSIE": 0.0164, "IHRE": 0.0164, "EPUB": 0.0163,
+	"LETZ": 0.0163, "TDES": 0.0163, "BLIK": 0.0162, "NTWE": 0.0162, "ZLIC": 0.0162,
+	"DASA": 0.0162, "BERD": 0.0162, "ABED": 0.0162, "TERK": 0.0162, "RTDI": 0.0162,
+	"GENU": 0.0161, "TREN": 0.0161, "HALB": 0.0161, "DERW": 0.0161, "RDEK": 0.0161,
+	"TETW": 0.0161, "ANDT": 0.0161, "OLIS": 0.0160, "LENA": 0.0160, "HERS": 0.0160,
+	"BETR": 0.0160, "SWER": 0.0160, "EUTE": 0.0160, "CHIT": 0.0159, "LEIT": 0.0159,
+	"SIEE": 0.0159, "TZLI": 0.0159, "RENS": 0.0159, "HERW": 0.0158, "ÄNGE": 0.0158,
+	"LADE": 0.0158, "ÜRDE": 0.0158, "AUBT": 0.0158, "EPAK": 0.0158, "ANWE": 0.0158,
+	"LLER": 0.0158, "GDES": 0.0157, "RITT": 0.0157, "TENW": 0.0157, "TEID": 0.0157,
+	"MVER": 0.0157, "LINK": 0.0157, "ZUER": 0.0157, "HEIT": 0.0157, "NENE": 0.0157,
+	"EÄND": 0.0157, "MMAN": 0.0157, "TFAL": 0.0156, "INIG": 0.0156, "HENA": 0.0156,
+	"SERS": 0.0156, "PPEN": 0.0156, "INNE": 0.0156, "GANG": 0.0156, "TERL": 0.0155,
+	"WÄHL": 0.0155, "NALL": 0.0155, "IEFE": 0.0155, "EFOL": 0.0155, "KONT": 0.0155,
+	"REFE": 0.0155, "RIFF": 0.0154, "UMDI": 0.0154, "LDUN": 0.0154, "RTIE": 0.0154,
+	"EZIE": 0.0154, "SENT": 0.0154, "UCHS": 0.0154, "ERBU": 0.0154, "RHAN": 0.0154,
+	"ISTU": 0.0154, "RGAB": 0.0154, "GTDI": 0.0154, "STAB": 0.0154, "DANN": 0.0154,
+	"ADEN": 0.0153, "SANG": 0.0153, "GETR": 0.0153, "NTHA": 0.0153, "DVER": 0.0152,
+	"EMEI": 0.0152, "BLOC": 0.0152, "THAL": 0.0152, "CKEN": 0.0152, "RVOR": 0.0152,
+	"LVER": 0.0152, "IEZU": 0.0152, "GESP": 0.0151, "IEGR": 0.0151, "ENFE": 0.0151,
+	"GEME": 0.0151, "FERE": 0.0151, "NZEL": 0.0151, "TENZ": 0.0151, "ICHD": 0.0150,
+	"DIEP": 0.0150, "EMBE": 0.0150, "MSCH": 0.0150, "CHUN": 0.0150, "MITS": 0.0150,
+	"MUST": 0.0150, "ORTI": 0.0150, "UNGW": 0.0150, "NESI": 0.0150, "ZUMB": 0.0150,
+	"GERU": 0.0149, "RPRO": 0.0149, "GELA": 0.0149, "ATUS": 0.0149, "RTEI": 0.0149,
+	"BEGR": 0.0149, "TAST": 0.0149, "EÜBE": 0.0148, "HENK": 0.0148, "NEDA": 0.0148,
+	"DIEU": 0.0148, "OLLE": 0.0148, "HTBE": 0.0148, "HERB": 0.0148, "CHTW": 0.0148,
+	"ENBA": 0.0148, "RBIN": 0.0147, "ISTK": 0.0147, "ÜHRT": 0.0147, "TEDI": 0.0147,
+	"WÖRT": 0.0147, "DIEL": 0.0147, "HTZU": 0.0147, "EINP": 0.0146, "GEZE": 0.0146,
+	"OSIT": 0.0146, "PRIN": 0.0146, "EGRÖ": 0.0146, "NNUN": 0.0146, "LATI": 0.0146,
+	"SKAN": 0.0146, "ASTE": 0.0146, "SCHW": 0.0146, "DRES": 0.0145, "TALS": 0.0145,
+	"ERND": 0.0145, "KTUR": 0.0145, "ABHN": 0.0145, "BHNG": 0.0145, "ESEM": 0.0145,
+	"DENF": 0.0145, "DIST": 0.0145, "DENM": 0.0145, "RENT": 0.0144, "RANC": 0.0144,
+	"WORD": 0.0144, "GENB": 0.0144, "GEIN": 0.0144, "ENAM": 0.0144, "HILF": 0.0143,
+	"TNUR": 0.0143, "EWIE": 0.0143, "EERZ": 0.0143, "IONV": 0.0143, "ELDU": 0.0143,
+	"DESA": 0.0143, "DENP": 0.0143, "NTSP": 0.0143, "ECHE": 0.0143, "SSDI": 0.0143,
+	"NDUR": 0.0142, "HNIC": 0.0142, "FRAG": 0.0142, "ALSE": 0.0142, "IENA": 0.0142,
+	"ALEN": 0.0142, "CHTL": 0.0142, "LTWE": 0.0142, "EUGE": 0.0142, "RING": 0.0142,
+	"MENA": 0.0142, "ENOP": 0.0142, "CHAU": 0.0141, "SSER": 0.0141, "VOLL": 0.0141,
+	"NNUR": 0.0141, "TEGR": 0.0141, "PARA": 0.0141, "RFEH": 0.0141, "VONA": 0.0141,
+	"MBEI": 0.0141, "BRAN": 0.0141, "NITT": 0.0140, "BENI": 0.0140, "ACHD": 0.0140,
+	"IMME": 0.0140, "RTWI": 0.0140, "VIEL": 0.0140, "RPAK": 0.0140, "RNEU": 0.0140,
+	"VONS": 0.0140, "REKT": 0.0140, "NDEX": 0.0140, "CHWE": 0.0140, "ULTI": 0.0139,
+	"FALS": 0.0139, "ENIM": 0.0139, "ÖRTE": 0.0139, "IGTE": 0.0139, "DRUC": 0.0139,
+	"RUCK": 0.0139, "TZUM": 0.0139, "AREN": 0.0139, "GRIF": 0.0139, "ERKA": 0.0139,
+	"RENA": 0.0139, "HLIE": 0.0139, "ETEI": 0.0139, "RLIC": 0.0139, "OPIE": 0.0139,
+	"HNIT": 0.0139, "TNAM": 0.0139, "ELES": 0.0139, "ERHE": 0.0139, "EBUN": 0.0139,
+	"DENZ": 0.0138, "ICHB": 0.0138, "NWIE": 0.0138, "SGES": 0.0138, "NBEF": 0.0138,
+	"TOMA": 0.0138, "GIGK": 0.0138, "AKZE": 0.0138, "NÖTI": 0.0138, "ÖTIG": 0.0138,
+	"NENV": 0.0138, "ITEK": 0.0138, "NSTR": 0.0137, "RKAN": 0.0137, "ENNT": 0.0137,
+	"ICHA": 0.0137, "ENWU": 0.0137, "WELC": 0.0137, "ELCH": 0.0137, "ITST": 0.0137,
+	"SSES": 0.0137, "TEKT": 0.0137, "ADRE": 0.0136, "NGIS": 0.0136, "RGEB": 0.0136,
+	"UGEN": 0.0136, "RERS": 0.0136, "IGTD": 0.0136, "WIRK": 0.0136, "EKTU": 0.0136,
+	"ALSC": 0.0136, "FFEN": 0.0136, "ITÄT": 0.0136, "RBEF": 0.0135, "RBEN": 0.0135,
+	"ERWI": 0.0135, "USDR": 0.0135, "ISTF": 0.0135, "IMIT": 0.0135, "GENT": 0.0135,
+	"DMIT": 0.0135, "HNGI": 0.0135, "NGUN": 0.0134, "ORHA": 0.0134, "SIES": 0.0134,
+	"NGEL": 0.0134, "NERS": 0.0134, "TENF": 0.0134, "FEIN": 0.0134, "EUNT": 0.0134,
+	"IRDI": 0.0134, "HELP": 0.0134, "ITOR": 0.0133, "ARDA": 0.0133, "NZUG": 0.0133,
+	"SDAS": 0.0133, "ARDM": 0.0133, "HTVE": 0.0133, "INIT": 0.0133, "NKON": 0.0133,
+	"HERU": 0.0133, "FACH": 0.0133, "IMMT": 0.0132, "EIMA": 0.0132, "STDA": 0.0132,
+	"GDIE": 0.0132, "ESWI": 0.0132, "NFOL": 0.0132, "NENF": 0.0132, "ONEI": 0.0132,
+	"RCHD": 0.0132, "SEOP": 0.0132, "KOPI": 0.0132, "VONP": 0.0131, "STEH": 0.0131,
+	"ESEO": 0.0131, "ECOM": 0.0131, "NÜBE": 0.0131, "TEBE": 0.0131, "CHZU": 0.0131,
+	"THEN": 0.0130, "ETWI": 0.0130, "NNEI": 0.0130, "ALSD": 0.0130, "SKOM": 0.0130,
+	"HEAD": 0.0130, "LOKA": 0.0130, "EFÜH": 0.0130, "FEST": 0.0130, "ZURÜ": 0.0130,
+	"NERF": 0.0130, "RBED": 0.0130, "MIND": 0.0130, "CODE": 0.0130, "TRAG": 0.0130,
+	"RFÜG": 0.0130, "TERT": 0.0130, "TENU": 0.0130, "KNNE": 0.0130, "MARK": 0.0129,
+	"ESSO": 0.0129, "RMAL": 0.0129, "TSVE": 0.0129, "RDIN": 0.0129, "TBER": 0.0129,
+	"ENNS": 0.0129, "EDAR": 0.0129, "FORT": 0.0129, "UNKO": 0.0129, "RNAT": 0.0129,
+	"INDU": 0.0128, "THÄL": 0.0128, "DEFI": 0.0128, "INHA": 0.0128, "SELT": 0.0128,
+	"STRE": 0.0128, "OMAT": 0.0128, "NSPR": 0.0128, "RARB": 0.0128, "NTHÄ": 0.0128,
+	"URÜC": 0.0128, "GFÜR": 0.0128, "EZIF": 0.0128, "ERTS": 0.0128, "STEU": 0.0127,
+	"FFER": 0.0127, "UTOM": 0.0127, "IONW": 0.0127, "EUSE": 0.0127, "TERF": 0.0127,
+	"CHEG": 0.0127, "ONTE": 0.0126, "GNAT": 0.0126, "NATU": 0.0126, "NHAL": 0.0126,
+	"RAKT": 0.0126, "UTEN": 0.0126, "TSEI": 0.0126, "SENW": 0.0126, "UNDL": 0.0126,
+	"TESI": 0.0126, "MINI": 0.0126, "EDUR": 0.0126, "ZERT": 0.0125, "NUNT": 0.0125,
+	"RGES": 0.0125, "ETES": 0.0125, "BENA": 0.0125, "BENÖ": 0.0125, "LTET": 0.0125,
+	"ZEND": 0.0125, "ENÖT": 0.0125, "TATI": 0.0125, "WARE": 0.0125, "NGLI": 0.0125,
+	"RDEM": 0.0125, "RREI": 0.0125, "EKTE": 0.0125, "PIER": 0.0125, "DSCH": 0.0124,
+	"SMIT": 0.0124, "HENT": 0.0124, "ISTR": 0.0124, "DEMS": 0.0124, "TEST": 0.0124,
+	"INEG": 0.0124, "ABEI": 0.0124, "RKON": 0.0124, "MULT": 0.0124, "FIZI": 0.0124,
+	"ENTW": 0.0124, "RHER": 0.0124, "EGEL": 0.0124, "RTIS": 0.0124, "POTE": 0.0124,
+	"NNSI": 0.0124, "LEGT": 0.0124, "OKAL": 0.0123, "RAGE": 0.0123, "LEGE": 0.0123,
+	"RSPE": 0.0123, "MITA": 0.0123, "ERFA": 0.0123, "RBER": 0.0123, "STZU": 0.0123,
+	"NORM": 0.0123, "VERT": 0.0123, "ITSV": 0.0123, "ESIC": 0.0123, "NENI": 0.0122,
+	"EIME": 0.0122, "SGEF": 0.0122, "ERTF": 0.0122, "GNAL": 0.0122, "ZIFI": 0.0122,
+	"MGEB": 0.0122, "UFEI": 0.0122, "NDDA": 0.0122, "ISTW": 0.0122, "TVOR": 0.0122,
+	"VONE": 0.0122, "IBTD": 0.0122, "ELEG": 0.0122, "GINN": 0.0122, "ONUN": 0.0121,
+	"NERW": 0.0121, "INEB": 0.0121, "ONBE": 0.0121, "HTAN": 0.0121, "SELE": 0.0121,
+	"SSTA": 0.0121, "NENW": 0.0121, "GEBU": 0.0121, "EGIT": 0.0121, "GESA": 0.0121,
+	"RTIF": 0.0121, "ASST": 0.0121, "ORIT": 0.0121, "NGEH": 0.0121, "OLLS": 0.0121,
+	"PROB": 0.0121, "NDEI": 0.0121, "EKIS": 0.0121, "FREI": 0.0120, "NITI": 0.0120,
+	"HERT": 0.0120, "TIAL": 0.0120, "LSZE": 0.0120, "DETE": 0.0120, "TERG": 0.0120,
+	"ENAR": 0.0120, "TEII": 0.0120, "BERG": 0.0120, "ENAC": 0.0120, "SUNG": 0.0120,
+	"NDEB": 0.0120, "TSIE": 0.0120, "SFEL": 0.0120, "SATZ": 0.0120, "RBUC": 0.0120,
+	"SVON": 0.0119, "ERNU": 0.0119, "ZUBE": 0.0119, "ESTL": 0.0119, "DDAS": 0.0119,
+	"EUGT": 0.0119, "NKÖN": 0.0119, "LENS": 0.0119, "CHEM": 0.0119, "EIMS": 0.0119,
+	"TRIB": 0.0119, "ARAM": 0.0119, "NBES": 0.0119, "NGAN": 0.0119, "RIND": 0.0119,
+	"RENV": 0.0119, "EGES": 0.0119, "ONIE": 0.0118, "ENHA": 0.0118, "RTDE": 0.0118,
+	"BLIC": 0.0118, "ENCO": 0.0118, "FHRT": 0.0118, "EREP": 0.0118, "RSEI": 0.0118,
+	"ONSE": 0.0118, "REDA": 0.0118, "SODE": 0.0118, "NZUN": 0.0118, "IEND": 0.0117,
+	"ISTZ": 0.0117, "EPAS": 0.0117, "INEV": 0.0117, "AMED": 0.0117, "KIER": 0.0117,
+	"IRDS": 0.0117, "ETHE": 0.0117, "VERK": 0.0117, "ENIG": 0.0117, "GERA": 0.0117,
+	"ELEM": 0.0117, "RADE": 0.0117, "ALSA": 0.0117, "COMP": 0.0117, "DIFF": 0.0117,
+	"DESB": 0.0116, "SPRI": 0.0116, "EMOT": 0.0116, "MERG": 0.0116, "MERI": 0.0116,
+	"GROẞ": 0.0116, "VERG": 0.0116, "NSTI": 0.0116, "NLIC": 0.0115, "NZUM": 0.0115,
+	"GENV": 0.0115, "ORGE": 0.0115, "UNDV": 0.0115, "DENG": 0.0115, "SFOR": 0.0115,
+	"IELD": 0.0115, "MOTE": 0.0115, "REGI": 0.0115, "TDAT": 0.0115, "NGEZ": 0.0115,
+	"TENP": 0.0115, "RDES": 0.0115, "ITTS": 0.0115, "RDDE": 0.0115, "RAND": 0.0114,
+	"ECHN": 0.0114, "TEUE": 0.0114, "ILFE": 0.0114, "INBE": 0.0114, "LLSE": 0.0114,
+	"TORY": 0.0114, "TRAL": 0.0114, "ESAU": 0.0114, "REGU": 0.0114, "EGUL": 0.0114,
+	"IEUN": 0.0114, "TSIC": 0.0114, "EGIN": 0.0114, "RTIG": 0.0114, "TEIF": 0.0114,
+	"ELIN": 0.0113, "LLST": 0.0113, "TÄND": 0.0113, "RWIR": 0.0113, "ERLE": 0.0113,
+	"TEIW": 0.0113, "MBEN": 0.0113, "SFÜR": 0.0113, "INIS": 0.0113, "EACH": 0.0113,
+	"NTEI": 0.0113, "IFIZ": 0.0113, "IVER": 0.0113, "ALER": 0.0113, "SDRU": 0.0113,
+	"IEFO": 0.0113, "ZENS": 0.0113, "KALE": 0.0112, "EKEI": 0.0112, "NZUS": 0.0112,
+	"AMET": 0.0112, "LEAN": 0.0112, "HVER": 0.0112, "TURE": 0.0112, "NEVE": 0.0112,
+	"FÜGB": 0.0112, "ÜGBA": 0.0112, "TETD": 0.0112, "ESAN": 0.0112, "UCHD": 0.0112,
+	"DETD": 0.0112, "EBES": 0.0112, "NDAU": 0.0112, "BEGI": 0.0112, "TERZ": 0.0111,
+	"HENF": 0.0111, "LIEẞ": 0.0111, "TEVO": 0.0111, "ESVE": 0.0111, "NGFÜ": 0.0111,
+	"IEMI": 0.0111, "RWAN": 0.0111, "CHEA": 0.0111, "NGSS": 0.0111, "NKET": 0.0111,
+	"RFÜR": 0.0111, "NURD": 0.0111, "GTEI": 0.0111, "NENU": 0.0111, "INAN": 0.0111,
+	"STKE": 0.0111, "ZUST": 0.0111, "GENI": 0.0111, "TERU": 0.0111, "IELL": 0.0111,
+	"NSEL": 0.0110, "ANDI": 0.0110, "ANNE": 0.0110, "ODIE": 0.0110, "ENÜB": 0.0110,
+	"EARB": 0.0110, "SPRE": 0.0110, "NPAS": 0.0110, "STDE": 0.0110, "HUNG": 0.0110,
+	"STED": 0.0110, "EGIS": 0.0110, "CHED": 0.0110, "ONSV": 0.0110, "BLEM": 0.0110,
+	"HERV": 0.0110, "CHGE": 0.0110, "NERE": 0.0110, "EALS": 0.0110, "RTED": 0.0110,
+	"ZTEN": 0.0110, "CHEC": 0.0110, "BERP": 0.0109, "AUFL": 0.0109, "ONDA": 0.0109,
+	"ASPR": 0.0109, "EDIN": 0.0109, "APOT": 0.0109, "HEMA": 0.0109, "ELBE": 0.0109,
+	"CONT": 0.0109, "TEKI": 0.0109, "RDMI": 0.0109, "FRDI": 0.0109, "TINS": 0.0108,
+	"EBEF": 0.0108, "TERW": 0.0108, "TBEI": 0.0108, "EINW": 0.0108, "NANZ": 0.0108,
+	"UNDI": 0.0108, "GREI": 0.0108, "NDIS": 0.0108, "IMPL": 0.0108, "RSTT": 0.0108,
+	"IBUT": 0.0108, "ZEPT": 0.0108, "RMIN": 0.0108, "ELBS": 0.0108, "LBST": 0.0108,
+	"NGSV": 0.0108, "YTES": 0.0107, "ALBD": 0.0107, "ITIA": 0.0107, "WERK": 0.0107,
+	"ÄNDI": 0.0107, "ATOR": 0.0107, "INEE": 0.0107, "ERGL": 0.0107, "OURC": 0.0107,
+	"ODUL": 0.0107, "IVEN": 0.0107, "ZAPO": 0.0107, "OTEK": 0.0107, "HIST": 0.0107,
+	"TEAN": 0.0107, "ÄHLT": 0.0107, "RENW": 0.0107, "ÜGEN": 0.0107, "TENK": 0.0107,
+	"SOUR": 0.0107, "URCE": 0.0107, "ESDE": 0.0107, "PKGD": 0.0107, "ESAR": 0.0106,
+	"IENT": 0.0106, "FINI": 0.0106, "RAME": 0.0106, "CHDA": 0.0106, "ERSO": 0.0106,
+	"DASE": 0.0106, "EINU": 0.0106, "BEIN": 0.0106, "ESTR": 0.0106, "HEGE": 0.0106,
+	"VERL": 0.0106, "NABE": 0.0106, "ELDA": 0.0106, "TERP": 0.0106, "TGEF": 0.0106,
+	"TYPE": 0.0106, "EERE": 0.0106, "BEVO": 0.0106, "MENG": 0.0106, "STWE": 0.0106,
+	"FÜGE": 0.0106, "NZEN": 0.0106, "NSIC": 0.0106, "ONDI": 0.0106, "STTZ": 0.0106,
+	"TMÖG": 0.0105, "IRDV": 0.0105, "LBDE": 0.0105, "VORA": 0.0105, "NSVE": 0.0105,
+	"RTUN": 0.0105, "LLEA": 0.0105, "DUSE": 0.0105, "MPLE": 0.0105, "GEBÄ": 0.0105,
+	"EBÄR": 0.0105, "BÄRD": 0.0105, "ÄRDE": 0.0105, "BOLI": 0.0105, "METE": 0.0105,
+	"KZEP": 0.0105, "SPAL": 0.0105, "PALT": 0.0105, "RIBU": 0.0105, "GEHE": 0.0105,
+	"HENW": 0.0105, "ERZW": 0.0105, "OMPA": 0.0105, "ONVE": 0.0105, "BEAC": 0.0105,
+	"ONAU": 0.0105, "THEK": 0.0105, "CHWI": 0.0105, "CHIS": 0.0104, "USSE": 0.0104,
+	"ERTR": 0.0104, "MEDE": 0.0104, "FÜRS": 0.0104, "ERBR": 0.0104, "ANST": 0.0104,
+	"NLES": 0.0104, "DISC": 0.0104, "EPTI": 0.0104, "NTYP": 0.0104, "INEL": 0.0104,
+	"ERAK": 0.0104, "DEAK": 0.0104, "NERA": 0.0104, "IBLI": 0.0104, "NSDA": 0.0104,
+	"HEBE": 0.0103, "NNDE": 0.0103, "DIGE": 0.0103, "ENLE": 0.0103, "WEDE": 0.0103,
+	"EWEI": 0.0103, "UREI": 0.0103, "SKEI": 0.0103, "ENTL": 0.0103, "UNDN": 0.0103,
+	"AUER": 0.0103, "HERN": 0.0103, "UGRI": 0.0103, "IALI": 0.0103, "STIN": 0.0103,
+	"PREC": 0.0103, "ENGL": 0.0103, "SAND": 0.0103, "HENV": 0.0103, "TEEI": 0.0103,
+	"REPO": 0.0103, "HESP": 0.0103, "GREP": 0.0103, "UCHT": 0.0102, "NDED": 0.0102,
+	"SIEA": 0.0102, "IONO": 0.0102, "PRUN": 0.0102, "TEMP": 0.0102, "LIOT": 0.0102,
+	"IOTH": 0.0102, "GEFO": 0.0102, "ABEL": 0.0102, "NNAC": 0.0102, "OBLE": 0.0102,
+	"HTMI": 0.0102, "GENO": 0.0102, "ENHI": 0.0102, "BEDI": 0.0102, "ÖẞED": 0.0102,
+	"OREN": 0.0102, "HINW": 0.0102, "EHLT": 0.0102, "ROBL": 0.0102, "TEME": 0.0102,
+	"MATA": 0.0102, "SPAS": 0.0102, "EFIL": 0.0102, "LLES": 0.0102, "LOGI": 0.0102,
+	"NDAN": 0.0101, "TKAN": 0.0101, "SELI": 0.0101, "ÖNNT": 0.0101, "NCOM": 0.0101,
+	"DUND": 0.0101, "RANZ": 0.0101, "ONIN": 0.0101, "HINE": 0.0101, "PTIE": 0.0101,
+	"ELTE": 0.0101, "HLSZ": 0.0101, "NDEL": 0.0101, "ESFE": 0.0101, "EINV": 0.0101,
+	"RALT": 0.0101, "LIZI": 0.0101, "NPAT": 0.0101, "TWAR": 0.0100, "DWER": 0.0100,
+	"UERS": 0.0100, "ILDE": 0.0100, "BIBL": 0.0100, "DAHE": 0.0100, "AHER": 0.0100,
+	"HAUP": 0.0100, "AUPT": 0.0100, "AUSE": 0.0100, "RKEN": 0.0100, "THAT": 0.0100,
+	"INSC": 0.0100, "SUNT": 0.0100, "ENGR": 0.0100, "ONFL": 0.0100, "ENME": 0.0100,
+	"INPA": 0.0100, "RELE": 0.0100, "MITV": 0.0100, "BLIO": 0.0100, "BISC": 0.0099,
+	"RODE": 0.0099, "DAUF": 0.0099, "SGEW": 0.0099, "EWÄH": 0.0099, "ZUFÜ": 0.0099,
+	"NZUF": 0.0099, "MEDI": 0.0099, "BANK": 0.0099, "DEMD": 0.0099, "BMOD": 0.0099,
+	"NNEU": 0.0099, "SKON": 0.0099, "GEDE": 0.0099, "ASPA": 0.0099, "DEDA": 0.0099,
+	"IREK": 0.0099, "SPER": 0.0099, "NBAN": 0.0099, "IENE": 0.0099, "ELÖS": 0.0098,
+	"KUND": 0.0098, "ARDE": 0.0098, "GANZ": 0.0098, "EZAH": 0.0098, "RDNI": 0.0098,
+	"STAU": 0.0098, "ERTN": 0.0098, "IMMU": 0.0098, "IENS": 0.0098, "NURE": 0.0098,
+	"EADM": 0.0098, "DMOD": 0.0098, "ARKI": 0.0098, "RDWE": 0.0098, "ANDO": 0.0098,
+	"FANG": 0.0098, "ÜFUN": 0.0098, "EERS": 0.0098, "UNER": 0.0098, "EINK": 0.0098,
+	"FÜRE": 0.0098, "NGAU": 0.0098, "ERKN": 0.0098, "SPRU": 0.0098, "OCHE": 0.0098,
+	"ONWI": 0.0098, "IEMA": 0.0098, "INVE": 0.0098, "BLEN": 0.0098, "EGRI": 0.0098,
+	"FEND": 0.0098, "GEWE": 0.0098, "ONSD": 0.0098, "DESK": 0.0097, "CHTN": 0.0097,
+	"TEIG": 0.0097, "BEHA": 0.0097, "LERA": 0.0097, "LFÜR": 0.0097, "ZTDI": 0.0097,
+	"TRIC": 0.0097, "CKET": 0.0097, "TMEH": 0.0097, "FERT": 0.0097, "HIER": 0.0097,
+	"EBEI": 0.0096, "EREC": 0.0096, "HTWE": 0.0096, "ONVO": 0.0096, "TGEL": 0.0096,
+	"NTLI": 0.0096, "CHVE": 0.0096, "RENI": 0.0096, "MPAT": 0.0096, "PLIZ": 0.0096,
+	"NTHE": 0.0096, "ESIE": 0.0096, "ESAM": 0.0096, "RVON": 0.0096, "ILES": 0.0096,
+	"ERAB": 0.0096, "RALL": 0.0096, "SWEI": 0.0096, "EFHR": 0.0096, "NTES": 0.0095,
+	"OLGT": 0.0095, "EIWI": 0.0095, "ONAN": 0.0095, "STET": 0.0095, "RKET": 0.0095,
+	"LPAK": 0.0095, "RÜFU": 0.0095, "SBEN": 0.0095, "RKIE": 0.0095, "ANNA": 0.0095,
+	"RDDA": 0.0095, "TEIK": 0.0095, "EIHE": 0.0095, "ETDI": 0.0095, "LLED": 0.0095,
+	"EPOS": 0.0095, "SVAR": 0.0095, "ATTD": 0.0095, "SCHS": 0.0095, "UTSC": 0.0094,
+	"TFEH": 0.0094, "RGLE": 0.0094, "SENE": 0.0094, "EBAU": 0.0094, "RTDA": 0.0094,
+	"RTFÜ": 0.0094, "MÜSS": 0.0094, "LLTW": 0.0094, "ERNO": 0.0094, "ELLP": 0.0094,
+	"ORIG": 0.0094, "STUF": 0.0094, "CHLE": 0.0093, "BILD": 0.0093, "NAKT": 0.0093,
+	"KETN": 0.0093, "RAUC": 0.0093, "SYNT": 0.0093, "YNTA": 0.0093, "HTIG": 0.0093,
+	"DWIR": 0.0093, "FNET": 0.0093, "SORT": 0.0093, "IEER": 0.0093, "ÄNGI": 0.0093,
+	"LERW": 0.0093, "NZUR": 0.0093, "BUIL": 0.0093, "IKAN": 0.0093, "HENU": 0.0093,
+	"UNGZ": 0.0093, "TREA": 0.0093, "SFEH": 0.0093, "NDMI": 0.0093, "BESS": 0.0093,
+	"PRIO": 0.0093, "LLPA": 0.0093, "RBAR": 0.0093, "BEZI": 0.0092, "UNDW": 0.0092,
+	"ESZU": 0.0092, "EINN": 0.0092, "LERD": 0.0092, "MINA": 0.0092, "SEBE": 0.0092,
+	"PUNK": 0.0092, "SERA": 0.0092, "ẞEDE": 0.0092, "TUFE": 0.0092, "NKNN": 0.0092,
+	"LAND": 0.0092, "EWEN": 0.0092, "NALI": 0.0092, "RGRU": 0.0092, "NTAX": 0.0092,
+	"DBEE": 0.0092, "LENU": 0.0092, "EITI": 0.0092, "EQUE": 0.0092, "HNER": 0.0092,
+	"EINM": 0.0092, "HENE": 0.0092, "TTDE": 0.0092, "IONB": 0.0091, "ALES": 0.0091,
+	"ẞERH": 0.0091, "BEAR": 0.0091, "SGIB": 0.0091, "RFEN": 0.0091, "NHIN": 0.0091,
+	"STRO": 0.0091, "UDEN": 0.0091, "ADMO": 0.0091, "ANGA": 0.0091, "ISTS": 0.0091,
+	"EINH": 0.0091, "WÜRD": 0.0091, "TZEI": 0.0091, "TORE": 0.0091, "BSCH": 0.0091,
+	"NUME": 0.0091, "VONB": 0.0091, "ETWA": 0.0091, "TWAS": 0.0091, "SSYS": 0.0091,
+	"RIGI": 0.0091, "GEFH": 0.0091, "SELN": 0.0090, "ERTH": 0.0090, "TBEN": 0.0090,
+	"LENT": 0.0090, "SOFT": 0.0090, "ELNE": 0.0090, "DETA": 0.0090, "ENBI": 0.0090,
+	"FENS": 0.0090, "HDEM": 0.0090, "GRUN": 0.0090, "VORD": 0.0090, "DESE": 0.0090,
+	"MAUF": 0.0090, "IGGE": 0.0090, "ADAT": 0.0090, "NDPK": 0.0090, "RIOR": 0.0090,
+	"ETIS": 0.0090, "KLEI": 0.0090, "SSIN": 0.0090, "ONTR": 0.0090, "NTEX": 0.0090,
+	"HTMÖ": 0.0090, "NULL": 0.0090, "ENDD": 0.0090, "NGSD": 0.0090, "MMUN": 0.0090,
+	"NTIS": 0.0090, "ENNO": 0.0090, "GWIR": 0.0090, "LIMI": 0.0089, "MALA": 0.0089,
+	"EINL": 0.0089, "NDIN": 0.0089, "TEHE": 0.0089, "DASD": 0.0089, "SIEI": 0.0089,
+	"SENA": 0.0089, "ORDN": 0.0089, "ERTU": 0.0089, "EREF": 0.0089, "ERUF": 0.0089,
+	"REIH": 0.0089, "ESIG": 0.0089, "NTED": 0.0089, "ITZU": 0.0089, "ITBE": 0.0089,
+	"SAKT": 0.0089, "EIAU": 0.0089, "SCHM": 0.0089, "LTEI": 0.0089, "EADS": 0.0089,
+	"ABEA": 0.0089, "PATI": 0.0089, "FZEI": 0.0089, "INSE": 0.0088, "LEVE": 0.0088,
+	"TESE": 0.0088, "HLTE": 0.0088, "SVOR": 0.0088, "TEIM": 0.0088, "ÜCKG": 0.0088,
+	"IEBI": 0.0088, "DASF": 0.0088, "ERIE": 0.0088, "ATIB": 0.0088, "TENN": 0.0088,
+	"TAUẞ": 0.0088, "BDES": 0.0088, "NGZU": 0.0088, "ITVE": 0.0088, "INAR": 0.0088,
+	"NERD": 0.0088, "ABSC": 0.0088, "NINS": 0.0088, "HSEI": 0.0088, "IFFE": 0.0088,
+	"ANNW": 0.0088, "STEV": 0.0088, "IORI": 0.0088, "NMEL": 0.0088, "BEEI": 0.0088,
+	"HENI": 0.0087, "LENV": 0.0087, "LÄRE": 0.0087, "BENV": 0.0087, "IONU": 0.0087,
+	"RBES": 0.0087, "EARC": 0.0087, "FENW": 0.0087, "REAL": 0.0087, "GRAD": 0.0087,
+	"MITG": 0.0087, "ISTO": 0.0087, "CHEB": 0.0087, "NNDA": 0.0087, "ESDA": 0.0087,
+	"MLES": 0.0087, "IGUN": 0.0087, "RNDE": 0.0087, "RGEN": 0.0087, "ETNA": 0.0087,
+	"SUBM": 0.0087, "EUEN": 0.0086, "MERN": 0.0086, "HODE": 0.0086, "NTEG": 0.0086,
+	"TEUN": 0.0086, "BEWI": 0.0086, "GELÖ": 0.0086, "ADER": 0.0086, "ASSU": 0.0086,
+	"PERR": 0.0086, "ORTS": 0.0086, "IGIN": 0.0086, "UILD": 0.0086, "UBMO": 0.0086,
+	"INGU": 0.0086, "MERK": 0.0086, "OCKE": 0.0086, "DERC": 0.0086, "TNAC": 0.0086,
+	"DVON": 0.0086, "ENSY": 0.0086, "USZU": 0.0086, "NGED": 0.0086, "TZUS": 0.0086,
+	"AUFZ": 0.0086, "USWE": 0.0086, "REAM": 0.0086, "CHAF": 0.0085, "FGER": 0.0085,
+	"HASH": 0.0085, "NENG": 0.0085, "RTWU": 0.0085, "LSAU": 0.0085, "ERSY": 0.0085,
+	"DASK": 0.0085, "GEÄN": 0.0085, "NALE": 0.0085, "DASV": 0.0085, "NNER": 0.0085,
+	"ANME": 0.0085, "EUTS": 0.0085, "THIS": 0.0085, "EAND": 0.0085, "TÜBE": 0.0085,
+	"TEGE": 0.0085, "ULÄR": 0.0085, "NMEH": 0.0085, "ITDI": 0.0085, "ENEU": 0.0085,
+	"RTSI": 0.0085, "NGEW": 0.0085, "OGIN": 0.0085, "MERS": 0.0084, "ERSA": 0.0084,
+	"AXIM": 0.0084, "HIND": 0.0084, "INEZ": 0.0084, "UMEI": 0.0084, "ENKÖ": 0.0084,
+	"MENM": 0.0084, "IDIE": 0.0084, "ETSI": 0.0084, "ORTE": 0.0084, "ELTA": 0.0084,
+	"EREG": 0.0084, "HSTA": 0.0084, "LEAU": 0.0084, "BINÄ": 0.0084, "INÄR": 0.0084,
+	"NGEI": 0.0084, "DESG": 0.0084, "GAUS": 0.0084, "MITB": 0.0084, "IWIR": 0.0084,
+	"LLEV": 0.0084, "UMER": 0.0084, "ASSI": 0.0084, "ANZU": 0.0084, "EWAR": 0.0084,
+	"INFA": 0.0084, "EGRU": 0.0084, "SEDA": 0.0084, "RCHE": 0.0084, "MAXI": 0.0083,
+	"AUFS": 0.0083, "LERM": 0.0083, "EIBU": 0.0083, "DRÜC": 0.0083, "NBYT": 0.0083,
+	"GULÄ": 0.0083, "KUNG": 0.0083, "ABEW": 0.0083, "LLSS": 0.0083, "LSSI": 0.0083,
+	"OPER": 0.0083, "EMER": 0.0083, "BERN": 0.0083, "EKTI": 0.0083, "ZUMA": 0.0083,
+	"SHAD": 0.0083, "HADO": 0.0083, "NMUS": 0.0083, "UNDK": 0.0083, "BELL": 0.0083,
+	"EMAN": 0.0083, "TWED": 0.0083, "ITIO": 0.0083, "DDES": 0.0083, "IBUN": 0.0083,
+	"GERI": 0.0083, "PERA": 0.0083, "FISC": 0.0083, "ELEA": 0.0083, "EKUN": 0.0083,
+	"SSIG": 0.0083, "STÄN": 0.0083, "LEDE": 0.0083, "DEMA": 0.0083, "EIML": 0.0083,
+	"DENO": 0.0083, "ADOW": 0.0083, "UEIN": 0.0082, "PROT": 0.0082, "TIME": 0.0082,
+	"RSIN": 0.0082, "DEBE": 0.0082, "ESGI": 0.0082, "FDER": 0.0082, "KNÜP": 0.0082,
+	"NÜPF": 0.0082, "BTDI": 0.0082, "ERAD": 0.0082, "NUMD": 0.0082, "UREN": 0.0082,
+	"ABHÄ": 0.0082, "BHÄN": 0.0082, "GSVA": 0.0082, "SEKU": 0.0082, "ASCH": 0.0082,
+	"ACHI": 0.0082, "STBE": 0.0082, "INKO": 0.0082, "HRIT": 0.0082, "SARG": 0.0082,
+	"UTET": 0.0082, "NNKE": 0.0082, "ONTA": 0.0082, "BLEI": 0.0082, "VONF": 0.0082,
+	"RIGE": 0.0081, "ZERN": 0.0081, "HERA": 0.0081, "IEPR": 0.0081, "RKNÜ": 0.0081,
+	"ALLA": 0.0081, "EADE": 0.0081, "PDAT": 0.0081, "NNVO": 0.0081, "NGIN": 0.0081,
+	"GINA": 0.0081, "ITAN": 0.0081, "THER": 0.0081, "LTNI": 0.0081, "TWIE": 0.0081,
+	"LENE": 0.0081, "STWI": 0.0081, "SWUR": 0.0081, "PFUN": 0.0081, "HLAN": 0.0081,
+	"LSDE": 0.0081, "HECK": 0.0081, "EDIT": 0.0081, "STHE": 0.0081, "ENKN": 0.0081,
+	"ETSC": 0.0080, "STRA": 0.0080, "GENF": 0.0080, "EMPF": 0.0080, "HRUN": 0.0080,
+	"NGBE": 0.0080, "ZUSE": 0.0080, "UNGN": 0.0080, "KODI": 0.0080, "SOPT": 0.0080,
+	"CHMI": 0.0080, "EALL": 0.0080, "NGET": 0.0080, "NESP": 0.0080, "ZERS": 0.0080,
+	"ETAI": 0.0080, "SAMT": 0.0080, "BAUT": 0.0080, "ENWO": 0.0080, "WILL": 0.0080,
+	"AUEN": 0.0080, "ELEI": 0.0080, "HERH": 0.0080, "USET": 0.0080, "MBER": 0.0080,
+	"MALS": 0.0080, "ARIS": 0.0080, "META": 0.0080, "IONM": 0.0079, "ERIC": 0.0079,
+	"LWIR": 0.0079, "TSET": 0.0079, "DNAM": 0.0079, "LERI": 0.0079, "IEẞE": 0.0079,
+	"TENM": 0.0079, "ZELN": 0.0079, "ENZI": 0.0079, "FDEN": 0.0079, "ARAB": 0.0079,
+	"IGTW": 0.0079, "TENO": 0.0079, "MITU": 0.0079, "LÄNG": 0.0079, "RGEG": 0.0079,
+	"KOLL": 0.0079, "ENZW": 0.0079, "EFFE": 0.0079, "SENU": 0.0079, "ONOD": 0.0079,
+	"EKÖN": 0.0079, "MPOR": 0.0079, "ONZU": 0.0079, "FENT": 0.0079, "LSDA": 0.0079,
+	"ALIA": 0.0079, "NARC": 0.0079, "INGT": 0.0078, "BUND": 0.0078, "WENI": 0.0078,
+	"IIST": 0.0078, "IONN": 0.0078, "LENW": 0.0078, "IREC": 0.0078, "SITI": 0.0078,
+	"EẞEN": 0.0078, "CHNU": 0.0078, "SBER": 0.0078, "DESD": 0.0078, "NETW": 0.0078,
+	"TDUR": 0.0078, "WING": 0.0078, "LIAS": 0.0078, "EVAR": 0.0078, "RITÄ": 0.0078,
+	"RENP": 0.0078, "DEDE": 0.0078, "GSDA": 0.0078, "NAUC": 0.0078, "MENF": 0.0078,
+	"ITGE": 0.0078, "ERHI": 0.0078, "RMOD": 0.0078, "FÜRK": 0.0078, "ISTG": 0.0078,
+	"LIEG": 0.0078, "INDO": 0.0078, "TPAC": 0.0078, "IHEN": 0.0078, "AUFF": 0.0078,
+	"EDEK": 0.0078, "THES": 0.0078, "AMER": 0.0077, "ONST": 0.0077, "SÄTZ": 0.0077,
+	"ÜRDA": 0.0077, "BEWE": 0.0077, "ERHÄ": 0.0077, "EIMI": 0.0077, "AUSS": 0.0077,
+	"ENMO": 0.0077, "SALS": 0.0077, "NZIE": 0.0077, "LUSS": 0.0077, "TROM": 0.0077,
+	"DEME": 0.0077, "MITM": 0.0077, "KETI": 0.0077, "NERH": 0.0077, "URIE": 0.0077,
+	"ALLO": 0.0077, "SIEM": 0.0077, "TWOR": 0.0077, "TEZU": 0.0077, "RMEL": 0.0077,
+	"GEWÄ": 0.0077, "NENP": 0.0077, "TAUC": 0.0077, "UBEN": 0.0077, "SUMM": 0.0077,
+	"ESKA": 0.0077, "GURI": 0.0076, "ANTE": 0.0076, "GENN": 0.0076, "AFTE": 0.0076,
+	"NISA": 0.0076, "BERI": 0.0076, "BRAU": 0.0076, "ONER": 0.0076, "ASBE": 0.0076,
+	"INEU": 0.0076, "NTRO": 0.0076, "UNGB": 0.0076, "LSTÄ": 0.0076, "STES": 0.0076,
+	"NARG": 0.0076, "ESGE": 0.0076, "INDD": 0.0076, "IONF": 0.0076, "DASB": 0.0076,
+	"RZUG": 0.0076, "ONSN": 0.0075, "METH": 0.0075, "GVER": 0.0075, "RTAU": 0.0075,
+	"UNGU": 0.0075, "NUMB": 0.0075, "SPRÜ": 0.0075, "ÜPFU": 0.0075, "LSER": 0.0075,
+	"ÖẞER": 0.0075, "USEI": 0.0075, "RECT": 0.0075, "FLUS": 0.0075, "NZUD": 0.0075,
+	"BINR": 0.0075, "TORI": 0.0075, "UNDF": 0.0075, "CHLO": 0.0075, "OSSE": 0.0075,
+	"SDES": 0.0075, "HOME": 0.0075, "GLIS": 0.0075, "ERTB": 0.0075, "TAKT": 0.0075,
+	"IMER": 0.0075, "ENTP": 0.0075, "NTPA": 0.0075, "ISZU": 0.0075, "TENL": 0.0075,
+	"THEU": 0.0075, "TTZT": 0.0075, "OPPE": 0.0074, "THOD": 0.0074, "MUNG": 0.0074,
+	"EXTE": 0.0074, "NERZ": 0.0074, "SINF": 0.0074, "ÄHLE": 0.0074, "VORS": 0.0074,
+	"SSUN": 0.0074, "ICHV": 0.0074, "EDIR": 0.0074, "MANG": 0.0074, "ENAK": 0.0074,
+	"HRTW": 0.0074, "OBEI": 0.0074, "TEMS": 0.0074, "GISC": 0.0074, "WIND": 0.0074,
+	"DASZ": 0.0074, "CHTF": 0.0074, "RPRÜ": 0.0074, "INEF": 0.0074, "WITH": 0.0074,
+	"UFÜG": 0.0074, "NGST": 0.0074, "SSPE": 0.0074, "RLIS": 0.0074, "GEBA": 0.0074,
+	"ERFI": 0.0074, "RHÄL": 0.0074, "ERAT": 0.0074, "LLEI": 0.0074, "ÄLTN": 0.0074,
+	"ETHO": 0.0074, "RDEV": 0.0074, "EZUS": 0.0074, "ROTO": 0.0074, "ELUN": 0.0074,
+	"IEAK": 0.0074, "URDI": 0.0074, "NTIE": 0.0074, "BREI": 0.0074, "OMPO": 0.0074,
+	"ERMO": 0.0074, "IGNI": 0.0074, "RFIL": 0.0074, "UPDA": 0.0074, "CACH": 0.0074,
+	"OKUM": 0.0074, "SÜDL": 0.0074, "RINC": 0.0074, "SIEN": 0.0073, "UNDG": 0.0073,
+	"LLOW": 0.0073, "ANGS": 0.0073, "RDLI": 0.0073, "CHUA": 0.0073, "LDES": 0.0073,
+	"NBIN": 0.0073, "INUN": 0.0073, "ICHI": 0.0073, "RTBE": 0.0073, "ARDW": 0.0073,
+	"LESI": 0.0073, "TNIS": 0.0073, "LLIS": 0.0073, "INIC": 0.0073, "ELAD": 0.0073,
+	"ERVI": 0.0073, "SSTU": 0.0073, "SBES": 0.0073, "OFTW": 0.0073, "LSSE": 0.0073,
+	"USDI": 0.0073, "SPAR": 0.0073, "EIDI": 0.0073, "NFAC": 0.0073, "ESHA": 0.0073,
+	"MACH": 0.0073, "TESP": 0.0073, "SITO": 0.0073, "DOKU": 0.0073, "KUME": 0.0073,
+	"ÜDLI": 0.0073, "HNLI": 0.0073, "RIGG": 0.0073, "OLEN": 0.0072, "ELAN": 0.0072,
+	"DESZ": 0.0072, "MENI": 0.0072, "TESA": 0.0072, "BEZE": 0.0072, "SNAM": 0.0072,
+	"MEIS": 0.0072, "WIES": 0.0072, "NEZE": 0.0072, "FTWA": 0.0072, "ESRE": 0.0072,
+	"HMIT": 0.0072, "ESKR": 0.0072, "DELT": 0.0072, "NDVE": 0.0072, "AUBE": 0.0072,
+	"IONZ": 0.0072, "LLAT": 0.0072, "PONE": 0.0072, "SELS": 0.0072, "DASM": 0.0072,
+	"KATI": 0.0072, "RINT": 0.0072, "CHVO": 0.0072, "STGE": 0.0072, "LMIT": 0.0072,
+	"IELS": 0.0072, "IXTE": 0.0072, "ZIEH": 0.0072, "ILIE": 0.0072, "UNGM": 0.0072,
+	"NGMI": 0.0072, "NGSA": 0.0072, "USTA": 0.0072, "TEPA": 0.0072, "IGEA": 0.0072,
+	"TERI": 0.0072, "ENLI": 0.0072, "NEEI": 0.0072, "MAUS": 0.0072, "ONSI": 0.0072,
+	"IFFS": 0.0072, "FÜGT": 0.0072, "UFDA": 0.0072, "ICHW": 0.0072, "IRDB": 0.0072,
+	"EMAL": 0.0072, "UPGR": 0.0072, "TROL": 0.0072, "TTST": 0.0072, "TRIG": 0.0072,
+	"TILS": 0.0072, "GGER": 0.0072, "BASI": 0.0071, "LIKT": 0.0071, "ESKO": 0.0071,
+	"EIIS": 0.0071, "EROO": 0.0071, "AUFT": 0.0071, "DEVO": 0.0071, "CHKE": 0.0071,
+	"RGEL": 0.0071, "LENB": 0.0071, "ESVO": 0.0071, "RCHS": 0.0071, "NFLI": 0.0071,
+	"UMDE": 0.0071, "IELI": 0.0071, "ADIE": 0.0071, "EMOV": 0.0071, "RDED": 0.0071,
+	"ACKA": 0.0071, "NMOD": 0.0071, "TSPE": 0.0071, "RABI": 0.0071, "UTIL": 0.0071,
+	"FTHE": 0.0071, "NDNI": 0.0071, "HUND": 0.0071, "CHBA": 0.0071, "EILI": 0.0071,
+	"HKEI": 0.0071, "ITSE": 0.0071, "HDEN": 0.0071, "RBRA": 0.0071, "RENU": 0.0071,
+	"RTZU": 0.0071, "ESEB": 0.0071, "MPON": 0.0071, "ORRE": 0.0071, "NGDI": 0.0071,
+	"RDBE": 0.0071, "MMTE": 0.0071, "HTDE": 0.0071, "LEAS": 0.0071, "NCON": 0.0071,
+	"HEDI": 0.0071, "ZMAD": 0.0071, "ACHR": 0.0070, "OTOK": 0.0070, "ENWA": 0.0070,
+	"RENF": 0.0070, "WAHL": 0.0070, "REVE": 0.0070, "TAGE": 0.0070, "RDIS": 0.0070,
+	"EISY": 0.0070, "ERUM": 0.0070, "NENM": 0.0070, "EBEG": 0.0070, "SERD": 0.0070,
+	"EASE": 0.0070, "RFAL": 0.0070, "MIXT": 0.0070, "ERBO": 0.0070, "TERV": 0.0070,
+	"AUTH": 0.0070, "RKEI": 0.0070, "TZUR": 0.0070, "NFIL": 0.0070, "ORHE": 0.0070,
+	"EHIL": 0.0070, "UFFE": 0.0070, "NDWE": 0.0070, "LIEF": 0.0070, "DNUR": 0.0070,
+	"SMUS": 0.0070, "EKEN": 0.0070, "RDNU": 0.0070, "NBRA": 0.0070, "AHRE": 0.0070,
+	"ESDI": 0.0070, "HTTP": 0.0070, "TENG": 0.0070, "ÖSTL": 0.0070, "THLT": 0.0070,
+	"HAUF": 0.0069, "UNDP": 0.0069, "ELSE": 0.0069, "LOSS": 0.0069, "VONN": 0.0069,
+	"ENTY": 0.0069, "ITSI": 0.0069, "NENK": 0.0069, "SDEM": 0.0069, "RTRA": 0.0069,
+	"PLAT": 0.0069, "CHRÄ": 0.0069, "ITIS": 0.0069, "GENZ": 0.0069, "HNET": 0.0069,
+	"EÖFF": 0.0069, "SOND": 0.0069, "IMLE": 0.0069, "BIGE": 0.0069, "ERHO": 0.0069,
+	"CHMA": 0.0069, "LEVO": 0.0069, "NTAT": 0.0069, "USCH": 0.0069, "ELIE": 0.0069,
+	"RTIN": 0.0069, "TPRO": 0.0069, "HLOS": 0.0069, "ESNI": 0.0069, "ARSE": 0.0069,
+	"TOKO": 0.0069, "OKOL": 0.0069, "NDVO": 0.0069, "UERD": 0.0069, "AUSZ": 0.0069,
+	"EMLI": 0.0069, "NAPT": 0.0069, "UNDZ": 0.0069, "DIEW": 0.0069, "HSEL": 0.0069,
+	"SEHE": 0.0069, "EHEL": 0.0069, "CIPA": 0.0069, "TCHE": 0.0069, "ECTO": 0.0069,
+	"NÖRD": 0.0068, "ÖRDL": 0.0068, "SOCK": 0.0068, "HTLE": 0.0068, "EHRA": 0.0068,
+	"GERS": 0.0068, "GNIC": 0.0068, "LNIC": 0.0068, "RÄND": 0.0068, "RDEE": 0.0068,
+	"EIFE": 0.0068, "NABH": 0.0068, "ETRA": 0.0068, "ELOG": 0.0068, "TELS": 0.0068,
+	"ITAU": 0.0068, "HEUS": 0.0068, "ERBA": 0.0068, "UNDM": 0.0068, "ISTB": 0.0068,
+	"TERH": 0.0068, "CHTK": 0.0068, "RTNI": 0.0068, "RZUS": 0.0068, "ABES": 0.0068,
+	"LEGI": 0.0068, "SGEG": 0.0068, "WIDE": 0.0068, "ASVE": 0.0068, "TAIL": 0.0068,
+	"WEIL": 0.0068, "MOVE": 0.0068, "HRBA": 0.0068, "NTHL": 0.0068, "RIES": 0.0067,
+	"TEMI": 0.0067, "RÜFE": 0.0067, "ICHK": 0.0067, "ERED": 0.0067, "EROD": 0.0067,
+	"LERS": 0.0067, "ANNM": 0.0067, "EARG": 0.0067, "URSP": 0.0067, "RHIN": 0.0067,
+	"NZUE": 0.0067, "LDIE": 0.0067, "NQUE": 0.0067, "NERI": 0.0067, "ITRE": 0.0067,
+	"ZTWI": 0.0067, "CKAG": 0.0067, "IEME": 0.0067, "ITHR": 0.0067, "NCIP": 0.0067,
+	"PPEL": 0.0067, "WÄHR": 0.0067, "ONNI": 0.0067, "ELAU": 0.0067, "HTVO": 0.0067,
+	"RDAN": 0.0067, "TRAT": 0.0067, "INEO": 0.0067, "HTAL": 0.0067, "EIZU": 0.0067,
+	"WOBE": 0.0067, "HSTE": 0.0067, "BASE": 0.0067, "LSWE": 0.0067, "CTOR": 0.0067,
+	"INCI": 0.0067, "IPAL": 0.0067, "DMIG": 0.0067, "STIL": 0.0066, "INFE": 0.0066,
+	"DENL": 0.0066, "FNEN": 0.0066, "TING": 0.0066, "SIEK": 0.0066, "TRÄG": 0.0066,
+	"SERE": 0.0066, "RDNE": 0.0066, "SERI": 0.0066, "KAGE": 0.0066, "MÄẞI": 0.0066,
+	"ÄẞIG": 0.0066, "IRDF": 0.0066, "NLZM": 0.0066, "IENU": 0.0066, "ATTR": 0.0066,
+	"EIAN": 0.0066, "DOPP": 0.0066, "ROUT": 0.0066, "LLGE": 0.0066, "NTEA": 0.0066,
+	"PEND": 0.0066, "TEIB": 0.0066, "ERRU": 0.0066, "IRDW": 0.0066, "ABIS": 0.0066,
+	"NCHE": 0.0065, "TABG": 0.0065, "LENN": 0.0065, "TTRI": 0.0065, "ENWÄ": 0.0065,
+	"LEBE": 0.0065, "NIMA": 0.0065, "ENMU": 0.0065, "AUSR": 0.0065, "NOMM": 0.0065,
+	"STEA": 0.0065, "NTAR": 0.0065, "GEÖF": 0.0065, "RENE": 0.0065, "DMIN": 0.0065,
+	"URZE": 0.0065, "UFLI": 0.0065, "IBTE": 0.0065, "GAUF": 0.0065, "NFEL": 0.0065,
+	"NERL": 0.0065, "ZULA": 0.0065, "LAUS": 0.0065, "ENDM": 0.0065, "TBES": 0.0065,
+	"HDER": 0.0065, "IPTE": 0.0065, "NANN": 0.0065, "SIEG": 0.0065, "RZWI": 0.0065,
+	"ETAU": 0.0065, "FÜLL": 0.0065, "NENH": 0.0065, "SPEC": 0.0065, "IMIN": 0.0065,
+	"KERN": 0.0065, "ITXZ": 0.0065, "LUND": 0.0065, "ERMA": 0.0065, "ERCO": 0.0065,
+	"EREM": 0.0065, "NTIF": 0.0065, "RGAN": 0.0065, "EPRI": 0.0065, "LENI": 0.0065,
+	"ÄTZL": 0.0065, "TEIZ": 0.0065, "VONI": 0.0065, "HERI": 0.0065, "SNUM": 0.0065,
+	"MITN": 0.0065, "IRDG": 0.0065, "TEWE": 0.0065, "SELW": 0.0065, "RDEB": 0.0065,
+	"RÜBE": 0.0065, "EZUM": 0.0065, "SWÖR": 0.0065, "FAKE": 0.0065, "TAPT": 0.0065,
+	"FHRE": 0.0065, "LIEN": 0.0064, "RFUN": 0.0064, "ESZE": 0.0064, "ERÄN": 0.0064,
+	"ZUSÄ": 0.0064, "USÄT": 0.0064, "ÜREI": 0.0064, "HRIC": 0.0064, "HTET": 0.0064,
+	"HDAS": 0.0064, "ISYS": 0.0064, "RTEG": 0.0064, "ECHS": 0.0064, "OTWE": 0.0064,
+	"DIET": 0.0064, "NEMA": 0.0064, "KETA": 0.0064, "OBER": 0.0064, "LTDI": 0.0064,
+	"LTIT": 0.0064, "TREU": 0.0064, "NDTW": 0.0064, "INTH": 0.0064, "SEVE": 0.0064,
+	"GMIT": 0.0064, "XIMA": 0.0064, "NENO": 0.0064, "ENOR": 0.0064, "TEMA": 0.0064,
+	"FÜRP": 0.0064, "GEAN": 0.0064, "SITZ": 0.0064, "ENNZ": 0.0064, "CHEV": 0.0064,
+	"ISTM": 0.0064, "VONU": 0.0064, "RHEI": 0.0064, "GTEN": 0.0064, "MBIN": 0.0064,
+	"DEBU": 0.0064, "NSYS": 0.0064, "MITF": 0.0064, "PGRA": 0.0064, "TITH": 0.0064,
+	"TRIE": 0.0063, "SCHÄ": 0.0063, "NGSZ": 0.0063, "NSYM": 0.0063, "HENG": 0.0063,
+	"UTZU": 0.0063, "ICHZ": 0.0063, "LDEN": 0.0063, "ILEA": 0.0063, "LINU": 0.0063,
+	"EHAL": 0.0063, "FLIS": 0.0063, "NOTW": 0.0063, "LTAB": 0.0063, "FEKT": 0.0063,
+	"INGS": 0.0063, "HERD": 0.0063, "ENEX": 0.0063, "AMES": 0.0063, "ECON": 0.0063,
+	"ONLY": 0.0063, "LERE": 0.0063, "EZUG": 0.0063, "HNUN": 0.0063, "MAIN": 0.0063,
+	"WÄRT": 0.0063, "NELI": 0.0063, "RNTW": 0.0063, "HENB": 0.0063, "ESYM": 0.0063,
+	"ETUN": 0.0063, "SAUC": 0.0063, "GITI": 0.0063, "NERN": 0.0063, "MFOR": 0.0063,
+	"ÜHRB": 0.0063, "MEML": 0.0063, "MLIM": 0.0063, "NELL": 0.0062, "LEDA": 0.0062,
+	"TKON": 0.0062, "TEZE": 0.0062, "LTES": 0.0062, "NVOL": 0.0062, "DESV": 0.0062,
+	"EHAN": 0.0062, "SIEB": 0.0062, "ERFR": 0.0062, "TRAC": 0.0062, "SSET": 0.0062,
+	"IESK": 0.0062, "EIEI": 0.0062, "XTEK": 0.0062, "THEP": 0.0062, "ENFR": 0.0062,
+	"EREA": 0.0062, "LINE": 0.0062, "ÄHRE": 0.0062, "LIGE": 0.0062, "IMSC": 0.0062,
+	"MSTA": 0.0062, "FLIK": 0.0062, "RMEH": 0.0062, "RTEA": 0.0062, "INRE": 0.0062,
+	"RDMÄ": 0.0062, "IONG": 0.0062, "EGRO": 0.0062, "AUFA": 0.0062, "NTIG": 0.0062,
+	"RIAN": 0.0061, "DEMI": 0.0061, "EMIN": 0.0061, "GEBR": 0.0061, "EFEL": 0.0061,
+	"ENFI": 0.0061, "NURA": 0.0061, "TANZ": 0.0061, "SSOR": 0.0061, "EMEL": 0.0061,
+	"WEIG": 0.0061, "DIND": 0.0061, "SBEI": 0.0061, "DMÄẞ": 0.0061, "IERB": 0.0061,
+	"EXTR": 0.0061, "NGIT": 0.0061, "FROM": 0.0061, "CONV": 0.0061, "ARES": 0.0061,
+	"NIED": 0.0061, "UNDU": 0.0061, "ÜHRU": 0.0061, "EILD": 0.0061, "INEK": 0.0061,
+	"IEEN": 0.0061, "ONGE": 0.0061, "PUFF": 0.0061, "INUX": 0.0061, "CHEZ": 0.0061,
+	"HRTE": 0.0061, "AUSL": 0.0061, "KTEN": 0.0061, "NEAN": 0.0061, "NFLU": 0.0061,
+	"ELTH": 0.0061, "UTHE": 0.0060, "HERG": 0.0060, "ALSS": 0.0060, "EMDE": 0.0060,
+	"AUTE": 0.0060, "LLBE": 0.0060, "SLIS": 0.0060, "EBAS": 0.0060, "WECH": 0.0060,
+	"HTDI": 0.0060, "ITIM": 0.0060, "MAUT": 0.0060, "BENF": 0.0060, "AMEA": 0.0060,
+	"INFL": 0.0060, "AKER": 0.0060, "HOST": 0.0060, "RANS": 0.0060, "ELTW": 0.0060,
+	"HTKO": 0.0060, "VONG": 0.0060, "PARS": 0.0060, "NEDE": 0.0060, "RDEA": 0.0060,
+	"NGSF": 0.0060, "ÜCKE": 0.0060, "ORAN": 0.0060, "MDAT": 0.0060, "GERÄ": 0.0060,
+	"BERA": 0.0060, "NNZE": 0.0060, "EBIT": 0.0060, "DEMB": 0.0060, "UGEF": 0.0060,
+	"ADMI": 0.0060, "HBAR": 0.0060, "ITVO": 0.0060, "ESYS": 0.0060, "DEDI": 0.0060,
+	"IFIS": 0.0060, "EUES": 0.0060, "HOCH": 0.0060, "NNOC": 0.0060, "RENB": 0.0060,
+	"ESED": 0.0060, "MADA": 0.0060, "TAIN": 0.0060, "LTHR": 0.0060, "MSSE": 0.0060,
+	"RIEN": 0.0059, "MISC": 0.0059, "IENM": 0.0059, "NEUN": 0.0059, "INEW": 0.0059,
+	"EMUS": 0.0059, "DODE": 0.0059, "SRIC": 0.0059, "ACKT": 0.0059, "NKEN": 0.0059,
+	"NGWI": 0.0059, "NHAN": 0.0059, "RENG": 0.0059, "KLIC": 0.0059, "HENO": 0.0059,
+	"GSST": 0.0059, "DESW": 0.0059, "EKOP": 0.0059, "MENW": 0.0059, "OFTH": 0.0059,
+	"CHKO": 0.0059, "ERRI": 0.0059, "NEUT": 0.0059, "RHAF": 0.0059, "INIM": 0.0059,
+	"ANDL": 0.0059, "ÄREN": 0.0059, "GEPA": 0.0059, "LGRE": 0.0059, "EAPT": 0.0059,
+	"GEDA": 0.0059, "RENO": 0.0059, "IESP": 0.0059, "ESEL": 0.0059, "LEDI": 0.0059,
+	"NINE": 0.0059, "GEWI": 0.0059, "NINF": 0.0059, "ZDAT": 0.0059, "VONR": 0.0059,
+	"STLE": 0.0058, "ICHU": 0.0058, "RDVE": 0.0058, "HNEN": 0.0058, "ESSC": 0.0058,
+	"EZUR": 0.0058, "TFOR": 0.0058, "ILED": 0.0058, "NJED": 0.0058, "RDGE": 0.0058,
+	"MSYS": 0.0058, "OLGR": 0.0058, "SQUE": 0.0058, "HAUS": 0.0058, "DIGT": 0.0058,
+	"SEAU": 0.0058, "DVOR": 0.0058, "DESF": 0.0058, "ADDU": 0.0058, "IMAU": 0.0058,
+	"SAME": 0.0058, "NEME": 0.0058, "EALT": 0.0058, "RNEL": 0.0058, "HEGR": 0.0058,
+	"TGET": 0.0058, "TERR": 0.0058, "OUNT": 0.0058, "LINI": 0.0058, "HRAL": 0.0058,
+	"GFEH": 0.0058, "SENI": 0.0058, "FÜRA": 0.0058, "SELU": 0.0058, "EADR": 0.0058,
+	"ERÄT": 0.0058, "BENT": 0.0058, "IDES": 0.0058, "RDIG": 0.0058, "EHIN": 0.0058,
+	"TEAL": 0.0058, "EITA": 0.0058, "UNGK": 0.0058, "ENUR": 0.0058, "EFAL": 0.0058,
+	"MODI": 0.0058, "NNES": 0.0058, "CHGR": 0.0058, "OVER": 0.0058, "ONPA": 0.0058,
+	"ERTO": 0.0058, "KOPF": 0.0058, "ENVI": 0.0058, "NNWI": 0.0058, "ZELT": 0.0058,
+	"KERO": 0.0058, "OUTE": 0.0058, "THEC": 0.0058, "ERFG": 0.0058, "ENLZ": 0.0058,
+	"ASKO": 0.0057, "IONK": 0.0057, "INEP": 0.0057, "LERH": 0.0057, "LIND": 0.0057,
+	"ERDR": 0.0057, "TIMI": 0.0057, "KILL": 0.0057, "RDEF": 0.0057, "DAZU": 0.0057,
+	"LLEP": 0.0057, "IEWE": 0.0057, "ERSH": 0.0057, "DFÜR": 0.0057, "ENBR": 0.0057,
+	"THED": 0.0057, "SERB": 0.0057, "NEAU": 0.0057, "ESSP": 0.0057, "TZWE": 0.0057,
+	"RSEN": 0.0057, "INPR": 0.0057, "EMPO": 0.0057, "IEAL": 0.0057, "NEHM": 0.0057,
+	"HINT": 0.0057, "PSTR": 0.0057, "IEUM": 0.0057, "DETS": 0.0057, "FAHR": 0.0057,
+	"SSIS": 0.0057, "ANNV": 0.0057, "HLSS": 0.0057, "NVIM": 0.0057, "GUND": 0.0056,
+	"RLAN": 0.0056, "ÖẞEN": 0.0056, "NTEK": 0.0056, "EERW": 0.0056, "ISUN": 0.0056,
+	"SIEW": 0.0056, "UNGG": 0.0056, "IESO": 0.0056, "RSYS": 0.0056, "ZUEI": 0.0056,
+	"ZUWE": 0.0056, "DIEH": 0.0056, "BAUM": 0.0056, "RAUM": 0.0056, "DENR": 0.0056,
+	"NISI": 0.0056, "ELVE": 0.0056, "ENEB": 0.0056, "IRDU": 0.0056, "GRIT": 0.0056,
+	"HEHE": 0.0056, "AGES": 0.0056, "FRAN": 0.0056, "TRAN": 0.0056, "RGEF": 0.0056,
+	"ETIN": 0.0056, "HEAU": 0.0056, "HBED": 0.0056, "ULAT": 0.0056, "NZWI": 0.0056,
+	"LENO": 0.0056, "ÜCKS": 0.0056, "DANG": 0.0056, "LLDA": 0.0056, "ISEN": 0.0056,
+	"ZWIN": 0.0056, "TINF": 0.0056, "IGEB": 0.0056, "USSI": 0.0056, "ABEV": 0.0056,
+	"DABE": 0.0056, "TEIV": 0.0056, "VONH": 0.0056, "ENSA": 0.0056, "ITIN": 0.0056,
+	"HNEL": 0.0056, "RTHR": 0.0056, "VONX": 0.0056, "RCHA": 0.0056, "BGES": 0.0056,
+	"AMEI": 0.0056, "HSCH": 0.0056, "ÜFEN": 0.0056, "OMPI": 0.0056, "MPIL": 0.0056,
+	"ERRO": 0.0056, "HLIC": 0.0056, "IEZE": 0.0056, "ANUN": 0.0056, "UFFI": 0.0056,
+	"TEPE": 0.0056, "LTDE": 0.0056, "HOLE": 0.0056, "ZERD": 0.0056, "ERBL": 0.0056,
+	"CKGE": 0.0056, "RÄGE": 0.0056, "IMVE": 0.0056, "IMBE": 0.0056, "SSWD": 0.0056,
+	"ESSY": 0.0056, "REUE": 0.0056, "ONKA": 0.0055, "ENED": 0.0055, "IMEI": 0.0055,
+	"BERO": 0.0055, "FÜRG": 0.0055, "FÜRN": 0.0055, "ESEH": 0.0055, "ACHA": 0.0055,
+	"RÄNK": 0.0055, "URBE": 0.0055, "NNAU": 0.0055, "EINO": 0.0055, "NDWI": 0.0055,
+	"SUFF": 0.0055, "KETD": 0.0055, "UMAN": 0.0055, "NDEF": 0.0055, "EABH": 0.0055,
+	"ESST": 0.0055, "GWUR": 0.0055, "VERM": 0.0055, "ESHE": 0.0055, "TEKO": 0.0055,
+	"ETRI": 0.0055, "GENK": 0.0055, "UCHA": 0.0055, "ELLI": 0.0055, "INSA": 0.0055,
+	"ENNK": 0.0055, "KREO": 0.0055, "REOL": 0.0055, "BLÖC": 0.0055, "LÖCK": 0.0055,
+	"ÖCKE": 0.0055, "KGDI": 0.0055, "EMAR": 0.0055, "NETE": 0.0055, "SEDI": 0.0055,
+	"EIDA": 0.0055, "EIVE": 0.0055, "REGE": 0.0055, "ATTE": 0.0055, "NDSI": 0.0055,
+	"TKOM": 0.0055, "MDAS": 0.0055, "IEGI": 0.0055, "MPLI": 0.0055, "EXIT": 0.0055,
+	"ERNF": 0.0055, "VONV": 0.0055, "SZUR": 0.0055, "ACKE": 0.0055, "RALE": 0.0055,
+	"UTOR": 0.0055, "ASIE": 0.0054, "MASC": 0.0054, "TETA": 0.0054, "ILEI": 0.0054,
+	"ASER": 0.0054, "TUMD": 0.0054, "SANZ": 0.0054, "ESKE": 0.0054, "NGRU": 0.0054,
+	"DEMP": 0.0054, "IZIT": 0.0054, "LSUN": 0.0054, "HWEN": 0.0054, "ATUM": 0.0054,
+	"HIVE": 0.0054, "FADE": 0.0054, "SERW": 0.0054, "ESAL": 0.0054, "HISC": 0.0054,
+	"ELSW": 0.0054, "RRUF": 0.0054, "NAUE": 0.0054, "NEDI": 0.0054, "ADNA": 0.0054,
+	"ZUMS": 0.0054, "TZTI": 0.0054, "MERD": 0.0054, "RINS": 0.0054, "ALLG": 0.0054,
+	"FFIX": 0.0054, "ASIS": 0.0054, "ONSO": 0.0054, "NSIG": 0.0054, "LVON": 0.0054,
+	"KORR": 0.0054, "WOHL": 0.0054, "DIGN": 0.0054, "WOLL": 0.0054, "DASG": 0.0054,
+	"GNIE": 0.0054, "ANZZ": 0.0054, "RREN": 0.0054, "NPFA": 0.0054, "SZUM": 0.0054,
+	"IENO": 0.0054, "EEIG": 0.0054, "LSBE": 0.0054, "TREM": 0.0054, "HEDE": 0.0054,
+	"GITR": 0.0054, "RBOS": 0.0054, "BOSE": 0.0054, "ERKR": 0.0053, "ETBE": 0.0053,
+	"ATDE": 0.0053, "CHSC": 0.0053, "IMAN": 0.0053, "LEIS": 0.0053, "RPAS": 0.0053,
+	"SWAH": 0.0053, "BREC": 0.0053, "RSIE": 0.0053, "FDAS": 0.0053, "SGÜL": 0.0053,
+	"NNMI": 0.0053, "DDUS": 0.0053, "NISD": 0.0053, "CHIM": 0.0053, "VONL": 0.0053,
+	"EDEB": 0.0053, "LLIN": 0.0053, "GELI": 0.0053, "ESWE": 0.0053, "MATE": 0.0053,
+	"HEPA": 0.0053, "LBEW": 0.0053, "GEOR": 0.0053, "SCHO": 0.0053, "ENTD": 0.0053,
+	"AHLE": 0.0053, "NTEL": 0.0053, "EPAR": 0.0053, "NFÜH": 0.0053, "USUN": 0.0053,
+	"ILEE": 0.0053, "NOHN": 0.0053, "ENOM": 0.0053, "VONK": 0.0053, "HMEN": 0.0053,
+	"SESS": 0.0053, "NTRÄ": 0.0053, "VONZ": 0.0053, "SMOD": 0.0053, "SESI": 0.0053,
+	"NGWU": 0.0053, "ELEE": 0.0053, "OPEN": 0.0053, "NZZA": 0.0053, "ZZAH": 0.0053,
+	"IEPA": 0.0053, "SESF": 0.0053, "EDEM": 0.0053, "TABL": 0.0053, "ERKZ": 0.0053,
+	"CHLS": 0.0053, "NTRI": 0.0052, "RATE": 0.0052, "OCHN": 0.0052, "NESS": 0.0052,
+	"DEVE": 0.0052, "USDA": 0.0052, "RDVO": 0.0052, "ERIM": 0.0052, "UNVE": 0.0052,
+	"GEVO": 0.0052, "RNAC": 0.0052, "HRÄN": 0.0052, "ITIG": 0.0052, "DPRO": 0.0052,
+	"NBIT": 0.0052, "ERRA": 0.0052, "ANPA": 0.0052, "SSSI": 0.0052, "GEFE": 0.0052,
+	"NIHR": 0.0052, "TZTU": 0.0052, "TYPI": 0.0052, "EPRÜ": 0.0052, "NSPA": 0.0052,
+	"EOLI": 0.0052, "ZUME": 0.0052, "RAFT": 0.0052, "RFGB": 0.0052, "FGBA": 0.0052,
+	"XZDA": 0.0052, "ONXZ": 0.0052, "NDEA": 0.0052, "INAU": 0.0052, "TESC": 0.0052,
+	"RSIG": 0.0052, "RTVO": 0.0052, "NTWO": 0.0052, "EMET": 0.0052, "DFEH": 0.0052,
+	"NDPR": 0.0052, "DSIE": 0.0052, "ONPR": 0.0052, "HERR": 0.0052, "TEHT": 0.0052,
+	"DATU": 0.0052, "RSIC": 0.0052, "NEMS": 0.0052, "WALT": 0.0052, "EIGN": 0.0052,
+	"GTWI": 0.0052, "ZVER": 0.0052, "PTGE": 0.0052, "MGLI": 0.0052, "SPAN": 0.0051,
+	"AMIT": 0.0051, "MASK": 0.0051, "ETIE": 0.0051, "TELT": 0.0051, "NETZ": 0.0051,
+	"ETZW": 0.0051, "MITI": 0.0051, "EABE": 0.0051, "NSNU": 0.0051, "NABS": 0.0051,
+	"ESON": 0.0051, "INZI": 0.0051, "HTLI": 0.0051, "NIGN": 0.0051, "GIND": 0.0051,
+	"ONDP": 0.0051, "USST": 0.0051, "HICH": 0.0051, "ARED": 0.0051, "COUN": 0.0051,
+	"REBA": 0.0051, "INRP": 0.0051, "TTAS": 0.0051, "HENZ": 0.0051, "MZEI": 0.0051,
+	"DESI": 0.0051, "ORTA": 0.0051, "TEXI": 0.0051, "USWA": 0.0051, "STFR": 0.0051,
+	"ETVE": 0.0051, "EFÜG": 0.0051, "UTIO": 0.0051, "RINF": 0.0051, "EMSC": 0.0051,
+	"NDLI": 0.0051, "GERT": 0.0051, "GZIP": 0.0051, "NIEM": 0.0051, "TZTA": 0.0051,
+	"SINN": 0.0051, "EZUE": 0.0051, "EGTW": 0.0051, "UGEB": 0.0051, "IRDM": 0.0051,
+	"FADN": 0.0051, "MITX": 0.0051, "ONRE": 0.0051, "RKZE": 0.0051, "ANDS": 0.0050,
+	"HERZ": 0.0050, "DWES": 0.0050, "LEWE": 0.0050, "LESS": 0.0050, "NGNI": 0.0050,
+	"NINT": 0.0050, "STYP": 0.0050, "TZUG": 0.0050, "NHÄN": 0.0050, "EHME": 0.0050,
+	"DASL": 0.0050, "EBUG": 0.0050, "NDZU": 0.0050, "IESW": 0.0050, "ERTZ": 0.0050,
+	"TESD": 0.0050, "OBEN": 0.0050, "ELLD": 0.0050, "OLDA": 0.0050, "NDAL": 0.0050,
+	"SOWI": 0.0050, "RKRA": 0.0050, "DENH": 0.0050, "EFRA": 0.0050, "RTAB": 0.0050,
+	"TLEE": 0.0050, "ECHA": 0.0050, "QUIR": 0.0050, "MDER": 0.0050, "BINA": 0.0050,
+	"NGLE": 0.0050, "INBI": 0.0050, "KLAS": 0.0050, "REIE": 0.0050, "ALSO": 0.0050,
+	"BESO": 0.0050, "SDEN": 0.0050, "LTUN": 0.0050, "SSEA": 0.0050, "RETE": 0.0050,
+	"ABEE": 0.0050, "ZULE": 0.0050, "DDEN": 0.0050, "GEWA": 0.0050, "REAT": 0.0050,
+	"RARC": 0.0050, "IGED": 0.0050, "LEIB": 0.0050, "ZMAU": 0.0050, "UFZE": 0.0050,
+	"KZEU": 0.0050, "MATD": 0.0050, "FSUM": 0.0050, "SODA": 0.0050, "UPTS": 0.0049,
+	"CHLU": 0.0049, "MPFA": 0.0049, "TEPR": 0.0049, "ERMU": 0.0049, "GSZE": 0.0049,
+	"EIBT": 0.0049, "INKS": 0.0049, "RUCH": 0.0049, "RANT": 0.0049, "ABEG": 0.0049,
+	"NEOP": 0.0049, "MDEN": 0.0049, "TSAN": 0.0049, "ÖFFE": 0.0049, "DECO": 0.0049,
+	"RKUN": 0.0049, "DOCH": 0.0049, "SENV": 0.0049, "MKOM": 0.0049, "DEFA": 0.0049,
+	"NBLO": 0.0049, "NRPA": 0.0049, "NIEN": 0.0049, "REST": 0.0049, "ALAN": 0.0049,
+	"VERN": 0.0049, "MANI": 0.0049, "NNUM": 0.0049, "MODE": 0.0049, "UIRE": 0.0049,
+	"INLI": 0.0049, "NGIB": 0.0049, "NLEE": 0.0049, "TREF": 0.0049, "ANNB": 0.0049,
+	"EIKA": 0.0049, "NALA": 0.0049, "NWEL": 0.0049, "SBEF": 0.0049, "DBEN": 0.0049,
+	"RDEW": 0.0049, "EBET": 0.0049, "SSDA": 0.0049, "HWIN": 0.0049, "IMUM": 0.0049,
+	"STST": 0.0049, "NTWI": 0.0049, "ZTER": 0.0049, "IENV": 0.0049, "LODE": 0.0049,
+	"IMAR": 0.0049, "TZUD": 0.0049, "STFA": 0.0049, "EHEH": 0.0049, "KRAF": 0.0049,
+	"ONGO": 0.0048, "ATAN": 0.0048, "EINR": 0.0048, "MITK": 0.0048, "TLES": 0.0048,
+	"TEER": 0.0048, "INEH": 0.0048, "SSTE": 0.0048, "ESWU": 0.0048, "ISTV": 0.0048,
+	"PORÄ": 0.0048, "ORÄR": 0.0048, "RÄRE": 0.0048, "ITSA": 0.0048, "ESGÜ": 0.0048,
+	"MSPE": 0.0048, "TSAU": 0.0048, "RESE": 0.0048, "NDIR": 0.0048, "IENW": 0.0048,
+	"EMPA": 0.0048, "INMA": 0.0048, "ASSS": 0.0048, "DFAL": 0.0048, "EUMG": 0.0048,
+	"YPEN": 0.0048, "ELET": 0.0048, "NEXI": 0.0048, "SINT": 0.0048, "PUND": 0.0048,
+	"ESWÖ": 0.0048, "ENMA": 0.0048, "IANG": 0.0048, "RGRÖ": 0.0048, "HESE": 0.0048,
+	"SEEI": 0.0048, "ENTT": 0.0048, "WAHR": 0.0048, "USRI": 0.0048, "HEVE": 0.0048,
+	"URAU": 0.0048, "LGEM": 0.0048, "VORZ": 0.0048, "CHTP": 0.0048, "DKEI": 0.0048,
+	"GEHÖ": 0.0048, "EHÖR": 0.0048, "ZERK": 0.0048, "OMBI": 0.0048, "SINS": 0.0048,
+	"ONFE": 0.0048, "RATO": 0.0048, "EERR": 0.0048, "MITZ": 0.0048, "CREA": 0.0048,
+	"PLEM": 0.0048, "NANS": 0.0048, "MALI": 0.0048, "NSAT": 0.0048, "EMAI": 0.0048,
+	"UMAU": 0.0048, "MPAK": 0.0048, "WHIC": 0.0048, "BUTI": 0.0047, "TESS": 0.0047,
+	"GERE": 0.0047, "LÄSS": 0.0047, "UCHB": 0.0047, "NDRE": 0.0047, "LENP": 0.0047,
+	"IAUS": 0.0047, "STVE": 0.0047, "ESEV": 0.0047, "EIMP": 0.0047, "CHÄD": 0.0047,
+	"DSTA": 0.0047, "ÄLTE": 0.0047, "SERN": 0.0047, "NGEP": 0.0047, "IEGT": 0.0047,
+	"DEZI": 0.0047, "ENDS": 0.0047, "NENL": 0.0047, "HTDA": 0.0047, "ÜBLI": 0.0047,
+	"DESC": 0.0047, "NEAR": 0.0047, "RÜFT": 0.0047, "UMZU": 0.0047, "ASSC": 0.0047,
+	"RTSE": 0.0047, "ESBA": 0.0047, "GEFR": 0.0047, "NLIS": 0.0047, "DAMI": 0.0047,
+	"NÜTZ": 0.0047, "DTHE": 0.0047, "ASTA": 0.0047, "HTIM": 0.0047, "BGEL": 0.0047,
+	"AHLA": 0.0047, "CHSU": 0.0047, "BEGE": 0.0047, "DASI": 0.0047, "HÄDI": 0.0047,
+	"ÄDIG": 0.0047, "RVIE": 0.0047, "DALS": 0.0047, "IRKL": 0.0047, "RKLI": 0.0047,
+	"HEDA": 0.0047, "NZWE": 0.0047, "ISEI": 0.0047, "EROR": 0.0047, "ENOB": 0.0047,
+	"RHOL": 0.0047, "TTHE": 0.0047, "APTG": 0.0047, "HENN": 0.0046, "APPL": 0.0046,
+	"URIN": 0.0046, "VERE": 0.0046, "TLIN": 0.0046, "NFRA": 0.0046, "TCHA": 0.0046,
+	"TTEI": 0.0046, "ZWER": 0.0046, "ERTV": 0.0046, "REAU": 0.0046, "STNU": 0.0046,
+	"RZUM": 0.0046, "HALL": 0.0046, "NERG": 0.0046, "NREC": 0.0046, "SEVO": 0.0046,
+	"EZIM": 0.0046, "RELA": 0.0046, "KURZ": 0.0046, "NGSE": 0.0046, "IONL": 0.0046,
+	"EBYT": 0.0046, "LLSN": 0.0046, "LDET": 0.0046, "FDEM": 0.0046, "MEVE": 0.0046,
+	"NNBE": 0.0046, "EDIS": 0.0046, "LEEI": 0.0046, "HTAB": 0.0046, "EIER": 0.0046,
+	"EAUT": 0.0046, "ESSA": 0.0046, "DSEI": 0.0046, "ETEA": 0.0046, "RNER": 0.0046,
+	"ANFA": 0.0046, "BRUC": 0.0046, "RESI": 0.0046, "LEPA": 0.0046, "ÄNGT": 0.0046,
+	"EMSY": 0.0046, "TEFÜ": 0.0046, "LOAD": 0.0046, "INDN": 0.0046, "GELS": 0.0046,
+	"HRLI": 0.0046, "LKAN": 0.0046, "RREK": 0.0046, "ELAT": 0.0046, "NEGR": 0.0046,
+	"TÄTS": 0.0046, "ETEM": 0.0046, "SERZ": 0.0046, "OLCH": 0.0046, "NSET": 0.0046,
+	"NWÄR": 0.0046, "TSOL": 0.0046, "HDAT": 0.0046, "STOP": 0.0046, "ERLO": 0.0046,
+	"INET": 0.0046, "ABEZ": 0.0046, "ANDD": 0.0046, "TLEG": 0.0046, "ẞEND": 0.0046,
+	"UCHG": 0.0046, "EITG": 0.0046, "FOPT": 0.0046, "TETS": 0.0046, "LIEB": 0.0046,
+	"AGER": 0.0046, "RTEF": 0.0046, "ZIMA": 0.0046, "UMLE": 0.0046, "PATH": 0.0046,
+	"TNOT": 0.0046, "EOBJ": 0.0046, "LEAR": 0.0046, "SOLC": 0.0046, "EHEA": 0.0046,
+	"NDAD": 0.0046, "DEBD": 0.0046, "INUM": 0.0046, "RMEN": 0.0046, "DKOM": 0.0046,
+	"PART": 0.0046, "HGRÖ": 0.0046, "ENXZ": 0.0046, "VALU": 0.0046, "PKGA": 0.0046,
+	"OWIE": 0.0045, "NTSC": 0.0045, "TIBE": 0.0045, "HMUS": 0.0045, "RPRE": 0.0045,
+	"TEKE": 0.0045, "CHTÜ": 0.0045, "IANI": 0.0045, "NEPA": 0.0045, "SHOW": 0.0045,
+	"ACHF": 0.0045, "REFF": 0.0045, "RENM": 0.0045, "NISW": 0.0045, "TEIH": 0.0045,
+	"GTDE": 0.0045, "NIMM": 0.0045, "TEBI": 0.0045, "VERÄ": 0.0045, "RCHG": 0.0045,
+	"RQUE": 0.0045, "AUFW": 0.0045, "CHSI": 0.0045, "FASS": 0.0045, "RESP": 0.0045,
+	"GTAU": 0.0045, "EMOR": 0.0045, "GESI": 0.0045, "MPRO": 0.0045, "NBEG": 0.0045,
+	"ORZU": 0.0045, "NEKO": 0.0045, "EVIE": 0.0045, "LLEE": 0.0045, "HERK": 0.0045,
+	"RNDI": 0.0045, "RCHL": 0.0045, "DULE": 0.0045, "ACCO": 0.0045, "THEF": 0.0045,
+	"HTEI": 0.0045, "ERNS": 0.0045, "USSA": 0.0045, "TRAU": 0.0045, "SYNC": 0.0045,
+	"ALBE": 0.0045, "EANW": 0.0045, "INBY": 0.0045, "ODAT": 0.0045, "EIKO": 0.0045,
+	"ÜRKO": 0.0045, "IEDL": 0.0045, "IEHA": 0.0045, "BELI": 0.0045, "ENQU": 0.0045,
+	"GERN": 0.0045, "RDUR": 0.0045, "MMAT": 0.0045, "NPRI": 0.0045, "DNET": 0.0045,
+	"SNOT": 0.0045, "EGAN": 0.0045, "ERFL": 0.0045, "DEWI": 0.0045, "GELO": 0.0045,
+	"KETV": 0.0045, "ZURE": 0.0045, "ABEF": 0.0045, "ICKE": 0.0045, "HESA": 0.0045,
+	"ÜTZL": 0.0045, "OBOT": 0.0045, "DFOR": 0.0045, "ALUE": 0.0045, "ESEE": 0.0045,
+	"NLAS": 0.0045, "THEG": 0.0045, "INGI": 0.0044, "ENGI": 0.0044, "RERW": 0.0044,
+	"NGVE": 0.0044, "NEVO": 0.0044, "EMDA": 0.0044, "EKUR": 0.0044, "IDAT": 0.0044,
+	"NNTW": 0.0044, "ABBR": 0.0044, "TOPT": 0.0044, "BITS": 0.0044, "RTGE": 0.0044,
+	"SZIE": 0.0044, "ALIN": 0.0044, "ASEI": 0.0044, "ERXZ": 0.0044, "BEAN": 0.0044,
+	"MATS": 0.0044, "ẞERD": 0.0044, "EMOD": 0.0044, "ERÜB": 0.0044, "HTWI": 0.0044,
+	"SHIN": 0.0044, "ZTIS": 0.0044, "IMSE": 0.0044, "HEKE": 0.0044, "HENM": 0.0044,
+	"BOLE": 0.0044, "ONTO": 0.0044, "STRÖ": 0.0044, "TRÖM": 0.0044, "RÖME": 0.0044,
+	"DIGK": 0.0044, "ROBO": 0.0044, "SSIC": 0.0044, "TEWI": 0.0044, "DITO": 0.0044,
+	"PECI": 0.0044, "CCOU": 0.0044, "KANI": 0.0044, "IFOR": 0.0044, "ÜTZU": 0.0044,
+	"FNIC": 0.0044, "ERGA": 0.0044, "NDSE": 0.0044, "EITV": 0.0044, "LTED": 0.0044,
+	"RREG": 0.0044, "EPAC": 0.0044, "MALL": 0.0044, "NGEM": 0.0044, "SERM": 0.0044,
+	"EART": 0.0044, "EORD": 0.0044, "ANNI": 0.0044, "DARA": 0.0044, "IMKO": 0.0044,
+	"SIEF": 0.0044, "BEFI": 0.0044, "ELGE": 0.0044, "SBED": 0.0044, "IFIE": 0.0044,
+	"HLTD": 0.0044, "EITB": 0.0044, "RPRF": 0.0044, "ORDI": 0.0043, "ALIT": 0.0043,
+	"UMBR": 0.0043, "DELN": 0.0043, "NISV": 0.0043, "NSER": 0.0043, "IGEP": 0.0043,
+	"GERW": 0.0043, "NINH": 0.0043, "ETWU": 0.0043, "ESSU": 0.0043, "ESOF": 0.0043,
+	"DEAN": 0.0043, "LITÄ": 0.0043, "NZIG": 0.0043, "EDLI": 0.0043, "IEES": 0.0043,
+	"IENK": 0.0043, "SALL": 0.0043, "ANHA": 0.0043, "DESH": 0.0043, "IEIM": 0.0043,
+	"AILS": 0.0043, "UGTW": 0.0043, "SGEL": 0.0043, "ASEN": 0.0043, "UDER": 0.0043,
+	"REBE": 0.0043, "ASAU": 0.0043, "ELLA": 0.0043, "RTAN": 0.0043, "ARTI": 0.0043,
+	"PISC": 0.0043, "IENN": 0.0043, "RECO": 0.0043, "NMAL": 0.0043, "SIEZ": 0.0043,
+	"ENBL": 0.0043, "FGEF": 0.0043, "DEFS": 0.0043, "KNNT": 0.0043, "TVIM": 0.0043,
+	"ASSA": 0.0043, "KENS": 0.0043, "MSER": 0.0043, "HTME": 0.0043, "SSDE": 0.0043,
+	"TBEE": 0.0043, "ITHM": 0.0043, "TEAB": 0.0043, "REPA": 0.0043, "ISAU": 0.0043,
+	"MNIC": 0.0043, "ENOH": 0.0043, "DAND": 0.0043, "INOD": 0.0043, "ZIGE": 0.0043,
+	"BEKO": 0.0043, "ESEF": 0.0043, "SSAU": 0.0043, "SWÜR": 0.0043, "TTEA": 0.0043,
+	"RZEL": 0.0043, "SEHR": 0.0043, "ICHG": 0.0043, "NALT": 0.0043, "NREG": 0.0043,
+	"LATZ": 0.0043, "JEDO": 0.0043, "EDOC": 0.0043, "ILLI": 0.0043, "NKTE": 0.0043,
+	"GTVO": 0.0043, "VERR": 0.0043, "LEKO": 0.0043, "ATED": 0.0043, "EBIB": 0.0043,
+	"FFIL": 0.0043, "NSAM": 0.0043, "REDI": 0.0043, "DEEI": 0.0043, "BENB": 0.0043,
+	"ALAI": 0.0043, "RENK": 0.0043, "ONFF": 0.0043, "TOTH": 0.0043, "ORTH": 0.0042,
+	"AMIS": 0.0042, "ESUN": 0.0042, "ACHS": 0.0042, "NESE": 0.0042, "UNGO": 0.0042,
+	"GODE": 0.0042, "RITH": 0.0042, "NTEE": 0.0042, "NELE": 0.0042, "EGEF": 0.0042,
+	"RJED": 0.0042, "TEOP": 0.0042, "IEKÖ": 0.0042, "UFTR": 0.0042, "UGTE": 0.0042,
+	"ABEK": 0.0042, "CHEO": 0.0042, "CHEE": 0.0042, "LLEZ": 0.0042, "TRET": 0.0042,
+	"ASAN": 0.0042, "TEMB": 0.0042, "NDGR": 0.0042, "ZTDE": 0.0042, "GSEI": 0.0042,
+	"EIFO": 0.0042, "RDSI": 0.0042, "IAUF": 0.0042, "THIN": 0.0042, "EROB": 0.0042,
+	"ÄRTI": 0.0042, "ODAS": 0.0042, "ASFO": 0.0042, "MAIL": 0.0042, "PFAN": 0.0042,
+	"ESOL": 0.0042, "ATES": 0.0042, "HATD": 0.0042, "AUFN": 0.0042, "HTÜB": 0.0042,
+	"EREX": 0.0042, "ETED": 0.0042, "GTDA": 0.0042, "LEEN": 0.0042, "EANS": 0.0042,
+	"LLSK": 0.0042, "TSKO": 0.0042, "INKE": 0.0042, "ÄRED": 0.0042, "HEID": 0.0042,
+	"PIEG": 0.0042, "GSTE": 0.0042, "LBEN": 0.0042, "RGÄN": 0.0042, "GENP": 0.0042,
+	"BROC": 0.0042, "ROCH": 0.0042, "POST": 0.0042, "EPAT": 0.0042, "GBEI": 0.0042,
+	"DGRO": 0.0042, "ENEA": 0.0042, "ETAD": 0.0042, "NWOL": 0.0042, "SCHD": 0.0042,
+	"USBE": 0.0042, "ZURA": 0.0042, "INCO": 0.0042, "AUTI": 0.0042, "ISDE": 0.0042,
+	"ARER": 0.0042, "GSAM": 0.0042, "AHLF": 0.0042, "BEIE": 0.0042, "FRDE": 0.0042,
+	"ARST": 0.0041, "IETE": 0.0041, "ATKE": 0.0041, "LAUT": 0.0041, "ELAS": 0.0041,
+	"NFAN": 0.0041, "IGEM": 0.0041, "ILIT": 0.0041, "NEMB": 0.0041, "DISA": 0.0041,
+	"IEAB": 0.0041, "ONSM": 0.0041, "NERR": 0.0041, "EMWE": 0.0041, "MENE": 0.0041,
+	"NERB": 0.0041, "RIDE": 0.0041, "EATE": 0.0041, "TCON": 0.0041, "EIIN": 0.0041,
+	"YPIS": 0.0041, "ENPF": 0.0041, "SDUR": 0.0041, "ANSP": 0.0041, "ESEK": 0.0041,
+	"SCRI": 0.0041, "STOR": 0.0041, "LETT": 0.0041, "SARE": 0.0041, "EUNK": 0.0041,
+	"RWAL": 0.0041, "STEZ": 0.0041, "FFEK": 0.0041, "EDOK": 0.0041, "UERF": 0.0041,
+	"ONES": 0.0041, "ININ": 0.0041, "ULAN": 0.0041, "GORI": 0.0041, "ONMI": 0.0041,
+	"ANTW": 0.0041, "NGGE": 0.0041, "RDRÜ": 0.0041, "ENLA": 0.0041, "MBEF": 0.0041,
+	"ESTS": 0.0041, "UMDA": 0.0041, "YTEA": 0.0041, "KTWE": 0.0041, "ALID": 0.0041,
+	"TELI": 0.0041, "RSYM": 0.0041, "HVON": 0.0041, "NCOD": 0.0041, "EGLE": 0.0041,
+	"NBEA": 0.0041, "HLFÜ": 0.0041, "HWEI": 0.0041, "EMSI": 0.0041, "SEXI": 0.0041,
+	"NGRÖ": 0.0041, "RAGT": 0.0041, "LAII": 0.0041, "IISC": 0.0041, "EPEC": 0.0041,
+	"EBLO": 0.0041, "REME": 0.0041, "NONE": 0.0041, "TGLE": 0.0041, "LEUN": 0.0041,
+	"RDWI": 0.0041, "DTWE": 0.0041, "NFFI": 0.0041, "VIMI": 0.0041, "RFRD": 0.0041,
+	"ANZÖ": 0.0040, "CHEW": 0.0040, "INHE": 0.0040, "ETAB": 0.0040, "LLEB": 0.0040,
+	"ASHE": 0.0040, "RZUR": 0.0040, "TFRE": 0.0040, "UCHM": 0.0040, "TETI": 0.0040,
+	"BILI": 0.0040, "RFAC": 0.0040, "HGEF": 0.0040, "DEPO": 0.0040, "GENM": 0.0040,
+	"BERM": 0.0040, "LNAM": 0.0040, "BGEB": 0.0040, "HKOM": 0.0040, "IMDE": 0.0040,
+	"RERZ": 0.0040, "VALI": 0.0040, "HIEB": 0.0040, "IBEL": 0.0040, "LENF": 0.0040,
+	"IEDU": 0.0040, "SOWO": 0.0040, "NDGE": 0.0040, "FLAG": 0.0040, "RTEV": 0.0040,
+	"TTER": 0.0040, "TJED": 0.0040, "ONHE": 0.0040, "PROX": 0.0040, "BISZ": 0.0040,
+	"NWAS": 0.0040, "TDAN": 0.0040, "TARC": 0.0040, "XZVE": 0.0040, "ITWE": 0.0040,
+	"SZUE": 0.0040, "SEWE": 0.0040, "ILLB": 0.0040, "ZUND": 0.0040, "RTHE": 0.0040,
+	"RDUN": 0.0040, "ENHE": 0.0040, "BERW": 0.0040, "ANFR": 0.0040, "ICEN": 0.0040,
+	"TTED": 0.0040, "TATS": 0.0040, "ALSP": 0.0040, "SSED": 0.0040, "INDW": 0.0040,
+	"TGEÖ": 0.0040, "EREV": 0.0040, "IEDO": 0.0040, "MIST": 0.0040, "VONW": 0.0040,
+	"RCHK": 0.0040, "EIMV": 0.0040, "ALAU": 0.0040, "SLET": 0.0040, "ALSB": 0.0040,
+	"ERTK": 0.0040, "HATE": 0.0040, "EXPO": 0.0040, "EREZ": 0.0040, "ZENV": 0.0040,
+	"HLSC": 0.0040, "ROXY": 0.0040, "ESIT": 0.0040, "GEEI": 0.0040, "ROLL": 0.0040,
+	"LENM": 0.0040, "SWEN": 0.0040, "PRIC": 0.0040, "NDEP": 0.0040, "GEPR": 0.0040,
+	"AIIS": 0.0040, "PERS": 0.0040, "GUTE": 0.0040, "IGTA": 0.0040, "TTZU": 0.0040,
+	"TRGE": 0.0040, "RNFA": 0.0040, "ESTH": 0.0040, "VIMR": 0.0040, "BCJF": 0.0040,
+	"CJFI": 0.0040, "JFIL": 0.0040, "DOWN": 0.0039, "NZÖS": 0.0039, "ZÖSI": 0.0039,
+	"ÖSIS": 0.0039, "RSER": 0.0039, "OTEN": 0.0039, "TOHN": 0.0039, "BAUE": 0.0039,
+	"PILI": 0.0039, "TGEN": 0.0039, "FÜRV": 0.0039, "LATT": 0.0039, "DESR": 0.0039,
+	"NZUL": 0.0039, "RAUE": 0.0039, "ETDE": 0.0039, "TIGU": 0.0039, "FÜRU": 0.0039,
+	"SIEU": 0.0039, "DREI": 0.0039, "BSOL": 0.0039, "AMMS": 0.0039, "LEZE": 0.0039,
+	"ERZI": 0.0039, "ITZE": 0.0039, "ZUVI": 0.0039, "GEHA": 0.0039, "REZE": 0.0039,
+	"NSBE": 0.0039, "EAUC": 0.0039, "TEEN": 0.0039, "FAUL": 0.0039, "FORC": 0.0039,
+	"EDPK": 0.0039, "MANU": 0.0039, "NEGE": 0.0039, "INSI": 0.0039, "EZWI": 0.0039,
+	"ELDI": 0.0039, "DALL": 0.0039, "ÄISC": 0.0039, "SCHC": 0.0039, "DEMO": 0.0039,
+	"ITAL": 0.0039, "SEMF": 0.0039, "LLEW": 0.0039, "NTAI": 0.0039, "RTFA": 0.0039,
+	"STAS": 0.0039, "NMSS": 0.0039, "SING": 0.0039, "NEGA": 0.0039, "NESC": 0.0039,
+	"NGAL": 0.0039, "DUNT": 0.0039, "TELN": 0.0039, "MFAL": 0.0039, "INSP": 0.0039,
+	"FÜRB": 0.0039, "DESN": 0.0039, "ANLE": 0.0039, "REIG": 0.0039, "HRSC": 0.0039,
+	"IODE": 0.0039, "TEFE": 0.0039, "RTVE": 0.0039, "URDA": 0.0039, "NESG": 0.0039,
+	"CKGA": 0.0039, "KGAB": 0.0039, "TBEA": 0.0039, "KIND": 0.0039, "NZUV": 0.0039,
+	"RCHF": 0.0039, "KOMB": 0.0039, "ISIS": 0.0039, "OLUT": 0.0039, "SESP": 0.0039,
+	"NEAK": 0.0039, "NDLE": 0.0039, "EIMH": 0.0039, "EIMK": 0.0039, "EFAU": 0.0039,
+	"AULT": 0.0039, "BARI": 0.0039, "IESC": 0.0039, "VIEW": 0.0039, "TTET": 0.0039,
+	"ESOR": 0.0039, "EMFO": 0.0039, "CRIP": 0.0039, "FTUN": 0.0039, "RÜFS": 0.0039,
+	"ÜFSU": 0.0039, "ZTDA": 0.0039, "UNDC": 0.0038, "ESMI": 0.0038, "UNDH": 0.0038,
+	"PIPE": 0.0038, "RDEU": 0.0038, "ALGO": 0.0038, "ÜRNI": 0.0038, "EWUR": 0.0038,
+	"RPAR": 0.0038, "NLIN": 0.0038, "LATO": 0.0038, "URSI": 0.0038, "KEND": 0.0038,
+	"ENMÜ": 0.0038, "EPOT": 0.0038, "ECKE": 0.0038, "UDIE": 0.0038, "CHEP": 0.0038,
+	"SSEM": 0.0038, "SEMI": 0.0038, "DADI": 0.0038, "IGVO": 0.0038, "EDEF": 0.0038,
+	"IEAR": 0.0038, "ABSO": 0.0038, "GTIN": 0.0038, "OWOH": 0.0038, "DASN": 0.0038,
+	"TADA": 0.0038, "ILIS": 0.0038, "ESLI": 0.0038, "EHAB": 0.0038, "DEZE": 0.0038,
+	"UFFÜ": 0.0038, "BEFO": 0.0038, "LTWI": 0.0038, "GITS": 0.0038, "GITC": 0.0038,
+	"NMER": 0.0038, "SENP": 0.0038, "MUND": 0.0038, "LAST": 0.0038, "HATK": 0.0038,
+	"NDSO": 0.0038, "GEBN": 0.0038, "BNIS": 0.0038, "ORTF": 0.0038, "EMSE": 0.0038,
+	"INAT": 0.0038, "SZUS": 0.0038, "SNAC": 0.0038, "DASH": 0.0038, "LENK": 0.0038,
+	"AUSU": 0.0038, "NDLU": 0.0038, "HZEI": 0.0038, "RGEH": 0.0038, "ÄRER": 0.0038,
+	"RERA": 0.0038, "RLEI": 0.0038, "ERTY": 0.0038, "RSOR": 0.0038, "ERQU": 0.0038,
+	"SERF": 0.0038, "LERN": 0.0038, "AGET": 0.0038, "NVIE": 0.0038, "RDAL": 0.0038,
+	"AILL": 0.0038, "LUSE": 0.0038, "LPUN": 0.0038, "ELLS": 0.0038, "XPOR": 0.0038,
+	"ROMA": 0.0038, "SFAL": 0.0038, "RBAU": 0.0038, "APTC": 0.0038, "NGFE": 0.0038,
+	"IFÜR": 0.0038, "ETYP": 0.0038, "USIN": 0.0038, "TNOC": 0.0038, "RCES": 0.0038,
+	"MSIE": 0.0038, "ORCE": 0.0038, "ENJE": 0.0038, "ANAL": 0.0038, "SCHB": 0.0038,
+	"GITA": 0.0038, "ITME": 0.0038, "ZUUN": 0.0038, "NHAB": 0.0038, "IESA": 0.0038,
+	"GLAU": 0.0038, "WHRE": 0.0038, "SFHR": 0.0038, "AFTS": 0.0037, "STPR": 0.0037,
+	"IRKT": 0.0037, "VERU": 0.0037, "EBNI": 0.0037, "DENC": 0.0037, "EBRA": 0.0037,
+	"ERIG": 0.0037, "SFUN": 0.0037, "ANNK": 0.0037, "NGRE": 0.0037, "UGRO": 0.0037,
+	"UFOP": 0.0037, "NGSI": 0.0037, "FÜRW": 0.0037, "LWER": 0.0037, "GÄNG": 0.0037,
+	"RALP": 0.0037, "UMSC": 0.0037, "DKAN": 0.0037, "DÜBE": 0.0037, "CHEF": 0.0037,
+	"MVOR": 0.0037, "NBIS": 0.0037, "ALSN": 0.0037, "LLEL": 0.0037, "EERF": 0.0037,
+	"NDOW": 0.0037, "BESI": 0.0037, "URIS": 0.0037, "UFZU": 0.0037, "ENEW": 0.0037,
+	"CKTE": 0.0037, "ALGE": 0.0037, "KZEN": 0.0037, "ÄHNL": 0.0037, "OMPL": 0.0037,
+	"STSI": 0.0037, "HOLT": 0.0037, "ANNS": 0.0037, "EGLA": 0.0037, "ESUP": 0.0037,
+	"REUT": 0.0037, "RZUK": 0.0037, "TESO": 0.0037, "ÄSSI": 0.0037, "MENU": 0.0037,
+	"VERI": 0.0037, "CHRO": 0.0037, "IGEI": 0.0037, "DEAU": 0.0037, "HLIN": 0.0037,
+	"DLUN": 0.0037, "HENL": 0.0037, "GEOP": 0.0037, "CHZE": 0.0037, "NKLA": 0.0037,
+	"ESUC": 0.0037, "SREC": 0.0037, "EZWE": 0.0037, "INLE": 0.0037, "ORSC": 0.0037,
+	"RNUR": 0.0037, "ZERA": 0.0037, "EEXI": 0.0037, "USLA": 0.0037, "NOTE": 0.0037,
+	"MERU": 0.0037, "DIEC": 0.0037, "ITMA": 0.0037, "DBEI": 0.0037, "IEDR": 0.0037,
+	"ITNI": 0.0037, "SOLU": 0.0037, "VONO": 0.0037, "AGIS": 0.0037, "IEGL": 0.0037,
+	"NAUẞ": 0.0037, "TLIS": 0.0037, "IEWI": 0.0037, "MARB": 0.0037, "TEMW": 0.0037,
+	"UERH": 0.0037, "NESA": 0.0037, "RBEG": 0.0037, "ERDU": 0.0037, "TASH": 0.0037,
+	"NHEL": 0.0037, "ELZU": 0.0037, "EHUN": 0.0037, "ETST": 0.0037, "ECIF": 0.0037,
+	"EFRD": 0.0037, "PRIV": 0.0037, "SEAN": 0.0037, "ORTD": 0.0037, "WEGE": 0.0037,
+	"ROẞE": 0.0037, "SZER": 0.0037, "TEHA": 0.0037, "RMUS": 0.0037, "INEC": 0.0037,
+	"EMÜS": 0.0037, "ESTO": 0.0037, "BTDE": 0.0037, "BULA": 0.0037, "HLEI": 0.0037,
+	"ARAN": 0.0037, "TWEI": 0.0037, "RUFO": 0.0037, "ITSP": 0.0037, "ISES": 0.0037,
+	"DDUR": 0.0037, "REUN": 0.0037, "UENS": 0.0037, "BTEI": 0.0037, "INDP": 0.0037,
+	"CENS": 0.0037, "URMI": 0.0037, "SEMB": 0.0037, "NHAT": 0.0037, "TENR": 0.0037,
+	"SGER": 0.0037, "HEFO": 0.0037, "EREL": 0.0037, "EKOD": 0.0037, "IVES": 0.0037,
+	"ELPU": 0.0037, "ARDF": 0.0037, "BEIA": 0.0037, "ITES": 0.0037, "HÖHE": 0.0037,
+	"WIEA": 0.0037, "WAIT": 0.0037, "BEAU": 0.0037, "EREE": 0.0037, "ZUDI": 0.0037,
+	"RDSE": 0.0037, "EDEZ": 0.0037, "ÄTSP": 0.0037, "TICK": 0.0037, "DUSD": 0.0037,
+	"NZUZ": 0.0037, "EMDI": 0.0037, "ESFO": 0.0037, "CESS": 0.0037, "ITBI": 0.0037,
+	"NBAU": 0.0037, "LONE": 0.0037, "GVIM": 0.0037, "IDUN": 0.0036, "AUFB": 0.0036,
+	"UFIN": 0.0036, "PRET": 0.0036, "TERO": 0.0036, "SSGE": 0.0036, "FÜRM": 0.0036,
+	"TAUT": 0.0036, "EAKZ": 0.0036, "SÜBE": 0.0036, "EUTI": 0.0036, "IZEN": 0.0036,
+	"SREG": 0.0036, "GSTR": 0.0036, "ZÄHL": 0.0036, "EHRF": 0.0036, "TGRÖ": 0.0036,
+	"SNEU": 0.0036, "LEPR": 0.0036, "EMBL": 0.0036, "UBTE": 0.0036, "ITFE": 0.0036,
+	"FGET": 0.0036, "TMUS": 0.0036, "INNT": 0.0036, "UFGR": 0.0036, "URVE": 0.0036,
+	"PENK": 0.0036, "FVER": 0.0036, "IBIL": 0.0036, "ASGE": 0.0036, "ISVE": 0.0036,
+	"BELE": 0.0036, "RLET": 0.0036, "EMAU": 0.0036, "ASDA": 0.0036, "GIGE": 0.0036,
+	"AGIN": 0.0036, "IBER": 0.0036, "THEM": 0.0036, "OPFZ": 0.0036, "PFZE": 0.0036,
+	"LESB": 0.0036, "ATVE": 0.0036, "TIEF": 0.0036, "ÖẞEI": 0.0036, "RTAL": 0.0036,
+	"UPST": 0.0036, "OOTA": 0.0036, "ASFE": 0.0036, "LITT": 0.0036, "NSUB": 0.0036,
+	"RDZU": 0.0036, "MCOM": 0.0036, "LGEK": 0.0036, "IESH": 0.0036, "FGEN": 0.0036,
+	"XZUN": 0.0036, "BUTE": 0.0036, "NDSC": 0.0036, "CHUM": 0.0036, "TINI": 0.0036,
+	"LSNI": 0.0036, "ECUR": 0.0036, "TABU": 0.0036, "LENZ": 0.0036, "NKUN": 0.0036,
+	"TEIO": 0.0036, "IPTO": 0.0036, "ÜRFE": 0.0036, "SELA": 0.0036, "INNI": 0.0036,
+	"SDEB": 0.0036, "ESSG": 0.0036, "NEWA": 0.0036, "DARS": 0.0036, "ITDA": 0.0036,
+	"FGEL": 0.0036, "EBRO": 0.0036, "LICE": 0.0036, "REIF": 0.0036, "HLVO": 0.0036,
+	"GIGV": 0.0036, "RZWE": 0.0036, "XTER": 0.0036, "IGNE": 0.0036, "UNGH": 0.0036,
+	"EHLA": 0.0036, "HLEE": 0.0036, "ADUR": 0.0036, "IGIS": 0.0036, "GERF": 0.0036,
+	"ONLE": 0.0036, "ELEC": 0.0036, "VVER": 0.0036, "INSO": 0.0036, "GSIN": 0.0036,
+	"EIFÜ": 0.0036, "EBIG": 0.0036, "DSIN": 0.0036, "IEVI": 0.0036, "ONME": 0.0036,
+	"MANN": 0.0036, "EMST": 0.0036, "SREP": 0.0036, "IRDZ": 0.0036, "DUSA": 0.0036,
+	"RWIE": 0.0036, "SETH": 0.0036, "FIED": 0.0036, "CIFI": 0.0036, "DRIG": 0.0035,
+	"NDON": 0.0035, "CHFÜ": 0.0035, "RAUT": 0.0035, "ZULÄ": 0.0035, "ULÄS": 0.0035,
+	"NLEG": 0.0035, "TINE": 0.0035, "DZEI": 0.0035, "NSOW": 0.0035, "EIOD": 0.0035,
+	"ANDB": 0.0035, "HEOP": 0.0035, "SWÄH": 0.0035, "IMEN": 0.0035, "HTHE": 0.0035,
+	"AREA": 0.0035, "GUNT": 0.0035, "NICE": 0.0035, "FSET": 0.0035, "RLAG": 0.0035,
+	"LITE": 0.0035, "ERTM": 0.0035, "NISN": 0.0035, "RLIN": 0.0035, "ESTG": 0.0035,
+	"DADU": 0.0035, "BDIE": 0.0035, "SZUG": 0.0035, "TEHI": 0.0035, "LSAN": 0.0035,
+	"NBEZ": 0.0035, "ALSL": 0.0035, "ASSY": 0.0035, "USWI": 0.0035, "TCOM": 0.0035,
+	"IEHU": 0.0035, "USNI": 0.0035, "BEGL": 0.0035, "PTSC": 0.0035, "NNVE": 0.0035,
+	"ITCO": 0.0035, "PPLY": 0.0035, "SCHK": 0.0035, "URGE": 0.0035, "RGER": 0.0035,
+	"ORSI": 0.0035, "MITR": 0.0035, "NING": 0.0035, "ILIG": 0.0035, "ESEZ": 0.0035,
+	"RPRI": 0.0035, "RTNU": 0.0035, "ERUR": 0.0035, "NEFE": 0.0035, "ABST": 0.0035,
+	"MENV": 0.0035, "ABUL": 0.0035, "EBIN": 0.0035, "IGEO": 0.0035, "VONM": 0.0035,
+	"IENB": 0.0035, "HTPA": 0.0035, "VONT": 0.0035, "DIUM": 0.0035, "SCII": 0.0035,
+	"GKAN": 0.0035, "TREE": 0.0035, "SENM": 0.0035, "LBEI": 0.0035, "AHLV": 0.0035,
+	"URER": 0.0035, "HTSI": 0.0035, "RITE": 0.0035, "TANS": 0.0035, "OCHA": 0.0035,
+	"BERF": 0.0035, "NURF": 0.0035, "ERTG": 0.0035, "PPOR": 0.0035, "AUSC": 0.0035,
+	"ONZE": 0.0035, "RTHA": 0.0035, "BENO": 0.0035, "EALM": 0.0035, "NURB": 0.0035,
+	"IELA": 0.0035, "DITI": 0.0035, "DERX": 0.0035, "UENT": 0.0035, "EWAH": 0.0035,
+	"ENMS": 0.0035, "TZMA": 0.0035, "ESEW": 0.0035, "KETB": 0.0035, "HECO": 0.0035,
+	"ANSC": 0.0034, "HESH": 0.0034, "ARKE": 0.0034, "SACH": 0.0034, "LGOR": 0.0034,
+	"HERF": 0.0034, "ERGI": 0.0034, "ORAU": 0.0034, "WIEE": 0.0034, "UTUN": 0.0034,
+	"HNED": 0.0034, "SPAC": 0.0034, "LSKE": 0.0034, "ASDE": 0.0034, "ESTD": 0.0034,
+	"ASCI": 0.0034, "ESSK": 0.0034, "ALPU": 0.0034, "TILL": 0.0034, "IVZU": 0.0034,
+	"SNUR": 0.0034, "RPFA": 0.0034, "ANFO": 0.0034, "MITW": 0.0034, "INKA": 0.0034,
+	"NNIN": 0.0034, "IRDK": 0.0034, "TKÖN": 0.0034, "HRTD": 0.0034, "NDKO": 0.0034,
+	"RREF": 0.0034, "RTSC": 0.0034, "ACQU": 0.0034, "CQUI": 0.0034, "INBU": 0.0034,
+	"UFLÖ": 0.0034, "FLÖS": 0.0034, "MSET": 0.0034, "NBUC": 0.0034, "RUMG": 0.0034,
+	"VENT": 0.0034, "TALI": 0.0034, "NISU": 0.0034, "TEWA": 0.0034, "MAGI": 0.0034,
+	"DDAN": 0.0034, "AINE": 0.0034, "DAUE": 0.0034, "AUBI": 0.0034, "TENH": 0.0034,
+	"UNAB": 0.0034, "TECT": 0.0034, "NOTI": 0.0034, "TXZD": 0.0034, "XZFO": 0.0034,
+	"ROHD": 0.0034, "OHDA": 0.0034, "MEAN": 0.0034, "VORL": 0.0034, "ARDI": 0.0034,
+	"ONSA": 0.0034, "ARGE": 0.0034, "REDE": 0.0034, "SELD": 0.0034, "PELT": 0.0034,
+	"RNUM": 0.0034, "EISU": 0.0034, "BENN": 0.0034, "ITNU": 0.0034, "SENN": 0.0034,
+	"ZENZ": 0.0034, "KIST": 0.0034, "ALSZ": 0.0034, "TABI": 0.0034, "HRFA": 0.0034,
+	"NDHA": 0.0034, "UÜBE": 0.0034, "EDIU": 0.0034, "ESEA": 0.0034, "SESA": 0.0034,
+	"NERK": 0.0034, "INSY": 0.0034, "EROF": 0.0034, "TEIU": 0.0034, "RMEI": 0.0034,
+	"EADD": 0.0034, "MAKE": 0.0034, "AHME": 0.0034, "BSTW": 0.0034, "HNEA": 0.0034,
+	"RSHE": 0.0034, "TSBE": 0.0034, "ITWI": 0.0034, "RZIE": 0.0034, "ZUKU": 0.0034,
+	"ERVA": 0.0034, "LECT": 0.0034, "NBET": 0.0034, "ESMU": 0.0034, "EREB": 0.0034,
+	"URAN": 0.0034, "TZTS": 0.0034, "MITO": 0.0034, "SBAR": 0.0034, "ISAN": 0.0034,
+	"NDEK": 0.0034, "IELZ": 0.0034, "DDEK": 0.0034, "IFTH": 0.0034, "ZUEN": 0.0034,
+	"PKGB": 0.0034, "NTRG": 0.0034, "CHAR": 0.0033, "CHAB": 0.0033, "MERE": 0.0033,
+	"ZIRK": 0.0033, "KTIS": 0.0033, "ORIA": 0.0033, "NEWE": 0.0033, "TTEG": 0.0033,
+	"CHPA": 0.0033, "IGEV": 0.0033, "NÄRE": 0.0033, "ABFR": 0.0033, "AHRS": 0.0033,
+	"NPOS": 0.0033, "NWAR": 0.0033, "ERSK": 0.0033, "ENNN": 0.0033, "SONS": 0.0033,
+	"PTOR": 0.0033, "IGEE": 0.0033, "SSUF": 0.0033, "MAKT": 0.0033, "DUSF": 0.0033,
+	"NMÜS": 0.0033, "NDKE": 0.0033, "WURZ": 0.0033, "ISTL": 0.0033, "NBED": 0.0033,
+	"LPUB": 0.0033, "PODE": 0.0033, "ADEZ": 0.0033, "ULES": 0.0033, "RSTR": 0.0033,
+	"SFIN": 0.0033, "NAHM": 0.0033, "TGIB": 0.0033, "HTAK": 0.0033, "ELWI": 0.0033,
+	"NTEF": 0.0033, "RTOD": 0.0033, "UMSI": 0.0033, "ZUKO": 0.0033, "NTTA": 0.0033,
+	"STAG": 0.0033, "UNDR": 0.0033, "LNEN": 0.0033, "EMAG": 0.0033, "NUMA": 0.0033,
+	"EMVE": 0.0033, "NWÜR": 0.0033, "FFÜL": 0.0033, "PLET": 0.0033, "ASED": 0.0033,
+	"EGTD": 0.0033, "EUTZ": 0.0033, "USFH": 0.0033, "GIER": 0.0033, "ELMI": 0.0033,
+	"RUSS": 0.0033, "ITIE": 0.0033, "NETI": 0.0033, "TDEC": 0.0033, "ORDA": 0.0033,
+	"SMEH": 0.0033, "ANGI": 0.0033, "GINS": 0.0033, "SERG": 0.0033, "IBTA": 0.0033,
+	"AUFV": 0.0033, "HLAU": 0.0033, "LENG": 0.0033, "LEGU": 0.0033, "IESY": 0.0033,
+	"ETAL": 0.0033, "NETD": 0.0033, "RBRE": 0.0033, "BEIB": 0.0033, "HENP": 0.0033,
+	"ALEA": 0.0033, "LICL": 0.0033, "ICLI": 0.0033, "CLIC": 0.0033, "IENZ": 0.0033,
+	"RDÜB": 0.0033, "EIMB": 0.0033, "EIHA": 0.0033, "RGEM": 0.0033, "VONC": 0.0033,
+	"MFÜR": 0.0033, "LUTE": 0.0033, "UVIE": 0.0033, "ILEV": 0.0033, "IEQU": 0.0033,
+	"NDEU": 0.0033, "EXPL": 0.0033, "ENEZ": 0.0033, "DEMF": 0.0033, "LDED": 0.0033,
+	"MERZ": 0.0033, "UFAL": 0.0033, "ÄCHS": 0.0033, "IEBL": 0.0033, "TTES": 0.0033,
+	"GRAF": 0.0033, "CKAU": 0.0033, "LECH": 0.0033, "IONH": 0.0033, "ORME": 0.0033,
+	"UZEI": 0.0033, "UBIG": 0.0033, "TDIR": 0.0033, "MMUL": 0.0033, "EILT": 0.0033,
+	"ANNZ": 0.0033, "RBET": 0.0033, "RDEL": 0.0033, "NTAB": 0.0032, "LÖST": 0.0032,
+	"RPAT": 0.0032, "NHEI": 0.0032, "TARG": 0.0032, "TATA": 0.0032, "IONT": 0.0032,
+	"KREU": 0.0032, "NURI": 0.0032, "ELEN": 0.0032, "NWEG": 0.0032, "SIGE": 0.0032,
+	"HTNA": 0.0032, "ESME": 0.0032, "MITL": 0.0032, "SENO": 0.0032, "BFRA": 0.0032,
+	"IMHO": 0.0032, "RANL": 0.0032, "ENSW": 0.0032, "SBEE": 0.0032, "TDAR": 0.0032,
+	"BTES": 0.0032, "CHNA": 0.0032, "NURM": 0.0032, "IRDÜ": 0.0032, "NESD": 0.0032,
+	"ODIF": 0.0032, "EKTW": 0.0032, "NNNU": 0.0032, "IBLE": 0.0032, "DWAR": 0.0032,
+	"EHAT": 0.0032, "FGRU": 0.0032, "HMAL": 0.0032, "ALSM": 0.0032, "EMPE": 0.0032,
+	"HTEX": 0.0032, "NSAN": 0.0032, "EUNG": 0.0032, "ENJO": 0.0032, "SARC": 0.0032,
+	"ARAU": 0.0032, "ANBE": 0.0032, "SKEN": 0.0032, "RTKE": 0.0032, "ZUÜB": 0.0032,
+	"NTAG": 0.0032, "GENG": 0.0032, "AMIL": 0.0032, "OCHL": 0.0032, "APAN": 0.0032,
+	"XTRE": 0.0032, "BOLD": 0.0032, "ILDI": 0.0032, "SZUV": 0.0032, "DZUR": 0.0032,
+	"NTDI": 0.0032, "EILS": 0.0032, "EPEN": 0.0032, "GEHR": 0.0032, "NFRD": 0.0032,
+	"RDFA": 0.0032, "ARDS": 0.0032, "MANC": 0.0032, "ALSI": 0.0032, "ORDW": 0.0032,
+	"TANI": 0.0032, "ERNN": 0.0032, "INDS": 0.0032, "RESU": 0.0032, "ENTU": 0.0032,
+	"IALS": 0.0032, "TINT": 0.0032, "EZUL": 0.0032, "IGEZ": 0.0032, "NNME": 0.0032,
+	"UGEH": 0.0032, "IONP": 0.0032, "SSOU": 0.0032, "UERK": 0.0032, "INAP": 0.0032,
+	"ESQU": 0.0032, "INVI": 0.0032, "INGÜ": 0.0032, "NDOP": 0.0032, "SEWI": 0.0032,
+	"ALSU": 0.0032, "SHER": 0.0032, "UNGL": 0.0032, "HEIM": 0.0032, "ERRT": 0.0032,
+	"ENEG": 0.0032, "HANI": 0.0032, "ERNV": 0.0032, "SWIE": 0.0032, "EDRI": 0.0032,
+	"DFIL": 0.0032, "THEL": 0.0032, "REPR": 0.0032, "MÖCH": 0.0032, "ÖCHT": 0.0032,
+	"ANRE": 0.0032, "RITY": 0.0032, "XITS": 0.0032, "PUSH": 0.0032, "ONTH": 0.0032,
+	"EDTO": 0.0032, "GEKR": 0.0032, "IANR": 0.0032, "RIKA": 0.0031, "NDBU": 0.0031,
+	"AVON": 0.0031, "IGHT": 0.0031, "LSIN": 0.0031, "IUND": 0.0031, "ICHM": 0.0031,
+	"ODEN": 0.0031, "RGRO": 0.0031, "CHTO": 0.0031, "ENDO": 0.0031, "IEKE": 0.0031,
+	"EPIN": 0.0031, "KURS": 0.0031, "MENB": 0.0031, "LTIN": 0.0031, "BACK": 0.0031,
+	"PTEN": 0.0031, "USSC": 0.0031, "TTEE": 0.0031, "MVON": 0.0031, "CKSI": 0.0031,
+	"HEPR": 0.0031, "IRKU": 0.0031, "SASS": 0.0031, "TESB": 0.0031, "SERO": 0.0031,
+	"RCON": 0.0031, "MEMO": 0.0031, "NGTE": 0.0031, "NGWE": 0.0031, "NVAR": 0.0031,
+	"EIAL": 0.0031, "NEST": 0.0031, "NÄND": 0.0031, "DAVO": 0.0031, "SKÖN": 0.0031,
+	"DESM": 0.0031, "NREL": 0.0031, "INNV": 0.0031, "DERQ": 0.0031, "NEMP": 0.0031,
+	"PVER": 0.0031, "TAGS": 0.0031, "EHLW": 0.0031, "KTEI": 0.0031, "ELLB": 0.0031,
+	"ENZA": 0.0031, "NAUT": 0.0031, "MEAU": 0.0031, "ERSD": 0.0031, "RADD": 0.0031,
+	"HIMA": 0.0031, "IENF": 0.0031, "USED": 0.0031, "FIEL": 0.0031, "ZMAN": 0.0031,
+	"ANLA": 0.0031, "MTHE": 0.0031, "EKRE": 0.0031, "EKNN": 0.0031, "BDEB": 0.0031,
+	"FRDA": 0.0031, "PRFT": 0.0031, "HNGT": 0.0031, "AMAN": 0.0031, "EZIR": 0.0031,
+	"ANDU": 0.0031, "RNOC": 0.0031, "MWAN": 0.0031, "NTEM": 0.0031, "NTRE": 0.0031,
+	"EGUN": 0.0031, "REKU": 0.0031, "SIEV": 0.0031, "HTUM": 0.0031, "IKON": 0.0031,
+	"ENAD": 0.0031, "ẞERA": 0.0031, "FÜRI": 0.0031, "IERF": 0.0031, "RNET": 0.0031,
+	"EGÜL": 0.0031, "RTSO": 0.0031, "TESK": 0.0031, "STDO": 0.0031, "OTES": 0.0031,
+	"DIFI": 0.0031, "ESBI": 0.0031, "ISED": 0.0031, "ZIFF": 0.0031, "PELP": 0.0031,
+	"HLUG": 0.0031, "STFE": 0.0031, "ALSG": 0.0031, "OLLI": 0.0031, "DIEQ": 0.0031,
+	"TDAH": 0.0031, "SSTD": 0.0031, "EUGU": 0.0031, "EANP": 0.0031, "UNSI": 0.0031,
+	"NESK": 0.0031, "HEAN": 0.0031, "LLAU": 0.0031, "TIMA": 0.0031, "NOBJ": 0.0031,
+	"SOBJ": 0.0031, "EMAC": 0.0031, "NURL": 0.0031, "DESL": 0.0031, "AUFI": 0.0031,
+	"MATV": 0.0031, "LSVE": 0.0031, "RCOM": 0.0031, "FBEI": 0.0031, "SCHZ": 0.0031,
+	"CHSA": 0.0031, "GARI": 0.0031, "ESHO": 0.0031, "LEST": 0.0031, "ENWÜ": 0.0031,
+	"EDAN": 0.0031, "ELZM": 0.0031, "FARB": 0.0031, "NETS": 0.0031, "ENOT": 0.0031,
+	"IEEX": 0.0031, "UBER": 0.0031, "DDAT": 0.0031, "DEML": 0.0031, "IONR": 0.0031,
+	"SUPP": 0.0031, "NGFR": 0.0031, "HELG": 0.0031, "UTZM": 0.0031, "OZEN": 0.0030,
+	"PANI": 0.0030, "IESU": 0.0030, "GEBI": 0.0030, "LTIS": 0.0030, "ZTES": 0.0030,
+	"UMWA": 0.0030, "KETZ": 0.0030, "MENN": 0.0030, "INNA": 0.0030, "RTEX": 0.0030,
+	"BENZ": 0.0030, "CHFO": 0.0030, "MITP": 0.0030, "UTIG": 0.0030, "ENKL": 0.0030,
+	"NEÜB": 0.0030, "INKL": 0.0030, "FFSR": 0.0030, "FSRE": 0.0030, "RDDU": 0.0030,
+	"RLAD": 0.0030, "DETU": 0.0030, "AMEE": 0.0030, "ZENU": 0.0030, "EMSP": 0.0030,
+	"FÜRH": 0.0030, "RABS": 0.0030, "HVOR": 0.0030, "KLAM": 0.0030, "LAMM": 0.0030,
+	"RREC": 0.0030, "TTEV": 0.0030, "NNOR": 0.0030, "ESIM": 0.0030, "IEZI": 0.0030,
+	"NMÖG": 0.0030, "TGEÄ": 0.0030, "STAP": 0.0030, "RERF": 0.0030, "UCHI": 0.0030,
+	"MBES": 0.0030, "NDAB": 0.0030, "CLEA": 0.0030, "EHRT": 0.0030, "PZEI": 0.0030,
+	"ONSP": 0.0030, "EVEN": 0.0030, "LDIN": 0.0030, "NSHE": 0.0030, "AMEO": 0.0030,
+	"HEZA": 0.0030, "PAPU": 0.0030, "APUA": 0.0030, "ESCO": 0.0030, "INTA": 0.0030,
+	"CHKA": 0.0030, "CHIR": 0.0030, "RKER": 0.0030, "ADDE": 0.0030, "HEUN": 0.0030,
+	"ILDS": 0.0030, "SESO": 0.0030, "NDEZ": 0.0030, "EMFA": 0.0030, "NISE": 0.0030,
+	"RUSE": 0.0030, "NENC": 0.0030, "EMEM": 0.0030, "AFTU": 0.0030, "FERA": 0.0030,
+	"MFEL": 0.0030, "USTZ": 0.0030, "THEO": 0.0030, "BERT": 0.0030, "NTEP": 0.0030,
+	"ZUSC": 0.0030, "GEVE": 0.0030, "LEZU": 0.0030, "NCHR": 0.0030, "UTER": 0.0030,
+	"INWI": 0.0030, "BENK": 0.0030, "NGRO": 0.0030, "EBED": 0.0030, "NSON": 0.0030,
+	"ENSU": 0.0030, "TORS": 0.0030, "EMLE": 0.0030, "SLAS": 0.0030, "SEFE": 0.0030,
+	"SERT": 0.0030, "ELWE": 0.0030, "INME": 0.0030, "CHSO": 0.0030, "ATAG": 0.0030,
+	"DEGE": 0.0030, "EIMD": 0.0030, "OBAL": 0.0030, "WECK": 0.0030, "MORY": 0.0030,
+	"ESMA": 0.0030, "IGEF": 0.0030, "NENR": 0.0030, "SSOL": 0.0030, "EIUN": 0.0030,
+	"ISNI": 0.0030, "DOPT": 0.0030, "ADED": 0.0030, "ENTZ": 0.0030, "MPEL": 0.0030,
+	"TIGK": 0.0030, "RDFE": 0.0030, "DNAC": 0.0030, "DEER": 0.0030, "ALTU": 0.0030,
+	"TBEG": 0.0030, "SCOM": 0.0030, "RESW": 0.0030, "ABLA": 0.0030, "ITOD": 0.0030,
+	"EPFA": 0.0030, "CHCA": 0.0030, "ONGI": 0.0030, "MARC": 0.0030, "ESFR": 0.0030,
+	"ẞERE": 0.0030, "NEUS": 0.0030, "ARFD": 0.0030, "ENEF": 0.0030, "DEPE": 0.0030,
+	"NSTP": 0.0030, "NARB": 0.0030, "NURW": 0.0030, "MLEI": 0.0030, "UNCO": 0.0030,
+	"EDBY": 0.0030, "EBDE": 0.0030, "EVIM": 0.0030, "RMAN": 0.0029, "BENG": 0.0029,
+	"NTDE": 0.0029, "TNUL": 0.0029, "KATE": 0.0029, "THMU": 0.0029, "ALSV": 0.0029,
+	"LSVO": 0.0029, "ESSS": 0.0029, "SERR": 0.0029, "ETSA": 0.0029, "REDU": 0.0029,
+	"UCHZ": 0.0029, "GERR": 0.0029, "EMFE": 0.0029, "NNWE": 0.0029, "NISK": 0.0029,
+	"ASKE": 0.0029, "NGSK": 0.0029, "ÜTZE": 0.0029, "EIHR": 0.0029, "NFAS": 0.0029,
+	"EHÄN": 0.0029, "ERPO": 0.0029, "RRAU": 0.0029, "ENOC": 0.0029, "IRTU": 0.0029,
+	"ALVE": 0.0029, "SGLE": 0.0029, "RRID": 0.0029, "EITW": 0.0029, "TEFI": 0.0029,
+	"NDAH": 0.0029, "FTER": 0.0029, "EJED": 0.0029, "NSGE": 0.0029, "SENG": 0.0029,
+	"AUFK": 0.0029, "HARD": 0.0029, "XTRA": 0.0029, "EMES": 0.0029, "DONE": 0.0029,
+	"LATE": 0.0029, "GING": 0.0029, "NFRE": 0.0029, "EMKO": 0.0029, "GTUN": 0.0029,
+	"LTSI": 0.0029, "ZFOR": 0.0029, "LLVE": 0.0029, "RFEL": 0.0029, "NSEV": 0.0029,
+	"KLON": 0.0029, "NORE": 0.0029, "LORI": 0.0029, "IBTH": 0.0029, "NDNE": 0.0029,
+	"ISEE": 0.0029, "THEA": 0.0029, "FLOR": 0.0029, "MITH": 0.0029, "ASAR": 0.0029,
+	"RBIS": 0.0029, "ARFN": 0.0029, "EHLI": 0.0029, "NGSM": 0.0029, "AUSI": 0.0029,
+	"NEPR": 0.0029, "CLIE": 0.0029, "ABZU": 0.0029, "RETI": 0.0029, "PROD": 0.0029,
+	"ANTI": 0.0029, "SZWE": 0.0029, "SARB": 0.0029, "USWÄ": 0.0029, "STIS": 0.0029,
+	"TSIG": 0.0029, "GETH": 0.0029, "ÜHRL": 0.0029, "BEZU": 0.0029, "ONAB": 0.0029,
+	"IHAT": 0.0029, "VIRT": 0.0029, "HEWE": 0.0029, "RROR": 0.0029, "NGSW": 0.0029,
+	"IGEK": 0.0029, "ATAB": 0.0029, "EZUV": 0.0029, "TIBI": 0.0029, "IESN": 0.0029,
+	"ISIN": 0.0029, "ETAR": 0.0029, "ESUM": 0.0029, "NESW": 0.0029, "DEFE": 0.0029,
+	"BLES": 0.0029, "XPLI": 0.0029, "ZTUN": 0.0029, "TUNB": 0.0029, "SOFE": 0.0029,
+	"MKON": 0.0029, "SSKE": 0.0029, "LEMI": 0.0029, "REEI": 0.0029, "RDWA": 0.0029,
+	"BGEF": 0.0029, "FDAT": 0.0029, "ITTL": 0.0029, "EMOK": 0.0029, "NEZU": 0.0029,
+	"FAST": 0.0029, "ARFS": 0.0029, "ITMI": 0.0029, "RAFI": 0.0029, "IKTE": 0.0029,
+	"HLEC": 0.0029, "RGRE": 0.0029, "ONVA": 0.0029, "TETH": 0.0029, "LSGE": 0.0029,
+	"RFAH": 0.0029, "ITHA": 0.0029, "MOPT": 0.0029, "LSES": 0.0029, "EFGT": 0.0029,
+	"LING": 0.0028, "KANA": 0.0028, "CHEL": 0.0028, "EREW": 0.0028, "EMRE": 0.0028,
+	"TARE": 0.0028, "TZUL": 0.0028, "ERLÄ": 0.0028, "STFÜ": 0.0028, "FÄLL": 0.0028,
+	"SALT": 0.0028, "EGER": 0.0028, "WIEV": 0.0028, "ERKL": 0.0028, "ONSS": 0.0028,
+	"ERÜC": 0.0028, "INVO": 0.0028, "IAND": 0.0028, "TEMD": 0.0028, "CHTH": 0.0028,
+	"NERV": 0.0028, "MENZ": 0.0028, "DEMN": 0.0028, "ISAS": 0.0028, "WIEI": 0.0028,
+	"GEGR": 0.0028, "ZWEC": 0.0028, "GEAU": 0.0028, "IELO": 0.0028, "RREL": 0.0028,
+	"ONSW": 0.0028, "NGSP": 0.0028, "EGEG": 0.0028, "ELUS": 0.0028, "MPAR": 0.0028,
+	"ERPF": 0.0028, "REXI": 0.0028, "ZERZ": 0.0028, "RMÖG": 0.0028, "IECH": 0.0028,
+	"RDST": 0.0028, "EIDU": 0.0028, "EHEI": 0.0028, "ASHI": 0.0028, "TEMN": 0.0028,
+	"ELPZ": 0.0028, "DOUT": 0.0028, "IESD": 0.0028, "ETSE": 0.0028, "ERKÖ": 0.0028,
+	"SVEN": 0.0028, "OGEN": 0.0028, "BLED": 0.0028, "DAUC": 0.0028, "RKÖN": 0.0028,
+	"THAF": 0.0028, "TPAR": 0.0028, "NÄCH": 0.0028, "NREI": 0.0028, "ECTU": 0.0028,
+	"LSLE": 0.0028, "ONET": 0.0028, "PRFU": 0.0028, "ROMT": 0.0028, "XZDE": 0.0028,
+	"XZDI": 0.0028, "NDST": 0.0028, "OWIN": 0.0028, "UNDO": 0.0028, "ALST": 0.0028,
+	"RTEB": 0.0028, "ERFU": 0.0028, "OFFE": 0.0028, "HTSE": 0.0028, "NINI": 0.0028,
+	"AMEF": 0.0028, "NGSO": 0.0028, "UFVO": 0.0028, "HSUC": 0.0028, "NGTD": 0.0028,
+	"KVER": 0.0028, "EMNI": 0.0028, "EGEW": 0.0028, "EBEA": 0.0028, "INGR": 0.0028,
+	"ONNA": 0.0028, "IEMÜ": 0.0028, "NGDA": 0.0028, "NZUB": 0.0028, "ENDB": 0.0028,
+	"GSWE": 0.0028, "TIVZ": 0.0028, "BITM": 0.0028, "LINS": 0.0028, "HREM": 0.0028,
+	"ASVO": 0.0028, "RNTE": 0.0028, "LLTA": 0.0028, "SIMP": 0.0028, "TYPS": 0.0028,
+	"STNO": 0.0028, "ILEW": 0.0028, "WEIM": 0.0028, "DAST": 0.0028, "DGES": 0.0028,
+	"ONWE": 0.0028, "RDFÜ": 0.0028, "WIEB": 0.0028, "EBIL": 0.0028, "ORBE": 0.0028,
+	"LLSA": 0.0028, "BDER": 0.0028, "HOBE": 0.0028, "NGKO": 0.0028, "WERF": 0.0028,
+	"KTSI": 0.0028, "DBES": 0.0028, "DUSW": 0.0028, "ECHI": 0.0028, "NTAL": 0.0028,
+	"HINS": 0.0028, "MEWI": 0.0028, "AINF": 0.0028, "EIED": 0.0028, "ITEC": 0.0028,
+	"GEDI": 0.0028, "OFER": 0.0028, "NREP": 0.0028, "NWÖR": 0.0028, "RSAN": 0.0028,
+	"ONFO": 0.0028, "SENB": 0.0028, "ZURC": 0.0028, "PTVE": 0.0028, "OMTH": 0.0028,
+	"STZL": 0.0028, "WHLT": 0.0028, "GEFG": 0.0028, "LDSC": 0.0028, "GENH": 0.0028,
+	"NCHA": 0.0028, "EWAN": 0.0028, "OUND": 0.0028, "INGM": 0.0028, "ORLA": 0.0028,
+	"ENET": 0.0028, "NGOD": 0.0028, "RRES": 0.0028, "UNIX": 0.0028, "LBYT": 0.0028,
+	"RROO": 0.0028, "CHTR": 0.0028, "LFEA": 0.0028, "NDZE": 0.0028, "ETMI": 0.0028,
+	"NTFÜ": 0.0028, "NESY": 0.0028, "LEWI": 0.0028, "ANON": 0.0028, "LDAS": 0.0028,
+	"SBAU": 0.0028, "ZENA": 0.0028, "ICHH": 0.0028, "NFIN": 0.0028, "LEVA": 0.0028,
+	"ONCO": 0.0028, "KONS": 0.0028, "IELF": 0.0028, "EOPE": 0.0028, "KÜRZ": 0.0028,
+	"RÖFF": 0.0028, "MTEN": 0.0028, "SEIS": 0.0028, "TANW": 0.0028, "DERÜ": 0.0028,
+	"SLES": 0.0028, "UGAN": 0.0028, "LSST": 0.0028, "ONWU": 0.0028, "HWER": 0.0028,
+	"INOR": 0.0028, "MEFÜ": 0.0028, "SKOP": 0.0028, "TDOU": 0.0028, "TRUS": 0.0028,
+}
+
+var chainBigramsDE = map[string]float64{
+	"EN": 3.8018, "ER": 3.6375, "EI": 2.1186, "DE": 2.0832, "TE": 2.0827,
+	"CH": 2.0056, "IE": 1.6011, "IN": 1.5841, "ND": 1.5336, "GE": 1.3236,
+	"ES": 1.3161, "BE": 1.1999, "ST": 1.1371, "RE": 1.1106, "NE": 1.0353,
+	"UN": 0.9974, "NG": 0.9506, "AN": 0.9493, "SE": 0.8950, "RD": 0.8943,
+	"DI": 0.8880, "IS": 0.8678, "ON": 0.8488, "IC": 0.8461, "TI": 0.8272,
+	"DA": 0.8085, "HE": 0.7933, "LE": 0.7901, "AT": 0.7765, "IT": 0.7119,
+	"NI": 0.7101, "EL": 0.7091, "WE": 0.6879, "SC": 0.6855, "AL": 0.6677,
+	"NT": 0.6667, "SI": 0.6621, "AU": 0.6539, "ET": 0.6358, "RT": 0.6064,
+	"NS": 0.6055, "NN": 0.5860, "NA": 0.5788, "SS": 0.5641, "ZE": 0.5631,
+	"TA": 0.5375, "ME": 0.5359, "VE": 0.5323, "OR": 0.5309, "LI": 0.5264,
+	"ED": 0.5242, "LL": 0.5186, "AR": 0.5092, "RS": 0.5006, "RA": 0.4915,
+	"IG": 0.4770, "US": 0.4754, "RI": 0.4614, "HT": 0.4549, "IO": 0.4378,
+	"ZU": 0.4281, "FE": 0.4281, "HL": 0.4263, "AS": 0.4231, "NU": 0.4055,
+	"MI": 0.4051, "EM": 0.4049, "LT": 0.4027, "EB": 0.3963, "EA": 0.3919,
+	"MA": 0.3837, "KE": 0.3816, "EH": 0.3811, "TZ": 0.3810, "UR": 0.3622,
+	"PR": 0.3555, "EG": 0.3460, "WI": 0.3457, "VO": 0.3456, "TD": 0.3393,
+	"AB": 0.3229, "KO": 0.3213, "IM": 0.3165, "HA": 0.3069, "EF": 0.3054,
+	"TW": 0.3030, "TS": 0.3023, "PA": 0.3019, "SP": 0.2925, "UF": 0.2881,
+	"RO": 0.2877, "HR": 0.2790, "LS": 0.2780, "OM": 0.2770, "NZ": 0.2751,
+	"IR": 0.2731, "NF": 0.2726, "AM": 0.2712, "RU": 0.2676, "UM": 0.2662,
+	"LA": 0.2661, "IL": 0.2640, "EK": 0.2585, "TU": 0.2551, "NW": 0.2539,
+	"RN": 0.2520, "GR": 0.2426, "SA": 0.2408, "OD": 0.2390, "UT": 0.2362,
+	"KA": 0.2352, "RB": 0.2319, "EE": 0.2310, "AK": 0.2297, "RW": 0.2275,
+	"FÜ": 0.2263, "FO": 0.2245, "PT": 0.2197, "TH": 0.2193, "NO": 0.2187,
+	"EU": 0.2104, "GI": 0.2087, "MM": 0.2064, "TR": 0.2054, "RM": 0.2049,
+	"NB": 0.2039, "NK": 0.2033, "KT": 0.2025, "OL": 0.2010, "SD": 0.1993,
+	"HI": 0.1990, "PE": 0.1982, "AC": 0.1949, "MP": 0.1928, "RG": 0.1924,
+	"NV": 0.1918, "OP": 0.1913, "EV": 0.1902, "DU": 0.1898, "FI": 0.1898,
+	"TT": 0.1885, "GA": 0.1876, "RZ": 0.1859, "AD": 0.1807, "HN": 0.1797,
+	"IB": 0.1794, "FA": 0.1782, "SO": 0.1746, "RF": 0.1688, "UE": 0.1685,
+	"EZ": 0.1659, "EP": 0.1643, "BI": 0.1628, "TO": 0.1599, "ÜR": 0.1573,
+	"SG": 0.1567, "RK": 0.1546, "WA": 0.1509, "EW": 0.1500, "LD": 0.1497,
+	"EC": 0.1474, "RC": 0.1459, "GT": 0.1440, "BA": 0.1397, "CK": 0.1357,
+	"DD": 0.1356, "SU": 0.1352, "SW": 0.1343, "GU": 0.1310, "ID": 0.1294,
+	"ZT": 0.1294, "BL": 0.1236, "GS": 0.1220, "TF": 0.1210, "CO": 0.1206,
+	"TN": 0.1184, "AG": 0.1170, "NM": 0.1167, "UC": 0.1167, "EO": 0.1160,
+	"IA": 0.1152, "LO": 0.1149, "LU": 0.1141, "OT": 0.1121, "NP": 0.1108,
+	"RH": 0.1103, "MO": 0.1097, "ZI": 0.1075, "RL": 0.1069, "EX": 0.1062,
+	"LG": 0.1053, "RV": 0.1050, "FR": 0.1034, "MB": 0.1033, "IV": 0.1014,
+	"VI": 0.1007, "IF": 0.0999, "FF": 0.0995, "TG": 0.0978, "SF": 0.0967,
+	"UG": 0.0966, "UP": 0.0940, "SK": 0.0935, "GL": 0.0933, "TV": 0.0933,
+	"PI": 0.0922, "AP": 0.0914, "SV": 0.0913, "AH": 0.0911, "BU": 0.0907,
+	"SY": 0.0904, "RP": 0.0898, "PO": 0.0884, "ÄN": 0.0882, "UL": 0.0880,
+	"MS": 0.0870, "OS": 0.0866, "RR": 0.0862, "SH": 0.0856, "MU": 0.0851,
+	"NL": 0.0848, "TL": 0.0841, "SZ": 0.0831, "UB": 0.0830, "TB": 0.0824,
+	"DO": 0.0819, "DS": 0.0812, "GN": 0.0810, "OB": 0.0802, "ÜL": 0.0800,
+	"WU": 0.0782, "SB": 0.0779, "TM": 0.0770, "FT": 0.0761, "FU": 0.0760,
+	"OC": 0.0746, "HS": 0.0740, "DP": 0.0739, "HO": 0.0739, "GÜ": 0.0735,
+	"ÜB": 0.0734, "NC": 0.0731, "ẞE": 0.0725, "WO": 0.0712, "KG": 0.0711,
+	"ÜS": 0.0693, "IK": 0.0690, "OG": 0.0682, "RÜ": 0.0682, "NH": 0.0678,
+	"ZA": 0.0675, "GD": 0.0673, "HD": 0.0667, "SN": 0.0663, "PU": 0.0656,
+	"XZ": 0.0652, "TK": 0.0644, "LB": 0.0622, "OU": 0.0618, "PK": 0.0616,
+	"YS": 0.0611, "DR": 0.0609, "LÜ": 0.0606, "BT": 0.0604, "PP": 0.0603,
+	"KI": 0.0597, "MD": 0.0595, "DN": 0.0583, "UA": 0.0581, "BR": 0.0580,
+	"IP": 0.0579, "SM": 0.0568, "JE": 0.0567, "AI": 0.0551, "FD": 0.0547,
+	"ZW": 0.0547, "SL": 0.0536, "DM": 0.0536, "VA": 0.0528, "NR": 0.0520,
+	"QU": 0.0515, "PF": 0.0504, "BO": 0.0502, "PL": 0.0502, "TP": 0.0501,
+	"TÜ": 0.0497, "ÜH": 0.0494, "CA": 0.0491, "RÖ": 0.0490, "OK": 0.0488,
+	"HU": 0.0486, "TC": 0.0485, "FG": 0.0483, "OW": 0.0479, "DB": 0.0470,
+	"LN": 0.0470, "DL": 0.0462, "TY": 0.0461, "UI": 0.0460, "LZ": 0.0455,
+	"OZ": 0.0452, "BS": 0.0451, "HÄ": 0.0450, "IZ": 0.0447, "DW": 0.0447,
+	"KU": 0.0444, "XI": 0.0444, "OF": 0.0433, "FL": 0.0430, "ÄR": 0.0425,
+	"MT": 0.0419, "KR": 0.0419, "LP": 0.0418, "IH": 0.0415, "YP": 0.0414,
+	"LF": 0.0412, "Öẞ": 0.0411, "ÜT": 0.0409, "CE": 0.0409, "MG": 0.0404,
+	"OO": 0.0389, "ÖN": 0.0389, "BM": 0.0386, "HM": 0.0385, "ML": 0.0385,
+	"XT": 0.0385, "FS": 0.0384, "ÖS": 0.0383, "RÄ": 0.0382, "AF": 0.0378,
+	"KÖ": 0.0375, "GV": 0.0361, "SR": 0.0356, "UD": 0.0355, "YT": 0.0352,
+	"OH": 0.0347, "BY": 0.0345, "GB": 0.0343, "KL": 0.0342, "DF": 0.0339,
+	"DT": 0.0338, "DV": 0.0334, "ÜC": 0.0333, "GK": 0.0332, "LÖ": 0.0328,
+	"KS": 0.0320, "ÄH": 0.0320, "GF": 0.0316, "KN": 0.0315, "ÜG": 0.0309,
+	"ÄT": 0.0307, "WÄ": 0.0306, "CT": 0.0300, "MÖ": 0.0298, "YM": 0.0297,
+	"HW": 0.0293, "LÄ": 0.0291, "LW": 0.0291, "DG": 0.0290, "HB": 0.0290,
+	"PS": 0.0289, "NÜ": 0.0283, "FN": 0.0281, "ZM": 0.0280, "SÜ": 0.0277,
+	"GO": 0.0276, "RY": 0.0275, "LC": 0.0272, "TÄ": 0.0268, "MF": 0.0266,
+	"ÄL": 0.0266, "ÖR": 0.0266, "GW": 0.0266, "MV": 0.0260, "II": 0.0256,
+	"ÖG": 0.0256, "KZ": 0.0252, "IX": 0.0252, "UV": 0.0251, "ÜF": 0.0250,
+	"BG": 0.0249, "LV": 0.0248, "GG": 0.0245, "ÜD": 0.0244, "FH": 0.0239,
+	"OA": 0.0234, "BH": 0.0232, "DK": 0.0232, "BD": 0.0231, "GZ": 0.0230,
+	"AX": 0.0229, "NÖ": 0.0226, "HK": 0.0222, "YN": 0.0216, "Uẞ": 0.0211,
+	"ÖF": 0.0209, "CI": 0.0208, "ZL": 0.0208, "CR": 0.0207, "HG": 0.0206,
+}
+
+var quadgramsGR = map[string]float64{
+	"ΡΧΕΙ": 0.3943, "ΑΡΧΕ": 0.3893, "ΕΙΝΑ": 0.3824, "ΧΕΙΟ": 0.3047, "ΙΝΑΙ": 0.2854,
+	"ΜΕΝΟ": 0.2669, "ΓΡΑΦ": 0.2514, "ΚΑΤΑ": 0.2507, "ΔΥΝΑ": 0.2507, "ΟΠΟΙ": 0.2414,
+	"ΗΜΑΤ": 0.2244, "ΑΠΟΤ": 0.2070, "ΧΡΗΣ": 0.2066, "ΜΑΤΙ": 0.2062, "ΠΟΙΗ": 0.2024,
+	"ΜΑΤΟ": 0.1865, "ΑΤΙΚ": 0.1777, "ΔΕΝΕ": 0.1695, "ΑΔΥΝ": 0.1692, "ΟΝΟΜ": 0.1680,
+	"ΑΤΟΣ": 0.1661, "ΝΟΜΑ": 0.1591, "ΑΛΛΑ": 0.1553, "ΕΠΙΛ": 0.1537, "ΣΦΑΛ": 0.1529,
+	"ΓΛΩΣ": 0.1487, "ΛΩΣΣ": 0.1487, "ΤΙΚΗ": 0.1471, "ΤΙΚΟ": 0.1460, "ΟΡΙΣ": 0.1456,
+	"ΡΑΦΗ": 0.1429, "ΥΝΑΤ": 0.1410, "ΕΤΑΙ": 0.1406, "ΟΙΗΣ": 0.1398, "ΠΡΟΣ": 0.1359,
+	"ΦΑΛΜ": 0.1356, "ΑΛΜΑ": 0.1336, "ΗΤΟΥ": 0.1332, "ΣΤΟΥ": 0.1329, "ΔΗΜΟ": 0.1294,
+	"ΚΛΕΙ": 0.1275, "ΠΕΡΙ": 0.1259, "ΟΜΕΝ": 0.1240, "ΑΜΙΑ": 0.1240, "ΠΑΡΑ": 0.1224,
+	"ΠΑΚΕ": 0.1224, "ΑΚΕΤ": 0.1224, "ΙΣΤΟ": 0.1220, "ΑΡΑΚ": 0.1209, "ΚΡΑΤ": 0.1209,
+	"ΡΙΣΜ": 0.1205, "ΕΙΟΥ": 0.1205, "ΡΑΜΜ": 0.1190, "ΝΕΙΝ": 0.1166, "ΤΑΣΤ": 0.1159,
+	"ΕΧΕΙ": 0.1132, "ΡΑΤΙ": 0.1132, "ΟΚΡΑ": 0.1128, "ΑΤΙΑ": 0.1128, "ΣΜΕΝ": 0.1116,
+	"ΥΝΑΜ": 0.1116, "ΕΝΕΙ": 0.1112, "ΠΙΛΟ": 0.1112, "ΙΛΟΓ": 0.1108, "ΘΗΚΕ": 0.1101,
+	"ΟΑΡΧ": 0.1101, "ΩΣΣΑ": 0.1101, "ΣΤΗΣ": 0.1097, "ΝΑΜΙ": 0.1097, "ΗΣΤΗ": 0.1093,
+	"ΓΡΑΜ": 0.1089, "ΜΟΚΡ": 0.1081, "ΗΜΟΚ": 0.1078, "ΙΑΤΗ": 0.1074, "ΙΑΤΟ": 0.1070,
+	"ΓΙΑΤ": 0.1066, "ΗΤΗΣ": 0.1058, "ΕΓΚΥ": 0.1054, "ΓΚΥΡ": 0.1054, "ΑΤΑΣ": 0.1054,
+	"ΙΚΗΓ": 0.1047, "ΗΓΛΩ": 0.1047, "ΝΟΗΜ": 0.1043, "ΟΗΜΑ": 0.1043, "ΗΣΗΣ": 0.1039,
+	"ΛΕΙΔ": 0.1031, "ΑΓΝΩ": 0.1031, "ΑΤΟΥ": 0.1023, "ΚΗΓΛ": 0.1023, "ΤΙΑΤ": 0.1012,
+	"ΜΕΤΑ": 0.1008, "ΙΚΟΥ": 0.0996, "ΑΣΤΑ": 0.0993, "ΓΓΡΑ": 0.0993, "ΙΑΝΑ": 0.0985,
+	"ΕΓΓΡ": 0.0985, "ΙΗΣΗ": 0.0977, "ΣΤΑΣ": 0.0954, "ΣΙΜΟ": 0.0950, "ΗΣΤΟ": 0.0931,
+	"ΕΙΔΙ": 0.0927, "ΤΗΡΙ": 0.0919, "ΝΑΤΗ": 0.0915, "ΟΥΡΓ": 0.0908, "ΟΓΡΑ": 0.0908,
+	"ΠΛΗΚ": 0.0908, "ΛΗΚΤ": 0.0908, "ΓΝΩΣ": 0.0904, "ΛΛΑΓ": 0.0900, "ΗΚΤΡ": 0.0892,
+	"ΙΣΤΕ": 0.0881, "ΣΗΤΟ": 0.0881, "ΗΕΓΚ": 0.0881, "ΕΡΓΑ": 0.0877, "ΑΥΤΟ": 0.0877,
+	"ΑΤΗΣ": 0.0873, "ΑΝΤΙ": 0.0865, "ΡΗΣΗ": 0.0865, "ΑΤΗΗ": 0.0854, "ΡΙΣΤ": 0.0850,
+	"ΑΣΙΑ": 0.0846, "ΙΣΤΗ": 0.0842, "ΤΟΥΣ": 0.0842, "ΥΠΑΡ": 0.0834, "ΤΑΣΗ": 0.0830,
+	"ΡΓΑΣ": 0.0826, "ΣΤΗΜ": 0.0826, "ΑΚΤΗ": 0.0823, "ΣΗΣΤ": 0.0823, "ΓΑΣΙ": 0.0819,
+	"ΣΥΝΔ": 0.0815, "ΜΗΕΓ": 0.0815, "ΛΟΓΗ": 0.0811, "ΥΠΟΣ": 0.0807, "ΟΡΕΙ": 0.0799,
+	"ΕΝΤΟ": 0.0796, "ΜΕΝΗ": 0.0796, "ΟΣΤΗ": 0.0792, "ΤΗΝΕ": 0.0792, "ΝΑΤΟ": 0.0788,
+	"ΜΠΟΡ": 0.0788, "ΙΣΜΟ": 0.0788, "ΝΙΚΑ": 0.0780, "ΚΕΤΟ": 0.0776, "ΝΙΚΟ": 0.0772,
+	"ΔΕΝΥ": 0.0769, "ΤΗΣΗ": 0.0769, "ΗΣΙΜ": 0.0765, "ΚΥΡΟ": 0.0765, "ΤΕΛΕ": 0.0757,
+	"ΕΝΥΠ": 0.0753, "ΑΝΙΚ": 0.0749, "ΡΗΣΙ": 0.0749, "ΡΕΠΕ": 0.0745, "ΑΝΑΓ": 0.0742,
+	"ΙΜΟΠ": 0.0738, "ΑΤΑΛ": 0.0738, "ΙΟΥΡ": 0.0734, "ΕΙΜΕ": 0.0734, "ΠΑΡΧ": 0.0734,
+	"ΣΤΗΝ": 0.0734, "ΩΡΙΣ": 0.0734, "ΥΝΔΕ": 0.0730, "ΜΟΠΟ": 0.0730, "ΟΣΤΟ": 0.0730,
+	"ΔΙΚΟ": 0.0726, "ΡΙΘΜ": 0.0726, "ΧΑΡΑ": 0.0714, "ΑΣΤΟ": 0.0714, "ΤΥΧΙ": 0.0711,
+	"ΡΗΣΤ": 0.0707, "ΥΧΙΑ": 0.0707, "ΠΟΤΥ": 0.0703, "ΡΑΚΤ": 0.0687, "ΚΤΗΡ": 0.0687,
+	"ΕΙΤΟ": 0.0687, "ΤΟΑΡ": 0.0687, "ΝΤΟΛ": 0.0687, "ΡΓΙΑ": 0.0684, "ΕΓΚΑ": 0.0680,
+	"ΓΚΑΤ": 0.0680, "ΙΚΟΣ": 0.0676, "ΠΙΣΤ": 0.0672, "ΔΗΜΙ": 0.0672, "ΗΜΙΟ": 0.0672,
+	"ΜΙΟΥ": 0.0672, "ΠΟΡΕ": 0.0672, "ΑΛΟΓ": 0.0672, "ΜΙΣΤ": 0.0668, "ΟΤΥΧ": 0.0668,
+	"ΓΙΝΕ": 0.0668, "ΛΟΓΙ": 0.0664, "ΑΑΝΑ": 0.0664, "ΑΑΠΟ": 0.0664, "ΤΥΠΟ": 0.0657,
+	"ΝΔΕΣ": 0.0649, "ΥΡΓΙ": 0.0649, "ΑΦΗΣ": 0.0649, "ΑΡΙΘ": 0.0649, "ΝΩΣΗ": 0.0649,
+	"ΕΝΩΝ": 0.0645, "ΣΤΟΠ": 0.0645, "ΗΓΙΑ": 0.0645, "ΟΥΑΡ": 0.0641, "ΜΑΤΑ": 0.0641,
+	"ΕΠΕΙ": 0.0637, "ΤΗΣΕ": 0.0637, "ΝΑΓΝ": 0.0637, "ΛΙΚΟ": 0.0633, "ΤΟΠΟ": 0.0630,
+	"ΠΡΟΕ": 0.0630, "ΤΙΚΕ": 0.0630, "ΙΚΟΝ": 0.0626, "ΑΝΑΜ": 0.0622, "ΟΥΜΕ": 0.0618,
+	"ΟΔΕΝ": 0.0618, "ΤΗΜΑ": 0.0618, "ΟΟΝΟ": 0.0618, "ΜΕΝΑ": 0.0618, "ΗΜΕΝ": 0.0618,
+	"ΕΙΤΑ": 0.0614, "ΕΝΟΥ": 0.0614, "ΑΡΑΒ": 0.0614, "ΜΕΝΩ": 0.0610, "ΗΔΙΑ": 0.0610,
+	"ΙΣΤΑ": 0.0606, "ΟΛΟΓ": 0.0602, "ΑΠΡΟ": 0.0602, "ΑΣΤΗ": 0.0602, "ΠΟΣΤ": 0.0599,
+	"ΩΣΗΣ": 0.0599, "ΣΕΤΕ": 0.0599, "ΗΕΠΙ": 0.0595, "ΡΑΦΟ": 0.0591, "ΣΙΑΣ": 0.0587,
+	"ΤΟΛΗ": 0.0583, "ΟΜΑΤ": 0.0583, "ΧΩΡΙ": 0.0583, "ΟΘΗΚ": 0.0583, "ΗΤΙΚ": 0.0575,
+	"ΑΑΡΧ": 0.0575, "ΑΦΟΡ": 0.0575, "ΘΜΙΣ": 0.0572, "ΗΜΕΡ": 0.0572, "ΑΜΜΗ": 0.0568,
+	"ΤΑΛΟ": 0.0568, "ΑΤΗΝ": 0.0568, "ΚΥΡΗ": 0.0568, "ΟΣΑΡ": 0.0568, "ΧΕΙΑ": 0.0560,
+	"ΤΟΥΑ": 0.0556, "ΥΣΤΗ": 0.0556, "ΙΤΑΙ": 0.0552, "ΛΟΓΟ": 0.0552, "ΙΑΑΝ": 0.0552,
+	"ΙΣΜΑ": 0.0552, "ΟΜΑΔ": 0.0548, "ΡΑΒΙ": 0.0548, "ΣΕΙΣ": 0.0545, "ΕΣΗΣ": 0.0545,
+	"ΥΑΡΧ": 0.0545, "ΝΕΡΓ": 0.0545, "ΛΑΓΗ": 0.0541, "ΑΓΡΑ": 0.0541, "ΝΥΠΟ": 0.0537,
+	"ΚΤΡΟ": 0.0537, "ΙΑΚΟ": 0.0533, "ΔΙΚΑ": 0.0533, "ΠΡΕΠ": 0.0533, "ΣΜΟΣ": 0.0533,
+	"ΤΙΚΑ": 0.0529, "ΟΔΙΑ": 0.0529, "ΕΡΜΑ": 0.0529, "ΤΟΔΕ": 0.0529, "ΠΟΤΕ": 0.0529,
+	"ΝΑΜΕ": 0.0529, "ΝΔΙΑ": 0.0525, "ΗΔΗΜ": 0.0525, "ΑΒΙΚ": 0.0525, "ΤΟΤΟ": 0.0521,
+	"ΟΥΣΥ": 0.0521, "ΕΡΙΕ": 0.0518, "ΗΔΕΝ": 0.0518, "ΣΥΜΒ": 0.0518, "ΑΚΑΤ": 0.0518,
+	"ΤΕΤΟ": 0.0518, "ΗΑΝΑ": 0.0518, "ΜΟΝΟ": 0.0518, "ΟΕΠΙ": 0.0518, "ΚΩΔΙ": 0.0514,
+	"ΩΔΙΚ": 0.0514, "ΓΙΑΝ": 0.0514, "ΟΝΙΚ": 0.0514, "ΗΣΤΕ": 0.0514, "ΗΣΗΤ": 0.0510,
+	"ΠΕΙΝ": 0.0510, "ΣΗΜΕ": 0.0510, "ΕΝΕΡ": 0.0510, "ΡΥΘΜ": 0.0510, "ΚΡΕΟ": 0.0510,
+	"ΡΕΟΛ": 0.0510, "ΣΤΗΡ": 0.0506, "ΕΚΔΟ": 0.0506, "ΙΑΠΟ": 0.0502, "ΚΔΟΣ": 0.0502,
+	"ΟΛΟΥ": 0.0498, "ΝΑΙΕ": 0.0498, "ΙΚΟΠ": 0.0498, "ΣΥΜΠ": 0.0498, "ΠΟΤΟ": 0.0494,
+	"ΕΟΛΗ": 0.0494, "ΙΑΚΑ": 0.0490, "ΔΟΜΕ": 0.0490, "ΙΚΗΣ": 0.0487, "ΛΙΚΗ": 0.0487,
+	"ΑΣΗΣ": 0.0487, "ΗΑΠΟ": 0.0487, "ΙΔΙΟ": 0.0487, "ΕΝΟΣ": 0.0487, "ΔΕΔΟ": 0.0487,
+	"ΝΥΠΑ": 0.0487, "ΑΛΑΙ": 0.0487, "ΝΤΙΚ": 0.0483, "ΣΔΕΝ": 0.0483, "ΙΜΕΝ": 0.0483,
+	"ΕΤΕΤ": 0.0483, "ΣΣΤΟ": 0.0483, "ΚΟΛΟ": 0.0479, "ΔΟΣΗ": 0.0479, "ΕΞΟΔ": 0.0479,
+	"ΞΟΔΟ": 0.0479, "ΜΕΤΟ": 0.0479, "ΙΘΜΟ": 0.0479, "ΥΘΜΙ": 0.0479, "ΠΛΗΡ": 0.0479,
+	"ΝΕΠΙ": 0.0475, "ΡΙΕΧ": 0.0475, "ΟΤΟΥ": 0.0475, "ΑΤΩΝ": 0.0475, "ΣΥΝΘ": 0.0475,
+	"ΒΙΚΑ": 0.0475, "ΙΤΕΙ": 0.0471, "ΖΕΤΑ": 0.0471, "ΑΜΕΤ": 0.0471, "ΣΜΟΥ": 0.0471,
+	"ΥΠΟΒ": 0.0471, "ΘΗΜΑ": 0.0471, "ΟΛΙΚ": 0.0467, "ΕΔΟΜ": 0.0467, "ΣΥΣΤ": 0.0467,
+	"ΟΒΟΛ": 0.0467, "ΙΚΗΚ": 0.0463, "ΝΑΠΟ": 0.0463, "ΣΕΩΝ": 0.0463, "ΕΛΕΣ": 0.0460,
+	"ΕΥΣΗ": 0.0460, "ΒΟΛΗ": 0.0460, "ΕΙΚΟ": 0.0460, "ΛΙΚΑ": 0.0456, "ΠΑΙΤ": 0.0456,
+	"ΑΔΙΑ": 0.0456, "ΡΕΙΝ": 0.0456, "ΚΕΤΑ": 0.0456, "ΑΓΓΛ": 0.0456, "ΓΓΛΙ": 0.0456,
+	"ΓΛΙΚ": 0.0456, "ΜΕΝΕ": 0.0452, "ΣΑΠΟ": 0.0452, "ΔΕΣΗ": 0.0452, "ΗΚΑΝ": 0.0452,
+	"ΟΚΛΕ": 0.0452, "ΝΕΤΑ": 0.0452, "ΣΓΙΑ": 0.0452, "ΥΝΘΗ": 0.0452, "ΕΙΩΝ": 0.0448,
+	"ΤΟΣΤ": 0.0448, "ΤΟΥΠ": 0.0448, "ΟΥΑΝ": 0.0444, "ΕΤΥΧ": 0.0444, "ΤΥΧΕ": 0.0444,
+	"ΗΤΑΝ": 0.0444, "ΗΘΕΙ": 0.0444, "ΑΚΟΛ": 0.0444, "ΑΝΔΙ": 0.0444, "ΑΠΟΜ": 0.0440,
+	"ΠΕΤΥ": 0.0440, "ΑΝΟΙ": 0.0440, "ΑΜΕΝ": 0.0440, "ΜΙΑΑ": 0.0440, "ΣΑΡΧ": 0.0440,
+	"ΑΙΡΕ": 0.0440, "ΑΠΟΘ": 0.0436, "ΡΙΚΟ": 0.0433, "ΙΝΕΙ": 0.0433, "ΕΠΙΠ": 0.0433,
+	"ΗΚΡΕ": 0.0433, "ΛΑΝΔ": 0.0433, "ΙΚΑΤ": 0.0429, "ΑΚΟΜ": 0.0429, "ΑΠΕΤ": 0.0429,
+	"ΣΤΕΙ": 0.0429, "ΕΙΑΣ": 0.0429, "ΟΥΣΤ": 0.0429, "ΟΤΥΠ": 0.0429, "ΕΣΜΕ": 0.0429,
+	"ΝΗΜΕ": 0.0429, "ΝΘΗΜ": 0.0429, "ΟΤΗΤ": 0.0425, "ΑΠΑΙ": 0.0425, "ΝΑΙΔ": 0.0425,
+	"ΑΚΥΡ": 0.0425, "ΑΛΛΙ": 0.0421, "ΟΜΑΚ": 0.0421, "ΑΤΑΤ": 0.0421, "ΚΕΙΜ": 0.0421,
+	"ΙΣΤΙ": 0.0421, "ΤΗΝΑ": 0.0421, "ΕΝΗΤ": 0.0421, "ΥΜΕΝ": 0.0421, "ΜΗΝΥ": 0.0421,
+	"ΝΥΜΑ": 0.0421, "ΤΗΡΑ": 0.0417, "ΣΤΙΚ": 0.0417, "ΑΟΡΙ": 0.0417, "ΗΝΥΜ": 0.0417,
+	"ΙΑΣΤ": 0.0417, "ΝΩΣΤ": 0.0417, "ΟΓΕΣ": 0.0417, "ΡΑΚΑ": 0.0417, "ΟΝΤΑ": 0.0413,
+	"ΓΙΑΣ": 0.0413, "ΔΙΑΔ": 0.0413, "ΕΝΟΤ": 0.0413, "ΚΗΚΡ": 0.0413, "ΟΔΟΣ": 0.0409,
+	"ΑΕΙΝ": 0.0409, "ΤΗΣΤ": 0.0409, "ΣΤΟΙ": 0.0409, "ΩΣΤΟ": 0.0409, "ΚΛΑΔ": 0.0409,
+	"ΚΑΝΟ": 0.0406, "ΜΑΚΡ": 0.0406, "ΔΙΑΚ": 0.0406, "ΕΠΙΤ": 0.0406, "ΝΔΥΝ": 0.0406,
+	"ΝΗΤΑ": 0.0406, "ΟΑΠΟ": 0.0406, "ΤΙΜΗ": 0.0406, "ΕΝΗΜ": 0.0406, "ΑΙΚΑ": 0.0402,
+	"ΕΙΔΟ": 0.0402, "ΕΤΟΝ": 0.0402, "ΡΟΥΣ": 0.0402, "ΥΠΟΓ": 0.0402, "ΕΙΤΕ": 0.0402,
+	"ΡΕΣΗ": 0.0402, "ΙΚΕΣ": 0.0402, "ΝΤΑΙ": 0.0398, "ΤΟΜΕ": 0.0398, "ΕΘΗΚ": 0.0398,
+	"ΜΑΔΑ": 0.0398, "ΛΟΥΘ": 0.0398, "ΘΗΚΗ": 0.0398, "ΗΤΩΝ": 0.0398, "ΛΙΣΤ": 0.0398,
+	"ΥΜΠΙ": 0.0394, "ΑΔΙΚ": 0.0394, "ΤΑΝΔ": 0.0394, "ΒΡΕΘ": 0.0394, "ΜΑΛΑ": 0.0390,
+	"ΜΑΝΙ": 0.0390, "ΠΟΛΥ": 0.0390, "ΗΡΙΖ": 0.0390, "ΑΙΤΕ": 0.0390, "ΤΑΤΗ": 0.0390,
+	"ΟΠΡΟ": 0.0390, "ΤΟΙΧ": 0.0390, "ΡΙΑΣ": 0.0390, "ΚΑΤΕ": 0.0390, "ΛΑΔΟ": 0.0390,
+	"ΚΤΡΑ": 0.0390, "ΗΥΠΟ": 0.0386, "ΙΝΑΕ": 0.0386, "ΜΑΤΩ": 0.0386, "ΠΟΓΡ": 0.0386,
+	"ΤΗΣΑ": 0.0386, "ΜΜΑΤ": 0.0386, "ΛΛΙΚ": 0.0382, "ΤΕΙΤ": 0.0382, "ΑΝΔΥ": 0.0382,
+	"ΙΑΓΡ": 0.0382, "ΑΕΠΙ": 0.0382, "ΠΙΕΣ": 0.0382, "ΓΑΛΛ": 0.0378, "ΕΣΤΗ": 0.0378,
+	"ΑΥΤΗ": 0.0378, "ΔΕΝΜ": 0.0378, "ΝΜΠΟ": 0.0378, "ΜΙΑΕ": 0.0378, "ΣΤΟΝ": 0.0378,
+	"ΝΑΙΑ": 0.0378, "ΙΚΗΔ": 0.0378, "ΤΑΙΣ": 0.0375, "ΕΝΑΑ": 0.0375, "ΔΡΟΜ": 0.0375,
+	"ΔΕΝΗ": 0.0375, "ΑΡΤΗ": 0.0375, "ΛΗΨΗ": 0.0375, "ΟΣΥΝ": 0.0375, "ΛΟΓΕ": 0.0375,
+	"ΟΔΟΥ": 0.0375, "ΙΝΕΤ": 0.0375, "ΠΟΒΟ": 0.0375, "ΕΝΟΑ": 0.0375, "ΟΥΠΑ": 0.0375,
+	"ΣΚΑΙ": 0.0371, "ΕΝΜΠ": 0.0371, "ΥΜΒΟ": 0.0371, "ΡΕΘΗ": 0.0371, "ΜΑΣΤ": 0.0371,
+	"ΣΤΟΣ": 0.0371, "ΕΤΙΚ": 0.0371, "ΤΟΠΑ": 0.0371, "ΑΡΜΟ": 0.0371, "ΕΤΟΥ": 0.0367,
+	"ΔΙΑΓ": 0.0367, "ΑΓΙΑ": 0.0367, "ΜΕΡΩ": 0.0367, "ΤΕΣΤ": 0.0367, "ΠΙΠΕ": 0.0367,
+	"ΙΠΕΔ": 0.0367, "ΡΟΛΟ": 0.0367, "ΕΡΙΚ": 0.0363, "ΤΟΥΜ": 0.0363, "ΤΟΠΙ": 0.0363,
+	"ΕΝΕΧ": 0.0363, "ΕΙΣΑ": 0.0363, "ΜΒΟΛ": 0.0363, "ΕΡΓΟ": 0.0363, "ΑΧΡΗ": 0.0363,
+	"ΣΗΜΑ": 0.0363, "ΜΠΙΕ": 0.0363, "ΠΕΔΟ": 0.0363, "ΣΣΕΣ": 0.0363, "ΝΕΧΕ": 0.0359,
+	"ΑΤΙΣ": 0.0359, "ΙΣΟΔ": 0.0359, "ΙΔΥΝ": 0.0359, "ΑΠΛΗ": 0.0359, "ΟΦΟΡ": 0.0359,
+	"ΟΘΕΤ": 0.0359, "ΕΝΕΣ": 0.0355, "ΑΔΗΜ": 0.0355, "ΤΑΙΑ": 0.0355, "ΑΙΔΥ": 0.0355,
+	"ΑΤΟΝ": 0.0355, "ΟΓΙΑ": 0.0355, "ΑΝΑΦ": 0.0355, "ΑΝΑΚ": 0.0355, "ΟΥΣΕ": 0.0351,
+	"ΟΥΚΑ": 0.0351, "ΕΚΤΕ": 0.0351, "ΟΓΙΣ": 0.0351, "ΡΙΖΕ": 0.0351, "ΝΑΙΚ": 0.0351,
+	"ΟΤΕΡ": 0.0351, "ΟΤΕΛ": 0.0351, "ΡΟΜΗ": 0.0351, "ΕΙΣΟ": 0.0351, "ΙΓΜΑ": 0.0351,
+	"ΡΟΦΟ": 0.0351, "ΤΗΜΕ": 0.0351, "ΦΑΝΙ": 0.0351, "ΥΤΟΥ": 0.0351, "ΩΣΣΕ": 0.0351,
+	"ΚΟΝΑ": 0.0348, "ΩΜΕΝ": 0.0348, "ΑΙΑΠ": 0.0348, "ΔΟΠΟ": 0.0348, "ΗΚΑΤ": 0.0348,
+	"ΑΝΙΣ": 0.0348, "ΗΡΙΟ": 0.0348, "ΣΥΓΚ": 0.0348, "ΙΤΟΥ": 0.0344, "ΣΤΗΚ": 0.0344,
+	"ΤΟΥΡ": 0.0344, "ΑΔΡΟ": 0.0344, "ΙΚΩΝ": 0.0344, "ΔΙΟΡ": 0.0344, "ΣΥΠΟ": 0.0344,
+	"ΛΗΡΟ": 0.0344, "ΦΟΡΑ": 0.0344, "ΕΜΦΑ": 0.0344, "ΜΦΑΝ": 0.0344, "ΝΑΛΛ": 0.0344,
+	"ΕΖΙΚ": 0.0344, "ΑΝΙΑ": 0.0340, "ΑΚΡΥ": 0.0340, "ΑΓΩΓ": 0.0340, "ΟΛΩΝ": 0.0340,
+	"ΤΟΑΝ": 0.0340, "ΕΠΑΝ": 0.0340, "ΤΕΝΑ": 0.0336, "ΚΟΜΙ": 0.0336, "ΟΜΙΣ": 0.0336,
+	"ΡΜΑΤ": 0.0336, "ΥΣΗΣ": 0.0336, "ΟΤΑΝ": 0.0336, "ΑΠΕΡ": 0.0336, "ΟΥΓΙ": 0.0336,
+	"ΑΛΕΙ": 0.0336, "ΗΜΕΤ": 0.0336, "ΔΙΑΣ": 0.0336, "ΙΚΕΙ": 0.0336, "ΦΑΙΡ": 0.0336,
+	"ΠΟΘΕ": 0.0336, "ΑΠΑΚ": 0.0336, "ΝΙΑΣ": 0.0332, "ΣΚΑΤ": 0.0332, "ΤΙΣΤ": 0.0332,
+	"ΤΗΚΕ": 0.0332, "ΑΠΟΥ": 0.0332, "ΙΑΔΙ": 0.0332, "ΣΜΑΤ": 0.0332, "ΣΠΡΟ": 0.0332,
+	"ΟΣΕΙ": 0.0332, "ΥΓΙΑ": 0.0332, "ΣΗΤΗ": 0.0332, "ΑΤΟΤ": 0.0332, "ΦΟΡΙ": 0.0332,
+	"ΝΑΡΧ": 0.0332, "ΑΜΜΑ": 0.0332, "ΑΦΑΙ": 0.0332, "ΕΤΗΡ": 0.0332, "ΕΦΑΡ": 0.0332,
+	"ΦΑΡΜ": 0.0332, "ΤΡΟΛ": 0.0332, "ΗΡΑΣ": 0.0328, "ΣΔΙΑ": 0.0328, "ΤΟΟΝ": 0.0328,
+	"ΣΕΙΝ": 0.0328, "ΕΤΗΝ": 0.0328, "ΙΣΜΕ": 0.0328, "ΗΡΟΦ": 0.0328, "ΑΜΕΣ": 0.0328,
+	"ΙΓΡΑ": 0.0328, "ΟΣΦΑ": 0.0328, "ΤΟΣΥ": 0.0328, "ΘΕΤΗ": 0.0328, "ΝΔΙΚ": 0.0328,
+	"ΝΙΚΗ": 0.0324, "ΣΟΔΟ": 0.0324, "ΑΝΑΛ": 0.0324, "ΤΟΥΚ": 0.0324, "ΝΟΙΓ": 0.0324,
+	"ΟΙΓΜ": 0.0324, "ΟΥΔΕ": 0.0324, "ΕΓΙΑ": 0.0324, "ΑΤΕΣ": 0.0324, "ΟΥΝΤ": 0.0324,
+	"ΚΤΙΚ": 0.0321, "ΙΝΗΣ": 0.0321, "ΤΗΤΑ": 0.0321, "ΑΧΩΡ": 0.0321, "ΟΕΙΔ": 0.0321,
+	"ΣΣΥΝ": 0.0321, "ΡΤΗΣ": 0.0321, "ΑΠΟΣ": 0.0321, "ΘΜΟΣ": 0.0321, "ΓΜΑΤ": 0.0321,
+	"ΕΙΣΤ": 0.0321, "ΥΠΕΡ": 0.0317, "ΙΚΑΜ": 0.0317, "ΗΝΕΠ": 0.0317, "ΣΥΓΧ": 0.0317,
+	"ΤΕΡΟ": 0.0317, "ΚΑΙΤ": 0.0317, "ΟΤΕΚ": 0.0317, "ΑΝΑΤ": 0.0313, "ΠΟΜΑ": 0.0313,
+	"ΑΙΤΟ": 0.0313, "ΙΖΕΤ": 0.0313, "ΩΜΑΤ": 0.0313, "ΙΑΔΡ": 0.0313, "ΙΔΟΠ": 0.0313,
+	"ΣΑΝΑ": 0.0313, "ΗΠΡΟ": 0.0313, "ΔΕΣΜ": 0.0313, "ΙΡΕΣ": 0.0313, "ΟΠΑΚ": 0.0313,
+	"ΡΕΙΑ": 0.0309, "ΑΚΑΛ": 0.0309, "ΙΤΡΕ": 0.0309, "ΤΡΕΠ": 0.0309, "ΥΤΟΤ": 0.0309,
+	"ΕΝΟΜ": 0.0309, "ΧΕΙΩ": 0.0309, "ΑΝΓΚ": 0.0309, "ΖΑΠΟ": 0.0309, "ΥΓΚΡ": 0.0309,
+	"ΓΙΑΑ": 0.0305, "ΕΥΘΥ": 0.0305, "ΥΘΥΝ": 0.0305, "ΙΕΧΕ": 0.0305, "ΡΟΕΙ": 0.0305,
+	"ΙΔΙΑ": 0.0305, "ΗΘΗΚ": 0.0305, "ΟΥΤΟ": 0.0305, "ΝΙΣΗ": 0.0305, "ΑΝΤΟ": 0.0301,
+	"ΚΤΕΛ": 0.0301, "ΤΗΤΗ": 0.0301, "ΘΥΝΣ": 0.0301, "ΝΟΜΕ": 0.0301, "ΑΝΟΝ": 0.0301,
+	"ΑΡΧΙ": 0.0301, "ΤΑΙΠ": 0.0301, "ΕΛΕΓ": 0.0297, "ΤΕΡΜ": 0.0297, "ΥΝΣΗ": 0.0297,
+	"ΟΛΗΣ": 0.0297, "ΥΡΟΣ": 0.0297, "ΙΑΣΜ": 0.0297, "ΙΕΡΓ": 0.0297, "ΝΑΓΙ": 0.0297,
+	"ΡΜΟΓ": 0.0297, "ΙΕΣΜ": 0.0294, "ΓΙΣΤ": 0.0294, "ΑΥΠΟ": 0.0294, "ΙΟΡΙ": 0.0294,
+	"ΤΗΗΑ": 0.0294, "ΔΙΟΥ": 0.0294, "ΗΣΕΙ": 0.0294, "ΕΤΑΤ": 0.0294, "ΕΛΟΣ": 0.0294,
+	"ΝΑΦΟ": 0.0294, "ΑΚΑΙ": 0.0294, "ΕΤΩΝ": 0.0294, "ΑΓΙΝ": 0.0294, "ΟΕΙΝ": 0.0294,
+	"ΣΑΛΛ": 0.0294, "ΕΑΥΤ": 0.0290, "ΝΑΝΑ": 0.0290, "ΖΗΤΗ": 0.0290, "ΣΗΣΣ": 0.0290,
+	"ΟΥΣΑ": 0.0290, "ΓΟΠΟ": 0.0290, "ΦΡΑΣ": 0.0290, "ΗΣΣΤ": 0.0290, "ΛΑΙΣ": 0.0290,
+	"ΣΗΓΙ": 0.0290, "ΙΚΑΠ": 0.0290, "ΣΓΛΩ": 0.0290, "ΤΟΓΡ": 0.0286, "ΔΙΕΥ": 0.0286,
+	"ΗΣΥΝ": 0.0286, "ΡΑΣΗ": 0.0286, "ΤΡΟΠ": 0.0286, "ΟΧΡΗ": 0.0286, "ΝΑΔΙ": 0.0282,
+	"ΙΑΖΕ": 0.0282, "ΚΟΠΟ": 0.0282, "ΡΓΟΠ": 0.0282, "ΕΕΝΑ": 0.0282, "ΠΙΤΡ": 0.0278,
+	"ΙΣΗΣ": 0.0278, "ΚΑΝΕ": 0.0278, "ΣΣΤΗ": 0.0278, "ΙΑΜΕ": 0.0278, "ΚΑΘΟ": 0.0278,
+	"ΒΟΛΙ": 0.0278, "ΔΙΑΦ": 0.0278, "ΟΙΗΘ": 0.0278, "ΜΕΣΑ": 0.0278, "ΛΑΓΕ": 0.0278,
+	"ΑΓΕΣ": 0.0278, "ΚΗΔΗ": 0.0278, "ΛΕΙΤ": 0.0274, "ΟΝΤΟ": 0.0274, "ΙΕΝΑ": 0.0274,
+	"ΤΗΔΙ": 0.0274, "ΗΕΙΝ": 0.0274, "ΑΙΣΙ": 0.0274, "ΙΚΟΤ": 0.0270, "ΝΑΑΠ": 0.0270,
+	"ΗΣΔΕ": 0.0270, "ΑΔΕΝ": 0.0270, "ΣΕΙΡ": 0.0270, "ΥΠΟΥ": 0.0270, "ΝΑΠΑ": 0.0270,
+	"ΠΙΛΕ": 0.0270, "ΕΡΩΣ": 0.0270, "ΣΣΑΣ": 0.0270, "ΔΙΕΡ": 0.0270, "ΤΟΣΑ": 0.0270,
+	"ΙΗΣΤ": 0.0270, "ΖΙΚΑ": 0.0270, "ΟΓΙΟ": 0.0270, "ΠΟΤΗ": 0.0266, "ΙΡΙΑ": 0.0266,
+	"ΚΕΤΩ": 0.0266, "ΥΣΤΟ": 0.0266, "ΝΤΟΥ": 0.0266, "ΩΝΕΥ": 0.0266, "ΥΣΜΕ": 0.0263,
+	"ΑΙΝΑ": 0.0263, "ΕΝΑΣ": 0.0263, "ΟΟΡΙ": 0.0263, "ΟΣΜΕ": 0.0263, "ΧΟΥΝ": 0.0263,
+	"ΕΥΡΕ": 0.0263, "ΙΚΟΚ": 0.0263, "ΟΝΓΚ": 0.0263, "ΕΣΓΛ": 0.0263, "ΥΓΧΩ": 0.0263,
+	"ΓΧΩΝ": 0.0263, "ΧΩΝΕ": 0.0263, "ΝΕΚΡ": 0.0263, "ΑΡΙΑ": 0.0259, "ΗΣΚΑ": 0.0259,
+	"ΥΠΟΔ": 0.0259, "ΥΠΤΟ": 0.0259, "ΝΑΕΙ": 0.0259, "ΜΕΤΡ": 0.0259, "ΙΜΕΤ": 0.0259,
+	"ΟΥΧΡ": 0.0259, "ΤΟΥΕ": 0.0259, "ΤΕΛΟ": 0.0259, "ΑΘΟΡ": 0.0259, "ΘΟΡΙ": 0.0259,
+	"ΑΛΛΟ": 0.0259, "ΣΜΕΤ": 0.0259, "ΠΑΝΑ": 0.0259, "ΑΠΑΡ": 0.0259, "ΙΚΑΣ": 0.0259,
+	"ΚΑΜΕ": 0.0259, "ΙΧΕΙ": 0.0259, "ΡΟΣΘ": 0.0259, "ΙΣΙΑ": 0.0259, "ΛΕΓΧ": 0.0255,
+	"ΛΗΡΩ": 0.0255, "ΣΠΕΡ": 0.0255, "ΙΕΥΘ": 0.0255, "ΑΙΕΝ": 0.0255, "ΡΟΣΑ": 0.0255,
+	"ΝΑΚΑ": 0.0255, "ΝΟΝΙ": 0.0255, "ΕΠΙΣ": 0.0255, "ΟΙΧΕ": 0.0255, "ΕΚΡΑ": 0.0255,
+	"ΚΡΥΣ": 0.0251, "ΡΥΣΜ": 0.0251, "ΗΚΕΑ": 0.0251, "ΙΣΑΓ": 0.0251, "ΕΙΟΤ": 0.0251,
+	"ΠΡΩΤ": 0.0251, "ΒΑΣΗ": 0.0251, "ΙΚΑΙ": 0.0251, "ΝΠΡΟ": 0.0251, "ΑΙΡΙ": 0.0251,
+	"ΣΗΚΑ": 0.0251, "ΚΕΝΟ": 0.0251, "ΤΟΝΑ": 0.0251, "ΜΑΣΙ": 0.0251, "ΟΜΗΝ": 0.0251,
+	"ΑΓΗΣ": 0.0251, "ΕΓΑΛ": 0.0251, "ΑΝΑΠ": 0.0251, "ΣΕΠΙ": 0.0251, "ΣΗΔΕ": 0.0251,
+	"ΑΡΙΣ": 0.0251, "ΤΑΤΟ": 0.0251, "ΜΟΡΦ": 0.0251, "ΑΑΛΛ": 0.0251, "ΡΜΑΝ": 0.0251,
+	"ΕΝΤΡ": 0.0247, "ΠΡΟΒ": 0.0247, "ΕΓΧΟ": 0.0247, "ΤΑΥΤ": 0.0247, "ΗΣΗΑ": 0.0247,
+	"ΡΙΩΝ": 0.0247, "ΛΜΑΑ": 0.0247, "ΑΣΦΑ": 0.0247, "ΡΟΣΤ": 0.0247, "ΩΣΗΤ": 0.0247,
+	"ΣΤΟΑ": 0.0247, "ΔΙΑΧ": 0.0247, "ΧΡΟΝ": 0.0247, "ΘΗΚΑ": 0.0247, "ΣΑΝΤ": 0.0247,
+	"ΑΘΕΣ": 0.0247, "ΘΕΣΙ": 0.0247, "ΟΛΕΣ": 0.0247, "ΤΟΚΛ": 0.0247, "ΜΕΣΟ": 0.0247,
+	"ΔΙΑΤ": 0.0247, "ΓΕΡΜ": 0.0247, "ΤΡΙΚ": 0.0243, "ΚΑΙΕ": 0.0243, "ΧΟΜΕ": 0.0243,
+	"ΛΕΙΣ": 0.0243, "ΤΗΗΕ": 0.0243, "ΤΑΙΡ": 0.0243, "ΕΚΤΟ": 0.0243, "ΟΓΟΥ": 0.0243,
+	"ΙΟΥΠ": 0.0243, "ΚΙΝΗ": 0.0243, "ΟΤΟΑ": 0.0243, "ΝΑΟΡ": 0.0243, "ΟΛΛΑ": 0.0243,
+	"ΑΣΜΟ": 0.0243, "ΥΔΕΝ": 0.0243, "ΑΜΜΕ": 0.0243, "ΗΣΗΓ": 0.0243, "ΣΙΚΑ": 0.0243,
+	"ΣΙΑΝ": 0.0243, "ΙΕΧΟ": 0.0239, "ΘΕΙΑ": 0.0239, "ΗΣΗΕ": 0.0239, "ΕΙΤΗ": 0.0239,
+	"ΗΑΡΧ": 0.0239, "ΟΜΑΣ": 0.0239, "ΡΑΠΛ": 0.0239, "ΤΩΝΠ": 0.0239, "ΗΣΓΙ": 0.0239,
+	"ΕΠΕΞ": 0.0239, "ΜΑΚΑ": 0.0239, "ΙΟΡΘ": 0.0239, "ΟΡΘΩ": 0.0239, "ΜΙΣΕ": 0.0239,
+	"ΤΟΥΤ": 0.0236, "ΙΚΟΑ": 0.0236, "ΕΙΡΑ": 0.0236, "ΕΝΑΠ": 0.0236, "ΗΤΙΜ": 0.0236,
+	"ΕΞΕΡ": 0.0236, "ΜΕΓΑ": 0.0236, "ΗΓΡΑ": 0.0236, "ΥΝΤΑ": 0.0236, "ΚΡΟΥ": 0.0236,
+	"ΗΜΕΙ": 0.0236, "ΝΑΕΠ": 0.0236, "ΥΣΥΣ": 0.0236, "ΡΓΕΙ": 0.0236, "ΣΤΕΚ": 0.0236,
+	"ΝΕΖΙ": 0.0236, "ΡΙΚΗ": 0.0232, "ΤΗΣΚ": 0.0232, "ΠΕΤΑ": 0.0232, "ΝΑΙΠ": 0.0232,
+	"ΟΥΠΡ": 0.0232, "ΤΟΣΕ": 0.0232, "ΝΕΝΑ": 0.0232, "ΑΠΑΝ": 0.0232, "ΠΕΞΕ": 0.0232,
+	"ΞΕΡΓ": 0.0232, "ΜΙΑΔ": 0.0232, "ΕΣΜΟ": 0.0232, "ΓΙΑΕ": 0.0232, "ΠΡΟΤ": 0.0232,
+	"ΗΚΑΙ": 0.0228, "ΕΠΕΤ": 0.0228, "ΗΣΔΙ": 0.0228, "ΗΔΙΕ": 0.0228, "ΗΕΝΤ": 0.0228,
+	"ΗΣΣΥ": 0.0228, "ΙΠΙΣ": 0.0228, "ΒΑΣΙ": 0.0228, "ΟΥΑΠ": 0.0228, "ΥΧΡΗ": 0.0228,
+	"ΟΓΗΣ": 0.0228, "ΑΙΣΤ": 0.0228, "ΟΠΛΗ": 0.0228, "ΡΟΕΠ": 0.0228, "ΥΤΑΙ": 0.0228,
+	"ΝΟΑΡ": 0.0228, "ΑΙΕΓ": 0.0228, "ΙΕΓΚ": 0.0228, "ΜΟΓΗ": 0.0228, "ΑΛΙΚ": 0.0228,
+	"ΜΠΟΥ": 0.0224, "ΤΗΣΣ": 0.0224, "ΛΜΑΤ": 0.0224, "ΜΑΑΝ": 0.0224, "ΤΑΙΝ": 0.0224,
+	"ΧΕΙΤ": 0.0224, "ΤΗΣΥ": 0.0224, "ΣΤΕΡ": 0.0224, "ΙΣΗΤ": 0.0224, "ΤΟΤΕ": 0.0224,
+	"ΙΟΘΗ": 0.0224, "ΑΙΠΙ": 0.0224, "ΡΙΚΑ": 0.0220, "ΝΗΣΟ": 0.0220, "ΑΝΤΑ": 0.0220,
+	"ΙΣΚΟ": 0.0220, "ΕΧΟΜ": 0.0220, "ΚΡΥΠ": 0.0220, "ΡΥΠΤ": 0.0220, "ΑΝΑΔ": 0.0220,
+	"ΓΩΓΗ": 0.0220, "ΝΑΖΗ": 0.0220, "ΑΖΗΤ": 0.0220, "ΟΣΔΙ": 0.0220, "ΚΑΝΑ": 0.0220,
+	"ΤΕΤΗ": 0.0220, "ΜΙΣΗ": 0.0220, "ΣΧΡΗ": 0.0220, "ΡΑΦΕ": 0.0220, "ΣΓΡΑ": 0.0220,
+	"ΕΚΦΡ": 0.0220, "ΚΦΡΑ": 0.0220, "ΤΕΓΙ": 0.0220, "ΕΧΟΥ": 0.0220, "ΙΘΜΗ": 0.0220,
+	"ΡΩΣΕ": 0.0220, "ΜΟΣΙ": 0.0220, "ΚΑΛΩ": 0.0220, "ΙΣΗΜ": 0.0220, "ΙΚΑΑ": 0.0220,
+	"ΑΚΛΕ": 0.0220, "ΧΕΙΡ": 0.0220, "ΠΑΛΑ": 0.0220, "ΟΣΥΜ": 0.0220, "ΓΚΡΟ": 0.0220,
+	"ΝΟΤΙ": 0.0216, "ΕΙΟΕ": 0.0216, "ΕΡΟΥ": 0.0216, "ΟΣΠΑ": 0.0216, "ΣΤΩΝ": 0.0216,
+	"ΛΜΑΣ": 0.0216, "ΕΙΣΕ": 0.0216, "ΔΕΝΤ": 0.0216, "ΟΥΝΑ": 0.0216, "ΣΕΝΑ": 0.0216,
+	"ΚΤΟΣ": 0.0216, "ΗΡΕΣ": 0.0216, "ΑΚΤΙ": 0.0216, "ΧΙΑΑ": 0.0216, "ΑΘΟΣ": 0.0216,
+	"ΟΣΗΣ": 0.0216, "ΗΣΗΜ": 0.0216, "ΤΟΝΟ": 0.0216, "ΗΜΟΣ": 0.0216, "ΕΤΟΙ": 0.0216,
+	"ΡΘΩΣ": 0.0216, "ΟΑΝΤ": 0.0216, "ΓΑΡΙ": 0.0216, "ΚΡΑΠ": 0.0216, "ΙΚΑΝ": 0.0212,
+	"ΒΟΡΕ": 0.0212, "ΟΣΚΑ": 0.0212, "ΕΛΕΥ": 0.0212, "ΙΖΕΙ": 0.0212, "ΣΠΟΥ": 0.0212,
+	"ΣΤΕΤ": 0.0212, "ΣΑΡΙ": 0.0212, "ΙΑΘΕ": 0.0212, "ΣΕΝΤ": 0.0212, "ΛΙΔΑ": 0.0212,
+	"ΟΕΝΑ": 0.0212, "ΕΜΙΑ": 0.0212, "ΤΟΕΠ": 0.0212, "ΚΕΦΑ": 0.0212, "ΕΦΑΛ": 0.0212,
+	"ΡΟΥΝ": 0.0212, "ΟΓΟΣ": 0.0212, "ΑΓΙΟ": 0.0209, "ΠΟΥΕ": 0.0209, "ΚΟΥΣ": 0.0209,
+	"ΝΤΑΣ": 0.0209, "ΟΥΔΙ": 0.0209, "ΚΤΗΣ": 0.0209, "ΑΛΗΘ": 0.0209, "ΝΕΥΣ": 0.0209,
+	"ΝΗΤΙ": 0.0209, "ΙΓΙΑ": 0.0209, "ΣΧΑΡ": 0.0209, "ΑΣΜΕ": 0.0209, "ΗΣΑΝ": 0.0209,
+	"ΜΙΑΣ": 0.0209, "ΔΙΑΘ": 0.0209, "ΣΠΑΡ": 0.0209, "ΙΚΗΕ": 0.0209, "ΛΑΘΟ": 0.0209,
+	"ΥΡΟΟ": 0.0209, "ΝΤΟΣ": 0.0209, "ΕΓΙΝ": 0.0209, "ΠΑΛΙ": 0.0209, "ΚΕΣΓ": 0.0209,
+	"ΛΕΙΟ": 0.0209, "ΠΟΔΟ": 0.0205, "ΕΑΠΟ": 0.0205, "ΗΠΑΡ": 0.0205, "ΑΝΑΖ": 0.0205,
+	"ΝΚΑΤ": 0.0205, "ΝΟΜΗ": 0.0205, "ΝΗΜΗ": 0.0205, "ΣΗΕΠ": 0.0205, "ΕΣΙΜ": 0.0205,
+	"ΠΟΙΟ": 0.0205, "ΙΜΑΣ": 0.0205, "ΩΝΑΡ": 0.0205, "ΘΟΥΝ": 0.0205, "ΟΥΕΙ": 0.0201,
+	"ΕΡΓΕ": 0.0201, "ΟΠΙΚ": 0.0201, "ΟΔΟΧ": 0.0201, "ΤΟΔΙ": 0.0201, "ΣΗΑΠ": 0.0201,
+	"ΕΝΗΣ": 0.0201, "ΑΝΕΙ": 0.0201, "ΟΙΗΤ": 0.0201, "ΤΗΣΔ": 0.0201, "ΟΛΥΜ": 0.0201,
+	"ΥΠΡΟ": 0.0201, "ΣΗΣΕ": 0.0201, "ΟΜΗΣ": 0.0201, "ΑΝΕΝ": 0.0201, "ΜΝΗΜ": 0.0201,
+	"ΑΙΜΕ": 0.0201, "ΕΣΤΕ": 0.0201, "ΥΡΕΣ": 0.0201, "ΑΝΑΣ": 0.0201, "ΕΞΑΡ": 0.0201,
+	"ΞΑΡΤ": 0.0201, "ΡΕΤΗ": 0.0201, "ΤΟΥΝ": 0.0201, "ΩΝΙΚ": 0.0201, "ΕΝΑΛ": 0.0201,
+	"ΝΗΣΙ": 0.0197, "ΙΝΙΚ": 0.0197, "ΗΑΛΛ": 0.0197, "ΙΗΜΕ": 0.0197, "ΠΤΟΓ": 0.0197,
+	"ΧΩΡΗ": 0.0197, "ΙΑΕΚ": 0.0197, "ΙΚΟΜ": 0.0197, "ΜΟΣΤ": 0.0197, "ΤΑΙΕ": 0.0197,
+	"ΝΟΥΣ": 0.0197, "ΑΠΟΚ": 0.0197, "ΣΤΟΤ": 0.0197, "ΡΙΖΟ": 0.0197, "ΚΟΣΦ": 0.0197,
+	"ΟΡΙΩ": 0.0197, "ΣΤΙΣ": 0.0197, "ΝΟΤΑ": 0.0197, "ΕΤΙΣ": 0.0197, "ΡΩΣΗ": 0.0197,
+	"ΟΑΝΑ": 0.0197, "ΟΤΗΣ": 0.0197, "ΕΝΙΚ": 0.0197, "ΝΑΚΛ": 0.0197, "ΣΟΜΑ": 0.0197,
+	"ΝΓΚΟ": 0.0197, "ΕΛΕΙ": 0.0193, "ΚΟΥΠ": 0.0193, "ΟΙΕΙ": 0.0193, "ΕΙΠΕ": 0.0193,
+	"ΕΙΚΑ": 0.0193, "ΡΑΜΕ": 0.0193, "ΑΙΩΝ": 0.0193, "ΤΟΥΔ": 0.0193, "ΡΟΣΠ": 0.0193,
+	"ΥΚΑΤ": 0.0193, "ΤΟΑΠ": 0.0193, "ΠΗΡΕ": 0.0193, "ΑΙΟΣ": 0.0193, "ΤΙΣΜ": 0.0193,
+	"ΟΜΕΤ": 0.0193, "ΙΠΡΟ": 0.0193, "ΥΣΥΝ": 0.0193, "ΥΤΕΡ": 0.0193, "ΙΣΕΤ": 0.0193,
+	"ΟΠΑΡ": 0.0193, "ΤΙΚΩ": 0.0193, "ΡΟΝΟ": 0.0193, "ΛΗΤΗ": 0.0193, "ΑΛΙΔ": 0.0193,
+	"ΙΚΟΕ": 0.0193, "ΑΣΚΑ": 0.0189, "ΜΕΡΙ": 0.0189, "ΑΤΟΛ": 0.0189, "ΑΡΙΟ": 0.0189,
+	"ΑΙΣΕ": 0.0189, "ΙΑΛΛ": 0.0189, "ΣΗΣΔ": 0.0189, "ΩΝΚΑ": 0.0189, "ΟΡΕΣ": 0.0189,
+	"ΕΣΕΝ": 0.0189, "ΙΤΗΝ": 0.0189, "ΗΠΕΡ": 0.0189, "ΤΗΝΠ": 0.0189, "ΔΙΑΜ": 0.0189,
+	"ΤΩΝΕ": 0.0189, "ΤΥΠΩ": 0.0189, "ΔΙΣΚ": 0.0189, "ΙΑΔΗ": 0.0189, "ΛΟΓΩ": 0.0189,
+	"ΠΟΘΗ": 0.0189, "ΡΤΟΓ": 0.0189, "ΝΟΠΟ": 0.0189, "ΟΥΠΟ": 0.0185, "ΤΟΛΕ": 0.0185,
+	"ΑΣΥΝ": 0.0185, "ΩΡΗΣ": 0.0185, "ΝΔΕΝ": 0.0185, "ΟΠΙΣ": 0.0185, "ΙΛΕΙ": 0.0185,
+	"ΥΡΟΥ": 0.0185, "ΗΗΑΝ": 0.0185, "ΛΥΣΗ": 0.0185, "ΩΝΠΑ": 0.0185, "ΡΧΙΚ": 0.0185,
+	"ΗΣΕΚ": 0.0185, "ΤΟΧΡ": 0.0185, "ΕΣΗΤ": 0.0185, "ΝΩΡΙ": 0.0185, "ΛΥΜΕ": 0.0185,
+	"ΝΕΙΣ": 0.0185, "ΡΙΟΥ": 0.0185, "ΤΟΥΧ": 0.0185, "ΣΗΤΩ": 0.0185, "ΡΕΙΤ": 0.0185,
+	"ΕΙΔΩ": 0.0185, "ΦΑΛΙ": 0.0185, "ΕΙΡΙ": 0.0185, "ΩΝΤΟ": 0.0185, "ΛΕΣΤ": 0.0185,
+	"ΜΕΡΟ": 0.0185, "ΤΑΠΑ": 0.0185, "ΑΔΟΣ": 0.0185, "ΗΣΙΑ": 0.0182, "ΠΟΛΟ": 0.0182,
+	"ΡΙΣΣ": 0.0182, "ΜΜΕΝ": 0.0182, "ΟΤΗΝ": 0.0182, "ΙΝΑΚ": 0.0182, "ΣΙΚΟ": 0.0182,
+	"ΝΒΡΕ": 0.0182, "ΥΜΑΤ": 0.0182, "ΓΝΩΡ": 0.0182, "ΧΡΕΙ": 0.0182, "ΕΤΑΒ": 0.0182,
+	"ΘΜΗΤ": 0.0182, "ΜΗΤΙ": 0.0182, "ΜΙΚΟ": 0.0182, "ΣΗΣΑ": 0.0182, "ΙΟΤΗ": 0.0182,
+	"ΤΩΝΑ": 0.0182, "ΕΙΟΘ": 0.0182, "ΙΣΕΩ": 0.0182, "ΝΑΙΣ": 0.0182, "ΙΝΕΖ": 0.0182,
+	"ΑΜΠΑ": 0.0178, "ΠΡΙΝ": 0.0178, "ΓΚΟΥ": 0.0178, "ΥΤΗΤ": 0.0178, "ΕΝΕΠ": 0.0178,
+	"ΑΤΑΙ": 0.0178, "ΛΕΙΠ": 0.0178, "ΑΡΑΜ": 0.0178, "ΥΤΗΣ": 0.0178, "ΑΣΤΕ": 0.0178,
+	"ΡΙΣΕ": 0.0178, "ΕΔΙΟ": 0.0178, "ΡΙΣΗ": 0.0178, "ΤΡΕΧ": 0.0178, "ΗΑΥΤ": 0.0178,
+	"ΙΣΤΡ": 0.0178, "ΣΤΕΓ": 0.0178, "ΤΑΙΜ": 0.0178, "ΑΟΝΟ": 0.0178, "ΤΗΝΚ": 0.0178,
+	"ΤΡΕΞ": 0.0178, "ΙΗΔΗ": 0.0178, "ΟΠΟΥ": 0.0178, "ΗΧΟΣ": 0.0178, "ΗΣΥΓ": 0.0178,
+	"ΠΑΝΤ": 0.0174, "ΥΠΟΛ": 0.0174, "ΟΧΑΡ": 0.0174, "ΤΟΤΗ": 0.0174, "ΗΧΡΗ": 0.0174,
+	"ΠΟΙΕ": 0.0174, "ΔΕΝΒ": 0.0174, "ΕΝΒΡ": 0.0174, "ΗΣΕΤ": 0.0174, "ΒΟΛΟ": 0.0174,
+	"ΜΗΑΝ": 0.0174, "ΩΝΣΤ": 0.0174, "ΑΣΥΜ": 0.0174, "ΩΤΕΡ": 0.0174, "ΤΕΡΙ": 0.0174,
+	"ΕΙΩΣ": 0.0174, "ΕΝΑΝ": 0.0174, "ΕΝΑΔ": 0.0174, "ΤΟΠΡ": 0.0174, "ΛΕΣΗ": 0.0174,
+	"ΑΦΗΤ": 0.0174, "ΠΟΤΑ": 0.0174, "ΑΔΕΙ": 0.0174, "ΠΟΕΝ": 0.0174, "ΙΟΣΚ": 0.0174,
+	"ΙΝΑΑ": 0.0174, "ΙΑΑΠ": 0.0174, "ΝΤΙΓ": 0.0174, "ΗΚΕΥ": 0.0174, "ΣΤΑΝ": 0.0174,
+	"ΑΣΙΛ": 0.0174, "ΣΙΛΕ": 0.0174, "ΣΤΡΑ": 0.0170, "ΟΛΟΚ": 0.0170, "ΦΗΣΗ": 0.0170,
+	"ΡΟΣΔ": 0.0170, "ΥΤΟΜ": 0.0170, "ΗΣΕΝ": 0.0170, "ΕΙΑΠ": 0.0170, "ΙΑΣΥ": 0.0170,
+	"ΗΣΥΠ": 0.0170, "ΦΟΡΤ": 0.0170, "ΟΑΝΟ": 0.0170, "ΙΑΧΩ": 0.0170, "ΙΑΕΠ": 0.0170,
+	"ΠΟΛΛ": 0.0170, "ΔΟΘΗ": 0.0170, "ΜΗΔΕ": 0.0170, "ΩΝΔΕ": 0.0170, "ΑΤΟΠ": 0.0170,
+	"ΣΤΑΠ": 0.0170, "ΠΟΥΔ": 0.0170, "ΑΑΝΟ": 0.0170, "ΙΑΦΟ": 0.0170, "ΣΜΙΚ": 0.0170,
+	"ΑΕΓΚ": 0.0170, "ΟΚΑΙ": 0.0170, "ΡΟΠΟ": 0.0170, "ΤΑΜΕ": 0.0170, "ΟΣΑΝ": 0.0170,
+	"ΛΜΑΚ": 0.0170, "ΕΙΟΡ": 0.0170, "ΤΟΥΛ": 0.0170, "ΚΑΠΟ": 0.0170, "ΝΚΑΙ": 0.0170,
+	"ΗΣΑΡ": 0.0170, "ΣΤΑΘ": 0.0170, "ΤΟΝΙ": 0.0170, "ΙΑΤΑ": 0.0170, "ΑΙΣΥ": 0.0166,
+	"ΤΟΝΤ": 0.0166, "ΑΡΑΛ": 0.0166, "ΝΕΙΤ": 0.0166, "ΑΖΕΙ": 0.0166, "ΙΟΥΑ": 0.0166,
+	"ΑΑΥΤ": 0.0166, "ΟΣΔΕ": 0.0166, "ΠΙΚΟ": 0.0166, "ΑΣΙΚ": 0.0166, "ΑΠΕΝ": 0.0166,
+	"ΝΗΣΗ": 0.0166, "ΟΡΤΩ": 0.0166, "ΥΠΗΡ": 0.0166, "ΜΜΗΣ": 0.0166, "ΣΚΟΥ": 0.0166,
+	"ΝΑΙΜ": 0.0166, "ΡΕΧΟ": 0.0166, "ΣΤΡΕ": 0.0166, "ΑΜΟΝ": 0.0166, "ΟΥΕΠ": 0.0166,
+	"ΙΣΙΜ": 0.0166, "ΩΝΕΙ": 0.0166, "ΑΚΕΛ": 0.0166, "ΒΟΗΘ": 0.0166, "ΜΗΣΗ": 0.0166,
+	"ΝΠΑΚ": 0.0166, "ΔΩΜΑ": 0.0166, "ΕΠΙΚ": 0.0166, "ΡΩΣΙ": 0.0166, "ΙΚΑΚ": 0.0166,
+	"ΑΔΑΣ": 0.0166, "ΜΕΙΩ": 0.0166, "ΠΕΡΑ": 0.0162, "ΤΟΛΙ": 0.0162, "ΤΙΝΙ": 0.0162,
+	"ΕΙΟΔ": 0.0162, "ΚΕΛΟ": 0.0162, "ΟΥΤΑ": 0.0162, "ΚΕΑΠ": 0.0162, "ΩΝΑΠ": 0.0162,
+	"ΕΡΙΣ": 0.0162, "ΛΕΙΑ": 0.0162, "ΣΤΟΛ": 0.0162, "ΤΗΣΠ": 0.0162, "ΟΣΣΤ": 0.0162,
+	"ΡΕΣΕ": 0.0162, "ΦΑΛΕ": 0.0162, "ΤΩΣΗ": 0.0162, "ΣΤΟΔ": 0.0162, "ΣΤΗΕ": 0.0162,
+	"ΠΕΔΙ": 0.0162, "ΙΟΥΣ": 0.0162, "ΕΣΤΟ": 0.0162, "ΣΚΩΔ": 0.0162, "ΙΩΣΗ": 0.0162,
+	"ΧΙΚΟ": 0.0162, "ΣΤΗΓ": 0.0162, "ΟΣΣΥ": 0.0162, "ΝΤΩΝ": 0.0162, "ΡΟΟΝ": 0.0162,
+	"ΛΗΣΗ": 0.0162, "ΑΖΕΤ": 0.0162, "ΠΗΓΑ": 0.0162, "ΗΓΑΙ": 0.0162, "ΤΕΤΙ": 0.0162,
+	"ΙΑΕΝ": 0.0162, "ΝΑΑΡ": 0.0162, "ΟΣΗΜ": 0.0162, "ΑΒΛΗ": 0.0162, "ΙΗΘΕ": 0.0162,
+	"ΗΣΑΠ": 0.0162, "ΟΥΘΑ": 0.0162, "ΡΟΤΥ": 0.0162, "ΟΛΗΤ": 0.0162, "ΟΥΜΠ": 0.0158,
+	"ΚΕΝΤ": 0.0158, "ΤΑΚΑ": 0.0158, "ΜΟΣΠ": 0.0158, "ΓΟΥΑ": 0.0158, "ΗΣΟΙ": 0.0158,
+	"ΔΙΚΗ": 0.0158, "ΗΕΞΟ": 0.0158, "ΔΕΝΠ": 0.0158, "ΝΑΠΡ": 0.0158, "ΣΗΣΠ": 0.0158,
+	"ΑΝΕΠ": 0.0158, "ΡΑΣΤ": 0.0158, "ΙΜΟΝ": 0.0158, "ΙΑΣΕ": 0.0158, "ΕΣΕΠ": 0.0158,
+	"ΩΣΤΗ": 0.0158, "ΑΡΧΟ": 0.0158, "ΕΙΑΖ": 0.0158, "ΙΚΕΤ": 0.0158, "ΓΗΣΤ": 0.0158,
+	"ΤΕΕΝ": 0.0158, "ΟΚΑΤ": 0.0158, "ΤΑΒΛ": 0.0158, "ΙΔΩΜ": 0.0158, "ΗΚΗΣ": 0.0158,
+	"ΟΒΛΗ": 0.0158, "ΒΛΗΜ": 0.0158, "ΥΠΑΚ": 0.0158, "ΛΕΤΕ": 0.0158, "ΘΩΣΗ": 0.0158,
+	"ΙΝΟΥ": 0.0158, "ΑΤΑΞ": 0.0158, "ΚΕΛΥ": 0.0158, "ΕΛΥΦ": 0.0158, "ΛΑΙΑ": 0.0158,
+	"ΑΤΙΝ": 0.0158, "ΑΜΕΡ": 0.0154, "ΝΤΡΟ": 0.0154, "ΝΤΑΚ": 0.0154, "ΚΟΥΑ": 0.0154,
+	"ΗΝΥΠ": 0.0154, "ΟΣΤΑ": 0.0154, "ΣΥΝΕ": 0.0154, "ΜΕΓΕ": 0.0154, "ΟΥΥΠ": 0.0154,
+	"ΙΗΤΙ": 0.0154, "ΤΕΡΑ": 0.0154, "ΗΝΑΝ": 0.0154, "ΧΕΤΕ": 0.0154, "ΗΣΠΡ": 0.0154,
+	"ΕΝΑΕ": 0.0154, "ΥΑΠΟ": 0.0154, "ΜΟΥΣ": 0.0154, "ΝΑΛΥ": 0.0154, "ΕΤΑΦ": 0.0154,
+	"ΔΕΝΑ": 0.0154, "ΕΡΙΓ": 0.0154, "ΡΙΓΡ": 0.0154, "ΣΥΝΤ": 0.0154, "ΡΟΟΡ": 0.0154,
+	"ΗΠΛΗ": 0.0154, "ΩΝΣΥ": 0.0154, "ΕΠΕΚ": 0.0154, "ΙΔΕΝ": 0.0154, "ΝΑΡΙ": 0.0154,
+	"ΓΕΣΣ": 0.0154, "ΩΣΕΙ": 0.0154, "ΓΑΙΟ": 0.0154, "ΓΙΣΜ": 0.0154, "ΟΛΗΑ": 0.0154,
+	"ΝΟΤΗ": 0.0154, "ΒΛΗΤ": 0.0154, "ΙΤΕΝ": 0.0154, "ΟΣΕΝ": 0.0154, "ΑΙΑΣ": 0.0154,
+	"ΠΙΛΥ": 0.0154, "ΟΜΕΣ": 0.0154, "ΛΥΦΟ": 0.0154, "ΓΟΥΙ": 0.0154, "ΑΛΙΑ": 0.0151,
+	"ΝΤΕΛ": 0.0151, "ΟΣΠΟ": 0.0151, "ΟΤΙΑ": 0.0151, "ΡΑΚΟ": 0.0151, "ΕΧΕΤ": 0.0151,
+	"ΤΗΝΥ": 0.0151, "ΗΣΣΕ": 0.0151, "ΟΚΛΗ": 0.0151, "ΤΑΙΗ": 0.0151, "ΕΓΕΘ": 0.0151,
+	"ΟΥΠΕ": 0.0151, "ΟΠΕΡ": 0.0151, "ΙΔΙΩ": 0.0151, "ΟΑΛΛ": 0.0151, "ΙΑΠΕ": 0.0151,
+	"ΥΡΓΗ": 0.0151, "ΝΤΟΠ": 0.0151, "ΝΟΥΑ": 0.0151, "ΣΕΚΔ": 0.0151, "ΖΟΝΤ": 0.0151,
+	"ΑΦΕΡ": 0.0151, "ΝΑΜΗ": 0.0151, "ΕΙΟΠ": 0.0151, "ΠΑΡΟ": 0.0151, "ΛΗΘΗ": 0.0151,
+	"ΤΑΞΗ": 0.0151, "ΠΡΟΓ": 0.0151, "ΙΛΕΓ": 0.0151, "ΑΑΚΟ": 0.0151, "ΦΟΡΕ": 0.0151,
+	"ΝΑΧΡ": 0.0151, "ΠΟΣΥ": 0.0151, "ΑΓΝΟ": 0.0151, "ΕΣΑΣ": 0.0151, "ΕΙΟΣ": 0.0151,
+	"ΜΟΝΑ": 0.0151, "ΓΑΛΙ": 0.0151, "ΚΙΝΕ": 0.0151, "ΙΧΡΗ": 0.0151, "ΟΝΔΙ": 0.0147,
+	"ΣΤΡΟ": 0.0147, "ΙΟΥΤ": 0.0147, "ΕΤΡΟ": 0.0147, "ΛΟΚΛ": 0.0147, "ΚΛΗΡ": 0.0147,
+	"ΣΑΓΩ": 0.0147, "ΙΣΣΟ": 0.0147, "ΣΣΟΤ": 0.0147, "ΣΟΤΕ": 0.0147, "ΙΠΕΙ": 0.0147,
+	"ΕΙΕΝ": 0.0147, "ΗΕΙΣ": 0.0147, "ΡΙΑΖ": 0.0147, "ΚΚΙΝ": 0.0147, "ΜΑΑΡ": 0.0147,
+	"ΗΔΕΔ": 0.0147, "ΛΟΣΤ": 0.0147, "ΗΑΝΤ": 0.0147, "ΙΑΥΤ": 0.0147, "ΟΙΗΜ": 0.0147,
+	"ΟΣΚΩ": 0.0147, "ΑΦΗΜ": 0.0147, "ΡΟΓΡ": 0.0147, "ΩΝΤΩ": 0.0147, "ΤΗΡΕ": 0.0147,
+	"ΜΑΤΗ": 0.0147, "ΑΙΔΙ": 0.0147, "ΙΣΜΙ": 0.0147, "ΗΜΟΝ": 0.0147, "ΕΣΠΟ": 0.0147,
+	"ΡΟΒΛ": 0.0147, "ΛΗΜΑ": 0.0147, "ΣΤΥΠ": 0.0147, "ΚΗΔΙ": 0.0147, "ΧΕΙΗ": 0.0147,
+	"ΕΞΑΓ": 0.0147, "ΙΟΕΙ": 0.0147, "ΛΙΑΣ": 0.0147, "ΕΞΤΕ": 0.0147, "ΡΙΣΝ": 0.0147,
+	"ΡΟΒΟ": 0.0143, "ΚΑΙΜ": 0.0143, "ΝΩΜΕ": 0.0143, "ΠΟΛΙ": 0.0143, "ΓΙΟΥ": 0.0143,
+	"ΔΟΧΗ": 0.0143, "ΣΤΟΜ": 0.0143, "ΤΟΣΟ": 0.0143, "ΑΙΤΗ": 0.0143, "ΛΟΣΕ": 0.0143,
+	"ΓΙΑΠ": 0.0143, "ΝΣΗΣ": 0.0143, "ΠΕΝΕ": 0.0143, "ΛΗΘΕ": 0.0143, "ΤΗΣΜ": 0.0143,
+	"ΔΙΚΤ": 0.0143, "ΝΣΤΟ": 0.0143, "ΑΑΝΤ": 0.0143, "ΙΝΑΠ": 0.0143, "ΑΝΑΝ": 0.0143,
+	"ΝΠΕΡ": 0.0143, "ΤΡΟΥ": 0.0143, "ΛΑΤΟ": 0.0143, "ΧΙΑΕ": 0.0143, "ΦΑΚΕ": 0.0143,
+	"ΤΑΑΡ": 0.0143, "ΚΑΙΔ": 0.0143, "ΓΜΕΝ": 0.0143, "ΛΕΥΤ": 0.0143, "ΕΥΤΑ": 0.0143,
+	"ΝΓΙΑ": 0.0143, "ΕΤΕΑ": 0.0143, "ΠΟΥΑ": 0.0143, "ΟΜΑΑ": 0.0143, "ΠΙΚΕ": 0.0143,
+	"ΟΡΦΗ": 0.0143, "ΝΟΕΙ": 0.0143, "ΕΙΗΔ": 0.0143, "ΟΧΩΡ": 0.0143, "ΑΠΟΡ": 0.0143,
+	"ΟΝΑΔ": 0.0143, "ΙΑΑΛ": 0.0143, "ΑΚΛΗ": 0.0143, "ΟΠΟΛ": 0.0143, "ΑΜΠΟ": 0.0143,
+	"ΑΛΒΑ": 0.0143, "ΛΑΤΙ": 0.0143, "ΛΟΓΑ": 0.0143, "ΟΣΙΕ": 0.0143, "ΣΙΕΥ": 0.0143,
+	"ΕΛΟΥ": 0.0139, "ΑΠΟΛ": 0.0139, "ΕΙΕΣ": 0.0139, "ΑΠΟΔ": 0.0139, "ΙΣΥΝ": 0.0139,
+	"ΥΜΕΓ": 0.0139, "ΑΤΑΧ": 0.0139, "ΠΡΟΟ": 0.0139, "ΟΥΚΛ": 0.0139, "ΑΛΙΟ": 0.0139,
+	"ΝΑΣΤ": 0.0139, "ΑΙΕΚ": 0.0139, "ΙΕΚΤ": 0.0139, "ΟΛΟΣ": 0.0139, "ΩΝΜΕ": 0.0139,
+	"ΣΤΕΣ": 0.0139, "ΕΣΩΤ": 0.0139, "ΗΜΗΣ": 0.0139, "ΙΤΙΚ": 0.0139, "ΤΟΜΗ": 0.0139,
+	"ΙΑΣΣ": 0.0139, "ΝΤΗΣ": 0.0139, "ΝΕΠΕ": 0.0139, "ΗΓΟΥ": 0.0139, "ΑΣΕΙ": 0.0139,
+	"ΣΣΥΓ": 0.0139, "ΑΣΣΤ": 0.0139, "ΗΝΕΝ": 0.0139, "ΦΗΤΟ": 0.0139, "ΣΚΕΥ": 0.0139,
+	"ΙΕΠΙ": 0.0139, "ΙΩΝΕ": 0.0139, "ΟΥΠΛ": 0.0139, "ΟΣΘΕ": 0.0139, "ΠΟΠΟ": 0.0139,
+	"ΓΙΑΛ": 0.0139, "ΚΑΠΑ": 0.0139, "ΩΘΗΚ": 0.0139, "ΟΤΟΚ": 0.0139, "ΝΕΙΑ": 0.0139,
+	"ΤΗΣΓ": 0.0139, "ΠΟΜΕ": 0.0139, "ΑΧΕΙ": 0.0139, "ΚΟΜΑ": 0.0139, "ΤΟΥΓ": 0.0139,
+	"ΤΕΤΑ": 0.0139, "ΑΣΗΕ": 0.0139, "ΑΠΑΛ": 0.0139, "ΟΓΑΡ": 0.0139, "ΚΟΥΡ": 0.0135,
+	"ΒΑΝΙ": 0.0135, "ΠΟΥΠ": 0.0135, "ΥΣΤΡ": 0.0135, "ΟΛΙΤ": 0.0135, "ΛΙΤΕ": 0.0135,
+	"ΤΑΙΤ": 0.0135, "ΙΤΟΠ": 0.0135, "ΗΤΑΣ": 0.0135, "ΟΥΣΙ": 0.0135, "ΕΡΕΣ": 0.0135,
+	"ΤΑΧΩ": 0.0135, "ΧΕΙΕ": 0.0135, "ΡΩΤΟ": 0.0135, "ΙΑΠΡ": 0.0135, "ΑΣΗΤ": 0.0135,
+	"ΕΣΣΤ": 0.0135, "ΣΔΙΟ": 0.0135, "ΜΗΚΟ": 0.0135, "ΥΔΙΑ": 0.0135, "ΙΗΣΕ": 0.0135,
+	"ΝΕΠΑ": 0.0135, "ΕΚΚΙ": 0.0135, "ΙΚΤΥ": 0.0135, "ΦΗΣΤ": 0.0135, "ΧΕΙΜ": 0.0135,
+	"ΧΕΙΚ": 0.0135, "ΑΛΗΨ": 0.0135, "ΕΣΤΡ": 0.0135, "ΥΕΠΙ": 0.0135, "ΑΕΓΓ": 0.0135,
+	"ΕΤΟΠ": 0.0135, "ΟΛΑΤ": 0.0135, "ΛΩΝΤ": 0.0135, "ΤΟΜΑ": 0.0135, "ΙΑΣΑ": 0.0135,
+	"ΣΗΣΓ": 0.0135, "ΙΑΕΓ": 0.0135, "ΡΑΤΟ": 0.0135, "ΑΘΕΙ": 0.0135, "ΕΙΟΑ": 0.0135,
+	"ΙΡΙΣ": 0.0135, "ΗΣΤΙ": 0.0135, "ΚΙΚΑ": 0.0135, "ΠΟΡΤ": 0.0135, "ΟΡΤΟ": 0.0135,
+	"ΟΓΑΛ": 0.0135, "ΑΡΟΥ": 0.0131, "ΚΟΥΚ": 0.0131, "ΙΛΑΝ": 0.0131, "ΛΗΦΘ": 0.0131,
+	"ΔΕΝΔ": 0.0131, "ΟΥΠΙ": 0.0131, "ΣΥΝΑ": 0.0131, "ΤΟΛΩ": 0.0131, "ΗΣΗΔ": 0.0131,
+	"ΕΝΑΤ": 0.0131, "ΗΕΚΔ": 0.0131, "ΘΕΙΤ": 0.0131, "ΚΑΚΟ": 0.0131, "ΑΔΕΔ": 0.0131,
+	"ΤΟΚΑ": 0.0131, "ΝΑΜΟ": 0.0131, "ΤΟΕΙ": 0.0131, "ΙΑΕΙ": 0.0131, "ΑΙΔΕ": 0.0131,
+	"ΣΘΗΚ": 0.0131, "ΑΝΑΕ": 0.0131, "ΗΚΛΕ": 0.0131, "ΤΡΑΜ": 0.0131, "ΟΔΙΕ": 0.0131,
+	"ΤΗΝΤ": 0.0131, "ΜΕΤΗ": 0.0131, "ΥΜΒΑ": 0.0131, "ΤΙΓΡ": 0.0131, "ΝΑΚΤ": 0.0131,
+	"ΤΟΙΜ": 0.0131, "ΕΝΑΚ": 0.0131, "ΙΕΙΝ": 0.0131, "ΞΑΓΩ": 0.0131, "ΛΕΠΤ": 0.0131,
+	"ΤΟΓΑ": 0.0131, "ΟΥΡΚ": 0.0131, "ΚΟΤΥ": 0.0131, "ΙΑΣΚ": 0.0127, "ΜΑΓΙ": 0.0127,
+	"ΕΣΜΑ": 0.0127, "ΟΣΠΕ": 0.0127, "ΙΑΑΥ": 0.0127, "ΑΥΣΤ": 0.0127, "ΡΙΝΟ": 0.0127,
+	"ΤΟΣΚ": 0.0127, "ΩΝΠΟ": 0.0127, "ΤΗΚΑ": 0.0127, "ΓΧΟΥ": 0.0127, "ΙΚΕΝ": 0.0127,
+	"ΣΗΠΡ": 0.0127, "ΙΚΟΔ": 0.0127, "ΕΤΟΑ": 0.0127, "ΠΟΥΝ": 0.0127, "ΤΟΓΙ": 0.0127,
+	"ΑΛΥΣ": 0.0127, "ΧΙΣΤ": 0.0127, "ΕΝΑΟ": 0.0127, "ΣΗΣΥ": 0.0127, "ΥΑΔΙ": 0.0127,
+	"ΙΖΟΝ": 0.0127, "ΙΟΥΕ": 0.0127, "ΜΟΤΟ": 0.0127, "ΤΗΣΒ": 0.0127, "ΟΣΧΑ": 0.0127,
+	"ΡΟΤΕ": 0.0127, "ΟΡΑΣ": 0.0127, "ΤΩΝΔ": 0.0127, "ΕΝΟΕ": 0.0127, "ΣΑΡΤ": 0.0127,
+	"ΔΕΞΙ": 0.0127, "ΡΕΤΙ": 0.0127, "ΩΣΕΤ": 0.0127, "ΣΑΤΕ": 0.0127, "ΣΚΛΕ": 0.0127,
+	"ΙΟΥΚ": 0.0127, "ΟΓΗΕ": 0.0127, "ΓΙΑΚ": 0.0127, "ΜΒΑΤ": 0.0127, "ΛΟΥΣ": 0.0127,
+	"ΟΙΜΑ": 0.0127, "ΟΜΑΠ": 0.0127, "ΠΑΡΕ": 0.0127, "ΤΗΓΡ": 0.0127, "ΟΣΤΥ": 0.0127,
+	"ΚΟΙΝ": 0.0127, "ΛΗΞΗ": 0.0127, "ΚΛΗΣ": 0.0127, "ΤΕΠΕ": 0.0127, "ΣΑΣΕ": 0.0127,
+	"ΤΟΠΕ": 0.0127, "ΕΥΤΕ": 0.0127, "ΕΣΑΛ": 0.0127, "ΙΝΕΑ": 0.0127, "ΑΓΟΥ": 0.0127,
+	"ΡΑΝΙ": 0.0127, "ΟΥΓΓ": 0.0127, "ΛΛΑΚ": 0.0127, "ΛΑΚΤ": 0.0127, "ΜΠΕΡ": 0.0124,
+	"ΕΞΑΝ": 0.0124, "ΕΝΤΑ": 0.0124, "ΟΥΑΛ": 0.0124, "ΟΚΛΑ": 0.0124, "ΚΑΙΑ": 0.0124,
+	"ΠΟΥΣ": 0.0124, "ΣΤΗΔ": 0.0124, "ΟΧΗΣ": 0.0124, "ΚΟΜΜ": 0.0124, "ΑΛΛΗ": 0.0124,
+	"ΡΙΣΚ": 0.0124, "ΙΤΗΣ": 0.0124, "ΗΣΗΚ": 0.0124, "ΕΔΙΑ": 0.0124, "ΝΤΙΣ": 0.0124,
+	"ΛΛΑΠ": 0.0124, "ΣΩΤΕ": 0.0124, "ΟΣΥΠ": 0.0124, "ΕΝΗΕ": 0.0124, "ΟΕΡΓ": 0.0124,
+	"ΟΗΘΕ": 0.0124, "ΝΣΥΝ": 0.0124, "ΚΑΘΕ": 0.0124, "ΥΕΙΝ": 0.0124, "ΑΠΟΕ": 0.0124,
+	"ΗΕΧΕ": 0.0124, "ΠΟΡΟ": 0.0124, "ΟΠΗΣ": 0.0124, "ΙΥΠΟ": 0.0124, "ΑΙΠΑ": 0.0124,
+	"ΞΑΝΑ": 0.0124, "ΟΥΛΟ": 0.0124, "ΓΕΙΑ": 0.0124, "ΣΟΛΑ": 0.0124, "ΛΛΟΝ": 0.0124,
+	"ΗΨΗΣ": 0.0124, "ΙΚΟΙ": 0.0124, "ΟΥΣΚ": 0.0124, "ΟΥΔΑ": 0.0124, "ΑΡΙΚ": 0.0124,
+	"ΣΣΑΜ": 0.0124, "ΩΣΙΚ": 0.0124, "ΛΛΑΝ": 0.0124, "ΙΝΤΕ": 0.0124, "ΣΩΛΗ": 0.0124,
+	"ΩΛΗΝ": 0.0124, "ΙΣΝΕ": 0.0124, "ΣΝΕΚ": 0.0124, "ΝΤΡΙ": 0.0120, "ΠΟΥΤ": 0.0120,
+	"ΑΤΡΙ": 0.0120, "ΕΤΙΑ": 0.0120, "ΑΕΙΣ": 0.0120, "ΟΚΕΙ": 0.0120, "ΡΩΤΗ": 0.0120,
+	"ΕΙΟΚ": 0.0120, "ΑΠΡΕ": 0.0120, "ΙΜΕΣ": 0.0120, "ΣΗΣΜ": 0.0120, "ΗΣΜΕ": 0.0120,
+	"ΕΝΑΜ": 0.0120, "ΤΟΥΟ": 0.0120, "ΥΣΕΙ": 0.0120, "ΝΜΕΤ": 0.0120, "ΚΤΥΟ": 0.0120,
+	"ΕΣΙΑ": 0.0120, "ΕΜΕΝ": 0.0120, "ΕΥΜΕ": 0.0120, "ΑΚΑΘ": 0.0120, "ΑΣΗΜ": 0.0120,
+	"ΗΣΕΩ": 0.0120, "ΟΣΘΗ": 0.0120, "ΤΑΤΑ": 0.0120, "ΓΗΤΟ": 0.0120, "ΤΕΑΥ": 0.0120,
+	"ΑΡΑΓ": 0.0120, "ΑΕΚΤ": 0.0120, "ΡΑΣΕ": 0.0120, "ΚΕΥΣ": 0.0120, "ΤΟΣΣ": 0.0120,
+	"ΚΑΑΡ": 0.0120, "ΙΕΠΑ": 0.0120, "ΠΟΡΡ": 0.0120, "ΝΙΑΚ": 0.0120, "ΡΟΜΕ": 0.0120,
+	"ΗΝΙΚ": 0.0120, "ΥΓΓΡ": 0.0120, "ΙΝΔΙ": 0.0120, "ΛΑΡΙ": 0.0120, "ΟΥΜΑ": 0.0120,
+	"ΡΑΦΙ": 0.0120, "ΛΒΑΝ": 0.0116, "ΓΕΘΟ": 0.0116, "ΣΒΑΣ": 0.0116, "ΜΙΑΠ": 0.0116,
+	"ΠΕΡΒ": 0.0116, "ΑΙΝΕ": 0.0116, "ΕΙΤΙ": 0.0116, "ΗΔΙΟ": 0.0116, "ΥΚΛΕ": 0.0116,
+	"ΣΤΗΛ": 0.0116, "ΕΘΕΙ": 0.0116, "ΣΥΝΟ": 0.0116, "ΤΟΝΕ": 0.0116, "ΑΧΙΣ": 0.0116,
+	"ΜΗΣΥ": 0.0116, "ΣΣΥΜ": 0.0116, "ΙΜΗΣ": 0.0116, "ΥΧΕΗ": 0.0116, "ΟΥΜΗ": 0.0116,
+	"ΤΟΣΗ": 0.0116, "ΧΟΝΤ": 0.0116, "ΙΟΥΔ": 0.0116, "ΚΟΑΡ": 0.0116, "ΔΟΚΙ": 0.0116,
+	"ΣΤΟΕ": 0.0116, "ΟΥΝΕ": 0.0116, "ΑΙΑΝ": 0.0116, "ΑΔΙΕ": 0.0116, "ΟΠΟΠ": 0.0116,
+	"ΝΑΣΥ": 0.0116, "ΙΠΑΡ": 0.0116, "ΠΙΝΑ": 0.0116, "ΕΤΕΜ": 0.0116, "ΟΣΥΣ": 0.0116,
+	"ΣΗΕΝ": 0.0116, "ΗΛΙΣ": 0.0116, "ΑΝΕΤ": 0.0116, "ΖΟΜΕ": 0.0116, "ΣΛΙΣ": 0.0116,
+	"ΠΑΝΕ": 0.0116, "ΩΝΗΤ": 0.0116, "ΑΤΡΟ": 0.0116, "ΡΙΑΚ": 0.0116, "ΟΥΣΣ": 0.0116,
+	"ΤΩΝΤ": 0.0116, "ΣΑΣΤ": 0.0116, "ΜΗΕΝ": 0.0116, "ΤΥΠΙ": 0.0116, "ΥΠΙΚ": 0.0116,
+	"ΤΕΚΑ": 0.0116, "ΑΡΤΟ": 0.0116, "ΕΣΑΝ": 0.0116, "ΣΣΑΤ": 0.0116, "ΠΑΝΩ": 0.0116,
+	"ΡΕΞΤ": 0.0116, "ΙΕΥΣ": 0.0116, "ΡΟΥΜ": 0.0112, "ΥΡΙΑ": 0.0112, "ΝΕΑΣ": 0.0112,
+	"ΑΙΚΕ": 0.0112, "ΕΣΑΠ": 0.0112, "ΜΕΓΙ": 0.0112, "ΙΤΟΕ": 0.0112, "ΙΔΙΕ": 0.0112,
+	"ΖΕΙΜ": 0.0112, "ΣΠΑΘ": 0.0112, "ΗΣΥΜ": 0.0112, "ΗΑΠΕ": 0.0112, "ΙΣΕΙ": 0.0112,
+	"ΝΑΔΗ": 0.0112, "ΚΟΔΙ": 0.0112, "ΜΑΑΠ": 0.0112, "ΛΑΧΙ": 0.0112, "ΑΚΕΝ": 0.0112,
+	"ΣΠΟΛ": 0.0112, "ΝΑΠΕ": 0.0112, "ΥΡΗΕ": 0.0112, "ΔΥΑΔ": 0.0112, "ΑΤΥΠ": 0.0112,
+	"ΛΕΙΨ": 0.0112, "ΙΔΙΚ": 0.0112, "ΜΑΠΡ": 0.0112, "ΦΗΜΕ": 0.0112, "ΝΑΔΕ": 0.0112,
+	"ΣΗΑΥ": 0.0112, "ΥΑΝΑ": 0.0112, "ΔΙΩΝ": 0.0112, "ΡΙΕΣ": 0.0112, "ΟΡΟΥ": 0.0112,
+	"ΙΚΟΦ": 0.0112, "ΤΙΣΕ": 0.0112, "ΟΥΣΗ": 0.0112, "ΗΝΠΡ": 0.0112, "ΙΤΕΤ": 0.0112,
+	"ΕΙΣΙ": 0.0112, "ΤΑΔΕ": 0.0112, "ΑΝΑΒ": 0.0112, "ΒΑΘΜ": 0.0112, "ΑΡΥΘ": 0.0112,
+	"ΙΟΔΕ": 0.0112, "ΤΩΝΚ": 0.0112, "ΟΔΗΓ": 0.0112, "ΝΕΓΚ": 0.0112, "ΟΕΧΕ": 0.0112,
+	"ΝΙΣΤ": 0.0112, "ΟΡΡΙ": 0.0112, "ΤΙΠΟ": 0.0112, "ΤΕΣΕ": 0.0112, "ΗΕΝΕ": 0.0112,
+	"ΙΠΟΤ": 0.0112, "ΟΥΙΝ": 0.0112, "ΜΑΡΟ": 0.0112, "ΟΥΡΟ": 0.0112, "ΛΗΝΙ": 0.0112,
+	"ΚΟΝΓ": 0.0112, "ΓΓΡΙ": 0.0112, "ΓΡΙΚ": 0.0112, "ΣΛΟΒ": 0.0112, "ΥΡΚΙ": 0.0112,
+	"ΠΟΤΙ": 0.0112, "ΚΑΙΝ": 0.0112, "ΦΩΝΗ": 0.0112, "ΜΠΑΡ": 0.0108, "ΡΑΣΑ": 0.0108,
+	"ΕΙΑΚ": 0.0108, "ΣΟΥΑ": 0.0108, "ΑΣΜΑ": 0.0108, "ΟΥΑΣ": 0.0108, "ΠΟΔΕ": 0.0108,
+	"ΕΣΕΙ": 0.0108, "ΥΥΠΟ": 0.0108, "ΚΑΜΙ": 0.0108, "ΕΓΙΣ": 0.0108, "ΝΑΙΗ": 0.0108,
+	"ΣΗΔΙ": 0.0108, "ΤΗΗΔ": 0.0108, "ΓΟΡΙ": 0.0108, "ΙΤΥΧ": 0.0108, "ΡΟΣΩ": 0.0108,
+	"ΕΠΑΛ": 0.0108, "ΡΤΩΣ": 0.0108, "ΣΗΑΡ": 0.0108, "ΣΔΙΕ": 0.0108, "ΑΚΡΙ": 0.0108,
+	"ΔΟΣΕ": 0.0108, "ΑΝΤΗ": 0.0108, "ΑΠΟΑ": 0.0108, "ΕΧΟΝ": 0.0108, "ΟΡΙΖ": 0.0108,
+	"ΤΗΕΙ": 0.0108, "ΟΝΗΣ": 0.0108, "ΗΕΞΑ": 0.0108, "ΛΕΓΜ": 0.0108, "ΕΓΜΕ": 0.0108,
+	"ΙΝΟΜ": 0.0108, "ΝΑΝΕ": 0.0108, "ΑΚΑΝ": 0.0108, "ΑΓΕΤ": 0.0108, "ΙΑΝΟ": 0.0108,
+	"ΣΗΠΑ": 0.0108, "ΩΝΔΙ": 0.0108, "ΑΙΠΡ": 0.0108, "ΗΝΚΑ": 0.0108, "ΙΚΕΦ": 0.0108,
+	"ΑΝΚΑ": 0.0108, "ΥΡΕΤ": 0.0108, "ΡΟΕΤ": 0.0108, "ΟΕΤΟ": 0.0108, "ΕΡΙΛ": 0.0108,
+	"ΡΙΛΑ": 0.0108, "ΤΗΣΛ": 0.0108, "ΕΤΕΝ": 0.0108, "ΙΑΧΕ": 0.0108, "ΝΑΚΟ": 0.0108,
+	"ΑΙΤΑ": 0.0108, "ΗΟΜΑ": 0.0108, "ΟΣΓΙ": 0.0108, "ΟΤΡΕ": 0.0108, "ΔΕΥΤ": 0.0108,
+	"ΚΙΣΤ": 0.0108, "ΕΡΟΜ": 0.0108, "ΙΝΑΟ": 0.0108, "ΣΠΑΝ": 0.0108, "ΛΕΤΟ": 0.0108,
+	"ΜΑΥΡ": 0.0108, "ΑΙΚΗ": 0.0108, "ΒΙΝΤ": 0.0108, "ΝΤΕΟ": 0.0108, "ΣΤΕΛ": 0.0108,
+	"ΑΙΕΙ": 0.0108, "ΕΤΗΣ": 0.0108, "ΕΞΙΟ": 0.0108, "ΚΑΤΩ": 0.0108, "ΡΓΗΘ": 0.0108,
+	"ΝΕΤΕ": 0.0108, "ΝΕΟΥ": 0.0108, "ΚΑΜΠ": 0.0104, "ΟΥΡΙ": 0.0104, "ΤΟΜΠ": 0.0104,
+	"ΓΚΑΝ": 0.0104, "ΥΤΙΚ": 0.0104, "ΑΣΔΕ": 0.0104, "ΙΕΙΤ": 0.0104, "ΛΕΣΜ": 0.0104,
+	"ΑΤΗΔ": 0.0104, "ΥΝΑΡ": 0.0104, "ΤΑΤΡ": 0.0104, "ΘΜΟΥ": 0.0104, "ΩΣΤΕ": 0.0104,
+	"ΟΤΟΠ": 0.0104, "ΩΣΗΑ": 0.0104, "ΤΥΟΥ": 0.0104, "ΗΠΑΚ": 0.0104, "ΗΡΥΘ": 0.0104,
+	"ΛΕΟΝ": 0.0104, "ΜΑΔΕ": 0.0104, "ΑΧΑΡ": 0.0104, "ΕΡΑΣ": 0.0104, "ΣΧΗΜ": 0.0104,
+	"ΧΗΜΑ": 0.0104, "ΕΤΟΜ": 0.0104, "ΜΙΑΚ": 0.0104, "ΠΙΤΥ": 0.0104, "ΑΜΗΝ": 0.0104,
+	"ΟΙΟΥ": 0.0104, "ΝΔΕΔ": 0.0104, "ΑΤΟΔ": 0.0104, "ΣΥΝΗ": 0.0104, "ΕΡΙΟ": 0.0104,
+	"ΟΡΙΟ": 0.0104, "ΘΕΣΗ": 0.0104, "ΣΗΣΚ": 0.0104, "ΓΙΚΑ": 0.0104, "ΗΕΚΦ": 0.0104,
+	"ΡΧΟΥ": 0.0104, "ΑΤΑΑ": 0.0104, "ΤΕΙΑ": 0.0104, "ΟΥΚΕ": 0.0104, "ΘΕΤΟ": 0.0104,
+	"ΠΗΓΗ": 0.0104, "ΜΕΡΕ": 0.0104, "ΕΤΕΕ": 0.0104, "ΠΙΣΗ": 0.0104, "ΞΕΤΕ": 0.0104,
+	"ΚΟΥΤ": 0.0104, "ΨΗΦΙ": 0.0104, "ΗΡΩΣ": 0.0104, "ΑΠΟΙ": 0.0104, "ΜΟΥΤ": 0.0104,
+	"ΚΑΙΠ": 0.0104, "ΙΟΜΕ": 0.0104, "ΟΜΕΓ": 0.0104, "ΚΟΝΤ": 0.0104, "ΟΝΟΣ": 0.0104,
+	"ΟΓΩΝ": 0.0104, "ΘΕΛΕ": 0.0104, "ΕΤΑΚ": 0.0104, "ΚΡΟΑ": 0.0104, "ΤΑΦΟ": 0.0104,
+	"ΣΙΜΑ": 0.0104, "ΛΕΥΚ": 0.0104, "ΟΣΚΛ": 0.0104, "ΕΠΤΟ": 0.0104, "ΣΚΛΑ": 0.0104,
+	"ΤΙΟΥ": 0.0104, "ΒΕΤΙ": 0.0104, "ΑΛΟΥ": 0.0104, "ΜΕΝΙ": 0.0104, "ΕΣΑΙ": 0.0104,
+	"ΔΑΝΙ": 0.0104, "ΛΑΙΚ": 0.0104, "ΥΛΛΟ": 0.0104, "ΓΑΣΤ": 0.0104, "ΥΠΟΕ": 0.0104,
+	"ΟΜΜΑ": 0.0104, "ΟΠΩΣ": 0.0104, "ΗΘΗΣ": 0.0104, "ΙΚΟΧ": 0.0104, "ΤΑΝΙ": 0.0100,
+	"ΠΟΥΡ": 0.0100, "ΑΣΑΝ": 0.0100, "ΤΙΑΝ": 0.0100, "ΕΙΑΝ": 0.0100, "ΟΣΠΡ": 0.0100,
+	"ΣΚΑΝ": 0.0100, "ΣΕΑΥ": 0.0100, "ΙΟΔΙ": 0.0100, "ΙΑΥΠ": 0.0100, "ΠΙΚΗ": 0.0100,
+	"ΛΜΑΕ": 0.0100, "ΑΡΚΕ": 0.0100, "ΟΜΠΟ": 0.0100, "ΙΠΟΛ": 0.0100, "ΕΙΑΛ": 0.0100,
+	"ΟΣΑΠ": 0.0100, "ΑΝΔΕ": 0.0100, "ΧΕΙΠ": 0.0100, "ΝΑΙΤ": 0.0100, "ΙΣΣΥ": 0.0100,
+	"ΝΑΒΡ": 0.0100, "ΛΛΑΞ": 0.0100, "ΕΝΑΡ": 0.0100, "ΤΩΝΜ": 0.0100, "ΓΙΑΜ": 0.0100,
+	"ΛΑΠΛ": 0.0100, "ΠΕΙΤ": 0.0100, "ΡΟΔΙ": 0.0100, "ΤΑΑΠ": 0.0100, "ΕΣΠΡ": 0.0100,
+	"ΟΚΑΘ": 0.0100, "ΜΕΣΩ": 0.0100, "ΗΣΠΟ": 0.0100, "ΟΚΙΜ": 0.0100, "ΟΡΙΕ": 0.0100,
+	"ΕΙΑΕ": 0.0100, "ΟΣΤΕ": 0.0100, "ΝΟΜΙ": 0.0100, "ΚΟΚΑ": 0.0100, "ΗΓΗΣ": 0.0100,
+	"ΓΕΤΕ": 0.0100, "ΙΟΚΛ": 0.0100, "ΙΣΩΣ": 0.0100, "ΠΡΟΚ": 0.0100, "ΚΟΣΑ": 0.0100,
+	"ΚΟΠΗ": 0.0100, "ΣΟΡΙ": 0.0100, "ΝΙΚΕ": 0.0100, "ΖΕΙΤ": 0.0100, "ΥΛΟΓ": 0.0100,
+	"ΑΠΟΠ": 0.0100, "ΑΠΩΝ": 0.0100, "ΩΝΤΑ": 0.0100, "ΧΕΙΣ": 0.0100, "ΕΣΟΛ": 0.0100,
+	"ΤΩΝΣ": 0.0100, "ΝΚΛΑ": 0.0100, "ΚΙΝΑ": 0.0100, "ΙΣΥΜ": 0.0100, "ΔΙΟΚ": 0.0100,
+	"ΑΚΑΣ": 0.0100, "ΗΣΠΑ": 0.0100, "ΙΛΥΣ": 0.0100, "ΙΜΙΑ": 0.0100, "ΚΑΙΟ": 0.0100,
+	"ΣΤΑΑ": 0.0100, "ΕΙΕΠ": 0.0100, "ΘΗΣΑ": 0.0100, "ΦΕΡΟ": 0.0100, "ΒΙΑΣ": 0.0100,
+	"ΣΑΙΩ": 0.0100, "ΑΤΑΡ": 0.0100, "ΥΜΕΣ": 0.0100, "ΙΣΛΑ": 0.0100, "ΙΡΛΑ": 0.0100,
+	"ΡΛΑΝ": 0.0100, "ΤΖΟΥ": 0.0100, "ΝΑΓΚ": 0.0100, "ΝΟΡΒ": 0.0100, "ΟΡΒΗ": 0.0100,
+	"ΡΒΗΓ": 0.0100, "ΒΗΓΙ": 0.0100, "ΗΕΙΚ": 0.0100, "ΛΗΝΩ": 0.0100, "ΗΝΩΣ": 0.0100,
+	"ΙΟΑΛ": 0.0100, "ΕΞΥΠ": 0.0100, "ΗΡΕΤ": 0.0100, "ΗΝΑΡ": 0.0100, "ΤΑΑΚ": 0.0100,
+	"ΚΗΣΑ": 0.0097, "ΟΝΙΑ": 0.0097, "ΕΙΑΤ": 0.0097, "ΟΜΟΣ": 0.0097, "ΥΣΕΤ": 0.0097,
+	"ΜΑΡΙ": 0.0097, "ΕΔΟΥ": 0.0097, "ΓΙΟΣ": 0.0097, "ΜΕΘΟ": 0.0097, "ΕΘΟΔ": 0.0097,
+	"ΘΟΔΟ": 0.0097, "ΥΣΙΑ": 0.0097, "ΤΟΚΕ": 0.0097, "ΕΡΑΙ": 0.0097, "ΒΡΙΣ": 0.0097,
+	"ΛΙΟΥ": 0.0097, "ΛΛΑΔ": 0.0097, "ΤΟΝΔ": 0.0097, "ΟΚΤΗ": 0.0097, "ΓΗΣΕ": 0.0097,
+	"ΝΕΝΤ": 0.0097, "ΝΩΝΑ": 0.0097, "ΚΟΣΤ": 0.0097, "ΕΠΑΡ": 0.0097, "ΙΟΥΓ": 0.0097,
+	"ΚΟΜΕ": 0.0097, "ΕΤΟΔ": 0.0097, "ΣΙΑΤ": 0.0097, "ΣΕΚΑ": 0.0097, "ΟΥΕΛ": 0.0097,
+	"ΥΝΟΛ": 0.0097, "ΣΕΛΙ": 0.0097, "ΡΟΣΜ": 0.0097, "ΕΙΜΟ": 0.0097, "ΥΣΚΕ": 0.0097,
+	"ΛΩΝΟ": 0.0097, "ΕΘΟΣ": 0.0097, "ΤΜΗΜ": 0.0097, "ΜΗΜΑ": 0.0097, "ΑΣΗΔ": 0.0097,
+	"ΝΑΑΝ": 0.0097, "ΩΝΓΙ": 0.0097, "ΔΙΑΝ": 0.0097, "ΗΟΡΙ": 0.0097, "ΡΩΝΑ": 0.0097,
+	"ΗΣΕΓ": 0.0097, "ΚΑΘΑ": 0.0097, "ΑΘΑΡ": 0.0097, "ΝΑΒΑ": 0.0097, "ΑΘΜΙ": 0.0097,
+	"ΑΠΕΙ": 0.0097, "ΗΜΕΣ": 0.0097, "ΣΗΕΞ": 0.0097, "ΙΑΛΗ": 0.0097, "ΑΡΕΧ": 0.0097,
+	"ΗΜΑΝ": 0.0097, "ΠΟΠΡ": 0.0097, "ΟΛΑΒ": 0.0097, "ΛΑΒΗ": 0.0097, "ΚΥΡΩ": 0.0097,
+	"ΘΥΓΑ": 0.0097, "ΥΓΑΤ": 0.0097, "ΓΑΤΡ": 0.0097, "ΤΙΣΗ": 0.0097, "ΕΠΕΡ": 0.0097,
+	"ΑΑΦΑ": 0.0097, "ΥΘΕΙ": 0.0097, "ΑΕΦΑ": 0.0097, "ΝΕΣΕ": 0.0097, "ΚΡΙΜ": 0.0097,
+	"ΑΙΓΥ": 0.0097, "ΙΩΝΙ": 0.0097, "ΣΙΑΚ": 0.0097, "ΑΑΡΑ": 0.0097, "ΥΙΝΕ": 0.0097,
+	"ΕΛΛΗ": 0.0097, "ΛΛΗΝ": 0.0097, "ΕΜΠΟ": 0.0097, "ΟΛΑΡ": 0.0097, "ΝΤΟΑ": 0.0097,
+	"ΙΑΣΩ": 0.0097, "ΝΕΥΜ": 0.0097, "ΗΣΑΣ": 0.0097, "ΚΟΠΛ": 0.0097, "ΗΣΑΛ": 0.0097,
+	"ΕΣΣΑ": 0.0097, "ΜΜΕΣ": 0.0097, "ΞΥΠΗ": 0.0097, "ΚΟΕΝ": 0.0097, "ΡΕΤΑ": 0.0093,
+	"ΜΠΙΑ": 0.0093, "ΟΥΡΑ": 0.0093, "ΛΑΟΥ": 0.0093, "ΟΥΕΡ": 0.0093, "ΤΑΝΑ": 0.0093,
+	"ΡΙΑΝ": 0.0093, "ΤΑΡΙ": 0.0093, "ΕΣΚΑ": 0.0093, "ΟΥΘΕ": 0.0093, "ΤΟΝΚ": 0.0093,
+	"ΥΝΕΧ": 0.0093, "ΤΕΡΕ": 0.0093, "ΗΟΝΟ": 0.0093, "ΥΚΑΙ": 0.0093, "ΟΣΒΑ": 0.0093,
+	"ΕΥΡΟ": 0.0093, "ΕΣΗΜ": 0.0093, "ΣΕΝΩ": 0.0093, "ΙΣΚΕ": 0.0093, "ΗΗΑΠ": 0.0093,
+	"ΕΣΗΚ": 0.0093, "ΡΟΣΕ": 0.0093, "ΛΑΔΕ": 0.0093, "ΑΒΡΕ": 0.0093, "ΙΝΑΔ": 0.0093,
+	"ΤΗΤΟ": 0.0093, "ΜΗΧΑ": 0.0093, "ΤΕΡΗ": 0.0093, "ΝΑΝΤ": 0.0093, "ΑΕΠΑ": 0.0093,
+	"ΡΑΙΟ": 0.0093, "ΚΡΙΣ": 0.0093, "ΩΝΙΑ": 0.0093, "ΗΣΗΠ": 0.0093, "ΜΗΓΙ": 0.0093,
+	"ΟΝΙΣ": 0.0093, "ΟΡΙΑ": 0.0093, "ΑΤΗΜ": 0.0093, "ΕΚΑΤ": 0.0093, "ΜΕΣΗ": 0.0093,
+	"ΜΟΝΗ": 0.0093, "ΤΟΣΧ": 0.0093, "ΝΟΛΟ": 0.0093, "ΝΠΟΥ": 0.0093, "ΓΩΓΙ": 0.0093,
+	"ΓΑΛΗ": 0.0093, "ΚΙΜΑ": 0.0093, "ΣΠΛΗ": 0.0093, "ΣΥΣΚ": 0.0093, "ΩΝΕΠ": 0.0093,
+	"ΣΙΜΗ": 0.0093, "ΑΣΔΙ": 0.0093, "ΜΑΣΑ": 0.0093, "ΣΤΑΤ": 0.0093, "ΝΟΑΝ": 0.0093,
+	"ΟΡΩΝ": 0.0093, "ΚΕΥΗ": 0.0093, "ΟΥΛΑ": 0.0093, "ΙΝΑΧ": 0.0093, "ΘΕΙΜ": 0.0093,
+	"ΡΩΘΗ": 0.0093, "ΚΟΝΙ": 0.0093, "ΣΩΣΤ": 0.0093, "ΣΑΠΑ": 0.0093, "ΑΦΩΝ": 0.0093,
+	"ΟΑΙΡ": 0.0093, "ΟΙΝΟ": 0.0093, "ΑΚΙΝ": 0.0093, "ΣΘΕΤ": 0.0093, "ΗΚΕΤ": 0.0093,
+	"ΟΝΑΠ": 0.0093, "ΡΟΠΗ": 0.0093, "ΣΕΜΙ": 0.0093, "ΙΜΑΤ": 0.0093, "ΧΙΑΚ": 0.0093,
+	"ΗΚΕΗ": 0.0093, "ΥΦΟΥ": 0.0093, "ΦΟΥΣ": 0.0093, "ΙΟΣΥ": 0.0093, "ΙΚΗΤ": 0.0093,
+	"ΤΑΜΑ": 0.0093, "ΜΠΑΝ": 0.0093, "ΝΓΚΑ": 0.0093, "ΙΝΔΟ": 0.0093, "ΑΓΚΑ": 0.0093,
+	"ΓΚΡΙ": 0.0093, "ΟΥΡΔ": 0.0093, "ΥΡΔΙ": 0.0093, "ΡΔΙΚ": 0.0093, "ΡΑΛΕ": 0.0093,
+	"ΟΥΗΔ": 0.0093, "ΖΟΥΑ": 0.0093, "ΥΑΝΓ": 0.0093, "ΕΤΟΕ": 0.0093, "ΝΕΙΕ": 0.0093,
+	"ΙΑΤΩ": 0.0093, "ΕΤΗΤ": 0.0093, "ΣΕΝΗ": 0.0093, "ΠΟΤΡ": 0.0093, "ΥΠΛΗ": 0.0093,
+	"ΤΡΕΦ": 0.0093, "ΜΠΑΜ": 0.0089, "ΡΕΙΟ": 0.0089, "ΟΤΟΣ": 0.0089, "ΦΘΗΚ": 0.0089,
+	"ΗΣΗΟ": 0.0089, "ΛΛΟΥ": 0.0089, "ΑΙΠΟ": 0.0089, "ΜΑΔΙ": 0.0089, "ΤΑΠΡ": 0.0089,
+	"ΩΝΠΡ": 0.0089, "ΚΕΡΑ": 0.0089, "ΟΣΣΕ": 0.0089, "ΓΙΑΔ": 0.0089, "ΛΑΜΒ": 0.0089,
+	"ΑΕΝΤ": 0.0089, "ΗΚΟΣ": 0.0089, "ΝΤΗΝ": 0.0089, "ΑΝΟΥ": 0.0089, "ΜΗΝΕ": 0.0089,
+	"ΕΥΣΕ": 0.0089, "ΣΗΑΝ": 0.0089, "ΜΠΙΣ": 0.0089, "ΕΚΧΩ": 0.0089, "ΚΧΩΡ": 0.0089,
+	"ΠΛΑΤ": 0.0089, "ΓΟΥΜ": 0.0089, "ΧΕΙΛ": 0.0089, "ΚΗΣΕ": 0.0089, "ΟΝΑΡ": 0.0089,
+	"ΙΕΞΟ": 0.0089, "ΙΑΠΑ": 0.0089, "ΑΝΤΕ": 0.0089, "ΗΝΕΙ": 0.0089, "ΣΗΜΟ": 0.0089,
+	"ΙΣΕΜ": 0.0089, "ΜΟΣΔ": 0.0089, "ΥΝΤΟ": 0.0089, "ΑΙΥΠ": 0.0089, "ΕΤΑΣ": 0.0089,
+	"ΤΕΙΕ": 0.0089, "ΩΡΙΝ": 0.0089, "ΑΝΕΚ": 0.0089, "ΒΙΒΛ": 0.0089, "ΙΒΛΙ": 0.0089,
+	"ΗΠΟΥ": 0.0089, "ΣΕΦΑ": 0.0089, "ΟΥΘΟ": 0.0089, "ΣΤΙΓ": 0.0089, "ΤΙΓΜ": 0.0089,
+	"ΣΕΓΓ": 0.0089, "ΒΑΛΛ": 0.0089, "ΟΕΓΓ": 0.0089, "ΤΗΣΙ": 0.0089, "ΜΜΗΕ": 0.0089,
+	"ΓΑΛΟ": 0.0089, "ΣΕΡΓ": 0.0089, "ΙΚΟΓ": 0.0089, "ΥΠΟΠ": 0.0089, "ΙΤΖΙ": 0.0089,
+	"ΑΒΙΑ": 0.0089, "ΠΟΛΩ": 0.0089, "ΣΕΡΒ": 0.0089, "ΥΗΔΙ": 0.0089, "ΦΥΛΛ": 0.0089,
+	"ΥΤΙΣ": 0.0089, "ΙΑΜΟ": 0.0089, "ΣΕΕΝ": 0.0089, "ΜΕΚΑ": 0.0089, "ΚΛΩΝ": 0.0089,
+	"ΡΕΦΕ": 0.0089, "ΔΟΛΑ": 0.0089, "ΒΙΚΟ": 0.0089, "ΑΓΚΟ": 0.0085, "ΚΡΑΝ": 0.0085,
+	"ΛΟΥΜ": 0.0085, "ΡΣΙΚ": 0.0085, "ΣΠΟΝ": 0.0085, "ΠΟΝΔ": 0.0085, "ΝΤΙΑ": 0.0085,
+	"ΤΑΚΙ": 0.0085, "ΙΚΑΕ": 0.0085, "ΤΡΟΣ": 0.0085, "ΝΤΟΝ": 0.0085, "ΚΟΣΣ": 0.0085,
+	"ΗΡΩΘ": 0.0085, "ΩΓΗΣ": 0.0085, "ΣΟΝΟ": 0.0085, "ΝΑΤΑ": 0.0085, "ΕΣΗΕ": 0.0085,
+	"ΤΟΚΟ": 0.0085, "ΑΜΕΓ": 0.0085, "ΟΚΩΔ": 0.0085, "ΟΕΥΡ": 0.0085, "ΗΛΕΙ": 0.0085,
+	"ΣΧΟΛ": 0.0085, "ΑΜΒΑ": 0.0085, "ΜΒΑΝ": 0.0085, "ΩΤΙΚ": 0.0085, "ΑΤΡΕ": 0.0085,
+	"ΟΥΟΝ": 0.0085, "ΙΤΟΑ": 0.0085, "ΣΙΕΣ": 0.0085, "ΥΣΗΤ": 0.0085, "ΤΑΣΕ": 0.0085,
+	"ΝΠΑΡ": 0.0085, "ΚΕΣΤ": 0.0085, "ΣΜΝΗ": 0.0085, "ΕΤΕΣ": 0.0085, "ΕΙΨΗ": 0.0085,
+	"ΗΜΝΗ": 0.0085, "ΣΕΚΤ": 0.0085, "ΡΕΠΟ": 0.0085, "ΠΟΝΤ": 0.0085, "ΗΘΕΣ": 0.0085,
+	"ΩΓΙΚ": 0.0085, "ΚΗΕΚ": 0.0085, "ΝΑΙΟ": 0.0085, "ΟΥΘΗ": 0.0085, "ΑΕΠΕ": 0.0085,
+	"ΚΗΤΟ": 0.0085, "ΕΚΤΥ": 0.0085, "ΚΤΥΠ": 0.0085, "ΩΣΗΕ": 0.0085, "ΕΤΟΣ": 0.0085,
+	"ΚΟΥΜ": 0.0085, "ΙΩΝΤ": 0.0085, "ΙΝΑΜ": 0.0085, "ΟΤΩΝ": 0.0085, "ΙΑΚΛ": 0.0085,
+	"ΙΟΤΟ": 0.0085, "ΕΙΑΥ": 0.0085, "ΘΕΙΗ": 0.0085, "ΟΗΘΗ": 0.0085, "ΙΗΘΗ": 0.0085,
+	"ΙΖΟΜ": 0.0085, "ΗΣΛΙ": 0.0085, "ΤΑΘΕ": 0.0085, "ΕΛΕΤ": 0.0085, "ΓΝΟΕ": 0.0085,
+	"ΤΟΣΠ": 0.0085, "ΝΕΓΓ": 0.0085, "ΤΑΛΕ": 0.0085, "ΑΙΓΙ": 0.0085, "ΙΟΠΟ": 0.0085,
+	"ΙΒΑΛ": 0.0085, "ΓΙΚΟ": 0.0085, "ΙΑΚΗ": 0.0085, "ΤΟΕΓ": 0.0085, "ΣΔΕΔ": 0.0085,
+	"ΟΠΟΘ": 0.0085, "ΠΤΟΜ": 0.0085, "ΑΤΑΚ": 0.0085, "ΟΥΝΙ": 0.0085, "ΣΣΑΑ": 0.0085,
+	"ΣΣΑΙ": 0.0085, "ΙΑΠΩ": 0.0085, "ΠΩΝΙ": 0.0085, "ΠΑΝΓ": 0.0085, "ΜΑΛΓ": 0.0085,
+	"ΡΟΥΠ": 0.0085, "ΙΣΠΑ": 0.0085, "ΣΟΥΗ": 0.0085, "ΟΥΚΡ": 0.0085, "ΑΣΩΛ": 0.0085,
+	"ΔΟΥΕ": 0.0085, "ΛΑΞΕ": 0.0085, "ΝΑΥΤ": 0.0085, "ΚΟΛΑ": 0.0085, "ΤΗΣΟ": 0.0085,
+	"ΕΝΩΜ": 0.0085, "ΙΡΕΘ": 0.0085, "ΤΙΣΑ": 0.0085, "ΙΣΕΠ": 0.0085, "ΟΡΑΚ": 0.0085,
+	"ΕΦΕΙ": 0.0085, "ΟΑΛΗ": 0.0085, "ΚΟΧΩ": 0.0085, "ΡΑΝΤ": 0.0081, "ΕΤΑΝ": 0.0081,
+	"ΙΝΤΙ": 0.0081, "ΚΟΝΕ": 0.0081, "ΦΕΡΕ": 0.0081, "ΕΡΕΙ": 0.0081, "ΑΣΚΟ": 0.0081,
+	"ΜΑΛΙ": 0.0081, "ΙΤΟΜ": 0.0081, "ΑΝΟΤ": 0.0081, "ΙΑΝΕ": 0.0081, "ΥΝΗΣ": 0.0081,
+	"ΤΑΙΛ": 0.0081, "ΝΟΤΟ": 0.0081, "ΕΥΘΕ": 0.0081, "ΙΡΑΝ": 0.0081, "ΡΟΝΑ": 0.0081,
+	"ΙΚΗΑ": 0.0081, "ΗΑΠΑ": 0.0081, "ΑΝΩΣ": 0.0081, "ΙΩΝΑ": 0.0081, "ΔΙΠΛ": 0.0081,
+	"ΡΕΣΠ": 0.0081, "ΡΟΣΒ": 0.0081, "ΑΚΕΡ": 0.0081, "ΝΑΡΤ": 0.0081, "ΤΗΛΕ": 0.0081,
+	"ΑΝΟΜ": 0.0081, "ΝΧΡΗ": 0.0081, "ΕΣΠΑ": 0.0081, "ΗΝΑΠ": 0.0081, "ΑΛΓΟ": 0.0081,
+	"ΛΓΟΡ": 0.0081, "ΟΡΙΘ": 0.0081, "ΠΙΣΜ": 0.0081, "ΗΧΑΝ": 0.0081, "ΙΟΝΟ": 0.0081,
+	"ΑΣΙΕ": 0.0081, "ΥΣΤΕ": 0.0081, "ΗΗΜΕ": 0.0081, "ΙΡΑΣ": 0.0081, "ΑΣΑΠ": 0.0081,
+	"ΙΜΗΕ": 0.0081, "ΑΙΑΚ": 0.0081, "ΕΙΓΙ": 0.0081, "ΝΔΥΑ": 0.0081, "ΙΣΗΑ": 0.0081,
+	"ΗΚΕΣ": 0.0081, "ΑΤΟΧ": 0.0081, "ΝΟΥΝ": 0.0081, "ΑΣΣΥ": 0.0081, "ΠΡΟΔ": 0.0081,
+	"ΟΑΡΙ": 0.0081, "ΕΝΔΙ": 0.0081, "ΙΟΠΡ": 0.0081, "ΑΝΗΚ": 0.0081, "ΠΡΟΗ": 0.0081,
+	"ΡΟΗΓ": 0.0081, "ΤΑΚΤ": 0.0081, "ΗΜΑΣ": 0.0081, "ΕΛΙΔ": 0.0081, "ΙΚΡΟ": 0.0081,
+	"ΙΣΗΠ": 0.0081, "ΣΗΠΛ": 0.0081, "ΥΠΩΣ": 0.0081, "ΤΗΤΩ": 0.0081, "ΔΕΙΑ": 0.0081,
+	"ΤΑΞΙ": 0.0081, "ΑΞΙΝ": 0.0081, "ΞΙΝΟ": 0.0081, "ΠΙΣΩ": 0.0081, "ΑΛΥΤ": 0.0081,
+	"ΗΣΓΡ": 0.0081, "ΓΗΔΕ": 0.0081, "ΙΚΑΓ": 0.0081, "ΟΛΗΕ": 0.0081, "ΙΑΛΟ": 0.0081,
+	"ΣΑΓΕ": 0.0081, "ΙΠΟΥ": 0.0081, "ΑΣΙΩ": 0.0081, "ΣΙΩΝ": 0.0081, "ΝΕΞΑ": 0.0081,
+	"ΩΤΗΣ": 0.0081, "ΑΣΕΚ": 0.0081, "ΗΤΕΣ": 0.0081, "ΜΙΚΡ": 0.0081, "ΑΒΑΘ": 0.0081,
+	"ΗΕΥΡ": 0.0081, "ΕΑΛΛ": 0.0081, "ΕΙΝΕ": 0.0081, "ΣΤΟΚ": 0.0081, "ΑΤΟΚ": 0.0081,
+	"ΜΑΜΕ": 0.0081, "ΔΕΒΡ": 0.0081, "ΠΟΙΑ": 0.0081, "ΡΕΧΕ": 0.0081, "ΟΣΩΡ": 0.0081,
+	"ΜΑΝΤ": 0.0081, "ΑΕΞΑ": 0.0081, "ΥΘΟΥ": 0.0081, "ΜΑΣΕ": 0.0081, "ΤΑΙΓ": 0.0081,
+	"ΑΒΗΤ": 0.0081, "ΚΟΣΥ": 0.0081, "ΥΠΩΜ": 0.0081, "ΗΣΟΜ": 0.0081, "ΡΟΗΣ": 0.0081,
+	"ΕΝΟΚ": 0.0081, "ΚΑΙΣ": 0.0081, "ΑΜΠΙ": 0.0081, "ΑΜΟΡ": 0.0081, "ΙΜΠΑ": 0.0081,
+	"ΑΡΟΚ": 0.0081, "ΑΡΜΕ": 0.0081, "ΡΜΕΝ": 0.0081, "ΛΩΝΙ": 0.0081, "ΝΑΜΠ": 0.0081,
+	"ΚΑΚΑ": 0.0081, "ΙΑΝΗ": 0.0081, "ΡΟΑΤ": 0.0081, "ΟΑΤΙ": 0.0081, "ΤΣΕΧ": 0.0081,
+	"ΓΚΟΛ": 0.0081, "ΠΑΝΙ": 0.0081, "ΡΚΙΚ": 0.0081, "ΕΤΑΠ": 0.0081, "ΑΦΙΚ": 0.0081,
+	"ΑΤΩΣ": 0.0081, "ΗΝΕΓ": 0.0081, "ΜΗΝΙ": 0.0081, "ΤΟΜΟ": 0.0081, "ΘΑΠΑ": 0.0081,
+	"ΙΑΦΕ": 0.0081, "ΕΧΡΗ": 0.0081, "ΕΙΧΡ": 0.0081, "ΔΕΓΙ": 0.0081, "ΚΙΚΟ": 0.0081,
+	"ΝΤΒΟ": 0.0081, "ΤΒΟΡ": 0.0081, "ΒΟΡΑ": 0.0081, "ΒΡΕΤ": 0.0077, "ΣΣΑΧ": 0.0077,
+	"ΔΥΤΙ": 0.0077, "ΑΙΜΙ": 0.0077, "ΤΙΑΚ": 0.0077, "ΗΣΙΔ": 0.0077, "ΙΝΓΚ": 0.0077,
+	"ΓΧΟΣ": 0.0077, "ΕΚΛΕ": 0.0077, "ΟΔΕΙ": 0.0077, "ΥΜΕΤ": 0.0077, "ΚΑΙΩ": 0.0077,
+	"ΑΙΩΜ": 0.0077, "ΙΩΜΑ": 0.0077, "ΚΟΔΕ": 0.0077, "ΟΜΗΚ": 0.0077, "ΟΙΔΙ": 0.0077,
+	"ΕΝΠΡ": 0.0077, "ΜΙΑΜ": 0.0077, "ΕΙΥΠ": 0.0077, "ΣΕΙΤ": 0.0077, "ΤΡΕΨ": 0.0077,
+	"ΡΕΨΕ": 0.0077, "ΗΗΔΗ": 0.0077, "ΥΟΝΟ": 0.0077, "ΙΠΛΕ": 0.0077, "ΠΛΕΟ": 0.0077,
+	"ΩΣΕΝ": 0.0077, "ΑΣΗΑ": 0.0077, "ΑΙΟΥ": 0.0077, "ΟΣΧΡ": 0.0077, "ΑΚΟΣ": 0.0077,
+	"ΝΑΛΗ": 0.0077, "ΕΤΑΑ": 0.0077, "ΟΜΙΑ": 0.0077, "ΑΦΕΣ": 0.0077, "ΗΝΕΚ": 0.0077,
+	"ΗΔΙΚ": 0.0077, "ΝΗΚΑ": 0.0077, "ΟΙΣΥ": 0.0077, "ΙΩΝΣ": 0.0077, "ΜΟΣΚ": 0.0077,
+	"ΓΕΣΕ": 0.0077, "ΗΡΩΝ": 0.0077, "ΘΗΣΗ": 0.0077, "ΗΟΛΩ": 0.0077, "ΔΟΥΣ": 0.0077,
+	"ΗΚΕΠ": 0.0077, "ΛΗΚΑ": 0.0077, "ΑΒΑΣ": 0.0077, "ΧΟΛΙ": 0.0077, "ΛΥΤΕ": 0.0077,
+	"ΝΧΑΡ": 0.0077, "ΚΕΣΕ": 0.0077, "ΙΠΛΗ": 0.0077, "ΗΣΤΑ": 0.0077, "ΑΡΑΠ": 0.0077,
+	"ΕΣΕΚ": 0.0077, "ΔΙΟΤ": 0.0077, "ΟΝΟΥ": 0.0077, "ΟΓΗΤ": 0.0077, "ΜΕΙΟ": 0.0077,
+	"ΙΟΥΝ": 0.0077, "ΓΗΣΗ": 0.0077, "ΒΑΤΟ": 0.0077, "ΛΛΕΣ": 0.0077, "ΕΚΑΠ": 0.0077,
+	"ΑΙΑΡ": 0.0077, "ΥΑΝΤ": 0.0077, "ΧΙΖΕ": 0.0077, "ΒΑΝΕ": 0.0077, "ΚΑΛΟ": 0.0077,
+	"ΡΑΦΩ": 0.0077, "ΒΛΙΟ": 0.0077, "ΡΙΝΙ": 0.0077, "ΙΓΜΗ": 0.0077, "ΟΤΟΜ": 0.0077,
+	"ΒΗΤΗ": 0.0077, "ΥΡΩΣ": 0.0077, "ΕΡΙΒ": 0.0077, "ΡΙΒΑ": 0.0077, "ΛΟΝΤ": 0.0077,
+	"ΟΚΑΝ": 0.0077, "ΕΠΟΜ": 0.0077, "ΗΣΧΡ": 0.0077, "ΟΣΚΟ": 0.0077, "ΑΣΕΤ": 0.0077,
+	"ΙΕΣΗ": 0.0077, "ΙΑΤΥ": 0.0077, "ΟΡΕΑ": 0.0077, "ΦΥΓΗ": 0.0077, "ΟΜΙΚ": 0.0077,
+	"ΗΡΟΗ": 0.0077, "ΧΕΤΙ": 0.0077, "ΓΕΣΠ": 0.0077, "ΟΣΑΣ": 0.0077, "ΙΑΒΑ": 0.0077,
+	"ΟΚΕΛ": 0.0077, "ΜΙΚΗ": 0.0077, "ΛΑΜΙ": 0.0077, "ΕΒΡΑ": 0.0077, "ΒΡΑΙ": 0.0077,
+	"ΜΠΑΧ": 0.0077, "ΙΓΥΠ": 0.0077, "ΓΥΠΤ": 0.0077, "ΑΜΑΙ": 0.0077, "ΟΛΗΜ": 0.0077,
+	"ΤΕΚΣ": 0.0077, "ΟΥΒΑ": 0.0077, "ΚΑΠΕ": 0.0077, "ΠΙΑΣ": 0.0077, "ΛΙΘΟ": 0.0077,
+	"ΙΘΟΥ": 0.0077, "ΘΟΥΑ": 0.0077, "ΥΑΝΙ": 0.0077, "ΜΑΠΑ": 0.0077, "ΑΛΓΑ": 0.0077,
+	"ΛΓΑΣ": 0.0077, "ΑΣΤΙ": 0.0077, "ΑΚΙΣ": 0.0077, "ΕΡΒΙ": 0.0077, "ΡΒΙΚ": 0.0077,
+	"ΕΡΟΕ": 0.0077, "ΕΝΟΙ": 0.0077, "ΤΟΕΧ": 0.0077, "ΥΣΑΣ": 0.0077, "ΨΕΤΕ": 0.0077,
+	"ΑΓΗΤ": 0.0077, "ΑΥΤΙ": 0.0077, "ΩΣΕΩ": 0.0077, "ΟΦΩΝ": 0.0077, "ΟΝΤΒ": 0.0077,
+	"ΑΛΑΜ": 0.0073, "ΡΙΝΤ": 0.0073, "ΕΝΤΙ": 0.0073, "ΟΥΑΤ": 0.0073, "ΧΟΥΣ": 0.0073,
+	"ΦΙΛΙ": 0.0073, "ΙΛΙΠ": 0.0073, "ΣΑΟΥ": 0.0073, "ΗΝΩΜ": 0.0073, "ΣΕΛΕ": 0.0073,
+	"ΗΦΘΗ": 0.0073, "ΚΕΝΗ": 0.0073, "ΙΑΔΕ": 0.0073, "ΡΚΕΙ": 0.0073, "ΚΕΙΑ": 0.0073,
+	"ΣΩΜΑ": 0.0073, "ΔΕΙΚ": 0.0073, "ΟΚΟΛ": 0.0073, "ΣΕΧΕ": 0.0073, "ΑΤΑΠ": 0.0073,
+	"ΕΠΡΟ": 0.0073, "ΔΟΣΣ": 0.0073, "ΣΚΕΝ": 0.0073, "ΡΕΘΕ": 0.0073, "ΟΥΚΙ": 0.0073,
+	"ΕΣΔΙ": 0.0073, "ΜΗΣΕ": 0.0073, "ΙΤΟΟ": 0.0073, "ΜΑΚΕ": 0.0073, "ΕΜΠΙ": 0.0073,
+	"ΥΝΔΥ": 0.0073, "ΟΣΕΚ": 0.0073, "ΡΟΝΙ": 0.0073, "ΑΣΓΙ": 0.0073, "ΕΤΡΗ": 0.0073,
+	"ΟΙΧΙ": 0.0073, "ΟΥΝΜ": 0.0073, "ΟΗΓΟ": 0.0073, "ΝΗΣΕ": 0.0073, "ΩΝΣΕ": 0.0073,
+	"ΝΕΚΚ": 0.0073, "ΕΝΗΑ": 0.0073, "ΜΑΥΠ": 0.0073, "ΣΕΚΦ": 0.0073, "ΤΗΡΩ": 0.0073,
+	"ΟΤΕΤ": 0.0073, "ΟΓΗΑ": 0.0073, "ΛΑΤΑ": 0.0073, "ΥΘΗΣ": 0.0073, "ΜΟΣΕ": 0.0073,
+	"ΤΑΜΙ": 0.0073, "ΣΗΣΧ": 0.0073, "ΡΑΣΔ": 0.0073, "ΛΛΗΛ": 0.0073, "ΛΕΣΕ": 0.0073,
+	"ΘΑΠΡ": 0.0073, "ΑΝΕΑ": 0.0073, "ΙΕΣΕ": 0.0073, "ΙΝΤΟ": 0.0073, "ΜΠΛΟ": 0.0073,
+	"ΤΗΝΟ": 0.0073, "ΥΛΑΧ": 0.0073, "ΗΕΝΟ": 0.0073, "ΟΑΥΤ": 0.0073, "ΗΜΟΡ": 0.0073,
+	"ΕΙΜΗ": 0.0073, "ΝΟΑΠ": 0.0073, "ΣΩΡΙ": 0.0073, "ΝΟΣΚ": 0.0073, "ΠΡΟΑ": 0.0073,
+	"ΣΕΝΕ": 0.0073, "ΔΙΕΠ": 0.0073, "ΕΠΑΦ": 0.0073, "ΠΑΦΗ": 0.0073, "ΑΦΟΥ": 0.0073,
+	"ΕΙΔΗ": 0.0073, "ΙΦΘΗ": 0.0073, "ΑΔΟΥ": 0.0073, "ΣΤΟΟ": 0.0073, "ΤΑΑΝ": 0.0073,
+	"ΑΓΩΝ": 0.0073, "ΤΟΤΡ": 0.0073, "ΚΟΣΕ": 0.0073, "ΙΑΦΥ": 0.0073, "ΑΦΥΓ": 0.0073,
+	"ΝΑΑΛ": 0.0073, "ΤΗΡΗ": 0.0073, "ΥΓΗΣ": 0.0073, "ΣΕΑΝ": 0.0073, "ΟΜΕΡ": 0.0073,
+	"ΙΑΕΦ": 0.0073, "ΣΕΓΚ": 0.0073, "ΡΑΛΛ": 0.0073, "ΣΗΕΙ": 0.0073, "ΟΙΚΟ": 0.0073,
+	"ΛΕΣΟ": 0.0073, "ΥΑΡΙ": 0.0073, "ΡΙΟΣ": 0.0073, "ΝΕΣΑ": 0.0073, "ΡΟΚΟ": 0.0073,
+	"ΙΝΑΣ": 0.0073, "ΤΖΑΜ": 0.0073, "ΣΕΧΙ": 0.0073, "ΦΙΝΛ": 0.0073, "ΙΝΛΑ": 0.0073,
+	"ΝΛΑΝ": 0.0073, "ΔΙΕΘ": 0.0073, "ΙΕΘΝ": 0.0073, "ΝΑΜΑ": 0.0073, "ΝΤΟΜ": 0.0073,
+	"ΙΛΗΨ": 0.0073, "ΗΝΙΑ": 0.0073, "ΠΟΒΑ": 0.0073, "ΟΕΝΟ": 0.0073, "ΣΑΜΟ": 0.0070,
+	"ΑΤΛΑ": 0.0070, "ΟΝΕΚ": 0.0070, "ΝΕΚΤ": 0.0070, "ΒΟΣΝ": 0.0070, "ΟΣΝΙ": 0.0070,
+	"ΣΝΙΑ": 0.0070, "ΟΝΤΕ": 0.0070, "ΙΑΝΤ": 0.0070, "ΚΑΡΑ": 0.0070, "ΙΚΗΠ": 0.0070,
+	"ΗΣΟΣ": 0.0070, "ΕΙΟΛ": 0.0070, "ΙΑΣΠ": 0.0070, "ΜΑΣΥ": 0.0070, "ΤΑΙΚ": 0.0070,
+	"ΕΡΩΤ": 0.0070, "ΠΕΙΚ": 0.0070, "ΕΙΟΜ": 0.0070, "ΤΑΔΙ": 0.0070, "ΗΣΕΠ": 0.0070,
+	"ΤΟΕΥ": 0.0070, "ΤΕΥΣ": 0.0070, "ΟΥΕΝ": 0.0070, "ΜΟΣΣ": 0.0070, "ΙΚΤΗ": 0.0070,
+	"ΘΕΙΣ": 0.0070, "ΡΓΗΣ": 0.0070, "ΟΤΙΜ": 0.0070, "ΤΟΥΙ": 0.0070, "ΗΒΑΣ": 0.0070,
+	"ΕΛΗΦ": 0.0070, "ΤΡΟΕ": 0.0070, "ΙΚΑΘ": 0.0070, "ΣΠΡΕ": 0.0070, "ΥΡΗΚ": 0.0070,
+	"ΡΗΚΑ": 0.0070, "ΣΥΜΦ": 0.0070, "ΥΜΑΣ": 0.0070, "ΟΥΤΕ": 0.0070, "ΩΤΟΥ": 0.0070,
+	"ΗΕΠΑ": 0.0070, "ΝΣΥΜ": 0.0070, "ΙΑΣΔ": 0.0070, "ΝΤΡΕ": 0.0070, "ΗΜΑΑ": 0.0070,
+	"ΤΡΟΦ": 0.0070, "ΝΣΤΗ": 0.0070, "ΥΣΤΙ": 0.0070, "ΠΩΣΗ": 0.0070, "ΚΛΑΣ": 0.0070,
+	"ΝΑΕΓ": 0.0070, "ΘΕΣΑ": 0.0070, "ΣΤΑΙ": 0.0070, "ΙΑΓΙ": 0.0070, "ΥΣΗΜ": 0.0070,
+	"ΟΚΡΥ": 0.0070, "ΟΝΟΤ": 0.0070, "ΑΝΙΖ": 0.0070, "ΤΟΝΝ": 0.0070, "ΠΟΡΩ": 0.0070,
+	"ΤΗΤΕ": 0.0070, "ΗΚΕΜ": 0.0070, "ΗΣΤΩ": 0.0070, "ΣΜΟΝ": 0.0070, "ΩΑΠΟ": 0.0070,
+	"ΡΑΦΤ": 0.0070, "ΝΟΣΑ": 0.0070, "ΗΗΕΥ": 0.0070, "ΘΑΡΙ": 0.0070, "ΤΑΝΟ": 0.0070,
+	"ΙΜΟΤ": 0.0070, "ΕΝΕΚ": 0.0070, "ΔΕΝΙ": 0.0070, "ΟΥΡΥ": 0.0070, "ΥΡΥΘ": 0.0070,
+	"ΙΠΑΤ": 0.0070, "ΕΒΡΕ": 0.0070, "ΑΤΑΜ": 0.0070, "ΠΑΘΕ": 0.0070, "ΑΝΕΓ": 0.0070,
+	"ΕΤΑΡ": 0.0070, "ΩΝΠΕ": 0.0070, "ΒΑΛΕ": 0.0070, "ΑΛΕΤ": 0.0070, "ΣΡΥΘ": 0.0070,
+	"ΙΡΕΤ": 0.0070, "ΚΟΚΛ": 0.0070, "ΣΜΠΟ": 0.0070, "ΟΥΣΧ": 0.0070, "ΣΙΔΙ": 0.0070,
+	"ΡΑΔΕ": 0.0070, "ΑΣΠΑ": 0.0070, "ΕΥΚΟ": 0.0070, "ΔΕΜΕ": 0.0070, "ΗΣΒΟ": 0.0070,
+	"ΣΕΞΟ": 0.0070, "ΚΥΡΙ": 0.0070, "ΟΥΝΠ": 0.0070, "ΣΧΩΡ": 0.0070, "ΣΧΕΤ": 0.0070,
+	"ΖΟΥΝ": 0.0070, "ΣΙΑΑ": 0.0070, "ΣΟΥΔ": 0.0070, "ΥΔΑΝ": 0.0070, "ΜΑΙΚ": 0.0070,
+	"ΣΥΡΙ": 0.0070, "ΟΛΗΚ": 0.0070, "ΣΣΑΚ": 0.0070, "ΑΛΤΕ": 0.0070, "ΡΑΓΟ": 0.0070,
+	"ΜΑΙΑ": 0.0070, "ΟΓΛΩ": 0.0070, "ΣΛΑΝ": 0.0070, "ΙΤΑΛ": 0.0070, "ΤΑΛΙ": 0.0070,
+	"ΚΟΡΕ": 0.0070, "ΣΣΑΠ": 0.0070, "ΤΟΥΒ": 0.0070, "ΣΣΑΝ": 0.0070, "ΗΓΙΚ": 0.0070,
+	"ΥΜΑΝ": 0.0070, "ΕΛΒΕ": 0.0070, "ΛΒΕΤ": 0.0070, "ΥΚΡΑ": 0.0070, "ΝΓΚΛ": 0.0070,
+	"ΕΣΜΗ": 0.0070, "ΩΓΡΑ": 0.0070, "ΚΟΠΑ": 0.0070, "ΑΦΕΙ": 0.0070, "ΕΣΕΤ": 0.0070,
+	"ΤΗΣΝ": 0.0070, "ΑΛΗΞ": 0.0070, "ΟΣΕΞ": 0.0070, "ΟΝΚΛ": 0.0070, "ΛΑΓΩ": 0.0070,
+	"ΘΟΣΑ": 0.0070, "ΤΙΣΣ": 0.0070, "ΟΕΝΩ": 0.0070, "ΝΤΙΜ": 0.0066, "ΓΕΩΡ": 0.0066,
+	"ΕΩΡΓ": 0.0066, "ΩΡΓΙ": 0.0066, "ΟΜΠΑ": 0.0066, "ΓΟΥΕ": 0.0066, "ΟΥΜΟ": 0.0066,
+	"ΙΟΥΜ": 0.0066, "ΝΙΓΗ": 0.0066, "ΙΓΗΡ": 0.0066, "ΑΚΟΥ": 0.0066, "ΑΕΛΕ": 0.0066,
+	"ΥΘΕΡ": 0.0066, "ΗΤΗΝ": 0.0066, "ΤΑΙΟ": 0.0066, "ΥΤΟΝ": 0.0066, "ΟΤΟΔ": 0.0066,
+	"ΩΘΕΙ": 0.0066, "ΝΕΧΙ": 0.0066, "ΑΔΕΣ": 0.0066, "ΙΧΝΕ": 0.0066, "ΣΔΟΚ": 0.0066,
+	"ΕΤΑΞ": 0.0066, "ΤΑΞΥ": 0.0066, "ΣΜΙΑ": 0.0066, "ΟΥΤΗ": 0.0066, "ΑΔΙΟ": 0.0066,
+	"ΣΗΜΗ": 0.0066, "ΙΑΡΧ": 0.0066, "ΕΛΑΤ": 0.0066, "ΑΑΠΕ": 0.0066, "ΕΤΟΓ": 0.0066,
+	"ΜΕΤΙ": 0.0066, "ΟΛΗΠ": 0.0066, "ΗΚΩΔ": 0.0066, "ΙΚΟΟ": 0.0066, "ΚΡΙΒ": 0.0066,
+	"ΔΥΑΣ": 0.0066, "ΥΜΦΩ": 0.0066, "ΜΦΩΝ": 0.0066, "ΙΧΑΡ": 0.0066, "ΡΗΣΕ": 0.0066,
+	"ΝΑΦΕ": 0.0066, "ΗΚΕΚ": 0.0066, "ΑΤΟΑ": 0.0066, "ΕΙΠΡ": 0.0066, "ΩΝΑΝ": 0.0066,
+	"ΥΝΗΘ": 0.0066, "ΡΙΝΗ": 0.0066, "ΛΗΞΕ": 0.0066, "ΕΝΟΠ": 0.0066, "ΑΦΗΚ": 0.0066,
+	"ΕΡΒΟ": 0.0066, "ΑΙΕΠ": 0.0066, "ΗΕΚΤ": 0.0066, "ΧΙΑΠ": 0.0066, "ΜΑΚΛ": 0.0066,
+	"ΑΣΗΠ": 0.0066, "ΟΓΗΔ": 0.0066, "ΑΝΕΩ": 0.0066, "ΤΙΣΠ": 0.0066, "ΤΕΜΙ": 0.0066,
+	"ΑΛΑΟ": 0.0066, "ΡΙΝΑ": 0.0066, "ΕΕΠΙ": 0.0066, "ΝΟΡΙ": 0.0066, "ΜΑΧΡ": 0.0066,
+	"ΠΟΥΧ": 0.0066, "ΠΛΟΚ": 0.0066, "ΡΑΒΛ": 0.0066, "ΑΒΛΕ": 0.0066, "ΡΟΥΕ": 0.0066,
+	"ΙΝΑΓ": 0.0066, "ΑΦΕΑ": 0.0066, "ΙΔΑΣ": 0.0066, "ΑΚΟΠ": 0.0066, "ΣΑΥΤ": 0.0066,
+	"ΑΦΗΑ": 0.0066, "ΔΗΓΙ": 0.0066, "ΝΟΣΤ": 0.0066, "ΥΜΠΕ": 0.0066, "ΥΑΠΕ": 0.0066,
+	"ΝΑΕΝ": 0.0066, "ΛΗΛΟ": 0.0066, "ΝΑΤΡ": 0.0066, "ΕΞΕΤ": 0.0066, "ΑΦΤΗ": 0.0066,
+	"ΦΤΗΚ": 0.0066, "ΤΡΑΠ": 0.0066, "ΗΤΗΠ": 0.0066, "ΣΚΟΠ": 0.0066, "ΥΣΣΤ": 0.0066,
+	"ΘΟΥΜ": 0.0066, "ΕΟΝΟ": 0.0066, "ΑΡΟΝ": 0.0066, "ΙΟΚΤ": 0.0066, "ΟΜΑΜ": 0.0066,
+	"ΘΑΕΠ": 0.0066, "ΑΟΜΑ": 0.0066, "ΑΤΗΡ": 0.0066, "ΟΘΕΣ": 0.0066, "ΡΙΜΕ": 0.0066,
+	"ΓΟΥΣ": 0.0066, "ΟΝΚΑ": 0.0066, "ΣΤΕΕ": 0.0066, "ΡΟΥΑ": 0.0066, "ΕΡΙΑ": 0.0066,
+	"ΣΤΕΝ": 0.0066, "ΖΑΜΠ": 0.0066, "ΑΔΩΝ": 0.0066, "ΛΟΒΑ": 0.0066, "ΟΒΑΚ": 0.0066,
+	"ΒΑΚΙ": 0.0066, "ΟΦΥΛ": 0.0066, "ΓΕΩΓ": 0.0066, "ΕΩΓΡ": 0.0066, "ΥΠΟΤ": 0.0066,
+	"ΗΤΟΣ": 0.0066, "ΕΣΟΥ": 0.0066, "ΟΡΦΩ": 0.0066, "ΦΩΣΗ": 0.0066, "ΩΣΗΚ": 0.0066,
+	"ΕΑΝΑ": 0.0066, "ΕΚΑΙ": 0.0066, "ΗΗΕΠ": 0.0066, "ΕΝΝΟ": 0.0066, "ΜΑΖΙ": 0.0066,
+	"ΗΕΠΕ": 0.0066, "ΑΝΟΠ": 0.0066, "ΡΙΤΟ": 0.0066, "ΙΣΑΛ": 0.0066, "ΗΤΑΥ": 0.0066,
+	"ΖΙΚΟ": 0.0066, "ΤΛΑΝ": 0.0062, "ΠΑΡΙ": 0.0062, "ΑΤΑΝ": 0.0062, "ΖΙΜΠ": 0.0062,
+	"ΔΙΑΒ": 0.0062, "ΟΥΠΗ": 0.0062, "ΑΣΣΑ": 0.0062, "ΜΟΝΤ": 0.0062, "ΟΥΤΖ": 0.0062,
+	"ΙΤΑΝ": 0.0062, "ΤΖΙΑ": 0.0062, "ΗΠΟΛ": 0.0062, "ΕΙΣΣ": 0.0062, "ΑΠΙΣ": 0.0062,
+	"ΗΡΙΞ": 0.0062, "ΤΗΔΕ": 0.0062, "ΔΙΑΡ": 0.0062, "ΕΑΠΡ": 0.0062, "ΟΛΛΟ": 0.0062,
+	"ΒΑΝΟ": 0.0062, "ΟΧΙΑ": 0.0062, "ΤΑΣΠ": 0.0062, "ΕΙΣΥ": 0.0062, "ΤΟΝΠ": 0.0062,
+	"ΟΒΑΣ": 0.0062, "ΑΝΑΑ": 0.0062, "ΧΑΝΗ": 0.0062, "ΕΙΟΝ": 0.0062, "ΙΣΤΩ": 0.0062,
+	"ΑΤΕΥ": 0.0062, "ΗΕΓΓ": 0.0062, "ΕΤΑΓ": 0.0062, "ΑΦΗΔ": 0.0062, "ΡΗΔΙ": 0.0062,
+	"ΛΛΟΙ": 0.0062, "ΗΣΜΝ": 0.0062, "ΙΜΗΓ": 0.0062, "ΜΗΣΤ": 0.0062, "ΤΟΣΜ": 0.0062,
+	"ΥΜΑΑ": 0.0062, "ΟΣΗΤ": 0.0062, "ΣΜΟΤ": 0.0062, "ΕΡΑΑ": 0.0062, "ΠΕΡΧ": 0.0062,
+	"ΑΙΜΟ": 0.0062, "ΝΟΓΙ": 0.0062, "ΟΧΡΟ": 0.0062, "ΑΤΕΡ": 0.0062, "ΚΗΕΙ": 0.0062,
+	"ΕΝΗΚ": 0.0062, "ΣΣΥΣ": 0.0062, "ΟΥΟΜ": 0.0062, "ΤΟΣΦ": 0.0062, "ΜΑΕΓ": 0.0062,
+	"ΑΤΑΕ": 0.0062, "ΩΝΕΚ": 0.0062, "ΣΗΟΛ": 0.0062, "ΝΕΙΔ": 0.0062, "ΧΩΡΟ": 0.0062,
+	"ΑΚΛΑ": 0.0062, "ΠΟΔΙ": 0.0062, "ΛΟΣΑ": 0.0062, "ΟΡΕΤ": 0.0062, "ΤΑΝΕ": 0.0062,
+	"ΚΡΥΝ": 0.0062, "ΗΜΠΟ": 0.0062, "ΑΙΩΣ": 0.0062, "ΣΜΑΓ": 0.0062, "ΒΕΒΑ": 0.0062,
+	"ΕΒΑΙ": 0.0062, "ΣΤΑΜ": 0.0062, "ΡΑΠΟ": 0.0062, "ΡΩΜΑ": 0.0062, "ΗΓΕΙ": 0.0062,
+	"ΩΝΑΛ": 0.0062, "ΗΝΕΞ": 0.0062, "ΝΙΖΕ": 0.0062, "ΟΝΝΑ": 0.0062, "ΡΩΤΑ": 0.0062,
+	"ΕΜΕΤ": 0.0062, "ΤΕΙΟ": 0.0062, "ΠΑΤΗ": 0.0062, "ΙΕΣΤ": 0.0062, "ΧΙΑΣ": 0.0062,
+	"ΧΙΑΔ": 0.0062, "ΑΡΧΑ": 0.0062, "ΡΧΑΙ": 0.0062, "ΝΑΙΥ": 0.0062, "ΣΚΟΣ": 0.0062,
+	"ΗΕΝΗ": 0.0062, "ΝΕΙΜ": 0.0062, "ΑΥΤΑ": 0.0062, "ΡΟΑΙ": 0.0062, "ΟΛΗΓ": 0.0062,
+	"ΛΙΟΘ": 0.0062, "ΝΟΝΤ": 0.0062, "ΗΜΙΑ": 0.0062, "ΘΕΜΑ": 0.0062, "ΑΚΡΟ": 0.0062,
+	"ΝΟΥΚ": 0.0062, "ΗΞΗΣ": 0.0062, "ΕΝΗΔ": 0.0062, "ΚΑΔΙ": 0.0062, "ΟΣΟΡ": 0.0062,
+	"ΗΕΦΑ": 0.0062, "ΙΔΟΘ": 0.0062, "ΟΠΕΔ": 0.0062, "ΡΙΜΑ": 0.0062, "ΙΟΑΝ": 0.0062,
+	"ΑΛΙΣ": 0.0062, "ΣΕΞΑ": 0.0062, "ΔΙΝΕ": 0.0062, "ΣΗΥΠ": 0.0062, "ΙΑΣΗ": 0.0062,
+	"ΙΑΑΡ": 0.0062, "ΤΑΡΤ": 0.0062, "ΣΑΚΟ": 0.0062, "ΕΡΙΝ": 0.0062, "ΝΜΑΡ": 0.0062,
+	"ΠΑΠΟ": 0.0062, "ΟΛΙΒ": 0.0062, "ΙΛΙΑ": 0.0062, "ΣΤΕΠ": 0.0062, "ΕΚΜΙ": 0.0062,
+	"ΟΜΑΛ": 0.0062, "ΜΑΛΤ": 0.0062, "ΛΗΜΕ": 0.0062, "ΒΙΚΗ": 0.0062, "ΚΑΙΚ": 0.0062,
+	"ΕΣΘΟ": 0.0062, "ΣΘΟΝ": 0.0062, "ΘΟΝΙ": 0.0062, "ΣΑΜΕ": 0.0062, "ΠΕΡΣ": 0.0062,
+	"ΕΡΣΙ": 0.0062, "ΑΜΑΤ": 0.0062, "ΚΟΦΥ": 0.0062, "ΟΣΙΑ": 0.0062, "ΕΤΑΔ": 0.0062,
+	"ΝΟΣΕ": 0.0062, "ΦΙΚΟ": 0.0062, "ΑΚΑΜ": 0.0062, "ΣΑΣΣ": 0.0062, "ΥΜΠΛ": 0.0062,
+	"ΣΚΙΩ": 0.0062, "ΚΙΩΔ": 0.0062, "ΥΦΟΣ": 0.0062, "ΟΣΓΡ": 0.0062, "ΥΜΠΑ": 0.0058,
+	"ΙΑΡΙ": 0.0058, "ΕΚΤΙ": 0.0058, "ΟΥΤΙ": 0.0058, "ΚΑΣΤ": 0.0058, "ΟΥΑΔ": 0.0058,
+	"ΥΡΓΟ": 0.0058, "ΜΠΕΚ": 0.0058, "ΑΙΛΑ": 0.0058, "ΤΣΟΥ": 0.0058, "ΛΕΥΘ": 0.0058,
+	"ΝΩΝΠ": 0.0058, "ΣΓΟΥ": 0.0058, "ΗΜΕΘ": 0.0058, "ΔΟΣΠ": 0.0058, "ΛΜΑΔ": 0.0058,
+	"ΡΙΞΗ": 0.0058, "ΚΗΥΠ": 0.0058, "ΕΘΟΥ": 0.0058, "ΚΟΥΥ": 0.0058, "ΝΑΥΠ": 0.0058,
+	"ΙΑΡΚ": 0.0058, "ΣΗΟΝ": 0.0058, "ΤΩΜΕ": 0.0058, "ΙΣΕΑ": 0.0058, "ΟΣΔΟ": 0.0058,
+	"ΟΤΙΟ": 0.0058, "ΩΤΟΚ": 0.0058, "ΚΟΛΛ": 0.0058, "ΜΗΥΠ": 0.0058, "ΗΡΙΣ": 0.0058,
+	"ΤΙΜΕ": 0.0058, "ΑΔΡΑ": 0.0058, "ΟΛΗΔ": 0.0058, "ΧΕΙΔ": 0.0058, "ΥΑΛΛ": 0.0058,
+	"ΗΕΛΕ": 0.0058, "ΑΣΚΕ": 0.0058, "ΕΙΚΤ": 0.0058, "ΡΑΑΠ": 0.0058, "ΚΟΥΔ": 0.0058,
+	"ΑΝΗΣ": 0.0058, "ΡΕΣΙ": 0.0058, "ΡΟΕΡ": 0.0058, "ΕΙΑΑ": 0.0058, "ΥΡΗΑ": 0.0058,
+	"ΡΗΕΠ": 0.0058, "ΙΑΚΕ": 0.0058, "ΠΕΙΔ": 0.0058, "ΡΕΣΑ": 0.0058, "ΚΕΚΑ": 0.0058,
+	"ΔΕΝΘ": 0.0058, "ΟΥΕΧ": 0.0058, "ΗΜΑΙ": 0.0058, "ΤΟΟΡ": 0.0058, "ΓΗΑΠ": 0.0058,
+	"ΤΑΕΙ": 0.0058, "ΝΕΧΟ": 0.0058, "ΕΩΝΣ": 0.0058, "ΝΕΞΟ": 0.0058, "ΝΟΤΕ": 0.0058,
+	"ΑΣΑΡ": 0.0058, "ΟΥΩΣ": 0.0058, "ΙΑΖΟ": 0.0058, "ΥΝΣΕ": 0.0058, "ΙΑΕΥ": 0.0058,
+	"ΥΣΥΜ": 0.0058, "ΓΑΛΥ": 0.0058, "ΕΙΗΕ": 0.0058, "ΑΙΜΗ": 0.0058, "ΙΜΗΔ": 0.0058,
+	"ΚΑΓΙ": 0.0058, "ΣΛΟΓ": 0.0058, "ΙΛΕΞ": 0.0058, "ΕΠΙΒ": 0.0058, "ΙΩΝΠ": 0.0058,
+	"ΗΙΔΙ": 0.0058, "ΚΩΝΔ": 0.0058, "ΕΥΗΣ": 0.0058, "ΝΤΕΡ": 0.0058, "ΗΣΚΙ": 0.0058,
+	"ΕΙΑΜ": 0.0058, "ΤΑΣΚ": 0.0058, "ΗΑΦΑ": 0.0058, "ΤΗΝΔ": 0.0058, "ΗΘΟΥ": 0.0058,
+	"ΜΕΛΟ": 0.0058, "ΙΩΝΚ": 0.0058, "ΣΠΗΓ": 0.0058, "ΑΤΟΕ": 0.0058, "ΚΕΣΑ": 0.0058,
+	"ΧΟΥΑ": 0.0058, "ΟΕΙΤ": 0.0058, "ΝΟΥΜ": 0.0058, "ΟΜΑΕ": 0.0058, "ΗΦΙΑ": 0.0058,
+	"ΕΡΩΝ": 0.0058, "ΙΣΑΝ": 0.0058, "ΑΣΕΝ": 0.0058, "ΧΘΕΙ": 0.0058, "ΟΔΗΜ": 0.0058,
+	"ΥΛΟΥ": 0.0058, "ΚΗΣΔ": 0.0058, "ΓΡΑΨ": 0.0058, "ΥΝΤΗ": 0.0058, "ΙΟΣΤ": 0.0058,
+	"ΑΝΜΙ": 0.0058, "ΜΗΔΙ": 0.0058, "ΟΜΟΝ": 0.0058, "ΙΝΩΝ": 0.0058, "ΣΒΟΗ": 0.0058,
+	"ΧΑΡΤ": 0.0058, "ΤΟΑΛ": 0.0058, "ΟΕΚΤ": 0.0058, "ΓΕΙΩ": 0.0058, "ΓΕΣΟ": 0.0058,
+	"ΕΣΟΙ": 0.0058, "ΥΣΚΑ": 0.0058, "ΥΚΕΝ": 0.0058, "ΩΣΗΔ": 0.0058, "ΙΑΣΛ": 0.0058,
+	"ΑΛΑΓ": 0.0058, "ΑΡΑΣ": 0.0058, "ΑΙΡΝ": 0.0058, "ΚΗΤΙ": 0.0058, "ΛΑΚΑ": 0.0058,
+	"ΚΑΜΑ": 0.0058, "ΤΙΝΗ": 0.0058, "ΣΕΠΟ": 0.0058, "ΣΤΡΙ": 0.0058, "ΕΡΜΠ": 0.0058,
+	"ΠΑΜΠ": 0.0058, "ΑΣΚΙ": 0.0058, "ΒΟΥΛ": 0.0058, "ΟΥΙΑ": 0.0058, "ΥΙΑΝ": 0.0058,
+	"ΛΗΤΟ": 0.0058, "ΤΙΑΣ": 0.0058, "ΛΗΤΩ": 0.0058, "ΧΑΙΑ": 0.0058, "ΑΝΖΑ": 0.0058,
+	"ΝΕΑΓ": 0.0058, "ΙΑΝΙ": 0.0058, "ΙΒΑΝ": 0.0058, "ΣΜΗΕ": 0.0058, "ΑΡΝΗ": 0.0058,
+	"ΗΛΟΓ": 0.0058, "ΙΚΟΒ": 0.0058, "ΟΤΙΤ": 0.0058, "ΡΦΩΣ": 0.0058, "ΝΕΙΧ": 0.0058,
+	"ΚΕΙΤ": 0.0058, "ΡΟΚΕ": 0.0058, "ΑΤΙΟ": 0.0058, "ΝΟΟΥ": 0.0058, "ΤΩΡΑ": 0.0058,
+	"ΤΟΠΛ": 0.0058, "ΓΗΘΕ": 0.0058, "ΔΕΙΤ": 0.0058, "ΙΟΚΑ": 0.0058, "ΠΟΙΩ": 0.0058,
+	"ΝΕΟΣ": 0.0058, "ΑΝΑΟ": 0.0058, "ΟΒΑΛ": 0.0058, "ΣΑΔΙ": 0.0058, "ΚΟΦΩ": 0.0058,
+	"ΑΣΑΔ": 0.0058, "ΥΘΑΠ": 0.0058, "ΑΛΑΣ": 0.0054, "ΑΡΤΑ": 0.0054, "ΕΝΟΒ": 0.0054,
+	"ΝΟΒΑ": 0.0054, "ΑΤΕΜ": 0.0054, "ΔΟΝΙ": 0.0054, "ΤΟΡΙ": 0.0054, "ΡΟΣΚ": 0.0054,
+	"ΕΝΕΤ": 0.0054, "ΣΠΙΣ": 0.0054, "ΠΙΘΑ": 0.0054, "ΙΘΑΝ": 0.0054, "ΕΥΤΗ": 0.0054,
+	"ΙΚΗΥ": 0.0054, "ΝΚΑΝ": 0.0054, "ΣΗΕΚ": 0.0054, "ΔΟΚΗ": 0.0054, "ΟΚΗΤ": 0.0054,
+	"ΑΕΚΔ": 0.0054, "ΟΜΕΑ": 0.0054, "ΠΑΓΚ": 0.0054, "ΑΠΛΟ": 0.0054, "ΤΟΥΥ": 0.0054,
+	"ΣΚΕΤ": 0.0054, "ΝΑΛΙ": 0.0054, "ΣΠΑΚ": 0.0054, "ΣΕΣΥ": 0.0054, "ΦΗΣΠ": 0.0054,
+	"ΟΥΚΩ": 0.0054, "ΥΚΩΔ": 0.0054, "ΗΣΠΕ": 0.0054, "ΟΤΟΝ": 0.0054, "ΠΟΚΛ": 0.0054,
+	"ΗΗΕΓ": 0.0054, "ΑΓΛΩ": 0.0054, "ΣΤΗΠ": 0.0054, "ΗΠΡΕ": 0.0054, "ΑΕΧΕ": 0.0054,
+	"ΥΡΗΔ": 0.0054, "ΥΑΣΜ": 0.0054, "ΠΟΚΡ": 0.0054, "ΥΡΗΣ": 0.0054, "ΥΓΧΡ": 0.0054,
+	"ΓΧΡΟ": 0.0054, "ΝΙΣΜ": 0.0054, "ΣΜΗΝ": 0.0054, "ΗΚΟΥ": 0.0054, "ΥΤΟΣ": 0.0054,
+	"ΙΑΣΓ": 0.0054, "ΟΠΡΕ": 0.0054, "ΛΛΑΤ": 0.0054, "ΟΜΑΟ": 0.0054, "ΥΤΟΠ": 0.0054,
+	"ΕΡΧΕ": 0.0054, "ΡΑΣΣ": 0.0054, "ΠΡΟΩ": 0.0054, "ΡΒΟΛ": 0.0054, "ΝΗΕΠ": 0.0054,
+	"ΚΑΤΗ": 0.0054, "ΙΑΣΧ": 0.0054, "ΡΟΥΟ": 0.0054, "ΑΤΑΓ": 0.0054, "ΟΥΝΚ": 0.0054,
+	"ΝΕΚΔ": 0.0054, "ΡΟΣΧ": 0.0054, "ΑΘΕΤ": 0.0054, "ΑΕΥΡ": 0.0054, "ΑΛΦΑ": 0.0054,
+	"ΦΑΡΙ": 0.0054, "ΙΕΝΗ": 0.0054, "ΟΣΙΟ": 0.0054, "ΝΑΟΝ": 0.0054, "ΗΚΕΕ": 0.0054,
+	"ΤΩΝΧ": 0.0054, "ΚΕΜΕ": 0.0054, "ΣΗΧΡ": 0.0054, "ΙΩΝΜ": 0.0054, "ΑΤΟΜ": 0.0054,
+	"ΙΟΠΑ": 0.0054, "ΕΝΑΓ": 0.0054, "ΑΤΕΤ": 0.0054, "ΕΣΦΑ": 0.0054, "ΠΟΣΑ": 0.0054,
+	"ΟΥΕΥ": 0.0054, "ΥΕΥΡ": 0.0054, "ΙΟΣΑ": 0.0054, "ΙΑΠΛ": 0.0054, "ΗΡΗΣ": 0.0054,
+	"ΙΑΡΥ": 0.0054, "ΓΝΟΗ": 0.0054, "ΟΙΚΑ": 0.0054, "ΙΛΑΜ": 0.0054, "ΛΛΑΕ": 0.0054,
+	"ΗΚΕΓ": 0.0054, "ΚΕΓΙ": 0.0054, "ΡΕΞΕ": 0.0054, "ΟΠΗΓ": 0.0054, "ΕΣΗΠ": 0.0054,
+	"ΣΗΜΙ": 0.0054, "ΩΝΥΜ": 0.0054, "ΓΗΣΣ": 0.0054, "ΙΗΤΗ": 0.0054, "ΠΟΣΕ": 0.0054,
+	"ΤΗΠΕ": 0.0054, "ΤΗΔΗ": 0.0054, "ΧΗΜΕ": 0.0054, "ΙΤΕΛ": 0.0054, "ΠΟΚΑ": 0.0054,
+	"ΗΡΙΑ": 0.0054, "ΕΤΕΠ": 0.0054, "ΟΛΙΟ": 0.0054, "ΤΟΣΓ": 0.0054, "ΟΥΛΕ": 0.0054,
+	"ΡΑΣΜ": 0.0054, "ΑΣΠΡ": 0.0054, "ΜΑΟΡ": 0.0054, "ΕΤΑΜ": 0.0054, "ΣΕΠΕ": 0.0054,
+	"ΡΧΟΝ": 0.0054, "ΗΣΡΟ": 0.0054, "ΣΡΟΗ": 0.0054, "ΦΑΙΝ": 0.0054, "ΕΙΜΙ": 0.0054,
+	"ΙΑΤΙ": 0.0054, "ΑΔΑΕ": 0.0054, "ΝΕΝΕ": 0.0054, "ΚΑΑΝ": 0.0054, "ΣΣΤΑ": 0.0054,
+	"ΙΤΙΣ": 0.0054, "ΜΟΓΕ": 0.0054, "ΝΩΝΕ": 0.0054, "ΝΟΚΑ": 0.0054, "ΝΑΔΑ": 0.0054,
+	"ΗΑΔΥ": 0.0054, "ΙΩΣΕ": 0.0054, "ΟΛΕΠ": 0.0054, "ΩΝΥΠ": 0.0054, "ΛΑΓΙ": 0.0054,
+	"ΤΑΓΙ": 0.0054, "ΤΡΙΤ": 0.0054, "ΠΤΟΥ": 0.0054, "ΥΔΑΙ": 0.0054, "ΙΡΑΚ": 0.0054,
+	"ΑΙΤΖ": 0.0054, "ΡΑΜΑ": 0.0054, "ΕΝΙΑ": 0.0054, "ΤΡΙΑ": 0.0054, "ΑΖΕΡ": 0.0054,
+	"ΣΑΜΠ": 0.0054, "ΒΡΑΖ": 0.0054, "ΡΑΖΙ": 0.0054, "ΑΖΙΛ": 0.0054, "ΖΙΛΙ": 0.0054,
+	"ΟΥΛΓ": 0.0054, "ΥΛΓΑ": 0.0054, "ΛΓΑΡ": 0.0054, "ΝΔΟΝ": 0.0054, "ΔΟΝΗ": 0.0054,
+	"ΑΧΙΛ": 0.0054, "ΚΟΑΤ": 0.0054, "ΡΘΕΝ": 0.0054, "ΝΗΣΩ": 0.0054, "ΚΙΑΣ": 0.0054,
+	"ΕΧΙΚ": 0.0054, "ΤΟΝΓ": 0.0054, "ΣΣΑΟ": 0.0054, "ΣΣΑΓ": 0.0054, "ΑΟΥΡ": 0.0054,
+	"ΤΑΝΖ": 0.0054, "ΝΖΑΝ": 0.0054, "ΖΑΝΙ": 0.0054, "ΝΑΚΕ": 0.0054, "ΙΑΛΑ": 0.0054,
+	"ΕΚΚΑ": 0.0054, "ΒΙΕΤ": 0.0054, "ΣΧΕΔ": 0.0054, "ΧΕΔΙ": 0.0054, "ΙΚΟΛ": 0.0054,
+	"ΛΕΚΤ": 0.0054, "ΝΕΣΜ": 0.0054, "ΠΑΤΙ": 0.0054, "ΣΤΕΑ": 0.0054, "ΩΝΟΠ": 0.0054,
+	"ΜΥΣΤ": 0.0054, "ΓΗΘΗ": 0.0054, "ΛΗΓΕ": 0.0054, "ΓΗΤΗ": 0.0054, "ΑΣΚΛ": 0.0054,
+	"ΟΤΡΙ": 0.0054, "ΜΠΛΗ": 0.0054, "ΥΝΕΟ": 0.0054, "ΗΝΕΑ": 0.0054, "ΚΟΕΛ": 0.0054,
+	"ΙΣΥΓ": 0.0054, "ΜΗΡΥ": 0.0054, "ΚΑΝΙ": 0.0050, "ΛΑΝΤ": 0.0050, "ΓΚΑΣ": 0.0050,
+	"ΥΡΑΣ": 0.0050, "ΡΟΚΑ": 0.0050, "ΜΕΙΝ": 0.0050, "ΣΕΤΗ": 0.0050, "ΡΕΙΕ": 0.0050,
+	"ΕΔΑΦ": 0.0050, "ΣΠΡΙ": 0.0050, "ΔΑΒΙ": 0.0050, "ΛΟΥΚ": 0.0050, "ΤΡΑΛ": 0.0050,
+	"ΝΕΣΣ": 0.0050, "ΤΕΞΑ": 0.0050, "ΝΠΟΛ": 0.0050, "ΠΙΚΑ": 0.0050, "ΙΚΑΟ": 0.0050,
+	"ΣΤΟΧ": 0.0050, "ΑΛΗΦ": 0.0050, "ΑΙΑΛ": 0.0050, "ΔΙΑΠ": 0.0050, "ΣΤΕΥ": 0.0050,
+	"ΝΗΣΤ": 0.0050, "ΙΠΕΡ": 0.0050, "ΣΜΑΣ": 0.0050, "ΙΤΙΜ": 0.0050, "ΙΟΧΕ": 0.0050,
+	"ΑΠΑΣ": 0.0050, "ΛΛΑΑ": 0.0050, "ΣΕΤΟ": 0.0050, "ΗΣΕΛ": 0.0050, "ΑΙΗΑ": 0.0050,
+	"ΔΟΧΕ": 0.0050, "ΟΧΕΑ": 0.0050, "ΙΤΟΝ": 0.0050, "ΑΤΗΑ": 0.0050, "ΤΗΑΠ": 0.0050,
+	"ΞΤΕΤ": 0.0050, "ΗΣΜΗ": 0.0050, "ΗΤΟΠ": 0.0050, "ΕΙΑΡ": 0.0050, "ΣΔΙΚ": 0.0050,
+	"ΙΜΕΓ": 0.0050, "ΕΡΗΣ": 0.0050, "ΕΙΣΜ": 0.0050, "ΦΘΕΙ": 0.0050, "ΤΑΣΑ": 0.0050,
+	"ΠΙΠΛ": 0.0050, "ΝΟΥΠ": 0.0050, "ΜΗΕΙ": 0.0050, "ΙΑΚΥ": 0.0050, "ΤΑΓΛ": 0.0050,
+	"ΓΛΩΤ": 0.0050, "ΝΑΕΧ": 0.0050, "ΠΑΡΚ": 0.0050, "ΝΑΣΕ": 0.0050, "ΣΜΕΓ": 0.0050,
+	"ΡΙΒΩ": 0.0050, "ΗΜΗΕ": 0.0050, "ΚΗΠΑ": 0.0050, "ΜΟΣΜ": 0.0050, "ΟΣΧΗ": 0.0050,
+	"ΤΟΣΔ": 0.0050, "ΑΣΕΑ": 0.0050, "ΤΗΝΣ": 0.0050, "ΝΠΡΕ": 0.0050, "ΥΕΧΕ": 0.0050,
+	"ΝΗΚΕ": 0.0050, "ΗΚΕΙ": 0.0050, "ΤΑΧΡ": 0.0050, "ΛΜΑΠ": 0.0050, "ΑΠΛΑ": 0.0050,
+	"ΤΕΠΑ": 0.0050, "ΜΑΙΝ": 0.0050, "ΝΕΙΟ": 0.0050, "ΑΑΠΑ": 0.0050, "ΤΗΗΤ": 0.0050,
+	"ΕΠΟΝ": 0.0050, "ΜΟΣΑ": 0.0050, "ΑΙΟΙ": 0.0050, "ΑΤΗΓ": 0.0050, "ΗΓΟΡ": 0.0050,
+	"ΟΠΡΩ": 0.0050, "ΝΟΛΑ": 0.0050, "ΑΙΕΞ": 0.0050, "ΩΝΩΣ": 0.0050, "ΙΣΕΝ": 0.0050,
+	"ΕΡΟΑ": 0.0050, "ΗΑΡΙ": 0.0050, "ΛΦΑΡ": 0.0050, "ΤΗΕΠ": 0.0050, "ΝΕΩΣ": 0.0050,
+	"ΑΛΩΕ": 0.0050, "ΟΜΑΓ": 0.0050, "ΟΔΙΣ": 0.0050, "ΩΝΚΛ": 0.0050, "ΝΠΛΗ": 0.0050,
+	"ΕΡΕΤ": 0.0050, "ΓΗΕΙ": 0.0050, "ΩΣΗΠ": 0.0050, "ΟΝΟΑ": 0.0050, "ΝΑΤΕ": 0.0050,
+	"ΗΤΗΔ": 0.0050, "ΑΕΚΧ": 0.0050, "ΙΣΗΕ": 0.0050, "ΑΣΥΣ": 0.0050, "ΗΝΟΜ": 0.0050,
+	"ΙΑΦΑ": 0.0050, "ΔΩΣΕ": 0.0050, "ΕΣΥΝ": 0.0050, "ΙΟΑΠ": 0.0050, "ΙΑΡΑ": 0.0050,
+	"ΠΟΥΚ": 0.0050, "ΤΕΙΝ": 0.0050, "ΚΛΗΘ": 0.0050, "ΝΤΥΠ": 0.0050, "ΤΕΙΜ": 0.0050,
+	"ΤΕΚΤ": 0.0050, "ΙΚΑΛ": 0.0050, "ΙΝΑΤ": 0.0050, "ΤΕΜΕ": 0.0050, "ΙΔΙΣ": 0.0050,
+	"ΑΕΝΟ": 0.0050, "ΔΗΠΟ": 0.0050, "ΗΠΟΤ": 0.0050, "ΑΙΗΔ": 0.0050, "ΗΔΗΟ": 0.0050,
+	"ΤΑΠΟ": 0.0050, "ΑΧΘΕ": 0.0050, "ΔΟΥΜ": 0.0050, "ΜΙΑΥ": 0.0050, "ΡΙΟΧ": 0.0050,
+	"ΠΗΣΗ": 0.0050, "ΡΙΟΡ": 0.0050, "ΦΟΤΕ": 0.0050, "ΩΣΜΕ": 0.0050, "ΝΟΑΛ": 0.0050,
+	"ΑΚΟΔ": 0.0050, "ΠΩΜΕ": 0.0050, "ΗΣΗΧ": 0.0050, "ΚΤΗΤ": 0.0050, "ΤΑΟΝ": 0.0050,
+	"ΙΑΛΙ": 0.0050, "ΗΨΗΤ": 0.0050, "ΕΙΑΓ": 0.0050, "ΑΙΤΙ": 0.0050, "ΕΣΠΕ": 0.0050,
+	"ΝΩΝΤ": 0.0050, "ΕΚΡΙ": 0.0050, "ΚΑΝΤ": 0.0050, "ΕΡΑΝ": 0.0050, "ΟΔΕΥ": 0.0050,
+	"ΥΜΠΟ": 0.0050, "ΔΟΘΕ": 0.0050, "ΟΥΘΙ": 0.0050, "ΠΑΙΡ": 0.0050, "ΙΡΝΕ": 0.0050,
+	"ΡΝΕΙ": 0.0050, "ΗΣΗΗ": 0.0050, "ΤΗΗΣ": 0.0050, "ΗΗΣΥ": 0.0050, "ΓΑΝΙ": 0.0050,
+	"ΑΦΡΙ": 0.0050, "ΦΡΙΚ": 0.0050, "ΓΗΡΙ": 0.0050, "ΠΑΧΑ": 0.0050, "ΩΝΜΑ": 0.0050,
+	"ΟΜΑΝ": 0.0050, "ΝΤΙΝ": 0.0050, "ΕΝΓΚ": 0.0050, "ΣΣΑΒ": 0.0050, "ΙΚΑΡ": 0.0050,
+	"ΙΔΑΔ": 0.0050, "ΣΡΙΛ": 0.0050, "ΙΜΑΙ": 0.0050, "ΚΟΥΒ": 0.0050, "ΙΚΑΔ": 0.0050,
+	"ΡΟΓΛ": 0.0050, "ΑΜΑΣ": 0.0050, "ΑΙΝΔ": 0.0050, "ΑΤΕΝ": 0.0050, "ΑΛΤΑ": 0.0050,
+	"ΟΥΑΚ": 0.0050, "ΟΤΕΠ": 0.0050, "ΟΓΚΟ": 0.0050, "ΟΥΑΗ": 0.0050, "ΑΟΛΟ": 0.0050,
+	"ΑΚΙΚ": 0.0050, "ΤΡΟΝ": 0.0050, "ΟΥΓΚ": 0.0050, "ΡΝΗΤ": 0.0050, "ΟΡΥΘ": 0.0050,
+	"ΤΙΤΛ": 0.0050, "ΕΚΤΡ": 0.0050, "ΜΑΔΩ": 0.0050, "ΕΤΑΕ": 0.0050, "ΡΗΤΟ": 0.0050,
+	"ΠΙΝΕ": 0.0050, "ΟΙΟΣ": 0.0050, "ΧΙΚΗ": 0.0050, "ΚΟΠΡ": 0.0050, "ΠΟΑΥ": 0.0050,
+	"ΕΣΤΙ": 0.0050, "ΒΕΛΓ": 0.0050, "ΕΛΓΙ": 0.0050, "ΝΗΔΗ": 0.0050, "ΑΜΙΚ": 0.0050,
+	"ΣΔΗΜ": 0.0050, "ΔΟΤΟ": 0.0050, "ΙΣΝΑ": 0.0050, "ΩΣΚΑ": 0.0050, "ΥΟΡΙ": 0.0050,
+	"ΕΡΟΣ": 0.0050, "ΕΣΟΜ": 0.0050, "ΔΙΑΛ": 0.0050, "ΟΙΑΛ": 0.0050, "ΔΙΕΧ": 0.0050,
+	"ΕΙΠΟ": 0.0050, "ΕΩΣΗ": 0.0050, "ΔΕΜΠ": 0.0050, "ΟΣΣΑ": 0.0050, "ΗΡΩΜ": 0.0050,
+	"ΡΑΤΗ": 0.0050, "ΕΝΟΛ": 0.0050, "ΑΕΝΗ": 0.0050, "ΕΟΥΑ": 0.0050, "ΤΗΛΟ": 0.0050,
+	"ΣΑΣΠ": 0.0050, "ΟΙΩΝ": 0.0050, "ΑΣΥΡ": 0.0050, "ΕΘΝΕ": 0.0050, "ΘΝΕΣ": 0.0050,
+	"ΤΑΝΗ": 0.0046, "ΚΑΛΑ": 0.0046, "ΣΙΚΗ": 0.0046, "ΕΛΑΟ": 0.0046, "ΙΜΠΟ": 0.0046,
+	"ΓΚΑΜ": 0.0046, "ΤΕΜΑ": 0.0046, "ΙΓΟΥ": 0.0046, "ΖΙΑΝ": 0.0046, "ΙΖΟΥ": 0.0046,
+	"ΡΥΝΣ": 0.0046, "ΕΞΙΚ": 0.0046, "ΥΑΛΙ": 0.0046, "ΣΜΑΡ": 0.0046, "ΑΦΟΣ": 0.0046,
+	"ΟΡΙΚ": 0.0046, "ΜΙΝΙ": 0.0046, "ΙΟΣΜ": 0.0046, "ΤΑΣΜ": 0.0046, "ΚΟΤΙ": 0.0046,
+	"ΤΖΙΝ": 0.0046, "ΟΜΙΝ": 0.0046, "ΛΛΑΟ": 0.0046, "ΟΕΛΕ": 0.0046, "ΕΧΙΣ": 0.0046,
+	"ΕΣΠΛ": 0.0046, "ΙΟΤΙ": 0.0046, "ΚΩΝΠ": 0.0046, "ΕΡΒΑ": 0.0046, "ΔΡΑΝ": 0.0046,
+	"ΟΛΕΙ": 0.0046, "ΗΠΙΣ": 0.0046, "ΔΙΩΤ": 0.0046, "ΙΩΤΙ": 0.0046, "ΧΟΥΤ": 0.0046,
+	"ΔΕΝΧ": 0.0046, "ΕΝΧΡ": 0.0046, "ΗΣΠΙ": 0.0046, "ΕΙΛΕ": 0.0046, "ΤΗΛΗ": 0.0046,
+	"ΑΝΘΑ": 0.0046, "ΟΝΕΝ": 0.0046, "ΠΑΛΗ": 0.0046, "ΗΘΕΥ": 0.0046, "ΟΣΚΕ": 0.0046,
+	"ΣΤΗΤ": 0.0046, "ΗΕΝΑ": 0.0046, "ΑΞΕΙ": 0.0046, "ΡΑΓΜ": 0.0046, "ΕΛΑΧ": 0.0046,
+	"ΕΑΣΕ": 0.0046, "ΤΗΗΜ": 0.0046, "ΤΑΦΡ": 0.0046, "ΑΦΡΑ": 0.0046, "ΗΦΘΕ": 0.0046,
+	"ΗΝΠΑ": 0.0046, "ΣΕΤΙ": 0.0046, "ΙΒΩΣ": 0.0046, "ΚΟΣΧ": 0.0046, "ΤΑΠΕ": 0.0046,
+	"ΥΝΜΕ": 0.0046, "ΝΑΔΡ": 0.0046, "ΕΝΘΑ": 0.0046, "ΗΣΕΣ": 0.0046, "ΟΝΤΡ": 0.0046,
+	"ΥΣΑΠ": 0.0046, "ΙΟΠΙ": 0.0046, "ΤΗΓΙ": 0.0046, "ΥΤΗΗ": 0.0046, "ΡΙΟΔ": 0.0046,
+	"ΣΛΕΙ": 0.0046, "ΟΝΟΓ": 0.0046, "ΔΗΛΩ": 0.0046, "ΝΟΣΧ": 0.0046, "ΛΟΥΑ": 0.0046,
+	"ΞΑΝΤ": 0.0046, "ΑΝΤΛ": 0.0046, "ΝΤΛΗ": 0.0046, "ΚΥΡΕ": 0.0046, "ΤΗΓΟ": 0.0046,
+	"ΥΟΜΕ": 0.0046, "ΟΥΤΡ": 0.0046, "ΔΟΣΔ": 0.0046, "ΑΤΑΥ": 0.0046, "ΟΛΟΙ": 0.0046,
+	"ΥΝΚΑ": 0.0046, "ΠΕΚΤ": 0.0046, "ΗΚΗΤ": 0.0046, "ΑΙΑΥ": 0.0046, "ΗΧΑΡ": 0.0046,
+	"ΙΑΟΡ": 0.0046, "ΥΠΛΑ": 0.0046, "ΟΥΔΗ": 0.0046, "ΤΑΙΔ": 0.0046, "ΟΛΙΑ": 0.0046,
+	"ΣΘΕΣ": 0.0046, "ΗΣΑΤ": 0.0046, "ΑΕΝΕ": 0.0046, "ΗΨΗΑ": 0.0046, "ΣΙΑΠ": 0.0046,
+	"ΡΟΧΟ": 0.0046, "ΠΗΓΕ": 0.0046, "ΗΓΕΣ": 0.0046, "ΕΙΠΑ": 0.0046, "ΟΝΟΔ": 0.0046,
+	"ΣΙΑΕ": 0.0046, "ΑΕΜΦ": 0.0046, "ΝΕΜΦ": 0.0046, "ΜΗΠΡ": 0.0046, "ΗΚΕΔ": 0.0046,
+	"ΠΑΡΩ": 0.0046, "ΚΑΚΗ": 0.0046, "ΟΜΑΧ": 0.0046, "ΣΕΓΙ": 0.0046, "ΙΕΣΠ": 0.0046,
+	"ΗΣΥΣ": 0.0046, "ΙΜΩΝ": 0.0046, "ΙΑΤΕ": 0.0046, "ΦΗΣΕ": 0.0046, "ΗΚΑΘ": 0.0046,
+	"ΕΜΟΝ": 0.0046, "ΗΚΗΕ": 0.0046, "ΟΥΕΞ": 0.0046, "ΘΕΙΕ": 0.0046, "ΕΑΑΡ": 0.0046,
+	"ΡΑΓΩ": 0.0046, "ΑΙΟΤ": 0.0046, "ΙΚΛΕ": 0.0046, "ΕΩΝΑ": 0.0046, "ΧΕΙΥ": 0.0046,
+	"ΝΑΦΑ": 0.0046, "ΥΤΟΔ": 0.0046, "ΣΑΣΦ": 0.0046, "ΣΗΚΛ": 0.0046, "ΙΝΑΝ": 0.0046,
+	"ΡΩΜΕ": 0.0046, "ΠΟΥΘ": 0.0046, "ΔΗΟΡ": 0.0046, "ΣΤΙΜ": 0.0046, "ΑΣΣΕ": 0.0046,
+	"ΟΘΕΜ": 0.0046, "ΙΤΑΥ": 0.0046, "ΕΝΟΟ": 0.0046, "ΝΟΟΝ": 0.0046, "ΩΣΙΜ": 0.0046,
+	"ΑΝΥΠ": 0.0046, "ΥΣΣΥ": 0.0046, "ΝΗΔΙ": 0.0046, "ΡΡΙΦ": 0.0046, "ΡΙΦΘ": 0.0046,
+	"ΑΦΗΕ": 0.0046, "ΑΦΟΤ": 0.0046, "ΝΜΙΑ": 0.0046, "ΟΚΕΝ": 0.0046, "ΙΟΚΕ": 0.0046,
+	"ΠΟΜΠ": 0.0046, "ΟΜΠΗ": 0.0046, "ΝΔΕΜ": 0.0046, "ΕΝΩΣ": 0.0046, "ΗΣΜΙ": 0.0046,
+	"ΘΗΤΙ": 0.0046, "ΠΕΝΑ": 0.0046, "ΤΕΥΘ": 0.0046, "ΟΛΛΕ": 0.0046, "ΜΕΠΕ": 0.0046,
+	"ΟΝΟΠ": 0.0046, "ΕΣΔΕ": 0.0046, "ΝΕΦΑ": 0.0046, "ΜΕΣΕ": 0.0046, "ΟΥΝΟ": 0.0046,
+	"ΜΗΕΠ": 0.0046, "ΟΕΙΣ": 0.0046, "ΟΛΥΤ": 0.0046, "ΟΜΗΔ": 0.0046, "ΙΔΙΔ": 0.0046,
+	"ΑΣΥΠ": 0.0046, "ΥΣΑΝ": 0.0046, "ΥΘΙΑ": 0.0046, "ΟΥΛΙ": 0.0046, "ΑΦΓΑ": 0.0046,
+	"ΦΓΑΝ": 0.0046, "ΣΑΧΑ": 0.0046, "ΡΑΙΚ": 0.0046, "ΑΜΑΝ": 0.0046, "ΑΙΜΠ": 0.0046,
+	"ΠΑΣΚ": 0.0046, "ΟΤΑΜ": 0.0046, "ΣΑΙΝ": 0.0046, "ΡΜΠΑ": 0.0046, "ΑΔΟΚ": 0.0046,
+	"ΛΤΕΠ": 0.0046, "ΟΛΟΜ": 0.0046, "ΟΥΑΧ": 0.0046, "ΥΑΧΙ": 0.0046, "ΧΙΛΙ": 0.0046,
+	"ΠΑΡΘ": 0.0046, "ΑΡΘΕ": 0.0046, "ΛΑΝΚ": 0.0046, "ΠΟΜΟ": 0.0046, "ΑΙΑΑ": 0.0046,
+	"ΕΤΕΟ": 0.0046, "ΟΝΟΗ": 0.0046, "ΚΕΝΥ": 0.0046, "ΕΝΥΑ": 0.0046, "ΣΑΜΑ": 0.0046,
+	"ΣΟΙΚ": 0.0046, "ΑΥΡΙ": 0.0046, "ΜΙΑΝ": 0.0046, "ΙΝΟΤ": 0.0046, "ΟΝΤΙ": 0.0046,
+	"ΣΣΙΚ": 0.0046, "ΣΑΝΕ": 0.0046, "ΛΟΒΕ": 0.0046, "ΟΒΕΝ": 0.0046, "ΒΕΝΙ": 0.0046,
+	"ΑΜΙΛ": 0.0046, "ΚΑΤΟ": 0.0046, "ΙΕΤΝ": 0.0046, "ΕΤΝΑ": 0.0046, "ΤΝΑΜ": 0.0046,
+	"ΑΖΙΜ": 0.0046, "ΚΑΓΡ": 0.0046, "ΣΚΗΝ": 0.0046, "ΘΕΝΤ": 0.0046, "ΦΟΡΗ": 0.0046,
+	"ΕΓΡΑ": 0.0046, "ΟΑΠΕ": 0.0046, "ΑΝΑΡ": 0.0046, "ΕΔΟΑ": 0.0046, "ΤΕΧΝ": 0.0046,
+	"ΑΣΤΩ": 0.0046, "ΞΕΙΣ": 0.0046, "ΣΥΛΛ": 0.0046, "ΕΔΟΤ": 0.0046, "ΙΝΟΑ": 0.0046,
+	"ΝΝΟΟ": 0.0046, "ΓΚΛΑ": 0.0046, "ΒΕΡΙ": 0.0046, "ΑΙΝΟ": 0.0046, "ΑΙΚΟ": 0.0046,
+	"ΕΣΓΡ": 0.0046, "ΤΕΜΗ": 0.0046, "ΒΡΟΧ": 0.0046, "ΙΟΘΕ": 0.0046, "ΕΙΛΗ": 0.0046,
+	"ΤΙΣΔ": 0.0046, "ΙΣΑΣ": 0.0046, "ΚΟΑΝ": 0.0046, "ΝΟΣΥ": 0.0046, "ΣΝΕΟ": 0.0046,
+	"ΤΥΧΗ": 0.0046, "ΦΛΟΙ": 0.0046, "ΛΟΙΟ": 0.0046, "ΣΥΠΑ": 0.0046, "ΕΜΗΣ": 0.0046,
+	"ΡΑΠΕ": 0.0046, "ΔΥΟΠ": 0.0046, "ΙΤΟΔ": 0.0046, "ΟΝΑΓ": 0.0046, "ΟΕΛΒ": 0.0046,
+	"ΣΥΡΜ": 0.0046, "ΥΡΜΑ": 0.0046, "ΕΡΑΤ": 0.0042, "ΑΜΠΕ": 0.0042, "ΕΡΙΦ": 0.0042,
+	"ΗΣΚΟ": 0.0042, "ΤΖΙΜ": 0.0042, "ΓΟΥΡ": 0.0042, "ΑΡΤΙ": 0.0042, "ΡΑΣΚ": 0.0042,
+	"ΝΙΟΥ": 0.0042, "ΞΙΚΟ": 0.0042, "ΣΝΗΣ": 0.0042, "ΝΤΑΡ": 0.0042, "ΠΑΡΜ": 0.0042,
+	"ΣΚΑΡ": 0.0042, "ΙΑΕΛ": 0.0042, "ΣΤΑΡ": 0.0042, "ΔΕΣΤ": 0.0042, "ΤΩΝΗ": 0.0042,
+	"ΟΥΙΣ": 0.0042, "ΧΕΤΑ": 0.0042, "ΣΤΗΑ": 0.0042, "ΛΩΣΗ": 0.0042, "ΑΝΙΧ": 0.0042,
+	"ΝΙΧΝ": 0.0042, "ΧΝΕΥ": 0.0042, "ΥΠΙΣ": 0.0042, "ΕΝΣΩ": 0.0042, "ΝΣΩΜ": 0.0042,
+	"ΣΕΑΠ": 0.0042, "ΟΣΗΠ": 0.0042, "ΣΑΚΕ": 0.0042, "ΔΙΟΧ": 0.0042, "ΕΝΩΑ": 0.0042,
+	"ΥΡΟΜ": 0.0042, "ΗΨΗΠ": 0.0042, "ΠΕΛΑ": 0.0042, "ΑΣΠΟ": 0.0042, "ΗΧΩΡ": 0.0042,
+	"ΙΥΠΕ": 0.0042, "ΝΑΣΚ": 0.0042, "ΛΑΝΘ": 0.0042, "ΝΟΙΚ": 0.0042, "ΕΨΕΙ": 0.0042,
+	"ΕΡΜΗ": 0.0042, "ΡΜΗΝ": 0.0042, "ΠΡΑΓ": 0.0042, "ΑΓΜΑ": 0.0042, "ΛΗΔΕ": 0.0042,
+	"ΟΥΕΚ": 0.0042, "ΙΣΚΑ": 0.0042, "ΙΑΜΗ": 0.0042, "ΜΗΑΠ": 0.0042, "ΗΚΕΟ": 0.0042,
+	"ΤΑΛΛ": 0.0042, "ΝΗΥΠ": 0.0042, "ΛΩΤΤ": 0.0042, "ΩΤΤΙ": 0.0042, "ΤΤΙΣ": 0.0042,
+	"ΙΑΜΙ": 0.0042, "ΝΗΣΥ": 0.0042, "ΦΗΔΙ": 0.0042, "ΣΕΜΗ": 0.0042, "ΙΑΚΡ": 0.0042,
+	"ΕΝΑΧ": 0.0042, "ΝΠΡΩ": 0.0042, "ΥΡΗΠ": 0.0042, "ΥΡΗΤ": 0.0042, "ΡΗΤΙ": 0.0042,
+	"ΟΜΕΚ": 0.0042, "ΟΣΥΓ": 0.0042, "ΥΜΗΝ": 0.0042, "ΟΙΕΝ": 0.0042, "ΑΣΕΕ": 0.0042,
+	"ΕΚΑΝ": 0.0042, "ΣΗΠΕ": 0.0042, "ΟΣΤΩ": 0.0042, "ΑΠΡΩ": 0.0042, "ΕΣΗΑ": 0.0042,
+	"ΣΗΑΛ": 0.0042, "ΙΣΕΚ": 0.0042, "ΕΕΙΣ": 0.0042, "ΟΓΗΓ": 0.0042, "ΝΤΑΞ": 0.0042,
+	"ΡΟΦΗ": 0.0042, "ΦΗΣΣ": 0.0042, "ΥΕΡΓ": 0.0042, "ΚΩΝΣ": 0.0042, "ΥΤΩΝ": 0.0042,
+	"ΩΝΕΞ": 0.0042, "ΛΑΣΗ": 0.0042, "ΤΑΤΙ": 0.0042, "ΧΙΣΕ": 0.0042, "ΗΓΩΝ": 0.0042,
+	"ΑΠΗΓ": 0.0042, "ΗΣΚΛ": 0.0042, "ΝΚΛΕ": 0.0042, "ΜΗΣΣ": 0.0042, "ΗΣΣΑ": 0.0042,
+	"ΛΗΣΤ": 0.0042, "ΙΟΜΠ": 0.0042, "ΙΒΕΒ": 0.0042, "ΝΗΓΙ": 0.0042, "ΙΑΘΑ": 0.0042,
+	"ΟΥΝΣ": 0.0042, "ΙΤΩΝ": 0.0042, "ΤΟΙΟ": 0.0042, "ΝΟΝΟ": 0.0042, "ΑΞΕΤ": 0.0042,
+	"ΟΨΗΦ": 0.0042, "ΕΠΛΗ": 0.0042, "ΜΑΠΕ": 0.0042, "ΦΕΑΑ": 0.0042, "ΕΞΩΤ": 0.0042,
+	"ΞΩΤΕ": 0.0042, "ΣΑΠΕ": 0.0042, "ΚΟΜΗ": 0.0042, "ΟΜΟΙ": 0.0042, "ΘΩΣΕ": 0.0042,
+	"ΘΕΙΚ": 0.0042, "ΡΜΟΣ": 0.0042, "ΗΣΜΟ": 0.0042, "ΟΕΞΑ": 0.0042, "ΝΟΗΘ": 0.0042,
+	"ΑΝΠΑ": 0.0042, "ΓΕΣΑ": 0.0042, "ΥΣΗΕ": 0.0042, "ΡΧΙΖ": 0.0042, "ΔΗΓΟ": 0.0042,
+	"ΝΕΣΠ": 0.0042, "ΕΤΣΙ": 0.0042, "ΥΝΑΛ": 0.0042, "ΝΟΧΡ": 0.0042, "ΘΕΤΑ": 0.0042,
+	"ΛΗΑΠ": 0.0042, "ΚΡΙΝ": 0.0042, "ΕΝΠΕ": 0.0042, "ΝΩΝΥ": 0.0042, "ΙΜΗΤ": 0.0042,
+	"ΤΟΔΗ": 0.0042, "ΔΙΑΥ": 0.0042, "ΙΑΥΛ": 0.0042, "ΑΥΛΟ": 0.0042, "ΙΑΧΡ": 0.0042,
+	"ΙΟΧΗ": 0.0042, "ΤΗΣΧ": 0.0042, "ΣΔΙΣ": 0.0042, "ΕΝΔΟ": 0.0042, "ΔΟΣΚ": 0.0042,
+	"ΝΓΡΑ": 0.0042, "ΤΑΝΜ": 0.0042, "ΛΑΣΙ": 0.0042, "ΑΚΗΔ": 0.0042, "ΚΤΕΤ": 0.0042,
+	"ΗΧΡΟ": 0.0042, "ΕΛΤΙ": 0.0042, "ΟΜΗΑ": 0.0042, "ΗΘΗΤ": 0.0042, "ΗΦΙΟ": 0.0042,
+	"ΙΜΑΚ": 0.0042, "ΤΗΣΡ": 0.0042, "ΗΣΕΞ": 0.0042, "ΜΙΑΤ": 0.0042, "ΝΔΙΝ": 0.0042,
+	"ΡΙΚΕ": 0.0042, "ΤΟΣΩ": 0.0042, "ΥΓΚΕ": 0.0042, "ΓΚΕΚ": 0.0042, "ΚΕΚΡ": 0.0042,
+	"ΥΡΓΕ": 0.0042, "ΕΚΤΗ": 0.0042, "ΑΣΑΣ": 0.0042, "ΑΙΑΕ": 0.0042, "ΥΠΡΙ": 0.0042,
+	"ΕΡΟΛ": 0.0042, "ΣΚΙΝ": 0.0042, "ΥΝΙΟ": 0.0042, "ΔΕΣΠ": 0.0042, "ΩΘΟΥ": 0.0042,
+	"ΜΕΧΡ": 0.0042, "ΦΑΝΕ": 0.0042, "ΑΜΙΣ": 0.0042, "ΑΛΓΕ": 0.0042, "ΛΓΕΡ": 0.0042,
+	"ΓΕΡΙ": 0.0042, "ΘΙΒΕ": 0.0042, "ΙΒΕΤ": 0.0042, "ΧΙΝΤ": 0.0042, "ΚΥΠΡ": 0.0042,
+	"ΟΚΟΥ": 0.0042, "ΣΟΠΟ": 0.0042, "ΟΠΟΤ": 0.0042, "ΑΣΟΥ": 0.0042, "ΤΥΝΗ": 0.0042,
+	"ΑΓΕΝ": 0.0042, "ΤΑΛΑ": 0.0042, "ΙΛΗΣ": 0.0042, "ΟΣΟΥ": 0.0042, "ΖΑΜΑ": 0.0042,
+	"ΗΤΟΜ": 0.0042, "ΤΡΙΝ": 0.0042, "ΗΣΩΝ": 0.0042, "ΦΡΑΓ": 0.0042, "ΟΥΚΟ": 0.0042,
+	"ΝΔΟΥ": 0.0042, "ΝΓΚΚ": 0.0042, "ΓΚΚΟ": 0.0042, "ΥΠΑΝ": 0.0042, "ΑΓΑΣ": 0.0042,
+	"ΜΟΛΔ": 0.0042, "ΟΛΔΑ": 0.0042, "ΛΔΑΒ": 0.0042, "ΟΗΠΑ": 0.0042, "ΠΑΚΙ": 0.0042,
+	"ΡΟΥΓ": 0.0042, "ΟΥΖΜ": 0.0042, "ΥΖΜΠ": 0.0042, "ΖΜΠΕ": 0.0042, "ΠΕΚΙ": 0.0042,
+	"ΕΣΩΝ": 0.0042, "ΜΑΘΗ": 0.0042, "ΑΘΗΜ": 0.0042, "ΙΤΛΟ": 0.0042, "ΔΩΝΣ": 0.0042,
+	"ΑΣΥΓ": 0.0042, "ΡΙΛΗ": 0.0042, "ΟΡΗΤ": 0.0042, "ΝΘΕΛ": 0.0042, "ΘΗΤΟ": 0.0042,
+	"ΕΧΝΗ": 0.0042, "ΕΙΣΚ": 0.0042, "ΥΣΤΑ": 0.0042, "ΙΝΟΣ": 0.0042, "ΟΙΤΟ": 0.0042,
+	"ΛΕΞΕ": 0.0042, "ΕΣΣΥ": 0.0042, "ΩΡΑΣ": 0.0042, "ΦΕΙΤ": 0.0042, "ΥΔΕΣ": 0.0042,
+	"ΚΟΣΚ": 0.0042, "ΑΥΡΟ": 0.0042, "ΥΡΟΒ": 0.0042, "ΟΒΟΥ": 0.0042, "ΒΟΥΝ": 0.0042,
+	"ΩΡΑΚ": 0.0042, "ΙΕΝΤ": 0.0042, "ΜΕΕΝ": 0.0042, "ΟΥΟΡ": 0.0042, "ΑΖΟΥ": 0.0042,
+	"ΙΤΙΠ": 0.0042, "ΠΩΣΕ": 0.0042, "ΑΘΑΕ": 0.0042, "ΣΙΟΚ": 0.0042, "ΛΕΙΦ": 0.0042,
+	"ΛΕΓΞ": 0.0042, "ΗΞΕΙ": 0.0042, "ΑΠΟΦ": 0.0042, "ΤΗΑΝ": 0.0042, "ΕΤΑΘ": 0.0042,
+	"ΤΑΘΑ": 0.0042, "ΟΕΓΚ": 0.0042, "ΓΗΜΕ": 0.0042, "ΑΡΧΗ": 0.0042, "ΟΠΑΛ": 0.0042,
+	"ΚΑΜΨ": 0.0042, "ΨΗΤΩ": 0.0042, "ΕΟΛΟ": 0.0042, "ΗΝΑΛ": 0.0042, "ΤΟΥΦ": 0.0042,
+	"ΕΣΝΑ": 0.0042, "ΤΕΑΝ": 0.0042, "ΙΣΔΙ": 0.0042, "ΗΛΟΘ": 0.0042, "ΛΟΘΕ": 0.0042,
+	"ΤΑΔΥ": 0.0042, "ΟΤΑΠ": 0.0042, "ΝΟΕΞ": 0.0042, "ΜΑΝΕ": 0.0042, "ΥΝΑΠ": 0.0042,
+	"ΙΠΙΝ": 0.0042, "ΛΙΡΑ": 0.0042, "ΤΑΠΛ": 0.0042, "ΚΑΝΣ": 0.0039, "ΟΡΡΑ": 0.0039,
+	"ΧΑΒΑ": 0.0039, "ΑΙΝΤ": 0.0039, "ΜΒΟΥ": 0.0039, "ΜΑΡΤ": 0.0039, "ΑΧΟΥ": 0.0039,
+	"ΙΓΚΑ": 0.0039, "ΣΟΤΑ": 0.0039, "ΝΕΑΥ": 0.0039, "ΙΟΔΥ": 0.0039, "ΟΔΥΤ": 0.0039,
+	"ΕΡΜΟ": 0.0039, "ΙΚΕΛ": 0.0039, "ΑΡΑΝ": 0.0039, "ΚΑΝΗ": 0.0039, "ΙΔΕΣ": 0.0039,
+	"ΒΕΝΕ": 0.0039, "ΤΟΧΑ": 0.0039, "ΣΣΦΑ": 0.0039, "ΡΑΛΗ": 0.0039, "ΡΩΘΕ": 0.0039,
+	"ΟΝΕΟ": 0.0039, "ΑΙΗΜ": 0.0039, "ΟΣΠΙ": 0.0039, "ΓΟΤΕ": 0.0039, "ΛΗΣΕ": 0.0039,
+	"ΟΧΕΤ": 0.0039, "ΕΤΕΥ": 0.0039, "ΣΗΣΟ": 0.0039, "ΝΣΗΜ": 0.0039, "ΣΗΕΛ": 0.0039,
+	"ΠΑΘΗ": 0.0039, "ΗΣΒΡ": 0.0039, "ΘΑΝΟ": 0.0039, "ΣΑΚΑ": 0.0039, "ΞΗΤΟ": 0.0039,
+	"ΑΔΟΜ": 0.0039, "ΔΟΜΗ": 0.0039, "ΕΝΑΒ": 0.0039, "ΜΟΤΙ": 0.0039, "ΑΡΞΗ": 0.0039,
+	"ΥΑΣΦ": 0.0039, "ΤΗΕΚ": 0.0039, "ΟΝΥΠ": 0.0039, "ΑΣΕΛ": 0.0039, "ΣΕΣΤ": 0.0039,
+	"ΟΥΣΔ": 0.0039, "ΕΩΝΠ": 0.0039, "ΡΟΣΥ": 0.0039, "ΑΘΗΚ": 0.0039, "ΤΑΙΧ": 0.0039,
+	"ΤΡΗΣ": 0.0039, "ΦΩΝΟ": 0.0039, "ΚΑΝΠ": 0.0039, "ΠΟΜΙ": 0.0039, "ΘΕΤΕ": 0.0039,
+	"ΕΣΥΠ": 0.0039, "ΤΡΩΝ": 0.0039, "ΡΩΝΠ": 0.0039, "ΕΛΛΕ": 0.0039, "ΗΜΗΔ": 0.0039,
+	"ΜΗΣΓ": 0.0039, "ΗΗΔΙ": 0.0039, "ΝΩΝΔ": 0.0039, "ΗΗΤΑ": 0.0039, "ΕΣΩΣ": 0.0039,
+	"ΕΑΡΧ": 0.0039, "ΦΗΚΕ": 0.0039, "ΙΜΟΣ": 0.0039, "ΜΗΕΞ": 0.0039, "ΝΟΕΝ": 0.0039,
+	"ΥΤΡΕ": 0.0039, "ΣΜΑΠ": 0.0039, "ΤΑΥΠ": 0.0039, "ΣΜΩΝ": 0.0039, "ΟΠΙΑ": 0.0039,
+	"ΕΚΤΑ": 0.0039, "ΥΝΕΙ": 0.0039, "ΜΗΤΟ": 0.0039, "ΩΝΕΝ": 0.0039, "ΝΤΑΜ": 0.0039,
+	"ΕΙΟΓ": 0.0039, "ΥΔΗΜ": 0.0039, "ΟΕΝΤ": 0.0039, "ΡΙΟΑ": 0.0039, "ΙΟΑΡ": 0.0039,
+	"ΟΛΗΧ": 0.0039, "ΙΑΑΚ": 0.0039, "ΡΟΑΠ": 0.0039, "ΤΗΥΠ": 0.0039, "ΡΕΣΜ": 0.0039,
+	"ΠΕΙΗ": 0.0039, "ΚΗΚΑ": 0.0039, "ΠΗΓΩ": 0.0039, "ΟΡΑΤ": 0.0039, "ΩΝΠΛ": 0.0039,
+	"ΗΣΕΑ": 0.0039, "ΑΝΕΞ": 0.0039, "ΠΙΒΕ": 0.0039, "ΒΑΙΩ": 0.0039, "ΟΛΙΣ": 0.0039,
+	"ΤΙΤΟ": 0.0039, "ΟΝΗΓ": 0.0039, "ΝΣΤΑ": 0.0039, "ΙΑΩΣ": 0.0039, "ΑΙΤΩ": 0.0039,
+	"ΝΔΙΕ": 0.0039, "ΟΥΣΟ": 0.0039, "ΝΗΜΑ": 0.0039, "ΘΜΟΤ": 0.0039, "ΩΝΜΗ": 0.0039,
+	"ΣΚΙΕ": 0.0039, "ΚΙΕΞ": 0.0039, "ΗΝΔΙ": 0.0039, "ΩΣΗΜ": 0.0039, "ΗΕΡΓ": 0.0039,
+	"ΟΥΤΥ": 0.0039, "ΥΤΥΠ": 0.0039, "ΑΜΕΛ": 0.0039, "ΣΜΟΡ": 0.0039, "ΕΙΚΛ": 0.0039,
+	"ΝΟΥΡ": 0.0039, "ΗΜΑΚ": 0.0039, "ΩΣΗΛ": 0.0039, "ΚΟΥΓ": 0.0039, "ΛΑΙΟ": 0.0039,
+	"ΡΕΣΣ": 0.0039, "ΑΙΠΛ": 0.0039, "ΑΕΝΑ": 0.0039, "ΩΣΔΕ": 0.0039, "ΑΝΠΡ": 0.0039,
+	"ΣΤΕΟ": 0.0039, "ΜΕΑΣ": 0.0039, "ΣΚΟΤ": 0.0039, "ΝΤΑΑ": 0.0039, "ΙΚΟΗ": 0.0039,
+	"ΚΙΝΟ": 0.0039, "ΜΑΕΝ": 0.0039, "ΜΗΠΟ": 0.0039, "ΙΑΤΡ": 0.0039, "ΠΡΟΘ": 0.0039,
+	"ΡΟΘΕ": 0.0039, "ΕΜΑΤ": 0.0039, "ΑΞΗΣ": 0.0039, "ΕΟΡΙ": 0.0039, "ΟΝΟΛ": 0.0039,
+	"ΝΚΩΔ": 0.0039, "ΟΣΕΓ": 0.0039, "ΥΣΕΑ": 0.0039, "ΝΟΣΗ": 0.0039, "ΜΙΑΛ": 0.0039,
+	"ΤΟΥΩ": 0.0039, "ΜΟΥΓ": 0.0039, "ΚΗΣΤ": 0.0039, "ΑΚΗΠ": 0.0039, "ΤΑΝΤ": 0.0039,
+	"ΝΤΟΤ": 0.0039, "ΑΞΕΚ": 0.0039, "ΞΕΚΙ": 0.0039, "ΕΚΙΝ": 0.0039, "ΟΚΕΦ": 0.0039,
+	"ΕΚΠΟ": 0.0039, "ΚΠΟΜ": 0.0039, "ΜΠΗΣ": 0.0039, "ΑΕΚΚ": 0.0039, "ΑΘΟΥ": 0.0039,
+	"ΝΟΥΤ": 0.0039, "ΚΗΕΞ": 0.0039, "ΞΗΣΤ": 0.0039, "ΕΚΚΡ": 0.0039, "ΚΚΡΕ": 0.0039,
+	"ΚΡΕΜ": 0.0039, "ΤΑΤΩ": 0.0039, "ΝΗΑΛ": 0.0039, "ΑΔΥΟ": 0.0039, "ΑΣΕΠ": 0.0039,
+	"ΟΜΕΙ": 0.0039, "ΤΑΣΣ": 0.0039, "ΩΝΕΓ": 0.0039, "ΗΤΥΠ": 0.0039, "ΥΠΟΑ": 0.0039,
+	"ΣΑΝΔ": 0.0039, "ΙΕΙΣ": 0.0039, "ΧΕΙΓ": 0.0039, "ΤΑΘΗ": 0.0039, "ΙΟΣΕ": 0.0039,
+	"ΡΟΣΣ": 0.0039, "ΟΙΕΠ": 0.0039, "ΙΜΕΜ": 0.0039, "ΜΕΜΙ": 0.0039, "ΛΕΙΩ": 0.0039,
+	"ΙΝΤΗ": 0.0039, "ΑΣΧΡ": 0.0039, "ΗΔΕΣ": 0.0039, "ΕΤΑΧ": 0.0039, "ΔΙΔΕ": 0.0039,
+	"ΟΥΕΓ": 0.0039, "ΗΤΕΛ": 0.0039, "ΡΟΛΕ": 0.0039, "ΟΣΚΙ": 0.0039, "ΟΥΟΠ": 0.0039,
+	"ΥΓΟΥ": 0.0039, "ΔΕΚΑ": 0.0039, "ΡΑΝΟ": 0.0039, "ΚΗΑΝ": 0.0039, "ΟΚΤΑ": 0.0039,
+	"ΚΤΑΔ": 0.0039, "ΑΠΟΧ": 0.0039, "ΕΝΠΑ": 0.0039, "ΠΙΤΖ": 0.0039, "ΚΟΓΙ": 0.0039,
+	"ΑΤΖΑ": 0.0039, "ΛΗΑΝ": 0.0039, "ΗΣΑΙ": 0.0039, "ΑΜΑΡ": 0.0039, "ΤΑΤΖ": 0.0039,
+	"ΑΤΖΙ": 0.0039, "ΤΖΙΚ": 0.0039, "ΖΕΡΜ": 0.0039, "ΜΠΑΙ": 0.0039, "ΑΧΑΜ": 0.0039,
+	"ΑΜΑΚ": 0.0039, "ΜΠΟΝ": 0.0039, "ΠΕΚΜ": 0.0039, "ΚΜΙΣ": 0.0039, "ΙΑΝΓ": 0.0039,
+	"ΗΣΟΥ": 0.0039, "ΛΗΓΟ": 0.0039, "ΜΠΑΓ": 0.0039, "ΟΜΑΡ": 0.0039, "ΣΑΙΣ": 0.0039,
+	"ΡΑΓΚ": 0.0039, "ΑΚΕΔ": 0.0039, "ΚΕΔΟ": 0.0039, "ΕΔΟΝ": 0.0039, "ΑΔΑΓ": 0.0039,
+	"ΑΟΥΙ": 0.0039, "ΕΝΗΓ": 0.0039, "ΚΟΚΟ": 0.0039, "ΕΝΤΕ": 0.0039, "ΤΕΚΚ": 0.0039,
+	"ΤΕΚΜ": 0.0039, "ΙΟΛΕ": 0.0039, "ΕΚΣΑ": 0.0039, "ΚΣΑΝ": 0.0039, "ΟΝΑΣ": 0.0039,
+	"ΑΝΜΑ": 0.0039, "ΩΣΣΙ": 0.0039, "ΩΣΙΑ": 0.0039, "ΣΙΕΡ": 0.0039, "ΤΑΙΒ": 0.0039,
+	"ΑΙΒΑ": 0.0039, "ΑΖΑΚ": 0.0039, "ΙΟΡΥ": 0.0039, "ΝΙΔΙ": 0.0039, "ΣΕΣΑ": 0.0039,
+	"ΟΓΗΠ": 0.0039, "ΣΕΜΕ": 0.0039, "ΟΔΕΣ": 0.0039, "ΒΛΕΠ": 0.0039, "ΟΙΑΕ": 0.0039,
+	"ΕΥΜΑ": 0.0039, "ΕΞΕΙ": 0.0039, "ΕΥΟΝ": 0.0039, "ΙΤΑΔ": 0.0039, "ΤΙΩΝ": 0.0039,
+	"ΤΑΙΩ": 0.0039, "ΘΕΣΕ": 0.0039, "ΚΟΡΩ": 0.0039, "ΟΡΩΣ": 0.0039, "ΟΙΧΡ": 0.0039,
+	"ΣΛΑΜ": 0.0039, "ΟΡΦΟ": 0.0039, "ΗΣΓΟ": 0.0039, "ΑΣΑΦ": 0.0039, "ΟΜΩΝ": 0.0039,
+	"ΑΙΟΠ": 0.0039, "ΑΤΙΕ": 0.0039, "ΤΑΛΗ": 0.0039, "ΝΑΘΕ": 0.0039, "ΟΥΟΙ": 0.0039,
+	"ΕΗΔΗ": 0.0039, "ΙΑΣΦ": 0.0039, "ΟΣΟΧ": 0.0039, "ΕΤΟΤ": 0.0039, "ΥΠΟΚ": 0.0039,
+	"ΙΜΑΖ": 0.0039, "ΖΙΜΕ": 0.0039, "ΕΔΡΙ": 0.0039, "ΡΑΚΙ": 0.0039, "ΝΟΣΠ": 0.0039,
+	"ΕΤΟΚ": 0.0039, "ΓΕΙΣ": 0.0039, "ΥΡΙΟ": 0.0039, "ΡΡΙΨ": 0.0039, "ΑΕΡΓ": 0.0039,
+	"ΥΤΕΣ": 0.0039, "ΙΔΗΜ": 0.0039, "ΣΕΞΥ": 0.0039, "ΕΙΝΟ": 0.0039, "ΜΗΠΑ": 0.0039,
+	"ΔΑΔΕ": 0.0039, "ΚΟΤΟ": 0.0039, "ΩΝΤΕ": 0.0039, "ΚΚΑΘ": 0.0039, "ΑΙΧΡ": 0.0039,
+	"ΛΕΓΕ": 0.0039, "ΙΣΕΦ": 0.0039, "ΟΓΗΝ": 0.0039, "ΓΗΝΑ": 0.0039, "ΗΖΗΤ": 0.0039,
+	"ΥΣΗΑ": 0.0039, "ΟΕΞΥ": 0.0039, "ΨΗΤΟ": 0.0039, "ΣΑΣΥ": 0.0039, "ΒΟΛΕ": 0.0039,
+	"ΥΜΕΚ": 0.0039, "ΙΡΥΘ": 0.0039, "ΣΚΕΛ": 0.0039, "ΥΠΙΑ": 0.0039, "ΓΙΟΔ": 0.0039,
+	"ΛΙΠΙ": 0.0039, "ΡΑΚΛ": 0.0039, "ΡΤΟΕ": 0.0039, "ΥΜΗΔ": 0.0039, "ΗΣΑΜ": 0.0035,
+	"ΜΠΡΑ": 0.0035, "ΜΠΡΟ": 0.0035, "ΛΟΥΠ": 0.0035, "ΝΤΑΧ": 0.0035, "ΙΛΛΙ": 0.0035,
+	"ΚΟΡΥ": 0.0035, "ΛΙΓΟ": 0.0035, "ΛΟΜΒ": 0.0035, "ΟΥΞΕ": 0.0035, "ΕΜΒΟ": 0.0035,
+	"ΒΟΥΡ": 0.0035, "ΣΙΓΚ": 0.0035, "ΙΜΙΝ": 0.0035, "ΙΝΕΣ": 0.0035, "ΝΤΑΝ": 0.0035,
+	"ΟΥΧΑ": 0.0035, "ΜΕΞΙ": 0.0035, "ΚΟΤΑ": 0.0035, "ΕΣΝΗ": 0.0035, "ΝΕΑΚ": 0.0035,
+	"ΕΑΚΑ": 0.0035, "ΛΙΠΠ": 0.0035, "ΙΓΚΙ": 0.0035, "ΔΟΥΑ": 0.0035, "ΡΟΝΤ": 0.0035,
+	"ΕΣΤΩ": 0.0035, "ΩΑΝΑ": 0.0035, "ΕΛΙΚ": 0.0035, "ΣΣΕΑ": 0.0035, "ΜΑΔΗ": 0.0035,
+	"ΙΑΠΙ": 0.0035, "ΙΤΟΚ": 0.0035, "ΩΤΗΜ": 0.0035, "ΥΤΗΚ": 0.0035, "ΗΛΗΨ": 0.0035,
+	"ΥΤΑΥ": 0.0035, "ΑΘΗΣ": 0.0035, "ΘΗΣΕ": 0.0035, "ΟΣΕΥ": 0.0035, "ΣΕΥΡ": 0.0035,
+	"ΘΥΡΑ": 0.0035, "ΙΤΗΔ": 0.0035, "ΜΟΥΠ": 0.0035, "ΗΚΡΥ": 0.0035, "ΓΙΑΟ": 0.0035,
+	"ΙΑΟΜ": 0.0035, "ΤΙΜΟ": 0.0035, "ΟΣΩΠ": 0.0035, "ΘΕΥΣ": 0.0035, "ΗΝΕΥ": 0.0035,
+	"ΗΦΟΡ": 0.0035, "ΟΥΙΔ": 0.0035, "ΥΙΔΙ": 0.0035, "ΟΙΧΟ": 0.0035, "ΗΣΧΑ": 0.0035,
+	"ΕΝΗΥ": 0.0035, "ΑΣΕΜ": 0.0035, "ΥΡΟΤ": 0.0035, "ΣΗΛΕ": 0.0035, "ΚΟΟΝ": 0.0035,
+	"ΤΗΡΟ": 0.0035, "ΓΗΣΥ": 0.0035, "ΣΗΠΟ": 0.0035, "ΕΟΣΥ": 0.0035, "ΙΤΥΠ": 0.0035,
+	"ΟΣΜΗ": 0.0035, "ΠΙΔΙ": 0.0035, "ΑΑΚΥ": 0.0035, "ΥΡΗΜ": 0.0035, "ΩΝΟΥ": 0.0035,
+	"ΗΝΣΥ": 0.0035, "ΙΣΣΤ": 0.0035, "ΛΛΕΙ": 0.0035, "ΝΩΝΜ": 0.0035, "ΣΣΕΙ": 0.0035,
+	"ΕΤΟΟ": 0.0035, "ΗΤΑΤ": 0.0035, "ΣΜΕΣ": 0.0035, "ΙΣΠΡ": 0.0035, "ΑΦΗΓ": 0.0035,
+	"ΙΛΙΣ": 0.0035, "ΛΙΣΗ": 0.0035, "ΡΟΝΗ": 0.0035, "ΤΗΘΕ": 0.0035, "ΤΙΚΡ": 0.0035,
+	"ΟΦΗΣ": 0.0035, "ΜΑΜΗ": 0.0035, "ΟΝΟΕ": 0.0035, "ΛΟΥΕ": 0.0035, "ΚΩΝΑ": 0.0035,
+	"ΓΡΕΝ": 0.0035, "ΝΚΑΘ": 0.0035, "ΛΙΚΩ": 0.0035, "ΟΙΧΑ": 0.0035, "ΟΣΕΠ": 0.0035,
+	"ΑΜΜΩ": 0.0035, "ΜΜΩΝ": 0.0035, "ΑΙΑΔ": 0.0035, "ΝΧΡΕ": 0.0035, "ΝΣΕΙ": 0.0035,
+	"ΡΗΕΚ": 0.0035, "ΩΣΠΡ": 0.0035, "ΙΗΕΝ": 0.0035, "ΗΤΕΡ": 0.0035, "ΤΕΧΡ": 0.0035,
+	"ΟΗΜΕ": 0.0035, "ΩΓΗΤ": 0.0035, "ΕΔΕΝ": 0.0035, "ΥΝΘΕ": 0.0035, "ΑΛΩΑ": 0.0035,
+	"ΚΟΥΝ": 0.0035, "ΙΣΗΟ": 0.0035, "ΙΟΛΑ": 0.0035, "ΣΩΤΟ": 0.0035, "ΕΙΣΗ": 0.0035,
+	"ΗΒΟΗ": 0.0035, "ΕΣΥΓ": 0.0035, "ΑΔΩΣ": 0.0035, "ΒΛΕΨ": 0.0035, "ΛΕΨΗ": 0.0035,
+	"ΙΟΓΙ": 0.0035, "ΝΑΓΡ": 0.0035, "ΜΙΣΜ": 0.0035, "ΑΛΛΕ": 0.0035, "ΟΥΝΝ": 0.0035,
+	"ΥΝΝΑ": 0.0035, "ΡΟΚΙ": 0.0035, "ΤΟΛΟ": 0.0035, "ΩΣΟΡ": 0.0035, "ΣΚΟΜ": 0.0035,
+	"ΟΜΕΕ": 0.0035, "ΕΕΤΙ": 0.0035, "ΛΜΑΜ": 0.0035, "ΑΙΤΡ": 0.0035, "ΙΟΤΕ": 0.0035,
+	"ΙΣΟΝ": 0.0035, "ΙΟΔΗ": 0.0035, "ΙΤΟΣ": 0.0035, "ΑΘΕΛ": 0.0035, "ΙΑΓΝ": 0.0035,
+	"ΣΟΚΑ": 0.0035, "ΕΠΑΚ": 0.0035, "ΑΔΙΣ": 0.0035, "ΒΑΤΑ": 0.0035, "ΜΕΑΠ": 0.0035,
+	"ΥΤΑΤ": 0.0035, "ΟΥΝΓ": 0.0035, "ΙΝΟΧ": 0.0035, "ΗΣΒΙ": 0.0035, "ΙΜΗΠ": 0.0035,
+	"ΡΙΣΘ": 0.0035, "ΟΔΗΠ": 0.0035, "ΡΑΓΙ": 0.0035, "ΑΡΑΘ": 0.0035, "ΙΕΥΚ": 0.0035,
+	"ΙΕΞΑ": 0.0035, "ΛΟΠΟ": 0.0035, "ΗΡΙΚ": 0.0035, "ΡΙΚΤ": 0.0035, "ΜΟΥΔ": 0.0035,
+	"ΥΔΙΚ": 0.0035, "ΑΣΗΚ": 0.0035, "ΙΗΕΙ": 0.0035, "ΑΠΟΟ": 0.0035, "ΚΟΚΕ": 0.0035,
+	"ΗΝΑΚ": 0.0035, "ΟΝΑΝ": 0.0035, "ΚΕΝΑ": 0.0035, "ΑΤΑΔ": 0.0035, "ΕΚΕΝ": 0.0035,
+	"ΝΝΑΕ": 0.0035, "ΜΟΑΠ": 0.0035, "ΟΣΧΕ": 0.0035, "ΜΑΕΠ": 0.0035, "ΛΜΑΡ": 0.0035,
+	"ΣΗΣΗ": 0.0035, "ΥΥΠΑ": 0.0035, "ΣΘΥΓ": 0.0035, "ΑΡΑΔ": 0.0035, "ΠΗΣΣ": 0.0035,
+	"ΑΜΗΕ": 0.0035, "ΑΑΡΙ": 0.0035, "ΑΤΑΟ": 0.0035, "ΕΠΤΑ": 0.0035, "ΜΜΗΑ": 0.0035,
+	"ΘΜΗΣ": 0.0035, "ΕΥΠΟ": 0.0035, "ΘΕΙΟ": 0.0035, "ΕΣΕΛ": 0.0035, "ΕΝΕΓ": 0.0035,
+	"ΟΔΕΚ": 0.0035, "ΔΕΚΤ": 0.0035, "ΙΕΝΕ": 0.0035, "ΦΕΙΛ": 0.0035, "ΛΟΜΕ": 0.0035,
+	"ΟΤΡΟ": 0.0035, "ΚΑΙΡ": 0.0035, "ΝΑΕΜ": 0.0035, "ΑΓΚΙ": 0.0035, "ΓΕΙΕ": 0.0035,
+	"ΙΤΟΤ": 0.0035, "ΑΛΟΣ": 0.0035, "ΕΙΧΕ": 0.0035, "ΟΤΟΤ": 0.0035, "ΜΟΣΥ": 0.0035,
+	"ΤΟΙΔ": 0.0035, "ΕΠΙΦ": 0.0035, "ΠΙΦΑ": 0.0035, "ΙΦΑΝ": 0.0035, "ΠΟΧΑ": 0.0035,
+	"ΡΕΣΤ": 0.0035, "ΑΡΜΠ": 0.0035, "ΠΡΙΑ": 0.0035, "ΑΑΙΓ": 0.0035, "ΕΣΟΠ": 0.0035,
+	"ΕΠΟΧ": 0.0035, "ΠΟΧΗ": 0.0035, "ΑΝΕΟ": 0.0035, "ΣΝΕΑ": 0.0035, "ΣΑΒΟ": 0.0035,
+	"ΑΒΟΛ": 0.0035, "ΛΙΒΙ": 0.0035, "ΙΒΙΑ": 0.0035, "ΜΟΓΓ": 0.0035, "ΟΓΓΟ": 0.0035,
+	"ΓΓΟΛ": 0.0035, "ΓΟΛΙ": 0.0035, "ΚΟΣΟ": 0.0035, "ΗΣΚΕ": 0.0035, "ΑΝΔΟ": 0.0035,
+	"ΙΝΙΔ": 0.0035, "ΝΙΔΑ": 0.0035, "ΥΚΙΑ": 0.0035, "ΑΚΑΑ": 0.0035, "ΥΠΤΙ": 0.0035,
+	"ΠΤΙΑ": 0.0035, "ΚΟΛΕ": 0.0035, "ΛΕΖΙ": 0.0035, "ΕΑΤΙ": 0.0035, "ΥΣΟΥ": 0.0035,
+	"ΑΜΜΙ": 0.0035, "ΚΟΡΟ": 0.0035, "ΣΑΡΑ": 0.0035, "ΑΜΑΛ": 0.0035, "ΜΑΝΣ": 0.0035,
+	"ΡΙΤΑ": 0.0035, "ΑΣΑΛ": 0.0035, "ΑΤΕΚ": 0.0035, "ΣΟΓΙ": 0.0035, "ΕΚΙΣ": 0.0035,
+	"ΟΑΠΑ": 0.0035, "ΜΟΓΚ": 0.0035, "ΑΝΟΡ": 0.0035, "ΥΑΗΣ": 0.0035, "ΛΩΝΕ": 0.0035,
+	"ΝΑΙΡ": 0.0035, "ΣΑΛΒ": 0.0035, "ΣΑΣΑ": 0.0035, "ΛΙΑΝ": 0.0035, "ΣΑΣΟ": 0.0035,
+	"ΥΜΟΝ": 0.0035, "ΥΕΛΑ": 0.0035, "ΦΙΑΚ": 0.0035, "ΣΤΟΡ": 0.0035, "ΟΒΑΘ": 0.0035,
+	"ΡΙΠΤ": 0.0035, "ΜΙΑΡ": 0.0035, "ΣΗΕΓ": 0.0035, "ΚΗΣΥ": 0.0035, "ΑΔΟΠ": 0.0035,
+	"ΚΑΛΥ": 0.0035, "ΓΟΝΟ": 0.0035, "ΑΕΤΙ": 0.0035, "ΕΣΕΞ": 0.0035, "ΕΤΟΗ": 0.0035,
+	"ΥΟΝΤ": 0.0035, "ΑΤΙΩ": 0.0035, "ΠΟΑΠ": 0.0035, "ΤΙΝΟ": 0.0035, "ΥΚΟΡ": 0.0035,
+	"ΛΙΒΑ": 0.0035, "ΑΙΣΑ": 0.0035, "ΜΠΟΤ": 0.0035, "ΟΥΒΕ": 0.0035, "ΟΙΝΗ": 0.0035,
+	"ΤΚΑΙ": 0.0035, "ΤΟΚΡ": 0.0035, "ΚΑΙΓ": 0.0035, "ΑΥΠΑ": 0.0035, "ΠΡΟΝ": 0.0035,
+	"ΕΤΗΕ": 0.0035, "ΘΟΣΤ": 0.0035, "ΑΝΘΕ": 0.0035, "ΟΛΥΓ": 0.0035, "ΥΝΔΙ": 0.0035,
+	"ΑΛΕΣ": 0.0035, "ΟΤΙΣ": 0.0035, "ΤΕΙΣ": 0.0035, "ΡΟΣΟ": 0.0035, "ΕΠΟΥ": 0.0035,
+	"ΘΟΣΓ": 0.0035, "ΝΟΙΑ": 0.0035, "ΥΤΟΕ": 0.0035, "ΘΩΡΑ": 0.0035, "ΣΙΓΟ": 0.0035,
+	"ΑΝΑΥ": 0.0035, "ΤΕΠΡ": 0.0035, "ΤΕΕΤ": 0.0035, "ΝΕΙΚ": 0.0035, "ΑΛΩΧ": 0.0035,
+	"ΛΩΧΡ": 0.0035, "ΩΧΡΗ": 0.0035, "ΩΣΕΓ": 0.0035, "ΣΠΑΣ": 0.0035, "ΟΥΝΔ": 0.0035,
+	"ΡΩΣΤ": 0.0035, "ΚΗΝΙ": 0.0035, "ΗΝΙΟ": 0.0035, "ΜΗΚΑ": 0.0035, "ΑΜΨΗ": 0.0035,
+	"ΑΩΣΚ": 0.0035, "ΙΩΔΩ": 0.0035, "ΩΔΩΝ": 0.0035, "ΑΔΑΔ": 0.0035, "ΓΕΣΚ": 0.0035,
+	"ΑΞΥΤ": 0.0035, "ΚΡΟΤ": 0.0035, "ΕΡΩΘ": 0.0035, "ΓΕΣΝ": 0.0035, "ΩΑΛΛ": 0.0035,
+	"ΛΟΙΧ": 0.0035, "ΡΕΘΟ": 0.0035, "ΜΕΕΠ": 0.0035, "ΣΗΝΕ": 0.0035, "ΑΙΠΩ": 0.0035,
+	"ΙΠΩΣ": 0.0035, "ΙΛΕΚ": 0.0035, "ΔΕΔΕ": 0.0035, "ΟΓΙΝ": 0.0035, "ΣΚΛΩ": 0.0035,
+	"ΩΝΕΤ": 0.0035, "ΓΗΕΠ": 0.0035, "ΙΛΕΧ": 0.0035, "ΕΥΡΩ": 0.0035, "ΤΕΟΛ": 0.0035,
+	"ΥΘΑΑ": 0.0035, "ΝΑΑΦ": 0.0035, "ΑΙΜΑ": 0.0035, "ΕΝΩΕ": 0.0035, "ΔΗΝΑ": 0.0035,
+	"ΟΙΡΑ": 0.0035, "ΕΓΕΙ": 0.0035, "ΙΧΙΖ": 0.0035, "ΛΓΙΚ": 0.0035, "ΓΙΟΜ": 0.0035,
+	"ΑΜΟΑ": 0.0031, "ΜΠΕΛ": 0.0031, "ΠΕΛΙ": 0.0031, "ΜΠΑΣ": 0.0031, "ΙΑΒΟ": 0.0031,
+	"ΥΑΤΕ": 0.0031, "ΕΜΑΛ": 0.0031, "ΛΟΥΞ": 0.0031, "ΥΞΕΜ": 0.0031, "ΞΕΜΒ": 0.0031,
+	"ΑΝΙΤ": 0.0031, "ΡΤΙΝ": 0.0031, "ΣΑΧΟ": 0.0031, "ΠΟΛΗ": 0.0031, "ΚΑΡΟ": 0.0031,
+	"ΝΕΣΝ": 0.0031, "ΔΑΦΗ": 0.0031, "ΠΑΛΕ": 0.0031, "ΠΕΡΟ": 0.0031, "ΡΟΥΤ": 0.0031,
+	"ΥΤΖΙ": 0.0031, "ΚΗΠΟ": 0.0031, "ΠΡΙΓ": 0.0031, "ΡΙΓΚ": 0.0031, "ΓΚΙΠ": 0.0031,
+	"ΚΙΠΑ": 0.0031, "ΟΣΜΑ": 0.0031, "ΙΜΙΚ": 0.0031, "ΣΑΣΚ": 0.0031, "ΑΑΥΣ": 0.0031,
+	"ΡΑΛΙ": 0.0031, "ΑΓΟΡ": 0.0031, "ΤΕΙΩ": 0.0031, "ΑΣΙΝ": 0.0031, "ΔΕΧΕ": 0.0031,
+	"ΧΙΣΗ": 0.0031, "ΤΕΥΤ": 0.0031, "ΘΟΥΣ": 0.0031, "ΜΑΣΦ": 0.0031, "ΗΠΡΩ": 0.0031,
+	"ΥΜΑΚ": 0.0031, "ΗΤΟΑ": 0.0031, "ΙΟΚΩ": 0.0031, "ΔΙΚΩ": 0.0031, "ΗΜΗΑ": 0.0031,
+	"ΜΕΜΗ": 0.0031, "ΚΟΑΛ": 0.0031, "ΛΑΤΗ": 0.0031, "ΖΕΙΣ": 0.0031, "ΤΕΙΛ": 0.0031,
+	"ΣΕΛΑ": 0.0031, "ΟΙΚΤ": 0.0031, "ΠΟΚΤ": 0.0031, "ΨΕΙΤ": 0.0031, "ΕΣΥΜ": 0.0031,
+	"ΓΙΑΥ": 0.0031, "ΙΜΟΥ": 0.0031, "ΝΑΡΞ": 0.0031, "ΝΑΕΡ": 0.0031, "ΑΕΡΜ": 0.0031,
+	"ΙΑΞΕ": 0.0031, "ΜΕΔΙ": 0.0031, "ΛΗΠΑ": 0.0031, "ΝΩΝΣ": 0.0031, "ΚΑΘΥ": 0.0031,
+	"ΑΘΥΣ": 0.0031, "ΘΥΣΤ": 0.0031, "ΙΠΛΟ": 0.0031, "ΗΑΙΤ": 0.0031, "ΤΙΑΥ": 0.0031,
+	"ΟΡΕΥ": 0.0031, "ΠΟΣΟ": 0.0031, "ΤΗΠΡ": 0.0031, "ΚΟΤΗ": 0.0031, "ΑΠΛΩ": 0.0031,
+	"ΠΛΩΝ": 0.0031, "ΚΗΔΕ": 0.0031, "ΕΜΗΝ": 0.0031, "ΡΗΑΠ": 0.0031, "ΝΩΣΕ": 0.0031,
+	"ΚΡΙΤ": 0.0031, "ΡΙΤΙ": 0.0031, "ΚΟΣΠ": 0.0031, "ΧΕΗΑ": 0.0031, "ΑΙΧΑ": 0.0031,
+	"ΤΕΛΙ": 0.0031, "ΛΗΨΕ": 0.0031, "ΔΕΝΣ": 0.0031, "ΕΝΘΕ": 0.0031, "ΝΘΕΤ": 0.0031,
+	"ΝΑΣΑ": 0.0031, "ΘΑΧΡ": 0.0031, "ΑΤΙΜ": 0.0031, "ΟΣΜΟ": 0.0031, "ΤΟΕΡ": 0.0031,
+	"ΕΛΑΒ": 0.0031, "ΣΗΚΡ": 0.0031, "ΣΣΗΜ": 0.0031, "ΕΙΛΙ": 0.0031, "ΡΙΣΙ": 0.0031,
+	"ΣΚΡΥ": 0.0031, "ΤΛΗΘ": 0.0031, "ΡΟΩΡ": 0.0031, "ΟΛΑΘ": 0.0031, "ΟΦΑΚ": 0.0031,
+	"ΣΜΑΜ": 0.0031, "ΟΥΣΠ": 0.0031, "ΤΑΓΡ": 0.0031, "ΟΙΠΑ": 0.0031, "ΑΙΟΝ": 0.0031,
+	"ΚΤΑΣ": 0.0031, "ΥΣΕΝ": 0.0031, "ΛΕΣΠ": 0.0031, "ΟΥΧΩ": 0.0031, "ΥΧΩΡ": 0.0031,
+	"ΩΡΟΥ": 0.0031, "ΑΣΗΧ": 0.0031, "ΥΡΟΠ": 0.0031, "ΚΕΠΡ": 0.0031, "ΩΡΟΣ": 0.0031,
+	"ΘΟΣΜ": 0.0031, "ΚΑΣΙ": 0.0031, "ΟΔΕΞ": 0.0031, "ΣΠΡΩ": 0.0031, "ΛΗΕΙ": 0.0031,
+	"ΝΗΕΝ": 0.0031, "ΜΟΛΟ": 0.0031, "ΗΤΡΟ": 0.0031, "ΣΑΡΩ": 0.0031, "ΑΡΩΣ": 0.0031,
+	"ΛΩΕΙ": 0.0031, "ΩΕΙΣ": 0.0031, "ΡΤΗΤ": 0.0031, "ΣΕΜΦ": 0.0031, "ΣΜΑΔ": 0.0031,
+	"ΝΟΔΙ": 0.0031, "ΣΗΟΡ": 0.0031, "ΟΔΟΤ": 0.0031, "ΩΝΕΜ": 0.0031, "ΟΜΗΠ": 0.0031,
+	"ΗΜΑΕ": 0.0031, "ΚΑΑΠ": 0.0031, "ΟΣΟΝ": 0.0031, "ΣΙΜΩ": 0.0031, "ΔΟΣΜ": 0.0031,
+	"ΗΣΕΜ": 0.0031, "ΕΣΗΔ": 0.0031, "ΧΑΛΑ": 0.0031, "ΛΑΕΙ": 0.0031, "ΙΕΓΓ": 0.0031,
+	"ΑΦΗΠ": 0.0031, "ΣΑΔΕ": 0.0031, "ΜΟΜΕ": 0.0031, "ΙΗΘΟ": 0.0031, "ΡΤΑΤ": 0.0031,
+	"ΙΔΩΝ": 0.0031, "ΟΓΟΙ": 0.0031, "ΗΕΤΙ": 0.0031, "ΣΕΝΟ": 0.0031, "ΧΑΜΕ": 0.0031,
+	"ΡΗΜΟ": 0.0031, "ΥΡΗΛ": 0.0031, "ΦΗΤΗ": 0.0031, "ΤΕΙΠ": 0.0031, "ΟΣΩΣ": 0.0031,
+	"ΝΣΥΓ": 0.0031, "ΝΠΑΛ": 0.0031, "ΑΤΕΛ": 0.0031, "ΟΤΕΙ": 0.0031, "ΙΧΩΡ": 0.0031,
+	"ΛΜΑΟ": 0.0031, "ΟΙΟΔ": 0.0031, "ΑΝΩΤ": 0.0031, "ΗΝΤΙ": 0.0031, "ΑΥΠΕ": 0.0031,
+	"ΟΧΡΕ": 0.0031, "ΛΗΣΑ": 0.0031, "ΕΙΛΑ": 0.0031, "ΙΤΕΚ": 0.0031, "ΙΕΣΑ": 0.0031,
+	"ΥΡΟΑ": 0.0031, "ΥΕΙΣ": 0.0031, "ΟΣΟΚ": 0.0031, "ΗΛΟΥ": 0.0031, "ΙΜΟΑ": 0.0031,
+	"ΙΜΕΑ": 0.0031, "ΜΟΤΩ": 0.0031, "ΩΝΟΝ": 0.0031, "ΩΣΝΑ": 0.0031, "ΕΡΩΜ": 0.0031,
+	"ΚΗΕΝ": 0.0031, "ΣΒΙΒ": 0.0031, "ΔΙΣΤ": 0.0031, "ΝΑΑΚ": 0.0031, "ΡΙΣΑ": 0.0031,
+	"ΣΑΝΟ": 0.0031, "ΕΥΚΡ": 0.0031, "ΥΚΡΙ": 0.0031, "ΗΑΚΟ": 0.0031, "ΕΚΑΘ": 0.0031,
+	"ΝΕΣΔ": 0.0031, "ΙΣΥΠ": 0.0031, "ΝΣΕΩ": 0.0031, "ΠΛΗΣ": 0.0031, "ΕΙΕΞ": 0.0031,
+	"ΣΕΜΟ": 0.0031, "ΦΟΥΑ": 0.0031, "ΣΑΓΝ": 0.0031, "ΥΛΟΠ": 0.0031, "ΜΙΑΟ": 0.0031,
+	"ΑΝΗΜ": 0.0031, "ΟΔΥΝ": 0.0031, "ΡΑΨΙ": 0.0031, "ΑΨΙΜ": 0.0031, "ΜΟΥΜ": 0.0031,
+	"ΑΙΗΕ": 0.0031, "ΜΕΑΡ": 0.0031, "ΜΙΑΦ": 0.0031, "ΗΝΚΛ": 0.0031, "ΤΑΚΛ": 0.0031,
+	"ΝΟΛΕ": 0.0031, "ΣΕΑΛ": 0.0031, "ΚΕΠΙ": 0.0031, "ΚΑΣΜ": 0.0031, "ΠΟΥΛ": 0.0031,
+	"ΞΕΠΕ": 0.0031, "ΙΟΥΛ": 0.0031, "ΡΕΑΣ": 0.0031, "ΟΛΥΕ": 0.0031, "ΚΗΣΓ": 0.0031,
+	"ΓΗΣΑ": 0.0031, "ΣΠΑΛ": 0.0031, "ΜΑΡΥ": 0.0031, "ΗΣΤΡ": 0.0031, "ΙΩΝΓ": 0.0031,
+	"ΤΟΝΧ": 0.0031, "ΙΧΙΣ": 0.0031, "ΑΝΓΙ": 0.0031, "ΓΗΣΚ": 0.0031, "ΟΙΝΩ": 0.0031,
+	"ΧΙΑΛ": 0.0031, "ΙΑΕΞ": 0.0031, "ΑΣΗΟ": 0.0031, "ΕΛΑΣ": 0.0031, "ΥΛΕΣ": 0.0031,
+	"ΕΙΧΑ": 0.0031, "ΙΟΥΟ": 0.0031, "ΡΟΜΙ": 0.0031, "ΟΜΗΜ": 0.0031, "ΜΕΙΤ": 0.0031,
+	"ΣΙΜΕ": 0.0031, "ΓΩΝΣ": 0.0031, "ΟΧΕΙ": 0.0031, "ΝΔΟΘ": 0.0031, "ΟΙΑΔ": 0.0031,
+	"ΗΣΧΩ": 0.0031, "ΡΓΟΣ": 0.0031, "ΓΟΣΧ": 0.0031, "ΤΗΜΟ": 0.0031, "ΟΦΕΙ": 0.0031,
+	"ΚΑΙΗ": 0.0031, "ΦΩΝΑ": 0.0031, "ΡΑΘΕ": 0.0031, "ΓΕΝΕ": 0.0031, "ΝΥΜΟ": 0.0031,
+	"ΑΙΕΣ": 0.0031, "ΩΣΑΡ": 0.0031, "ΣΑΝΜ": 0.0031, "ΝΜΟΝ": 0.0031, "ΑΛΜΕ": 0.0031,
+	"ΛΜΕΝ": 0.0031, "ΝΑΔΟ": 0.0031, "ΠΑΤΟ": 0.0031, "ΣΙΑΟ": 0.0031, "ΒΡΙΟ": 0.0031,
+	"ΘΙΑΔ": 0.0031, "ΟΥΑΦ": 0.0031, "ΑΦΡΟ": 0.0031, "ΤΛΑΤ": 0.0031, "ΚΑΑΛ": 0.0031,
+	"ΑΑΛΓ": 0.0031, "ΔΑΙΩ": 0.0031, "ΥΕΜΕ": 0.0031, "ΑΒΟΡ": 0.0031, "ΚΑΣΑ": 0.0031,
+	"ΑΡΝΤ": 0.0031, "ΒΑΡΙ": 0.0031, "ΟΥΤΛ": 0.0031, "ΥΤΛΑ": 0.0031, "ΓΚΑΛ": 0.0031,
+	"ΑΠΑΠ": 0.0031, "ΟΑΤΛ": 0.0031, "ΚΟΠΤ": 0.0031, "ΡΕΝΑ": 0.0031, "ΒΙΚΕ": 0.0031,
+	"ΩΝΝΗ": 0.0031, "ΝΝΗΣ": 0.0031, "ΑΣΛΟ": 0.0031, "ΗΜΕΛ": 0.0031, "ΥΒΑΣ": 0.0031,
+	"ΑΣΑΜ": 0.0031, "ΚΡΟΓ": 0.0031, "ΑΓΑΛ": 0.0031, "ΤΙΦΙ": 0.0031, "ΤΣΙΜ": 0.0031,
+	"ΣΙΜΙ": 0.0031, "ΩΤΟΓ": 0.0031, "ΤΖΑΝ": 0.0031, "ΙΑΜΑ": 0.0031, "ΜΟΥΝ": 0.0031,
+	"ΑΚΟΝ": 0.0031, "ΥΑΝΚ": 0.0031, "ΙΝΚΑ": 0.0031, "ΣΣΑΛ": 0.0031, "ΣΑΛΙ": 0.0031,
+	"ΥΑΚΑ": 0.0031, "ΥΑΝΕ": 0.0031, "ΕΑΓΟ": 0.0031, "ΣΛΑΒ": 0.0031, "ΤΑΜΠ": 0.0031,
+	"ΜΑΝΑ": 0.0031, "ΤΕΑΛ": 0.0031, "ΙΑΛΤ": 0.0031, "ΝΓΚΝ": 0.0031, "ΟΚΟΤ": 0.0031,
+	"ΚΟΤΕ": 0.0031, "ΠΕΝΙ": 0.0031, "ΛΑΣΣ": 0.0031, "ΝΑΟΥ": 0.0031, "ΗΛΑΝ": 0.0031,
+	"ΙΑΟΛ": 0.0031, "ΤΟΜΙ": 0.0031, "ΙΕΡΑ": 0.0031, "ΕΟΝΕ": 0.0031, "ΟΥΡΗ": 0.0031,
+	"ΑΚΙΑ": 0.0031, "ΙΑΝΜ": 0.0031, "ΙΑΛΕ": 0.0031, "ΒΑΛΟ": 0.0031, "ΟΥΓΟ": 0.0031,
+	"ΕΝΕΖ": 0.0031, "ΝΕΖΟ": 0.0031, "ΕΖΟΥ": 0.0031, "ΖΟΥΕ": 0.0031, "ΝΓΚΓ": 0.0031,
+	"ΓΚΓΙ": 0.0031, "ΩΝΚΕ": 0.0031, "ΑΘΜΟ": 0.0031, "ΟΝΙΔ": 0.0031, "ΟΑΣΦ": 0.0031,
+	"ΟΚΟΜ": 0.0031, "ΕΩΝΕ": 0.0031, "ΠΛΟΥ": 0.0031, "ΑΒΟΗ": 0.0031, "ΤΑΧΥ": 0.0031,
+	"ΕΛΛΟ": 0.0031, "ΗΜΑΥ": 0.0031, "ΡΗΛΙ": 0.0031, "ΠΟΣΦ": 0.0031, "ΥΝΠΡ": 0.0031,
+	"ΜΕΚΟ": 0.0031, "ΘΕΤΩ": 0.0031, "ΗΤΗΘ": 0.0031, "ΙΣΕΕ": 0.0031, "ΛΕΠΕ": 0.0031,
+	"ΓΗΚΑ": 0.0031, "ΣΟΛΟ": 0.0031, "ΥΘΜΟ": 0.0031, "ΟΣΡΥ": 0.0031, "ΟΥΤΩ": 0.0031,
+	"ΤΡΟΤ": 0.0031, "ΟΣΕΜ": 0.0031, "ΕΝΣΤ": 0.0031, "ΝΤΙΟ": 0.0031, "ΜΕΑΥ": 0.0031,
+	"ΥΕΙΤ": 0.0031, "ΛΤΙΚ": 0.0031, "ΕΣΚΕ": 0.0031, "ΣΒΟΡ": 0.0031, "ΝΤΕΣ": 0.0031,
+	"ΑΝΗΔ": 0.0031, "ΘΕΝΟ": 0.0031, "ΝΟΙΝ": 0.0031, "ΡΙΝΣ": 0.0031, "ΣΟΣΙ": 0.0031,
+	"ΣΙΑΛ": 0.0031, "ΟΝΔΕ": 0.0031, "ΚΗΠΕ": 0.0031, "ΗΣΜΑ": 0.0031, "ΚΑΖΑ": 0.0031,
+	"ΗΣΙΣ": 0.0031, "ΗΣΛΕ": 0.0031, "ΟΤΜΗ": 0.0031, "ΩΡΙΖ": 0.0031, "ΡΙΟΜ": 0.0031,
+	"ΥΧΡΟ": 0.0031, "ΗΣΗΒ": 0.0031, "ΡΙΟΘ": 0.0031, "ΩΝΧΑ": 0.0031, "ΡΑΚΗ": 0.0031,
+	"ΗΠΑΛ": 0.0031, "ΣΝΑΑ": 0.0031, "ΤΩΣΕ": 0.0031, "ΑΝΗΕ": 0.0031, "ΡΟΧΩ": 0.0031,
+	"ΝΕΠΟ": 0.0031, "ΑΞΑΝ": 0.0031, "ΤΑΝΠ": 0.0031, "ΕΡΙΠ": 0.0031, "ΡΦΟΠ": 0.0031,
+	"ΦΟΠΟ": 0.0031, "ΛΕΞΤ": 0.0031, "ΠΩΜΑ": 0.0031, "ΕΙΦΘ": 0.0031, "ΠΟΥΟ": 0.0031,
+	"ΛΙΤΙ": 0.0031, "ΥΤΟΧ": 0.0031, "ΛΗΕΠ": 0.0031, "ΙΕΛΕ": 0.0031, "ΘΕΝΑ": 0.0031,
+	"ΙΔΙΛ": 0.0031, "ΔΙΛΗ": 0.0031, "ΙΛΗΓ": 0.0031, "ΠΤΩΣ": 0.0031, "ΟΜΗΧ": 0.0031,
+	"ΗΤΗΤ": 0.0031, "ΦΑΣΗ": 0.0031, "ΣΑΣΔ": 0.0031, "ΟΥΔΟ": 0.0031, "ΔΟΣΤ": 0.0031,
+	"ΝΟΠΑ": 0.0031, "ΙΜΕΚ": 0.0031, "ΙΟΕΧ": 0.0031, "ΙΟΤΑ": 0.0031, "ΝΔΗΜ": 0.0031,
+	"ΑΖΟΜ": 0.0031, "ΞΕΚΛ": 0.0031, "ΗΛΗΞ": 0.0031, "ΕΑΝΤ": 0.0031, "ΚΟΧΡ": 0.0031,
+	"ΟΛΗΛ": 0.0031, "ΚΩΝΤ": 0.0031, "ΗΣΝΕ": 0.0031, "ΙΩΔΗ": 0.0031, "ΗΔΗΣ": 0.0031,
+	"ΔΗΣΤ": 0.0031, "ΜΕΣΥ": 0.0031, "ΓΕΣΜ": 0.0031, "ΛΛΑΣ": 0.0031, "ΗΝΑΑ": 0.0031,
+	"ΝΩΜΙ": 0.0031, "ΩΜΙΑ": 0.0031, "ΟΓΗΖ": 0.0031, "ΓΗΖΗ": 0.0031, "ΟΤΡΑ": 0.0031,
+	"ΝΩΑΛ": 0.0031, "ΘΕΩΡ": 0.0031, "ΟΕΙΑ": 0.0031, "ΝΑΙΙ": 0.0031, "ΟΜΠΛ": 0.0031,
+	"ΥΜΕΕ": 0.0031, "ΑΝΣΗ": 0.0031, "ΤΕΣΜ": 0.0031, "ΣΚΕΣ": 0.0031, "ΔΗΠΛ": 0.0031,
+	"ΚΕΗΕ": 0.0031, "ΝΕΑΕ": 0.0031, "ΕΙΑΘ": 0.0031, "ΕΠΕΓ": 0.0031, "ΠΕΓΓ": 0.0031,
+	"ΑΦΟΝ": 0.0031, "ΦΟΝΤ": 0.0031, "ΙΜΗΡ": 0.0031, "ΙΟΕΝ": 0.0031, "ΡΤΩΘ": 0.0031,
+	"ΣΥΝΩ": 0.0031, "ΥΝΩΝ": 0.0031, "ΕΙΡΕ": 0.0031, "ΦΕΙΕ": 0.0031, "ΔΟΧΑ": 0.0031,
+	"ΣΣΩΛ": 0.0031, "ΠΟΑΙ": 0.0031, "ΠΕΣΟ": 0.0031, "ΛΥΓΛ": 0.0031, "ΥΓΛΩ": 0.0031,
+	"ΚΟΙΡ": 0.0031, "ΥΝΙΑ": 0.0031, "ΥΤΜΗ": 0.0031, "ΤΑΘΟ": 0.0031, "ΜΠΛΑ": 0.0027,
+	"ΠΛΑΓ": 0.0027, "ΛΜΠΑ": 0.0027, "ΑΜΠΡ": 0.0027, "ΣΟΙΜ": 0.0027, "ΜΟΥΡ": 0.0027,
+	"ΝΕΜΠ": 0.0027, "ΤΑΚΟ": 0.0027, "ΑΝΕΣ": 0.0027, "ΑΕΔΑ": 0.0027, "ΚΑΛΗ": 0.0027,
+	"ΤΟΒΑ": 0.0027, "ΕΡΤΟ": 0.0027, "ΚΕΛΙ": 0.0027, "ΕΛΙΑ": 0.0027, "ΝΕΤΙ": 0.0027,
+	"ΙΝΙΑ": 0.0027, "ΚΤΟΝ": 0.0027, "ΑΣΠΕ": 0.0027, "ΘΑΝΩ": 0.0027, "ΜΑΕΙ": 0.0027,
+	"ΓΗΣΟ": 0.0027, "ΗΚΕΝ": 0.0027, "ΕΙΑΔ": 0.0027, "ΔΕΝΚ": 0.0027, "ΡΒΑΙ": 0.0027,
+	"ΒΑΙΝ": 0.0027, "ΜΗΑΔ": 0.0027, "ΗΗΕΞ": 0.0027, "ΔΟΣΑ": 0.0027, "ΟΤΗΛ": 0.0027,
+	"ΝΑΕΞ": 0.0027, "ΕΙΠΙ": 0.0027, "ΥΝΑΥ": 0.0027, "ΗΝΑΙ": 0.0027, "ΣΒΡΙ": 0.0027,
+	"ΟΝΑΛ": 0.0027, "ΙΝΗΤ": 0.0027, "ΣΜΑΑ": 0.0027, "ΤΗΝΜ": 0.0027, "ΕΤΙΜ": 0.0027,
+	"ΣΕΙΜ": 0.0027, "ΧΕΑΣ": 0.0027, "ΠΛΟΤ": 0.0027, "ΛΟΤΥ": 0.0027, "ΤΥΠΗ": 0.0027,
+	"ΕΝΕΑ": 0.0027, "ΠΑΓΟ": 0.0027, "ΞΗΔΕ": 0.0027, "ΦΙΛΤ": 0.0027, "ΙΛΤΡ": 0.0027,
+	"ΟΚΡΙ": 0.0027, "ΟΣΕΩ": 0.0027, "ΗΡΟΥ": 0.0027, "ΤΙΝΑ": 0.0027, "ΟΣΕΡ": 0.0027,
+	"ΣΕΝΔ": 0.0027, "ΕΝΔΕ": 0.0027, "ΣΜΗΚ": 0.0027, "ΑΣΓΡ": 0.0027, "ΟΥΒΡ": 0.0027,
+	"ΠΟΑΡ": 0.0027, "ΚΕΙΣ": 0.0027, "ΑΛΩΝ": 0.0027, "ΕΠΕΣ": 0.0027, "ΠΕΣΤ": 0.0027,
+	"ΛΑΒΕ": 0.0027, "ΖΕΙΑ": 0.0027, "ΜΑΟΜ": 0.0027, "ΘΙΣΤ": 0.0027, "ΕΝΗΠ": 0.0027,
+	"ΜΗΣΜ": 0.0027, "ΕΤΟΧ": 0.0027, "ΣΤΗΘ": 0.0027, "ΟΣΑΛ": 0.0027, "ΟΣΚΡ": 0.0027,
+	"ΜΕΕΙ": 0.0027, "ΡΗΠΡ": 0.0027, "ΝΗΣΚ": 0.0027, "ΟΩΡΟ": 0.0027, "ΙΦΟΡ": 0.0027,
+	"ΙΟΧΙ": 0.0027, "ΤΡΗΤ": 0.0027, "ΓΗΥΠ": 0.0027, "ΔΕΙΓ": 0.0027, "ΕΙΓΜ": 0.0027,
+	"ΙΔΟΣ": 0.0027, "ΕΥΩΝ": 0.0027, "ΥΝΕΠ": 0.0027, "ΜΩΝΣ": 0.0027, "ΡΙΟΠ": 0.0027,
+	"ΣΤΑΞ": 0.0027, "ΑΠΛΕ": 0.0027, "ΠΛΕΣ": 0.0027, "ΚΗΕΠ": 0.0027, "ΙΕΣΓ": 0.0027,
+	"ΕΣΓΙ": 0.0027, "ΙΕΣΚ": 0.0027, "ΙΣΑΠ": 0.0027, "ΣΤΕΜ": 0.0027, "ΝΠΗΓ": 0.0027,
+	"ΓΗΚΛ": 0.0027, "ΩΝΤΗ": 0.0027, "ΙΔΙΠ": 0.0027, "ΘΕΙΠ": 0.0027, "ΔΙΑΙ": 0.0027,
+	"ΙΣΗΔ": 0.0027, "ΖΩΝΗ": 0.0027, "ΦΑΛΑ": 0.0027, "ΗΠΡΙ": 0.0027, "ΕΔΩΤ": 0.0027,
+	"ΝΤΙΤ": 0.0027, "ΥΝΣΤ": 0.0027, "ΕΠΡΕ": 0.0027, "ΓΕΙΤ": 0.0027, "ΤΙΣΙ": 0.0027,
+	"ΙΣΩΤ": 0.0027, "ΣΤΑΔ": 0.0027, "ΤΩΝΟ": 0.0027, "ΙΩΝΗ": 0.0027, "ΩΝΗΣ": 0.0027,
+	"ΥΤΗΝ": 0.0027, "ΝΑΔΩ": 0.0027, "ΘΗΚΩ": 0.0027, "ΗΚΩΝ": 0.0027, "ΕΙΕΓ": 0.0027,
+	"ΝΟΗΤ": 0.0027, "ΟΙΟΑ": 0.0027, "ΡΟΕΚ": 0.0027, "ΔΑΣΤ": 0.0027, "ΕΧΩΡ": 0.0027,
+	"ΝΟΜΟ": 0.0027, "ΚΟΓΡ": 0.0027, "ΤΗΛΙ": 0.0027, "ΝΗΘΗ": 0.0027, "ΗΝΑΦ": 0.0027,
+	"ΣΑΦΑ": 0.0027, "ΚΑΘΙ": 0.0027, "ΑΝΝΑ": 0.0027, "ΗΟΔΗ": 0.0027, "ΙΟΤΩ": 0.0027,
+	"ΟΜΑΖ": 0.0027, "ΡΟΑΡ": 0.0027, "ΩΝΕΧ": 0.0027, "ΕΤΕΚ": 0.0027, "ΙΜΕΡ": 0.0027,
+	"ΙΣΘΕ": 0.0027, "ΣΘΕΙ": 0.0027, "ΙΝΙΣ": 0.0027, "ΙΕΣΣ": 0.0027, "ΗΔΗΕ": 0.0027,
+	"ΔΟΔΕ": 0.0027, "ΑΙΚΛ": 0.0027, "ΣΣΕΜ": 0.0027, "ΡΓΟΥ": 0.0027, "ΟΤΟΛ": 0.0027,
+	"ΑΝΧΡ": 0.0027, "ΝΧΩΡ": 0.0027, "ΗΤΑΜ": 0.0027, "ΜΙΑΧ": 0.0027, "ΧΟΣΕ": 0.0027,
+	"ΗΘΥΓ": 0.0027, "ΠΗΚΕ": 0.0027, "ΚΑΛΕ": 0.0027, "ΑΕΧΟ": 0.0027, "ΕΙΗΠ": 0.0027,
+	"ΣΑΡΜ": 0.0027, "ΑΦΕΤ": 0.0027, "ΟΝΑΚ": 0.0027, "ΧΘΗΚ": 0.0027, "ΝΑΞΕ": 0.0027,
+	"ΟΗΤΑ": 0.0027, "ΕΝΟΗ": 0.0027, "ΚΟΙΣ": 0.0027, "ΟΡΥΦ": 0.0027, "ΙΟΕΠ": 0.0027,
+	"ΠΛΑΣ": 0.0027, "ΥΛΕΙ": 0.0027, "ΗΣΕΦ": 0.0027, "ΜΑΟΝ": 0.0027, "ΣΞΕΠ": 0.0027,
+	"ΜΑΕΚ": 0.0027, "ΑΣΛΕ": 0.0027, "ΟΣΩΜ": 0.0027, "ΝΟΥΓ": 0.0027, "ΥΤΟΑ": 0.0027,
+	"ΨΗΣΠ": 0.0027, "ΒΕΛΤ": 0.0027, "ΡΟΜΟ": 0.0027, "ΜΩΝΕ": 0.0027, "ΙΚΥΡ": 0.0027,
+	"ΤΑΝΓ": 0.0027, "ΥΜΑΕ": 0.0027, "ΓΗΑΡ": 0.0027, "ΣΓΝΩ": 0.0027, "ΥΘΑΕ": 0.0027,
+	"ΑΨΗΦ": 0.0027, "ΑΙΣΩ": 0.0027, "ΙΟΝΑ": 0.0027, "ΟΝΑΕ": 0.0027, "ΦΗΣΑ": 0.0027,
+	"ΣΦΟΡ": 0.0027, "ΗΤΗΑ": 0.0027, "ΗΔΗΚ": 0.0027, "ΛΑΣΤ": 0.0027, "ΟΔΟΜ": 0.0027,
+	"ΡΑΣΥ": 0.0027, "ΣΟΥΝ": 0.0027, "ΓΟΣΕ": 0.0027, "ΕΙΟΟ": 0.0027, "ΑΝΜΕ": 0.0027,
+	"ΧΕΙΧ": 0.0027, "ΝΕΥΘ": 0.0027, "ΙΜΗΥ": 0.0027, "ΜΙΚΑ": 0.0027, "ΕΑΝΔ": 0.0027,
+	"ΝΕΝΟ": 0.0027, "ΜΗΜΟ": 0.0027, "ΡΟΗΕ": 0.0027, "ΟΣΕΧ": 0.0027, "ΥΠΑΛ": 0.0027,
+	"ΜΕΟΝ": 0.0027, "ΑΓΗΔ": 0.0027, "ΝΩΣΙ": 0.0027, "ΣΟΦΕ": 0.0027, "ΕΙΛΟ": 0.0027,
+}
+
+var chainBigramsGR = map[string]float64{
+	"ΤΟ": 2.0611, "ΕΙ": 1.9464, "ΟΥ": 1.9019, "ΙΑ": 1.7690, "ΗΣ": 1.6560,
+	"ΝΑ": 1.6158, "ΑΤ": 1.5545, "ΣΤ": 1.4626, "ΙΚ": 1.4472, "ΕΝ": 1.3899,
+	"ΠΟ": 1.2905, "ΤΗ": 1.2766, "ΑΝ": 1.2741, "ΤΑ": 1.2566, "ΑΣ": 1.1968,
+	"ΜΑ": 1.1729, "ΟΣ": 1.1640, "ΚΑ": 1.1547, "ΙΣ": 1.1020, "ΜΕ": 1.0724,
+	"ΡΑ": 1.0607, "ΑΡ": 1.0468, "ΣΗ": 1.0311, "ΑΙ": 0.9976, "ΡΙ": 0.9078,
+	"ΕΤ": 0.8929, "ΝΟ": 0.8690, "ΑΠ": 0.8558, "ΚΟ": 0.8540, "ΤΙ": 0.8394,
+	"ΑΛ": 0.8273, "ΕΣ": 0.8195, "ΙΟ": 0.8159, "ΡΟ": 0.8113, "ΤΕ": 0.7927,
+	"ΝΕ": 0.7585, "ΙΝ": 0.7521, "ΑΚ": 0.7372, "ΗΜ": 0.7218, "ΔΙ": 0.7069,
+	"ΣΕ": 0.6798, "ΟΜ": 0.6630, "ΩΝ": 0.6566, "ΠΑ": 0.6502, "ΔΕ": 0.6463,
+	"ΟΝ": 0.6296, "ΕΡ": 0.6185, "ΜΟ": 0.6007, "ΧΕ": 0.5971, "ΥΝ": 0.5957,
+	"ΟΤ": 0.5886, "ΑΜ": 0.5879, "ΟΛ": 0.5619, "ΣΑ": 0.5465, "ΝΤ": 0.5348,
+	"ΥΠ": 0.5330, "ΚΕ": 0.5302, "ΕΠ": 0.5269, "ΟΡ": 0.5234, "ΛΟ": 0.5220,
+	"ΓΙ": 0.5180, "ΗΤ": 0.5180, "ΑΔ": 0.5148, "ΡΕ": 0.5141, "ΟΠ": 0.5098,
+	"ΛΑ": 0.5049, "ΛΗ": 0.4952, "ΩΣ": 0.4870, "ΑΓ": 0.4756, "ΟΙ": 0.4731,
+	"ΛΕ": 0.4699, "ΠΕ": 0.4682, "ΠΙ": 0.4664, "ΗΚ": 0.4632, "ΣΥ": 0.4596,
+	"ΜΗ": 0.4411, "ΟΓ": 0.4382, "ΙΤ": 0.4250, "ΜΙ": 0.4222, "ΥΣ": 0.4211,
+	"ΡΧ": 0.4204, "ΣΙ": 0.4169, "ΣΜ": 0.4169, "ΠΡ": 0.4094, "ΕΓ": 0.4015,
+	"ΙΕ": 0.3894, "ΔΟ": 0.3873, "ΑΦ": 0.3837, "ΙΜ": 0.3691, "ΝΙ": 0.3684,
+	"ΥΡ": 0.3645, "ΣΣ": 0.3645, "ΛΙ": 0.3641, "ΗΕ": 0.3570, "ΚΗ": 0.3556,
+	"ΓΡ": 0.3527, "ΕΚ": 0.3470, "ΟΚ": 0.3424, "ΑΑ": 0.3367, "ΝΗ": 0.3349,
+	"ΚΡ": 0.3260, "ΓΚ": 0.3228, "ΗΝ": 0.3189, "ΡΗ": 0.3135, "ΚΤ": 0.3068,
+	"ΤΡ": 0.3011, "ΟΔ": 0.3003, "ΟΑ": 0.2943, "ΦΑ": 0.2936, "ΥΜ": 0.2911,
+	"ΛΛ": 0.2897, "ΙΔ": 0.2893, "ΕΛ": 0.2879, "ΜΠ": 0.2793, "ΥΤ": 0.2758,
+	"ΔΥ": 0.2736, "ΑΕ": 0.2658, "ΝΔ": 0.2644, "ΟΕ": 0.2572, "ΗΓ": 0.2558,
+	"ΗΡ": 0.2537, "ΙΗ": 0.2490, "ΗΔ": 0.2473, "ΘΗ": 0.2473, "ΘΕ": 0.2462,
+	"ΝΩ": 0.2430, "ΔΗ": 0.2426, "ΙΛ": 0.2412, "ΧΡ": 0.2391, "ΣΚ": 0.2380,
+	"ΡΓ": 0.2359, "ΗΑ": 0.2327, "ΣΟ": 0.2294, "ΤΥ": 0.2291, "ΕΥ": 0.2252,
+	"ΛΩ": 0.2213, "ΓΑ": 0.2184, "ΚΛ": 0.2166, "ΕΧ": 0.2138, "ΤΩ": 0.2120,
+	"ΓΗ": 0.2113, "ΥΑ": 0.2106, "ΑΥ": 0.2009, "ΣΠ": 0.1963, "ΙΠ": 0.1935,
+	"ΦΟ": 0.1846, "ΓΛ": 0.1842, "ΓΕ": 0.1835, "ΠΛ": 0.1817, "ΕΞ": 0.1796,
+	"ΓΟ": 0.1732, "ΟΗ": 0.1692, "ΙΩ": 0.1689, "ΙΡ": 0.1664, "ΒΑ": 0.1657,
+	"ΦΗ": 0.1650, "ΕΑ": 0.1639, "ΒΟ": 0.1628, "ΑΘ": 0.1596, "ΧΙ": 0.1568,
+	"ΡΩ": 0.1568, "ΚΥ": 0.1561, "ΣΦ": 0.1521, "ΓΓ": 0.1521, "ΧΟ": 0.1518,
+	"ΗΠ": 0.1514, "ΕΜ": 0.1507, "ΥΧ": 0.1507, "ΝΥ": 0.1504, "ΑΒ": 0.1489,
+	"ΣΔ": 0.1454, "ΕΔ": 0.1436, "ΚΙ": 0.1404, "ΥΓ": 0.1400, "ΑΧ": 0.1350,
+	"ΩΡ": 0.1347, "ΛΜ": 0.1340, "ΥΘ": 0.1333, "ΙΓ": 0.1304, "ΜΜ": 0.1297,
+	"ΕΟ": 0.1293, "ΘΜ": 0.1272, "ΟΧ": 0.1265, "ΑΟ": 0.1190, "ΝΣ": 0.1176,
+	"ΧΑ": 0.1172, "ΡΜ": 0.1165, "ΗΘ": 0.1154, "ΗΗ": 0.1151, "ΓΝ": 0.1151,
+	"ΒΙ": 0.1129, "ΟΘ": 0.1104, "ΡΥ": 0.1090, "ΧΩ": 0.1087, "ΘΟ": 0.1083,
+	"ΝΠ": 0.1080, "ΟΒ": 0.1065, "ΟΟ": 0.1047, "ΔΑ": 0.1040, "ΥΕ": 0.1033,
+	"ΥΚ": 0.1030, "ΣΓ": 0.1026, "ΖΕ": 0.0912, "ΛΥ": 0.0894, "ΙΘ": 0.0894,
+	"ΚΩ": 0.0891, "ΝΜ": 0.0887, "ΡΤ": 0.0884, "ΑΖ": 0.0884, "ΝΓ": 0.0869,
+	"ΣΧ": 0.0866, "ΕΘ": 0.0859, "ΝΚ": 0.0855, "ΣΩ": 0.0844, "ΠΗ": 0.0844,
+	"ΒΡ": 0.0819, "ΩΜ": 0.0812, "ΩΤ": 0.0802, "ΙΧ": 0.0795, "ΙΖ": 0.0791,
+	"ΗΛ": 0.0755, "ΕΩ": 0.0748, "ΖΙ": 0.0730, "ΥΔ": 0.0730, "ΘΑ": 0.0705,
+	"ΞΕ": 0.0702, "ΜΒ": 0.0698, "ΕΦ": 0.0691, "ΟΦ": 0.0677, "ΖΑ": 0.0659,
+	"ΥΛ": 0.0659, "ΕΕ": 0.0652, "ΨΗ": 0.0641, "ΗΧ": 0.0631, "ΝΘ": 0.0624,
+	"ΓΩ": 0.0620, "ΞΑ": 0.0606, "ΩΔ": 0.0606, "ΦΕ": 0.0599, "ΥΟ": 0.0584,
+	"ΓΜ": 0.0574, "ΠΤ": 0.0570, "ΒΕ": 0.0566, "ΙΒ": 0.0556, "ΣΛ": 0.0556,
+	"ΗΟ": 0.0556, "ΓΧ": 0.0545, "ΑΞ": 0.0517, "ΤΖ": 0.0513, "ΖΟ": 0.0513,
+	"ΦΙ": 0.0499, "ΔΡ": 0.0492, "ΣΘ": 0.0481, "ΠΩ": 0.0474, "ΣΝ": 0.0474,
+	"ΚΔ": 0.0467, "ΒΛ": 0.0467, "ΞΟ": 0.0463, "ΘΥ": 0.0460, "ΧΗ": 0.0449,
+	"ΞΗ": 0.0435, "ΗΥ": 0.0431, "ΔΩ": 0.0420, "ΜΦ": 0.0403, "ΩΓ": 0.0399,
+	"ΕΖ": 0.0395, "ΣΒ": 0.0392, "ΗΨ": 0.0385, "ΦΡ": 0.0385, "ΦΩ": 0.0374,
+	"ΕΒ": 0.0353, "ΡΒ": 0.0346, "ΤΣ": 0.0342, "ΘΩ": 0.0324, "ΡΚ": 0.0321,
+	"ΥΙ": 0.0321, "ΚΚ": 0.0321, "ΖΗ": 0.0317, "ΛΓ": 0.0314, "ΡΘ": 0.0303,
+	"ΝΝ": 0.0299, "ΑΗ": 0.0289, "ΕΗ": 0.0289, "ΝΧ": 0.0278, "ΗΦ": 0.0267,
+	"ΥΦ": 0.0267, "ΞΙ": 0.0260, "ΡΝ": 0.0257, "ΥΗ": 0.0257, "ΩΘ": 0.0246,
+	"ΡΦ": 0.0242, "ΙΦ": 0.0239, "ΒΗ": 0.0232, "ΜΝ": 0.0228, "ΤΛ": 0.0221,
+	"ΛΒ": 0.0221, "ΨΕ": 0.0214, "ΛΤ": 0.0214, "ΝΒ": 0.0210, "ΜΩ": 0.0210,
+	"ΚΦ": 0.0203, "ΣΡ": 0.0200, "ΦΘ": 0.0196, "ΡΡ": 0.0192, "ΘΙ": 0.0189,
+	"ΗΞ": 0.0185, "ΩΑ": 0.0178, "ΙΥ": 0.0178, "ΞΤ": 0.0175, "ΦΥ": 0.0175,
+	"ΡΔ": 0.0167, "ΗΒ": 0.0167, "ΥΒ": 0.0160, "ΝΛ": 0.0160, "ΞΥ": 0.0153,
+	"ΥΥ": 0.0150, "ΩΠ": 0.0150, "ΙΨ": 0.0150, "ΚΜ": 0.0150, "ΧΝ": 0.0146,
+	"ΕΨ": 0.0143, "ΩΛ": 0.0143, "ΡΛ": 0.0132, "ΓΥ": 0.0128, "ΚΣ": 0.0128,
+	"ΙΞ": 0.0121, "ΧΘ": 0.0121, "ΤΜ": 0.0118, "ΡΣ": 0.0114, "ΚΠ": 0.0114,
+	"ΦΤ": 0.0100, "ΥΩ": 0.0096, "ΩΕ": 0.0096, "ΚΧ": 0.0089, "ΧΤ": 0.0089,
+	"ΤΤ": 0.0086, "ΑΩ": 0.0086, "ΗΙ": 0.0086, "ΑΨ": 0.0086, "ΟΩ": 0.0082,
+	"ΘΝ": 0.0082, "ΚΝ": 0.0075, "ΤΒ": 0.0075, "ΩΚ": 0.0071, "ΝΖ": 0.0071,
+	"ΞΩ": 0.0068, "ΠΧ": 0.0068, "ΝΦ": 0.0068, "ΒΩ": 0.0064, "ΩΧ": 0.0064,
+	"ΥΨ": 0.0061, "ΙΙ": 0.0061, "ΜΥ": 0.0061, "ΟΖ": 0.0061, "ΥΖ": 0.0061,
+	"ΥΞ": 0.0057, "ΟΨ": 0.0057, "ΦΛ": 0.0053, "ΗΩ": 0.0053, "ΛΔ": 0.0053,
+	"ΣΖ": 0.0053, "ΛΦ": 0.0050, "ΠΠ": 0.0046, "ΖΩ": 0.0046, "ΧΥ": 0.0046,
+	"ΦΓ": 0.0043, "ΛΣ": 0.0043, "ΤΝ": 0.0043, "ΚΓ": 0.0043, "ΘΡ": 0.0043,
+	"ΤΚ": 0.0043, "ΗΖ": 0.0043, "ΣΞ": 0.0039, "ΖΜ": 0.0039, "ΠΝ": 0.0039,
+	"ΓΞ": 0.0039, "ΜΨ": 0.0039, "ΟΞ": 0.0036, "ΡΞ": 0.0036, "ΨΑ": 0.0036,
+	"ΨΙ": 0.0032, "ΝΡ": 0.0032, "ΛΠ": 0.0029, "ΝΞ": 0.0025, "ΒΥ": 0.0025,
+	"ΩΥ": 0.0025, "ΩΒ": 0.0021, "ΜΛ": 0.0021, "ΩΟ": 0.0021, "ΨΟ": 0.0021,
+	"ΧΧ": 0.0021, "ΠΓ": 0.0021, "ΤΘ": 0.0018, "ΔΚ": 0.0018, "ΡΠ": 0.0018,
+	"ΡΖ": 0.0014, "ΛΘ": 0.0014, "ΠΥ": 0.0014, "ΒΔ": 0.0014, "ΠΚ": 0.0014,
+}
+
+var quadgramsRU = map[string]float64{
+	"ФАЙЛ": 0.3671, "ЕНИЕ": 0.3523, "ЕТСЯ": 0.2864, "ПОЛЬ": 0.2679, "ОЛЬЗ": 0.2644,
+	"ЕНИЯ": 0.2587, "ОВАТ": 0.2245, "СПОЛ": 0.2235, "ВАТЬ": 0.2189, "ИСПО": 0.2184,
+	"УДАЛ": 0.2127, "СТРО": 0.2016, "ЗОВА": 0.1981, "ПЕРЕ": 0.1932, "КЛЮЧ": 0.1807,
+	"ЛЬЗО": 0.1786, "ЬЗОВ": 0.1786, "ОВАН": 0.1696, "ПРЕД": 0.1677, "ЕУДА": 0.1647,
+	"РОВА": 0.1632, "НЕУД": 0.1617, "ИРОВ": 0.1607, "АННЫ": 0.1585, "ЛОСЬ": 0.1513,
+	"ОШИБ": 0.1502, "ЧЕНИ": 0.1501, "АЛОС": 0.1499, "АЕТС": 0.1471, "ДЕРЖ": 0.1469,
+	"СИМВ": 0.1446, "ДАЛО": 0.1444, "ИМВО": 0.1443, "МВОЛ": 0.1433, "КАЗА": 0.1416,
+	"ШИБК": 0.1401, "НОГО": 0.1348, "СКИЙ": 0.1335, "СТАН": 0.1320, "АМЕТ": 0.1278,
+	"ПРАВ": 0.1271, "ПАРА": 0.1254, "ЗНАЧ": 0.1245, "ЩЕНИ": 0.1209, "ВАНИ": 0.1208,
+	"ИБКА": 0.1194, "ЕРЖИ": 0.1189, "МЕНТ": 0.1187, "ПОЛН": 0.1174, "СКАЯ": 0.1164,
+	"МЕТР": 0.1155, "РАМЕ": 0.1149, "АРАМ": 0.1148, "НАЧЕ": 0.1129, "МОЖН": 0.1109,
+	"РЕМЕ": 0.1108, "ФОРМ": 0.1108, "ЛЕНИ": 0.1097, "ТРОК": 0.1097, "АЧЕН": 0.1095,
+	"УКАЗ": 0.1082, "ОБРА": 0.1081, "АЙЛА": 0.1075, "ДАНН": 0.1074, "ОЖНО": 0.1072,
+	"ЛЬНЫ": 0.1069, "РАЗД": 0.1061, "ТЕЛЬ": 0.1050, "ОРМА": 0.1050, "ЗДЕЛ": 0.1048,
+	"АЗДЕ": 0.1046, "АТЕЛ": 0.1039, "ПУСТ": 0.1024, "ЕЩЕН": 0.1020, "ЕННЫ": 0.1014,
+	"АНИЕ": 0.0998, "ННЫЙ": 0.0988, "ВЛЕН": 0.0973, "ОПЕР": 0.0963, "ЛЬНО": 0.0955,
+	"УЕТС": 0.0955, "НЕНИ": 0.0955, "ОМАН": 0.0935, "МАНД": 0.0928, "ЗМОЖ": 0.0925,
+	"СТВУ": 0.0925, "ЕЛЬН": 0.0924, "ВОЗМ": 0.0922, "ОЗМО": 0.0922, "ЖЕНИ": 0.0919,
+	"ЕМЕН": 0.0917, "КОМА": 0.0915, "МЕЩЕ": 0.0909, "КАТА": 0.0880, "АЛЬН": 0.0878,
+	"ЕСЛИ": 0.0877, "ОПУС": 0.0873, "ИМЕН": 0.0867, "РАБО": 0.0865, "АНОВ": 0.0863,
+	"ИСТР": 0.0859, "НЕПО": 0.0859, "ВЕРН": 0.0855, "ТАНО": 0.0854, "ЛЬЗУ": 0.0844,
+	"ОЛЬК": 0.0839, "ИЧЕС": 0.0820, "ЛЬКО": 0.0816, "ННЫХ": 0.0813, "ТАЛО": 0.0810,
+	"АПИС": 0.0807, "ПЕРА": 0.0798, "ЕРЕМ": 0.0794, "ВЕСТ": 0.0794, "ГИСТ": 0.0790,
+	"ЗАПИ": 0.0790, "ЕВЕР": 0.0786, "АЛОГ": 0.0786, "НЕВО": 0.0783, "АЗАН": 0.0782,
+	"ЕВОЗ": 0.0778, "УСТИ": 0.0773, "АТАЛ": 0.0771, "РЕГИ": 0.0770, "ВАЕТ": 0.0769,
+	"ИВАЕ": 0.0767, "ЕГИС": 0.0767, "БЫТЬ": 0.0766, "УСТА": 0.0764, "НЕДО": 0.0762,
+	"ФИКА": 0.0762, "ИФИК": 0.0760, "ПОДД": 0.0760, "ДОЛЖ": 0.0759, "АНИЯ": 0.0757,
+	"ОДДЕ": 0.0756, "ДДЕР": 0.0749, "АТЬС": 0.0729, "АЙЛО": 0.0728, "ЕДЕЛ": 0.0727,
+	"СТРУ": 0.0724, "ЖИВА": 0.0723, "ИЗМЕ": 0.0721, "ЕПОД": 0.0714, "ОДЕР": 0.0713,
+	"СОЗД": 0.0712, "ОЗДА": 0.0712, "ЕННО": 0.0710, "ТНЫЙ": 0.0709, "ЗМЕН": 0.0708,
+	"МЕНЕ": 0.0704, "АКЕТ": 0.0699, "ПАКЕ": 0.0698, "ВАТЕ": 0.0697, "ННЫЕ": 0.0694,
+	"АБОТ": 0.0693, "ТРУК": 0.0691, "СОДЕ": 0.0689, "ЕСТВ": 0.0689, "РЕКТ": 0.0686,
+	"РЕДЕ": 0.0679, "ДЕЛЕ": 0.0676, "ТОЛЬ": 0.0675, "НЕВЕ": 0.0673, "СТИМ": 0.0673,
+	"ЧЕСК": 0.0672, "ТСТВ": 0.0669, "НСТР": 0.0668, "ТИФИ": 0.0667, "ИНСТ": 0.0666,
+	"ИТЕЛ": 0.0664, "ЫПОЛ": 0.0664, "ДОПУ": 0.0664, "ЗАДА": 0.0661, "ПОСЛ": 0.0657,
+	"ОСЛЕ": 0.0655, "ЕСТН": 0.0647, "ОЖИД": 0.0644, "ЖИДА": 0.0644, "ДЕНИ": 0.0643,
+	"РЕЖД": 0.0642, "МЕНИ": 0.0640, "РУКЦ": 0.0637, "УКЦИ": 0.0637, "ИКАТ": 0.0636,
+	"РЖИВ": 0.0632, "УМЕН": 0.0625, "ТВУЕ": 0.0625, "ВУЕТ": 0.0625, "ВЫВО": 0.0624,
+	"ЫВОД": 0.0624, "ЖДЕН": 0.0622, "ОПРЕ": 0.0619, "ЙЛОВ": 0.0610, "ВЫПО": 0.0609,
+	"ЕРСИ": 0.0603, "ВЕРС": 0.0602, "ЧАНИ": 0.0601, "ЯЕТС": 0.0600, "ОВЕР": 0.0598,
+	"ДОСТ": 0.0598, "ВИТЬ": 0.0598, "ЕКТН": 0.0596, "АНДА": 0.0594, "ЕМЕЩ": 0.0594,
+	"ЕЖДЕ": 0.0593, "ДЕЛА": 0.0591, "ИЗВЕ": 0.0586, "НЕКО": 0.0577, "ЕНЕН": 0.0574,
+	"РАЗМ": 0.0573, "НЕИЗ": 0.0571, "НЕПР": 0.0570, "МЕСТ": 0.0569, "ДАЕТ": 0.0569,
+	"ОДНО": 0.0566, "НИЕП": 0.0564, "ЕЛЕН": 0.0564, "ПОКА": 0.0563, "НОСТ": 0.0562,
+	"ДАТЬ": 0.0560, "ИНФО": 0.0557, "ОГРА": 0.0556, "АЦИИ": 0.0555, "ЗВЕС": 0.0555,
+	"АЗМЕ": 0.0554, "СЛЕД": 0.0549, "ЗМЕР": 0.0545, "ОЛОВ": 0.0543, "ВРЕМ": 0.0543,
+	"ЧИТЬ": 0.0543, "МАЦИ": 0.0543, "НФОР": 0.0541, "СПИС": 0.0541, "ЕИЗВ": 0.0540,
+	"РМАЦ": 0.0538, "КОРР": 0.0537, "ОРРЕ": 0.0536, "РРЕК": 0.0533, "РЕЖИ": 0.0530,
+	"ЕЖИМ": 0.0530, "ЕДОП": 0.0528, "ССЫЛ": 0.0526, "АРХИ": 0.0524, "НИТЬ": 0.0523,
+	"ОСТА": 0.0519, "ЙСТВ": 0.0519, "ХОДН": 0.0519, "АНИЮ": 0.0517, "АННО": 0.0513,
+	"АЗАТ": 0.0512, "ЛЯЕТ": 0.0512, "ТАТЬ": 0.0509, "КЦИИ": 0.0508, "ЕКОР": 0.0507,
+	"ЬЗУЕ": 0.0504, "АВЕР": 0.0501, "РЕДУ": 0.0501, "ПРЕЖ": 0.0501, "МОЖЕ": 0.0500,
+	"ИСТЕ": 0.0499, "ЬНЫЙ": 0.0496, "ОКАЗ": 0.0496, "АДАН": 0.0493, "МОЛЧ": 0.0489,
+	"УПРЕ": 0.0488, "ОЛЧА": 0.0488, "ОЖЕТ": 0.0487, "ДУПР": 0.0487, "АЖЕН": 0.0487,
+	"ПОУМ": 0.0487, "ОУМО": 0.0487, "УМОЛ": 0.0487, "ЕДУП": 0.0486, "ЛЧАН": 0.0486,
+	"ОБЪЕ": 0.0482, "ЛЮЧЕ": 0.0478, "ЕРАН": 0.0475, "ВАНН": 0.0475, "РАВИ": 0.0474,
+	"АДРЕ": 0.0473, "НОВЛ": 0.0473, "КОММ": 0.0471, "НАСТ": 0.0471, "АСТР": 0.0469,
+	"ДРЕС": 0.0469, "ЙФАЙ": 0.0469, "ДЛЯП": 0.0468, "РМАТ": 0.0467, "ОСТИ": 0.0465,
+	"СУТС": 0.0465, "УТСТ": 0.0465, "ПОЛУ": 0.0464, "ЕНИЙ": 0.0464, "ОВЛЕ": 0.0464,
+	"КОТО": 0.0462, "ЕНТИ": 0.0462, "РАЖЕ": 0.0461, "ОДИТ": 0.0458, "РАНД": 0.0457,
+	"ОЛУЧ": 0.0456, "НСКИ": 0.0455, "ОПРО": 0.0453, "СТЕМ": 0.0452, "ПУСК": 0.0450,
+	"ОТСУ": 0.0449, "ЧИСЛ": 0.0449, "АРГУ": 0.0449, "ТСУТ": 0.0449, "ЗАВЕ": 0.0448,
+	"ТЕЛЯ": 0.0448, "АЦИЮ": 0.0448, "РГУМ": 0.0447, "ГУМЕ": 0.0447, "СИСТ": 0.0446,
+	"ЛИТЬ": 0.0446, "ВЕРШ": 0.0445, "АПРИ": 0.0445, "ЛЕНН": 0.0444, "НИЕВ": 0.0443,
+	"РОЦЕ": 0.0442, "ОТОР": 0.0440, "КОМП": 0.0440, "ЛЮЧА": 0.0440, "БАЙТ": 0.0440,
+	"КРЫТ": 0.0440, "АТЬВ": 0.0439, "РОКИ": 0.0438, "ЗАТЬ": 0.0438, "ПРОЦ": 0.0437,
+	"ИТЬС": 0.0437, "СТРА": 0.0435, "ВЛЯЕ": 0.0435, "ЮТСЯ": 0.0434, "ИНДЕ": 0.0431,
+	"ВЫРА": 0.0430, "ТСЯВ": 0.0429, "ЛЮЧИ": 0.0429, "ПИСИ": 0.0429, "ЗУЕТ": 0.0427,
+	"БЛОК": 0.0426, "СТВО": 0.0425, "ОПОЛ": 0.0425, "ОРИР": 0.0425, "ИГНО": 0.0424,
+	"ГНОР": 0.0424, "НОРИ": 0.0424, "ДЕКС": 0.0420, "НДЕК": 0.0419, "ЕСТИ": 0.0417,
+	"ИТЬП": 0.0417, "РНЫЙ": 0.0416, "РОВЕ": 0.0416, "АТЬП": 0.0415, "НТИФ": 0.0412,
+	"ОЖЕН": 0.0412, "ЕНИИ": 0.0412, "ЯЗЫК": 0.0412, "ОБЩЕ": 0.0411, "ТЬСЯ": 0.0410,
+	"РИРО": 0.0410, "КАТО": 0.0410, "АТОР": 0.0410, "НИЕН": 0.0410, "РАЦИ": 0.0408,
+	"ГРАМ": 0.0408, "ОЦЕС": 0.0408, "ЦЕСС": 0.0408, "ЕРНЫ": 0.0407, "АТЬК": 0.0406,
+	"ЛЕМЕ": 0.0406, "ПОДП": 0.0405, "ЧИТА": 0.0404, "СЛИШ": 0.0404, "ОЙСТ": 0.0404,
+	"АВИЛ": 0.0403, "ЛИШК": 0.0403, "ИШКО": 0.0403, "РАММ": 0.0403, "ОЛНЕ": 0.0402,
+	"СТУП": 0.0401, "ШКОМ": 0.0401, "НЕОБ": 0.0400, "ОСТО": 0.0399, "ОЛЖН": 0.0399,
+	"ООБЩ": 0.0398, "ЕЗНА": 0.0398, "АТЬИ": 0.0398, "ТРЕБ": 0.0397, "ЕСКО": 0.0397,
+	"БЪЕК": 0.0396, "ЪЕКТ": 0.0396, "ОСТУ": 0.0394, "ОЗНА": 0.0393, "ПРОГ": 0.0393,
+	"РОГР": 0.0391, "ПИСЬ": 0.0390, "НОВИ": 0.0390, "ТЬПО": 0.0390, "АЛЕН": 0.0389,
+	"ЭЛЕМ": 0.0389, "ТОРЫ": 0.0386, "СЫЛК": 0.0386, "ЗЫВА": 0.0384, "ПРОВ": 0.0383,
+	"ОВИТ": 0.0383, "ОБНО": 0.0382, "НИЕС": 0.0382, "ИРУЕ": 0.0382, "ЛНЕН": 0.0380,
+	"РАНИ": 0.0379, "ХОДИ": 0.0378, "ДАЛЕ": 0.0378, "ЕФАЙ": 0.0377, "ДПИС": 0.0376,
+	"АЙЛЫ": 0.0376, "РЖИТ": 0.0375, "ЯВЛЯ": 0.0375, "СТВИ": 0.0374, "СООБ": 0.0374,
+	"ОДПИ": 0.0374, "АВЛЕ": 0.0374, "ГРУП": 0.0372, "РУПП": 0.0372, "ЛОЖЕ": 0.0370,
+	"ННАЯ": 0.0368, "ЗАПР": 0.0367, "ДЛИН": 0.0367, "НЫЙР": 0.0366, "ОМЕР": 0.0364,
+	"ННОЕ": 0.0364, "ЕТРЫ": 0.0364, "ВМЕС": 0.0363, "ДАНИ": 0.0362, "НОМЕ": 0.0361,
+	"МЕНН": 0.0361, "РАВН": 0.0360, "СКИЕ": 0.0358, "ОВРЕ": 0.0358, "РЕБУ": 0.0357,
+	"НЕРА": 0.0356, "СТВЕ": 0.0355, "ЙДЕН": 0.0355, "ПРИМ": 0.0352, "РИМЕ": 0.0352,
+	"ВОДИ": 0.0351, "ДИАП": 0.0351, "ИАПА": 0.0351, "АПАЗ": 0.0351, "ПАЗО": 0.0351,
+	"АЗОН": 0.0351, "ЗАНН": 0.0350, "НЫЙП": 0.0349, "ННОГ": 0.0349, "ЕПРА": 0.0348,
+	"ОМПО": 0.0348, "ОТКР": 0.0347, "УЮЩИ": 0.0347, "ПИСА": 0.0346, "ОСЬП": 0.0346,
+	"СТАВ": 0.0345, "ЕЙСТ": 0.0345, "ОЛНИ": 0.0344, "АДЛЯ": 0.0343, "КТНЫ": 0.0342,
+	"ЗАПУ": 0.0342, "ЛИКА": 0.0341, "ЕДЛЯ": 0.0341, "НАЙД": 0.0340, "ИЛЬН": 0.0340,
+	"ВТОР": 0.0340, "АЙДЕ": 0.0339, "ДЕЙС": 0.0339, "ЫЙФА": 0.0339, "ПРОС": 0.0338,
+	"ТКРЫ": 0.0338, "ВИЛЬ": 0.0337, "ЕНТА": 0.0337, "АЦИЯ": 0.0336, "ИДАЕ": 0.0336,
+	"УСКА": 0.0334, "ЛНИТ": 0.0333, "БУДЕ": 0.0333, "УДЕТ": 0.0333, "ДОБА": 0.0332,
+	"ТЬКО": 0.0332, "БКАП": 0.0331, "ТОБЫ": 0.0331, "ЗЫКИ": 0.0331, "БРАБ": 0.0330,
+	"ОБАВ": 0.0330, "ЩЕСТ": 0.0330, "НИЯП": 0.0330, "ВАЕМ": 0.0330, "НЕНА": 0.0328,
+	"КАПР": 0.0327, "АПРО": 0.0327, "ОЛНЯ": 0.0327, "ТСЯП": 0.0327, "СЛОВ": 0.0327,
+	"НЫЙС": 0.0326, "ЫВАТ": 0.0326, "СУЩЕ": 0.0326, "УЩЕС": 0.0326, "РАСП": 0.0325,
+	"АПУС": 0.0325, "ЧТОБ": 0.0325, "ВОЛО": 0.0324, "ТЬПР": 0.0324, "ЕРАЦ": 0.0323,
+	"ЕБУЕ": 0.0322, "ОГОП": 0.0322, "УБЛИ": 0.0321, "ТИТЬ": 0.0321, "ИЕНЕ": 0.0320,
+	"НЫЙФ": 0.0320, "ТИМЫ": 0.0320, "ЛОВО": 0.0319, "РЕСП": 0.0317, "НИЕД": 0.0317,
+	"ВКЛЮ": 0.0316, "ЫРАЖ": 0.0316, "ТРОВ": 0.0315, "ТЕНИ": 0.0315, "РХИВ": 0.0315,
+	"НЕСО": 0.0314, "ЗДАТ": 0.0314, "ЧТЕН": 0.0314, "ПРОП": 0.0313, "ОЛЖЕ": 0.0313,
+	"ЛЖЕН": 0.0313, "БЩЕН": 0.0312, "ВЫХО": 0.0312, "АЕМЫ": 0.0312, "ЧЕСТ": 0.0311,
+	"НИЕМ": 0.0311, "НИЯВ": 0.0311, "АВЛЯ": 0.0311, "РИРУ": 0.0311, "ОММИ": 0.0311,
+	"РУЕТ": 0.0310, "НОВЫ": 0.0310, "ТИРО": 0.0310, "ВХОД": 0.0310, "БРАЗ": 0.0309,
+	"ЗАМЕ": 0.0309, "БНОВ": 0.0309, "БЛИК": 0.0308, "МЕТК": 0.0308, "РОПУ": 0.0308,
+	"ПУБЛ": 0.0307, "ЫЙРЕ": 0.0307, "ОФАЙ": 0.0307, "ТСЯН": 0.0307, "УЙТЕ": 0.0306,
+	"ЕСПУ": 0.0305, "НАЧА": 0.0305, "ЬФАЙ": 0.0305, "ММИТ": 0.0305, "ЮЧЕН": 0.0304,
+	"ТЕКС": 0.0304, "НЫЙК": 0.0304, "ННОЙ": 0.0304, "СПУБ": 0.0303, "СТИТ": 0.0303,
+	"ЕТРА": 0.0303, "СМЕЩ": 0.0303, "ЕПЕР": 0.0302, "БУЕТ": 0.0301, "ТБЫТ": 0.0300,
+	"ЕНЕР": 0.0300, "ИСОК": 0.0300, "ОЕЗН": 0.0300, "СПРА": 0.0300, "НОВК": 0.0300,
+	"ЕРЕД": 0.0299, "ТРОЙ": 0.0298, "ЕКСТ": 0.0297, "ПАРО": 0.0296, "ЕПОЛ": 0.0296,
+	"РАВЛ": 0.0296, "КАНЕ": 0.0295, "ЕИМЕ": 0.0295, "ГОЛО": 0.0295, "ЕРНО": 0.0294,
+	"АНДЫ": 0.0294, "ТЬРА": 0.0294, "ТВУЮ": 0.0294, "РОКА": 0.0293, "ТОЧН": 0.0292,
+	"СТНЫ": 0.0291, "АРОЛ": 0.0290, "ТВЕТ": 0.0290, "РАВК": 0.0290, "ТОРО": 0.0290,
+	"СТАР": 0.0290, "ЛОКА": 0.0290, "СТОЯ": 0.0288, "ЬПРО": 0.0288, "РЕПО": 0.0288,
+	"ЗДАН": 0.0288, "ЮЧИТ": 0.0287, "ИТАТ": 0.0287, "АГОЛ": 0.0287, "ЛАСТ": 0.0286,
+	"ИТЬВ": 0.0286, "ЯДЛЯ": 0.0286, "ЗАГО": 0.0286, "ОГОВ": 0.0285, "РЕНН": 0.0284,
+	"ЫХОД": 0.0284, "АЛИТ": 0.0284, "ПИСО": 0.0284, "АСТЬ": 0.0283, "ИДЕН": 0.0283,
+	"ПЕРВ": 0.0283, "АПРЕ": 0.0282, "ДАЛИ": 0.0282, "ИСКА": 0.0281, "ЬНЫЕ": 0.0281,
+	"ЕРВЕ": 0.0280, "ЕСТО": 0.0280, "ОСТЬ": 0.0280, "ПРОБ": 0.0280, "ОБЛА": 0.0279,
+	"БЛАС": 0.0278, "КАЗЫ": 0.0278, "АЗЫВ": 0.0278, "ЕПАР": 0.0278, "ГЕНЕ": 0.0277,
+	"ДЕНТ": 0.0276, "НИМА": 0.0276, "ЕРАЗ": 0.0276, "ЯФАЙ": 0.0276, "ТСЯС": 0.0276,
+	"ЕРТИ": 0.0275, "БРАТ": 0.0275, "ЫВАЕ": 0.0275, "ИТЕК": 0.0275, "РВЕР": 0.0274,
+	"АВИТ": 0.0274, "ОТВЕ": 0.0274, "АГРУ": 0.0274, "МПОН": 0.0274, "ЕИСП": 0.0273,
+	"ОДНЫ": 0.0273, "ОВКА": 0.0273, "ОЛЬШ": 0.0271, "БОТА": 0.0271, "СОСТ": 0.0271,
+	"ВРЕЖ": 0.0271, "БОЛЬ": 0.0270, "ВЕНН": 0.0270, "ТЬФА": 0.0270, "ТНОЕ": 0.0270,
+	"АСПО": 0.0270, "УЖЕН": 0.0270, "ЗАГР": 0.0270, "НИЯС": 0.0270, "ЯОШИ": 0.0270,
+	"ЕСКИ": 0.0269, "НЕМО": 0.0269, "КТИВ": 0.0269, "РЕДС": 0.0268, "РАЗР": 0.0267,
+	"ТОРИ": 0.0266, "ДЕЛИ": 0.0266, "ЕОБР": 0.0266, "РСИИ": 0.0266, "НИЕИ": 0.0266,
+	"СПЕЦ": 0.0266, "ЕРАС": 0.0265, "АНСК": 0.0264, "ТАБЛ": 0.0264, "ЕКОМ": 0.0264,
+	"ЭТОТ": 0.0263, "ЕДИН": 0.0261, "ИСЛО": 0.0261, "АБЛИ": 0.0260, "ОДИМ": 0.0260,
+	"ИДАН": 0.0259, "АТЬО": 0.0259, "АЮТС": 0.0259, "ДНОЙ": 0.0259, "КРАЙ": 0.0258,
+	"ЕМЫЙ": 0.0258, "ПОРТ": 0.0257, "НУТР": 0.0257, "БЛИЦ": 0.0257, "РУЖЕ": 0.0257,
+	"ПИСК": 0.0257, "ОВЫЙ": 0.0256, "ЙТИП": 0.0256, "ЮЩИЕ": 0.0255, "ВНУТ": 0.0254,
+	"ТЬНЕ": 0.0254, "ВОДА": 0.0254, "ПРИН": 0.0253, "РЕДА": 0.0253, "ИФАЙ": 0.0253,
+	"ОЛЬН": 0.0252, "ЕМОЖ": 0.0252, "ИМОЕ": 0.0252, "ОИСП": 0.0252, "БХОД": 0.0251,
+	"ЕННА": 0.0250, "ОДЛЯ": 0.0250, "НЯЕТ": 0.0250, "ИТЬИ": 0.0250, "ПРОИ": 0.0250,
+	"ТИЧЕ": 0.0250, "СЕРВ": 0.0250, "РТИФ": 0.0250, "КТУР": 0.0250, "ЕДОС": 0.0250,
+	"КИЙК": 0.0249, "ИЙСК": 0.0249, "АВТО": 0.0249, "СЕРТ": 0.0249, "ЕЛЬЗ": 0.0248,
+	"ИЕПО": 0.0248, "ОБХО": 0.0248, "ДРУГ": 0.0247, "ЕРИР": 0.0247, "ТЬЗА": 0.0247,
+	"ГОФА": 0.0247, "КАЯО": 0.0246, "КОНТ": 0.0246, "ЛОКИ": 0.0246, "ОГОФ": 0.0246,
+	"ЛЬСК": 0.0245, "РАЗО": 0.0245, "НЕРИ": 0.0245, "ВОЛА": 0.0245, "ВСТР": 0.0244,
+	"ЕНИТ": 0.0244, "ДЛЯС": 0.0244, "ЕУКА": 0.0244, "ВУЮЩ": 0.0244, "ИЙКР": 0.0243,
+	"КЕТА": 0.0243, "ОЧИТ": 0.0242, "АКСИ": 0.0242, "ИДЛЯ": 0.0242, "ЕПРО": 0.0241,
+	"ТИВН": 0.0241, "ХРАН": 0.0241, "ЙКРА": 0.0240, "ОСТР": 0.0240, "НЕЗА": 0.0240,
+	"ШЕНИ": 0.0240, "ЕПОС": 0.0240, "АЗРЕ": 0.0240, "ЕНТО": 0.0239, "ЗОНА": 0.0239,
+	"НЕЛЬ": 0.0239, "НОЕЗ": 0.0239, "ПОЗИ": 0.0239, "НСКА": 0.0238, "ЬНОЕ": 0.0238,
+	"УЧЕН": 0.0238, "ПОЛЕ": 0.0238, "ЕЗАП": 0.0238, "НОЙС": 0.0237, "ВОЛЫ": 0.0237,
+	"ИЯНЕ": 0.0237, "ЛЬЗЯ": 0.0237, "ЫЙТИ": 0.0237, "ЕТСТ": 0.0237, "ЕОПР": 0.0237,
+	"ЛУЧЕ": 0.0236, "ОВПА": 0.0236, "ЕЧАТ": 0.0236, "НЕЯВ": 0.0236, "ТНАЯ": 0.0235,
+	"ЛЕДУ": 0.0235, "ИМЕЕ": 0.0235, "МЕЕТ": 0.0235, "ЛНЯЕ": 0.0234, "МЕНА": 0.0234,
+	"ЙРАЗ": 0.0234, "ЗУЙТ": 0.0234, "ТИМО": 0.0232, "ПРОЧ": 0.0232, "ЕВЫР": 0.0232,
+	"ЬЗУЙ": 0.0232, "КАЦИ": 0.0231, "ЕТБЫ": 0.0230, "КАЛЬ": 0.0230, "ЕИМЯ": 0.0230,
+	"ЕСИМ": 0.0230, "КОПИ": 0.0230, "ОВКИ": 0.0230, "НДАР": 0.0229, "ЕДСТ": 0.0229,
+	"ЖЕТБ": 0.0229, "ЫЙКО": 0.0229, "ВФАЙ": 0.0229, "ИКАЦ": 0.0228, "ЖЕНБ": 0.0228,
+	"ЕТКИ": 0.0228, "ОКАЛ": 0.0227, "ЕНБЫ": 0.0227, "ЗРЕШ": 0.0227, "АБЛО": 0.0227,
+	"ЖНОС": 0.0227, "ЕЯЗЫ": 0.0227, "ТЬИН": 0.0226, "ВЕТК": 0.0226, "ЙСТР": 0.0226,
+	"ЛИЧЕ": 0.0226, "ЕОБХ": 0.0226, "НБЫТ": 0.0225, "НИЯИ": 0.0225, "ЛАВИ": 0.0225,
+	"ИСИМ": 0.0225, "ТАНД": 0.0224, "ФУНК": 0.0224, "УНКЦ": 0.0224, "НКЦИ": 0.0224,
+	"ТОЯН": 0.0224, "ОТКЛ": 0.0224, "РАСШ": 0.0224, "АТРИ": 0.0223, "ТОГО": 0.0223,
+	"ОГОС": 0.0223, "РЫТЬ": 0.0223, "ИНСК": 0.0222, "РОЧИ": 0.0222, "МОСТ": 0.0222,
+	"АСШИ": 0.0222, "ЕНОВ": 0.0222, "ДИТЕ": 0.0222, "ИЧНЫ": 0.0221, "ОГОК": 0.0221,
+	"НАПР": 0.0221, "АЗНА": 0.0221, "ЙСКИ": 0.0220, "ПОМЕ": 0.0220, "ОСЬС": 0.0220,
+	"ТВЕН": 0.0220, "АЗОВ": 0.0219, "ЙСИМ": 0.0219, "ДАРТ": 0.0218, "АРТН": 0.0218,
+	"ДИТЬ": 0.0218, "ИМОС": 0.0218, "ЮЩИЙ": 0.0217, "ЬРАЗ": 0.0217, "ДНОГ": 0.0217,
+	"ТРАН": 0.0217, "ДСТВ": 0.0216, "ЕНАЙ": 0.0216, "РНОЕ": 0.0216, "АТЬД": 0.0216,
+	"НИЕО": 0.0215, "ПЕЧА": 0.0215, "ДЛЯВ": 0.0215, "ЕЯВЛ": 0.0215, "ИЕЯЗ": 0.0215,
+	"НОВО": 0.0214, "ОЯНИ": 0.0214, "ТЕКУ": 0.0214, "ДИМО": 0.0214, "НАЯС": 0.0213,
+	"АТЬН": 0.0213, "РОВК": 0.0213, "АЧАЛ": 0.0212, "ЯЗАП": 0.0212, "ЯИСП": 0.0212,
+	"НЫЙТ": 0.0212, "ЕЛИТ": 0.0211, "КИЕЯ": 0.0211, "ИТОР": 0.0210, "ВЕТС": 0.0210,
+	"ЛЯПО": 0.0210, "СШИР": 0.0210, "ЖНОП": 0.0210, "МЕНЯ": 0.0210, "СБОЙ": 0.0210,
+	"РЕМЯ": 0.0210, "ЦИЮО": 0.0210, "НЯТЬ": 0.0210, "ЕТКА": 0.0210, "УЧИТ": 0.0209,
+	"ЕРЕН": 0.0209, "КЦИЯ": 0.0209, "ЕКТУ": 0.0209, "КЛАВ": 0.0209, "ООТВ": 0.0208,
+	"СОВП": 0.0208, "ТРОЕ": 0.0208, "ЕРНА": 0.0207, "ТОРА": 0.0207, "НИЯН": 0.0207,
+	"ОЛИЧ": 0.0206, "РСИЯ": 0.0206, "ЛИНЕ": 0.0206, "ВАЮЩ": 0.0206, "ВОЙС": 0.0206,
+	"ЛЬНА": 0.0205, "СРЕД": 0.0205, "ПОЛО": 0.0205, "ИЯПО": 0.0205, "ЫЙСИ": 0.0204,
+	"ОНЕН": 0.0204, "АНИИ": 0.0204, "ПОЗН": 0.0204, "ЬКОД": 0.0204, "ВВОД": 0.0204,
+	"ТАВЛ": 0.0204, "ЯПОЛ": 0.0203, "ТИПА": 0.0203, "НЕВЫ": 0.0203, "СООТ": 0.0203,
+	"НЫЕС": 0.0203, "ВОГО": 0.0203, "НЫХС": 0.0203, "ННЫМ": 0.0203, "ЕПОЗ": 0.0203,
+	"ТЕКТ": 0.0203, "ИЕПА": 0.0202, "АНДН": 0.0202, "ГРУЗ": 0.0202, "ОВТО": 0.0202,
+	"ИМЫЙ": 0.0202, "НИЕК": 0.0201, "ОДИН": 0.0201, "АТЬТ": 0.0201, "КТНО": 0.0201,
+	"ОВОЙ": 0.0201, "АВИС": 0.0201, "РХИТ": 0.0201, "ХИТЕ": 0.0201, "РОЛЬ": 0.0200,
+	"ИПРО": 0.0200, "ПРИС": 0.0200, "НЫМИ": 0.0200, "НАЗН": 0.0200, "НАКО": 0.0199,
+	"АЙЛЕ": 0.0199, "ПАМЯ": 0.0199, "АМЯТ": 0.0199, "КАЕТ": 0.0198, "ЕОЖИ": 0.0198,
+	"УПРА": 0.0198, "ЙРЕГ": 0.0197, "АЛИЗ": 0.0197, "ИНЕН": 0.0197, "ЛУЧИ": 0.0197,
+	"ТЬКА": 0.0197, "ИЛИП": 0.0197, "ВЫВЕ": 0.0197, "СТНО": 0.0197, "НТЕР": 0.0196,
+	"ТЬВС": 0.0196, "ОСЬО": 0.0196, "ИНТЕ": 0.0196, "ЬПЕР": 0.0196, "ПЕЦИ": 0.0196,
+	"НОВА": 0.0195, "СЬПР": 0.0195, "ЕКУЩ": 0.0195, "СПОЗ": 0.0195, "ОЛОЖ": 0.0194,
+	"ОНОВ": 0.0194, "ОСИТ": 0.0194, "АЯОБ": 0.0193, "ЗАВИ": 0.0193, "ЕРЕЗ": 0.0193,
+	"СКАЕ": 0.0193, "ТЬСИ": 0.0193, "ЕДУЮ": 0.0193, "НАРУ": 0.0193, "КОГО": 0.0193,
+	"ПОТО": 0.0192, "РОСТ": 0.0192, "НЕНН": 0.0192, "ТСЯД": 0.0192, "ЬПОЛ": 0.0192,
+	"АЮЩИ": 0.0192, "ФИКС": 0.0192, "РШЕН": 0.0191, "ЕПРЕ": 0.0191, "ДУЮЩ": 0.0191,
+	"ХФАЙ": 0.0191, "ИРЕН": 0.0190, "НЫЕП": 0.0190, "ЕРШЕ": 0.0190, "ЬДЛЯ": 0.0190,
+	"ЯПРО": 0.0190, "МНОГ": 0.0190, "КОЛИ": 0.0190, "ЧНЫЙ": 0.0190, "ЖИМО": 0.0190,
+	"ОТОК": 0.0190, "АДАТ": 0.0190, "ЩЕГО": 0.0190, "ТСЯИ": 0.0190, "ЕСКА": 0.0189,
+	"МОДУ": 0.0189, "ОДУЛ": 0.0189, "ЧАСТ": 0.0189, "ЗВАН": 0.0189, "ДИНА": 0.0188,
+	"ОЕИМ": 0.0188, "ЯНИЕ": 0.0188, "РАТН": 0.0188, "НИЯД": 0.0188, "ЕЧЕН": 0.0187,
+	"ОПРИ": 0.0187, "АТЬР": 0.0187, "ВПАД": 0.0187, "ЙПАР": 0.0187, "КАЖД": 0.0187,
+	"ЕПРИ": 0.0187, "ВЕРК": 0.0186, "ОЧНО": 0.0186, "НОЕИ": 0.0186, "ЕСОВ": 0.0186,
+	"ШИРЕ": 0.0186, "ЛИНА": 0.0185, "МЕРА": 0.0185, "ПОМО": 0.0185, "ОГОР": 0.0185,
+	"ТЬСО": 0.0185, "ИЕДЛ": 0.0185, "ЕЧИС": 0.0185, "ЗНАН": 0.0185, "НОНЕ": 0.0184,
+	"ЯОБЛ": 0.0183, "ТКЛЮ": 0.0183, "ЛЖНО": 0.0183, "РАНЕ": 0.0183, "ЬНАЯ": 0.0182,
+	"НТАК": 0.0182, "БАВЛ": 0.0182, "ЯПЕР": 0.0182, "НАЧИ": 0.0182, "ЕНАР": 0.0182,
+	"ЕНТЫ": 0.0182, "ЫВЕС": 0.0182, "ИБУТ": 0.0181, "ЯКОМ": 0.0181, "АФАЙ": 0.0181,
+	"ЫЙРА": 0.0181, "ЕМОГ": 0.0181, "ИСТО": 0.0180, "ОРАЗ": 0.0180, "ТЬОБ": 0.0180,
+	"ЕССО": 0.0179, "НОПО": 0.0179, "ИСАН": 0.0179, "НОПР": 0.0179, "ДЛЯО": 0.0179,
+	"ЛОВК": 0.0179, "ПРИВ": 0.0178, "ТРЕН": 0.0178, "РЖИМ": 0.0178, "ТАЕТ": 0.0178,
+	"НЕОЖ": 0.0178, "ЛЯТЬ": 0.0178, "ОБНА": 0.0178, "РОЙК": 0.0178, "ДЛЯИ": 0.0178,
+	"КОЛЬ": 0.0177, "ЖИМЕ": 0.0177, "ЗОБР": 0.0177, "БРАН": 0.0176, "ИВАТ": 0.0176,
+	"ПОДК": 0.0176, "ОВНЕ": 0.0176, "КОНЕ": 0.0176, "РЕСА": 0.0176, "ПОВТ": 0.0176,
+	"ЛОВИ": 0.0176, "НЫЙВ": 0.0176, "НЫХП": 0.0176, "НЕУК": 0.0175, "СТОВ": 0.0175,
+	"КОДО": 0.0175, "ЗАТЕ": 0.0175, "ЫЙПА": 0.0175, "ЬЗАП": 0.0175, "ЕСУЩ": 0.0175,
+	"СХОД": 0.0175, "РОИЗ": 0.0175, "ВИСИ": 0.0175, "ОПЦИ": 0.0175, "ЕКТИ": 0.0174,
+	"ТРИБ": 0.0174, "РИБУ": 0.0174, "БРАЖ": 0.0174, "ОЗИТ": 0.0174, "ЗИТО": 0.0174,
+	"КОНС": 0.0173, "ИСХО": 0.0173, "МОГУ": 0.0173, "ГОПР": 0.0172, "ЛОГА": 0.0172,
+	"ТЬТО": 0.0172, "ОИСК": 0.0172, "ТЬДЛ": 0.0172, "ИЧНО": 0.0172, "ТСЯО": 0.0172,
+	"СЬСО": 0.0171, "ЦИАЛ": 0.0171, "РАТЬ": 0.0171, "ГРАН": 0.0170, "БНАР": 0.0170,
+	"АКТИ": 0.0170, "ЭТОМ": 0.0170, "АЙЛС": 0.0170, "ИГНА": 0.0170, "АКОМ": 0.0170,
+	"СИГН": 0.0170, "ТЬЭТ": 0.0170, "АБОЧ": 0.0170, "СКОЛ": 0.0169, "НИЧЕ": 0.0169,
+	"ИТЬД": 0.0169, "ИПЕР": 0.0169, "ЯПОС": 0.0169, "АРУЖ": 0.0169, "СТНА": 0.0169,
+	"ЕКЛЮ": 0.0169, "ИЙРА": 0.0168, "АСНО": 0.0168, "УТРЕ": 0.0168, "ИИСП": 0.0168,
+	"ЬТОЛ": 0.0168, "ИТЬК": 0.0168, "ВОЛЬ": 0.0168, "ИВАН": 0.0167, "ПОИС": 0.0167,
+	"РЕНИ": 0.0167, "ЮЩЕЙ": 0.0167, "ВЛЯЮ": 0.0167, "СЯНЕ": 0.0167, "НТОВ": 0.0167,
+	"ИЛОЖ": 0.0167, "ТЕЛЕ": 0.0167, "БЛОН": 0.0166, "ТЬПА": 0.0166, "ОКИР": 0.0166,
+	"АЗАП": 0.0166, "КОНФ": 0.0166, "РТИР": 0.0166, "ПРИЛ": 0.0166, "РИЛО": 0.0166,
+	"НЕОП": 0.0166, "ОВМЕ": 0.0166, "ТНОС": 0.0166, "ЕРШИ": 0.0165, "ЕТПО": 0.0165,
+	"СЬЗА": 0.0165, "ИВНО": 0.0165, "ОРТИ": 0.0165, "ИРЕК": 0.0165, "РНАЯ": 0.0164,
+	"АКОВ": 0.0164, "АЙТИ": 0.0164, "ПАСН": 0.0164, "ЙЗАП": 0.0164, "ЧИНА": 0.0164,
+	"ТНОГ": 0.0164, "ШАБЛ": 0.0164, "АММЫ": 0.0164, "ИТСЯ": 0.0164, "ПОНЕ": 0.0164,
+	"СТОЧ": 0.0163, "ИТЬР": 0.0163, "БЕЗО": 0.0163, "ЕЧТО": 0.0163, "ТИТЕ": 0.0163,
+	"МАТИ": 0.0162, "АПОЛ": 0.0162, "НЫЙА": 0.0162, "УТЕН": 0.0162, "АКОН": 0.0162,
+	"ЛЯПР": 0.0162, "ЛЕДН": 0.0162, "НОЕВ": 0.0162, "ОХРА": 0.0162, "ТУПН": 0.0162,
+	"ЬНЫХ": 0.0162, "МАЛЬ": 0.0161, "КИРО": 0.0161, "ШИФР": 0.0161, "ИФРО": 0.0161,
+	"ЬНОЙ": 0.0161, "ГНАЛ": 0.0161, "СЯНА": 0.0161, "ИКОМ": 0.0161, "КАНА": 0.0160,
+	"РИТЕ": 0.0160, "ЕЗАВ": 0.0160, "ЛУЧА": 0.0160, "АТЬФ": 0.0160, "ОСРЕ": 0.0160,
+	"РЕЗА": 0.0160, "ЛЕНО": 0.0160, "ОГОИ": 0.0160, "ОЙПР": 0.0160, "ИСАТ": 0.0159,
+	"НИЕР": 0.0159, "СЯПО": 0.0159, "ЫБРА": 0.0159, "ДОПО": 0.0159, "ПОСР": 0.0159,
+	"ЙСКА": 0.0158, "КАКО": 0.0158, "БКАВ": 0.0158, "НОСО": 0.0158, "ФРОВ": 0.0158,
+	"ОПАС": 0.0158, "ЛАМИ": 0.0158, "ЙПРО": 0.0158, "ВЫБР": 0.0158, "ТИСП": 0.0158,
+	"ТСЯК": 0.0158, "ЯПОД": 0.0158, "НИЕЗ": 0.0157, "ПРЕО": 0.0157, "ЬСОЗ": 0.0157,
+	"НИЯО": 0.0157, "МОГО": 0.0157, "ИМЕР": 0.0156, "СИНТ": 0.0156, "ТЕНТ": 0.0156,
+	"ВПРО": 0.0156, "ПРИП": 0.0156, "ОСЬВ": 0.0156, "РЕОБ": 0.0156, "ЛИОТ": 0.0156,
+	"СТВА": 0.0156, "КОМБ": 0.0156, "КЕТО": 0.0156, "ДИРЕ": 0.0156, "АУТЕ": 0.0155,
+	"НАНН": 0.0155, "ЧАТА": 0.0155, "СОХР": 0.0155, "ЕДОЛ": 0.0155, "ВРАЗ": 0.0155,
+	"НИКА": 0.0154, "НЕСК": 0.0154, "НИТЕ": 0.0154, "НАРИ": 0.0153, "МФАЙ": 0.0153,
+	"СЦЕН": 0.0153, "ЫЛКИ": 0.0153, "НЫЙО": 0.0152, "ЛИНН": 0.0152, "ЖНОБ": 0.0152,
+	"ТАКС": 0.0152, "ИЕЗА": 0.0152, "КУМЕ": 0.0152, "АННА": 0.0151, "АЧИН": 0.0151,
+	"ОНТЕ": 0.0151, "ДИНЕ": 0.0151, "ОПЫТ": 0.0151, "МЯТИ": 0.0151, "ЯПАР": 0.0151,
+	"АТИЧ": 0.0151, "НОСИ": 0.0151, "ЕКТА": 0.0151, "ТУРЫ": 0.0151, "ЕРЕВ": 0.0150,
+	"НЫЕИ": 0.0150, "ПОПЫ": 0.0150, "НОБЫ": 0.0150, "ДЛЯК": 0.0150, "СИМО": 0.0150,
+	"ЕДАК": 0.0150, "СОВМ": 0.0150, "КИЙР": 0.0150, "АВИШ": 0.0150, "ОДКЛ": 0.0150,
+	"ДКЛЮ": 0.0150, "СЬПО": 0.0150, "ДЛЯН": 0.0150, "РЕДП": 0.0150, "ВИДЕ": 0.0150,
+	"ЕЗАД": 0.0150, "ОНСТ": 0.0150, "ЕРЕК": 0.0149, "МПОР": 0.0149, "ЧАЕТ": 0.0149,
+	"ОСЬЗ": 0.0149, "ЦЕНА": 0.0149, "ПОВР": 0.0149, "ПУЩЕ": 0.0149, "УЩЕН": 0.0149,
+	"НЕГО": 0.0148, "АСТИ": 0.0148, "АЯКО": 0.0148, "НАМИ": 0.0148, "ЙРЕЖ": 0.0148,
+	"ЕБУД": 0.0148, "ТЬПЕ": 0.0148, "НОЕС": 0.0147, "ЙКОД": 0.0147, "БУФЕ": 0.0147,
+	"УФЕР": 0.0147, "ВЕДЕ": 0.0147, "ЮЧЕЙ": 0.0147, "ЙОПЕ": 0.0147, "АНЕН": 0.0146,
+	"ЕИЛИ": 0.0146, "ЕДАН": 0.0146, "СТЕК": 0.0146, "ИНТА": 0.0146, "НОИС": 0.0146,
+	"НЕНТ": 0.0146, "ОВОГ": 0.0145, "ОТЛА": 0.0145, "ЕРЖА": 0.0145, "ИИНЕ": 0.0145,
+	"НАЙТ": 0.0145, "ОБЫТ": 0.0145, "ОЕВЫ": 0.0145, "ЕССЫ": 0.0145, "ЕТОВ": 0.0145,
+	"ОВОК": 0.0145, "ОКУМ": 0.0145, "АНДО": 0.0144, "ОЧНЫ": 0.0144, "ДОВА": 0.0144,
+	"ЖНОО": 0.0144, "ОБЫЧ": 0.0144, "БЫЧН": 0.0144, "ЕТНЕ": 0.0144, "ЕЗОП": 0.0144,
+	"ЙДЛЯ": 0.0144, "АЮЩЕ": 0.0144, "НОЕП": 0.0144, "СКОГ": 0.0144, "ОРЯД": 0.0144,
+	"ЕТРО": 0.0143, "ВЕТВ": 0.0143, "РЫВА": 0.0143, "ЫХФА": 0.0143, "ЗАНИ": 0.0143,
+	"ДОКУ": 0.0143, "АЯПО": 0.0142, "ЛИТЕ": 0.0142, "ЮЩИХ": 0.0142, "СПОМ": 0.0142,
+	"ЬСКИ": 0.0141, "МАТЕ": 0.0141, "СТАТ": 0.0141, "ЧЕГО": 0.0141, "АИСП": 0.0141,
+	"ИЯДЛ": 0.0141, "ЛЯЮЩ": 0.0141, "ВИТЕ": 0.0140, "ЕРЖК": 0.0140, "ЧЕНН": 0.0140,
+	"САТЬ": 0.0140, "БОТУ": 0.0140, "ЫЕФА": 0.0140, "ПРИО": 0.0140, "ЭТОГ": 0.0140,
+	"ДАВА": 0.0140, "ОТЕК": 0.0140, "МЕРО": 0.0139, "МАНИ": 0.0139, "СЬВЫ": 0.0139,
+	"КОДИ": 0.0139, "ЗОПА": 0.0139, "ИЕСЛ": 0.0139, "ТЬВЫ": 0.0139, "ИЕПР": 0.0139,
+	"ОРЫЕ": 0.0139, "ТАНА": 0.0138, "ИНИМ": 0.0138, "НОМУ": 0.0138, "ИТЬН": 0.0138,
+	"ЧЕТН": 0.0138, "ТЫВА": 0.0138, "ЛЖНЫ": 0.0138, "ЕСОО": 0.0138, "СЛУЧ": 0.0138,
+	"ЬЭТО": 0.0138, "ЕУСТ": 0.0138, "ОЙКИ": 0.0138, "ГРАФ": 0.0138, "АТЬЗ": 0.0137,
+	"ЕРЕП": 0.0137, "УЮЩЕ": 0.0137, "АВНИ": 0.0137, "ЕИЗМ": 0.0137, "ОЧНИ": 0.0137,
+	"ФЛАГ": 0.0137, "БУДУ": 0.0137, "ЗАПЯ": 0.0137, "АПЯТ": 0.0137, "РСКИ": 0.0136,
+	"РЕСУ": 0.0136, "ЕТЗА": 0.0136, "НОЗА": 0.0136, "ЛИЦА": 0.0136, "ЙКАТ": 0.0136,
+	"ТЬИМ": 0.0136, "ЕНАС": 0.0136, "ЬВСЕ": 0.0136, "СЧИТ": 0.0136, "ХОДА": 0.0136,
+	"ЕТНО": 0.0136, "ПОРЯ": 0.0136, "АВКИ": 0.0136, "ЛОГО": 0.0135, "ДЕЛЬ": 0.0135,
+	"ТОМА": 0.0135, "ЯПРИ": 0.0135, "ЯСТР": 0.0135, "ЕКТО": 0.0135, "НИЯК": 0.0135,
+	"ЬНОС": 0.0135, "ЗАНО": 0.0135, "ИЯНИ": 0.0135, "ПОЛЯ": 0.0135, "ЛЕДО": 0.0134,
+	"ВНЕД": 0.0134, "КОДА": 0.0134, "РОКЕ": 0.0134, "ЕДОВ": 0.0134, "ЕМЫЕ": 0.0134,
+	"ЧАТЬ": 0.0134, "ЛИЯН": 0.0134, "КАПО": 0.0133, "КРАТ": 0.0133, "ЕРВЫ": 0.0133,
+	"КАКИ": 0.0133, "СТАЛ": 0.0133, "ЛЕНА": 0.0133, "ТЬИЗ": 0.0133, "ТЛАД": 0.0133,
+	"ХИВА": 0.0133, "ЖНОИ": 0.0133, "СЛИЯ": 0.0133, "НСТА": 0.0133, "ССОР": 0.0132,
+	"ЕННЯ": 0.0132, "ННЯЯ": 0.0132, "ННОМ": 0.0132, "АНЕП": 0.0132, "ЬПОД": 0.0132,
+	"ЧНИК": 0.0132, "НДНО": 0.0132, "ТОПО": 0.0131, "СЯПР": 0.0131, "ОДИР": 0.0131,
+	"ЕНАП": 0.0131, "УСТР": 0.0131, "ЬСИМ": 0.0131, "ОМЕН": 0.0131, "ЕДПО": 0.0131,
+	"ШИТЬ": 0.0131, "ИТЬО": 0.0131, "ПОНО": 0.0131, "АКРЫ": 0.0130, "РАВА": 0.0130,
+	"СКОМ": 0.0130, "ТВИТ": 0.0130, "ЙПРИ": 0.0130, "ЬКОН": 0.0130, "ТОВЫ": 0.0130,
+	"ЕСТР": 0.0130, "ПАДА": 0.0130, "НАЛА": 0.0130, "ТЕМЫ": 0.0130, "ОТМЕ": 0.0130,
+	"ОМОЩ": 0.0130, "ОТНО": 0.0130, "ТАНТ": 0.0130, "ДСКА": 0.0130, "ТАРЕ": 0.0130,
+	"ЛЬШЕ": 0.0130, "ЗАКР": 0.0130, "ТЕМН": 0.0130, "РОЕН": 0.0130, "ОКОМ": 0.0130,
+	"РАЙО": 0.0129, "АЙОН": 0.0129, "ДЕНН": 0.0129, "ВЕРЕ": 0.0129, "ВЫЗО": 0.0129,
+	"ЫЗОВ": 0.0129, "МЕТО": 0.0129, "ЖЕНН": 0.0129, "ТЬИС": 0.0129, "ИЛИН": 0.0129,
+	"БАВИ": 0.0129, "СИТЕ": 0.0129, "ЙРАЙ": 0.0128, "ОКРУ": 0.0128, "ОНЕЦ": 0.0128,
+	"ИПРИ": 0.0128, "ОТПР": 0.0128, "ДВОИ": 0.0128, "ВОИЧ": 0.0128, "ОИЧН": 0.0128,
+	"ОПИС": 0.0128, "НИВА": 0.0128, "ЖАТЬ": 0.0128, "ТОЧК": 0.0128, "ЗУЕМ": 0.0128,
+	"ЕМЫХ": 0.0128, "ЛЕВО": 0.0127, "ТПРА": 0.0127, "ЯСИМ": 0.0127, "ВНИВ": 0.0127,
+	"ВТОМ": 0.0127, "ВЫДЕ": 0.0127, "ЕДЕН": 0.0127, "ЛИРО": 0.0127, "АВНО": 0.0127,
+	"ВЕРИ": 0.0126, "ЕСТА": 0.0126, "ЛИВА": 0.0126, "СЛИВ": 0.0126, "ЛИКО": 0.0126,
+	"ЯНЕП": 0.0126, "СТИС": 0.0126, "ГОКО": 0.0126, "ДЛЯР": 0.0126, "ЫЛКА": 0.0126,
+	"ММНО": 0.0126, "ОГУТ": 0.0126, "КОДЛ": 0.0126, "ИНАМ": 0.0126, "ПОЧТ": 0.0126,
+	"ЕТНА": 0.0126, "СЛЕН": 0.0125, "ЕТПР": 0.0125, "ЫВАЮ": 0.0125, "УСЛО": 0.0125,
+	"ОПИР": 0.0125, "ПИРО": 0.0124, "АССЕ": 0.0124, "ИЕРА": 0.0124, "НТЕК": 0.0124,
+	"ПРОТ": 0.0124, "ЕШЕН": 0.0124, "ТИПО": 0.0124, "ЙФОР": 0.0124, "НОЙП": 0.0124,
+	"ОБЕЛ": 0.0123, "ОСИМ": 0.0123, "ВАНО": 0.0123, "МИНА": 0.0123, "РОЙС": 0.0123,
+	"ПОДР": 0.0123, "УСТО": 0.0123, "ОЙКО": 0.0123, "РУГИ": 0.0123, "БОТК": 0.0123,
+	"ТВИЯ": 0.0123, "УЕМЫ": 0.0123, "РОБЕ": 0.0123, "СЕМБ": 0.0122, "ЧНЫЕ": 0.0122,
+	"ВНЕН": 0.0122, "ИМАН": 0.0122, "ИЕФА": 0.0122, "ИТЬФ": 0.0122, "ЬКОМ": 0.0122,
+	"ДЛЯД": 0.0122, "ЯИЛИ": 0.0122, "АЯОШ": 0.0122, "АПЕР": 0.0122, "ЬНЫМ": 0.0122,
+	"ТНОЙ": 0.0122, "ЯЕМЫ": 0.0122, "НАЯП": 0.0122, "ОБЩИ": 0.0122, "МИЧЕ": 0.0122,
+	"ТЧИК": 0.0122, "ЕНЕМ": 0.0121, "ИМАЛ": 0.0121, "ЩАЕТ": 0.0121, "АНИЦ": 0.0121,
+	"АКЖЕ": 0.0121, "НЯЯО": 0.0121, "НЯЕМ": 0.0121, "ЯЮЩИ": 0.0121, "ИЗОБ": 0.0121,
+	"ЕСТЕ": 0.0120, "КАЧЕ": 0.0120, "ТЕРФ": 0.0120, "АОШИ": 0.0120, "ВОЗВ": 0.0120,
+	"АЧЕС": 0.0120, "ТАКЖ": 0.0120, "АЙЛН": 0.0120, "ТСЯТ": 0.0120, "ЬПРИ": 0.0120,
+	"СЯТО": 0.0120, "РАЗУ": 0.0120, "ОДОП": 0.0120, "ВНИМ": 0.0120, "АМЕН": 0.0119,
+	"ОКОН": 0.0119, "АНАВ": 0.0119, "ИРАЗ": 0.0119, "АРАЗ": 0.0119, "ОЕДИ": 0.0119,
+	"РЕШЕ": 0.0119, "ВСЕГ": 0.0119, "АЖДО": 0.0119, "ЯРАЗ": 0.0119, "ЫКЛЮ": 0.0119,
+	"РУЗК": 0.0119, "ЯИНС": 0.0119, "ЦАТЕ": 0.0119, "ТРОМ": 0.0118, "ВЕЛИ": 0.0118,
+	"ТЬСТ": 0.0118, "ГОПО": 0.0118, "СЬОТ": 0.0118, "КСИМ": 0.0118, "ОЗВР": 0.0118,
+	"ЗВРА": 0.0118, "ШЕСТ": 0.0118, "ЛОГИ": 0.0118, "ЫРАВ": 0.0118, "ЕДИТ": 0.0118,
+	"АВАТ": 0.0118, "ОЙИН": 0.0118, "ВАНА": 0.0117, "СЛИТ": 0.0117, "ДИРО": 0.0117,
+	"ЬНОГ": 0.0117, "ЕТСО": 0.0117, "РТНЫ": 0.0117, "ОЛНО": 0.0117, "ЫЙПР": 0.0117,
+	"АМИЧ": 0.0117, "ЕРФЕ": 0.0117, "РФЕЙ": 0.0117, "ФЕЙС": 0.0117, "ЙКОМ": 0.0117,
+	"НЫЙИ": 0.0117, "РЕШИ": 0.0116, "АККА": 0.0116, "АНАЛ": 0.0116, "СЛИН": 0.0116,
+	"ЛАВА": 0.0116, "КИНА": 0.0116, "ЕСОД": 0.0116, "ИЯМИ": 0.0116, "ЗАЦИ": 0.0116,
+	"РАНН": 0.0116, "ЯЯОШ": 0.0116, "ОПУЩ": 0.0116, "ИМОП": 0.0116, "АЕМО": 0.0116,
+	"ЫЙОП": 0.0116, "ЕЛОВ": 0.0115, "ОЭТО": 0.0115, "НЫЙН": 0.0115, "ЛЯНЕ": 0.0115,
+	"ИЕВЫ": 0.0115, "ДАЛЯ": 0.0115, "СМОТ": 0.0115, "МОТР": 0.0115, "ЬКОП": 0.0115,
+	"ОВПО": 0.0115, "ЕНЯЕ": 0.0115, "БКАН": 0.0115, "ИОТЕ": 0.0115, "ИПАР": 0.0115,
+	"ВНЕШ": 0.0114, "НЕШН": 0.0114, "МЕЧА": 0.0114, "УЛЬТ": 0.0114, "НЕСУ": 0.0114,
+	"ЯВЕР": 0.0114, "ОЙЗА": 0.0114, "ВПЕР": 0.0114, "ДАКТ": 0.0114, "БИБЛ": 0.0114,
+	"РОВН": 0.0113, "СОЕД": 0.0113, "ЛОВН": 0.0113, "ОСЬН": 0.0113, "ТЬДО": 0.0113,
+	"РАТИ": 0.0113, "ИЕКО": 0.0113, "АДАЕ": 0.0113, "МЕНО": 0.0113, "СКЛЮ": 0.0113,
+	"ПЫТК": 0.0113, "ЕСТЬ": 0.0113, "РАФИ": 0.0113, "МИТА": 0.0113, "ЕМБЛ": 0.0113,
+	"НАЯК": 0.0112, "ЕРОВ": 0.0112, "ТЬНА": 0.0112, "УППА": 0.0112, "ЫТЬИ": 0.0112,
+	"БОЛЕ": 0.0112, "ОЛЕЕ": 0.0112, "СЬНА": 0.0112, "НЫЕД": 0.0112, "ТОРН": 0.0112,
+	"ВЫКЛ": 0.0112, "ЛАТЬ": 0.0112, "ДИТС": 0.0112, "УППЫ": 0.0112, "ИБЛИ": 0.0112,
+	"БЛИО": 0.0112, "АТИВ": 0.0112, "МОЩЬ": 0.0112, "ССЕМ": 0.0112, "АЯРЕ": 0.0111,
+	"ИКАК": 0.0111, "ОВЫВ": 0.0111, "НЫХД": 0.0111, "МЕЖД": 0.0111, "ЕЖДУ": 0.0111,
+	"ЕДИА": 0.0111, "КОНЦ": 0.0111, "ВКАЧ": 0.0111, "ЙЛАП": 0.0111, "ТЬРЕ": 0.0111,
+	"ОМАТ": 0.0111, "ОТРИ": 0.0111, "ТЕКА": 0.0111, "ВВЕД": 0.0111, "ЛАДО": 0.0111,
+	"ОЩЬЮ": 0.0111, "ЕЛАМ": 0.0111, "ИНАЛ": 0.0110, "ЛИЗА": 0.0110, "ОГОО": 0.0110,
+	"ЬКАТ": 0.0110, "КЕТЫ": 0.0110, "СПОР": 0.0110, "РОБЛ": 0.0110, "ОБЛЕ": 0.0110,
+	"ОКАН": 0.0110, "АНИЧ": 0.0110, "НУЛЕ": 0.0110, "ИСКЕ": 0.0110, "ОСЬУ": 0.0110,
+	"ТЬНО": 0.0110, "ЬОТК": 0.0110, "ЕРВО": 0.0110, "АИЛИ": 0.0110, "ИЛИР": 0.0110,
+	"ТСЯР": 0.0110, "БЛЕМ": 0.0110, "НАНЕ": 0.0109, "ЗАКО": 0.0109, "ЫЙКЛ": 0.0109,
+	"ИЯКО": 0.0109, "ТУПА": 0.0109, "ТОБР": 0.0109, "ЯНИЯ": 0.0109, "ЕЛАТ": 0.0109,
+	"КСПО": 0.0109, "СЖАТ": 0.0109, "ПРОЙ": 0.0109, "ЕЛЬС": 0.0108, "ВЕРА": 0.0108,
+	"ДИНИ": 0.0108, "КТОР": 0.0108, "ЦИИН": 0.0108, "ЖИМА": 0.0108, "АВНЕ": 0.0108,
+	"ОРИТ": 0.0108, "ЧНОГ": 0.0108, "ТЬВР": 0.0108, "ЫЕСИ": 0.0108, "ТЬСП": 0.0108,
+	"УДУТ": 0.0108, "ТВЛЕ": 0.0108, "АЛЬТ": 0.0107, "АСТА": 0.0107, "ЖНОВ": 0.0107,
+	"ИСЛЕ": 0.0107, "ИЗАЦ": 0.0107, "ЛЕЖИ": 0.0107, "ЭТОЙ": 0.0107, "ШЕНН": 0.0107,
+	"ИЗАП": 0.0107, "ПЛАВ": 0.0107, "АВАЮ": 0.0107, "ЯТОЛ": 0.0107, "ЛИВЫ": 0.0107,
+	"ОНИМ": 0.0107, "ЕТВЛ": 0.0107, "ОЙТИ": 0.0107, "ЯРЕС": 0.0106, "КАЯР": 0.0106,
+	"РЕДО": 0.0106, "ИПОЛ": 0.0106, "НЕДИ": 0.0106, "ПУТЬ": 0.0106, "ОУКА": 0.0106,
+	"ЕТРЕ": 0.0106, "ИИЛИ": 0.0106, "ТСЯЗ": 0.0106, "РИЧН": 0.0106, "ЛЯЗА": 0.0106,
+	"ККАК": 0.0106, "АМИД": 0.0106, "ННУЮ": 0.0106, "ЭКСП": 0.0106, "КОРО": 0.0106,
+	"ОЙПО": 0.0106, "ЕЦИФ": 0.0106, "АЯСТ": 0.0105, "ТАЛЬ": 0.0105, "ЕРЕС": 0.0105,
+	"ОДСТ": 0.0105, "РАНС": 0.0105, "АНЕС": 0.0105, "НАЯД": 0.0105, "ЕКСА": 0.0105,
+	"ЦИЯН": 0.0105, "ИМПО": 0.0105, "ЕНЕП": 0.0105, "ИЗИР": 0.0105, "ТУРА": 0.0105,
+	"КУЩЕ": 0.0105, "ИМЯФ": 0.0105, "ЕЦИА": 0.0105, "ОСОБ": 0.0105, "ГМЕН": 0.0105,
+	"ГОРА": 0.0105, "ФИЧЕ": 0.0105, "ТСКИ": 0.0104, "ВОДС": 0.0104, "ПОДС": 0.0104,
+	"ЙКЛЮ": 0.0104, "ВАЮТ": 0.0104, "НАПО": 0.0104, "НЕБУ": 0.0104, "КАВЫ": 0.0104,
+	"УЧАЕ": 0.0104, "ЫДЕЛ": 0.0104, "ДЛЯЗ": 0.0104, "СДЕЛ": 0.0104, "БОЧЕ": 0.0104,
+	"ЬУДА": 0.0104, "МОПР": 0.0104, "СКОЕ": 0.0103, "ДЕНА": 0.0103, "ЕДНЕ": 0.0103,
+	"ОЧТО": 0.0103, "ЬИНФ": 0.0103, "ИВНЫ": 0.0103, "ЕТИМ": 0.0103, "ИМЯП": 0.0103,
+	"ТОЖЕ": 0.0103, "ЫДЛЯ": 0.0103, "ОТИВ": 0.0103, "НЫХФ": 0.0103, "ЬВЫП": 0.0103,
+	"ИАЛЬ": 0.0103, "ТАКК": 0.0103, "ЕВДО": 0.0103, "БОЙП": 0.0103, "АФИЧ": 0.0103,
+	"ЕНЕВ": 0.0102, "КСТО": 0.0102, "АЕТН": 0.0102, "МЕЩА": 0.0102, "ЕВЫХ": 0.0102,
+	"ТЬОТ": 0.0102, "ТДЛЯ": 0.0102, "ЕТКУ": 0.0102, "ЛЕНЫ": 0.0102, "ОМСЛ": 0.0102,
+	"ОИЗВ": 0.0102, "ИСПР": 0.0102, "РОЕК": 0.0102, "КСТА": 0.0101, "ЕРАТ": 0.0101,
+	"ЕВРЕ": 0.0101, "СТОП": 0.0101, "ИЧЕН": 0.0101, "ЗИРО": 0.0101, "ГОРЕ": 0.0101,
+	"НИЯМ": 0.0101, "МАКС": 0.0101, "ЕРИТ": 0.0101, "ШИБО": 0.0101, "ЬУКА": 0.0101,
+	"АТЬБ": 0.0101, "ОДАВ": 0.0101, "ПСЕВ": 0.0101, "СЕВД": 0.0101, "СЫЛО": 0.0101,
+	"РОЙТ": 0.0101, "ЯРЕГ": 0.0101, "ДЕНО": 0.0100, "СКРИ": 0.0100, "АТАТ": 0.0100,
+	"ВКОН": 0.0100, "ЬИЛИ": 0.0100, "НИЕФ": 0.0100, "СЯЗА": 0.0100, "ОЧИС": 0.0100,
+	"НЫЕВ": 0.0100, "ЫЙВЫ": 0.0100, "ОВЫЕ": 0.0100, "ЬВРЕ": 0.0100, "НОНИ": 0.0100,
+	"УЮТС": 0.0100, "СНОВ": 0.0100, "ЛАГА": 0.0099, "АТОВ": 0.0099, "ОРОМ": 0.0099,
+	"ЕВОЙ": 0.0099, "РОЛЕ": 0.0099, "ОВНО": 0.0099, "ЬПАР": 0.0099, "ИТЬЗ": 0.0099,
+	"ПОСТ": 0.0099, "ТЬУК": 0.0099, "ЦИИС": 0.0099, "ДЛЯУ": 0.0099, "НЫЕФ": 0.0099,
+	"ЕШИТ": 0.0099, "ВДЛЯ": 0.0099, "НОЙИ": 0.0099, "ЕТОК": 0.0099, "РИНИ": 0.0098,
+	"ЮЧАЕ": 0.0098, "ЙТИА": 0.0098, "ЖИТЕ": 0.0098, "ОЕЧИ": 0.0098, "ЕЕСЛ": 0.0098,
+	"ЕРЕГ": 0.0098, "ОВЫП": 0.0098, "СЫВА": 0.0098, "НУЖН": 0.0098, "ЬЗЯИ": 0.0098,
+	"ТСЯА": 0.0098, "ЕРША": 0.0098, "АСИМ": 0.0098, "ИУКА": 0.0098, "КСИС": 0.0098,
+	"ЛИКТ": 0.0098, "ВЕДИ": 0.0098, "ТИВА": 0.0098, "ЯЭТО": 0.0098, "РАЩА": 0.0098,
+	"ДЕЛО": 0.0098, "ЦИОН": 0.0098, "ЛИЙС": 0.0097, "МИНИ": 0.0097, "АНТА": 0.0097,
+	"КУРС": 0.0097, "РАТО": 0.0097, "НООТ": 0.0097, "ЫТЬП": 0.0097, "НЕИС": 0.0097,
+	"ЬНОМ": 0.0097, "ЩЕНН": 0.0097, "ЩИЕС": 0.0097, "ОНФЛ": 0.0097, "НФЛИ": 0.0097,
+	"ФЛИК": 0.0097, "ДНЫХ": 0.0097, "ТИМА": 0.0097, "ЕЛЕЙ": 0.0097, "ЬИСП": 0.0097,
+	"ОЕПЕ": 0.0097, "РЖАТ": 0.0097, "ЫЛОК": 0.0097, "НАЯО": 0.0096, "АВЛИ": 0.0096,
+	"ИЛИС": 0.0096, "ОГОД": 0.0096, "ИЯПР": 0.0096, "ЕЧАН": 0.0096, "ОБОЛ": 0.0096,
+	"НЕОТ": 0.0096, "ЖНОУ": 0.0096, "ВЭТО": 0.0096, "КРЫВ": 0.0096, "МЯФА": 0.0096,
+	"АЙЛИ": 0.0096, "ИТОВ": 0.0096, "РИЗА": 0.0096, "ЬНОВ": 0.0096, "ТИАУ": 0.0096,
+	"ИАУТ": 0.0096, "ЯКОН": 0.0096, "ЛЕКТ": 0.0096, "ОСТЕ": 0.0095, "ПУТИ": 0.0095,
+	"ТФАЙ": 0.0095, "РЫТИ": 0.0095, "ЫТЬО": 0.0095, "АТНО": 0.0095, "ООТК": 0.0095,
+	"ЫЙФО": 0.0095, "ТОВО": 0.0095, "РЯДК": 0.0095, "ЕССА": 0.0094, "САМО": 0.0094,
+	"ЛАНД": 0.0094, "ЛОВА": 0.0094, "ИТАН": 0.0094, "ДНЫЙ": 0.0094, "ДСКИ": 0.0094,
+	"ДСТА": 0.0094, "ЧЕНО": 0.0094, "УЧЕТ": 0.0094, "УЛЕВ": 0.0094, "РОКУ": 0.0094,
+	"ГОНЕ": 0.0094, "КЦИЙ": 0.0094, "ЬРАБ": 0.0094, "ОТАТ": 0.0094, "АВКА": 0.0094,
+	"ПАКО": 0.0094, "ИСКЛ": 0.0094, "ЧНЫХ": 0.0094, "ДОПЕ": 0.0094, "СТИП": 0.0094,
+	"ОИЗО": 0.0094, "КАКН": 0.0094, "ОЙСИ": 0.0094, "МДЛЯ": 0.0094, "ИДИА": 0.0094,
+	"ТОНЕ": 0.0093, "ИНАС": 0.0093, "МБОЛ": 0.0093, "ЧЕРЕ": 0.0093, "ВЛЯТ": 0.0093,
+	"АВКУ": 0.0093, "РТНО": 0.0093, "ТВИЕ": 0.0093, "ЫТЬВ": 0.0093, "НОНА": 0.0093,
+	"НАЗВ": 0.0093, "АЗВА": 0.0093, "ЬЗУЮ": 0.0093, "ОТАН": 0.0093, "ВСЕХ": 0.0093,
+	"ОЕНН": 0.0093, "ИЗОШ": 0.0093, "НТРО": 0.0093, "ВЛИВ": 0.0093, "ТСЛЕ": 0.0093,
+	"ЬСКА": 0.0092, "СИМА": 0.0092, "ЕРОМ": 0.0092, "ЛАНЕ": 0.0092, "ЛЯСО": 0.0092,
+	"ЛЯРА": 0.0092, "ЬКОС": 0.0092, "УПАК": 0.0092, "ЙАРГ": 0.0092, "ЬИЗМ": 0.0092,
+	"НСТВ": 0.0092, "ДЛЯЭ": 0.0092, "НАВЛ": 0.0092, "ПРИЧ": 0.0092, "МИДИ": 0.0092,
+	"КСКИ": 0.0091, "РИНА": 0.0091, "ЕРАБ": 0.0091, "ПРИЗ": 0.0091, "СОБР": 0.0091,
+	"ЦИИО": 0.0091, "ТРАЗ": 0.0091, "АНЕО": 0.0091, "КОНЧ": 0.0091, "ОГДА": 0.0091,
+	"ЗЯИС": 0.0091, "ЧАЛЬ": 0.0091, "СЯДЛ": 0.0091, "ОСНО": 0.0091, "ЦИФИ": 0.0091,
+	"БЪЕД": 0.0091, "ЕТЧИ": 0.0091, "ЕОСТ": 0.0090, "ОКРА": 0.0090, "СУФФ": 0.0090,
+	"ОДОЛ": 0.0090, "ХДАН": 0.0090, "ЕОТС": 0.0090, "НЫХИ": 0.0090, "ИМАЯ": 0.0090,
+	"ТПРО": 0.0090, "ОТКА": 0.0090, "ЪЕДИ": 0.0090, "ПАТЧ": 0.0090, "ОРИЯ": 0.0090,
+	"КАРТ": 0.0090, "ПИСЕ": 0.0090, "РЕЗЕ": 0.0090, "АПРА": 0.0090, "КИПО": 0.0090,
+	"АТЬЭ": 0.0090, "ЧТОИ": 0.0090, "АММА": 0.0090, "ЯССЫ": 0.0090, "ЕТКО": 0.0090,
+	"УПНЫ": 0.0090, "АЯИН": 0.0090, "ЫТКА": 0.0090, "ОММН": 0.0090, "УДАЕ": 0.0090,
+	"ЛЬТА": 0.0089, "ВАРИ": 0.0089, "ИСЛИ": 0.0089, "ЕКОН": 0.0089, "ОКЛЮ": 0.0089,
+	"ЕЛИК": 0.0089, "ИЕСИ": 0.0089, "ОСОО": 0.0089, "НОМС": 0.0089, "СНОС": 0.0089,
+	"ЛЬНУ": 0.0089, "ИДАЛ": 0.0089, "НЫХВ": 0.0089, "ЫЙПО": 0.0089, "ЗФАЙ": 0.0089,
+	"КТОВ": 0.0089, "ЫПРО": 0.0089, "СРАВ": 0.0089, "СИРО": 0.0089, "УФФИ": 0.0089,
+	"ФФИК": 0.0089, "ИСТИ": 0.0089, "ЛЮЧН": 0.0089, "РУГО": 0.0089, "ИТЬЭ": 0.0089,
+	"РОШЕ": 0.0089, "ЫХПО": 0.0089, "РСКА": 0.0088, "НИКО": 0.0088, "ТВЕР": 0.0088,
+	"МЕРС": 0.0088, "ГОРИ": 0.0088, "ЕТНЫ": 0.0088, "ДУЛЯ": 0.0088, "НАДЛ": 0.0088,
+	"НЫЕО": 0.0088, "ГОВЫ": 0.0088, "БОТЫ": 0.0088, "ВРАЩ": 0.0088, "ЛЯРН": 0.0088,
+	"ВНЫЙ": 0.0088, "КРИП": 0.0088, "РИПТ": 0.0088, "ЮЩАЯ": 0.0088, "НОЙЗ": 0.0088,
+	"ОВЫХ": 0.0088, "ИСЕЙ": 0.0088, "ДЕТП": 0.0088, "РАЗА": 0.0088, "СТЕЙ": 0.0088,
+	"ИОНН": 0.0088, "МЕРИ": 0.0087, "АНТО": 0.0087, "АНДЕ": 0.0087, "ОМБО": 0.0087,
+	"АРИЙ": 0.0087, "ТАТО": 0.0087, "СХЕМ": 0.0087, "ТНЫЕ": 0.0087, "ЩЕНО": 0.0087,
+	"ЙНОМ": 0.0087, "ЫТЬУ": 0.0087, "ИЗФА": 0.0087, "ЮЩЕГ": 0.0087, "РОТИ": 0.0087,
+	"ВНОМ": 0.0087, "ЛЯКО": 0.0087, "ЛАСЬ": 0.0087, "АНЕД": 0.0087, "ЛИПО": 0.0087,
+	"ЕТИС": 0.0087, "РПОЛ": 0.0087, "ОТОБ": 0.0087, "ОВНА": 0.0087, "АЭТО": 0.0087,
+	"ОШЕН": 0.0087, "КЛОН": 0.0087, "ИМОГ": 0.0087, "КАЯС": 0.0086, "ЕРКА": 0.0086,
+	"АШИН": 0.0086, "ЛОБА": 0.0086, "ЕГОН": 0.0086, "АЙЛУ": 0.0086, "АДОЛ": 0.0086,
+	"ЕНТН": 0.0086, "ИВАЮ": 0.0086, "ЛЬШО": 0.0086, "ЬУСТ": 0.0086, "ИЙКА": 0.0086,
+	"РИТЬ": 0.0086, "АТУР": 0.0086, "ОЕСО": 0.0086, "ИЕСО": 0.0086, "ЮДЛЯ": 0.0086,
+	"ЕГОК": 0.0086, "ЛЯВЫ": 0.0086, "ТОПР": 0.0086, "ТНЫМ": 0.0086, "ИТЬТ": 0.0086,
+	"ОВКЕ": 0.0086, "КЦИЮ": 0.0086, "РШИЛ": 0.0086, "ТМЕН": 0.0086, "ОВКУ": 0.0086,
+	"АЯЗА": 0.0086, "ОМПИ": 0.0086, "МПИЛ": 0.0086, "СЕГМ": 0.0086, "ЗНАК": 0.0086,
+	"ТОТК": 0.0086, "ОТОЗ": 0.0086, "ШИРИ": 0.0085, "РОСИ": 0.0085, "КАРА": 0.0085,
+	"ЛОВЫ": 0.0085, "ОВАЯ": 0.0085, "ЕРЕИ": 0.0085, "АЕТР": 0.0085, "УЕТИ": 0.0085,
+	"ЛЯЦИ": 0.0085, "НОПЕ": 0.0085, "КОГД": 0.0085, "ДЕЛЯ": 0.0085, "АТЬА": 0.0085,
+	"АЧТЕ": 0.0085, "ВОСС": 0.0085, "ДЕРЕ": 0.0085, "ОЕСЛ": 0.0085, "ТПЕР": 0.0085,
+	"ОЧКИ": 0.0085, "СЧЕТ": 0.0085, "ЕГМЕ": 0.0085, "ДЛЯТ": 0.0085, "ЫЕПА": 0.0085,
+	"ЕНОП": 0.0085, "СВЯЗ": 0.0085, "ЗДАВ": 0.0085, "ОТСЛ": 0.0085, "ЕРИЧ": 0.0085,
+	"ООПЕ": 0.0085, "ТОЗВ": 0.0085, "ОЗВА": 0.0085, "ЯПРЕ": 0.0084, "ЕРНЕ": 0.0084,
+	"ЗОВЫ": 0.0084, "ИАЛИ": 0.0084, "СЬРА": 0.0084, "НЫЙД": 0.0084, "ОМКО": 0.0084,
+	"ЯКАК": 0.0084, "АЯВЕ": 0.0084, "ИИЗМ": 0.0084, "ТЕМУ": 0.0084, "ТРАБ": 0.0084,
+	"ЦЕЛЬ": 0.0084, "ЙСИС": 0.0084, "ИЗВЛ": 0.0084, "ЗВЛЕ": 0.0084, "САЦИ": 0.0084,
+	"ТЕРА": 0.0083, "ЕНОС": 0.0083, "ПРЕР": 0.0083, "ЕРАВ": 0.0083, "ОЛЕЙ": 0.0083,
+	"КОМД": 0.0083, "ТВОВ": 0.0083, "ЯТЬС": 0.0083, "ИБОК": 0.0083, "ДОВЕ": 0.0083,
+	"АЗАД": 0.0083, "АДОЧ": 0.0083, "ЛАОШ": 0.0083, "ЛАДК": 0.0083, "ТЕЧТ": 0.0083,
+	"НОЙФ": 0.0083, "ВЕРХ": 0.0082, "ЕКОТ": 0.0082, "РМИН": 0.0082, "ИНЕП": 0.0082,
+	"ЬНУЮ": 0.0082, "РАЗБ": 0.0082, "ИЯПА": 0.0082, "ТЬМЕ": 0.0082, "ЙКОН": 0.0082,
+	"МСЛУ": 0.0082, "ДПОЛ": 0.0082, "ЫЙАР": 0.0082, "ОЗАП": 0.0082, "ИЕДО": 0.0082,
+	"ЕМАЯ": 0.0082, "СЯРЕ": 0.0082, "ОНТР": 0.0082, "ЛАДЕ": 0.0082, "СЛЕЖ": 0.0082,
+	"АРАБ": 0.0082, "АРИЯ": 0.0081, "ЕГОП": 0.0081, "АЙСК": 0.0081, "АРСК": 0.0081,
+	"ИСТА": 0.0081, "ИСЛА": 0.0081, "ОВОЕ": 0.0081, "ОРТА": 0.0081, "ТРОН": 0.0081,
+	"ДРОБ": 0.0081, "РОБН": 0.0081, "АТОЧ": 0.0081, "ШАЕТ": 0.0081, "АЙЛП": 0.0081,
+	"НАЛО": 0.0081, "ЬНАЙ": 0.0081, "ЬИМЯ": 0.0081, "НОЕЧ": 0.0081, "РИСО": 0.0081,
+	"ОДОМ": 0.0081, "ЯЗАТ": 0.0081, "ХСИМ": 0.0081, "РЕДЫ": 0.0081, "ОССТ": 0.0081,
+	"ССТА": 0.0081, "ИЕПЕ": 0.0081, "ЯТЬП": 0.0081, "ЛОВП": 0.0081, "СЯКА": 0.0081,
+	"ОЙФА": 0.0081, "ТРОЛ": 0.0081, "ЗОШЛ": 0.0081, "ОТКИ": 0.0081, "РЕЧИ": 0.0081,
+	"КСИР": 0.0081, "СВОЙ": 0.0081, "ВФОР": 0.0081, "ИЙРЕ": 0.0080, "КРУГ": 0.0080,
+	"РОВО": 0.0080, "КОВО": 0.0080, "РОНН": 0.0080, "ОКАТ": 0.0080, "МАТА": 0.0080,
+	"ИНАР": 0.0080, "АЙТА": 0.0080, "ЕВЫП": 0.0080, "РЕЗУ": 0.0080, "ЕЗУЛ": 0.0080,
+	"ЗУЛЬ": 0.0080, "ТОКО": 0.0080, "ЛИЧИ": 0.0080, "ОПОС": 0.0080, "ОСЬР": 0.0080,
+	"ТЬДА": 0.0080, "ИВЫП": 0.0080, "ЫЙНО": 0.0080, "ИННО": 0.0080, "ОННО": 0.0080,
+	"КАТЬ": 0.0080, "ИМЫЕ": 0.0080, "ТОРП": 0.0080, "НЕДЕ": 0.0080, "ЯБЕЗ": 0.0080,
+	"ИЯФА": 0.0080, "НЫХК": 0.0080, "ЛЮТН": 0.0080, "ДОЧН": 0.0080, "НЫЕН": 0.0080,
+	"ЙТОЧ": 0.0080, "АНОН": 0.0080, "ОШЛА": 0.0080, "АНЕИ": 0.0080, "ТРИГ": 0.0080,
+	"ТАКО": 0.0080, "ОСЛА": 0.0079, "ЕННЕ": 0.0079, "ТИПП": 0.0079, "ИБКИ": 0.0079,
+	"РСИЮ": 0.0079, "ЙАДР": 0.0079, "ИЕОБ": 0.0079, "САНИ": 0.0079, "РОЛЯ": 0.0079,
+	"УЛЯР": 0.0079, "ИПТО": 0.0079, "ЙЛАВ": 0.0079, "ТЕРМ": 0.0079, "АЕТИ": 0.0079,
+	"СОРТ": 0.0079, "ЬИНС": 0.0079, "УЖЕС": 0.0079, "АБСО": 0.0079, "БСОЛ": 0.0079,
+	"СОЛЮ": 0.0079, "ОЛЮТ": 0.0079, "ТСЯЧ": 0.0079, "СОРА": 0.0079, "АЩАЕ": 0.0079,
+	"ЛЮЧО": 0.0079, "ИЯНА": 0.0079, "СРОК": 0.0079, "АНГЛ": 0.0079, "ЬПРЕ": 0.0078,
+	"ИНИЦ": 0.0078, "ОГЛА": 0.0078, "ОПОД": 0.0078, "КАМИ": 0.0078, "ЙВЫВ": 0.0078,
+	"ОЗАД": 0.0078, "ЕСОЗ": 0.0078, "ЕРМИ": 0.0078, "ПРЕЩ": 0.0078, "ТЕРИ": 0.0078,
+	"ЙЛАН": 0.0078, "ТАНН": 0.0078, "НОВР": 0.0078, "ХОТИ": 0.0078, "ОТИТ": 0.0078,
+	"СПОС": 0.0078, "ЕННУ": 0.0078, "ЧНЫМ": 0.0078, "ЛАНА": 0.0078, "СТОЙ": 0.0078,
+	"ЧТОВ": 0.0078, "ЕПАК": 0.0078, "МАШИ": 0.0078, "ИСЫВ": 0.0078, "ЕЖИВ": 0.0078,
+	"ЛИЦЫ": 0.0078, "ОЕКО": 0.0077, "АТЕР": 0.0077, "АНДС": 0.0077, "ОКЕТ": 0.0077,
+	"ИЛИИ": 0.0077, "ТЗАП": 0.0077, "ТИПР": 0.0077, "ТЬИЛ": 0.0077, "ИМЯС": 0.0077,
+	"ЬЗАГ": 0.0077, "ЫЙЗА": 0.0077, "ЛЖНА": 0.0077, "ЦИИВ": 0.0077, "ЕВЫВ": 0.0077,
+	"АЙЛВ": 0.0077, "ЛАСС": 0.0077, "ЕДЫД": 0.0077, "ДЫДУ": 0.0077, "ЫДУЩ": 0.0077,
+	"НАЯИ": 0.0077, "РЕИМ": 0.0077, "НЫЕК": 0.0077, "НАПЕ": 0.0077, "УЕТП": 0.0077,
+	"СЯКО": 0.0077, "РЕАЛ": 0.0077, "БРОС": 0.0077, "МЕТА": 0.0077, "ЕТАД": 0.0077,
+	"ВСПИ": 0.0077, "ННОС": 0.0077, "АПОС": 0.0077, "ПИСЫ": 0.0077, "НАКА": 0.0076,
+	"НОЕК": 0.0076, "ЕНОН": 0.0076, "ДНОВ": 0.0076, "МАЕТ": 0.0076, "БКАО": 0.0076,
+	"ЬТАТ": 0.0076, "ЖИТН": 0.0076, "ОРЫЙ": 0.0076, "ЗАЩИ": 0.0076, "НИЕТ": 0.0076,
+	"ГООБ": 0.0076, "ИЛИВ": 0.0076, "КИНЕ": 0.0076, "ЛИПР": 0.0076, "УЖНО": 0.0076,
+	"ИТЫВ": 0.0076, "ЯЮТС": 0.0076, "НОЙК": 0.0076, "РЕЩЕ": 0.0076, "КИПР": 0.0076,
+	"ИСПИ": 0.0076, "ОРПО": 0.0076, "ЧНОС": 0.0076, "НЕИМ": 0.0076, "РЕСТ": 0.0076,
+	"ЛОЧК": 0.0076, "ВИАТ": 0.0076, "ЯПАК": 0.0076, "АДЕН": 0.0075, "СЕВЕ": 0.0075,
+	"НООБ": 0.0075, "ЯНСК": 0.0075, "АДЕЛ": 0.0075, "ЬКОВ": 0.0075, "РАСК": 0.0075,
+	"НЕРЕ": 0.0075, "РЕКО": 0.0075, "СТИК": 0.0075, "ЕЩАЕ": 0.0075, "ИТНЕ": 0.0075,
+	"ЫХСЕ": 0.0075, "АТЬМ": 0.0075, "ИРУЮ": 0.0075, "МЕРП": 0.0075, "ОБОШ": 0.0075,
+	"ЕЗЕР": 0.0075, "ПОДМ": 0.0075, "РЕГУ": 0.0075, "ЕГУЛ": 0.0075, "РАЗЛ": 0.0075,
+	"АЗЛИ": 0.0075, "ЗЛИЧ": 0.0075, "ВЫШЕ": 0.0075, "ЕСКР": 0.0075, "КАЧТ": 0.0075,
+	"ВПОЛ": 0.0075, "ОДНА": 0.0075, "ОВДЛ": 0.0075, "НДЛЯ": 0.0075, "ОМЕЩ": 0.0075,
+	"ЕКОЛ": 0.0075, "ЩАЕМ": 0.0075, "ЛЯИН": 0.0075, "ОЛЕВ": 0.0075, "МЕТИ": 0.0075,
+	"СТОЛ": 0.0074, "ФРАН": 0.0074, "ЕНТЕ": 0.0074, "АНДУ": 0.0074, "АССИ": 0.0074,
+	"ИРИН": 0.0074, "СБРО": 0.0074, "ТАВИ": 0.0074, "ОДРО": 0.0074, "ПРОД": 0.0074,
+	"НИЕУ": 0.0074, "СОГЛ": 0.0074, "БОШИ": 0.0074, "ЗЕРВ": 0.0074, "СИМЕ": 0.0074,
+	"БЯЗА": 0.0074, "ТЬВЕ": 0.0074, "ЕГОИ": 0.0074, "ОНЕТ": 0.0074, "ОМФА": 0.0074,
+	"ЙЛНЕ": 0.0074, "ДДЛЯ": 0.0074, "УЕМО": 0.0074, "ДИСК": 0.0074, "ЕНАЗ": 0.0074,
+	"НИЯР": 0.0074, "ПОСО": 0.0074, "ЛЯЭТ": 0.0074, "ГОКА": 0.0074, "ИНАЧ": 0.0074,
+	"ЕСУР": 0.0074, "ОСОЗ": 0.0074, "СЛАБ": 0.0074, "ЕФИК": 0.0074, "ТАНЕ": 0.0074,
+	"ЕОПЕ": 0.0074, "ЕЗАГ": 0.0074, "ЬТЕР": 0.0074, "БОЛО": 0.0073, "ОВЕН": 0.0073,
+	"ЕВОД": 0.0073, "ЛИЦЕ": 0.0073, "ЛИЗИ": 0.0073, "АНЕВ": 0.0073, "ТАМИ": 0.0073,
+	"ТЕРН": 0.0073, "ЫХДА": 0.0073, "ЫЧНЫ": 0.0073, "БЕЗП": 0.0073, "ЕГОС": 0.0073,
+	"СИЧЕ": 0.0073, "ГУЛЯ": 0.0073, "ОБЯЗ": 0.0073, "КОМВ": 0.0073, "РУЕМ": 0.0073,
+	"ИУДА": 0.0073, "УДАЧ": 0.0073, "ТИЧН": 0.0073, "СЯДО": 0.0073, "ЫЕПР": 0.0073,
+	"УЮИН": 0.0073, "ВНОЙ": 0.0073, "ВЛЕЧ": 0.0073, "ШЛАО": 0.0073, "ОРИЙ": 0.0073,
+	"СТИВ": 0.0073, "ОМЕТ": 0.0073, "РЕКЛ": 0.0073, "АВСТ": 0.0072, "ИНОН": 0.0072,
+	"НЫЙМ": 0.0072, "СЕГО": 0.0072, "РНОГ": 0.0072, "ИТЬУ": 0.0072, "ОМДЛ": 0.0072,
+	"ДЛЯА": 0.0072, "ИЮДЛ": 0.0072, "ОВВО": 0.0072, "МЯПО": 0.0072, "КЛАС": 0.0072,
+	"ЕЭТО": 0.0072, "ПОЛА": 0.0072, "ДНЫЕ": 0.0072, "ОМВЕ": 0.0072, "ТЬКЛ": 0.0072,
+	"ЧИВА": 0.0072, "ТЬОД": 0.0072, "ЫЕПО": 0.0072, "ДЛЯФ": 0.0072, "ЦИИП": 0.0072,
+	"ЕМНЫ": 0.0072, "ИЭТО": 0.0072, "СУРС": 0.0072, "ЯАРХ": 0.0072, "УРОВ": 0.0072,
+	"ЖАТИ": 0.0072, "ПРЕФ": 0.0072, "РЕФИ": 0.0072, "МЕРВ": 0.0072, "ИАТУ": 0.0072,
+	"ЕЕТС": 0.0072, "ОСМЕ": 0.0072, "ДОМА": 0.0071, "ОВПР": 0.0071, "НЕТИ": 0.0071,
+	"ИСКО": 0.0071, "ДАНА": 0.0071, "ИПРЕ": 0.0071, "АЗОБ": 0.0071, "РИВЫ": 0.0071,
+	"ПАДЕ": 0.0071, "ОНЦЕ": 0.0071, "АТАЕ": 0.0071, "СКАТ": 0.0071, "ЦИЕЙ": 0.0071,
+	"ТРАТ": 0.0071, "ТЬТЕ": 0.0071, "НОЙО": 0.0071, "НИЯФ": 0.0071, "НЕМЕ": 0.0071,
+	"ИЛАС": 0.0071, "НЫХО": 0.0071, "ЕКОД": 0.0071, "ГУТБ": 0.0071, "УТБЫ": 0.0071,
+	"ОДНИ": 0.0071, "ДЛЯЧ": 0.0071, "ТЕКО": 0.0071, "ЕСМЕ": 0.0071, "НГЛИ": 0.0071,
+	"ГЛИЙ": 0.0071, "ЕКСИ": 0.0071, "ОНСК": 0.0070, "АЙТО": 0.0070, "ОЛОМ": 0.0070,
+	"ОНЕС": 0.0070, "ОКОЛ": 0.0070, "ВЛАД": 0.0070, "РИПО": 0.0070, "СОКЕ": 0.0070,
+	"АМЕЧ": 0.0070, "ХСЕР": 0.0070, "РЫТЫ": 0.0070, "ТРНЕ": 0.0070, "ИЯЗА": 0.0070,
+	"РВЫЙ": 0.0070, "ОНЧИ": 0.0070, "ЛАПР": 0.0070, "ИПОД": 0.0070, "ЖЕНО": 0.0070,
+	"ОИМЕ": 0.0070, "ЧИТЫ": 0.0070, "ГОИН": 0.0070, "ОАДР": 0.0070, "ДЕСК": 0.0070,
+	"ПТОР": 0.0070, "ИЕСЯ": 0.0070, "ЖНОН": 0.0070, "ИПОС": 0.0070, "ЬКЛЮ": 0.0070,
+	"ЙСТА": 0.0070, "ЛОВВ": 0.0070, "ДАЖЕ": 0.0070, "ЮИНФ": 0.0070, "ДУЕТ": 0.0070,
+	"ЬОБЪ": 0.0070, "ЕРЕЧ": 0.0070, "ОВКО": 0.0070, "ЙИЛИ": 0.0070, "ЯТИП": 0.0070,
+	"ОЛОЧ": 0.0070, "БАЗО": 0.0070, "НЕСЛ": 0.0070, "РОКД": 0.0070, "ТИНС": 0.0070,
+	"АТАК": 0.0070, "ОНОМ": 0.0070, "КАЯК": 0.0070, "СТИЛ": 0.0070, "ЕЙСК": 0.0070,
+	"АЛЬС": 0.0070, "РЕДИ": 0.0070, "БАЛЬ": 0.0070, "ЙИНД": 0.0070, "ИГУР": 0.0070,
+	"ИЕНА": 0.0070, "ОМРЕ": 0.0070, "ГОКЛ": 0.0070, "ОРНЕ": 0.0070, "АЙЛК": 0.0070,
+	"АЯДЛ": 0.0070, "АЕТВ": 0.0070, "БКАС": 0.0070, "ЩИЕП": 0.0070, "СПРО": 0.0070,
+	"НЕНО": 0.0070, "ВНОГ": 0.0070, "ЙССЫ": 0.0070, "ЖНОЗ": 0.0070, "БКАЧ": 0.0070,
+	"ЕСПЕ": 0.0070, "КОВЫ": 0.0070, "ЫХСИ": 0.0070, "ВЕРЯ": 0.0070, "СЬНЕ": 0.0070,
+	"ОЧКА": 0.0070, "ЕТВЫ": 0.0070, "ОУСТ": 0.0070, "ЫЕИЗ": 0.0070, "ЕМЕС": 0.0070,
+	"ВСТА": 0.0070, "УТРИ": 0.0070, "ЯЕСЛ": 0.0070, "ОБЫП": 0.0070, "ОДМО": 0.0070,
+	"ЕЦКЛ": 0.0070, "ЕТТА": 0.0069, "ТИЛИ": 0.0069, "РУЗИ": 0.0069, "ОЛЬС": 0.0069,
+	"НАХО": 0.0069, "РАВЕ": 0.0069, "ИЛИО": 0.0069, "ИОТК": 0.0069, "ЗИТЬ": 0.0069,
+	"НЫЙЗ": 0.0069, "ЬКОО": 0.0069, "ЯДЛИ": 0.0069, "ХВАТ": 0.0069, "УЛЯЦ": 0.0069,
+	"УЩИЙ": 0.0069, "ИКОД": 0.0069, "БОЧИ": 0.0069, "ЕТРИ": 0.0069, "ТКОМ": 0.0069,
+	"МЫЙС": 0.0069, "КТИР": 0.0069, "ПРИУ": 0.0069, "НИРО": 0.0069, "АММН": 0.0069,
+	"ДНЕЙ": 0.0069, "ИЯРА": 0.0069, "ТУПЕ": 0.0069, "НАЯВ": 0.0069, "ТПОЛ": 0.0069,
+	"ИЗВО": 0.0069, "ЛАЕТ": 0.0069, "ТИВЫ": 0.0069, "ОГОМ": 0.0069, "ЕЙСА": 0.0069,
+	"МЫЙФ": 0.0069, "ИИМЕ": 0.0069, "МКАТ": 0.0069, "ИВСЕ": 0.0069, "ДМОД": 0.0069,
+	"ЕРХН": 0.0068, "ЛЬТЕ": 0.0068, "АРЕЛ": 0.0068, "НИЕА": 0.0068, "СТРИ": 0.0068,
+	"ТОКА": 0.0068, "ИЕСТ": 0.0068, "ЕТИН": 0.0068, "ТНЫХ": 0.0068, "ЕРКИ": 0.0068,
+	"ЦЕЛО": 0.0068, "ОМАШ": 0.0068, "МАШН": 0.0068, "ЕНЕС": 0.0068, "ОИЛИ": 0.0068,
+	"ВОЛВ": 0.0068, "ИНЕУ": 0.0068, "КУЩИ": 0.0068, "ОЛАГ": 0.0068, "ЕТИТ": 0.0068,
+	"ИТРЕ": 0.0068, "ЕРВИ": 0.0068, "ТИНФ": 0.0068, "СЬУД": 0.0068, "ОПАК": 0.0068,
+	"ЕДНИ": 0.0068, "ИЧТЕ": 0.0068, "ФИГУ": 0.0068, "МРАЗ": 0.0068, "МИТО": 0.0068,
+	"ГУРА": 0.0067, "ЕГОР": 0.0067, "РНЫЕ": 0.0067, "АЕТП": 0.0067, "ЫВАН": 0.0067,
+	"ГЛАС": 0.0067, "ЫЙАД": 0.0067, "ДАНО": 0.0067, "ИИПР": 0.0067, "ЯНАС": 0.0067,
+	"ТСЯФ": 0.0067, "ПОПР": 0.0067, "НАЗА": 0.0067, "НИЕБ": 0.0067, "ЫСТР": 0.0067,
+	"ЛЯЮТ": 0.0067, "ШЕНО": 0.0067, "ГРУЖ": 0.0067, "ВСИС": 0.0067, "ОГОА": 0.0067,
+	"ЙЛОМ": 0.0067, "ИИПО": 0.0067, "ДАЧН": 0.0067, "ТТАК": 0.0067, "АПОД": 0.0067,
+	"ГЛОБ": 0.0067, "ОБАЛ": 0.0067, "НОВН": 0.0067, "СЯОТ": 0.0067, "ДНИМ": 0.0067,
+	"ЕНИЮ": 0.0067, "ЙЗАГ": 0.0067, "ЯЮЩЕ": 0.0067, "ИОСТ": 0.0067, "ПРОШ": 0.0067,
+	"АЕСЛ": 0.0067, "ЕКАК": 0.0067, "ТНАД": 0.0067, "ЗАГЛ": 0.0067, "ОНФИ": 0.0067,
+	"ЧЕТЧ": 0.0067, "ЦКАЯ": 0.0066, "ТЕМА": 0.0066, "НДСК": 0.0066, "ЕРСК": 0.0066,
+	"ЗАПО": 0.0066, "ВОВР": 0.0066, "ЕВНЕ": 0.0066, "ЬСТР": 0.0066, "АШИФ": 0.0066,
+	"ЕРПР": 0.0066, "ИЧЕГ": 0.0066, "КАСО": 0.0066, "РАЗН": 0.0066, "АТЬУ": 0.0066,
+	"ПИЛИ": 0.0066, "АЯСС": 0.0066, "ОЕПО": 0.0066, "МВЕЛ": 0.0066, "РЕВО": 0.0066,
+	"ВОЛИ": 0.0066, "ИУСТ": 0.0066, "ВЩИК": 0.0066, "ИННЫ": 0.0066, "РШИТ": 0.0066,
+	"УПЕН": 0.0066, "ИЗАД": 0.0066, "СИТЬ": 0.0066, "ИЗНА": 0.0066, "ИЯДО": 0.0066,
+	"СИНО": 0.0066, "ХКОМ": 0.0066, "МЕНЬ": 0.0066, "ЕСАЦ": 0.0066, "ЯТАЯ": 0.0066,
+	"ВЕРО": 0.0065, "ЕГОВ": 0.0065, "ВЫВА": 0.0065, "РНАТ": 0.0065, "ПЕЦК": 0.0065,
+	"АХОД": 0.0065, "КОВА": 0.0065, "РОДО": 0.0065, "СЯВН": 0.0065, "БЫЛО": 0.0065,
+	"НИЙП": 0.0065, "ЬСЯК": 0.0065, "ОБАЙ": 0.0065, "ЛЯПА": 0.0065, "СЯСИ": 0.0065,
+	"УСПЕ": 0.0065, "ОЗИЦ": 0.0065, "ЗИЦИ": 0.0065, "ЖЕТЕ": 0.0065, "НИЯБ": 0.0065,
+	"ТЕМЕ": 0.0065, "ОЙСС": 0.0065, "ВОЛН": 0.0065, "РДЛЯ": 0.0065, "СЫЛА": 0.0065,
+	"ЫХПА": 0.0065, "ЧНОЙ": 0.0065, "ЙИНФ": 0.0065, "ЛОГЕ": 0.0065, "ДЕЛЫ": 0.0065,
+	"ЯЧТЕ": 0.0065, "РИНУ": 0.0065, "ИПАК": 0.0065, "ГГЕР": 0.0065, "УРСО": 0.0065,
+	"КРЕТ": 0.0065, "РЕТН": 0.0065, "ИСОЗ": 0.0065, "ТНОМ": 0.0065, "БЛИР": 0.0065,
+	"ЕКТР": 0.0065, "БЕЗС": 0.0065, "ЕНЬШ": 0.0065, "ЫЙИН": 0.0065, "КДЕЙ": 0.0065,
+	"НФИГ": 0.0065, "ПЯТА": 0.0065, "АВЕН": 0.0064, "ЧЕРН": 0.0064, "КУНД": 0.0064,
+	"АКОТ": 0.0064, "ВИНС": 0.0064, "ИМАЕ": 0.0064, "СКАЗ": 0.0064, "ЬИМЕ": 0.0064,
+	"ЕНЕО": 0.0064, "КТНА": 0.0064, "ЧНОЕ": 0.0064, "ЯОПЕ": 0.0064, "ЖЕЧТ": 0.0064,
+	"СЛЕИ": 0.0064, "ЬПОС": 0.0064, "СТИИ": 0.0064, "ВВИД": 0.0064, "СПОД": 0.0064,
+	"УДЛЯ": 0.0064, "СПАР": 0.0064, "КИСП": 0.0064, "ЦЕЛЕ": 0.0064, "УЕТВ": 0.0064,
+	"ТИМЯ": 0.0064, "ОЙАР": 0.0064, "ОГОТ": 0.0064, "ТОМУ": 0.0064, "НОДЛ": 0.0064,
+	"ЕПОУ": 0.0064, "ЫЛКУ": 0.0064, "ЦИФР": 0.0064, "АУДИ": 0.0064, "ЭЛЕК": 0.0064,
+	"КТРО": 0.0064, "ФРАЗ": 0.0064, "ГОПА": 0.0063, "ГОСИ": 0.0063, "КОПР": 0.0063,
+	"МРЕЖ": 0.0063, "ЩИЙС": 0.0063, "НЕПЕ": 0.0063, "ИНАП": 0.0063, "НАЛИ": 0.0063,
+	"ЫЕДА": 0.0063, "ЕБЛО": 0.0063, "ЯУКА": 0.0063, "АЩИЩ": 0.0063, "ЕКУР": 0.0063,
+	"ИЯОБ": 0.0063, "ЕБЕЗ": 0.0063, "ЙЛАИ": 0.0063, "НАФА": 0.0063, "ТЬБА": 0.0063,
+	"ИЕРЕ": 0.0063, "ООДН": 0.0063, "ЯРНО": 0.0063, "ГАЕТ": 0.0063, "НЫЕБ": 0.0063,
+	"НОЕО": 0.0063, "ЦИЯО": 0.0063, "ИКЛЮ": 0.0063, "ВАШЕ": 0.0063, "ЮЧНЕ": 0.0063,
+	"ЛЬНЕ": 0.0063, "ЫДОЛ": 0.0063, "ИНЕС": 0.0063, "ГОИС": 0.0063, "СТЬЮ": 0.0063,
+	"ИЯИС": 0.0063, "ДНОМ": 0.0063, "УДИТ": 0.0063, "РИГГ": 0.0063, "ИГГЕ": 0.0063,
+	"ИНЕО": 0.0063, "ЦИИИ": 0.0063, "АСОД": 0.0063, "СРАЗ": 0.0063, "ЦИЯМ": 0.0063,
+	"СМЕН": 0.0063, "ИТЕС": 0.0063, "НОТО": 0.0063, "РАБС": 0.0063, "ОКДЕ": 0.0063,
+	"СЛИС": 0.0063, "ЦКЛА": 0.0063, "ЕНСК": 0.0062, "АСЕР": 0.0062, "ОЕВО": 0.0062,
+	"АЦИО": 0.0062, "ЯНЕС": 0.0062, "АНИТ": 0.0062, "АБСК": 0.0062, "ОТАЕ": 0.0062,
+	"ИЯСО": 0.0062, "ЯВНЕ": 0.0062, "ЕЦФА": 0.0062, "ЦФАЙ": 0.0062, "ЗАШИ": 0.0062,
+	"АСОВ": 0.0062, "СБОР": 0.0062, "ИЕОТ": 0.0062, "ЛИУК": 0.0062, "БКАЗ": 0.0062,
+	"ЕРЫВ": 0.0062, "ИВЫВ": 0.0062, "РОГО": 0.0062, "СЯИЗ": 0.0062, "ЗВОД": 0.0062,
+	"ЦЕЛИ": 0.0062, "ЕБЫЛ": 0.0062, "ЧИСТ": 0.0062, "ЕНАК": 0.0062, "УАЛЬ": 0.0062,
+	"КДЛЯ": 0.0062, "ЕШНЕ": 0.0062, "ОЙКА": 0.0062, "СУММ": 0.0062, "КАОТ": 0.0062,
+	"РИЧТ": 0.0062, "АЕТО": 0.0062, "ДКОМ": 0.0062, "НАТИ": 0.0062, "АТНА": 0.0061,
+	"ОНАЙ": 0.0061, "КИЙО": 0.0061, "НОВЕ": 0.0061, "ЖЕНА": 0.0061, "ТОВИ": 0.0061,
+	"ЕНЕД": 0.0061, "АНСТ": 0.0061, "ТАРО": 0.0061, "РЕНА": 0.0061, "НИМИ": 0.0061,
+	"АНАС": 0.0061, "ОВОМ": 0.0061, "ИТЬБ": 0.0061, "КФАЙ": 0.0061, "ИИКО": 0.0061,
+	"АТЬЧ": 0.0061, "АЛГО": 0.0061, "ЕРПО": 0.0061, "НИЙС": 0.0061, "НЕТС": 0.0061,
+	"ГОВВ": 0.0061, "АТЫВ": 0.0061, "ЬКАК": 0.0061, "ЛЕВЫ": 0.0061, "МЫСЛ": 0.0061,
+	"ЕКАТ": 0.0061, "ЙТОВ": 0.0061, "ЫМОЖ": 0.0061, "ЕНЯТ": 0.0061, "ДУЩЕ": 0.0061,
+	"АЙТЕ": 0.0061, "ЫЕКО": 0.0061, "КИДЛ": 0.0061, "ИИДЛ": 0.0061, "НЬШЕ": 0.0061,
+	"ТОТП": 0.0061, "ЖЕСУ": 0.0061, "АНИЙ": 0.0061, "ЯЦИИ": 0.0061, "УЗКИ": 0.0061,
+	"АНАП": 0.0061, "ТАДА": 0.0061, "БЫЛИ": 0.0061, "ЕМОЕ": 0.0061, "ЕКРЕ": 0.0061,
+	"ИКОН": 0.0061, "НИИП": 0.0061, "ЕКУН": 0.0061, "НДОВ": 0.0061, "АВИА": 0.0061,
+	"ЕИНС": 0.0061, "ЛИНО": 0.0060, "ЙОКР": 0.0060, "КУНА": 0.0060, "РОКО": 0.0060,
+	"РАНЦ": 0.0060, "РАСС": 0.0060, "ЯДОЛ": 0.0060, "ЛИИС": 0.0060, "НЕЦФ": 0.0060,
+	"ЕТОД": 0.0060, "ЕМЕТ": 0.0060, "ИТЬА": 0.0060, "ТСИМ": 0.0060, "ХОДЯ": 0.0060,
+	"ОЙВЕ": 0.0060, "ЕТРН": 0.0060, "ТЬТА": 0.0060, "ЬЧТО": 0.0060, "СМЫС": 0.0060,
+	"ОИНТ": 0.0060, "НИИС": 0.0060, "ОРЫП": 0.0060, "НИЦЫ": 0.0060, "ДАНЕ": 0.0060,
+	"ЯТРЕ": 0.0060, "ИМАТ": 0.0060, "ЫТЬК": 0.0060, "ЛДЛЯ": 0.0060, "ТРЫП": 0.0060,
+	"ЙЧАС": 0.0060, "СПЛА": 0.0060, "ЫМПО": 0.0060, "ЕВСТ": 0.0060, "ЛИПА": 0.0060,
+	"ВНЫМ": 0.0060, "СЕКУ": 0.0060, "ИЯОТ": 0.0060, "ЛЯОТ": 0.0060, "ЭТОП": 0.0060,
+	"НАДЦ": 0.0060, "АДЦА": 0.0060, "ДЦАТ": 0.0060, "ТПАР": 0.0060, "ЕСЛО": 0.0060,
+	"ПЦИЯ": 0.0060, "ЙИНС": 0.0060, "АГЛУ": 0.0060, "УРАЦ": 0.0060, "ИЕВЕ": 0.0060,
+	"ДЕЛС": 0.0060, "МБАЙ": 0.0059, "БОРА": 0.0059, "УЗСК": 0.0059, "ОДАН": 0.0059,
+	"АЛСЯ": 0.0059, "НУЮС": 0.0059, "ЕСОС": 0.0059, "ЯДВО": 0.0059, "ЕХВА": 0.0059,
+	"АДАЮ": 0.0059, "АЕТТ": 0.0059, "ВОБО": 0.0059, "АСПР": 0.0059, "ОДДЛ": 0.0059,
+	"НТИР": 0.0059, "ИМЕТ": 0.0059, "БЕСП": 0.0059, "БИНА": 0.0059, "АЗАВ": 0.0059,
+	"ИЙНЕ": 0.0059, "ЫИЛИ": 0.0059, "ЛНЯТ": 0.0059, "ОДЕЛ": 0.0059, "ИТЗА": 0.0059,
+	"ОТОМ": 0.0059, "ЕТОТ": 0.0059, "ЛЯЧТ": 0.0059, "СКИХ": 0.0059, "ПОВЕ": 0.0059,
+	"КИФА": 0.0059, "ИЦАТ": 0.0059, "ЕМКА": 0.0059, "ЖЕСТ": 0.0059, "АКТО": 0.0059,
+	"ГЛУШ": 0.0059, "ЕРИЯ": 0.0058, "ЧНАЯ": 0.0058, "ЛГОР": 0.0058, "АДОС": 0.0058,
+	"АРИА": 0.0058, "ОВСК": 0.0058, "АНДИ": 0.0058, "ЬСКО": 0.0058, "НАТО": 0.0058,
+	"ЛОВС": 0.0058, "ППЕР": 0.0058, "КОНВ": 0.0058, "ОНВЕ": 0.0058, "ИМОД": 0.0058,
+	"МДЛИ": 0.0058, "ЕРНУ": 0.0058, "НАБО": 0.0058, "ТОВВ": 0.0058, "АПЕЧ": 0.0058,
+	"ПОДХ": 0.0058, "ОДХО": 0.0058, "ДХОД": 0.0058, "ЯКЛА": 0.0058, "ВЫМО": 0.0058,
+	"СЛИУ": 0.0058, "АГАЕ": 0.0058, "АНИР": 0.0058, "ЯУСТ": 0.0058, "АРЕВ": 0.0058,
+	"ИНУД": 0.0058, "ЙЛЫС": 0.0058, "ТРЫИ": 0.0058, "ЧНУЮ": 0.0058, "АЕМА": 0.0058,
+	"НОЙТ": 0.0058, "ВКОМ": 0.0058, "ЕОБН": 0.0058, "ОРОТ": 0.0058, "УГИМ": 0.0058,
+	"ИБЫЛ": 0.0058, "КАДЛ": 0.0058, "ЮЩИМ": 0.0058, "СЕКР": 0.0058, "ЖДАТ": 0.0058,
+	"АКЛЮ": 0.0058, "СТИО": 0.0058, "ЮЧАТ": 0.0058, "ЕЙЗА": 0.0058, "РАТК": 0.0058,
+	"МБЛЕ": 0.0058, "БЛЕР": 0.0058, "ВЫЙР": 0.0058, "ЕВЕТ": 0.0058, "ИКАН": 0.0057,
+	"АМСК": 0.0057, "БЕЛЬ": 0.0057, "АСТЕ": 0.0057, "АЧАЕ": 0.0057, "ОРЕГ": 0.0057,
+	"УССК": 0.0057, "ТОМС": 0.0057, "НИМО": 0.0057, "ИМЕЮ": 0.0057, "ОЧЕР": 0.0057,
+	"УЮВЕ": 0.0057, "РОСА": 0.0057, "РЕСО": 0.0057, "ЕВСЕ": 0.0057, "КСИЧ": 0.0057,
+	"МАСК": 0.0057, "ОМНЕ": 0.0057, "ЬКОР": 0.0057, "ЮЩЕЕ": 0.0057, "ЛЯПЕ": 0.0057,
+	"ИЛСЯ": 0.0057, "ЯРАС": 0.0057, "ВСЕП": 0.0057, "ЯИНФ": 0.0057, "АУСТ": 0.0057,
+	"ЕДЕЙ": 0.0057, "ЕОБЪ": 0.0057, "ЯПРА": 0.0057, "ОРОЙ": 0.0057, "НУДИ": 0.0057,
+	"ОТЛИ": 0.0057, "ОВЩИ": 0.0057, "АСЛИ": 0.0057, "ЯМЕТ": 0.0057, "ИЕИЗ": 0.0057,
+	"ЛИЧА": 0.0057, "АРАВ": 0.0057, "ОТДЕ": 0.0057, "ТДЕЛ": 0.0057, "УДИО": 0.0057,
+	"ДРАЗ": 0.0057, "АКНО": 0.0057, "ВИША": 0.0057, "РАБА": 0.0056, "ВОСТ": 0.0056,
+	"РАНА": 0.0056, "РИАН": 0.0056, "ПЛАТ": 0.0056, "НАЯЗ": 0.0056, "ОТРЕ": 0.0056,
+	"НЕТП": 0.0056, "КАОБ": 0.0056, "РНЕП": 0.0056, "ВЫЧИ": 0.0056, "РИТМ": 0.0056,
+	"ШНИЙ": 0.0056, "ИЦИА": 0.0056, "ЫТЬФ": 0.0056, "ЬДАН": 0.0056, "ЧЕНА": 0.0056,
+	"ИБКЕ": 0.0056, "ТКАП": 0.0056, "ЫРАЗ": 0.0056, "ТИМЕ": 0.0056, "ОЖЕЧ": 0.0056,
+	"ЕРЕХ": 0.0056, "РАНЯ": 0.0056, "ИТАЕ": 0.0056, "ТИРУ": 0.0056, "НИЯТ": 0.0056,
+	"НФАЙ": 0.0056, "ЫХКО": 0.0056, "ЫДАН": 0.0056, "ЛАГИ": 0.0056, "ОИЗМ": 0.0056,
+	"ЯЦЕЛ": 0.0056, "ПЛОХ": 0.0056, "ОТКО": 0.0056, "ВОДН": 0.0056, "ЮЧОМ": 0.0056,
+	"ООПР": 0.0056, "ВКОТ": 0.0056, "ТЛИЧ": 0.0056, "ЬРАС": 0.0056, "ЛЯОБ": 0.0056,
+	"ИЙПО": 0.0056, "ОКОВ": 0.0056, "ДЕТВ": 0.0056, "ТЕНЕ": 0.0056, "ОДРА": 0.0056,
+	"ОВИС": 0.0056, "ВЫРО": 0.0056, "АНДД": 0.0056, "АТИН": 0.0055, "АУКА": 0.0055,
+	"ССКА": 0.0055, "МЕНД": 0.0055, "ОРНО": 0.0055, "МОНТ": 0.0055, "УСТЫ": 0.0055,
+	"ИОДН": 0.0055, "ИКОТ": 0.0055, "ЕОДН": 0.0055, "ОНЕП": 0.0055, "ИЗАК": 0.0055,
+	"ЭТОС": 0.0055, "ТЕДЛ": 0.0055, "ЛЮБО": 0.0055, "ЯИМЕ": 0.0055, "ЬОБР": 0.0055,
+	"ЛАПО": 0.0055, "РИПР": 0.0055, "ХПРО": 0.0055, "ЕНАВ": 0.0055, "КРУЖ": 0.0055,
+	"НИЛИ": 0.0055, "ИЛИД": 0.0055, "АЛЯЕ": 0.0055, "НЫХЗ": 0.0055, "СЯОБ": 0.0055,
+	"АНДВ": 0.0055, "ЕЛЯЕ": 0.0055, "ЕАЛИ": 0.0055, "ТНЕП": 0.0055, "ОЕРА": 0.0055,
+	"ИИФА": 0.0055, "КОМЕ": 0.0055, "ПРИР": 0.0055, "ЯПОУ": 0.0055, "РУСС": 0.0055,
+	"ОЯЗЫ": 0.0055, "МЕЧЕ": 0.0055, "ПЦИИ": 0.0055, "МБЛИ": 0.0055, "ЫРОВ": 0.0055,
+	"АРАТ": 0.0054, "ЕНАН": 0.0054, "ОРАС": 0.0054, "АБОР": 0.0054, "ОКНЕ": 0.0054,
+	"УРСИ": 0.0054, "НТАР": 0.0054, "УЕТЗ": 0.0054, "ЫБЫТ": 0.0054, "НТНЕ": 0.0054,
+	"ЫЧНО": 0.0054, "АБЕЗ": 0.0054, "ЮСТР": 0.0054, "ИЕВН": 0.0054, "ЖИТС": 0.0054,
+	"НОУК": 0.0054, "ОКИП": 0.0054, "ЕШНО": 0.0054, "СЛИО": 0.0054, "ИЙДЛ": 0.0054,
+	"ЕПОК": 0.0054, "СПЕР": 0.0054, "ГОЗА": 0.0054, "ОРОВ": 0.0054, "АЖДЫ": 0.0054,
+	"ОРЫХ": 0.0054, "ЫХЗА": 0.0054, "ИПОМ": 0.0054, "ЛИСП": 0.0054, "ИЯВЫ": 0.0054,
+	"НОИЗ": 0.0054, "АОПЕ": 0.0054, "ЕЛЕВ": 0.0054, "НЫЕЗ": 0.0054, "ИДОЛ": 0.0054,
+	"НРАЗ": 0.0054, "ИЗОВ": 0.0054, "ЛАБЛ": 0.0054, "ЖУРН": 0.0054, "УРНА": 0.0054,
+	"РНАЛ": 0.0054, "ТЬОП": 0.0054, "ЙЛАС": 0.0054, "ЛЯИС": 0.0054, "СКОЙ": 0.0054,
+	"ЯДОП": 0.0054, "РИЦА": 0.0054, "ЙПОД": 0.0054, "ИНАЮ": 0.0054, "ВОВА": 0.0054,
+	"ЕТВЕ": 0.0054, "АКРО": 0.0054, "ИМИЗ": 0.0054, "ЬТИП": 0.0054, "СЛИП": 0.0054,
+	"КОДД": 0.0054, "ДОГО": 0.0054, "КАЛИ": 0.0053, "РЕВА": 0.0053, "АЛАН": 0.0053,
+	"СЕНТ": 0.0053, "ТОТИ": 0.0053, "ЕНИС": 0.0053, "ТСОД": 0.0053, "ЙПЕР": 0.0053,
+	"ЫЧИС": 0.0053, "АИЗМ": 0.0053, "ИТЬМ": 0.0053, "ЛЯСИ": 0.0053, "КООД": 0.0053,
+	"ОКОД": 0.0053, "НОЙВ": 0.0053, "ЛЯМИ": 0.0053, "ТПРИ": 0.0053, "РАЩЕ": 0.0053,
+	"АЩЕН": 0.0053, "ОВИЗ": 0.0053, "СЬОБ": 0.0053, "ОЖНЫ": 0.0053, "ЬНЕС": 0.0053,
+	"РЫПР": 0.0053, "ТРИТ": 0.0053, "АТНЫ": 0.0053, "ТЕПЕ": 0.0053, "ЫТЬН": 0.0053,
+	"СПЕЧ": 0.0053, "МПРО": 0.0053, "УППУ": 0.0053, "СКРЫ": 0.0053, "СЛИИ": 0.0053,
+	"АЧНО": 0.0053, "РШАЕ": 0.0053, "ЫХПР": 0.0053, "ЖЕЕС": 0.0053, "СТЬВ": 0.0053,
+	"ОЖИТ": 0.0053, "ЛИЗО": 0.0053, "ДУЩИ": 0.0053, "ЛЕЧЕ": 0.0053, "ЯТОЙ": 0.0053,
+	"ОЛЕЗ": 0.0053, "ОРАК": 0.0053, "ТРИЦ": 0.0053, "МЫЙР": 0.0053, "ИЯИЗ": 0.0053,
+	"ТИОТ": 0.0053, "ЯТАБ": 0.0053, "ЯСОЗ": 0.0053, "ЙЛАХ": 0.0053, "ИНЯТ": 0.0053,
+	"ЯРАБ": 0.0053, "ЧТОП": 0.0053, "ЕБОЛ": 0.0053, "СПРЯ": 0.0053, "СДВИ": 0.0053,
+	"ЛИЕС": 0.0053, "НИСТ": 0.0052, "ВСКА": 0.0052, "ИНАД": 0.0052, "ЛИМЕ": 0.0052,
+	"ОКИН": 0.0052, "АНЦУ": 0.0052, "НЦУЗ": 0.0052, "ЦУЗС": 0.0052, "МАТР": 0.0052,
+	"ЖНЫЕ": 0.0052, "ЕДСК": 0.0052, "ЕЙЕР": 0.0052, "ПРЕВ": 0.0052, "УЗИТ": 0.0052,
+	"ЩИЙР": 0.0052, "БОРК": 0.0052, "ВНАЧ": 0.0052, "ОККО": 0.0052, "ДАЮЩ": 0.0052,
+	"ЬКОИ": 0.0052, "МЯТЬ": 0.0052, "ЕТТО": 0.0052, "ВЫБО": 0.0052, "ЫБОР": 0.0052,
+	"ОГОН": 0.0052, "РЕРЫ": 0.0052, "ЭТОН": 0.0052, "ЧАЛО": 0.0052, "ОЛИТ": 0.0052,
+	"ЫИСП": 0.0052, "НАЛЬ": 0.0052, "АНЕУ": 0.0052, "НЫМП": 0.0052, "ЕНЕТ": 0.0052,
+	"СОКР": 0.0052, "ООБЪ": 0.0052, "ИОБЪ": 0.0052, "ОПИИ": 0.0052, "РЯДО": 0.0052,
+	"ВРАБ": 0.0052, "ДВИГ": 0.0052, "ОТЗЫ": 0.0052, "ТЗЫВ": 0.0052, "ВОРА": 0.0052,
+	"ЖДОГ": 0.0052, "ИЕИН": 0.0051, "КИЙС": 0.0051, "ТАНИ": 0.0051, "ЕВОЕ": 0.0051,
+	"СТОН": 0.0051, "НЕТР": 0.0051, "ОДЕС": 0.0051, "ЕНТД": 0.0051, "КОДЕ": 0.0051,
+	"ИППЕ": 0.0051, "ВИНД": 0.0051, "ТПОД": 0.0051, "СЯВК": 0.0051, "НОМР": 0.0051,
+	"ТОМО": 0.0051, "ЕВЫШ": 0.0051, "ЖНЫБ": 0.0051, "НЫБЫ": 0.0051, "НИЙК": 0.0051,
+	"УКАЖ": 0.0051, "ТСЯУ": 0.0051, "ЙВЕР": 0.0051, "ОПАР": 0.0051, "СЯФА": 0.0051,
+	"ЕОБЯ": 0.0051, "ТЬЧТ": 0.0051, "СВОБ": 0.0051, "ИЙСИ": 0.0051, "ЬТЕК": 0.0051,
+	"ЙЛЫН": 0.0051, "ЗАНЫ": 0.0051, "ИЕИЛ": 0.0051, "ВУЮТ": 0.0051, "НИИД": 0.0051,
+	"ПРИИ": 0.0051, "ОЧЕН": 0.0051, "ЫПРИ": 0.0051, "ЕНПО": 0.0051, "АВСЕ": 0.0051,
+	"СТИЧ": 0.0051, "УПЕР": 0.0051, "ЕЙЧА": 0.0051, "ИПОУ": 0.0051, "ИБКО": 0.0051,
+	"ЗВОЛ": 0.0051, "ЙАРХ": 0.0051, "ЛЯОП": 0.0051, "ЕКСН": 0.0051, "ИТЕВ": 0.0051,
+	"ЛЕМА": 0.0051, "ОМЕС": 0.0051, "ЕТСИ": 0.0051, "ОМРА": 0.0051, "ИЯСП": 0.0051,
+	"ЙВЕТ": 0.0051, "ЯЧТО": 0.0051, "ТОРЕ": 0.0051, "ВЕКТ": 0.0051, "ЩЕЙЗ": 0.0051,
+	"МАКР": 0.0051, "МЕРР": 0.0051, "ОМЕЧ": 0.0051, "ЕКТЫ": 0.0051, "ОЙРА": 0.0051,
+	"БЫСТ": 0.0051, "ЯДКО": 0.0051, "ЛУШК": 0.0051, "БЛЕН": 0.0050, "ИЙОК": 0.0050,
+	"НЫЙБ": 0.0050, "ОННЕ": 0.0050, "ЕСИС": 0.0050, "АБУЛ": 0.0050, "ЛОНЕ": 0.0050,
+	"РОМЕ": 0.0050, "ОМБА": 0.0050, "ЯДАН": 0.0050, "НВЕЙ": 0.0050, "ВЕЙЕ": 0.0050,
+	"ЕРВА": 0.0050, "ЕЧЕМ": 0.0050, "РЕВЫ": 0.0050, "ЕЙНЕ": 0.0050, "ТЗНА": 0.0050,
+	"ЛОЖИ": 0.0050, "СГЕН": 0.0050, "КАЖИ": 0.0050, "АЖИТ": 0.0050, "АЦИЙ": 0.0050,
+	"ФЕРА": 0.0050, "НИЯЗ": 0.0050, "НАСО": 0.0050, "МСИМ": 0.0050, "ТАБУ": 0.0050,
+	"АМОЖ": 0.0050, "ТСЯБ": 0.0050, "ММЫИ": 0.0050, "ЬВЕР": 0.0050, "СЛОМ": 0.0050,
+	"ОБЕС": 0.0050, "АПОК": 0.0050, "ОБРЕ": 0.0050, "МЫЕФ": 0.0050, "СЯОП": 0.0050,
+	"ЕРВН": 0.0050, "ОТОВ": 0.0050, "ВДИА": 0.0050, "ЯКАТ": 0.0050, "СКИМ": 0.0050,
+	"СЛОТ": 0.0050, "ЯКЛЮ": 0.0050, "АТОЛ": 0.0050, "РОТК": 0.0050, "НЫМК": 0.0050,
+	"ПЯТО": 0.0050, "СТИН": 0.0050, "ОРАЯ": 0.0050, "ЛЯДО": 0.0050, "АНИМ": 0.0050,
+	"НЕНУ": 0.0050, "ЕТИЧ": 0.0050, "СТРЫ": 0.0050, "ВАРХ": 0.0050, "ЛСОД": 0.0050,
+	"ОКАС": 0.0050, "ВАДО": 0.0050, "ЕЦКА": 0.0050, "ИТЕН": 0.0050, "ЛИТО": 0.0050,
+	"МЕРУ": 0.0050, "АБАТ": 0.0050, "ТОВС": 0.0050, "ЯНОВ": 0.0050, "РЖКИ": 0.0050,
+	"ТЬСЕ": 0.0050, "ТОВЕ": 0.0050, "НИЦИ": 0.0050, "РПРЕ": 0.0050, "СЬПЕ": 0.0050,
+	"ИОТС": 0.0050, "НАБЫ": 0.0050, "ТРАС": 0.0050, "ОКАВ": 0.0050, "РАВО": 0.0050,
+	"ЫЕОП": 0.0050, "ТОВН": 0.0050, "ИЕМО": 0.0050, "АЛЯТ": 0.0050, "ТИСТ": 0.0050,
+	"ЦИКЛ": 0.0050, "ТЬАР": 0.0050, "ОБЩА": 0.0050, "ЕАРГ": 0.0050, "КИКО": 0.0050,
+	"ОЧИЙ": 0.0050, "ИДЕО": 0.0050, "МОЕИ": 0.0050, "ОДПР": 0.0050, "ВИМЕ": 0.0050,
+	"ШИВА": 0.0050, "ХПАР": 0.0050, "ОНТИ": 0.0050, "ЛЯФА": 0.0050, "ЛОКО": 0.0050,
+	"ОЙОБ": 0.0050, "ИИНФ": 0.0050, "ИЮОП": 0.0050, "ДЕКО": 0.0050, "ЗОНЕ": 0.0050,
+	"БАЗЫ": 0.0050, "ЯИЗМ": 0.0050, "ЕМОЙ": 0.0050, "ЕТПЕ": 0.0050, "НИЮД": 0.0050,
+	"ЕРЕО": 0.0050, "ЙЭЛЕ": 0.0050, "КАКД": 0.0050, "ТЭЛЕ": 0.0050, "ИЙКО": 0.0050,
+	"ЯКОД": 0.0050, "НКАК": 0.0050, "ПРЯМ": 0.0050, "ЛИБЫ": 0.0050, "ЕЖДА": 0.0050,
+	"УШКИ": 0.0050, "ТИКА": 0.0049, "СТРЕ": 0.0049, "ДОНИ": 0.0049, "ОВЕД": 0.0049,
+	"ВАНЕ": 0.0049, "ЛЕРА": 0.0049, "ЗСКИ": 0.0049, "ВИЛИ": 0.0049, "РАСТ": 0.0049,
+	"ИТЕР": 0.0049, "ТПРЕ": 0.0049, "АОТС": 0.0049, "ВНОС": 0.0049, "СЯПЕ": 0.0049,
+	"ЬОПР": 0.0049, "ЬЗАД": 0.0049, "ОДЯЩ": 0.0049, "СТЬП": 0.0049, "РЕКУ": 0.0049,
+	"ЬСОО": 0.0049, "БКАХ": 0.0049, "ЙЛЫП": 0.0049, "ОВСЕ": 0.0049, "ЯОБР": 0.0049,
+	"ОВЫМ": 0.0049, "УЩЕГ": 0.0049, "ЭКРА": 0.0049, "КРАН": 0.0049, "РЕНО": 0.0049,
+	"НДОЙ": 0.0049, "НДЫИ": 0.0049, "ОИТЬ": 0.0049, "ИЮПО": 0.0049, "ЫТЬС": 0.0049,
+	"ТКАЗ": 0.0049, "РАМИ": 0.0049, "СЕЙЧ": 0.0049, "УГОЙ": 0.0049, "ЮТНО": 0.0049,
+	"ЯВНО": 0.0049, "ЯВСЕ": 0.0049, "ЛЯЕМ": 0.0049, "АПАК": 0.0049, "ЖЕТИ": 0.0049,
+	"ВАНЫ": 0.0049, "КАКВ": 0.0049, "НООП": 0.0049, "ЕРАК": 0.0049, "ОЖЕС": 0.0049,
+	"МЕРК": 0.0049, "СЛИБ": 0.0049, "АИНС": 0.0049, "ОСПР": 0.0049, "АКЦИ": 0.0049,
+	"ВЯЗА": 0.0049, "ТАКИ": 0.0049, "ТРЯН": 0.0049, "ВЫЙФ": 0.0049, "МСКИ": 0.0048,
+	"ИЛИК": 0.0048, "РОТО": 0.0048, "ОКСИ": 0.0048, "ИНИТ": 0.0048, "ШИЛА": 0.0048,
+	"ЮПРО": 0.0048, "ЛИБО": 0.0048, "РУКТ": 0.0048, "АКОД": 0.0048, "ТЕПО": 0.0048,
+	"ПРЕТ": 0.0048, "ЛАСО": 0.0048, "АБЫТ": 0.0048, "ЙПОЛ": 0.0048, "ЕТИП": 0.0048,
+	"СТОЕ": 0.0048, "ГОСО": 0.0048, "ВНЫЕ": 0.0048, "РЕДВ": 0.0048, "АВЫЧ": 0.0048,
+	"НАТЬ": 0.0048, "ИПОК": 0.0048, "ВОДО": 0.0048, "ЛАДЛ": 0.0048, "ЛЯКА": 0.0048,
+	"НЫМС": 0.0048, "ЕНАФ": 0.0048, "НОУС": 0.0048, "ИВОЗ": 0.0048, "ИОБР": 0.0048,
+	"ИНЕД": 0.0048, "ДПРО": 0.0048, "ПОЖА": 0.0048, "ОЖАЛ": 0.0048, "ЕТОБ": 0.0048,
+	"АСКР": 0.0048, "БРЕЗ": 0.0048, "ДОЧЕ": 0.0048, "ЫПЕР": 0.0048, "ЯГРУ": 0.0048,
+	"ИТЕТ": 0.0048, "ЗУЮТ": 0.0048, "РЫПО": 0.0048, "ВКАТ": 0.0048, "КИМИ": 0.0048,
+	"ЫЕНЕ": 0.0048, "НИЮИ": 0.0048, "ОЙТО": 0.0048, "ОЗВО": 0.0048, "ЦИИД": 0.0048,
+	"ЕРЕА": 0.0048, "АТИЯ": 0.0048, "ОРЕП": 0.0048, "ЬСПИ": 0.0048, "ЬПРА": 0.0048,
+	"ЯНЕО": 0.0048, "ТКЛО": 0.0048, "ИИОБ": 0.0048, "АЗУЕ": 0.0048, "ИВРЕ": 0.0048,
+	"ЖИТИ": 0.0048, "ВСЕК": 0.0048, "ЫЙДЛ": 0.0048, "АВНЫ": 0.0048, "РСОР": 0.0048,
+	"ТОГР": 0.0048, "ОТБР": 0.0048, "АВОЗ": 0.0048, "НЕТО": 0.0048, "ЯЧАС": 0.0048,
+	"АКАК": 0.0048, "ОЧЕМ": 0.0048, "МНЫЙ": 0.0047, "РЕДН": 0.0047, "КОРЕ": 0.0047,
+	"ЛОНА": 0.0047, "ЯЗАН": 0.0047, "ЛИСТ": 0.0047, "ЕЛАН": 0.0047, "ОКПО": 0.0047,
+	"ТАПО": 0.0047, "НОРА": 0.0047, "ЬНЕУ": 0.0047, "АИМЕ": 0.0047, "АНЯТ": 0.0047,
+	"ЕТФА": 0.0047, "ИСЕР": 0.0047, "УКТУ": 0.0047, "ДУЛЬ": 0.0047, "ОРНЫ": 0.0047,
+	"ОВХО": 0.0047, "СКАЮ": 0.0047, "НЫПР": 0.0047, "БАТЫ": 0.0047, "ТЬУС": 0.0047,
+	"ОМНО": 0.0047, "ОСВО": 0.0047, "ИИСО": 0.0047, "КИЕС": 0.0047, "СКЛА": 0.0047,
+	"РИЛИ": 0.0047, "ЕМУЮ": 0.0047, "ОЕИС": 0.0047, "РОИТ": 0.0047, "ЖАЛУ": 0.0047,
+	"АЛУЙ": 0.0047, "ЛУЙС": 0.0047, "УЙСТ": 0.0047, "ИМЫХ": 0.0047, "ЫКОМ": 0.0047,
+	"ТУДА": 0.0047, "КИЗА": 0.0047, "ТАРЫ": 0.0047, "ООБР": 0.0047, "ТОМК": 0.0047,
+	"КАПЕ": 0.0047, "СТЬС": 0.0047, "ОГОЗ": 0.0047, "ПОЗВ": 0.0047, "ЬСЯС": 0.0047,
+	"ЫМКО": 0.0047, "ЙЛАМ": 0.0047, "ЕЛЬЦ": 0.0047, "КЕТЕ": 0.0047, "НАЕТ": 0.0047,
+	"СЛИК": 0.0047, "ОЕСМ": 0.0047, "КИЙД": 0.0047, "НОВЩ": 0.0047, "ИЛИЕ": 0.0047,
+	"АКИС": 0.0047, "УЩЕЙ": 0.0047, "ВАНС": 0.0046, "ЕСАМ": 0.0046, "ЛИЧН": 0.0046,
+	"НАЯТ": 0.0046, "ВСКИ": 0.0046, "КАВС": 0.0046, "КОРН": 0.0046, "МАЛА": 0.0046,
+	"МЕРЕ": 0.0046, "КЛАД": 0.0046, "АЛОВ": 0.0046, "ИВЕР": 0.0046, "ОРИИ": 0.0046,
+	"АСТО": 0.0046, "ИАНТ": 0.0046, "ЛАТИ": 0.0046, "ВЕРТ": 0.0046, "ЧЕМК": 0.0046,
+	"ИМЯВ": 0.0046, "РЕЙТ": 0.0046, "ОЙДЛ": 0.0046, "СЬУС": 0.0046, "ИТЕП": 0.0046,
+	"ЬСТА": 0.0046, "ЗАДЕ": 0.0046, "ЛЕЗА": 0.0046, "ЖНАБ": 0.0046, "НЕХВ": 0.0046,
+	"СИВН": 0.0046, "КАЮТ": 0.0046, "ЬСЯП": 0.0046, "СТЕС": 0.0046, "ЯСТА": 0.0046,
+	"ЫПОД": 0.0046, "ОКНА": 0.0046, "ВОЛС": 0.0046, "ИЯВЕ": 0.0046, "ОБОД": 0.0046,
+	"ОВЫР": 0.0046, "ИЧТО": 0.0046, "ЬСОД": 0.0046, "ЗДАЕ": 0.0046, "ТРИВ": 0.0046,
+	"ИЙДО": 0.0046, "ЕНЫВ": 0.0046, "ТАТИ": 0.0046, "НЕУС": 0.0046, "ЯЗАВ": 0.0046,
+	"ЯКОТ": 0.0046, "УЕТН": 0.0046, "ТНУЮ": 0.0046, "АЖЕЕ": 0.0046, "АЙЛБ": 0.0046,
+	"ЬОБН": 0.0046, "ЩЕНА": 0.0046, "ПОДЧ": 0.0046, "ЯВРЕ": 0.0046, "ЫЕЗА": 0.0046,
+	"ТОЯЩ": 0.0046, "ОКИЗ": 0.0046, "МЫЙП": 0.0046, "ТУАЛ": 0.0046, "ПОДО": 0.0046,
+	"ОВУЮ": 0.0046, "ЬЗНА": 0.0046, "ИСЬВ": 0.0046, "ЕГОО": 0.0046, "ВКАК": 0.0046,
+	"НЫЕА": 0.0046, "ПОКО": 0.0046, "ИМЫМ": 0.0046, "ЕНАД": 0.0046, "СТОГ": 0.0046,
+	"ЕВРА": 0.0046, "ОДОБ": 0.0046, "ЯВЕТ": 0.0046, "АВЫВ": 0.0046, "ДООП": 0.0046,
+	"ОРСК": 0.0045, "НИЖН": 0.0045, "ЗАПА": 0.0045, "АКНЕ": 0.0045, "КАСТ": 0.0045,
+	"ОННА": 0.0045, "МИЛИ": 0.0045, "ЛКАН": 0.0045, "ЕНИФ": 0.0045, "ЛОВЕ": 0.0045,
+	"ГАЛЬ": 0.0045, "МАСС": 0.0045, "НАРА": 0.0045, "АЛИС": 0.0045, "ЗСКА": 0.0045,
+	"РАШИ": 0.0045, "ЦИЯП": 0.0045, "РАЗВ": 0.0045, "ИЙПР": 0.0045, "ОЙПА": 0.0045,
+	"ИСИС": 0.0045, "РНЕВ": 0.0045, "ЫЕСС": 0.0045, "ЛАЗА": 0.0045, "ЛЯРЕ": 0.0045,
+	"ЯФОР": 0.0045, "ТУСП": 0.0045, "ЛЫПО": 0.0045, "НЫЕР": 0.0045, "ИЛИТ": 0.0045,
+	"БУЛЯ": 0.0045, "ЛЕИМ": 0.0045, "ЙЛСО": 0.0045, "ВРАТ": 0.0045, "ОЕПР": 0.0045,
+	"ЬШОЕ": 0.0045, "ЬСЯВ": 0.0045, "АПОУ": 0.0045, "НИИК": 0.0045, "ШЕГО": 0.0045,
+	"РОСМ": 0.0045, "ОТИП": 0.0045, "ЯЗНА": 0.0045, "ОЧКО": 0.0045, "ЧКОЙ": 0.0045,
+	"АЖАТ": 0.0045, "ЯВЫП": 0.0045, "ИЙФА": 0.0045, "ИТЕИ": 0.0045, "ЧЛЕН": 0.0045,
+	"УЮЗА": 0.0045, "ЕЙПО": 0.0045, "ОЗАВ": 0.0045, "ЫЙКА": 0.0045, "НОЗН": 0.0045,
+	"ОНАЧ": 0.0045, "НЕНЫ": 0.0045, "НАЭТ": 0.0045, "ЙТЕК": 0.0045, "ИКСИ": 0.0045,
+	"ДПОЧ": 0.0045, "ИЛИУ": 0.0045, "АЗНЫ": 0.0045, "ВЕТО": 0.0045, "КАДО": 0.0045,
+	"ЕДОБ": 0.0045, "ЛЯТР": 0.0045, "МЧТО": 0.0045, "УЮКО": 0.0045, "РОКС": 0.0045,
+	"ИСОД": 0.0045, "ЧАЮТ": 0.0045, "АЕТЧ": 0.0045, "ОМУС": 0.0045, "ЕНИП": 0.0045,
+	"ЛПЕР": 0.0045, "КАЯД": 0.0045, "ИТАК": 0.0045, "ПОГР": 0.0045, "ЫНАС": 0.0045,
+	"КОЛО": 0.0044, "РИСТ": 0.0044, "ИКАС": 0.0044, "БИРА": 0.0044, "НАРН": 0.0044,
+	"НИЦЕ": 0.0044, "НАСИ": 0.0044, "ТЬПУ": 0.0044, "ИСЬИ": 0.0044, "УЮСТ": 0.0044,
+	"КЕТН": 0.0044, "ИЕКЛ": 0.0044, "ЫТИЯ": 0.0044, "ЕАДР": 0.0044, "ИЩЕН": 0.0044,
+	"ИСИВ": 0.0044, "ЕКРА": 0.0044, "СИИИ": 0.0044, "ВКУИ": 0.0044, "ХСТР": 0.0044,
+	"ДНЫМ": 0.0044, "ЙТЕП": 0.0044, "ВОДЕ": 0.0044, "ЫЙНЕ": 0.0044, "ЛЫНА": 0.0044,
+	"ЕЛАЕ": 0.0044, "РИВА": 0.0044, "ЙЛЫИ": 0.0044, "ТРОИ": 0.0044, "НИИВ": 0.0044,
+	"ЩИКА": 0.0044, "ЯУДА": 0.0044, "ОВЗА": 0.0044, "РЯЮЩ": 0.0044, "ЕНФА": 0.0044,
+	"КАКУ": 0.0044, "ОИМЯ": 0.0044, "ПУТЕ": 0.0044, "ЕТВС": 0.0044, "НЕБЫ": 0.0044,
+	"ЛЕНЕ": 0.0044, "ЕНДЛ": 0.0044, "ЙДОЛ": 0.0044, "ВЕНЬ": 0.0044, "АДКИ": 0.0044,
+	"РЕОП": 0.0044, "ШНЕГ": 0.0044, "ТЬЗН": 0.0044, "ДЕТС": 0.0044, "НЫЙЭ": 0.0044,
+	"НДУЕ": 0.0044, "НИЕЭ": 0.0044, "ИЯИН": 0.0044, "ВОЙН": 0.0044, "ССИВ": 0.0044,
+	"ЛЬТИ": 0.0044, "ЮССЫ": 0.0044, "ТЬВО": 0.0044, "ЛЕНТ": 0.0044, "ТОВД": 0.0044,
+	"ЕМЕЦ": 0.0044, "МЕЦК": 0.0044, "СТОР": 0.0044, "НОСД": 0.0044, "ТКОН": 0.0044,
+	"МФОР": 0.0044, "МКОМ": 0.0044, "ЯОПЦ": 0.0044, "ТКУЮ": 0.0044, "ЯСПЕ": 0.0044,
+	"ЛЯВО": 0.0044, "НЫХР": 0.0044, "ВДОО": 0.0044, "ЬНЕО": 0.0044, "АМЕР": 0.0043,
+	"ИЕОС": 0.0043, "РИТА": 0.0043, "ЕНТР": 0.0043, "ССКИ": 0.0043, "НИИИ": 0.0043,
+	"ОРЕН": 0.0043, "ТФОР": 0.0043, "РЕТИ": 0.0043, "УНАР": 0.0043, "РОВП": 0.0043,
+	"ЕНИМ": 0.0043, "ЕШНИ": 0.0043, "РЕСН": 0.0043, "БУТА": 0.0043, "ДЛЯБ": 0.0043,
+	"ВЫЧК": 0.0043, "ЕНАЧ": 0.0043, "РЕХО": 0.0043, "ЕХОД": 0.0043, "СЕГД": 0.0043,
+	"ЕГДА": 0.0043, "ЛОНО": 0.0043, "БОДН": 0.0043, "ПОАД": 0.0043, "НИИО": 0.0043,
+	"РЕВШ": 0.0043, "ВИСП": 0.0043, "ЕНЕУ": 0.0043, "АЮЩА": 0.0043, "НИЮП": 0.0043,
+	"БУТЫ": 0.0043, "ОКИИ": 0.0043, "ТОРЯ": 0.0043, "ТРАМ": 0.0043, "ЫУКА": 0.0043,
+	"ГОТО": 0.0043, "ЕМНО": 0.0043, "ЕЯВН": 0.0043, "ЬОДН": 0.0043, "ТЬВН": 0.0043,
+	"ЯНЕД": 0.0043, "ЫЕНА": 0.0043, "ЫЛАЕ": 0.0043, "ЕНУЛ": 0.0043, "ОУДА": 0.0043,
+	"СЯСО": 0.0043, "ЙНЕП": 0.0043, "ИЕОП": 0.0043, "ЕНДУ": 0.0043, "НУЮК": 0.0043,
+	"ШИНЫ": 0.0043, "ИИНА": 0.0043, "РИОТ": 0.0043, "ЕЗАМ": 0.0043, "РФАЙ": 0.0043,
+	"ТВОД": 0.0043, "БУКВ": 0.0043, "КИМЕ": 0.0043, "ТУРЕ": 0.0043, "ТИКО": 0.0043,
+	"ЕИГН": 0.0043, "ТАЙС": 0.0042, "АНТИ": 0.0042, "АССА": 0.0042, "ОДСК": 0.0042,
+	"БЕРИ": 0.0042, "ИНИС": 0.0042, "АТЕМ": 0.0042, "НАМЕ": 0.0042, "РОЛИ": 0.0042,
+	"АПЛА": 0.0042, "АНЕТ": 0.0042, "АЛЛЕ": 0.0042, "ЕНТС": 0.0042, "ОМИН": 0.0042,
+	"ТУКА": 0.0042, "ЕЙТИ": 0.0042, "АКАН": 0.0042, "КУИЗ": 0.0042, "ЯСЕР": 0.0042,
+	"ОСЬИ": 0.0042, "СЛУЖ": 0.0042, "КАПА": 0.0042, "УЩЕМ": 0.0042, "ЕАНС": 0.0042,
+	"НИФА": 0.0042, "ЕВЫМ": 0.0042, "ЙЛИМ": 0.0042, "ЯСИС": 0.0042, "ЛИФА": 0.0042,
+	"ВПОД": 0.0042, "ИКОВ": 0.0042, "АЧАТ": 0.0042, "МКЛЮ": 0.0042, "СЯРА": 0.0042,
+	"РОДИ": 0.0042, "ЫХОТ": 0.0042, "НОИМ": 0.0042, "АЗЫД": 0.0042, "ВЫДА": 0.0042,
+	"НОДО": 0.0042, "ЕДУЕ": 0.0042, "ДЕЛП": 0.0042, "БКОЙ": 0.0042, "ЩЕЙТ": 0.0042,
+	"ЫМФА": 0.0042, "ТВЫП": 0.0042, "ЛЯНА": 0.0042, "ВПАК": 0.0042, "СНЫЙ": 0.0042,
+	"ЫЙЭЛ": 0.0042, "МУКА": 0.0042, "ЕТВИ": 0.0042, "СЯТИ": 0.0042, "ОЧЕГ": 0.0042,
+	"ЦИЮП": 0.0042, "ЕНКА": 0.0042, "НОМФ": 0.0042, "ОВРА": 0.0042, "ТЕКИ": 0.0042,
+	"ИЕИС": 0.0042, "ЯВКА": 0.0042, "ДВОР": 0.0042, "ТСКА": 0.0041, "ЬЕГО": 0.0041,
+	"ДАВС": 0.0041, "ОСОВ": 0.0041, "ЛИПЕ": 0.0041, "КАМО": 0.0041, "ДНАЯ": 0.0041,
+	"ЗАКА": 0.0041, "ОМОЖ": 0.0041, "АВАД": 0.0041, "НИИН": 0.0041, "ТБЕЗ": 0.0041,
+	"ВПАР": 0.0041, "ИБЕЗ": 0.0041, "СПЕШ": 0.0041, "ПЕШН": 0.0041, "ТИДЛ": 0.0041,
+	"АДЛЕ": 0.0041, "ДЛЕЖ": 0.0041, "ЕТОЛ": 0.0041, "СЕАН": 0.0041, "ЬБАЙ": 0.0041,
+	"НИЦА": 0.0041, "ЯТЬИ": 0.0041, "ИЕКА": 0.0041, "ТТОЛ": 0.0041, "ЕНЗИ": 0.0041,
+	"НЕПА": 0.0041, "УГИЕ": 0.0041, "ЕСМО": 0.0041, "ЙИСП": 0.0041, "ЕНТВ": 0.0041,
+	"СМАТ": 0.0041, "АРЕГ": 0.0041, "АШИВ": 0.0041, "МАТЬ": 0.0041, "АЙЛД": 0.0041,
+	"КУПО": 0.0041, "РОБУ": 0.0041, "ЗЫДА": 0.0041, "КАКП": 0.0041, "ЕЙТО": 0.0041,
+	"РЯЕТ": 0.0041, "ЫЙСП": 0.0041, "ТАДЛ": 0.0041, "ХПАК": 0.0041, "ВЫЗВ": 0.0041,
+	"ЫЗВА": 0.0041, "МКОД": 0.0041, "ОЧТЕ": 0.0041, "НАНО": 0.0041, "ЧАЛА": 0.0041,
+	"ЩИЙФ": 0.0041, "ОМОГ": 0.0041, "ОСЛИ": 0.0041, "ЕКСЕ": 0.0041, "ЖАТЫ": 0.0041,
+	"ЩАТЬ": 0.0041, "ЬСИС": 0.0041, "ТОВР": 0.0041, "ИСПА": 0.0041, "ВОСП": 0.0041,
+	"КРОС": 0.0041, "ТОЭТ": 0.0041, "ДАЮТ": 0.0041, "ЕТСМ": 0.0041, "СТРВ": 0.0041,
+	"ТОТБ": 0.0041, "НДДО": 0.0041, "ДДОЛ": 0.0041, "ЬИНД": 0.0041, "ТБЛО": 0.0041,
+	"ЮПОД": 0.0041, "ОТУС": 0.0041, "СТЕР": 0.0040, "КАРЕ": 0.0040, "ОДНЕ": 0.0040,
+	"ЦКИЙ": 0.0040, "МОДЕ": 0.0040, "АЯМА": 0.0040, "ВПРИ": 0.0040, "БСКА": 0.0040,
+	"АСПИ": 0.0040, "ВИЛА": 0.0040, "РЖАЩ": 0.0040, "ЬБОЛ": 0.0040, "МЕЮТ": 0.0040,
+	"ЦИИК": 0.0040, "ЕЗПР": 0.0040, "ТЬСЛ": 0.0040, "ВСОО": 0.0040, "МПОЛ": 0.0040,
+	"ЛИОН": 0.0040, "ИВПР": 0.0040, "ИСИН": 0.0040, "УИЗА": 0.0040, "ОЛЫВ": 0.0040,
+	"МЗНА": 0.0040, "ИМЯК": 0.0040, "НПАР": 0.0040, "ЫЕСЛ": 0.0040, "АЕТК": 0.0040,
+	"РЖКО": 0.0040, "ЖКОЙ": 0.0040, "ЛИЛИ": 0.0040, "УПНО": 0.0040, "ИНАК": 0.0040,
+	"ТИПС": 0.0040, "НЕСМ": 0.0040, "ВЫЕС": 0.0040, "ВЫДО": 0.0040, "АТЬЕ": 0.0040,
+	"ЖЕТС": 0.0040, "ЛЕЛЬ": 0.0040, "ИЗСТ": 0.0040, "МОМЕ": 0.0040, "ОЙНЕ": 0.0040,
+	"ОРМЕ": 0.0040, "ЙЛАБ": 0.0040, "ВНАС": 0.0040, "СЯСП": 0.0040, "ЛКИН": 0.0040,
+	"ЕТОЧ": 0.0040, "ДУЛЕ": 0.0040, "ЦИЯВ": 0.0040, "ЬНАС": 0.0040, "ЬБЛО": 0.0040,
+	"ЯДИР": 0.0040, "АКОЙ": 0.0040, "АСПА": 0.0040, "ТЕЗА": 0.0040, "СКОБ": 0.0040,
+	"УЮСС": 0.0040, "ЛОВУ": 0.0040, "АЯДВ": 0.0040, "ЫТЬР": 0.0040, "ВСЕС": 0.0040,
+	"ППАР": 0.0040, "АЯТА": 0.0040, "ЙПОЧ": 0.0040, "АНОС": 0.0040, "УПОМ": 0.0040,
+	"ТЧТО": 0.0040, "ИВАЛ": 0.0040, "ДЕСЯ": 0.0040, "ЕСЯТ": 0.0040, "ЫПАР": 0.0040,
+	"ЕЗАН": 0.0040, "ТОИС": 0.0040, "ТЬКР": 0.0040, "ЮЩЕМ": 0.0040, "ОГРУ": 0.0040,
+	"ФОНЕ": 0.0040, "ТАРИ": 0.0039, "ДУНА": 0.0039, "ЕРРА": 0.0039, "АБАЙ": 0.0039,
+	"АНАН": 0.0039, "АГРА": 0.0039, "КРИТ": 0.0039, "НОЕУ": 0.0039, "ОВОС": 0.0039,
+	"ОММА": 0.0039, "ТАЛИ": 0.0039, "НОЭТ": 0.0039, "ВНЕП": 0.0039, "ТОЧТ": 0.0039,
+	"ИЮПР": 0.0039, "ОМСО": 0.0039, "ЯНИИ": 0.0039, "ЖИМН": 0.0039, "МЕРН": 0.0039,
+	"ГОСЕ": 0.0039, "ЫТЫЙ": 0.0039, "ФИЛЬ": 0.0039, "РСИВ": 0.0039, "ОПАМ": 0.0039,
+	"ОКИС": 0.0039, "ИЮОВ": 0.0039, "ЫМИС": 0.0039, "ЖДОЙ": 0.0039, "ЙЛАД": 0.0039,
+	"УФАЙ": 0.0039, "ИЦЕН": 0.0039, "ЦЕНЗ": 0.0039, "ТОЧИ": 0.0039, "ЫЙОБ": 0.0039,
+	"ОММЕ": 0.0039, "ОКИД": 0.0039, "ТМЕТ": 0.0039, "ИЦАС": 0.0039, "ЫЙМО": 0.0039,
+	"ПЕЧЕ": 0.0039, "ИЗАВ": 0.0039, "ОВВС": 0.0039, "ТУПК": 0.0039, "РАКТ": 0.0039,
+	"ИТИН": 0.0039, "МОТК": 0.0039, "ЗАБЛ": 0.0039, "ОНЕВ": 0.0039, "ТЬСВ": 0.0039,
+	"ЯЗАГ": 0.0039, "ЯВЛЕ": 0.0039, "ЕТУС": 0.0039, "АННЕ": 0.0039, "ЕЕТН": 0.0039,
+	"ОДОВ": 0.0039, "ЬСЯН": 0.0039, "СПАК": 0.0039, "ОМУП": 0.0039, "ЩИМИ": 0.0039,
+	"ЛЕМЫ": 0.0039, "ТОРС": 0.0039, "СЯПА": 0.0039, "ИМЯИ": 0.0039, "РОЧН": 0.0039,
+	"МНЕО": 0.0039, "ЯТЬН": 0.0039, "ТЫНЕ": 0.0039, "ВДОН": 0.0039, "РЯТЬ": 0.0039,
+	"ЖДУН": 0.0039, "ЙНЕР": 0.0039, "ОСДЕ": 0.0039, "ДОБН": 0.0039, "СОКИ": 0.0039,
+	"РИНЯ": 0.0039, "ОБЫУ": 0.0039, "ОЙОП": 0.0039, "ЦИИТ": 0.0039, "ЙТЕС": 0.0039,
+	"АЗУМ": 0.0039, "ДМИН": 0.0039, "АБЛЕ": 0.0039, "ХИВЕ": 0.0039, "НТОЙ": 0.0039,
+	"ОБЛО": 0.0039, "ЬКРА": 0.0039, "ЕТПА": 0.0039, "АВСК": 0.0038, "БУРГ": 0.0038,
+	"ИНДИ": 0.0038, "ТЕНА": 0.0038, "КАЯА": 0.0038, "ЕДЕР": 0.0038, "ИЧИН": 0.0038,
+	"ОНГО": 0.0038, "ОНАЛ": 0.0038, "ОВИЯ": 0.0038, "САНТ": 0.0038, "ИРАБ": 0.0038,
+	"СИЛИ": 0.0038, "РАПО": 0.0038, "ВЫЗЫ": 0.0038, "ЫЗЫВ": 0.0038, "НИОД": 0.0038,
+	"ЕРЕЙ": 0.0038, "ВЫЙК": 0.0038, "КАФА": 0.0038, "ТАТУ": 0.0038, "АТУС": 0.0038,
+	"ЕЗАК": 0.0038, "КИПЕ": 0.0038, "ИЯСЕ": 0.0038, "РЕКР": 0.0038, "СЛОС": 0.0038,
+	"ЬНЕП": 0.0038, "ЬМЕТ": 0.0038, "ИРАС": 0.0038, "ТЬЕГ": 0.0038, "ЫЕАР": 0.0038,
+	"ЩИЙК": 0.0038, "ВОЗН": 0.0038, "РНУТ": 0.0038, "НУТЬ": 0.0038, "ЙРАБ": 0.0038,
+	"ОПОТ": 0.0038, "ДАИС": 0.0038, "ПРАШ": 0.0038, "МИТЕ": 0.0038, "ЛОГН": 0.0038,
+	"ТИНЕ": 0.0038, "НАЦИ": 0.0038, "ОБУЙ": 0.0038, "БУЙТ": 0.0038, "УСКЕ": 0.0038,
+	"НИЯА": 0.0038, "НУЮИ": 0.0038, "АРАЛ": 0.0038, "РАЛЛ": 0.0038, "ЛЛЕЛ": 0.0038,
+	"АПАР": 0.0038, "ТНЕО": 0.0038, "ОКАК": 0.0038, "КАИЗ": 0.0038, "СТЬН": 0.0038,
+	"БЫЛА": 0.0038, "ЕСПО": 0.0038, "ВОЛЯ": 0.0038, "ГОАР": 0.0038, "ЛИСЬ": 0.0038,
+	"ЯДОК": 0.0038, "ГОТИ": 0.0038, "ОТПА": 0.0038, "АНОД": 0.0038, "ОИГН": 0.0038,
+	"ОСПИ": 0.0038, "ЕВХО": 0.0038, "ХОТЯ": 0.0038, "ТВОП": 0.0038, "МЫЙТ": 0.0038,
+	"ОНЕЧ": 0.0038, "ИЕМЕ": 0.0038, "СДЛЯ": 0.0038, "МОГА": 0.0038, "ОРАП": 0.0038,
+	"НАЯМ": 0.0038, "ИНАЕ": 0.0038, "МАТО": 0.0038, "ЯСЛИ": 0.0038, "ЕМЫМ": 0.0038,
+	"НЕТТ": 0.0038, "ДАЗА": 0.0038, "ИЯВС": 0.0038, "ЕПОМ": 0.0038, "ОПОВ": 0.0038,
+	"ЛАТЫ": 0.0038, "КИСТ": 0.0038, "ЙПРЕ": 0.0038, "АСОЗ": 0.0038, "АЯКЛ": 0.0038,
+	"ОКАД": 0.0038, "УЮСП": 0.0038, "ЩИКО": 0.0038, "ЙТЕЧ": 0.0038, "АДМИ": 0.0038,
+	"ЗСПЕ": 0.0038, "ИЙЗА": 0.0038, "ЫЕБЛ": 0.0038, "ОДАП": 0.0038, "ОТБЛ": 0.0038,
+	"ЕЗСП": 0.0038, "ДИНС": 0.0037, "ТИНА": 0.0037, "АНИЛ": 0.0037, "АВАН": 0.0037,
+	"ШСКА": 0.0037, "ЛОСТ": 0.0037, "СКОВ": 0.0037, "ЕНЕИ": 0.0037, "КАКА": 0.0037,
+	"АТАБ": 0.0037, "БАЗА": 0.0037, "ОДАС": 0.0037, "НАЯА": 0.0037, "УДАН": 0.0037,
+	"ЩЕНЕ": 0.0037, "ЗОВО": 0.0037, "АТРЕ": 0.0037, "БОВА": 0.0037, "НЫХН": 0.0037,
+	"ЕНИВ": 0.0037, "ТЬБО": 0.0037, "ЧНОМ": 0.0037, "ЖИТЗ": 0.0037, "ИМНЕ": 0.0037,
+	"АЙЛЗ": 0.0037, "ЬЗАК": 0.0037, "ЕРКУ": 0.0037, "ОНИЗ": 0.0037, "РВОГ": 0.0037,
+	"ЯНЕВ": 0.0037, "ЯОБО": 0.0037, "ИЯИЛ": 0.0037, "ЯСОВ": 0.0037, "ЩИХС": 0.0037,
+	"ДАТА": 0.0037, "СЯАР": 0.0037, "ТАЦИ": 0.0037, "ВКАП": 0.0037, "ЕПОВ": 0.0037,
+	"ГОДЕ": 0.0037, "РИОБ": 0.0037, "АССМ": 0.0037, "НИЮВ": 0.0037, "УЕТТ": 0.0037,
+	"МОДИ": 0.0037, "НИЙИ": 0.0037, "ХИЛИ": 0.0037, "ОМПР": 0.0037, "ИПОВ": 0.0037,
+	"МИМЕ": 0.0037, "КИИС": 0.0037, "ЫЕИМ": 0.0037, "ИЯПЕ": 0.0037, "ОТРА": 0.0037,
+	"АМИИ": 0.0037, "УЗКА": 0.0037, "ВКАН": 0.0037, "СЯВР": 0.0037, "ВБУФ": 0.0037,
+	"ЯМИИ": 0.0037, "ВИРТ": 0.0037, "ЬШЕН": 0.0037, "РУЮТ": 0.0037, "САНН": 0.0037,
+	"ОГИЧ": 0.0037, "ВЫПУ": 0.0037, "ЫПУС": 0.0037, "ТЬБЛ": 0.0037, "ЕНОИ": 0.0037,
+	"РУЧН": 0.0037, "ТЗАГ": 0.0037, "ЛЯДЛ": 0.0037, "ОЕГО": 0.0037, "ОХОТ": 0.0037,
+	"СКОП": 0.0037, "АУДА": 0.0037, "ЙСКО": 0.0037, "СЯЧТ": 0.0037, "ЛАСН": 0.0037,
+	"БОТЧ": 0.0037, "ОТЧИ": 0.0037, "ЕЭЛЕ": 0.0037, "ВКАВ": 0.0037, "ГОИМ": 0.0037,
+	"СИСП": 0.0037, "ДАЕМ": 0.0037, "ОТОЛ": 0.0037, "ХРАЗ": 0.0037, "ТЬТИ": 0.0037,
+	"ИИПА": 0.0037, "НТНО": 0.0037, "ЕЛЯХ": 0.0037, "РАДЛ": 0.0037, "ОТПЕ": 0.0037,
+	"БЫПО": 0.0037, "МРЕГ": 0.0037, "ИЮОБ": 0.0037, "АЯАР": 0.0037, "ТИМИ": 0.0037,
+	"ОВСТ": 0.0037, "ПРИГ": 0.0037, "ЛБУД": 0.0037, "КУЮС": 0.0037, "АТКУ": 0.0037,
+	"КАНС": 0.0036, "КИЕО": 0.0036, "АПАД": 0.0036, "ПАДН": 0.0036, "ЛЬЦА": 0.0036,
+	"ЦЕНТ": 0.0036, "РОВС": 0.0036, "ЫЙСУ": 0.0036, "ОНТА": 0.0036, "ЕНТУ": 0.0036,
+	"СКОР": 0.0036, "ТОЛБ": 0.0036, "УЕТК": 0.0036, "НАСЕ": 0.0036, "ЙПАК": 0.0036,
+	"ЬПАК": 0.0036, "ЛУЖБ": 0.0036, "ЛИШН": 0.0036, "ОГОБ": 0.0036, "ИКАЛ": 0.0036,
+	"ИНАТ": 0.0036, "ЫЙОТ": 0.0036, "ДНЕГ": 0.0036, "МУПО": 0.0036, "ЙСЕР": 0.0036,
+	"ГООП": 0.0036, "ЯНЕУ": 0.0036, "ТЬВМ": 0.0036, "ЬВЫВ": 0.0036, "ЬРЕГ": 0.0036,
+	"ВЛОЖ": 0.0036, "ДАТЕ": 0.0036, "ИТЕД": 0.0036, "ЧИЙК": 0.0036, "СЬИЗ": 0.0036,
+	"ТВСЕ": 0.0036, "КИСИ": 0.0036, "СТЕП": 0.0036, "ЕВКЛ": 0.0036, "НДАН": 0.0036,
+	"ЕЛЬП": 0.0036, "ВОПР": 0.0036, "ЕЛЕМ": 0.0036, "НИЙН": 0.0036, "ЩИЙП": 0.0036,
+	"ПНЫХ": 0.0036, "СОРН": 0.0036, "ОЕВР": 0.0036, "ЫЙДО": 0.0036, "АВЫП": 0.0036,
+	"ЯТНО": 0.0036, "ЕНОМ": 0.0036, "ЮЗАП": 0.0036, "ИСЬС": 0.0036, "НОСЛ": 0.0036,
+	"ИНАЦ": 0.0036, "ВОЧН": 0.0036, "НУЮП": 0.0036, "ИЗАГ": 0.0036, "ЛОХО": 0.0036,
+	"СЯВС": 0.0036, "ИЕВР": 0.0036, "ТАЮТ": 0.0036, "ДЛЯГ": 0.0036, "НИЙВ": 0.0036,
+	"КЕПО": 0.0036, "ТКОЙ": 0.0036, "ЛКАК": 0.0036, "ЕТРД": 0.0036, "АСНЫ": 0.0036,
+	"ЕБАЙ": 0.0036, "ПРОК": 0.0036, "КОМС": 0.0036, "ИНАН": 0.0036, "ТИВС": 0.0036,
+	"ТСЯМ": 0.0036, "КОБК": 0.0036, "РАТЕ": 0.0036, "ЗАРЕ": 0.0036, "ТНЕИ": 0.0036,
+	"ЕНЫП": 0.0036, "ЬНОП": 0.0036, "АЯМЕ": 0.0036, "АППА": 0.0036, "НАЮЩ": 0.0036,
+	"ДАПР": 0.0036, "ОНСО": 0.0036, "ЯМЕЖ": 0.0036, "ОННЫ": 0.0036, "ДАКЦ": 0.0036,
+	"ТОКС": 0.0036, "ЛИРУ": 0.0036, "ЕЛЯМ": 0.0036, "ТЕЛИ": 0.0036, "РНОС": 0.0036,
+	"ОВИЕ": 0.0036, "МЯКО": 0.0036, "ЗУМЕ": 0.0036, "УМЕВ": 0.0036, "МЕВА": 0.0036,
+	"ОБЕЗ": 0.0036, "ЕГЕН": 0.0036, "АНЕК": 0.0036, "ОРОЛ": 0.0036, "ЛЕВС": 0.0036,
+	"АТОМ": 0.0036, "МЕНФ": 0.0036, "МИЗА": 0.0036, "СЯВЫ": 0.0036, "ТПОМ": 0.0036,
+	"ЫБУД": 0.0036, "ГЕРО": 0.0036, "АТСК": 0.0035, "МАНС": 0.0035, "ТОНО": 0.0035,
+	"НИЙР": 0.0035, "ЕНГЕ": 0.0035, "ИМИН": 0.0035, "ЕРНИ": 0.0035, "БИТО": 0.0035,
+	"ВОЕВ": 0.0035, "ДАЛЬ": 0.0035, "АНАД": 0.0035, "НАНА": 0.0035, "НДАВ": 0.0035,
+	"КАИН": 0.0035, "МОНИ": 0.0035, "ЫЙМА": 0.0035, "ДАКО": 0.0035, "АКАТ": 0.0035,
+	"НЕЧЕ": 0.0035, "БЫЛС": 0.0035, "ОСЕР": 0.0035, "ИБОЛ": 0.0035, "ТНЕС": 0.0035,
+	"ИЗКО": 0.0035, "РНОЙ": 0.0035, "ДАЛС": 0.0035, "ФИЦИ": 0.0035, "ТЬУД": 0.0035,
+	"КСТБ": 0.0035, "ТЕРП": 0.0035, "ТЬМА": 0.0035, "ЬСЯИ": 0.0035, "ЗАНА": 0.0035,
+	"ЫЙДИ": 0.0035, "ЙДИА": 0.0035, "МУСО": 0.0035, "РПЕР": 0.0035, "ЕТВО": 0.0035,
+	"АЕТЗ": 0.0035, "АНАБ": 0.0035, "МИСП": 0.0035, "НИЮС": 0.0035, "ЕЕПО": 0.0035,
+	"ЗАНП": 0.0035, "ЙОБР": 0.0035, "АЧИТ": 0.0035, "ЙОБЪ": 0.0035, "ЬВЫХ": 0.0035,
+	"ВНОЕ": 0.0035, "ЛИИЗ": 0.0035, "ШАТЬ": 0.0035, "ЫЕСТ": 0.0035, "ТКАК": 0.0035,
+	"ИСЬП": 0.0035, "ОРОГ": 0.0035, "ОБЫИ": 0.0035, "АЯПЕ": 0.0035, "ОНЕД": 0.0035,
+	"ЫЕДЛ": 0.0035, "НЫМФ": 0.0035, "ВСПО": 0.0035, "ИНЕИ": 0.0035, "ИМОЖ": 0.0035,
+	"ЛНОС": 0.0035, "РКОМ": 0.0035, "ЯНЕМ": 0.0035, "ОИНД": 0.0035, "ЕКСО": 0.0035,
+	"МЕТЬ": 0.0035, "ЙТЕИ": 0.0035, "ОЕНА": 0.0035, "НОУД": 0.0035, "ЙРЕП": 0.0035,
+	"ЕБУЮ": 0.0035, "НЕЧН": 0.0035, "УПНА": 0.0035, "РИРА": 0.0035, "ЗДЕС": 0.0035,
+	"СЛЕС": 0.0035, "МЫЕП": 0.0035, "ЛЮЧП": 0.0035, "ВБАЗ": 0.0035, "МНОЖ": 0.0035,
+	"ОЛЛА": 0.0035, "ЙЛПО": 0.0035, "ТЕЙН": 0.0035, "ДАВО": 0.0035, "ПЛЕК": 0.0035,
+	"ЯТСЯ": 0.0035, "ЕРАД": 0.0035, "ВОДВ": 0.0035, "ОСВЕ": 0.0035, "ЮОПО": 0.0035,
+	"ЕРЯТ": 0.0035, "НИЯЕ": 0.0035, "ЙСУФ": 0.0035, "ВОПЕ": 0.0035, "ЮВЕТ": 0.0035,
+	"АНТН": 0.0035, "ТСМЫ": 0.0035, "ТКИВ": 0.0035, "ЛОКВ": 0.0035, "АНЗА": 0.0034,
+	"ОМНА": 0.0034, "АТИС": 0.0034, "УМЕР": 0.0034, "АРАН": 0.0034, "УНДА": 0.0034,
+	"АЯКА": 0.0034, "РОДН": 0.0034, "АРНА": 0.0034, "КАДР": 0.0034, "АКАР": 0.0034,
+	"КАЯЛ": 0.0034, "ОНЦА": 0.0034, "ОСКО": 0.0034, "МУСТ": 0.0034, "ПОЛИ": 0.0034,
+	"ОРТУ": 0.0034, "СКАЧ": 0.0034, "СКАН": 0.0034, "ВАЛЕ": 0.0034, "СВЕН": 0.0034,
+	"МПЕР": 0.0034, "ОТСО": 0.0034, "ИЕНО": 0.0034, "СЯВП": 0.0034, "ОВОВ": 0.0034,
+	"ЬЗЯС": 0.0034, "ТНЕВ": 0.0034, "ЙЛЗА": 0.0034, "ЕОТК": 0.0034, "ТЬАД": 0.0034,
+	"СОВА": 0.0034, "РЕВЕ": 0.0034, "АДЕР": 0.0034, "ЕЦЕЛ": 0.0034, "УСОР": 0.0034,
+	"ЫЙСЕ": 0.0034, "АСОО": 0.0034, "РИПЕ": 0.0034, "ДШЕС": 0.0034, "ИИЗА": 0.0034,
+	"НЧИТ": 0.0034, "ЬВМЕ": 0.0034, "ОКИВ": 0.0034, "ЯКАЖ": 0.0034, "ЙЕСЛ": 0.0034,
+	"АИГН": 0.0034, "ДЕТИ": 0.0034, "ОЗНИ": 0.0034, "ЗНИК": 0.0034, "ЕНОО": 0.0034,
+	"ВСЕО": 0.0034, "ЕНАМ": 0.0034, "ОПИЮ": 0.0034, "АРНЫ": 0.0034, "НОМП": 0.0034,
+	"ГОСТ": 0.0034, "ЬВЫД": 0.0034, "ИНОМ": 0.0034, "КПРО": 0.0034, "АЯПР": 0.0034,
+	"ЖЕЗА": 0.0034, "ЬПОВ": 0.0034, "ТИИН": 0.0034, "АДАВ": 0.0034, "ВЫНЕ": 0.0034,
+	"ОБЕН": 0.0034, "БЩИЙ": 0.0034, "ЯАДР": 0.0034, "ТЬВК": 0.0034, "ИНЕМ": 0.0034,
+	"ЯПОВ": 0.0034, "ИЗБЕ": 0.0034, "СОПР": 0.0034, "РИЙН": 0.0034, "ТВОС": 0.0034,
+	"ЖАЕТ": 0.0034, "ААРХ": 0.0034, "ДЛЯЦ": 0.0034, "ВНАЯ": 0.0034, "ЕОБО": 0.0034,
+	"ИРТУ": 0.0034, "РТУА": 0.0034, "ОСЬМ": 0.0034, "ЯСОС": 0.0034, "ОЛЯВ": 0.0034,
+	"ЕРКЕ": 0.0034, "ЖИТЬ": 0.0034, "ММОЖ": 0.0034, "ЛЕЗН": 0.0034, "ЙЛКА": 0.0034,
+	"ИЕВО": 0.0034, "ТРДЛ": 0.0034, "ЕЙСО": 0.0034, "СПРЕ": 0.0034, "МНЫЕ": 0.0034,
+	"ТЬСУ": 0.0034, "КЕДО": 0.0034, "ЧИКА": 0.0034, "ДЕФИ": 0.0034, "ЕФИС": 0.0034,
+	"ДНИЙ": 0.0034, "ЯОБН": 0.0034, "ЧИНЕ": 0.0034, "УЮПО": 0.0034, "ТОПЕ": 0.0034,
+	"ИЯСИ": 0.0034, "ЕОТН": 0.0034, "ОГОУ": 0.0034, "ЕНСО": 0.0034, "ОКОТ": 0.0034,
+	"ЙАТР": 0.0034, "ВИЯП": 0.0034, "ЖИТЭ": 0.0034, "ПЕРС": 0.0034, "ЙСПИ": 0.0034,
+	"СКАВ": 0.0034, "ИНАЗ": 0.0034, "КОВК": 0.0034, "СУДА": 0.0034, "АТИТ": 0.0034,
+	"ИТОЛ": 0.0034, "ТИЛЬ": 0.0034, "ВСТВ": 0.0034, "ЫСИМ": 0.0034, "ММАЛ": 0.0034,
+	"ДЕСЬ": 0.0034, "ЯОБЪ": 0.0034, "ЕНУЖ": 0.0034, "ГОПЕ": 0.0034, "ГОСЛ": 0.0034,
+	"СУКА": 0.0034, "АМОЕ": 0.0034, "ЛИНС": 0.0033, "ЛАГО": 0.0033, "КОЕС": 0.0033,
+	"ЕКСК": 0.0033, "РБУД": 0.0033, "МАРК": 0.0033, "КАНИ": 0.0033, "ВАШИ": 0.0033,
+	"БОРО": 0.0033, "ГЕРС": 0.0033, "ЕРАЛ": 0.0033, "ОНИР": 0.0033, "ЛИВО": 0.0033,
+	"АТНЕ": 0.0033, "ТНЕН": 0.0033, "РМАН": 0.0033, "СКАР": 0.0033, "ПОТЕ": 0.0033,
+	"АТЕН": 0.0033, "ТАРА": 0.0033, "ТИРА": 0.0033, "ИЛЬТ": 0.0033, "ТНОВ": 0.0033,
+	"КОПО": 0.0033, "ЛОНИ": 0.0033, "ЖАЩИ": 0.0033, "ОНЕО": 0.0033, "ВЫЙТ": 0.0033,
+	"ЛИНЫ": 0.0033, "АНОП": 0.0033, "СЯИМ": 0.0033, "ЩИЩЕ": 0.0033, "ООДИ": 0.0033,
+	"ИНЕВ": 0.0033, "ТПОС": 0.0033, "АНАЗ": 0.0033, "ЬКОК": 0.0033, "ВНЕС": 0.0033,
+	"ИЯСТ": 0.0033, "АЯФО": 0.0033, "ОКПР": 0.0033, "ЮОВЕ": 0.0033, "ИСЧЕ": 0.0033,
+	"ЯВЫВ": 0.0033, "АКИХ": 0.0033, "СЯСТ": 0.0033, "СЛИФ": 0.0033, "ИДРУ": 0.0033,
+	"ТЕСО": 0.0033, "РУЖА": 0.0033, "ЛЕКО": 0.0033, "ГОДИ": 0.0033, "ОСМО": 0.0033,
+	"ВАМИ": 0.0033, "ТЧЕТ": 0.0033, "ВЗАВ": 0.0033, "ОБНЫ": 0.0033, "ЬЗАВ": 0.0033,
+	"ЬТАК": 0.0033, "ЗУЮЩ": 0.0033, "ЕЛЯВ": 0.0033, "ТЕИС": 0.0033, "СЯНО": 0.0033,
+	"ВГРУ": 0.0033, "ЬРЕЗ": 0.0033, "ЧКАМ": 0.0033, "ЕЛЯИ": 0.0033, "АЕТЕ": 0.0033,
+	"ОНАП": 0.0033, "ЯДОС": 0.0033, "ТЬИХ": 0.0033, "ТАРГ": 0.0033, "ЯЗАД": 0.0033,
+	"АНСП": 0.0033, "НСПО": 0.0033, "ИПРА": 0.0033, "ИЕСС": 0.0033, "КАИС": 0.0033,
+	"ЬОТЛ": 0.0033, "ИИСТ": 0.0033, "РОЧЕ": 0.0033, "ПОЭТ": 0.0033, "ЯИНД": 0.0033,
+	"НОКО": 0.0033, "АЯДИ": 0.0033, "КИМО": 0.0033, "ВЗАГ": 0.0033, "ЯСПИ": 0.0033,
+	"ТОЙС": 0.0033, "ДВОЙ": 0.0033, "ЯОЖИ": 0.0033, "ЫТИИ": 0.0033, "АОБР": 0.0033,
+	"ЛЮЧЗ": 0.0033, "ХПЕР": 0.0033, "ОЛЫИ": 0.0033, "КОСВ": 0.0033, "ЕСОГ": 0.0033,
+	"ТЬДИ": 0.0033, "АНЕР": 0.0033, "ЛЯТО": 0.0033, "ЕТИЗ": 0.0033, "ЙИДЕ": 0.0033,
+	"КАТЕ": 0.0033, "РИЙС": 0.0033, "ОЕИЗ": 0.0033, "ЕИЗО": 0.0033, "ДЕНВ": 0.0033,
+	"РРАЗ": 0.0033, "ЫЕОБ": 0.0033, "ОМФО": 0.0033, "ЕЙВЕ": 0.0033, "ЫХОБ": 0.0033,
+	"РЫКО": 0.0033, "АКИМ": 0.0033, "ЙТЕД": 0.0033, "ШИТЕ": 0.0033, "УЕСЛ": 0.0033,
+	"ОПТИ": 0.0033, "ЕАРХ": 0.0033, "ЖИМВ": 0.0033, "АЛЕЕ": 0.0033, "РОИН": 0.0033,
+	"АРТЫ": 0.0033, "ТПЕЧ": 0.0033, "ЮСПР": 0.0033, "КАЯБ": 0.0033, "АНСА": 0.0032,
+	"ИЛЬС": 0.0032, "ОРОН": 0.0032, "МИТИ": 0.0032, "РМАЛ": 0.0032, "ЕРСО": 0.0032,
+	"ИЕВС": 0.0032, "НАЛЕ": 0.0032, "МОНГ": 0.0032, "ОТЕР": 0.0032, "КАЯМ": 0.0032,
+	"ЕНСИ": 0.0032, "ШСКИ": 0.0032, "РАФА": 0.0032, "ТАТА": 0.0032, "ВИРО": 0.0032,
+	"РЕБО": 0.0032, "ЗВЕР": 0.0032, "УЕТФ": 0.0032, "МЗАП": 0.0032, "ЬШОЙ": 0.0032,
+	"ЫЙПУ": 0.0032, "СТБЕ": 0.0032, "ИИОТ": 0.0032, "ЛЬТР": 0.0032, "АЗБО": 0.0032,
+	"ЗБОР": 0.0032, "ЕПУС": 0.0032, "АОЖИ": 0.0032, "УПОЛ": 0.0032, "ЕДВА": 0.0032,
+	"АРИТ": 0.0032, "АИМЯ": 0.0032, "РЕДШ": 0.0032, "ЕДШЕ": 0.0032, "КОЙС": 0.0032,
+	"ЕТРП": 0.0032, "НОЕР": 0.0032, "ВПРЕ": 0.0032, "РПРО": 0.0032, "ССИМ": 0.0032,
+	"АЕМУ": 0.0032, "МОЕР": 0.0032, "ИЯОП": 0.0032, "ЯОПР": 0.0032, "ВКИП": 0.0032,
+	"ОДОС": 0.0032, "ЫЗАП": 0.0032, "МАТВ": 0.0032, "ДЕЛН": 0.0032, "РВИЧ": 0.0032,
+	"ВИЧН": 0.0032, "ЫСПР": 0.0032, "АСЬН": 0.0032, "ЕТЕС": 0.0032, "ЖИТП": 0.0032,
+	"ТАПР": 0.0032, "ЕТАР": 0.0032, "КАЖЕ": 0.0032, "ОДЧИ": 0.0032, "МУЛЯ": 0.0032,
+	"ИСИТ": 0.0032, "ЯЭЛЕ": 0.0032, "ЯИСТ": 0.0032, "НЧИЛ": 0.0032, "АСОС": 0.0032,
+	"ХИСП": 0.0032, "ОВОТ": 0.0032, "ТКАН": 0.0032, "НОВУ": 0.0032, "КИИЗ": 0.0032,
+	"ТИВО": 0.0032, "НЕБЕ": 0.0032, "ЛУЧШ": 0.0032, "АННУ": 0.0032, "РОИГ": 0.0032,
+	"НАВЕ": 0.0032, "ТЕПА": 0.0032, "ИЯМЕ": 0.0032, "РИСВ": 0.0032, "БИТА": 0.0032,
+	"ИЧАЮ": 0.0032, "ЮЩУЮ": 0.0032, "НЕТВ": 0.0032, "ПОХО": 0.0032, "ОХОЖ": 0.0032,
+	"СЕПО": 0.0032, "АЯСП": 0.0032, "АНЕМ": 0.0032, "ЫЙАТ": 0.0032, "АНОТ": 0.0032,
+	"РВЫМ": 0.0032, "ОНАС": 0.0032, "ИТЭЛ": 0.0032, "АТЕГ": 0.0032, "ЙЛНА": 0.0032,
+	"ЫЕРА": 0.0032, "ОЧТЫ": 0.0032, "ТБРО": 0.0032, "БРОШ": 0.0032, "ИЗДА": 0.0032,
+	"АКОГ": 0.0032, "СКАО": 0.0032, "УДОВ": 0.0032, "ИЯНО": 0.0032, "РОМН": 0.0032,
+	"МЫХФ": 0.0032, "ТЬОС": 0.0032, "НЕБО": 0.0032, "ВИВА": 0.0032, "ПТИМ": 0.0032,
+	"ОКИФ": 0.0032, "ДИКА": 0.0032, "ТЬСС": 0.0032, "ЬАРХ": 0.0032, "ТРЫК": 0.0032,
+	"ИЗПО": 0.0032, "РЯДУ": 0.0032, "ППАХ": 0.0032, "ОЛИН": 0.0031, "МАРА": 0.0031,
+	"КОНА": 0.0031, "ТРАЛ": 0.0031, "НОМН": 0.0031, "ОЛОН": 0.0031, "НТАЛ": 0.0031,
+	"АСКА": 0.0031, "РАНТ": 0.0031, "РЕЛИ": 0.0031, "УСИМ": 0.0031, "НАДА": 0.0031,
+	"ЕССЕ": 0.0031, "КОРА": 0.0031, "ЖНЫЙ": 0.0031, "ЕРЛЕ": 0.0031, "ИЯБЕ": 0.0031,
+	"ТОТС": 0.0031, "АВКО": 0.0031, "СТЫМ": 0.0031, "ЙТИВ": 0.0031, "РИВЯ": 0.0031,
+	"ИВЯЗ": 0.0031, "КСЕР": 0.0031, "АШНИ": 0.0031, "КИСО": 0.0031, "МОЕЗ": 0.0031,
+	"ЯИМЯ": 0.0031, "АЯСИ": 0.0031, "РСИЙ": 0.0031, "СИНХ": 0.0031, "ЕНЕК": 0.0031,
+	"АТКА": 0.0031, "НОПА": 0.0031, "ДАНЫ": 0.0031, "ЕЕСТ": 0.0031, "ВНЕВ": 0.0031,
+	"ЫЛСО": 0.0031, "ЛОШИ": 0.0031, "АВНА": 0.0031, "НОЕН": 0.0031, "ОДСИ": 0.0031,
+	"СТЫЕ": 0.0031, "ЗЫКА": 0.0031, "ЯАРГ": 0.0031, "ДВУХ": 0.0031, "АНПА": 0.0031,
+	"ЛОМА": 0.0031, "ЛЯКЛ": 0.0031, "ОТЧЕ": 0.0031, "ЬГРУ": 0.0031, "ИЕМП": 0.0031,
+	"НИЙО": 0.0031, "ЬПАМ": 0.0031, "АНЕЯ": 0.0031, "ЬДОП": 0.0031, "РАЖА": 0.0031,
+	"ОРЯЮ": 0.0031, "ОМБИ": 0.0031, "ТРАИ": 0.0031, "АУЖЕ": 0.0031, "ЕТЗН": 0.0031,
+	"МИНЕ": 0.0031, "ИЗНЕ": 0.0031, "АДЕЙ": 0.0031, "ТКАВ": 0.0031, "ОБЪЯ": 0.0031,
+	"ЗСТА": 0.0031, "ЕУСЛ": 0.0031, "ЖЕНС": 0.0031, "ДЕНЫ": 0.0031, "АНЫП": 0.0031,
+	"ТЬВП": 0.0031, "СДРУ": 0.0031, "ВЫЙД": 0.0031, "ИТЕЧ": 0.0031, "ЧТОН": 0.0031,
+	"ЬВСП": 0.0031, "НИИФ": 0.0031, "ИВЫД": 0.0031, "ДЕТО": 0.0031, "ИМЯР": 0.0031,
+	"ЛЯИЗ": 0.0031, "ТООН": 0.0031, "ТЬДЕ": 0.0031, "ИКСА": 0.0031, "ОЙНО": 0.0031,
+	"ВОЙК": 0.0031, "НИПО": 0.0031, "ЮЧАЮ": 0.0031, "ИМЕС": 0.0031, "ЙКОП": 0.0031,
+	"БУТО": 0.0031, "ТЬВД": 0.0031, "ОГАТ": 0.0031, "ТЬДВ": 0.0031, "ОКСТ": 0.0031,
+	"ЯОТК": 0.0031, "ЕСЛЕ": 0.0031, "ХССЫ": 0.0031, "СЯИС": 0.0031, "ЯДКЕ": 0.0031,
+	"ОНЕЗ": 0.0031, "СОКВ": 0.0031, "ЯВХО": 0.0031, "ЬЕСЛ": 0.0031, "ИФРЫ": 0.0031,
+	"МАТД": 0.0031, "ТОЙИ": 0.0031, "ИЕИМ": 0.0031, "ЯВОС": 0.0031, "ИЯЕС": 0.0031,
+	"РИНЫ": 0.0031, "ЕАКТ": 0.0031, "ОДАО": 0.0031, "НОЛЬ": 0.0031, "ЕМОТ": 0.0031,
+	"ЮОГР": 0.0031, "ЯВЫБ": 0.0031, "ЕОПЦ": 0.0031, "УДОБ": 0.0031, "НАЯР": 0.0030,
+	"КАБА": 0.0030, "ЛЕНД": 0.0030, "АТАН": 0.0030, "РАЛЬ": 0.0030, "ЕМОН": 0.0030,
+	"ЮЖНО": 0.0030, "ДАРС": 0.0030, "ИКАМ": 0.0030, "МЕРТ": 0.0030, "ИСИП": 0.0030,
+	"НЫЙУ": 0.0030, "АБРА": 0.0030, "АСИН": 0.0030, "СИНС": 0.0030, "РОВИ": 0.0030,
+	"ЕНАИ": 0.0030, "МАНЕ": 0.0030, "ЯБАЙ": 0.0030, "РЕРВ": 0.0030, "ЕРТЫ": 0.0030,
+	"ПЫТА": 0.0030, "ЕРСТ": 0.0030, "УЧАЙ": 0.0030, "ЧАЙН": 0.0030, "ВЫХС": 0.0030,
+	"ЙПУТ": 0.0030, "РУЮЩ": 0.0030, "ЩЕЕС": 0.0030, "РЕСС": 0.0030, "ДЛЯМ": 0.0030,
+	"АДЛИ": 0.0030, "ННЕВ": 0.0030, "ИМИТ": 0.0030, "ЙВВО": 0.0030, "ИИИЛ": 0.0030,
+	"ИНОВ": 0.0030, "ЧЕРТ": 0.0030, "ПАРН": 0.0030, "ЫФАЙ": 0.0030, "ЯБУД": 0.0030,
+	"МОЕП": 0.0030, "ЕПЕН": 0.0030, "ИМАД": 0.0030, "ИОБН": 0.0030, "ССОВ": 0.0030,
+	"ТОВП": 0.0030, "ОЙСО": 0.0030, "ЙЛДЛ": 0.0030, "ВЫМИ": 0.0030, "ЯМОЖ": 0.0030,
+	"ТЕСЬ": 0.0030, "НУЮЗ": 0.0030, "ОЙГР": 0.0030, "ЙГРУ": 0.0030, "ОБЫО": 0.0030,
+	"АЗУД": 0.0030, "ВТЕК": 0.0030, "ОКАП": 0.0030, "МЕРБ": 0.0030, "МНОЕ": 0.0030,
+	"ЗБЕЖ": 0.0030, "ФИЛИ": 0.0030, "ЯСПО": 0.0030, "ИВНА": 0.0030, "ТКАИ": 0.0030,
+	"ТУСТ": 0.0030, "СФАЙ": 0.0030, "РЕЧЕ": 0.0030, "ГИЧЕ": 0.0030, "ГИМИ": 0.0030,
+	"ТКАТ": 0.0030, "ГООТ": 0.0030, "ВРУЧ": 0.0030, "ЕТАК": 0.0030, "ИТАЛ": 0.0030,
+	"ТЕИЛ": 0.0030, "КВРЕ": 0.0030, "ОБЫВ": 0.0030, "ОНЕУ": 0.0030, "ЗНЫХ": 0.0030,
+	"КРАЩ": 0.0030, "ОСОД": 0.0030, "БОЗН": 0.0030, "НЧИВ": 0.0030, "КОДС": 0.0030,
+	"ИРУЙ": 0.0030, "РУЙТ": 0.0030, "ГАТЕ": 0.0030, "ЩИЕФ": 0.0030, "ИОНА": 0.0030,
+	"НЫХТ": 0.0030, "СИЯС": 0.0030, "КАСИ": 0.0030, "ЮТНЫ": 0.0030, "ЕСЯС": 0.0030,
+	"ЕИДЕ": 0.0030, "ТЕГО": 0.0030, "ЮЧЗА": 0.0030, "ОЕУС": 0.0030, "ТАВК": 0.0030,
+	"ОВОД": 0.0030, "ТДАН": 0.0030, "ДАВЫ": 0.0030, "ГОНА": 0.0030, "ЙЛЕН": 0.0030,
+	"ГОВО": 0.0030, "ЕВАЕ": 0.0030, "ПРИЭ": 0.0030, "ИНЕБ": 0.0030, "ТЬФО": 0.0030,
+	"ЭКВИ": 0.0030, "КВИВ": 0.0030, "ТОКВ": 0.0030, "ВВЕР": 0.0030, "РИЕВ": 0.0030,
+	"СТРД": 0.0030, "ТКИС": 0.0030, "СТРН": 0.0030, "ЫЗАД": 0.0030, "ЛЕВА": 0.0030,
+	"ЖДЫЙ": 0.0030, "ДЧИН": 0.0030, "ТЬОК": 0.0030, "НАЖА": 0.0030, "ОТИО": 0.0030,
+	"ТИОС": 0.0030, "АЯСШ": 0.0030, "ЯСША": 0.0030, "ИЮОГ": 0.0030, "ЕРТА": 0.0030,
+	"РХНЕ": 0.0030, "ЕРИК": 0.0030, "ГАНД": 0.0030, "РЕМО": 0.0030, "КАСЕ": 0.0030,
+	"ЛЬША": 0.0030, "НТАН": 0.0030, "ЛИНИ": 0.0030, "МСКА": 0.0030, "НГЕР": 0.0030,
+	"НЕГЕ": 0.0030, "НИЖЕ": 0.0030, "НЫЕМ": 0.0030, "КОВН": 0.0030, "НАИМ": 0.0030,
+	"СИСА": 0.0030, "ЬФОР": 0.0030, "ОВОЗ": 0.0030, "БКАИ": 0.0030, "АЗВЕ": 0.0030,
+	"ИЙСЯ": 0.0030, "ЬАДР": 0.0030, "ЕОГР": 0.0030, "ВСЕР": 0.0030, "ОВАЛ": 0.0030,
+	"ЕНТП": 0.0030, "ОРКИ": 0.0030, "ЕЛОЧ": 0.0030, "АВФА": 0.0030, "ХРОН": 0.0030,
+	"ЕМЯП": 0.0030, "ВАТА": 0.0030, "ОТДО": 0.0030, "ЕНЕБ": 0.0030, "ЗПРЕ": 0.0030,
+	"ДЯЩИ": 0.0030, "ЛКАМ": 0.0030, "ЬТАБ": 0.0030, "ИКОГ": 0.0030, "ЯТЬВ": 0.0030,
+	"ТТИП": 0.0030, "ИСБО": 0.0030, "ЙКЛА": 0.0030, "ИВЫЙ": 0.0030, "ИИВЫ": 0.0030,
+	"НАДИ": 0.0030, "ИЯКА": 0.0030, "НАБЕ": 0.0030, "ТИВЕ": 0.0030, "ЕТЕК": 0.0030,
+	"ЛНОГ": 0.0030, "АПУЩ": 0.0030, "ЕДОМ": 0.0030, "ОЙОТ": 0.0030, "ЬОДИ": 0.0030,
+	"ТИКИ": 0.0030, "ЯЕМО": 0.0030, "ЛИТИ": 0.0030, "БРАЩ": 0.0030, "ТСТР": 0.0030,
+	"ОВПЕ": 0.0030, "ИНЕЯ": 0.0030, "МУНЕ": 0.0030, "ЛОГУ": 0.0030, "МБИН": 0.0030,
+	"СЛИЗ": 0.0030, "ДАМП": 0.0030, "АРЫЙ": 0.0030, "ОЕОБ": 0.0030, "ЙСИН": 0.0030,
+	"ИСТК": 0.0030, "ЭМУЛ": 0.0030, "АТФО": 0.0030, "ОЕТО": 0.0030, "СЬМЕ": 0.0030,
+	"ЕМНЕ": 0.0030, "МИРО": 0.0030, "ЕЛИЧ": 0.0030, "УЧНУ": 0.0030, "ВЕРЬ": 0.0030,
+	"АПОВ": 0.0030, "ИБКУ": 0.0030, "ЯТАК": 0.0030, "АВОЧ": 0.0030, "НИЯУ": 0.0030,
+	"ЕЙКО": 0.0030, "ЕМЫС": 0.0030, "ЛЮЧС": 0.0030, "КАНЧ": 0.0030, "АНЧИ": 0.0030,
+	"ИТЬЧ": 0.0030, "ОВФА": 0.0030, "ТСОЗ": 0.0030, "МУЛЬ": 0.0030, "НЫМВ": 0.0030,
+	"АНОИ": 0.0030, "ЬСУЩ": 0.0030, "АММО": 0.0030, "ТВИИ": 0.0030, "ЕТЧТ": 0.0030,
+	"РИЧИ": 0.0030, "ЖИТК": 0.0030, "НИЯХ": 0.0030, "ЫУДА": 0.0030, "ЯОБЫ": 0.0030,
+	"ОЙНА": 0.0030, "ЕМПО": 0.0030, "ЕМОБ": 0.0030, "РИИС": 0.0030, "ЬСВО": 0.0030,
+	"ЛЯСЛ": 0.0030, "КИОБ": 0.0030, "ХПОД": 0.0030, "ВЕНГ": 0.0030, "НОРВ": 0.0030,
+	"ОРВЕ": 0.0030, "ИЕДА": 0.0030, "АЯПА": 0.0030, "УЛЕЙ": 0.0030, "ККОТ": 0.0030,
+	"ЫЕЗН": 0.0030, "КИИН": 0.0030, "ЕВМЕ": 0.0030, "АИНФ": 0.0030, "ЛЯВС": 0.0030,
+	"ЛОЖН": 0.0030, "ЫЧТО": 0.0030, "БЩЕГ": 0.0030, "ЕТАВ": 0.0030, "ИИТР": 0.0030,
+	"ЕЖАТ": 0.0030, "КРАЗ": 0.0030, "ОБИТ": 0.0030, "ОКВЫ": 0.0030, "ТЕСЛ": 0.0030,
+	"ОИНС": 0.0030, "ИЯВР": 0.0030, "АСКЛ": 0.0030, "ЩИЩА": 0.0030, "ИЦЫС": 0.0030,
+	"ИЩАЕ": 0.0030, "ЖЕСА": 0.0030, "ЛСОБ": 0.0030, "НСКО": 0.0029, "АМИН": 0.0029,
+	"ЕРРЕ": 0.0029, "МЕРД": 0.0029, "НДИЯ": 0.0029, "МБУР": 0.0029, "АССО": 0.0029,
+	"УТОВ": 0.0029, "КОЕВ": 0.0029, "ГОДО": 0.0029, "НОВС": 0.0029, "СЛАВ": 0.0029,
+	"ВОРЕ": 0.0029, "КОСО": 0.0029, "ЕНИЛ": 0.0029, "НИТО": 0.0029, "ОНГА": 0.0029,
+	"ОЛАН": 0.0029, "СФОР": 0.0029, "РВАН": 0.0029, "ЛОВД": 0.0029, "АЛАС": 0.0029,
+	"РИВЕ": 0.0029, "ОЛЕН": 0.0029, "АКСК": 0.0029, "АЯБЕ": 0.0029, "ЕРАП": 0.0029,
+	"ЖДУИ": 0.0029, "ИГРУ": 0.0029, "ИЕУС": 0.0029, "НОЙД": 0.0029, "ЬСЕР": 0.0029,
+	"ОЛБЦ": 0.0029, "СЯКС": 0.0029, "ОРМИ": 0.0029, "ЯПОИ": 0.0029, "ЛАКО": 0.0029,
+	"ОЛНЫ": 0.0029, "СОПО": 0.0029, "ОВСО": 0.0029, "ЮВЕР": 0.0029, "ТВРЕ": 0.0029,
+	"ЕНАО": 0.0029, "АЗАК": 0.0029, "ИНХР": 0.0029, "НХРО": 0.0029, "УЕТД": 0.0029,
+	"ЕТДЛ": 0.0029, "ИТИП": 0.0029, "ОЖДЕ": 0.0029, "АНИЗ": 0.0029, "ТЗАЩ": 0.0029,
+	"ЕЖИТ": 0.0029, "ЛЬШИ": 0.0029, "ЧЕНЫ": 0.0029, "НИЕЧ": 0.0029, "ДВАР": 0.0029,
+	"НДНЕ": 0.0029, "ЕДАЧ": 0.0029, "РОКК": 0.0029, "АМИС": 0.0029, "ЫЕРЕ": 0.0029,
+	"ЫДЕЙ": 0.0029, "ВСЕМ": 0.0029, "ТИПИ": 0.0029, "ОВМО": 0.0029, "ОВЕЕ": 0.0029,
+	"ТРИЛ": 0.0029, "ТОВК": 0.0029, "ЫХИЗ": 0.0029, "РИУС": 0.0029, "ОДИФ": 0.0029,
+	"ДИФИ": 0.0029, "ИКАП": 0.0029, "ТЕОТ": 0.0029, "СТРС": 0.0029, "ВНЕО": 0.0029,
+	"ЛЯГР": 0.0029, "ИОРИ": 0.0029, "АКИЕ": 0.0029, "ТДРУ": 0.0029, "СОБЕ": 0.0029,
+	"ОАРГ": 0.0029, "НУТЫ": 0.0029, "УЩЕЕ": 0.0029, "БЕЖА": 0.0029, "ЩЕЕВ": 0.0029,
+	"ОСЛО": 0.0029, "БЪЯВ": 0.0029, "КСОВ": 0.0029, "ТЗАД": 0.0029, "ЛАТФ": 0.0029,
+	"ИТОТ": 0.0029, "АБЫЛ": 0.0029, "УСКВ": 0.0029, "ДВОЕ": 0.0029, "НУЛЯ": 0.0029,
+	"ЛАИЗ": 0.0029, "ВКИН": 0.0029, "РАЗИ": 0.0029, "НИЙД": 0.0029, "ЮИСП": 0.0029,
+	"РЫЕС": 0.0029, "ЕИНФ": 0.0029, "ЫЙТЕ": 0.0029, "МОБР": 0.0029, "РВОЙ": 0.0029,
+	"ВЗАП": 0.0029, "УВАС": 0.0029, "ИСЬН": 0.0029, "ГОСП": 0.0029, "НЫЗА": 0.0029,
+	"ЧАЮЩ": 0.0029, "АКЛА": 0.0029, "ХОРО": 0.0029, "ОРОШ": 0.0029, "СТИД": 0.0029,
+	"СЯИЛ": 0.0029, "ОМСТ": 0.0029, "ЛКОЙ": 0.0029, "ЕЛНЕ": 0.0029, "ЯВФА": 0.0029,
+	"ОДАИ": 0.0029, "СТКИ": 0.0029, "ТЬСК": 0.0029, "ТРЕТ": 0.0029, "ТНЕД": 0.0029,
+	"АЯВЛ": 0.0029, "МКАК": 0.0029, "УКОМ": 0.0029, "ГЛУБ": 0.0029, "КАЯИ": 0.0029,
+	"РИСУ": 0.0029, "АЙЛМ": 0.0029, "ЛНЯЮ": 0.0029, "ЛИЭТ": 0.0029, "МОЕМ": 0.0029,
+	"ТЕГИ": 0.0029, "ОМУО": 0.0029, "ВКИВ": 0.0029, "ЧЕТА": 0.0029, "ВЫХП": 0.0029,
+	"НОРМ": 0.0029, "АЧТО": 0.0029, "МУЮИ": 0.0029, "ВАМН": 0.0029, "ИЯВК": 0.0029,
+	"СЛЕП": 0.0029, "ИЗРА": 0.0029, "АИНА": 0.0029, "ЙФУН": 0.0029, "ЯВЫР": 0.0029,
+	"ЕДИК": 0.0029, "ИЮНЕ": 0.0029, "БЩЕМ": 0.0029, "КСОМ": 0.0029, "КАЗУ": 0.0029,
+	"АЙТВ": 0.0029, "АОБЪ": 0.0029, "ЫМРЕ": 0.0029, "ТКАС": 0.0029, "ТАСП": 0.0029,
+	"КИВС": 0.0029, "ЕМУК": 0.0029, "ЬССЫ": 0.0029, "ЕМЯК": 0.0029, "ЬМЕР": 0.0028,
+	"ЕАЛЬ": 0.0028, "РИКА": 0.0028, "ЕНГА": 0.0028, "ТАНС": 0.0028, "НТРА": 0.0028,
+	"ИЕНТ": 0.0028, "ЖНАЯ": 0.0028, "АБОЛ": 0.0028, "АНАК": 0.0028, "КОСТ": 0.0028,
+	"МАДР": 0.0028, "ЛЬЯН": 0.0028, "НАДО": 0.0028, "АЯЛА": 0.0028, "ТРАВ": 0.0028,
+	"ВАЛИ": 0.0028, "АТАР": 0.0028, "ТАЗА": 0.0028, "ИТИЧ": 0.0028, "ЕКАВ": 0.0028,
+	"ЯВКО": 0.0028, "ВКОД": 0.0028, "ЕБОВ": 0.0028, "УВЕР": 0.0028, "АИВА": 0.0028,
+	"ЯДОБ": 0.0028, "НООН": 0.0028, "СЬОП": 0.0028, "СОШИ": 0.0028, "КЕТС": 0.0028,
+	"ОЦЕД": 0.0028, "ЦЕДУ": 0.0028, "ЕДУР": 0.0028, "ИЯВО": 0.0028, "ЖНОР": 0.0028,
+	"ЛОПР": 0.0028, "ТСОО": 0.0028, "ЫТЬЗ": 0.0028, "ИВНЕ": 0.0028, "ЫЕСО": 0.0028,
+	"ИСОО": 0.0028, "АРЕЗ": 0.0028, "ВЫБИ": 0.0028, "ЫБИР": 0.0028, "ЬЭТУ": 0.0028,
+	"ОГОЧ": 0.0028, "РПОС": 0.0028, "ТВЕИ": 0.0028, "УПРО": 0.0028, "ЛОНЫ": 0.0028,
+	"ОСПЕ": 0.0028, "РЫДЛ": 0.0028, "МКОН": 0.0028, "ЧТОД": 0.0028, "ЫСЛЕ": 0.0028,
+	"ЫЙВВ": 0.0028, "ТЕСП": 0.0028, "ЕГОЗ": 0.0028, "НТДЛ": 0.0028, "ИКТУ": 0.0028,
+	"ОБЫС": 0.0028, "ЫМСИ": 0.0028, "ЛЯУС": 0.0028, "ММЕН": 0.0028, "ПОСИ": 0.0028,
+	"РЫВН": 0.0028, "МАДЛ": 0.0028, "НОМО": 0.0028, "ЛЯДИ": 0.0028, "ТЬГР": 0.0028,
+	"РИОР": 0.0028, "СТЬИ": 0.0028, "ИИМЯ": 0.0028, "ЫХРА": 0.0028, "ЫКОТ": 0.0028,
+	"ОПРА": 0.0028, "ИЕВП": 0.0028, "НИЗИ": 0.0028, "ИНЕК": 0.0028, "ЫТЬД": 0.0028,
+	"ЖИМЫ": 0.0028, "ЛЕНП": 0.0028, "ОДАД": 0.0028, "ДИТЗ": 0.0028, "ЛИЦУ": 0.0028,
+	"ЯПОП": 0.0028, "ОБУД": 0.0028, "ВОЕТ": 0.0028, "ЯЩИЙ": 0.0028, "ВБУД": 0.0028,
+	"УДУЩ": 0.0028, "ВОСЬ": 0.0028, "ВОЙП": 0.0028, "КСНЫ": 0.0028, "ЕТАТ": 0.0028,
+	"ЫЙДЕ": 0.0028, "РМИР": 0.0028, "ОЯВЛ": 0.0028, "ЕРЬТ": 0.0028, "РЬТЕ": 0.0028,
+	"РИЕМ": 0.0028, "ЫЕВЕ": 0.0028, "АНДЛ": 0.0028, "ОВИЛ": 0.0028, "РИЙД": 0.0028,
+	"ЦИЮС": 0.0028, "ДЕЛВ": 0.0028, "ЛИОБ": 0.0028, "КПЕР": 0.0028, "ЗВАТ": 0.0028,
+}
+
+var chainBigramsRU = map[string]float64{
+	"ЕН": 1.7509, "НЕ": 1.4127, "СТ": 1.3630, "НИ": 1.2722, "РА": 1.2640,
+	"ПО": 1.1892, "ОВ": 1.1863, "АН": 1.1723, "ЕР": 1.1480, "НО": 1.1402,
+	"РЕ": 1.0748, "КА": 1.0361, "ЕТ": 1.0142, "ОЛ": 0.9940, "АТ": 0.9556,
+	"ТЬ": 0.9490, "НА": 0.9109, "МЕ": 0.8881, "РО": 0.8775, "ПР": 0.8730,
+	"КО": 0.8076, "ИС": 0.8009, "ВА": 0.7869, "ТО": 0.7745, "ЕС": 0.7553,
+	"ЛЬ": 0.7324, "НЫ": 0.7176, "ДА": 0.7035, "ТА": 0.6956, "ОД": 0.6904,
+	"ИТ": 0.6843, "ДЕ": 0.6738, "ОС": 0.6629, "ИМ": 0.6447, "ЛИ": 0.6301,
+	"ЛО": 0.6286, "ЗА": 0.6257, "ТР": 0.6136, "ТИ": 0.6132, "ТЕ": 0.6062,
+	"ВО": 0.5964, "ИЕ": 0.5753, "АЛ": 0.5592, "ОР": 0.5590, "ИН": 0.5582,
+	"ОМ": 0.5474, "ВЕ": 0.5444, "АЗ": 0.5368, "ОБ": 0.5311, "ЛЕ": 0.5301,
+	"ТС": 0.5250, "ОТ": 0.5133, "СК": 0.5114, "АЙ": 0.5091, "ЕД": 0.5042,
+	"ИЯ": 0.5014, "АВ": 0.4994, "ЕЛ": 0.4923, "КИ": 0.4896, "ОП": 0.4892,
+	"ЕМ": 0.4873, "ЛЯ": 0.4831, "РИ": 0.4777, "АР": 0.4760, "НН": 0.4744,
+	"СП": 0.4569, "СИ": 0.4468, "СО": 0.4454, "ОК": 0.4454, "ЕК": 0.4438,
+	"МО": 0.4397, "ЛА": 0.4350, "ВЫ": 0.4112, "МА": 0.4098, "ДО": 0.4055,
+	"СЯ": 0.4040, "ОГ": 0.3982, "ЫЙ": 0.3964, "ОН": 0.3935, "ПА": 0.3860,
+	"ЧЕ": 0.3838, "ЕВ": 0.3793, "ГО": 0.3761, "ПЕ": 0.3718, "АП": 0.3672,
+	"АК": 0.3651, "АС": 0.3591, "ФА": 0.3542, "ЙЛ": 0.3512, "ЕП": 0.3426,
+	"АМ": 0.3401, "ДЛ": 0.3348, "СЛ": 0.3346, "ИВ": 0.3274, "ИЗ": 0.3214,
+	"ЬЗ": 0.3152, "ИИ": 0.3106, "ОЙ": 0.3097, "ТВ": 0.3091, "ИЙ": 0.3085,
+	"АЯ": 0.3080, "ЦИ": 0.3025, "УД": 0.3010, "ИР": 0.3008, "ВИ": 0.2959,
+	"АЕ": 0.2940, "ЗО": 0.2931, "ЬН": 0.2925, "НТ": 0.2857, "АД": 0.2839,
+	"УС": 0.2829, "ОЖ": 0.2818, "ИК": 0.2810, "РУ": 0.2743, "ОЗ": 0.2743,
+	"ТН": 0.2706, "ЖЕ": 0.2604, "ЖИ": 0.2591, "ДИ": 0.2583, "НД": 0.2565,
+	"ШИ": 0.2525, "ЩЕ": 0.2510, "ОЕ": 0.2449, "ИЛ": 0.2418, "ЧИ": 0.2293,
+	"ПИ": 0.2271, "КЛ": 0.2242, "БО": 0.2229, "ПУ": 0.2214, "СЕ": 0.2175,
+	"ЙС": 0.2161, "АБ": 0.2142, "МИ": 0.2133, "ЗМ": 0.2109, "ИБ": 0.2086,
+	"ЧА": 0.2085, "ЕУ": 0.2084, "ЫЕ": 0.2052, "ИП": 0.2050, "ЕИ": 0.2034,
+	"СЬ": 0.2034, "ВН": 0.2034, "АЧ": 0.2016, "ЫВ": 0.2012, "ЫХ": 0.1993,
+	"УК": 0.1984, "ЕЗ": 0.1946, "ЛЮ": 0.1946, "ЕГ": 0.1932, "ВЛ": 0.1919,
+	"УЕ": 0.1901, "ЕО": 0.1886, "КТ": 0.1883, "ЕЙ": 0.1868, "ЬС": 0.1864,
+	"НС": 0.1835, "ЯВ": 0.1816, "ИД": 0.1809, "БЛ": 0.1802, "ЗД": 0.1787,
+	"ТК": 0.1778, "УП": 0.1765, "УТ": 0.1729, "ЯП": 0.1721, "ЮЧ": 0.1714,
+	"МВ": 0.1713, "ВК": 0.1710, "ЖН": 0.1691, "ИЧ": 0.1683, "РЫ": 0.1675,
+	"ОШ": 0.1674, "ЗН": 0.1651, "КС": 0.1646, "СС": 0.1595, "КР": 0.1575,
+	"БЫ": 0.1571, "ОЧ": 0.1563, "РН": 0.1559, "ХО": 0.1557, "КЕ": 0.1542,
+	"БР": 0.1539, "ВС": 0.1538, "ЯС": 0.1519, "ДУ": 0.1503, "ЙТ": 0.1501,
+	"ЬК": 0.1500, "ЯН": 0.1490, "СА": 0.1481, "ДН": 0.1474, "ЕЖ": 0.1473,
+	"ЬП": 0.1462, "АЦ": 0.1460, "ГР": 0.1453, "БА": 0.1446, "ТУ": 0.1445,
+	"КУ": 0.1444, "ЫТ": 0.1419, "РЖ": 0.1409, "ОИ": 0.1407, "БУ": 0.1399,
+	"МЫ": 0.1388, "БК": 0.1361, "УМ": 0.1358, "ЛН": 0.1347, "УЮ": 0.1344,
+	"ЯТ": 0.1334, "ИФ": 0.1307, "ОО": 0.1299, "ВР": 0.1294, "ФИ": 0.1292,
+	"СУ": 0.1286, "ЧН": 0.1255, "ГИ": 0.1251, "РМ": 0.1234, "РС": 0.1221,
+	"ИЮ": 0.1220, "НУ": 0.1219, "ФО": 0.1198, "ЗВ": 0.1187, "ТЫ": 0.1152,
+	"ЯО": 0.1140, "ЦЕ": 0.1139, "ВУ": 0.1131, "ЙК": 0.1126, "МЯ": 0.1124,
+	"ЩИ": 0.1123, "ЕЧ": 0.1118, "ЕЩ": 0.1113, "ИО": 0.1109, "ЬВ": 0.1106,
+	"ЫП": 0.1101, "ЗУ": 0.1097, "РТ": 0.1091, "АГ": 0.1089, "ЧТ": 0.1086,
+	"ЮЩ": 0.1070, "ЫМ": 0.1059, "ЛУ": 0.1056, "ЯД": 0.1056, "ЭТ": 0.1030,
+	"БЕ": 0.1028, "УР": 0.1028, "ШЕ": 0.1014, "ЯЕ": 0.1014, "ЯИ": 0.1014,
+	"ИГ": 0.1004, "ЯЗ": 0.0994, "ЖД": 0.0980, "ЙП": 0.0976, "ММ": 0.0973,
+	"ИА": 0.0951, "ДР": 0.0950, "ЕБ": 0.0945, "МП": 0.0937, "ЬИ": 0.0920,
+	"ВП": 0.0912, "НЯ": 0.0901, "АИ": 0.0894, "ЯК": 0.0887, "АЖ": 0.0882,
+	"КЦ": 0.0880, "ЕЕ": 0.0869, "ГУ": 0.0863, "ДД": 0.0846, "РВ": 0.0844,
+	"ЗЫ": 0.0834, "ОУ": 0.0821, "ЙР": 0.0812, "ГА": 0.0808, "ХИ": 0.0807,
+	"ТП": 0.0804, "УЧ": 0.0803, "ЫЛ": 0.0796, "СМ": 0.0789, "МУ": 0.0772,
+	"ЙД": 0.0765, "НФ": 0.0750, "УЛ": 0.0746, "УЩ": 0.0744, "ЬО": 0.0739,
+	"УН": 0.0732, "ЛЖ": 0.0727, "ЮТ": 0.0723, "ИЦ": 0.0722, "МН": 0.0717,
+	"ЛЫ": 0.0716, "АЮ": 0.0714, "СЫ": 0.0710, "ВВ": 0.0688, "ЬТ": 0.0683,
+	"ДС": 0.0673, "УЖ": 0.0658, "ЗИ": 0.0654, "ЙО": 0.0654, "АО": 0.0653,
+	"ГН": 0.0651, "ЯР": 0.0648, "ЫК": 0.0648, "СН": 0.0646, "ИХ": 0.0633,
+	"ЙФ": 0.0632, "РР": 0.0629, "ВТ": 0.0623, "ИШ": 0.0615, "ДП": 0.0608,
+	"АХ": 0.0606, "ЫР": 0.0596, "РХ": 0.0593, "АУ": 0.0592, "БИ": 0.0586,
+	"ДВ": 0.0585, "БН": 0.0584, "ЕФ": 0.0584, "ША": 0.0583, "ГЕ": 0.0582,
+	"ЕШ": 0.0569, "МС": 0.0566, "СВ": 0.0565, "ЬД": 0.0565, "РГ": 0.0555,
+	"ЖА": 0.0551, "ЕЦ": 0.0549, "ЯМ": 0.0549, "БЩ": 0.0548, "ЙН": 0.0547,
+	"ЙВ": 0.0528, "ЕЯ": 0.0524, "ЫС": 0.0516, "ЫД": 0.0516, "ПП": 0.0516,
+	"РЯ": 0.0506, "ЛК": 0.0505, "ЙИ": 0.0499, "ЭЛ": 0.0492, "БЪ": 0.0490,
+	"НК": 0.0488, "ЬР": 0.0484, "ЛС": 0.0482, "ОЦ": 0.0481, "ЫИ": 0.0480,
+	"ДЫ": 0.0479, "СР": 0.0478, "ЛЧ": 0.0472, "НГ": 0.0470, "ТБ": 0.0470,
+	"РШ": 0.0468, "ШК": 0.0467, "ЪЕ": 0.0465, "ХС": 0.0464, "УБ": 0.0461,
+	"АШ": 0.0459, "АФ": 0.0439, "ВМ": 0.0437, "ВД": 0.0435, "МБ": 0.0432,
+	"РК": 0.0431, "ОЯ": 0.0428, "ФР": 0.0411, "СБ": 0.0409, "ЦА": 0.0400,
+	"ДК": 0.0395, "ЯФ": 0.0391, "ГЛ": 0.0389, "УГ": 0.0386, "ЩА": 0.0385,
+	"ЕА": 0.0384, "ОФ": 0.0384, "ЬУ": 0.0375, "УЗ": 0.0373, "ЫН": 0.0370,
+	"КВ": 0.0368, "ЬФ": 0.0368, "ТД": 0.0368, "ХП": 0.0367, "ЫБ": 0.0361,
+	"ХР": 0.0359, "УЙ": 0.0357, "ЬШ": 0.0354, "ТЗ": 0.0351, "ПЛ": 0.0349,
+	"МК": 0.0348, "РП": 0.0342, "ЙА": 0.0335, "ВФ": 0.0334, "ЫЗ": 0.0329,
+	"ЯА": 0.0326, "ИУ": 0.0326, "КН": 0.0325, "ОХ": 0.0323, "ЗР": 0.0323,
+	"УВ": 0.0314, "ФЕ": 0.0312, "НЬ": 0.0310, "ЕХ": 0.0309, "ТЧ": 0.0309,
+	"ЯЮ": 0.0306, "ЯБ": 0.0303, "ВХ": 0.0303, "ЧК": 0.0302, "СД": 0.0301,
+}
+
+var quadgramsCZ = map[string]float64{
+	"SOUB": 0.4110, "OUBO": 0.4089, "UBOR": 0.4082, "OVÁN": 0.2928, "CHYB": 0.2557,
+	"TINA": 0.2151, "ELZE": 0.2009, "NELZ": 0.1999, "ŠTIN": 0.1990, "VÁNÍ": 0.1787,
+	"POUŽ": 0.1749, "KLÍČ": 0.1637, "STAV": 0.1529, "PLAT": 0.1500, "LATN": 0.1462,
+	"STUP": 0.1458, "PŘEP": 0.1432, "ASTA": 0.1426, "NEBO": 0.1421, "NENÍ": 0.1407,
+	"DRES": 0.1388, "ADRE": 0.1386, "BORU": 0.1385, "OUŽI": 0.1383, "HYBA": 0.1379,
+	"NAST": 0.1263, "ŘEPÍ": 0.1246, "ATEL": 0.1242, "EPÍN": 0.1227, "ÍNAČ": 0.1221,
+	"PÍNA": 0.1216, "OVAT": 0.1199, "VATE": 0.1185, "MENT": 0.1151, "PŘÍK": 0.1106,
+	"ZNAK": 0.1094, "ROVÁ": 0.1083, "PODP": 0.1077, "EPLA": 0.1051, "ÍKAZ": 0.1041,
+	"ŘÍKA": 0.1039, "BALÍ": 0.1032, "UŽIV": 0.1022, "NEPL": 0.1020, "ŽIVA": 0.1018,
+	"IVAT": 0.1018, "RESÁ": 0.0990, "HODN": 0.0973, "OVAN": 0.0965, "ESÁŘ": 0.0962,
+	"FORM": 0.0962, "UMEN": 0.0954, "ZNAM": 0.0920, "ODPO": 0.0918, "PRÁV": 0.0901,
+	"JAKO": 0.0876, "JÍCÍ": 0.0838, "ALÍK": 0.0821, "DNOT": 0.0819, "NOST": 0.0816,
+	"VYTV": 0.0814, "ODNO": 0.0797, "SELH": 0.0795, "APŘI": 0.0791, "ATNÝ": 0.0768,
+	"UŽIT": 0.0766, "NÝCH": 0.0762, "ENÍP": 0.0757, "OROV": 0.0749, "YBAP": 0.0749,
+	"ELHA": 0.0745, "LIKA": 0.0740, "LHAL": 0.0740, "SLED": 0.0736, "ZMĚN": 0.0734,
+	"BAPŘ": 0.0732, "JMÉN": 0.0728, "ČÍSL": 0.0723, "ŘÁDK": 0.0721, "PROS": 0.0721,
+	"LÍČE": 0.0711, "NÍPO": 0.0696, "IFIK": 0.0683, "ARGU": 0.0670, "RGUM": 0.0670,
+	"GUME": 0.0670, "AVEN": 0.0670, "VÝCH": 0.0666, "SKUP": 0.0664, "ŽÁDN": 0.0660,
+	"KTER": 0.0658, "JSOU": 0.0645, "PŘED": 0.0641, "TVOŘ": 0.0639, "KONČ": 0.0633,
+	"ÁZEV": 0.0633, "TIFI": 0.0630, "KUPI": 0.0630, "UPIN": 0.0630, "STRA": 0.0630,
+	"NÁZE": 0.0628, "PRAV": 0.0618, "PÍŠE": 0.0618, "TAVE": 0.0615, "EBYL": 0.0613,
+	"OBSA": 0.0611, "YTVO": 0.0609, "EZNA": 0.0605, "MÍST": 0.0597, "ZNÁM": 0.0597,
+	"IKÁT": 0.0597, "ŘENÍ": 0.0596, "VÁNO": 0.0594, "BSAH": 0.0594, "NÍHO": 0.0592,
+	"VYPÍ": 0.0592, "TELE": 0.0590, "NÉHO": 0.0588, "SYST": 0.0586, "VERZ": 0.0584,
+	"ICKÉ": 0.0575, "EPOU": 0.0571, "ÍSOU": 0.0569, "BRAZ": 0.0567, "ÁLNÍ": 0.0565,
+	"YPÍŠ": 0.0565, "YSTÉ": 0.0565, "STÉM": 0.0565, "OSTI": 0.0563, "EZEN": 0.0561,
+	"EPRO": 0.0561, "FIKÁ": 0.0559, "VÝST": 0.0559, "HYBN": 0.0558, "KONT": 0.0556,
+	"OVĚŘ": 0.0556, "TUPN": 0.0556, "ODST": 0.0556, "POKU": 0.0552, "ÝSTU": 0.0550,
+	"ODKA": 0.0548, "POČE": 0.0546, "OČET": 0.0546, "DKAZ": 0.0546, "PUBL": 0.0544,
+	"UBLI": 0.0544, "OBRA": 0.0544, "DSTR": 0.0542, "NAČE": 0.0541, "REPU": 0.0537,
+	"EPUB": 0.0537, "BLIK": 0.0537, "NEBY": 0.0535, "DOVÁ": 0.0535, "OUŽÍ": 0.0535,
+	"VSTU": 0.0535, "ORMÁ": 0.0533, "ÍSLO": 0.0531, "ESTA": 0.0527, "UŽIJ": 0.0527,
+	"ESOU": 0.0525, "VANÝ": 0.0525, "UJÍC": 0.0522, "OSOU": 0.0522, "ZADÁ": 0.0522,
+	"MÉNO": 0.0518, "TRAN": 0.0516, "ZENÍ": 0.0512, "DPOR": 0.0510, "TUJE": 0.0510,
+	"BUDE": 0.0510, "NÍCH": 0.0508, "LZEZ": 0.0508, "EPOD": 0.0504, "ERTI": 0.0504,
+	"EXIS": 0.0504, "ADÁN": 0.0504, "STAL": 0.0504, "NEPO": 0.0503, "DATA": 0.0503,
+	"XIST": 0.0503, "NSTA": 0.0501, "CERT": 0.0501, "INST": 0.0499, "RTIF": 0.0499,
+	"EPŘE": 0.0499, "VYŽA": 0.0495, "YŽAD": 0.0495, "PISU": 0.0493, "HESL": 0.0491,
+	"ARCH": 0.0491, "SEZN": 0.0487, "JEDN": 0.0487, "ODPI": 0.0487, "DPIS": 0.0487,
+	"KLÁV": 0.0486, "RCHI": 0.0486, "ČEKÁ": 0.0484, "PŘES": 0.0484, "RMÁT": 0.0484,
+	"PORO": 0.0482, "STUJ": 0.0480, "ŽITÍ": 0.0480, "ÁREP": 0.0478, "BAJT": 0.0474,
+	"INFO": 0.0472, "NÍSO": 0.0470, "ISTU": 0.0470, "LOŽK": 0.0468, "AROV": 0.0468,
+	"TELN": 0.0468, "LÁVE": 0.0468, "ÁVES": 0.0468, "NALE": 0.0467, "UJES": 0.0467,
+	"OKUD": 0.0467, "KÁRE": 0.0463, "HALO": 0.0463, "SAHU": 0.0461, "TICK": 0.0461,
+	"BORY": 0.0461, "STAN": 0.0459, "POLO": 0.0457, "PŘIP": 0.0457, "LOVA": 0.0455,
+	"OLOŽ": 0.0453, "ALEZ": 0.0449, "ROVA": 0.0448, "OVOL": 0.0440, "ORMA": 0.0440,
+	"AKTU": 0.0440, "ČTEN": 0.0438, "ZOBR": 0.0438, "VACÍ": 0.0436, "PROC": 0.0434,
+	"ALIZ": 0.0431, "ROVN": 0.0431, "MOŽN": 0.0427, "OJEN": 0.0425, "STAR": 0.0423,
+	"OUZE": 0.0423, "VOLB": 0.0421, "POUZ": 0.0421, "BYLO": 0.0417, "ADOV": 0.0415,
+	"ÝCHO": 0.0413, "CHIV": 0.0413, "PROV": 0.0412, "NFOR": 0.0410, "ATOV": 0.0408,
+	"EDEN": 0.0408, "TENT": 0.0406, "ANDA": 0.0402, "VŠEC": 0.0400, "ŘETĚ": 0.0400,
+	"ETĚZ": 0.0400, "BORŮ": 0.0400, "NSKÉ": 0.0400, "ŽADO": 0.0398, "AHUJ": 0.0398,
+	"POSL": 0.0398, "ŠECH": 0.0396, "CHOZ": 0.0396, "HOZÍ": 0.0396, "RMAC": 0.0394,
+	"ROCE": 0.0394, "KAŽD": 0.0394, "LICK": 0.0394, "OVÉH": 0.0393, "PROT": 0.0393,
+	"LEZE": 0.0393, "EZNÁ": 0.0393, "OPRO": 0.0393, "MŮŽE": 0.0389, "ÍPRO": 0.0387,
+	"ÁNÍP": 0.0387, "POJE": 0.0385, "NEZN": 0.0385, "NÍPR": 0.0383, "LZEP": 0.0383,
+	"EDNO": 0.0383, "VÉHO": 0.0381, "EPŘÍ": 0.0381, "OČEK": 0.0379, "ENOV": 0.0379,
+	"NŠTI": 0.0379, "ENÍS": 0.0377, "LIKO": 0.0377, "EKÁV": 0.0376, "VĚŘE": 0.0376,
+	"NDAR": 0.0376, "ŽIJE": 0.0376, "VENÍ": 0.0376, "SKÁR": 0.0374, "ZÍSK": 0.0374,
+	"ĚŘEN": 0.0374, "TAND": 0.0374, "ÝSOU": 0.0374, "ÁNÍS": 0.0372, "PRAC": 0.0372,
+	"ESLO": 0.0372, "MUSÍ": 0.0370, "PŘÍL": 0.0370, "TENÍ": 0.0370, "ZENA": 0.0370,
+	"OGRA": 0.0370, "TUPU": 0.0368, "ŘÍLI": 0.0366, "ÍLIŠ": 0.0366, "DARD": 0.0366,
+	"ÍPŘE": 0.0366, "ENTO": 0.0366, "ITEL": 0.0364, "OVAC": 0.0364, "UPRO": 0.0364,
+	"VANÉ": 0.0362, "OCES": 0.0362, "RUJE": 0.0358, "TEXT": 0.0357, "PROM": 0.0357,
+	"VARO": 0.0355, "ÁVÁN": 0.0355, "JEPŘ": 0.0353, "JAZY": 0.0353, "NOTA": 0.0351,
+	"KOST": 0.0351, "JEPO": 0.0351, "TOVÝ": 0.0351, "JESE": 0.0349, "ELIK": 0.0349,
+	"ZADA": 0.0349, "ARDN": 0.0347, "KAZY": 0.0347, "ALOV": 0.0347, "STAT": 0.0345,
+	"SENE": 0.0345, "NÍPŘ": 0.0345, "AČNÍ": 0.0343, "KOMP": 0.0343, "ERVE": 0.0341,
+	"MACE": 0.0341, "RAZÍ": 0.0341, "RACO": 0.0339, "ACOV": 0.0339, "SLOV": 0.0339,
+	"PROG": 0.0339, "HELL": 0.0339, "SHEL": 0.0339, "SERV": 0.0338, "DOST": 0.0338,
+	"TPRO": 0.0336, "ROST": 0.0336, "ÁDKU": 0.0336, "RDNÍ": 0.0336, "ŘÁDE": 0.0334,
+	"ÁDEK": 0.0334, "OVÝK": 0.0334, "ECHN": 0.0334, "NÁZV": 0.0334, "NTRO": 0.0334,
+	"OŘEN": 0.0332, "UJEP": 0.0332, "VELI": 0.0332, "STRO": 0.0332, "RVER": 0.0330,
+	"BEZP": 0.0330, "DPOV": 0.0330, "GRAM": 0.0330, "TÉMU": 0.0330, "OTEV": 0.0328,
+	"IKOS": 0.0328, "EDNÍ": 0.0328, "ROMĚ": 0.0328, "ORUJ": 0.0326, "HUJE": 0.0324,
+	"LEDN": 0.0324, "VÝRA": 0.0322, "ÝRAZ": 0.0322, "ERZE": 0.0322, "ÍSTO": 0.0322,
+	"ČÁST": 0.0322, "PRÁZ": 0.0321, "RÁZD": 0.0321, "ÁZDN": 0.0321, "TERÉ": 0.0321,
+	"ATNÉ": 0.0321, "ENÍN": 0.0321, "ZPRÁ": 0.0321, "JEVY": 0.0317, "SPOJ": 0.0317,
+	"ENÍZ": 0.0317, "ROGR": 0.0317, "LAST": 0.0317, "NOVÝ": 0.0315, "ÝKÓD": 0.0315,
+	"NÍNA": 0.0315, "ONTR": 0.0315, "STNÍ": 0.0313, "TROL": 0.0313, "VÝKÓ": 0.0311,
+	"AZYK": 0.0311, "IGNO": 0.0311, "DAŘI": 0.0311, "UALI": 0.0311, "TALO": 0.0311,
+	"OSLE": 0.0311, "ÁZNA": 0.0309, "GNOR": 0.0309, "ŘIPO": 0.0309, "AŘIL": 0.0309,
+	"MĚNN": 0.0309, "ÍPOD": 0.0307, "IVNÍ": 0.0307, "AZEN": 0.0307, "HLAV": 0.0307,
+	"UŽÍV": 0.0305, "SÁŘE": 0.0305, "TUAL": 0.0305, "KONF": 0.0305, "OMĚN": 0.0305,
+	"LSKÉ": 0.0305, "SIGN": 0.0303, "KTUA": 0.0303, "ENAS": 0.0302, "OZNA": 0.0302,
+	"ZDRO": 0.0302, "DROJ": 0.0302, "DOKU": 0.0302, "SOUČ": 0.0302, "DUJE": 0.0298,
+	"POVO": 0.0296, "ZPRA": 0.0296, "ÁTOR": 0.0296, "ÁVRA": 0.0296, "VRAT": 0.0296,
+	"VOŘI": 0.0294, "NÁVR": 0.0294, "SPRÁ": 0.0294, "RŠTI": 0.0294, "STIT": 0.0292,
+	"ČASN": 0.0292, "EVYŽ": 0.0290, "ÁRNÍ": 0.0290, "STŘE": 0.0290, "VÍCE": 0.0288,
+	"HLED": 0.0288, "KAZU": 0.0288, "OŘIT": 0.0288, "POVĚ": 0.0288, "SYMB": 0.0288,
+	"YMBO": 0.0288, "MBOL": 0.0288, "OLBY": 0.0288, "IZAC": 0.0286, "POMO": 0.0286,
+	"OMOC": 0.0286, "LZEO": 0.0286, "OSTA": 0.0286, "EPŘI": 0.0286, "ONFI": 0.0286,
+	"ZÁZN": 0.0286, "NÁSL": 0.0286, "KUME": 0.0286, "VOLE": 0.0284, "PROP": 0.0284,
+	"TEVŘ": 0.0284, "ATNÁ": 0.0284, "EEXI": 0.0284, "VEDE": 0.0284, "OPŘE": 0.0283,
+	"PLIC": 0.0283, "ÁSLE": 0.0283, "TŘED": 0.0283, "JENÍ": 0.0281, "UŠTĚ": 0.0281,
+	"NFIG": 0.0281, "NOVÉ": 0.0279, "LICI": 0.0279, "ROVE": 0.0279, "OKUM": 0.0279,
+	"ONTE": 0.0277, "NÍNE": 0.0277, "RATO": 0.0277, "UKON": 0.0275, "TEČN": 0.0275,
+	"AKON": 0.0275, "TEJN": 0.0275, "LÍKU": 0.0275, "ENTI": 0.0273, "OLEN": 0.0273,
+	"ICIT": 0.0273, "OVÝC": 0.0273, "UPNÍ": 0.0273, "ŘILO": 0.0273, "VÁNA": 0.0271,
+	"AVIT": 0.0271, "DDĚL": 0.0271, "YBNÝ": 0.0271, "TIVN": 0.0271, "PORT": 0.0269,
+	"UJEV": 0.0269, "OKLÍ": 0.0269, "PISO": 0.0269, "PSAT": 0.0269, "CITN": 0.0269,
+	"ODDĚ": 0.0269, "ŠENÍ": 0.0269, "IGNÁ": 0.0269, "ÍBÝT": 0.0267, "CHNY": 0.0267,
+	"TOVA": 0.0267, "NEEX": 0.0267, "NÍVÝ": 0.0267, "GNÁL": 0.0267, "KOVÁ": 0.0266,
+	"ZOVA": 0.0266, "TSOU": 0.0266, "METR": 0.0266, "ZNAČ": 0.0266, "ÁDNÉ": 0.0266,
+	"ADAN": 0.0266, "EVYT": 0.0264, "PAMĚ": 0.0264, "TERÝ": 0.0264, "OMPR": 0.0264,
+	"ZYKY": 0.0264, "HOSO": 0.0262, "FUNK": 0.0262, "UNKC": 0.0262, "IGUR": 0.0262,
+	"IMPL": 0.0262, "LOŽE": 0.0260, "ŠIFR": 0.0258, "ÍZEN": 0.0258, "FIGU": 0.0258,
+	"AJTŮ": 0.0258, "LZEV": 0.0256, "OOVĚ": 0.0256, "ÝCHP": 0.0256, "AJÍC": 0.0254,
+	"RÁVA": 0.0254, "EZPE": 0.0254, "ZPEČ": 0.0254, "JENE": 0.0254, "ÁNÍN": 0.0254,
+	"ROTO": 0.0252, "NÍSE": 0.0252, "PŘIH": 0.0252, "NÁPO": 0.0252, "ŘÍZE": 0.0252,
+	"ÉHOS": 0.0252, "APRO": 0.0250, "ŘIHL": 0.0250, "TNOS": 0.0250, "ČENÍ": 0.0248,
+	"TAVI": 0.0248, "REŽI": 0.0248, "EŽIM": 0.0248, "MOCÍ": 0.0247, "OVAČ": 0.0247,
+	"RAME": 0.0247, "SKON": 0.0247, "OLIC": 0.0247, "ICKÝ": 0.0247, "BORN": 0.0245,
+	"IKAC": 0.0245, "EJSO": 0.0245, "BOLI": 0.0245, "AKOV": 0.0245, "PŘÍP": 0.0245,
+	"ATIV": 0.0245, "ISOV": 0.0243, "ORIT": 0.0243, "ATAB": 0.0243, "KLAD": 0.0243,
+	"UŽÍT": 0.0243, "NĚNÍ": 0.0241, "ŠTĚN": 0.0241, "OSTŘ": 0.0241, "MĚNI": 0.0241,
+	"ASTN": 0.0241, "ÁNOO": 0.0241, "TAVÍ": 0.0241, "TĚZE": 0.0239, "ÍSEL": 0.0239,
+	"PROU": 0.0239, "VLAS": 0.0239, "JEPR": 0.0237, "ZEVY": 0.0237, "PLIK": 0.0237,
+	"ENTY": 0.0237, "LIZA": 0.0237, "VOŘE": 0.0237, "NAPŘ": 0.0237, "MEZE": 0.0235,
+	"ÁVAN": 0.0235, "OVÝS": 0.0235, "VŘÍT": 0.0235, "NÝSO": 0.0235, "ÍZNA": 0.0235,
+	"UJEN": 0.0235, "STEJ": 0.0235, "HYBÍ": 0.0233, "KÁVA": 0.0233, "ENEP": 0.0233,
+	"NAMU": 0.0233, "RANÍ": 0.0233, "CÍCH": 0.0231, "ĚZEC": 0.0231, "ÁDNÝ": 0.0231,
+	"EDUJ": 0.0231, "ENÍV": 0.0231, "IFRO": 0.0229, "LZEN": 0.0229, "PARA": 0.0229,
+	"ARAM": 0.0229, "TERN": 0.0229, "LEDU": 0.0229, "PŘIZ": 0.0229, "NOOV": 0.0229,
+	"INTE": 0.0228, "JMEN": 0.0228, "ZEPŘ": 0.0228, "KONE": 0.0228, "ÚROV": 0.0228,
+	"ÉJAZ": 0.0228, "ENEB": 0.0226, "FROV": 0.0226, "PORU": 0.0226, "CEST": 0.0226,
+	"AMET": 0.0226, "NÝPO": 0.0226, "ODLE": 0.0226, "VOLÁ": 0.0226, "BORS": 0.0224,
+	"ATNO": 0.0224, "PEČN": 0.0224, "ZÁPI": 0.0224, "ÁPIS": 0.0224, "OVĚD": 0.0224,
+	"AZOV": 0.0224, "TRIB": 0.0222, "NAPO": 0.0222, "PODE": 0.0222, "VSOU": 0.0222,
+	"ISUJ": 0.0222, "AUTO": 0.0222, "PŘID": 0.0222, "TABÁ": 0.0222, "OPER": 0.0222,
+	"SKÉJ": 0.0222, "KÉJA": 0.0222, "NTEX": 0.0220, "RIBU": 0.0220, "ODEP": 0.0220,
+	"ORNE": 0.0220, "POVÍ": 0.0220, "ADUJ": 0.0220, "LOVÁ": 0.0220, "ONČÍ": 0.0220,
+	"RIPT": 0.0220, "NAKY": 0.0220, "ISTI": 0.0220, "EZMĚ": 0.0220, "ONČE": 0.0218,
+	"NÍKO": 0.0218, "NAKO": 0.0218, "ICKÁ": 0.0218, "TISK": 0.0218, "ÍPŘÍ": 0.0218,
+	"IPOJ": 0.0218, "LÁNÍ": 0.0218, "EBOP": 0.0216, "OVÍD": 0.0216, "RAVD": 0.0216,
+	"DUJÍ": 0.0216, "ZAST": 0.0216, "ENÍD": 0.0216, "MPLI": 0.0216, "ABÁZ": 0.0216,
+	"OLÁN": 0.0216, "LŠTI": 0.0216, "OŽEN": 0.0214, "COVÁ": 0.0214, "SOVA": 0.0214,
+	"ORUS": 0.0214, "HLÁŠ": 0.0214, "ÚLOH": 0.0214, "EVŘÍ": 0.0212, "VÁNY": 0.0212,
+	"ACEO": 0.0212, "OJOV": 0.0212, "NAIN": 0.0212, "ELLU": 0.0212, "NČEN": 0.0211,
+	"SLOU": 0.0211, "MEZI": 0.0211, "DLOU": 0.0211, "OSTU": 0.0211, "AINS": 0.0209,
+	"ROZS": 0.0209, "NÍDO": 0.0209, "OUPO": 0.0209, "ÍSKÁ": 0.0209, "POČÍ": 0.0209,
+	"YPRO": 0.0209, "EBUD": 0.0209, "OŽNÉ": 0.0207, "POZN": 0.0207, "EOČE": 0.0207,
+	"OZSA": 0.0207, "ZSAH": 0.0207, "ONEC": 0.0207, "KÁVÁ": 0.0207, "ŽADU": 0.0207,
+	"NÁMÝ": 0.0207, "VYPR": 0.0207, "AŽDÉ": 0.0207, "EJNÉ": 0.0207, "ĚNIT": 0.0207,
+	"PODL": 0.0207, "DVOL": 0.0207, "NÝPŘ": 0.0205, "RITM": 0.0205, "ÍKON": 0.0205,
+	"YTVÁ": 0.0205, "TVÁŘ": 0.0205, "OSTR": 0.0205, "VYPS": 0.0205, "BLOK": 0.0203,
+	"POŽA": 0.0203, "OŽAD": 0.0203, "ITNÍ": 0.0203, "TNÝP": 0.0203, "PROB": 0.0203,
+	"PRIM": 0.0203, "TĚNÍ": 0.0201, "LEDÁ": 0.0201, "DOVA": 0.0201, "OŘÁD": 0.0201,
+	"SPOU": 0.0201, "JEZA": 0.0201, "EDOV": 0.0201, "PODA": 0.0201, "ROSÍ": 0.0201,
+	"OSÍM": 0.0201, "ATRI": 0.0199, "NTER": 0.0199, "ELNÉ": 0.0199, "TELS": 0.0199,
+	"SUJE": 0.0199, "VZOR": 0.0199, "ENÝC": 0.0199, "UDOU": 0.0199, "ZACE": 0.0197,
+	"USÍB": 0.0197, "SÍBÝ": 0.0197, "LOUH": 0.0197, "IBUT": 0.0197, "VATS": 0.0197,
+	"JEDE": 0.0197, "ANÝC": 0.0197, "LAVI": 0.0197, "AVIČ": 0.0197, "ELSK": 0.0197,
+	"PRON": 0.0195, "DÁNÍ": 0.0195, "ÁHOD": 0.0195, "ENÍM": 0.0195, "KACE": 0.0195,
+	"NEJS": 0.0195, "ÁŠEN": 0.0195, "OPŘÍ": 0.0195, "SKRI": 0.0195, "URČE": 0.0195,
+	"EZAD": 0.0195, "KDYŽ": 0.0195, "PUST": 0.0195, "BUDO": 0.0195, "VÍDA": 0.0193,
+	"LÁŠE": 0.0193, "VRÁT": 0.0193, "ŘESK": 0.0193, "KOVÉ": 0.0193, "APLI": 0.0193,
+	"UNEB": 0.0192, "NAME": 0.0192, "IZOV": 0.0192, "ÍADR": 0.0192, "OVOU": 0.0192,
+	"DÉLK": 0.0192, "VANÁ": 0.0192, "OVNÁ": 0.0192, "RANĚ": 0.0192, "RÁVN": 0.0192,
+	"SPUS": 0.0192, "RSKÉ": 0.0192, "OVÉS": 0.0190, "NENA": 0.0190, "ŘENO": 0.0190,
+	"ORUN": 0.0190, "KRIP": 0.0190, "ELNÝ": 0.0190, "ANĚN": 0.0190, "PINY": 0.0190,
+	"GURA": 0.0190, "MÁTU": 0.0190, "VYPI": 0.0188, "ATIC": 0.0188, "RČEN": 0.0188,
+	"ÝCHS": 0.0188, "PROZ": 0.0188, "NEOČ": 0.0186, "NÍZA": 0.0186, "ZEPO": 0.0186,
+	"EVYP": 0.0186, "POLE": 0.0186, "NOUT": 0.0186, "CHPO": 0.0186, "AZNA": 0.0184,
+	"OVÝM": 0.0184, "ESPO": 0.0184, "DŮVĚ": 0.0184, "YPIS": 0.0184, "ESKO": 0.0184,
+	"HSOU": 0.0184, "ODNÍ": 0.0184, "ÚSPĚ": 0.0184, "JEJI": 0.0184, "UÁLN": 0.0184,
+	"POUŠ": 0.0184, "OUŠT": 0.0184, "KOVA": 0.0184, "OLIT": 0.0184, "PINA": 0.0184,
+	"KOVÝ": 0.0184, "OBRÁ": 0.0184, "BYLA": 0.0182, "LENE": 0.0182, "SKYT": 0.0182,
+	"SOUP": 0.0182, "AŘÍZ": 0.0182, "ADAT": 0.0182, "ÍNEB": 0.0182, "LÍČŮ": 0.0182,
+	"LOST": 0.0180, "MÁLN": 0.0180, "ÁDKY": 0.0180, "ZAŘÍ": 0.0180, "ANGL": 0.0180,
+	"PRVN": 0.0180, "RVNÍ": 0.0180, "ŘÍPO": 0.0180, "PSÁN": 0.0180, "POZO": 0.0178,
+	"NKCE": 0.0178, "LOSE": 0.0178, "OZEN": 0.0178, "AMĚT": 0.0178, "OVST": 0.0178,
+	"NEVY": 0.0178, "OČÍT": 0.0178, "PŘIČ": 0.0178, "KTIV": 0.0178, "ROJE": 0.0178,
+	"YHOD": 0.0178, "NUTÍ": 0.0178, "ÍCHY": 0.0176, "OZOR": 0.0176, "ZDNÝ": 0.0176,
+	"ZAKÁ": 0.0176, "UJEA": 0.0176, "TUÁL": 0.0176, "ŘAZE": 0.0176, "OKON": 0.0174,
+	"ZEVS": 0.0174, "CKET": 0.0174, "GORI": 0.0174, "KÓDO": 0.0174, "POZI": 0.0174,
+	"ÍVÝS": 0.0174, "UJEZ": 0.0174, "TOVÁ": 0.0174, "USOU": 0.0174, "NECH": 0.0174,
+	"OČAS": 0.0174, "TPŘE": 0.0174, "ZÁVI": 0.0174, "ÁVIS": 0.0174, "RAČN": 0.0174,
+	"ALÍČ": 0.0174, "PŮSO": 0.0173, "ŮSOB": 0.0173, "MĚTI": 0.0173, "ÉČÍS": 0.0173,
+	"ESLA": 0.0173, "ASOV": 0.0173, "DNÍV": 0.0173, "NORU": 0.0173, "PŘEK": 0.0173,
+	"NAKŮ": 0.0173, "LUJE": 0.0173, "LÍKY": 0.0173, "OŽKA": 0.0171, "PŘÍS": 0.0171,
+	"ZEZA": 0.0171, "OSTN": 0.0171, "PŘIJ": 0.0171, "AKTI": 0.0171, "ČASO": 0.0171,
+	"AČEN": 0.0171, "EZDA": 0.0171, "ÝKLÍ": 0.0171, "EOBS": 0.0171, "NÍJE": 0.0169,
+	"DENT": 0.0169, "OZNÁ": 0.0169, "ERNA": 0.0169, "ALGO": 0.0169, "TNÍK": 0.0169,
+	"EOTE": 0.0169, "HOVA": 0.0169, "ÁNÍM": 0.0169, "ÝNÁZ": 0.0169, "NGLI": 0.0169,
+	"VISL": 0.0169, "ODAŘ": 0.0169, "NOVA": 0.0169, "OBNO": 0.0169, "BRÁZ": 0.0169,
+	"ERZI": 0.0167, "ENAL": 0.0167, "SLOS": 0.0167, "ÓDOV": 0.0167, "UPŘE": 0.0167,
+	"ŮVĚR": 0.0167, "MSOU": 0.0167, "ILOS": 0.0167, "ÁSOB": 0.0167, "OADR": 0.0167,
+	"NANE": 0.0167, "OŽKY": 0.0167, "IČTE": 0.0167, "ÁNSK": 0.0167, "ŽÍVÁ": 0.0165,
+	"ÍSKA": 0.0165, "LGOR": 0.0165, "EČNÉ": 0.0165, "DNÍH": 0.0165, "BORJ": 0.0165,
+	"BORP": 0.0163, "NAČÍ": 0.0163, "ZPŮS": 0.0163, "CHSO": 0.0163, "SEST": 0.0163,
+	"LEDO": 0.0163, "HIVU": 0.0163, "YPRŠ": 0.0163, "ENÍA": 0.0163, "INAS": 0.0163,
+	"ALEN": 0.0161, "ČÍST": 0.0161, "ÉJMÉ": 0.0161, "AŽDÝ": 0.0161, "YSOU": 0.0161,
+	"ÍCHS": 0.0161, "EBON": 0.0161, "DALŠ": 0.0161, "ALŠÍ": 0.0161, "SENA": 0.0161,
+	"DANÝ": 0.0161, "KÓDV": 0.0161, "ÁZEK": 0.0161, "RÁTÍ": 0.0161, "NSKÁ": 0.0159,
+	"ENEN": 0.0159, "ANEN": 0.0159, "ONČI": 0.0159, "INÁR": 0.0159, "EBÝT": 0.0159,
+	"NULO": 0.0159, "TÍPŘ": 0.0159, "VYPN": 0.0159, "ROUD": 0.0159, "MPRO": 0.0159,
+	"VEST": 0.0159, "KLÁD": 0.0159, "YPSA": 0.0159, "ÍCÍC": 0.0157, "SKAT": 0.0157,
+	"TMUS": 0.0157, "ZEOT": 0.0157, "DAJÍ": 0.0157, "STRÁ": 0.0157, "ÝPŘE": 0.0157,
+	"EJED": 0.0157, "ÉPRO": 0.0157, "JEST": 0.0157, "EJNÝ": 0.0157, "CHOV": 0.0157,
+	"ŘIČT": 0.0157, "MĚNĚ": 0.0157, "PERA": 0.0157, "ODKL": 0.0157, "TYPU": 0.0156,
+	"HALA": 0.0156, "SEPO": 0.0156, "LIZO": 0.0156, "IMÁL": 0.0156, "KOLI": 0.0156,
+	"HOVÁ": 0.0156, "ZNOV": 0.0156, "PROK": 0.0156, "SMAZ": 0.0156, "ŘEDP": 0.0156,
+	"URAČ": 0.0156, "JŠTI": 0.0156, "RÁZE": 0.0156, "NATI": 0.0156, "IHLÁ": 0.0156,
+	"LOUP": 0.0154, "EČÍS": 0.0154, "TĚZC": 0.0154, "NÉPO": 0.0154, "ROVÝ": 0.0154,
+	"ASOU": 0.0154, "ŮŽEB": 0.0154, "ORJE": 0.0154, "LENÝ": 0.0154, "ITÍP": 0.0154,
+	"LZES": 0.0154, "APŘÍ": 0.0154, "ÁŘEN": 0.0154, "NOVÁ": 0.0154, "ŠÍNE": 0.0154,
+	"DRUH": 0.0154, "YLOM": 0.0154, "RANI": 0.0154, "BYLZ": 0.0154, "NÍPL": 0.0154,
+	"TOŽE": 0.0154, "ROBL": 0.0154, "VIČK": 0.0154, "TUJÍ": 0.0154, "VESA": 0.0154,
+	"UMÍS": 0.0152, "TOTO": 0.0152, "RESE": 0.0152, "ANÉH": 0.0152, "LTER": 0.0152,
+	"RAZU": 0.0152, "ÝZNA": 0.0152, "ŽEBÝ": 0.0152, "TŘEB": 0.0152, "BORO": 0.0152,
+	"ÝODK": 0.0152, "NÍAD": 0.0152, "ÍPON": 0.0152, "KAZN": 0.0152, "ÍKLÍ": 0.0152,
+	"ÍPLA": 0.0152, "ROVY": 0.0152, "TROV": 0.0152, "FRAN": 0.0152, "TROJ": 0.0152,
+	"MPRI": 0.0150, "EPIS": 0.0150, "EČNO": 0.0150, "EPOS": 0.0150, "DĚLO": 0.0150,
+	"IDEN": 0.0150, "EDOS": 0.0150, "LONA": 0.0150, "ROZŠ": 0.0150, "ÍPOU": 0.0150,
+	"VĚRY": 0.0150, "OMOŽ": 0.0150, "DANÉ": 0.0150, "NOTY": 0.0150, "INOV": 0.0150,
+	"OBLÉ": 0.0150, "BLÉM": 0.0150, "ÍCÍS": 0.0150, "ÍHOS": 0.0148, "ĚLOV": 0.0148,
+	"YBNÁ": 0.0148, "ÁDKŮ": 0.0148, "ŘEDÍ": 0.0148, "PŘEV": 0.0148, "OKOV": 0.0148,
+	"JENA": 0.0148, "SMĚR": 0.0148, "TŠTI": 0.0148, "ODVO": 0.0148, "OMEZ": 0.0146,
+	"NAHR": 0.0146, "IMIT": 0.0146, "ORUP": 0.0146, "NAČN": 0.0146, "VEŘE": 0.0146,
+	"NÝKL": 0.0146, "ÉHOP": 0.0146, "ROPO": 0.0146, "NOVU": 0.0146, "NEBU": 0.0146,
+	"OTOŽ": 0.0146, "EZER": 0.0146, "MAXI": 0.0144, "AXIM": 0.0144, "ŘÍST": 0.0144,
+	"ÍSTU": 0.0144, "ITMU": 0.0144, "ÍNAS": 0.0144, "NÁHO": 0.0144, "KONC": 0.0144,
+	"ENÍK": 0.0144, "ÁPOV": 0.0144, "LIMI": 0.0144, "NÝNÁ": 0.0144, "GLIC": 0.0144,
+	"NEZD": 0.0144, "MENO": 0.0144, "EJTE": 0.0144, "ONEN": 0.0144, "PŘIO": 0.0144,
+	"ÁNÍZ": 0.0144, "MĚNÍ": 0.0144, "VOLA": 0.0144, "LITE": 0.0144, "HRAN": 0.0144,
+	"ALTE": 0.0144, "RNAT": 0.0144, "MRTV": 0.0144, "ČENO": 0.0142, "MÉNA": 0.0142,
+	"EPRÁ": 0.0142, "VELK": 0.0142, "TAVU": 0.0142, "MATI": 0.0142, "ENTU": 0.0142,
+	"ÁDNÁ": 0.0142, "KAZE": 0.0142, "EJMÉ": 0.0142, "ÁNÍV": 0.0142, "ATPO": 0.0140,
+	"NEDO": 0.0140, "EJAK": 0.0140, "AČTE": 0.0140, "ODES": 0.0140, "SYNT": 0.0140,
+	"YNTA": 0.0140, "ÁCHY": 0.0140, "SHOD": 0.0140, "ZDAŘ": 0.0140, "EŘEJ": 0.0140,
+	"HOTO": 0.0140, "TOHO": 0.0140, "ÍHOD": 0.0140, "ELEM": 0.0140, "PNUT": 0.0138,
+	"EROV": 0.0138, "ÍDAJ": 0.0138, "AKÁZ": 0.0138, "CHÁZ": 0.0138, "UVYP": 0.0138,
+	"PROH": 0.0138, "ROUR": 0.0138, "SOBN": 0.0138, "DĚLE": 0.0138, "ŽIJT": 0.0138,
+	"IJTE": 0.0138, "ZÁLO": 0.0138, "ÍNEŽ": 0.0138, "ŘEJN": 0.0138, "KUJE": 0.0138,
+	"UČAS": 0.0138, "CHOD": 0.0138, "LOMO": 0.0138, "ANIT": 0.0138, "USTI": 0.0138,
+	"UŠEN": 0.0138, "ENÉH": 0.0138, "STVÍ": 0.0138, "OVÉM": 0.0137, "RESA": 0.0137,
+	"KÁTU": 0.0137, "PINU": 0.0137, "EROZ": 0.0137, "TPOD": 0.0137, "ONEB": 0.0137,
+	"MĚNY": 0.0137, "ČETP": 0.0137, "YBAV": 0.0137, "VYBR": 0.0137, "VYHO": 0.0137,
+	"SKOČ": 0.0137, "LOVÝ": 0.0137, "EODS": 0.0137, "ROJO": 0.0137, "JIST": 0.0137,
+	"LOKO": 0.0137, "VATP": 0.0137, "VÁŘE": 0.0137, "OVEŇ": 0.0137, "OBAL": 0.0137,
+	"NÍKA": 0.0135, "NASE": 0.0135, "ČNOS": 0.0135, "RACE": 0.0135, "ÍSTA": 0.0135,
+	"ICKY": 0.0135, "ĚLEN": 0.0135, "ENEZ": 0.0135, "ÁNÍK": 0.0135, "ŽENÍ": 0.0135,
+	"ĚNNÉ": 0.0135, "LÍČK": 0.0135, "EDPO": 0.0135, "TKOV": 0.0135, "ČTIN": 0.0135,
+	"INSK": 0.0135, "CENE": 0.0133, "EZÍS": 0.0133, "ATIN": 0.0133, "NOHO": 0.0133,
+	"APSA": 0.0133, "ŽÍVA": 0.0133, "OPRA": 0.0133, "NÉJA": 0.0133, "OČEN": 0.0133,
+	"ÝKON": 0.0133, "ČÍTA": 0.0133, "RUŠE": 0.0133, "ONAS": 0.0133, "EKTU": 0.0133,
+	"SLOŽ": 0.0133, "LATI": 0.0133, "DŠTI": 0.0133, "ETIC": 0.0133, "TSTA": 0.0131,
+	"OTOM": 0.0131, "ZUJE": 0.0131, "EZAP": 0.0131, "TYPE": 0.0131, "ESEZ": 0.0131,
+	"OVSK": 0.0131, "VNIT": 0.0131, "TORU": 0.0131, "NEPŘ": 0.0131, "ĚNĚN": 0.0131,
+	"ÍBAL": 0.0131, "RAZE": 0.0131, "PODR": 0.0131, "NORO": 0.0131, "LNÍS": 0.0131,
+	"APŘE": 0.0131, "JEKT": 0.0131, "PRŠE": 0.0131, "EMEN": 0.0131, "CHPŘ": 0.0131,
+	"ISTO": 0.0129, "EVER": 0.0129, "LOKÁ": 0.0129, "NOTU": 0.0129, "OROZ": 0.0129,
+	"SPUŠ": 0.0129, "PUŠT": 0.0129, "ROVÉ": 0.0129, "POSK": 0.0129, "AŽEN": 0.0129,
+	"ÍDAT": 0.0129, "OPIS": 0.0129, "NÁMÁ": 0.0129, "JEHO": 0.0129, "ÁZVY": 0.0129,
+	"KTUÁ": 0.0129, "SELI": 0.0129, "DOČA": 0.0129, "DPRO": 0.0129, "OUČA": 0.0129,
+	"ECHÁ": 0.0129, "VOLI": 0.0129, "DEPS": 0.0129, "ABLO": 0.0129, "NÍZN": 0.0129,
+	"PŘIV": 0.0129, "ACEP": 0.0129, "RUNE": 0.0127, "EMŮŽ": 0.0127, "CHÉM": 0.0127,
+	"BORE": 0.0127, "ZESO": 0.0127, "ENAP": 0.0127, "USEL": 0.0127, "ÉJAK": 0.0127,
+	"TOMA": 0.0127, "OMAT": 0.0127, "NITŘ": 0.0127, "AHOV": 0.0127, "SOFT": 0.0127,
+	"RAVI": 0.0127, "LÍČN": 0.0127, "ÉSOU": 0.0127, "OPOD": 0.0127, "EPSA": 0.0127,
+	"RANC": 0.0127, "ĚROV": 0.0127, "DSKÉ": 0.0127, "VERU": 0.0125, "VAJÍ": 0.0125,
+	"ÍSTĚ": 0.0125, "SCHÉ": 0.0125, "HÉMA": 0.0125, "EBOV": 0.0125, "ENTA": 0.0125,
+	"OSKY": 0.0125, "ISTR": 0.0125, "EČNÝ": 0.0125, "SPEC": 0.0125, "ÉLKA": 0.0125,
+	"NAČT": 0.0125, "OBJE": 0.0125, "ÍŠEP": 0.0125, "RÁNÍ": 0.0125, "OZŠÍ": 0.0125,
+	"ZŠÍŘ": 0.0125, "ULOV": 0.0125, "AZEM": 0.0125, "ŘIDÁ": 0.0125, "KAZO": 0.0125,
+	"NÝMI": 0.0125, "DÁNO": 0.0125, "ROBN": 0.0125, "KÁNÍ": 0.0125, "LÁDÁ": 0.0125,
+	"ŠTĚČ": 0.0125, "EFOR": 0.0125, "TOKL": 0.0125, "SEKU": 0.0125, "EKUN": 0.0125,
+	"KUND": 0.0125, "DÁVÁ": 0.0123, "CÍHO": 0.0123, "TAKT": 0.0123, "BYLY": 0.0123,
+	"ISKN": 0.0123, "NAKE": 0.0123, "CHCE": 0.0123, "VÝSO": 0.0123, "ZENÝ": 0.0123,
+	"ARSK": 0.0123, "RTVÝ": 0.0123, "OKÁL": 0.0121, "NÁMK": 0.0121, "UPŘI": 0.0121,
+	"ENÍO": 0.0121, "ÁDAT": 0.0121, "NÝPR": 0.0121, "ŠÍŘE": 0.0121, "ÍŘEN": 0.0121,
+	"YNEB": 0.0121, "UTOM": 0.0121, "ÁSTI": 0.0121, "ŽIMU": 0.0121, "ČUJE": 0.0121,
+	"UJEJ": 0.0121, "DROB": 0.0121, "ÍRÁN": 0.0121, "PŘIŘ": 0.0121, "VÝPI": 0.0121,
+	"ŽDÉH": 0.0121, "DÉHO": 0.0121, "OPOU": 0.0119, "KÁLN": 0.0119, "AMEN": 0.0119,
+	"OKUS": 0.0119, "NÍVE": 0.0119, "AADR": 0.0119, "ĚJŠÍ": 0.0119, "KUDN": 0.0119,
+	"UDNE": 0.0119, "NÍST": 0.0119, "REGU": 0.0119, "EGUL": 0.0119, "GULÁ": 0.0119,
+	"ULÁR": 0.0119, "LÁRN": 0.0119, "RAMU": 0.0119, "LICE": 0.0119, "ŘEKL": 0.0119,
+	"HOPR": 0.0119, "DESK": 0.0119, "ZACH": 0.0119, "NTOS": 0.0119, "NAJE": 0.0119,
+	"SPOL": 0.0119, "KÉHO": 0.0119, "EKON": 0.0119, "ZEVP": 0.0119, "ANCO": 0.0119,
+	"JIŽN": 0.0118, "LIŠD": 0.0118, "IŠDL": 0.0118, "ŠDLO": 0.0118, "DNOU": 0.0118,
+	"VŘEN": 0.0118, "ÝPRO": 0.0118, "OZIC": 0.0118, "TNEB": 0.0118, "ÍHOV": 0.0118,
+	"EBOS": 0.0118, "JNÉJ": 0.0118, "RPRO": 0.0118, "ÝARG": 0.0118, "PŘEJ": 0.0118,
+	"AKOP": 0.0118, "JÍSE": 0.0118, "TALA": 0.0118, "OZAD": 0.0118, "BÁZE": 0.0118,
+	"ISLO": 0.0118, "ÁVNĚ": 0.0118, "ŘIŘA": 0.0118, "ÍSLA": 0.0118, "NCOU": 0.0118,
+	"COUZ": 0.0118, "KART": 0.0118, "ERNÍ": 0.0116, "STĚN": 0.0116, "ERAC": 0.0116,
+	"VYNE": 0.0116, "UPOD": 0.0116, "ALOK": 0.0116, "NAKN": 0.0116, "ŠEPO": 0.0116,
+	"ÍŘÁD": 0.0116, "ZOVÁ": 0.0116, "PÍSM": 0.0116, "NÁRO": 0.0116, "TŘÍD": 0.0116,
+	"VNÁV": 0.0116, "ALIA": 0.0116, "CESU": 0.0116, "ANEB": 0.0116, "COVA": 0.0116,
+	"ANOU": 0.0116, "OKRA": 0.0116, "ULOŽ": 0.0116, "AHRA": 0.0116, "LEME": 0.0116,
+	"UDEP": 0.0116, "NTIF": 0.0116, "SKÉS": 0.0116, "NÍDA": 0.0114, "KVŮL": 0.0114,
+	"VŮLI": 0.0114, "NESM": 0.0114, "HYBO": 0.0114, "OKOL": 0.0114, "AZAT": 0.0114,
+	"XIMÁ": 0.0114, "NEZA": 0.0114, "NAPR": 0.0114, "NÁVÁ": 0.0114, "NÉPR": 0.0114,
+	"TEPR": 0.0114, "ASNÝ": 0.0114, "SPĚC": 0.0114, "PĚCH": 0.0114, "OVNÍ": 0.0114,
+	"ESEN": 0.0114, "OPAK": 0.0114, "STOR": 0.0114, "EJME": 0.0114, "NÍKL": 0.0114,
+	"NTYP": 0.0114, "ŽETE": 0.0114, "ODRO": 0.0114, "MAZA": 0.0114, "YLZA": 0.0114,
+	"UVED": 0.0114, "JSTE": 0.0114, "OPŘI": 0.0114, "DPKG": 0.0114, "OHOT": 0.0114,
+	"ROZH": 0.0114, "ZVUK": 0.0114, "ÝCHK": 0.0114, "ECKÉ": 0.0114, "MIMO": 0.0112,
+	"ČÍSE": 0.0112, "NPRO": 0.0112, "ODOB": 0.0112, "POŠK": 0.0112, "OŠKO": 0.0112,
+	"ACHY": 0.0112, "BYLN": 0.0112, "BOPŘ": 0.0112, "ESEL": 0.0112, "UPOU": 0.0112,
+	"EVŠE": 0.0112, "AČNE": 0.0112, "NÝAR": 0.0112, "ÝTYP": 0.0112, "OVED": 0.0112,
+	"ÍPŘI": 0.0112, "RTOV": 0.0112, "JNÝK": 0.0112, "AMÍS": 0.0112, "LENÍ": 0.0112,
+	"SÁŘN": 0.0112, "EZPR": 0.0112, "OSTÍ": 0.0112, "ÍČAS": 0.0112, "KRÁT": 0.0112,
+	"KÁTO": 0.0112, "ÁNÍD": 0.0112, "MĚRO": 0.0112, "AMER": 0.0112, "TABU": 0.0110,
+	"ABUL": 0.0110, "ORPR": 0.0110, "ENÍJ": 0.0110, "AKCE": 0.0110, "DEFI": 0.0110,
+	"ĚZCE": 0.0110, "IPRO": 0.0110, "POPI": 0.0110, "MOVS": 0.0110, "TRÁN": 0.0110,
+	"LOVÉ": 0.0110, "LOMÍ": 0.0110, "OŽKU": 0.0110, "YBRA": 0.0110, "STIS": 0.0110,
+	"POTO": 0.0110, "YPŘE": 0.0110, "SEPŘ": 0.0110, "UKLÍ": 0.0110, "BJEK": 0.0110,
+	"SKÁN": 0.0110, "LÍKŮ": 0.0110, "TORI": 0.0110, "ÉHOK": 0.0110, "ÁNŠT": 0.0110,
+	"UTEN": 0.0108, "OTOK": 0.0108, "ŘÍZN": 0.0108, "RUSK": 0.0108, "EFIN": 0.0108,
+	"KÁZA": 0.0108, "PODO": 0.0108, "KOZE": 0.0108, "IPŘE": 0.0108, "NONE": 0.0108,
+	"ANÝP": 0.0108, "HOŘÁ": 0.0108, "DOMO": 0.0108, "VÉST": 0.0108, "UROV": 0.0108,
+	"PŘIS": 0.0108, "NÍSY": 0.0108, "ÍSYS": 0.0108, "CEPO": 0.0108, "KAZŮ": 0.0108,
+	"OJIT": 0.0108, "AŠIF": 0.0108, "DKLÍ": 0.0108, "VOŘÍ": 0.0108, "UPOV": 0.0106,
+	"EURČ": 0.0106, "ŘIJA": 0.0106, "VPRO": 0.0106, "ŠKOZ": 0.0106, "ÍHOP": 0.0106,
+	"OČÍS": 0.0106, "IKDY": 0.0106, "SMEN": 0.0106, "UJED": 0.0106, "NAJÍ": 0.0106,
+	"ALAC": 0.0106, "VÝMI": 0.0106, "ŘADÍ": 0.0106, "NÁLU": 0.0106, "KOPÍ": 0.0106,
+	"OPÍR": 0.0106, "KÝCH": 0.0106, "NOUP": 0.0106, "EAKT": 0.0106, "EADR": 0.0106,
+	"LOVO": 0.0106, "NOPO": 0.0106, "ÝŘÁD": 0.0106, "TSKÉ": 0.0106, "VAČE": 0.0106,
+	"ÍSEZ": 0.0106, "OZHR": 0.0106, "ÝPIS": 0.0106, "AVID": 0.0104, "ALES": 0.0104,
+	"PACK": 0.0104, "OSLO": 0.0104, "AZUJ": 0.0104, "ENUL": 0.0104, "EBOZ": 0.0104,
+	"RUSE": 0.0104, "ISPO": 0.0104, "EMNE": 0.0104, "ENÁZ": 0.0104, "ŘEBA": 0.0104,
+	"TECH": 0.0104, "OMOV": 0.0104, "SÁŘŮ": 0.0104, "OFTW": 0.0104, "ZPĚT": 0.0104,
+	"ÍTKO": 0.0104, "ECHY": 0.0104, "ÍZAD": 0.0104, "TEPO": 0.0104, "JOVÝ": 0.0104,
+	"ÁZVU": 0.0104, "DUŽI": 0.0104, "PŘÍZ": 0.0104, "MAZÁ": 0.0104, "ASNÉ": 0.0104,
+	"META": 0.0104, "PROD": 0.0104, "RICK": 0.0104, "APOK": 0.0104, "LOCK": 0.0104,
+	"YBOV": 0.0102, "OSKU": 0.0102, "ÝPŘÍ": 0.0102, "UNEL": 0.0102, "IJAT": 0.0102,
+	"ESKU": 0.0102, "YJSO": 0.0102, "ÉODK": 0.0102, "ÍSME": 0.0102, "ÁSTR": 0.0102,
+	"ZÁSO": 0.0102, "OBNÍ": 0.0102, "BNÍK": 0.0102, "TWAR": 0.0102, "NÝKO": 0.0102,
+	"TÉMO": 0.0102, "INAK": 0.0102, "SOUV": 0.0102, "DEJT": 0.0102, "DISK": 0.0102,
+	"HOPŘ": 0.0102, "LOZA": 0.0102, "YNEC": 0.0102, "ÝADR": 0.0102, "APOS": 0.0102,
+	"CÍLO": 0.0102, "MACI": 0.0102, "RÁVC": 0.0102, "ESEP": 0.0102, "OLNÍ": 0.0102,
+	"ČNÍS": 0.0102, "CETE": 0.0102, "ZAŠI": 0.0102, "ÓDVR": 0.0102, "NEMO": 0.0101,
+	"ESMÍ": 0.0101, "ZAPN": 0.0101, "EPOK": 0.0101, "KTUR": 0.0101, "AČÍS": 0.0101,
+	"EMOH": 0.0101, "ÍJMÉ": 0.0101, "OUHÝ": 0.0101, "ACES": 0.0101, "YLNA": 0.0101,
+	"VĚDU": 0.0101, "TJAK": 0.0101, "RÁNK": 0.0101, "OŽNO": 0.0101, "ÁROD": 0.0101,
+	"FTWA": 0.0101, "DÁLE": 0.0101, "HYBY": 0.0101, "OMÍT": 0.0101, "ENES": 0.0101,
+	"AKEM": 0.0101, "DNÍM": 0.0101, "POSI": 0.0101, "STOP": 0.0101, "CHPR": 0.0101,
+	"ODMÍ": 0.0101, "UPNÝ": 0.0101, "OLAT": 0.0101, "AZÁN": 0.0101, "POZA": 0.0101,
+	"ĚNÍP": 0.0101, "ZHRA": 0.0101, "UZSK": 0.0101, "SKÉK": 0.0101, "CHKL": 0.0101,
+	"EČEN": 0.0099, "HYBĚ": 0.0099, "ORTU": 0.0099, "ÁMKA": 0.0099, "OČTE": 0.0099,
+	"OTIS": 0.0099, "YBNÉ": 0.0099, "TREA": 0.0099, "ROZD": 0.0099, "DOVO": 0.0099,
+	"MÍNK": 0.0099, "ÉMOV": 0.0099, "JICH": 0.0099, "PŘEN": 0.0099, "AVOV": 0.0099,
+	"ORTO": 0.0099, "ISKU": 0.0099, "ÍČNE": 0.0099, "ENTŮ": 0.0099, "ZJIS": 0.0099,
+	"ÍNOV": 0.0099, "MNOH": 0.0099, "ITPR": 0.0099, "DVOJ": 0.0099, "TEČK": 0.0099,
+	"ENÝS": 0.0099, "ZRUŠ": 0.0099, "CKÁR": 0.0099, "OUZS": 0.0099, "JEVE": 0.0097,
+	"EJIŽ": 0.0097, "HOKL": 0.0097, "ACKE": 0.0097, "KAZA": 0.0097, "ŠPAT": 0.0097,
+	"PATN": 0.0097, "ZESE": 0.0097, "ÍVER": 0.0097, "EDÁN": 0.0097, "NÍOD": 0.0097,
+	"TOPR": 0.0097, "TEST": 0.0097, "NASO": 0.0097, "TRAŇ": 0.0097, "SPŘE": 0.0097,
+	"JESO": 0.0097, "EJIC": 0.0097, "VUJE": 0.0097, "TATN": 0.0097, "ENAJ": 0.0097,
+	"TERM": 0.0097, "NÉPŘ": 0.0097, "PNOS": 0.0097, "BRAN": 0.0097, "TKLÍ": 0.0097,
+	"NAMÍ": 0.0097, "ÍINF": 0.0097, "ECHO": 0.0097, "ESUN": 0.0097, "IDUŽ": 0.0097,
+	"YSTE": 0.0097, "EUŽI": 0.0097, "ŽITE": 0.0097, "EBEZ": 0.0097, "OPRÁ": 0.0097,
+	"ÍLOV": 0.0097, "ZENO": 0.0097, "ATNA": 0.0097, "NÍMO": 0.0097, "AKOA": 0.0097,
+	"EBOJ": 0.0097, "EPSÁ": 0.0097, "ROZB": 0.0097, "ÍTAČ": 0.0097, "ACIN": 0.0097,
+	"PODK": 0.0097, "OCKE": 0.0095, "EDÁV": 0.0095, "ZEZÍ": 0.0095, "LIŠV": 0.0095,
+	"NERO": 0.0095, "IKOV": 0.0095, "NÁRN": 0.0095, "KÁCH": 0.0095, "EPOČ": 0.0095,
+	"VĚJŠ": 0.0095, "VOLN": 0.0095, "AČEM": 0.0095, "AVUJ": 0.0095, "UNAS": 0.0095,
+	"ELIN": 0.0095, "LINU": 0.0095, "INUX": 0.0095, "NTOP": 0.0095, "ŘEJM": 0.0095,
+	"TVÝC": 0.0095, "IJES": 0.0095, "ÍŠES": 0.0095, "EZJI": 0.0095, "LEPO": 0.0095,
+	"AŠOV": 0.0095, "VENO": 0.0095, "NTOK": 0.0095, "ROLN": 0.0095, "HCET": 0.0095,
+	"ZKON": 0.0095, "ELNÁ": 0.0095, "ČÍNA": 0.0095, "LENÉ": 0.0095, "ÝCHA": 0.0095,
+	"ŠÍŘK": 0.0095, "ŘÍDÍ": 0.0095, "TIZA": 0.0093, "ACEN": 0.0093, "POVÁ": 0.0093,
+	"NEMÁ": 0.0093, "EKTE": 0.0093, "TPŘÍ": 0.0093, "MORO": 0.0093, "IALI": 0.0093,
+	"EDAT": 0.0093, "MINI": 0.0093, "ŽADA": 0.0093, "ADAV": 0.0093, "PECI": 0.0093,
+	"NEOD": 0.0093, "PROA": 0.0093, "ŘEDN": 0.0093, "YKON": 0.0093, "EJEN": 0.0093,
+	"EXTU": 0.0093, "EVSO": 0.0093, "ÍVÝR": 0.0093, "RODN": 0.0093, "PĚTN": 0.0093,
+	"NOVĚ": 0.0093, "ESKR": 0.0093, "NTAX": 0.0093, "TARA": 0.0093, "EPOV": 0.0093,
+	"LOHY": 0.0093, "CKÝO": 0.0093, "OUPŘ": 0.0093, "ZADE": 0.0093, "OVEN": 0.0093,
+	"ÍCHP": 0.0093, "VODN": 0.0093, "OPEN": 0.0093, "LZAD": 0.0093, "VÍRÁ": 0.0093,
+	"RÁTO": 0.0093, "ŘIVY": 0.0093, "IŘAZ": 0.0093, "KUDS": 0.0093, "AVDA": 0.0093,
+	"YBYL": 0.0091, "HOST": 0.0091, "NEMŮ": 0.0091, "LENO": 0.0091, "ETOD": 0.0091,
+	"TOKO": 0.0091, "PROO": 0.0091, "INIC": 0.0091, "NICI": 0.0091, "NICE": 0.0091,
+	"RÁVĚ": 0.0091, "RÁVY": 0.0091, "NEPR": 0.0091, "NESP": 0.0091, "UZEP": 0.0091,
+	"AŘÁD": 0.0091, "NÍVS": 0.0091, "MÍTK": 0.0091, "IPTO": 0.0091, "LNÍP": 0.0091,
+	"KNUT": 0.0091, "OSTO": 0.0091, "TOMT": 0.0091, "OMTO": 0.0091, "SPOD": 0.0091,
+	"KÝOD": 0.0091, "SELN": 0.0091, "ENEL": 0.0091, "ŮŽET": 0.0091, "SEVY": 0.0091,
+	"YBAN": 0.0091, "ÁVCE": 0.0091, "VNĚN": 0.0091, "VYNU": 0.0091, "EBAL": 0.0091,
+	"BYLP": 0.0091, "ÁLOV": 0.0091, "EPOŽ": 0.0091, "ŘEDV": 0.0091, "ÁLOŽ": 0.0091,
+	"ZEOD": 0.0091, "OVÝP": 0.0091, "AJIN": 0.0091, "ÍČEK": 0.0091, "ÍCER": 0.0091,
+	"ARAB": 0.0091, "ANSK": 0.0091, "JSKÉ": 0.0091, "DÍCÍ": 0.0091, "BSKÉ": 0.0091,
+	"NĚME": 0.0091, "KOČE": 0.0091, "SKÉM": 0.0091, "DAPO": 0.0091, "SOCK": 0.0089,
+	"ENTP": 0.0089, "RESU": 0.0089, "VATV": 0.0089, "YHLE": 0.0089, "OMEN": 0.0089,
+	"IMOR": 0.0089, "ITPO": 0.0089, "SLUŽ": 0.0089, "EKOM": 0.0089, "SYNC": 0.0089,
+	"UNEN": 0.0089, "DESL": 0.0089, "ASKO": 0.0089, "RUNA": 0.0089, "KYNE": 0.0089,
+	"ŽNOS": 0.0089, "PTOR": 0.0089, "NETI": 0.0089, "OVER": 0.0089, "POST": 0.0089,
+	"OSEZ": 0.0089, "ATSO": 0.0089, "MINÁ": 0.0089, "LACI": 0.0089, "ČÍTÁ": 0.0089,
+	"ÍMZA": 0.0089, "ADEJ": 0.0089, "ÍNÁZ": 0.0089, "ZEPR": 0.0089, "ÚDAJ": 0.0089,
+	"ČASU": 0.0089, "IÁLN": 0.0089, "SEZA": 0.0089, "RSOU": 0.0089, "EXPO": 0.0089,
+	"OŘAD": 0.0089, "LIŠM": 0.0089, "EDVO": 0.0089, "STAH": 0.0089, "ŘEPS": 0.0089,
+	"KETŮ": 0.0089, "OŽNÍ": 0.0089, "ŠABL": 0.0089, "BLON": 0.0089, "RIMO": 0.0089,
+	"IMOV": 0.0089, "DOPL": 0.0089, "TOSH": 0.0089, "RATI": 0.0089, "TAJN": 0.0089,
+	"ORAK": 0.0089, "RŽEN": 0.0087, "NTIZ": 0.0087, "LNÍH": 0.0087, "BÝTP": 0.0087,
+	"OVÁV": 0.0087, "INDI": 0.0087, "RNEB": 0.0087, "ORSK": 0.0087, "NAAD": 0.0087,
+	"ZAPS": 0.0087, "BYTE": 0.0087, "ESET": 0.0087, "OVIN": 0.0087, "TLAČ": 0.0087,
+	"ONÁP": 0.0087, "STRE": 0.0087, "OVNĚ": 0.0087, "NÍZÁ": 0.0087, "NAČŮ": 0.0087,
+	"POVA": 0.0087, "NÍŘÁ": 0.0087, "OSYS": 0.0087, "ÉZNA": 0.0087, "PONE": 0.0087,
+	"ÍDOS": 0.0087, "JEJE": 0.0087, "ROUP": 0.0087, "TOMK": 0.0087, "ÁŘNE": 0.0087,
+	"STIN": 0.0087, "ZAVŘ": 0.0087, "ÍVOL": 0.0087, "NÍCE": 0.0087, "VIDE": 0.0087,
+	"ŠOVA": 0.0087, "OZPR": 0.0087, "EKNE": 0.0087, "ZAPO": 0.0087, "AKUJ": 0.0087,
+	"ÍCÍM": 0.0087, "PRES": 0.0087, "TAVO": 0.0087, "ZAČÍ": 0.0087, "ZBAL": 0.0087,
+	"BONE": 0.0087, "SLIC": 0.0087, "ARŠT": 0.0087, "NDŠT": 0.0087, "GRAF": 0.0087,
+	"LÍČP": 0.0087, "ĚMEC": 0.0087, "MECK": 0.0087, "HIST": 0.0087, "ÉMAT": 0.0085,
+	"ÉNOS": 0.0085, "VLOŽ": 0.0085, "URČI": 0.0085, "RČIT": 0.0085, "OREM": 0.0085,
+	"ŠVEL": 0.0085, "VĚŘO": 0.0085, "ĚŘOV": 0.0085, "CIAL": 0.0085, "ÉHOD": 0.0085,
+	"AMUS": 0.0085, "EODP": 0.0085, "ÍUŽI": 0.0085, "ÍSPO": 0.0085, "AMĚŤ": 0.0085,
+	"TANE": 0.0085, "NČIL": 0.0085, "DNEB": 0.0085, "RNÍV": 0.0085, "EKLA": 0.0085,
+	"PSAL": 0.0085, "HOAD": 0.0085, "ARAL": 0.0085, "IZÁP": 0.0085, "IŠTĚ": 0.0085,
+	"YPNU": 0.0085, "NÍNÁ": 0.0085, "HÁZÍ": 0.0085, "UZAV": 0.0085, "ODNÝ": 0.0085,
+	"NAMS": 0.0085, "EČNĚ": 0.0085, "HRAZ": 0.0085, "POJI": 0.0085, "XPOR": 0.0085,
+	"TBAJ": 0.0085, "LIST": 0.0085, "NEOB": 0.0085, "IHLA": 0.0085, "HLAŠ": 0.0085,
+	"STAŽ": 0.0085, "ÝPOD": 0.0085, "EBOO": 0.0085, "REAM": 0.0085, "INDO": 0.0085,
+	"ÍSLI": 0.0085, "SVAZ": 0.0085, "SLOC": 0.0085, "ONSK": 0.0085, "ALSK": 0.0085,
+	"DVOR": 0.0085, "VORA": 0.0085, "ÍPOL": 0.0083, "LPRO": 0.0083, "VYHL": 0.0083,
+	"LZEU": 0.0083, "IŠVE": 0.0083, "ÁZAT": 0.0083, "STRU": 0.0083, "DEPI": 0.0083,
+	"KOŘE": 0.0083, "ZAPI": 0.0083, "DUPL": 0.0083, "ÁNÍO": 0.0083, "ŘENÉ": 0.0083,
+	"ÝPOČ": 0.0083, "MENÁ": 0.0083, "BINÁ": 0.0083, "CKÉO": 0.0083, "LÁTO": 0.0083,
+	"NÍVY": 0.0083, "EVEL": 0.0083, "ÉHOŘ": 0.0083, "OCSP": 0.0083, "OVĚJ": 0.0083,
+	"JINA": 0.0083, "NAPS": 0.0083, "TNÝM": 0.0083, "AZÍT": 0.0083, "TNUT": 0.0083,
+	"EVST": 0.0083, "INÁL": 0.0083, "PŘEČ": 0.0083, "OUPR": 0.0083, "ANÝS": 0.0083,
+	"ENÝM": 0.0083, "TATO": 0.0083, "NAMY": 0.0083, "SOVÉ": 0.0083, "TOPŘ": 0.0083,
+	"ZKUS": 0.0083, "DATU": 0.0083, "ČENÉ": 0.0083, "POŘA": 0.0083, "LINK": 0.0083,
+	"BANE": 0.0083, "TAŽE": 0.0083, "ALUJ": 0.0083, "IČKA": 0.0083, "AČÍN": 0.0083,
+	"OZBA": 0.0083, "NNEP": 0.0083, "BEZM": 0.0083, "SÁNÍ": 0.0083, "ZSKÉ": 0.0083,
+	"ÁKLÁ": 0.0083, "DRŽE": 0.0082, "DOKO": 0.0082, "AUTE": 0.0082, "KETU": 0.0082,
+	"SAHO": 0.0082, "ČENE": 0.0082, "EMIM": 0.0082, "ESPU": 0.0082, "GENE": 0.0082,
+	"TUNE": 0.0082, "ERPR": 0.0082, "UPLI": 0.0082, "OCEN": 0.0082, "TNÍM": 0.0082,
+	"TATE": 0.0082, "ESES": 0.0082, "ENÁS": 0.0082, "CESO": 0.0082, "OVÁD": 0.0082,
+	"NÉJM": 0.0082, "RONE": 0.0082, "HOPO": 0.0082, "TART": 0.0082, "UVOZ": 0.0082,
+	"OTLA": 0.0082, "ÍPOČ": 0.0082, "OLIV": 0.0082, "TORY": 0.0082, "DATE": 0.0082,
+	"ÍVST": 0.0082, "NAZA": 0.0082, "TOJE": 0.0082, "KYNA": 0.0082, "TPOU": 0.0082,
+	"PONA": 0.0082, "ORUV": 0.0082, "ERMI": 0.0082, "AZNE": 0.0082, "VĚTŠ": 0.0082,
+	"ĚRYH": 0.0082, "RYHO": 0.0082, "OSTP": 0.0082, "ŘEDK": 0.0082, "ÁNOV": 0.0082,
+	"OVÍC": 0.0082, "AVOL": 0.0082, "TAVĚ": 0.0082, "NECS": 0.0082, "OZAS": 0.0082,
+	"MPRE": 0.0082, "OUČE": 0.0082, "HTTP": 0.0082, "ITPŘ": 0.0082, "ACÍM": 0.0082,
+	"ASPO": 0.0082, "ŠEST": 0.0082, "STNÁ": 0.0082, "INAM": 0.0082, "SAMI": 0.0082,
+	"TOPO": 0.0082, "USKÉ": 0.0082, "RABS": 0.0082, "ABSK": 0.0082, "EŘÁD": 0.0082,
+	"ONET": 0.0082, "FONE": 0.0082, "ČENA": 0.0080, "YBAS": 0.0080, "ESER": 0.0080,
+	"TUPO": 0.0080, "ENER": 0.0080, "TADR": 0.0080, "APIS": 0.0080, "UADR": 0.0080,
+	"ELEN": 0.0080, "PŘIN": 0.0080, "ÍPRA": 0.0080, "AKJE": 0.0080, "DOŠL": 0.0080,
+	"ENÍT": 0.0080, "ENNE": 0.0080, "ZACI": 0.0080, "APOD": 0.0080, "OCHY": 0.0080,
+	"TUTO": 0.0080, "VYSO": 0.0080, "ÉŘÁD": 0.0080, "ČETV": 0.0080, "ITŘN": 0.0080,
+	"ČNEN": 0.0080, "EARG": 0.0080, "DNÍP": 0.0080, "ENCE": 0.0080, "MACÍ": 0.0080,
+	"TAXE": 0.0080, "ATAK": 0.0080, "OBSL": 0.0080, "ENOS": 0.0080, "AKNE": 0.0080,
+	"UJÍS": 0.0080, "DAJE": 0.0080, "ŘIPŘ": 0.0080, "ĚNNÁ": 0.0080, "CSOU": 0.0080,
+	"ISOU": 0.0080, "VIDL": 0.0080, "ITNĚ": 0.0080, "NĚNO": 0.0080, "POTŘ": 0.0080,
+	"OTŘE": 0.0080, "RŠEL": 0.0080, "ROSP": 0.0080, "APOU": 0.0080, "POKL": 0.0080,
+	"ACÍP": 0.0080, "RUPR": 0.0080, "ÉBAL": 0.0080, "ÁNNE": 0.0080, "ESTO": 0.0080,
+	"DYŽJ": 0.0080, "DPOK": 0.0080, "VOVÁ": 0.0080, "MÁTO": 0.0080, "MERI": 0.0080,
+	"MŠTI": 0.0080, "RAFI": 0.0080, "VYDA": 0.0080, "ERÁT": 0.0080, "ENSK": 0.0078,
+	"OALE": 0.0078, "OTAZ": 0.0078, "ENAV": 0.0078, "SOKE": 0.0078, "OKET": 0.0078,
+	"VATN": 0.0078, "HASH": 0.0078, "SLOP": 0.0078, "CESE": 0.0078, "LNAL": 0.0078,
+	"ÍČEM": 0.0078, "NÝZN": 0.0078, "YKTE": 0.0078, "ZORK": 0.0078, "KURZ": 0.0078,
+	"YŽÁD": 0.0078, "TEPŘ": 0.0078, "NPŘE": 0.0078, "ŘIZÁ": 0.0078, "UPŘÍ": 0.0078,
+	"ČESK": 0.0078, "RMIN": 0.0078, "ÍTÁN": 0.0078, "ÍÚSP": 0.0078, "BNOS": 0.0078,
+	"ODIN": 0.0078, "ÍROV": 0.0078, "PROJ": 0.0078, "ESPR": 0.0078, "ÍCES": 0.0078,
+	"VAŠE": 0.0078, "STÁT": 0.0078, "ACÍC": 0.0078, "ENEJ": 0.0078, "AVĚN": 0.0078,
+	"BÁZI": 0.0078, "PRVK": 0.0078, "ZENÉ": 0.0078, "REPO": 0.0078, "NAMI": 0.0078,
+	"ÁHLA": 0.0078, "ZEZM": 0.0078, "OLBA": 0.0078, "AVÍR": 0.0078, "ECSO": 0.0078,
+	"MPŘÍ": 0.0078, "HUJÍ": 0.0078, "ZEST": 0.0078, "ĚNÍM": 0.0078, "ÉHES": 0.0078,
+	"ERIC": 0.0078, "IJSK": 0.0078, "ESMĚ": 0.0078, "ÍDÍC": 0.0078, "ERTY": 0.0078,
+	"DVRÁ": 0.0078, "VDAP": 0.0078, "IMPO": 0.0076, "PASS": 0.0076, "HLAS": 0.0076,
+	"EVŘE": 0.0076, "LENA": 0.0076, "DOBN": 0.0076, "NÍSP": 0.0076, "LOKA": 0.0076,
+	"JENÁ": 0.0076, "HOVS": 0.0076, "ÍJAK": 0.0076, "EKUR": 0.0076, "ŇUJE": 0.0076,
+	"BÝTZ": 0.0076, "VNÍC": 0.0076, "BSLU": 0.0076, "YPNE": 0.0076, "JEDO": 0.0076,
+	"CHNA": 0.0076, "UPRA": 0.0076, "AJÍT": 0.0076, "APOV": 0.0076, "BNOV": 0.0076,
+	"SPĚŠ": 0.0076, "PĚŠN": 0.0076, "YPŘÍ": 0.0076, "GROU": 0.0076, "ZEZJ": 0.0076,
+	"POLI": 0.0076, "ORUŠ": 0.0076, "CIÁL": 0.0076, "INAN": 0.0076, "VZDÁ": 0.0076,
+	"ÉHOA": 0.0076, "ŮVOD": 0.0076, "ČÁRK": 0.0076, "KUNE": 0.0076, "NÍMI": 0.0076,
+	"NÍMS": 0.0076, "NÍRO": 0.0076, "DNOC": 0.0076, "VNÍM": 0.0076, "ÍMOŽ": 0.0076,
+	"BOJE": 0.0076, "DÁNN": 0.0076, "ÝSEZ": 0.0076, "PROX": 0.0076, "ÉHOO": 0.0076,
+	"OJED": 0.0076, "SKÁV": 0.0076, "OVÉČ": 0.0076, "OHOU": 0.0076, "MOVA": 0.0076,
+	"NYPO": 0.0076, "DNÍC": 0.0076, "ESTN": 0.0076, "STON": 0.0076, "LOGI": 0.0076,
+	"SKÉP": 0.0076, "PERÁ": 0.0076, "ÉDVO": 0.0076, "SUNT": 0.0076, "UNTY": 0.0076,
+	"BÝTN": 0.0074, "ONOV": 0.0074, "DATO": 0.0074, "MPOR": 0.0074, "MENA": 0.0074,
+	"TNÍH": 0.0074, "JEMI": 0.0074, "OZMĚ": 0.0074, "TDAT": 0.0074, "DOSO": 0.0074,
+	"LUŽB": 0.0074, "YTEČ": 0.0074, "AJEN": 0.0074, "DENA": 0.0074, "CELÉ": 0.0074,
+	"CENA": 0.0074, "KPRO": 0.0074, "NŽÁD": 0.0074, "VYKO": 0.0074, "ŘEDC": 0.0074,
+	"EDCH": 0.0074, "ORSE": 0.0074, "NÍRE": 0.0074, "REKU": 0.0074, "ÍSTN": 0.0074,
+	"ROZP": 0.0074, "BECN": 0.0074, "RNÍH": 0.0074, "UTOR": 0.0074, "SALI": 0.0074,
+	"SLUH": 0.0074, "UDJE": 0.0074, "NÍBA": 0.0074, "OJEP": 0.0074, "MOHL": 0.0074,
+	"IJEP": 0.0074, "ŘENÝ": 0.0074, "NÍČA": 0.0074, "TNÍP": 0.0074, "NKOV": 0.0074,
+	"BĚŽÍ": 0.0074, "PATH": 0.0074, "TENA": 0.0074, "ÚČET": 0.0074, "MAKE": 0.0074,
+	"CELK": 0.0074, "ERUŠ": 0.0074, "OUČÁ": 0.0074, "IŠMN": 0.0074, "ŠMNO": 0.0074,
+	"OUVE": 0.0074, "LAŠO": 0.0074, "PISY": 0.0074, "ÁPOL": 0.0074, "NČIT": 0.0074,
+	"OVNI": 0.0074, "LOŽN": 0.0074, "CÍSO": 0.0074, "LÍČI": 0.0074, "TITU": 0.0074,
+	"ZÁNÍ": 0.0074, "ÍČÍS": 0.0074, "DESÍ": 0.0074, "OUDU": 0.0074, "ETEP": 0.0074,
+	"NOTK": 0.0074, "ROKO": 0.0074, "ÍNAJ": 0.0074, "NÝSE": 0.0074, "AVAT": 0.0074,
+	"LOVS": 0.0074, "QWER": 0.0074, "WERT": 0.0074, "HKLÁ": 0.0074, "VERN": 0.0072,
+	"OUPC": 0.0072, "OTYP": 0.0072, "ÁVAJ": 0.0072, "ÍVÁN": 0.0072, "ENÍB": 0.0072,
+	"ĚNÍS": 0.0072, "PROČ": 0.0072, "VÁSE": 0.0072, "ROPŘ": 0.0072, "ZAČN": 0.0072,
+	"ÁLNĚ": 0.0072, "CHRO": 0.0072, "SLON": 0.0072, "SOVÝ": 0.0072, "OUVY": 0.0072,
+	"LAČÍ": 0.0072, "OKAŽ": 0.0072, "KÉOD": 0.0072, "ÍOBS": 0.0072, "TACE": 0.0072,
+	"OBEC": 0.0072, "OLUJ": 0.0072, "SKNU": 0.0072, "OVAŽ": 0.0072, "VÁŘÍ": 0.0072,
+	"KUDJ": 0.0072, "AJED": 0.0072, "ÍVAT": 0.0072, "ATNE": 0.0072, "ÁZÁN": 0.0072,
+	"ŇOVÁ": 0.0072, "ENPR": 0.0072, "RELA": 0.0072, "FILE": 0.0072, "CTRL": 0.0072,
+	"TVOL": 0.0072, "LOVE": 0.0072, "ENOP": 0.0072, "ŽENÝ": 0.0072, "ČKOV": 0.0072,
+	"HODI": 0.0072, "PISŮ": 0.0072, "HRÁN": 0.0072, "UKLÁ": 0.0072, "PLNĚ": 0.0072,
+	"ÁNÍA": 0.0072, "ROXY": 0.0072, "ŘESU": 0.0072, "MASK": 0.0072, "ENTN": 0.0072,
+	"MOHO": 0.0072, "JINÉ": 0.0072, "OPLŇ": 0.0072, "KAČN": 0.0072, "YDAV": 0.0072,
+	"DAVA": 0.0072, "NOCE": 0.0072, "SKÉI": 0.0072, "CÍBA": 0.0072, "KÉDV": 0.0072,
+	"EZMR": 0.0072, "ZMRT": 0.0072, "SEVE": 0.0070, "SVAT": 0.0070, "NAKU": 0.0070,
+	"BĚHE": 0.0070, "ÍSES": 0.0070, "SESO": 0.0070, "LESE": 0.0070, "ÍNEN": 0.0070,
+	"ÍALG": 0.0070, "ANAS": 0.0070, "LOŽI": 0.0070, "PŘEB": 0.0070, "NÝOD": 0.0070,
+	"MODE": 0.0070, "MPLE": 0.0070, "ĚPOD": 0.0070, "OTAP": 0.0070, "NAMP": 0.0070,
+	"ÁNÍT": 0.0070, "TIME": 0.0070, "POTL": 0.0070, "ETŘE": 0.0070, "ÍŠEN": 0.0070,
+	"JEZN": 0.0070, "OBVY": 0.0070, "TŘNÍ": 0.0070, "AŽUJ": 0.0070, "STEČ": 0.0070,
+	"INOU": 0.0070, "USEN": 0.0070, "KAZS": 0.0070, "ÝCHZ": 0.0070, "ESYS": 0.0070,
+	"SLAN": 0.0070, "EKLÁ": 0.0070, "VENA": 0.0070, "NÉSO": 0.0070, "ŘIST": 0.0070,
+	"AZAD": 0.0070, "PINO": 0.0070, "ÁNÍC": 0.0070, "NÍSK": 0.0070, "ÍSKU": 0.0070,
+	"FICK": 0.0070, "CÍLE": 0.0070, "NÉNA": 0.0070, "ATUM": 0.0070, "BORB": 0.0070,
+	"NJAK": 0.0070, "EPRA": 0.0070, "ČKOU": 0.0070, "ITÁŘ": 0.0070, "HITE": 0.0070,
+	"EVPO": 0.0070, "ZAVÍ": 0.0070, "NÉBA": 0.0070, "PÍRO": 0.0070, "OLOV": 0.0070,
+	"VNÍS": 0.0070, "RAZO": 0.0070, "TVYP": 0.0070, "UČÁS": 0.0070, "ERAN": 0.0070,
+	"CAPS": 0.0070, "KRÁL": 0.0070, "RÁLO": 0.0070, "HPOK": 0.0070, "REVO": 0.0070,
+	"EVOK": 0.0070, "PSLO": 0.0070, "DOPO": 0.0068, "ĚHEM": 0.0068, "ERNE": 0.0068,
+	"ASSW": 0.0068, "APLA": 0.0068, "ÁVAC": 0.0068, "RACÍ": 0.0068, "ČNÍC": 0.0068,
+	"APAM": 0.0068, "UJEM": 0.0068, "KONÁ": 0.0068, "LOVY": 0.0068, "ÁNON": 0.0068,
+	"BRÁN": 0.0068, "OHLE": 0.0068, "NAŘÁ": 0.0068, "ŘEKR": 0.0068, "ENAD": 0.0068,
+	"KNIH": 0.0068, "OZPO": 0.0068, "NOBA": 0.0068, "ÍKLA": 0.0068, "DICÍ": 0.0068,
+	"HOVÝ": 0.0068, "UJTE": 0.0068, "ÍNKY": 0.0068, "DÁNP": 0.0068, "ÍCÍP": 0.0068,
+	"HPRO": 0.0068, "SOVÁ": 0.0068, "VAŽU": 0.0068, "ŽUJE": 0.0068, "ODNÉ": 0.0068,
+	"EVOD": 0.0068, "NÁSO": 0.0068, "ÉPŘE": 0.0068, "YBAL": 0.0068, "ÁVÁM": 0.0068,
+	"STIC": 0.0068, "TELI": 0.0068, "ŘEPI": 0.0068, "ESLE": 0.0068, "POJO": 0.0068,
+	"ÝFOR": 0.0068, "SUNE": 0.0068, "YLOZ": 0.0068, "NÉMU": 0.0068, "BÍRÁ": 0.0068,
+	"POKR": 0.0068, "ĚKTE": 0.0068, "ÍLEN": 0.0068, "STOV": 0.0068, "PŘER": 0.0068,
+	"ŘERU": 0.0068, "ORSO": 0.0068, "STNO": 0.0068, "MODU": 0.0068, "ŽEJE": 0.0068,
+	"PSAN": 0.0068, "CHIT": 0.0068, "TEKT": 0.0068, "OVÁH": 0.0068, "ANAL": 0.0068,
+	"BORK": 0.0068, "TARÁ": 0.0068, "ORIE": 0.0068, "DOMÉ": 0.0068, "OMÉN": 0.0068,
+	"ESAM": 0.0068, "IVYT": 0.0068, "VSKÉ": 0.0068, "UKTE": 0.0068, "OKLÁ": 0.0068,
+	"IČKO": 0.0068, "KAMI": 0.0068, "TNÁC": 0.0068, "UNAP": 0.0068, "PAKO": 0.0068,
+	"TURE": 0.0068, "STÁN": 0.0068, "INTO": 0.0068, "NÍŽE": 0.0068, "KÉMA": 0.0068,
+	"VSTV": 0.0068, "OLSK": 0.0068, "VESY": 0.0068, "APSL": 0.0068, "OBDR": 0.0066,
+	"BDRŽ": 0.0066, "OUKO": 0.0066, "OŽNÁ": 0.0066, "SMÍB": 0.0066, "MÍBÝ": 0.0066,
+	"TNEN": 0.0066, "ÝVÝS": 0.0066, "VÝSL": 0.0066, "DOTA": 0.0066, "KANA": 0.0066,
+	"BULK": 0.0066, "KÁTN": 0.0066, "RNEN": 0.0066, "OUSE": 0.0066, "ABEZ": 0.0066,
+	"UKAZ": 0.0066, "ONCE": 0.0066, "ÍCHO": 0.0066, "SOBE": 0.0066, "FIKA": 0.0066,
+	"IZUJ": 0.0066, "KUTE": 0.0066, "DNEN": 0.0066, "NTAK": 0.0066, "OŽEK": 0.0066,
+	"ANÁZ": 0.0066, "PISN": 0.0066, "HODO": 0.0066, "ÉNAS": 0.0066, "UZES": 0.0066,
+	"OVYP": 0.0066, "UJEO": 0.0066, "ZARG": 0.0066, "ZPOZ": 0.0066, "ŘÍKL": 0.0066,
+	"ŽENÉ": 0.0066, "KPŘE": 0.0066, "DÁNA": 0.0066, "ŘINA": 0.0066, "ENÍU": 0.0066,
+	"ESCA": 0.0066, "JTEP": 0.0066, "ODPR": 0.0066, "NNEB": 0.0066, "AKOS": 0.0066,
+	"BUTY": 0.0066, "EJEJ": 0.0066, "PODM": 0.0066, "AVŘE": 0.0066, "ANÉM": 0.0066,
+	"OJMÉ": 0.0066, "BYST": 0.0066, "USÍT": 0.0066, "ESTE": 0.0066, "OSEN": 0.0066,
+	"FINO": 0.0066, "ENAN": 0.0066, "AČOV": 0.0066, "IŽBY": 0.0066, "NANA": 0.0066,
+	"ČITE": 0.0066, "ŘIPR": 0.0066, "NĚPO": 0.0066, "OŠLO": 0.0066, "ITEK": 0.0066,
+	"LANA": 0.0066, "LINE": 0.0066, "TORS": 0.0066, "ANÝM": 0.0066, "NAKA": 0.0066,
+	"NÁCT": 0.0066, "ÁCTK": 0.0066, "CTKO": 0.0066, "ARIT": 0.0066, "CINT": 0.0066,
+	"LÉMS": 0.0066, "NĚNA": 0.0066, "ÉKRÁ": 0.0066, "AJNÝ": 0.0066, "ÍCÍB": 0.0066,
+	"SKÉA": 0.0066, "ITSK": 0.0064, "TOVÉ": 0.0064, "ÁNÍJ": 0.0064, "BOVÝ": 0.0064,
+	"LEDE": 0.0064, "EDEK": 0.0064, "ÁPŘÍ": 0.0064, "ÍČEN": 0.0064, "OVAL": 0.0064,
+	"ITSO": 0.0064, "TERP": 0.0064, "EVYH": 0.0064, "RSKO": 0.0064, "LNEB": 0.0064,
+	"ZICI": 0.0064, "SLÁN": 0.0064, "AJEP": 0.0064, "ŘETE": 0.0064, "KUPR": 0.0064,
+	"ÝŘET": 0.0064, "ROHL": 0.0064, "ÁKLA": 0.0064, "BVYK": 0.0064, "VYKL": 0.0064,
+	"ÝNEB": 0.0064, "ÍJED": 0.0064, "TNÝN": 0.0064, "LADU": 0.0064, "TEJE": 0.0064,
+	"STLI": 0.0064, "NÁNÍ": 0.0064, "DNÉP": 0.0064, "NÉZN": 0.0064, "RESY": 0.0064,
+	"NAČP": 0.0064, "NULA": 0.0064, "ÁADR": 0.0064, "UPNÉ": 0.0064, "STIK": 0.0064,
+	"ANÝK": 0.0064, "EDNA": 0.0064, "ETEN": 0.0064, "ENÍČ": 0.0064, "OUHÉ": 0.0064,
+	"ASKU": 0.0064, "ČLEN": 0.0064, "ESTY": 0.0064, "YOBS": 0.0064, "EVÝS": 0.0064,
+	"KRAČ": 0.0064, "ÁLEN": 0.0064, "ROLU": 0.0064, "LNÍC": 0.0064, "NÁPR": 0.0064,
+	"NOUV": 0.0064, "RACI": 0.0064, "OVYT": 0.0064, "LNÍM": 0.0064, "KYTU": 0.0064,
+	"KRAT": 0.0064, "EZÁV": 0.0064, "ROLO": 0.0064, "LSKÝ": 0.0064, "RAZI": 0.0064,
+	"ITME": 0.0064, "KEMN": 0.0064, "OSTV": 0.0064, "MÉDI": 0.0064, "GŠTI": 0.0064,
+	"EZIN": 0.0064, "NAČS": 0.0064, "ČNÍH": 0.0064, "HPŘÍ": 0.0064, "SKÉB": 0.0064,
+	"SKÉQ": 0.0064, "SKÉD": 0.0064, "EMOŽ": 0.0063, "ÍPOV": 0.0063, "METO": 0.0063,
+	"ÁVÁS": 0.0063, "JINÝ": 0.0063, "OUHL": 0.0063, "ÍHOK": 0.0063, "ŘITS": 0.0063,
+	"ETOV": 0.0063, "LESP": 0.0063, "SPOŇ": 0.0063, "TOKE": 0.0063, "IDNE": 0.0063,
+	"BNÝP": 0.0063, "YVYP": 0.0063, "TUPP": 0.0063, "VATZ": 0.0063, "ZVYS": 0.0063,
+	"BORZ": 0.0063, "JETŘ": 0.0063, "JEOD": 0.0063, "STIP": 0.0063, "EKRO": 0.0063,
+	"HOVN": 0.0063, "OTOJ": 0.0063, "EVOL": 0.0063, "MÁTE": 0.0063, "ENÉM": 0.0063,
+	"EINF": 0.0063, "ZESP": 0.0063, "SCAP": 0.0063, "EKVE": 0.0063, "AVST": 0.0063,
+	"NATO": 0.0063, "BASY": 0.0063, "JEDI": 0.0063, "NÍOB": 0.0063, "AŽÁD": 0.0063,
+	"OPOL": 0.0063, "CEPR": 0.0063, "LÍČO": 0.0063, "ZÍJE": 0.0063, "CESY": 0.0063,
+	"ČASP": 0.0063, "KOPI": 0.0063, "ATNÍ": 0.0063, "CHOP": 0.0063, "TELŮ": 0.0063,
+	"ČETN": 0.0063, "SKÉH": 0.0063, "SÍTE": 0.0063, "TINS": 0.0063, "ENEM": 0.0063,
+	"SSWD": 0.0063, "EDĚL": 0.0063, "VANO": 0.0063, "VYRO": 0.0063, "NĚKT": 0.0063,
+	"ÍŠEV": 0.0063, "ZDÁL": 0.0063, "ZENY": 0.0063, "CÍPO": 0.0063, "BORV": 0.0063,
+	"ÍOPE": 0.0063, "ODUL": 0.0063, "TMET": 0.0063, "TONE": 0.0063, "VÝZN": 0.0063,
+	"MNEB": 0.0063, "ÍZPR": 0.0063, "ÁNOP": 0.0063, "MPŘE": 0.0063, "EKLÍ": 0.0063,
+	"KACÍ": 0.0063, "LBYP": 0.0063, "RKLÍ": 0.0063, "LIAS": 0.0063, "ČOVA": 0.0063,
+	"ONŠT": 0.0063, "ZINÁ": 0.0063, "EPOZ": 0.0063, "ÍZMĚ": 0.0063, "ŠLOK": 0.0063,
+	"LSKÁ": 0.0063, "ARTY": 0.0063, "ŘESM": 0.0063, "PŘET": 0.0061, "OPOR": 0.0061,
+	"MUJE": 0.0061, "UHOD": 0.0061, "UHLA": 0.0061, "CHAN": 0.0061, "TRUK": 0.0061,
+	"CÍAL": 0.0061, "RONI": 0.0061, "KYTN": 0.0061, "ÁROV": 0.0061, "ÍCÍH": 0.0061,
+	"LEPŘ": 0.0061, "NÁDA": 0.0061, "NTNE": 0.0061, "ÍROZ": 0.0061, "ERZÍ": 0.0061,
+	"NÉČÍ": 0.0061, "KANE": 0.0061, "MPŘI": 0.0061, "DNÝC": 0.0061, "ANÝA": 0.0061,
+	"OUOD": 0.0061, "EREK": 0.0061, "RAŇU": 0.0061, "AŇUJ": 0.0061, "ZDNÉ": 0.0061,
+	"ŘNÍC": 0.0061, "TZAD": 0.0061, "JEAR": 0.0061, "ÁTKU": 0.0061, "ZEJE": 0.0061,
+	"ICEN": 0.0061, "DNÝP": 0.0061, "YPOD": 0.0061, "ACÍZ": 0.0061, "NÉAR": 0.0061,
+	"ESTL": 0.0061, "ORYP": 0.0061, "ÍARG": 0.0061, "SEDO": 0.0061, "ORŮN": 0.0061,
+	"EČTE": 0.0061, "CUJE": 0.0061, "OSIT": 0.0061, "TPŘI": 0.0061, "NÁVO": 0.0061,
+	"KNEN": 0.0061, "ĚTŠÍ": 0.0061, "TÁHN": 0.0061, "NÍMA": 0.0061, "SCII": 0.0061,
+	"SNEN": 0.0061, "ŽITO": 0.0061, "LITI": 0.0061, "YPOU": 0.0061, "ÍVŠE": 0.0061,
+	"EDNÁ": 0.0061, "ČETZ": 0.0061, "NEJV": 0.0061, "PRVE": 0.0061, "MAŽE": 0.0061,
+	"LNĚN": 0.0061, "EČKO": 0.0061, "JTEČ": 0.0061, "TNAS": 0.0061, "ÉMUN": 0.0061,
+	"DĚNÍ": 0.0061, "DEPŘ": 0.0061, "ŘOVÁ": 0.0061, "IBAL": 0.0061, "ETRY": 0.0061,
+	"ESHO": 0.0061, "YTUJ": 0.0061, "EKTI": 0.0061, "AHOD": 0.0061, "UČET": 0.0061,
+	"EINS": 0.0061, "ACÍH": 0.0061, "SEZÁ": 0.0061, "SEMÁ": 0.0061, "VEFO": 0.0061,
+	"KROM": 0.0061, "PŘIR": 0.0061, "CEOS": 0.0061, "TAČE": 0.0061, "SÍLÁ": 0.0061,
+	"ORYN": 0.0061, "ORUK": 0.0061, "KAPO": 0.0061, "BŠTI": 0.0061, "DSOU": 0.0061,
+	"LÍČJ": 0.0061, "EBOU": 0.0061, "CHBA": 0.0061, "BOPO": 0.0059, "ŽEPO": 0.0059,
+	"MATU": 0.0059, "OVÁP": 0.0059, "ROČT": 0.0059, "VÁVÁ": 0.0059, "OUHO": 0.0059,
+	"ÁTNE": 0.0059, "ONEP": 0.0059, "USER": 0.0059, "JENU": 0.0059, "ITIK": 0.0059,
+	"TINF": 0.0059, "ICIA": 0.0059, "RPRE": 0.0059, "ÍTSO": 0.0059, "ČNÍP": 0.0059,
+	"PAKE": 0.0059, "ESTU": 0.0059, "ÁZAN": 0.0059, "TAJE": 0.0059, "NEKO": 0.0059,
+	"OBNĚ": 0.0059, "ZPŘE": 0.0059, "TEČE": 0.0059, "ZÁKL": 0.0059, "ADNÍ": 0.0059,
+	"OJAK": 0.0059, "ZAČÁ": 0.0059, "AČÁT": 0.0059, "AVYP": 0.0059, "EIGN": 0.0059,
+	"TOZN": 0.0059, "UJAK": 0.0059, "KROČ": 0.0059, "NIHO": 0.0059, "IHOV": 0.0059,
+	"NAČV": 0.0059, "ZNAN": 0.0059, "URZI": 0.0059, "OBAJ": 0.0059, "YZNA": 0.0059,
+	"CÍZN": 0.0059, "RZEN": 0.0059, "EŽÁD": 0.0059, "UDEV": 0.0059, "RÁCE": 0.0059,
+	"VNÍH": 0.0059, "UDES": 0.0059, "ÉPOU": 0.0059, "IPOU": 0.0059, "URČU": 0.0059,
+	"RČUJ": 0.0059, "ORNA": 0.0059, "WARE": 0.0059, "JEJA": 0.0059, "EDIN": 0.0059,
+	"RAVO": 0.0059, "UPNO": 0.0059, "AČÍT": 0.0059, "ASCI": 0.0059, "DMÍN": 0.0059,
+	"LACE": 0.0059, "ONÁZ": 0.0059, "NÉMP": 0.0059, "ROOT": 0.0059, "MÍTN": 0.0059,
+	"JIŽE": 0.0059, "TSYS": 0.0059, "ESMA": 0.0059, "KEŠE": 0.0059, "LADI": 0.0059,
+	"SÁŘI": 0.0059, "BĚŽN": 0.0059, "RÁVU": 0.0059, "ATSE": 0.0059, "DEKN": 0.0059,
+	"EROU": 0.0059, "MYSL": 0.0059, "LADĚ": 0.0059, "TALU": 0.0059, "ÍÚRO": 0.0059,
+	"AKTE": 0.0059, "BITŮ": 0.0059, "NÉNE": 0.0059, "NÍDE": 0.0059, "TAMI": 0.0059,
+	"EBAJ": 0.0059, "HOOD": 0.0059, "ÍVLA": 0.0059, "NAMA": 0.0059, "NAKK": 0.0059,
+	"ANÉP": 0.0059, "NÝMP": 0.0059, "TNOV": 0.0059, "LOCA": 0.0059, "HÁNÍ": 0.0059,
+	"JNÉH": 0.0059, "ISTÁ": 0.0059, "IDEO": 0.0059, "UMOŽ": 0.0059, "DNÍZ": 0.0059,
+	"ÉROZ": 0.0059, "MSKÉ": 0.0059, "VÝŠE": 0.0059, "ÁTOV": 0.0059, "EHES": 0.0059,
+	"DĚLI": 0.0059, "ÉQWE": 0.0059, "KÉUS": 0.0059, "ÉUSA": 0.0059, "TITE": 0.0057,
+	"ÝSLE": 0.0057, "ZORN": 0.0057, "ELKÝ": 0.0057, "CEJE": 0.0057, "JESP": 0.0057,
+	"RUKT": 0.0057, "SPRO": 0.0057, "ENAH": 0.0057, "EZAS": 0.0057, "LOKU": 0.0057,
+	"TNÁP": 0.0057, "NÁPŘ": 0.0057, "OVĚĎ": 0.0057, "TNÁH": 0.0057, "TAPR": 0.0057,
+	"OKEN": 0.0057, "DEKV": 0.0057, "NCHR": 0.0057, "HRON": 0.0057, "UTEČ": 0.0057,
+	"OZÍH": 0.0057, "RUPŘ": 0.0057, "ÍHES": 0.0057, "ČENÝ": 0.0057, "VOZO": 0.0057,
+	"OZOV": 0.0057, "ÁNÍU": 0.0057, "OVÉZ": 0.0057, "ÍVYP": 0.0057, "TONÁ": 0.0057,
+	"ČÁTK": 0.0057, "ITNE": 0.0057, "AZJE": 0.0057, "ÉARG": 0.0057, "DÁNY": 0.0057,
+	"DOPR": 0.0057, "ATJE": 0.0057, "NÍKU": 0.0057, "STEM": 0.0057, "SAMO": 0.0057,
+	"HOKO": 0.0057, "ORYS": 0.0057, "ÍCÍN": 0.0057, "ADBY": 0.0057, "MAJÍ": 0.0057,
+	"OINS": 0.0057, "NOVI": 0.0057, "ÁZVŮ": 0.0057, "ÉPOD": 0.0057, "NAZÁ": 0.0057,
+	"OBEZ": 0.0057, "NÝVÝ": 0.0057, "POLU": 0.0057, "EKÁN": 0.0057, "NOVO": 0.0057,
+	"AJSO": 0.0057, "IŽEX": 0.0057, "OVÁS": 0.0057, "UJEK": 0.0057, "BYLV": 0.0057,
+	"USTE": 0.0057, "ÝCHV": 0.0057, "HPOD": 0.0057, "ORPO": 0.0057, "TARÝ": 0.0057,
+	"SNÉH": 0.0057, "EIMP": 0.0057, "MĚNA": 0.0057, "ESKA": 0.0057, "SKAK": 0.0057,
+	"DOUC": 0.0057, "OJTE": 0.0057, "OVÝŘ": 0.0057, "ZJIŠ": 0.0057, "RYCH": 0.0057,
+	"OPOČ": 0.0057, "UVER": 0.0057, "UZAM": 0.0057, "ROJI": 0.0057, "NYNE": 0.0057,
+	"NÝTY": 0.0057, "EZKO": 0.0057, "EMÍS": 0.0057, "AJTY": 0.0057, "ÍČES": 0.0057,
+	"IDAT": 0.0057, "ATPR": 0.0057, "NADO": 0.0057, "AZIT": 0.0057, "TVŠE": 0.0057,
+	"ITST": 0.0057, "LÁDA": 0.0057, "DOWS": 0.0057, "RJEP": 0.0057, "TZNA": 0.0057,
+	"SKLÍ": 0.0057, "VÉČÍ": 0.0057, "PEVN": 0.0057, "POŘÁ": 0.0057, "OLŠT": 0.0057,
+	"ZŠTI": 0.0057, "ÍČKY": 0.0057, "NÁST": 0.0057, "SOBÍ": 0.0057, "NYNA": 0.0057,
+	"MFOR": 0.0057, "CTVÍ": 0.0057, "ĚCHP": 0.0057, "DEMO": 0.0057, "NSKO": 0.0057,
+	"KÉKR": 0.0057, "NAČA": 0.0057, "ÝMIS": 0.0057, "RUŽI": 0.0057, "AVDU": 0.0057,
+	"OZDĚ": 0.0057, "IKLÁ": 0.0057, "ÁTÍÚ": 0.0057, "TÍÚS": 0.0057, "KÉQW": 0.0057,
+	"TĚČE": 0.0057, "VATÝ": 0.0055, "IŽNÍ": 0.0055, "ŽENA": 0.0055, "UNIX": 0.0055,
+	"JERO": 0.0055, "ÁTJE": 0.0055, "SLAT": 0.0055, "ACÍA": 0.0055, "ITDO": 0.0055,
+	"RONA": 0.0055, "PRET": 0.0055, "ENAČ": 0.0055, "AKET": 0.0055, "POČÁ": 0.0055,
+	"ČÁTE": 0.0055, "TUNA": 0.0055, "ATDO": 0.0055, "FIKO": 0.0055, "CONF": 0.0055,
+	"NÍMU": 0.0055, "ATEK": 0.0055, "ÍTPR": 0.0055, "ENÝP": 0.0055, "YNCH": 0.0055,
+	"ONCI": 0.0055, "BUFF": 0.0055, "UFFE": 0.0055, "FFER": 0.0055, "TENO": 0.0055,
+	"SKUT": 0.0055, "TSEL": 0.0055, "ÝCER": 0.0055, "ZÍHO": 0.0055, "ARTU": 0.0055,
+	"NÝŘE": 0.0055, "SOUZ": 0.0055, "VATA": 0.0055, "ÝCHB": 0.0055, "YJAK": 0.0055,
+	"UJET": 0.0055, "VÝBĚ": 0.0055, "ÝBĚR": 0.0055, "WARU": 0.0055, "STRI": 0.0055,
+	"LIŽE": 0.0055, "ÁCEN": 0.0055, "ÁŘES": 0.0055, "VNÍA": 0.0055, "RACU": 0.0055,
+	"ACUJ": 0.0055, "OSIX": 0.0055, "ORYV": 0.0055, "ÁVOD": 0.0055, "VENS": 0.0055,
+	"ASYS": 0.0055, "ANEL": 0.0055, "ANOV": 0.0055, "NADB": 0.0055, "DBYT": 0.0055,
+	"AČEP": 0.0055, "ACHO": 0.0055, "GURO": 0.0055, "STÁH": 0.0055, "RANÉ": 0.0055,
+	"ATKL": 0.0055, "MZAD": 0.0055, "OZÍJ": 0.0055, "ŮPRO": 0.0055, "KUPO": 0.0055,
+	"LZEA": 0.0055, "NÝFO": 0.0055, "ŘITP": 0.0055, "YPŘI": 0.0055, "LPŘE": 0.0055,
+	"ABYS": 0.0055, "ÍNÁS": 0.0055, "KUST": 0.0055, "EVES": 0.0055, "PATI": 0.0055,
+	"UJEC": 0.0055, "VOLÍ": 0.0055, "KCHY": 0.0055, "NOPR": 0.0055, "RKOU": 0.0055,
+	"SEMA": 0.0055, "OMKA": 0.0055, "WIND": 0.0055, "VOJT": 0.0055, "YNUT": 0.0055,
+	"JTEN": 0.0055, "OTEN": 0.0055, "YLPŘ": 0.0055, "ÍZNÁ": 0.0055, "UVNI": 0.0055,
+	"RUŠI": 0.0055, "ANON": 0.0055, "YVOL": 0.0055, "NÍSL": 0.0055, "OUNA": 0.0055,
+	"NOSO": 0.0055, "OODK": 0.0055, "NÍVL": 0.0055, "LAVN": 0.0055, "AVNÍ": 0.0055,
+	"NOVÍ": 0.0055, "MAĎA": 0.0055, "AĎAR": 0.0055, "UDEO": 0.0055, "OVÝO": 0.0055,
+	"STYL": 0.0055, "AFIC": 0.0055, "EKPO": 0.0055, "SOUS": 0.0055, "RANA": 0.0055,
+	"LOHA": 0.0055, "VOKA": 0.0055, "NDSK": 0.0055, "ROZE": 0.0055, "PLŇO": 0.0055,
+	"LŇOV": 0.0055, "SKÝD": 0.0055, "KÉSU": 0.0055, "KLON": 0.0055, "TION": 0.0053,
+	"ATUS": 0.0053, "ŽENO": 0.0053, "IXOV": 0.0053, "XOVÉ": 0.0053, "ZEUR": 0.0053,
+	"HODU": 0.0053, "TABE": 0.0053, "EOVĚ": 0.0053, "TCHY": 0.0053, "ÝSTA": 0.0053,
+	"OČÁT": 0.0053, "ANEP": 0.0053, "NÝST": 0.0053, "NÍMP": 0.0053, "USPO": 0.0053,
+	"NÍUŽ": 0.0053, "KACI": 0.0053, "ACIS": 0.0053, "DCHO": 0.0053, "LENÁ": 0.0053,
+	"HÁZE": 0.0053, "KTIC": 0.0053, "DOBA": 0.0053, "ASHO": 0.0053, "SOUR": 0.0053,
+	"KORE": 0.0053, "VSKÁ": 0.0053, "PNÍS": 0.0053, "RZIV": 0.0053, "TNÝZ": 0.0053,
+	"MOVÉ": 0.0053, "ITAD": 0.0053, "JEVÝ": 0.0053, "COVN": 0.0053, "SEKV": 0.0053,
+	"VENC": 0.0053, "ŮNEB": 0.0053, "NAVS": 0.0053, "VREŽ": 0.0053, "ŘEVO": 0.0053,
+	"ENTÁ": 0.0053, "SNÝS": 0.0053, "ÍSEP": 0.0053, "SEPR": 0.0053, "OUPN": 0.0053,
+	"OPOS": 0.0053, "TETO": 0.0053, "OSIG": 0.0053, "ZOVÉ": 0.0053, "ÉHON": 0.0053,
+	"OLUS": 0.0053, "USTA": 0.0053, "ÁSTE": 0.0053, "VSKÝ": 0.0053, "ENAI": 0.0053,
+	"TORE": 0.0053, "ŽEXI": 0.0053, "TNÍC": 0.0053, "RIMÁ": 0.0053, "IMÁR": 0.0053,
+	"MÁRN": 0.0053, "NONA": 0.0053, "TUPS": 0.0053, "OMPA": 0.0053, "VĚNÝ": 0.0053,
+	"ENÍI": 0.0053, "LOPR": 0.0053, "RVKU": 0.0053, "UDEN": 0.0053, "VNÍP": 0.0053,
+	"KAKU": 0.0053, "DUCH": 0.0053, "EMUS": 0.0053, "OUCH": 0.0053, "PODŘ": 0.0053,
+	"EPOŠ": 0.0053, "ĚNÍN": 0.0053, "ETAD": 0.0053, "ÉMPŘ": 0.0053, "TAHU": 0.0053,
+	"ŮKTE": 0.0053, "ÁNOZ": 0.0053, "TERÁ": 0.0053, "ÉHOZ": 0.0053, "UŠIT": 0.0053,
+	"PPRO": 0.0053, "STOU": 0.0053, "JENÉ": 0.0053, "IZÍS": 0.0053, "CKÉH": 0.0053,
+	"NÍZE": 0.0053, "ÍSLU": 0.0053, "NDOW": 0.0053, "NAKZ": 0.0053, "ŘÍPA": 0.0053,
+	"ÍPAD": 0.0053, "LEJE": 0.0053, "AROZ": 0.0053, "ÁNPO": 0.0053, "REZE": 0.0053,
+	"ÍMPO": 0.0053, "PKCS": 0.0053, "JÍMA": 0.0053, "UBEZ": 0.0053, "AJŠT": 0.0053,
+	"IJŠT": 0.0053, "ANŠT": 0.0053, "ŠPAN": 0.0053, "PANĚ": 0.0053, "ANĚL": 0.0053,
+	"OVĚK": 0.0053, "NGŠT": 0.0053, "YCHL": 0.0053, "ONVE": 0.0053, "ERSK": 0.0053,
+	"ÍNSK": 0.0053, "RDSK": 0.0053, "AMPO": 0.0053, "WARC": 0.0053, "SHIF": 0.0053,
+	"HIFT": 0.0053, "ÉSUN": 0.0053, "DKLO": 0.0053, "RTUG": 0.0051, "ENOU": 0.0051,
+	"LEPR": 0.0051, "NSOU": 0.0051, "NESO": 0.0051, "APNU": 0.0051, "ATPŘ": 0.0051,
+	"NALÉ": 0.0051, "SÁŘP": 0.0051, "KÁTE": 0.0051, "ŘÍTS": 0.0051, "NÍPA": 0.0051,
+	"LNÍV": 0.0051, "VADR": 0.0051, "ALEP": 0.0051, "ETRU": 0.0051, "OTAV": 0.0051,
+	"EHOD": 0.0051, "IPOD": 0.0051, "LOPO": 0.0051, "ROZL": 0.0051, "DÁVA": 0.0051,
+	"ROMA": 0.0051, "USES": 0.0051, "ONIZ": 0.0051, "TRAC": 0.0051, "AZPR": 0.0051,
+	"OKAC": 0.0051, "MKLÍ": 0.0051, "RÁTI": 0.0051, "TÉTO": 0.0051, "ŘESN": 0.0051,
+	"ÝLIM": 0.0051, "APOZ": 0.0051, "AVÝS": 0.0051, "MÁCH": 0.0051, "ZOVK": 0.0051,
+	"TŘÁD": 0.0051, "OVÝB": 0.0051, "EMPO": 0.0051, "EXTO": 0.0051, "EZAR": 0.0051,
+	"ZIVN": 0.0051, "NCOV": 0.0051, "EVÝC": 0.0051, "OPRV": 0.0051, "ESYM": 0.0051,
+	"ZVLÁ": 0.0051, "VOVA": 0.0051, "KÁZÁ": 0.0051, "ÚPLN": 0.0051, "NĚNÝ": 0.0051,
+	"ROJŮ": 0.0051, "ŘIOD": 0.0051, "LBÝT": 0.0051, "INEN": 0.0051, "ENYN": 0.0051,
+	"NOUS": 0.0051, "NEBE": 0.0051, "UZOB": 0.0051, "IPŘI": 0.0051, "TSIG": 0.0051,
+	"STIV": 0.0051, "HONÁ": 0.0051, "EZOB": 0.0051, "VYBÍ": 0.0051, "ÁPRO": 0.0051,
+	"EMĚL": 0.0051, "KOMB": 0.0051, "OMBI": 0.0051, "MBIN": 0.0051, "NEDĚ": 0.0051,
+	"OARG": 0.0051, "BYBY": 0.0051, "NNOU": 0.0051, "ABYL": 0.0051, "YLAN": 0.0051,
+	"ZAHR": 0.0051, "ROPR": 0.0051, "ŽBYL": 0.0051, "ÁRKO": 0.0051, "ENJA": 0.0051,
+	"JENO": 0.0051, "ENAK": 0.0051, "SEOD": 0.0051, "YNEJ": 0.0051, "IOVĚ": 0.0051,
+	"RÁTK": 0.0051, "ÍZÁZ": 0.0051, "AČSO": 0.0051, "TADA": 0.0051, "VĚŘI": 0.0051,
+	"OUČT": 0.0051, "NIZO": 0.0051, "DEPO": 0.0051, "NENT": 0.0051, "NÍIN": 0.0051,
+	"ESTÁ": 0.0051, "MZAK": 0.0051, "TAKÉ": 0.0051, "ÁSTÍ": 0.0051, "TJED": 0.0051,
+	"ZERY": 0.0051, "LENY": 0.0051, "IMAC": 0.0051, "TUSE": 0.0051, "UDOS": 0.0051,
+	"OKTE": 0.0051, "ELKÁ": 0.0051, "BOVY": 0.0051, "VPOL": 0.0051, "KOVO": 0.0051,
+	"EVED": 0.0051, "ORUZ": 0.0051, "ENÍŽ": 0.0051, "ÍTKA": 0.0051, "DEKO": 0.0051,
+	"AZYC": 0.0051, "IČTI": 0.0051, "VJAZ": 0.0051, "NÍFO": 0.0051, "KÓDP": 0.0051,
+	"POŠT": 0.0051, "KOAL": 0.0051, "ČETS": 0.0051, "ODEB": 0.0051, "DÁNS": 0.0051,
+	"LLOV": 0.0051, "EJEV": 0.0051, "KONV": 0.0051, "NVER": 0.0051, "RŠEN": 0.0051,
+	"ÍČJE": 0.0051, "NOZP": 0.0051, "EDLE": 0.0051, "BNÝC": 0.0051, "OLAR": 0.0051,
+	"VÁKL": 0.0051, "LONĚ": 0.0051, "ABEL": 0.0051, "BRIT": 0.0049, "NAOD": 0.0049,
+	"CACH": 0.0049, "ACHE": 0.0049, "ORUČ": 0.0049, "ÍCEN": 0.0049, "NOUN": 0.0049,
+	"UCHY": 0.0049, "LDAP": 0.0049, "READ": 0.0049, "ÍPOM": 0.0049, "TNÍS": 0.0049,
+	"HYBU": 0.0049, "OSTM": 0.0049, "ENÉS": 0.0049, "NÝPA": 0.0049, "ÝPAR": 0.0049,
+	"TYTO": 0.0049, "ENAZ": 0.0049, "ZENÁ": 0.0049, "MECH": 0.0049, "NÍMK": 0.0049,
+	"ÍNAT": 0.0049, "ONÁV": 0.0049, "DAVK": 0.0049, "POČT": 0.0049, "HŘÁD": 0.0049,
+	"EODD": 0.0049, "VŽDY": 0.0049, "NIKD": 0.0049, "ZENE": 0.0049, "AČVY": 0.0049,
+	"DNÁS": 0.0049, "BALE": 0.0049, "AZYP": 0.0049, "EJEP": 0.0049, "TLIŽ": 0.0049,
+	"NAKV": 0.0049, "ÁNPŘ": 0.0049, "ÍHOA": 0.0049, "ŽÍTP": 0.0049, "KVEN": 0.0049,
+	"ÁLOH": 0.0049, "VNÁN": 0.0049, "VPŘE": 0.0049, "ŘEČÍ": 0.0049, "AJÍS": 0.0049,
+	"TŠÍN": 0.0049, "ÝMIK": 0.0049, "SÍMZ": 0.0049, "KILL": 0.0049, "KUDP": 0.0049,
+	"NNÝC": 0.0049, "UČUJ": 0.0049, "DLEP": 0.0049, "TITS": 0.0049, "EALO": 0.0049,
+	"SLOZ": 0.0049, "SNEB": 0.0049, "NÍKE": 0.0049, "ÉMUS": 0.0049, "EBOD": 0.0049,
+	"KÁNA": 0.0049, "ECIÁ": 0.0049, "NSIG": 0.0049, "RUJI": 0.0049, "NAJI": 0.0049,
+	"ŘEČT": 0.0049, "EMAK": 0.0049, "ÍTAB": 0.0049, "ZAVO": 0.0049, "MPAT": 0.0049,
+	"EXPA": 0.0049, "ELKE": 0.0049, "LKEM": 0.0049, "AHRN": 0.0049, "ÁDOS": 0.0049,
+	"ITAP": 0.0049, "VÝŘÁ": 0.0049, "AČEV": 0.0049, "ŘIOV": 0.0049, "INDE": 0.0049,
+	"TAKO": 0.0049, "RIMA": 0.0049, "ČSOU": 0.0049, "ANAP": 0.0049, "EŠIT": 0.0049,
+	"ITJE": 0.0049, "LOŽT": 0.0049, "OŽTE": 0.0049, "IPRA": 0.0049, "NÉOD": 0.0049,
+	"NTAC": 0.0049, "VÁDĚ": 0.0049, "ÉHOB": 0.0049, "ÁNÍB": 0.0049, "NIST": 0.0049,
+	"DÍLE": 0.0049, "OVNA": 0.0049, "TAHO": 0.0049, "NOVE": 0.0049, "ROTE": 0.0049,
+	"ERÉJ": 0.0049, "DNOS": 0.0049, "SOUK": 0.0049, "VATD": 0.0049, "KUNA": 0.0049,
+	"ŘIZÍ": 0.0049, "INEB": 0.0049, "LOŽC": 0.0049, "OŽCE": 0.0049, "ORKL": 0.0049,
+	"DOBĚ": 0.0049, "UPLA": 0.0049, "ÉMAC": 0.0049, "KÁTŮ": 0.0049, "ELHÁ": 0.0049,
+	"LHÁN": 0.0049, "OSMI": 0.0049, "UŠTI": 0.0049, "URŠT": 0.0049, "ENŠT": 0.0049,
+	"ALŠT": 0.0049, "TUGA": 0.0049, "UGAL": 0.0049, "ŠVÉD": 0.0049, "ÝOBR": 0.0049,
+	"ÝPOM": 0.0049, "NÍZM": 0.0049, "RIOV": 0.0049, "UPOL": 0.0049, "HPŘE": 0.0049,
+	"ANDS": 0.0049, "ÉOST": 0.0049, "EMOK": 0.0049, "JSKÁ": 0.0049, "OGIN": 0.0049,
+	"KÝMI": 0.0049, "VESN": 0.0049, "SNIC": 0.0049, "INKA": 0.0049, "DIRS": 0.0049,
+	"ŘIRO": 0.0049, "ÍODK": 0.0049, "AILL": 0.0049, "ILLO": 0.0049, "OLEM": 0.0049,
+	"TVÝM": 0.0049, "TSKÁ": 0.0047, "KOLU": 0.0047, "HRAD": 0.0047, "VDAT": 0.0047,
+	"ŘENA": 0.0047, "ENÍC": 0.0047, "TODA": 0.0047, "ÁPRÁ": 0.0047, "BYMĚ": 0.0047,
+	"YMĚL": 0.0047, "IZAČ": 0.0047, "NÍME": 0.0047, "NUTO": 0.0047, "SESE": 0.0047,
+	"ALÉZ": 0.0047, "LÉZT": 0.0047, "VATJ": 0.0047, "VITS": 0.0047, "DONE": 0.0047,
+	"AVEK": 0.0047, "ZAKO": 0.0047, "DĚPO": 0.0047, "ASYN": 0.0047, "CEJA": 0.0047,
+	"ECHA": 0.0047, "TEKP": 0.0047, "LIZU": 0.0047, "JEAL": 0.0047, "ÉNOP": 0.0047,
+	"NÍTO": 0.0047, "CKÁC": 0.0047, "UTON": 0.0047, "PNÍC": 0.0047, "NAVÝ": 0.0047,
+	"LADN": 0.0047, "EBOA": 0.0047, "OZDÍ": 0.0047, "ZDÍL": 0.0047, "XTOV": 0.0047,
+	"ROČE": 0.0047, "NAHL": 0.0047, "ÝTZA": 0.0047, "ACEV": 0.0047, "LNÉP": 0.0047,
+	"NEPÁ": 0.0047, "ENPŘ": 0.0047, "OUZA": 0.0047, "MÉNĚ": 0.0047, "VYSK": 0.0047,
+	"NÍAR": 0.0047, "DEVY": 0.0047, "ENST": 0.0047, "VLÁŠ": 0.0047, "LÁŠT": 0.0047,
+	"ČTEC": 0.0047, "TUPR": 0.0047, "NACH": 0.0047, "MTOS": 0.0047, "ŘENE": 0.0047,
+	"KYPO": 0.0047, "NAŽÁ": 0.0047, "EZDR": 0.0047, "VATK": 0.0047, "ATST": 0.0047,
+	"SEZE": 0.0047, "HLÁS": 0.0047, "TNÍA": 0.0047, "SEŘA": 0.0047, "NÝNE": 0.0047,
+	"SCHO": 0.0047, "ROOD": 0.0047, "CÍPR": 0.0047, "KRIT": 0.0047, "ABSO": 0.0047,
+	"BSOL": 0.0047, "SOLU": 0.0047, "OLUT": 0.0047, "LUTN": 0.0047, "LÁNO": 0.0047,
+	"OUNE": 0.0047, "INAA": 0.0047, "KÁŽE": 0.0047, "TYNE": 0.0047, "ZPRO": 0.0047,
+	"VTOM": 0.0047, "ELNE": 0.0047, "ÉHOU": 0.0047, "ENSI": 0.0047, "SKÝA": 0.0047,
+	"ZERO": 0.0047, "RONT": 0.0047, "UUŽI": 0.0047, "ĚNNÝ": 0.0047, "KYPŘ": 0.0047,
+	"ENÁV": 0.0047, "EODK": 0.0047, "USTÍ": 0.0047, "XPAN": 0.0047, "OURA": 0.0047,
+	"RAČO": 0.0047, "JIŽB": 0.0047, "EZAV": 0.0047, "ZKOU": 0.0047, "ROSE": 0.0047,
+	"VIRT": 0.0047, "IRTU": 0.0047, "YNAS": 0.0047, "UZMĚ": 0.0047, "CHAR": 0.0047,
+	"ÁNEB": 0.0047, "REST": 0.0047, "ĚPRO": 0.0047, "NDEX": 0.0047, "ZÁME": 0.0047,
+	"ÁMEK": 0.0047, "MAPO": 0.0047, "NESH": 0.0047, "ÍARC": 0.0047, "UJEH": 0.0047,
+	"ESLÁ": 0.0047, "ESYN": 0.0047, "NANÁ": 0.0047, "CHÁV": 0.0047, "ERÉS": 0.0047,
+	"CHPA": 0.0047, "ÍMNE": 0.0047, "ÉHOV": 0.0047, "ESCH": 0.0047, "AURI": 0.0047,
+	"RESN": 0.0047, "SUNO": 0.0047, "IVNĚ": 0.0047, "TLIV": 0.0047, "ACEM": 0.0047,
+	"TEVÍ": 0.0047, "EMNA": 0.0047, "GIST": 0.0047, "ŘIJÍ": 0.0047, "DNÝM": 0.0047,
+	"AJTU": 0.0047, "UNIK": 0.0047, "AVÍS": 0.0047, "RYNE": 0.0047, "NÁVE": 0.0047,
+	"LÍČS": 0.0047, "APOL": 0.0047, "DESE": 0.0047, "OLIK": 0.0047, "REXI": 0.0047,
+	"KZNA": 0.0047, "HARD": 0.0047, "IČNÍ": 0.0047, "SMIČ": 0.0047, "MIČK": 0.0047,
+	"INŠT": 0.0047, "MONG": 0.0047, "ZYCE": 0.0047, "VÝOB": 0.0047, "ÝBAL": 0.0047,
+	"UDSO": 0.0047, "VLIV": 0.0047, "ODLO": 0.0047, "CÍSE": 0.0047, "CENÍ": 0.0047,
+	"LNÉM": 0.0047, "SÉRI": 0.0047, "ÉRIO": 0.0047, "OTYŠ": 0.0047, "LEMA": 0.0047,
+	"OLEČ": 0.0047, "ĎARS": 0.0047, "TENE": 0.0047, "ÚČTU": 0.0047, "AMAT": 0.0047,
+	"OAKT": 0.0047, "AVŠE": 0.0047, "ĚLIT": 0.0047, "ESNI": 0.0047, "OSHE": 0.0047,
+	"IROZ": 0.0047, "SHAD": 0.0047, "DOLA": 0.0047, "SKÉL": 0.0047, "ŠSKÉ": 0.0047,
+	"MIKL": 0.0047, "ONĚN": 0.0047, "KALI": 0.0046, "ICHY": 0.0046, "ENEŽ": 0.0046,
+	"VÁPR": 0.0046, "TNEL": 0.0046, "TJEP": 0.0046, "INIM": 0.0046, "OŽIT": 0.0046,
+	"DAVE": 0.0046, "SAŽE": 0.0046, "TZPR": 0.0046, "CIFI": 0.0046, "TERA": 0.0046,
+	"EALE": 0.0046, "MÉNE": 0.0046, "ÉNEM": 0.0046, "ETPO": 0.0046, "ELES": 0.0046,
+	"VYBE": 0.0046, "CHŘÁ": 0.0046, "NÍJM": 0.0046, "UPUP": 0.0046, "AZYV": 0.0046,
+	"ORZA": 0.0046, "YZES": 0.0046, "NAVE": 0.0046, "ČERP": 0.0046, "DNOZ": 0.0046,
+	"NOZN": 0.0046, "NÝRE": 0.0046, "OVÉV": 0.0046, "VYBA": 0.0046, "JEJM": 0.0046,
+	"NĚNE": 0.0046, "VIZT": 0.0046, "IZTE": 0.0046, "NEJE": 0.0046, "ENÍR": 0.0046,
+	"NÍMV": 0.0046, "JESY": 0.0046, "RNÍM": 0.0046, "ÁŠTN": 0.0046, "PŘÍM": 0.0046,
+	"NÁVA": 0.0046, "ATOM": 0.0046, "KOME": 0.0046, "ÍŽÁD": 0.0046, "OBYČ": 0.0046,
+	"BYČE": 0.0046, "ÁHNO": 0.0046, "HNOU": 0.0046, "ÝCHD": 0.0046, "TATI": 0.0046,
+	"ÉINF": 0.0046, "DPŘÍ": 0.0046, "DNÝN": 0.0046, "ECHP": 0.0046, "MENŠ": 0.0046,
+	"ENTE": 0.0046, "ODEM": 0.0046, "HNYP": 0.0046, "SESK": 0.0046, "NÁSE": 0.0046,
+	"KÝAD": 0.0046, "OTOV": 0.0046, "VATB": 0.0046, "TBAL": 0.0046, "OHOD": 0.0046,
+	"ŽNEN": 0.0046, "TEZA": 0.0046, "MOVÝ": 0.0046, "NÁCH": 0.0046, "CKÝC": 0.0046,
+	"YPSÁ": 0.0046, "TYPR": 0.0046, "ĚNNO": 0.0046, "EFIL": 0.0046, "ATIB": 0.0046,
+	"TIBI": 0.0046, "IBIL": 0.0046, "NNÉP": 0.0046, "ZKRÁ": 0.0046, "RBYL": 0.0046,
+	"JEME": 0.0046, "EKVI": 0.0046, "NÁMÉ": 0.0046, "KCES": 0.0046, "UDEZ": 0.0046,
+	"EKNA": 0.0046, "ROSO": 0.0046, "NÍOP": 0.0046, "KOUŠ": 0.0046, "RTUÁ": 0.0046,
+	"OSUN": 0.0046, "NÁNE": 0.0046, "NÍAK": 0.0046, "LAVÍ": 0.0046, "ÍKEM": 0.0046,
+	"TNOU": 0.0046, "PONU": 0.0046, "ZÍCH": 0.0046, "OMPO": 0.0046, "VHOD": 0.0046,
+	"STEP": 0.0046, "RONÁ": 0.0046, "ODAT": 0.0046, "AČEA": 0.0046, "YŽJE": 0.0046,
+	"ŠTNÍ": 0.0046, "ORYA": 0.0046, "EVÍR": 0.0046, "REGI": 0.0046, "EGIS": 0.0046,
+	"SCHÁ": 0.0046, "ENNA": 0.0046, "NÍČÍ": 0.0046, "CEBA": 0.0046, "ÍKOP": 0.0046,
+	"SUPO": 0.0046, "ALON": 0.0046, "PONY": 0.0046, "OSOB": 0.0046, "ÍPAM": 0.0046,
+	"ZAPA": 0.0046, "STÍP": 0.0046, "OREX": 0.0046, "ERÉM": 0.0046, "ASEZ": 0.0046,
+	"FAIL": 0.0046, "ÍKÓD": 0.0046, "CHÁN": 0.0046, "JOVA": 0.0046, "TARO": 0.0046,
+	"VŠTI": 0.0046, "ORŠT": 0.0046, "AMIL": 0.0046, "ÍFOR": 0.0046, "ÍČEP": 0.0046,
+	"UODD": 0.0046, "ÍCHV": 0.0046, "DPON": 0.0046, "ESÍT": 0.0046, "ROLA": 0.0046,
+	"ÚČEL": 0.0046, "KKTE": 0.0046, "POJÍ": 0.0046, "RSKÁ": 0.0046, "SKÉO": 0.0046,
+	"MOKR": 0.0046, "NSKÝ": 0.0046, "DEMK": 0.0046, "EDŮV": 0.0046, "FERO": 0.0046,
+	"HADO": 0.0046, "ADOW": 0.0046, "JÁDR": 0.0046, "ČETB": 0.0046, "HHHH": 0.0046,
+	"ÝDOL": 0.0046, "BELÁ": 0.0046, "ELÁT": 0.0046, "EUKO": 0.0044, "YLOU": 0.0044,
+	"LONE": 0.0044, "VANĚ": 0.0044, "ENAT": 0.0044, "ELEP": 0.0044, "NTEG": 0.0044,
+	"NOUH": 0.0044, "LNEN": 0.0044, "NÉMS": 0.0044, "ÍJEN": 0.0044, "VÁZA": 0.0044,
+	"EMJE": 0.0044, "KCEP": 0.0044, "UKTU": 0.0044, "KATA": 0.0044, "ÁTUN": 0.0044,
+	"TŮNA": 0.0044, "ZEČÍ": 0.0044, "EČNÁ": 0.0044, "VÁNP": 0.0044, "YBÍR": 0.0044,
+	"FILT": 0.0044, "VALI": 0.0044, "ESNE": 0.0044, "ETEČ": 0.0044, "KBYL": 0.0044,
+	"ŮPŘE": 0.0044, "YBER": 0.0044, "MŘÁD": 0.0044, "EHOP": 0.0044, "ŽDÉM": 0.0044,
+	"RUPO": 0.0044, "ÍSTR": 0.0044, "OURY": 0.0044, "CHNE": 0.0044, "ZARO": 0.0044,
+	"VNÁZ": 0.0044, "IJEV": 0.0044, "NUJE": 0.0044, "ĚNÍV": 0.0044, "SMYČ": 0.0044,
+	"UZEZ": 0.0044, "ERPÁ": 0.0044, "RPÁN": 0.0044, "ÁMÝP": 0.0044, "ADIC": 0.0044,
+	"OJEJ": 0.0044, "OVÝR": 0.0044, "ONCO": 0.0044, "ŘÍDA": 0.0044, "CÍPŘ": 0.0044,
+	"ESEK": 0.0044, "ORPŘ": 0.0044, "RAVU": 0.0044, "YTNU": 0.0044, "AKOU": 0.0044,
+	"TOSY": 0.0044, "TÁŘE": 0.0044, "YČEJ": 0.0044, "ČEJN": 0.0044, "BÝTO": 0.0044,
+	"CHZN": 0.0044, "BUTU": 0.0044, "JENP": 0.0044, "ÍTEN": 0.0044, "DNÉZ": 0.0044,
+	"EZNE": 0.0044, "NETE": 0.0044, "VESO": 0.0044, "TIKA": 0.0044, "PRIO": 0.0044,
+	"RIOR": 0.0044, "IORI": 0.0044, "NYPR": 0.0044, "AJEJ": 0.0044, "IVYP": 0.0044,
+	"TJEN": 0.0044, "ATAL": 0.0044, "LOHU": 0.0044, "IŽNE": 0.0044, "ODUJ": 0.0044,
+	"INAJ": 0.0044, "NOUK": 0.0044, "RÉHO": 0.0044, "EŘET": 0.0044, "ONAN": 0.0044,
+	"ÝCHÚ": 0.0044, "JECH": 0.0044, "ROZÁ": 0.0044, "MNOŽ": 0.0044, "EDON": 0.0044,
+	"ITSE": 0.0044, "TOBS": 0.0044, "ÍÚLO": 0.0044, "JŠÍN": 0.0044, "PŘEH": 0.0044,
+	"UVOL": 0.0044, "STÁL": 0.0044, "TÁLE": 0.0044, "OVÝA": 0.0044, "BÝTV": 0.0044,
+	"ÍPOS": 0.0044, "ATEČ": 0.0044, "CHSE": 0.0044, "PLEM": 0.0044, "ADĚN": 0.0044,
+	"POSU": 0.0044, "OZÁZ": 0.0044, "SITÁ": 0.0044, "LYNA": 0.0044, "CHRÁ": 0.0044,
+	"ENÍH": 0.0044, "ÁDÁN": 0.0044, "NAMV": 0.0044, "LÍKN": 0.0044, "STPO": 0.0044,
+	"DNÉS": 0.0044, "LAPŘ": 0.0044, "GZIP": 0.0044, "JEŠT": 0.0044, "EŠTĚ": 0.0044,
+	"KODE": 0.0044, "ÉBEZ": 0.0044, "TSEZ": 0.0044, "TNÉH": 0.0044, "ÍČÁS": 0.0044,
+	"MBAL": 0.0044, "SDÍL": 0.0044, "LNÝC": 0.0044, "DANO": 0.0044, "TOUP": 0.0044,
+	"IZNA": 0.0044, "ILSK": 0.0044, "TROZ": 0.0044, "TAPO": 0.0044, "IJÍM": 0.0044,
+	"ŘEVE": 0.0044, "PISE": 0.0044, "MPOD": 0.0044, "OHES": 0.0044, "ÉPŘÍ": 0.0044,
+	"ÉNON": 0.0044, "VÁHO": 0.0044, "ACÍO": 0.0044, "IŠTI": 0.0044, "ATŠT": 0.0044,
+	"ŘEDO": 0.0044, "RAND": 0.0044, "USTR": 0.0044, "OSTS": 0.0044, "VTAR": 0.0044,
+	"PENP": 0.0044, "ENPG": 0.0044, "NPGP": 0.0044, "CEPŘ": 0.0044, "TAKD": 0.0044,
+	"KÓDN": 0.0044, "PROÚ": 0.0044, "NÁME": 0.0044, "SKÝC": 0.0044, "NERU": 0.0044,
+	"NÁHL": 0.0044, "ÍŘKA": 0.0044, "KÉOS": 0.0044, "AMSK": 0.0044, "ÁLSK": 0.0044,
+	"LOTY": 0.0044, "POLS": 0.0044, "GALS": 0.0044, "KÉBR": 0.0044, "BYSE": 0.0044,
+	"NKCI": 0.0044, "KÉID": 0.0044, "ETES": 0.0044, "TEHE": 0.0044, "NITP": 0.0044,
+	"TÝCH": 0.0044, "VEDL": 0.0044, "VRAC": 0.0044, "IČKY": 0.0044, "EKAŽ": 0.0044,
+	"KÉLA": 0.0044, "SKÉU": 0.0044, "ÍKUN": 0.0044, "UČEN": 0.0042, "ŽESE": 0.0042,
+	"UPOZ": 0.0042, "YUŽI": 0.0042, "TOID": 0.0042, "SOUH": 0.0042, "TOME": 0.0042,
+	"NAVÁ": 0.0042, "NĚTE": 0.0042, "TIST": 0.0042, "TNAM": 0.0042, "DEKZ": 0.0042,
+	"AHRÁ": 0.0042, "PÁRO": 0.0042, "LZEČ": 0.0042, "ODEL": 0.0042, "ECIF": 0.0042,
+	"ALIT": 0.0042, "MULT": 0.0042, "ULTI": 0.0042, "TNEP": 0.0042, "UJEI": 0.0042,
+	"NÉUR": 0.0042, "DNOD": 0.0042, "JAKJ": 0.0042, "VÁDÍ": 0.0042, "OVÝL": 0.0042,
+	"DOUV": 0.0042, "BERE": 0.0042, "YPRÁ": 0.0042, "ÍREG": 0.0042, "KETY": 0.0042,
+	"NYŽÁ": 0.0042, "EXTE": 0.0042, "DYNE": 0.0042, "SKÁS": 0.0042, "BJEV": 0.0042,
+	"VYČE": 0.0042, "YČER": 0.0042, "VNĚŽ": 0.0042, "AZAČ": 0.0042, "ĚTNÝ": 0.0042,
+	"NESE": 0.0042, "MZNA": 0.0042, "CAPE": 0.0042, "RPŘE": 0.0042, "XOVÝ": 0.0042,
+	"ASEL": 0.0042, "ASTE": 0.0042, "ŽÍTJ": 0.0042, "PROR": 0.0042, "NÁLŮ": 0.0042,
+	"ÁZVE": 0.0042, "ACHÁ": 0.0042, "MENN": 0.0042, "EMEZ": 0.0042, "TYPŘ": 0.0042,
+	"EVYB": 0.0042, "VSYS": 0.0042, "OPNO": 0.0042, "OZAŘ": 0.0042, "NÉVE": 0.0042,
+	"RITA": 0.0042, "ÉHOČ": 0.0042, "ANÉS": 0.0042, "ORŮV": 0.0042, "ŠESE": 0.0042,
+	"MNÁZ": 0.0042, "IDÁV": 0.0042, "PORN": 0.0042, "OODS": 0.0042, "DATP": 0.0042,
+	"ÚČTE": 0.0042, "YLVY": 0.0042, "LIKT": 0.0042, "IDLA": 0.0042, "EJNE": 0.0042,
+	"VÉŘÁ": 0.0042, "ORBY": 0.0042, "ŽNÉO": 0.0042, "NORM": 0.0042, "VUPO": 0.0042,
+	"ŠENA": 0.0042, "SBĚR": 0.0042, "BĚRN": 0.0042, "ZAHO": 0.0042, "OCÍL": 0.0042,
+	"OŽÁD": 0.0042, "ZAMÍ": 0.0042, "ENJE": 0.0042, "EVPR": 0.0042, "TNÁS": 0.0042,
+	"ENED": 0.0042, "ŮSOU": 0.0042, "ÍAKT": 0.0042, "VÉSO": 0.0042, "ONFL": 0.0042,
+	"NFLI": 0.0042, "YLYN": 0.0042, "ÍZÁV": 0.0042, "ALOZ": 0.0042, "ČEKA": 0.0042,
+	"RUČN": 0.0042, "AMČE": 0.0042, "MČEN": 0.0042, "EZAM": 0.0042, "OVNO": 0.0042,
+	"HOBA": 0.0042, "NOPŘ": 0.0042, "DENO": 0.0042, "YLOP": 0.0042, "TIVO": 0.0042,
+	"ŘIDA": 0.0042, "ASLO": 0.0042, "EŠIF": 0.0042, "ÍBUD": 0.0042, "SKÝM": 0.0042,
+	"ÁNÍI": 0.0042, "NAKJ": 0.0042, "SOUN": 0.0042, "KAJE": 0.0042, "UMER": 0.0042,
+	"AČES": 0.0042, "LÉHO": 0.0042, "ÍMSO": 0.0042, "ESÍL": 0.0042, "AZUN": 0.0042,
+	"ÁPOR": 0.0042, "OZÍS": 0.0042, "TOSO": 0.0042, "ÁNYP": 0.0042, "NYJE": 0.0042,
+	"VPŘÍ": 0.0042, "AČPO": 0.0042, "EBOK": 0.0042, "ELAT": 0.0042, "VAZK": 0.0042,
+	"ÍPOK": 0.0042, "ARDW": 0.0042, "RDWA": 0.0042, "DWAR": 0.0042, "EZAH": 0.0042,
+	"ÍNEL": 0.0042, "VPOŘ": 0.0042, "HŠTI": 0.0042, "VĚKÁ": 0.0042, "EČTI": 0.0042,
+	"EJŠT": 0.0042, "JAPO": 0.0042, "APON": 0.0042, "FONT": 0.0042, "ETRI": 0.0042,
+	"NOTE": 0.0042, "RECK": 0.0042, "UPOČ": 0.0042, "ETPŘ": 0.0042, "SÍTK": 0.0042,
+	"KNEB": 0.0042, "ČETD": 0.0042, "DĚNÉ": 0.0042, "AJSK": 0.0042, "DENÉ": 0.0042,
+	"MSKÁ": 0.0042, "PONS": 0.0042, "VÉDS": 0.0042, "ÉDSK": 0.0042, "STÍN": 0.0042,
+	"SLEM": 0.0042, "AKOD": 0.0042, "METI": 0.0042, "LÍČB": 0.0042, "ŽÁDA": 0.0042,
+	"VBUD": 0.0042, "KUSÍ": 0.0042, "UPOS": 0.0042, "UKAŽ": 0.0042, "ITÍV": 0.0042,
+	"ÁŘEM": 0.0042, "OSPO": 0.0042, "ÍSHE": 0.0042, "ÉMEZ": 0.0042, "KÝDO": 0.0042,
+	"SKÉF": 0.0042, "URDS": 0.0042, "LAND": 0.0040, "ÁNIE": 0.0040, "ŽNÍK": 0.0040,
+	"AODS": 0.0040, "ZDNÁ": 0.0040, "NTPR": 0.0040, "YNEN": 0.0040, "NUTA": 0.0040,
+	"MSTA": 0.0040, "OINT": 0.0040, "AVÁZ": 0.0040, "NEOV": 0.0040, "STNA": 0.0040,
+	"EINT": 0.0040, "LEDA": 0.0040, "SÍŤO": 0.0040, "ÍŤOV": 0.0040, "UŽBY": 0.0040,
+	"OTAJ": 0.0040, "AKOM": 0.0040, "VANE": 0.0040, "VÍDÁ": 0.0040, "AVDĚ": 0.0040,
+	"VDĚP": 0.0040, "ROVO": 0.0040, "TNÉČ": 0.0040, "ELÉČ": 0.0040, "LÉČÍ": 0.0040,
+	"POVI": 0.0040, "DENZ": 0.0040, "ENOZ": 0.0040, "TFOR": 0.0040, "EZPŘ": 0.0040,
+	"VÝLI": 0.0040, "ŮVYP": 0.0040, "UPUN": 0.0040, "YHOV": 0.0040, "OVUJ": 0.0040,
+	"EVZO": 0.0040, "OČIT": 0.0040, "BÝTA": 0.0040, "STOZ": 0.0040, "TUPD": 0.0040,
+	"RNÍC": 0.0040, "ÁNKA": 0.0040, "AVEL": 0.0040, "BEZA": 0.0040, "ČVYŽ": 0.0040,
+	"HADR": 0.0040, "MENU": 0.0040, "NÝOB": 0.0040, "BAVE": 0.0040, "ÁTEP": 0.0040,
+	"YSKY": 0.0040, "RUSO": 0.0040, "TNÝA": 0.0040, "NÍUR": 0.0040, "ABUD": 0.0040,
+	"YBUD": 0.0040, "UZNA": 0.0040, "ŘEDE": 0.0040, "OJES": 0.0040, "TKON": 0.0040,
+	"KONA": 0.0040, "KAZP": 0.0040, "VÁPO": 0.0040, "ÁVER": 0.0040, "ASEN": 0.0040,
+	"KOPR": 0.0040, "CENÁ": 0.0040, "ZEMS": 0.0040, "YBAZ": 0.0040, "ODOS": 0.0040,
+	"NUTN": 0.0040, "IKÓD": 0.0040, "PŘII": 0.0040, "TUŽI": 0.0040, "NÁLE": 0.0040,
+	"ROMP": 0.0040, "CESŮ": 0.0040, "ÝSIG": 0.0040, "PATŘ": 0.0040, "ATŘÍ": 0.0040,
+	"KRES": 0.0040, "IDSK": 0.0040, "EDKY": 0.0040, "RODI": 0.0040, "HOPN": 0.0040,
+	"KÓDE": 0.0040, "OREC": 0.0040, "OVÉP": 0.0040, "EOPR": 0.0040, "TNÁZ": 0.0040,
+	"ETOP": 0.0040, "ÁSKU": 0.0040, "ÍPRÁ": 0.0040, "DNÝS": 0.0040, "AŠEH": 0.0040,
+	"NEMĚ": 0.0040, "OLEB": 0.0040, "STET": 0.0040, "CÍTA": 0.0040, "ILTR": 0.0040,
+	"NYOB": 0.0040, "OZÍC": 0.0040, "REKV": 0.0040, "NÉVY": 0.0040, "NYNÍ": 0.0040,
+	"OSTB": 0.0040, "ETPR": 0.0040, "ÍČOV": 0.0040, "RVEK": 0.0040, "ZÁNO": 0.0040,
+	"ÁSEN": 0.0040, "RNIC": 0.0040, "CINE": 0.0040, "HOZE": 0.0040, "CONT": 0.0040,
+	"EEXP": 0.0040, "ŘENÁ": 0.0040, "HRÁV": 0.0040, "AMÍT": 0.0040, "EPOT": 0.0040,
+	"EXTP": 0.0040, "LIJS": 0.0040, "DŘÍZ": 0.0040, "ZBYT": 0.0040, "BRAT": 0.0040,
+	"NIKA": 0.0040, "TVER": 0.0040, "FLIK": 0.0040, "ŘÍTP": 0.0040, "ĚŘIT": 0.0040,
+	"OMÍS": 0.0040, "BOVO": 0.0040, "OSEV": 0.0040, "TOBA": 0.0040, "OVÝT": 0.0040,
+	"REFE": 0.0040, "EFER": 0.0040, "SEJE": 0.0040, "ŘIZA": 0.0040, "TĚNE": 0.0040,
+	"NAČK": 0.0040, "LOUŽ": 0.0040, "TITA": 0.0040, "HÁVÁ": 0.0040, "ÉJSO": 0.0040,
+	"KADR": 0.0040, "NASL": 0.0040, "PISP": 0.0040, "NTIT": 0.0040, "ÓDEM": 0.0040,
+	"UPNÁ": 0.0040, "ÁDÉL": 0.0040, "NPOU": 0.0040, "OPIE": 0.0040, "ÍSYM": 0.0040,
+	"BYPŘ": 0.0040, "NÍZP": 0.0040, "CKÝM": 0.0040, "AKOB": 0.0040, "OVÉR": 0.0040,
+	"VĚDI": 0.0040, "EUMÍ": 0.0040, "YROV": 0.0040, "JETE": 0.0040, "AVÝC": 0.0040,
+	"NĚNÉ": 0.0040, "THES": 0.0040, "ÉMSE": 0.0040, "ETNA": 0.0040, "FUNG": 0.0040,
+	"UNGU": 0.0040, "MĚNU": 0.0040, "DOVĚ": 0.0040, "ITAL": 0.0040, "ÓDVJ": 0.0040,
+	"DVJA": 0.0040, "VRCH": 0.0040, "ENTR": 0.0040, "TOGR": 0.0040, "OVLI": 0.0040,
+	"ZICE": 0.0040, "INNÉ": 0.0040, "ČERN": 0.0040, "NKCÍ": 0.0040, "ENÉP": 0.0040,
+	"ROZA": 0.0040, "GSTR": 0.0040, "EAME": 0.0040, "OZLO": 0.0040, "ZLOŽ": 0.0040,
+	"SPOS": 0.0040, "POCH": 0.0040, "ÉUŽI": 0.0040, "INYN": 0.0040, "RAVE": 0.0040,
+	"ÍMTO": 0.0040, "TŘET": 0.0040, "ZMÍN": 0.0040, "ÉPOL": 0.0040, "ŘITZ": 0.0040,
+	"YOST": 0.0040, "ITÁN": 0.0040, "DNÍS": 0.0040, "EJSK": 0.0040, "KÁLI": 0.0040,
+	"ECKO": 0.0040, "TYŠS": 0.0040, "YŠSK": 0.0040, "UREC": 0.0040, "ENPO": 0.0040,
+	"AHES": 0.0040, "RITI": 0.0040, "ITIC": 0.0040, "DPOS": 0.0040, "PAMA": 0.0040,
+	"OKAČ": 0.0040, "RUKL": 0.0040, "ZERA": 0.0040, "LBYS": 0.0040, "ROKA": 0.0040,
+	"AUŽI": 0.0040, "VANÍ": 0.0040, "ČETO": 0.0040, "VOUK": 0.0040, "ÉÚRO": 0.0040,
+	"ÉALT": 0.0040, "CHÚČ": 0.0040, "HÚČT": 0.0040, "RUŠÍ": 0.0040, "JEŘÁ": 0.0040,
+	"HOUB": 0.0040, "ETBA": 0.0040, "ZDĚL": 0.0040, "KÉAL": 0.0040, "TINK": 0.0040,
+	"SKÉT": 0.0040, "ÉNOB": 0.0040, "ISTA": 0.0038, "TATU": 0.0038, "ENON": 0.0038,
+	"ASER": 0.0038, "TPRÁ": 0.0038, "OVÝV": 0.0038, "ECNE": 0.0038, "UOBS": 0.0038,
+	"ŮŽEZ": 0.0038, "WORD": 0.0038, "ČNÍM": 0.0038, "KÁTJ": 0.0038, "KUSI": 0.0038,
+	"VERO": 0.0038, "OCER": 0.0038, "EZAK": 0.0038, "ROTI": 0.0038, "RETO": 0.0038,
+	"HOUŽ": 0.0038, "LATS": 0.0038, "NIMÁ": 0.0038, "IZPR": 0.0038, "EKÓD": 0.0038,
+	"CÍJM": 0.0038, "TNÝS": 0.0038, "OBEN": 0.0038, "APRÁ": 0.0038, "NAKP": 0.0038,
+	"ZUJÍ": 0.0038, "EJÍC": 0.0038, "AVSO": 0.0038, "VAZE": 0.0038, "PROŠ": 0.0038,
+	"ČETŘ": 0.0038, "UASK": 0.0038, "UPOT": 0.0038, "IJEN": 0.0038, "AKOO": 0.0038,
+	"HOVU": 0.0038, "GLOB": 0.0038, "JAKÉ": 0.0038, "RÝCH": 0.0038, "JEBA": 0.0038,
+	"NACE": 0.0038, "UNOV": 0.0038, "OVÝN": 0.0038, "UZEJ": 0.0038, "TNÝO": 0.0038,
+	"AJAK": 0.0038, "ÁKON": 0.0038, "ABAL": 0.0038, "DIST": 0.0038, "ESUP": 0.0038,
+	"SKÁT": 0.0038, "IŽEJ": 0.0038, "SPOČ": 0.0038, "ÝTPO": 0.0038, "ÁNŽÁ": 0.0038,
+	"NOSI": 0.0038, "TIPO": 0.0038, "TAPŘ": 0.0038, "ORYZ": 0.0038, "EČAS": 0.0038,
+	"ĚTNÉ": 0.0038, "CÍNE": 0.0038, "JETO": 0.0038, "RNEL": 0.0038, "LNÝP": 0.0038,
+	"OBNÉ": 0.0038, "SPŘÍ": 0.0038, "PROI": 0.0038, "LNÝS": 0.0038, "DENN": 0.0038,
+	"EBOM": 0.0038, "ZABI": 0.0038, "INUP": 0.0038, "OUPI": 0.0038, "UNIC": 0.0038,
+	"DEPR": 0.0038, "NÉZÁ": 0.0038, "ECNÉ": 0.0038, "LEZN": 0.0038, "ETEV": 0.0038,
+	"RECH": 0.0038, "ENŠÍ": 0.0038, "IDPR": 0.0038, "ŽITÉ": 0.0038, "MUSO": 0.0038,
+	"ORUD": 0.0038, "TALE": 0.0038, "ŽÍTS": 0.0038, "ASPR": 0.0038, "PRIN": 0.0038,
+	"VPAT": 0.0038, "ATBA": 0.0038, "ÉMUP": 0.0038, "DMÍT": 0.0038, "ÍTNU": 0.0038,
+	"IUŽI": 0.0038, "ČUJÍ": 0.0038, "ŠEHO": 0.0038, "NÍNO": 0.0038, "ELKO": 0.0038,
+	"CHSP": 0.0038, "ĚNYO": 0.0038, "AČUJ": 0.0038, "PUJE": 0.0038, "ZANÝ": 0.0038,
+	"ÉSLO": 0.0038, "ZORE": 0.0038, "ĚRNI": 0.0038, "PŮVO": 0.0038, "OIMP": 0.0038,
+	"DPOJ": 0.0038, "ŽÁDO": 0.0038, "EÚLO": 0.0038, "PROF": 0.0038, "PÍNÁ": 0.0038,
+	"JTŮN": 0.0038, "HOAR": 0.0038, "NTOV": 0.0038, "CATE": 0.0038, "SAHP": 0.0038,
+	"ANÝT": 0.0038, "ŠÍVE": 0.0038, "NAMZ": 0.0038, "SMYS": 0.0038, "TÍPO": 0.0038,
+	"ŘUJE": 0.0038, "ŘIZP": 0.0038, "LÍKJ": 0.0038, "UJEŽ": 0.0038, "ZAMČ": 0.0038,
+	"AMYK": 0.0038, "OHLA": 0.0038, "MPON": 0.0038, "ANÁD": 0.0038, "FRON": 0.0038,
+	"ZAMK": 0.0038, "AMKN": 0.0038, "ATAR": 0.0038, "ERÝM": 0.0038, "ABYB": 0.0038,
+	"BÝTU": 0.0038, "NAKT": 0.0038, "VYVO": 0.0038, "HELP": 0.0038, "ÍMPŘ": 0.0038,
+	"CEMI": 0.0038, "ANÉA": 0.0038, "LOPŘ": 0.0038, "TAPL": 0.0038, "ARIA": 0.0038,
+	"OFOR": 0.0038, "AVAD": 0.0038, "ÍLÁN": 0.0038, "ŘIOT": 0.0038, "ÍZES": 0.0038,
+	"ÝMOD": 0.0038, "UNDY": 0.0038, "ZÍTK": 0.0038, "ZÁPO": 0.0038, "MOCN": 0.0038,
+	"RYPO": 0.0038, "ZEVN": 0.0038, "EVPŘ": 0.0038, "SOUD": 0.0038, "OUDO": 0.0038,
+	"LIŠÍ": 0.0038, "IČCE": 0.0038, "DENP": 0.0038, "DPŘE": 0.0038, "AKLÍ": 0.0038,
+	"DŮVO": 0.0038, "NÍMN": 0.0038, "HZNA": 0.0038, "TANO": 0.0038, "MĚLB": 0.0038,
+	"ÉNEB": 0.0038, "OREK": 0.0038, "OŠTI": 0.0038, "LASI": 0.0038, "RVAT": 0.0038,
+	"ONGŠ": 0.0038, "AMEZ": 0.0038, "SOCI": 0.0038, "MALA": 0.0038, "OSMA": 0.0038,
+	"KRAJ": 0.0038, "SURO": 0.0038, "BOOK": 0.0038, "MATO": 0.0038, "FILI": 0.0038,
+	"ÝTOK": 0.0038, "TVAR": 0.0038, "STEN": 0.0038, "RITY": 0.0038, "ZNEB": 0.0038,
+	"VINN": 0.0038, "ÉMUG": 0.0038, "ATTA": 0.0038, "NITJ": 0.0038, "NADA": 0.0038,
+	"IOVÉ": 0.0038, "GICK": 0.0038, "NKYO": 0.0038, "WARO": 0.0038, "ATSK": 0.0038,
+	"SKÁL": 0.0038, "EDER": 0.0038, "NĚLS": 0.0038, "ĚLSK": 0.0038, "AKAŽ": 0.0038,
+	"ÚVOD": 0.0038, "SDAT": 0.0038, "ORUŽ": 0.0038, "BYPR": 0.0038, "PADĚ": 0.0038,
+	"ČNÍK": 0.0038, "AZYS": 0.0038, "DIRM": 0.0038, "IRMN": 0.0038, "RMNG": 0.0038,
+	"MNGR": 0.0038, "ČEPO": 0.0038, "OZEB": 0.0038, "NICT": 0.0038, "ICTV": 0.0038,
+	"AKÁŽ": 0.0038, "STID": 0.0038, "HBAL": 0.0038, "LLOG": 0.0038, "UPCŮ": 0.0038,
+	"OUBÝ": 0.0038, "UBÝT": 0.0038, "ÍŘKY": 0.0038, "ZDĚD": 0.0038, "RPRA": 0.0038,
+	"DĚDĚ": 0.0038, "ĚDĚN": 0.0038, "ÉFON": 0.0038, "ÉLAT": 0.0038, "VBAL": 0.0038,
+	"ÍKNE": 0.0038, "OARC": 0.0038, "NÝBA": 0.0038, "PŘÍR": 0.0038, "RŮST": 0.0038,
+	"ČENS": 0.0036, "INAL": 0.0036, "LICH": 0.0036, "VÁNE": 0.0036, "STAK": 0.0036,
+	"ÉMUJ": 0.0036, "IMUM": 0.0036, "ÁŽÁD": 0.0036, "ÍTOM": 0.0036, "LIEN": 0.0036,
+	"ENTS": 0.0036, "PSTR": 0.0036, "AKÓD": 0.0036, "USKO": 0.0036, "OCIA": 0.0036,
+	"ETNE": 0.0036, "MOHU": 0.0036, "ÍODP": 0.0036, "ŘÍCH": 0.0036, "TNÉL": 0.0036,
+	"ENEO": 0.0036, "TNÝR": 0.0036, "ÍPAR": 0.0036, "ÍCEJ": 0.0036, "DISP": 0.0036,
+	"EKPA": 0.0036, "OTOP": 0.0036, "EMVY": 0.0036, "ÁZEJ": 0.0036, "ETŘÁ": 0.0036,
+	"STNE": 0.0036, "JENN": 0.0036, "ERÝC": 0.0036, "MPOU": 0.0036, "UIGN": 0.0036,
+	"AZÍP": 0.0036, "STIO": 0.0036, "ETYP": 0.0036, "NÍTE": 0.0036, "MYČK": 0.0036,
+	"UNÁV": 0.0036, "UPNE": 0.0036, "ADAL": 0.0036, "ÁMÝT": 0.0036, "RALÝ": 0.0036,
+	"RŮNE": 0.0036, "PNÍH": 0.0036, "MREŽ": 0.0036, "NÍBU": 0.0036, "ÍVÝC": 0.0036,
+	"AKPO": 0.0036, "JNÝM": 0.0036, "SATP": 0.0036, "VPOD": 0.0036, "ÁNAN": 0.0036,
+	"ČNÉP": 0.0036, "NÁAD": 0.0036, "ÉMSO": 0.0036, "ĚNÝC": 0.0036, "ÍKYB": 0.0036,
+	"ÍČEV": 0.0036, "AZÍS": 0.0036, "ŘEDZ": 0.0036, "ZVEM": 0.0036, "TELO": 0.0036,
+	"TORŮ": 0.0036, "KYPR": 0.0036, "AJEO": 0.0036, "ŽNÉZ": 0.0036, "ZNET": 0.0036,
+	"OJME": 0.0036, "ELAC": 0.0036, "REÁL": 0.0036, "EÁLN": 0.0036, "DINA": 0.0036,
+	"UPUJ": 0.0036, "ÍCÍZ": 0.0036, "TUPZ": 0.0036, "NAČI": 0.0036, "POŇJ": 0.0036,
+	"OŇJE": 0.0036, "ŇJED": 0.0036, "MNOV": 0.0036, "VOUS": 0.0036, "OUSK": 0.0036,
+	"PÍRU": 0.0036, "ÍRUJ": 0.0036, "NČÍM": 0.0036, "UTNÍ": 0.0036, "EMSK": 0.0036,
+	"ITEČ": 0.0036, "NICN": 0.0036, "APOČ": 0.0036, "TÁNO": 0.0036, "CHVÝ": 0.0036,
+	"ZATÍ": 0.0036, "ATÍM": 0.0036, "NÍMZ": 0.0036, "ITOB": 0.0036, "DADR": 0.0036,
+	"AVED": 0.0036, "OSES": 0.0036, "OSTZ": 0.0036, "ZÍSE": 0.0036, "NÉSE": 0.0036,
+	"AFOR": 0.0036, "SOUO": 0.0036, "ÍPOT": 0.0036, "VODU": 0.0036, "TÁSE": 0.0036,
+	"OUCN": 0.0036, "UCNO": 0.0036, "CNOS": 0.0036, "ITOU": 0.0036, "NODU": 0.0036,
+	"ŽENE": 0.0036, "DNÝŘ": 0.0036, "ZECN": 0.0036, "IJST": 0.0036, "NADR": 0.0036,
+	"JEKL": 0.0036, "ESTR": 0.0036, "SANÝ": 0.0036, "CKÉM": 0.0036, "ORVA": 0.0036,
+	"NAMŮ": 0.0036, "NÝŘÁ": 0.0036, "CÍNA": 0.0036, "DNÉA": 0.0036, "NÉAP": 0.0036,
+	"YLAP": 0.0036, "KUSE": 0.0036, "DIRE": 0.0036, "TIVY": 0.0036, "NOTO": 0.0036,
+	"OTOU": 0.0036, "OUNÁ": 0.0036, "TNÉJ": 0.0036, "OSPR": 0.0036, "REAL": 0.0036,
+	"APSÁ": 0.0036, "SÁNO": 0.0036, "SCHY": 0.0036, "RÉSE": 0.0036, "ODDÍ": 0.0036,
+	"DDÍL": 0.0036, "NOJE": 0.0036, "RÉJS": 0.0036, "ÍMIN": 0.0036, "OUKR": 0.0036,
+	"VENÝ": 0.0036, "ZEKO": 0.0036, "UNOU": 0.0036, "NČÍS": 0.0036, "ENÉN": 0.0036,
+	"ÍMEZ": 0.0036, "MINE": 0.0036, "ÍAPL": 0.0036, "ZÁVO": 0.0036, "ÁVOR": 0.0036,
+	"ÍDÉL": 0.0036, "ERAT": 0.0036, "RANS": 0.0036, "IOTE": 0.0036, "TARÉ": 0.0036,
+	"EDOP": 0.0036, "NNÁZ": 0.0036, "TPOM": 0.0036, "ERÝS": 0.0036, "BONA": 0.0036,
+	"NEUM": 0.0036, "ISEM": 0.0036, "NARO": 0.0036, "ÉDIU": 0.0036, "DIUM": 0.0036,
+	"ELIŠ": 0.0036, "KUSO": 0.0036, "OVKA": 0.0036, "ÉNOV": 0.0036, "AHLA": 0.0036,
+	"ÍMOD": 0.0036, "AZKU": 0.0036, "NÉHE": 0.0036, "PREZ": 0.0036, "ZENT": 0.0036,
+	"AZAR": 0.0036, "TALI": 0.0036, "ALIS": 0.0036, "EJVÝ": 0.0036, "NGUJ": 0.0036,
+	"GUJE": 0.0036, "AKZN": 0.0036, "EBÍR": 0.0036, "BOSE": 0.0036, "ONGO": 0.0036,
+	"SEŠI": 0.0036, "EODV": 0.0036, "OJEK": 0.0036, "NTEJ": 0.0036, "JNER": 0.0036,
+	"TOVN": 0.0036, "CKÉK": 0.0036, "ANÁV": 0.0036, "EZÁS": 0.0036, "OZÁP": 0.0036,
+	"DEKP": 0.0036, "BOPR": 0.0036, "UGST": 0.0036, "AZMĚ": 0.0036, "LPŘI": 0.0036,
+	"CHST": 0.0036, "AČAS": 0.0036, "DATY": 0.0036, "OVYO": 0.0036, "NAKL": 0.0036,
+	"OZEM": 0.0036, "ILIP": 0.0036, "LIPÍ": 0.0036, "IPÍN": 0.0036, "LSKO": 0.0036,
+	"FEDE": 0.0036, "DERA": 0.0036, "ASKA": 0.0036, "ÍVEL": 0.0036, "PNÍŘ": 0.0036,
+	"ÍSLE": 0.0036, "VÉHE": 0.0036, "LPOU": 0.0036, "VĚŘU": 0.0036, "ĚŘUJ": 0.0036,
+	"SHOV": 0.0036, "OPOV": 0.0036, "EFUN": 0.0036, "SÍMV": 0.0036, "HKLÍ": 0.0036,
+	"LITA": 0.0036, "MADR": 0.0036, "NOUŽ": 0.0036, "PNOU": 0.0036, "SMAŽ": 0.0036,
+	"MUNA": 0.0036, "AKOI": 0.0036, "INKO": 0.0036, "TOVE": 0.0036, "IHES": 0.0036,
+	"NUXO": 0.0036, "UXOV": 0.0036, "UTÍS": 0.0036, "LEČN": 0.0036, "OUKL": 0.0036,
+	"LLUP": 0.0036, "ÍKAŽ": 0.0036, "MISP": 0.0036, "KOVĚ": 0.0036, "SKÉC": 0.0036,
+	"KÉBE": 0.0036, "SMRT": 0.0036, "ŘÍRŮ": 0.0036, "ÍRŮS": 0.0036, "NAZN": 0.0034,
+	"ÉHOT": 0.0034, "APIŠ": 0.0034, "ĚJÍC": 0.0034, "VÝVÝ": 0.0034, "LCHY": 0.0034,
+	"UJER": 0.0034, "ŘÍTO": 0.0034, "IENT": 0.0034, "TĚNA": 0.0034, "TURU": 0.0034,
+	"ATAD": 0.0034, "ONAL": 0.0034, "ÁTEM": 0.0034, "STSO": 0.0034, "VYŠŠ": 0.0034,
+	"YŠŠÍ": 0.0034, "BÝTS": 0.0034, "TURA": 0.0034, "ĚJED": 0.0034, "NÁSC": 0.0034,
+	"USPR": 0.0034, "AKPR": 0.0034, "PUPŘ": 0.0034, "ÍODD": 0.0034, "ŠENÝ": 0.0034,
+	"SPOZ": 0.0034, "OPLA": 0.0034, "TPOL": 0.0034, "ÁPOD": 0.0034, "ÁNÍH": 0.0034,
+	"DKYV": 0.0034, "KYVY": 0.0034, "VUJÍ": 0.0034, "ÉMUK": 0.0034, "AKOJ": 0.0034,
+	"NĚJE": 0.0034, "ETVY": 0.0034, "ULÁT": 0.0034, "CHAD": 0.0034, "ZÍPO": 0.0034,
+	"ÉLKU": 0.0034, "ITZÁ": 0.0034, "AČNÝ": 0.0034, "ELLO": 0.0034, "CSPO": 0.0034,
+	"CENC": 0.0034, "ÁNAV": 0.0034, "MOVÁ": 0.0034, "NAČJ": 0.0034, "OZÍP": 0.0034,
+	"LADA": 0.0034, "TDOP": 0.0034, "ÍREŽ": 0.0034, "ZAZN": 0.0034, "ÍCÍA": 0.0034,
+	"UJMÉ": 0.0034, "EZIP": 0.0034, "NEJM": 0.0034, "USED": 0.0034, "OBOD": 0.0034,
+	"RYSE": 0.0034, "NOUA": 0.0034, "NTÁŘ": 0.0034, "ONEL": 0.0034, "OBNÝ": 0.0034,
+	"ČPRO": 0.0034, "OVIT": 0.0034, "ATVE": 0.0034, "AKOC": 0.0034, "IDLO": 0.0034,
+	"TARŠ": 0.0034, "IJEJ": 0.0034, "ÍCÍK": 0.0034, "KÉPO": 0.0034, "ZEAL": 0.0034,
+	"ŘNEL": 0.0034, "POJM": 0.0034, "USKU": 0.0034, "MNEN": 0.0034, "TICH": 0.0034,
+	"UODS": 0.0034, "ANÝV": 0.0034, "ŮPŘI": 0.0034, "ENSO": 0.0034, "ASNĚ": 0.0034,
+	"SÁŘS": 0.0034, "ZEZP": 0.0034, "AJIŽ": 0.0034, "AVYT": 0.0034, "YKLE": 0.0034,
+	"DSEL": 0.0034, "ETOZ": 0.0034, "OZNO": 0.0034, "ICNE": 0.0034, "NĚVY": 0.0034,
+	"AKEF": 0.0034, "KEFI": 0.0034, "PSOU": 0.0034, "DNÁV": 0.0034, "VÝRO": 0.0034,
+	"OVÉŘ": 0.0034, "RERE": 0.0034, "KVIZ": 0.0034, "ĚNIL": 0.0034, "KÁSE": 0.0034,
+	"HRNU": 0.0034, "NEŽJ": 0.0034, "NEÚS": 0.0034, "EÚSP": 0.0034, "ESOR": 0.0034,
+	"STOT": 0.0034, "CHSY": 0.0034, "BORD": 0.0034, "DÁSE": 0.0034, "UARC": 0.0034,
+	"NNÉS": 0.0034, "EVSK": 0.0034, "ESEU": 0.0034, "ESEA": 0.0034, "AZYN": 0.0034,
+	"DVOU": 0.0034, "TITP": 0.0034, "JIŠT": 0.0034, "ODŘÍ": 0.0034, "MUPR": 0.0034,
+	"KUPŘ": 0.0034, "YCHY": 0.0034, "MÁNE": 0.0034, "ÉVER": 0.0034, "URAC": 0.0034,
+	"AVÍP": 0.0034, "ÍPRV": 0.0034, "ÍKJE": 0.0034, "TYPS": 0.0034, "EASE": 0.0034,
+	"PREF": 0.0034, "ZAMY": 0.0034, "ACEB": 0.0034, "YMUS": 0.0034, "ACÍS": 0.0034,
+	"NAVR": 0.0034, "TIVA": 0.0034, "AJEV": 0.0034, "YNEM": 0.0034, "ÁŘNA": 0.0034,
+	"TERO": 0.0034, "HOSY": 0.0034, "KNOU": 0.0034, "DMIN": 0.0034, "ÍTEZ": 0.0034,
+	"STMI": 0.0034, "LÍČV": 0.0034, "NÍAL": 0.0034, "UJEŘ": 0.0034, "TKEM": 0.0034,
+	"VESC": 0.0034, "RAKT": 0.0034, "ÁNAS": 0.0034, "DEŠI": 0.0034, "UKRO": 0.0034,
+	"ESKT": 0.0034, "IKON": 0.0034, "DNÍK": 0.0034, "PIŠT": 0.0034, "IŠTE": 0.0034,
+	"ESPE": 0.0034, "ATVŠ": 0.0034, "COMP": 0.0034, "COOK": 0.0034, "TNEO": 0.0034,
+	"OUMÍ": 0.0034, "LNÍD": 0.0034, "NÁDÉ": 0.0034, "HROM": 0.0034, "TVYB": 0.0034,
+	"YNAP": 0.0034, "OSEK": 0.0034, "ÁČÍS": 0.0034, "CÍPA": 0.0034, "ATOB": 0.0034,
+	"DLES": 0.0034, "ŘEST": 0.0034, "OTOS": 0.0034, "ÝCHM": 0.0034, "NTYN": 0.0034,
+	"ŽEPŘ": 0.0034, "ORŮP": 0.0034, "ERPO": 0.0034, "JVÝŠ": 0.0034, "ČTVR": 0.0034,
+	"TVRT": 0.0034, "ONAP": 0.0034, "ÝREŽ": 0.0034, "SLAV": 0.0034, "NÍSM": 0.0034,
+	"ÍCHZ": 0.0034, "ŽŠTI": 0.0034, "AFRI": 0.0034, "ÁLŠT": 0.0034, "CHOR": 0.0034,
+	"ERŠT": 0.0034, "NAMO": 0.0034, "LING": 0.0034, "KURD": 0.0034, "POTÉ": 0.0034,
+	"ALIN": 0.0034, "TUPK": 0.0034, "NAVŠ": 0.0034, "ITOV": 0.0034, "YJED": 0.0034,
+	"KOUO": 0.0034, "ATCH": 0.0034, "NEŽP": 0.0034, "NEMA": 0.0034, "DYŽS": 0.0034,
+	"RYNA": 0.0034, "LIVÉ": 0.0034, "MJAK": 0.0034, "MUGS": 0.0034, "OUST": 0.0034,
+	"ODOM": 0.0034, "NÉUŽ": 0.0034, "ÉMFO": 0.0034, "ÍKUV": 0.0034, "TÍMT": 0.0034,
+	"MINU": 0.0034, "YOBR": 0.0034, "TRVA": 0.0034, "DLEJ": 0.0034, "INAP": 0.0034,
+	"NÍTA": 0.0034, "ALAJ": 0.0034, "RITÁ": 0.0034, "UNSK": 0.0034, "ĚNÉH": 0.0034,
+	"KNEP": 0.0034, "HUŽI": 0.0034, "ZEZÁ": 0.0034, "VOUP": 0.0034, "ÁVEL": 0.0034,
+	"CÍKL": 0.0034, "ÍČBY": 0.0034, "RÁVZ": 0.0034, "ÁVZA": 0.0034, "ČNEB": 0.0034,
+	"ÁDÁM": 0.0034, "OUBE": 0.0034, "ÉNOU": 0.0034, "TIDO": 0.0034, "ACÍJ": 0.0034,
+	"EBOR": 0.0034, "TÍNO": 0.0034, "CHJE": 0.0034, "RCHO": 0.0034, "OČÍN": 0.0034,
+	"CKÉB": 0.0034, "RETU": 0.0034, "ÉKLÁ": 0.0034, "COLE": 0.0034, "TSKO": 0.0032,
+	"ÍJEV": 0.0032, "BĚJÍ": 0.0032, "ENOB": 0.0032, "PŘÍT": 0.0032, "BIND": 0.0032,
+	"ZEIN": 0.0032, "TVÝS": 0.0032, "NAHO": 0.0032, "ATDA": 0.0032, "KUJÍ": 0.0032,
+	"ENAA": 0.0032, "TBYL": 0.0032, "ATUR": 0.0032, "RASE": 0.0032, "JEIN": 0.0032,
+	"TUSP": 0.0032, "AMEM": 0.0032, "ATYP": 0.0032, "KJED": 0.0032, "ENŽÁ": 0.0032,
+	"DNÝZ": 0.0032, "PŘIA": 0.0032, "TNĚN": 0.0032, "RSES": 0.0032, "ANÁP": 0.0032,
+	"NEUK": 0.0032, "EREN": 0.0032, "EOZN": 0.0032, "DKEM": 0.0032, "UPPO": 0.0032,
+	"ORKU": 0.0032, "ORKY": 0.0032, "OURO": 0.0032, "HNYS": 0.0032, "OAUT": 0.0032,
+	"KAPR": 0.0032, "TUPE": 0.0032, "UPEM": 0.0032, "NANÝ": 0.0032, "ŘÍDY": 0.0032,
+	"OLNÉ": 0.0032, "VOJE": 0.0032, "RAZJ": 0.0032, "TYJS": 0.0032, "SECH": 0.0032,
+	"MÝTY": 0.0032, "RALÉ": 0.0032, "DÁNŽ": 0.0032, "DNÉV": 0.0032, "PNEP": 0.0032,
+	"SCRI": 0.0032, "ÁNÍŘ": 0.0032, "ISLÝ": 0.0032, "DĚLÍ": 0.0032, "NOVK": 0.0032,
+	"ÝNÁV": 0.0032, "KPOU": 0.0032, "BYLS": 0.0032, "NUXU": 0.0032, "TÍPR": 0.0032,
+	"NÝMS": 0.0032, "AZYZ": 0.0032, "EBOI": 0.0032, "APRA": 0.0032, "USNE": 0.0032,
+	"TOMU": 0.0032, "IODS": 0.0032, "RAŇO": 0.0032, "AŇOV": 0.0032, "ÉNOD": 0.0032,
+	"ATIS": 0.0032, "UVEL": 0.0032, "VUVY": 0.0032, "BIJE": 0.0032, "YSTA": 0.0032,
+	"MSEZ": 0.0032, "ŠENÁ": 0.0032, "LÁSÍ": 0.0032, "LANÝ": 0.0032, "AZÍI": 0.0032,
+	"ZÍIN": 0.0032, "TOST": 0.0032, "IJEZ": 0.0032, "CKÉP": 0.0032, "ČEVY": 0.0032,
+	"ÝMIP": 0.0032, "NÁMO": 0.0032, "NÉZA": 0.0032, "ÁLEM": 0.0032, "ÁTEK": 0.0032,
+	"NAMN": 0.0032, "ENÍL": 0.0032, "RINT": 0.0032, "ASEV": 0.0032, "EVYN": 0.0032,
+	"TOVO": 0.0032, "VATU": 0.0032, "ELEA": 0.0032, "ÍRÁM": 0.0032, "EJNO": 0.0032,
+	"NÍJA": 0.0032, "ANEE": 0.0032, "TELJ": 0.0032, "MKON": 0.0032, "LVYT": 0.0032,
+	"NÝAL": 0.0032, "CÍCE": 0.0032, "NEČN": 0.0032, "ÍŘET": 0.0032, "ĚŽNÝ": 0.0032,
+	"UŠTÍ": 0.0032, "KRÁC": 0.0032, "LPŘÍ": 0.0032, "PRER": 0.0032, "VIZI": 0.0032,
+	"IZIT": 0.0032, "EDOK": 0.0032, "LAZA": 0.0032, "USIG": 0.0032, "BANA": 0.0032,
+	"LSIG": 0.0032, "ČOVÁ": 0.0032, "OBÁL": 0.0032, "OVUV": 0.0032, "MKAP": 0.0032,
+	"TARG": 0.0032, "SESY": 0.0032, "NOSE": 0.0032, "OUŠÍ": 0.0032, "VOJI": 0.0032,
+	"ODUC": 0.0032, "TPOK": 0.0032, "NÝCE": 0.0032, "ISUS": 0.0032, "ILNÍ": 0.0032,
+	"ETNĚ": 0.0032, "RUJÍ": 0.0032, "MEDI": 0.0032, "ÍMVY": 0.0032, "ÍCHN": 0.0032,
+	"ZÁHL": 0.0032, "RESO": 0.0032, "XTER": 0.0032, "AMUZ": 0.0032, "ESEJ": 0.0032,
+	"ASTI": 0.0032, "IZAV": 0.0032, "STPŘ": 0.0032, "ČÍNÁ": 0.0032, "ANEJ": 0.0032,
+	"OURČ": 0.0032, "EAUT": 0.0032, "ADMI": 0.0032, "VEKT": 0.0032, "VECH": 0.0032,
+	"ROKL": 0.0032, "ÍTKE": 0.0032, "AKCI": 0.0032, "VENÉ": 0.0032, "OKAL": 0.0032,
+	"KUSU": 0.0032, "NYSO": 0.0032, "IPAM": 0.0032, "VATO": 0.0032, "CÍMZ": 0.0032,
+	"ATSY": 0.0032, "NUME": 0.0032, "RIAN": 0.0032, "OMAD": 0.0032, "CKÉA": 0.0032,
+	"ISUD": 0.0032, "SUDO": 0.0032, "NITV": 0.0032, "RÝSE": 0.0032, "AČEJ": 0.0032,
+	"DANÁ": 0.0032, "ENÉA": 0.0032, "ANÍN": 0.0032, "SATS": 0.0032, "OODD": 0.0032,
+	"ANÉU": 0.0032, "ZEMĚ": 0.0032, "TZMĚ": 0.0032, "UZET": 0.0032, "NÉID": 0.0032,
+	"ROUS": 0.0032, "UURČ": 0.0032, "KAPŘ": 0.0032, "YNAZ": 0.0032, "ERÉN": 0.0032,
+	"PISK": 0.0032, "ĚŠNÉ": 0.0032, "NÍAP": 0.0032, "ANÍP": 0.0032, "LHAR": 0.0032,
+	"BĚHU": 0.0032, "ITNA": 0.0032, "ANÁS": 0.0032, "EBOČ": 0.0032, "EJNĚ": 0.0032,
+	"ÝVÝR": 0.0032, "OVÝZ": 0.0032, "NICK": 0.0032, "ARMÉ": 0.0032, "RMÉN": 0.0032,
+	"ROVĚ": 0.0032, "ITEV": 0.0032, "NGOL": 0.0032, "MARO": 0.0032, "UKRA": 0.0032,
+	"RAJI": 0.0032, "VARC": 0.0032, "DEOD": 0.0032, "RODO": 0.0032, "HIVT": 0.0032,
+	"IVTA": 0.0032, "FOTO": 0.0032, "ČEPR": 0.0032, "LKOV": 0.0032, "VNÍO": 0.0032,
+	"DOUP": 0.0032, "OABY": 0.0032, "ÁTUS": 0.0032, "EŘAZ": 0.0032, "IKAČ": 0.0032,
+	"OURU": 0.0032, "ÁDĚN": 0.0032, "ECHS": 0.0032, "EJIN": 0.0032, "ÍVAN": 0.0032,
+	"ZPŘÍ": 0.0032, "AJÍM": 0.0032, "VYOB": 0.0032, "TACI": 0.0032, "RVAL": 0.0032,
+	"NABA": 0.0032, "IZOZ": 0.0032, "ZOZE": 0.0032, "FINS": 0.0032, "INAG": 0.0032,
+	"NORS": 0.0032, "DSKÁ": 0.0032, "MALI": 0.0032, "CHUŽ": 0.0032, "PAKU": 0.0032,
+	"PRŠÍ": 0.0032, "NITS": 0.0032, "PŘÍJ": 0.0032, "ŽITP": 0.0032, "NĚNY": 0.0032,
+	"ÁNKY": 0.0032, "ŠELA": 0.0032, "KAZZ": 0.0032, "ČBYL": 0.0032, "UDSE": 0.0032,
+	"EMPŘ": 0.0032, "TOFU": 0.0032, "STNI": 0.0032, "TNIC": 0.0032, "ELOK": 0.0032,
+	"RESP": 0.0032, "APNE": 0.0032, "MÉNY": 0.0032, "ESKÉ": 0.0032, "YŽJS": 0.0032,
+	"ÝCHŘ": 0.0032, "LOKŮ": 0.0032, "ETAK": 0.0032, "CHOL": 0.0032, "JEZÁ": 0.0032,
+	"AZÍN": 0.0032, "SELŽ": 0.0032, "ELŽE": 0.0032, "ÉŠÍŘ": 0.0032, "ESYC": 0.0032,
+	"KÉFO": 0.0032, "NIZN": 0.0032, "UBAL": 0.0032, "AKKT": 0.0032, "SKÁK": 0.0030,
+	"TRIK": 0.0030, "NALO": 0.0030, "ESAS": 0.0030, "COPY": 0.0030, "DŘÍV": 0.0030,
+	"ÁNOA": 0.0030, "YBĚJ": 0.0030, "ERUN": 0.0030, "URIN": 0.0030, "TNEM": 0.0030,
+	"YBÍP": 0.0030, "ULKY": 0.0030, "LABÝ": 0.0030, "EMPR": 0.0030, "ÁTNÍ": 0.0030,
+	"NNEL": 0.0030, "ENAB": 0.0030, "POIN": 0.0030, "ATSP": 0.0030, "TSPO": 0.0030,
+	"VERE": 0.0030, "EREM": 0.0030, "BYNE": 0.0030, "ÍSTS": 0.0030, "ÉSPO": 0.0030,
+	"ATAN": 0.0030, "TJMÉ": 0.0030, "DOSA": 0.0030, "ÁNPR": 0.0030, "USÍM": 0.0030,
+	"ENÍF": 0.0030, "RAMO": 0.0030, "ÝROZ": 0.0030, "ÁNAP": 0.0030, "UJIS": 0.0030,
+	"BNÉP": 0.0030, "KDIS": 0.0030, "YNEP": 0.0030, "KCEN": 0.0030, "ENEV": 0.0030,
+	"ATFO": 0.0030, "LOŘÁ": 0.0030, "ELEC": 0.0030, "KÁTP": 0.0030, "AVKU": 0.0030,
+	"DCHÁ": 0.0030, "OPTS": 0.0030, "ZEVV": 0.0030, "NÍHE": 0.0030, "ÁMÁC": 0.0030,
+	"ASOC": 0.0030, "UNAV": 0.0030, "ZEVJ": 0.0030, "JEVŠ": 0.0030, "HNEB": 0.0030,
+	"BULÁ": 0.0030, "TEMP": 0.0030, "ECEL": 0.0030, "JEVI": 0.0030, "MÝPŘ": 0.0030,
+	"NBEZ": 0.0030, "NÝMO": 0.0030, "AJTO": 0.0030, "JTOV": 0.0030, "MÍOB": 0.0030,
+	"NÍZD": 0.0030, "UPER": 0.0030, "COVÉ": 0.0030, "ÍCÍV": 0.0030, "TJEJ": 0.0030,
+	"PESE": 0.0030, "ZBÝV": 0.0030, "CRIP": 0.0030, "ÍAUT": 0.0030, "RAZD": 0.0030,
+	"AKYN": 0.0030, "YNUL": 0.0030, "TRÁD": 0.0030, "YSEL": 0.0030, "NANO": 0.0030,
+	"MNAS": 0.0030, "UČÍS": 0.0030, "RORE": 0.0030, "AZÁP": 0.0030, "REJS": 0.0030,
+	"CISO": 0.0030, "ABIT": 0.0030, "ITÍM": 0.0030, "SNÁZ": 0.0030, "IČEK": 0.0030,
+	"LUČU": 0.0030, "DSKU": 0.0030, "ZKRA": 0.0030, "ŘECH": 0.0030, "ESIG": 0.0030,
+	"ÍSEN": 0.0030, "NAPI": 0.0030, "ÝCHC": 0.0030, "LNÉH": 0.0030, "ÍPOJ": 0.0030,
+	"ERUK": 0.0030, "PNÝC": 0.0030, "EKDY": 0.0030, "JEZD": 0.0030, "ÁNAD": 0.0030,
+	"MSYS": 0.0030, "ITÍS": 0.0030, "HOJM": 0.0030, "VYUŽ": 0.0030, "LEAS": 0.0030,
+	"EZES": 0.0030, "ETEZ": 0.0030, "JEKO": 0.0030, "KJAK": 0.0030, "UDEU": 0.0030,
+	"ÍCEP": 0.0030, "TESE": 0.0030, "ČNÝC": 0.0030, "EDEF": 0.0030, "DOAD": 0.0030,
+	"ZAVE": 0.0030, "DNÝV": 0.0030, "APOM": 0.0030, "SEZM": 0.0030, "ŠÍCH": 0.0030,
+	"CENO": 0.0030, "ČNEP": 0.0030, "ČOVÉ": 0.0030, "VERR": 0.0030, "ERRI": 0.0030,
+	"RIDE": 0.0030, "EKÁS": 0.0030, "BORM": 0.0030, "EŽJE": 0.0030, "ISUN": 0.0030,
+	"MITP": 0.0030, "ZVAŽ": 0.0030, "OTOH": 0.0030, "HOSE": 0.0030, "PRÁC": 0.0030,
+	"ÍSIG": 0.0030, "DUPR": 0.0030, "SEBE": 0.0030, "ĚNEN": 0.0030, "ÍNÁV": 0.0030,
+	"ŽÍTN": 0.0030, "MUNE": 0.0030, "VÉMS": 0.0030, "TYNA": 0.0030, "TNÁV": 0.0030,
+	"LASE": 0.0030, "ORŮS": 0.0030, "BNOU": 0.0030, "ÍFUN": 0.0030, "ENÁN": 0.0030,
+	"ÍVES": 0.0030, "BILN": 0.0030, "TLOG": 0.0030, "AVDI": 0.0030, "VDIV": 0.0030,
+	"NĚSE": 0.0030, "ENCI": 0.0030, "ÍHLA": 0.0030, "NOTI": 0.0030, "TDOČ": 0.0030,
+	"TČÁS": 0.0030, "VSEZ": 0.0030, "ÁČÁS": 0.0030, "ŽECH": 0.0030, "ERÝJ": 0.0030,
+	"RÝJE": 0.0030, "ŽTEP": 0.0030, "RAVA": 0.0030, "EXPI": 0.0030, "XPIR": 0.0030,
+	"MANU": 0.0030, "OURC": 0.0030, "URCE": 0.0030, "ŽNÉP": 0.0030, "OZÍM": 0.0030,
+	"JINO": 0.0030, "ZABE": 0.0030, "ITVE": 0.0030, "INIS": 0.0030, "CÍMI": 0.0030,
+	"EDNĚ": 0.0030, "VPOU": 0.0030, "IVOV": 0.0030, "NÍAU": 0.0030, "ESNÍ": 0.0030,
+	"NÝJA": 0.0030, "ÝJAK": 0.0030, "NNÁP": 0.0030, "OUTP": 0.0030, "ZEZK": 0.0030,
+	"TSLO": 0.0030, "NADS": 0.0030, "AVÍM": 0.0030, "VEKN": 0.0030, "UPIT": 0.0030,
+	"HOZA": 0.0030, "ETDN": 0.0030, "ÁNÍR": 0.0030, "ÁNÍČ": 0.0030, "FSTA": 0.0030,
+	"CHSL": 0.0030, "TNÁD": 0.0030, "TUPV": 0.0030, "YJEP": 0.0030, "EVRO": 0.0030,
+	"ÁPÍS": 0.0030, "KAZJ": 0.0030, "PADN": 0.0030, "KKLÍ": 0.0030, "YKLÍ": 0.0030,
+	"SÁNY": 0.0030, "ÍJEJ": 0.0030, "ANÍS": 0.0030, "ZÍZÁ": 0.0030, "ORUA": 0.0030,
+	"VIČC": 0.0030, "UNAK": 0.0030, "ÝCHH": 0.0030, "ESAU": 0.0030, "DOJE": 0.0030,
+}
+
+var chainBigramsCZ = map[string]float64{
+	"EN": 1.3944, "OV": 1.3203, "NA": 1.2946, "PO": 1.2759, "ST": 1.2274,
+	"NE": 1.2118, "NÍ": 1.2104, "OU": 1.0359, "RO": 0.9907, "OR": 0.8237,
+	"AT": 0.8147, "PR": 0.7880, "PŘ": 0.7715, "JE": 0.7644, "ES": 0.7557,
+	"CH": 0.7546, "TE": 0.7216, "EP": 0.7036, "OD": 0.6990, "IN": 0.6809,
+	"NO": 0.6786, "SO": 0.6407, "TA": 0.6386, "EL": 0.6386, "LO": 0.6286,
+	"ZE": 0.6050, "KO": 0.6046, "ÁN": 0.5913, "BO": 0.5885, "TI": 0.5883,
+	"VA": 0.5862, "ŘE": 0.5817, "AN": 0.5581, "AL": 0.5522, "RA": 0.5494,
+	"SE": 0.5455, "SK": 0.5258, "OS": 0.5179, "NÁ": 0.4996, "ER": 0.4986,
+	"KA": 0.4984, "TO": 0.4961, "IT": 0.4924, "HO": 0.4854, "UB": 0.4781,
+	"LI": 0.4780, "LE": 0.4627, "AD": 0.4531, "ON": 0.4494, "TU": 0.4479,
+	"LA": 0.4474, "EZ": 0.4469, "VÁ": 0.4446, "AK": 0.4410, "VY": 0.4346,
+	"ZA": 0.4241, "NÝ": 0.4236, "AS": 0.4220, "ÍS": 0.4202, "AZ": 0.4172,
+	"AR": 0.4129, "VE": 0.4120, "RE": 0.4090, "CE": 0.4033, "ED": 0.4007,
+	"DO": 0.3984, "UP": 0.3943, "DN": 0.3888, "AV": 0.3856, "UJ": 0.3822,
+	"NÉ": 0.3740, "TN": 0.3678, "EV": 0.3561, "IS": 0.3559, "ČE": 0.3557,
+	"BA": 0.3547, "OL": 0.3539, "AP": 0.3518, "KU": 0.3440, "ET": 0.3419,
+	"ZN": 0.3360, "OB": 0.3326, "VÝ": 0.3301, "DA": 0.3271, "VO": 0.3262,
+	"DE": 0.3244, "SL": 0.3232, "ME": 0.3225, "NT": 0.3220, "AM": 0.3209,
+	"OZ": 0.3172, "RU": 0.3154, "AC": 0.3149, "EB": 0.3147, "ÁV": 0.3097,
+	"OP": 0.3095, "EM": 0.3076, "EK": 0.3069, "ŘÍ": 0.3058, "ŘI": 0.2976,
+	"YB": 0.2957, "UŽ": 0.2868, "OT": 0.2862, "ÍN": 0.2839, "OK": 0.2822,
+	"ÍK": 0.2806, "AČ": 0.2781, "LÍ": 0.2740, "KL": 0.2674, "ÍP": 0.2671,
+	"MA": 0.2665, "ŽI": 0.2649, "OM": 0.2647, "YP": 0.2647, "KÁ": 0.2599,
+	"HY": 0.2598, "RÁ": 0.2582, "TR": 0.2578, "KÉ": 0.2507, "IC": 0.2491,
+	"IV": 0.2486, "ŠT": 0.2486, "IK": 0.2484, "EJ": 0.2429, "PI": 0.2429,
+	"US": 0.2400, "CÍ": 0.2395, "ÁZ": 0.2383, "ÁD": 0.2354, "PL": 0.2351,
+	"SP": 0.2313, "LZ": 0.2313, "ÍC": 0.2297, "ĚN": 0.2281, "EC": 0.2169,
+	"DR": 0.2145, "BY": 0.2143, "SA": 0.2132, "UN": 0.2084, "MO": 0.2082,
+	"PÍ": 0.2022, "RI": 0.1990, "KY": 0.1965, "TV": 0.1960, "ÁT": 0.1954,
+	"AJ": 0.1951, "ÍČ": 0.1942, "DP": 0.1915, "LN": 0.1898, "OŽ": 0.1892,
+	"NI": 0.1876, "NĚ": 0.1876, "PA": 0.1819, "UD": 0.1795, "ŠE": 0.1779,
+	"MĚ": 0.1766, "OČ": 0.1754, "OJ": 0.1747, "CK": 0.1745, "MU": 0.1743,
+	"ÍM": 0.1741, "TY": 0.1734, "VN": 0.1729, "ÝC": 0.1715, "KT": 0.1699,
+	"ČÍ": 0.1676, "UM": 0.1660, "UR": 0.1656, "EO": 0.1654, "ÍT": 0.1651,
+	"NS": 0.1615, "JA": 0.1612, "UT": 0.1610, "ŽE": 0.1599, "NU": 0.1597,
+	"LÁ": 0.1597, "VĚ": 0.1578, "ID": 0.1572, "ÁL": 0.1564, "MI": 0.1551,
+	"ÍV": 0.1548, "ÉM": 0.1544, "HA": 0.1539, "OC": 0.1537, "ÁS": 0.1519,
+	"NY": 0.1517, "DK": 0.1512, "YS": 0.1510, "PU": 0.1503, "IM": 0.1485,
+	"ZÁ": 0.1480, "TP": 0.1471, "FI": 0.1464, "JÍ": 0.1462, "CI": 0.1439,
+	"TS": 0.1434, "MP": 0.1432, "AH": 0.1425, "RT": 0.1409, "BU": 0.1407,
+	"ÉH": 0.1386, "SY": 0.1379, "ZP": 0.1373, "VÉ": 0.1370, "ZO": 0.1370,
+	"IP": 0.1370, "DÁ": 0.1363, "ÝS": 0.1345, "MÁ": 0.1338, "AB": 0.1333,
+	"YL": 0.1327, "ÍZ": 0.1315, "DU": 0.1313, "JI": 0.1313, "SU": 0.1313,
+	"ČN": 0.1311, "VI": 0.1299, "TÍ": 0.1295, "IL": 0.1294, "ZÍ": 0.1281,
+	"RN": 0.1276, "YT": 0.1270, "PE": 0.1267, "YN": 0.1260, "ND": 0.1242,
+	"RM": 0.1242, "ÁŘ": 0.1240, "DI": 0.1238, "EX": 0.1235, "LU": 0.1231,
+	"FO": 0.1222, "UZ": 0.1222, "HL": 0.1214, "GU": 0.1180, "SÁ": 0.1178,
+	"VS": 0.1176, "IZ": 0.1167, "IG": 0.1167, "UK": 0.1158, "EČ": 0.1150,
+	"HE": 0.1148, "KE": 0.1139, "VÍ": 0.1137, "BE": 0.1135, "ZD": 0.1126,
+	"MÍ": 0.1118, "UV": 0.1118, "IF": 0.1114, "ÁR": 0.1111, "ÍD": 0.1103,
+	"TĚ": 0.1103, "ÉN": 0.1096, "SI": 0.1089, "BR": 0.1087, "EA": 0.1086,
+	"DS": 0.1073, "BL": 0.1068, "ÍL": 0.1057, "CO": 0.1054, "ŘÁ": 0.1052,
+	"OH": 0.1043, "ÁM": 0.1041, "OŘ": 0.1038, "IJ": 0.1025, "RY": 0.1002,
+	"KR": 0.0972, "UL": 0.0968, "PN": 0.0961, "ÝM": 0.0954, "JM": 0.0951,
+	"MÉ": 0.0949, "BN": 0.0949, "ÍH": 0.0940, "JS": 0.0931, "ÝP": 0.0927,
+	"PS": 0.0917, "ČT": 0.0915, "SH": 0.0912, "ZI": 0.0906, "BS": 0.0904,
+	"RV": 0.0897, "ÁP": 0.0896, "SÍ": 0.0887, "ŠÍ": 0.0885, "ZM": 0.0883,
+	"SM": 0.0880, "UA": 0.0876, "VU": 0.0864, "NN": 0.0862, "ČA": 0.0860,
+	"OO": 0.0856, "JT": 0.0844, "RS": 0.0816, "SN": 0.0816, "TK": 0.0814,
+	"NF": 0.0814, "LS": 0.0808, "AŽ": 0.0803, "LH": 0.0800, "HI": 0.0800,
+	"HU": 0.0794, "NK": 0.0794, "RZ": 0.0787, "ÍA": 0.0784, "TŘ": 0.0784,
+	"VR": 0.0782, "ÝT": 0.0771, "TÉ": 0.0771, "DV": 0.0769, "GN": 0.0753,
+	"MS": 0.0750, "DĚ": 0.0739, "ŽN": 0.0737, "RG": 0.0737, "GR": 0.0732,
+	"ŽÁ": 0.0732, "MN": 0.0723, "YŽ": 0.0721, "ŽA": 0.0716, "YK": 0.0713,
+	"OA": 0.0711, "ÉS": 0.0707, "RC": 0.0707, "ZY": 0.0704, "ŽÍ": 0.0697,
+	"ĚL": 0.0689, "IA": 0.0688, "ZV": 0.0686, "DL": 0.0682, "ČI": 0.0681,
+	"KN": 0.0672, "TÁ": 0.0672, "AU": 0.0670, "UŠ": 0.0670, "ÝK": 0.0661,
+	"KÝ": 0.0659, "NC": 0.0652, "ÍR": 0.0650, "XI": 0.0649, "ÓD": 0.0649,
+	"NG": 0.0647, "ÉP": 0.0645, "ÍŠ": 0.0643, "MB": 0.0636, "NČ": 0.0633,
+	"IB": 0.0631, "UH": 0.0629, "DÍ": 0.0627, "IŠ": 0.0625, "RD": 0.0624,
+	"TŮ": 0.0624, "EU": 0.0618, "EŽ": 0.0617, "BÝ": 0.0608, "LL": 0.0608,
+	"JN": 0.0608, "IČ": 0.0608, "HR": 0.0604, "YV": 0.0604, "OG": 0.0604,
+	"KÓ": 0.0599, "ÍB": 0.0597, "DY": 0.0595, "AŘ": 0.0592, "KC": 0.0590,
+	"LB": 0.0586, "YM": 0.0585, "TZ": 0.0585, "ÍJ": 0.0583, "ÉJ": 0.0583,
+	"RP": 0.0579, "ZU": 0.0579, "RŮ": 0.0574, "SC": 0.0569, "ÍO": 0.0569,
+	"BI": 0.0565, "BĚ": 0.0563, "LK": 0.0563, "PT": 0.0561, "HN": 0.0560,
+	"IE": 0.0554, "VP": 0.0554, "UČ": 0.0551, "ČK": 0.0549, "IO": 0.0547,
+	"ĚŘ": 0.0544, "KŮ": 0.0544, "HP": 0.0533, "MK": 0.0522, "FR": 0.0515,
+	"CA": 0.0513, "ĚT": 0.0513, "KD": 0.0508, "NP": 0.0505, "IR": 0.0505,
+	"EF": 0.0499, "VŠ": 0.0494, "EE": 0.0494, "TM": 0.0490, "ÝR": 0.0490,
+}
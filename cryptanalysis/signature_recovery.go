@@ -0,0 +1,345 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Key recovery of binary files from their file signature. Most file
+ * formats begin with well-known magic bytes (PNG, PDF, ZIP...). The
+ * Binary Tabula Recta adds the key to every byte (modulo 256), so the
+ * magic bytes laid over the first ciphered bytes give the key of each
+ * of them, a known plain text attack that needs no crib. From that
+ * key stream the Caesar key, the Didimus key & offset, the Fibonacci
+ * prime key or the Bellaso secret are inferred and confirmed by
+ * decrypting the beginning of the file with the very cipher.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	SIGNATURE_PEEK = 64 // bytes at the beginning of a file that hold its signature
+)
+
+var (
+	ErrSignatureVariant  = errors.New("the signature recovery supports Caesar, Didimus, Fibonacci & Bellaso")
+	ErrSignatureNotFound = errors.New("no known file signature reveals a key of the variant")
+)
+
+// the well-known signatures, the longer the more evidence
+var fileSignatures = []*FileSignature{
+	{"PNG image", "png", []SignaturePart{{0, []byte("\x89PNG\r\n\x1a\n")}, {12, []byte("IHDR")}}},
+	{"JPEG image (JFIF)", "jpg", []SignaturePart{{0, []byte("\xff\xd8\xff\xe0")}, {6, []byte("JFIF\x00")}}},
+	{"JPEG image (Exif)", "jpg", []SignaturePart{{0, []byte("\xff\xd8\xff\xe1")}, {6, []byte("Exif\x00\x00")}}},
+	{"GIF image", "gif", []SignaturePart{{0, []byte("GIF8")}, {5, []byte("a")}}},
+	{"PDF document", "pdf", []SignaturePart{{0, []byte("%PDF-")}}},
+	{"ZIP archive", "zip", []SignaturePart{{0, []byte("PK\x03\x04")}}},
+	{"GZIP archive", "gz", []SignaturePart{{0, []byte("\x1f\x8b\x08")}}},
+	{"7-Zip archive", "7z", []SignaturePart{{0, []byte("7z\xbc\xaf\x27\x1c")}}},
+	{"ELF executable", "elf", []SignaturePart{{0, []byte("\x7fELF")}, {6, []byte("\x01")}}},
+}
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// Known bytes at a fixed position of a file format
+type SignaturePart struct {
+	Offset int
+	Bytes  []byte
+}
+
+// The magic bytes of a file format
+type FileSignature struct {
+	Name      string
+	Extension string
+	Parts     []SignaturePart
+}
+
+// The key recovered with a file signature
+type SignatureSolution struct {
+	Variant   z.CipherVariant
+	Signature *FileSignature
+	Key       rune   // Caesar, Didimus & Fibonacci (prime) key
+	AltOffset int    // the alternate key offset (Didimus only)
+	Secret    string // Bellaso secret
+}
+
+// The key of a ciphered byte (ciphered - plain)
+type byteKey struct {
+	Pos   int
+	Shift int
+}
+
+type SignatureRecovery struct {
+	signatures []*FileSignature
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A key recovery of binary files ciphered with the Binary
+ * Tabula Recta (-alpha binary) with the built-in file signatures.
+ * · follow with WithSignatures() to try other file formats too.
+ */
+func NewSignatureRecovery() *SignatureRecovery {
+	return &SignatureRecovery{fileSignatures}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer
+func (s *FileSignature) String() string {
+	parts := make([]string, 0, len(s.Parts))
+	for _, part := range s.Parts {
+		parts = append(parts, fmt.Sprintf("% X @%d", part.Bytes, part.Offset))
+	}
+
+	return fmt.Sprintf("%s (%s)", s.Name, strings.Join(parts, ", "))
+}
+
+// the number of bytes at the beginning of a file the signature needs
+func (s *FileSignature) Size() int {
+	size := 0
+	for _, part := range s.Parts {
+		size = max(size, part.Offset+len(part.Bytes))
+	}
+
+	return size
+}
+
+// the plain bytes match the signature
+func (s *FileSignature) Matches(plain []byte) bool {
+	for _, part := range s.Parts {
+		end := part.Offset + len(part.Bytes)
+		if end > len(plain) || !bytes.Equal(plain[part.Offset:end], part.Bytes) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// implements fmt.Stringer
+func (s *SignatureSolution) String() string {
+	switch s.Variant {
+	case z.DidimusCipher:
+		return fmt.Sprintf("%s %s key %q offset %d", s.Variant, s.Signature.Name, s.Key, s.AltOffset)
+	case z.BellasoCipher:
+		return fmt.Sprintf("%s %s secret %q", s.Variant, s.Signature.Name, s.Secret)
+	}
+
+	return fmt.Sprintf("%s %s key %q", s.Variant, s.Signature.Name, s.Key)
+}
+
+// the signatures of other file formats, they are tried before the
+// built-in ones.
+func (r *SignatureRecovery) WithSignatures(signatures ...*FileSignature) *SignatureRecovery {
+	r.signatures = append(signatures, r.signatures...)
+	return r
+}
+
+/**
+ * Recover the key of the variant from the first bytes of a ciphered
+ * file (SIGNATURE_PEEK are enough). Every signature that reveals a key
+ * is returned, only if the cipher with that key turns the ciphered
+ * bytes back into the signature.
+ */
+func (r *SignatureRecovery) Recover(variant z.CipherVariant, head []byte) ([]*SignatureSolution, error) {
+	switch variant {
+	case z.CaesarCipher, z.DidimusCipher, z.FibonacciCipher, z.BellasoCipher:
+	default:
+		return nil, ErrSignatureVariant
+	}
+
+	solutions := make([]*SignatureSolution, 0)
+	for _, signature := range r.signatures {
+		size := signature.Size()
+		if size > len(head) {
+			continue
+		}
+
+		solution := r.infer(variant, signature, keyStream(signature, head))
+		if solution == nil {
+			continue
+		}
+
+		if !signature.Matches(r.decode(solution, head[:size])) {
+			mlog.DebugT("signature key not confirmed", mlog.String("Key", solution.String()))
+			continue
+		}
+		solutions = append(solutions, solution)
+	}
+
+	if len(solutions) == 0 {
+		return nil, ErrSignatureNotFound
+	}
+
+	return solutions, nil
+}
+
+// the key of the variant revealed by the key stream with CRIB_EVIDENCE,
+// nil if there is none.
+func (r *SignatureRecovery) infer(variant z.CipherVariant, signature *FileSignature, keys []byteKey) *SignatureSolution {
+	solution := &SignatureSolution{
+		Variant:   variant,
+		Signature: signature,
+		Key:       0,
+		AltOffset: 0,
+		Secret:    "",
+	}
+
+	switch variant {
+	case z.CaesarCipher:
+		shift := agreeOnBytes(keys)
+		if shift == -1 || len(keys)-1 < CRIB_EVIDENCE {
+			return nil
+		}
+		solution.Key = rune(shift)
+
+	case z.DidimusCipher:
+		// the prime key at the even positions, the alternate at the odd
+		even, odd := make([]byteKey, 0), make([]byteKey, 0)
+		for _, key := range keys {
+			if key.Pos%2 == 0 {
+				even = append(even, key)
+			} else {
+				odd = append(odd, key)
+			}
+		}
+		prime, alt := agreeOnBytes(even), agreeOnBytes(odd)
+		if prime == -1 || alt == -1 || alt == prime || len(keys)-2 < CRIB_EVIDENCE {
+			return nil
+		}
+		solution.Key, solution.AltOffset = rune(prime), modulo(alt-prime, int(cmn.BINARY_DISK.Size()))
+
+	case z.FibonacciCipher:
+		if len(keys)-1 < CRIB_EVIDENCE {
+			return nil
+		}
+		for _, prime := range cmn.BINARY_DISK.Chars {
+			if fibonacciMatches(crypto.NewFibonacciSequencer(cmn.BINARY_DISK, prime).Keys(), keys) {
+				solution.Key = prime
+				return solution
+			}
+		}
+		return nil
+
+	case z.BellasoCipher:
+		secret := bellasoBytes(keys)
+		// the Bellaso sequencer folds the secret to uppercase & trims it
+		if len(secret) == 0 || cmn.BINARY_DISK.ToUpperString(secret) != secret || strings.Trim(secret, " \t") != secret {
+			return nil
+		}
+		solution.Secret = secret
+	}
+
+	return solution
+}
+
+// the beginning of the ciphered file decoded with the solution
+func (r *SignatureRecovery) decode(solution *SignatureSolution, ciphered []byte) []byte {
+	var cipher ciphers.ICipher
+	switch solution.Variant {
+	case z.CaesarCipher:
+		cipher = caesar.NewCaesarTabulaRecta(cmn.BINARY_DISK, solution.Key)
+	case z.DidimusCipher:
+		cipher = caesar.NewDidimusTabulaRecta(cmn.BINARY_DISK, solution.Key, uint8(solution.AltOffset))
+	case z.FibonacciCipher:
+		cipher = caesar.NewFibonacciTabulaRecta(cmn.BINARY_DISK, solution.Key)
+	case z.BellasoCipher:
+		cipher = bellaso.NewBellasoTabulaRecta(cmn.BINARY_DISK, solution.Secret)
+	}
+
+	return cipher.DecodeBytes(ciphered)
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// the built-in file signatures
+func FileSignatures() []*FileSignature {
+	return fileSignatures
+}
+
+// the key of every byte of the signature laid over the ciphered bytes
+func keyStream(signature *FileSignature, ciphered []byte) []byteKey {
+	keys := make([]byteKey, 0, signature.Size())
+	for _, part := range signature.Parts {
+		for i, plain := range part.Bytes {
+			pos := part.Offset + i
+			keys = append(keys, byteKey{pos, modulo(int(ciphered[pos])-int(plain), int(cmn.BINARY_DISK.Size()))})
+		}
+	}
+
+	return keys
+}
+
+// the shift all the keys agree on, -1 if they don't (or there are none)
+func agreeOnBytes(keys []byteKey) int {
+	shift := -1
+	for _, key := range keys {
+		if shift != -1 && shift != key.Shift {
+			return -1
+		}
+		shift = key.Shift
+	}
+
+	return shift
+}
+
+// the derived Fibonacci keys repeat over every byte of the file
+func fibonacciMatches(derived []rune, keys []byteKey) bool {
+	for _, key := range keys {
+		if int(derived[key.Pos%len(derived)]) != key.Shift {
+			return false
+		}
+	}
+
+	return true
+}
+
+// the shortest secret the key stream is consistent with. Every letter of
+// the secret must be revealed and it must repeat CRIB_EVIDENCE times,
+// else any period would do. Empty if there is none.
+func bellasoBytes(keys []byteKey) string {
+next:
+	for period := 1; period <= len(keys)-CRIB_EVIDENCE; period++ {
+		cosets := make([][]byteKey, period)
+		for _, key := range keys {
+			cosets[key.Pos%period] = append(cosets[key.Pos%period], key)
+		}
+
+		secret := make([]rune, period)
+		for i, coset := range cosets {
+			shift := agreeOnBytes(coset)
+			if shift == -1 {
+				continue next
+			}
+			secret[i] = rune(shift)
+		}
+
+		return string(secret)
+	}
+
+	return ""
+}
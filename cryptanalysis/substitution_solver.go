@@ -0,0 +1,386 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * General monoalphabetic substitution solver. A keyword-mixed (or any
+ * shuffled) alphabet has far too many keys to brute-force them, so
+ * the key is found by hill-climbing: two letters of the key are
+ * swapped whenever that makes the decoded text more likely in the
+ * language (unigram, bigram & quadgram log-probabilities). The climb
+ * starts from the key that matches the letter frequencies. To get off
+ * a local maximum the best key is then shaken (a few random swaps) &
+ * climbed again, over & over, a key that is no worse replaces it.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/cmn"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	SUBSTITUTION_MIN_LETTERS = 50  // least letters to solve a substitution
+	SUBSTITUTION_RESTARTS    = 60  // default climbs from the shaken best key
+	SUBSTITUTION_SHAKE       = 3   // random swaps that shake the best key
+	SUBSTITUTION_SEED        = 355 // the search is repeatable
+)
+
+var (
+	ErrSubstitutionShort = errors.New("too few letters to solve a substitution")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The recovered key of a monoalphabetic substitution
+type SubstitutionSolution struct {
+	Alphabet *cmn.Alphabet
+	// ciphered to plain letters, reusable to decode the rest of the traffic
+	Key   *cmn.RuneTranslator
+	Plain string
+	Score float64 // average log10 probability per letter, the higher the better
+}
+
+type SubstitutionSolver struct {
+	ref      *LanguageStats
+	size     int
+	restarts int
+	unigrams []float64 // log10 probability by letter position
+	bigrams  []float64 // log10 probability by first*size + second
+	// the listed quadgrams grouped by their first three letters, those
+	// of the trigram T are quadLast[quadFirst[T]:quadFirst[T+1]]
+	quadFirst []int
+	quadLast  []int     // last letter position
+	quadBonus []float64 // log10 ratio of the listed quadgrams to the bigram chain
+	quadLog   float64   // log10 ratio of the rest
+}
+
+// a ciphered text as the N-grams its score adds up
+type gramText struct {
+	first  int     // ciphered position of the first letter
+	grams  [][]int // distinct bigrams & quadgrams (ciphered positions)
+	counts []int   // times every N-gram is in the text
+	with   [][]int // the N-grams (index in grams) of every ciphered letter
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A substitution solver for the language of a built-in alphabet.
+ * The scoring tables are indexed by the letter positions so the climb
+ * doesn't deal with runes at all.
+ */
+func NewSubstitutionSolver(alpha *cmn.Alphabet) (*SubstitutionSolver, error) {
+	ref := StatsFor(alpha)
+	if ref == nil {
+		return nil, ErrNoReference
+	}
+
+	letters := []rune(ref.alpha.Chars)
+	size := len(letters)
+	s := &SubstitutionSolver{
+		ref:      ref,
+		size:     size,
+		restarts: SUBSTITUTION_RESTARTS,
+		unigrams: make([]float64, size),
+		bigrams:  make([]float64, size*size),
+	}
+	for i, p := range ref.unigrams {
+		s.unigrams[i] = math.Log10(math.Max(p, BIGRAM_FLOOR))
+	}
+
+	// the N-grams that are not listed share the rest of the probability
+	// as if their letters were independent. The bigrams are those of the
+	// text the quadgrams were counted over.
+	var listed, listedIndependent float64 = 0, 0
+	for i, first := range letters {
+		for j, second := range letters {
+			if p, found := ref.chain[string([]rune{first, second})]; found {
+				s.bigrams[i*size+j] = math.Log10(p)
+				listed += p
+				listedIndependent += ref.unigrams[i] * ref.unigrams[j]
+			}
+		}
+	}
+	rest := math.Log10((1 - listed) / (1 - listedIndependent))
+	for i, first := range letters {
+		for j, second := range letters {
+			if _, found := ref.chain[string([]rune{first, second})]; !found {
+				s.bigrams[i*size+j] = rest + s.unigrams[i] + s.unigrams[j]
+			}
+		}
+	}
+
+	// the listed quadgrams are a bonus over what the bigrams predict
+	bonus := make(map[int]float64, len(ref.quads))
+	listed, listedPredicted := 0.0, 0.0
+	for _, quadgram := range slices.Sorted(maps.Keys(ref.quads)) {
+		pos := make([]int, 0, 4)
+		for _, r := range quadgram {
+			pos = append(pos, ref.alpha.PositionOf(r))
+		}
+		predicted := s.bigrams[pos[0]*size+pos[1]]
+		for i := 2; i < 4; i++ {
+			predicted += s.bigrams[pos[i-1]*size+pos[i]] - s.unigrams[pos[i-1]]
+		}
+
+		p := ref.quads[quadgram]
+		bonus[((pos[0]*size+pos[1])*size+pos[2])*size+pos[3]] = math.Log10(p) - predicted
+		listed += p
+		listedPredicted += math.Pow(10, predicted)
+	}
+	s.quadLog = math.Log10((1 - listed) / (1 - listedPredicted))
+
+	// a lookup by trigram is much faster than a map during the climb
+	s.quadFirst = make([]int, size*size*size+1)
+	for _, quad := range slices.Sorted(maps.Keys(bonus)) {
+		s.quadFirst[quad/size+1]++
+		s.quadLast = append(s.quadLast, quad%size)
+		s.quadBonus = append(s.quadBonus, bonus[quad])
+	}
+	for i := 1; i < len(s.quadFirst); i++ {
+		s.quadFirst[i] += s.quadFirst[i-1]
+	}
+
+	return s, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (s *SubstitutionSolution) String() string {
+	return fmt.Sprintf("%s %s (%.3f)", s.Alphabet.Name, s.Key.GetTarget(), s.Score)
+}
+
+// the number of times the best key is shaken & climbed again, more is
+// slower but less likely to get stuck.
+func (s *SubstitutionSolver) WithRestarts(restarts int) *SubstitutionSolver {
+	s.restarts = max(restarts, 0)
+	return s
+}
+
+/**
+ * Recover the substitution key of the ciphered text. Runes foreign to the
+ * alphabet (word separators, punctuation) are left as they are. The key
+ * of letters absent in the ciphered text is a guess.
+ */
+func (s *SubstitutionSolver) Solve(ciphered string) (*SubstitutionSolution, error) {
+	text := make([]int, 0, len(ciphered))
+	for _, r := range ciphered {
+		if pos := s.ref.alpha.PositionOf(toUpperRune(s.ref.alpha, r)); pos != -1 {
+			text = append(text, pos)
+		}
+	}
+	if len(text) < SUBSTITUTION_MIN_LETTERS {
+		return nil, ErrSubstitutionShort
+	}
+
+	grams := s.newGramText(text)
+	random := rand.New(rand.NewSource(SUBSTITUTION_SEED))
+	best := s.initialKey(text)
+	bestScore := s.climb(best, grams)
+	key := make([]int, s.size)
+	for range s.restarts {
+		copy(key, best)
+		for range SUBSTITUTION_SHAKE {
+			i, j := random.Intn(s.size), random.Intn(s.size)
+			key[i], key[j] = key[j], key[i]
+		}
+
+		if score := s.climb(key, grams); score >= bestScore {
+			copy(best, key)
+			bestScore = score
+		}
+	}
+	bestScore = s.score(best, grams) // without the rounding of the swaps
+	mlog.DebugT("Substitution solved", mlog.String("Alpha", s.ref.alpha.Name), mlog.Int("Letters", len(text)), mlog.String("Score", fmt.Sprintf("%.3f", bestScore)))
+
+	return s.solution(best, bestScore/float64(len(text)), ciphered), nil
+}
+
+// the most common ciphered letters are the most common of the language
+func (s *SubstitutionSolver) initialKey(text []int) []int {
+	counts := make([]int, s.size)
+	for _, pos := range text {
+		counts[pos]++
+	}
+
+	ciphered := make([]int, s.size)
+	plain := make([]int, s.size)
+	for i := range s.size {
+		ciphered[i], plain[i] = i, i
+	}
+	sort.SliceStable(ciphered, func(i, j int) bool { return counts[ciphered[i]] > counts[ciphered[j]] })
+	sort.SliceStable(plain, func(i, j int) bool { return s.unigrams[plain[i]] > s.unigrams[plain[j]] })
+
+	key := make([]int, s.size)
+	for i := range s.size {
+		key[ciphered[i]] = plain[i]
+	}
+
+	return key
+}
+
+// the ciphered text as its distinct bigrams & quadgrams, the ones of
+// every ciphered letter are indexed so that swapping two letters of
+// the key only rescores theirs.
+func (s *SubstitutionSolver) newGramText(text []int) *gramText {
+	g := &gramText{first: text[0], with: make([][]int, s.size)}
+	found := make(map[int]int)
+	for i := 1; i < len(text); i++ {
+		for _, length := range []int{2, 4} {
+			if i+1 < length {
+				continue
+			}
+			gram := text[i+1-length : i+1]
+			idx := length // the bigrams & quadgrams don't share an index
+			for _, pos := range gram {
+				idx = idx*s.size + pos
+			}
+			if at, ok := found[idx]; ok {
+				g.counts[at]++
+				continue
+			}
+
+			at := len(g.grams)
+			found[idx] = at
+			g.grams = append(g.grams, gram)
+			g.counts = append(g.counts, 1)
+			for j, pos := range gram {
+				if !slices.Contains(gram[:j], pos) {
+					g.with[pos] = append(g.with[pos], at)
+				}
+			}
+		}
+	}
+
+	return g
+}
+
+// swaps pairs of the key in place while the score improves, it returns
+// the final score.
+func (s *SubstitutionSolver) climb(key []int, text *gramText) float64 {
+	best := s.score(key, text)
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < s.size-1; i++ {
+			for j := i + 1; j < s.size; j++ {
+				if delta := s.swap(key, text, i, j); delta > 0 {
+					best, improved = best+delta, true
+				} else {
+					key[i], key[j] = key[j], key[i]
+				}
+			}
+		}
+	}
+
+	return best
+}
+
+// log10 likelihood of the text deciphered with the key. Every letter
+// depends on the previous one (bigram chain) & the listed quadgrams
+// correct the chain.
+func (s *SubstitutionSolver) score(key []int, text *gramText) float64 {
+	score := s.unigrams[key[text.first]]
+	for at, gram := range text.grams {
+		score += float64(text.counts[at]) * s.gramScore(key, gram)
+	}
+
+	return score
+}
+
+// swaps the key of the ciphered letters i & j, it returns the change of
+// the score.
+func (s *SubstitutionSolver) swap(key []int, text *gramText, i, j int) float64 {
+	delta := -s.partialScore(key, text, i, j)
+	key[i], key[j] = key[j], key[i]
+
+	return delta + s.partialScore(key, text, i, j)
+}
+
+// the part of the score of the N-grams holding the ciphered letters i or j
+func (s *SubstitutionSolver) partialScore(key []int, text *gramText, i, j int) float64 {
+	var score float64 = 0
+	if text.first == i || text.first == j {
+		score += s.unigrams[key[text.first]]
+	}
+	for _, at := range text.with[i] {
+		score += float64(text.counts[at]) * s.gramScore(key, text.grams[at])
+	}
+	for _, at := range text.with[j] {
+		if gram := text.grams[at]; !slices.Contains(gram, i) {
+			score += float64(text.counts[at]) * s.gramScore(key, gram)
+		}
+	}
+
+	return score
+}
+
+// the bigram chain link or the quadgram correction of a ciphered N-gram
+func (s *SubstitutionSolver) gramScore(key, gram []int) float64 {
+	if len(gram) == 2 {
+		return s.bigrams[key[gram[0]]*s.size+key[gram[1]]] - s.unigrams[key[gram[0]]]
+	}
+
+	trigram := (key[gram[0]]*s.size+key[gram[1]])*s.size + key[gram[2]]
+	for at := s.quadFirst[trigram]; at < s.quadFirst[trigram+1]; at++ {
+		if s.quadLast[at] == key[gram[3]] {
+			return s.quadBonus[at]
+		}
+	}
+	return s.quadLog
+}
+
+// the key as a RuneTranslator and the text it deciphers
+func (s *SubstitutionSolver) solution(key []int, score float64, ciphered string) *SubstitutionSolution {
+	alpha := s.ref.alpha
+	var plain strings.Builder
+	for _, pos := range key {
+		plain.WriteRune(alpha.GetRuneAt(pos))
+	}
+
+	caser := alpha.BorrowSpecialCase()
+	if caser == nil {
+		caser = cmn.DefaultCaseHandler
+	}
+	translator := cmn.NewSimpleRuneTranslator(alpha.Name, alpha.Chars, plain.String(), caser)
+
+	return &SubstitutionSolution{
+		Alphabet: alpha,
+		Key:      translator,
+		Plain:    DecodeSubstitution(translator, ciphered),
+		Score:    score,
+	}
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// Decode a text with a recovered substitution key, the case is preserved
+// and the runes the key doesn't know are left as they are.
+func DecodeSubstitution(key *cmn.RuneTranslator, ciphered string) string {
+	var sb strings.Builder
+	for _, r := range ciphered {
+		plain, _ := key.Lookup(r) // unknown runes are returned as they are
+		sb.WriteRune(plain)
+	}
+
+	return sb.String()
+}
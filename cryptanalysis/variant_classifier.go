@@ -0,0 +1,475 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * Identification of the cipher variant (and alphabet) that most likely
+ * produced a ciphered text. The alphabet is identified by the letters
+ * of the message, the NGram grouping is undone, and the Index of
+ * Coincidence tells monoalphabetic, periodic and flat texts apart.
+ * The Tabula Recta variants keep the word separators & punctuation,
+ * the polygraphic & fractionating ones strip them.
+ *-----------------------------------------------------------------*/
+package cryptanalysis
+
+import (
+	"errors"
+	"fmt"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/beaufort"
+	"lordofscripts/caesarx/cmn"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	CLASSIFY_SAMPLE   = 2000 // runes of the ciphered text that are analysed
+	CLASSIFY_IC_RATIO = 0.55 // of the way from the random to the language IC
+	CLASSIFY_COSET    = 15   // least letters per coset (or per primer letter) to trust it
+	CLASSIFY_FITNESS  = -0.5 // a decoded text with this fitness reads like the language
+	CLASSIFY_MARGIN   = 0.3  // least fitness lead of the decoding over the runner-up
+	FIBONACCI_PERIOD  = 10   // keys of the Fibonacci series, skipped runes count
+	MAX_NGRAM_GROUP   = 5    // largest NGram grouping (NgramCmd)
+)
+
+var (
+	ErrClassifyEmpty   = errors.New("the ciphered text has no letters nor digits")
+	ErrClassifyUnknown = errors.New("no CaesarX variant produces such a text")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The statistics of a ciphered text the variant is identified by
+type CipherProfile struct {
+	Alphabet   *cmn.Alphabet // most likely (master) alphabet, nil if no letters
+	Coverage   float64       // ratio of the letters in the alphabet
+	Letters    int           // of the alphabet in the analysed text
+	Grouping   int           // NGram group size, 0 if not grouped
+	Stripped   bool          // only uppercase letters, no word separators
+	IC         float64       // Index of Coincidence of the letters
+	ExpectedIC float64       // of a plain text of the language
+	RandomIC   float64       // of random letters
+	Period     int           // key length whose cosets look like the language, 0 if none
+	PeriodIC   float64       // average IC of those cosets
+	PositionIC float64       // average IC of every 10th rune (Fibonacci)
+}
+
+// A possible cipher variant of a ciphered text
+type VariantGuess struct {
+	Variant    z.CipherVariant
+	Alphabet   *cmn.Alphabet // nil if unknown (Polybius & ADFGVX)
+	Confidence float64       // 0..1, those of all the guesses add up to 1
+	Reason     string
+}
+
+type VariantClassifier struct {
+	slave   *cmn.Alphabet
+	guesses map[z.CipherVariant]*VariantGuess
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) A classifier of ciphered texts by the CaesarX variant that
+ * produced them. Only the built-in language alphabets are identified.
+ */
+func NewVariantClassifier() *VariantClassifier {
+	return &VariantClassifier{nil, make(map[z.CipherVariant]*VariantGuess)}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+func (g *VariantGuess) String() string {
+	alphaName := "?"
+	if g.Alphabet != nil {
+		alphaName = g.Alphabet.Name
+	}
+
+	return fmt.Sprintf("%s %s %.0f%% (%s)", g.Variant, alphaName, 100*g.Confidence, g.Reason)
+}
+
+// the slave alphabet (digits, punctuation) the message was ciphered with,
+// there is none by default (like in the CLI). The crackers that confirm
+// the guesses use it too.
+func (v *VariantClassifier) WithChain(slave *cmn.Alphabet) *VariantClassifier {
+	v.slave = slave
+	return v
+}
+
+// the ratio of the IC from that of random letters to that of the language
+func (p *CipherProfile) relativeIC(ic float64) float64 {
+	if p.ExpectedIC <= p.RandomIC {
+		return 0
+	}
+
+	return (ic - p.RandomIC) / (p.ExpectedIC - p.RandomIC)
+}
+
+/**
+ * The statistics of (the first CLASSIFY_SAMPLE runes of) the ciphered
+ * text. The NGram grouping is undone first. Texts with letters foreign
+ * to every built-in alphabet use the one that covers them best.
+ */
+func (v *VariantClassifier) Profile(ciphered string) (*CipherProfile, error) {
+	if runes := []rune(ciphered); len(runes) > CLASSIFY_SAMPLE {
+		ciphered = string(runes[:CLASSIFY_SAMPLE])
+	}
+	text, grouping := ungroup(ciphered)
+
+	profile := &CipherProfile{Grouping: grouping, Stripped: isStripped(text)}
+	matches := cmn.IdentifyAlphabets(text)
+	if len(matches) == 0 {
+		if strings.IndexFunc(text, unicode.IsDigit) == -1 {
+			return nil, ErrClassifyEmpty
+		}
+		return profile, nil
+	}
+
+	profile.Alphabet, profile.Coverage = matches[0].Alphabet, matches[0].Coverage
+	ref := StatsFor(profile.Alphabet)
+	if ref == nil {
+		return nil, ErrNoReference
+	}
+	profile.ExpectedIC, profile.RandomIC = ref.ExpectedIC(), ref.RandomIC()
+
+	table := CountFrequencies(text, profile.Alphabet)
+	profile.Letters, profile.IC = table.Total(), table.IndexOfCoincidence()
+
+	// the shortest periodic key over the letters (Didimus, Bellaso, Beaufort)
+	// unless they look like the language already (monoalphabetic)
+	cracker, _ := NewPolyCracker(profile.Alphabet) // has reference
+	letters := cracker.WithChain(v.slave).keyedLetters(text)
+	if profile.relativeIC(profile.IC) >= CLASSIFY_IC_RATIO {
+		letters = nil
+	}
+	for length := 2; length <= MAX_KEY_LENGTH && length*CLASSIFY_COSET <= len(letters); length++ {
+		var ic float64 = 0
+		for _, coset := range cracker.cosets(letters, length) {
+			ic += indexOfCoincidence(coset, profile.Alphabet.Size()) / float64(length)
+		}
+		if profile.relativeIC(ic) >= CLASSIFY_IC_RATIO {
+			profile.Period, profile.PeriodIC = length, ic
+			break
+		}
+	}
+
+	// the Fibonacci key repeats over the runes, skipped ones included
+	cosets := make([][]int, FIBONACCI_PERIOD)
+	for i, r := range []rune(text) {
+		if pos := profile.Alphabet.PositionOf(toUpperRune(profile.Alphabet, r)); pos != -1 {
+			cosets[i%FIBONACCI_PERIOD] = append(cosets[i%FIBONACCI_PERIOD], pos)
+		}
+	}
+	for _, coset := range cosets {
+		profile.PositionIC += indexOfCoincidence(coset, profile.Alphabet.Size()) / FIBONACCI_PERIOD
+	}
+
+	return profile, nil
+}
+
+/**
+ * The likely cipher variants of the ciphered text, the most likely first.
+ * The profile decides among the families, the monoalphabetic & periodic
+ * candidates are confirmed by cracking them. The guesses of variants that
+ * can be cracked feed the crack commands directly.
+ */
+func (v *VariantClassifier) Classify(ciphered string) ([]*VariantGuess, *CipherProfile, error) {
+	profile, err := v.Profile(ciphered)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	text, _ := ungroup(ciphered)
+	if runes := []rune(text); len(runes) > CLASSIFY_SAMPLE {
+		text = string(runes[:CLASSIFY_SAMPLE])
+	}
+	core := strings.Join(strings.Fields(text), "")
+
+	clear(v.guesses)
+	switch {
+	case profile.Alphabet == nil:
+		v.classifyDigits(core)
+	case isSubset(core, "ADFGVX"):
+		v.guess(z.AdfgvxCipher, nil, 0.7, "only the letters ADFGVX")
+		v.guess(z.PolybiusCipher, nil, 0.3, "ADFGX labels")
+	case profile.Stripped:
+		v.classifyStripped(profile, core)
+	default:
+		v.classifyTabula(profile, text)
+	}
+
+	if len(v.guesses) == 0 {
+		return nil, profile, ErrClassifyUnknown
+	}
+
+	return v.ranked(), profile, nil
+}
+
+// Polybius squares with numeric labels
+func (v *VariantClassifier) classifyDigits(core string) {
+	if len([]rune(core))%2 == 0 && isSubset(core, "123456789") {
+		v.guess(z.PolybiusCipher, nil, 1, "digits in pairs")
+	}
+}
+
+/**
+ * Only uppercase letters without word separators: polygraphic (Playfair,
+ * Hill) or fractionating (Bifid) ciphers. Playfair never ciphers a
+ * doubled letter pair and, like Bifid, lacks one letter (J).
+ */
+func (v *VariantClassifier) classifyStripped(profile *CipherProfile, core string) {
+	runes := []rune(core)
+	even := len(runes)%2 == 0
+	doubled := false
+	for i := 0; even && i+1 < len(runes); i += 2 {
+		doubled = doubled || runes[i] == runes[i+1]
+	}
+	lacksJ := !strings.ContainsRune(strings.ToUpper(core), 'J')
+
+	switch {
+	case even && !doubled && lacksJ:
+		v.guess(z.PlayfairCipher, profile.Alphabet, 1, "letter pairs never doubled, no J")
+	case even && !doubled:
+		v.guess(z.PlayfairCipher, profile.Alphabet, 0.3, "letter pairs never doubled")
+	}
+
+	if lacksJ {
+		v.guess(z.BifidCipher, profile.Alphabet, 0.6, "no J, letters only")
+	} else {
+		v.guess(z.BifidCipher, profile.Alphabet, 0.1, "letters only")
+	}
+
+	if even || len(runes)%3 == 0 {
+		v.guess(z.HillCipher, profile.Alphabet, 0.5, "length is a multiple of the block")
+	} else {
+		v.guess(z.HillCipher, profile.Alphabet, 0.1, "letters only")
+	}
+	v.guess(z.EnigmaCipher, profile.Alphabet, 0.2, "letters only")
+
+	// an uppercase plain text without spaces ciphered with a Tabula
+	if profile.relativeIC(profile.IC) >= CLASSIFY_IC_RATIO {
+		v.guess(z.CaesarCipher, profile.Alphabet, 0.5, fmt.Sprintf("IC %.4f of the language", profile.IC))
+	}
+}
+
+/**
+ * The Tabula Recta variants (and Affine, Enigma) keep the separators.
+ * The variants with a small key space are confirmed by cracking them,
+ * the IC of some key length means a periodic cipher, otherwise the key
+ * doesn't repeat.
+ */
+func (v *VariantClassifier) classifyTabula(profile *CipherProfile, text string) {
+	alpha := profile.Alphabet
+	ref := StatsFor(alpha)
+	icReason := fmt.Sprintf("IC %.4f", profile.IC)
+	periodReason := fmt.Sprintf("key length %d (IC %.4f)", profile.Period, profile.PeriodIC)
+	fibonacciReason := fmt.Sprintf("every %dth rune IC %.4f", FIBONACCI_PERIOD, profile.PositionIC)
+
+	caesar, _ := NewKeyCracker(z.CaesarCipher)
+	didimus, _ := NewKeyCracker(z.DidimusCipher)
+	fibonacci, _ := NewKeyCracker(z.FibonacciCipher)
+	poly, _ := NewPolyCracker(alpha)
+	poly.WithChain(v.slave)
+	switch {
+	case v.decodes(caesar.WithChain(v.slave).Crack(text, alpha)):
+		v.guess(z.CaesarCipher, alpha, 1, icReason+", a shift decodes it")
+		v.guess(z.AffineCipher, alpha, 0.2, icReason)
+
+	case v.decodesAffine(NewAffineCracker().WithChain(v.slave).Crack(text, alpha)):
+		v.guess(z.AffineCipher, alpha, 1, icReason+", only an Affine key decodes it")
+		v.guess(z.CaesarCipher, alpha, 0.1, icReason)
+
+	case v.decodes(fibonacci.WithChain(v.slave).Crack(text, alpha)):
+		v.guess(z.FibonacciCipher, alpha, 1, fibonacciReason+", a prime key decodes it")
+
+	// short texts may prefer a multiple of the key length (or none at all
+	// when half the keys repeat)
+	case profile.Period%2 == 0 && v.decodes(didimus.WithChain(v.slave).Crack(text, alpha)):
+		v.guess(z.DidimusCipher, alpha, 1, periodReason+", prime & alternate keys decode it")
+		v.guess(z.BellasoCipher, alpha, 0.3, periodReason)
+
+	case profile.Period != 0 && v.readable(v.solveBeaufort(poly, ref, text, profile.Period), v.solveBeaufort(poly, ref, text, profile.Period+1)):
+		v.guess(z.BeaufortCipher, alpha, 1, periodReason+", reversed alphabets decode it")
+		v.guess(z.BellasoCipher, alpha, 0.1, periodReason)
+
+	case profile.Period != 0 && v.readable(poly.SolveBellasoLength(text, profile.Period).Score, poly.SolveBellasoLength(text, profile.Period+1).Score):
+		v.guess(z.BellasoCipher, alpha, 1, periodReason+", a secret decodes it")
+		v.guess(z.VariantBeaufortCipher, alpha, 0.5, periodReason+", same shifts as Bellaso")
+		v.guess(z.BeaufortCipher, alpha, 0.1, periodReason)
+
+	case profile.relativeIC(profile.PositionIC) >= CLASSIFY_IC_RATIO:
+		v.guess(z.FibonacciCipher, alpha, 1, fibonacciReason)
+
+	case profile.relativeIC(profile.IC) >= CLASSIFY_IC_RATIO:
+		v.guess(z.CaesarCipher, alpha, 0.5, icReason+" of the language")
+		v.guess(z.AffineCipher, alpha, 0.5, icReason+" of the language")
+
+	default:
+		reason := fmt.Sprintf("flat IC %.4f", profile.IC)
+		// a long primer fits any short text
+		poly.WithMaxLength(profile.Letters / CLASSIFY_COSET)
+		if solution, err := poly.SolveAutokey(text); err == nil && solution.Score >= CLASSIFY_FITNESS {
+			v.guess(z.VigenereCipher, alpha, 1, reason+", an autokey decodes it")
+		} else {
+			v.guess(z.VigenereCipher, alpha, 0.3, reason)
+		}
+		v.guess(z.RunningKeyCipher, alpha, 0.4, reason)
+		v.guess(z.EnigmaCipher, alpha, 0.4, reason)
+		if profile.Period != 0 { // but no secret decodes it (wrong slave?)
+			v.guess(z.BellasoCipher, alpha, 0.3, periodReason)
+		}
+	}
+}
+
+/**
+ * The fitness of the text deciphered as Beaufort with the given key
+ * length. Every coset is a reversed alphabet, p = k - c, solved with the
+ * lowest Chi-Squared.
+ */
+func (v *VariantClassifier) solveBeaufort(poly *PolyCracker, ref *LanguageStats, text string, length int) float64 {
+	secret := make([]rune, length)
+	for i, coset := range poly.cosets(poly.keyedLetters(text), length) {
+		secret[i] = ref.Alphabet().GetRuneAt(reflectedShift(coset, ref))
+	}
+
+	return poly.solution(z.BeaufortCipher, beaufort.NewBeaufortTabulaRecta(ref.Alphabet(), string(secret)), string(secret), text).Score
+}
+
+// the best candidate of a brute-force attack reads like the language
+func (v *VariantClassifier) decodes(candidates []*Candidate, err error) bool {
+	if err != nil || len(candidates) < 2 {
+		return false
+	}
+	return v.readable(candidates[0].Score, candidates[1].Score)
+}
+
+func (v *VariantClassifier) decodesAffine(candidates []*AffineCandidate, err error) bool {
+	if err != nil || len(candidates) < 2 {
+		return false
+	}
+	return v.readable(candidates[0].Score, candidates[1].Score)
+}
+
+// a decoding reads like the language & clearly beats the runner-up key
+// (or key length), the wrong ones only come close to it when it's noise.
+func (v *VariantClassifier) readable(best, runnerUp float64) bool {
+	return best >= CLASSIFY_FITNESS && best-runnerUp >= CLASSIFY_MARGIN
+}
+
+func (v *VariantClassifier) guess(variant z.CipherVariant, alpha *cmn.Alphabet, weight float64, reason string) {
+	v.guesses[variant] = &VariantGuess{variant, alpha, weight, reason}
+}
+
+// the guesses by decreasing confidence, the weights normalized
+func (v *VariantClassifier) ranked() []*VariantGuess {
+	guesses := make([]*VariantGuess, 0, len(v.guesses))
+	var total float64 = 0
+	for _, guess := range v.guesses {
+		guesses = append(guesses, guess)
+		total += guess.Confidence
+	}
+
+	for _, guess := range guesses {
+		guess.Confidence /= total
+	}
+	sort.SliceStable(guesses, func(i, j int) bool {
+		if guesses[i].Confidence != guesses[j].Confidence {
+			return guesses[i].Confidence > guesses[j].Confidence
+		}
+		return guesses[i].Variant < guesses[j].Variant
+	})
+
+	return guesses
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// the text without the separators NgramCmd inserts and the size of its
+// groups, 0 if it isn't grouped
+func ungroup(text string) (string, int) {
+	runes := []rune(strings.TrimSpace(text))
+	for size := 2; size <= MAX_NGRAM_GROUP; size++ {
+		if len(runes) <= 2*(size+1) {
+			continue
+		}
+
+		sep := runes[size]
+		if unicode.IsLetter(sep) || unicode.IsDigit(sep) {
+			continue
+		}
+
+		grouped := true
+		for i, r := range runes {
+			if ((i+1)%(size+1) == 0) != (r == sep) {
+				grouped = false
+				break
+			}
+		}
+		if grouped {
+			return strings.ReplaceAll(string(runes), string(sep), ""), size
+		}
+	}
+
+	return text, 0
+}
+
+// only uppercase letters and line breaks, i.e. Playfair, Hill & Bifid
+func isStripped(text string) bool {
+	letters := 0
+	for _, r := range text {
+		switch {
+		case unicode.IsUpper(r):
+			letters++
+		case r != '\n' && r != '\r':
+			return false
+		}
+	}
+
+	return letters != 0
+}
+
+// all the runes of the text are in the set
+func isSubset(text, set string) bool {
+	return len(text) != 0 && strings.IndexFunc(text, func(r rune) bool {
+		return !strings.ContainsRune(set, r)
+	}) == -1
+}
+
+// the shift of a reversed alphabet (p = k - c) with the lowest
+// Chi-Squared against the language
+func reflectedShift(coset []int, ref *LanguageStats) int {
+	size := int(ref.Alphabet().Size())
+	bestShift, bestChi := 0, math.Inf(1)
+	for shift := range size {
+		counts := make([]int, size)
+		for _, pos := range coset {
+			counts[modulo(shift-pos, size)]++
+		}
+
+		var chi float64 = 0
+		for pos, observed := range counts {
+			if expected := ref.ExpectedAt(pos) * float64(len(coset)); expected > 0 {
+				delta := float64(observed) - expected
+				chi += delta * delta / expected
+			}
+		}
+		if chi < bestChi {
+			bestShift, bestChi = shift, chi
+		}
+	}
+
+	return bestShift
+}
@@ -75,6 +75,14 @@ func (h *AffineHelper) AreCoprime(a, b int) bool {
 	return gcd.Cmp(big.NewInt(1)) == 0
 }
 
+/**
+ * The mathematical (never negative) remainder of a modulo n, Go's %
+ * keeps the sign of a.
+ */
+func (h *AffineHelper) Modulo(a, n int) int {
+	return ((a % n) + n) % n
+}
+
 /**
  * For a given alphabet length N, get the slice of
  * coprimes of N between (0..N]
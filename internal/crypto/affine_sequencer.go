@@ -4,6 +4,11 @@
  * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
  * The Key Sequencer is a flexible generator of the current key
  * during Encoding/Decoding.
+ * The Affine sequencer delivers a schedule of (A,B) coefficient
+ * pairs, one per (encodable) position. The B coefficient is the
+ * additive shift and is given as a key rune like the Caesar family,
+ * the A coefficient is the multiplier and is given by GetMultiplier().
+ * A single pair is the classic (monoalphabetic) Affine cipher.
  *-----------------------------------------------------------------*/
 package crypto
 
@@ -11,6 +16,7 @@ import (
 	"fmt"
 	"lordofscripts/caesarx/app/mlog"
 	"lordofscripts/caesarx/cmn"
+	"strings"
 )
 
 /* ----------------------------------------------------------------
@@ -20,22 +26,38 @@ import (
 const ALG_NAME_AFFINE = "Affine"
 const ALG_CODE_AFFINE = "AFIN"
 
+const ALG_NAME_AFFINE_POLY = "Polyalphabetic Affine"
+const ALG_CODE_AFFINE_POLY = "AFIP"
+
 /* ----------------------------------------------------------------
  *						I n t e r f a c e s
  *-----------------------------------------------------------------*/
 
 var _ IKeySequencer = (*AffineSequencer)(nil)
+var _ IAffineSequencer = (*AffineSequencer)(nil)
 
 /* ----------------------------------------------------------------
  *							T y p e s
  *-----------------------------------------------------------------*/
 
+// A pair of Affine coefficients f(x) = (A*x + B) % N
+type AffinePair struct {
+	A int // multiplier, coprime of N
+	B int // shift, applied modulo N
+}
+
 type AffineSequencer struct {
-	coefA   int
-	coefAp  int
-	coefB   int
-	modulo  int
-	skipped int
+	schedule []AffinePair
+	keys     []rune                  // the B coefficients as master runes
+	factors  map[int][]affineFactors // A & A' of every pair for an alphabet length
+	modulo   int
+	skipped  int
+}
+
+// the multiplier A and its modular inverse A' for a given N
+type affineFactors struct {
+	a  int
+	aP int
 }
 
 /* ----------------------------------------------------------------
@@ -48,21 +70,81 @@ type AffineSequencer struct {
  * error if something happens.
  */
 func NewAffineSequencer(a, b int, alpha *cmn.Alphabet) *AffineSequencer {
+	return NewAffineScheduleSequencer([]AffinePair{{a, b}}, alpha)
+}
+
+/**
+ * Instantiates a polyalphabetic Affine Sequencer that repeats the
+ * schedule of coefficients over the encodable runes of the message.
+ * Every A must be a coprime of the alphabet length N. It returns nil
+ * (and logs the error) if any of the pairs is invalid.
+ */
+func NewAffineScheduleSequencer(schedule []AffinePair, alpha *cmn.Alphabet) *AffineSequencer {
+	n := int(alpha.Size())
+	if len(schedule) == 0 {
+		mlog.ErrorT("empty Affine schedule", mlog.At())
+		return nil
+	}
+
 	ahlp := NewAffineHelper()
-	if aP, err := ahlp.VerifySettings(a, b, int(alpha.Size())); err != nil {
-		mlog.ErrorT("couldn't instantiate AffineHelper",
-			mlog.At(),
-			mlog.Err(err))
+	keys := make([]rune, len(schedule))
+	master := make([]affineFactors, len(schedule))
+	for i, pair := range schedule {
+		aP, err := ahlp.VerifySettings(pair.A, pair.B, n)
+		if err != nil {
+			mlog.ErrorT("couldn't instantiate AffineHelper",
+				mlog.Int("Pair", i+1),
+				mlog.At(),
+				mlog.Err(err))
+			return nil
+		}
+
+		keys[i] = alpha.GetRuneAt(pair.B % n)
+		master[i] = affineFactors{pair.A, aP}
+	}
+
+	return &AffineSequencer{
+		schedule: schedule,
+		keys:     keys,
+		factors:  map[int][]affineFactors{n: master},
+		modulo:   n,
+		skipped:  0,
+	}
+}
+
+/**
+ * Instantiates a polyalphabetic Affine Sequencer whose schedule is
+ * derived from a keyword. Every letter at (0-based) position k in the
+ * alphabet gives the pair B=k and A=the k-th valid coprime of N (other
+ * than 1, which would make it a plain Caesar shift). It returns nil if
+ * the keyword has letters that are not in the alphabet.
+ */
+func NewAffineKeywordSequencer(keyword string, alpha *cmn.Alphabet) *AffineSequencer {
+	keyword = strings.Trim(alpha.ToUpperString(keyword), " \t")
+	if len(keyword) == 0 {
+		mlog.ErrorT("empty Affine keyword", mlog.At())
 		return nil
-	} else {
-		return &AffineSequencer{
-			coefA:   a,
-			coefB:   b,
-			coefAp:  aP,
-			modulo:  int(alpha.Size()),
-			skipped: 0,
+	}
+
+	coprimes := NewAffineHelper().ValidCoprimesUpTo(alpha.Size())
+	if len(coprimes) > 1 {
+		coprimes = coprimes[1:]
+	}
+
+	schedule := make([]AffinePair, 0, len(keyword))
+	for _, char := range keyword {
+		k := alpha.PositionOf(char)
+		if k == -1 {
+			mlog.ErrorT("invalid keyword for Affine sequencer",
+				mlog.Rune("Letter", char),
+				mlog.String("Alpha", alpha.Name))
+			return nil
 		}
+
+		schedule = append(schedule, AffinePair{coprimes[k%len(coprimes)], k})
 	}
+
+	return NewAffineScheduleSequencer(schedule, alpha)
 }
 
 /* ----------------------------------------------------------------
@@ -71,9 +153,24 @@ func NewAffineSequencer(a, b int, alpha *cmn.Alphabet) *AffineSequencer {
 
 // The sequencer's friendly name.
 func (cs *AffineSequencer) Name() string {
+	if cs.IsPolyalphabetic() {
+		return ALG_NAME_AFFINE_POLY
+	}
 	return ALG_NAME_AFFINE
 }
 
+// whether the schedule has more than one pair of coefficients
+func (cs *AffineSequencer) IsPolyalphabetic() bool {
+	return len(cs.schedule) > 1
+}
+
+// a copy of the schedule of coefficients
+func (cs *AffineSequencer) Schedule() []AffinePair {
+	schedule := make([]AffinePair, len(cs.schedule))
+	copy(schedule, cs.schedule)
+	return schedule
+}
+
 /**
  * N.A.
  */
@@ -98,22 +195,57 @@ func (cs *AffineSequencer) Skip() int {
 	return cs.skipped
 }
 
-// (N.A.) Affine does not use a "key" but coefficients to a formula.
-// therefore it returns the target rune as-is.
+/**
+ * Get the key to be used for encoding target rune at this position.
+ * For Affine the key is the B coefficient of the scheduled pair as
+ * a rune of the master alphabet, i.e. the additive shift.
+ *
+ * @param pos (int) position of the target rune in the message
+ * @param target (rune) ignored in this algorithm
+ * @returns the basic key to use for encoding/decoding at this position.
+ */
 func (cs *AffineSequencer) GetKey(pos int, target rune) rune {
-	return target
+	return cs.keys[cs.index(pos)]
+}
+
+/**
+ * Get the multiplier (the A coefficient) and its modular inverse (A')
+ * of the scheduled pair at this position for an alphabet of length n.
+ * A slave alphabet of another length keeps A if it is also a coprime
+ * of n, else it is recalculated like the monoalphabetic Affine does.
+ */
+func (cs *AffineSequencer) GetMultiplier(pos int, n int) (int, int) {
+	factors, found := cs.factors[n]
+	if !found {
+		factors = cs.slaveFactors(n)
+		cs.factors[n] = factors
+	}
+
+	f := factors[cs.index(pos)]
+	return f.a, f.aP
 }
 
 func (cs *AffineSequencer) String() string {
-	return ALG_NAME_AFFINE
+	return cs.Name()
 }
 
 func (cs *AffineSequencer) GetKeyInfo() string {
-	return fmt.Sprintf("%cƒ𝓍 (A:%d,B:%d,N:%d)", UC_MATH_BOLD_A, cs.coefA, cs.coefB, cs.modulo)
+	pairs := make([]string, len(cs.schedule))
+	for i, pair := range cs.schedule {
+		pairs[i] = fmt.Sprintf("A:%d,B:%d", pair.A, pair.B)
+	}
+
+	return fmt.Sprintf("%cƒ𝓍 (%s,N:%d)", UC_MATH_BOLD_A, strings.Join(pairs, " "), cs.modulo)
 }
 
-// Verify via the callback that the given rune can be used as Coefficient A?
+// Verify via the callback that the B coefficients can be used as keys.
 func (cs *AffineSequencer) Verify(callback func(rune) error) error {
+	for _, key := range cs.keys {
+		if err := callback(key); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -127,3 +259,26 @@ func (cs *AffineSequencer) VerifySecret(s string, alpha *cmn.Alphabet) (string,
 func (cs *AffineSequencer) Reset() {
 	cs.skipped = 0
 }
+
+// the schedule index for the position, only encodable runes count
+func (cs *AffineSequencer) index(pos int) int {
+	count := len(cs.schedule)
+	return ((pos-cs.skipped)%count + count) % count
+}
+
+// the factors of every pair for a (slave) alphabet of length n
+func (cs *AffineSequencer) slaveFactors(n int) []affineFactors {
+	ahlp := NewAffineHelper()
+	factors := make([]affineFactors, len(cs.schedule))
+	for i, pair := range cs.schedule {
+		a := pair.A
+		if !ahlp.IsCommonCoprime(a, cs.modulo, n) {
+			a = ahlp.CalculateSlaveCoprime(pair.A, cs.modulo, pair.B, n)
+		}
+
+		aP, _ := ahlp.ModularInverse(a, n) // a is a coprime of n
+		factors[i] = affineFactors{a, aP}
+	}
+
+	return factors
+}
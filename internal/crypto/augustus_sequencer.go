@@ -0,0 +1,66 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Key Sequencer of the Extended Caesar Augustus. The inner disk
+ * oscillates back & forth between the key N and N+K on every
+ * convertable rune: N, N+K, N, N+K...
+ *-----------------------------------------------------------------*/
+package crypto
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const ALG_NAME_AUGUSTUS = "Augustus"
+const ALG_CODE_AUGUSTUS = "CAEO"
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ IKeySequencer = (*AugustusSequencer)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// Augustus is a Didimus whose offset is not limited to a byte and
+// whose slave is the symbols disk rather than the numbers disk.
+type AugustusSequencer struct {
+	*DidimusSequencer
+	offset uint
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+func NewAugustusSequencer(key rune, offset uint, alpha *cmn.Alphabet) *AugustusSequencer {
+	inner := uint8(offset % alpha.Size()) // Binary has 256 runes, still a byte
+	return &AugustusSequencer{NewDidimusSequencer(key, inner, alpha), offset}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * @returns The sequencer's friendly name.
+ */
+func (cs *AugustusSequencer) Name() string {
+	return ALG_NAME_AUGUSTUS
+}
+
+func (cs *AugustusSequencer) String() string {
+	return fmt.Sprintf("%s f2k(%c)/f2k+1(%c) K=%d", ALG_NAME_AUGUSTUS, cs.prime, cs.alt, cs.offset)
+}
+
+func (cs *AugustusSequencer) GetKeyInfo() string {
+	return fmt.Sprintf("%cƒ𝓍 (%s=%c,%s=%c)", UC_MATH_BOLD_O, keyEvenString, cs.prime, keyOddString, cs.alt)
+}
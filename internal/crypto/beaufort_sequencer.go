@@ -0,0 +1,130 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Key Sequencer is a flexible generator of the current key
+ * during Encoding/Decoding. The Beaufort family repeats the secret
+ * over the convertible runes just like Bellaso, the difference lies
+ * in the way the Tabula Recta is read (C = K - P or C = P - K).
+ *-----------------------------------------------------------------*/
+package crypto
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const ALG_NAME_BEAUFORT = "Beaufort"
+const ALG_CODE_BEAUFORT = "BEAU"
+
+const ALG_NAME_VARIANT_BEAUFORT = "VariantBeaufort"
+const ALG_CODE_VARIANT_BEAUFORT = "VBEA"
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ IKeySequencer = (*BeaufortSequencer)(nil)
+var _ IKeySequencer = (*VariantBeaufortSequencer)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// The Beaufort key schedule is that of Bellaso, only the reading of
+// the Tabula Recta (done by the cipher) differs.
+type BeaufortSequencer struct {
+	BellasoSequencer
+}
+
+// The Variant Beaufort uses the same key schedule as Beaufort.
+type VariantBeaufortSequencer struct {
+	BeaufortSequencer
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+func NewBeaufortSequencer(secret string, alpha *cmn.Alphabet) *BeaufortSequencer {
+	beaufort := &BeaufortSequencer{BellasoSequencer{nil, 0, 0}}
+	secret = alpha.ToUpperString(secret)
+	if newSecret, err := beaufort.VerifySecret(secret, alpha); err != nil {
+		mlog.ErrorT("invalid secret for Beaufort sequencer", mlog.Err(err))
+		return nil
+	} else {
+		beaufort.secret = []rune(newSecret)
+		beaufort.subKeyCount = len(beaufort.secret)
+	}
+
+	return beaufort
+}
+
+func NewVariantBeaufortSequencer(secret string, alpha *cmn.Alphabet) *VariantBeaufortSequencer {
+	beaufort := NewBeaufortSequencer(secret, alpha)
+	if beaufort == nil {
+		return nil
+	}
+
+	return &VariantBeaufortSequencer{*beaufort}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * @returns The sequencer's friendly name.
+ */
+func (cs *BeaufortSequencer) Name() string {
+	return ALG_NAME_BEAUFORT
+}
+
+func (cs *BeaufortSequencer) String() string {
+	return ALG_NAME_BEAUFORT
+}
+
+func (cs *BeaufortSequencer) GetKeyInfo() string {
+	return fmt.Sprintf("%cƒ𝓍 ('%s',K-P)", UC_MATH_SCR_B, string(cs.secret))
+}
+
+/**
+ * All the letters in the Beaufort secret must be known in the primary/master alphabet.
+ * The only exception is the SPACE/TAB characters which are eliminated from the secret.
+ */
+func (cs *BeaufortSequencer) VerifySecret(s string, alpha *cmn.Alphabet) (string, error) {
+	s = strings.Trim(s, " \t")
+	if len(s) == 0 {
+		return "", fmt.Errorf("empty secret for %s", ALG_NAME_BEAUFORT)
+	}
+
+	for _, char := range s {
+		if !alpha.Contains(char, true) {
+			return "", fmt.Errorf("subkey '%c' not present in %s alphabet for Beaufort", char, alpha.Name)
+		}
+	}
+
+	return s, nil
+}
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ *				V a r i a n t   B e a u f o r t
+ *- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -*/
+
+func (cs *VariantBeaufortSequencer) Name() string {
+	return ALG_NAME_VARIANT_BEAUFORT
+}
+
+func (cs *VariantBeaufortSequencer) String() string {
+	return ALG_NAME_VARIANT_BEAUFORT
+}
+
+func (cs *VariantBeaufortSequencer) GetKeyInfo() string {
+	return fmt.Sprintf("%cƒ𝓍 ('%s',P-K)", UC_MATH_SCR_B, string(cs.secret))
+}
@@ -106,7 +106,9 @@ func (cs *BellasoSequencer) Skip() int {
  */
 func (cs *BellasoSequencer) GetKey(pos int, target rune) rune {
 	at := pos - cs.skipped // use key only on convertable positions
-	keyPos := at % cs.subKeyCount
+	// the positions of a text file start over with every line, but
+	// not the skipped runes: on the next lines it may be negative.
+	keyPos := ((at % cs.subKeyCount) + cs.subKeyCount) % cs.subKeyCount
 
 	return cs.secret[keyPos]
 }
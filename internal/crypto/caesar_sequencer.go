@@ -16,6 +16,10 @@ import "fmt"
 const ALG_NAME_CAESAR = "Caesar"
 const ALG_CODE_CAESAR = "CAES"
 
+// same Caesar sequencer, but with the symbols disk as slave
+const ALG_NAME_CAESAR_EXTENDED = "Caesar Extended"
+const ALG_CODE_CAESAR_EXTENDED = "CAEX"
+
 /* ----------------------------------------------------------------
  *						I n t e r f a c e s
  *-----------------------------------------------------------------*/
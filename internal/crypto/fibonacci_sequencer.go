@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"lordofscripts/caesarx/app/mlog"
 	"lordofscripts/caesarx/cmn"
+	"slices"
 )
 
 /* ----------------------------------------------------------------
@@ -130,6 +131,15 @@ func (fs *FibonacciSequencer) GetKeyInfo() string {
 	return fmt.Sprintf("%cƒ𝓍 (%d)", UC_MATH_BOLD_F, len(fs.fkeys))
 }
 
+/**
+ * The derived keys in the order they are used. They repeat over the
+ * input, skipped runes included, so the key at position pos is
+ * Keys()[pos % len(Keys())].
+ */
+func (fs *FibonacciSequencer) Keys() []rune {
+	return slices.Clone(fs.fkeys)
+}
+
 func (cs *FibonacciSequencer) Verify(callback func(rune) error) error {
 	for _, k := range cs.fkeys {
 		if err := callback(k); err != nil {
@@ -0,0 +1,396 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Key Sequencer is a flexible generator of the current key
+ * during Encoding/Decoding. The Running Key takes its key stream
+ * from a long text (a book) held by both parties, starting at an
+ * agreed chapter/line/character offset. Only the runes of the book
+ * that belong to the master alphabet become keys.
+ *-----------------------------------------------------------------*/
+package crypto
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/cmn"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const ALG_NAME_RUNNINGKEY = "RunningKey"
+const ALG_CODE_RUNNINGKEY = "RUNK"
+
+var (
+	ErrRunningKeyExhausted = errors.New("running key exhausted")
+	ErrBookOffset          = errors.New("invalid book offset")
+)
+
+// A line of the book starting with one of these words (case-insensitive)
+// is considered a chapter heading.
+var chapterMarkers = []string{
+	"CHAPTER",  // English
+	"CAPÍTULO", // Spanish, Portuguese
+	"CAPITULO", // Spanish, Portuguese (unaccented)
+	"CAPITOLO", // Italian
+	"KAPITEL",  // German
+	"KAPITOLA", // Czech
+	"ΚΕΦΑΛΑΙΟ", // Greek
+	"ГЛАВА",    // Russian
+}
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ IKeySequencer = (*RunningKeySequencer)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// BookOffset is where the key stream starts within the book. Chapter
+// is 1-based (0 means the beginning of the book), Line is the 0-based
+// line after the chapter heading (or after the beginning of the book)
+// and Char is the 0-based rune within the text from that line on.
+type BookOffset struct {
+	Chapter int
+	Line    int
+	Char    int
+}
+
+type RunningKeySequencer struct {
+	book    string
+	offset  BookOffset
+	text    []byte // the book from the offset on
+	keys    []rune
+	next    int
+	skipped int
+	overrun bool
+	err     error
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// NewRunningKeySequencer reads the key stream from the book file. The
+// book is read completely and filtered at construction time.
+func NewRunningKeySequencer(bookFilename string, offset BookOffset, alpha *cmn.Alphabet) (*RunningKeySequencer, error) {
+	fd, err := os.Open(bookFilename)
+	if err != nil {
+		mlog.ErrorE(err)
+		return nil, err
+	}
+	defer fd.Close()
+
+	return NewRunningKeySequencerFromReader(bufio.NewReader(fd), filepath.Base(bookFilename), offset, alpha)
+}
+
+// NewRunningKeySequencerFromReader does the same as NewRunningKeySequencer
+// but the book is given as a reader. The name is only informative.
+func NewRunningKeySequencerFromReader(r io.Reader, name string, offset BookOffset, alpha *cmn.Alphabet) (*RunningKeySequencer, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := offset.locate(data, alpha.IsBinary())
+	if err != nil {
+		mlog.ErrorT("running key offset", mlog.String("Book", name), mlog.Err(err))
+		return nil, err
+	}
+
+	rk := &RunningKeySequencer{
+		book:    name,
+		offset:  offset,
+		text:    data[start:],
+		keys:    filterKeyStream(data[start:], alpha),
+		next:    0,
+		skipped: 0,
+		overrun: false,
+		err:     nil,
+	}
+
+	if len(rk.keys) == 0 {
+		err = fmt.Errorf("%w: no %s runes in '%s' after offset %s", ErrRunningKeyExhausted, alpha.Name, name, offset)
+		mlog.ErrorE(err)
+		return nil, err
+	}
+
+	mlog.DebugT("RunningKeySequencer", mlog.String("Book", name), mlog.Int("Keys", len(rk.keys)))
+	return rk, nil
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * @returns The sequencer's friendly name.
+ */
+func (cs *RunningKeySequencer) Name() string {
+	return ALG_NAME_RUNNINGKEY
+}
+
+/**
+ * To instruct the sequencer whether it is being used for encipherment
+ * or decipherment. Not relevant with the Running Key but, since it is
+ * called at the start of every operation, it clears the error left by
+ * a previous operation.
+ */
+func (cs *RunningKeySequencer) SetDecryptionMode(isDecrypting bool) {
+	cs.err = nil
+	cs.overrun = false
+}
+
+/**
+ * Called for every converted rune, it consumes the key handed out by
+ * GetKey(). If that key was beyond the end of the key stream the error
+ * is recorded, see Err().
+ */
+func (cs *RunningKeySequencer) Feedback(rune) error {
+	if cs.overrun {
+		if cs.err == nil {
+			cs.err = fmt.Errorf("%w: '%s' provides only %d key runes from offset %s",
+				ErrRunningKeyExhausted, cs.book, len(cs.keys), cs.offset)
+			mlog.ErrorE(cs.err)
+		}
+		return nil
+	}
+
+	cs.next++
+	return nil
+}
+
+/**
+ * Skip the current position. Must be called when character in the
+ * input stream is not part of the encoding alphabet. The key handed
+ * out by GetKey() is not consumed.
+ *
+ * @returns (int) number of skipped runes so far.
+ */
+func (cs *RunningKeySequencer) Skip() int {
+	cs.overrun = false // the key wasn't used after all
+	cs.skipped++
+	return cs.skipped
+}
+
+/**
+ * Get the key to be used for encoding target rune at this position.
+ * Should only be called if target is part of the encoding alphabet!
+ * NOTE: unlike the other sequencers the position is not used. Every
+ * converted rune consumes the next rune of the book, therefore the key
+ * stream flows on across the lines of a text file instead of starting
+ * over on each line.
+ *
+ * @param pos (int) position of the target rune in the input stream
+ * @param target (rune) ignored in this algorithm
+ * @returns the basic key to use for encoding/decoding at this position.
+ */
+func (cs *RunningKeySequencer) GetKey(pos int, target rune) rune {
+	if cs.next >= len(cs.keys) {
+		// could be a trailing non-convertible rune, Feedback() decides
+		cs.overrun = true
+		return cs.keys[len(cs.keys)-1]
+	}
+
+	cs.overrun = false
+	return cs.keys[cs.next]
+}
+
+func (cs *RunningKeySequencer) String() string {
+	return ALG_NAME_RUNNINGKEY
+}
+
+func (cs *RunningKeySequencer) GetKeyInfo() string {
+	return fmt.Sprintf("%cƒ𝓍 ('%s'@%s,%d)", UC_MATH_BOLD_R, cs.book, cs.offset, len(cs.keys))
+}
+
+func (cs *RunningKeySequencer) Verify(callback func(rune) error) error {
+	for _, char := range cs.keys {
+		if err1 := callback(char); err1 != nil {
+			return err1
+		}
+	}
+
+	return nil
+}
+
+/**
+ * Resets the sequencer. It should be done after every Encode or Decode.
+ * The exhaustion error (if any) survives so that it can be queried
+ * with Err() after the operation.
+ */
+func (cs *RunningKeySequencer) Reset() {
+	cs.next = 0
+	cs.skipped = 0
+	cs.overrun = false
+}
+
+// Rebuild filters the key stream again for a different master alphabet.
+func (cs *RunningKeySequencer) Rebuild(alpha *cmn.Alphabet) error {
+	keys := filterKeyStream(cs.text, alpha)
+	if len(keys) == 0 {
+		return fmt.Errorf("%w: no %s runes in '%s' after offset %s", ErrRunningKeyExhausted, alpha.Name, cs.book, cs.offset)
+	}
+
+	cs.keys = keys
+	cs.Reset()
+	return nil
+}
+
+// Capacity is the number of key runes available from the offset on.
+func (cs *RunningKeySequencer) Capacity() int {
+	return len(cs.keys)
+}
+
+// Err reports whether the last operation ran out of key stream.
+func (cs *RunningKeySequencer) Err() error {
+	return cs.err
+}
+
+// implements fmt.Stringer as C:L:N
+func (o BookOffset) String() string {
+	return fmt.Sprintf("%d:%d:%d", o.Chapter, o.Line, o.Char)
+}
+
+// locate the byte position in the book where the key stream begins.
+func (o BookOffset) locate(data []byte, isBinary bool) (int, error) {
+	if o.Chapter < 0 || o.Line < 0 || o.Char < 0 {
+		return 0, fmt.Errorf("%w: negative value in %s", ErrBookOffset, o)
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	lineIdx := 0
+	if o.Chapter > 0 {
+		found := 0
+		lineIdx = -1
+		for i, line := range lines {
+			if isChapterHeading(line) {
+				if found++; found == o.Chapter {
+					lineIdx = i + 1 // the text begins after the heading
+					break
+				}
+			}
+		}
+
+		if lineIdx == -1 {
+			return 0, fmt.Errorf("%w: chapter %d requested but the book has %d", ErrBookOffset, o.Chapter, found)
+		}
+	}
+
+	lineIdx += o.Line
+	if lineIdx >= len(lines) {
+		return 0, fmt.Errorf("%w: line %d is beyond the end of the book", ErrBookOffset, o.Line)
+	}
+
+	start := 0
+	for _, line := range lines[:lineIdx] {
+		start += len(line)
+	}
+
+	// move Char runes (bytes if binary) forward
+	for n := 0; n < o.Char; n++ {
+		if start >= len(data) {
+			return 0, fmt.Errorf("%w: character %d is beyond the end of the book", ErrBookOffset, o.Char)
+		}
+
+		if isBinary {
+			start++
+		} else {
+			_, size := utf8.DecodeRune(data[start:])
+			start += size
+		}
+	}
+
+	return start, nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// ParseBookOffset parses a book offset given as "N" (character offset),
+// "L:N" (line & character) or "C:L:N" (chapter, line & character).
+// An empty string is the beginning of the book.
+func ParseBookOffset(s string) (BookOffset, error) {
+	var result BookOffset
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return result, nil
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return result, fmt.Errorf("%w: '%s' use N, L:N or C:L:N", ErrBookOffset, s)
+	}
+
+	values := make([]int, 3)
+	for i, part := range parts {
+		v, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || v < 0 {
+			return result, fmt.Errorf("%w: '%s' use N, L:N or C:L:N", ErrBookOffset, s)
+		}
+		values[3-len(parts)+i] = v
+	}
+
+	result.Chapter, result.Line, result.Char = values[0], values[1], values[2]
+	return result, nil
+}
+
+// check whether a line of the book is a chapter heading
+func isChapterHeading(line []byte) bool {
+	text := strings.ToUpper(strings.TrimSpace(string(line)))
+	for _, marker := range chapterMarkers {
+		if strings.HasPrefix(text, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keep only those runes (in uppercase) that belong to the master
+// alphabet. For a binary alphabet every byte is a key.
+func filterKeyStream(data []byte, alpha *cmn.Alphabet) []rune {
+	if alpha.IsBinary() {
+		keys := make([]rune, len(data))
+		for i, b := range data {
+			keys[i] = rune(b)
+		}
+		return keys
+	}
+
+	caser := alpha.BorrowSpecialCase()
+	toUpper := unicode.ToUpper
+	if caser != nil {
+		toUpper = caser.ToUpperRune
+	}
+
+	catalog := make(map[rune]bool)
+	for _, r := range alpha.Chars {
+		catalog[toUpper(r)] = true
+	}
+
+	keys := make([]rune, 0, len(data))
+	for _, r := range string(data) {
+		if up := toUpper(r); catalog[up] {
+			keys = append(keys, up)
+		}
+	}
+
+	return keys
+}
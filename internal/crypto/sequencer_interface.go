@@ -16,7 +16,11 @@ const (
 	UC_MATH_BOLD_C rune = rune(0x1d46a) // for Caesar
 	UC_MATH_BOLD_D rune = rune(0x1d46b) // for Didimus
 	UC_MATH_BOLD_F rune = rune(0x1d46d) // for Fibonacius
+	UC_MATH_BOLD_O rune = rune(0x1d476) // for Augustus (Oscillating)
+	UC_MATH_BOLD_R rune = rune(0x1d479) // for Running Key
+	UC_MATH_BOLD_T rune = rune(0x1d47b) // for Tiberius
 	UC_MATH_BOLD_V rune = rune(0x1d47d) // for Vigenère
+	UC_MATH_SCR_B  rune = rune(0x1d4d1) // for Beaufort
 
 	UC_SUBSCRIPT_0 = rune(0x2080)
 	UC_SUBSCRIPT_1 = rune(0x2081)
@@ -83,3 +87,19 @@ type IKeySequencer interface {
 	 */
 	Feedback(rune) error
 }
+
+/**
+ * The Affine sequencers also deliver the multiplicative coefficient of
+ * f(x) = (A*x + B) % N while GetKey() delivers B as a key rune. The
+ * Text & Binary iterators apply the multiplication before (encoding)
+ * or after (decoding) the regular Tabula Recta substitution.
+ */
+type IAffineSequencer interface {
+	IKeySequencer
+
+	/**
+	 * Get the multiplier A and its modular inverse A' to use at this
+	 * position for an alphabet of length n.
+	 */
+	GetMultiplier(pos int, n int) (int, int)
+}
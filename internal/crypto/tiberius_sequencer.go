@@ -0,0 +1,139 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * The Key Sequencer of the Extended Caesar Tiberius. The outer
+ * (master) disk and the inner (slave) disk turn independently, each
+ * one with its own key: N for letters and N' for the symbols.
+ *-----------------------------------------------------------------*/
+package crypto
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/cmn"
+	"unicode/utf8"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const ALG_NAME_TIBERIUS = "Tiberius"
+const ALG_CODE_TIBERIUS = "CAET"
+
+/* ----------------------------------------------------------------
+ *						I n t e r f a c e s
+ *-----------------------------------------------------------------*/
+
+var _ IKeySequencer = (*TiberiusSequencer)(nil)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+type TiberiusSequencer struct {
+	outer   rune               // the key of the master (outer) disk
+	inner   uint               // the shift of the slave (inner) disk
+	slave   cmn.IRuneLocalizer // the inner disk, nil if none
+	skipped int
+}
+
+/* ----------------------------------------------------------------
+ *							C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+/**
+ * (Ctor) the outer key is a rune of the master alphabet, the inner
+ * key is a shift that is taken modulo the length of whatever slave
+ * gets chained, hence it works with every alphabet.
+ */
+func NewTiberiusSequencer(outerKey rune, innerKey uint, slave cmn.IRuneLocalizer) *TiberiusSequencer {
+	return &TiberiusSequencer{outerKey, innerKey, slave, 0}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+/**
+ * @returns The sequencer's friendly name.
+ */
+func (cs *TiberiusSequencer) Name() string {
+	return ALG_NAME_TIBERIUS
+}
+
+/**
+ * The inner disk (slave) is attached after construction when the
+ * cipher gets chained. A nil slave means only the outer key is used.
+ */
+func (cs *TiberiusSequencer) WithSlave(slave cmn.IRuneLocalizer) *TiberiusSequencer {
+	cs.slave = slave
+	return cs
+}
+
+/**
+ * To instruct the sequencer whether it is being used for encipherment
+ * or decipherment. Not relevant with Tiberius variant.
+ */
+func (cs *TiberiusSequencer) SetDecryptionMode(isDecrypting bool) {
+}
+
+/**
+ * N.A.
+ */
+func (cs *TiberiusSequencer) Feedback(rune) error {
+	return nil
+}
+
+/**
+ * Skip the current position. Must be called when character in the
+ * input stream is not part of the encoding alphabet.
+ *
+ * @returns (int) number of skipped runes so far.
+ */
+func (cs *TiberiusSequencer) Skip() int {
+	cs.skipped++
+	return cs.skipped
+}
+
+/**
+ * Get the key to be used for encoding target rune at this position.
+ * NOTE: Tiberius uses the outer key for the runes of the master
+ *		 alphabet. For the runes of the slave it returns the slave's
+ *		 own rune at the inner shift (modulo its length), that way
+ *		 the slave is turned independently of the master.
+ *
+ * @param pos (int) ignored in this algorithm
+ * @param target (rune) the rune to en/decode
+ * @returns the basic key to use for encoding/decoding at this position.
+ */
+func (cs *TiberiusSequencer) GetKey(pos int, target rune) rune {
+	if cs.slave != nil {
+		if chars, _, err := cs.slave.FindRune(target); err == nil {
+			at := int(cs.inner % uint(utf8.RuneCountInString(chars)))
+			return cmn.RuneAt(chars, at)
+		}
+	}
+
+	return cs.outer
+}
+
+func (cs *TiberiusSequencer) String() string {
+	return fmt.Sprintf("%s outer(%c)/inner(%d)", ALG_NAME_TIBERIUS, cs.outer, cs.inner)
+}
+
+func (cs *TiberiusSequencer) GetKeyInfo() string {
+	return fmt.Sprintf("%cƒ𝓍 (%c=%c,%c'=%d)", UC_MATH_BOLD_T, UC_MATH_K, cs.outer, UC_MATH_K, cs.inner)
+}
+
+// only the outer key is a rune of the master alphabet
+func (cs *TiberiusSequencer) Verify(callback func(rune) error) error {
+	return callback(cs.outer)
+}
+
+/**
+ * Resets the sequencer. It should be done after every Encode or Decode
+ */
+func (cs *TiberiusSequencer) Reset() {
+	cs.skipped = 0
+}
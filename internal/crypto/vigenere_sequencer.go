@@ -9,7 +9,6 @@ package crypto
 
 import (
 	"fmt"
-	"lordofscripts/caesarx"
 	"lordofscripts/caesarx/app/mlog"
 	"lordofscripts/caesarx/cmn"
 	"strings"
@@ -96,6 +95,12 @@ func (cs *VigenereSequencer) SetDecryptionMode(isDecrypting bool) {
  * sequencer to progressively rebuild the auto-key .
  */
 func (cs *VigenereSequencer) Feedback(r rune) error {
+	// only in a text file, whose positions start over with every line,
+	// the secret may come again with a full buffer: the oldest goes.
+	if cs.buffer.IsFull() {
+		cs.buffer.Pop()
+	}
+
 	if size, err := cs.buffer.Push(r); err != nil {
 		mlog.ErrorT(
 			"couldn't push decoded rune.",
@@ -146,21 +151,17 @@ func (cs *VigenereSequencer) GetKey(pos int, target rune) rune {
 		// auto-key
 		// we pop the last decoded rune as the progressive auto-key
 		// to decode the target rune.
-		var size int
 		var err error
-		currentKey, size, err = cs.buffer.Pop()
-		if err != nil {
-			mlog.Fatal(caesarx.ERR_SEQUENCER,
-				"couldn't pop key rune.",
-				//mlog.String("At", "VigenereSequencer.GetKey"),
-				mlog.At(),
-				mlog.Int("Size", size),
-				mlog.Rune("Target", target),
-				mlog.Int("Pos", pos))
+		if currentKey, _, err = cs.buffer.Pop(); err != nil {
+			// every skipped rune pops one too, once they have used up
+			// the auto-key we fall back on the secret until the fed
+			// back runes fill the buffer again.
+			currentKey = cs.secret[at%cs.subKeyCount]
 		}
 	} else {
-		// secret ()
-		keyPos := at % cs.subKeyCount
+		// secret () the position is negative on the lines of a text
+		// file that follow some skipped runes
+		keyPos := ((at % cs.subKeyCount) + cs.subKeyCount) % cs.subKeyCount
 		currentKey = cs.secret[keyPos]
 	}
 
@@ -0,0 +1,276 @@
+/* -----------------------------------------------------------------
+ *					L o r d  O f   S c r i p t s (tm)
+ *				  Copyright (C)2025 Dídimo Grimaldo T.
+ * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+ * ASCII armor for ciphered text messages, to paste them in chats and
+ * e-mails. The message is enclosed by BEGIN/END lines, its Key: value
+ * headers tell the recipient how it was encrypted (all but the key)
+ * and the checksum line detects a mangled message:
+ *	-----BEGIN CAESARX MESSAGE-----
+ *	Variant: Bellaso
+ *	Alphabet: EN
+ *	Chained: NUMDX
+ *	Date: 2025-08-14
+ *
+ *	ciphered text
+ *	=1A2B3C4D
+ *	-----END CAESARX MESSAGE-----
+ *-----------------------------------------------------------------*/
+package files
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"lordofscripts/caesarx"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/* ----------------------------------------------------------------
+ *							G l o b a l s
+ *-----------------------------------------------------------------*/
+
+const (
+	ARMOR_BEGIN    string = "-----BEGIN CAESARX MESSAGE-----"
+	ARMOR_END      string = "-----END CAESARX MESSAGE-----"
+	ARMOR_CHECKSUM string = "="
+	ARMOR_DATE     string = "2006-01-02"
+
+	// armor header keys, those without value are omitted
+	ARMOR_VARIANT  string = "Variant"
+	ARMOR_ALPHABET string = "Alphabet" // ISO code of the master alphabet
+	ARMOR_CHAINED  string = "Chained"  // ISO code of the slave alphabet
+	ARMOR_NGRAM    string = "NGram"
+	ARMOR_DATED    string = "Date" // for the Caesarium codebook
+	ARMOR_PROFILE  string = "Profile"
+
+	// the separator of the NGram formatter
+	ARMOR_NGRAM_SEPARATOR rune = '·'
+)
+
+var (
+	ErrArmorFormat   = errors.New("not a CaesarX armored message")
+	ErrArmorChecksum = errors.New("the armored message doesn't match its checksum")
+)
+
+/* ----------------------------------------------------------------
+ *							T y p e s
+ *-----------------------------------------------------------------*/
+
+// An armored ciphered text message
+type Armor struct {
+	Variant  caesarx.CipherVariant
+	Alphabet string // ISO code of the master alphabet
+	Chained  string // ISO code of the slave alphabet, empty if none
+	NGram    int    // 0 if not formatted
+	Date     time.Time
+	Profile  string // the profile ID (recipient), empty if none
+	Body     string // the ciphered text, lines separated by \n
+}
+
+/* ----------------------------------------------------------------
+ *						C o n s t r u c t o r s
+ *-----------------------------------------------------------------*/
+
+// an armor for a message encrypted today with the cipher & alphabet
+// (ISO code). Follow with the optional fields and the Body.
+func NewArmor(cipherId caesarx.CipherVariant, langCode string) *Armor {
+	return &Armor{
+		Variant:  cipherId,
+		Alphabet: langCode,
+		Date:     time.Now(),
+	}
+}
+
+/* ----------------------------------------------------------------
+ *							M e t h o d s
+ *-----------------------------------------------------------------*/
+
+// the armored message, ready to be pasted
+func (a *Armor) String() string {
+	var sb strings.Builder
+	a.Write(&sb)
+	return sb.String()
+}
+
+// the CRC32 of the ciphered text
+func (a *Armor) Checksum() string {
+	return fmt.Sprintf("%s%08X", ARMOR_CHECKSUM, crc32.ChecksumIEEE([]byte(a.Body)))
+}
+
+// the message must have been encrypted with the given cipher
+func (a *Armor) CheckCipher(cipherId caesarx.CipherVariant) error {
+	if a.Variant != cipherId {
+		return fmt.Errorf("%w: the message was encrypted with %s, not %s", ErrHeaderCipher, a.Variant, cipherId)
+	}
+
+	return nil
+}
+
+// the ciphered text without the NGram separators (if formatted), as
+// the ciphers expect it
+func (a *Armor) Text() string {
+	if a.NGram == 0 {
+		return a.Body
+	}
+
+	return strings.ReplaceAll(a.Body, string(ARMOR_NGRAM_SEPARATOR), "")
+}
+
+// the ciphered text lines
+func (a *Armor) Lines() []string {
+	return strings.Split(a.Text(), "\n")
+}
+
+func (a *Armor) Write(w io.Writer) error {
+	headers := [][2]string{
+		{ARMOR_VARIANT, a.Variant.String()},
+		{ARMOR_ALPHABET, a.Alphabet},
+		{ARMOR_CHAINED, a.Chained},
+		{ARMOR_NGRAM, ""},
+		{ARMOR_DATED, ""},
+		{ARMOR_PROFILE, a.Profile},
+	}
+	if a.NGram != 0 {
+		headers[3][1] = strconv.Itoa(a.NGram)
+	}
+	if !a.Date.IsZero() {
+		headers[4][1] = a.Date.Format(ARMOR_DATE)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ARMOR_BEGIN + "\n")
+	for _, header := range headers {
+		if len(header[1]) != 0 {
+			fmt.Fprintf(&sb, "%s: %s\n", header[0], header[1])
+		}
+	}
+	sb.WriteString("\n")
+	sb.WriteString(a.Body + "\n")
+	sb.WriteString(a.Checksum() + "\n")
+	sb.WriteString(ARMOR_END + "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+/**
+ * Reads the armored message, the lines before the BEGIN line are
+ * skipped (pasted greetings & such). The headers end at the first
+ * blank line, the checksum line is the last one before the END line.
+ */
+func (a *Armor) Read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 1024*1024)
+	next := func() (string, bool) {
+		if !scanner.Scan() {
+			return "", false
+		}
+		return strings.TrimSuffix(scanner.Text(), "\r"), true
+	}
+
+	// BEGIN
+	line, ok := next()
+	for ok && strings.TrimSpace(line) != ARMOR_BEGIN {
+		line, ok = next()
+	}
+	if !ok {
+		return ErrArmorFormat
+	}
+
+	// Key: value headers
+	*a = Armor{}
+	for line, ok = next(); ok && len(strings.TrimSpace(line)) != 0; line, ok = next() {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			return fmt.Errorf("%w: header line '%s'", ErrArmorFormat, line)
+		}
+		if err := a.setHeader(strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+			return err
+		}
+	}
+	if a.Variant == caesarx.NoCipher || len(a.Alphabet) == 0 {
+		return fmt.Errorf("%w: needs the %s & %s headers", ErrArmorFormat, ARMOR_VARIANT, ARMOR_ALPHABET)
+	}
+
+	// body, checksum & END
+	lines := make([]string, 0)
+	for line, ok = next(); ok && strings.TrimSpace(line) != ARMOR_END; line, ok = next() {
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if !ok || len(lines) < 2 || !strings.HasPrefix(lines[len(lines)-1], ARMOR_CHECKSUM) {
+		return fmt.Errorf("%w: no checksum or %s line", ErrArmorFormat, ARMOR_END)
+	}
+
+	checksum := strings.TrimSpace(lines[len(lines)-1])
+	a.Body = strings.Join(lines[:len(lines)-1], "\n")
+	if !strings.EqualFold(checksum, a.Checksum()) {
+		return ErrArmorChecksum
+	}
+
+	return nil
+}
+
+// sets the (known) header, the unknown ones are ignored
+func (a *Armor) setHeader(key, value string) error {
+	var err error
+	switch key {
+	case ARMOR_VARIANT:
+		a.Variant, err = caesarx.NoCipher.Parse(value)
+	case ARMOR_ALPHABET:
+		a.Alphabet = value
+	case ARMOR_CHAINED:
+		a.Chained = value
+	case ARMOR_NGRAM:
+		a.NGram, err = strconv.Atoi(value)
+	case ARMOR_DATED:
+		a.Date, err = time.Parse(ARMOR_DATE, value)
+	case ARMOR_PROFILE:
+		a.Profile = value
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %s header: %v", ErrArmorFormat, key, err)
+	}
+	return nil
+}
+
+/* ----------------------------------------------------------------
+ *							F u n c t i o n s
+ *-----------------------------------------------------------------*/
+
+// whether the text has an armored message
+func IsArmored(text string) bool {
+	return strings.Contains(text, ARMOR_BEGIN)
+}
+
+// parses the armored message
+func ParseArmor(text string) (*Armor, error) {
+	armor := new(Armor)
+	if err := armor.Read(strings.NewReader(text)); err != nil {
+		return nil, err
+	}
+
+	return armor, nil
+}
+
+// reads the armored message of the text file, nil if it isn't armored
+func ReadArmorFile(filename string) (*Armor, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !IsArmored(string(contents)) {
+		return nil, nil
+	}
+
+	return ParseArmor(string(contents))
+}
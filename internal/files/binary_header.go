@@ -2,17 +2,25 @@
  *					L o r d  O f   S c r i p t s (tm)
  *				  Copyright (C)2025 Dídimo Grimaldo T.
  * - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
- * Magic Headers for binary encrypted files
+ * Magic Headers for binary encrypted files. Version 2.0 adds the
+ * alphabet, a salted fingerprint of the key (a wrong key is refused
+ * before any output is written) and the SHA-256 of the plain file
+ * (the decrypted file is verified). Version 1.0 files are still read.
  *-----------------------------------------------------------------*/
 package files
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"lordofscripts/caesarx"
 	"lordofscripts/caesarx/app/mlog"
+	"lordofscripts/caesarx/cmn"
+	"os"
 	"path"
 	"strings"
 )
@@ -22,8 +30,9 @@ import (
  *-----------------------------------------------------------------*/
 
 const (
-	FILEHEADER_MAJOR uint8  = 0x01
+	FILEHEADER_MAJOR uint8  = 0x02
 	FILEHEADER_MINOR uint8  = 0x00
+	FILEHEADER_V1    uint8  = 0x01 // major version without FileHeaderCheck
 	FILEHEADER_START uint32 = 0xBABEF007
 	FILEHEADER_END   uint16 = 0xDEAD
 	CAE              uint16 = 0xCAE5
@@ -32,6 +41,18 @@ const (
 	BEL              uint16 = 0xBE50
 	VIG              uint16 = 0xB16E
 	AFI              uint16 = 0xAF1E
+	BEA              uint16 = 0xBEAF
+	VBE              uint16 = 0xBEA5
+	RUN              uint16 = 0xB00C
+
+	// the salt is repeated to make the probe encrypted with the key
+	KEY_PROBE_REPEAT int = 4
+)
+
+var (
+	ErrHeaderCipher = errors.New("wrong cipher")
+	ErrHeaderKey    = errors.New("wrong key, it doesn't match the fingerprint of the file header")
+	ErrHeaderDigest = errors.New("the decrypted file doesn't match the digest of the file header")
 )
 
 /* ----------------------------------------------------------------
@@ -59,14 +80,29 @@ type FileHeaderEnd struct {
 	Trailer   uint16
 }
 
+// (internal) v2.0 alphabet, key fingerprint & plain file digest. This
+// is a variable-size structure between FileHeaderStart & FileHeaderEnd.
+type FileHeaderCheck struct {
+	LangLen     byte
+	LangCode    string // ISO code of the (master) alphabet
+	Salt        [16]byte
+	Fingerprint uint64   // CRC64 of the salt & the probe encrypted with the key
+	Digest      [32]byte // SHA-256 of the plain file
+}
+
 // A binary file header that contains basic information about the
 // encrypted file that may be useful when recovering it (decode).
 type FileHeader struct {
 	Start   *FileHeaderStart
+	Check   *FileHeaderCheck // nil in v1.0 headers
 	End     *FileHeaderEnd
 	isValid bool
 }
 
+// the key-dependent transformation of the probe, its encryption with
+// the key of the cipher.
+type KeyProbe func(probe []byte) []byte
+
 /* ----------------------------------------------------------------
  *						C o n s t r u c t o r s
  *-----------------------------------------------------------------*/
@@ -84,6 +120,7 @@ func NewFileHeader(cipherId caesarx.CipherVariant, filename string) (*FileHeader
 		fhS, err := newFileHeaderStart(cipherId)
 		fh := &FileHeader{
 			Start:   fhS,
+			Check:   new(FileHeaderCheck),
 			End:     fhE,
 			isValid: true,
 		}
@@ -96,6 +133,7 @@ func NewFileHeader(cipherId caesarx.CipherVariant, filename string) (*FileHeader
 func NewEmptyFileHeader() *FileHeader {
 	return &FileHeader{
 		Start:   new(FileHeaderStart),
+		Check:   new(FileHeaderCheck),
 		End:     new(FileHeaderEnd),
 		isValid: false,
 	}
@@ -118,6 +156,12 @@ func newFileHeaderStart(cipherId caesarx.CipherVariant) (*FileHeaderStart, error
 		id = FIB
 	case caesarx.VigenereCipher:
 		id = VIG
+	case caesarx.BeaufortCipher:
+		id = BEA
+	case caesarx.VariantBeaufortCipher:
+		id = VBE
+	case caesarx.RunningKeyCipher:
+		id = RUN
 	default:
 		return nil, fmt.Errorf("invalid algorithm ID by file header")
 	}
@@ -176,6 +220,69 @@ func (fe *FileHeaderEnd) Equals(other *FileHeaderEnd) bool {
 	return result
 }
 
+/* ----------------------------------------------------------------
+ *				M e t h o d s :: FileHeaderCheck
+ *-----------------------------------------------------------------*/
+
+// implements fmt.Stringer for FileHeaderCheck
+func (fc *FileHeaderCheck) String() string {
+	return fmt.Sprintf("CheckFH %s %016x %x", fc.LangCode, fc.Fingerprint, fc.Digest)
+}
+
+func (fc *FileHeaderCheck) Equals(other *FileHeaderCheck) bool {
+	if fc == nil || other == nil {
+		return fc == other
+	}
+
+	return fc.LangLen == other.LangLen &&
+		fc.LangCode == other.LangCode &&
+		fc.Salt == other.Salt &&
+		fc.Fingerprint == other.Fingerprint &&
+		fc.Digest == other.Digest
+}
+
+// the fingerprint of the key which encrypts the probe made of the salt
+func (fc *FileHeaderCheck) fingerprint(probe KeyProbe) uint64 {
+	ciphered := probe(bytes.Repeat(fc.Salt[:], KEY_PROBE_REPEAT))
+	return cmn.CalculateCRC64(append(fc.Salt[:], ciphered...))
+}
+
+func (fc *FileHeaderCheck) write(w io.Writer) error {
+	var err error
+	if err = binary.Write(w, binary.LittleEndian, fc.LangLen); err == nil {
+		if err = binary.Write(w, binary.LittleEndian, []byte(fc.LangCode)); err == nil {
+			if err = binary.Write(w, binary.LittleEndian, fc.Salt); err == nil {
+				if err = binary.Write(w, binary.LittleEndian, fc.Fingerprint); err == nil {
+					err = binary.Write(w, binary.LittleEndian, fc.Digest)
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+func (fc *FileHeaderCheck) read(r io.Reader) error {
+	var err error
+	if err = binary.Read(r, binary.LittleEndian, &fc.LangLen); err == nil {
+		langData := make([]byte, fc.LangLen)
+		if err = binary.Read(r, binary.LittleEndian, langData); err == nil {
+			fc.LangCode = string(langData)
+			if err = binary.Read(r, binary.LittleEndian, &fc.Salt); err == nil {
+				if err = binary.Read(r, binary.LittleEndian, &fc.Fingerprint); err == nil {
+					err = binary.Read(r, binary.LittleEndian, &fc.Digest)
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+func (fc *FileHeaderCheck) size() int {
+	return binary.Size(fc.LangLen) + len(fc.LangCode) + binary.Size(fc.Salt) + binary.Size(fc.Fingerprint) + binary.Size(fc.Digest)
+}
+
 /* ----------------------------------------------------------------
  *				M e t h o d s :: FileHeaderStart
  *-----------------------------------------------------------------*/
@@ -211,6 +318,11 @@ func (fh *FileHeader) String() string {
 	sb.WriteString(fmt.Sprintf("\tVersion: %x.%02x", fh.Start.MajorVersion, fh.Start.MinorVersion) + NL)
 	sb.WriteString("\tCipher: " + fh.Start.AlgorithmA.String() + NL)
 	sb.WriteString(fmt.Sprintf("\tAlgo: %02x\n", fh.Start.AlgorithmB))
+	if fh.Check != nil {
+		sb.WriteString("\tAlphabet: " + fh.Check.LangCode + NL)
+		sb.WriteString(fmt.Sprintf("\tFingerprint: %016x\n", fh.Check.Fingerprint))
+		sb.WriteString(fmt.Sprintf("\tSHA-256: %x\n", fh.Check.Digest))
+	}
 
 	sb.WriteString("Header:Epilogue" + NL)
 	sb.WriteString("\tExtension: " + fh.End.Extension + NL)
@@ -237,6 +349,79 @@ func (fh *FileHeader) FileExtension() string {
 	return result
 }
 
+// the size in bytes of the header as written to the file
+func (fh *FileHeader) Size() int {
+	size := binary.Size(fh.Start) + binary.Size(fh.End.ExtLen) + len(fh.End.Extension) + binary.Size(fh.End.Trailer)
+	if fh.Check != nil {
+		size += fh.Check.size()
+	}
+
+	return size
+}
+
+// the ISO code of the alphabet the file was encrypted with, empty in v1.0
+func (fh *FileHeader) Alphabet() string {
+	if fh.Check == nil {
+		return ""
+	}
+
+	return fh.Check.LangCode
+}
+
+// records the ISO code of the (master) alphabet (v2.0)
+func (fh *FileHeader) SetAlphabet(langCode string) error {
+	if len(langCode) > 255 {
+		return fmt.Errorf("alphabet code '%s' too long", langCode)
+	}
+
+	fh.Check.LangLen = byte(len(langCode))
+	fh.Check.LangCode = langCode
+	return nil
+}
+
+// records the fingerprint of the key under a fresh salt (v2.0)
+func (fh *FileHeader) SetKey(probe KeyProbe) error {
+	if _, err := rand.Read(fh.Check.Salt[:]); err != nil {
+		return err
+	}
+
+	fh.Check.Fingerprint = fh.Check.fingerprint(probe)
+	return nil
+}
+
+// records the SHA-256 of the plain file (v2.0)
+func (fh *FileHeader) SetDigest(filename string) error {
+	return fh.digest(filename, &fh.Check.Digest)
+}
+
+// the key must match the fingerprint of a v2.0 header, else it fails
+// with ErrHeaderKey. There is nothing to check in v1.0 headers.
+func (fh *FileHeader) VerifyKey(probe KeyProbe) error {
+	if fh.Check != nil && fh.Check.fingerprint(probe) != fh.Check.Fingerprint {
+		return ErrHeaderKey
+	}
+
+	return nil
+}
+
+// the decrypted file must match the digest of a v2.0 header, else it
+// fails with ErrHeaderDigest. There is nothing to check in v1.0 headers.
+func (fh *FileHeader) VerifyDigest(filename string) error {
+	if fh.Check == nil {
+		return nil
+	}
+
+	var digest [32]byte
+	if err := fh.digest(filename, &digest); err != nil {
+		return err
+	}
+	if digest != fh.Check.Digest {
+		return ErrHeaderDigest
+	}
+
+	return nil
+}
+
 // compares the equality of two file header instances
 // by their values
 func (fh *FileHeader) Equals(other *FileHeader) bool {
@@ -244,6 +429,7 @@ func (fh *FileHeader) Equals(other *FileHeader) bool {
 	if other != nil {
 		result = fh.isValid && other.isValid &&
 			fh.Start.Equals(other.Start) &&
+			fh.Check.Equals(other.Check) &&
 			fh.End.Equals(other.End)
 	}
 
@@ -255,7 +441,10 @@ func (fh *FileHeader) Write(w io.Writer) error {
 	var err error
 	buf := new(bytes.Buffer)
 
-	if err = binary.Write(buf, binary.LittleEndian, fh.Start); err == nil {
+	if err = binary.Write(buf, binary.LittleEndian, fh.Start); err == nil && fh.Check != nil {
+		err = fh.Check.write(buf)
+	}
+	if err == nil {
 		if err = binary.Write(buf, binary.LittleEndian, fh.End.ExtLen); err == nil {
 			extSlice := []byte(fh.End.Extension)
 			if err = binary.Write(buf, binary.LittleEndian, extSlice); err == nil {
@@ -277,8 +466,18 @@ func (fh *FileHeader) Write(w io.Writer) error {
 func (fh *FileHeader) Read(r io.Reader) error {
 	var err error
 
-	// read fixed header
+	// read fixed header, then the checks of v2.0 headers
 	if err = binary.Read(r, binary.LittleEndian, fh.Start); err == nil {
+		switch fh.Start.MajorVersion {
+		case FILEHEADER_V1:
+			fh.Check = nil
+		case FILEHEADER_MAJOR:
+			err = fh.Check.read(r)
+		default: // check compatibility of major version
+			return fmt.Errorf("incompatible file header major version, exp:%x got:%x", FILEHEADER_MAJOR, fh.Start.MajorVersion)
+		}
+	}
+	if err == nil {
 		// read extension length as part of the variable-size header epilogue
 		if err = binary.Read(r, binary.LittleEndian, &fh.End.ExtLen); err == nil {
 			if fh.End.ExtLen == 0 {
@@ -307,21 +506,74 @@ func (fh *FileHeader) Read(r io.Reader) error {
 		if fh.Start.Magic != FILEHEADER_START {
 			return fmt.Errorf("invalid file header magic %v", fh.Start.Magic)
 		}
-		// check compatibility of major version
-		if fh.Start.MajorVersion != FILEHEADER_MAJOR {
-			return fmt.Errorf("incompatible file header major version, exp:%x got:%x", FILEHEADER_MAJOR, fh.Start.MajorVersion)
-		}
-
 		fh.isValid = true
 	}
 
 	return err
 }
 
+// the header must name the cipher the file is decrypted with, else
+// it fails with ErrHeaderCipher.
+func (fh *FileHeader) CheckCipher(cipherId caesarx.CipherVariant) error {
+	if fh.Start.AlgorithmA != cipherId {
+		return fmt.Errorf("%w: the file was encrypted with %s, not %s", ErrHeaderCipher, fh.Start.AlgorithmA, cipherId)
+	}
+
+	return nil
+}
+
+// the SHA-256 of a file
+func (fh *FileHeader) digest(filename string, digest *[32]byte) error {
+	sum, err := cmn.CalculateFileSHA256(filename)
+	if err != nil {
+		return err
+	}
+
+	_, err = hex.Decode(digest[:], []byte(sum))
+	return err
+}
+
 /* ----------------------------------------------------------------
  *							F u n c t i o n s
  *-----------------------------------------------------------------*/
 
+/**
+ * Reads the header of an encrypted binary file if it has one, the reader
+ * is then positioned at the encrypted data. Files without header (v1.0
+ * or written with the header disabled) return a nil header and the
+ * reader is rewound.
+ */
+func ReadOptionalHeader(r io.ReadSeeker) (*FileHeader, error) {
+	var magic uint32
+	if err := binary.Read(r, binary.LittleEndian, &magic); err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if magic != FILEHEADER_START {
+		return nil, nil
+	}
+
+	fh := NewEmptyFileHeader()
+	if err := fh.Read(r); err != nil {
+		return nil, err
+	}
+
+	return fh, nil
+}
+
+// the header of an encrypted binary file, nil if it has none
+func PeekFileHeader(filename string) (*FileHeader, error) {
+	fd, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	return ReadOptionalHeader(fd)
+}
+
 /*
 func demo() {
 	const DUMMY string = "filename.bin"
@@ -17,6 +17,7 @@ import (
 	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/internal/bip39"
 	"lordofscripts/caesarx/internal/crypto"
+	"slices"
 	"strings"
 	"time"
 )
@@ -29,9 +30,21 @@ const (
 	// The default Codebook secret word length for Bellaso & Vigenère
 	DEFAULT_SECRET_LENGTH int = 26
 
+	// The Enigma key sheet: rotors in the machine & plugboard cables
+	DEFAULT_ENIGMA_ROTORS int = 3
+	DEFAULT_ENIGMA_PLUGS  int = 10
+
 	extraOffsetSeed int64 = 98254762
 )
 
+var (
+	// the Enigma I of the Wehrmacht: rotors I-V and reflectors B & C
+	// with the 26 letters of English
+	enigmaRotors     = []string{"I", "II", "III", "IV", "V"}
+	enigmaReflectors = []string{"B", "C"}
+	enigmaLetters    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
 /* ----------------------------------------------------------------
  *						I n t e r f a c e s
  *-----------------------------------------------------------------*/
@@ -65,6 +78,16 @@ type TriParametric struct {
 	C int
 }
 
+// A row of the Enigma key sheet. It is plain data, the enigma package
+// parses its String() like any other settings given with -secret.
+type EnigmaParametric struct {
+	Reflector string   // Umkehrwalze
+	Rotors    []string // Walzenlage, left to right
+	Rings     []int    // Ringstellung, left to right: 1 (A) .. 26 (Z)
+	Start     string   // Grundstellung
+	Plugs     []string // Steckerverbindungen
+}
+
 /* ----------------------------------------------------------------
  *							C o n s t r u c t o r s
  *-----------------------------------------------------------------*/
@@ -93,6 +116,18 @@ func (b BiParametric) String() string {
 	return fmt.Sprintf("%d, %d", b.A, b.B)
 }
 
+// the key sheet notation: UKW ROTORS RINGS START PLUGBOARD,
+// for example "B I-II-III 01-12-22 ABC AV BS"
+func (e EnigmaParametric) String() string {
+	rings := make([]string, len(e.Rings))
+	for i, ring := range e.Rings {
+		rings[i] = fmt.Sprintf("%02d", ring)
+	}
+
+	fields := []string{e.Reflector, strings.Join(e.Rotors, "-"), strings.Join(rings, "-"), e.Start}
+	return strings.Join(append(fields, e.Plugs...), " ")
+}
+
 // implements fmt.Stringer
 func (c *Caesarium) String() string {
 	return fmt.Sprintf("'%s' %s", c.title, c.yearBook)
@@ -323,6 +358,93 @@ func (c *Caesarium) CompileAffineBook() []TriParametric {
 	return paramBooklet
 }
 
+// generate a schedule of Affine coefficients for the polyalphabetic
+// Affine cipher. It is the Affine book of the month, one (A,B) pair per
+// day in the order of the days.
+func (c *Caesarium) CompileAffineSchedule() []crypto.AffinePair {
+	book := c.CompileAffineBook()
+	schedule := make([]crypto.AffinePair, len(book))
+	for day, params := range book {
+		schedule[day] = crypto.AffinePair{A: params.A, B: params.B}
+	}
+
+	return schedule
+}
+
+// generate an Enigma key sheet for the month: the daily reflector,
+// rotor order (Walzenlage), ring settings (Ringstellung), start
+// positions (Grundstellung) & plugboard pairs (Steckerverbindungen).
+// The historical machine has the 26 letters of English regardless of
+// the alphabet given in the constructor.
+func (c *Caesarium) CompileEnigmaBook() []EnigmaParametric {
+	letters := []rune(enigmaLetters)
+	N := len(letters)
+
+	// for the letters: rings, start positions & plugs
+	var rndL IRandomizer
+	if c.repeatable {
+		rndL = NewRepeatableRand(c.date, c.userSeed, 0, N-1)
+	} else {
+		rndL = NewTrueRand(0, N-1, false, false)
+	}
+
+	// for the wheels we want a different list but predictable
+	offsetSeed := c.userSeed + extraOffsetSeed
+	var rndW IRandomizer
+	if c.repeatable {
+		rndW = NewRepeatableRand(c.date, offsetSeed, 0, len(enigmaRotors)-1)
+	} else {
+		rndW = NewTrueRand(0, len(enigmaRotors)-1, false, false)
+	}
+
+	// the last day of this month
+	totalDays := DaysInMonth(c.date)
+	paramBooklet := make([]EnigmaParametric, totalDays)
+	for day := range totalDays {
+		settings := EnigmaParametric{
+			Reflector: enigmaReflectors[rndL.Intn()%len(enigmaReflectors)],
+			Rotors:    make([]string, 0, DEFAULT_ENIGMA_ROTORS),
+			Rings:     make([]int, DEFAULT_ENIGMA_ROTORS),
+			Plugs:     make([]string, 0, DEFAULT_ENIGMA_PLUGS),
+		}
+
+		// each rotor is used at most once
+		used := make(map[int]bool)
+		for len(settings.Rotors) < DEFAULT_ENIGMA_ROTORS {
+			if wheel := rndW.Intn(); !used[wheel] {
+				used[wheel] = true
+				settings.Rotors = append(settings.Rotors, enigmaRotors[wheel])
+			}
+		}
+
+		start := make([]rune, DEFAULT_ENIGMA_ROTORS)
+		for i := range DEFAULT_ENIGMA_ROTORS {
+			settings.Rings[i] = rndL.Intn() + 1
+			start[i] = letters[rndL.Intn()]
+		}
+		settings.Start = string(start)
+
+		// each letter is plugged at most once
+		plugged := make(map[int]bool)
+		for len(settings.Plugs) < DEFAULT_ENIGMA_PLUGS {
+			a, b := rndL.Intn(), rndL.Intn()
+			if a != b && !plugged[a] && !plugged[b] {
+				plugged[a], plugged[b] = true, true
+				if a > b {
+					a, b = b, a
+				}
+				settings.Plugs = append(settings.Plugs, string([]rune{letters[a], letters[b]}))
+			}
+		}
+
+		// sorted like the printed key sheets
+		slices.Sort(settings.Plugs)
+		paramBooklet[day] = settings
+	}
+
+	return paramBooklet
+}
+
 /* ----------------------------------------------------------------
  *							F u n c t i o n s
  *-----------------------------------------------------------------*/
@@ -0,0 +1,208 @@
+package tests
+
+import (
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"lordofscripts/caesarx/internal/sched"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ * Cipher: Polyalphabetic Affine (AffineSequencer in the Tabula Recta pipeline)
+ * Languages: all built-in
+ * Type : Known vectors & Round-trip (Encode-Decode)
+ */
+
+// A single pair through the pipeline is the same as the classic
+// (table-based) Affine, slave alphabet included.
+func Test_AffineSchedule_SinglePair(t *testing.T) {
+	allCases := []struct {
+		Alpha  *cmn.Alphabet
+		Params *affine.AffineParams
+		In     string
+	}{
+		{cmn.ALPHA_DISK, validAffineParamsEN, "I love cryptography 2025"},
+		{cmn.ALPHA_DISK_LATIN, validAffineParamsES, "Amo la criptografía 2025"},
+		{cmn.ALPHA_DISK_GERMAN, validAffineParamsDE, "Daß liebe hübschen Mädschen 2025"},
+		{cmn.ALPHA_DISK_GREEK, validAffineParamsGR, "Λατρεύω την κρυπτογραφία 2025"},
+		{cmn.ALPHA_DISK_CYRILLIC, validAffineParamsRU, "Мы любим криптографию 2025"},
+	}
+
+	for i, tc := range allCases {
+		classic := commands.NewAffineCommandExt(tc.Alpha, tc.Params)
+		classic.WithChain(cmn.NUMBERS_DISK_EXT)
+		expected, _ := classic.Encode(tc.In)
+
+		alg := commands.NewAffineTabulaCommand(tc.Alpha, []crypto.AffinePair{{A: tc.Params.A, B: tc.Params.B}})
+		alg.WithChain(cmn.NUMBERS_DISK_EXT)
+		if cipher, _ := alg.Encode(tc.In); cipher != expected {
+			t.Errorf("#%d %s Encode fail\n\texp: %s\n\tgot: %s", i+1, tc.Alpha.Name, expected, cipher)
+		}
+		if plain, _ := alg.Decode(expected); plain != tc.In {
+			t.Errorf("#%d %s Decode fail\n\texp: %s\n\tgot: %s", i+1, tc.Alpha.Name, tc.In, plain)
+		}
+	}
+}
+
+// f(x) = (A*x + B) % 26 alternating (5,3) and (7,0), the space is
+// skipped and does not consume a pair.
+func Test_AffineSchedule_Vectors(t *testing.T) {
+	const PLAIN = "Attack at dawn"
+	schedule, err := affine.ParseAffineSchedule("5:3,7:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alg := commands.NewAffineTabulaCommand(cmn.ALPHA_DISK, schedule)
+	cipher, _ := alg.Encode(PLAIN)
+	if cipher != "Dduans dd sajn" {
+		t.Errorf("Encode fail\n\texp: %s\n\tgot: %s", "Dduans dd sajn", cipher)
+	}
+	if plain, _ := alg.Decode(cipher); plain != PLAIN {
+		t.Errorf("Decode fail\n\texp: %s\n\tgot: %s", PLAIN, plain)
+	}
+
+	if got := affine.ScheduleString(schedule); got != "5:3,7:0" {
+		t.Errorf("ScheduleString exp: 5:3,7:0 got: %s", got)
+	}
+	for _, spec := range []string{"", "5", "5:3,", "x:3", "5:-1", "0:3"} {
+		if _, err := affine.ParseAffineSchedule(spec); err == nil {
+			t.Errorf("ParseAffineSchedule '%s' should fail", spec)
+		}
+	}
+	if commands.NewAffineTabulaCommand(cmn.ALPHA_DISK, []crypto.AffinePair{{A: 13, B: 1}}) != nil {
+		t.Error("A=13 is not a coprime of 26")
+	}
+}
+
+// All the built-in alphabets with a keyword schedule, a Caesarium
+// schedule and the digits in a slave alphabet.
+func Test_AffineSchedule_RoundTrip(t *testing.T) {
+	allCases := []struct {
+		Alpha *cmn.Alphabet
+		Input string
+	}{
+		{cmn.ALPHA_DISK, "I love cryptography since 1985, 100% true!"},
+		{cmn.ALPHA_DISK_LATIN, "Años amé la criptografía, ¡desde 1985!"},
+		{cmn.ALPHA_DISK_ITALIAN, "Amo la crittografia dal 1985, è vero?"},
+		{cmn.ALPHA_DISK_PORTUGUESE, "Eu amo a criptografia desde 1985, não é?"},
+		{cmn.ALPHA_DISK_GERMAN, "Daß liebe hübschen Mädschen (seit 1985)"},
+		{cmn.ALPHA_DISK_GREEK, "Λατρεύω την κρυπτογραφία από το 1985!"},
+		{cmn.ALPHA_DISK_CYRILLIC, "Я люблю криптографию с 1985 года!"},
+		{cmn.ALPHA_DISK_CZECH, "Miluji kryptografii od roku 1985, že?"},
+	}
+
+	date := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range allCases {
+		keyword := string([]rune{tc.Alpha.GetRuneAt(3), tc.Alpha.GetRuneAt(-1), tc.Alpha.GetRuneAt(11)})
+		book := sched.NewCaesarium("Test", tc.Alpha, date, 0).MakeRecoverable("affine", "").CompileAffineSchedule()
+		for _, alg := range []*commands.AffineTabulaCommand{
+			commands.NewAffineKeywordCommand(tc.Alpha, keyword),
+			commands.NewAffineTabulaCommand(tc.Alpha, book),
+		} {
+			if alg == nil {
+				t.Fatalf("%s no command for the schedule", tc.Alpha.Name)
+			}
+			alg.WithChain(cmn.NUMBERS_DISK)
+
+			cipher, _ := alg.Encode(tc.Input)
+			if strings.Contains(cipher, "1985") {
+				t.Errorf("%s digits were not encoded: %s", tc.Alpha.Name, cipher)
+			}
+			if plain, _ := alg.Decode(cipher); plain != tc.Input {
+				t.Errorf("%s %s Decode fail\n\texp: %s\n\tgot: %s", tc.Alpha.Name, alg, tc.Input, plain)
+			}
+		}
+	}
+}
+
+func Test_AffineSchedule_Sequencer(t *testing.T) {
+	const DUMMY = 'x'
+	keygen := crypto.NewAffineKeywordSequencer("kEy", cmn.ALPHA_DISK)
+	// coprimes of 26 other than 1: 3 5 7 9 11 15 17 19 21 23 25
+	expected := []crypto.AffinePair{{A: 25, B: 10}, {A: 11, B: 4}, {A: 7, B: 24}}
+	for i, pair := range keygen.Schedule() {
+		if pair != expected[i] {
+			t.Errorf("keyword pair #%d exp: %v got: %v", i+1, expected[i], pair)
+		}
+	}
+
+	// positions 0,1 then a skipped position, then 3 uses the 3rd pair
+	for pos, exp := range []rune{'K', 'E'} {
+		if k := keygen.GetKey(pos, DUMMY); k != exp {
+			t.Errorf("pos %d exp: %c got: %c", pos, exp, k)
+		}
+	}
+	keygen.Skip()
+	if a, aP := keygen.GetMultiplier(3, 26); a != 7 || (a*aP)%26 != 1 {
+		t.Errorf("multiplier exp: 7 got: %d (inverse %d)", a, aP)
+	}
+	// 11 is not a coprime of 10, the slave gets another one
+	if a, aP := keygen.GetMultiplier(2, 10); (a*aP)%10 != 1 {
+		t.Errorf("slave multiplier %d has no inverse %d", a, aP)
+	}
+	keygen.Reset()
+	if k := keygen.GetKey(3, DUMMY); k != 'K' {
+		t.Errorf("after Reset exp: K got: %c", k)
+	}
+
+	if crypto.NewAffineKeywordSequencer("Año", cmn.ALPHA_DISK) != nil {
+		t.Error("Ñ is not an English letter")
+	}
+	if crypto.NewAffineScheduleSequencer(nil, cmn.ALPHA_DISK) != nil {
+		t.Error("an empty schedule is not valid")
+	}
+}
+
+// Text & binary files go through the Text & Binary iterators
+func Test_AffineSchedule_Files(t *testing.T) {
+	const TEXT = "Attack at 10:45, bring 3 guns!\nI love cryptography"
+	FILE_IN := "/tmp/test_affine_schedule.txt"
+	FILE_RET := "/tmp/test_affine_schedule_rt.txt"
+	if err := os.WriteFile(FILE_IN, []byte(TEXT+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(FILE_IN)
+	defer os.Remove(FILE_RET)
+
+	schedule := []crypto.AffinePair{{A: 7, B: 3}, {A: 11, B: 250}, {A: 25, B: 0}}
+	alg := commands.NewAffineTabulaCommand(cmn.ALPHA_DISK, schedule)
+	alg.WithChain(cmn.NUMBERS_DISK)
+	if err := alg.EncryptTextFile(FILE_IN); err != nil {
+		t.Fatalf("failed EncryptTextFile: %v", err)
+	}
+	if !strings.HasSuffix(alg.GetOutputFilename(), commands.FILE_EXT_AFFINE_POLY) {
+		t.Errorf("exp. extension %s got: %s", commands.FILE_EXT_AFFINE_POLY, alg.GetOutputFilename())
+	}
+	if err := alg.DecryptTextFile(alg.GetOutputFilename(), FILE_RET); err != nil {
+		t.Errorf("failed DecryptTextFile: %v", err)
+	}
+	md5In, _ := cmn.CalculateFileMD5(FILE_IN)
+	md5Out, _ := cmn.CalculateFileMD5(FILE_RET)
+	if md5In != md5Out {
+		t.Error("round-trip decrypted text file not the same as input")
+	}
+	os.Remove(alg.GetOutputFilename())
+
+	assetIn := getAssetFilename(t, TEST_ASSETS, "input.bin")
+	assetRet := getAssetFilename(t, TEST_ASSETS, "output_schedule.bin")
+	bin := commands.NewAffineTabulaCommand(cmn.BINARY_DISK, schedule)
+	if err := bin.EncryptBinFile(assetIn); err != nil {
+		t.Fatalf("failed EncryptBinFile: %v", err)
+	}
+	if err := bin.DecryptBinFile(bin.GetOutputFilename(), assetRet); err != nil {
+		t.Errorf("failed DecryptBinFile: %v", err)
+	}
+	md5In, _ = cmn.CalculateFileMD5(assetIn)
+	md5Out, _ = cmn.CalculateFileMD5(assetRet)
+	if md5In != md5Out {
+		t.Error("round-trip decrypted binary file not the same as input")
+	}
+	os.Remove(bin.GetOutputFilename())
+	os.Remove(assetRet)
+}
@@ -609,6 +609,11 @@ func Test_Affine_Exit(t *testing.T) {
 		{"NGram 2", z.EXIT_CODE_SUCCESS, []string{"-ngram", "2", "-A", "7", "-B", "20", "'plain text'"}},
 		{"NGram 5", z.EXIT_CODE_SUCCESS, []string{"-ngram", "5", "-A", "7", "-B", "20", "'plain text'"}},
 		{"NGram invalid", z.ERR_PARAMETER, []string{"-ngram", "6", "-A", "7", "-B", "20", "'plain text'"}},
+		// common: transposition
+		{"Transpose Columnar", z.EXIT_CODE_SUCCESS, []string{"-transpose", "ZEBRA", "-A", "7", "-B", "20", "'plain text'"}},
+		{"Transpose Double", z.EXIT_CODE_SUCCESS, []string{"-transpose", "ZEBRA,KITE", "-A", "7", "-B", "20", "-d", "'cipher text'"}},
+		{"Transpose invalid", z.ERR_CLI_OPTIONS, []string{"-transpose", "ZEBRA,", "-A", "7", "-B", "20", "'plain text'"}},
+		{"Transpose binary", z.ERR_CLI_OPTIONS, []string{"-transpose", "ZEBRA", "-A", "7", "-B", "20", "-alpha", "binary", "-F", OUT_PLAIN_FILE}},
 		// application: encode cases
 		{"Encode message", z.EXIT_CODE_SUCCESS, []string{"-A", "7", "-B", "20", "'plain text'"}},
 		{"Encode message missing -B", z.ERR_PARAMETER, []string{"-A", "7", "'plain text'"}},
@@ -622,6 +627,29 @@ func Test_Affine_Exit(t *testing.T) {
 		{"Decode message missing -A -B", z.ERR_PARAMETER, []string{"-d", "'plain text'"}},
 		{"Decode file missing output", z.ERR_PARAMETER, []string{"-A", "7", "-B", "20", "-d", "-F", OUT_CIPHER_FILE}},
 		{"Decode file", z.EXIT_CODE_SUCCESS, []string{"-A", "7", "-B", "20", "-d", "-F", OUT_CIPHER_FILE, OUT_DECODED_FILE}},
+		// application: polyalphabetic schedule
+		{"Schedule encode", z.EXIT_CODE_SUCCESS, []string{"-schedule", "7:3,5:12", "'plain text'"}},
+		{"Schedule decode", z.EXIT_CODE_SUCCESS, []string{"-schedule", "7:3,5:12", "-d", "'cipher text'"}},
+		{"Schedule secret", z.EXIT_CODE_SUCCESS, []string{"-secret", "KEY", "-num", "N", "'plain text 2025'"}},
+		{"Schedule invalid", z.ERR_PARAMETER, []string{"-schedule", "7:3,5", "'plain text'"}},
+		{"Schedule invalid A", z.ERR_PARAMETER, []string{"-schedule", "13:3", "'plain text'"}},
+		{"Schedule invalid secret", z.ERR_PARAMETER, []string{"-secret", "Año", "'plain text'"}},
+		{"Schedule with -A", z.ERR_PARAMETER, []string{"-schedule", "7:3", "-A", "7", "'plain text'"}},
+		{"Schedule with -secret", z.ERR_PARAMETER, []string{"-schedule", "7:3", "-secret", "KEY", "'plain text'"}},
+		{"Schedule with -tabula", z.ERR_PARAMETER, []string{"-schedule", "7:3", "-tabula"}},
+		// application: cryptanalysis
+		{"Crack message", z.EXIT_CODE_SUCCESS, []string{"-crack", "-top", "3", "'Xrw qwijwx awwxyhk yq ux hooh'"}},
+		{"Crack file auto", z.EXIT_CODE_SUCCESS, []string{"-crack", "-alpha", "auto", "-F", OUT_CIPHER_FILE}},
+		{"Crack known", z.EXIT_CODE_SUCCESS, []string{"-crack", "-known", "t:x,e:w", "'Xrw qwijwx awwxyhk yq ux hooh'"}},
+		{"Crack known no key", z.ERR_PARAMETER, []string{"-crack", "-known", "t:x,h:x", "'Xrw qwijwx'"}},
+		{"Crack known one pair", z.ERR_PARAMETER, []string{"-crack", "-known", "t:x", "'Xrw qwijwx'"}},
+		{"Crack with -A", z.ERR_PARAMETER, []string{"-crack", "-A", "7", "'Xrw qwijwx'"}},
+		{"Crack binary", z.ERR_PARAMETER, []string{"-crack", "-alpha", "binary", "-F", OUT_CIPHER_FILE}},
+		{"Known without -crack", z.ERR_PARAMETER, []string{"-known", "t:x,e:w", "-A", "7", "-B", "20", "'plain text'"}},
+		{"Strength", z.EXIT_CODE_SUCCESS, []string{"-strength", "-A", "1", "-B", "3"}},
+		{"Strength message", z.EXIT_CODE_SUCCESS, []string{"-strength", "-A", "7", "-B", "3", "'plain text'"}},
+		{"Strength not coprime", z.ERR_PARAMETER, []string{"-strength", "-A", "13", "-B", "3"}},
+		{"Strength without -B", z.ERR_PARAMETER, []string{"-strength", "-A", "7"}},
 	}
 
 	// @note We set this on go.yml so that this test is SKIPPED on GitHub servers
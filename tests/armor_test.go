@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"errors"
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/internal/files"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ * Package: internal/files (ASCII armor)
+ * Languages: English
+ * Type : Armored message write, read & checksum
+ */
+
+func Test_Armor_ReadWrite(t *testing.T) {
+	armor := files.NewArmor(z.BellasoCipher, "EN")
+	armor.Chained = "NUMDX"
+	armor.NGram = 5
+	armor.Date = time.Date(2025, time.August, 14, 0, 0, 0, 0, time.UTC)
+	armor.Profile = "you@bitbucket.com"
+	armor.Body = "Lxfop·v+mh6·oeib6\n$4:@2"
+
+	armored := armor.String()
+	if !strings.HasPrefix(armored, files.ARMOR_BEGIN+"\n") || !strings.HasSuffix(armored, armor.Checksum()+"\n"+files.ARMOR_END+"\n") {
+		t.Errorf("bad armor:\n%s", armored)
+	}
+
+	// pasted in a chat, with greetings & Windows line breaks
+	pasted := "Hi Bob, here it goes\r\n" + strings.ReplaceAll(armored, "\n", "\r\n") + "Bye\r\n"
+	if !files.IsArmored(pasted) {
+		t.Fatal("armored message not detected")
+	}
+	armorIn, err := files.ParseArmor(pasted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *armorIn != *armor {
+		t.Errorf("exp: %+v got: %+v", armor, armorIn)
+	}
+	if lines := armorIn.Lines(); len(lines) != 2 || lines[0] != "Lxfopv+mh6oeib6" {
+		t.Errorf("NGram separators not removed: %q", lines)
+	}
+	if err = armorIn.CheckCipher(z.VigenereCipher); !errors.Is(err, files.ErrHeaderCipher) {
+		t.Errorf("exp: %v got: %v", files.ErrHeaderCipher, err)
+	}
+}
+
+func Test_Armor_Errors(t *testing.T) {
+	armor := files.NewArmor(z.CaesarCipher, "EN")
+	armor.Body = "Wkh txlfn eurzq ira"
+	armored := armor.String()
+
+	if files.IsArmored(armor.Body) {
+		t.Error("plain ciphered text taken as armored")
+	}
+
+	tests := []struct {
+		Title string
+		Text  string
+		Err   error
+	}{
+		{"mangled text", strings.Replace(armored, "txlfn", "txlfm", 1), files.ErrArmorChecksum},
+		{"no checksum", strings.Replace(armored, armor.Checksum()+"\n", "", 1), files.ErrArmorFormat},
+		{"no END", strings.Replace(armored, files.ARMOR_END, "", 1), files.ErrArmorFormat},
+		{"no variant", strings.Replace(armored, "Variant: Caesar\n", "", 1), files.ErrArmorFormat},
+		{"bad variant", strings.Replace(armored, "Variant: Caesar", "Variant: Nero", 1), files.ErrArmorFormat},
+		{"not armored", armor.Body, files.ErrArmorFormat},
+	}
+
+	for _, tc := range tests {
+		if _, err := files.ParseArmor(tc.Text); !errors.Is(err, tc.Err) {
+			t.Errorf("%s exp: %v got: %v", tc.Title, tc.Err, err)
+		}
+	}
+}
@@ -7,8 +7,14 @@
 package tests
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
 	"lordofscripts/caesarx/internal/files"
 	"os"
 	"testing"
@@ -19,7 +25,7 @@ import (
  *-----------------------------------------------------------------*/
 
 /* ----------------------------------------------------------------
- *					T e s t s :: AffineHelper
+ *					T e s t s :: FileHeader
  *-----------------------------------------------------------------*/
 
 // File Header test. It writes a valid header to a binary file, then
@@ -69,3 +75,200 @@ func Test_FileHeader_ReadWrite(t *testing.T) {
 
 	os.Remove(OUTPUT_BIN_FILE)
 }
+
+// Headerless files (v1.0 or -noheader) are read as such and the reader
+// is rewound, else it is left at the encrypted data.
+func Test_FileHeader_Optional(t *testing.T) {
+	fh, err := files.NewFileHeader(caesarx.BellasoCipher, "photo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err = fh.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("DATA")
+
+	reader := bytes.NewReader(buf.Bytes())
+	if fhIn, err := files.ReadOptionalHeader(reader); err != nil || !fhIn.Equals(fh) {
+		t.Errorf("header not read: %v %v", fhIn, err)
+	} else if reader.Len() != 4 || fhIn.Size() != buf.Len()-4 || fhIn.FileExtension() != ".png" {
+		t.Errorf("reader not at the data, %d bytes left", reader.Len())
+	}
+
+	for _, data := range []string{"", "PK", "some plain data"} {
+		reader = bytes.NewReader([]byte(data))
+		if fhIn, err := files.ReadOptionalHeader(reader); fhIn != nil || err != nil || reader.Len() != len(data) {
+			t.Errorf("%q exp: no header got: %v %v", data, fhIn, err)
+		}
+	}
+
+	if err = fh.CheckCipher(caesarx.BellasoCipher); err != nil {
+		t.Error(err)
+	}
+	if err = fh.CheckCipher(caesarx.VigenereCipher); !errors.Is(err, files.ErrHeaderCipher) {
+		t.Errorf("exp: %v got: %v", files.ErrHeaderCipher, err)
+	}
+}
+
+// Every cipher writes its header, decryption refuses the file of another
+// cipher & still reads headerless files.
+func Test_FileHeader_Cipher(t *testing.T) {
+	const INPUT_FILE = "testdata/input.bin"
+	const RETURN_FILE = "testdata/input-hdr-rt.bin"
+
+	tests := []struct {
+		Variant caesarx.CipherVariant
+		Command ciphers.IFileHeaderCommand
+	}{
+		{caesarx.CaesarCipher, commands.NewCaesarCommand(cmn.BINARY_DISK, 'K')},
+		{caesarx.DidimusCipher, commands.NewDidimusCommand(cmn.BINARY_DISK, 'K', 5)},
+		{caesarx.FibonacciCipher, commands.NewFibonacciCommand(cmn.BINARY_DISK, 'K')},
+		{caesarx.BellasoCipher, commands.NewBellasoCommand(cmn.BINARY_DISK, "LEMON")},
+		{caesarx.VigenereCipher, commands.NewVigenereCommand(cmn.BINARY_DISK, "LEMON")},
+		{caesarx.BeaufortCipher, commands.NewBeaufortCommand(cmn.BINARY_DISK, "LEMON")},
+		{caesarx.VariantBeaufortCipher, commands.NewVariantBeaufortCommand(cmn.BINARY_DISK, "LEMON")},
+		{caesarx.AffineCipher, commands.NewAffineCommand(cmn.BINARY_DISK, 7, 3)},
+	}
+
+	other := commands.NewBellasoCommand(cmn.BINARY_DISK, "LEMON")
+	wrongKey := commands.NewBellasoCommand(cmn.BINARY_DISK, "LEMONS")
+	for _, tc := range tests {
+		if err := tc.Command.EncryptBinFile(INPUT_FILE); err != nil {
+			t.Errorf("%s: %v", tc.Variant, err)
+			continue
+		}
+		output := tc.Command.GetOutputFilename()
+
+		if fh, err := files.PeekFileHeader(output); err != nil || fh == nil {
+			t.Errorf("%s no header: %v", tc.Variant, err)
+		} else if fh.Start.AlgorithmA != tc.Variant || fh.FileExtension() != ".bin" {
+			t.Errorf("%s wrong header %s", tc.Variant, fh)
+		}
+
+		if fh, _ := files.PeekFileHeader(output); fh != nil && fh.Alphabet() != cmn.BINARY_DISK.LangCodeISO() {
+			t.Errorf("%s exp: alphabet %s got: %q", tc.Variant, cmn.BINARY_DISK.LangCodeISO(), fh.Alphabet())
+		}
+
+		if tc.Variant != caesarx.BellasoCipher {
+			if err := other.DecryptBinFile(output, RETURN_FILE); !errors.Is(err, files.ErrHeaderCipher) {
+				t.Errorf("%s exp: %v got: %v", tc.Variant, files.ErrHeaderCipher, err)
+			}
+			if _, err := os.Stat(RETURN_FILE); err == nil {
+				t.Errorf("%s output written for the wrong cipher", tc.Variant)
+			}
+		} else {
+			if err := wrongKey.DecryptBinFile(output, RETURN_FILE); !errors.Is(err, files.ErrHeaderKey) {
+				t.Errorf("%s exp: %v got: %v", tc.Variant, files.ErrHeaderKey, err)
+			}
+			if _, err := os.Stat(RETURN_FILE); err == nil {
+				t.Errorf("%s output written with the wrong key", tc.Variant)
+			}
+		}
+
+		// headerless, as v1.0 did
+		tc.Command.WithFileHeader(false)
+		if err := tc.Command.EncryptBinFile(INPUT_FILE); err != nil {
+			t.Errorf("%s: %v", tc.Variant, err)
+		} else if fh, _ := files.PeekFileHeader(output); fh != nil {
+			t.Errorf("%s header written with WithFileHeader(false)", tc.Variant)
+		} else if err = tc.Command.DecryptBinFile(output, RETURN_FILE); err != nil {
+			t.Errorf("%s: %v", tc.Variant, err)
+		} else {
+			md5In, _ := cmn.CalculateFileMD5(INPUT_FILE)
+			md5Out, _ := cmn.CalculateFileMD5(RETURN_FILE)
+			if md5In != md5Out {
+				t.Errorf("%s headerless round trip failed", tc.Variant)
+			}
+		}
+
+		os.Remove(output)
+		os.Remove(RETURN_FILE)
+	}
+}
+
+// v2.0 headers hold a salted key fingerprint & the digest of the plain
+// file, v1.0 headers have neither and are still read.
+func Test_FileHeader_V2(t *testing.T) {
+	const INPUT_FILE = "testdata/input.bin"
+	const OUTPUT_FILE = "testdata/input-v1.bel"
+	const RETURN_FILE = "testdata/input-v1-rt.bin"
+
+	keyed := func(shift byte) files.KeyProbe {
+		return func(probe []byte) []byte {
+			ciphered := make([]byte, len(probe))
+			for i, b := range probe {
+				ciphered[i] = b + shift
+			}
+			return ciphered
+		}
+	}
+
+	fh, _ := files.NewFileHeader(caesarx.CaesarCipher, INPUT_FILE)
+	if err := fh.SetAlphabet("BX"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fh.SetKey(keyed(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := fh.SetDigest(INPUT_FILE); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := fh.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	fhIn := files.NewEmptyFileHeader()
+	if err := fhIn.Read(&buf); err != nil || !fhIn.Equals(fh) || fhIn.Alphabet() != "BX" {
+		t.Errorf("v2 header not read back: %v %v", fhIn, err)
+	}
+	if err := fhIn.VerifyKey(keyed(3)); err != nil {
+		t.Error(err)
+	}
+	if err := fhIn.VerifyKey(keyed(4)); err != files.ErrHeaderKey {
+		t.Errorf("exp: %v got: %v", files.ErrHeaderKey, err)
+	}
+	if err := fhIn.VerifyDigest(INPUT_FILE); err != nil {
+		t.Error(err)
+	}
+	if err := fhIn.VerifyDigest("testdata/ascii.bin"); err != files.ErrHeaderDigest {
+		t.Errorf("exp: %v got: %v", files.ErrHeaderDigest, err)
+	}
+
+	// a v1.0 file: the header without checks followed by the ciphered data
+	plain, err := os.ReadFile(INPUT_FILE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1, _ := files.NewFileHeader(caesarx.BellasoCipher, INPUT_FILE)
+	v1.Start.MajorVersion = files.FILEHEADER_V1
+	v1.Check = nil
+	buf.Reset()
+	if err = v1.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	buf.Write(bellaso.NewBellasoTabulaRecta(cmn.BINARY_DISK, "LEMON").EncodeBytes(plain))
+	if err = os.WriteFile(OUTPUT_FILE, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if fhIn, err = files.PeekFileHeader(OUTPUT_FILE); err != nil || fhIn == nil || fhIn.Check != nil {
+		t.Errorf("v1 header not read: %v %v", fhIn, err)
+	} else if fhIn.Size() != buf.Len()-len(plain) {
+		t.Errorf("v1 header size exp: %d got: %d", buf.Len()-len(plain), fhIn.Size())
+	}
+	if err = commands.NewBellasoCommand(cmn.BINARY_DISK, "LEMON").DecryptBinFile(OUTPUT_FILE, RETURN_FILE); err != nil {
+		t.Error(err)
+	} else if md5In, _ := cmn.CalculateFileMD5(INPUT_FILE); md5In != md5Of(RETURN_FILE) {
+		t.Error("v1 file not decrypted")
+	}
+
+	os.Remove(OUTPUT_FILE)
+	os.Remove(RETURN_FILE)
+}
+
+func md5Of(filename string) string {
+	sum, _ := cmn.CalculateFileMD5(filename)
+	return sum
+}
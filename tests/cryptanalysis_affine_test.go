@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (Affine cracker)
+ * Languages: all built-in
+ * Type : Brute-force & known plain text key recovery
+ */
+
+// the coefficients are the first candidate with every alphabet
+func Test_AffineCrack_Alphabets(t *testing.T) {
+	cracker := cryptanalysis.NewAffineCracker()
+	for _, alpha := range BuiltinAlphabets {
+		plain := SampleTexts[alpha.LangCodeISO()]
+		coprimes := affine.NewAffineHelper().ValidCoprimesUpTo(alpha.Size())
+		a, b := coprimes[len(coprimes)/2], 11
+		cipher, _ := commands.NewAffineCommand(alpha, a, b).Encode(plain)
+
+		candidates, err := cracker.Crack(cipher, alpha)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp := len(coprimes) * int(alpha.Size()); len(candidates) != exp {
+			t.Errorf("%s tried exp: %d keys got: %d", alpha.Name, exp, len(candidates))
+		}
+		if best := candidates[0]; best.Params.A != a || best.Params.B != b || best.Plain != plain {
+			t.Errorf("%s exp: A=%d B=%d got: %s", alpha.Name, a, b, best)
+		}
+	}
+}
+
+// the slave coefficients are derived from the master ones
+func Test_AffineCrack_Slave(t *testing.T) {
+	const PLAIN = "Attack at dawn with 300 men, the enemy is weak on the eastern flank"
+	cmdCipher := commands.NewAffineCommand(cmn.ALPHA_DISK, 11, 4)
+	cmdCipher.WithChain(cmn.NUMBERS_DISK)
+	cipher, _ := cmdCipher.Encode(PLAIN)
+
+	cracker := cryptanalysis.NewAffineCracker().WithChain(cmn.NUMBERS_DISK)
+	candidates, _ := cracker.Crack(cipher, cmn.ALPHA_DISK, cmn.ALPHA_DISK_GREEK)
+	best := candidates[0]
+	if best.Plain != PLAIN || best.Slave == nil {
+		t.Fatalf("slave exp: %s got: %s", PLAIN, best.Plain)
+	}
+	if best.Slave.N != 10 || best.Slave.A*best.Slave.Ap%10 != 1 {
+		t.Errorf("slave params %s", best.Slave)
+	}
+}
+
+func Test_AffineCrack_Known(t *testing.T) {
+	plain := SampleTexts[cmn.ISO_EN]
+	cipher, _ := commands.NewAffineCommand(cmn.ALPHA_DISK, 7, 20).Encode(plain)
+	cracker := cryptanalysis.NewAffineCracker()
+
+	// T(19)->X(23) & E(4)->W(22) differ by 15, coprime of 26
+	// T(19)->X(23) & H(7)->R(17) differ by 12, solved by testing every A
+	for _, spec := range []string{"t:x,e:w", "T:X,h:r"} {
+		pairs, err := cryptanalysis.ParseKnownPairs(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		candidates, err := cracker.SolveKnown(cipher, pairs[0], pairs[1], BuiltinAlphabets...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if best := candidates[0]; best.Alphabet != cmn.ALPHA_DISK || best.Params.A != 7 || best.Params.B != 20 || best.Plain != plain {
+			t.Errorf("%s exp: A=7 B=20 got: %s", spec, best)
+		}
+	}
+
+	for spec, exp := range map[string]error{
+		"t:x,h:x": cryptanalysis.ErrKnownNoKey,     // different letters can't map to the same
+		"a:a,n:n": cryptanalysis.ErrKnownAmbiguous, // differ by 13, every odd A fits
+		"t:x,1:3": cryptanalysis.ErrKnownLetter,
+	} {
+		pairs, _ := cryptanalysis.ParseKnownPairs(spec)
+		if _, err := cracker.SolveKnown(cipher, pairs[0], pairs[1], cmn.ALPHA_DISK); err != exp {
+			t.Errorf("%s exp: %v got: %v", spec, exp, err)
+		}
+	}
+
+	for _, spec := range []string{"t:x,e", "tx:x", "t:", ""} {
+		if _, err := cryptanalysis.ParseKnownPairs(spec); err != cryptanalysis.ErrKnownPairs {
+			t.Errorf("%q exp. ErrKnownPairs got: %v", spec, err)
+		}
+	}
+}
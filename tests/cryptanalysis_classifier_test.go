@@ -0,0 +1,128 @@
+package tests
+
+import (
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+/**
+ * Package: cryptanalysis (variant classifier)
+ * Languages: all built-in
+ * Type : Identification of the variant & alphabet of a ciphered text
+ */
+
+// the Tabula Recta variants (and Affine) of every built-in language
+func Test_Classify_Tabula(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		plain := SampleTexts[alpha.LangCodeISO()]
+		key := []rune(alpha.Chars)[10]
+		secret := string([]rune(alpha.Chars)[3:8])
+		coprime := affine.NewAffineHelper().ValidCoprimesUpTo(alpha.Size())[3]
+
+		ciphered := map[z.CipherVariant]string{}
+		ciphered[z.CaesarCipher], _ = commands.NewCaesarCommand(alpha, key).WithChain(nil).Encode(plain)
+		ciphered[z.DidimusCipher], _ = commands.NewDidimusCommand(alpha, key, 5).WithChain(nil).Encode(plain)
+		ciphered[z.FibonacciCipher], _ = commands.NewFibonacciCommand(alpha, key).WithChain(nil).Encode(plain)
+		ciphered[z.BellasoCipher], _ = commands.NewBellasoCommand(alpha, secret).WithChain(nil).Encode(plain)
+		ciphered[z.BeaufortCipher], _ = commands.NewBeaufortCommand(alpha, secret).WithChain(nil).Encode(plain)
+		ciphered[z.AffineCipher], _ = commands.NewAffineCommand(alpha, coprime, 8).Encode(plain)
+
+		for variant, cipher := range ciphered {
+			guesses, profile, err := cryptanalysis.NewVariantClassifier().Classify(cipher)
+			if err != nil {
+				t.Errorf("%s %s: %v", alpha.Name, variant, err)
+				continue
+			}
+			if profile.Alphabet.LangCodeISO() != alpha.LangCodeISO() {
+				t.Errorf("%s %s: alphabet %s", alpha.Name, variant, profile.Alphabet.Name)
+			}
+			if guesses[0].Variant != variant {
+				t.Errorf("%s exp: %s got: %v", alpha.Name, variant, guesses)
+			}
+		}
+	}
+}
+
+// the autokey is slow to confirm, English only
+func Test_Classify_Vigenere(t *testing.T) {
+	// the autokey can't skip punctuation foreign to the slave
+	plain := strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) {
+			return -1
+		}
+		return r
+	}, SampleTexts[cmn.ISO_EN])
+	cipher, _ := commands.NewVigenereCommand(cmn.ALPHA_DISK, "DEFGH").WithChain(cmn.NUMBERS_DISK_EXT).Encode(plain)
+
+	guesses, _, err := cryptanalysis.NewVariantClassifier().WithChain(cmn.NUMBERS_DISK_EXT).Classify(cipher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if guesses[0].Variant != z.VigenereCipher {
+		t.Errorf("exp: Vigenere got: %v", guesses)
+	}
+}
+
+// the polygraphic & fractionating variants strip the separators
+func Test_Classify_Stripped(t *testing.T) {
+	plain := SampleTexts[cmn.ISO_EN]
+	playfair, _ := commands.NewPlayfairCommand(cmn.ALPHA_DISK, "MONARCHY")
+	polybius, _ := commands.NewPolybiusCommand(cmn.ALPHA_DISK, "", "")
+	adfgvx, _ := commands.NewAdfgvxCommand(cmn.ALPHA_DISK, "PRIVACY", "GERMAN")
+
+	for variant, command := range map[z.CipherVariant]interface {
+		Encode(string) (string, error)
+	}{z.PlayfairCipher: playfair, z.PolybiusCipher: polybius, z.AdfgvxCipher: adfgvx} {
+		cipher, err := command.Encode(plain)
+		if err != nil {
+			t.Fatalf("%s: %v", variant, err)
+		}
+
+		guesses, _, err := cryptanalysis.NewVariantClassifier().Classify(cipher)
+		if err != nil {
+			t.Errorf("%s: %v", variant, err)
+		} else if guesses[0].Variant != variant {
+			t.Errorf("exp: %s got: %v", variant, guesses)
+		}
+	}
+}
+
+// the NGram grouping is undone before the analysis
+func Test_Classify_Grouping(t *testing.T) {
+	cipher, _ := commands.NewCaesarCommand(cmn.ALPHA_DISK, 'K').WithChain(nil).Encode(SampleTexts[cmn.ISO_EN])
+	for _, size := range []uint8{2, 3, 5} {
+		grouped, _ := cmn.NewNgramFormatter(size, '·').Execute(cipher)
+		profile, err := cryptanalysis.NewVariantClassifier().Profile(grouped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if profile.Grouping != int(size) {
+			t.Errorf("exp: %d got: %d", size, profile.Grouping)
+		}
+	}
+
+	if _, _, err := cryptanalysis.NewVariantClassifier().Classify("¡!  ¿?"); err != cryptanalysis.ErrClassifyEmpty {
+		t.Errorf("exp: %v got: %v", cryptanalysis.ErrClassifyEmpty, err)
+	}
+}
+
+func Test_IdentifyAlphabets(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		matches := cmn.IdentifyAlphabets(SampleTexts[alpha.LangCodeISO()])
+		if len(matches) == 0 || matches[0].Alphabet.LangCodeISO() != alpha.LangCodeISO() {
+			t.Errorf("%s identified as %v", alpha.Name, matches)
+		} else if matches[0].Coverage < 0.99 {
+			t.Errorf("%s coverage %.2f", alpha.Name, matches[0].Coverage)
+		}
+	}
+
+	if matches := cmn.IdentifyAlphabets("1234 5678"); len(matches) != 0 {
+		t.Errorf("digits identified as %v", matches)
+	}
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (brute-force key cracker)
+ * Languages: all built-in
+ * Type : Known key recovery
+ */
+
+// every variant with every alphabet, the right key is the first candidate.
+// Like in the CLI there is no slave unless -num is given.
+func Test_Crack_Variants(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		plain := SampleTexts[alpha.LangCodeISO()]
+		key := alpha.GetRuneAt(7)
+		for _, tc := range []struct {
+			Alg    ciphers.ICipherCommand
+			Offset int
+		}{
+			{commands.NewCaesarCommand(alpha, key), 0},
+			{commands.NewDidimusCommand(alpha, key, 5).WithChain(nil), 5},
+			{commands.NewFibonacciCommand(alpha, key).WithChain(nil), 0},
+		} {
+			cipher, _ := tc.Alg.Encode(plain)
+			variant := z.DidimusCipher
+			switch tc.Alg.(type) {
+			case *commands.CaesarCommand:
+				variant = z.CaesarCipher
+			case *commands.FibonacciCommand:
+				variant = z.FibonacciCipher
+			}
+
+			cracker, err := cryptanalysis.NewKeyCracker(variant)
+			if err != nil {
+				t.Fatal(err)
+			}
+			candidates, err := cracker.Crack(cipher, alpha)
+			if err != nil {
+				t.Fatal(err)
+			}
+			best := candidates[0]
+			if best.Key != key || best.Offset != tc.Offset || best.Plain != plain {
+				t.Errorf("%s %s exp: key %c offset %d got: %s", alpha.Name, variant, key, tc.Offset, best)
+			}
+		}
+	}
+}
+
+// with -alpha auto the language of the message is found as well
+func Test_Crack_AutoAlphabet(t *testing.T) {
+	all := make([]*cmn.Alphabet, 0)
+	for _, ref := range cryptanalysis.AllLanguageStats() {
+		all = append(all, ref.Alphabet())
+	}
+
+	cracker, _ := cryptanalysis.NewKeyCracker(z.CaesarCipher)
+	for _, alpha := range BuiltinAlphabets {
+		key := alpha.GetRuneAt(int(alpha.Size()) - 3)
+		cipher, _ := commands.NewCaesarCommand(alpha, key).Encode(SampleTexts[alpha.LangCodeISO()])
+		candidates, _ := cracker.Crack(cipher, all...)
+		if best := candidates[0]; best.Alphabet != alpha || best.Key != key {
+			t.Errorf("%s exp: key %c got: %s", alpha.Name, key, best)
+		}
+	}
+
+	// the digits are only recovered with the same slave
+	const PLAIN = "Attack at dawn with 300 men, the enemy is weak on the eastern flank"
+	cipher, _ := commands.NewCaesarCommand(cmn.ALPHA_DISK, 'K').WithChain(cmn.NUMBERS_DISK).Encode(PLAIN)
+	candidates, _ := cracker.WithChain(cmn.NUMBERS_DISK).Crack(cipher, all...)
+	if top := cryptanalysis.TopCandidates(candidates, 3); len(top) != 3 || top[0].Plain != PLAIN {
+		t.Errorf("slave exp: %s got: %s", PLAIN, top[0].Plain)
+	}
+}
+
+func Test_Crack_Errors(t *testing.T) {
+	if _, err := cryptanalysis.NewKeyCracker(z.BellasoCipher); err != cryptanalysis.ErrCrackVariant {
+		t.Errorf("exp. ErrCrackVariant got: %v", err)
+	}
+
+	cracker, _ := cryptanalysis.NewKeyCracker(z.CaesarCipher)
+	if _, err := cracker.Crack("12345", cmn.NUMBERS_DISK, cmn.BINARY_DISK); err != cryptanalysis.ErrCrackAlphabet {
+		t.Errorf("exp. ErrCrackAlphabet got: %v", err)
+	}
+
+	// plain text is closer to zero than ciphered or foreign text
+	ref := cryptanalysis.StatsFor(cmn.ALPHA_DISK)
+	plain := SampleTexts[cmn.ISO_EN]
+	cipher, _ := commands.NewCaesarCommand(cmn.ALPHA_DISK, 'D').Encode(plain)
+	fitPlain := cryptanalysis.Fitness(plain, ref)
+	if fitCipher := cryptanalysis.Fitness(cipher, ref); fitCipher >= fitPlain {
+		t.Errorf("fitness of ciphered %.3f not below plain %.3f", fitCipher, fitPlain)
+	}
+	if fitGreek := cryptanalysis.Fitness(SampleTexts[cmn.ISO_GR], ref); fitGreek >= fitPlain {
+		t.Errorf("fitness of Greek %.3f not below English %.3f", fitGreek, fitPlain)
+	}
+}
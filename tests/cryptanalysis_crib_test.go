@@ -0,0 +1,117 @@
+package tests
+
+import (
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"slices"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (crib attack)
+ * Languages: all built-in
+ * Type : Key inference from a known plain text
+ */
+
+// the key of every variant is inferred from a crib at a known & unknown position
+func Test_Crib_Variants(t *testing.T) {
+	for _, slave := range []*cmn.Alphabet{nil, cmn.NUMBERS_DISK_EXT} {
+		for _, alpha := range BuiltinAlphabets {
+			plain := SampleTexts[alpha.LangCodeISO()]
+			crib := string([]rune(plain)[20:40])
+			key := []rune(alpha.Chars)[10]
+			secret := string([]rune(alpha.Chars)[3:8])
+
+			ciphered := map[z.CipherVariant]string{}
+			ciphered[z.CaesarCipher], _ = commands.NewCaesarCommand(alpha, key).WithChain(slave).Encode(plain)
+			ciphered[z.DidimusCipher], _ = commands.NewDidimusCommand(alpha, key, 5).WithChain(slave).Encode(plain)
+			ciphered[z.FibonacciCipher], _ = commands.NewFibonacciCommand(alpha, key).WithChain(slave).Encode(plain)
+			ciphered[z.BellasoCipher], _ = commands.NewBellasoCommand(alpha, secret).WithChain(slave).Encode(plain)
+
+			attack := cryptanalysis.NewCribAttack(alpha).WithChain(slave)
+			for variant, cipher := range ciphered {
+				for _, at := range []int{20, cryptanalysis.CRIB_ANYWHERE} {
+					solutions, err := attack.Solve(variant, cipher, crib, at)
+					if err != nil {
+						t.Errorf("%s %s at %d: %v", alpha.Name, variant, at, err)
+						continue
+					}
+
+					i := slices.IndexFunc(solutions, func(s *cryptanalysis.CribSolution) bool { return s.Stream.Offset == 20 })
+					if i == -1 || solutions[i].Plain != plain {
+						t.Errorf("%s %s at %d: crib not solved %v", alpha.Name, variant, at, solutions)
+						continue
+					}
+					solution := solutions[i]
+					switch variant {
+					case z.DidimusCipher:
+						if solution.Key != key || solution.AltOffset != 5 {
+							t.Errorf("%s Didimus exp: %c/5 got: %c/%d", alpha.Name, key, solution.Key, solution.AltOffset)
+						}
+					case z.BellasoCipher:
+						if solution.Secret != secret {
+							t.Errorf("%s Bellaso exp: %s got: %s", alpha.Name, secret, solution.Secret)
+						}
+					default:
+						if solution.Key != key {
+							t.Errorf("%s %s exp: %c got: %c", alpha.Name, variant, key, solution.Key)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func Test_Crib_KeyStream(t *testing.T) {
+	attack := cryptanalysis.NewCribAttack(cmn.ALPHA_DISK).WithChain(cmn.NUMBERS_DISK)
+	// Caesar key D, the digits shift 3 too
+	stream, err := attack.KeyStream("Wkh 4 vhfuhwv!", "the 1 secrets!", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(stream.Skipped, []int{3, 5, 13}) || len(stream.Keys) != 11 {
+		t.Errorf("skipped %v keys %v", stream.Skipped, stream.Keys)
+	}
+	for _, key := range stream.Keys {
+		if key.Shift != 3 || key.Slave != (key.Pos == 4) {
+			t.Errorf("key %v", key)
+		}
+	}
+
+	// only digits at the first key position, the secret is incomplete
+	cipher, _ := commands.NewBellasoCommand(cmn.ALPHA_DISK, "LEMON").WithChain(cmn.NUMBERS_DISK).Encode("1he se3ret")
+	solutions, err := attack.Solve(z.BellasoCipher, cipher, "1he se3r", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if solutions[0].Secret != "?EMON" || len(solutions[0].Plain) != 0 {
+		t.Errorf("partial secret exp: ?EMON got: %s", solutions[0].Secret)
+	}
+}
+
+func Test_Crib_Errors(t *testing.T) {
+	attack := cryptanalysis.NewCribAttack(cmn.ALPHA_DISK)
+	cases := []struct {
+		Variant z.CipherVariant
+		Crib    string
+		At      int
+		Err     error
+	}{
+		{z.VigenereCipher, "the", 0, cryptanalysis.ErrCribVariant},
+		{z.CaesarCipher, "123", 0, cryptanalysis.ErrCribEmpty},
+		{z.CaesarCipher, "the", 20, cryptanalysis.ErrCribOffset},
+		{z.CaesarCipher, "the!", 0, cryptanalysis.ErrCribMismatch},
+		{z.CaesarCipher, "tha", 0, cryptanalysis.ErrCribKey},
+		{z.DidimusCipher, "t", 0, cryptanalysis.ErrCribShort},
+		{z.CaesarCipher, "abcdef", cryptanalysis.CRIB_ANYWHERE, cryptanalysis.ErrCribNotFound},
+	}
+
+	for _, c := range cases {
+		if _, err := attack.Solve(c.Variant, "Wkh vhfuhw", c.Crib, c.At); err != c.Err {
+			t.Errorf("%s %q exp: %v got: %v", c.Variant, c.Crib, c.Err, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/runningkey"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"lordofscripts/caesarx/internal/crypto"
+	"os"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (deniable keys)
+ * Languages: English, binary
+ * Type : Decoy secret & one-time pad of an existing ciphered message
+ */
+
+func Test_Decoy_Bellaso(t *testing.T) {
+	const PLAIN = "Meet me at the old bridge at 10 tonight"
+	ciphered := bellaso.NewBellasoTabulaRecta(cmn.ALPHA_DISK, "LEMON").Encode(PLAIN)
+
+	tests := []struct {
+		Decoy  string
+		Secret string
+		Plain  string
+	}{
+		// the real plain text gives the real secret back
+		{PLAIN, "LEMON", PLAIN},
+		// other digits, they are in the slave alphabet
+		{"Call me by the new castle at 12 tonight", "", "Call me by the new castle at 12 tonight"},
+	}
+
+	for _, tc := range tests {
+		decoy, err := cryptanalysis.NewDecoyGenerator(cmn.ALPHA_DISK).Secret(ciphered, tc.Decoy)
+		if err != nil {
+			t.Errorf("%q: %v", tc.Decoy, err)
+			continue
+		}
+		if len(tc.Secret) != 0 && decoy.Secret != tc.Secret {
+			t.Errorf("exp: %s got: %s", tc.Secret, decoy.Secret)
+		}
+		if decoy.Plain != tc.Plain {
+			t.Errorf("exp: %q got: %q", tc.Plain, decoy.Plain)
+		}
+
+		// the decoy secret works like any other
+		if plain := bellaso.NewBellasoTabulaRecta(cmn.ALPHA_DISK, decoy.Secret).Decode(ciphered); plain != tc.Plain {
+			t.Errorf("%s exp: %q got: %q", decoy.Secret, tc.Plain, plain)
+		}
+	}
+}
+
+func Test_Decoy_Errors(t *testing.T) {
+	ciphered := bellaso.NewBellasoTabulaRecta(cmn.ALPHA_DISK, "LEMON").Encode("Attack at 10")
+	generator := cryptanalysis.NewDecoyGenerator(cmn.ALPHA_DISK)
+	for _, decoy := range []string{"Attack at 1", "Attack at 100", "Attack at 10 pm"} {
+		if _, err := generator.Secret(ciphered, decoy); err != cryptanalysis.ErrDecoyLength {
+			t.Errorf("%q exp: %v got: %v", decoy, cryptanalysis.ErrDecoyLength, err)
+		}
+	}
+
+	// a letter where the ciphered text has a digit
+	if _, err := generator.Secret(ciphered, "Attack at 1X"); err == nil {
+		t.Error("letter over a digit accepted")
+	}
+
+	// the runes the cipher skips can't change: punctuation and, without a
+	// slave alphabet, the digits & space
+	ciphered = bellaso.NewBellasoTabulaRecta(cmn.ALPHA_DISK, "LEMON").Encode("Attack at 10!")
+	if _, err := generator.Secret(ciphered, "Defend at 12."); !errors.Is(err, cryptanalysis.ErrDecoyLayout) {
+		t.Errorf("punctuation exp: %v got: %v", cryptanalysis.ErrDecoyLayout, err)
+	}
+	ciphered = bellaso.NewBellasoTabulaRecta(cmn.ALPHA_DISK, "LEMON").WithChain(nil).Encode("Attack at 10")
+	if _, err := generator.WithChain(nil).Secret(ciphered, "Defend at 12"); !errors.Is(err, cryptanalysis.ErrDecoyLayout) {
+		t.Errorf("digits exp: %v got: %v", cryptanalysis.ErrDecoyLayout, err)
+	}
+
+	if _, err := cryptanalysis.DecoyPad([]byte{1, 2, 3}, []byte{1, 2}); err != cryptanalysis.ErrDecoySize {
+		t.Errorf("exp: %v got: %v", cryptanalysis.ErrDecoySize, err)
+	}
+}
+
+// a one-time pad explains a ciphered PNG image as a text file
+func Test_Decoy_Pad(t *testing.T) {
+	png, err := os.ReadFile("testdata/caesar-silver-coin.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := os.ReadFile("testdata/text_EN.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ciphered := bellaso.NewBellasoTabulaRecta(cmn.BINARY_DISK, "PASSWORD").EncodeBytes(png)
+	decoy := bytes.Repeat(text, len(ciphered)/len(text)+1)[:len(ciphered)]
+	pad, err := cryptanalysis.DecoyPad(ciphered, decoy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	book, err := crypto.NewRunningKeySequencerFromReader(bytes.NewReader(pad), "pad", crypto.BookOffset{}, cmn.BINARY_DISK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain := runningkey.NewRunningKeyTabulaRecta(cmn.BINARY_DISK, book).DecodeBytes(ciphered); !bytes.Equal(plain, decoy) {
+		t.Error("the pad doesn't decode the ciphered image into the decoy")
+	}
+}
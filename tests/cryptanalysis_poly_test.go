@@ -0,0 +1,135 @@
+package tests
+
+import (
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+/**
+ * Package: cryptanalysis (polyalphabetic)
+ * Languages: all built-in
+ * Type : Key length estimation, secret & primer recovery
+ */
+
+// the Bellaso secret is recovered from the sample text of every language
+func Test_Poly_Bellaso(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		plain := SampleTexts[alpha.LangCodeISO()]
+		secret := string([]rune(alpha.Chars)[3:8])
+		cipher, _ := commands.NewBellasoCommand(alpha, secret).WithChain(nil).Encode(plain)
+
+		cracker, err := cryptanalysis.NewPolyCracker(alpha)
+		if err != nil {
+			t.Fatal(err)
+		}
+		estimates, err := cracker.KeyLengths(cipher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// short texts may prefer a multiple of the length
+		if estimates[0].Length%5 != 0 {
+			t.Errorf("%s key length exp: 5 got: %v", alpha.Name, estimates[:3])
+		}
+
+		solution, _ := cracker.SolveBellaso(cipher)
+		if solution.Variant != z.BellasoCipher || solution.Secret != secret || solution.Plain != plain {
+			t.Errorf("%s secret exp: %s got: %s", alpha.Name, secret, solution.Secret)
+		}
+	}
+
+	// the slave runes use key positions too
+	plain := SampleTexts[cmn.ISO_EN]
+	cipher, _ := commands.NewBellasoCommand(cmn.ALPHA_DISK, "LEMON").WithChain(cmn.NUMBERS_DISK_EXT).Encode(plain)
+	cracker, _ := cryptanalysis.NewPolyCracker(cmn.ALPHA_DISK)
+	if solution, _ := cracker.WithChain(cmn.NUMBERS_DISK_EXT).SolveBellaso(cipher); solution.Secret != "LEMON" {
+		t.Errorf("slave secret exp: LEMON got: %s", solution.Secret)
+	}
+}
+
+func Test_Poly_Autokey(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		primer := string([]rune(alpha.Chars)[3:7])
+		cracker, _ := cryptanalysis.NewPolyCracker(alpha)
+		cracker.WithMaxLength(6)
+
+		// letters only, the primer is recovered
+		plain := strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) {
+				return r
+			}
+			return -1
+		}, SampleTexts[alpha.LangCodeISO()])
+		cipher, _ := commands.NewVigenereCommand(alpha, primer).WithChain(nil).Encode(plain)
+		solution, err := cracker.SolveAutokey(cipher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if solution.Variant != z.VigenereCipher || solution.Secret != primer || solution.Plain != plain {
+			t.Errorf("%s primer exp: %s got: %s", alpha.Name, primer, solution.Secret)
+		}
+
+		// with spaces the auto-key runs short, the primer is recovered too
+		spaced := strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) {
+				return -1
+			}
+			return r
+		}, SampleTexts[alpha.LangCodeISO()])
+		cipher, _ = commands.NewVigenereCommand(alpha, primer).WithChain(nil).Encode(spaced)
+		solution, err = cracker.SolveAutokey(cipher)
+		if err != nil {
+			t.Fatalf("%s spaced: %v", alpha.Name, err)
+		}
+		if solution.Secret != primer || solution.Plain != spaced {
+			t.Errorf("%s spaced primer exp: %s got: %s", alpha.Name, primer, solution.Secret)
+		}
+
+		// with spaces & a slave most of the plain text is recovered
+		plain = strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) {
+				return -1
+			}
+			return r
+		}, SampleTexts[alpha.LangCodeISO()])
+		cipher, _ = commands.NewVigenereCommand(alpha, primer).Encode(plain)
+		solution, _ = cracker.WithChain(cmn.NUMBERS_DISK_EXT).SolveAutokey(cipher)
+		if ratio := sameRunes(plain, solution.Plain); ratio < 0.9 {
+			t.Errorf("%s slave exp: 90%% of the plain text got: %.0f%%", alpha.Name, 100*ratio)
+		}
+	}
+}
+
+func Test_Poly_Errors(t *testing.T) {
+	if _, err := cryptanalysis.NewPolyCracker(cmn.NUMBERS_DISK); err != cryptanalysis.ErrNoReference {
+		t.Errorf("exp. ErrNoReference got: %v", err)
+	}
+
+	cracker, _ := cryptanalysis.NewPolyCracker(cmn.ALPHA_DISK)
+	if _, err := cracker.KeyLengths("A"); err != cryptanalysis.ErrNoKeyLength {
+		t.Errorf("exp. ErrNoKeyLength got: %v", err)
+	}
+
+	// THE repeats at a distance of 12, the punctuation is skipped
+	votes := cracker.Kasiski("THEabc, defghiTHE")
+	if votes[2] != 1 || votes[3] != 1 || votes[4] != 1 || votes[6] != 1 || votes[12] != 1 || votes[5] != 0 {
+		t.Errorf("Kasiski votes %v", votes)
+	}
+}
+
+// the ratio of runes at the same position in both texts
+func sameRunes(exp, got string) float64 {
+	expRunes, gotRunes := []rune(exp), []rune(got)
+	same := 0
+	for i := range min(len(expRunes), len(gotRunes)) {
+		if expRunes[i] == gotRunes[i] {
+			same++
+		}
+	}
+
+	return float64(same) / float64(len(expRunes))
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (file signature recovery)
+ * Languages: binary
+ * Type : Key recovery of binary files from their magic bytes
+ */
+
+// the key of every variant is recovered from a real PNG image
+func Test_Signature_Variants(t *testing.T) {
+	png, err := os.ReadFile("testdata/caesar-silver-coin.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for variant, cipher := range map[z.CipherVariant]ciphers.ICipher{
+		z.CaesarCipher:    caesar.NewCaesarTabulaRecta(cmn.BINARY_DISK, 'K'),
+		z.DidimusCipher:   caesar.NewDidimusTabulaRecta(cmn.BINARY_DISK, 'K', 5),
+		z.FibonacciCipher: caesar.NewFibonacciTabulaRecta(cmn.BINARY_DISK, 'K'),
+		z.BellasoCipher:   bellaso.NewBellasoTabulaRecta(cmn.BINARY_DISK, "PASSWORD"),
+	} {
+		head := cipher.EncodeBytes(png[:cryptanalysis.SIGNATURE_PEEK])
+		solutions, err := cryptanalysis.NewSignatureRecovery().Recover(variant, head)
+		if err != nil {
+			t.Errorf("%s: %v", variant, err)
+			continue
+		}
+
+		solution := solutions[0]
+		if solution.Signature.Extension != "png" {
+			t.Errorf("%s exp: PNG got: %s", variant, solution.Signature.Name)
+		}
+		switch variant {
+		case z.DidimusCipher:
+			if solution.Key != 'K' || solution.AltOffset != 5 {
+				t.Errorf("Didimus exp: K/5 got: %c/%d", solution.Key, solution.AltOffset)
+			}
+		case z.BellasoCipher:
+			if solution.Secret != "PASSWORD" {
+				t.Errorf("Bellaso exp: PASSWORD got: %s", solution.Secret)
+			}
+		default:
+			if solution.Key != 'K' {
+				t.Errorf("%s exp: K got: %c", variant, solution.Key)
+			}
+		}
+	}
+}
+
+// every built-in signature reveals a key beyond the printable ASCII
+func Test_Signature_Formats(t *testing.T) {
+	random := rand.New(rand.NewSource(2025))
+	for _, signature := range cryptanalysis.FileSignatures() {
+		plain := make([]byte, cryptanalysis.SIGNATURE_PEEK)
+		random.Read(plain)
+		known := 0
+		for _, part := range signature.Parts {
+			copy(plain[part.Offset:], part.Bytes)
+			known += len(part.Bytes)
+		}
+
+		for variant, cipher := range map[z.CipherVariant]ciphers.ICipher{
+			z.CaesarCipher:  caesar.NewCaesarTabulaRecta(cmn.BINARY_DISK, 'é'),
+			z.BellasoCipher: bellaso.NewBellasoTabulaRecta(cmn.BINARY_DISK, "\x07Z"),
+		} {
+			// the secret must repeat within the signature
+			if variant == z.BellasoCipher && known-2 < cryptanalysis.CRIB_EVIDENCE {
+				continue
+			}
+			solutions, err := cryptanalysis.NewSignatureRecovery().Recover(variant, cipher.EncodeBytes(plain))
+			if err != nil {
+				t.Errorf("%s %s: %v", signature.Name, variant, err)
+				continue
+			}
+
+			found := false
+			for _, solution := range solutions {
+				found = found || solution.Signature == signature && (solution.Key == 'é' || solution.Secret == "\x07Z")
+			}
+			if !found {
+				t.Errorf("%s %s: %v", signature.Name, variant, solutions)
+			}
+		}
+	}
+}
+
+func Test_Signature_Errors(t *testing.T) {
+	recovery := cryptanalysis.NewSignatureRecovery()
+	if _, err := recovery.Recover(z.VigenereCipher, []byte("\x89PNG\r\n\x1a\n")); err != cryptanalysis.ErrSignatureVariant {
+		t.Errorf("exp: %v got: %v", cryptanalysis.ErrSignatureVariant, err)
+	}
+
+	// a text file has no signature, nor a file shorter than all of them
+	for _, head := range [][]byte{[]byte("The secret meeting is at noon near the old bridge"), {0x89, 0x50}} {
+		if _, err := recovery.Recover(z.CaesarCipher, head); err != cryptanalysis.ErrSignatureNotFound {
+			t.Errorf("exp: %v got: %v", cryptanalysis.ErrSignatureNotFound, err)
+		}
+	}
+
+	// the signatures of other formats
+	wav := &cryptanalysis.FileSignature{Name: "WAVE audio", Extension: "wav", Parts: []cryptanalysis.SignaturePart{{Offset: 0, Bytes: []byte("RIFF")}, {Offset: 8, Bytes: []byte("WAVE")}}}
+	head := caesar.NewCaesarTabulaRecta(cmn.BINARY_DISK, 'W').EncodeBytes([]byte("RIFF\x24\x08\x00\x00WAVEfmt "))
+	if solutions, err := recovery.WithSignatures(wav).Recover(z.CaesarCipher, head); err != nil || solutions[0].Signature != wav {
+		t.Errorf("WAVE not recovered: %v %v", solutions, err)
+	}
+}
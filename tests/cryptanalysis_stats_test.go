@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"math"
+	"strings"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (frequency analysis)
+ * Languages: all built-in
+ * Type : Reference tables, counting, IC & Chi-Squared
+ */
+
+func Test_Stats_References(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		ref := cryptanalysis.StatsFor(alpha)
+		if ref == nil {
+			t.Errorf("%s has no reference frequencies", alpha.Name)
+			continue
+		}
+
+		var sum float64 = 0
+		for pos := range int(alpha.Size()) {
+			sum += ref.ExpectedAt(pos)
+		}
+		if math.Abs(sum-1.0) > 1e-9 {
+			t.Errorf("%s unigrams add up to %f", alpha.Name, sum)
+		}
+		if ref.ExpectedIC() <= ref.RandomIC() {
+			t.Errorf("%s expected IC %.4f not above random %.4f", alpha.Name, ref.ExpectedIC(), ref.RandomIC())
+		}
+		if ref.Expected(alpha.GetRuneAt(0)) != ref.ExpectedAt(0) {
+			t.Errorf("%s Expected() by rune differs from by position", alpha.Name)
+		}
+		for _, bigram := range ref.Bigrams() {
+			for _, r := range bigram.NGram {
+				if alpha.PositionOf(r) == -1 {
+					t.Errorf("%s reference bigram %s not in the alphabet", alpha.Name, bigram.NGram)
+				}
+			}
+		}
+
+		// clones lose the language code, but not the letters
+		if cryptanalysis.StatsFor(alpha.Clone()) != ref {
+			t.Errorf("%s clone has no reference frequencies", alpha.Name)
+		}
+	}
+
+	if cryptanalysis.StatsByISO(cmn.ISO_UA) != cryptanalysis.StatsByISO(cmn.ISO_RU) {
+		t.Error("Ukrainian uses the Cyrillic reference")
+	}
+	if cryptanalysis.StatsFor(cmn.BINARY_DISK) != nil || cryptanalysis.StatsFor(cmn.NUMBERS_DISK) != nil {
+		t.Error("binary & numbers have no language")
+	}
+	if _, err := cryptanalysis.ChiSquared("12345", cmn.NUMBERS_DISK); err != cryptanalysis.ErrNoReference {
+		t.Errorf("exp. ErrNoReference got: %v", err)
+	}
+}
+
+func Test_Stats_Counting(t *testing.T) {
+	table := cryptanalysis.CountFrequencies("Hello, World!", cmn.ALPHA_DISK)
+	if table.Total() != 10 {
+		t.Errorf("Total exp: 10 got: %d", table.Total())
+	}
+	if table.Count('l') != 3 || table.Count('L') != 3 || table.Count('!') != 0 {
+		t.Errorf("Count(L) exp: 3 got: %d", table.Count('L'))
+	}
+	if table.Frequency('o') != 0.2 {
+		t.Errorf("Frequency(O) exp: 0.2 got: %f", table.Frequency('o'))
+	}
+	// the space breaks the bigram
+	for bigram, exp := range map[string]int{"LL": 1, "lo": 1, "OW": 0, "WO": 1, "LD": 1} {
+		if got := table.BigramCount(bigram); got != exp {
+			t.Errorf("BigramCount(%s) exp: %d got: %d", bigram, exp, got)
+		}
+	}
+	if top := table.TopBigrams(1); len(top) != 1 || top[0].Value != 1.0/8.0 {
+		t.Errorf("TopBigrams %v", top)
+	}
+
+	// accumulates and honors the German special case
+	table = cryptanalysis.NewFrequencyTable(cmn.ALPHA_DISK_GERMAN).Add("Daß").Add("STRAẞE")
+	if table.Count('ß') != 2 || table.Total() != 9 {
+		t.Errorf("German ẞ exp: 2 of 9 got: %d of %d", table.Count('ß'), table.Total())
+	}
+
+	// 2*(2-1) + 2*(2-1) / 4*3
+	if ic := cryptanalysis.IndexOfCoincidence("a a b b", cmn.ALPHA_DISK); ic != 1.0/3.0 {
+		t.Errorf("IC exp: 0.3333 got: %f", ic)
+	}
+	if ic := cryptanalysis.IndexOfCoincidence("a", cmn.ALPHA_DISK); ic != 0 {
+		t.Errorf("IC of a single letter exp: 0 got: %f", ic)
+	}
+
+	histogram := table.Histogram(cryptanalysis.StatsFor(cmn.ALPHA_DISK_GERMAN), 20)
+	if lines := strings.Split(strings.TrimRight(histogram, "\n"), "\n"); len(lines) != 30 {
+		t.Errorf("German histogram exp: 30 lines got: %d", len(lines))
+	}
+}
+
+// A plain text is closer to its language than the same text ciphered
+func Test_Stats_Languages(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		plain := SampleTexts[alpha.LangCodeISO()]
+		ref := cryptanalysis.StatsFor(alpha)
+		table := cryptanalysis.CountFrequencies(plain, alpha)
+
+		if ic := table.IndexOfCoincidence(); ic < 1.3*ref.RandomIC() {
+			t.Errorf("%s plain IC %.4f too close to random %.4f", alpha.Name, ic, ref.RandomIC())
+		}
+
+		cipher, _ := commands.NewCaesarCommand(alpha, alpha.GetRuneAt(3)).Encode(plain)
+		chiPlain := table.ChiSquared(ref)
+		chiCipher, _ := cryptanalysis.ChiSquared(cipher, alpha)
+		if chiPlain >= chiCipher {
+			t.Errorf("%s Chi² plain %.2f not below ciphered %.2f", alpha.Name, chiPlain, chiCipher)
+		}
+
+		// a long secret flattens the distribution
+		bellaso, _ := commands.NewBellasoCommand(alpha, string([]rune(alpha.Chars)[1:12])).Encode(plain)
+		if ic := cryptanalysis.IndexOfCoincidence(bellaso, alpha); ic >= table.IndexOfCoincidence() {
+			t.Errorf("%s Bellaso IC %.4f not below plain %.4f", alpha.Name, ic, table.IndexOfCoincidence())
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	z "lordofscripts/caesarx"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (key strength)
+ * Languages: English, Greek, binary
+ * Type : Keyspace, cracking effort & weak key warnings
+ */
+
+func Test_Strength_Keyspace(t *testing.T) {
+	tests := []struct {
+		Config   cryptanalysis.KeyConfig
+		Period   int
+		Keyspace *big.Int
+	}{
+		{cryptanalysis.KeyConfig{Variant: z.CaesarCipher, Alphabet: cmn.ALPHA_DISK, Key: 'K'}, 1, big.NewInt(26)},
+		{cryptanalysis.KeyConfig{Variant: z.CaesarCipher, Alphabet: cmn.ALPHA_DISK_GREEK, Key: 'Κ'}, 1, big.NewInt(24)},
+		{cryptanalysis.KeyConfig{Variant: z.DidimusCipher, Alphabet: cmn.ALPHA_DISK, Key: 'K', Offset: 5}, 1, big.NewInt(625)},
+		{cryptanalysis.KeyConfig{Variant: z.FibonacciCipher, Alphabet: cmn.ALPHA_DISK, Key: 'K'}, 1, big.NewInt(26)},
+		// the repeats of a Bellaso secret add nothing, those of the primer do
+		{cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: "lemonLEMON"}, 5, big.NewInt(11881376)},
+		{cryptanalysis.KeyConfig{Variant: z.VigenereCipher, Alphabet: cmn.ALPHA_DISK, Secret: "LEMONLEMON"}, 10, new(big.Int).Exp(big.NewInt(26), big.NewInt(10), nil)},
+		{cryptanalysis.KeyConfig{Variant: z.AffineCipher, Alphabet: cmn.ALPHA_DISK, A: 7, B: 3}, 1, big.NewInt(12 * 26)},
+	}
+
+	for _, tc := range tests {
+		report, err := cryptanalysis.NewStrengthAnalyzer().Analyze(&tc.Config, 0)
+		if err != nil {
+			t.Errorf("%s: %v", tc.Config.Variant, err)
+			continue
+		}
+		if report.Period != tc.Period || report.Keyspace.Cmp(tc.Keyspace) != 0 {
+			t.Errorf("%s exp: %d/%s got: %d/%s", tc.Config.Variant, tc.Period, tc.Keyspace, report.Period, report.Keyspace)
+		}
+		if len(report.Warnings) != 0 {
+			t.Errorf("%s unexpected warnings %v", tc.Config.Variant, report.Warnings)
+		}
+	}
+
+	// a secret longer than the crackers try
+	config := &cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: strings.Repeat("XYZ", 7) + "Q"}
+	if report, _ := cryptanalysis.NewStrengthAnalyzer().Analyze(config, 0); !math.IsInf(report.Effort, 1) {
+		t.Errorf("exp: out of reach got: %v", report)
+	}
+}
+
+func Test_Strength_Warnings(t *testing.T) {
+	tests := []struct {
+		Config  cryptanalysis.KeyConfig
+		Letters int
+		Warning uint16
+	}{
+		{cryptanalysis.KeyConfig{Variant: z.CaesarCipher, Alphabet: cmn.ALPHA_DISK, Key: 'A'}, 0, cryptanalysis.WARN_IDENTITY_KEY},
+		{cryptanalysis.KeyConfig{Variant: z.DidimusCipher, Alphabet: cmn.ALPHA_DISK, Key: 'A', Offset: 3}, 0, cryptanalysis.WARN_IDENTITY_KEY},
+		{cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: "AAA"}, 0, cryptanalysis.WARN_IDENTITY_KEY},
+		{cryptanalysis.KeyConfig{Variant: z.AffineCipher, Alphabet: cmn.ALPHA_DISK, A: 1, B: 26}, 0, cryptanalysis.WARN_IDENTITY_KEY},
+		{cryptanalysis.KeyConfig{Variant: z.AffineCipher, Alphabet: cmn.ALPHA_DISK, A: 1, B: 3}, 0, cryptanalysis.WARN_AFFINE_CAESAR},
+		{cryptanalysis.KeyConfig{Variant: z.VigenereCipher, Alphabet: cmn.ALPHA_DISK, Secret: "Secret"}, 0, cryptanalysis.WARN_DICTIONARY_WORD},
+		{cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: "KEY"}, 100, cryptanalysis.WARN_UNICITY},
+		{cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.BINARY_DISK, Secret: "KEY"}, 0, cryptanalysis.WARN_FILE_SIGNATURE},
+	}
+
+	for _, tc := range tests {
+		report, err := cryptanalysis.NewStrengthAnalyzer().Analyze(&tc.Config, tc.Letters)
+		if err != nil {
+			t.Errorf("%s: %v", tc.Config.Variant, err)
+			continue
+		}
+		if !report.HasWarning(tc.Warning) || report.Warnings[0].Code.Package != z.CryptanalysisPCode {
+			t.Errorf("%s exp: warning %d got: %v", tc.Config.Variant, tc.Warning, report.Warnings)
+		}
+	}
+
+	// a dictionary word is tried first, a short message is below the unicity distance
+	vigenere := &cryptanalysis.KeyConfig{Variant: z.VigenereCipher, Alphabet: cmn.ALPHA_DISK, Secret: "XQZVK"}
+	if report, _ := cryptanalysis.NewStrengthAnalyzer().WithDictionary("xqzvk").Analyze(vigenere, 0); !report.HasWarning(cryptanalysis.WARN_DICTIONARY_WORD) {
+		t.Errorf("custom dictionary ignored: %v", report.Warnings)
+	}
+	bellaso := &cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: "KEY"}
+	if report, _ := cryptanalysis.NewStrengthAnalyzer().Analyze(bellaso, 4); len(report.Warnings) != 0 {
+		t.Errorf("unexpected warnings %v", report.Warnings)
+	}
+}
+
+func Test_Strength_Errors(t *testing.T) {
+	analyzer := cryptanalysis.NewStrengthAnalyzer()
+	tests := []struct {
+		Config cryptanalysis.KeyConfig
+		Err    error
+	}{
+		{cryptanalysis.KeyConfig{Variant: z.HillCipher, Alphabet: cmn.ALPHA_DISK, Secret: "HILL"}, cryptanalysis.ErrStrengthVariant},
+		{cryptanalysis.KeyConfig{Variant: z.CaesarCipher, Alphabet: cmn.ALPHA_DISK, Key: '5'}, cryptanalysis.ErrStrengthKey},
+		{cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: " "}, cryptanalysis.ErrStrengthSecret},
+		{cryptanalysis.KeyConfig{Variant: z.AffineCipher, Alphabet: cmn.ALPHA_DISK, A: 13, B: 3}, cryptanalysis.ErrStrengthAffine},
+	}
+
+	for _, tc := range tests {
+		if _, err := analyzer.Analyze(&tc.Config, 0); err != tc.Err {
+			t.Errorf("%s exp: %v got: %v", tc.Config.Variant, tc.Err, err)
+		}
+	}
+
+	// the secret letters must be in the alphabet
+	config := &cryptanalysis.KeyConfig{Variant: z.BellasoCipher, Alphabet: cmn.ALPHA_DISK, Secret: "K3Y"}
+	if _, err := analyzer.Analyze(config, 0); err == nil {
+		t.Error("secret K3Y accepted")
+	}
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/cryptanalysis"
+	"strings"
+	"testing"
+)
+
+/**
+ * Package: cryptanalysis (substitution solver)
+ * Languages: all the built-in ones
+ * Type : Hill-climbing recovery of keyword-mixed alphabets
+ */
+
+// long texts (public domain or, in Greek, the Universal Declaration of Human
+// Rights without accents), a substitution needs several hundred letters
+var substitutionTexts = map[string]string{
+	cmn.ISO_EN: "Four score and seven years ago our fathers brought forth on this continent, a new nation, conceived in Liberty, " +
+		"and dedicated to the proposition that all men are created equal. Now we are engaged in a great civil war, testing " +
+		"whether that nation, or any nation so conceived and so dedicated, can long endure. We are met on a great battle-field " +
+		"of that war. We have come to dedicate a portion of that field, as a final resting place for those who here gave their " +
+		"lives that that nation might live. It is altogether fitting and proper that we should do this. But, in a larger sense, " +
+		"we can not dedicate, we can not consecrate, we can not hallow this ground. The brave men, living and dead, who struggled " +
+		"here, have consecrated it, far above our poor power to add or detract. The world will little note, nor long remember " +
+		"what we say here, but it can never forget what they did here.",
+	cmn.ISO_ES: "En un lugar de la Mancha, de cuyo nombre no quiero acordarme, no ha mucho tiempo que vivía un hidalgo de los " +
+		"de lanza en astillero, adarga antigua, rocín flaco y galgo corredor. Una olla de algo más vaca que carnero, salpicón " +
+		"las más noches, duelos y quebrantos los sábados, lentejas los viernes, algún palomino de añadidura los domingos, " +
+		"consumían las tres partes de su hacienda. El resto della concluían sayo de velarte, calzas de velludo para las fiestas " +
+		"con sus pantuflos de lo mismo, y los días de entre semana se honraba con su vellorí de lo más fino. Tenía en su casa " +
+		"una ama que pasaba de los cuarenta, y una sobrina que no llegaba a los veinte, y un mozo de campo y plaza, que así " +
+		"ensillaba el rocín como tomaba la podadera. Frisaba la edad de nuestro hidalgo con los cincuenta años; era de " +
+		"complexión recia, seco de carnes, enjuto de rostro, gran madrugador y amigo de la caza. Quieren decir que tenía el " +
+		"sobrenombre de Quijada, o Quesada, que en esto hay alguna diferencia en los autores que deste caso escriben; aunque " +
+		"por conjeturas verosímiles se deja entender que se llamaba Quejana. Pero esto importa poco a nuestro cuento; basta " +
+		"que en la narración dél no se salga un punto de la verdad.",
+	cmn.ISO_IT: "Quel ramo del lago di Como, che volge a mezzogiorno, tra due catene non interrotte di monti, tutto a seni e a " +
+		"golfi, a seconda dello sporgere e del rientrare di quelli, vien, quasi a un tratto, a ristringersi, e a prender corso " +
+		"e figura di fiume, tra un promontorio a destra, e un'ampia costiera dall'altra parte; e il ponte, che ivi congiunge " +
+		"le due rive, par che renda ancor più sensibile all'occhio questa trasformazione, e segni il punto in cui il lago " +
+		"cessa, e l'Adda rincomincia, per ripigliar poi nome di lago dove le rive, allontanandosi di nuovo, lascian l'acqua " +
+		"distendersi e rallentarsi in nuovi golfi e in nuovi seni. La costiera, formata dal deposito di tre grossi torrenti, " +
+		"scende appoggiata a due monti contigui, l'uno detto di san Martino, l'altro, con voce lombarda, il Resegone, dai " +
+		"molti suoi cocuzzoli in fila, che in vero lo fanno somigliare a una sega: talché non è chi, al primo vederlo, purché " +
+		"sia di fronte, come per esempio di su le mura di Milano che guardano a settentrione, non lo discerna tosto, a un tal " +
+		"contrassegno, in quella lunga e vasta giogaia, dagli altri monti di nome più oscuro e di forma più comune.",
+	cmn.ISO_PT: "Uma noite destas, vindo da cidade para o Engenho Novo, encontrei no trem da Central um rapaz aqui do bairro, " +
+		"que eu conheço de vista e de chapéu. Cumprimentou-me, sentou-se ao pé de mim, falou da lua e dos ministros, e acabou " +
+		"recitando-me versos. A viagem era curta, e os versos pode ser que não fossem inteiramente maus. Sucedeu, porém, que, " +
+		"como eu estava cansado, fechei os olhos três ou quatro vezes; tanto bastou para que ele interrompesse a leitura e " +
+		"metesse os versos no bolso. Continue, disse eu acordando. Já acabei, murmurou ele. São muito bonitos. Vi-lhe fazer " +
+		"um gesto para tirá-los outra vez do bolso, mas não passou do gesto; estava amuado. No dia seguinte entrou a dizer de " +
+		"mim nomes feios, e acabou alcunhando-me Dom Casmurro. Os vizinhos, que não gostam dos meus hábitos reclusos e " +
+		"calados, deram curso à alcunha, que afinal pegou. Nem por isso me zanguei. Contei a anedota aos amigos da cidade, e " +
+		"eles, por graça, chamam-me assim, alguns em bilhetes: Dom Casmurro, domingo vou jantar com você.",
+	cmn.ISO_DE: "Als Gregor Samsa eines Morgens aus unruhigen Träumen erwachte, fand er sich in seinem Bett zu einem " +
+		"ungeheueren Ungeziefer verwandelt. Er lag auf seinem panzerartig harten Rücken und sah, wenn er den Kopf ein wenig " +
+		"hob, seinen gewölbten, braunen, von bogenförmigen Versteifungen geteilten Bauch, auf dessen Höhe sich die Bettdecke, " +
+		"zum gänzlichen Niedergleiten bereit, kaum noch erhalten konnte. Seine vielen, im Vergleich zu seinem sonstigen Umfang " +
+		"kläglich dünnen Beine flimmerten ihm hilflos vor den Augen. Was ist mit mir geschehen? dachte er. Es war kein Traum. " +
+		"Sein Zimmer, ein richtiges, nur etwas zu kleines Menschenzimmer, lag ruhig zwischen den vier wohlbekannten Wänden. " +
+		"Über dem Tisch, auf dem eine auseinandergepackte Musterkollektion von Tuchwaren ausgebreitet war, Samsa war " +
+		"Reisender, hing das Bild, das er vor kurzem aus einer illustrierten Zeitschrift ausgeschnitten und in einem hübschen, " +
+		"vergoldeten Rahmen untergebracht hatte. Es stellte eine Dame dar, die, mit einem Pelzhut und einer Pelzboa versehen, " +
+		"aufrecht dasaß und einen schweren Pelzmuff, in dem ihr ganzer Unterarm verschwunden war, dem Beschauer entgegenhob.",
+	cmn.ISO_GR: "ΕΠΕΙΔΗ Η ΑΝΑΓΝΩΡΙΣΗ ΤΗΣ ΑΞΙΟΠΡΕΠΕΙΑΣ, ΠΟΥ ΕΙΝΑΙ ΣΥΜΦΥΤΗ ΣΕ ΟΛΑ ΤΑ ΜΕΛΗ ΤΗΣ ΑΝΘΡΩΠΙΝΗΣ ΟΙΚΟΓΕΝΕΙΑΣ, ΚΑΘΩΣ " +
+		"ΚΑΙ ΤΩΝ ΙΣΩΝ ΚΑΙ ΑΝΑΠΑΛΛΟΤΡΙΩΤΩΝ ΔΙΚΑΙΩΜΑΤΩΝ ΤΟΥΣ ΑΠΟΤΕΛΕΙ ΤΟ ΘΕΜΕΛΙΟ ΤΗΣ ΕΛΕΥΘΕΡΙΑΣ, ΤΗΣ ΔΙΚΑΙΟΣΥΝΗΣ ΚΑΙ " +
+		"ΤΗΣ ΕΙΡΗΝΗΣ ΣΤΟΝ ΚΟΣΜΟ. ΕΠΕΙΔΗ Η ΠΑΡΑΓΝΩΡΙΣΗ ΚΑΙ Η ΠΕΡΙΦΡΟΝΗΣΗ ΤΩΝ ΔΙΚΑΙΩΜΑΤΩΝ ΤΟΥ ΑΝΘΡΩΠΟΥ ΟΔΗΓΗΣΑΝ ΣΕ " +
+		"ΠΡΑΞΕΙΣ ΒΑΡΒΑΡΟΤΗΤΑΣ, ΠΟΥ ΕΞΕΓΕΙΡΟΥΝ ΤΗΝ ΑΝΘΡΩΠΙΝΗ ΣΥΝΕΙΔΗΣΗ, ΚΑΙ Η ΠΡΟΟΠΤΙΚΗ ΕΝΟΣ ΚΟΣΜΟΥ ΟΠΟΥ ΟΙ " +
+		"ΑΝΘΡΩΠΟΙ ΘΑ ΕΙΝΑΙ ΕΛΕΥΘΕΡΟΙ ΝΑ ΜΙΛΟΥΝ ΚΑΙ ΝΑ ΠΙΣΤΕΥΟΥΝ, ΛΥΤΡΩΜΕΝΟΙ ΑΠΟ ΤΟΝ ΤΡΟΜΟ ΚΑΙ ΤΗΝ ΑΘΛΙΟΤΗΤΑ, ΕΧΕΙ " +
+		"ΔΙΑΚΗΡΥΧΘΕΙ ΩΣ Η ΠΙΟ ΥΨΗΛΗ ΕΠΙΔΙΩΞΗ ΤΟΥ ΑΝΘΡΩΠΟΥ. ΕΠΕΙΔΗ ΕΧΕΙ ΟΥΣΙΑΣΤΙΚΗ ΣΗΜΑΣΙΑ ΝΑ ΠΡΟΣΤΑΤΕΥΟΝΤΑΙ ΤΑ " +
+		"ΑΝΘΡΩΠΙΝΑ ΔΙΚΑΙΩΜΑΤΑ ΑΠΟ ΕΝΑ ΚΑΘΕΣΤΩΣ ΔΙΚΑΙΟΥ, ΩΣΤΕ Ο ΑΝΘΡΩΠΟΣ ΝΑ ΜΗΝ ΑΝΑΓΚΑΖΕΤΑΙ ΝΑ ΠΡΟΣΦΕΥΓΕΙ, ΩΣ " +
+		"ΕΣΧΑΤΟ ΚΑΤΑΦΥΓΙΟ, ΣΤΗΝ ΕΞΕΓΕΡΣΗ ΚΑΤΑ ΤΗΣ ΤΥΡΑΝΝΙΑΣ ΚΑΙ ΤΗΣ ΚΑΤΑΠΙΕΣΗΣ. ΟΛΟΙ ΟΙ ΑΝΘΡΩΠΟΙ ΓΕΝΝΙΟΥΝΤΑΙ " +
+		"ΕΛΕΥΘΕΡΟΙ ΚΑΙ ΙΣΟΙ ΣΤΗΝ ΑΞΙΟΠΡΕΠΕΙΑ ΚΑΙ ΤΑ ΔΙΚΑΙΩΜΑΤΑ. ΕΙΝΑΙ ΠΡΟΙΚΙΣΜΕΝΟΙ ΜΕ ΛΟΓΙΚΗ ΚΑΙ ΣΥΝΕΙΔΗΣΗ, ΚΑΙ " +
+		"ΟΦΕΙΛΟΥΝ ΝΑ ΣΥΜΠΕΡΙΦΕΡΟΝΤΑΙ ΜΕΤΑΞΥ ΤΟΥΣ ΜΕ ΠΝΕΥΜΑ ΑΔΕΛΦΟΣΥΝΗΣ.",
+	cmn.ISO_RU: "Все счастливые семьи похожи друг на друга, каждая несчастливая семья несчастлива по-своему. Все смешалось " +
+		"в доме Облонских. Жена узнала, что муж был в связи с бывшею в их доме француженкою-гувернанткой, и объявила мужу, " +
+		"что не может жить с ним в одном доме. Положение это продолжалось уже третий день и мучительно чувствовалось и " +
+		"самими супругами, и всеми членами семьи, и домочадцами. Все члены семьи и домочадцы чувствовали, что нет смысла " +
+		"в их сожительстве и что на каждом постоялом дворе случайно сошедшиеся люди более связаны между собой, чем они, " +
+		"члены семьи и домочадцы Облонских. Жена не выходила из своих комнат, мужа третий день не было дома. Дети бегали " +
+		"по всему дому, как потерянные; англичанка поссорилась с экономкой и написала записку приятельнице, прося " +
+		"приискать ей новое место; повар ушел еще вчера со двора, во время обеда.",
+	cmn.ISO_CZ: "Dávno, dávno již tomu, co jsem naposledy pohlížela v tu milou tvář, co jsem líbala ty bledé, vrásčité líce, " +
+		"nahlížela do modrých očí, v nichž se tolik dobroty a lásky jevilo; dávno již tomu, co mi žehnaly naposled staré " +
+		"její ruce! Není více naší staré matičky! Již dávno odpočívá v chladné zemi! Mně ale neumřela! Obraz její v duši mi " +
+		"vrytý s úplnou barvou a svěžestí, a pokud živa budu, živa bude i ona v něm! Kdybych štětcem vládnouti uměla, jinak " +
+		"bych tě oslavila, milá babičko! Ale tento nástin, nevím, nevím, jak se komu zalíbí. Ty jsi ovšem říkávala: Není na " +
+		"světě člověka, aby se zachoval lidem všem. Dosti na tom, když se najde několik čtenářů, kteří o tobě s takovou " +
+		"láskou čtou, s jakou já o tobě píšu. Babička měla syna a dvě dcery. Starší dcera žila mnoho let ve Vídni u " +
+		"příbuzných, mladší byla v službě v městě. Syn byl panským myslivcem a bydlel s rodinou v malém údolí pod lesem, " +
+		"kde stálo stavení se zahradou. Babička bydlela v pohorské vesnici na hranicích Slezska a žila spokojeně v malé " +
+		"chaloupce. Každý rok přicházely od syna prosby, aby k nim přišla bydlet, že jí nebude u nich ničeho scházet, a " +
+		"že ji děti budou mít rády.",
+}
+
+func Test_Substitution_Solve(t *testing.T) {
+	for _, alpha := range []*cmn.Alphabet{cmn.ALPHA_DISK, cmn.ALPHA_DISK_LATIN, cmn.ALPHA_DISK_ITALIAN, cmn.ALPHA_DISK_PORTUGUESE,
+		cmn.ALPHA_DISK_GERMAN, cmn.ALPHA_DISK_GREEK, cmn.ALPHA_DISK_CYRILLIC, cmn.ALPHA_DISK_CZECH} {
+		plain := substitutionTexts[alpha.LangCodeISO()]
+		runes := []rune(alpha.Chars)
+		keyed := alpha.Keyed(string([]rune{runes[5], runes[17], runes[2], runes[11], runes[20], runes[8]}))
+		caser := alpha.BorrowSpecialCase()
+		if caser == nil {
+			caser = cmn.DefaultCaseHandler
+		}
+		cipher := cryptanalysis.DecodeSubstitution(cmn.NewSimpleRuneTranslator("keyed", alpha.Chars, keyed.Chars, caser), plain)
+
+		solver, err := cryptanalysis.NewSubstitutionSolver(alpha)
+		if err != nil {
+			t.Fatal(err)
+		}
+		solution, err := solver.Solve(cipher)
+		if err != nil {
+			t.Errorf("%s: %v", alpha.Name, err)
+			continue
+		}
+
+		least := 0.95
+		if alpha == cmn.ALPHA_DISK {
+			least = 0.98
+		}
+		if ratio := solvedRatio(alpha, plain, solution.Plain); ratio < least {
+			t.Errorf("%s solved %.2f of the letters: %s", alpha.Name, ratio, solution.Plain)
+		}
+		if reused := cryptanalysis.DecodeSubstitution(solution.Key, cipher); reused != solution.Plain {
+			t.Errorf("%s key doesn't decode the traffic", alpha.Name)
+		}
+	}
+}
+
+// the recovered key decodes the rest of the traffic (same key, other message)
+func Test_Substitution_Reuse(t *testing.T) {
+	keyed := cmn.ALPHA_DISK.Keyed("ZEBRAS")
+	encoder := cmn.NewSimpleRuneTranslator("keyed", cmn.ALPHA_DISK.Chars, keyed.Chars, cmn.DefaultCaseHandler)
+
+	solver, _ := cryptanalysis.NewSubstitutionSolver(cmn.ALPHA_DISK)
+	solution, err := solver.WithRestarts(5).Solve(cryptanalysis.DecodeSubstitution(encoder, substitutionTexts[cmn.ISO_EN]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plain := SampleTexts[cmn.ISO_EN]
+	decoded := cryptanalysis.DecodeSubstitution(solution.Key, cryptanalysis.DecodeSubstitution(encoder, plain))
+	if ratio := solvedRatio(cmn.ALPHA_DISK, plain, decoded); ratio < 0.98 {
+		t.Errorf("solved %.2f of the letters: %s", ratio, decoded)
+	}
+}
+
+func Test_Substitution_Errors(t *testing.T) {
+	if _, err := cryptanalysis.NewSubstitutionSolver(cmn.NUMBERS_DISK); err != cryptanalysis.ErrNoReference {
+		t.Errorf("exp: %v got: %v", cryptanalysis.ErrNoReference, err)
+	}
+
+	solver, _ := cryptanalysis.NewSubstitutionSolver(cmn.ALPHA_DISK)
+	if _, err := solver.Solve(strings.Repeat("Abc, ", 10)); err != cryptanalysis.ErrSubstitutionShort {
+		t.Errorf("exp: %v got: %v", cryptanalysis.ErrSubstitutionShort, err)
+	}
+}
+
+// the ratio of the letters of the plain text that were recovered
+func solvedRatio(alpha *cmn.Alphabet, plain, solved string) float64 {
+	expected, got := []rune(plain), []rune(solved)
+	letters, right := 0, 0
+	for i, r := range expected {
+		if alpha.Contains(r, cmn.CaseInsensitive) {
+			letters++
+			if i < len(got) && got[i] == r {
+				right++
+			}
+		}
+	}
+
+	return float64(right) / float64(letters)
+}
@@ -0,0 +1,235 @@
+package tests
+
+import (
+	"errors"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/ciphers/enigma"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/sched"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ * Cipher: Enigma I with the historical rotors & reflectors.
+ * Languages: English (ASCII).
+ * Type : Known vectors, ring settings, double stepping & reciprocity.
+ */
+func Test_Enigma_Vectors(t *testing.T) {
+	allCases := []struct {
+		Spec   string
+		Plain  string
+		Cipher string
+	}{
+		{"B I-II-III 01-01-01 AAA", "AAAAA", "BDZGO"},
+		{"B I-II-III 02-02-02 AAA", "AAAAA", "EWTYX"},
+		{"B I-II-III", "aaaaa", "bdzgo"},
+		{"B I-II-III 01-01-01 AAA", "Aa, aa!", "Bd, zg!"},
+	}
+
+	for i, tc := range allCases {
+		settings, err := enigma.ParseSettings(tc.Spec)
+		if err != nil {
+			t.Fatalf("#%d '%s' unexpected error: %v", i+1, tc.Spec, err)
+		}
+		eng, err := enigma.NewEnigmaCrypto(cmn.ALPHA_DISK, settings)
+		if err != nil {
+			t.Fatalf("#%d '%s' unexpected error: %v", i+1, tc.Spec, err)
+		}
+
+		if encoded, _ := eng.Encode(tc.Plain); encoded != tc.Cipher {
+			t.Errorf("#%d '%s' exp: %s got: %s", i+1, tc.Spec, tc.Cipher, encoded)
+		}
+		if decoded, _ := eng.Decode(tc.Cipher); decoded != tc.Plain {
+			t.Errorf("#%d '%s' round-trip exp: %s got: %s", i+1, tc.Spec, tc.Plain, decoded)
+		}
+	}
+
+	// the middle rotor steps twice in a row at its notch (E for II)
+	machine, _ := enigma.NewHistoricalMachine(cmn.ALPHA_DISK, enigma.Settings{Reflector: "B", Rotors: []string{"I", "II", "III"}, Start: "ADU"})
+	for _, exp := range []string{"ADV", "AEW", "BFX", "BFY"} {
+		machine.PressKey(0)
+		if got := machine.Positions(); got != exp {
+			t.Errorf("double stepping exp: %s got: %s", exp, got)
+		}
+	}
+	machine.Reset()
+	if got := machine.Positions(); got != "ADU" {
+		t.Errorf("reset exp: ADU got: %s", got)
+	}
+
+	// with plugboard: reciprocal and no letter encrypts to itself
+	settings, _ := enigma.ParseSettings("C IV-II-V 06-22-14 WXC AV BS CG DL FU HZ IN KM OW RX")
+	eng, _ := enigma.NewEnigmaCrypto(cmn.ALPHA_DISK, settings)
+	plain := strings.Repeat(enigma.HISTORICAL_LETTERS, 4)
+	encoded, _ := eng.Encode(plain)
+	for i, r := range encoded {
+		if rune(plain[i]) == r {
+			t.Errorf("letter %c at %d encrypted to itself", r, i)
+		}
+	}
+	if decoded, _ := eng.Decode(encoded); decoded != plain {
+		t.Errorf("plugboard round-trip failed: %s", decoded)
+	}
+	if settings.String() != "C IV-II-V 06-22-14 WXC AV BS CG DL FU HZ IN KM OW RX" {
+		t.Errorf("unexpected settings notation: %s", settings)
+	}
+}
+
+/**
+ * Cipher: Enigma parts.
+ * Languages: English (ASCII) & Greek.
+ * Type : Invalid wirings, plugboards & settings.
+ */
+func Test_Enigma_Errors(t *testing.T) {
+	if _, err := enigma.NewRotor(cmn.ALPHA_DISK, "X", "ABC", "A"); !errors.Is(err, enigma.ErrWiring) {
+		t.Errorf("expected wiring error for a short rotor, got: %v", err)
+	}
+	if _, err := enigma.NewReflector(cmn.ALPHA_DISK, "X", enigma.HistoricalRotors["I"].Wiring); !errors.Is(err, enigma.ErrWiring) {
+		t.Errorf("expected wiring error for a reflector without pairs, got: %v", err)
+	}
+	if _, err := enigma.NewPlugboard(cmn.ALPHA_DISK, "AB", "BC"); !errors.Is(err, enigma.ErrPlugboard) {
+		t.Errorf("expected plugboard error for a reused letter, got: %v", err)
+	}
+	if _, err := enigma.NewPlugboard(cmn.ALPHA_DISK, "AA"); !errors.Is(err, enigma.ErrPlugboard) {
+		t.Errorf("expected plugboard error for a self-plugged letter, got: %v", err)
+	}
+	if _, err := enigma.NewHistoricalRotor(cmn.ALPHA_DISK_GREEK, "I"); !errors.Is(err, enigma.ErrWiring) {
+		t.Errorf("expected wiring error for Greek, got: %v", err)
+	}
+
+	for _, spec := range []string{"B", "B I-II-IX", "D I-II-III", "B I-II-III 01-27-01", "B I-II-III 1A-01-01", "B I-II-III 01-01 AAA", "B I-II-III 01-01-01 AA"} {
+		settings, err := enigma.ParseSettings(spec)
+		if err == nil {
+			_, err = enigma.NewEnigmaCrypto(cmn.ALPHA_DISK, settings)
+		}
+		if !errors.Is(err, enigma.ErrSetting) {
+			t.Errorf("'%s' expected setting error, got: %v", spec, err)
+		}
+	}
+
+	if _, err := commands.NewEnigmaCommand(cmn.BINARY_DISK, "B I-II-III"); !errors.Is(err, enigma.ErrBinaryFile) {
+		t.Errorf("expected binary error, got: %v", err)
+	}
+}
+
+// Tests a machine with custom rotors for the Greek alphabet
+func Test_Enigma_CustomMachine(t *testing.T) {
+	alpha := cmn.ALPHA_DISK_GREEK
+	letters := []rune(alpha.Clone().ToUpper().Chars)
+	N := len(letters)
+
+	// rotor: shift by 7, reflector: pairs of opposite letters
+	shifted := make([]rune, N)
+	paired := make([]rune, N)
+	for i := range letters {
+		shifted[i] = letters[(i+7)%N]
+		paired[i] = letters[(i+N/2)%N]
+	}
+
+	rotorL, err := enigma.NewRotor(alpha, "L", string(shifted), string(letters[3]))
+	if err != nil {
+		t.Fatalf("unexpected rotor error: %v", err)
+	}
+	rotorR, _ := enigma.NewRotor(alpha, "R", string(shifted), string(letters[N-1]))
+	reflector, err := enigma.NewReflector(alpha, "G", string(paired))
+	if err != nil {
+		t.Fatalf("unexpected reflector error: %v", err)
+	}
+	plugboard, _ := enigma.NewPlugboard(alpha, string(letters[0:2]))
+
+	machine, err := enigma.NewEnigmaMachine(alpha, reflector, plugboard, rotorL, rotorR)
+	if err != nil {
+		t.Fatalf("unexpected machine error: %v", err)
+	}
+	const PLAIN = "Καλημέρα κόσμε"
+	encoded := machine.Encipher(PLAIN)
+	machine.Reset()
+	if decoded := machine.Encipher(encoded); decoded != PLAIN || encoded == PLAIN {
+		t.Errorf("Greek round-trip failed: %s → %s", encoded, decoded)
+	}
+}
+
+// Tests text file Enigma encryption with round-trip, binary files
+// are refused.
+func Test_EnigmaCmd_EncryptTextFile(t *testing.T) {
+	FILE_IN := "/tmp/test_enigma.txt"
+	FILE_RET := "/tmp/test_enigma_rt.txt"
+	const PLAIN = "Attack at once\nflee at dawn\n"
+	os.WriteFile(FILE_IN, []byte(PLAIN), 0644)
+	defer os.Remove(FILE_IN)
+
+	cmd, err := commands.NewEnigmaCommand(cmn.ALPHA_DISK, "B II-IV-V 02-21-12 BLA AV BS CG DL FU HZ IN KM OW RX")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cmd.EncryptTextFile(FILE_IN); err != nil {
+		t.Errorf("failed EncryptTextFile: %v", err)
+	}
+	FILE_OUT := cmd.GetOutputFilename()
+	defer os.Remove(FILE_OUT)
+	defer os.Remove(FILE_RET)
+
+	// the file is a single message, the rotors keep turning across lines
+	encoded, _ := cmd.Encode(strings.ReplaceAll(PLAIN, "\n", " "))
+	if data, _ := os.ReadFile(FILE_OUT); strings.ReplaceAll(string(data), "\n", " ") != encoded {
+		t.Errorf("unexpected encrypted file\n%s", data)
+	}
+
+	if err := cmd.DecryptTextFile(FILE_OUT, FILE_RET); err != nil {
+		t.Errorf("failed DecryptTextFile: %v", err)
+	}
+	if data, _ := os.ReadFile(FILE_RET); string(data) != PLAIN {
+		t.Errorf("unexpected round-trip text\n%s", data)
+	}
+
+	if !strings.HasSuffix(FILE_OUT, commands.FILE_EXT_ENIGMA) {
+		t.Errorf("unexpected output file extension %s", FILE_OUT)
+	}
+	if err := cmd.EncryptBinFile(FILE_IN); !errors.Is(err, enigma.ErrBinaryFile) {
+		t.Errorf("expected binary file error, got: %v", err)
+	}
+}
+
+// Tests the Caesarium Enigma key sheet: valid daily settings and the
+// same sheet from the same recovery phrase.
+func Test_Caesarium_EnigmaBook(t *testing.T) {
+	const RECOVERY = "abandon ability able about above absent absorb abstract absurd abuse access accident"
+	date := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	book1 := sched.NewCaesarium("Test", cmn.ALPHA_DISK, date, 0).MakeRecoverable(RECOVERY, "").CompileEnigmaBook()
+	book2 := sched.NewCaesarium("Test", cmn.ALPHA_DISK, date, 0).MakeRecoverable(RECOVERY, "").CompileEnigmaBook()
+	if len(book1) != 31 {
+		t.Fatalf("expected 31 days, got: %d", len(book1))
+	}
+
+	for day, settings := range book1 {
+		if settings.String() != book2[day].String() {
+			t.Errorf("day %d not recoverable: %s vs %s", day+1, settings, book2[day])
+		}
+		if len(settings.Rotors) != sched.DEFAULT_ENIGMA_ROTORS || len(settings.Plugs) != sched.DEFAULT_ENIGMA_PLUGS {
+			t.Errorf("day %d unexpected settings: %s", day+1, settings)
+		}
+		// the key sheet row is a valid machine spec
+		spec, err := enigma.ParseSettings(settings.String())
+		if err == nil {
+			_, err = enigma.NewEnigmaCrypto(cmn.ALPHA_DISK, spec)
+		}
+		if err != nil {
+			t.Errorf("day %d invalid settings %s: %v", day+1, settings, err)
+		}
+	}
+
+	// a truly random key sheet is valid too
+	for day, settings := range sched.NewCaesarium("Test", cmn.ALPHA_DISK, date, 0).CompileEnigmaBook() {
+		spec, err := enigma.ParseSettings(settings.String())
+		if err == nil {
+			_, err = enigma.NewEnigmaCrypto(cmn.ALPHA_DISK, spec)
+		}
+		if err != nil {
+			t.Errorf("day %d invalid random settings %s: %v", day+1, settings, err)
+		}
+	}
+}
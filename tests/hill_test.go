@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"errors"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/ciphers/hill"
+	"lordofscripts/caesarx/cmn"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+/**
+ * Matrix: Hill 2x2 & 3x3 modulo N.
+ * Type : Known inverses & matrices that are not invertible modulo N,
+ *		  the error tells why.
+ */
+func Test_Hill_Matrix(t *testing.T) {
+	allCases := []struct {
+		Values   []int
+		N        int
+		Inverse  []int
+		Contains string
+	}{
+		{[]int{3, 3, 2, 5}, 26, []int{15, 17, 20, 9}, ""},
+		{[]int{6, 24, 1, 13, 16, 10, 20, 17, 15}, 26, []int{8, 5, 10, 21, 8, 21, 21, 12, 8}, ""},
+		{[]int{3, 3, 2, 5}, 24, nil, "not coprime"},
+		{[]int{3, 3, 2, 5}, 33, nil, "not coprime"},
+		{[]int{2, 4, 1, 2}, 26, nil, "is 0"},
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9}, 26, nil, "is 0"},
+		{[]int{-1, 2, 3, -5}, 30, []int{5, 2, 3, 1}, ""},
+	}
+
+	for i, tc := range allCases {
+		m, err := hill.NewHillMatrix(tc.Values, tc.N)
+		if tc.Inverse == nil {
+			if !errors.Is(err, hill.ErrNotInvertible) || !strings.Contains(err.Error(), tc.Contains) {
+				t.Errorf("#%d expected not invertible (%s), got: %v", i+1, tc.Contains, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("#%d unexpected error: %v", i+1, err)
+		}
+		inverse, err := m.Inverse()
+		if err != nil || !slices.Equal(inverse.Values(), tc.Inverse) {
+			t.Errorf("#%d inverse exp: %v got: %v (%v)", i+1, tc.Inverse, inverse, err)
+		}
+		// K⁻¹·(K·P) = P
+		block := make([]int, m.Size())
+		for j := range block {
+			block[j] = tc.N - 1 - j
+		}
+		if got := inverse.Multiply(m.Multiply(block)); !slices.Equal(got, block) {
+			t.Errorf("#%d round-trip exp: %v got: %v", i+1, block, got)
+		}
+	}
+
+	if _, err := hill.NewHillMatrix([]int{1, 2, 3}, 26); !errors.Is(err, hill.ErrMatrixSize) {
+		t.Errorf("expected matrix size error, got: %v", err)
+	}
+}
+
+/**
+ * Cipher: Hill.
+ * Languages: English (ASCII).
+ * Type : Known vectors. Numeric & letter keys, padding with the nulls
+ *		  used in turn. The decoded text keeps the nulls.
+ */
+func Test_Hill_Vectors(t *testing.T) {
+	allCases := []struct {
+		Key     string
+		Nulls   string
+		Plain   string
+		Cipher  string
+		Decoded string
+	}{
+		{"3 3 2 5", "", "Help!", "HIAT", "HELP"},
+		{"3,3,2,5", "", "Help me", "HIATWS", "HELPME"},
+		{"GYBNQKURP", "", "act cat", "POHFIN", "ACTCAT"},
+		{"GYB NQK URP", "", "Act", "POH", "ACT"},
+		{"HILL", "", "short", "APADFU", "SHORTX"},
+		{"GYBNQKURP", "QZ", "acta", "POHTMX", "ACTAQZ"},
+	}
+
+	for i, tc := range allCases {
+		cmd, err := commands.NewHillCommand(cmn.ALPHA_DISK, tc.Key)
+		if err != nil {
+			t.Fatalf("#%d unexpected error: %v", i+1, err)
+		}
+		if len(tc.Nulls) != 0 {
+			if err = cmd.WithNulls(tc.Nulls); err != nil {
+				t.Fatalf("#%d unexpected nulls error: %v", i+1, err)
+			}
+		}
+		if got, err := cmd.Encode(tc.Plain); err != nil || got != tc.Cipher {
+			t.Errorf("#%d Encode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Cipher, got, err)
+		}
+		if got, err := cmd.Decode(tc.Cipher); err != nil || got != tc.Decoded {
+			t.Errorf("#%d Decode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Decoded, got, err)
+		}
+	}
+
+	cmd, _ := commands.NewHillCommand(cmn.ALPHA_DISK, "HILL")
+	for _, nulls := range []string{"", "X7", "Ñ"} {
+		if err := cmd.WithNulls(nulls); !errors.Is(err, hill.ErrNulls) {
+			t.Errorf("'%s' expected nulls error, got: %v", nulls, err)
+		}
+	}
+	if _, err := cmd.Decode("ABC"); !errors.Is(err, hill.ErrBlock) {
+		t.Errorf("expected block error, got: %v", err)
+	}
+	for _, key := range []string{"", "HIL", "H1LL", "1 2 x 4"} {
+		if _, err := commands.NewHillCommand(cmn.ALPHA_DISK, key); err == nil {
+			t.Errorf("'%s' expected key error", key)
+		}
+	}
+}
+
+/**
+ * Cipher: Hill.
+ * Languages: all built-in language alphabets, chained with Numbers.
+ * Type : Round-trip with a unimodular (det=1) 3x3 matrix which is
+ *		  invertible for any N. Pipe to a formatter whose separators
+ *		  are ignored when decoding.
+ */
+func Test_Hill_Alphabets(t *testing.T) {
+	const KEY string = "2 3 1, 1 2 1, 1 1 1"
+	for _, alpha := range BuiltinAlphabets {
+		for _, slave := range []*cmn.Alphabet{nil, cmn.NUMBERS_DISK} {
+			plain := alpha.Chars + "1984" + alpha.ToLowerString(alpha.Chars)
+			expected := alpha.ToUpperString(plain)
+			if slave == nil {
+				expected = alpha.ToUpperString(alpha.Chars + alpha.Chars)
+			}
+			null := alpha.GetRuneAt(-1)
+			if strings.ContainsRune(alpha.Chars, hill.DEFAULT_NULL) {
+				null = hill.DEFAULT_NULL
+			}
+			for len([]rune(expected))%3 != 0 {
+				expected += string(null)
+			}
+
+			cmd, err := commands.NewHillCommand(alpha, KEY)
+			if err != nil {
+				t.Fatalf("«%s» unexpected error: %v", alpha.Name, err)
+			}
+			cmd.WithChain(slave)
+			var _ ciphers.ICipherCommand = cmd
+
+			cipher, _ := cmd.Encode(plain)
+			if plain2, err := cmd.Decode(cipher); err != nil || plain2 != expected {
+				t.Errorf("«%s» %s round-trip fail\n\texp: '%s'\n\tgot: '%s' (%v)", alpha.Name, cmd, expected, plain2, err)
+			}
+
+			cmd.WithPipe(cmn.NewNgramFormatter(3, '·'))
+			formatted, _ := cmd.Encode(plain)
+			decoder, _ := commands.NewHillCommand(alpha, KEY)
+			decoder.WithChain(slave)
+			if plain2, _ := decoder.Decode(formatted); plain2 != expected {
+				t.Errorf("«%s» separators not ignored, got: '%s'", alpha.Name, plain2)
+			}
+		}
+	}
+}
+
+// Tests text file Hill encryption with round-trip. Each line is
+// padded on its own and binary files are refused.
+func Test_HillCmd_EncryptTextFile(t *testing.T) {
+	FILE_IN := "/tmp/test_hill.txt"
+	FILE_OUT := cmn.NewNameExtOnly(FILE_IN, commands.FILE_EXT_HILL, true)
+	FILE_RET := "/tmp/test_hill_rt.txt"
+	os.WriteFile(FILE_IN, []byte("Act now\ncat\n"), 0644)
+	defer os.Remove(FILE_IN)
+
+	cmd, _ := commands.NewHillCommand(cmn.ALPHA_DISK, "GYBNQKURP")
+	if err := cmd.EncryptTextFile(FILE_IN); err != nil {
+		t.Errorf("failed EncryptTextFile: %v", err)
+	}
+	defer os.Remove(FILE_OUT)
+
+	if err := cmd.DecryptTextFile(FILE_OUT, FILE_RET); err != nil {
+		t.Errorf("failed DecryptTextFile: %v", err)
+	}
+	defer os.Remove(FILE_RET)
+
+	if data, _ := os.ReadFile(FILE_RET); string(data) != "ACTNOW\nCAT\n" {
+		t.Errorf("unexpected round-trip text\n%s", data)
+	}
+
+	if err := cmd.EncryptBinFile(FILE_IN); !errors.Is(err, hill.ErrBinaryFile) {
+		t.Errorf("expected binary file error, got: %v", err)
+	}
+	if mx := cmd.Inverse().GetMatrixString("\t"); !strings.Contains(mx, " 0|   8   5  10") {
+		t.Errorf("unexpected matrix rendering\n%s", mx)
+	}
+}
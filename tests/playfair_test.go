@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"errors"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/ciphers/playfair"
+	"lordofscripts/caesarx/cmn"
+	"os"
+	"strings"
+	"testing"
+)
+
+/**
+ * Square: Playfair 5x5 (I=J) & 6x6 (with digits).
+ * Languages: English (ASCII).
+ * Type : Known squares. Alphabets that do not fit are refused.
+ */
+func Test_Playfair_Square(t *testing.T) {
+	allCases := []struct {
+		Slave    *cmn.Alphabet
+		Keyword  string
+		Expected string
+	}{
+		{nil, "Playfair Example", "PLAYFIREXMBCDGHKNOQSTUVWZ"},
+		{nil, "Jujitsu", "IUTSABCDEFGHKLMNOPQRVWXYZ"},
+		{cmn.NUMBERS_DISK, "Playfair 1854", "PLAYFIR1854BCDEGHJKMNOQSTUVWXZ023679"},
+	}
+
+	for i, tc := range allCases {
+		cmd, err := commands.NewPlayfairCommand(cmn.ALPHA_DISK, tc.Keyword)
+		if err != nil {
+			t.Fatalf("#%d unexpected error: %v", i+1, err)
+		}
+		cmd.WithChain(tc.Slave)
+		if got := cmd.Alphabet(); got != tc.Expected {
+			t.Errorf("#%d '%s' exp: %s got: %s", i+1, tc.Keyword, tc.Expected, got)
+		}
+	}
+
+	for _, alpha := range []*cmn.Alphabet{cmn.ALPHA_DISK_GREEK, cmn.ALPHA_DISK_CYRILLIC, cmn.BINARY_DISK} {
+		if _, err := playfair.NewPlayfairSquare(alpha, ""); !errors.Is(err, playfair.ErrSquareAlphabet) {
+			t.Errorf("«%s» expected square alphabet error, got: %v", alpha.Name, err)
+		}
+	}
+}
+
+/**
+ * Cipher: Playfair.
+ * Languages: English (ASCII).
+ * Type : Known vectors. Doubled letters, odd length & filler handling.
+ *		  The decoded text keeps the fillers.
+ */
+func Test_Playfair_Vectors(t *testing.T) {
+	allCases := []struct {
+		Keyword string
+		Slave   *cmn.Alphabet
+		Plain   string
+		Cipher  string
+		Decoded string
+	}{
+		{"Playfair Example", nil, "Hide the gold in the tree stump!", "BMODZBXDNABEKUDMUIXMMOUVIF", "HIDETHEGOLDINTHETREXESTUMP"},
+		{"Monarchy", nil, "instruments", "GATLMZCLRQXA", "INSTRUMENTSX"},
+		{"Monarchy", nil, "balloon", "IBSUPMNA", "BALXLOON"},
+		{"Monarchy", nil, "Jazz", "SBUZUZ", "IAZXZX"},
+		{"Monarchy", nil, "taxx", "SRWSWS", "TAXQXQ"},
+		{"Playfair Example", cmn.NUMBERS_DISK, "Meet at 10pm", "BXMQYS21YR", "MEETAT10PM"},
+	}
+
+	for i, tc := range allCases {
+		cmd, _ := commands.NewPlayfairCommand(cmn.ALPHA_DISK, tc.Keyword)
+		cmd.WithChain(tc.Slave)
+		if got, err := cmd.Encode(tc.Plain); err != nil || got != tc.Cipher {
+			t.Errorf("#%d Encode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Cipher, got, err)
+		}
+		if got, err := cmd.Decode(tc.Cipher); err != nil || got != tc.Decoded {
+			t.Errorf("#%d Decode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Decoded, got, err)
+		}
+	}
+}
+
+/**
+ * Cipher: Playfair.
+ * Type : Configurable fillers, invalid ciphertext & pipe to a
+ *		  formatter whose separators are ignored when decoding.
+ */
+func Test_Playfair_Fillers(t *testing.T) {
+	cmd, _ := commands.NewPlayfairCommand(cmn.ALPHA_DISK, "Monarchy")
+	if err := cmd.WithFiller('Z', 'K'); err != nil {
+		t.Fatalf("unexpected filler error: %v", err)
+	}
+	cipher, _ := cmd.Encode("balloon")
+	if plain, _ := cmd.Decode(cipher); plain != "BALZLOON" {
+		t.Errorf("filler Z not used, got: %s", plain)
+	}
+	cipher, _ = cmd.Encode("Pizza")
+	if plain, _ := cmd.Decode(cipher); plain != "PIZKZA" {
+		t.Errorf("alternate filler K not used, got: %s", plain)
+	}
+
+	for _, fillers := range []string{"XX", "IJ", "X7", "Xñ"} {
+		f := []rune(fillers)
+		if err := cmd.WithFiller(f[0], f[1]); !errors.Is(err, playfair.ErrFiller) {
+			t.Errorf("'%s' expected filler error, got: %v", fillers, err)
+		}
+	}
+
+	for _, cipher := range []string{"ABC", "AABC"} {
+		if _, err := cmd.Decode(cipher); !errors.Is(err, playfair.ErrDigraph) {
+			t.Errorf("'%s' expected digraph error, got: %v", cipher, err)
+		}
+	}
+
+	var _ ciphers.ICipherCommand = cmd
+	cmd.WithPipe(cmn.NewNgramFormatter(2, '·'))
+	if got, _ := cmd.Encode("instruments"); got != "GA·TL·MZ·CL·RQ·TX" {
+		t.Errorf("pipe output fail, got: %s", got)
+	}
+	plain, _ := commands.NewPlayfairCommand(cmn.ALPHA_DISK, "Monarchy")
+	if got, _ := plain.Decode("GA·TL·MZ·CL·RQ·XA"); got != "INSTRUMENTSX" {
+		t.Errorf("separators not ignored, got: %s", got)
+	}
+}
+
+// Tests text file Playfair encryption with round-trip. Each line is
+// padded on its own and binary files are refused.
+func Test_PlayfairCmd_EncryptTextFile(t *testing.T) {
+	FILE_IN := "/tmp/test_playfair.txt"
+	FILE_OUT := cmn.NewNameExtOnly(FILE_IN, commands.FILE_EXT_PLAYFAIR, true)
+	FILE_RET := "/tmp/test_playfair_rt.txt"
+	os.WriteFile(FILE_IN, []byte("Hide the gold\nin the tree stump\n"), 0644)
+	defer os.Remove(FILE_IN)
+
+	cmd, _ := commands.NewPlayfairCommand(cmn.ALPHA_DISK, "Playfair Example")
+	if err := cmd.EncryptTextFile(FILE_IN); err != nil {
+		t.Errorf("failed EncryptTextFile: %v", err)
+	}
+	defer os.Remove(FILE_OUT)
+
+	if err := cmd.DecryptTextFile(FILE_OUT, FILE_RET); err != nil {
+		t.Errorf("failed DecryptTextFile: %v", err)
+	}
+	defer os.Remove(FILE_RET)
+
+	if data, _ := os.ReadFile(FILE_RET); string(data) != "HIDETHEGOLDX\nINTHETREESTUMP\n" {
+		t.Errorf("unexpected round-trip text\n%s", data)
+	}
+
+	if err := cmd.EncryptBinFile(FILE_IN); !errors.Is(err, playfair.ErrBinaryFile) {
+		t.Errorf("expected binary file error, got: %v", err)
+	}
+	if sq := cmd.Square().String(); !strings.Contains(sq, "1|P L A Y F") || !strings.Contains(sq, "5|T U V W Z") {
+		t.Errorf("unexpected square rendering\n%s", sq)
+	}
+}
@@ -0,0 +1,235 @@
+package tests
+
+import (
+	"errors"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/cmn"
+	"os"
+	"strings"
+	"testing"
+)
+
+/**
+ * Square: Polybius 5x5 (I=J), 6x6 (with digits) and bigger squares.
+ * Languages: all built-in alphabets.
+ * Type : Square size, labels & the fillers of the partial squares.
+ */
+func Test_Polybius_Square(t *testing.T) {
+	allCases := []struct {
+		Alpha  *cmn.Alphabet
+		Labels string
+		Side   int
+		Merged bool
+	}{
+		{cmn.ALPHA_DISK, "", 5, true},
+		{cmn.ALPHA_DISK, polybius.LABELS_ADFGX, 5, true},
+		{cmn.ALPHA_DISK_GREEK, "", 5, false},
+		{cmn.ALPHA_DISK_GERMAN, "", 6, false},
+		{cmn.ALPHA_DISK_LATIN, "", 6, false},
+		{cmn.ALPHA_DISK_LATIN, polybius.LABELS_ADFGVX, 6, false},
+		{cmn.ALPHA_DISK_CYRILLIC, "", 6, false},
+	}
+
+	for i, tc := range allCases {
+		sq, err := polybius.NewPolybiusSquare(tc.Alpha, "", tc.Labels)
+		if err != nil {
+			t.Fatalf("#%d «%s» unexpected error: %v", i+1, tc.Alpha.Name, err)
+		}
+		if sq.Side() != tc.Side || sq.IsMerged() != tc.Merged {
+			t.Errorf("#%d «%s» exp: %dx%d merged:%t got: %dx%d merged:%t", i+1, tc.Alpha.Name, tc.Side, tc.Side, tc.Merged, sq.Side(), sq.Side(), sq.IsMerged())
+		}
+		// every letter of the alphabet has a cell
+		for _, r := range tc.Alpha.Clone().ToUpper().Chars {
+			n, _ := sq.Normalize(r)
+			if _, _, found := sq.Coordinates(n); !found {
+				t.Errorf("#%d «%s» letter %c not in the square", i+1, tc.Alpha.Name, r)
+			}
+		}
+	}
+
+	// the classic square & a keyed one
+	if sq, _ := polybius.NewPolybiusSquare(cmn.ALPHA_DISK, "", ""); sq.Runes() != "ABCDEFGHIKLMNOPQRSTUVWXYZ" {
+		t.Errorf("unexpected classic square %s", sq.Runes())
+	}
+	if sq, _ := polybius.NewPolybiusSquare(cmn.ALPHA_DISK, "Jujitsu", ""); sq.Runes() != "IUTSABCDEFGHKLMNOPQRVWXYZ" {
+		t.Errorf("unexpected keyed square %s", sq.Runes())
+	}
+	// the Greek square has a single filler
+	if sq, _ := polybius.NewPolybiusSquare(cmn.ALPHA_DISK_GREEK, "", ""); !strings.HasSuffix(sq.Runes(), "Ω0") {
+		t.Errorf("unexpected Greek square %s", sq.Runes())
+	}
+
+	// too few labels for the alphabet, duplicate labels & binary
+	if _, err := polybius.NewPolybiusSquare(cmn.ALPHA_DISK_GERMAN, "", polybius.LABELS_ADFGX); !errors.Is(err, polybius.ErrSquareAlphabet) {
+		t.Errorf("expected square alphabet error, got: %v", err)
+	}
+	if _, err := polybius.NewPolybiusSquare(cmn.ALPHA_DISK, "", "AADFG"); !errors.Is(err, polybius.ErrSquareLabels) {
+		t.Errorf("expected square labels error, got: %v", err)
+	}
+	if _, err := polybius.NewPolybiusSquare(cmn.BINARY_DISK, "", ""); !errors.Is(err, polybius.ErrSquareAlphabet) {
+		t.Errorf("expected square alphabet error, got: %v", err)
+	}
+}
+
+/**
+ * Cipher: Polybius, ADFGX, ADFGVX & Bifid.
+ * Languages: English (ASCII).
+ * Type : Known vectors. The decoded text is the normalized plain text.
+ */
+func Test_Polybius_Vectors(t *testing.T) {
+	const ADFGX_KEY = "BTALPDHOZKQFVSNGICUXMREWY"
+	const ADFGVX_KEY = "NA1C3H8TB2OME5WRPD4F6G7I9J0KLQSUVXYZ"
+	const BIFID_KEY = "BGWKZQPNDSIOAXEFCLUMTHYVR"
+
+	polybiusCmd, _ := commands.NewPolybiusCommand(cmn.ALPHA_DISK, "", "")
+	polybiusAdfgx, _ := commands.NewPolybiusCommand(cmn.ALPHA_DISK, "KEY", "adfgx")
+	adfgxCmd, _ := commands.NewAdfgvxCommand(cmn.ALPHA_DISK, ADFGX_KEY, "CARGO")
+	adfgvxCmd, _ := commands.NewAdfgvxCommand(cmn.ALPHA_DISK, ADFGVX_KEY, "PRIVACY")
+	adfgvxCmd.WithChain(cmn.NUMBERS_DISK)
+	bifidCmd, _ := commands.NewBifidCommand(cmn.ALPHA_DISK, BIFID_KEY, 0)
+
+	allCases := []struct {
+		Cmd     ciphers.ICipherCommand
+		Plain   string
+		Cipher  string
+		Decoded string
+	}{
+		{polybiusCmd, "Hello", "2315313134", "HELLO"},
+		{polybiusCmd, "Jump!", "24453235", "IUMP"},
+		{polybiusAdfgx, "Hello", "DXADFDFDFX", "HELLO"},
+		{adfgxCmd, "attack at once", "FAXDFADDDGDGFFFAFAXAFAFX", "ATTACKATONCE"},
+		{adfgvxCmd, "attack at 1200am", "DGDDDAGDDGAFADDFDADVDVFAADVX", "ATTACKAT1200AM"},
+		{bifidCmd, "flee at once", "UAEOLWRINS", "FLEEATONCE"},
+	}
+
+	for i, tc := range allCases {
+		if got, err := tc.Cmd.Encode(tc.Plain); err != nil || got != tc.Cipher {
+			t.Errorf("#%d %s Encode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Cmd, tc.Cipher, got, err)
+		}
+		if got, err := tc.Cmd.Decode(tc.Cipher); err != nil || got != tc.Decoded {
+			t.Errorf("#%d %s Decode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Cmd, tc.Decoded, got, err)
+		}
+	}
+
+	if adfgxCmd.Name() != polybius.LABELS_ADFGX || adfgvxCmd.Name() != polybius.LABELS_ADFGVX {
+		t.Errorf("unexpected names %s %s", adfgxCmd.Name(), adfgvxCmd.Name())
+	}
+	if _, err := polybiusCmd.Decode("231"); !errors.Is(err, polybius.ErrCoordinates) {
+		t.Errorf("expected coordinates error, got: %v", err)
+	}
+	if _, err := commands.NewAdfgvxCommand(cmn.ALPHA_DISK, "", ""); !errors.Is(err, ciphers.ErrTranspositionKey) {
+		t.Errorf("expected transposition error, got: %v", err)
+	}
+	if _, err := commands.NewAdfgvxCommand(cmn.ALPHA_DISK_CZECH, "", "CARGO"); !errors.Is(err, polybius.ErrSquareAlphabet) {
+		t.Errorf("expected square alphabet error, got: %v", err)
+	}
+	if _, err := commands.NewBifidCommand(cmn.ALPHA_DISK, "", -1); !errors.Is(err, polybius.ErrPeriod) {
+		t.Errorf("expected period error, got: %v", err)
+	}
+}
+
+/**
+ * Cipher: Polybius, ADFGVX & Bifid.
+ * Languages: all built-in alphabets that fit the square.
+ * Type : Round-trip with keyword, NGram formatting & Bifid periods.
+ */
+func Test_Polybius_AllAlphabets(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		if alpha.IsBinary() {
+			continue
+		}
+
+		plain := alpha.Clone().ToUpper().Chars
+		keyword := string([]rune(plain)[3:8])
+		var all []ciphers.ICipherCommand
+		if cmd, err := commands.NewPolybiusCommand(alpha, keyword, ""); err == nil {
+			all = append(all, cmd)
+		} else {
+			t.Errorf("«%s» Polybius unexpected error: %v", alpha.Name, err)
+		}
+		for _, period := range []int{0, 5, 7} {
+			if cmd, err := commands.NewBifidCommand(alpha, keyword, period); err == nil {
+				all = append(all, cmd)
+			} else {
+				t.Errorf("«%s» Bifid unexpected error: %v", alpha.Name, err)
+			}
+		}
+		// only those that fit in a 5x5 or 6x6 square
+		if cmd, err := commands.NewAdfgvxCommand(alpha, keyword, "ZEBRA,SCHWEIN"); err == nil {
+			all = append(all, cmd)
+		}
+
+		for _, cmd := range all {
+			expected := cmd.(interface {
+				Square() *polybius.PolybiusSquare
+			}).Square()
+			want := normalizeSquare(expected, plain)
+			cipher, err := cmd.Encode(plain)
+			if err != nil {
+				t.Errorf("«%s» %s Encode error: %v", alpha.Name, cmd, err)
+				continue
+			}
+			// the separators of the formatted ciphertext are ignored
+			formatted, _ := cmn.NewNgramFormatter(3, '·').Execute(cipher)
+			if got, err := cmd.Decode(formatted); err != nil || got != want {
+				t.Errorf("«%s» %s round-trip fail\n\texp: '%s'\n\tgot: '%s' (%v)\n\tcipher: %s", alpha.Name, cmd, want, got, err, cipher)
+			}
+		}
+	}
+}
+
+// Tests text file Polybius family encryption with round-trip, binary
+// files are refused.
+func Test_PolybiusCmd_EncryptTextFile(t *testing.T) {
+	FILE_IN := "/tmp/test_polybius.txt"
+	FILE_RET := "/tmp/test_polybius_rt.txt"
+	os.WriteFile(FILE_IN, []byte("Attack at once\nflee at dawn\n"), 0644)
+	defer os.Remove(FILE_IN)
+
+	polybiusCmd, _ := commands.NewPolybiusCommand(cmn.ALPHA_DISK, "Secret", "")
+	adfgvxCmd, _ := commands.NewAdfgvxCommand(cmn.ALPHA_DISK, "Secret", "CARGO")
+	bifidCmd, _ := commands.NewBifidCommand(cmn.ALPHA_DISK, "Secret", 5)
+	for _, cmd := range []ciphers.ICipherCommand{polybiusCmd, adfgvxCmd, bifidCmd} {
+		if err := cmd.EncryptTextFile(FILE_IN); err != nil {
+			t.Errorf("%s failed EncryptTextFile: %v", cmd, err)
+		}
+		FILE_OUT := cmd.GetOutputFilename()
+
+		if err := cmd.DecryptTextFile(FILE_OUT, FILE_RET); err != nil {
+			t.Errorf("%s failed DecryptTextFile: %v", cmd, err)
+		}
+
+		if data, _ := os.ReadFile(FILE_RET); string(data) != "ATTACKATONCE\nFLEEATDAWN\n" {
+			t.Errorf("%s unexpected round-trip text\n%s", cmd, data)
+		}
+		os.Remove(FILE_OUT)
+		os.Remove(FILE_RET)
+
+		if err := cmd.EncryptBinFile(FILE_IN); !errors.Is(err, polybius.ErrBinaryFile) {
+			t.Errorf("%s expected binary file error, got: %v", cmd, err)
+		}
+	}
+
+	if !strings.HasSuffix(polybiusCmd.GetOutputFilename(), commands.FILE_EXT_POLYBIUS) ||
+		!strings.HasSuffix(adfgvxCmd.GetOutputFilename(), commands.FILE_EXT_ADFGVX) ||
+		!strings.HasSuffix(bifidCmd.GetOutputFilename(), commands.FILE_EXT_BIFID) {
+		t.Errorf("unexpected output file extensions")
+	}
+	if sq := adfgvxCmd.Square().String(); !strings.Contains(sq, "A|S E C R T") {
+		t.Errorf("unexpected square rendering\n%s", sq)
+	}
+}
+
+// the (uppercase) text as it appears in the square: J merged into I
+// and the runes that are not in the square removed.
+func normalizeSquare(sq *polybius.PolybiusSquare, upper string) string {
+	var sb strings.Builder
+	for _, r := range upper {
+		if n, ok := sq.Normalize(r); ok {
+			sb.WriteRune(n)
+		}
+	}
+	return sb.String()
+}
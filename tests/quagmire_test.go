@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+	"testing"
+)
+
+/**
+ * Alphabet: keyword-mixed.
+ * Languages: English, German (special case ß).
+ * Type : Known keyed alphabets. Repeated & foreign keyword runes are
+ *		  ignored and the match is case-insensitive.
+ */
+func Test_Alphabet_Keyed(t *testing.T) {
+	allCases := []struct {
+		Alpha    *cmn.Alphabet
+		Keyword  string
+		Expected string
+	}{
+		{cmn.ALPHA_DISK, "KRYPTOS", "KRYPTOSABCDEFGHIJLMNQUVWXZ"},
+		{cmn.ALPHA_DISK, "kryptos", "KRYPTOSABCDEFGHIJLMNQUVWXZ"},
+		{cmn.ALPHA_DISK, "Hello, World!", "HELOWRDABCFGIJKMNPQSTUVXYZ"},
+		{cmn.ALPHA_DISK, "", cmn.ALPHA_DISK.Chars},
+		{cmn.ALPHA_DISK_GERMAN, "Straße", "STRAẞEBCDFGHIJKLMNOPQUVWXYZÄÖÜ"},
+	}
+
+	for i, tc := range allCases {
+		keyed := tc.Alpha.Keyed(tc.Keyword)
+		if keyed.Chars != tc.Expected {
+			t.Errorf("#%d '%s' exp: %s got: %s", i+1, tc.Keyword, tc.Expected, keyed.Chars)
+		}
+		if keyed.Name != tc.Alpha.Name || !keyed.Check() {
+			t.Errorf("#%d '%s' bad keyed alphabet %s", i+1, tc.Keyword, keyed)
+		}
+	}
+}
+
+/**
+ * Keywords: Quagmire type from the CLI specification.
+ */
+func Test_Quagmire_ParseKeys(t *testing.T) {
+	allCases := []struct {
+		Spec     string
+		Expected ciphers.Quagmire
+	}{
+		{"", ciphers.QuagmireNone},
+		{"ZEBRA:", ciphers.QuagmireI},
+		{":ZEBRA", ciphers.QuagmireII},
+		{"ZEBRA", ciphers.QuagmireIII},
+		{"ZEBRA:ZEBRA", ciphers.QuagmireIII},
+		{"ZEBRA:HORSE", ciphers.QuagmireIV},
+	}
+
+	for i, tc := range allCases {
+		if got := ciphers.ParseQuagmireKeys(tc.Spec).Type(); got != tc.Expected {
+			t.Errorf("#%d '%s' exp: %s got: %s", i+1, tc.Spec, tc.Expected, got)
+		}
+	}
+}
+
+/**
+ * Cipher: Bellaso with Quagmire III.
+ * Languages: English (ASCII).
+ * Type : Known vector, part 1 of the Kryptos sculpture.
+ */
+func Test_Quagmire_Kryptos(t *testing.T) {
+	const PLAIN string = "BETWEENSUBTLESHADINGANDTHEABSENCEOFLIGHTLIESTHENUANCEOFIQLUSION"
+	const CIPHER string = "EMUFPHZLRFAXYUSDJKZLDKRNSHGNFIVJYQTQUXQBQVYUVLLTREVJYQTMKYRDMFD"
+
+	cmd := commands.NewBellasoCommand(cmn.ALPHA_DISK, "PALIMPSEST")
+	cmd.WithKeyword(ciphers.NewQuagmireKeys("KRYPTOS"))
+	if got, err := cmd.Encode(PLAIN); err != nil || got != CIPHER {
+		t.Errorf("Encode fail\n\texp: '%s'\n\tgot: '%s' (%v)", CIPHER, got, err)
+	}
+	if got, err := cmd.Decode(CIPHER); err != nil || got != PLAIN {
+		t.Errorf("Decode fail\n\texp: '%s'\n\tgot: '%s' (%v)", PLAIN, got, err)
+	}
+}
+
+/**
+ * Cipher: Caesar, Bellaso & Vigenère with Quagmire I-IV.
+ * Languages: all built-in language alphabets.
+ * Type : Round-trip with the numbers slave. Keyed encryption must
+ *		  differ from the one with straight alphabets.
+ */
+func Test_Quagmire_RoundTrip(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		size := int(alpha.Size())
+		w1 := fmt.Sprintf("%c%c%c", alpha.GetRuneAt(-1), alpha.GetRuneAt(4), alpha.GetRuneAt(size-3))
+		w2 := fmt.Sprintf("%c%c", alpha.GetRuneAt(size-2), alpha.GetRuneAt(7))
+		secret := fmt.Sprintf("%c%c%c", alpha.GetRuneAt(3), alpha.GetRuneAt(-1), alpha.GetRuneAt(int(alpha.Size()/2)))
+		plain := alpha.Chars + " 1984 " + strings.ToLower(alpha.Chars)
+
+		for _, spec := range []string{w1 + ":", ":" + w1, w1, w1 + ":" + w2} {
+			keys := ciphers.ParseQuagmireKeys(spec)
+			all := []ciphers.IKeyedCipherCommand{
+				commands.NewCaesarCommand(alpha, alpha.GetRuneAt(5)),
+				commands.NewBellasoCommand(alpha, secret),
+				commands.NewVigenereCommand(alpha, secret),
+			}
+			for _, cmd := range all {
+				straight, _ := cmd.WithChain(cmn.NUMBERS_DISK).Encode(plain)
+				cmd.WithKeyword(keys)
+
+				cipher, err := cmd.Encode(plain)
+				if err != nil {
+					t.Fatalf("«%s» %s %s unexpected error: %v", alpha.Name, cmd, keys, err)
+				}
+				if cipher == straight {
+					t.Errorf("«%s» %s %s same as straight alphabets", alpha.Name, cmd, keys)
+				}
+				if decoded, _ := cmd.Decode(cipher); decoded != plain {
+					t.Errorf("«%s» %s %s Decode fail\n\texp: '%s'\n\tgot: '%s'", alpha.Name, cmd, keys, plain, decoded)
+				}
+			}
+		}
+	}
+}
+
+/**
+ * Cipher: Beaufort with Quagmire I-IV.
+ * Type : Its reading only uses the rows, a plaintext keyword of its own
+ *		  (Quagmire I & IV) is refused instead of being ignored.
+ */
+func Test_Quagmire_Beaufort(t *testing.T) {
+	for _, tc := range []struct {
+		Spec string
+		Err  error
+	}{
+		{"ZEBRA:", ciphers.ErrPlainKeyword},
+		{":ZEBRA", nil},
+		{"ZEBRA", nil},
+		{"ZEBRA:HORSE", ciphers.ErrPlainKeyword},
+	} {
+		cmd := commands.NewBeaufortCommand(cmn.ALPHA_DISK, "PASSWD")
+		cmd.WithKeyword(ciphers.ParseQuagmireKeys(tc.Spec))
+		if _, err := cmd.Encode("plain text"); !errors.Is(err, tc.Err) {
+			t.Errorf("'%s' exp: %v got: %v", tc.Spec, tc.Err, err)
+		}
+	}
+}
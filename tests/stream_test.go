@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/vigenere"
+	"lordofscripts/caesarx/cmn"
+	"os"
+	"testing"
+	"testing/iotest"
+)
+
+/**
+ * Package: ciphers (crypto streams)
+ * Languages: Greek, Spanish, binary
+ * Type : the stream en/decrypted in chunks is the same as the whole
+ *		  message, even when the chunks split multi-byte runes.
+ */
+
+// writes the data in chunks of the given size
+func writeChunks(w io.Writer, data []byte, size int) error {
+	for len(data) != 0 {
+		n := min(size, len(data))
+		if _, err := w.Write(data[:n]); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+func Test_Stream_Text(t *testing.T) {
+	tests := []struct {
+		Alpha  *cmn.Alphabet
+		Secret string
+		Plain  string
+	}{
+		{cmn.ALPHA_DISK_GREEK, "τηνκρυπ", "Λατρεύω την κρυπτογραφία\nαπό το 1985!\n"},
+		{cmn.ALPHA_DISK_LATIN, "Llave", "Años amé la criptografía\r\nmañana más"},
+	}
+
+	for _, tc := range tests {
+		for _, cipher := range []ciphers.ICipher{
+			bellaso.NewBellasoTabulaRecta(tc.Alpha, tc.Secret),
+			vigenere.NewVigenereTabulaRecta(tc.Alpha, tc.Secret),
+		} {
+			expected := cipher.Encode(tc.Plain)
+
+			for _, size := range []int{1, 2, 3, 4096} {
+				var ciphered bytes.Buffer
+				writer := ciphers.NewEncryptingWriter(&ciphered, cipher)
+				if err := writeChunks(writer, []byte(tc.Plain), size); err != nil {
+					t.Fatal(err)
+				}
+				if err := writer.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if ciphered.String() != expected {
+					t.Errorf("%s chunks of %d exp: %q got: %q", cipher, size, expected, ciphered.String())
+				}
+
+				reader := ciphers.NewDecryptingReader(iotest.OneByteReader(&ciphered), cipher)
+				plain, err := io.ReadAll(reader)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(plain) != tc.Plain {
+					t.Errorf("%s chunks of %d exp: %q got: %q", cipher, size, tc.Plain, plain)
+				}
+			}
+		}
+	}
+
+	// the Affine cipher streams too
+	acrypto := affine.NewAffineCrypto(cmn.ALPHA_DISK_GREEK, validAffineParamsGR)
+	var ciphered bytes.Buffer
+	writer := ciphers.NewEncryptingWriter(&ciphered, acrypto)
+	writeChunks(writer, []byte("Λατρεύω την κρυπτογραφία"), 1)
+	writer.Close()
+	if ciphered.String() != "Βδκυθύφ κχπ τυρνκζσυδωίδ" {
+		t.Errorf("Affine stream got: %q", ciphered.String())
+	}
+}
+
+func Test_Stream_Binary(t *testing.T) {
+	png, err := os.ReadFile("testdata/caesar-silver-coin.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cipher := vigenere.NewVigenereTabulaRecta(cmn.BINARY_DISK, "PASSWORD")
+	expected := cipher.EncodeBytes(png)
+
+	var ciphered bytes.Buffer
+	writer := ciphers.NewBinaryEncryptingWriter(&ciphered, cipher)
+	if err = writeChunks(writer, png, 1000); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+	if !bytes.Equal(ciphered.Bytes(), expected) {
+		t.Error("the binary stream doesn't match EncodeBytes")
+	}
+	if _, err = writer.Write(png); err != ciphers.ErrStreamClosed {
+		t.Errorf("exp: %v got: %v", ciphers.ErrStreamClosed, err)
+	}
+
+	plain, err := io.ReadAll(ciphers.NewBinaryDecryptingReader(iotest.HalfReader(&ciphered), cipher))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(plain, png) {
+		t.Error("the binary stream round trip failed")
+	}
+}
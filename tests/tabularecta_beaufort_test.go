@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"fmt"
+	"lordofscripts/caesarx/ciphers/beaufort"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"os"
+	"strings"
+	"testing"
+)
+
+/**
+ * Cipher: Beaufort & Variant Beaufort.
+ * Languages: English (ASCII).
+ * Type : Known vectors (Encode-Decode)
+ */
+func Test_BeaufortCmd_KnownVectors(t *testing.T) {
+	const SECRET string = "FORTIFICATION"
+	const PLAIN string = "DEFENDTHEEASTWALLOFTHECASTLE"
+
+	bft := commands.NewBeaufortCommand(cmn.ALPHA_DISK, SECRET)
+	if got, err := bft.Encode(PLAIN); err != nil {
+		t.Errorf("Beaufort unexpected encode error: %v", err)
+	} else if got != "CKMPVCPVWPIWUJOGIUAPVWRIWUUK" {
+		t.Errorf("Beaufort Encode fail\n\texp: '%s'\n\tgot: '%s'", "CKMPVCPVWPIWUJOGIUAPVWRIWUUK", got)
+	}
+
+	vbf := commands.NewVariantBeaufortCommand(cmn.ALPHA_DISK, SECRET)
+	if got, err := vbf.Encode(PLAIN); err != nil {
+		t.Errorf("Variant Beaufort unexpected encode error: %v", err)
+	} else if got != "YQOLFYLFELSEGRMUSGALFEJSEGGQ" {
+		t.Errorf("Variant Beaufort Encode fail\n\texp: '%s'\n\tgot: '%s'", "YQOLFYLFELSEGRMUSGALFEJSEGGQ", got)
+	} else if plain, _ := vbf.Decode(got); plain != PLAIN {
+		t.Errorf("Variant Beaufort Decode fail\n\texp: '%s'\n\tgot: '%s'", PLAIN, plain)
+	}
+}
+
+/**
+ * Cipher: Beaufort (reciprocal polyalphabetic).
+ * Languages: all built-in language alphabets.
+ * Type : Reciprocity. Encoding the ciphertext gives back the plain text
+ *		  and Decode is the very same operation as Encode. The message
+ *		  includes lowercase, digits of the slave and skipped runes.
+ */
+func Test_Beaufort_Reciprocal(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		secret := fmt.Sprintf("%c%c%c", alpha.GetRuneAt(3), alpha.GetRuneAt(-1), alpha.GetRuneAt(int(alpha.Size()/2)))
+		plain := alpha.Chars + " 1984 @ " + strings.ToLower(alpha.Chars) + "!"
+
+		ctr := beaufort.NewBeaufortTabulaRecta(alpha, secret)
+		if err := ctr.VerifyKey(); err != nil {
+			t.Fatalf("«%s» unexpected key error: %v", alpha.Name, err)
+		}
+
+		cipher := ctr.Encode(plain)
+		if cipher == plain {
+			t.Errorf("«%s» Encode did nothing", alpha.Name)
+		}
+		if again := ctr.Encode(cipher); again != plain {
+			t.Errorf("«%s» not reciprocal\n\texp: '%s'\n\tgot: '%s'", alpha.Name, plain, again)
+		}
+		if decoded := ctr.Decode(cipher); decoded != plain {
+			t.Errorf("«%s» Decode fail\n\texp: '%s'\n\tgot: '%s'", alpha.Name, plain, decoded)
+		}
+	}
+}
+
+/**
+ * Cipher: Variant Beaufort.
+ * Languages: all built-in language alphabets.
+ * Type : Round-trip and equivalence: Variant Beaufort encipherment is
+ *		  the same as Bellaso decipherment with the same secret.
+ */
+func Test_VariantBeaufort_RoundTrip(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		secret := fmt.Sprintf("%c%c%c", alpha.GetRuneAt(3), alpha.GetRuneAt(-1), alpha.GetRuneAt(int(alpha.Size()/2)))
+		plain := alpha.Chars + " 1984 @ " + strings.ToLower(alpha.Chars) + "!"
+
+		vbf := commands.NewVariantBeaufortCommand(alpha, secret)
+		bel := commands.NewBellasoCommand(alpha, secret)
+
+		cipher, err := vbf.Encode(plain)
+		if err != nil {
+			t.Fatalf("«%s» unexpected encode error: %v", alpha.Name, err)
+		}
+		if expected, _ := bel.Decode(plain); cipher != expected {
+			t.Errorf("«%s» not Bellaso's inverse\n\texp: '%s'\n\tgot: '%s'", alpha.Name, expected, cipher)
+		}
+		if decoded, _ := vbf.Decode(cipher); decoded != plain {
+			t.Errorf("«%s» Decode fail\n\texp: '%s'\n\tgot: '%s'", alpha.Name, plain, decoded)
+		}
+	}
+}
+
+// Tests Beaufort & Variant Beaufort round-trip encryption of a BINARY FILE.
+// Beaufort is reciprocal, yet its files are decrypted (& checked) too.
+func Test_BeaufortCmd_EncryptBinFile(t *testing.T) {
+	allCases := []struct {
+		Variant       bool
+		Secret        string
+		InputFilename string // plain binary file to be encrypted
+		TwinFilename  string // plain binary file after round-trip encrypt-decrypt
+	}{
+		{false, "Amor", "input.bin", "output_BF.bin"},
+		{false, "Detox", "caesar-silver-coin.png", "caesar-silver-coin-BF-ret.png"},
+		{true, "Amor", "input.bin", "output_VBF.bin"},
+		{true, "Detox", "caesar-silver-coin.png", "caesar-silver-coin-VBF-ret.png"},
+	}
+
+	for i, tc := range allCases {
+		var err error
+
+		assetIn := getAssetFilename(t, TEST_ASSETS, tc.InputFilename)
+		assetRet := getAssetFilename(t, TEST_ASSETS, tc.TwinFilename)
+		var assetOut string
+
+		if !tc.Variant {
+			ctr := commands.NewBeaufortCommand(cmn.BINARY_DISK, tc.Secret)
+			if err = ctr.EncryptBinFile(assetIn); err != nil {
+				t.Errorf("#%d failed EncryptBinFile: %v", i+1, err)
+			}
+			assetOut = ctr.GetOutputFilename()
+			// a wrong secret is refused instead of giving garbage
+			if err = commands.NewBeaufortCommand(cmn.BINARY_DISK, "Wrong").DecryptBinFile(assetOut, assetRet); err == nil {
+				t.Errorf("#%d a wrong secret decrypted the file", i+1)
+			}
+			if err = ctr.DecryptBinFile(assetOut, assetRet); err != nil {
+				t.Errorf("#%d failed DecryptBinFile: %v", i+1, err)
+			}
+		} else {
+			ctr := commands.NewVariantBeaufortCommand(cmn.BINARY_DISK, tc.Secret)
+			if err = ctr.EncryptBinFile(assetIn); err != nil {
+				t.Errorf("#%d failed EncryptBinFile: %v", i+1, err)
+			}
+			assetOut = ctr.GetOutputFilename()
+			if err = ctr.DecryptBinFile(assetOut, assetRet); err != nil {
+				t.Errorf("#%d failed DecryptBinFile: %v", i+1, err)
+			}
+		}
+
+		md5In, _ := cmn.CalculateFileMD5(assetIn)
+		md5Out, _ := cmn.CalculateFileMD5(assetRet)
+		if md5In != md5Out {
+			t.Errorf("#%d round-trip decrypted file not the same as input. %s vs %s", i+1, md5In, md5Out)
+		}
+
+		os.Remove(assetOut)
+		os.Remove(assetRet)
+	}
+}
@@ -0,0 +1,225 @@
+package tests
+
+import (
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"os"
+	"strings"
+	"testing"
+)
+
+/**
+ * Cipher: Caesar Extended, Augustus & Tiberius.
+ * Languages: English (ASCII).
+ * Type : Known vectors & Round-trip (Encode-Decode)
+ * The digits, punctuation & symbols are encoded with the SYMBOL_DISK.
+ */
+func Test_CaesarModes_Vectors(t *testing.T) {
+	const PLAIN = "Attack at 10:45, bring 3 guns!"
+	allCases := []struct {
+		Opts      *caesar.CaesarOptions
+		ExpCipher string
+	}{
+		{caesar.NewCaesarOpts('K'), "Kddkmu kd 10:45, lbsxq 3 qexc!"},
+		{caesar.NewCaesarExtendedOpts('K'), "Kddkmu kd ;:¡>¿6 lbsxq = qexc+"},
+		{caesar.NewCaesarAugustusOpts('K', 3), "Kgdnmx kg ;=¡@¿9 lesaq ? qhxf+"},
+		{caesar.NewCaesarTiberiusOpts('K', 3), "Kddkmu kd 43=78/ lbsxq 6 qexc$"},
+		{caesar.NewCaesarTiberiusOpts('K', 3+36), "Kddkmu kd 43=78/ lbsxq 6 qexc$"}, // inner shift modulo SYMBOL_DISK
+	}
+
+	for i, tc := range allCases {
+		alg := commands.NewCaesarCommandWithOptions(cmn.ALPHA_DISK, tc.Opts)
+		if alg == nil {
+			t.Fatalf("#%d no command for %s", i+1, tc.Opts)
+		}
+
+		if cipher, err := alg.Encode(PLAIN); err != nil {
+			t.Errorf("#%d unexpected encode error: %v", i+1, err)
+		} else if cipher != tc.ExpCipher {
+			t.Errorf("#%d %s Encode fail\n\texp: %s\n\tgot: %s", i+1, tc.Opts.LeaderString(), tc.ExpCipher, cipher)
+		}
+
+		if plain, err := alg.Decode(tc.ExpCipher); err != nil {
+			t.Errorf("#%d unexpected decode error: %v", i+1, err)
+		} else if plain != PLAIN {
+			t.Errorf("#%d %s Decode fail\n\texp: %s\n\tgot: %s", i+1, tc.Opts.LeaderString(), PLAIN, plain)
+		}
+	}
+}
+
+// All the built-in alphabets with every extended mode. Tiberius has
+// an inner shift larger than any of the alphabets.
+func Test_CaesarModes_RoundTrip(t *testing.T) {
+	allCases := []struct {
+		Alpha *cmn.Alphabet
+		Input string
+	}{
+		{cmn.ALPHA_DISK, "I love cryptography since 1985, 100% true!"},
+		{cmn.ALPHA_DISK_LATIN, "Años amé la criptografía, ¡desde 1985!"},
+		{cmn.ALPHA_DISK_ITALIAN, "Amo la crittografia dal 1985, è vero?"},
+		{cmn.ALPHA_DISK_PORTUGUESE, "Eu amo a criptografia desde 1985, não é?"},
+		{cmn.ALPHA_DISK_GERMAN, "Daß liebe hübschen Mädschen (seit 1985)"},
+		{cmn.ALPHA_DISK_GREEK, "Λατρεύω την κρυπτογραφία από το 1985!"},
+		{cmn.ALPHA_DISK_CYRILLIC, "Я люблю криптографию с 1985 года!"},
+		{cmn.ALPHA_DISK_CZECH, "Miluji kryptografii od roku 1985, že?"},
+	}
+
+	for _, tc := range allCases {
+		key := tc.Alpha.GetRuneAt(7)
+		for _, opts := range []*caesar.CaesarOptions{
+			caesar.NewCaesarExtendedOpts(key),
+			caesar.NewCaesarAugustusOpts(key, 5),
+			caesar.NewCaesarTiberiusOpts(key, 50),
+		} {
+			alg := commands.NewCaesarCommandWithOptions(tc.Alpha, opts)
+			cipher, err := alg.Encode(tc.Input)
+			if err != nil {
+				t.Errorf("%s %s unexpected encode error: %v", tc.Alpha.Name, opts.LeaderString(), err)
+				continue
+			}
+			if strings.Contains(cipher, "1985") {
+				t.Errorf("%s %s digits were not encoded: %s", tc.Alpha.Name, opts.LeaderString(), cipher)
+			}
+
+			if plain, err := alg.Decode(cipher); err != nil {
+				t.Errorf("%s %s unexpected decode error: %v", tc.Alpha.Name, opts.LeaderString(), err)
+			} else if plain != tc.Input {
+				t.Errorf("%s %s Decode fail\n\texp: %s\n\tgot: %s", tc.Alpha.Name, opts.LeaderString(), tc.Input, plain)
+			}
+		}
+	}
+}
+
+// The alternate key of Augustus depends on the alphabet, it must
+// follow a change of alphabet.
+func Test_CaesarModes_WithAlphabet(t *testing.T) {
+	const PHRASE = "Años amé la criptografía en 2025"
+	alg := commands.NewCaesarCommandWithOptions(cmn.ALPHA_DISK, caesar.NewCaesarAugustusOpts('B', 30))
+	alg.WithAlphabet(cmn.ALPHA_DISK_LATIN)
+
+	twin := commands.NewCaesarCommandWithOptions(cmn.ALPHA_DISK_LATIN, caesar.NewCaesarAugustusOpts('B', 30))
+	cipher, err := alg.Encode(PHRASE)
+	if err != nil {
+		t.Fatalf("unexpected encode error: %v", err)
+	}
+	if expected, _ := twin.Encode(PHRASE); cipher != expected {
+		t.Errorf("Augustus after WithAlphabet\n\texp: %s\n\tgot: %s", expected, cipher)
+	}
+	if plain, _ := alg.Decode(cipher); plain != PHRASE {
+		t.Errorf("Augustus after WithAlphabet\n\texp: %s\n\tgot: %s", PHRASE, plain)
+	}
+}
+
+func Test_CaesarModes_Sequencers(t *testing.T) {
+	const DUMMY = 'x'
+	augustus := crypto.NewAugustusSequencer('M', 5, cmn.ALPHA_DISK)
+	for pos, exp := range []rune{'M', 'R', 'M', 'R'} {
+		if k := augustus.GetKey(pos, DUMMY); k != exp {
+			t.Errorf("Augustus pos %d exp: %c got: %c", pos, exp, k)
+		}
+	}
+
+	slave := ciphers.NewTabulaRecta(cmn.SYMBOL_DISK, true)
+	tiberius := crypto.NewTiberiusSequencer('M', 40, slave)
+	if k := tiberius.GetKey(0, 'A'); k != 'M' {
+		t.Errorf("Tiberius outer key exp: M got: %c", k)
+	}
+	if k := tiberius.GetKey(1, '7'); k != cmn.SYMBOL_DISK.GetRuneAt(40%int(cmn.SYMBOL_DISK.Size())) {
+		t.Errorf("Tiberius inner key got: %c", k)
+	}
+	if k := tiberius.WithSlave(nil).GetKey(1, '7'); k != 'M' {
+		t.Errorf("Tiberius without slave exp: M got: %c", k)
+	}
+}
+
+func Test_CaesarModes_LeaderString(t *testing.T) {
+	allCases := []struct {
+		Opts     *caesar.CaesarOptions
+		Expected string
+	}{
+		{caesar.NewCaesarOpts('M'), "CAESM"},
+		{caesar.NewCaesarExtendedOpts('M'), "CAEXM"},
+		{caesar.NewCaesarAugustusOpts('M', 3), "CAEOM03"},
+		{caesar.NewCaesarTiberiusOpts('Λ', 7), "CAETΛ07"},
+		{caesar.NewCaesarOpts(0x0A), "CAES{0A}"},
+		{&caesar.CaesarOptions{Variant: caesar.BELLASO, Initial: 'M'}, "XXX"},
+	}
+
+	for i, tc := range allCases {
+		if got := tc.Opts.LeaderString(); got != tc.Expected {
+			t.Errorf("#%d LeaderString exp: %s got: %s", i+1, tc.Expected, got)
+		}
+	}
+
+	if got := caesar.VIGNERE_AUTOKEY.String(); got != "Vignere" {
+		t.Errorf("mode name exp: Vignere got: %s", got)
+	}
+	if cmd := commands.NewCaesarCommandWithOptions(cmn.ALPHA_DISK, &caesar.CaesarOptions{Variant: caesar.BELLASO}); cmd != nil {
+		t.Error("Bellaso is not a Caesar mode")
+	}
+}
+
+// Text & binary files, each mode has its own filename extension
+func Test_CaesarModes_Files(t *testing.T) {
+	const TEXT = "Attack at 10:45, bring 3 guns!\nΛατρεύω την κρυπτογραφία"
+	FILE_IN := "/tmp/test_caesar_modes.txt"
+	FILE_RET := "/tmp/test_caesar_modes_rt.txt"
+	if err := os.WriteFile(FILE_IN, []byte(TEXT+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(FILE_IN)
+	defer os.Remove(FILE_RET)
+
+	allCases := []struct {
+		Opts   *caesar.CaesarOptions
+		ExpExt string
+	}{
+		{caesar.NewCaesarExtendedOpts('Z'), commands.FILE_EXT_CAESAR_EXTENDED},
+		{caesar.NewCaesarAugustusOpts('Z', 9), commands.FILE_EXT_CAESAR_AUGUSTUS},
+		{caesar.NewCaesarTiberiusOpts('Z', 9), commands.FILE_EXT_CAESAR_TIBERIUS},
+	}
+
+	for i, tc := range allCases {
+		// text
+		alg := commands.NewCaesarCommandWithOptions(cmn.ALPHA_DISK, tc.Opts)
+		if err := alg.EncryptTextFile(FILE_IN); err != nil {
+			t.Errorf("#%d failed EncryptTextFile: %v", i+1, err)
+			continue
+		}
+		fileOut := alg.GetOutputFilename()
+		if !strings.HasSuffix(fileOut, tc.ExpExt) {
+			t.Errorf("#%d exp. extension %s got: %s", i+1, tc.ExpExt, fileOut)
+		}
+		if err := alg.DecryptTextFile(fileOut, FILE_RET); err != nil {
+			t.Errorf("#%d failed DecryptTextFile: %v", i+1, err)
+		}
+		md5In, _ := cmn.CalculateFileMD5(FILE_IN)
+		md5Out, _ := cmn.CalculateFileMD5(FILE_RET)
+		if md5In != md5Out {
+			t.Errorf("#%d round-trip decrypted text file not the same as input", i+1)
+		}
+		os.Remove(fileOut)
+
+		// binary
+		assetIn := getAssetFilename(t, TEST_ASSETS, "input.bin")
+		assetRet := getAssetFilename(t, TEST_ASSETS, "output_modes.bin")
+		bin := commands.NewCaesarCommandWithOptions(cmn.BINARY_DISK, tc.Opts)
+		if err := bin.EncryptBinFile(assetIn); err != nil {
+			t.Errorf("#%d failed EncryptBinFile: %v", i+1, err)
+			continue
+		}
+		if err := bin.DecryptBinFile(bin.GetOutputFilename(), assetRet); err != nil {
+			t.Errorf("#%d failed DecryptBinFile: %v", i+1, err)
+		}
+		md5In, _ = cmn.CalculateFileMD5(assetIn)
+		md5Out, _ = cmn.CalculateFileMD5(assetRet)
+		if md5In != md5Out {
+			t.Errorf("#%d round-trip decrypted binary file not the same as input", i+1)
+		}
+		os.Remove(bin.GetOutputFilename())
+		os.Remove(assetRet)
+	}
+}
@@ -358,11 +358,15 @@ func Test_Caesar_RoundTrip(t *testing.T) {
 // @note something odd happening with this, at times it reports the wrong
 // exitCode even though the constant is correct!
 func Test_Caesar_Exit(t *testing.T) {
-	const OUT_PLAIN_FILE = "testdata/text_EN.txt"              // part of the repository!
-	const OUT_CIPHER_FILE_CAE = "testdata/text_EN_txt.cae"     // generated
-	const OUT_DECODED_FILE_CAE = "testdata/text_EN_cae_rt.txt" // generated
-	const OUT_CIPHER_FILE_VIG = "testdata/text_EN_txt.vig"     // generated
-	const OUT_CIPHER_FILE_BEL = "testdata/text_EN_txt.bel"     // generated
+	const OUT_PLAIN_FILE = "testdata/text_EN.txt"                     // part of the repository!
+	const OUT_CIPHER_FILE_CAE = "testdata/text_EN_txt.cae"            // generated
+	const OUT_DECODED_FILE_CAE = "testdata/text_EN_cae_rt.txt"        // generated
+	const OUT_CIPHER_FILE_VIG = "testdata/text_EN_txt.vig"            // generated
+	const OUT_CIPHER_FILE_BEL = "testdata/text_EN_txt.bel"            // generated
+	const OUT_CIPHER_FILE_CAT = "testdata/text_EN_txt.cat"            // generated
+	const OUT_CIPHER_FILE_PNG = "testdata/caesar-silver-coin_png.bel" // generated
+	const OUT_PAD_FILE = "testdata/text_EN_txt.pad"                   // generated
+	const OUT_DECODED_FILE_PNG = "testdata/caesar-silver-coin-rt"     // generated, .png from the header
 
 	// test cases for CLI execution
 	allCases := []struct {
@@ -386,15 +390,37 @@ func Test_Caesar_Exit(t *testing.T) {
 		{"NGram 2", z.EXIT_CODE_SUCCESS, []string{"-ngram", "2", "-key", "L", "'plain text'"}},
 		{"NGram 5", z.EXIT_CODE_SUCCESS, []string{"-ngram", "5", "-key", "L", "'plain text'"}},
 		{"NGram invalid", z.ERR_PARAMETER, []string{"-ngram", "6", "-key", "L", "'plain text'"}}, // @audit 1 got 2
+		// common: transposition
+		{"Transpose Columnar", z.EXIT_CODE_SUCCESS, []string{"-transpose", "ZEBRA", "-ngram", "5", "-key", "L", "'plain text'"}},
+		{"Transpose Double", z.EXIT_CODE_SUCCESS, []string{"-transpose", "ZEBRA,KITE", "-key", "L", "-d", "'cipher text'"}},
+		{"Transpose invalid", z.ERR_CLI_OPTIONS, []string{"-transpose", "Z", "-key", "L", "'plain text'"}},
+		{"Transpose binary", z.ERR_CLI_OPTIONS, []string{"-transpose", "ZEBRA", "-alpha", "binary", "-key", "L", "-F", OUT_PLAIN_FILE}},
+		{"Transpose NGram", z.EXIT_CODE_SUCCESS, []string{"-transpose", "ZEBRA", "-ngram", "5", "-key", "L", "'HELLO WORLD TODAY'"}},
+		{"Untranspose NGram", z.EXIT_CODE_SUCCESS, []string{"-transpose", "ZEBRA", "-ngram", "5", "-key", "L", "-d", "ZOJWC·OPZZW·WLSHE"}},
+		{"Untranspose NGram size", z.ERR_PARAMETER, []string{"-transpose", "ZEBRA", "-ngram", "7", "-key", "L", "-d", "ZOJWC·OPZZW·WLSHE"}},
 		// application: encode cases
 		{"Encode Caesar message", z.EXIT_CODE_SUCCESS, []string{"-key", "L", "'plain text'"}},
 		{"Encode Caesar message missing -key", z.ERR_CLI_OPTIONS, []string{"'plain text'"}},
 		{"Encode Caesar file", z.EXIT_CODE_SUCCESS, []string{"-key", "L", "-F", OUT_PLAIN_FILE}},
+		{"Encode Caesar file verify", z.EXIT_CODE_SUCCESS, []string{"-key", "L", "-verify", "-F", OUT_PLAIN_FILE}},
 		// application: decode cases
 		{"Decode Caesar message", z.EXIT_CODE_SUCCESS, []string{"-key", "L", "-d", "'cipher text'"}},
 		{"Decode Caesar message missing -key", z.ERR_CLI_OPTIONS, []string{"-d", "'plain text'"}},
 		{"Decode Caesar file missing output", z.ERR_PARAMETER, []string{"-key", "L", "-d", "-F", OUT_CIPHER_FILE_CAE}},
 		{"Decode Caesar file", z.EXIT_CODE_SUCCESS, []string{"-key", "L", "-d", "-F", OUT_CIPHER_FILE_CAE, OUT_DECODED_FILE_CAE}},
+		// application: ASCII armor, the variant is taken from the armored message
+		{"Encode armored file", z.EXIT_CODE_SUCCESS, []string{"-armor", "-key", "L", "-F", OUT_PLAIN_FILE}},
+		{"Decode armored file", z.EXIT_CODE_SUCCESS, []string{"-key", "L", "-d", "-F", OUT_CIPHER_FILE_CAE, OUT_DECODED_FILE_CAE}},
+		{"Decode armored wrong cipher", z.ERR_PARAMETER, []string{"-variant", "bellaso", "-secret", "KEY", "-d", "-F", OUT_CIPHER_FILE_CAE, OUT_DECODED_FILE_CAE}},
+		{"Decode armored wrong alphabet", z.ERR_PARAMETER, []string{"-alpha", "greek", "-key", "Λ", "-d", "-F", OUT_CIPHER_FILE_CAE, OUT_DECODED_FILE_CAE}},
+		{"Armor binary", z.ERR_CLI_OPTIONS, []string{"-armor", "-alpha", "binary", "-key", "L", "-F", OUT_PLAIN_FILE}},
+		// application: Caesar modes
+		{"Caesar Extended", z.EXIT_CODE_SUCCESS, []string{"-mode", "extended", "-key", "L", "'plain text 123'"}},
+		{"Caesar Augustus", z.EXIT_CODE_SUCCESS, []string{"-mode", "augustus", "-key", "L", "-offset", "3", "'plain text 123'"}},
+		{"Caesar Tiberius file", z.EXIT_CODE_SUCCESS, []string{"-mode", "tiberius", "-key", "L", "-offset", "40", "-F", OUT_PLAIN_FILE}},
+		{"Caesar Augustus missing -offset", z.ERR_CLI_OPTIONS, []string{"-mode", "augustus", "-key", "L", "'plain text'"}},
+		{"Caesar unknown -mode", z.ERR_CLI_OPTIONS, []string{"-mode", "nero", "-key", "L", "'plain text'"}},
+		{"Didimus with -mode", z.ERR_CLI_OPTIONS, []string{"-variant", "didimus", "-mode", "extended", "-key", "L", "-offset", "3", "'plain text'"}},
 		// application: other abnormal cases
 		{"Redirect -variant affine", z.ERR_CLI_OPTIONS, []string{"-variant", "affine", "'plain text'"}},
 		// other...
@@ -403,10 +429,70 @@ func Test_Caesar_Exit(t *testing.T) {
 		{"Fibonacci", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "fibonacci", "-key", "L", "'plain text'"}},
 		{"Bellaso Message", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "bellaso", "-secret", "PASSWD", "'plain text'"}},
 		{"Bellaso File", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "bellaso", "-secret", "PASSWD", "-F", OUT_PLAIN_FILE}},
+		{"Bellaso File verify", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "bellaso", "-secret", "PASSWD", "-verify", "-F", OUT_PLAIN_FILE}},
 		{"Bellaso missing -secret", z.ERR_CLI_OPTIONS, []string{"-num", "E", "-variant", "bellaso", "-key", "P", "'plain text'"}},
 		{"Vigenere Message", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "vigenere", "-secret", "PASSWD", "'plain text'"}},
 		{"Vigenere missing -secret", z.ERR_CLI_OPTIONS, []string{"-num", "E", "-variant", "vigenere", "-key", "P", "'plain text'"}},
 		{"Vigenere File", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "vigenere", "-secret", "PASSWD", "-F", OUT_PLAIN_FILE}},
+		{"Vigenere File verify", z.EXIT_CODE_SUCCESS, []string{"-num", "E", "-variant", "vigenere", "-secret", "PASSWD", "-verify", "-F", OUT_PLAIN_FILE}},
+		{"Beaufort keyword", z.EXIT_CODE_SUCCESS, []string{"-variant", "beaufort", "-secret", "PASSWD", "-keyword", "ZEBRA", "'plain text'"}},
+		{"Beaufort plaintext keyword", z.ERR_PARAMETER, []string{"-variant", "beaufort", "-secret", "PASSWD", "-keyword", "ZEBRA:HORSE", "'plain text'"}},
+		{"Hill Message", z.EXIT_CODE_SUCCESS, []string{"-variant", "hill", "-secret", "3 3 2 5", "-filler", "QZ", "'plain text'"}},
+		{"Hill singular matrix", z.ERR_PARAMETER, []string{"-variant", "hill", "-secret", "2 4 1 2", "'plain text'"}},
+		{"Hill invalid nulls", z.ERR_PARAMETER, []string{"-variant", "hill", "-secret", "HILL", "-filler", "7", "'plain text'"}},
+		{"Hill binary", z.ERR_CLI_OPTIONS, []string{"-variant", "hill", "-alpha", "binary", "-secret", "HILL", "-F", OUT_PLAIN_FILE}},
+		{"Polybius Message", z.EXIT_CODE_SUCCESS, []string{"-variant", "polybius", "-labels", "ADFGX", "'plain text'"}},
+		{"ADFGVX Message", z.EXIT_CODE_SUCCESS, []string{"-num", "A", "-variant", "adfgvx", "-secret", "KEY", "-transpose", "CARGO", "'plain text'"}},
+		{"ADFGVX missing -transpose", z.ERR_CLI_OPTIONS, []string{"-variant", "adfgvx", "-secret", "KEY", "'plain text'"}},
+		{"Bifid Message", z.EXIT_CODE_SUCCESS, []string{"-variant", "bifid", "-period", "5", "'plain text'"}},
+		{"Bifid negative -period", z.ERR_CLI_OPTIONS, []string{"-variant", "bifid", "-period", "-1", "'plain text'"}},
+		{"Enigma Message", z.EXIT_CODE_SUCCESS, []string{"-variant", "enigma", "-secret", "B I-II-III 01-12-22 ABC AV BS", "'plain text'"}},
+		{"Enigma unknown rotor", z.ERR_PARAMETER, []string{"-variant", "enigma", "-secret", "B I-II-IX", "'plain text'"}},
+		{"Enigma with -num", z.ERR_CLI_OPTIONS, []string{"-num", "A", "-variant", "enigma", "-secret", "B I-II-III", "'plain text'"}},
+		// sub-commands
+		{"Stats message", z.EXIT_CODE_SUCCESS, []string{"stats", "-alpha", "german", "'Daß liebe hübschen Mädchen'"}},
+		{"Stats file", z.EXIT_CODE_SUCCESS, []string{"stats", "-F", OUT_PLAIN_FILE}},
+		{"Stats missing text", z.ERR_PARAMETER, []string{"stats"}},
+		{"Stats binary", z.ERR_PARAMETER, []string{"stats", "-alpha", "binary", "-F", OUT_PLAIN_FILE}},
+		{"Crack Caesar", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "caesar", "-top", "3", "'Wkh vhfuhw phhwlqj lv dw qrrq'"}},
+		{"Crack Didimus auto", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "didimus", "-alpha", "auto", "'Khdomy kh nogb'"}},
+		{"Crack Fibonacci file", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "fibonacci", "-F", OUT_PLAIN_FILE}},
+		{"Crack Bellaso", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "bellaso", "Elq grnvqh zpifwar me og ysab aped hup sxr ocmpur"}},
+		{"Crack Vigenere", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "vigenere", "-num", "E", "Dlc$zicjiv qxefmrz1vy3il3nhoa7brae+tye$vpd7muiexm"}},
+		{"Crack Vigenere short", z.ERR_PARAMETER, []string{"crack", "-variant", "vigenere", "'K'"}},
+		{"Crack Hill", z.ERR_PARAMETER, []string{"crack", "-variant", "hill", "'Khdomy kh nogb'"}},
+		{"Crack Caesar extended", z.ERR_PARAMETER, []string{"crack", "-variant", "caesar", "-mode", "extended", "'Khdomy kh nogb'"}},
+		{"Crack top zero", z.ERR_PARAMETER, []string{"crack", "-top", "0", "'Khdomy kh nogb'"}},
+		{"Crack crib Caesar", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "caesar", "-crib", "secret", "'Wkh vhfuhw phhwlqj lv dw qrrq'"}},
+		{"Crack crib Bellaso at", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "bellaso", "-crib", "meeting", "-at", "11", "Elq grnvqh zpifwar me og ysab aped hup sxr ocmpur"}},
+		{"Crack crib not found", z.ERR_PARAMETER, []string{"crack", "-variant", "caesar", "-crib", "zzzzzz", "'Wkh vhfuhw phhwlqj lv dw qrrq'"}},
+		{"Crack crib Vigenere", z.ERR_PARAMETER, []string{"crack", "-variant", "vigenere", "-crib", "secret", "'Wkh vhfuhw'"}},
+		{"Crack at alone", z.ERR_PARAMETER, []string{"crack", "-at", "3", "'Wkh vhfuhw'"}},
+		{"Encode binary Bellaso", z.EXIT_CODE_SUCCESS, []string{"-variant", "bellaso", "-alpha", "binary", "-secret", "KEY", "-F", "testdata/caesar-silver-coin.png"}},
+		{"Crack binary Bellaso", z.EXIT_CODE_SUCCESS, []string{"crack", "-variant", "bellaso", "-alpha", "binary", "-F", OUT_CIPHER_FILE_PNG}},
+		{"Crack binary Vigenere", z.ERR_PARAMETER, []string{"crack", "-variant", "vigenere", "-alpha", "binary", "-F", OUT_CIPHER_FILE_PNG}},
+		{"Crack binary text", z.ERR_PARAMETER, []string{"crack", "-alpha", "binary", "-F", OUT_PLAIN_FILE}},
+		{"Decode binary header", z.EXIT_CODE_SUCCESS, []string{"-alpha", "binary", "-secret", "KEY", "-d", "-F", OUT_CIPHER_FILE_PNG, OUT_DECODED_FILE_PNG}},
+		{"Decode binary wrong key", z.ERR_CIPHER, []string{"-alpha", "binary", "-secret", "KEYS", "-d", "-F", OUT_CIPHER_FILE_PNG, OUT_DECODED_FILE_PNG}},
+		{"Decode binary wrong cipher", z.ERR_PARAMETER, []string{"-variant", "vigenere", "-alpha", "binary", "-secret", "KEY", "-d", "-F", OUT_CIPHER_FILE_PNG, OUT_DECODED_FILE_PNG}},
+		{"Decoy binary header", z.ERR_PARAMETER, []string{"decoy", "-variant", "runningkey", "-alpha", "binary", "-decoy", "testdata/caesar-silver-coin.png", "-keyfile", OUT_PAD_FILE, "-F", OUT_CIPHER_FILE_PNG}},
+		{"Strength Caesar", z.EXIT_CODE_SUCCESS, []string{"strength", "-variant", "caesar", "-key", "A"}},
+		{"Strength Bellaso file", z.EXIT_CODE_SUCCESS, []string{"strength", "-variant", "bellaso", "-secret", "KEY", "-F", OUT_PLAIN_FILE}},
+		{"Strength Vigenere", z.EXIT_CODE_SUCCESS, []string{"strength", "-variant", "vigenere", "-secret", "secret", "'Attack at dawn'"}},
+		{"Strength no key", z.ERR_CLI_OPTIONS, []string{"strength", "-variant", "didimus", "-key", "K"}},
+		{"Strength Hill", z.ERR_PARAMETER, []string{"strength", "-variant", "hill", "-secret", "HILL"}},
+		{"Decoy Bellaso", z.EXIT_CODE_SUCCESS, []string{"decoy", "-variant", "bellaso", "-decoy", "Call me by the new castle at 10 tonight", "Xiqh zp ef hup sxr ocmpur lx 10 fcatkth"}},
+		{"Decoy digits without -num", z.ERR_PARAMETER, []string{"decoy", "-variant", "bellaso", "-decoy", "Call me by the new castle at 12 tonight", "Xiqh zp ef hup sxr ocmpur lx 10 fcatkth"}},
+		{"Decoy length", z.ERR_PARAMETER, []string{"decoy", "-variant", "bellaso", "-decoy", "Call me", "'Xiqh zp ef hup sxr ocmpur lx 10 fcatkth'"}},
+		{"Decoy missing", z.ERR_CLI_OPTIONS, []string{"decoy", "-variant", "bellaso", "'Xiqh zp ef hup sxr ocmpur lx 10 fcatkth'"}},
+		{"Decoy Vigenere", z.ERR_PARAMETER, []string{"decoy", "-variant", "vigenere", "-decoy", "Call me", "'Xiqh zp'"}},
+		{"Decoy binary pad", z.EXIT_CODE_SUCCESS, []string{"decoy", "-variant", "runningkey", "-alpha", "binary", "-decoy", OUT_PLAIN_FILE, "-keyfile", OUT_PAD_FILE, "-F", OUT_PLAIN_FILE}},
+		{"Decoy binary size", z.ERR_PARAMETER, []string{"decoy", "-variant", "runningkey", "-alpha", "binary", "-decoy", "testdata/ascii.bin", "-keyfile", OUT_PAD_FILE, "-F", OUT_PLAIN_FILE}},
+		{"Decoy binary no pad", z.ERR_PARAMETER, []string{"decoy", "-variant", "runningkey", "-alpha", "binary", "-decoy", OUT_PLAIN_FILE, "-F", OUT_PLAIN_FILE}},
+		{"Identify Caesar", z.EXIT_CODE_SUCCESS, []string{"identify", "-top", "2", "'Wkh vhfuhw phhwlqj lv dw qrrq dqg zh zloo eulqj wkh pdsv'"}},
+		{"Identify file", z.EXIT_CODE_SUCCESS, []string{"identify", "-F", OUT_PLAIN_FILE}},
+		{"Identify punctuation", z.ERR_PARAMETER, []string{"identify", "'¡! ¿?'"}},
+		{"Identify missing text", z.ERR_PARAMETER, []string{"identify"}},
 	}
 
 	// @note We set this on go.yml so that this test is SKIPPED on GitHub servers
@@ -437,6 +523,29 @@ func Test_Caesar_Exit(t *testing.T) {
 	os.Remove(OUT_DECODED_FILE_CAE)
 	os.Remove(OUT_CIPHER_FILE_VIG)
 	os.Remove(OUT_CIPHER_FILE_BEL)
+	os.Remove(OUT_CIPHER_FILE_CAT)
+	os.Remove(OUT_CIPHER_FILE_PNG)
+	os.Remove(OUT_DECODED_FILE_PNG + ".png")
+	os.Remove(OUT_PAD_FILE)
+}
+
+// every usage entry of -help is on its own line
+func Test_Caesar_Usage(t *testing.T) {
+	if os.Getenv("GITHUBLOS") != "" {
+		t.Skip("Skipping working test due to missing executable")
+	}
+
+	application := getCaesarExecutable(t, "caesarx")
+	output, err := exec.Command(application, "-help").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(string(output), "\n") {
+		if _, after, found := strings.Cut(line, "'user text'"); found && strings.TrimSpace(after) != "" && !strings.HasPrefix(strings.TrimSpace(after), "|") {
+			t.Errorf("run-together usage line: %q", line)
+		}
+	}
 }
 
 func getAssetFilename(t *testing.T, where, asset string) string {
@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"errors"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const RUNNINGKEY_BOOK string = `A Tale of Two Cities
+CHAPTER I
+It was the best of times, it was the worst of times,
+it was the age of wisdom, it was the age of foolishness.
+CHAPTER II
+There were a king with a large jaw and a queen with a plain face.
+`
+
+/**
+ * Sequencer: Running Key.
+ * Type : Book offset parsing of N, L:N & C:L:N
+ */
+func Test_RunningKey_ParseBookOffset(t *testing.T) {
+	allCases := []struct {
+		Offset   string
+		Expected crypto.BookOffset
+		IsValid  bool
+	}{
+		{"", crypto.BookOffset{}, true},
+		{"12", crypto.BookOffset{Char: 12}, true},
+		{"3:12", crypto.BookOffset{Line: 3, Char: 12}, true},
+		{"2:3:12", crypto.BookOffset{Chapter: 2, Line: 3, Char: 12}, true},
+		{"1:2:3:4", crypto.BookOffset{}, false},
+		{"1:-2", crypto.BookOffset{}, false},
+		{"one", crypto.BookOffset{}, false},
+	}
+
+	for i, tc := range allCases {
+		got, err := crypto.ParseBookOffset(tc.Offset)
+		if tc.IsValid && (err != nil || got != tc.Expected) {
+			t.Errorf("#%d '%s' exp: %v got: %v (%v)", i+1, tc.Offset, tc.Expected, got, err)
+		}
+		if !tc.IsValid && !errors.Is(err, crypto.ErrBookOffset) {
+			t.Errorf("#%d '%s' expected an invalid offset error, got %v", i+1, tc.Offset, err)
+		}
+	}
+}
+
+/**
+ * Cipher: Running Key.
+ * Languages: English (ASCII).
+ * Type : Equivalence. The key runes come from the given offset of the
+ *		  book, ignoring punctuation & spaces, hence the result must be
+ *		  that of Bellaso with a secret as long as the message.
+ */
+func Test_RunningKey_BookOffsets(t *testing.T) {
+	const PLAIN string = "Attack at dawn, 1984!"
+	allCases := []struct {
+		Offset string
+		Secret string // expected key stream
+	}{
+		{"0", "ATALEOFTWOCITIESCHAP"},
+		{"1:0:0", "ITWASTHEBESTOFTIMESI"},
+		{"1:1:7", "THEAGEOFWISDOMITWAST"},
+		{"2:0:6", "WEREAKINGWITHALARGEJ"},
+	}
+
+	for i, tc := range allCases {
+		at, _ := crypto.ParseBookOffset(tc.Offset)
+		book, err := crypto.NewRunningKeySequencerFromReader(strings.NewReader(RUNNINGKEY_BOOK), "tale.txt", at, cmn.ALPHA_DISK)
+		if err != nil {
+			t.Fatalf("#%d unexpected book error: %v", i+1, err)
+		}
+
+		rkey := commands.NewRunningKeyCommand(cmn.ALPHA_DISK, book)
+		bel := commands.NewBellasoCommand(cmn.ALPHA_DISK, tc.Secret)
+		expected, _ := bel.Encode(PLAIN)
+		if got, err := rkey.Encode(PLAIN); err != nil || got != expected {
+			t.Errorf("#%d @%s Encode fail\n\texp: '%s'\n\tgot: '%s' (%v)", i+1, tc.Offset, expected, got, err)
+		} else if plain, _ := rkey.Decode(got); plain != PLAIN {
+			t.Errorf("#%d @%s Decode fail\n\texp: '%s'\n\tgot: '%s'", i+1, tc.Offset, PLAIN, plain)
+		}
+	}
+
+	// offsets beyond the book
+	for _, offset := range []string{"3:0:0", "1:9:0", "0:0:9999"} {
+		at, _ := crypto.ParseBookOffset(offset)
+		if _, err := crypto.NewRunningKeySequencerFromReader(strings.NewReader(RUNNINGKEY_BOOK), "tale.txt", at, cmn.ALPHA_DISK); !errors.Is(err, crypto.ErrBookOffset) {
+			t.Errorf("@%s expected an invalid offset error, got %v", offset, err)
+		}
+	}
+}
+
+/**
+ * Cipher: Running Key.
+ * Languages: all built-in language alphabets.
+ * Type : Round-trip using a CaesarTabulaRecta with the sequencer. The
+ *		  book is made of the alphabet itself mixed with foreign runes
+ *		  which must be skipped.
+ */
+func Test_RunningKey_RoundTrip(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		book := strings.Repeat("12 @ "+strings.ToLower(alpha.Chars)+"\n", 3)
+		plain := alpha.Chars + " 1984 @ " + strings.ToLower(alpha.Chars) + "!"
+
+		seq, err := crypto.NewRunningKeySequencerFromReader(strings.NewReader(book), alpha.Name, crypto.BookOffset{}, alpha)
+		if err != nil {
+			t.Fatalf("«%s» unexpected book error: %v", alpha.Name, err)
+		}
+		if seq.Capacity() != 3*int(alpha.Size()) {
+			t.Errorf("«%s» foreign runes in key stream, capacity %d", alpha.Name, seq.Capacity())
+		}
+
+		ctr := caesar.NewCaesarTabulaRecta(alpha, alpha.GetRuneAt(0))
+		ctr.WithSequencer(seq)
+		if err := ctr.VerifyKey(); err != nil {
+			t.Fatalf("«%s» unexpected key error: %v", alpha.Name, err)
+		}
+
+		cipher := ctr.Encode(plain)
+		if decoded := ctr.Decode(cipher); decoded != plain {
+			t.Errorf("«%s» Decode fail\n\texp: '%s'\n\tgot: '%s'", alpha.Name, plain, decoded)
+		}
+		if seq.Err() != nil {
+			t.Errorf("«%s» unexpected sequencer error: %v", alpha.Name, seq.Err())
+		}
+	}
+}
+
+/**
+ * Cipher: Running Key.
+ * Languages: English (ASCII).
+ * Type : Key exhaustion. A message longer than the key text is refused
+ *		  but trailing runes that are not converted need no key.
+ */
+func Test_RunningKey_Exhausted(t *testing.T) {
+	book, _ := crypto.NewRunningKeySequencerFromReader(strings.NewReader("a-b-c-d-e"), "abcde.txt", crypto.BookOffset{}, cmn.ALPHA_DISK)
+	rkey := commands.NewRunningKeyCommand(cmn.ALPHA_DISK, book)
+
+	if got, err := rkey.Encode("Hello."); err != nil || got != "Hfnos." {
+		t.Errorf("unexpected result '%s' error: %v", got, err)
+	}
+
+	if got, err := rkey.Encode("Hello World"); !errors.Is(err, crypto.ErrRunningKeyExhausted) || got != "" {
+		t.Errorf("expected exhaustion error, got '%s' error: %v", got, err)
+	}
+
+	// bypassing the command the sequencer reports the problem afterwards
+	ctr := caesar.NewCaesarTabulaRecta(cmn.ALPHA_DISK, 'A')
+	ctr.WithSequencer(book)
+	ctr.Encode("Hello World")
+	if !errors.Is(book.Err(), crypto.ErrRunningKeyExhausted) {
+		t.Errorf("expected sequencer exhaustion error, got: %v", book.Err())
+	}
+	ctr.Encode("Hello")
+	if book.Err() != nil {
+		t.Errorf("sequencer error should be cleared, got: %v", book.Err())
+	}
+}
+
+// Tests text file Running Key encryption with round-trip. The key
+// stream continues across lines.
+func Test_RunningKeyCmd_EncryptTextFile(t *testing.T) {
+	FILE_IN := "/tmp/test_runningkey.txt"
+	FILE_OUT := cmn.NewNameExtOnly(FILE_IN, commands.FILE_EXT_RUNNINGKEY, true)
+	FILE_RET := "/tmp/test_runningkey_rt.txt"
+	os.WriteFile(FILE_IN, []byte("I love\ncryptography\n"), 0644)
+	defer os.Remove(FILE_IN)
+
+	at := crypto.BookOffset{Chapter: 1}
+	book, err := crypto.NewRunningKeySequencerFromReader(strings.NewReader(RUNNINGKEY_BOOK), "tale.txt", at, cmn.ALPHA_DISK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctr := commands.NewRunningKeyCommand(cmn.ALPHA_DISK, book)
+	if err = ctr.EncryptTextFile(FILE_IN); err != nil {
+		t.Errorf("failed EncryptTextFile: %v", err)
+	}
+	defer os.Remove(FILE_OUT)
+
+	bel := commands.NewBellasoCommand(cmn.ALPHA_DISK, "ITWASTHEBESTOFTIMESI")
+	expected, _ := bel.Encode("I lovecryptography")
+	if data, _ := os.ReadFile(FILE_OUT); strings.ReplaceAll(string(data), "\n", "") != expected {
+		t.Errorf("key stream does not continue across lines\n\texp: '%s'\n\tgot: '%s'", expected, data)
+	}
+
+	if err = ctr.DecryptTextFile(FILE_OUT, FILE_RET); err != nil {
+		t.Errorf("failed DecryptTextFile: %v", err)
+	}
+	defer os.Remove(FILE_RET)
+
+	md5In, _ := cmn.CalculateFileMD5(FILE_IN)
+	md5Out, _ := cmn.CalculateFileMD5(FILE_RET)
+	if md5In != md5Out {
+		t.Errorf("round-trip decrypted file not the same as input. %s vs %s", md5In, md5Out)
+	}
+}
+
+// A text file with a line longer than 64 KiB: the key length check
+// reads whole lines of any length.
+func Test_RunningKeyCmd_LongLine(t *testing.T) {
+	dir := t.TempDir()
+	fileIn := filepath.Join(dir, "long.txt")
+	fileRet := filepath.Join(dir, "long_rt.txt")
+	os.WriteFile(fileIn, []byte(strings.Repeat("very long line ", 5000)+"\nthe end\n"), 0644)
+
+	for _, tc := range []struct {
+		BookSize int
+		Err      error
+	}{
+		{80000, nil},
+		{60000, crypto.ErrRunningKeyExhausted},
+	} {
+		book, _ := crypto.NewRunningKeySequencerFromReader(strings.NewReader(strings.Repeat("TALE", tc.BookSize/4)), "tale.txt", crypto.BookOffset{}, cmn.ALPHA_DISK)
+		ctr := commands.NewRunningKeyCommand(cmn.ALPHA_DISK, book)
+		if err := ctr.EncryptTextFile(fileIn); !errors.Is(err, tc.Err) {
+			t.Fatalf("book of %d exp: %v got: %v", tc.BookSize, tc.Err, err)
+		}
+		if tc.Err != nil {
+			continue
+		}
+
+		if err := ctr.DecryptTextFile(ctr.GetOutputFilename(), fileRet); err != nil {
+			t.Fatalf("failed DecryptTextFile: %v", err)
+		}
+		md5In, _ := cmn.CalculateFileMD5(fileIn)
+		md5Out, _ := cmn.CalculateFileMD5(fileRet)
+		if md5In != md5Out {
+			t.Errorf("round-trip decrypted file not the same as input. %s vs %s", md5In, md5Out)
+		}
+	}
+}
+
+// Tests Running Key round-trip encryption of a BINARY FILE where the
+// book is another binary file. A book that is too short is refused
+// before the output file is created.
+func Test_RunningKeyCmd_EncryptBinFile(t *testing.T) {
+	assetIn := getAssetFilename(t, TEST_ASSETS, "ascii.bin")
+	assetRet := getAssetFilename(t, TEST_ASSETS, "ascii-RK-ret.bin")
+
+	ctr, err := commands.NewRunningKeyCommandFromFile(cmn.BINARY_DISK, getAssetFilename(t, TEST_ASSETS, "caesar-silver-coin.png"), "100")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = ctr.EncryptBinFile(assetIn); err != nil {
+		t.Errorf("failed EncryptBinFile: %v", err)
+	}
+	assetOut := ctr.GetOutputFilename()
+	if err = ctr.DecryptBinFile(assetOut, assetRet); err != nil {
+		t.Errorf("failed DecryptBinFile: %v", err)
+	}
+
+	md5In, _ := cmn.CalculateFileMD5(assetIn)
+	md5Out, _ := cmn.CalculateFileMD5(assetRet)
+	if md5In != md5Out {
+		t.Errorf("round-trip decrypted file not the same as input. %s vs %s", md5In, md5Out)
+	}
+
+	os.Remove(assetOut)
+	os.Remove(assetRet)
+
+	short, _ := commands.NewRunningKeyCommandFromFile(cmn.BINARY_DISK, getAssetFilename(t, TEST_ASSETS, "input.bin"), "0")
+	if err = short.EncryptBinFile(assetIn); !errors.Is(err, crypto.ErrRunningKeyExhausted) {
+		t.Errorf("expected exhaustion error, got: %v", err)
+	}
+	if _, errS := os.Stat(cmn.NewNameExtOnly(assetIn, commands.FILE_EXT_RUNNINGKEY, true)); errS == nil {
+		t.Errorf("no output file expected when the key is too short")
+	}
+}
@@ -40,6 +40,8 @@ func Test_VigenereCmd_RoundTrip(t *testing.T) {
 		{cmn.ALPHA_DISK_GREEK, "τηνκρυπ", "Λατρεύω την κρυπτογραφία", "Εηηβφύτ8εηη9ξπυκαγγβρπίτ"},
 		{cmn.ALPHA_DISK_CYRILLIC, "кр", "Я люблю криптографию", "Й кюмйя5ируаыюхягеит"},
 		{cmn.ALPHA_DISK_CYRILLIC, "кфюЖ", "Я люблю криптографию", "Й+йеалй7льжпэялатгло"},
+		// more skipped runes than auto-key letters
+		{cmn.ALPHA_DISK, "Key", "Hi! Oh, no... yes?!", "Rm!0Vp,7uo...0ycw?!"},
 	}
 
 	defer func() {
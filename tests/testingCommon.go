@@ -13,6 +13,49 @@ var (
 		cmn.ALPHA_DISK_GREEK,
 		cmn.ALPHA_DISK_CYRILLIC,
 	}
+
+	BuiltinAlphabets = append(AllAlphabets, // every built-in language alphabet
+		cmn.ALPHA_DISK_ITALIAN,
+		cmn.ALPHA_DISK_PORTUGUESE,
+		cmn.ALPHA_DISK_CZECH,
+	)
+
+	// a paragraph of plain text per built-in language (by ISO code) for
+	// the frequency analysis & cryptanalysis tests
+	SampleTexts = map[string]string{
+		cmn.ISO_EN: "It was the best of times, it was the worst of times, it was the age of wisdom, " +
+			"it was the age of foolishness, it was the epoch of belief, it was the epoch of incredulity, " +
+			"it was the season of Light, it was the season of Darkness, it was the spring of hope, " +
+			"it was the winter of despair, we had everything before us, we had nothing before us.",
+		cmn.ISO_ES: "En un lugar de la Mancha, de cuyo nombre no quiero acordarme, no ha mucho tiempo " +
+			"que vivía un hidalgo de los de lanza en astillero, adarga antigua, rocín flaco y galgo corredor. " +
+			"Una olla de algo más vaca que carnero, salpicón las más noches, duelos y quebrantos los sábados, " +
+			"lentejas los viernes, algún palomino de añadidura los domingos, consumían las tres partes de su hacienda.",
+		cmn.ISO_IT: "Quel ramo del lago di Como, che volge a mezzogiorno, tra due catene non interrotte di monti, " +
+			"tutto a seni e a golfi, a seconda dello sporgere e del rientrare di quelli, vien, quasi a un tratto, " +
+			"a ristringersi, e a prender corso e figura di fiume, tra un promontorio a destra, e un'ampia costiera " +
+			"dall'altra parte; e il ponte, che ivi congiunge le due rive, par che renda ancor più sensibile all'occhio questa trasformazione.",
+		cmn.ISO_PT: "Não te deixes vencer pelo desânimo. A vida é feita de pequenas vitórias, e cada dia traz " +
+			"uma nova oportunidade para aprender, para crescer e para ajudar os outros. Quem tem um coração " +
+			"generoso encontra sempre um caminho, mesmo quando a estrada parece longa e difícil, porque a " +
+			"esperança é a última coisa que se perde e a amizade verdadeira não conhece distâncias.",
+		cmn.ISO_DE: "Als Gregor Samsa eines Morgens aus unruhigen Träumen erwachte, fand er sich in seinem Bett " +
+			"zu einem ungeheueren Ungeziefer verwandelt. Er lag auf seinem panzerartig harten Rücken und sah, " +
+			"wenn er den Kopf ein wenig hob, seinen gewölbten, braunen, von bogenförmigen Versteifungen geteilten " +
+			"Bauch, auf dessen Höhe sich die Bettdecke, zum gänzlichen Niedergleiten bereit, kaum noch erhalten konnte.",
+		cmn.ISO_GR: "ΕΝ ΑΡΧΗ ΗΝ Ο ΛΟΓΟΣ ΚΑΙ Ο ΛΟΓΟΣ ΗΝ ΠΡΟΣ ΤΟΝ ΘΕΟΝ ΚΑΙ ΘΕΟΣ ΗΝ Ο ΛΟΓΟΣ ΟΥΤΟΣ ΗΝ ΕΝ ΑΡΧΗ " +
+			"ΠΡΟΣ ΤΟΝ ΘΕΟΝ ΠΑΝΤΑ ΔΙ ΑΥΤΟΥ ΕΓΕΝΕΤΟ ΚΑΙ ΧΩΡΙΣ ΑΥΤΟΥ ΕΓΕΝΕΤΟ ΟΥΔΕ ΕΝ Ο ΓΕΓΟΝΕΝ ΕΝ ΑΥΤΩ ΖΩΗ " +
+			"ΗΝ ΚΑΙ Η ΖΩΗ ΗΝ ΤΟ ΦΩΣ ΤΩΝ ΑΝΘΡΩΠΩΝ ΚΑΙ ΤΟ ΦΩΣ ΕΝ ΤΗ ΣΚΟΤΙΑ ΦΑΙΝΕΙ ΚΑΙ Η ΣΚΟΤΙΑ ΑΥΤΟ ΟΥ " +
+			"ΚΑΤΕΛΑΒΕΝ ΕΓΕΝΕΤΟ ΑΝΘΡΩΠΟΣ ΑΠΕΣΤΑΛΜΕΝΟΣ ΠΑΡΑ ΘΕΟΥ ΟΝΟΜΑ ΑΥΤΩ ΙΩΑΝΝΗΣ",
+		cmn.ISO_RU: "Все счастливые семьи похожи друг на друга, каждая несчастливая семья несчастлива по-своему. " +
+			"Все смешалось в доме Облонских. Жена узнала, что муж был в связи с бывшею в их доме " +
+			"француженкою-гувернанткой, и объявила мужу, что не может жить с ним в одном доме. Положение это " +
+			"продолжалось уже третий день и мучительно чувствовалось и самими супругами, и всеми членами семьи.",
+		cmn.ISO_CZ: "Byl pozdní večer, první máj, večerní máj, byl lásky čas. Hrdliččin zval ku lásce hlas, " +
+			"kde borový zaváněl háj. O lásce šeptal tichý mech, květoucí strom lhal lásky žel, svou lásku " +
+			"slavík růži pěl, růžinu jevil vonný vzdech. Jezero hladké v křovích stinných zvučelo temně " +
+			"tajný bol, břeh je objímal kol a kol, a slunce jasná světů jiných bloudila blankytnou dálí.",
+	}
 )
 
 func IsEnglish(a *cmn.Alphabet) bool {
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/affine"
+	"lordofscripts/caesarx/ciphers/beaufort"
+	"lordofscripts/caesarx/ciphers/bellaso"
+	"lordofscripts/caesarx/ciphers/caesar"
+	"lordofscripts/caesarx/ciphers/enigma"
+	"lordofscripts/caesarx/ciphers/hill"
+	"lordofscripts/caesarx/ciphers/playfair"
+	"lordofscripts/caesarx/ciphers/polybius"
+	"lordofscripts/caesarx/ciphers/runningkey"
+	"lordofscripts/caesarx/ciphers/vigenere"
+	"lordofscripts/caesarx/cmn"
+	"lordofscripts/caesarx/internal/crypto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/**
+ * Package: ciphers (text files)
+ * Languages: English, Spanish
+ * Type : decrypt(encrypt(file)) is byte-identical to the file: CRLF
+ *		  line breaks, lines longer than 64 KiB & no final line break.
+ *		  The block ciphers keep only the letters (uppercase & padded),
+ *		  for them the file layout & the lines are checked instead.
+ *		  The text files of v1 still decrypt.
+ */
+
+// a text file that used to break the round trip of text files
+func textFileFixture(t *testing.T) string {
+	plain := "Años amé la criptografía, ¡y mañana más!\r\n" +
+		"\r\n" +
+		strings.Repeat("a very long line ", 5000) + "\r\n" +
+		"Veni, vidi, vici.\n" +
+		"no final line break"
+
+	filename := filepath.Join(t.TempDir(), "plain.txt")
+	if err := os.WriteFile(filename, []byte(plain), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// the text file methods of every cipher, Affine included
+type iTextFileCipher interface {
+	EncryptTextFile(input, output string) error
+	DecryptTextFile(input, output string) error
+}
+
+// the ciphers that convert whole lines
+type iBlockTextFileCipher interface {
+	iTextFileCipher
+	Encode(string) (string, error)
+	Decode(string) (string, error)
+}
+
+func Test_TextFile_RoundTrip(t *testing.T) {
+	input := textFileFixture(t)
+	original, _ := os.ReadFile(input)
+
+	// the book has a key rune for every letter of the file
+	book, err := crypto.NewRunningKeySequencerFromReader(strings.NewReader(strings.Repeat(RUNNINGKEY_BOOK, 1000)), "tale.txt", crypto.BookOffset{}, cmn.ALPHA_DISK_LATIN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings, _ := enigma.ParseSettings("B I-II-III 01-12-22 ABC AV BS")
+	machine, err := enigma.NewEnigmaCrypto(cmn.ALPHA_DISK, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alpha := cmn.ALPHA_DISK_LATIN
+	for _, cipher := range []iTextFileCipher{
+		caesar.NewCaesarTabulaRecta(alpha, 'M'),
+		caesar.NewDidimusTabulaRecta(alpha, 'M', 5),
+		caesar.NewFibonacciTabulaRecta(alpha, 'M'),
+		bellaso.NewBellasoTabulaRecta(alpha, "Llave"),
+		vigenere.NewVigenereTabulaRecta(alpha, "Llave"),
+		beaufort.NewBeaufortTabulaRecta(alpha, "Llave"),
+		beaufort.NewVariantBeaufortTabulaRecta(alpha, "Llave"),
+		affine.NewAffineCrypto(cmn.ALPHA_DISK, validAffineParamsEN),
+		runningkey.NewRunningKeyTabulaRecta(alpha, book),
+		machine,
+	} {
+		ciphered := input + ".enc"
+		plain := input + ".dec"
+		if err := cipher.EncryptTextFile(input, ciphered); err != nil {
+			t.Fatalf("%s encrypt: %v", cipher, err)
+		}
+		if err := cipher.DecryptTextFile(ciphered, plain); err != nil {
+			t.Fatalf("%s decrypt: %v", cipher, err)
+		}
+
+		result, _ := os.ReadFile(plain)
+		if !bytes.Equal(result, original) {
+			t.Errorf("%s the round trip isn't byte-identical (%d vs %d bytes)", cipher, len(result), len(original))
+		}
+	}
+}
+
+// The text files of v1 (each line started the key positions over)
+// still decrypt, and new ones are the same.
+func Test_TextFile_Legacy(t *testing.T) {
+	const PLAIN = "Hello world, this is line one.\nRedondo dice hola 2025!\nThird line here.\n"
+	var allCases = []struct {
+		Cipher iTextFileCipher
+		Legacy string
+	}{
+		// made by the v1 application, without numbers (-num N)
+		{caesar.NewFibonacciTabulaRecta(cmn.ALPHA_DISK, 'L').WithChain(nil), "Sqxyc pmxeo, gvyl ol xuas hlk.\nCqpbbth jbnq ucbt 2025!\nGvykb etzq vukc.\n"},
+		{caesar.NewDidimusTabulaRecta(cmn.ALPHA_DISK, 'L', 5).WithChain(nil), "Suwbz mzhwt, exti ti wyyu zdp.\nHptzdoe oynu sewq 2025!\nJsyct wyyu sucu.\n"},
+	}
+
+	dir := t.TempDir()
+	plainFile := filepath.Join(dir, "plain.txt")
+	legacyFile := filepath.Join(dir, "legacy.txt")
+	os.WriteFile(plainFile, []byte(PLAIN), 0644)
+	for i, tc := range allCases {
+		os.WriteFile(legacyFile, []byte(tc.Legacy), 0644)
+		if err := tc.Cipher.DecryptTextFile(legacyFile, legacyFile+".dec"); err != nil {
+			t.Fatalf("#%d %s decrypt: %v", i+1, tc.Cipher, err)
+		}
+		if got, _ := os.ReadFile(legacyFile + ".dec"); string(got) != PLAIN {
+			t.Errorf("#%d %s legacy file\n\texp: %q\n\tgot: %q", i+1, tc.Cipher, PLAIN, got)
+		}
+
+		if err := tc.Cipher.EncryptTextFile(plainFile, plainFile+".enc"); err != nil {
+			t.Fatalf("#%d %s encrypt: %v", i+1, tc.Cipher, err)
+		}
+		if got, _ := os.ReadFile(plainFile + ".enc"); string(got) != tc.Legacy {
+			t.Errorf("#%d %s encrypted file\n\texp: %q\n\tgot: %q", i+1, tc.Cipher, tc.Legacy, got)
+		}
+	}
+}
+
+// Playfair, Hill, Polybius, Bifid & ADFGVX can't keep the runes that
+// are not letters, nor the case, and pad the blocks: a file can't come
+// back byte-identical. They keep the layout, every line comes back as
+// the cipher converts it on its own.
+func Test_TextFile_LineBreaks(t *testing.T) {
+	input := textFileFixture(t)
+	original, _ := os.ReadFile(input)
+	ciphered := input + ".enc"
+	plain := input + ".dec"
+
+	alpha := cmn.ALPHA_DISK
+	playfairCipher, err1 := playfair.NewPlayfairCrypto(alpha, "MONARCHY")
+	hillCipher, err2 := hill.NewHillCrypto(alpha, "GYBNQKURP")
+	polybiusCipher, err3 := polybius.NewPolybiusCrypto(alpha, "ZEBRAS", "")
+	bifidCipher, err4 := polybius.NewBifidCrypto(alpha, "ZEBRAS", 5)
+	adfgvxCipher, err5 := polybius.NewAdfgvxCrypto(alpha, "ZEBRAS", "CARGO")
+	if err := errors.Join(err1, err2, err3, err4, err5); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cipher := range []iBlockTextFileCipher{playfairCipher, hillCipher, polybiusCipher, bifidCipher, adfgvxCipher} {
+		if err := cipher.EncryptTextFile(input, ciphered); err != nil {
+			t.Fatalf("%s encrypt: %v", cipher, err)
+		}
+		if err := cipher.DecryptTextFile(ciphered, plain); err != nil {
+			t.Fatalf("%s decrypt: %v", cipher, err)
+		}
+
+		encoded, _ := os.ReadFile(ciphered)
+		lines := strings.SplitAfter(string(encoded), "\n")
+		if len(lines) != 5 {
+			t.Fatalf("%s exp: 5 lines got: %d", cipher, len(lines))
+		}
+		for i, eol := range []string{"\r\n", "\r\n", "\r\n", "\n", ""} {
+			if _, got := ciphers.SplitLineBreak(lines[i]); got != eol {
+				t.Errorf("%s line #%d exp: %q got: %q", cipher, i+1, eol, got)
+			}
+		}
+
+		decoded, _ := os.ReadFile(plain)
+		expLines := strings.SplitAfter(string(original), "\n")
+		gotLines := strings.SplitAfter(string(decoded), "\n")
+		if len(gotLines) != len(expLines) {
+			t.Fatalf("%s decrypted exp: %d lines got: %d", cipher, len(expLines), len(gotLines))
+		}
+		for i := range expLines {
+			content, eol := ciphers.SplitLineBreak(expLines[i])
+			encodedLine, _ := cipher.Encode(content)
+			expLine, _ := cipher.Decode(encodedLine)
+			if gotLines[i] != expLine+eol {
+				t.Errorf("%s decrypted line #%d isn't the converted line (%d vs %d bytes)", cipher, i+1, len(gotLines[i]), len(expLine+eol))
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"errors"
+	"lordofscripts/caesarx/ciphers"
+	"lordofscripts/caesarx/ciphers/commands"
+	"lordofscripts/caesarx/cmn"
+	"strings"
+	"testing"
+)
+
+/**
+ * Transposition: Columnar & Double Columnar.
+ * Type : Known vectors without padding (incomplete last row) and
+ *		  repeated keyword letters which are taken left to right.
+ */
+func Test_Transposition_Columnar(t *testing.T) {
+	allCases := []struct {
+		Spec     string
+		Plain    string
+		Expected string
+	}{
+		{"ZEBRAS", "WEAREDISCOVEREDFLEEATONCE", "EVLNACDTESEAROFODEECWIREE"},
+		{"ZEBRA", "ABCDEFGH", "ECHBGDAF"},
+		{"BOOK", "ABCDEFGHIJ", "AEIDHBFJCG"},
+		{"ZEBRA,ZEBRA", "ABCDEFGH", "GHFCABED"},
+		{"ab", "Hi, there!", "H,teei hr!"},
+	}
+
+	for i, tc := range allCases {
+		transposer, err := ciphers.ParseTransposition(tc.Spec)
+		if err != nil {
+			t.Fatalf("#%d '%s' unexpected error: %v", i+1, tc.Spec, err)
+		}
+
+		got, _ := transposer.Execute(tc.Plain)
+		if got != tc.Expected {
+			t.Errorf("#%d %s Execute fail\n\texp: '%s'\n\tgot: '%s'", i+1, transposer, tc.Expected, got)
+		}
+		if plain, _ := transposer.Inverse().Execute(got); plain != tc.Plain {
+			t.Errorf("#%d %s Inverse fail\n\texp: '%s'\n\tgot: '%s'", i+1, transposer, tc.Plain, plain)
+		}
+	}
+
+	for _, spec := range []string{"", "A", " Z ", "ZEBRA,", ",ZEBRA"} {
+		if _, err := ciphers.ParseTransposition(spec); !errors.Is(err, ciphers.ErrTranspositionKey) {
+			t.Errorf("'%s' expected keyword error, got: %v", spec, err)
+		}
+	}
+}
+
+/**
+ * Transposition: Double Columnar.
+ * Type : Equivalence with two Columnar passes & their inverse commands.
+ */
+func Test_Transposition_DoubleColumnar(t *testing.T) {
+	const PLAIN string = "Superencipherment: substitution followed by transposition."
+
+	double, _ := ciphers.NewDoubleColumnarCommand("ZEBRA", "Kite")
+	first, _ := ciphers.NewColumnarCommand("ZEBRA")
+	second, _ := ciphers.NewColumnarCommand("Kite")
+	expected, _ := cmn.NewCommandChain(first, second).Execute(PLAIN)
+	if got, _ := double.Execute(PLAIN); got != expected {
+		t.Errorf("not the same as two passes\n\texp: '%s'\n\tgot: '%s'", expected, got)
+	}
+
+	inverse, _ := ciphers.NewDoubleColumnarInverseCommand("ZEBRA", "Kite")
+	inv1, _ := ciphers.NewColumnarInverseCommand("Kite")
+	inv2, _ := ciphers.NewColumnarInverseCommand("ZEBRA")
+	if got, _ := inverse.Execute(expected); got != PLAIN {
+		t.Errorf("inverse command fail\n\texp: '%s'\n\tgot: '%s'", PLAIN, got)
+	}
+	if got, _ := cmn.NewCommandChain(inv1, inv2).Execute(expected); got != PLAIN {
+		t.Errorf("inverse passes fail\n\texp: '%s'\n\tgot: '%s'", PLAIN, got)
+	}
+}
+
+/**
+ * Cipher: Caesar, Bellaso & Affine followed by a transposition.
+ * Languages: all built-in language alphabets.
+ * Type : Superencipherment round-trip through the Pipe, with NGram
+ *		  formatting after the transposition.
+ */
+func Test_Transposition_Superencipherment(t *testing.T) {
+	for _, alpha := range BuiltinAlphabets {
+		plain := alpha.Chars + " 1984 & " + alpha.ToLowerString(alpha.Chars)
+		transposer, _ := ciphers.ParseTransposition("Alpha,Omega")
+
+		all := []ciphers.ICipherCommand{
+			commands.NewCaesarCommand(alpha, alpha.GetRuneAt(5)),
+			commands.NewBellasoCommand(alpha, string(alpha.GetRuneAt(2))+string(alpha.GetRuneAt(-1))),
+			commands.NewAffineCommand(alpha, 1, 3),
+		}
+		for _, cmd := range all {
+			substituted, _ := cmd.Encode(plain)
+			cmd.WithPipe(transposer)
+			cipher, err := cmd.Encode(plain)
+			if err != nil {
+				t.Fatalf("«%s» %s unexpected error: %v", alpha.Name, cmd, err)
+			}
+			if expected, _ := transposer.Execute(substituted); cipher != expected {
+				t.Errorf("«%s» %s transposition not piped\n\texp: '%s'\n\tgot: '%s'", alpha.Name, cmd, expected, cipher)
+			}
+
+			cmd.WithPipe(cmn.NewCommandChain(transposer, cmn.NewNgramFormatter(5, '·')))
+			if formatted, _ := cmd.Encode(plain); formatted == cipher {
+				t.Errorf("«%s» %s NGram not chained", alpha.Name, cmd)
+			}
+
+			untransposed, _ := transposer.Inverse().Execute(cipher)
+			var decoder ciphers.ICipherCommand
+			switch cmd.(type) {
+			case *commands.AffineCommand:
+				decoder = commands.NewAffineCommand(alpha, 1, 3)
+			default:
+				decoder = cmd
+				decoder.WithPipe(cmn.NewCommandChain())
+			}
+			if decoded, _ := decoder.Decode(untransposed); decoded != plain {
+				t.Errorf("«%s» %s Decode fail\n\texp: '%s'\n\tgot: '%s'", alpha.Name, cmd, plain, decoded)
+			}
+		}
+	}
+}
+
+/**
+ * Transposition with NGram formatting (-transpose & -ngram).
+ * Type : the NGram grouping is removed before the transposition is
+ *		  undone, the spaces (dropped by the formatting) don't come back.
+ */
+func Test_Transposition_NGram(t *testing.T) {
+	const PLAIN = "HELLO WORLD TODAY"
+	transposer, _ := ciphers.ParseTransposition("ZEBRA")
+	ngram := cmn.NewNgramFormatter(5, '·')
+	untranspose := cmn.NewCommandChain(ngram.Inverse(), transposer.Inverse())
+
+	all := []ciphers.ICipherCommand{
+		commands.NewCaesarCommand(cmn.ALPHA_DISK, 'L'),
+		commands.NewAffineCommand(cmn.ALPHA_DISK, 5, 8),
+	}
+	for _, cmd := range all {
+		cmd.WithPipe(cmn.NewCommandChain(ngram.Inverse(), transposer, ngram))
+		cipher, err := cmd.Encode(PLAIN)
+		if err != nil {
+			t.Fatalf("%s unexpected error: %v", cmd, err)
+		}
+		if strings.Count(cipher, "·") != 2 {
+			t.Errorf("%s not NGram formatted: '%s'", cmd, cipher)
+		}
+
+		ciphered, _ := untranspose.Execute(cipher)
+		cmd.WithPipe(cmn.NewCommandChain())
+		if decoded, _ := cmd.Decode(ciphered); decoded != "HELLOWORLDTODAY" {
+			t.Errorf("%s Decode fail\n\texp: 'HELLOWORLDTODAY'\n\tgot: '%s'", cmd, decoded)
+		}
+	}
+}
@@ -21,6 +21,7 @@ const (
 	CommonPCode
 	InternalPCode
 	ConfigurationPCode
+	CryptanalysisPCode
 )
 
 /* ----------------------------------------------------------------
@@ -69,6 +70,7 @@ func toPackageName(pkg PackageCode) string { // @note must match iota definition
 		CommonPCode:        "Common",
 		InternalPCode:      "Internal",
 		ConfigurationPCode: "Configuration",
+		CryptanalysisPCode: "Cryptanalysis",
 	}
 
 	if name, ok := pn[pkg]; ok {